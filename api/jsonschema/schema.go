@@ -0,0 +1,44 @@
+// Package jsonschema bundles the JSON schema of the workflow kinds, which is generated from the OpenAPI spec, so that
+// they can be validated without a cluster.
+package jsonschema
+
+import (
+	_ "embed"
+	"fmt"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+//go:embed schema.json
+var schemaJSON []byte
+
+var (
+	schemaOnce sync.Once
+	schema     *gojsonschema.Schema
+	schemaErr  error
+)
+
+// Validate validates the JSON of a workflow, workflow template, cluster workflow template, cron workflow or workflow
+// event binding against the schema, returning the descriptions of its errors, if any
+func Validate(data []byte) ([]string, error) {
+	schemaOnce.Do(func() {
+		schema, schemaErr = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaJSON))
+	})
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, err
+	}
+	var errs []string
+	for _, e := range result.Errors() {
+		// the kinds are alternatives, so this error only says that the data is not a valid one of them
+		if e.Type() == "number_one_of" && len(result.Errors()) > 1 {
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", e.Field(), e.Description()))
+	}
+	return errs, nil
+}
@@ -0,0 +1,28 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		errs, err := Validate([]byte(`{"apiVersion": "argoproj.io/v1alpha1", "kind": "WorkflowTemplate", "metadata": {"name": "my-wftmpl"}, "spec": {"templates": [{"name": "main", "container": {"image": "my-image"}}]}}`))
+		if assert.NoError(t, err) {
+			assert.Empty(t, errs)
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		errs, err := Validate([]byte(`{"apiVersion": "argoproj.io/v1alpha1", "kind": "WorkflowTemplate", "metadata": {"name": "my-wftmpl"}, "spec": {"templates": [{"name": "main", "container": {"image": 1}}]}}`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"spec.templates.0.container.image: Invalid type. Expected: string, given: integer"}, errs)
+		}
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		errs, err := Validate([]byte(`{"apiVersion": "v1", "kind": "ConfigMap"}`))
+		if assert.NoError(t, err) {
+			assert.NotEmpty(t, errs)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type approveOps struct {
+	nodeFieldSelector string // --node-field-selector
+	comment           string // --comment
+}
+
+func NewApproveCommand() *cobra.Command {
+	var approveArgs approveOps
+
+	command := &cobra.Command{
+		Use:   "approve WORKFLOW1 WORKFLOW2...",
+		Short: "approve the approval gates of zero or more workflows",
+		Example: `# Approve the approval gates of a workflow:
+
+  argo approve my-wf --comment "change CHG-1234 approved"
+
+# Approve one approval gate of a workflow:
+
+  argo approve my-wf --node-field-selector displayName=approve-deploy
+`,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowRunning),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+
+			selector, err := fields.ParseSelector(approveArgs.nodeFieldSelector)
+			if err != nil {
+				log.Fatalf("Unable to parse node field selector '%s': %s", approveArgs.nodeFieldSelector, err)
+			}
+
+			for _, wfName := range args {
+				_, err := serviceClient.ApproveWorkflow(ctx, &workflowpkg.WorkflowApproveRequest{
+					Name:              wfName,
+					Namespace:         namespace,
+					NodeFieldSelector: selector.String(),
+					Comment:           approveArgs.comment,
+				})
+				if err != nil {
+					log.Fatalf("Failed to approve %s: %+v", wfName, err)
+				}
+				fmt.Printf("workflow %s approved\n", wfName)
+			}
+		},
+	}
+	command.Flags().StringVar(&approveArgs.nodeFieldSelector, "node-field-selector", "", "selector of approval gate to approve, eg: --node-field-selector displayName=approve-deploy")
+	command.Flags().StringVar(&approveArgs.comment, "comment", "", "the reason for the approval, recorded on the approval gate")
+	return command
+}
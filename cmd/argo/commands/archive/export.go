@@ -0,0 +1,106 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewExportCommand() *cobra.Command {
+	var (
+		selector      string
+		startedAfter  string
+		startedBefore string
+		allNamespaces bool
+		chunkSize     int64
+	)
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "export workflows in the archive as newline-delimited JSON",
+		Long: `Export workflows in the archive as newline-delimited JSON, one workflow per line, to the standard output.
+
+The output can be imported into another archive with "argo archive import", e.g. to move the archive to another database.`,
+		Example: `# Export the archived workflows of a namespace:
+
+  argo archive export -n my-ns > archive.ndjson
+
+# Export the archived workflows of every namespace, that started in October:
+
+  argo archive export -A --started-after 2021-10-01T00:00:00Z --started-before 2021-11-01T00:00:00Z > archive.ndjson
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var fieldSelector []string
+			if !allNamespaces {
+				fieldSelector = append(fieldSelector, "metadata.namespace="+client.Namespace())
+			}
+			for _, s := range []struct{ flag, value, op string }{{"--started-after", startedAfter, ">"}, {"--started-before", startedBefore, "<"}} {
+				if s.value == "" {
+					continue
+				}
+				if _, err := time.Parse(time.RFC3339, s.value); err != nil {
+					log.Fatalf("%s must be an RFC3339 time: %v", s.flag, err)
+				}
+				fieldSelector = append(fieldSelector, "spec.startedAt"+s.op+s.value)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			n, err := exportArchivedWorkflows(ctx, serviceClient, &metav1.ListOptions{
+				FieldSelector: strings.Join(fieldSelector, ","),
+				LabelSelector: selector,
+				Limit:         chunkSize,
+			}, os.Stdout)
+			errors.CheckError(err)
+			log.Infof("Exported %d workflows", n)
+		},
+	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&startedAfter, "started-after", "", "Only export workflows that started after this time (RFC3339)")
+	command.Flags().StringVar(&startedBefore, "started-before", "", "Only export workflows that started before this time (RFC3339)")
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Export the archived workflows of every namespace")
+	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 100, "List the archived workflows in chunks of this size")
+	return command
+}
+
+// exportArchivedWorkflows writes each of the archived workflows that match the list options to out, as a line of JSON,
+// and returns how many were written. The list only returns a summary of each, so each is got in full.
+func exportArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, listOpts *metav1.ListOptions, out io.Writer) (int, error) {
+	n := 0
+	for {
+		log.WithField("listOpts", listOpts).Debug()
+		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts})
+		if err != nil {
+			return n, err
+		}
+		for _, item := range resp.Items {
+			wf, err := serviceClient.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: string(item.UID)})
+			if err != nil {
+				return n, fmt.Errorf("failed to get archived workflow %s/%s: %w", item.Namespace, item.Name, err)
+			}
+			data, err := json.Marshal(wf)
+			if err != nil {
+				return n, err
+			}
+			if _, err := fmt.Fprintln(out, string(data)); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if resp.Continue == "" {
+			return n, nil
+		}
+		listOpts.Continue = resp.Continue
+	}
+}
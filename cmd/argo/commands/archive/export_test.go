@@ -0,0 +1,88 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// fakeArchive is an archive of workflows, keyed by UID, that lists them one per page
+type fakeArchive struct {
+	workflowarchivepkg.ArchivedWorkflowServiceClient
+	uids      []string
+	workflows map[string]*wfv1.Workflow
+}
+
+func newFakeArchive() *fakeArchive {
+	return &fakeArchive{workflows: map[string]*wfv1.Workflow{}}
+}
+
+func (f *fakeArchive) ListArchivedWorkflows(_ context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowList, error) {
+	i := 0
+	for i < len(f.uids) && req.ListOptions.Continue != "" && f.uids[i] != req.ListOptions.Continue {
+		i++
+	}
+	list := &wfv1.WorkflowList{}
+	if i < len(f.uids) {
+		wf := f.workflows[f.uids[i]]
+		// the list only has a summary of each workflow
+		list.Items = append(list.Items, wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: wf.Name, Namespace: wf.Namespace, UID: wf.UID}})
+	}
+	if i+1 < len(f.uids) {
+		list.Continue = f.uids[i+1]
+	}
+	return list, nil
+}
+
+func (f *fakeArchive) GetArchivedWorkflow(_ context.Context, req *workflowarchivepkg.GetArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	return f.workflows[req.Uid], nil
+}
+
+func (f *fakeArchive) CreateArchivedWorkflow(_ context.Context, req *workflowarchivepkg.CreateArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	uid := string(req.Workflow.UID)
+	if _, ok := f.workflows[uid]; !ok {
+		f.uids = append(f.uids, uid)
+	}
+	f.workflows[uid] = req.Workflow
+	return req.Workflow, nil
+}
+
+func Test_exportImportArchivedWorkflows(t *testing.T) {
+	ctx := context.Background()
+	from := newFakeArchive()
+	for _, name := range []string{"my-wf-1", "my-wf-2"} {
+		_, _ = from.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Workflow: &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", UID: types.UID(name + "-uid")},
+			Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded},
+		}})
+	}
+
+	buf := &bytes.Buffer{}
+	n, err := exportArchivedWorkflows(ctx, from, &metav1.ListOptions{Limit: 1}, buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, n)
+		assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 2, "one workflow per line")
+	}
+
+	to := newFakeArchive()
+	out := &bytes.Buffer{}
+	n, err = importArchivedWorkflows(ctx, to, buf, out)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, n)
+		assert.Equal(t, "Archived workflow my-ns/my-wf-1 (my-wf-1-uid)\nArchived workflow my-ns/my-wf-2 (my-wf-2-uid)\n", out.String())
+		assert.Equal(t, from.workflows, to.workflows, "the workflows are imported in full")
+	}
+
+	_, err = importArchivedWorkflows(ctx, to, strings.NewReader("{}\nnot json"), out)
+	assert.EqualError(t, err, "failed to decode workflow 2: invalid character 'o' in literal null (expecting 'u')")
+}
@@ -0,0 +1,76 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewImportCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import FILE",
+		Short: "import workflows exported with `argo archive export` into the archive",
+		Long: `Import workflows exported with "argo archive export" into the archive.
+
+FILE is newline-delimited JSON, one workflow per line, or "-" to read the standard input. Each workflow is archived in its own namespace, with its own UID, replacing any archived workflow with that UID. So a file can be imported again, e.g. if an import was interrupted.`,
+		Example: `# Import archived workflows:
+
+  argo archive import archive.ndjson
+
+# Copy the archive of one Argo Server to another:
+
+  argo archive export -A --argo-server old:2746 | argo archive import - --argo-server new:2746
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			in := os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				errors.CheckError(err)
+				defer func() { _ = f.Close() }()
+				in = f
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			n, err := importArchivedWorkflows(ctx, serviceClient, in, os.Stdout)
+			if err != nil {
+				log.Fatalf("Failed after importing %d workflows: %v", n, err)
+			}
+			log.Infof("Imported %d workflows", n)
+		},
+	}
+	return command
+}
+
+// importArchivedWorkflows archives each workflow read from in, and returns how many were archived
+func importArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, in io.Reader, out io.Writer) (int, error) {
+	decoder := json.NewDecoder(in)
+	n := 0
+	for {
+		wf := &wfv1.Workflow{}
+		if err := decoder.Decode(wf); err == io.EOF {
+			return n, nil
+		} else if err != nil {
+			return n, fmt.Errorf("failed to decode workflow %d: %w", n+1, err)
+		}
+		if _, err := serviceClient.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Namespace: wf.Namespace, Workflow: wf}); err != nil {
+			return n, fmt.Errorf("failed to archive workflow %s/%s: %w", wf.Namespace, wf.Name, err)
+		}
+		_, _ = fmt.Fprintf(out, "Archived workflow %s/%s (%s)\n", wf.Namespace, wf.Name, wf.UID)
+		n++
+	}
+}
@@ -18,6 +18,7 @@ import (
 func NewListCommand() *cobra.Command {
 	var (
 		selector  string
+		search    string
 		output    string
 		chunkSize int64
 	)
@@ -37,7 +38,7 @@ func NewListCommand() *cobra.Command {
 			var workflows wfv1.Workflows
 			for {
 				log.WithField("listOpts", listOpts).Debug()
-				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts})
+				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts, Search: search})
 				errors.CheckError(err)
 				workflows = append(workflows, resp.Items...)
 				if resp.Continue == "" {
@@ -52,6 +53,7 @@ func NewListCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&search, "search", "", "Only list workflows whose name, labels, annotations, or node display names or messages match every word of the search")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	return command
 }
@@ -0,0 +1,58 @@
+package archive
+
+import (
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
+)
+
+func NewResubmitCommand() *cobra.Command {
+	var (
+		memoized   bool
+		parameters []string
+		output     string
+	)
+	command := &cobra.Command{
+		Use:   "resubmit UID",
+		Short: "resubmit a workflow in the archive",
+		Example: `# Resubmit an archived workflow:
+
+  argo archive resubmit 7e3a7b8c-0d16-41a4-8baa-8e0b6a3d6cd4
+
+# Resubmit an archived workflow with a new argument, re-using the outputs of the steps whose inputs are unchanged:
+
+  argo archive resubmit 7e3a7b8c-0d16-41a4-8baa-8e0b6a3d6cd4 --memoized -p message=goodbye
+
+# Resubmit an archived workflow in another namespace:
+
+  argo archive resubmit 7e3a7b8c-0d16-41a4-8baa-8e0b6a3d6cd4 -n other-ns
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			req := &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: args[0], Memoized: memoized, Parameters: parameters}
+			if cmd.Flag("namespace").Changed {
+				req.Namespace = client.Namespace()
+			}
+			wf, err := serviceClient.ResubmitArchivedWorkflow(ctx, req)
+			errors.CheckError(err)
+			err = printer.PrintWorkflows(wfv1.Workflows{*wf}, os.Stdout, printer.PrintOpts{Output: output, Namespace: true})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().BoolVar(&memoized, "memoized", false, "re-use the outputs of the successful steps whose output artifacts still exist and whose inputs are unchanged")
+	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "override a workflow argument parameter, e.g. -p message=goodbye")
+	command.Flags().StringVarP(&output, "output", "o", "name", "Output format. One of: name|json|yaml|wide")
+	return command
+}
@@ -17,5 +17,8 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewListLabelKeyCommand())
 	command.AddCommand(NewListLabelValueCommand())
+	command.AddCommand(NewExportCommand())
+	command.AddCommand(NewImportCommand())
+	command.AddCommand(NewResubmitCommand())
 	return command
 }
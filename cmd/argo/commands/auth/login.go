@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+)
+
+func NewLoginCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "login",
+		Short: "log in to the Argo Server with SSO, from a machine without a browser",
+		Long: `Log in to the Argo Server with SSO, using the OAuth2 device authorization grant, so you can log in from a machine without a browser, such as over SSH.
+
+A code is printed, for you to enter on any device with a browser. Once you have, the token is cached, and used by each command that uses the Argo Server, until you run "argo auth logout". It is refreshed when it expires, if the SSO provider issued a refresh token, e.g. because the "offline_access" scope is configured.
+
+The SSO provider must support the device authorization grant.`,
+		Example: `# Log in to the Argo Server:
+
+  argo auth login --argo-server argo.example.com:443
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx := cmd.Context()
+			code, err := client.RequestDeviceCode(ctx)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if code.VerificationURIComplete != "" {
+				fmt.Printf("Open %s and check the code is %s\n", code.VerificationURIComplete, code.UserCode)
+			} else {
+				fmt.Printf("Open %s and enter the code %s\n", code.VerificationURI, code.UserCode)
+			}
+			token, err := client.PollSSOToken(ctx, code)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := client.WriteSSOToken(token); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("Logged in to %s until %s\n", client.GetArgoServerOpts().URL, token.Expiry.Local().Format("2006-01-02 15:04:05 MST"))
+		},
+	}
+}
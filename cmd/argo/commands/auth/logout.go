@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+)
+
+func NewLogoutCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "delete the SSO token cached by argo auth login",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			deleted, err := client.DeleteSSOToken()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if deleted {
+				fmt.Printf("Logged out of %s\n", client.GetArgoServerOpts().URL)
+			} else {
+				fmt.Printf("Not logged in to %s\n", client.GetArgoServerOpts().URL)
+			}
+		},
+	}
+}
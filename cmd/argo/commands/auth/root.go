@@ -12,6 +12,8 @@ func NewAuthCommand() *cobra.Command {
 			cmd.HelpFunc()(cmd, args)
 		},
 	}
+	command.AddCommand(NewLoginCommand())
+	command.AddCommand(NewLogoutCommand())
 	command.AddCommand(NewTokenCommand())
 	return command
 }
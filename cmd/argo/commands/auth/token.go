@@ -13,6 +13,7 @@ func NewTokenCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "token",
 		Short: "Print the auth token",
+		Long:  "Print the auth token, which is ARGO_TOKEN if it is set, else the SSO token cached by \"argo auth login\", refreshed if it has expired, else the token from the kube config.",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) != 0 {
 				cmd.HelpFunc()(cmd, args)
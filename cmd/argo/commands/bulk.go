@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const bulkUsage = "Perform the operation on the server in a single request, rather than one request per workflow. One result is printed per workflow."
+
+// bulkWorkflows performs the operation on the server, and prints the result for each workflow. It returns an error if
+// the operation failed for any workflow.
+func bulkWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, req *workflowpkg.WorkflowBulkRequest, labelSelector, fieldSelector string, done string) error {
+	if labelSelector != "" || fieldSelector != "" {
+		req.ListOptions = &metav1.ListOptions{LabelSelector: labelSelector, FieldSelector: fieldSelector}
+	}
+	res, err := serviceClient.BulkWorkflows(ctx, req)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, r := range res.Results {
+		if r.Error != "" {
+			failed++
+			fmt.Printf("workflow %s failed: %s\n", r.Name, r.Error)
+		} else {
+			fmt.Printf("workflow %s %s\n", r.Name, done)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d workflows failed to be %s", failed, len(res.Results), done)
+	}
+	return nil
+}
+
+// dryRunWorkflows prints the workflows an operation would be performed on, without performing it. The workflows are
+// de-duplicated, and those that were named, rather than listed, are got, so their phases are printed and those that do
+// not exist are reported.
+func dryRunWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, out io.Writer, wfs wfv1.Workflows, done string) error {
+	var selected wfv1.Workflows
+	seen := make(map[string]bool)
+	for _, wf := range wfs {
+		if wf.Status.Phase == "" && wf.CreationTimestamp.IsZero() {
+			got, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+				Name:      wf.Name,
+				Namespace: wf.Namespace,
+				Fields:    "metadata.name,metadata.namespace,metadata.creationTimestamp,status.phase",
+			})
+			if status.Code(err) == codes.NotFound {
+				_, _ = fmt.Fprintf(out, "workflow %s not found\n", wf.Name)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			wf = *got
+		}
+		key := wf.Namespace + "/" + wf.Name
+		if seen[key] {
+			// de-duplication in case there is an overlap between the selector and given workflow names
+			continue
+		}
+		seen[key] = true
+		selected = append(selected, wf)
+	}
+	if len(selected) > 0 {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tSTATUS\tAGE")
+		for _, wf := range selected {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", wf.Namespace, wf.Name, wf.Status.Phase, humanize.RelativeDurationShort(wf.CreationTimestamp.Time, time.Now()))
+		}
+		_ = w.Flush()
+	}
+	_, _ = fmt.Fprintf(out, "%d workflows would be %s (dry-run)\n", len(selected), done)
+	return nil
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_dryRunWorkflows(t *testing.T) {
+	created := metav1.NewTime(time.Now().Add(-time.Hour))
+	named := func(name string) wfv1.Workflow {
+		return wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argo"}}
+	}
+	t.Run("Listed and named", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("GetWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WorkflowGetRequest) bool { return req.Name == "bar" })).
+			Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "argo", CreationTimestamp: created}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed}}, nil)
+		c.On("GetWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WorkflowGetRequest) bool { return req.Name == "missing" })).
+			Return(nil, status.Error(codes.NotFound, "not found"))
+		listed := wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "argo", CreationTimestamp: created}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}}
+		out := &bytes.Buffer{}
+		err := dryRunWorkflows(context.Background(), c, out, wfv1.Workflows{listed, named("bar"), named("missing"), named("bar")}, "stopped")
+		if assert.NoError(t, err) {
+			assert.Equal(t, `workflow missing not found
+NAMESPACE  NAME  STATUS   AGE
+argo       foo   Running  1h
+argo       bar   Failed   1h
+2 workflows would be stopped (dry-run)
+`, out.String())
+		}
+	})
+	t.Run("None", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		out := &bytes.Buffer{}
+		err := dryRunWorkflows(context.Background(), c, out, nil, "deleted")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "0 workflows would be deleted (dry-run)\n", out.String())
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("GetWorkflow", mock.Anything, mock.Anything).Return(nil, status.Error(codes.PermissionDenied, "denied"))
+		err := dryRunWorkflows(context.Background(), c, &bytes.Buffer{}, wfv1.Workflows{named("foo")}, "terminated")
+		assert.Error(t, err)
+	})
+}
@@ -25,6 +25,7 @@ var overrides = clientcmd.ConfigOverrides{}
 var (
 	explicitPath string
 	Offline      bool
+	OfflineFiles []string
 )
 
 func AddKubectlFlagsToCmd(cmd *cobra.Command) {
@@ -41,6 +42,7 @@ func GetConfig() clientcmd.ClientConfig {
 }
 
 func AddAPIClientFlagsToCmd(cmd *cobra.Command) {
+	apiClientFlags = cmd.PersistentFlags()
 	cmd.PersistentFlags().StringVar(&instanceID, "instanceid", os.Getenv("ARGO_INSTANCEID"), "submit with a specific controller's instance id label. Default to the ARGO_INSTANCEID environment variable.")
 	// "-s" like kubectl
 	cmd.PersistentFlags().StringVarP(&argoServerOpts.URL, "argo-server", "s", os.Getenv("ARGO_SERVER"), "API server `host:port`. e.g. localhost:2746. Defaults to the ARGO_SERVER environment variable.")
@@ -52,16 +54,25 @@ func AddAPIClientFlagsToCmd(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&argoServerOpts.InsecureSkipVerify, "insecure-skip-verify", "k", os.Getenv("ARGO_INSECURE_SKIP_VERIFY") == "true", "If true, the Argo Server's certificate will not be checked for validity. This will make your HTTPS connections insecure. Defaults to the ARGO_INSECURE_SKIP_VERIFY environment variable.")
 }
 
+// GetArgoServerOpts returns the options used to connect to the Argo Server. If the URL is empty, the CLI does not use it.
+// Unless the Argo Server was given by a flag or the environment, it is the Argo Server of the current kube config
+// context, from the CLI's config file.
+func GetArgoServerOpts() apiclient.ArgoServerOpts {
+	applyContextConfig()
+	return argoServerOpts
+}
+
 func NewAPIClient(ctx context.Context) (context.Context, apiclient.Client) {
 	ctx, client, err := apiclient.NewClientFromOpts(
 		apiclient.Opts{
-			ArgoServerOpts: argoServerOpts,
+			ArgoServerOpts: GetArgoServerOpts(),
 			InstanceID:     instanceID,
 			AuthSupplier: func() string {
 				return GetAuthString()
 			},
 			ClientConfigSupplier: func() clientcmd.ClientConfig { return GetConfig() },
 			Offline:              Offline,
+			OfflineFiles:         OfflineFiles,
 			Context:              ctx,
 		})
 	if err != nil {
@@ -93,6 +104,9 @@ func GetAuthString() string {
 	if ok {
 		return token
 	}
+	if authString, ok := ssoAuthString(); ok {
+		return authString
+	}
 	restConfig, err := GetConfig().ClientConfig()
 	if err != nil {
 		log.Fatal(err)
@@ -105,3 +119,15 @@ func GetAuthString() string {
 	}
 	return authString
 }
+
+// CurrentContext returns the name of the kube config context the CLI uses
+func CurrentContext() string {
+	if overrides.CurrentContext != "" {
+		return overrides.CurrentContext
+	}
+	rawConfig, err := GetConfig().RawConfig()
+	if err != nil {
+		return ""
+	}
+	return rawConfig.CurrentContext
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// ContextConfig is how the CLI talks to the Argo Server of the cluster of a kube config context
+type ContextConfig struct {
+	// ArgoServer is the Argo Server's `host:port`, as per --argo-server
+	ArgoServer         string `json:"argoServer"`
+	BaseHRef           string `json:"baseHref,omitempty"`
+	HTTP1              bool   `json:"http1,omitempty"`
+	Secure             *bool  `json:"secure,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// Config is the CLI's config file, which maps each kube config context to the Argo Server of its cluster. The CLI uses
+// the Argo Server of the current context, and talks directly to Kubernetes for a context that is not in the file.
+type Config struct {
+	Contexts map[string]ContextConfig `json:"contexts,omitempty"`
+}
+
+var (
+	// the flags the options of the Argo Server were parsed from, so those given explicitly are not overridden
+	apiClientFlags     *pflag.FlagSet
+	contextConfigOnce  sync.Once
+	contextConfigError error
+)
+
+// ConfigFile returns the path of the CLI's config file, which is $ARGO_CONFIG, or argo/config.yaml in the user's
+// config directory
+func ConfigFile() (string, error) {
+	if file, ok := os.LookupEnv("ARGO_CONFIG"); ok {
+		return file, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "argo", "config.yaml"), nil
+}
+
+// ReadConfig returns the CLI's config file, which is empty if there is none
+func ReadConfig() (*Config, error) {
+	file, err := ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	data, err := ioutil.ReadFile(filepath.Clean(file))
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", file, err)
+	}
+	return config, nil
+}
+
+// applyContextConfig sets the options of the Argo Server to those of the current kube config context, unless the Argo
+// Server was given by --argo-server or ARGO_SERVER. Options given explicitly by a flag are kept.
+func applyContextConfig() {
+	contextConfigOnce.Do(func() {
+		if argoServerOpts.URL != "" || Offline {
+			return
+		}
+		config, err := ReadConfig()
+		if err != nil {
+			contextConfigError = err
+			return
+		}
+		contextName := CurrentContext()
+		contextConfig, ok := config.Contexts[contextName]
+		if !ok || contextConfig.ArgoServer == "" {
+			return
+		}
+		log.WithFields(log.Fields{"context": contextName, "argoServer": contextConfig.ArgoServer}).Debug("Using the Argo Server of the context")
+		argoServerOpts.URL = contextConfig.ArgoServer
+		if !flagChanged("argo-base-href") && os.Getenv("ARGO_BASE_HREF") == "" {
+			argoServerOpts.Path = contextConfig.BaseHRef
+		}
+		if !flagChanged("argo-http1") && os.Getenv("ARGO_HTTP1") == "" {
+			argoServerOpts.HTTP1 = contextConfig.HTTP1
+		}
+		if !flagChanged("secure") && os.Getenv("ARGO_SECURE") == "" && contextConfig.Secure != nil {
+			argoServerOpts.Secure = *contextConfig.Secure
+		}
+		if !flagChanged("insecure-skip-verify") && os.Getenv("ARGO_INSECURE_SKIP_VERIFY") == "" {
+			argoServerOpts.InsecureSkipVerify = contextConfig.InsecureSkipVerify
+		}
+	})
+	if contextConfigError != nil {
+		log.Fatal(contextConfigError)
+	}
+}
+
+func flagChanged(name string) bool {
+	if apiClientFlags == nil {
+		return false
+	}
+	f := apiClientFlags.Lookup(name)
+	return f != nil && f.Changed
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+)
+
+func withConfig(t *testing.T, config string) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(config), 0o600))
+	t.Setenv("ARGO_CONFIG", file)
+	overrides.CurrentContext = "my-context"
+	t.Cleanup(func() {
+		overrides.CurrentContext = ""
+		argoServerOpts = apiclient.ArgoServerOpts{}
+		apiClientFlags = nil
+		contextConfigOnce = sync.Once{}
+		contextConfigError = nil
+	})
+}
+
+func TestReadConfig(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		t.Setenv("ARGO_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+		config, err := ReadConfig()
+		require.NoError(t, err)
+		assert.Empty(t, config.Contexts)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		withConfig(t, "contexts:\n  my-context:\n    argoServr: localhost:2746\n")
+		_, err := ReadConfig()
+		assert.Error(t, err)
+	})
+}
+
+func TestGetArgoServerOpts(t *testing.T) {
+	const config = `contexts:
+  my-context:
+    argoServer: argo.example.com:443
+    baseHref: /argo
+    insecureSkipVerify: true
+  other-context:
+    argoServer: localhost:2746
+`
+	t.Run("Context", func(t *testing.T) {
+		withConfig(t, config)
+		argoServerOpts.Secure = true
+		opts := GetArgoServerOpts()
+		assert.Equal(t, "argo.example.com:443", opts.URL)
+		assert.Equal(t, "/argo", opts.Path)
+		assert.True(t, opts.Secure)
+		assert.True(t, opts.InsecureSkipVerify)
+	})
+	t.Run("NoContext", func(t *testing.T) {
+		withConfig(t, config)
+		overrides.CurrentContext = "kube-context"
+		assert.Empty(t, GetArgoServerOpts().URL)
+	})
+	t.Run("ArgoServer", func(t *testing.T) {
+		withConfig(t, config)
+		argoServerOpts.URL = "localhost:2746"
+		opts := GetArgoServerOpts()
+		assert.Equal(t, "localhost:2746", opts.URL)
+		assert.Empty(t, opts.Path)
+	})
+	t.Run("Flag", func(t *testing.T) {
+		withConfig(t, config)
+		apiClientFlags = pflag.NewFlagSet("", pflag.ContinueOnError)
+		apiClientFlags.BoolVarP(&argoServerOpts.InsecureSkipVerify, "insecure-skip-verify", "k", false, "")
+		require.NoError(t, apiClientFlags.Parse([]string{"--insecure-skip-verify=false"}))
+		opts := GetArgoServerOpts()
+		assert.Equal(t, "argo.example.com:443", opts.URL)
+		assert.False(t, opts.InsecureSkipVerify)
+	})
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+)
+
+// how long before an SSO token expires it is refreshed, so it does not expire during a request
+const ssoTokenRefreshBefore = time.Minute
+
+// how often the token is requested, while the user has not entered the code, unless the Argo Server says otherwise
+var ssoPollInterval = 5 * time.Second
+
+// ssoTokenDir returns the directory SSO tokens are cached in, or an error if there is no cache directory
+var ssoTokenDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "argo", "sso"), nil
+}
+
+// ssoTokenFile returns the file the SSO token for the Argo Server is cached in
+func ssoTokenFile() (string, error) {
+	dir, err := ssoTokenDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(GetArgoServerOpts().GetURL()))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+func ssoHTTPClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: GetArgoServerOpts().InsecureSkipVerify},
+	}}
+}
+
+// tokenError is the error the Argo Server returned to a token request, e.g. "authorization_pending"
+type tokenError struct {
+	code        string
+	description string
+}
+
+func (e *tokenError) Error() string {
+	if e.description == "" {
+		return e.code
+	}
+	return e.code + ": " + e.description
+}
+
+// postSSO posts the values to the Argo Server's OAuth2 endpoint, and decodes a successful response into v. If the
+// request failed, the error is returned as a *tokenError.
+func postSSO(ctx context.Context, path string, values url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, GetArgoServerOpts().GetURL()+path, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := ssoHTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		res := &sso.TokenError{}
+		if err := json.Unmarshal(data, res); err != nil || res.Error == "" {
+			return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(data)))
+		}
+		return &tokenError{code: res.Error, description: res.ErrorDescription}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// RequestDeviceCode requests a code for the user to enter, to authorize the CLI, as per RFC 8628
+func RequestDeviceCode(ctx context.Context) (*sso.DeviceCode, error) {
+	if GetArgoServerOpts().URL == "" {
+		return nil, fmt.Errorf("SSO requires the Argo Server, set --argo-server or ARGO_SERVER")
+	}
+	code := &sso.DeviceCode{}
+	if err := postSSO(ctx, "/oauth2/device", url.Values{}, code); err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+	return code, nil
+}
+
+// PollSSOToken requests the token until the user has entered the code, or the code expires
+func PollSSOToken(ctx context.Context, code *sso.DeviceCode) (*sso.Token, error) {
+	interval := ssoPollInterval
+	if code.Interval > 0 {
+		interval = time.Duration(code.Interval) * time.Second
+	}
+	if code.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("the code was not entered in time: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+		token := &sso.Token{}
+		err := postSSO(ctx, "/oauth2/token", url.Values{"grant_type": {sso.DeviceCodeGrantType}, "device_code": {code.DeviceCode}}, token)
+		if tokenErr, ok := err.(*tokenError); ok {
+			switch tokenErr.code {
+			case "authorization_pending":
+				continue
+			case "slow_down":
+				interval += ssoPollInterval
+				continue
+			}
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("the code was not entered in time: %w", ctx.Err())
+			}
+			return nil, fmt.Errorf("failed to get token: %w", err)
+		}
+		return token, nil
+	}
+}
+
+func refreshSSOToken(ctx context.Context, refreshToken string) (*sso.Token, error) {
+	token := &sso.Token{}
+	if err := postSSO(ctx, "/oauth2/token", url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}}, token); err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// ReadSSOToken returns the SSO token cached for the Argo Server, or nil if there is none
+func ReadSSOToken() (*sso.Token, error) {
+	file, err := ssoTokenFile()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(file))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	token := &sso.Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("failed to read SSO token %s: %w", file, err)
+	}
+	return token, nil
+}
+
+// WriteSSOToken caches the SSO token for the Argo Server, where only the user can read it
+func WriteSSOToken(token *sso.Token) error {
+	file, err := ssoTokenFile()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0o600)
+}
+
+// DeleteSSOToken deletes the SSO token cached for the Argo Server, and returns false if there was none
+func DeleteSSOToken() (bool, error) {
+	file, err := ssoTokenFile()
+	if err != nil {
+		return false, err
+	}
+	err = os.Remove(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// ssoAuthString returns the authorization of the SSO token cached for the Argo Server, refreshing it if it is about to
+// expire, and true, or false if there is no token that can be used
+func ssoAuthString() (string, bool) {
+	if GetArgoServerOpts().URL == "" {
+		return "", false
+	}
+	token, err := ReadSSOToken()
+	if err != nil {
+		log.WithError(err).Warn("Failed to read SSO token")
+		return "", false
+	}
+	if token == nil {
+		return "", false
+	}
+	if time.Until(token.Expiry) > ssoTokenRefreshBefore {
+		return token.Authorization, true
+	}
+	if token.RefreshToken == "" {
+		log.Warn("SSO token has expired, run `argo auth login` to get a new one")
+		return "", false
+	}
+	refreshed, err := refreshSSOToken(context.Background(), token.RefreshToken)
+	if err != nil {
+		log.WithError(err).Warn("SSO token has expired, run `argo auth login` to get a new one")
+		return "", false
+	}
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	if err := WriteSSOToken(refreshed); err != nil {
+		log.WithError(err).Warn("Failed to cache SSO token")
+	}
+	return refreshed.Authorization, true
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+)
+
+// withArgoServer uses a fake Argo Server, and a temporary token cache, for the test
+func withArgoServer(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	dir := t.TempDir()
+	oldOpts, oldDir, oldInterval := argoServerOpts, ssoTokenDir, ssoPollInterval
+	argoServerOpts = apiclient.ArgoServerOpts{URL: strings.TrimPrefix(server.URL, "http://")}
+	ssoTokenDir = func() (string, error) { return dir, nil }
+	ssoPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		server.Close()
+		argoServerOpts, ssoTokenDir, ssoPollInterval = oldOpts, oldDir, oldInterval
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestLogin(t *testing.T) {
+	polls := 0
+	withArgoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/device":
+			writeJSON(w, http.StatusOK, &sso.DeviceCode{DeviceCode: "my-device-code", UserCode: "ABCD-EFGH", VerificationURI: "https://sso/device", ExpiresIn: 60})
+		case "/oauth2/token":
+			assert.Equal(t, sso.DeviceCodeGrantType, r.PostFormValue("grant_type"))
+			assert.Equal(t, "my-device-code", r.PostFormValue("device_code"))
+			polls++
+			switch polls {
+			case 1:
+				writeJSON(w, http.StatusBadRequest, &sso.TokenError{Error: "authorization_pending"})
+			case 2:
+				writeJSON(w, http.StatusBadRequest, &sso.TokenError{Error: "slow_down"})
+			default:
+				writeJSON(w, http.StatusOK, &sso.Token{Authorization: "Bearer v2:my-token", Expiry: time.Now().Add(time.Hour)})
+			}
+		}
+	})
+	code, err := RequestDeviceCode(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD-EFGH", code.UserCode)
+	token, err := PollSSOToken(context.Background(), code)
+	require.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, "Bearer v2:my-token", token.Authorization)
+}
+
+func TestLoginDenied(t *testing.T) {
+	withArgoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusBadRequest, &sso.TokenError{Error: "access_denied", ErrorDescription: "the user denied the request"})
+	})
+	_, err := PollSSOToken(context.Background(), &sso.DeviceCode{DeviceCode: "my-device-code"})
+	assert.EqualError(t, err, "failed to get token: access_denied: the user denied the request")
+}
+
+func TestLoginNotSupported(t *testing.T) {
+	withArgoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotImplemented)
+	})
+	_, err := RequestDeviceCode(context.Background())
+	assert.EqualError(t, err, "failed to request device code: 501 Not Implemented: ")
+}
+
+func Test_ssoAuthString(t *testing.T) {
+	refreshes := 0
+	withArgoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("grant_type") != "refresh_token" || r.PostFormValue("refresh_token") != "my-refresh-token" {
+			writeJSON(w, http.StatusBadRequest, &sso.TokenError{Error: "invalid_grant"})
+			return
+		}
+		refreshes++
+		writeJSON(w, http.StatusOK, &sso.Token{Authorization: "Bearer v2:my-refreshed-token", Expiry: time.Now().Add(time.Hour)})
+	})
+	t.Run("NotLoggedIn", func(t *testing.T) {
+		_, ok := ssoAuthString()
+		assert.False(t, ok)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, WriteSSOToken(&sso.Token{Authorization: "Bearer v2:my-token", Expiry: time.Now().Add(time.Hour)}))
+		authString, ok := ssoAuthString()
+		assert.True(t, ok)
+		assert.Equal(t, "Bearer v2:my-token", authString)
+		assert.Zero(t, refreshes)
+	})
+	t.Run("Refreshed", func(t *testing.T) {
+		require.NoError(t, WriteSSOToken(&sso.Token{Authorization: "Bearer v2:my-token", Expiry: time.Now(), RefreshToken: "my-refresh-token"}))
+		authString, ok := ssoAuthString()
+		assert.True(t, ok)
+		assert.Equal(t, "Bearer v2:my-refreshed-token", authString)
+		assert.Equal(t, 1, refreshes)
+		token, err := ReadSSOToken()
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer v2:my-refreshed-token", token.Authorization)
+		// the Argo Server did not return a new one, so the old one is kept
+		assert.Equal(t, "my-refresh-token", token.RefreshToken)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, WriteSSOToken(&sso.Token{Authorization: "Bearer v2:my-token", Expiry: time.Now()}))
+		_, ok := ssoAuthString()
+		assert.False(t, ok)
+	})
+	t.Run("RefreshFailed", func(t *testing.T) {
+		require.NoError(t, WriteSSOToken(&sso.Token{Authorization: "Bearer v2:my-token", Expiry: time.Now(), RefreshToken: "revoked"}))
+		_, ok := ssoAuthString()
+		assert.False(t, ok)
+	})
+	t.Run("LoggedOut", func(t *testing.T) {
+		deleted, err := DeleteSSOToken()
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		deleted, err = DeleteSSOToken()
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		_, ok := ssoAuthString()
+		assert.False(t, ok)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -23,8 +24,9 @@ type cliCreateOpts struct {
 func NewCreateCommand() *cobra.Command {
 	var cliCreateOpts cliCreateOpts
 	command := &cobra.Command{
-		Use:   "create FILE1 FILE2...",
-		Short: "create a cluster workflow template",
+		Use:               "create FILE1 FILE2...",
+		Short:             "create a cluster workflow template",
+		ValidArgsFunction: completion.ManifestFiles,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
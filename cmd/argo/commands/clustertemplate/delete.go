@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 )
 
@@ -16,8 +17,9 @@ func NewDeleteCommand() *cobra.Command {
 	var all bool
 
 	command := &cobra.Command{
-		Use:   "delete WORKFLOW_TEMPLATE",
-		Short: "delete a cluster workflow template",
+		Use:               "delete WORKFLOW_TEMPLATE",
+		Short:             "delete a cluster workflow template",
+		ValidArgsFunction: completion.ClusterWorkflowTemplates,
 		Run: func(cmd *cobra.Command, args []string) {
 			apiServerDeleteClusterWorkflowTemplates(cmd.Context(), all, args)
 		},
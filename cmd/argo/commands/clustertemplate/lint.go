@@ -6,19 +6,22 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/lint"
 	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 )
 
 func NewLintCommand() *cobra.Command {
 	var (
-		strict bool
-		output string
+		strict                     bool
+		strictTemplateRefArguments bool
+		output                     string
 	)
 
 	command := &cobra.Command{
-		Use:   "lint FILE...",
-		Short: "validate files or directories of cluster workflow template manifests",
+		Use:               "lint FILE...",
+		Short:             "validate files or directories of cluster workflow template manifests",
+		ValidArgsFunction: completion.ManifestFiles,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -27,10 +30,11 @@ func NewLintCommand() *cobra.Command {
 
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			opts := lint.LintOptions{
-				Files:            args,
-				DefaultNamespace: client.Namespace(),
-				Strict:           strict,
-				Printer:          os.Stdout,
+				Files:                      args,
+				DefaultNamespace:           client.Namespace(),
+				Strict:                     strict,
+				StrictTemplateRefArguments: strictTemplateRefArguments,
+				Printer:                    os.Stdout,
 			}
 
 			lint.RunLint(ctx, apiClient, []string{wf.ClusterWorkflowTemplatePlural}, output, false, opts)
@@ -39,5 +43,6 @@ func NewLintCommand() *cobra.Command {
 
 	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple")
 	command.Flags().BoolVar(&strict, "strict", true, "perform strict workflow validation")
+	command.Flags().BoolVar(&strictTemplateRefArguments, "strict-template-ref-arguments", false, "reject arguments of steps and tasks that are not inputs of their referenced templates")
 	return command
 }
@@ -0,0 +1,56 @@
+package clustertemplate
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func NewRenderCommand() *cobra.Command {
+	var (
+		submitOpts wfv1.SubmitOpts
+		output     string
+	)
+	command := &cobra.Command{
+		Use:   "render CLUSTER_WORKFLOW_TEMPLATE",
+		Short: "print the workflow a cluster workflow template would be submitted as, without submitting it",
+		Long: `Print the workflow a cluster workflow template would be submitted as, in the namespace, without submitting it.
+
+The spec is the one the controller would run: the spec of the cluster workflow template, with the parameters, the workflow defaults and the template defaults applied. Variables are not substituted, as most are only known when the workflow runs.`,
+		Example: `# Render a cluster workflow template:
+
+  argo cluster-template render my-cwftmpl
+
+# Render a cluster workflow template, with parameters, as JSON:
+
+  argo cluster-template render my-cwftmpl -p message=hello -o json
+`,
+		ValidArgsFunction: completion.ClusterWorkflowTemplate,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			wf, err := template.RenderWorkflow(ctx, serviceClient, client.Namespace(), workflow.ClusterWorkflowTemplateKind, args[0], &submitOpts)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := template.PrintRenderedWorkflow(os.Stdout, wf, output); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	util.PopulateSubmitOpts(command, &submitOpts, false)
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml")
+	return command
+}
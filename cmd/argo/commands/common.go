@@ -59,8 +59,10 @@ func initializeSession() {
 			wfv1.NodeTypeSuspend: ansiFormat("Suspend", FgCyan),
 		}
 		workflowConditionIconMap = map[wfv1.ConditionType]string{
-			wfv1.ConditionTypeMetricsError: ansiFormat("Error", FgRed),
-			wfv1.ConditionTypeSpecWarning:  ansiFormat("Warning", FgYellow),
+			wfv1.ConditionTypeMetricsError:         ansiFormat("Error", FgRed),
+			wfv1.ConditionTypeSpecWarning:          ansiFormat("Warning", FgYellow),
+			wfv1.ConditionTypeAgentUnresponsive:    ansiFormat("Warning", FgYellow),
+			wfv1.ConditionTypeExecutorUnresponsive: ansiFormat("Warning", FgYellow),
 		}
 	} else {
 		jobStatusIconMap = map[wfv1.NodePhase]string{
@@ -75,19 +77,22 @@ func initializeSession() {
 			wfv1.NodeTypeSuspend: ansiFormat("ǁ", FgCyan),
 		}
 		workflowConditionIconMap = map[wfv1.ConditionType]string{
-			wfv1.ConditionTypeMetricsError: ansiFormat("✖", FgRed),
-			wfv1.ConditionTypeSpecWarning:  ansiFormat("⚠", FgYellow),
+			wfv1.ConditionTypeMetricsError:         ansiFormat("✖", FgRed),
+			wfv1.ConditionTypeSpecWarning:          ansiFormat("⚠", FgYellow),
+			wfv1.ConditionTypeAgentUnresponsive:    ansiFormat("⚠", FgYellow),
+			wfv1.ConditionTypeExecutorUnresponsive: ansiFormat("⚠", FgYellow),
 		}
 	}
 }
 
+var ansiColors = []int{FgRed, FgGreen, FgYellow, FgBlue, FgMagenta, FgCyan, FgWhite}
+
 func ansiColorCode(s string) int {
 	i := 0
 	for _, c := range s {
 		i += int(c)
 	}
-	colors := []int{FgRed, FgGreen, FgYellow, FgBlue, FgMagenta, FgCyan, FgWhite}
-	return colors[i%len(colors)]
+	return ansiColors[i%len(ansiColors)]
 }
 
 // ansiFormat wraps ANSI escape codes to a string to format the string to a desired color.
@@ -0,0 +1,70 @@
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// how long listed names are used for, so completing a command again, e.g. after a typo, is fast
+const cacheTTL = 30 * time.Second
+
+// cacheDir returns the directory names are cached in, or an error if there is no cache directory
+var cacheDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "argo", "completion"), nil
+}
+
+type cacheEntry struct {
+	Time  time.Time `json:"time"`
+	Names []string  `json:"names"`
+}
+
+func cacheFile(key string) (string, error) {
+	dir, err := cacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// readCache returns the names cached for the key, and true, if they were cached less than cacheTTL ago
+func readCache(key string) ([]string, bool) {
+	file, err := cacheFile(key)
+	if err != nil {
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || time.Since(entry.Time) > cacheTTL {
+		return nil, false
+	}
+	return entry.Names, true
+}
+
+// writeCache caches the names for the key. The cache is only an optimization, so errors are ignored.
+func writeCache(key string, names []string) {
+	file, err := cacheFile(key)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(cacheEntry{Time: time.Now(), Names: names})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(file, data, 0o600)
+}
@@ -0,0 +1,276 @@
+// Package completion completes the arguments and flags of commands dynamically, with the names of the resources that
+// exist, from the Argo Server or the Kubernetes API.
+package completion
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	clusterworkflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// Func completes an argument or flag, as per cobra.Command.ValidArgsFunction
+type Func = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// how long to wait for the names, so a slow or unreachable server does not hang the shell
+const timeout = 5 * time.Second
+
+// lister returns the names of a kind of resource
+type lister func(ctx context.Context) ([]string, error)
+
+// complete returns the names that start with toComplete, from the cache if they were listed recently. Errors are not
+// returned, as there is nowhere to show them, so no names are completed instead.
+func complete(key string, list lister, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, ok := readCache(key)
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		var err error
+		names, err = list(ctx)
+		if err != nil {
+			log.WithError(err).Debug("Failed to list names to complete")
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		writeCache(key, names)
+	}
+	var completions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// cacheKey returns a key for what is listed, which is unique to where it is listed from
+func cacheKey(parts ...string) string {
+	return strings.Join(append([]string{client.GetArgoServerOpts().URL, client.CurrentContext(), client.Namespace()}, parts...), "/")
+}
+
+// firstArg completes f for the first argument only
+func firstArg(f Func) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return f(cmd, args, toComplete)
+	}
+}
+
+// Workflows completes every argument with the names of the workflows in any of the phases, or all workflows
+func Workflows(phases ...wfv1.WorkflowPhase) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var values []string
+		for _, phase := range phases {
+			values = append(values, string(phase))
+		}
+		return complete(cacheKey("workflows", strings.Join(values, ",")), func(ctx context.Context) ([]string, error) {
+			ctx, apiClient := client.NewAPIClient(ctx)
+			listOpts := &metav1.ListOptions{}
+			if len(values) > 0 {
+				req, err := labels.NewRequirement(common.LabelKeyPhase, selection.In, values)
+				if err != nil {
+					return nil, err
+				}
+				listOpts.LabelSelector = labels.NewSelector().Add(*req).String()
+			}
+			list, err := apiClient.NewWorkflowServiceClient().ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{
+				Namespace:   client.Namespace(),
+				ListOptions: listOpts,
+				Fields:      "items.metadata.name",
+			})
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, wf := range list.Items {
+				names = append(names, wf.Name)
+			}
+			return names, nil
+		}, toComplete)
+	}
+}
+
+// Workflow completes the first argument with the names of the workflows in any of the phases, or all workflows
+func Workflow(phases ...wfv1.WorkflowPhase) Func {
+	return firstArg(Workflows(phases...))
+}
+
+// WorkflowTemplates completes every argument with the names of the workflow templates
+func WorkflowTemplates(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return complete(cacheKey("workflowtemplates"), func(ctx context.Context) ([]string, error) {
+		ctx, apiClient := client.NewAPIClient(ctx)
+		serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		list, err := serviceClient.ListWorkflowTemplates(ctx, &workflowtemplatepkg.WorkflowTemplateListRequest{Namespace: client.Namespace(), ListOptions: &metav1.ListOptions{}})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, tmpl := range list.Items {
+			names = append(names, tmpl.Name)
+		}
+		return names, nil
+	}, toComplete)
+}
+
+// WorkflowTemplate completes the first argument with the names of the workflow templates
+var WorkflowTemplate = firstArg(WorkflowTemplates)
+
+// ClusterWorkflowTemplates completes every argument with the names of the cluster workflow templates
+func ClusterWorkflowTemplates(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return complete(cacheKey("clusterworkflowtemplates"), func(ctx context.Context) ([]string, error) {
+		ctx, apiClient := client.NewAPIClient(ctx)
+		serviceClient, err := apiClient.NewClusterWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		list, err := serviceClient.ListClusterWorkflowTemplates(ctx, &clusterworkflowtemplatepkg.ClusterWorkflowTemplateListRequest{ListOptions: &metav1.ListOptions{}})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, tmpl := range list.Items {
+			names = append(names, tmpl.Name)
+		}
+		return names, nil
+	}, toComplete)
+}
+
+// ClusterWorkflowTemplate completes the first argument with the names of the cluster workflow templates
+var ClusterWorkflowTemplate = firstArg(ClusterWorkflowTemplates)
+
+// CronWorkflows completes every argument with the names of the cron workflows
+func CronWorkflows(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return complete(cacheKey("cronworkflows"), func(ctx context.Context) ([]string, error) {
+		ctx, apiClient := client.NewAPIClient(ctx)
+		serviceClient, err := apiClient.NewCronWorkflowServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		list, err := serviceClient.ListCronWorkflows(ctx, &cronworkflowpkg.ListCronWorkflowsRequest{Namespace: client.Namespace(), ListOptions: &metav1.ListOptions{}})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, cronWf := range list.Items {
+			names = append(names, cronWf.Name)
+		}
+		return names, nil
+	}, toComplete)
+}
+
+// CronWorkflow completes the first argument with the names of the cron workflows
+var CronWorkflow = firstArg(CronWorkflows)
+
+// Namespaces completes with the names of the namespaces, which are listed from the Kubernetes API
+func Namespaces(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return complete(strings.Join([]string{client.CurrentContext(), "namespaces"}, "/"), func(ctx context.Context) ([]string, error) {
+		restConfig, err := client.GetConfig().ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		list, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, ns := range list.Items {
+			names = append(names, ns.Name)
+		}
+		return names, nil
+	}, toComplete)
+}
+
+// getWorkflow gets the workflow, to complete its nodes or pods
+func getWorkflow(ctx context.Context, name string) (*wfv1.Workflow, error) {
+	ctx, apiClient := client.NewAPIClient(ctx)
+	return apiClient.NewWorkflowServiceClient().GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+		Name:      name,
+		Namespace: client.Namespace(),
+		Fields:    "metadata.name,metadata.annotations,status.nodes",
+	})
+}
+
+// NodesOf returns the display names of the nodes of the workflow
+func NodesOf(workflowName, toComplete string) []string {
+	names, _ := complete(cacheKey("nodes", workflowName), func(ctx context.Context) ([]string, error) {
+		wf, err := getWorkflow(ctx, workflowName)
+		if err != nil {
+			return nil, err
+		}
+		set := make(map[string]bool)
+		for _, node := range wf.Status.Nodes {
+			set[node.DisplayName] = true
+		}
+		var names []string
+		for name := range set {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names, nil
+	}, toComplete)
+	return names
+}
+
+// Nodes completes a flag with the display names of the nodes of the workflow that is the first argument
+func Nodes(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return NodesOf(args[0], toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// Pods completes the second argument with the names of the pods of the workflow that is the first argument
+func Pods(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return complete(cacheKey("pods", args[0]), func(ctx context.Context) ([]string, error) {
+		wf, err := getWorkflow(ctx, args[0])
+		if err != nil {
+			return nil, err
+		}
+		version := util.GetWorkflowPodNameVersion(wf)
+		var names []string
+		for _, node := range wf.Status.Nodes {
+			if node.Type != wfv1.NodeTypePod {
+				continue
+			}
+			templateName := node.TemplateName
+			if node.TemplateRef != nil {
+				templateName = node.TemplateRef.Template
+			}
+			names = append(names, util.PodName(wf.Name, node.Name, templateName, node.ID, version))
+		}
+		sort.Strings(names)
+		return names, nil
+	}, toComplete)
+}
+
+// ManifestFiles completes every argument with YAML and JSON files
+func ManifestFiles(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return []string{"yaml", "yml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+}
@@ -0,0 +1,63 @@
+package completion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func withCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	old := cacheDir
+	cacheDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { cacheDir = old })
+}
+
+func Test_complete(t *testing.T) {
+	withCacheDir(t)
+	calls := 0
+	list := func(ctx context.Context) ([]string, error) {
+		calls++
+		_, ok := ctx.Deadline()
+		assert.True(t, ok, "names are listed with a timeout")
+		return []string{"my-wf", "my-other-wf", "other-wf"}, nil
+	}
+	t.Run("Prefix", func(t *testing.T) {
+		names, directive := complete("my-key", list, "my-")
+		assert.Equal(t, []string{"my-wf", "my-other-wf"}, names)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+	t.Run("Cached", func(t *testing.T) {
+		names, _ := complete("my-key", list, "")
+		assert.Len(t, names, 3)
+		assert.Equal(t, 1, calls, "the names are cached")
+		_, _ = complete("other-key", list, "")
+		assert.Equal(t, 2, calls, "the names are cached by key")
+	})
+	t.Run("Error", func(t *testing.T) {
+		names, directive := complete("error-key", func(ctx context.Context) ([]string, error) { return nil, errors.New("unreachable") }, "")
+		assert.Empty(t, names)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+		_, ok := readCache("error-key")
+		assert.False(t, ok, "errors are not cached")
+	})
+}
+
+func Test_firstArg(t *testing.T) {
+	f := firstArg(func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"my-wf"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	names, _ := f(nil, nil, "")
+	assert.Equal(t, []string{"my-wf"}, names)
+	names, _ = f(nil, []string{"my-wf"}, "")
+	assert.Empty(t, names)
+}
+
+func TestManifestFiles(t *testing.T) {
+	exts, directive := ManifestFiles(nil, nil, "")
+	assert.Equal(t, []string{"yaml", "yml", "json"}, exts)
+	assert.Equal(t, cobra.ShellCompDirectiveFilterFileExt, directive)
+}
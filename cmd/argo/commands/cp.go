@@ -0,0 +1,245 @@
+package commands
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type cpOpts struct {
+	artifactName string // --artifact-name
+	list         bool   // --list
+	resume       bool   // --resume
+}
+
+func NewCpCommand() *cobra.Command {
+	var opts cpOpts
+	command := &cobra.Command{
+		Use:   "cp WORKFLOW[:NODE] DIRECTORY",
+		Short: "copy the output artifacts of a workflow to a local directory",
+		Long: `Copy the output artifacts of a workflow to a local directory, via the Argo Server, so you do not need credentials for the artifact repository.
+
+NODE is the ID, name or display name of a node, or a glob pattern matching one of them. If it is not specified, the artifacts of every node are copied. Each artifact is copied to DIRECTORY/NODE_ID/FILE, where FILE is the last part of the artifact's key, as stored, e.g. compressed.`,
+		Example: `# List the output artifacts of a workflow:
+
+  argo cp my-wf --list
+
+# Copy the output artifacts of a workflow:
+
+  argo cp my-wf ./results
+
+# Copy the output artifacts of a step, whose names start with "report":
+
+  argo cp my-wf:my-step ./results --artifact-name 'report*'
+
+# Carry on copying, after a copy was interrupted:
+
+  argo cp my-wf ./results --resume
+`,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch len(args) {
+			case 0:
+				// complete the nodes, once the workflow has been completed
+				if parts := strings.SplitN(toComplete, ":", 2); len(parts) == 2 {
+					var completions []string
+					for _, name := range completion.NodesOf(parts[0], parts[1]) {
+						completions = append(completions, parts[0]+":"+name)
+					}
+					return completions, cobra.ShellCompDirectiveNoFileComp
+				}
+				return completion.Workflows()(cmd, args, toComplete)
+			case 1:
+				return nil, cobra.ShellCompDirectiveFilterDirs
+			default:
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 && !(len(args) == 1 && opts.list) {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			parts := strings.SplitN(args[0], ":", 2)
+			workflowName, nodePattern := parts[0], "*"
+			if len(parts) == 2 {
+				nodePattern = parts[1]
+			}
+			if _, err := path.Match(nodePattern, ""); err != nil {
+				log.Fatalf("node pattern %q is not valid: %v", nodePattern, err)
+			}
+			if _, err := path.Match(opts.artifactName, ""); err != nil {
+				log.Fatalf("artifact name pattern %q is not valid: %v", opts.artifactName, err)
+			}
+			argoServerOpts := client.GetArgoServerOpts()
+			if argoServerOpts.URL == "" && !opts.list {
+				log.Fatal("argo cp copies artifacts via the Argo Server, set --argo-server or ARGO_SERVER")
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+				Name:      workflowName,
+				Namespace: client.Namespace(),
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+			artifacts := selectArtifacts(wf, nodePattern, opts.artifactName)
+			if opts.list {
+				printArtifacts(os.Stdout, artifacts)
+				return
+			}
+			if len(artifacts) == 0 {
+				log.Fatal("no artifacts found")
+			}
+			httpClient := &http.Client{Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: argoServerOpts.InsecureSkipVerify},
+			}}
+			authString := client.GetAuthString()
+			for _, a := range artifacts {
+				u := argoServerOpts.GetURL() + "/artifacts/" + strings.Join([]string{
+					url.PathEscape(wf.Namespace), url.PathEscape(wf.Name), url.PathEscape(a.nodeID), url.PathEscape(a.artifact.Name),
+				}, "/")
+				dest := filepath.Join(args[1], a.nodeID, a.fileName())
+				if err := downloadArtifact(ctx, httpClient, u, authString, dest, opts.resume); err != nil {
+					log.Fatalf("Failed to copy artifact %s of node %s: %v", a.artifact.Name, a.nodeID, err)
+				}
+				fmt.Println(dest)
+			}
+		},
+	}
+	command.Flags().StringVar(&opts.artifactName, "artifact-name", "*", "name, or glob pattern, of the artifacts to copy")
+	command.Flags().BoolVar(&opts.list, "list", false, "list the artifacts, rather than copying them")
+	command.Flags().BoolVar(&opts.resume, "resume", false, "carry on copying artifacts that were partly copied, rather than copying them again")
+	return command
+}
+
+type nodeArtifact struct {
+	nodeID      string
+	displayName string
+	artifact    wfv1.Artifact
+}
+
+// fileName returns the name to save the artifact as, which is the last part of its key, as per the Argo Server
+func (a nodeArtifact) fileName() string {
+	if key, err := a.artifact.GetKey(); err == nil && path.Base(key) != "." && path.Base(key) != "/" {
+		return path.Base(key)
+	}
+	return a.artifact.Name
+}
+
+// selectArtifacts returns the output artifacts of the nodes that match the node pattern, which match the artifact name
+// pattern, ordered by node ID, then artifact name
+func selectArtifacts(wf *wfv1.Workflow, nodePattern, artifactNamePattern string) []nodeArtifact {
+	var artifacts []nodeArtifact
+	for _, node := range wf.Status.Nodes {
+		if node.Outputs == nil || !nodeMatches(nodePattern, node) {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if ok, _ := path.Match(artifactNamePattern, art.Name); ok && art.HasLocationOrKey() {
+				artifacts = append(artifacts, nodeArtifact{nodeID: node.ID, displayName: node.DisplayName, artifact: art})
+			}
+		}
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		if artifacts[i].nodeID != artifacts[j].nodeID {
+			return artifacts[i].nodeID < artifacts[j].nodeID
+		}
+		return artifacts[i].artifact.Name < artifacts[j].artifact.Name
+	})
+	return artifacts
+}
+
+// nodeMatches returns true if the pattern is the ID, name or display name of the node, or a glob pattern matching one
+// of them
+func nodeMatches(pattern string, node wfv1.NodeStatus) bool {
+	for _, s := range []string{node.ID, node.Name, node.DisplayName} {
+		// node names often contain brackets, e.g. "my-wf[0].step", which are not literal in a pattern
+		if pattern == s {
+			return true
+		}
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func printArtifacts(out io.Writer, artifacts []nodeArtifact) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NODE ID\tNODE\tARTIFACT\tFILE")
+	for _, a := range artifacts {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", a.nodeID, a.displayName, a.artifact.Name, a.fileName())
+	}
+	_ = w.Flush()
+}
+
+// downloadArtifact downloads the artifact at the URL to the file. If resume is true, and the file exists, only the rest of
+// the artifact is downloaded, and appended to the file.
+func downloadArtifact(ctx context.Context, httpClient *http.Client, u, authString, dest string, resume bool) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authString)
+	var offset int64
+	if resume {
+		if stat, err := os.Stat(dest); err == nil && stat.Size() > 0 {
+			offset = stat.Size()
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+	}
+	log.Debugf("curl -H 'Authorization: ******' '%s'", u)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	flags := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusOK:
+		flags |= os.O_TRUNC
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusRequestedRangeNotSatisfiable:
+		if offset > 0 {
+			// the file was already completely downloaded
+			return nil
+		}
+		fallthrough
+	default:
+		data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	f, err := os.OpenFile(filepath.Clean(dest), flags, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_selectArtifacts(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+status:
+  nodes:
+    my-wf-1:
+      id: my-wf-1
+      name: my-wf[0].a
+      displayName: a
+      outputs:
+        artifacts:
+          - name: report
+            s3:
+              key: my-wf/my-wf-1/report.tgz
+          - name: main-logs
+            s3:
+              key: my-wf/my-wf-1/main.log
+          - name: no-location
+    my-wf-2:
+      id: my-wf-2
+      name: my-wf[0].b
+      displayName: b
+      outputs:
+        artifacts:
+          - name: report
+            s3:
+              key: my-wf/my-wf-2/report.tgz
+`)
+	names := func(artifacts []nodeArtifact) []string {
+		var names []string
+		for _, a := range artifacts {
+			names = append(names, a.nodeID+"/"+a.fileName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"my-wf-1/main.log", "my-wf-1/report.tgz", "my-wf-2/report.tgz"}, names(selectArtifacts(wf, "*", "*")))
+	assert.Equal(t, []string{"my-wf-1/report.tgz", "my-wf-2/report.tgz"}, names(selectArtifacts(wf, "*", "rep*")))
+	assert.Equal(t, []string{"my-wf-2/report.tgz"}, names(selectArtifacts(wf, "b", "*")))
+	assert.Equal(t, []string{"my-wf-1/main.log", "my-wf-1/report.tgz"}, names(selectArtifacts(wf, "my-wf[0].a", "*")))
+	assert.Empty(t, selectArtifacts(wf, "c", "*"))
+
+	out := &bytes.Buffer{}
+	printArtifacts(out, selectArtifacts(wf, "b", "*"))
+	assert.Equal(t, `NODE ID  NODE  ARTIFACT  FILE
+my-wf-2  b     report    report.tgz
+`, out.String())
+}
+
+func Test_downloadArtifact(t *testing.T) {
+	content := "my-artifact-content"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("not authorized\n"))
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(content))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "cp")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	dest := filepath.Join(dir, "my-node", "my-file")
+	read := func() string {
+		data, _ := ioutil.ReadFile(dest)
+		return string(data)
+	}
+
+	t.Run("Download", func(t *testing.T) {
+		if assert.NoError(t, downloadArtifact(ctx, server.Client(), server.URL, "Bearer my-token", dest, false)) {
+			assert.Equal(t, content, read())
+		}
+	})
+	t.Run("Overwrite", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(dest, []byte("other-content-that-is-longer"), 0o600))
+		if assert.NoError(t, downloadArtifact(ctx, server.Client(), server.URL, "Bearer my-token", dest, false)) {
+			assert.Equal(t, content, read())
+		}
+	})
+	t.Run("Resume", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(dest, []byte(content[:5]), 0o600))
+		if assert.NoError(t, downloadArtifact(ctx, server.Client(), server.URL, "Bearer my-token", dest, true)) {
+			assert.Equal(t, content, read())
+		}
+	})
+	t.Run("ResumeComplete", func(t *testing.T) {
+		if assert.NoError(t, downloadArtifact(ctx, server.Client(), server.URL, "Bearer my-token", dest, true)) {
+			assert.Equal(t, content, read())
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		err := downloadArtifact(ctx, server.Client(), server.URL, "", dest, false)
+		assert.EqualError(t, err, "401 Unauthorized: not authorized")
+	})
+}
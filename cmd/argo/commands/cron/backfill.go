@@ -0,0 +1,190 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	cronutil "github.com/argoproj/argo-workflows/v3/util/cron"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// scheduledTimePlaceholder is replaced, in the values of parameters, with the time a backfilled workflow was scheduled for
+const scheduledTimePlaceholder = "{{scheduledTime}}"
+
+// how often to check if backfilled workflows have completed, when --parallelism is set
+const backfillPollInterval = 5 * time.Second
+
+type cliBackfillOpts struct {
+	start       string   // --start
+	end         string   // --end
+	parallelism int      // --parallelism
+	parameters  []string // --parameter
+	timeFormat  string   // --scheduled-time-format
+	dryRun      bool     // --dry-run
+}
+
+func NewBackfillCommand() *cobra.Command {
+	var opts cliBackfillOpts
+	command := &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW --start START --end END",
+		Short: "create the workflows a cron workflow would have run between two times",
+		Long: `Create the workflows a cron workflow would have run between two times, e.g. to re-process after an outage.
+
+One workflow is created for each time the schedule matches, from START to END inclusive, oldest first, in the cron workflow's timezone. Each is named, labelled and annotated as if the controller had created it. So a workflow that was already run for a time is not created again, and backfilled workflows count towards the cron workflow's history limits.
+
+In the values of parameters, "{{scheduledTime}}" is replaced with the time the workflow was scheduled for.`,
+		Example: `# Create the workflows a cron workflow would have run yesterday:
+
+  argo cron backfill my-cwf --start 2021-10-01T00:00:00Z --end 2021-10-01T23:59:59Z
+
+# Print the workflows that would be created, without creating them:
+
+  argo cron backfill my-cwf --start 2021-10-01T00:00:00Z --end 2021-10-01T23:59:59Z --dry-run
+
+# Run the workflows one at a time, passing them the date they were scheduled for:
+
+  argo cron backfill my-cwf --start 2021-10-01T00:00:00Z --end 2021-10-07T00:00:00Z --parallelism 1 \
+    -p date={{scheduledTime}} --scheduled-time-format 2006-01-02
+`,
+		ValidArgsFunction: completion.CronWorkflow,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 || opts.start == "" || opts.end == "" {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			start, err := time.Parse(time.RFC3339, opts.start)
+			if err != nil {
+				log.Fatalf("--start must be an RFC3339 time: %v", err)
+			}
+			end, err := time.Parse(time.RFC3339, opts.end)
+			if err != nil {
+				log.Fatalf("--end must be an RFC3339 time: %v", err)
+			}
+			if end.Before(start) {
+				log.Fatal("--end must not be before --start")
+			}
+			if opts.parallelism < 0 {
+				log.Fatal("--parallelism must not be negative")
+			}
+			backfillCronWorkflow(cmd.Context(), args[0], start, end, &opts)
+		},
+	}
+	command.Flags().StringVar(&opts.start, "start", "", "the time to backfill from, inclusive (RFC3339)")
+	command.Flags().StringVar(&opts.end, "end", "", "the time to backfill to, inclusive (RFC3339)")
+	command.Flags().IntVar(&opts.parallelism, "parallelism", 0, "the most backfilled workflows to run at once, 1 runs them one after another, 0 creates them all at once")
+	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "pass an input parameter, "+scheduledTimePlaceholder+" in its value is replaced with the scheduled time")
+	command.Flags().StringVar(&opts.timeFormat, "scheduled-time-format", time.RFC3339, "the Go time layout "+scheduledTimePlaceholder+" is formatted with")
+	command.Flags().BoolVar(&opts.dryRun, "dry-run", false, "print the workflows that would be created, without creating them")
+	return command
+}
+
+func backfillCronWorkflow(ctx context.Context, name string, start, end time.Time, opts *cliBackfillOpts) {
+	ctx, apiClient := client.NewAPIClient(ctx)
+	cronClient, err := apiClient.NewCronWorkflowServiceClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	serviceClient := apiClient.NewWorkflowServiceClient()
+	namespace := client.Namespace()
+
+	cronWf, err := cronClient.GetCronWorkflow(ctx, &cronworkflowpkg.GetCronWorkflowRequest{Name: name, Namespace: namespace})
+	if err != nil {
+		log.Fatal(err)
+	}
+	times, err := backfillTimes(cronWf, start, end)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(times) == 0 {
+		fmt.Println("The schedule does not match any time between --start and --end")
+		return
+	}
+
+	var running []string
+	for _, scheduledTime := range times {
+		wf, err := backfillWorkflow(cronWf, scheduledTime, opts.parameters, opts.timeFormat)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if opts.dryRun {
+			fmt.Printf("Workflow '%s' would be created for %s\n", wf.Name, scheduledTime.Format(time.RFC3339))
+			continue
+		}
+		for opts.parallelism > 0 && len(running) >= opts.parallelism {
+			time.Sleep(backfillPollInterval)
+			running = stillRunning(ctx, serviceClient, namespace, running)
+		}
+		created, err := serviceClient.CreateWorkflow(ctx, &workflowpkg.WorkflowCreateRequest{Namespace: namespace, Workflow: wf})
+		if status.Code(err) == codes.AlreadyExists {
+			fmt.Printf("Workflow '%s' for %s already exists\n", wf.Name, scheduledTime.Format(time.RFC3339))
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Failed to create workflow for %s: %v", scheduledTime.Format(time.RFC3339), err)
+		}
+		fmt.Printf("Workflow '%s' created for %s\n", created.Name, scheduledTime.Format(time.RFC3339))
+		running = append(running, created.Name)
+	}
+}
+
+// stillRunning returns the workflows that have not completed
+func stillRunning(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, names []string) []string {
+	var running []string
+	for _, name := range names {
+		wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: namespace})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !wf.Status.Fulfilled() {
+			running = append(running, name)
+		}
+	}
+	return running
+}
+
+// backfillTimes returns the times any of the cron workflow's schedules match, from start to end inclusive, oldest first
+func backfillTimes(cronWf *wfv1.CronWorkflow, start, end time.Time) ([]time.Time, error) {
+	schedule, err := cronutil.ParseCronWorkflowSchedule(cronWf)
+	if err != nil {
+		return nil, err
+	}
+	var times []time.Time
+	// schedules are to the second, and Next returns the time after the one given
+	for t := schedule.Next(start.Add(-time.Second)); !t.IsZero() && !t.After(end); t = schedule.Next(t) {
+		if !t.Before(start) {
+			times = append(times, t)
+		}
+	}
+	return times, nil
+}
+
+// backfillWorkflow returns the workflow the controller would have created for the scheduled time, with the parameters
+func backfillWorkflow(cronWf *wfv1.CronWorkflow, scheduledTime time.Time, parameters []string, timeFormat string) (*wfv1.Workflow, error) {
+	// this is the name the controller gives the workflow
+	wf := common.ConvertCronWorkflowToWorkflowWithProperties(cronWf, fmt.Sprintf("%s-%d", cronWf.Name, scheduledTime.Unix()), scheduledTime)
+	var params []string
+	for _, p := range parameters {
+		params = append(params, strings.ReplaceAll(p, scheduledTimePlaceholder, scheduledTime.Format(timeFormat)))
+	}
+	if err := util.ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: params}); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func mustParse(t *testing.T, s string) time.Time {
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func Test_backfillTimes(t *testing.T) {
+	cronWf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedule: "0 */6 * * *", Timezone: "UTC"}}
+	t.Run("Inclusive", func(t *testing.T) {
+		times, err := backfillTimes(cronWf, mustParse(t, "2021-10-01T00:00:00Z"), mustParse(t, "2021-10-01T12:00:00Z"))
+		if assert.NoError(t, err) && assert.Len(t, times, 3) {
+			assert.Equal(t, mustParse(t, "2021-10-01T00:00:00Z").Unix(), times[0].Unix())
+			assert.Equal(t, mustParse(t, "2021-10-01T06:00:00Z").Unix(), times[1].Unix())
+			assert.Equal(t, mustParse(t, "2021-10-01T12:00:00Z").Unix(), times[2].Unix())
+		}
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		times, err := backfillTimes(cronWf, mustParse(t, "2021-10-01T01:00:00Z"), mustParse(t, "2021-10-01T05:00:00Z"))
+		assert.NoError(t, err)
+		assert.Empty(t, times)
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		cronWf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedule: "0 9 * * *", Timezone: "Asia/Tokyo"}}
+		times, err := backfillTimes(cronWf, mustParse(t, "2021-10-01T00:00:00Z"), mustParse(t, "2021-10-01T23:00:00Z"))
+		if assert.NoError(t, err) && assert.Len(t, times, 1) {
+			assert.Equal(t, mustParse(t, "2021-10-01T00:00:00Z").Unix(), times[0].Unix())
+		}
+	})
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		_, err := backfillTimes(&wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedule: "foo"}}, time.Now(), time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func Test_backfillWorkflow(t *testing.T) {
+	cronWf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf", Namespace: "argo"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule: "0 0 * * *",
+			WorkflowSpec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "date", Value: wfv1.AnyStringPtr("today")}}},
+			},
+		},
+	}
+	scheduledTime := mustParse(t, "2021-10-01T00:00:00Z")
+	wf, err := backfillWorkflow(cronWf, scheduledTime, []string{"date={{scheduledTime}}"}, "2006-01-02")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-cwf-1633046400", wf.Name)
+		assert.Equal(t, "my-cwf", wf.Labels[common.LabelKeyCronWorkflow])
+		assert.Equal(t, "2021-10-01T00:00:00Z", wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+		assert.Equal(t, "2021-10-01", wf.Spec.Arguments.GetParameterByName("date").Value.String())
+	}
+	_, err = backfillWorkflow(cronWf, scheduledTime, []string{"date"}, time.RFC3339)
+	assert.Error(t, err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -28,8 +29,9 @@ func NewCreateCommand() *cobra.Command {
 		submitOpts    wfv1.SubmitOpts
 	)
 	command := &cobra.Command{
-		Use:   "create FILE1 FILE2...",
-		Short: "create a cron workflow",
+		Use:               "create FILE1 FILE2...",
+		Short:             "create a cron workflow",
+		ValidArgsFunction: completion.ManifestFiles,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -43,7 +45,7 @@ func NewCreateCommand() *cobra.Command {
 	util.PopulateSubmitOpts(command, &submitOpts, false)
 	command.Flags().StringVarP(&cliCreateOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
 	command.Flags().BoolVar(&cliCreateOpts.strict, "strict", true, "perform strict workflow validation")
-	command.Flags().StringVar(&cliCreateOpts.schedule, "schedule", "", "override cron workflow schedule")
+	command.Flags().StringVar(&cliCreateOpts.schedule, "schedule", "", "override cron workflow schedule, or schedules")
 	return command
 }
 
@@ -74,6 +76,7 @@ func CreateCronWorkflows(ctx context.Context, filePaths []string, cliOpts *cliCr
 
 		if cliOpts.schedule != "" {
 			cronWf.Spec.Schedule = cliOpts.schedule
+			cronWf.Spec.Schedules = nil
 		}
 
 		newWf := wfv1.Workflow{Spec: cronWf.Spec.WorkflowSpec}
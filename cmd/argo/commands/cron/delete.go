@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 )
 
@@ -13,8 +14,9 @@ func NewDeleteCommand() *cobra.Command {
 	var all bool
 
 	command := &cobra.Command{
-		Use:   "delete [CRON_WORKFLOW... | --all]",
-		Short: "delete a cron workflow",
+		Use:               "delete [CRON_WORKFLOW... | --all]",
+		Short:             "delete a cron workflow",
+		ValidArgsFunction: completion.CronWorkflows,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient, err := apiClient.NewCronWorkflowServiceClient()
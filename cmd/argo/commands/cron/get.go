@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -21,8 +22,9 @@ func NewGetCommand() *cobra.Command {
 	var output string
 
 	command := &cobra.Command{
-		Use:   "get CRON_WORKFLOW...",
-		Short: "display details about a cron workflow",
+		Use:               "get CRON_WORKFLOW...",
+		Short:             "display details about a cron workflow",
+		ValidArgsFunction: completion.CronWorkflows,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -73,23 +75,58 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	out += fmt.Sprintf(fmtStr, "Name:", cwf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", cwf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(cwf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	out += fmt.Sprintf(fmtStr, "Schedule:", strings.Join(cwf.Spec.GetSchedules(), "; "))
 	out += fmt.Sprintf(fmtStr, "Suspended:", cwf.Spec.Suspend)
 	if cwf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", cwf.Spec.Timezone)
 	}
+	if cwf.Spec.DaylightSavingPolicy != "" {
+		out += fmt.Sprintf(fmtStr, "DaylightSavingPolicy:", cwf.Spec.DaylightSavingPolicy)
+	}
 	if cwf.Spec.StartingDeadlineSeconds != nil {
 		out += fmt.Sprintf(fmtStr, "StartingDeadlineSeconds:", *cwf.Spec.StartingDeadlineSeconds)
 	}
 	if cwf.Spec.ConcurrencyPolicy != "" {
 		out += fmt.Sprintf(fmtStr, "ConcurrencyPolicy:", cwf.Spec.ConcurrencyPolicy)
 	}
+	if cwf.Spec.Splay != "" {
+		out += fmt.Sprintf(fmtStr, "Splay:", cwf.Spec.Splay)
+	}
+	if cwf.Spec.PreviousRunPolicy != "" {
+		out += fmt.Sprintf(fmtStr, "PreviousRunPolicy:", cwf.Spec.PreviousRunPolicy)
+	}
+	if cwf.Spec.When != "" {
+		out += fmt.Sprintf(fmtStr, "When:", cwf.Spec.When)
+	}
+	if stopStrategy := cwf.Spec.StopStrategy; stopStrategy != nil && stopStrategy.SuspendAfterFailures != nil {
+		out += fmt.Sprintf(fmtStr, "SuspendAfterFailures:", *stopStrategy.SuspendAfterFailures)
+	}
+	if stopStrategy := cwf.Spec.StopStrategy; stopStrategy != nil && stopStrategy.Expression != "" {
+		out += fmt.Sprintf(fmtStr, "StopExpression:", stopStrategy.Expression)
+	}
+	if catchup := cwf.Spec.Catchup; catchup.GetPolicy() == wfv1.CatchupAll {
+		out += fmt.Sprintf(fmtStr, "Catchup:", fmt.Sprintf("%s (limit %d)", catchup.GetPolicy(), catchup.GetLimit()))
+	} else if catchup != nil {
+		out += fmt.Sprintf(fmtStr, "Catchup:", catchup.GetPolicy())
+	}
 	if cwf.Status.LastScheduledTime != nil {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(cwf.Status.LastScheduledTime.Time))
 	}
 
-	next, err := GetNextRuntime(cwf)
-	if err == nil {
+	if cwf.Status.DelayedScheduledTime != nil {
+		out += fmt.Sprintf(fmtStr, "DelayedScheduledTime:", humanize.Timestamp(cwf.Status.DelayedScheduledTime.Time))
+	}
+	if cwf.Status.Succeeded > 0 || cwf.Status.Failed > 0 {
+		out += fmt.Sprintf(fmtStr, "Completed Workflows:", fmt.Sprintf("%d succeeded, %d failed (%d consecutively)", cwf.Status.Succeeded, cwf.Status.Failed, cwf.Status.ConsecutiveFailures))
+	}
+	if len(cwf.Status.NextScheduledTimes) > 0 {
+		// the workflow-controller computed these, so they are correct, whatever its timezone
+		var nextTimes []string
+		for _, next := range cwf.Status.NextScheduledTimes {
+			nextTimes = append(nextTimes, humanize.Timestamp(next.Time))
+		}
+		out += fmt.Sprintf(fmtStr, "NextScheduledTimes:", strings.Join(nextTimes, ", "))
+	} else if next, err := GetNextRuntime(cwf); err == nil {
 		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
 	}
 
@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/pkg/humanize"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -67,3 +69,58 @@ func TestNextRuntime(t *testing.T) {
 		assert.Greater(t, next.Unix(), time.Now().Unix())
 	}
 }
+
+func TestPrintCronWorkflowCatchup(t *testing.T) {
+	var cronWf = v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	assert.NotContains(t, getCronWorkflowGet(cronWf), "Catchup:")
+
+	cronWf.Spec.Catchup = &v1alpha1.Catchup{}
+	assert.Contains(t, getCronWorkflowGet(cronWf), "Catchup:                       Latest\n")
+
+	cronWf.Spec.Catchup.Policy = v1alpha1.CatchupAll
+	assert.Contains(t, getCronWorkflowGet(cronWf), "Catchup:                       All (limit 10)\n")
+}
+
+func TestPrintCronWorkflowNextScheduledTimes(t *testing.T) {
+	var cronWf = v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	assert.Contains(t, getCronWorkflowGet(cronWf), "NextScheduledTime:")
+
+	cronWf.Spec.DaylightSavingPolicy = v1alpha1.DaylightSavingSkip
+	next := time.Now().Add(time.Hour)
+	cronWf.Status.NextScheduledTimes = []metav1.Time{metav1.NewTime(next), metav1.NewTime(next.Add(time.Hour))}
+	out := getCronWorkflowGet(cronWf)
+	assert.Contains(t, out, "DaylightSavingPolicy:          Skip\n")
+	assert.Contains(t, out, "NextScheduledTimes:            "+humanize.Timestamp(next)+", "+humanize.Timestamp(next.Add(time.Hour))+"\n")
+	assert.NotContains(t, out, "NextScheduledTime:")
+}
+
+func TestPrintCronWorkflowStopStrategy(t *testing.T) {
+	var cronWf = v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	out := getCronWorkflowGet(cronWf)
+	assert.NotContains(t, out, "SuspendAfterFailures:")
+	assert.NotContains(t, out, "Completed Workflows:")
+
+	failures := int32(5)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{SuspendAfterFailures: &failures, Expression: "failed > 10"}
+	cronWf.Status.Succeeded = 3
+	cronWf.Status.Failed = 4
+	cronWf.Status.ConsecutiveFailures = 2
+	out = getCronWorkflowGet(cronWf)
+	assert.Contains(t, out, "SuspendAfterFailures:          5\n")
+	assert.Contains(t, out, "StopExpression:                failed > 10\n")
+	assert.Contains(t, out, "Completed Workflows:           3 succeeded, 4 failed (2 consecutively)\n")
+}
+
+func TestPrintCronWorkflowPreviousRunPolicy(t *testing.T) {
+	var cronWf = v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	assert.NotContains(t, getCronWorkflowGet(cronWf), "PreviousRunPolicy:")
+
+	cronWf.Spec.PreviousRunPolicy = v1alpha1.PreviousRunDelay
+	cronWf.Spec.When = "weekday != 'Sunday'"
+	delayed := time.Now().Add(-time.Hour)
+	cronWf.Status.DelayedScheduledTime = &metav1.Time{Time: delayed}
+	out := getCronWorkflowGet(cronWf)
+	assert.Contains(t, out, "PreviousRunPolicy:             Delay\n")
+	assert.Contains(t, out, "When:                          weekday != 'Sunday'\n")
+	assert.Contains(t, out, "DelayedScheduledTime:          "+humanize.Timestamp(delayed)+"\n")
+}
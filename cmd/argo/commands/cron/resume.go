@@ -7,14 +7,16 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 )
 
 // NewSuspendCommand returns a new instance of an `argo suspend` command
 func NewResumeCommand() *cobra.Command {
 	command := &cobra.Command{
-		Use:   "resume [CRON_WORKFLOW...]",
-		Short: "resume zero or more cron workflows",
+		Use:               "resume [CRON_WORKFLOW...]",
+		Short:             "resume zero or more cron workflows",
+		ValidArgsFunction: completion.CronWorkflows,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient, err := apiClient.NewCronWorkflowServiceClient()
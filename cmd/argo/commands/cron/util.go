@@ -3,15 +3,14 @@ package cron
 import (
 	"time"
 
-	"github.com/robfig/cron/v3"
-
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	cronutil "github.com/argoproj/argo-workflows/v3/util/cron"
 )
 
 // GetNextRuntime returns the next time the workflow should run in local time. It assumes the workflow-controller is in
 // UTC, but nevertheless returns the time in the local timezone.
 func GetNextRuntime(cwf *v1alpha1.CronWorkflow) (time.Time, error) {
-	cronSchedule, err := cron.ParseStandard(cwf.Spec.GetScheduleString())
+	cronSchedule, err := cronutil.ParseCronWorkflowSchedule(cwf)
 	if err != nil {
 		return time.Time{}, err
 	}
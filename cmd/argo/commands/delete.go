@@ -1,18 +1,26 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
+	argotime "github.com/argoproj/pkg/time"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 // NewDeleteCommand returns a new instance of an `argo delete` command
@@ -22,10 +30,14 @@ func NewDeleteCommand() *cobra.Command {
 		all           bool
 		allNamespaces bool
 		dryRun        bool
+		bulk          bool
 	)
 	command := &cobra.Command{
-		Use:   "delete [--dry-run] [WORKFLOW...|[--all] [--older] [--completed] [--resubmitted] [--prefix PREFIX] [--selector SELECTOR]]",
+		Use:   "delete [--dry-run] [WORKFLOW...|[--all] [--older-than DURATION] [--status STATUS] [--completed] [--resubmitted] [--prefix PREFIX] [--selector SELECTOR]]",
 		Short: "delete workflows",
+		Long: `Delete workflows, either by name, or those selected by the flags.
+
+The server selects the workflows by --older-than, --status, --completed, --resubmitted, --prefix, --selector and --field-selector, so only the workflows to delete are listed, and with --bulk they are all deleted in a single request.`,
 		Example: `# Delete a workflow:
 
   argo delete my-wf
@@ -33,15 +45,38 @@ func NewDeleteCommand() *cobra.Command {
 # Delete the latest workflow:
 
   argo delete @latest
+
+# Delete all completed workflows with a label in a single request to the server:
+
+  argo delete --bulk --completed -l workflows.argoproj.io/test=true
+
+# Print the workflows that would be deleted, without deleting them:
+
+  argo delete --dry-run --completed -l workflows.argoproj.io/test=true
+
+# Delete the failed and errored workflows of a team that finished more than 3 days ago:
+
+  argo delete --older-than 72h --status Failed,Error -l team=my-team
 `,
+		ValidArgsFunction: completion.Workflows(),
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) == 0 && !(all || allNamespaces || flags.completed || flags.resubmitted || flags.prefix != "" || flags.labels != "" || flags.fields != "" || flags.finishedAfter != "") {
+			selected := all || flags.completed || flags.resubmitted || flags.prefix != "" || flags.labels != "" || flags.fields != "" || flags.finishedAfter != "" || len(flags.status) > 0
+			if len(args) == 0 && !(allNamespaces || selected) {
 				cmd.HelpFunc()(cmd, args)
 				os.Exit(1)
 			}
+			errors.CheckError(checkWorkflowPhases(flags.status))
+			errors.CheckError(selectOlderThan(&flags, time.Now()))
 
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient := apiClient.NewWorkflowServiceClient()
+			if bulk {
+				errors.CheckError(checkBulkDeleteFlags(flags, all, allNamespaces))
+				if !dryRun {
+					errors.CheckError(bulkDeleteWorkflows(ctx, serviceClient, flags, args))
+					return
+				}
+			}
 			var workflows wfv1.Workflows
 			if !allNamespaces {
 				flags.namespace = client.Namespace()
@@ -51,7 +86,7 @@ func NewDeleteCommand() *cobra.Command {
 					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: flags.namespace},
 				})
 			}
-			if all || flags.completed || flags.resubmitted || flags.prefix != "" || flags.labels != "" || flags.fields != "" || flags.finishedAfter != "" {
+			if selected {
 				listed, err := listWorkflows(ctx, serviceClient, flags)
 				errors.CheckError(err)
 				workflows = append(workflows, listed...)
@@ -62,18 +97,19 @@ func NewDeleteCommand() *cobra.Command {
 				return
 			}
 
+			if dryRun {
+				errors.CheckError(dryRunWorkflows(ctx, serviceClient, os.Stdout, workflows, "deleted"))
+				return
+			}
+
 			for _, wf := range workflows {
-				if !dryRun {
-					_, err := serviceClient.DeleteWorkflow(ctx, &workflowpkg.WorkflowDeleteRequest{Name: wf.Name, Namespace: wf.Namespace})
-					if err != nil && status.Code(err) == codes.NotFound {
-						fmt.Printf("Workflow '%s' not found\n", wf.Name)
-						continue
-					}
-					errors.CheckError(err)
-					fmt.Printf("Workflow '%s' deleted\n", wf.Name)
-				} else {
-					fmt.Printf("Workflow '%s' deleted (dry-run)\n", wf.Name)
+				_, err := serviceClient.DeleteWorkflow(ctx, &workflowpkg.WorkflowDeleteRequest{Name: wf.Name, Namespace: wf.Namespace})
+				if err != nil && status.Code(err) == codes.NotFound {
+					fmt.Printf("Workflow '%s' not found\n", wf.Name)
+					continue
 				}
+				errors.CheckError(err)
+				fmt.Printf("Workflow '%s' deleted\n", wf.Name)
 			}
 		},
 	}
@@ -83,9 +119,77 @@ func NewDeleteCommand() *cobra.Command {
 	command.Flags().BoolVar(&flags.completed, "completed", false, "Delete completed workflows")
 	command.Flags().BoolVar(&flags.resubmitted, "resubmitted", false, "Delete resubmitted workflows")
 	command.Flags().StringVar(&flags.prefix, "prefix", "", "Delete workflows by prefix")
+	command.Flags().StringVar(&flags.finishedAfter, "older-than", "", "Delete completed workflows finished before the specified duration (e.g. 10m, 3h, 1d)")
 	command.Flags().StringVar(&flags.finishedAfter, "older", "", "Delete completed workflows finished before the specified duration (e.g. 10m, 3h, 1d)")
+	_ = command.Flags().MarkDeprecated("older", "use --older-than instead")
+	command.Flags().StringSliceVar(&flags.status, "status", []string{}, "Delete workflows with any of these statuses (comma separated), e.g. Failed,Error")
 	command.Flags().StringVarP(&flags.labels, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&flags.fields, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selectorkey1=value1,key2=value2). The server only supports a limited number of field queries per type.")
-	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the workflow, only print what would happen")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the workflows, only print those that would be deleted")
+	command.Flags().BoolVar(&bulk, "bulk", false, bulkUsage)
 	return command
 }
+
+// checkBulkDeleteFlags returns an error if flags are given that the server cannot delete workflows in bulk by
+func checkBulkDeleteFlags(flags listFlags, all, allNamespaces bool) error {
+	if all || allNamespaces {
+		return fmt.Errorf("--all and --all-namespaces cannot be used with --bulk")
+	}
+	return nil
+}
+
+// checkWorkflowPhases returns an error if any of the phases is not a workflow phase, as it would select no workflows
+func checkWorkflowPhases(phases []string) error {
+	for _, phase := range phases {
+		switch wfv1.WorkflowPhase(phase) {
+		case wfv1.WorkflowPending, wfv1.WorkflowRunning, wfv1.WorkflowSucceeded, wfv1.WorkflowFailed, wfv1.WorkflowError:
+		default:
+			return fmt.Errorf("unknown status %q, must be one of: Pending, Running, Succeeded, Failed, Error", phase)
+		}
+	}
+	return nil
+}
+
+// selectOlderThan adds selectors to the flags, for the workflows that completed more than --older-than before now, so
+// the server selects them, rather than every workflow being listed and filtered by the client
+func selectOlderThan(flags *listFlags, now time.Time) error {
+	if flags.finishedAfter == "" {
+		return nil
+	}
+	duration, err := argotime.ParseDuration(flags.finishedAfter)
+	if err != nil {
+		return fmt.Errorf("invalid --older-than %q: %w", flags.finishedAfter, err)
+	}
+	flags.completed = true
+	flags.fields = strings.TrimPrefix(flags.fields+",status.finishedAt<"+now.Add(-*duration).UTC().Format(time.RFC3339), ",")
+	return nil
+}
+
+// bulkDeleteWorkflows deletes the named and selected workflows in a single request to the server
+func bulkDeleteWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, flags listFlags, args []string) error {
+	labelSelector, err := labels.Parse(flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(flags.status) > 0 {
+		req, _ := labels.NewRequirement(common.LabelKeyPhase, selection.In, flags.status)
+		labelSelector = labelSelector.Add(*req)
+	}
+	if flags.completed {
+		req, _ := labels.NewRequirement(common.LabelKeyCompleted, selection.Equals, []string{"true"})
+		labelSelector = labelSelector.Add(*req)
+	}
+	if flags.resubmitted {
+		req, _ := labels.NewRequirement(common.LabelKeyPreviousWorkflowName, selection.Exists, []string{})
+		labelSelector = labelSelector.Add(*req)
+	}
+	fieldSelector := flags.fields
+	if flags.prefix != "" {
+		fieldSelector = strings.TrimPrefix(fieldSelector+",metadata.name^="+flags.prefix, ",")
+	}
+	return bulkWorkflows(ctx, serviceClient, &workflowpkg.WorkflowBulkRequest{
+		Namespace: client.Namespace(),
+		Operation: "delete",
+		Names:     args,
+	}, labelSelector.String(), fieldSelector, "deleted")
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+)
+
+func Test_checkBulkDeleteFlags(t *testing.T) {
+	assert.NoError(t, checkBulkDeleteFlags(listFlags{finishedAfter: "72h", status: []string{"Failed"}}, false, false))
+	assert.Error(t, checkBulkDeleteFlags(listFlags{}, true, false))
+	assert.Error(t, checkBulkDeleteFlags(listFlags{}, false, true))
+}
+
+func Test_checkWorkflowPhases(t *testing.T) {
+	assert.NoError(t, checkWorkflowPhases(nil))
+	assert.NoError(t, checkWorkflowPhases([]string{"Failed", "Error"}))
+	assert.EqualError(t, checkWorkflowPhases([]string{"Failed", "Errored"}), `unknown status "Errored", must be one of: Pending, Running, Succeeded, Failed, Error`)
+}
+
+func Test_selectOlderThan(t *testing.T) {
+	now := time.Date(2021, 10, 4, 12, 0, 0, 0, time.UTC)
+	t.Run("None", func(t *testing.T) {
+		flags := listFlags{fields: "metadata.name=my-wf"}
+		if assert.NoError(t, selectOlderThan(&flags, now)) {
+			assert.Equal(t, listFlags{fields: "metadata.name=my-wf"}, flags)
+		}
+	})
+	t.Run("Hours", func(t *testing.T) {
+		flags := listFlags{finishedAfter: "72h"}
+		if assert.NoError(t, selectOlderThan(&flags, now)) {
+			assert.True(t, flags.completed)
+			assert.Equal(t, "status.finishedAt<2021-10-01T12:00:00Z", flags.fields)
+		}
+	})
+	t.Run("Days", func(t *testing.T) {
+		flags := listFlags{finishedAfter: "1d", fields: "metadata.name=my-wf"}
+		if assert.NoError(t, selectOlderThan(&flags, now)) {
+			assert.Equal(t, "metadata.name=my-wf,status.finishedAt<2021-10-03T12:00:00Z", flags.fields)
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.Error(t, selectOlderThan(&listFlags{finishedAfter: "a while"}, now))
+	})
+}
+
+func Test_bulkDeleteWorkflows(t *testing.T) {
+	t.Setenv("ARGO_NAMESPACE", "argo")
+	flags := listFlags{finishedAfter: "72h", status: []string{"Failed", "Error"}, labels: "team=my-team"}
+	now := time.Now()
+	assert.NoError(t, selectOlderThan(&flags, now))
+	c := &workflowmocks.WorkflowServiceClient{}
+	c.On("BulkWorkflows", mock.Anything, &workflowpkg.WorkflowBulkRequest{
+		Namespace: "argo",
+		Operation: "delete",
+		ListOptions: &metav1.ListOptions{
+			LabelSelector: "team=my-team,workflows.argoproj.io/completed=true,workflows.argoproj.io/phase in (Error,Failed)",
+			FieldSelector: "status.finishedAt<" + now.Add(-72*time.Hour).UTC().Format(time.RFC3339),
+		},
+	}).Return(&workflowpkg.WorkflowBulkResponse{Results: []*workflowpkg.WorkflowBulkResult{{Name: "my-wf"}}}, nil)
+	assert.NoError(t, bulkDeleteWorkflows(context.Background(), c, flags, nil))
+	c.AssertExpectations(t)
+}
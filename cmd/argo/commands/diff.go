@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewDiffCommand() *cobra.Command {
+	var allNodes bool
+	command := &cobra.Command{
+		Use:   "diff WORKFLOW1 [WORKFLOW2]",
+		Short: "display the differences between two workflows, or between a workflow and its template",
+		Long: `Display the differences between two workflows, or between a workflow and its template.
+
+The fields of the specs the workflows ran that differ, including their parameters, and the phases and durations of their nodes are displayed. Nodes are matched by their names, without the name of their workflow. Only nodes whose phases differ are displayed, unless --all-nodes is given.
+
+If only one workflow is given, its spec is compared with the workflow template, cluster workflow template or cron workflow it was created from, as it is now.`,
+		Example: `# Display the differences between yesterday's and today's runs:
+
+  argo diff my-wf-1633046400 my-wf-1633132800
+
+# Display the differences between a workflow and its template:
+
+  argo diff my-wf
+`,
+		ValidArgsFunction: completion.Workflows(),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 && len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+			if err := diffWorkflows(ctx, serviceClient, os.Stdout, namespace, args, allNodes); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	command.Flags().BoolVar(&allNodes, "all-nodes", false, "display every node, not only those whose phases differ")
+	return command
+}
+
+// diffWorkflows prints the differences between the spec of the last workflow and the spec of the first, or of the
+// resource it was created from, and then between the nodes of the two workflows, if there are two
+func diffWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, out io.Writer, namespace string, names []string, allNodes bool) error {
+	req := &workflowpkg.WorkflowDiffRequest{Namespace: namespace, Name: names[len(names)-1]}
+	if len(names) == 2 {
+		req.OtherWorkflow = names[0]
+	}
+	res, err := serviceClient.DiffWorkflow(ctx, req)
+	if err != nil {
+		return err
+	}
+	printSpecDiff(out, res, req.Name)
+	if len(names) == 1 {
+		return nil
+	}
+	var wfs []*wfv1.Workflow
+	for _, name := range names {
+		wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: namespace})
+		if err != nil {
+			return err
+		}
+		wfs = append(wfs, wf)
+	}
+	_, _ = fmt.Fprintln(out)
+	printNodesDiff(out, wfs[0], wfs[1], allNodes)
+	return nil
+}
+
+// printSpecDiff prints the fields of the specs that differ, with their values in the base and in the workflow
+func printSpecDiff(out io.Writer, res *workflowpkg.WorkflowDiffResponse, name string) {
+	_, _ = fmt.Fprintln(out, "Spec:")
+	if len(res.Entries) == 0 {
+		_, _ = fmt.Fprintln(out, "No differences")
+		return
+	}
+	base := res.BaseName
+	if res.BaseKind != workflow.WorkflowKind {
+		base = strings.ToLower(res.BaseKind) + "/" + res.BaseName
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "FIELD\t%s\t%s\n", base, name)
+	for _, e := range res.Entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", e.Path, diffValue(e.Base), diffValue(e.Workflow))
+	}
+	_ = w.Flush()
+}
+
+// diffValue returns the value, as JSON, or "-" if the field is missing
+func diffValue(v string) string {
+	if v == "" {
+		return "-"
+	}
+	return v
+}
+
+// printNodesDiff prints the phases and durations of the nodes of both workflows, matching them by their name without
+// the name of their workflow
+func printNodesDiff(out io.Writer, wf1, wf2 *wfv1.Workflow, allNodes bool) {
+	_, _ = fmt.Fprintln(out, "Nodes:")
+	nodes1, nodes2 := diffNodes(wf1), diffNodes(wf2)
+	var keys []string
+	for key := range nodes1 {
+		keys = append(keys, key)
+	}
+	for key := range nodes2 {
+		if _, ok := nodes1[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NODE\t%s\t\t%s\n", wf1.Name, wf2.Name)
+	printed := false
+	for _, key := range keys {
+		n1, ok1 := nodes1[key]
+		n2, ok2 := nodes2[key]
+		if !allNodes && ok1 && ok2 && n1.Phase == n2.Phase {
+			continue
+		}
+		printed = true
+		displayName := n1.DisplayName
+		if !ok1 {
+			displayName = n2.DisplayName
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", displayName, diffNodeOutcome(n1, ok1), diffNodeOutcome(n2, ok2))
+	}
+	if printed {
+		_ = w.Flush()
+	} else {
+		_, _ = fmt.Fprintln(out, "No differences")
+	}
+}
+
+func diffNodes(wf *wfv1.Workflow) map[string]wfv1.NodeStatus {
+	nodes := make(map[string]wfv1.NodeStatus, len(wf.Status.Nodes))
+	for _, node := range wf.Status.Nodes {
+		nodes[strings.TrimPrefix(node.Name, wf.Name)] = node
+	}
+	return nodes
+}
+
+// diffNodeOutcome returns the phase and duration of the node, as two columns
+func diffNodeOutcome(node wfv1.NodeStatus, ok bool) string {
+	if !ok {
+		return "-\t"
+	}
+	duration := "-"
+	if !node.StartedAt.IsZero() && !node.FinishedAt.IsZero() {
+		duration = node.FinishedAt.Sub(node.StartedAt.Time).Truncate(time.Second).String()
+	}
+	return fmt.Sprintf("%s\t%s", node.Phase, duration)
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func diffTestWorkflow(name string, phase wfv1.NodePhase, seconds int) *wfv1.Workflow {
+	startedAt := metav1.NewTime(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC))
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			name: {ID: name, Name: name, DisplayName: name, Phase: wfv1.NodeSucceeded},
+			name + "-1": {
+				ID: name + "-1", Name: name + ".step", DisplayName: "step", Phase: phase,
+				StartedAt: startedAt, FinishedAt: metav1.NewTime(startedAt.Add(time.Duration(seconds) * time.Second)),
+			},
+		}},
+	}
+}
+
+func Test_diffWorkflows(t *testing.T) {
+	t.Run("Workflows", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("DiffWorkflow", mock.Anything, &workflowpkg.WorkflowDiffRequest{Namespace: "my-ns", Name: "my-wf-2", OtherWorkflow: "my-wf-1"}).
+			Return(&workflowpkg.WorkflowDiffResponse{BaseKind: workflow.WorkflowKind, BaseName: "my-wf-1", Entries: []*workflowpkg.WorkflowDiffEntry{
+				{Path: "spec.arguments.parameters[0].value", Base: `"hello"`, Workflow: `"goodbye"`},
+				{Path: "spec.templates[0].container.image", Base: `"argosay:v1"`, Workflow: `"argosay:v2"`},
+			}}, nil)
+		c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf-1"}).
+			Return(diffTestWorkflow("my-wf-1", wfv1.NodeSucceeded, 10), nil)
+		c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf-2"}).
+			Return(diffTestWorkflow("my-wf-2", wfv1.NodeFailed, 3), nil)
+		out := &bytes.Buffer{}
+		if assert.NoError(t, diffWorkflows(context.Background(), c, out, "my-ns", []string{"my-wf-1", "my-wf-2"}, false)) {
+			assert.Equal(t, `Spec:
+FIELD                               my-wf-1       my-wf-2
+spec.arguments.parameters[0].value  "hello"       "goodbye"
+spec.templates[0].container.image   "argosay:v1"  "argosay:v2"
+
+Nodes:
+NODE  my-wf-1         my-wf-2
+step  Succeeded  10s  Failed  3s
+`, out.String())
+		}
+	})
+	t.Run("Template", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("DiffWorkflow", mock.Anything, &workflowpkg.WorkflowDiffRequest{Namespace: "my-ns", Name: "my-wf"}).
+			Return(&workflowpkg.WorkflowDiffResponse{BaseKind: workflow.WorkflowTemplateKind, BaseName: "my-wftmpl", Entries: []*workflowpkg.WorkflowDiffEntry{
+				{Path: "spec.arguments.parameters[0].value", Workflow: `"hello"`},
+			}}, nil)
+		out := &bytes.Buffer{}
+		if assert.NoError(t, diffWorkflows(context.Background(), c, out, "my-ns", []string{"my-wf"}, false)) {
+			assert.Equal(t, `Spec:
+FIELD                               workflowtemplate/my-wftmpl  my-wf
+spec.arguments.parameters[0].value  -                           "hello"
+`, out.String())
+		}
+	})
+	t.Run("NoDifferences", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("DiffWorkflow", mock.Anything, mock.Anything).Return(&workflowpkg.WorkflowDiffResponse{BaseKind: workflow.WorkflowKind, BaseName: "my-wf-1"}, nil)
+		c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf-1"}).
+			Return(diffTestWorkflow("my-wf-1", wfv1.NodeSucceeded, 10), nil)
+		c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf-2"}).
+			Return(diffTestWorkflow("my-wf-2", wfv1.NodeSucceeded, 3), nil)
+		out := &bytes.Buffer{}
+		if assert.NoError(t, diffWorkflows(context.Background(), c, out, "my-ns", []string{"my-wf-1", "my-wf-2"}, false)) {
+			assert.Equal(t, `Spec:
+No differences
+
+Nodes:
+No differences
+`, out.String())
+		}
+	})
+}
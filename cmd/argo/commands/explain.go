@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func NewExplainCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "explain WORKFLOW NODE",
+		Short: "explain why a node of a workflow has not started, or completed",
+		Long: `Explain why a node of a workflow has not started, or completed.
+
+The reasons are aggregated in one answer: whether the workflow is suspended or queued, the dependencies, or previous steps, the node waits for, whether its when expression evaluates true, or false, the lock it waits for and who holds it, the parallelism limits it is held back by, and, if its pod is pending, why the pod has not been scheduled.
+
+NODE is the ID, name or display name of the node, or the name of a step, or task, that does not have a node yet.`,
+		Example: `# Explain why a task has not started:
+
+  argo explain my-wf my-task
+
+# Explain as JSON:
+
+  argo explain my-wf my-task -o json
+`,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch len(args) {
+			case 0:
+				return completion.Workflows()(cmd, args, toComplete)
+			case 1:
+				return completion.NodesOf(args[0], toComplete), cobra.ShellCompDirectiveNoFileComp
+			default:
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			errors.CheckError(explainNode(cmd.Context(), os.Stdout, args[0], args[1], output))
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+func explainNode(ctx context.Context, out io.Writer, workflowName, nodeName, output string) error {
+	switch output {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	ctx, apiClient := client.NewAPIClient(ctx)
+	serviceClient := apiClient.NewWorkflowServiceClient()
+	explanation, err := serviceClient.ExplainWorkflowNode(ctx, &workflowpkg.WorkflowExplainRequest{Name: workflowName, Namespace: client.Namespace(), NodeName: nodeName})
+	if err != nil {
+		return err
+	}
+	return printExplanation(out, explanation, output)
+}
+
+func printExplanation(out io.Writer, explanation *workflowpkg.WorkflowExplainResponse, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(explanation, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(explanation)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	const fmtStr = "%-20s %v\n"
+	_, _ = fmt.Fprintf(out, fmtStr, "Name:", explanation.NodeName)
+	if explanation.NodeId != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "ID:", explanation.NodeId)
+		_, _ = fmt.Fprintf(out, fmtStr, "Status:", explanation.Phase)
+	} else {
+		_, _ = fmt.Fprintf(out, fmtStr, "Status:", "Not started")
+	}
+	_, _ = fmt.Fprintln(out)
+	if len(explanation.Reasons) == 0 {
+		_, _ = fmt.Fprintln(out, "No reason found, the node may be about to start")
+		return nil
+	}
+	_, _ = fmt.Fprintln(out, "Reasons:")
+	for _, reason := range explanation.Reasons {
+		_, _ = fmt.Fprintf(out, "  %-17s %s\n", reason.Kind+":", reason.Message)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func Test_printExplanation(t *testing.T) {
+	t.Run("NotStarted", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		err := printExplanation(out, &workflowpkg.WorkflowExplainResponse{
+			NodeName: "b",
+			Reasons: []*workflowpkg.NodeExplanation{
+				{Kind: "Dependencies", Message: `waiting for a (Pending) to complete, depends on "a"`},
+				{Kind: "Parallelism", Message: "the workflow's parallelism 1 is reached by 1 active pods"},
+			},
+		}, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, `Name:                b
+Status:              Not started
+
+Reasons:
+  Dependencies:     waiting for a (Pending) to complete, depends on "a"
+  Parallelism:      the workflow's parallelism 1 is reached by 1 active pods
+`, out.String())
+		}
+	})
+	t.Run("NoReasons", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		err := printExplanation(out, &workflowpkg.WorkflowExplainResponse{NodeId: "my-wf-1", NodeName: "my-wf.a", Phase: "Pending"}, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, `Name:                my-wf.a
+ID:                  my-wf-1
+Status:              Pending
+
+No reason found, the node may be about to start
+`, out.String())
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		err := printExplanation(out, &workflowpkg.WorkflowExplainResponse{NodeName: "b", Reasons: []*workflowpkg.NodeExplanation{{Kind: "When", Message: "when 'false' evaluated false"}}}, "json")
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"nodeName":"b","reasons":[{"kind":"When","message":"when 'false' evaluated false"}]}`, out.String())
+		}
+	})
+}
@@ -8,15 +8,18 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
 	"github.com/argoproj/pkg/humanize"
 	"github.com/spf13/cobra"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argoutil "github.com/argoproj/argo-workflows/v3/util"
@@ -29,6 +32,10 @@ const onExitSuffix = "onExit"
 type getFlags struct {
 	output                  string
 	nodeFieldSelectorString string
+	// tree collapses completed branches, and adds the estimated time left of each node
+	tree bool
+	// asOf gets the status of the workflow as of the time, from its snapshots
+	asOf string
 
 	// Only used for backwards compatibility
 	status string
@@ -64,7 +71,20 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Get the graph of a workflow, and render it with Graphviz:
+
+  argo get my-wf -o dot | dot -Tsvg > my-wf.svg
+
+# Get the graph of a workflow as a Mermaid flowchart:
+
+  argo get my-wf -o mermaid
+
+# Get the status of a workflow as it was at a time, from its snapshots:
+
+  argo get my-wf --as-of 2021-01-01T12:00:00Z
 `,
+		ValidArgsFunction: completion.Workflows(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -78,17 +98,32 @@ func NewGetCommand() *cobra.Command {
 					Name:      name,
 					Namespace: namespace,
 				})
+				if getArgs.asOf != "" {
+					// snapshots outlive their workflows
+					if apierr.IsNotFound(err) {
+						wf, err = &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, nil
+					}
+					errors.CheckError(err)
+					snapshot, err := serviceClient.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{
+						Name:      name,
+						Namespace: namespace,
+						AsOf:      getArgs.asOf,
+					})
+					errors.CheckError(err)
+					wf.Status = *snapshot.Status
+				}
 				errors.CheckError(err)
 				printWorkflow(wf, getArgs)
 			}
 		},
 	}
 
-	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|short|wide")
+	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|short|wide|dot|mermaid")
 	command.Flags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
 	command.Flags().BoolVar(&noUtf8, "no-utf8", false, "Use plain 7-bits ascii characters")
 	command.Flags().StringVar(&getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	command.Flags().StringVar(&getArgs.nodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().StringVar(&getArgs.asOf, "as-of", "", "Get the status of the workflow as of the time, in RFC3339 format, from its snapshots. Requires workflow snapshots to be enabled.")
 	return command
 }
 
@@ -106,6 +141,10 @@ func printWorkflow(wf *wfv1.Workflow, getArgs getFlags) {
 	case "yaml":
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
+	case "dot":
+		printWorkflowDOT(os.Stdout, wf)
+	case "mermaid":
+		printWorkflowMermaid(os.Stdout, wf)
 	case "short", "wide", "":
 		fmt.Print(printWorkflowHelper(wf, getArgs))
 	default:
@@ -157,6 +196,9 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 	if !wf.Status.ResourcesDuration.IsZero() {
 		out += fmt.Sprintf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
 	}
+	if wf.Status.EstimatedCost != nil {
+		out += fmt.Sprintf(fmtStr, "EstimatedCost:", wf.Status.EstimatedCost.Value)
+	}
 	if len(wf.GetExecSpec().Arguments.Parameters) > 0 {
 		out += fmt.Sprintf(fmtStr, "Parameters:", "")
 		for _, param := range wf.GetExecSpec().Arguments.Parameters {
@@ -201,6 +243,8 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tARTIFACTS\tMESSAGE\tRESOURCESDURATION\tNODENAME\n", ansiFormat("STEP", FgDefault))
 		} else if getArgs.output == "short" {
 			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tMESSAGE\tNODENAME\n", ansiFormat("STEP", FgDefault))
+		} else if getArgs.tree {
+			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tETA\tMESSAGE\n", ansiFormat("STEP", FgDefault))
 		} else {
 			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tMESSAGE\n", ansiFormat("STEP", FgDefault))
 		}
@@ -288,7 +332,7 @@ func isNonBoundaryParentNode(node wfv1.NodeType) bool {
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypePlugin)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypeGRPC) || (node == wfv1.NodeTypeSQL) || (node == wfv1.NodeTypeNotification) || (node == wfv1.NodeTypeWorkflow) || (node == wfv1.NodeTypePlugin)
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
@@ -561,18 +605,67 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, wfName, nodePrefix str
 			args[len(args)-1] = node.HostNodeName
 		}
 		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\n", args...)
+	} else if getArgs.tree {
+		args = append(args[:5], append([]interface{}{nodeETA(node, time.Now())}, args[5:]...)...)
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\t%s\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\n", args...)
 	}
 }
 
+// nodeETA returns how much longer the node is estimated to run for, if it is running and has an estimated duration
+func nodeETA(node wfv1.NodeStatus, now time.Time) string {
+	if node.Fulfilled() || node.StartedAt.IsZero() || node.EstimatedDuration <= 0 {
+		return ""
+	}
+	finishAt := node.StartedAt.Add(node.EstimatedDuration.ToDuration())
+	if !now.Before(finishAt) {
+		return "overdue"
+	}
+	return humanize.RelativeDurationShort(now, finishAt)
+}
+
+// collapseNode returns whether, in a tree, the children of the node are not rendered, which they are not if it
+// completed without failing, unless it is the root
+func collapseNode(node wfv1.NodeStatus, depth int, getArgs getFlags) bool {
+	return getArgs.tree && depth > 0 && node.Fulfilled() && !node.FailedOrError()
+}
+
+// countNodes returns the number of nodes contained by the render node, not including itself
+func countNodes(n renderNode) int {
+	var children []renderNode
+	switch n := n.(type) {
+	case *boundaryNode:
+		children = n.boundaryContained
+	case *nonBoundaryParentNode:
+		children = n.children
+	}
+	count := len(children)
+	for _, child := range children {
+		count += countNodes(child)
+	}
+	return count
+}
+
 // renderNodes for each renderNode Type
 // boundaryNode
 func (nodeInfo *boundaryNode) renderNodes(w *tabwriter.Writer, wf *wfv1.Workflow, depth int, nodePrefix string, childPrefix string, getArgs getFlags) {
-	filtered, childIndent := filterNode(nodeInfo.getNodeStatus(wf), getArgs)
+	node := nodeInfo.getNodeStatus(wf)
+	filtered, childIndent := filterNode(node, getArgs)
+	collapsed := collapseNode(node, depth, getArgs)
+	if collapsed && node.Message == "" {
+		if count := countNodes(nodeInfo); count == 1 {
+			node.Message = "1 node collapsed"
+		} else {
+			node.Message = fmt.Sprintf("%d nodes collapsed", count)
+		}
+	}
 	if !filtered {
 		version := util.GetWorkflowPodNameVersion(wf)
-		printNode(w, nodeInfo.getNodeStatus(wf), wf.ObjectMeta.Name, nodePrefix, getArgs, version)
+		printNode(w, node, wf.ObjectMeta.Name, nodePrefix, getArgs, version)
+	}
+	if collapsed {
+		return
 	}
 
 	for i, nInfo := range nodeInfo.boundaryContained {
@@ -364,6 +364,74 @@ status:
 	})
 }
 
+func Test_printWorkflowHelperTree(t *testing.T) {
+	var wf wfv1.Workflow
+	wfv1.MustUnmarshal(`
+metadata:
+  name: my-wf
+status:
+  phase: Running
+  nodes:
+    my-wf:
+      id: my-wf
+      name: my-wf
+      displayName: my-wf
+      type: Steps
+      phase: Running
+      children: [my-wf-sg]
+    my-wf-sg:
+      id: my-wf-sg
+      name: my-wf[0]
+      displayName: "[0]"
+      type: StepGroup
+      phase: Running
+      boundaryID: my-wf
+      children: [my-wf-inner, my-wf-b]
+    my-wf-inner:
+      id: my-wf-inner
+      name: my-wf[0].inner
+      displayName: inner
+      type: Steps
+      phase: Succeeded
+      boundaryID: my-wf
+      children: [my-wf-a]
+    my-wf-a:
+      id: my-wf-a
+      name: my-wf[0].inner[0].a
+      displayName: a
+      type: Pod
+      phase: Succeeded
+      boundaryID: my-wf-inner
+    my-wf-b:
+      id: my-wf-b
+      name: my-wf[0].b
+      displayName: b
+      type: Pod
+      phase: Running
+      boundaryID: my-wf
+      startedAt: "2020-01-01T00:00:00Z"
+      estimatedDuration: 60
+`, &wf)
+	output := printWorkflowHelper(&wf, getFlags{tree: true})
+	assert.Regexp(t, `STEP.* +TEMPLATE +PODNAME +DURATION +ETA +MESSAGE`, output)
+	assert.Regexp(t, `inner +1 node collapsed`, output)
+	assert.NotContains(t, output, "my-wf-a")
+	assert.Regexp(t, `my-wf-b +\S+ +overdue`, output)
+
+	output = printWorkflowHelper(&wf, getFlags{})
+	assert.Contains(t, output, "my-wf-a")
+	assert.NotContains(t, output, "ETA")
+}
+
+func Test_nodeETA(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 30, 0, time.UTC)
+	startedAt := metav1.NewTime(now.Add(-30 * time.Second))
+	assert.Equal(t, "", nodeETA(wfv1.NodeStatus{Phase: wfv1.NodeRunning, StartedAt: startedAt}, now))
+	assert.Equal(t, "", nodeETA(wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, StartedAt: startedAt, EstimatedDuration: 60}, now))
+	assert.Equal(t, "30s", nodeETA(wfv1.NodeStatus{Phase: wfv1.NodeRunning, StartedAt: startedAt, EstimatedDuration: 60}, now))
+	assert.Equal(t, "overdue", nodeETA(wfv1.NodeStatus{Phase: wfv1.NodeRunning, StartedAt: startedAt, EstimatedDuration: 10}, now))
+}
+
 func Test_printWorkflowHelperNudges(t *testing.T) {
 	securedWf := wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{},
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// the colors of the phases, as per the UI
+var graphPhaseColors = map[wfv1.NodePhase]string{
+	wfv1.NodePending:   "#f4c030",
+	wfv1.NodeRunning:   "#0dadea",
+	wfv1.NodeSucceeded: "#18be94",
+	wfv1.NodeSkipped:   "#ccd6dd",
+	wfv1.NodeFailed:    "#e96d76",
+	wfv1.NodeError:     "#e96d76",
+	wfv1.NodeOmitted:   "#ccd6dd",
+}
+
+// graphNodes returns the nodes of the workflow, ordered by ID, so graphs are the same each time they are printed
+func graphNodes(wf *wfv1.Workflow) []wfv1.NodeStatus {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+func graphNodeLabel(node wfv1.NodeStatus) string {
+	if node.Phase == "" {
+		return node.DisplayName
+	}
+	return fmt.Sprintf("%s\n%s", node.DisplayName, node.Phase)
+}
+
+// printWorkflowDOT prints the nodes of the workflow, and the edges from each node to its children, in the Graphviz DOT
+// language
+func printWorkflowDOT(w io.Writer, wf *wfv1.Workflow) {
+	_, _ = fmt.Fprintf(w, "digraph %q {\n", wf.Name)
+	_, _ = fmt.Fprintln(w, `  node [shape=box, style="rounded,filled", fillcolor="#ffffff"];`)
+	nodes := graphNodes(wf)
+	for _, node := range nodes {
+		attrs := fmt.Sprintf("label=%q", graphNodeLabel(node))
+		if color, ok := graphPhaseColors[node.Phase]; ok {
+			attrs += fmt.Sprintf(", fillcolor=%q", color)
+		}
+		_, _ = fmt.Fprintf(w, "  %q [%s];\n", node.ID, attrs)
+	}
+	for _, node := range nodes {
+		for _, childID := range node.Children {
+			_, _ = fmt.Fprintf(w, "  %q -> %q;\n", node.ID, childID)
+		}
+	}
+	_, _ = fmt.Fprintln(w, "}")
+}
+
+// printWorkflowMermaid prints the nodes of the workflow, and the edges from each node to its children, as a Mermaid
+// flowchart. Node IDs are not valid Mermaid IDs, so each node is given one.
+func printWorkflowMermaid(w io.Writer, wf *wfv1.Workflow) {
+	_, _ = fmt.Fprintln(w, "flowchart TD")
+	nodes := graphNodes(wf)
+	ids := make(map[string]string, len(nodes))
+	for i, node := range nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+	}
+	for _, node := range nodes {
+		_, _ = fmt.Fprintf(w, "  %s[\"%s\"]", ids[node.ID], mermaidEscape(graphNodeLabel(node)))
+		if _, ok := graphPhaseColors[node.Phase]; ok {
+			_, _ = fmt.Fprintf(w, ":::%s", node.Phase)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	for _, node := range nodes {
+		for _, childID := range node.Children {
+			if childID, ok := ids[childID]; ok {
+				_, _ = fmt.Fprintf(w, "  %s --> %s\n", ids[node.ID], childID)
+			}
+		}
+	}
+	var phases []string
+	for phase := range graphPhaseColors {
+		phases = append(phases, string(phase))
+	}
+	sort.Strings(phases)
+	for _, phase := range phases {
+		_, _ = fmt.Fprintf(w, "  classDef %s fill:%s\n", phase, graphPhaseColors[wfv1.NodePhase(phase)])
+	}
+}
+
+// mermaidEscape escapes the label for a quoted Mermaid node label, where new lines are <br> and quotes are entities
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br>").Replace(s)
+}
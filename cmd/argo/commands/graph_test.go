@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var graphWorkflow = wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+status:
+  nodes:
+    my-wf:
+      id: my-wf
+      displayName: my-wf
+      type: DAG
+      phase: Running
+      children: [my-wf-1, my-wf-2]
+    my-wf-1:
+      id: my-wf-1
+      displayName: a
+      type: Pod
+      phase: Succeeded
+      children: [my-wf-2]
+    my-wf-2:
+      id: my-wf-2
+      displayName: b "quoted"
+      type: Pod
+`)
+
+func Test_printWorkflowDOT(t *testing.T) {
+	out := &bytes.Buffer{}
+	printWorkflowDOT(out, graphWorkflow)
+	assert.Equal(t, `digraph "my-wf" {
+  node [shape=box, style="rounded,filled", fillcolor="#ffffff"];
+  "my-wf" [label="my-wf\nRunning", fillcolor="#0dadea"];
+  "my-wf-1" [label="a\nSucceeded", fillcolor="#18be94"];
+  "my-wf-2" [label="b \"quoted\""];
+  "my-wf" -> "my-wf-1";
+  "my-wf" -> "my-wf-2";
+  "my-wf-1" -> "my-wf-2";
+}
+`, out.String())
+}
+
+func Test_printWorkflowMermaid(t *testing.T) {
+	out := &bytes.Buffer{}
+	printWorkflowMermaid(out, graphWorkflow)
+	assert.Equal(t, `flowchart TD
+  n0["my-wf<br>Running"]:::Running
+  n1["a<br>Succeeded"]:::Succeeded
+  n2["b #quot;quoted#quot;"]
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+  classDef Error fill:#e96d76
+  classDef Failed fill:#e96d76
+  classDef Omitted fill:#ccd6dd
+  classDef Pending fill:#f4c030
+  classDef Running fill:#0dadea
+  classDef Skipped fill:#ccd6dd
+  classDef Succeeded fill:#18be94
+`, out.String())
+}
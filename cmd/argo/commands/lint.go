@@ -8,16 +8,18 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/lint"
 	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 )
 
 func NewLintCommand() *cobra.Command {
 	var (
-		strict    bool
-		lintKinds []string
-		output    string
-		offline   bool
+		strict                     bool
+		strictTemplateRefArguments bool
+		lintKinds                  []string
+		output                     string
+		offline                    bool
 	)
 
 	allKinds := []string{wf.WorkflowPlural, wf.WorkflowTemplatePlural, wf.CronWorkflowPlural, wf.ClusterWorkflowTemplatePlural}
@@ -32,9 +34,15 @@ func NewLintCommand() *cobra.Command {
 
 # Lint only manifests of Workflows and CronWorkflows from stdin:
 
-  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -`,
+  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -
+
+# Lint manifests without a cluster, resolving references to workflow templates and cluster workflow templates from the manifests:
+
+  argo lint --offline ./manifests`,
+		ValidArgsFunction: completion.ManifestFiles,
 		Run: func(cmd *cobra.Command, args []string) {
 			client.Offline = offline
+			client.OfflineFiles = args
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -44,10 +52,11 @@ func NewLintCommand() *cobra.Command {
 				lintKinds = allKinds
 			}
 			ops := lint.LintOptions{
-				Files:            args,
-				Strict:           strict,
-				DefaultNamespace: client.Namespace(),
-				Printer:          os.Stdout,
+				Files:                      args,
+				Strict:                     strict,
+				StrictTemplateRefArguments: strictTemplateRefArguments,
+				DefaultNamespace:           client.Namespace(),
+				Printer:                    os.Stdout,
 			}
 			lint.RunLint(ctx, apiClient, lintKinds, output, offline, ops)
 		},
@@ -56,7 +65,8 @@ func NewLintCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&lintKinds, "kinds", []string{"all"}, fmt.Sprintf("Which kinds will be linted. Can be: %s", strings.Join(allKinds, "|")))
 	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple")
 	command.Flags().BoolVar(&strict, "strict", true, "Perform strict workflow validation")
-	command.Flags().BoolVar(&offline, "offline", false, "perform offline linting")
+	command.Flags().BoolVar(&strictTemplateRefArguments, "strict-template-ref-arguments", false, "reject arguments of steps and tasks that are not inputs of their referenced templates")
+	command.Flags().BoolVar(&offline, "offline", false, "perform offline linting, without a cluster or Argo Server. References to workflow templates and cluster workflow templates are resolved from the files being linted, which must be valid against the bundled schema")
 
 	return command
 }
@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"path"
 	"time"
 
 	"github.com/argoproj/pkg/errors"
@@ -15,7 +16,10 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 func NewLogsCommand() *cobra.Command {
@@ -25,6 +29,7 @@ func NewLogsCommand() *cobra.Command {
 		tailLines int64
 		grep      string
 		selector  string
+		node      string
 	)
 	logOptions := &corev1.PodLogOptions{}
 	command := &cobra.Command{
@@ -54,9 +59,20 @@ func NewLogsCommand() *cobra.Command {
 
   argo logs --since=1h my-pod
 
+# Print the last 10 lines of the logs of the nodes named "step-a", or starting with "fanout":
+
+  argo logs my-wf --node step-a --tail 10
+  argo logs my-wf --node 'fanout*'
+
 # Print the logs of the latest workflow:
   argo logs @latest
 `,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return completion.Workflows()(cmd, args, toComplete)
+			}
+			return completion.Pods(cmd, args, toComplete)
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			// parse all the args
 			workflow := ""
@@ -73,6 +89,10 @@ func NewLogsCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			if podName != "" && node != "" {
+				log.Fatal("a pod and --node cannot be used together")
+			}
+
 			if since > 0 && sinceTime != "" {
 				log.Fatal("--since-time and --since cannot be used together")
 			}
@@ -97,7 +117,17 @@ func NewLogsCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
 
-			logWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, selector, logOptions)
+			var matcher *podNodeMatcher
+			if node != "" {
+				if _, err := path.Match(node, ""); err != nil {
+					log.Fatalf("node pattern %q is not valid: %v", node, err)
+				}
+				matcher = newPodNodeMatcher(node, func() (*wfv1.Workflow, error) {
+					return serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: workflow, Namespace: namespace})
+				})
+			}
+
+			logWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, selector, logOptions, matcher)
 		},
 	}
 	command.Flags().StringVarP(&logOptions.Container, "container", "c", "main", "Print the logs of this container")
@@ -108,12 +138,18 @@ func NewLogsCommand() *cobra.Command {
 	command.Flags().Int64Var(&tailLines, "tail", -1, "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime")
 	command.Flags().StringVar(&grep, "grep", "", "grep for lines")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "log selector for some pod")
+	command.Flags().StringVar(&node, "node", "", "Only print the logs of the nodes with this ID, name or display name, or glob pattern matching one of them")
 	command.Flags().BoolVar(&logOptions.Timestamps, "timestamps", false, "Include timestamps on each line in the log output")
 	command.Flags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
+	if err := command.RegisterFlagCompletionFunc("node", completion.Nodes); err != nil {
+		log.Fatal(err)
+	}
 	return command
 }
 
-func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep, selector string, logOptions *corev1.PodLogOptions) {
+// logWorkflow prints the logs of the workflow, each line prefixed with its pod's name. If matcher is not nil, only the logs
+// of the pods of the nodes it matches are printed.
+func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep, selector string, logOptions *corev1.PodLogOptions, matcher *podNodeMatcher) {
 	// logs
 	stream, err := serviceClient.WorkflowLogs(ctx, &workflowpkg.WorkflowLogRequest{
 		Name:       workflow,
@@ -125,6 +161,7 @@ func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceC
 	})
 	errors.CheckError(err)
 
+	colors := newPodColors()
 	// loop on log lines
 	for {
 		event, err := stream.Recv()
@@ -132,6 +169,70 @@ func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceC
 			return
 		}
 		errors.CheckError(err)
-		fmt.Println(ansiFormat(fmt.Sprintf("%s: %s", event.PodName, event.Content), ansiColorCode(event.PodName)))
+		if matcher != nil {
+			ok, err := matcher.matches(event.PodName)
+			errors.CheckError(err)
+			if !ok {
+				continue
+			}
+		}
+		fmt.Println(ansiFormat(fmt.Sprintf("%s: %s", event.PodName, event.Content), colors.get(event.PodName)))
+	}
+}
+
+// podColors gives each pod its own color, in the order they are first seen, so pods logging at the same time are
+// easy to tell apart. Colors are only re-used once every color has been given out.
+type podColors struct {
+	colors map[string]int
+}
+
+func newPodColors() *podColors {
+	return &podColors{colors: make(map[string]int)}
+}
+
+func (p *podColors) get(podName string) int {
+	color, ok := p.colors[podName]
+	if !ok {
+		color = ansiColors[len(p.colors)%len(ansiColors)]
+		p.colors[podName] = color
+	}
+	return color
+}
+
+// podNodeMatcher works out if a pod is one of the nodes matching a pattern. Pods are looked up in the workflow's nodes,
+// and the workflow is only got again when a pod is seen that was not in it the last time, e.g. when following logs.
+type podNodeMatcher struct {
+	pattern     string
+	getWorkflow func() (*wfv1.Workflow, error)
+	// pod name -> if it matches
+	pods map[string]bool
+}
+
+func newPodNodeMatcher(pattern string, getWorkflow func() (*wfv1.Workflow, error)) *podNodeMatcher {
+	return &podNodeMatcher{pattern: pattern, getWorkflow: getWorkflow, pods: make(map[string]bool)}
+}
+
+func (m *podNodeMatcher) matches(podName string) (bool, error) {
+	if ok, seen := m.pods[podName]; seen {
+		return ok, nil
+	}
+	wf, err := m.getWorkflow()
+	if err != nil {
+		return false, err
+	}
+	version := util.GetWorkflowPodNameVersion(wf)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod {
+			continue
+		}
+		templateName := node.TemplateName
+		if node.TemplateRef != nil {
+			templateName = node.TemplateRef.Template
+		}
+		m.pods[util.PodName(wf.Name, node.Name, templateName, node.ID, version)] = nodeMatches(m.pattern, node)
 	}
+	// remember pods that are still not in the workflow, so we do not get it for every line they log
+	ok := m.pods[podName]
+	m.pods[podName] = ok
+	return ok, nil
 }
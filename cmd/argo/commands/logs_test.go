@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_podColors(t *testing.T) {
+	colors := newPodColors()
+	assert.Equal(t, FgRed, colors.get("pod-a"))
+	assert.Equal(t, FgGreen, colors.get("pod-b"))
+	assert.Equal(t, FgRed, colors.get("pod-a"), "a pod keeps its color")
+	for i := 0; i < len(ansiColors)-2; i++ {
+		colors.get(string(rune('c' + i)))
+	}
+	assert.Equal(t, FgRed, colors.get("pod-last"), "colors are re-used once they have all been given out")
+}
+
+func Test_podNodeMatcher(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Annotations: map[string]string{common.AnnotationKeyPodNameVersion: "v2"}},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", Name: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeSteps},
+			"my-wf-1": {ID: "my-wf-1", Name: "my-wf[0].step-a", DisplayName: "step-a", TemplateName: "echo", Type: wfv1.NodeTypePod},
+			"my-wf-2": {ID: "my-wf-2", Name: "my-wf[0].fanout(0)", DisplayName: "fanout(0)", TemplateName: "echo", Type: wfv1.NodeTypePod},
+			"my-wf-3": {ID: "my-wf-3", Name: "my-wf[0].fanout(1)", DisplayName: "fanout(1)", TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "print"}, Type: wfv1.NodeTypePod},
+		}},
+	}
+	gets := 0
+	getWorkflow := func() (*wfv1.Workflow, error) {
+		gets++
+		return wf, nil
+	}
+	t.Run("DisplayName", func(t *testing.T) {
+		gets = 0
+		m := newPodNodeMatcher("step-a", getWorkflow)
+		ok, err := m.matches("my-wf-echo-4044919119")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		ok, _ = m.matches("my-wf-echo-2943946035")
+		assert.False(t, ok)
+		assert.Equal(t, 1, gets, "the workflow is only got once for pods in it")
+	})
+	t.Run("Glob", func(t *testing.T) {
+		m := newPodNodeMatcher("fanout*", getWorkflow)
+		ok, _ := m.matches("my-wf-echo-4044919119")
+		assert.False(t, ok)
+		ok, _ = m.matches("my-wf-echo-2943946035")
+		assert.True(t, ok)
+		ok, _ = m.matches("my-wf-print-3548087414")
+		assert.True(t, ok)
+	})
+	t.Run("UnknownPod", func(t *testing.T) {
+		gets = 0
+		m := newPodNodeMatcher("*", getWorkflow)
+		ok, _ := m.matches("other-pod")
+		assert.False(t, ok)
+		ok, _ = m.matches("other-pod")
+		assert.False(t, ok)
+		assert.Equal(t, 1, gets, "the workflow is not got again for a pod that was not in it")
+	})
+}
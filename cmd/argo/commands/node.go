@@ -1,19 +1,31 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type setOps struct {
@@ -24,12 +36,27 @@ type setOps struct {
 }
 
 func NewNodeCommand() *cobra.Command {
-	var setArgs setOps
+	var (
+		setArgs setOps
+		output  string
+	)
 
 	command := &cobra.Command{
 		Use:   "node ACTION WORKFLOW FLAGS",
 		Short: "perform action on a node in a workflow",
-		Example: `# Set outputs to a node within a workflow:
+		Long: `Perform an action on a node in a workflow. The actions are:
+
+* set WORKFLOW: set the outputs, message or phase of the nodes selected by --node-field-selector.
+* inspect WORKFLOW NODE: display the resolved inputs and outputs, retries, resources duration and pod spec of a node. NODE is the ID, name or display name of the node, or a glob pattern matching one of them. "argo node WORKFLOW NODE" is short for this.`,
+		Example: `# Display the details of a node, including the spec of the pod that ran it:
+
+  argo node inspect my-wf my-step
+
+# Display the details of a node as JSON:
+
+  argo node my-wf my-step -o json
+
+# Set outputs to a node within a workflow:
 
   argo node set my-wf --output-parameter parameter-name="Hello, world!" --node-field-selector displayName=approve
 
@@ -37,13 +64,37 @@ func NewNodeCommand() *cobra.Command {
 
   argo node set my-wf --message "We did it!"" --node-field-selector displayName=approve
 `,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch {
+			case len(args) == 0:
+				return []string{"inspect", "set"}, cobra.ShellCompDirectiveNoFileComp
+			case len(args) == 1:
+				return completion.Workflows()(cmd, args, toComplete)
+			case len(args) == 2 && args[0] == "inspect":
+				return completion.NodesOf(args[1], toComplete), cobra.ShellCompDirectiveNoFileComp
+			default:
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) < 2 {
 				cmd.HelpFunc()(cmd, args)
 				os.Exit(1)
 			}
 
-			if args[0] != "set" {
+			switch {
+			case args[0] == "inspect":
+				if len(args) != 3 {
+					cmd.HelpFunc()(cmd, args)
+					os.Exit(1)
+				}
+				errors.CheckError(inspectNode(cmd.Context(), os.Stdout, args[1], args[2], output))
+				return
+			case args[0] == "set":
+			case len(args) == 2:
+				errors.CheckError(inspectNode(cmd.Context(), os.Stdout, args[0], args[1], output))
+				return
+			default:
 				log.Fatalf("unknown action '%s'", args[0])
 			}
 
@@ -93,5 +144,228 @@ func NewNodeCommand() *cobra.Command {
 	command.Flags().StringVar(&setArgs.phase, "phase", "", "Phase to set the node to, eg: --phase Succeeded")
 	command.Flags().StringArrayVarP(&setArgs.outputParameters, "output-parameter", "p", []string{}, "Set a \"supplied\" output parameter of node, eg: --output-parameter parameter-name=\"Hello, world!\"")
 	command.Flags().StringVarP(&setArgs.message, "message", "m", "", "Set the message of a node, eg: --message \"Hello, world!\"")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format of inspect. One of: json|yaml")
 	return command
 }
+
+// nodeInspection is everything about a node that inspect displays
+type nodeInspection struct {
+	Node wfv1.NodeStatus `json:"node"`
+	// Retries are the attempts of the retry node, if the node is one, or is one of its attempts
+	Retries []wfv1.NodeStatus `json:"retries,omitempty"`
+	PodName string            `json:"podName,omitempty"`
+	PodSpec *corev1.PodSpec   `json:"podSpec,omitempty"`
+	// PodError is why the pod spec could not be got, e.g. the pod has been deleted
+	PodError string `json:"podError,omitempty"`
+}
+
+func inspectNode(ctx context.Context, out io.Writer, workflowName, nodePattern, output string) error {
+	switch output {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	ctx, apiClient := client.NewAPIClient(ctx)
+	serviceClient := apiClient.NewWorkflowServiceClient()
+	wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: workflowName, Namespace: client.Namespace()})
+	if err != nil {
+		return err
+	}
+	node, err := findNode(wf, nodePattern)
+	if err != nil {
+		return err
+	}
+	inspection := newNodeInspection(wf, node)
+	if node.Type == wfv1.NodeTypePod {
+		pod, err := serviceClient.GetWorkflowNodePod(ctx, &workflowpkg.WorkflowNodePodRequest{Name: wf.Name, Namespace: wf.Namespace, NodeId: node.ID})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			inspection.PodError = "the pod was not found, it may have been deleted"
+		case err != nil:
+			inspection.PodError = err.Error()
+		default:
+			inspection.PodSpec = &pod.Spec
+		}
+	}
+	return printNodeInspection(out, inspection, output)
+}
+
+// findNode returns the node of the workflow with the ID, or the only node whose name or display name matches the
+// pattern
+func findNode(wf *wfv1.Workflow, pattern string) (wfv1.NodeStatus, error) {
+	if node, ok := wf.Status.Nodes[pattern]; ok {
+		return node, nil
+	}
+	var ids []string
+	for id, node := range wf.Status.Nodes {
+		if nodeMatches(pattern, node) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	switch len(ids) {
+	case 0:
+		return wfv1.NodeStatus{}, fmt.Errorf("workflow %s has no node %q", wf.Name, pattern)
+	case 1:
+		return wf.Status.Nodes[ids[0]], nil
+	default:
+		return wfv1.NodeStatus{}, fmt.Errorf("%q matches %d nodes of workflow %s, use one of their IDs: %s", pattern, len(ids), wf.Name, strings.Join(ids, ", "))
+	}
+}
+
+func newNodeInspection(wf *wfv1.Workflow, node wfv1.NodeStatus) *nodeInspection {
+	inspection := &nodeInspection{Node: node}
+	if node.Type == wfv1.NodeTypePod {
+		inspection.PodName = util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf))
+	}
+	retryNode := node
+	if node.Type != wfv1.NodeTypeRetry {
+		retryNode = wfv1.NodeStatus{}
+		for _, n := range wf.Status.Nodes {
+			if n.Type == wfv1.NodeTypeRetry && containsString(n.Children, node.ID) {
+				retryNode = n
+				break
+			}
+		}
+	}
+	// the children of a retry node are its attempts, in order
+	for _, id := range retryNode.Children {
+		if attempt, ok := wf.Status.Nodes[id]; ok {
+			inspection.Retries = append(inspection.Retries, attempt)
+		}
+	}
+	return inspection
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func printNodeInspection(out io.Writer, inspection *nodeInspection, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(inspection, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(inspection)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	const fmtStr = "%-20s %v\n"
+	node := inspection.Node
+	_, _ = fmt.Fprintf(out, fmtStr, "ID:", node.ID)
+	_, _ = fmt.Fprintf(out, fmtStr, "Name:", node.Name)
+	_, _ = fmt.Fprintf(out, fmtStr, "Display Name:", node.DisplayName)
+	_, _ = fmt.Fprintf(out, fmtStr, "Type:", node.Type)
+	if node.TemplateRef != nil {
+		_, _ = fmt.Fprintf(out, fmtStr, "Template:", node.TemplateRef.Name+"/"+node.TemplateRef.Template)
+	} else if node.TemplateName != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "Template:", node.TemplateName)
+	}
+	_, _ = fmt.Fprintf(out, fmtStr, "Status:", node.Phase)
+	if node.Message != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "Message:", node.Message)
+	}
+	if inspection.PodName != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "Pod Name:", inspection.PodName)
+	}
+	if node.HostNodeName != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "Host:", node.HostNodeName)
+	}
+	if !node.StartedAt.IsZero() {
+		_, _ = fmt.Fprintf(out, fmtStr, "Started:", humanize.Timestamp(node.StartedAt.Time))
+	}
+	if !node.FinishedAt.IsZero() {
+		_, _ = fmt.Fprintf(out, fmtStr, "Finished:", humanize.Timestamp(node.FinishedAt.Time))
+	}
+	if !node.StartedAt.IsZero() {
+		_, _ = fmt.Fprintf(out, fmtStr, "Duration:", humanize.RelativeDuration(node.StartedAt.Time, node.FinishedAt.Time))
+	}
+	if node.Progress != "" {
+		_, _ = fmt.Fprintf(out, fmtStr, "Progress:", node.Progress)
+	}
+	if !node.ResourcesDuration.IsZero() {
+		_, _ = fmt.Fprintf(out, fmtStr, "ResourcesDuration:", node.ResourcesDuration)
+	}
+	if node.EstimatedCost != nil {
+		_, _ = fmt.Fprintf(out, fmtStr, "EstimatedCost:", node.EstimatedCost.Value)
+	}
+	if node.Inputs != nil {
+		printNodeParameters(out, fmtStr, "Input Parameters:", node.Inputs.Parameters)
+		printNodeArtifacts(out, fmtStr, "Input Artifacts:", node.Inputs.Artifacts)
+	}
+	if outputs := node.Outputs; outputs != nil {
+		printNodeParameters(out, fmtStr, "Output Parameters:", outputs.Parameters)
+		printNodeArtifacts(out, fmtStr, "Output Artifacts:", outputs.Artifacts)
+		if outputs.Result != nil {
+			_, _ = fmt.Fprintf(out, fmtStr, "Result:", *outputs.Result)
+		}
+		if outputs.ExitCode != nil {
+			_, _ = fmt.Fprintf(out, fmtStr, "Exit Code:", *outputs.ExitCode)
+		}
+	}
+	if len(inspection.Retries) > 0 {
+		_, _ = fmt.Fprintln(out)
+		_, _ = fmt.Fprintln(out, "Retries:")
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "  ATTEMPT\tID\tSTATUS\tDURATION\tMESSAGE")
+		for i, attempt := range inspection.Retries {
+			_, _ = fmt.Fprintf(w, "  %d\t%s\t%s\t%s\t%s\n", i, attempt.ID, attempt.Phase, humanize.RelativeDurationShort(attempt.StartedAt.Time, attempt.FinishedAt.Time), attempt.Message)
+		}
+		_ = w.Flush()
+	}
+	if inspection.PodSpec != nil {
+		data, err := yaml.Marshal(inspection.PodSpec)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out)
+		_, _ = fmt.Fprintln(out, "Pod Spec:")
+		for _, line := range strings.SplitAfter(strings.TrimSuffix(string(data), "\n"), "\n") {
+			_, _ = fmt.Fprint(out, "  "+line)
+		}
+		_, _ = fmt.Fprintln(out)
+	} else if inspection.PodError != "" {
+		_, _ = fmt.Fprintln(out)
+		_, _ = fmt.Fprintf(out, fmtStr, "Pod Spec:", inspection.PodError)
+	}
+	return nil
+}
+
+func printNodeParameters(out io.Writer, fmtStr, title string, parameters []wfv1.Parameter) {
+	if len(parameters) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(out, fmtStr, title, "")
+	for _, param := range parameters {
+		if param.HasValue() {
+			_, _ = fmt.Fprintf(out, fmtStr, "  "+param.Name+":", param.GetValue())
+		}
+	}
+}
+
+func printNodeArtifacts(out io.Writer, fmtStr, title string, artifacts wfv1.Artifacts) {
+	if len(artifacts) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(out, fmtStr, title, "")
+	for _, art := range artifacts {
+		location := ""
+		if key, err := art.GetKey(); err == nil {
+			location = key
+		}
+		_, _ = fmt.Fprintf(out, fmtStr, "  "+art.Name+":", location)
+	}
+}
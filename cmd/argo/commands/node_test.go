@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func nodeTestWorkflow() *wfv1.Workflow {
+	started := metav1.NewTime(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(time.Minute))
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "argo"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", Name: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeSteps, Children: []string{"my-wf-1"}},
+			"my-wf-1": {ID: "my-wf-1", Name: "my-wf[0].step", DisplayName: "step", Type: wfv1.NodeTypeRetry, Children: []string{"my-wf-2", "my-wf-3"}},
+			"my-wf-2": {ID: "my-wf-2", Name: "my-wf[0].step(0)", DisplayName: "step(0)", Type: wfv1.NodeTypePod, TemplateName: "main", Phase: wfv1.NodeFailed, Message: "Error (exit code 1)", StartedAt: started, FinishedAt: finished},
+			"my-wf-3": {
+				ID:                "my-wf-3",
+				Name:              "my-wf[0].step(1)",
+				DisplayName:       "step(1)",
+				Type:              wfv1.NodeTypePod,
+				TemplateName:      "main",
+				Phase:             wfv1.NodeSucceeded,
+				StartedAt:         started,
+				FinishedAt:        finished,
+				HostNodeName:      "my-host",
+				ResourcesDuration: wfv1.ResourcesDuration{corev1.ResourceCPU: wfv1.NewResourceDuration(30 * time.Second)},
+				Inputs:            &wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+				Outputs:           &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("world")}}, ExitCode: pointerString("0")},
+			},
+		}},
+	}
+}
+
+func pointerString(s string) *string { return &s }
+
+func Test_findNode(t *testing.T) {
+	wf := nodeTestWorkflow()
+	for pattern, id := range map[string]string{"my-wf-3": "my-wf-3", "step(1)": "my-wf-3", "my-wf[0].step": "my-wf-1", "step": "my-wf-1"} {
+		node, err := findNode(wf, pattern)
+		if assert.NoError(t, err, pattern) {
+			assert.Equal(t, id, node.ID, pattern)
+		}
+	}
+	_, err := findNode(wf, "step(*)")
+	assert.EqualError(t, err, `"step(*)" matches 2 nodes of workflow my-wf, use one of their IDs: my-wf-2, my-wf-3`)
+	_, err = findNode(wf, "other")
+	assert.EqualError(t, err, `workflow my-wf has no node "other"`)
+}
+
+func Test_newNodeInspection(t *testing.T) {
+	wf := nodeTestWorkflow()
+	t.Run("Attempt", func(t *testing.T) {
+		inspection := newNodeInspection(wf, wf.Status.Nodes["my-wf-3"])
+		assert.Equal(t, "my-wf-3", inspection.PodName)
+		if assert.Len(t, inspection.Retries, 2) {
+			assert.Equal(t, "my-wf-2", inspection.Retries[0].ID)
+			assert.Equal(t, "my-wf-3", inspection.Retries[1].ID)
+		}
+	})
+	t.Run("Retry", func(t *testing.T) {
+		inspection := newNodeInspection(wf, wf.Status.Nodes["my-wf-1"])
+		assert.Empty(t, inspection.PodName)
+		assert.Len(t, inspection.Retries, 2)
+	})
+	t.Run("NoRetries", func(t *testing.T) {
+		inspection := newNodeInspection(wf, wf.Status.Nodes["my-wf"])
+		assert.Empty(t, inspection.Retries)
+	})
+}
+
+func Test_printNodeInspection(t *testing.T) {
+	wf := nodeTestWorkflow()
+	inspection := newNodeInspection(wf, wf.Status.Nodes["my-wf-3"])
+	inspection.PodSpec = &corev1.PodSpec{ServiceAccountName: "my-sa", Containers: []corev1.Container{{Name: "main", Image: "argoproj/argosay:v2"}}}
+	t.Run("Text", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		assert.NoError(t, printNodeInspection(out, inspection, ""))
+		assert.Contains(t, out.String(), `ID:                  my-wf-3
+Name:                my-wf[0].step(1)
+Display Name:        step(1)
+Type:                Pod
+Template:            main
+Status:              Succeeded
+Pod Name:            my-wf-3
+Host:                my-host
+`)
+		assert.Contains(t, out.String(), `Duration:            1 minute 0 seconds
+ResourcesDuration:   30s*(1 cpu)
+Input Parameters:    
+  message:           hello
+Output Parameters:   
+  result:            world
+Exit Code:           0
+
+Retries:
+  ATTEMPT  ID       STATUS     DURATION  MESSAGE
+  0        my-wf-2  Failed     1m        Error (exit code 1)
+  1        my-wf-3  Succeeded  1m        
+
+Pod Spec:
+  containers:
+  - image: argoproj/argosay:v2
+    name: main
+    resources: {}
+  serviceAccountName: my-sa
+`)
+	})
+	t.Run("PodError", func(t *testing.T) {
+		inspection := newNodeInspection(wf, wf.Status.Nodes["my-wf-2"])
+		inspection.PodError = "the pod was not found, it may have been deleted"
+		out := &bytes.Buffer{}
+		assert.NoError(t, printNodeInspection(out, inspection, ""))
+		assert.Contains(t, out.String(), "Pod Spec:            the pod was not found, it may have been deleted\n")
+	})
+	t.Run("JSON", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		assert.NoError(t, printNodeInspection(out, inspection, "json"))
+		assert.Contains(t, out.String(), `"podName": "my-wf-3"`)
+		assert.Contains(t, out.String(), `"serviceAccountName": "my-sa"`)
+	})
+}
@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
+)
+
+func NewInspectCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "inspect PLUGIN...",
+		Short: "display details about executor plugins available to workflows in the namespace",
+		Example: `# Inspect the plugin "hello":
+  argo plugin inspect hello
+
+# Print the plugin "hello" as YAML:
+  argo plugin inspect hello -o yaml
+`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewPluginServiceClient()
+			if err != nil {
+				log.Fatal(err)
+			}
+			namespace := client.Namespace()
+			for _, name := range args {
+				p, err := serviceClient.GetPlugin(ctx, &pluginpkg.GetPluginRequest{Namespace: namespace, Name: name})
+				if err != nil {
+					log.Fatal(err)
+				}
+				printPlugin(os.Stdout, p, output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printPlugin(out io.Writer, p *pluginpkg.InstalledPlugin, outFmt string) {
+	switch outFmt {
+	case "json":
+		data, _ := json.MarshalIndent(p, "", "    ")
+		_, _ = fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, _ := yaml.Marshal(p)
+		_, _ = fmt.Fprint(out, string(data))
+	case "wide", "":
+		const fmtStr = "%-20s %v\n"
+		_, _ = fmt.Fprintf(out, fmtStr, "Name:", p.Name)
+		_, _ = fmt.Fprintf(out, fmtStr, "Namespace:", p.Namespace)
+		_, _ = fmt.Fprintf(out, fmtStr, "Version:", p.Version)
+		if p.Description != "" {
+			_, _ = fmt.Fprintf(out, fmtStr, "Description:", p.Description)
+		}
+		_, _ = fmt.Fprintf(out, fmtStr, "Templates:", strings.Join(p.TemplateKinds, ","))
+		if p.Image != "" {
+			_, _ = fmt.Fprintf(out, fmtStr, "Image:", p.Image)
+		}
+		if h := p.Health; h != nil {
+			_, _ = fmt.Fprintf(out, fmtStr, "Health:", healthSummary(h))
+			if h.Restarts > 0 {
+				_, _ = fmt.Fprintf(out, fmtStr, "Restarts:", h.Restarts)
+			}
+			if h.Message != "" {
+				_, _ = fmt.Fprintf(out, fmtStr, "Message:", h.Message)
+			}
+		}
+	default:
+		log.Fatalf("Unknown output format: %s", outFmt)
+	}
+}
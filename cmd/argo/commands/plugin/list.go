@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
+)
+
+func NewListCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list the executor plugins available to workflows in the namespace",
+		Example: `# List the plugins available to workflows in the current namespace:
+  argo plugin list
+
+# List the plugins available to workflows in the namespace "my-ns":
+  argo plugin list -n my-ns
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewPluginServiceClient()
+			if err != nil {
+				log.Fatal(err)
+			}
+			list, err := serviceClient.ListPlugins(ctx, &pluginpkg.ListPluginsRequest{Namespace: client.Namespace()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			switch output {
+			case "", "wide":
+				printTable(os.Stdout, list.Items)
+			case "name":
+				for _, p := range list.Items {
+					fmt.Println(p.Name)
+				}
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|name")
+	return command
+}
+
+func printTable(out io.Writer, plugins []*pluginpkg.InstalledPlugin) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAME\tNAMESPACE\tVERSION\tTEMPLATES\tHEALTH\n")
+	for _, p := range plugins {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.Name, p.Namespace, p.Version, strings.Join(p.TemplateKinds, ","), healthSummary(p.Health))
+	}
+	_ = w.Flush()
+}
+
+// healthSummary returns the status of the health, and how many of the agents running the plugin are ready, e.g.
+// "Unhealthy (1/2)"
+func healthSummary(h *pluginpkg.InstalledPluginHealth) string {
+	if h == nil {
+		return ""
+	}
+	if h.Agents == 0 {
+		return h.Status
+	}
+	return fmt.Sprintf("%s (%d/%d)", h.Status, h.ReadyAgents, h.Agents)
+}
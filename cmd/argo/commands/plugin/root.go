@@ -0,0 +1,20 @@
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewPluginCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "plugin",
+		Short: "view the executor plugins installed",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewInspectCommand())
+
+	return command
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewRejectCommand() *cobra.Command {
+	var rejectArgs approveOps
+
+	command := &cobra.Command{
+		Use:   "reject WORKFLOW1 WORKFLOW2...",
+		Short: "reject the approval gates of zero or more workflows, failing them",
+		Example: `# Reject the approval gates of a workflow:
+
+  argo reject my-wf --comment "outside the change window"
+
+# Reject one approval gate of a workflow:
+
+  argo reject my-wf --node-field-selector displayName=approve-deploy
+`,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowRunning),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+
+			selector, err := fields.ParseSelector(rejectArgs.nodeFieldSelector)
+			if err != nil {
+				log.Fatalf("Unable to parse node field selector '%s': %s", rejectArgs.nodeFieldSelector, err)
+			}
+
+			for _, wfName := range args {
+				_, err := serviceClient.RejectWorkflow(ctx, &workflowpkg.WorkflowRejectRequest{
+					Name:              wfName,
+					Namespace:         namespace,
+					NodeFieldSelector: selector.String(),
+					Comment:           rejectArgs.comment,
+				})
+				if err != nil {
+					log.Fatalf("Failed to reject %s: %+v", wfName, err)
+				}
+				fmt.Printf("workflow %s rejected\n", wfName)
+			}
+		},
+	}
+	command.Flags().StringVar(&rejectArgs.nodeFieldSelector, "node-field-selector", "", "selector of approval gate to reject, eg: --node-field-selector displayName=approve-deploy")
+	command.Flags().StringVar(&rejectArgs.comment, "comment", "", "the reason for the rejection, recorded on the approval gate")
+	return command
+}
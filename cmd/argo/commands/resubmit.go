@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -68,6 +69,7 @@ func NewResubmitCommand() *cobra.Command {
 
   argo resubmit @latest
 `,
+		ValidArgsFunction: completion.Workflows(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if cmd.Flag("priority").Changed {
 				cliSubmitOpts.priority = &resubmitOpts.priority
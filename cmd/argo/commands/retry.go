@@ -11,16 +11,22 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 type retryOps struct {
-	nodeFieldSelector string // --node-field-selector
-	restartSuccessful bool   // --restart-successful
-	namespace         string // --namespace
-	labelSelector     string // --selector
-	fieldSelector     string // --field-selector
+	nodeFieldSelector string   // --node-field-selector
+	restartSuccessful bool     // --restart-successful
+	namespace         string   // --namespace
+	labelSelector     string   // --selector
+	fieldSelector     string   // --field-selector
+	bulk              bool     // --bulk
+	dryRun            bool     // --dry-run
+	parameters        []string // --parameter
+	restartNodes      []string // --restart-node
+	restartFrom       string   // --restart-from
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -55,6 +61,14 @@ func NewRetryCommand() *cobra.Command {
 
   argo retry --field-selector metadata.namespace=argo
 
+# Retry all failed workflows with a label in a single request to the server:
+
+  argo retry --bulk -l workflows.argoproj.io/test=true --field-selector status.phase=Failed
+
+# Print the workflows that would be retried, without retrying them:
+
+  argo retry --dry-run -l workflows.argoproj.io/test=true --field-selector status.phase=Failed
+
 # Retry and wait for completion:
 
   argo retry --wait my-wf.yaml
@@ -67,10 +81,23 @@ func NewRetryCommand() *cobra.Command {
 
   argo retry --log my-wf.yaml
 
+# Retry a workflow with a different value for a parameter:
+
+  argo retry my-wf -p message=goodbye
+
+# Retry a workflow, restarting a step that succeeded, and its children:
+
+  argo retry my-wf --restart-node my-wf.build
+
+# Restart a workflow, which may have succeeded, from a step onward:
+
+  argo retry my-wf --restart-from test
+
 # Retry the latest workflow:
 
   argo retry @latest
 `,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowFailed, wfv1.WorkflowError),
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 && !retryOpts.hasSelector() {
 				cmd.HelpFunc()(cmd, args)
@@ -92,6 +119,12 @@ func NewRetryCommand() *cobra.Command {
 	command.Flags().StringVar(&retryOpts.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
 	command.Flags().StringVarP(&retryOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&retryOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
+	command.Flags().BoolVar(&retryOpts.bulk, "bulk", false, bulkUsage)
+	command.Flags().BoolVar(&retryOpts.dryRun, "dry-run", false, "Do not retry the workflows, only print those that would be retried")
+	command.Flags().StringArrayVarP(&retryOpts.parameters, "parameter", "p", []string{}, "override a workflow argument parameter, e.g. -p message=goodbye")
+	command.Flags().StringArrayVar(&retryOpts.restartNodes, "restart-node", []string{}, "name, or glob pattern, of a node to restart, with its children, even if it succeeded")
+	command.Flags().StringVar(&retryOpts.restartFrom, "restart-from", "", "name or display name of a node to restart the workflow from, restarting it and every node after it, even if the workflow succeeded")
+	errors.CheckError(command.RegisterFlagCompletionFunc("restart-from", completion.Nodes))
 	return command
 }
 
@@ -101,6 +134,18 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 	if err != nil {
 		return fmt.Errorf("unable to parse node field selector '%s': %s", retryOpts.nodeFieldSelector, err)
 	}
+	if retryOpts.bulk && !retryOpts.dryRun {
+		return bulkWorkflows(ctx, serviceClient, &workflowpkg.WorkflowBulkRequest{
+			Namespace:         retryOpts.namespace,
+			Operation:         "retry",
+			Names:             args,
+			RestartSuccessful: retryOpts.restartSuccessful,
+			NodeFieldSelector: selector.String(),
+			Parameters:        retryOpts.parameters,
+			RestartNodes:      retryOpts.restartNodes,
+			RestartFrom:       retryOpts.restartFrom,
+		}, retryOpts.labelSelector, retryOpts.fieldSelector, "retried")
+	}
 	var wfs wfv1.Workflows
 	if retryOpts.hasSelector() {
 		wfs, err = listWorkflows(ctx, serviceClient, listFlags{
@@ -122,6 +167,10 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 		})
 	}
 
+	if retryOpts.dryRun {
+		return dryRunWorkflows(ctx, serviceClient, os.Stdout, wfs, "retried")
+	}
+
 	var lastRetried *wfv1.Workflow
 	retriedNames := make(map[string]bool)
 	for _, wf := range wfs {
@@ -136,6 +185,9 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 			Namespace:         wf.Namespace,
 			RestartSuccessful: retryOpts.restartSuccessful,
 			NodeFieldSelector: selector.String(),
+			Parameters:        retryOpts.parameters,
+			RestartNodes:      retryOpts.restartNodes,
+			RestartFrom:       retryOpts.restartFrom,
 		})
 		if err != nil {
 			return err
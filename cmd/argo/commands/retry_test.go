@@ -15,6 +15,21 @@ import (
 )
 
 func Test_retryWorkflows(t *testing.T) {
+	t.Run("Retry workflow dry-run", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		retryOpts := retryOps{
+			namespace: "argo",
+			dryRun:    true,
+		}
+
+		c.On("GetWorkflow", mock.Anything, mock.Anything).Return(&wfv1.Workflow{}, nil)
+
+		err := retryWorkflows(context.Background(), c, retryOpts, cliSubmitOpts{}, []string{"foo", "bar"})
+		c.AssertNotCalled(t, "RetryWorkflow")
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("Retry workflow by names", func(t *testing.T) {
 		c := &workflowmocks.WorkflowServiceClient{}
 		retryOpts := retryOps{
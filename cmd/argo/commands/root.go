@@ -9,9 +9,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/archive"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/executorplugin"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/plugin"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 )
@@ -82,18 +84,33 @@ Use the same configuration as GRPC mode, but also set:
 If your server is behind an ingress with a path (you'll be running "argo server --basehref /...) or "BASE_HREF=/... argo server"):
 
 	ARGO_BASE_HREF=/argo
+
+# Kube Config Contexts
+
+If ARGO_SERVER is not set, the CLI uses the Argo Server of the current kube config context, from the file ARGO_CONFIG, which defaults to argo/config.yaml in your user config directory (e.g. ~/.config/argo/config.yaml). The CLI uses the Kubernetes API for a context that is not in the file:
+
+	contexts:
+	  my-cluster:
+	    argoServer: argo.example.com:443
+	    baseHref: /argo
+	    http1: true
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.HelpFunc()(cmd, args)
 		},
 	}
 
+	command.AddCommand(NewApproveCommand())
 	command.AddCommand(NewCompletionCommand())
+	command.AddCommand(NewCpCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewDiffCommand())
+	command.AddCommand(NewExplainCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewLogsCommand())
+	command.AddCommand(NewRejectCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewResumeCommand())
 	command.AddCommand(NewRetryCommand())
@@ -106,14 +123,20 @@ If your server is behind an ingress with a path (you'll be running "argo server
 	command.AddCommand(NewStopCommand())
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
+	command.AddCommand(NewTimelineCommand())
+	command.AddCommand(NewTopCommand())
 	command.AddCommand(archive.NewArchiveCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
 	command.AddCommand(clustertemplate.NewClusterTemplateCommand())
 	command.AddCommand(executorplugin.NewRootCommand())
+	command.AddCommand(plugin.NewPluginCommand())
 
 	client.AddKubectlFlagsToCmd(command)
+	if err := command.RegisterFlagCompletionFunc("namespace", completion.Namespaces); err != nil {
+		log.Fatal(err)
+	}
 	client.AddAPIClientFlagsToCmd(command)
 	// global log level
 	var logLevel string
@@ -32,9 +32,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	"github.com/argoproj/argo-workflows/v3/util/cmd"
+	diagnosticsutil "github.com/argoproj/argo-workflows/v3/util/diagnostics"
 	"github.com/argoproj/argo-workflows/v3/util/help"
 	pprofutil "github.com/argoproj/argo-workflows/v3/util/pprof"
 	tlsutils "github.com/argoproj/argo-workflows/v3/util/tls"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 )
 
 func NewServerCommand() *cobra.Command {
@@ -53,9 +55,13 @@ func NewServerCommand() *cobra.Command {
 		eventOperationQueueSize  int
 		eventWorkerCount         int
 		eventAsyncDispatch       bool
+		readOnly                 bool
 		frameOptions             string
 		accessControlAllowOrigin string
 		logFormat                string // --log-format
+		pprof                    bool   // --pprof
+		diagnostics              bool   // --diagnostics
+		debugPort                int    // --debug-port
 	)
 
 	command := cobra.Command{
@@ -68,6 +74,12 @@ See %s`, help.ArgoServer),
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
 			pprofutil.Init()
+			if pprof {
+				pprofutil.Enable()
+			}
+			if diagnostics {
+				diagnosticsutil.Enable()
+			}
 
 			config, err := client.GetConfig().ClientConfig()
 			if err != nil {
@@ -89,6 +101,12 @@ See %s`, help.ArgoServer),
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
+			shutdownTracing, err := tracing.Init(ctx, "argo-server")
+			if err != nil {
+				return err
+			}
+			defer func() { _ = shutdownTracing(context.Background()) }()
+
 			if !namespaced && managedNamespace != "" {
 				log.Warn("ignoring --managed-namespace because --namespaced is false")
 				managedNamespace = ""
@@ -103,6 +121,7 @@ See %s`, help.ArgoServer),
 				"managedNamespace": managedNamespace,
 				"baseHRef":         baseHRef,
 				"secure":           secure,
+				"readOnly":         readOnly,
 			}).Info()
 
 			var tlsConfig *tls.Config
@@ -173,6 +192,7 @@ See %s`, help.ArgoServer),
 				EventOperationQueueSize:  eventOperationQueueSize,
 				EventWorkerCount:         eventWorkerCount,
 				EventAsyncDispatch:       eventAsyncDispatch,
+				ReadOnly:                 readOnly,
 				XFrameOptions:            frameOptions,
 				AccessControlAllowOrigin: accessControlAllowOrigin,
 			}
@@ -193,14 +213,17 @@ See %s`, help.ArgoServer),
 			}
 
 			// disabled by default, for security
-			if x, enabled := os.LookupEnv("ARGO_SERVER_PPROF"); enabled {
-				port, err := strconv.Atoi(x)
+			x, enabled := os.LookupEnv("ARGO_SERVER_PPROF")
+			if enabled {
+				debugPort, err = strconv.Atoi(x)
 				if err != nil {
 					return err
 				}
+			}
+			if enabled || pprof || diagnostics {
 				go func() {
-					log.Infof("starting server for pprof on :%d, see https://golang.org/pkg/net/http/pprof/", port)
-					log.Println(http.ListenAndServe(fmt.Sprintf(":%d", port), nil))
+					log.Infof("starting server for pprof and diagnostics on :%d, see https://golang.org/pkg/net/http/pprof/", debugPort)
+					log.Println(http.ListenAndServe(fmt.Sprintf(":%d", debugPort), nil))
 				}()
 			}
 
@@ -220,7 +243,7 @@ See %s`, help.ArgoServer),
 	command.Flags().BoolVarP(&secure, "secure", "e", true, "Whether or not we should listen on TLS.")
 	command.Flags().StringVar(&tlsCertificateSecretName, "tls-certificate-secret-name", "", "The name of a Kubernetes secret that contains the server certificates")
 	command.Flags().BoolVar(&htst, "hsts", true, "Whether or not we should add a HTTP Secure Transport Security header. This only has effect if secure is enabled.")
-	command.Flags().StringArrayVar(&authModes, "auth-mode", []string{"client"}, "API server authentication mode. Any 1 or more length permutation of: client,server,sso")
+	command.Flags().StringArrayVar(&authModes, "auth-mode", []string{"client"}, "API server authentication mode. Any 1 or more length permutation of: client,server,sso,api-token")
 	command.Flags().StringVar(&configMap, "configmap", "workflow-controller-configmap", "Name of K8s configmap to retrieve workflow controller configuration")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that watches, default to the installation namespace")
@@ -229,9 +252,13 @@ See %s`, help.ArgoServer),
 	command.Flags().IntVar(&eventOperationQueueSize, "event-operation-queue-size", 16, "how many events operations that can be queued at once")
 	command.Flags().IntVar(&eventWorkerCount, "event-worker-count", 4, "how many event workers to run")
 	command.Flags().BoolVar(&eventAsyncDispatch, "event-async-dispatch", false, "dispatch event async")
+	command.Flags().BoolVar(&readOnly, "read-only", false, "Deny every API call that changes something, e.g. submitting or deleting workflows. Listing and getting resources, logs, and artifacts still work.")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", "DENY", "Set X-Frame-Options header in HTTP responses.")
 	command.Flags().StringVar(&accessControlAllowOrigin, "access-control-allow-origin", "", "Set Access-Control-Allow-Origin header in HTTP responses.")
 	command.Flags().StringVar(&logFormat, "log-format", "text", "The formatter to use for logs. One of: text|json")
+	command.Flags().BoolVar(&pprof, "pprof", false, "Serve pprof endpoints, at /debug/pprof, on the debug port.")
+	command.Flags().BoolVar(&diagnostics, "diagnostics", false, "Serve a dump of the server's caches and queues, at /diagnostics, on the debug port.")
+	command.Flags().IntVar(&debugPort, "debug-port", 6060, "Port to serve the pprof and diagnostics endpoints on.")
 
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("ARGO")
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -22,6 +23,7 @@ type stopOps struct {
 	labelSelector     string // --selector
 	fieldSelector     string // --field-selector
 	dryRun            bool   // --dry-run
+	bulk              bool   // --bulk
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -53,7 +55,16 @@ func NewStopCommand() *cobra.Command {
 # Stop multiple workflows by field selector
 
   argo stop --field-selector metadata.namespace=argo
+
+# Stop all running workflows with a label in a single request to the server
+
+  argo stop --bulk -l workflows.argoproj.io/test=true --field-selector status.phase=Running
+
+# Print the workflows that would be stopped, without stopping them
+
+  argo stop --dry-run -l workflows.argoproj.io/test=true --field-selector status.phase=Running
 `,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowRunning, wfv1.WorkflowPending),
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 && !stopArgs.hasSelector() {
 				cmd.HelpFunc()(cmd, args)
@@ -71,7 +82,8 @@ func NewStopCommand() *cobra.Command {
 	command.Flags().StringVar(&stopArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to stop, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
 	command.Flags().StringVarP(&stopArgs.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&stopArgs.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
-	command.Flags().BoolVar(&stopArgs.dryRun, "dry-run", false, "If true, only stop the workflows that would be stopped, without stopping them.")
+	command.Flags().BoolVar(&stopArgs.dryRun, "dry-run", false, "If true, only print the workflows that would be stopped, without stopping them.")
+	command.Flags().BoolVar(&stopArgs.bulk, "bulk", false, bulkUsage)
 	return command
 }
 
@@ -81,6 +93,15 @@ func stopWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 	if err != nil {
 		return fmt.Errorf("unable to parse node field selector '%s': %s", stopArgs.nodeFieldSelector, err)
 	}
+	if stopArgs.bulk && !stopArgs.dryRun {
+		return bulkWorkflows(ctx, serviceClient, &workflowpkg.WorkflowBulkRequest{
+			Namespace:         stopArgs.namespace,
+			Operation:         "stop",
+			Names:             args,
+			NodeFieldSelector: selector.String(),
+			Message:           stopArgs.message,
+		}, stopArgs.labelSelector, stopArgs.fieldSelector, "stopped")
+	}
 	var wfs wfv1.Workflows
 	if stopArgs.hasSelector() {
 		wfs, err = listWorkflows(ctx, serviceClient, listFlags{
@@ -102,6 +123,10 @@ func stopWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 		})
 	}
 
+	if stopArgs.dryRun {
+		return dryRunWorkflows(ctx, serviceClient, os.Stdout, wfs, "stopped")
+	}
+
 	stoppedNames := make(map[string]bool)
 	for _, wf := range wfs {
 		if _, ok := stoppedNames[wf.Name]; ok {
@@ -110,10 +135,6 @@ func stopWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 		}
 		stoppedNames[wf.Name] = true
 
-		if stopArgs.dryRun {
-			fmt.Printf("workflow %s stopped (dry-run)\n", wf.Name)
-			continue
-		}
 		wf, err := serviceClient.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{
 			Name:              wf.Name,
 			Namespace:         wf.Namespace,
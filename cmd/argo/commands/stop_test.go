@@ -21,6 +21,8 @@ func Test_stopWorkflows(t *testing.T) {
 			dryRun: true,
 		}
 
+		c.On("GetWorkflow", mock.Anything, mock.Anything).Return(&wfv1.Workflow{}, nil)
+
 		err := stopWorkflows(context.Background(), c, stopArgs, []string{"foo", "bar"})
 		c.AssertNotCalled(t, "StopWorkflow")
 
@@ -120,4 +122,43 @@ func Test_stopWorkflows(t *testing.T) {
 		err := stopWorkflows(context.Background(), c, stopArgs, []string{"foo"})
 		assert.Errorf(t, err, "mock error")
 	})
+	t.Run("Stop workflow in bulk", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		stopArgs := stopOps{
+			namespace:     "argo",
+			labelSelector: "custom-label=true",
+			message:       "incident",
+			bulk:          true,
+		}
+		bulkReq := &workflowpkg.WorkflowBulkRequest{
+			Namespace:   "argo",
+			Operation:   "stop",
+			Names:       []string{"foo"},
+			ListOptions: &metav1.ListOptions{LabelSelector: "custom-label=true"},
+			Message:     "incident",
+		}
+		c.On("BulkWorkflows", mock.Anything, bulkReq).Return(&workflowpkg.WorkflowBulkResponse{Results: []*workflowpkg.WorkflowBulkResult{
+			{Name: "bar", Phase: "Running"},
+			{Name: "foo", Error: "mock error"},
+		}}, nil)
+		err := stopWorkflows(context.Background(), c, stopArgs, []string{"foo"})
+		c.AssertNotCalled(t, "StopWorkflow")
+		assert.EqualError(t, err, "1 of 2 workflows failed to be stopped")
+	})
+	t.Run("Stop workflow in bulk dry-run", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		stopArgs := stopOps{
+			namespace:     "argo",
+			labelSelector: "custom-label=true",
+			dryRun:        true,
+			bulk:          true,
+		}
+		c.On("ListWorkflows", mock.Anything, mock.Anything).Return(&wfv1.WorkflowList{Items: wfv1.Workflows{
+			{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "argo"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}},
+		}}, nil)
+		err := stopWorkflows(context.Background(), c, stopArgs, []string{})
+		c.AssertNotCalled(t, "BulkWorkflows")
+		c.AssertNotCalled(t, "StopWorkflow")
+		assert.NoError(t, err)
+	})
 }
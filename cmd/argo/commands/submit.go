@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -15,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -25,6 +29,7 @@ import (
 type cliSubmitOpts struct {
 	output        string // --output
 	wait          bool   // --wait
+	waitOpts      waitOpts
 	watch         bool   // --watch
 	log           bool   // --log
 	strict        bool   // --strict
@@ -51,10 +56,18 @@ func NewSubmitCommand() *cobra.Command {
 
   argo submit --wait my-wf.yaml
 
+# Submit and wait for completion for up to an hour, exiting with 2 if the workflow fails:
+
+  argo submit --wait my-wf.yaml --wait-timeout 1h --exit-code Failed=2
+
 # Submit and watch until completion:
 
   argo submit --watch my-wf.yaml
 
+# Submit and watch until completion, collapsing completed branches:
+
+  argo submit --watch --tree my-wf.yaml
+
 # Submit and tail logs until completion:
 
   argo submit --log my-wf.yaml
@@ -62,7 +75,20 @@ func NewSubmitCommand() *cobra.Command {
 # Submit a single workflow from an existing resource
 
   argo submit --from cronwf/my-cron-wf
+
+# Submit a workflow with the parameters in a YAML or JSON file, where nested values are passed as JSON:
+
+  argo submit my-wf.yaml --parameter-file values.yaml
+
+# Submit a workflow with the parameters and labels of a preset, overriding one of its parameters:
+
+  argo submit my-wf.yaml --preset nightly-eu -p region=eu-west-2
+
+# Submit each workflow from stdin, which may be YAML documents, or JSON documents one after another:
+
+  generate-workflows | argo submit -
 `,
+		ValidArgsFunction: completion.ManifestFiles,
 		Run: func(cmd *cobra.Command, args []string) {
 			if cmd.Flag("priority").Changed {
 				cliSubmitOpts.priority = &priority
@@ -71,6 +97,9 @@ func NewSubmitCommand() *cobra.Command {
 			if !cliSubmitOpts.watch && len(cliSubmitOpts.getArgs.status) > 0 {
 				log.Warn("--status should only be used with --watch")
 			}
+			if !cliSubmitOpts.watch && cliSubmitOpts.getArgs.tree {
+				log.Warn("--tree should only be used with --watch")
+			}
 
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient := apiClient.NewWorkflowServiceClient()
@@ -89,13 +118,16 @@ func NewSubmitCommand() *cobra.Command {
 	util.PopulateSubmitOpts(command, &submitOpts, true)
 	command.Flags().StringVarP(&cliSubmitOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
 	command.Flags().BoolVarP(&cliSubmitOpts.wait, "wait", "w", false, "wait for the workflow to complete")
+	addWaitFlags(command, &cliSubmitOpts.waitOpts, "wait-timeout", "wait-output")
 	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow until it completes")
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&cliSubmitOpts.strict, "strict", true, "perform strict workflow validation")
 	command.Flags().Int32Var(&priority, "priority", 0, "workflow priority")
+	command.Flags().StringVar(&submitOpts.Preset, "preset", "", "submit with the parameters and labels of the preset, a config map in the namespace with the label workflows.argoproj.io/configmap-type: ParameterPreset, which those passed take precedence over")
 	command.Flags().StringVar(&from, "from", "", "Submit from an existing `kind/name` E.g., --from=cronwf/hello-world-cwf")
 	command.Flags().StringVar(&cliSubmitOpts.getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error). Should only be used with --watch.")
 	command.Flags().StringVar(&cliSubmitOpts.getArgs.nodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().BoolVar(&cliSubmitOpts.getArgs.tree, "tree", false, "collapse the completed branches of the workflow, and display the estimated time left of each running node. Should only be used with --watch.")
 	command.Flags().StringVar(&cliSubmitOpts.scheduledTime, "scheduled-time", "", "Override the workflow's scheduledTime parameter (useful for backfilling). The time must be RFC3339")
 
 	// Only complete files with appropriate extension.
@@ -202,35 +234,106 @@ func submitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServ
 		os.Exit(1)
 	}
 
-	var workflowNames []string
+	workflowNames, err := createWorkflows(ctx, serviceClient, namespace, workflows, submitOpts, cliOpts, os.Stderr)
+	if err != nil && len(workflows) == 1 {
+		log.Fatalf("Failed to submit workflow: %v", err)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	waitWatchOrLog(ctx, serviceClient, namespace, workflowNames, *cliOpts)
+}
 
-	for _, wf := range workflows {
-		if wf.Namespace == "" {
-			// This is here to avoid passing an empty namespace when using --server-dry-run
-			wf.Namespace = namespace
+// createWorkflows creates the workflows, and returns the names of those created. If there is more than one, the
+// progress of each is reported, and one that fails does not stop the rest being created, but an error is returned.
+func createWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts, progress io.Writer) ([]string, error) {
+	var workflowNames []string
+	failed := 0
+	for i, wf := range workflows {
+		created, err := createWorkflow(ctx, serviceClient, namespace, wf, submitOpts, cliOpts)
+		if err != nil {
+			if len(workflows) == 1 {
+				return nil, err
+			}
+			failed++
+			_, _ = fmt.Fprintf(progress, "[%d/%d] Failed to submit workflow %s: %v\n", i+1, len(workflows), workflowDescription(wf), err)
+			continue
 		}
-		err := util.ApplySubmitOpts(&wf, submitOpts)
-		errors.CheckError(err)
-		wf.Spec.Priority = cliOpts.priority
-		options := &metav1.CreateOptions{}
-		if submitOpts.DryRun {
-			options.DryRun = []string{"All"}
+		if len(workflows) > 1 {
+			_, _ = fmt.Fprintf(progress, "[%d/%d] Workflow %s submitted\n", i+1, len(workflows), created.Name)
 		}
-		created, err := serviceClient.CreateWorkflow(ctx, &workflowpkg.WorkflowCreateRequest{
-			Namespace:     wf.Namespace,
-			Workflow:      &wf,
-			ServerDryRun:  submitOpts.ServerDryRun,
-			CreateOptions: options,
-		})
+		printWorkflow(created, getFlags{output: cliOpts.output, status: cliOpts.getArgs.status})
+		workflowNames = append(workflowNames, created.Name)
+	}
+	if len(workflows) > 1 {
+		_, _ = fmt.Fprintf(progress, "%d of %d workflows submitted, %d failed\n", len(workflowNames), len(workflows), failed)
+	}
+	if failed > 0 {
+		return workflowNames, fmt.Errorf("%d of %d workflows failed to be submitted", failed, len(workflows))
+	}
+	return workflowNames, nil
+}
+
+func createWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, wf wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *cliSubmitOpts) (*wfv1.Workflow, error) {
+	if wf.Namespace == "" {
+		// This is here to avoid passing an empty namespace when using --server-dry-run
+		wf.Namespace = namespace
+	}
+	if err := util.ApplySubmitOpts(&wf, submitOpts); err != nil {
+		return nil, err
+	}
+	wf.Spec.Priority = cliOpts.priority
+	options := &metav1.CreateOptions{}
+	if submitOpts.DryRun {
+		options.DryRun = []string{"All"}
+	}
+	req := &workflowpkg.WorkflowCreateRequest{
+		Namespace:     wf.Namespace,
+		Workflow:      &wf,
+		ServerDryRun:  submitOpts.ServerDryRun,
+		CreateOptions: options,
+	}
+	if submitOpts.Preset != "" {
+		// the server resolves the preset, and so is passed the parameters and labels that take precedence over it
+		parameters, err := presetParameters(submitOpts)
 		if err != nil {
-			log.Fatalf("Failed to submit workflow: %v", err)
+			return nil, err
 		}
+		req.SubmitOptions = &wfv1.SubmitOpts{Preset: submitOpts.Preset, Parameters: parameters, Labels: submitOpts.Labels}
+	}
+	return serviceClient.CreateWorkflow(ctx, req)
+}
 
-		printWorkflow(created, getFlags{output: cliOpts.output, status: cliOpts.getArgs.status})
-		workflowNames = append(workflowNames, created.Name)
+// presetParameters returns the parameters that take precedence over a preset, those of the parameter file, unless
+// overridden by those of --parameter, as NAME=VALUE
+func presetParameters(submitOpts *wfv1.SubmitOpts) ([]string, error) {
+	if submitOpts.ParameterFile == "" {
+		return submitOpts.Parameters, nil
+	}
+	fileParams, err := util.ReadParameterFile(submitOpts.ParameterFile)
+	if err != nil {
+		return nil, err
+	}
+	passed := make(map[string]bool)
+	for _, p := range submitOpts.Parameters {
+		passed[strings.SplitN(p, "=", 2)[0]] = true
+	}
+	var parameters []string
+	for _, p := range fileParams {
+		if !passed[p.Name] {
+			parameters = append(parameters, p.Name+"="+p.Value.String())
+		}
 	}
+	return append(parameters, submitOpts.Parameters...), nil
+}
 
-	waitWatchOrLog(ctx, serviceClient, namespace, workflowNames, *cliOpts)
+// workflowDescription returns the name of the workflow, or its generate name, as it has not been created
+func workflowDescription(wf wfv1.Workflow) string {
+	if wf.Name != "" {
+		return wf.Name
+	}
+	return wf.GenerateName
 }
 
 // unmarshalWorkflows unmarshals the input bytes as either json or yaml
@@ -240,6 +343,9 @@ func unmarshalWorkflows(wfBytes []byte, strict bool) []wfv1.Workflow {
 	if strict {
 		jsonOpts = append(jsonOpts, argoJson.DisallowUnknownFields)
 	}
+	if jsonWfs, err := unmarshalJSONWorkflows(wfBytes, strict); err == nil && len(jsonWfs) > 1 {
+		return jsonWfs
+	}
 	err := argoJson.Unmarshal(wfBytes, &wf, jsonOpts...)
 	if err == nil {
 		return []wfv1.Workflow{wf}
@@ -252,6 +358,29 @@ func unmarshalWorkflows(wfBytes []byte, strict bool) []wfv1.Workflow {
 	return nil
 }
 
+// unmarshalJSONWorkflows unmarshals a stream of JSON workflows, one after another, e.g. as output by "jq -c"
+func unmarshalJSONWorkflows(data []byte, strict bool) ([]wfv1.Workflow, error) {
+	if !util.IsJSONStr(string(data)) {
+		return nil, fmt.Errorf("not JSON")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	var workflows []wfv1.Workflow
+	for {
+		var wf wfv1.Workflow
+		err := decoder.Decode(&wf)
+		if err == io.EOF {
+			return workflows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, wf)
+	}
+}
+
 func waitWatchOrLog(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, cliSubmitOpts cliSubmitOpts) {
 	if cliSubmitOpts.log {
 		for _, workflow := range workflowNames {
@@ -259,11 +388,13 @@ func waitWatchOrLog(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 				Container: common.MainContainerName,
 				Follow:    true,
 				Previous:  false,
-			})
+			}, nil)
 		}
 	}
 	if cliSubmitOpts.wait {
-		waitWorkflows(ctx, serviceClient, namespace, workflowNames, false, !(cliSubmitOpts.output == "" || cliSubmitOpts.output == "wide"))
+		opts := cliSubmitOpts.waitOpts
+		opts.quiet = !(cliSubmitOpts.output == "" || cliSubmitOpts.output == "wide")
+		waitWorkflows(ctx, serviceClient, namespace, workflowNames, opts)
 	} else if cliSubmitOpts.watch {
 		for _, workflow := range workflowNames {
 			watchWorkflow(ctx, serviceClient, namespace, workflow, cliSubmitOpts.getArgs)
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_unmarshalWorkflows(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		wfs := unmarshalWorkflows([]byte(`{"metadata": {"name": "foo"}}`), true)
+		if assert.Len(t, wfs, 1) {
+			assert.Equal(t, "foo", wfs[0].Name)
+		}
+	})
+	t.Run("JSONStream", func(t *testing.T) {
+		wfs := unmarshalWorkflows([]byte(`{"metadata": {"name": "foo"}}
+{"metadata": {"name": "bar"}}
+`), true)
+		if assert.Len(t, wfs, 2) {
+			assert.Equal(t, "foo", wfs[0].Name)
+			assert.Equal(t, "bar", wfs[1].Name)
+		}
+	})
+	t.Run("YAMLDocuments", func(t *testing.T) {
+		wfs := unmarshalWorkflows([]byte(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: foo
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: bar
+`), true)
+		if assert.Len(t, wfs, 2) {
+			assert.Equal(t, "foo", wfs[0].Name)
+			assert.Equal(t, "bar", wfs[1].Name)
+		}
+	})
+}
+
+func Test_createWorkflows(t *testing.T) {
+	workflows := []wfv1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+		{ObjectMeta: metav1.ObjectMeta{GenerateName: "bar-"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "baz"}},
+	}
+	created := func(name string) func(*workflowpkg.WorkflowCreateRequest) bool {
+		return func(req *workflowpkg.WorkflowCreateRequest) bool {
+			return req.Workflow.Name == name && req.Namespace == "argo"
+		}
+	}
+	t.Run("Submitted", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("CreateWorkflow", mock.Anything, mock.Anything).Return(func(_ context.Context, req *workflowpkg.WorkflowCreateRequest, _ ...grpc.CallOption) *wfv1.Workflow {
+			return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: workflowDescription(*req.Workflow) + "1"}}
+		}, nil)
+		progress := &bytes.Buffer{}
+		names, err := createWorkflows(context.Background(), c, "argo", workflows, &wfv1.SubmitOpts{}, &cliSubmitOpts{output: "name"}, progress)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"foo1", "bar-1", "baz1"}, names)
+			assert.Equal(t, `[1/3] Workflow foo1 submitted
+[2/3] Workflow bar-1 submitted
+[3/3] Workflow baz1 submitted
+3 of 3 workflows submitted, 0 failed
+`, progress.String())
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("CreateWorkflow", mock.Anything, mock.MatchedBy(created("foo"))).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+		c.On("CreateWorkflow", mock.Anything, mock.MatchedBy(created(""))).Return(nil, fmt.Errorf("invalid"))
+		c.On("CreateWorkflow", mock.Anything, mock.MatchedBy(created("baz"))).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "baz"}}, nil)
+		progress := &bytes.Buffer{}
+		names, err := createWorkflows(context.Background(), c, "argo", workflows, &wfv1.SubmitOpts{}, &cliSubmitOpts{output: "name"}, progress)
+		assert.EqualError(t, err, "1 of 3 workflows failed to be submitted")
+		// the rest are still submitted
+		assert.Equal(t, []string{"foo", "baz"}, names)
+		assert.Equal(t, `[1/3] Workflow foo submitted
+[2/3] Failed to submit workflow bar-: invalid
+[3/3] Workflow baz submitted
+2 of 3 workflows submitted, 1 failed
+`, progress.String())
+	})
+	t.Run("One", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("CreateWorkflow", mock.Anything, mock.Anything).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+		progress := &bytes.Buffer{}
+		names, err := createWorkflows(context.Background(), c, "argo", workflows[:1], &wfv1.SubmitOpts{}, &cliSubmitOpts{output: "name"}, progress)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"foo"}, names)
+			assert.Empty(t, progress.String())
+		}
+	})
+	t.Run("Preset", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("CreateWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowpkg.WorkflowCreateRequest) bool {
+			return req.SubmitOptions != nil && req.SubmitOptions.Preset == "nightly-eu" && len(req.SubmitOptions.Parameters) == 1 && req.SubmitOptions.Parameters[0] == "region=eu"
+		})).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+		names, err := createWorkflows(context.Background(), c, "argo", workflows[:1], &wfv1.SubmitOpts{Preset: "nightly-eu", Parameters: []string{"region=eu"}}, &cliSubmitOpts{output: "name"}, &bytes.Buffer{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"foo"}, names)
+		}
+	})
+	t.Run("PresetWithParameterFile", func(t *testing.T) {
+		parameterFile := filepath.Join(t.TempDir(), "params.yaml")
+		assert.NoError(t, os.WriteFile(parameterFile, []byte("region: us\nsize: large\n"), 0o600))
+		c := &workflowmocks.WorkflowServiceClient{}
+		var parameters []string
+		c.On("CreateWorkflow", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			parameters = args.Get(1).(*workflowpkg.WorkflowCreateRequest).SubmitOptions.Parameters
+		}).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+		_, err := createWorkflows(context.Background(), c, "argo", workflows[:1], &wfv1.SubmitOpts{Preset: "nightly-eu", ParameterFile: parameterFile, Parameters: []string{"region=eu"}}, &cliSubmitOpts{output: "name"}, &bytes.Buffer{})
+		if assert.NoError(t, err) {
+			// the parameter file's take precedence over the preset, and --parameter's over the parameter file's
+			assert.Equal(t, []string{"size=large", "region=eu"}, parameters)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 )
 
@@ -17,8 +18,9 @@ func NewDeleteCommand() *cobra.Command {
 	var all bool
 
 	command := &cobra.Command{
-		Use:   "delete WORKFLOW_TEMPLATE",
-		Short: "delete a workflow template",
+		Use:               "delete WORKFLOW_TEMPLATE",
+		Short:             "delete a workflow template",
+		ValidArgsFunction: completion.WorkflowTemplates,
 		Run: func(cmd *cobra.Command, args []string) {
 			apiServerDeleteWorkflowTemplates(cmd.Context(), all, args)
 		},
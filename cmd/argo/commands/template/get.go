@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -18,8 +19,9 @@ func NewGetCommand() *cobra.Command {
 	var output string
 
 	command := &cobra.Command{
-		Use:   "get WORKFLOW_TEMPLATE...",
-		Short: "display details about a workflow template",
+		Use:               "get WORKFLOW_TEMPLATE...",
+		Short:             "display details about a workflow template",
+		ValidArgsFunction: completion.WorkflowTemplates,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
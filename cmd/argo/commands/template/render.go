@@ -0,0 +1,94 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func NewRenderCommand() *cobra.Command {
+	var (
+		submitOpts wfv1.SubmitOpts
+		output     string
+	)
+	command := &cobra.Command{
+		Use:   "render WORKFLOW_TEMPLATE",
+		Short: "print the workflow a workflow template would be submitted as, without submitting it",
+		Long: `Print the workflow a workflow template would be submitted as, without submitting it.
+
+The spec is the one the controller would run: the spec of the workflow template, with the parameters, the workflow defaults and the template defaults applied. Variables are not substituted, as most are only known when the workflow runs.
+
+Use "argo cluster-template render" to render a cluster workflow template.`,
+		Example: `# Render a workflow template:
+
+  argo template render my-wftmpl
+
+# Render a workflow template with parameters:
+
+  argo template render my-wftmpl -p message=hello -p count=3
+
+# Render a workflow template as JSON:
+
+  argo template render my-wftmpl -o json
+`,
+		ValidArgsFunction: completion.WorkflowTemplate,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			wf, err := RenderWorkflow(ctx, serviceClient, client.Namespace(), workflow.WorkflowTemplateKind, args[0], &submitOpts)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := PrintRenderedWorkflow(os.Stdout, wf, output); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	util.PopulateSubmitOpts(command, &submitOpts, false)
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml")
+	return command
+}
+
+// RenderWorkflow returns the workflow that the workflow template, or cluster workflow template, of the kind would be
+// submitted as in the namespace
+func RenderWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, kind, name string, submitOpts *wfv1.SubmitOpts) (*wfv1.Workflow, error) {
+	return serviceClient.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{
+		Namespace:     namespace,
+		ResourceKind:  kind,
+		ResourceName:  name,
+		SubmitOptions: submitOpts,
+	})
+}
+
+// PrintRenderedWorkflow prints the rendered workflow in the output format, json or yaml
+func PrintRenderedWorkflow(w io.Writer, wf *wfv1.Workflow, outFmt string) error {
+	switch outFmt {
+	case "json":
+		outBytes, _ := json.MarshalIndent(wf, "", "    ")
+		_, err := fmt.Fprintln(w, string(outBytes))
+		return err
+	case "yaml":
+		outBytes, _ := yaml.Marshal(wf)
+		_, err := fmt.Fprint(w, string(outBytes))
+		return err
+	default:
+		return fmt.Errorf("unknown output format: %s", outFmt)
+	}
+}
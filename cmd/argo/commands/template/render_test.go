@@ -0,0 +1,62 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestNewRenderCommand(t *testing.T) {
+	root := &cobra.Command{Use: "argo"}
+	client.AddKubectlFlagsToCmd(root)
+	root.AddCommand(NewRenderCommand())
+	render, _, err := root.Find([]string{"render"})
+	if assert.NoError(t, err) {
+		assert.Nil(t, render.LocalNonPersistentFlags().Lookup("cluster"), "the kube config's --cluster flag is not shadowed")
+		assert.NoError(t, render.ParseFlags([]string{"--cluster", "my-cluster"}))
+		assert.Equal(t, "my-cluster", render.Flag("cluster").Value.String())
+	}
+}
+
+func TestRenderWorkflow(t *testing.T) {
+	c := &workflowmocks.WorkflowServiceClient{}
+	submitOpts := &wfv1.SubmitOpts{Parameters: []string{"message=hello"}}
+	c.On("RenderWorkflow", mock.Anything, &workflowpkg.WorkflowRenderRequest{
+		Namespace:     "my-ns",
+		ResourceKind:  workflow.ClusterWorkflowTemplateKind,
+		ResourceName:  "my-cwftmpl",
+		SubmitOptions: submitOpts,
+	}).Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{GenerateName: "my-cwftmpl-"}}, nil)
+	wf, err := RenderWorkflow(context.Background(), c, "my-ns", workflow.ClusterWorkflowTemplateKind, "my-cwftmpl", submitOpts)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-cwftmpl-", wf.GenerateName)
+	}
+}
+
+func TestPrintRenderedWorkflow(t *testing.T) {
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{GenerateName: "my-wftmpl-"}}
+	t.Run("YAML", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		assert.NoError(t, PrintRenderedWorkflow(w, wf, "yaml"))
+		assert.Contains(t, w.String(), "generateName: my-wftmpl-\n")
+	})
+	t.Run("JSON", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		assert.NoError(t, PrintRenderedWorkflow(w, wf, "json"))
+		assert.Contains(t, w.String(), `"generateName": "my-wftmpl-"`)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		assert.EqualError(t, PrintRenderedWorkflow(&bytes.Buffer{}, wf, "wide"), "unknown output format: wide")
+	})
+}
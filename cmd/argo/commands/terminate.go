@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -18,6 +19,7 @@ type terminateOption struct {
 	labels    string
 	fields    string
 	dryRun    bool
+	bulk      bool
 }
 
 func (t *terminateOption) isList() bool {
@@ -61,7 +63,16 @@ func NewTerminateCommand() *cobra.Command {
 # Terminate multiple workflows by field selector
 
   argo terminate --field-selector metadata.namespace=argo
+
+# Terminate all workflows with a label in a single request to the server
+
+  argo terminate --bulk -l workflows.argoproj.io/test=true
+
+# Print the workflows that would be terminated, without terminating them
+
+  argo terminate --dry-run -l workflows.argoproj.io/test=true
 `,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowRunning, wfv1.WorkflowPending),
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 && !t.isList() {
 				cmd.HelpFunc()(cmd, args)
@@ -72,6 +83,16 @@ func NewTerminateCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			t.namespace = client.Namespace()
 
+			if t.bulk && !t.dryRun {
+				err := bulkWorkflows(ctx, serviceClient, &workflowpkg.WorkflowBulkRequest{
+					Namespace: t.namespace,
+					Operation: "terminate",
+					Names:     args,
+				}, t.labels, t.fields, "terminated")
+				errors.CheckError(err)
+				return
+			}
+
 			var workflows wfv1.Workflows
 
 			if t.isList() {
@@ -82,15 +103,21 @@ func NewTerminateCommand() *cobra.Command {
 				})
 				errors.CheckError(err)
 				workflows = append(workflows, listed...)
-			} else {
-				workflows = t.convertToWorkflows(args)
+			}
+			workflows = append(workflows, t.convertToWorkflows(args)...)
+
+			if t.dryRun {
+				errors.CheckError(dryRunWorkflows(ctx, serviceClient, os.Stdout, workflows, "terminated"))
+				return
 			}
 
+			terminated := make(map[string]bool)
 			for _, w := range workflows {
-				if t.dryRun {
-					fmt.Printf("workflow %s terminated (dry-run)\n", w.Name)
+				if terminated[w.Name] {
+					// de-duplication in case there is an overlap between the selector and given workflow names
 					continue
 				}
+				terminated[w.Name] = true
 
 				wf, err := serviceClient.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{
 					Name:      w.Name,
@@ -104,6 +131,7 @@ func NewTerminateCommand() *cobra.Command {
 
 	command.Flags().StringVarP(&t.labels, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&t.fields, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selectorkey1=value1,key2=value2). The server only supports a limited number of field queries per type.")
-	command.Flags().BoolVar(&t.dryRun, "dry-run", false, "Do not terminate the workflow, only print what would happen")
+	command.Flags().BoolVar(&t.dryRun, "dry-run", false, "Do not terminate the workflows, only print those that would be terminated")
+	command.Flags().BoolVar(&t.bulk, "bulk", false, bulkUsage)
 	return command
 }
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func NewTimelineCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "timeline WORKFLOW",
+		Short: "print the events of a workflow in the order they happened",
+		Long: `Print the events of a workflow in the order they happened.
+
+The timeline has when the workflow was created, started and completed, when each node started and completed, and which were retries, the Kubernetes events of the workflow, and of its pods, and when artifacts were saved, and deleted.
+
+Kubernetes deletes events after an hour, by default, so older events of pods are not in the timeline.`,
+		Example: `# Print the timeline of a workflow:
+
+  argo timeline my-wf
+
+# Print the timeline as JSON:
+
+  argo timeline my-wf -o json
+`,
+		ValidArgsFunction: completion.Workflows(),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			errors.CheckError(getTimeline(cmd.Context(), os.Stdout, args[0], output))
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+func getTimeline(ctx context.Context, out io.Writer, workflowName, output string) error {
+	switch output {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	ctx, apiClient := client.NewAPIClient(ctx)
+	serviceClient := apiClient.NewWorkflowServiceClient()
+	timeline, err := serviceClient.GetWorkflowTimeline(ctx, &workflowpkg.WorkflowTimelineRequest{Name: workflowName, Namespace: client.Namespace()})
+	if err != nil {
+		return err
+	}
+	return printTimeline(out, timeline, output)
+}
+
+func printTimeline(out io.Writer, timeline *workflowpkg.WorkflowTimeline, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(timeline, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(timeline)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tKIND\tNODE\tREASON\tMESSAGE")
+	for _, e := range timeline.Events {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Time.UTC().Format(time.RFC3339), e.Kind, e.NodeName, e.Reason, e.Message)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func Test_printTimeline(t *testing.T) {
+	t0 := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 := metav1.NewTime(t0.Add(time.Minute))
+	timeline := &workflowpkg.WorkflowTimeline{Events: []*workflowpkg.TimelineEvent{
+		{Time: &t0, Kind: "Workflow", Reason: "Created"},
+		{Time: &t1, Kind: "Pod", NodeId: "my-wf-1", NodeName: "a", Reason: "BackOff", Message: "Back-off pulling image"},
+	}}
+	t.Run("Table", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		if assert.NoError(t, printTimeline(out, timeline, "")) {
+			assert.Equal(t, `TIME                  KIND      NODE  REASON   MESSAGE
+2021-01-01T00:00:00Z  Workflow        Created  
+2021-01-01T00:01:00Z  Pod       a     BackOff  Back-off pulling image
+`, out.String())
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		if assert.NoError(t, printTimeline(out, timeline, "json")) {
+			assert.Contains(t, out.String(), `"reason": "BackOff"`)
+		}
+	})
+}
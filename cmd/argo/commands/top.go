@@ -0,0 +1,250 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func NewTopCommand() *cobra.Command {
+	var live bool
+	command := &cobra.Command{
+		Use:   "top WORKFLOW",
+		Short: "display the resource usage of a workflow, by node and by template",
+		Long: `Display the resource usage of a workflow, by node and by template.
+
+The resources duration of each node is the resources it requested, multiplied by how long it ran for. With --live, the CPU and memory each running pod is using now is also shown. This is read from the metrics server (metrics.k8s.io), so needs access to the Kubernetes API.`,
+		Example: `# Display the resource usage of a workflow:
+
+  argo top my-wf
+
+# Display the resource usage of a running workflow, including what its pods are using now:
+
+  argo top my-wf --live
+`,
+		ValidArgsFunction: completion.Workflow(),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+			wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+				Name:      args[0],
+				Namespace: namespace,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+			var usages map[string]corev1.ResourceList
+			if live {
+				usages, err = getLiveUsages(ctx, wf)
+				if err != nil {
+					log.Fatalf("Failed to get the resource usage of the workflow's pods from the metrics server: %v", err)
+				}
+			}
+			printTop(os.Stdout, wf, usages, time.Now())
+		},
+	}
+	command.Flags().BoolVar(&live, "live", false, "display the CPU and memory running pods are using now, from the metrics server")
+	return command
+}
+
+// podMetricsList is the part of a metrics.k8s.io/v1beta1 PodMetricsList we need
+type podMetricsList struct {
+	Items []struct {
+		Metadata   metav1.ObjectMeta `json:"metadata"`
+		Containers []struct {
+			Usage corev1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// getLiveUsages returns the resources each of the workflow's running pods is using, summed over its containers, keyed
+// by node ID
+func getLiveUsages(ctx context.Context, wf *wfv1.Workflow) (map[string]corev1.ResourceList, error) {
+	restConfig, err := client.GetConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	labelSelector := common.LabelKeyWorkflow + "=" + wf.Name
+	pods, err := kubeClient.CoreV1().Pods(wf.GetPodNamespace()).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	nodeIDs := make(map[string]string)
+	for _, pod := range pods.Items {
+		nodeIDs[pod.Name] = pod.Annotations[common.AnnotationKeyNodeID]
+	}
+	data, err := kubeClient.CoreV1().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", wf.Namespace, "pods").
+		Param("labelSelector", labelSelector).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list podMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	usages := make(map[string]corev1.ResourceList)
+	for _, item := range list.Items {
+		nodeID, ok := nodeIDs[item.Metadata.Name]
+		if !ok || nodeID == "" {
+			continue
+		}
+		usage := corev1.ResourceList{}
+		for _, c := range item.Containers {
+			for name, quantity := range c.Usage {
+				sum := usage[name]
+				sum.Add(quantity)
+				usage[name] = sum
+			}
+		}
+		usages[nodeID] = usage
+	}
+	return usages, nil
+}
+
+type templateUsage struct {
+	nodes             int
+	duration          time.Duration
+	resourcesDuration wfv1.ResourcesDuration
+	// the most any one of its running pods is using now
+	maxUsage corev1.ResourceList
+}
+
+// printTop prints the pod nodes of the workflow, then the totals of each template. The usage columns are only printed
+// if usages is not nil.
+func printTop(out io.Writer, wf *wfv1.Workflow, usages map[string]corev1.ResourceList, now time.Time) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	live := usages != nil
+	templates := make(map[string]*templateUsage)
+	_, _ = fmt.Fprint(w, "NODE\tTEMPLATE\tPHASE\tDURATION\tRESOURCES DURATION")
+	if live {
+		_, _ = fmt.Fprint(w, "\tCPU\tMEMORY")
+	}
+	_, _ = fmt.Fprintln(w)
+	for _, node := range nodes {
+		templateName := node.TemplateName
+		if node.TemplateRef != nil {
+			templateName = fmt.Sprintf("%s/%s", node.TemplateRef.Name, node.TemplateRef.Template)
+		}
+		duration := nodeDuration(node, now)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", node.DisplayName, templateName, node.Phase, duration, formatResourcesDuration(node.ResourcesDuration))
+		usage := usages[node.ID]
+		if live {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s", formatUsage(usage, corev1.ResourceCPU), formatUsage(usage, corev1.ResourceMemory))
+		}
+		_, _ = fmt.Fprintln(w)
+
+		t, ok := templates[templateName]
+		if !ok {
+			t = &templateUsage{resourcesDuration: wfv1.ResourcesDuration{}, maxUsage: corev1.ResourceList{}}
+			templates[templateName] = t
+		}
+		t.nodes++
+		t.duration += duration
+		t.resourcesDuration = t.resourcesDuration.Add(node.ResourcesDuration)
+		for name, quantity := range usage {
+			if m, ok := t.maxUsage[name]; !ok || quantity.Cmp(m) > 0 {
+				t.maxUsage[name] = quantity
+			}
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+
+	var templateNames []string
+	for name := range templates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+	_, _ = fmt.Fprint(w, "TEMPLATE\tNODES\tDURATION\tRESOURCES DURATION")
+	if live {
+		_, _ = fmt.Fprint(w, "\tMAX CPU\tMAX MEMORY")
+	}
+	_, _ = fmt.Fprintln(w)
+	for _, name := range templateNames {
+		t := templates[name]
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s", name, t.nodes, t.duration, formatResourcesDuration(t.resourcesDuration))
+		if live {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s", formatUsage(t.maxUsage, corev1.ResourceCPU), formatUsage(t.maxUsage, corev1.ResourceMemory))
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	_ = w.Flush()
+}
+
+// nodeDuration returns how long the node ran for, or has been running for
+func nodeDuration(node wfv1.NodeStatus, now time.Time) time.Duration {
+	if node.StartedAt.IsZero() {
+		return 0
+	}
+	if node.FinishedAt.IsZero() {
+		return now.Sub(node.StartedAt.Time).Truncate(time.Second)
+	}
+	return node.GetDuration().Truncate(time.Second)
+}
+
+// formatResourcesDuration is like wfv1.ResourcesDuration.String, but in a stable order
+func formatResourcesDuration(in wfv1.ResourcesDuration) string {
+	var parts []string
+	for n, d := range in {
+		parts = append(parts, fmt.Sprintf("%v*(%s %s)", d, wfv1.ResourceQuantityDenominator(n).String(), n))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// formatUsage formats CPU in millicores and memory in mebibytes, as per `kubectl top`
+func formatUsage(usage corev1.ResourceList, name corev1.ResourceName) string {
+	quantity, ok := usage[name]
+	if !ok {
+		return "-"
+	}
+	switch name {
+	case corev1.ResourceCPU:
+		return fmt.Sprintf("%dm", quantity.MilliValue())
+	case corev1.ResourceMemory:
+		return fmt.Sprintf("%dMi", quantity.Value()/(1024*1024))
+	default:
+		return quantity.String()
+	}
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_printTop(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 10, 0, 0, time.UTC)
+	startedAt := metav1.NewTime(now.Add(-10 * time.Minute))
+	wf := &wfv1.Workflow{
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf": {ID: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeSteps, TemplateName: "main", StartedAt: startedAt},
+			"my-wf-1": {
+				ID: "my-wf-1", DisplayName: "a", Type: wfv1.NodeTypePod, TemplateName: "work", Phase: wfv1.NodeSucceeded,
+				StartedAt: startedAt, FinishedAt: metav1.NewTime(startedAt.Add(time.Minute)),
+				ResourcesDuration: wfv1.ResourcesDuration{corev1.ResourceCPU: 60, corev1.ResourceMemory: 60},
+			},
+			"my-wf-2": {
+				ID: "my-wf-2", DisplayName: "b", Type: wfv1.NodeTypePod, TemplateName: "work", Phase: wfv1.NodeRunning,
+				StartedAt: metav1.NewTime(now.Add(-2 * time.Minute)),
+			},
+			"my-wf-3": {
+				ID: "my-wf-3", DisplayName: "c", Type: wfv1.NodeTypePod, TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "other"}, Phase: wfv1.NodeRunning,
+				StartedAt: metav1.NewTime(now.Add(-time.Minute)),
+			},
+		}},
+	}
+	t.Run("ResourcesDuration", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		printTop(out, wf, nil, now)
+		assert.Equal(t, `NODE  TEMPLATE         PHASE      DURATION  RESOURCES DURATION
+a     work             Succeeded  1m0s      1m0s*(1 cpu),1m0s*(100Mi memory)
+b     work             Running    2m0s      
+c     my-wftmpl/other  Running    1m0s      
+
+TEMPLATE         NODES  DURATION  RESOURCES DURATION
+my-wftmpl/other  1      1m0s      
+work             2      3m0s      1m0s*(1 cpu),1m0s*(100Mi memory)
+`, out.String())
+	})
+	t.Run("Live", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		printTop(out, wf, map[string]corev1.ResourceList{
+			"my-wf-2": {corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("64Mi")},
+		}, now)
+		assert.Equal(t, `NODE  TEMPLATE         PHASE      DURATION  RESOURCES DURATION                CPU   MEMORY
+a     work             Succeeded  1m0s      1m0s*(1 cpu),1m0s*(100Mi memory)  -     -
+b     work             Running    2m0s                                        250m  64Mi
+c     my-wftmpl/other  Running    1m0s                                        -     -
+
+TEMPLATE         NODES  DURATION  RESOURCES DURATION                MAX CPU  MAX MEMORY
+my-wftmpl/other  1      1m0s                                        -        -
+work             2      3m0s      1m0s*(1 cpu),1m0s*(100Mi memory)  250m     64Mi
+`, out.String())
+	})
+}
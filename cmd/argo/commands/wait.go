@@ -2,10 +2,12 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -15,16 +17,62 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 )
 
+// waitOpts holds the options of waiting for workflows, shared by `argo wait` and `argo submit --wait`
+type waitOpts struct {
+	ignoreNotFound bool           // --ignore-not-found
+	timeout        time.Duration  // --timeout
+	exitCodes      map[string]int // --exit-code
+	output         string         // --output
+	// quiet is true if nothing should be printed
+	quiet bool
+}
+
+// waitTimeout is the outcome when waiting times out, which can be given an exit code like a phase
+const waitTimeout = "Timeout"
+
+// the exit codes of each outcome, unless overridden with --exit-code
+var defaultWaitExitCodes = map[string]int{
+	string(wfv1.WorkflowSucceeded): 0,
+	string(wfv1.WorkflowFailed):    1,
+	string(wfv1.WorkflowError):     1,
+	waitTimeout:                    124,
+}
+
+// waitResult is the outcome of waiting for a workflow, printed with --output json
+type waitResult struct {
+	Name       string             `json:"name"`
+	Namespace  string             `json:"namespace"`
+	Phase      wfv1.WorkflowPhase `json:"phase,omitempty"`
+	Message    string             `json:"message,omitempty"`
+	StartedAt  *metav1.Time       `json:"startedAt,omitempty"`
+	FinishedAt *metav1.Time       `json:"finishedAt,omitempty"`
+	NotFound   bool               `json:"notFound,omitempty"`
+	TimedOut   bool               `json:"timedOut,omitempty"`
+	ExitCode   int                `json:"exitCode"`
+}
+
+// outcome returns the phase of the workflow, or Timeout if waiting for it timed out
+func (r waitResult) outcome() string {
+	if r.TimedOut {
+		return waitTimeout
+	}
+	return string(r.Phase)
+}
+
 func NewWaitCommand() *cobra.Command {
-	var ignoreNotFound bool
+	var opts waitOpts
 	command := &cobra.Command{
 		Use:   "wait [WORKFLOW...]",
 		Short: "waits for workflows to complete",
+		Long: `Waits for workflows to complete.
+
+The exit code is 0 if every workflow succeeded, 1 if any failed or errored, and 124 if waiting timed out. Use --exit-code to give each outcome (Succeeded, Failed, Error or Timeout) its own exit code. When waiting for more than one workflow, the highest exit code is used.`,
 		Example: `# Wait on a workflow:
 
   argo wait my-wf
@@ -32,77 +80,165 @@ func NewWaitCommand() *cobra.Command {
 # Wait on the latest workflow:
 
   argo wait @latest
+
+# Wait on a workflow for up to an hour, exiting with a different code for each outcome:
+
+  argo wait my-wf --timeout 1h --exit-code Failed=2 --exit-code Error=3 --exit-code Timeout=4
+
+# Wait on workflows, and print a JSON summary of how each completed:
+
+  argo wait my-wf my-other-wf -o json
 `,
+		ValidArgsFunction: completion.Workflows(wfv1.WorkflowRunning, wfv1.WorkflowPending),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
-			waitWorkflows(ctx, serviceClient, namespace, args, ignoreNotFound, false)
+			waitWorkflows(ctx, serviceClient, namespace, args, opts)
 		},
 	}
-	command.Flags().BoolVar(&ignoreNotFound, "ignore-not-found", false, "Ignore the wait if the workflow is not found")
+	command.Flags().BoolVar(&opts.ignoreNotFound, "ignore-not-found", false, "Ignore the wait if the workflow is not found")
+	addWaitFlags(command, &opts, "timeout", "output")
 	return command
 }
 
-// waitWorkflows waits for the given workflowNames.
-func waitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, ignoreNotFound, quiet bool) {
-	var wg sync.WaitGroup
-	wfSuccessStatus := true
+// addWaitFlags adds the flags for the timeout, exit codes and output of waiting, with the given names for the timeout and
+// output flags, so they do not clash with the flags of other commands
+func addWaitFlags(command *cobra.Command, opts *waitOpts, timeoutFlag, outputFlag string) {
+	command.Flags().DurationVar(&opts.timeout, timeoutFlag, 0, "Stop waiting after this long, e.g. 10m, 0 waits forever")
+	command.Flags().StringToIntVar(&opts.exitCodes, "exit-code", nil, "The exit code of an outcome, one of Succeeded, Failed, Error or Timeout, e.g. --exit-code Failed=2")
+	if outputFlag == "output" {
+		command.Flags().StringVarP(&opts.output, outputFlag, "o", "", "Output format. One of: json")
+	} else {
+		command.Flags().StringVar(&opts.output, outputFlag, "", "Output format of waiting. One of: json")
+	}
+}
 
-	for _, name := range workflowNames {
-		wg.Add(1)
-		go func(name string) {
-			if !waitOnOne(serviceClient, ctx, name, namespace, ignoreNotFound, quiet) {
-				wfSuccessStatus = false
-			}
-			wg.Done()
-		}(name)
+// waitExitCodes returns the exit code of each outcome, with the overrides applied
+func waitExitCodes(overrides map[string]int) (map[string]int, error) {
+	codes := make(map[string]int, len(defaultWaitExitCodes))
+	for outcome, code := range defaultWaitExitCodes {
+		codes[outcome] = code
+	}
+	for outcome, code := range overrides {
+		if _, ok := codes[outcome]; !ok {
+			return nil, fmt.Errorf("unknown outcome %q in --exit-code, must be one of Succeeded, Failed, Error or Timeout", outcome)
+		}
+		codes[outcome] = code
+	}
+	return codes, nil
+}
 
+// waitWorkflows waits for the given workflowNames, then exits with the highest exit code of their outcomes, if it is not 0.
+func waitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, opts waitOpts) {
+	if opts.output != "" && opts.output != "json" {
+		log.Fatalf("Unknown output format: %s", opts.output)
+	}
+	exitCodes, err := waitExitCodes(opts.exitCodes)
+	errors.CheckError(err)
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+	if opts.output == "json" {
+		opts.quiet = true
+	}
+
+	var wg sync.WaitGroup
+	results := make([]waitResult, len(workflowNames))
+	for i, name := range workflowNames {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i] = waitOnOne(serviceClient, ctx, name, namespace, opts.ignoreNotFound, opts.quiet)
+		}(i, name)
 	}
 	wg.Wait()
 
-	if !wfSuccessStatus {
-		os.Exit(1)
+	exitCode := 0
+	for i, r := range results {
+		results[i].ExitCode = exitCodes[r.outcome()]
+		if results[i].ExitCode > exitCode {
+			exitCode = results[i].ExitCode
+		}
+	}
+	if opts.output == "json" {
+		printWaitResults(os.Stdout, results)
 	}
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+func printWaitResults(out io.Writer, results []waitResult) {
+	data, err := json.MarshalIndent(results, "", "  ")
+	errors.CheckError(err)
+	_, _ = fmt.Fprintln(out, string(data))
 }
 
-func waitOnOne(serviceClient workflowpkg.WorkflowServiceClient, ctx context.Context, wfName, namespace string, ignoreNotFound, quiet bool) bool {
+// waitOnOne waits for the workflow to complete, or for the context to be done
+func waitOnOne(serviceClient workflowpkg.WorkflowServiceClient, ctx context.Context, wfName, namespace string, ignoreNotFound, quiet bool) waitResult {
+	result := waitResult{Name: wfName, Namespace: namespace}
 	req := &workflowpkg.WatchWorkflowsRequest{
 		Namespace: namespace,
 		ListOptions: &metav1.ListOptions{
-			FieldSelector:   util.GenerateFieldSelectorFromWorkflowName(wfName),
-			ResourceVersion: "0",
+			FieldSelector:       util.GenerateFieldSelectorFromWorkflowName(wfName),
+			ResourceVersion:     "0",
+			AllowWatchBookmarks: true,
 		},
 	}
+	timedOut := func() waitResult {
+		result.TimedOut = true
+		if !quiet && result.Phase != "" {
+			fmt.Printf("%s timed out waiting, still %s\n", wfName, result.Phase)
+		} else if !quiet {
+			fmt.Printf("%s timed out waiting\n", wfName)
+		}
+		return result
+	}
 	stream, err := serviceClient.WatchWorkflows(ctx, req)
 	if err != nil {
 		if status.Code(err) == codes.NotFound && ignoreNotFound {
-			return true
+			result.NotFound = true
+			result.Phase = wfv1.WorkflowSucceeded
+			return result
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return timedOut()
 		}
 		errors.CheckError(err)
-		return false
 	}
 	for {
 		event, err := stream.Recv()
-		if err == io.EOF {
+		if ctx.Err() == context.DeadlineExceeded {
+			return timedOut()
+		}
+		if reestablishWatch(req, err) {
 			log.Debug("Re-establishing workflow watch")
 			stream, err = serviceClient.WatchWorkflows(ctx, req)
+			if ctx.Err() == context.DeadlineExceeded {
+				return timedOut()
+			}
 			errors.CheckError(err)
 			continue
 		}
 		errors.CheckError(err)
-		if event == nil {
+		if event == nil || event.Object == nil || resumeWatch(req, event) {
 			continue
 		}
 		wf := event.Object
+		result.Phase = wf.Status.Phase
+		result.Message = wf.Status.Message
+		if !wf.Status.StartedAt.IsZero() {
+			result.StartedAt = wf.Status.StartedAt.DeepCopy()
+		}
 		if !wf.Status.FinishedAt.IsZero() {
+			result.FinishedAt = wf.Status.FinishedAt.DeepCopy()
 			if !quiet {
 				fmt.Printf("%s %s at %v\n", wfName, wf.Status.Phase, wf.Status.FinishedAt)
 			}
-			if wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
-				return false
-			}
-			return true
+			return result
 		}
 	}
 }
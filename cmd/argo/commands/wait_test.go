@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// watchStream sends the workflows, then blocks until the context is done
+type watchStream struct {
+	grpc.ClientStream
+	ctx       context.Context
+	workflows []*wfv1.Workflow
+}
+
+func (s *watchStream) Recv() (*workflowpkg.WorkflowWatchEvent, error) {
+	if len(s.workflows) > 0 {
+		wf := s.workflows[0]
+		s.workflows = s.workflows[1:]
+		return &workflowpkg.WorkflowWatchEvent{Object: wf}, nil
+	}
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func Test_waitExitCodes(t *testing.T) {
+	codes, err := waitExitCodes(nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, defaultWaitExitCodes, codes)
+	}
+	codes, err = waitExitCodes(map[string]int{"Failed": 2, "Timeout": 3})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int{"Succeeded": 0, "Failed": 2, "Error": 1, "Timeout": 3}, codes)
+	}
+	_, err = waitExitCodes(map[string]int{"Running": 2})
+	assert.EqualError(t, err, `unknown outcome "Running" in --exit-code, must be one of Succeeded, Failed, Error or Timeout`)
+}
+
+func Test_waitOnOne(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC))
+	finishedAt := metav1.NewTime(startedAt.Add(time.Minute))
+	running := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, StartedAt: startedAt}}
+	t.Run("Completed", func(t *testing.T) {
+		ctx := context.Background()
+		serviceClient := &workflowmocks.WorkflowServiceClient{}
+		serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).Return(&watchStream{ctx: ctx, workflows: []*wfv1.Workflow{
+			running,
+			{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, Message: "oops", StartedAt: startedAt, FinishedAt: finishedAt}},
+		}}, nil)
+		result := waitOnOne(serviceClient, ctx, "my-wf", "my-ns", false, true)
+		assert.Equal(t, waitResult{Name: "my-wf", Namespace: "my-ns", Phase: wfv1.WorkflowFailed, Message: "oops", StartedAt: &startedAt, FinishedAt: &finishedAt}, result)
+		assert.Equal(t, "Failed", result.outcome())
+	})
+	t.Run("TimedOut", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		serviceClient := &workflowmocks.WorkflowServiceClient{}
+		serviceClient.On("WatchWorkflows", mock.Anything, mock.Anything).Return(&watchStream{ctx: ctx, workflows: []*wfv1.Workflow{running}}, nil)
+		result := waitOnOne(serviceClient, ctx, "my-wf", "my-ns", false, true)
+		assert.True(t, result.TimedOut)
+		assert.Equal(t, wfv1.WorkflowRunning, result.Phase)
+		assert.Equal(t, "Timeout", result.outcome())
+	})
+}
+
+func Test_printWaitResults(t *testing.T) {
+	out := &bytes.Buffer{}
+	printWaitResults(out, []waitResult{
+		{Name: "my-wf", Namespace: "my-ns", Phase: wfv1.WorkflowSucceeded},
+		{Name: "my-other-wf", Namespace: "my-ns", Phase: wfv1.WorkflowRunning, TimedOut: true, ExitCode: 124},
+	})
+	assert.Equal(t, `[
+  {
+    "name": "my-wf",
+    "namespace": "my-ns",
+    "phase": "Succeeded",
+    "exitCode": 0
+  },
+  {
+    "name": "my-other-wf",
+    "namespace": "my-ns",
+    "phase": "Running",
+    "timedOut": true,
+    "exitCode": 124
+  }
+]
+`, out.String())
+}
@@ -2,20 +2,27 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/argoproj/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiwatch "k8s.io/apimachinery/pkg/watch"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/completion"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
 	"github.com/argoproj/argo-workflows/v3/workflow/packer"
 )
 
@@ -29,32 +36,69 @@ func NewWatchCommand() *cobra.Command {
 
   argo watch my-wf
 
+# Watch a workflow with many nodes, collapsing completed branches and displaying the estimated time left of running nodes:
+
+  argo watch my-wf --tree
+
 # Watch the latest workflow:
 
   argo watch @latest
+
+# Print one JSON document per line each time the workflow's phase changes, a node is added, or a node's phase changes:
+
+  argo watch my-wf -o jsonl
 `,
+		ValidArgsFunction: completion.Workflow(wfv1.WorkflowRunning, wfv1.WorkflowPending),
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) != 1 {
 				cmd.HelpFunc()(cmd, args)
 				os.Exit(1)
 			}
+			if getArgs.output != "" && getArgs.output != "jsonl" {
+				log.Fatalf("unknown output format %q, must be jsonl", getArgs.output)
+			}
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
 			watchWorkflow(ctx, serviceClient, namespace, args[0], getArgs)
 		},
 	}
+	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: jsonl, which prints a JSON document per line each time the workflow or a node changes, rather than displaying the workflow")
 	command.Flags().StringVar(&getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	command.Flags().StringVar(&getArgs.nodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().BoolVar(&getArgs.tree, "tree", false, "collapse the completed branches of the workflow, and display the estimated time left of each running node")
 	return command
 }
 
+// resumeWatch updates the request, so that a re-established watch resumes from the event, and returns whether the event
+// is a bookmark, which has no workflow
+func resumeWatch(req *workflowpkg.WatchWorkflowsRequest, event *workflowpkg.WorkflowWatchEvent) bool {
+	if event.Object != nil && event.Object.ResourceVersion != "" {
+		req.ListOptions.ResourceVersion = event.Object.ResourceVersion
+	}
+	return event.Type == string(apiwatch.Bookmark)
+}
+
+// reestablishWatch returns whether the watch can be re-established after the error, i.e. it closed, or the resource
+// version it would resume from is too old, in which case the request is updated to start from the current workflow
+func reestablishWatch(req *workflowpkg.WatchWorkflowsRequest, err error) bool {
+	switch {
+	case err == io.EOF:
+		return true
+	case status.Code(grpcutil.TranslateError(err)) == codes.OutOfRange:
+		req.ListOptions.ResourceVersion = "0"
+		return true
+	}
+	return false
+}
+
 func watchWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflow string, getArgs getFlags) {
 	req := &workflowpkg.WatchWorkflowsRequest{
 		Namespace: namespace,
 		ListOptions: &metav1.ListOptions{
-			FieldSelector:   util.GenerateFieldSelectorFromWorkflowName(workflow),
-			ResourceVersion: "0",
+			FieldSelector:       util.GenerateFieldSelectorFromWorkflowName(workflow),
+			ResourceVersion:     "0",
+			AllowWatchBookmarks: true,
 		},
 	}
 	stream, err := serviceClient.WatchWorkflows(ctx, req)
@@ -64,21 +108,21 @@ func watchWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 	go func() {
 		for {
 			event, err := stream.Recv()
-			if err == io.EOF {
+			if reestablishWatch(req, err) {
 				log.Debug("Re-establishing workflow watch")
 				stream, err = serviceClient.WatchWorkflows(ctx, req)
 				errors.CheckError(err)
 				continue
 			}
 			errors.CheckError(err)
-			if event == nil {
+			if event == nil || resumeWatch(req, event) {
 				continue
 			}
 			wfChan <- event.Object
 		}
 	}()
 
-	var wf *wfv1.Workflow
+	var wf, prevWf *wfv1.Workflow
 	ticker := time.NewTicker(time.Second)
 	for {
 		select {
@@ -90,12 +134,21 @@ func watchWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 			wf = newWf
 		case <-ticker.C:
 			// If we don't, refresh the workflow screen every second
+			if getArgs.output == "jsonl" {
+				continue
+			}
 		case <-ctx.Done():
 			// When the context gets canceled
 			return
 		}
 
-		printWorkflowStatus(wf, getArgs)
+		if getArgs.output == "jsonl" {
+			errors.CheckError(packer.DecompressWorkflow(wf))
+			printWatchEvents(os.Stdout, watchEvents(prevWf, wf, time.Now(), getArgs))
+			prevWf = wf
+		} else {
+			printWorkflowStatus(wf, getArgs)
+		}
 		if wf != nil && !wf.Status.FinishedAt.IsZero() {
 			return
 		}
@@ -112,3 +165,106 @@ func printWorkflowStatus(wf *wfv1.Workflow, getArgs getFlags) {
 	print("\033[0;0H")
 	fmt.Print(printWorkflowHelper(wf, getArgs))
 }
+
+const (
+	watchEventWorkflowPhaseChanged = "WorkflowPhaseChanged"
+	watchEventNodeAdded            = "NodeAdded"
+	watchEventNodePhaseChanged     = "NodePhaseChanged"
+)
+
+// watchEvent is a change to a workflow, printed by argo watch -o jsonl
+type watchEvent struct {
+	Time          time.Time       `json:"time"`
+	Type          string          `json:"type"`
+	Namespace     string          `json:"namespace"`
+	Workflow      string          `json:"workflow"`
+	Node          *watchEventNode `json:"node,omitempty"`
+	Phase         string          `json:"phase"`
+	PreviousPhase string          `json:"previousPhase,omitempty"`
+	Message       string          `json:"message,omitempty"`
+	Progress      string          `json:"progress,omitempty"`
+}
+
+type watchEventNode struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Type         string `json:"type"`
+	TemplateName string `json:"templateName,omitempty"`
+}
+
+// watchEvents returns the changes from the previous workflow, which is nil the first time it is watched, so its phase
+// and each of its nodes are changes. Node changes are ordered by when the nodes started, and only nodes that would be
+// displayed are included. A change to the workflow's phase is first, unless the workflow has completed.
+func watchEvents(prevWf, wf *wfv1.Workflow, now time.Time, getArgs getFlags) []watchEvent {
+	if prevWf == nil {
+		prevWf = &wfv1.Workflow{}
+	}
+	var events []watchEvent
+	var phaseChanged *watchEvent
+	if wf.Status.Phase != prevWf.Status.Phase {
+		phaseChanged = &watchEvent{
+			Type:          watchEventWorkflowPhaseChanged,
+			Phase:         string(wf.Status.Phase),
+			PreviousPhase: string(prevWf.Status.Phase),
+			Message:       wf.Status.Message,
+			Progress:      string(wf.Status.Progress),
+		}
+	}
+	if phaseChanged != nil && !wf.Status.Fulfilled() {
+		events = append(events, *phaseChanged)
+	}
+	nodes := make([]wfv1.NodeStatus, 0, len(wf.Status.Nodes))
+	for _, node := range wf.Status.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	for _, node := range nodes {
+		if !getArgs.shouldPrint(node) {
+			continue
+		}
+		event := watchEvent{
+			Node: &watchEventNode{
+				ID:           node.ID,
+				Name:         node.Name,
+				DisplayName:  node.DisplayName,
+				Type:         string(node.Type),
+				TemplateName: node.TemplateName,
+			},
+			Phase:   string(node.Phase),
+			Message: node.Message,
+		}
+		prevNode, ok := prevWf.Status.Nodes[node.ID]
+		switch {
+		case !ok:
+			event.Type = watchEventNodeAdded
+		case prevNode.Phase != node.Phase:
+			event.Type = watchEventNodePhaseChanged
+			event.PreviousPhase = string(prevNode.Phase)
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+	if phaseChanged != nil && wf.Status.Fulfilled() {
+		events = append(events, *phaseChanged)
+	}
+	for i := range events {
+		events[i].Time = now
+		events[i].Namespace = wf.Namespace
+		events[i].Workflow = wf.Name
+	}
+	return events
+}
+
+func printWatchEvents(out io.Writer, events []watchEvent) {
+	encoder := json.NewEncoder(out)
+	for _, event := range events {
+		errors.CheckError(encoder.Encode(event))
+	}
+}
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_watchEvents(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	started := metav1.NewTime(now.Add(-time.Minute))
+	node := func(id string, phase wfv1.NodePhase, startedAt metav1.Time) wfv1.NodeStatus {
+		return wfv1.NodeStatus{ID: id, Name: "my-wf." + id, DisplayName: id, Type: wfv1.NodeTypePod, TemplateName: "main", Phase: phase, StartedAt: startedAt}
+	}
+	workflow := func(phase wfv1.WorkflowPhase, nodes ...wfv1.NodeStatus) *wfv1.Workflow {
+		wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "argo"}, Status: wfv1.WorkflowStatus{Phase: phase, Nodes: wfv1.Nodes{}}}
+		for _, n := range nodes {
+			wf.Status.Nodes[n.ID] = n
+		}
+		return wf
+	}
+	wf1 := workflow(wfv1.WorkflowRunning, node("b", wfv1.NodeRunning, started), node("a", wfv1.NodeRunning, metav1.NewTime(started.Add(time.Second))))
+	t.Run("First", func(t *testing.T) {
+		events := watchEvents(nil, wf1, now, getFlags{})
+		if assert.Len(t, events, 3) {
+			assert.Equal(t, watchEvent{Time: now, Type: watchEventWorkflowPhaseChanged, Namespace: "argo", Workflow: "my-wf", Phase: "Running"}, events[0])
+			assert.Equal(t, watchEventNodeAdded, events[1].Type)
+			// ordered by when they started
+			assert.Equal(t, "b", events[1].Node.ID)
+			assert.Equal(t, "Running", events[1].Phase)
+			assert.Equal(t, "a", events[2].Node.ID)
+		}
+	})
+	t.Run("NoChanges", func(t *testing.T) {
+		assert.Empty(t, watchEvents(wf1, wf1.DeepCopy(), now, getFlags{}))
+	})
+	wf2 := workflow(wfv1.WorkflowRunning, node("b", wfv1.NodeSucceeded, started), node("a", wfv1.NodeRunning, started), node("c", wfv1.NodePending, metav1.Time{}))
+	t.Run("NodeChanges", func(t *testing.T) {
+		events := watchEvents(wf1, wf2, now, getFlags{})
+		if assert.Len(t, events, 2) {
+			assert.Equal(t, watchEventNodeAdded, events[0].Type)
+			assert.Equal(t, "c", events[0].Node.ID)
+			assert.Equal(t, watchEvent{
+				Time:          now,
+				Type:          watchEventNodePhaseChanged,
+				Namespace:     "argo",
+				Workflow:      "my-wf",
+				Node:          &watchEventNode{ID: "b", Name: "my-wf.b", DisplayName: "b", Type: "Pod", TemplateName: "main"},
+				Phase:         "Succeeded",
+				PreviousPhase: "Running",
+			}, events[1])
+		}
+	})
+	t.Run("Completed", func(t *testing.T) {
+		wf3 := workflow(wfv1.WorkflowFailed, node("b", wfv1.NodeSucceeded, started), node("a", wfv1.NodeFailed, started), node("c", wfv1.NodeFailed, metav1.Time{}))
+		wf3.Status.Message = "child failed"
+		events := watchEvents(wf2, wf3, now, getFlags{})
+		if assert.Len(t, events, 3) {
+			assert.Equal(t, "c", events[0].Node.ID)
+			assert.Equal(t, "a", events[1].Node.ID)
+			// the workflow completed after its nodes
+			assert.Equal(t, watchEventWorkflowPhaseChanged, events[2].Type)
+			assert.Equal(t, "Failed", events[2].Phase)
+			assert.Equal(t, "Running", events[2].PreviousPhase)
+			assert.Equal(t, "child failed", events[2].Message)
+		}
+	})
+	t.Run("NodeFieldSelector", func(t *testing.T) {
+		events := watchEvents(wf1, wf2, now, getFlags{nodeFieldSelectorString: "phase=Succeeded"})
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, "b", events[0].Node.ID)
+		}
+	})
+}
+
+func Test_printWatchEvents(t *testing.T) {
+	out := &bytes.Buffer{}
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	printWatchEvents(out, []watchEvent{
+		{Time: now, Type: watchEventWorkflowPhaseChanged, Namespace: "argo", Workflow: "my-wf", Phase: "Running"},
+		{Time: now, Type: watchEventNodeAdded, Namespace: "argo", Workflow: "my-wf", Node: &watchEventNode{ID: "my-wf", Name: "my-wf", DisplayName: "my-wf", Type: "Steps"}, Phase: "Running"},
+	})
+	assert.Equal(t, `{"time":"2021-10-01T00:00:00Z","type":"WorkflowPhaseChanged","namespace":"argo","workflow":"my-wf","phase":"Running"}
+{"time":"2021-10-01T00:00:00Z","type":"NodeAdded","namespace":"argo","workflow":"my-wf","node":{"id":"my-wf","name":"my-wf","displayName":"my-wf","type":"Steps"},"phase":"Running"}
+`, out.String())
+}
+
+func Test_resumeWatch(t *testing.T) {
+	req := &workflowpkg.WatchWorkflowsRequest{ListOptions: &metav1.ListOptions{ResourceVersion: "0"}}
+	assert.False(t, resumeWatch(req, &workflowpkg.WorkflowWatchEvent{Type: "MODIFIED", Object: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"}}}))
+	assert.Equal(t, "2", req.ListOptions.ResourceVersion)
+	assert.True(t, resumeWatch(req, &workflowpkg.WorkflowWatchEvent{Type: "BOOKMARK", Object: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "3"}}}))
+	assert.Equal(t, "3", req.ListOptions.ResourceVersion)
+}
+
+func Test_reestablishWatch(t *testing.T) {
+	req := &workflowpkg.WatchWorkflowsRequest{ListOptions: &metav1.ListOptions{ResourceVersion: "3"}}
+	assert.True(t, reestablishWatch(req, io.EOF))
+	assert.Equal(t, "3", req.ListOptions.ResourceVersion)
+	assert.False(t, reestablishWatch(req, status.Error(codes.Unauthenticated, "")))
+	assert.True(t, reestablishWatch(req, status.Error(codes.OutOfRange, "too old resource version: 3")))
+	assert.Equal(t, "0", req.ListOptions.ResourceVersion)
+	req.ListOptions.ResourceVersion = "3"
+	assert.True(t, reestablishWatch(req, apierr.NewResourceExpired("too old resource version: 3")))
+	assert.Equal(t, "0", req.ListOptions.ResourceVersion)
+}
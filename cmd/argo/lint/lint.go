@@ -31,11 +31,13 @@ type ServiceClients struct {
 }
 
 type LintOptions struct {
-	Files            []string
-	Strict           bool
-	DefaultNamespace string
-	Formatter        Formatter
-	ServiceClients   ServiceClients
+	Files  []string
+	Strict bool
+	// StrictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced templates
+	StrictTemplateRefArguments bool
+	DefaultNamespace           string
+	Formatter                  Formatter
+	ServiceClients             ServiceClients
 
 	// Printer if not nil the lint result is written to this writer after each
 	// file is linted.
@@ -197,7 +199,7 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 			if err == nil {
 				_, err = opts.ServiceClients.ClusterWorkflowTemplateClient.LintClusterWorkflowTemplate(
 					ctx,
-					&clusterworkflowtemplatepkg.ClusterWorkflowTemplateLintRequest{Template: v},
+					&clusterworkflowtemplatepkg.ClusterWorkflowTemplateLintRequest{Template: v, StrictTemplateRefArguments: opts.StrictTemplateRefArguments},
 				)
 			}
 		case *wfv1.CronWorkflow:
@@ -210,7 +212,7 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 			if err == nil {
 				_, err = opts.ServiceClients.CronWorkflowsClient.LintCronWorkflow(
 					ctx,
-					&cronworkflowpkg.LintCronWorkflowRequest{Namespace: namespace, CronWorkflow: v},
+					&cronworkflowpkg.LintCronWorkflowRequest{Namespace: namespace, CronWorkflow: v, StrictTemplateRefArguments: opts.StrictTemplateRefArguments},
 				)
 			}
 		case *wfv1.Workflow:
@@ -223,7 +225,7 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 			if err == nil {
 				_, err = opts.ServiceClients.WorkflowsClient.LintWorkflow(
 					ctx,
-					&workflowpkg.WorkflowLintRequest{Namespace: namespace, Workflow: v},
+					&workflowpkg.WorkflowLintRequest{Namespace: namespace, Workflow: v, StrictTemplateRefArguments: opts.StrictTemplateRefArguments},
 				)
 			}
 		case *wfv1.WorkflowEventBinding:
@@ -238,7 +240,7 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 			if err == nil {
 				_, err = opts.ServiceClients.WorkflowTemplatesClient.LintWorkflowTemplate(
 					ctx,
-					&workflowtemplatepkg.WorkflowTemplateLintRequest{Namespace: namespace, Template: v},
+					&workflowtemplatepkg.WorkflowTemplateLintRequest{Namespace: namespace, Template: v, StrictTemplateRefArguments: opts.StrictTemplateRefArguments},
 				)
 			}
 		default:
@@ -8,6 +8,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 
@@ -24,7 +25,9 @@ func NewAgentCommand() *cobra.Command {
 		Use:          "agent",
 		SilenceUsage: true, // this prevents confusing usage message being printed on error
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return initAgentExecutor().Agent(context.Background())
+			ctx, shutdownTracing := initTracing(context.Background())
+			defer shutdownTracing()
+			return initAgentExecutor().Agent(ctx)
 		},
 	}
 }
@@ -51,6 +54,7 @@ func initAgentExecutor() *executor.AgentExecutor {
 	if !ok {
 		log.Fatalf("Unable to determine workflow name from environment variable %s", common.EnvVarWorkflowName)
 	}
+	logs.AddFieldsHook(logs.WorkflowFields(namespace, workflowName, types.UID(os.Getenv(common.EnvVarWorkflowUID))))
 
 	var addresses []string
 	if err := json.Unmarshal([]byte(os.Getenv(common.EnvVarPluginAddresses)), &addresses); err != nil {
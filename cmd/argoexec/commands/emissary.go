@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -104,6 +105,28 @@ func NewEmissaryCommand() *cobra.Command {
 				}
 			}
 
+			if template.IsMainContainerName(containerName) {
+				for _, y := range template.GetWaitForReadySidecarNames() {
+					logger.Infof("waiting for sidecar %q to be ready", y)
+					for {
+						if _, err := os.Stat(varRunArgo + "/ctr/" + y + "/ready"); err == nil {
+							break
+						}
+						if _, err := os.Stat(varRunArgo + "/ctr/" + y + "/exitcode"); err == nil {
+							return fmt.Errorf("sidecar %q exited before it was ready", y)
+						}
+						time.Sleep(time.Second)
+					}
+				}
+			}
+
+			stdinPipe, stdoutPipe, err := openPipes(template)
+			if err != nil {
+				return err
+			}
+			defer closePipe(stdinPipe)
+			defer closePipe(stdoutPipe)
+
 			name, err = exec.LookPath(name)
 			if err != nil {
 				return fmt.Errorf("failed to find name in PATH: %w", err)
@@ -141,6 +164,13 @@ func NewEmissaryCommand() *cobra.Command {
 				if err != nil {
 					return fmt.Errorf("failed to create command: %w", err)
 				}
+				if stdinPipe != nil {
+					command.Stdin = stdinPipe
+				}
+				if stdoutPipe != nil {
+					// the stream is the next container's input, not this one's log
+					command.Stdout = stdoutPipe
+				}
 
 				if err := command.Start(); err != nil {
 					return err
@@ -228,7 +258,7 @@ func createCommand(name string, args []string, template *wfv1.Template) (*exec.C
 	var stderr *os.File
 	var err error
 	// this may not be that important an optimisation, except for very long logs we don't want to capture
-	if includeScriptOutput || template.SaveLogsAsArtifact() {
+	if includeScriptOutput || template.SaveLogsAsArtifact() || (containerName == common.MainContainerName && template.Outputs.HasLogsRegexParameters()) {
 		logger.Info("capturing logs")
 		stdout, err = os.OpenFile(varRunArgo+"/ctr/"+containerName+"/stdout", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 		if err != nil {
@@ -246,6 +276,99 @@ func createCommand(name string, args []string, template *wfv1.Template) (*exec.C
 	return command, stdout, stderr, nil
 }
 
+// openPipes opens the pipes the container's stdin is read from, and its stdout is written to, if there are any. They are
+// opened at the same time, because opening one end of a pipe blocks until the other end is opened.
+func openPipes(template *wfv1.Template) (*os.File, *os.File, error) {
+	var stdin, stdout *os.File
+	var stdinErr, stdoutErr error
+	var wg sync.WaitGroup
+	if p := template.GetStdinPipe(containerName); p != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stdin, stdinErr = openPipe(*p, os.O_RDONLY, p.From)
+		}()
+	}
+	if p := template.GetStdoutPipe(containerName); p != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stdout, stdoutErr = openPipe(*p, os.O_WRONLY, p.To)
+		}()
+	}
+	wg.Wait()
+	if stdinErr != nil || stdoutErr != nil {
+		closePipe(stdin)
+		closePipe(stdout)
+		if stdinErr != nil {
+			return nil, nil, stdinErr
+		}
+		return nil, nil, stdoutErr
+	}
+	return stdin, stdout, nil
+}
+
+// openPipe creates the pipe, unless the other container has, and opens this container's end of it once the other
+// container opens its end. If the other container exits without doing so, the reader gets an empty stdin, and the
+// writer an error.
+func openPipe(p wfv1.ContainerPipe, flag int, other string) (*os.File, error) {
+	path := p.GetPath(varRunArgo)
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+		return nil, fmt.Errorf("failed to create pipes directory: %w", err)
+	}
+	if err := osspecific.Mkfifo(path); err != nil {
+		return nil, fmt.Errorf("failed to create pipe %q: %w", p.Name, err)
+	}
+	logger.Infof("waiting for container %q to open pipe %q", other, p.Name)
+	type result struct {
+		file *os.File
+		err  error
+	}
+	opened := make(chan result, 1)
+	go func() {
+		f, err := os.OpenFile(path, flag, 0)
+		opened <- result{f, err}
+	}()
+	for {
+		select {
+		case r := <-opened:
+			if r.err != nil {
+				return nil, fmt.Errorf("failed to open pipe %q: %w", p.Name, r.err)
+			}
+			return r.file, nil
+		case <-time.After(time.Second):
+			if _, err := os.Stat(varRunArgo + "/ctr/" + other + "/exitcode"); err != nil {
+				continue
+			}
+			// open the other end, so that our own open returns
+			otherFlag := os.O_RDONLY
+			if flag == os.O_RDONLY {
+				otherFlag = os.O_WRONLY
+			}
+			f, err := os.OpenFile(path, otherFlag, 0)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open pipe %q: %w", p.Name, err)
+			}
+			r := <-opened
+			_ = f.Close()
+			if r.err != nil {
+				return nil, fmt.Errorf("failed to open pipe %q: %w", p.Name, r.err)
+			}
+			if flag == os.O_WRONLY {
+				_ = r.file.Close()
+				return nil, fmt.Errorf("container %q exited without reading pipe %q", other, p.Name)
+			}
+			return r.file, nil
+		}
+	}
+}
+
+func closePipe(f *os.File) {
+	if f != nil {
+		_ = f.Close()
+	}
+}
+
 func saveArtifact(srcPath string) error {
 	if common.FindOverlappingVolume(template, srcPath) != nil {
 		logger.Infof("no need to save artifact - on overlapping volume: %s", srcPath)
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestEmissary(t *testing.T) {
@@ -189,6 +191,49 @@ func TestEmissary(t *testing.T) {
 	})
 }
 
+func TestOpenPipe(t *testing.T) {
+	varRunArgo = t.TempDir()
+	p := wfv1.ContainerPipe{Name: "my-pipe", From: "producer", To: "consumer"}
+	t.Run("Stream", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w, err := openPipe(p, os.O_WRONLY, p.To)
+			if assert.NoError(t, err) {
+				_, err = w.WriteString("hello")
+				assert.NoError(t, err)
+				assert.NoError(t, w.Close())
+			}
+		}()
+		r, err := openPipe(p, os.O_RDONLY, p.From)
+		if assert.NoError(t, err) {
+			data, err := ioutil.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, "hello", string(data))
+			assert.NoError(t, r.Close())
+		}
+		wg.Wait()
+	})
+	t.Run("ReaderExited", func(t *testing.T) {
+		assert.NoError(t, os.MkdirAll(varRunArgo+"/ctr/consumer", 0o777))
+		assert.NoError(t, ioutil.WriteFile(varRunArgo+"/ctr/consumer/exitcode", []byte("1"), 0o600))
+		_, err := openPipe(p, os.O_WRONLY, p.To)
+		assert.EqualError(t, err, `container "consumer" exited without reading pipe "my-pipe"`)
+	})
+	t.Run("WriterExited", func(t *testing.T) {
+		assert.NoError(t, os.MkdirAll(varRunArgo+"/ctr/producer", 0o777))
+		assert.NoError(t, ioutil.WriteFile(varRunArgo+"/ctr/producer/exitcode", []byte("1"), 0o600))
+		r, err := openPipe(p, os.O_RDONLY, p.From)
+		if assert.NoError(t, err) {
+			data, err := ioutil.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Empty(t, data)
+			assert.NoError(t, r.Close())
+		}
+	})
+}
+
 func run(name string, args []string) error {
 	cmd := NewEmissaryCommand()
 	containerName = "main"
@@ -11,9 +11,10 @@ import (
 func NewInitCommand() *cobra.Command {
 	command := cobra.Command{
 		Use:   "init",
-		Short: "Load artifacts",
+		Short: "Load artifacts, and secrets",
 		Run: func(cmd *cobra.Command, args []string) {
-			ctx := context.Background()
+			ctx, shutdownTracing := initTracing(context.Background())
+			defer shutdownTracing()
 			err := loadArtifacts(ctx)
 			if err != nil {
 				log.Fatalf("%+v", err)
@@ -43,5 +44,10 @@ func loadArtifacts(ctx context.Context) error {
 		wfExecutor.AddError(err)
 		return err
 	}
+	err = wfExecutor.LoadExternalSecrets(ctx)
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
 	return nil
 }
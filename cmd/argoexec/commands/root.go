@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -10,6 +11,7 @@ import (
 	kubecli "github.com/argoproj/pkg/kube/cli"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -18,7 +20,9 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/cmd"
+	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/docker"
@@ -97,6 +101,9 @@ func initExecutor() *executor.WorkflowExecutor {
 	if !ok {
 		log.Fatalf("Unable to determine pod name from environment variable %s", common.EnvVarPodName)
 	}
+	fields := logs.WorkflowFields(namespace, os.Getenv(common.EnvVarWorkflowName), types.UID(os.Getenv(common.EnvVarWorkflowUID)))
+	fields[logs.FieldNodeID] = os.Getenv(common.EnvVarNodeID)
+	logs.AddFieldsHook(fields)
 
 	tmpl := &wfv1.Template{}
 	checkErr(json.Unmarshal([]byte(os.Getenv(common.EnvVarTemplate)), tmpl))
@@ -126,6 +133,11 @@ func initExecutor() *executor.WorkflowExecutor {
 	checkErr(err)
 
 	wfExecutor := executor.NewExecutor(clientset, restClient, podName, namespace, cre, *tmpl, includeScriptOutput, deadline, annotationPatchTickDuration, progressFileTickDuration)
+	wfExecutor.MaxOutputParameterSize = env.LookupEnvIntOr(common.EnvVarMaxOutputParameterSize, 0)
+	wfExecutor.MaxOutputArtifacts = env.LookupEnvIntOr(common.EnvVarMaxOutputArtifacts, 0)
+	wfExecutor.ArtifactParallelism = env.LookupEnvIntOr(common.EnvVarArtifactParallelism, 0)
+	wfExecutor.DigestArtifacts = os.Getenv(common.EnvVarDigestArtifacts) == "true"
+	wfExecutor.ArtifactUploadFault = os.Getenv(common.EnvVarArtifactUploadFault)
 
 	log.
 		WithField("version", version.String()).
@@ -134,10 +146,27 @@ func initExecutor() *executor.WorkflowExecutor {
 		WithField("template", wfv1.MustMarshallJSON(&wfExecutor.Template)).
 		WithField("includeScriptOutput", includeScriptOutput).
 		WithField("deadline", deadline).
+		WithField("maxOutputParameterSize", wfExecutor.MaxOutputParameterSize).
+		WithField("maxOutputArtifacts", wfExecutor.MaxOutputArtifacts).
+		WithField("artifactParallelism", wfExecutor.ArtifactParallelism).
+		WithField("digestArtifacts", wfExecutor.DigestArtifacts).
+		WithField("artifactUploadFault", wfExecutor.ArtifactUploadFault).
 		Info("Executor initialized")
 	return &wfExecutor
 }
 
+// initTracing exports the spans of the executor, and returns the context, with the span that created the pod as the parent
+// of its spans, and a function that exports the spans not yet exported
+func initTracing(ctx context.Context) (context.Context, func()) {
+	shutdown, err := tracing.Init(ctx, "argo-executor")
+	checkErr(err)
+	return tracing.ContextFromEnv(ctx), func() {
+		if err := shutdown(context.Background()); err != nil {
+			log.WithError(err).Warn("failed to export spans")
+		}
+	}
+}
+
 // checkErr is a convenience function to panic upon error
 func checkErr(err error) {
 	if err != nil {
@@ -2,11 +2,14 @@ package commands
 
 import (
 	"context"
+	"os"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func NewWaitCommand() *cobra.Command {
@@ -14,7 +17,8 @@ func NewWaitCommand() *cobra.Command {
 		Use:   "wait",
 		Short: "wait for main container to finish and save artifacts",
 		Run: func(cmd *cobra.Command, args []string) {
-			ctx := context.Background()
+			ctx, shutdownTracing := initTracing(context.Background())
+			defer shutdownTracing()
 			err := waitContainer(ctx)
 			if err != nil {
 				log.Fatalf("%+v", err)
@@ -36,18 +40,33 @@ func waitContainer(ctx context.Context) error {
 		}
 	}()
 
+	if interval, _ := time.ParseDuration(os.Getenv(common.EnvVarHeartbeatInterval)); interval > 0 {
+		heartbeatCtx, stopHeartbeats := context.WithCancel(ctx)
+		defer stopHeartbeats()
+		go wfExecutor.Heartbeat(heartbeatCtx, interval)
+	}
+
+	checkpointsCtx, stopCheckpoints := context.WithCancel(ctx)
+	defer stopCheckpoints()
+	go wfExecutor.SaveCheckpoints(checkpointsCtx)
+
 	// Wait for main container to complete
 	err := wfExecutor.Wait(ctx)
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
+	// Saving the last checkpoint, which is not an error of the step if it fails
+	stopCheckpoints()
+	if err := wfExecutor.SaveCheckpoint(ctx); err != nil {
+		log.WithError(err).Warn("failed to save checkpoint")
+	}
 	// Capture output script result
 	err = wfExecutor.CaptureScriptResult(ctx)
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
 	// Saving logs
-	logArt, err := wfExecutor.SaveLogs(ctx)
+	logArts, err := wfExecutor.SaveLogs(ctx)
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
@@ -62,7 +81,7 @@ func waitContainer(ctx context.Context) error {
 		wfExecutor.AddError(err)
 	}
 	// Annotating pod with output
-	err = wfExecutor.AnnotateOutputs(ctx, logArt)
+	err = wfExecutor.AnnotateOutputs(ctx, logArts)
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
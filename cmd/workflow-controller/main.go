@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/pkg/cli"
@@ -27,8 +29,10 @@ import (
 	"github.com/argoproj/argo-workflows/v3"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
+	diagnosticsutil "github.com/argoproj/argo-workflows/v3/util/diagnostics"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
 	pprofutil "github.com/argoproj/argo-workflows/v3/util/pprof"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 )
@@ -57,6 +61,9 @@ func NewRootCommand() *cobra.Command {
 		namespaced               bool   // --namespaced
 		managedNamespace         string // --managed-namespace
 		executorPlugins          bool
+		faultInjection           bool // --fault-injection
+		pprof                    bool // --pprof
+		diagnostics              bool // --diagnostics
 	)
 
 	command := cobra.Command{
@@ -71,6 +78,12 @@ func NewRootCommand() *cobra.Command {
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
 			pprofutil.Init()
+			if pprof {
+				pprofutil.Enable()
+			}
+			if diagnostics {
+				diagnosticsutil.Enable()
+			}
 
 			config, err := clientConfig.ClientConfig()
 			if err != nil {
@@ -104,24 +117,41 @@ func NewRootCommand() *cobra.Command {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			wfController, err := controller.NewWorkflowController(ctx, config, kubeclientset, wfclientset, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap, executorPlugins)
+			shutdownTracing, err := tracing.Init(ctx, "workflow-controller")
+			errors.CheckError(err)
+			defer func() { _ = shutdownTracing(context.Background()) }()
+
+			wfController, err := controller.NewWorkflowController(ctx, config, kubeclientset, wfclientset, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap, executorPlugins, faultInjection)
 			errors.CheckError(err)
 
-			go wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podCleanupWorkers)
+			stopped := make(chan struct{})
+			go func() {
+				defer close(stopped)
+				wfController.Run(ctx, workflowWorkers, workflowTTLWorkers, podCleanupWorkers)
+			}()
 
 			http.HandleFunc("/healthz", wfController.Healthz)
+			http.HandleFunc("/log-level", wfController.LogLevel)
 
 			go func() {
 				log.Println(http.ListenAndServe(":6060", nil))
 			}()
 
-			// Wait forever
-			select {}
+			// Wait until terminated, e.g. by a rolling upgrade, then shut down gracefully, before releasing the lease
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+			log.WithField("signal", <-signals).Info("Received signal")
+			wfController.Shutdown(ctx)
+			cancel()
+			<-stopped
+			return nil
 		},
 	}
 
 	clientConfig = kubecli.AddKubectlFlagsToCmd(&command)
 	command.AddCommand(cmdutil.NewVersionCmd(CLIName))
+	command.AddCommand(NewMigrateCommand(clientConfig))
+	command.AddCommand(NewSimulateCommand())
 	command.Flags().StringVar(&configMap, "configmap", "workflow-controller-configmap", "Name of K8s configmap to retrieve workflow controller configuration")
 	command.Flags().StringVar(&executorImage, "executor-image", "", "Executor image to use (overrides value in configmap)")
 	command.Flags().StringVar(&executorImagePullPolicy, "executor-image-pull-policy", "", "Executor imagePullPolicy to use (overrides value in configmap)")
@@ -137,6 +167,9 @@ func NewRootCommand() *cobra.Command {
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run workflow-controller as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that workflow-controller watches, default to the installation namespace")
 	command.Flags().BoolVar(&executorPlugins, "executor-plugins", false, "enable executor plugins")
+	command.Flags().BoolVar(&faultInjection, "fault-injection", false, "inject the faults of the configmap into the pods of workflows, to test them. Never enable in production")
+	command.Flags().BoolVar(&pprof, "pprof", false, "serve pprof endpoints, at /debug/pprof, on port 6060")
+	command.Flags().BoolVar(&diagnostics, "diagnostics", false, "serve a dump of the controller's informer caches, queues, and locks, at /diagnostics, on port 6060")
 
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("ARGO")
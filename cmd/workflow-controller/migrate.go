@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+)
+
+// NewMigrateCommand returns a command that migrates, or downgrades, the schema of the persistence database, without
+// starting the controller
+func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	var (
+		configMap   string
+		dryRun      bool
+		downgradeTo int
+	)
+	command := &cobra.Command{
+		Use:   "migrate",
+		Short: "migrate the schema of the persistence database",
+		Example: `# Print the changes that would be applied:
+  workflow-controller migrate --dry-run
+
+# Apply them:
+  workflow-controller migrate
+
+# Revert the changes applied after schema version 60, e.g. before rolling back the controller:
+  workflow-controller migrate --downgrade-to 60`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := context.Background()
+			restConfig, err := clientConfig.ClientConfig()
+			if err != nil {
+				return err
+			}
+			namespace, _, err := clientConfig.Namespace()
+			if err != nil {
+				return err
+			}
+			kubeclientset := kubernetes.NewForConfigOrDie(restConfig)
+			v, err := config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc).Get(ctx)
+			if err != nil {
+				return err
+			}
+			persistence := v.(*config.Config).Persistence
+			if persistence == nil {
+				return fmt.Errorf("persistence is not configured in %s", configMap)
+			}
+			session, tableName, err := sqldb.CreateDBSession(kubeclientset, namespace, persistence)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = session.Close() }()
+			m := sqldb.NewMigrate(session, persistence.GetClusterName(), tableName)
+			if err := m.Validate(ctx); err != nil {
+				return err
+			}
+			if c.Flags().Changed("downgrade-to") {
+				plan, err := m.PlanDowngrade(ctx, downgradeTo)
+				if err != nil {
+					return err
+				}
+				printPlan("revert", plan)
+				if dryRun || len(plan) == 0 {
+					return nil
+				}
+				return m.Downgrade(ctx, downgradeTo)
+			}
+			plan, err := m.Plan(ctx)
+			if err != nil {
+				return err
+			}
+			printPlan("apply", plan)
+			if dryRun || len(plan) == 0 {
+				return nil
+			}
+			return m.Exec(ctx)
+		},
+	}
+	command.Flags().StringVar(&configMap, "configmap", "workflow-controller-configmap", "Name of K8s configmap to retrieve workflow controller configuration")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "print the changes, without applying, or reverting, them")
+	command.Flags().IntVar(&downgradeTo, "downgrade-to", 0, "revert the changes applied after this schema version")
+	return command
+}
+
+func printPlan(verb string, plan []sqldb.PlannedChange) {
+	if len(plan) == 0 {
+		fmt.Printf("No changes to %s\n", verb)
+		return
+	}
+	for _, p := range plan {
+		fmt.Printf("-- %s schema version %d\n%s\n\n", verb, p.Version, p.Change)
+	}
+}
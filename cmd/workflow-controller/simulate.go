@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/simulation"
+)
+
+// NewSimulateCommand returns a command that runs a workflow with a controller that has fake clients, to print the pods
+// it would create, without a cluster
+func NewSimulateCommand() *cobra.Command {
+	var (
+		configMapFile string
+		failPods      []string
+		maxRounds     int
+		output        string
+	)
+	command := &cobra.Command{
+		Use:   "simulate FILE...",
+		Short: "simulate a workflow, printing the pods it would create, without a cluster",
+		Long: `Simulate a workflow, printing the pods it would create, without a cluster.
+
+The files contain the workflow, and any workflow templates, and cluster workflow templates, it references. Every pod
+succeeds, unless its template is one of --fail, its output parameters are the defaults of their "valueFrom", and its
+output artifacts are at placeholder keys.
+
+The command fails if the workflow does not succeed, or stops progressing, e.g. as it is suspended.`,
+		Example: `# Print the pods of a workflow:
+  workflow-controller simulate my-wf.yaml my-wftmpl.yaml
+
+# Use the configuration of the controller, e.g. its workflow defaults:
+  workflow-controller simulate my-wf.yaml --configmap-file workflow-controller-configmap.yaml
+
+# Check what happens when the pods of a template fail:
+  workflow-controller simulate my-wf.yaml --fail flaky-step`,
+		Args:          cobra.MinimumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := context.Background()
+			log.SetLevel(log.ErrorLevel)
+			opts := controller.SimulateOpts{MaxRounds: maxRounds}
+			var wfs []wfv1.Workflow
+			for _, file := range args {
+				body, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				w, err := common.SplitWorkflowYAMLFile(body, true)
+				if err != nil {
+					return err
+				}
+				wfs = append(wfs, w...)
+				wftmpls, err := common.SplitWorkflowTemplateYAMLFile(body, true)
+				if err != nil {
+					return err
+				}
+				opts.WorkflowTemplates = append(opts.WorkflowTemplates, wftmpls...)
+				cwftmpls, err := common.SplitClusterWorkflowTemplateYAMLFile(body, true)
+				if err != nil {
+					return err
+				}
+				opts.ClusterWorkflowTemplates = append(opts.ClusterWorkflowTemplates, cwftmpls...)
+			}
+			if len(wfs) != 1 {
+				return fmt.Errorf("expected exactly one workflow, got %d", len(wfs))
+			}
+			if configMapFile != "" {
+				cfg, err := readConfigMapFile(configMapFile)
+				if err != nil {
+					return err
+				}
+				opts.Config = *cfg
+			}
+			if len(failPods) > 0 {
+				fail := map[string]bool{}
+				for _, t := range failPods {
+					fail[t] = true
+				}
+				opts.PodPhase = func(pod *apiv1.Pod) apiv1.PodPhase {
+					if fail[podTemplateName(pod)] {
+						return apiv1.PodFailed
+					}
+					return apiv1.PodSucceeded
+				}
+			}
+			sim, simErr := simulation.Simulate(ctx, &wfs[0], opts)
+			if sim != nil {
+				if err := printSimulation(sim, output); err != nil {
+					return err
+				}
+			}
+			if simErr != nil {
+				return simErr
+			}
+			if status := sim.Workflow.Status; status.Phase != wfv1.WorkflowSucceeded {
+				return fmt.Errorf("workflow %s", strings.TrimSpace(string(status.Phase)+" "+status.Message))
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&configMapFile, "configmap-file", "", "file of the workflow controller configmap to simulate the controller with")
+	command.Flags().StringSliceVar(&failPods, "fail", nil, "templates whose pods fail")
+	command.Flags().IntVar(&maxRounds, "max-rounds", 1000, "maximum number of times the workflow is reconciled")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|wide")
+	return command
+}
+
+// readConfigMapFile reads the controller's config from the configmap in the file, as the controller would
+func readConfigMapFile(file string) (*config.Config, error) {
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	cm := &apiv1.ConfigMap{}
+	if err := yaml.UnmarshalStrict(body, cm); err != nil {
+		return nil, err
+	}
+	v, err := config.ParseConfigMap(cm, config.EmptyConfigFunc)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*config.Config), nil
+}
+
+// podTemplateName returns the name of the template the pod runs
+func podTemplateName(pod *apiv1.Pod) string {
+	for _, c := range pod.Spec.Containers {
+		for _, e := range c.Env {
+			if e.Name == common.EnvVarTemplate {
+				tmpl := &wfv1.Template{}
+				if err := json.Unmarshal([]byte(e.Value), tmpl); err == nil {
+					return tmpl.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func printSimulation(sim *controller.Simulation, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(sim, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	case "", "wide":
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if output == "wide" {
+		_, _ = fmt.Fprintln(w, "ROUND\tPOD\tNODE\tTEMPLATE\tPHASE")
+	} else {
+		_, _ = fmt.Fprintln(w, "ROUND\tNODE\tTEMPLATE\tPHASE")
+	}
+	for _, p := range sim.Pods {
+		if output == "wide" {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", p.Round, p.Name, p.NodeName, p.TemplateName, p.Phase)
+		} else {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", p.Round, p.NodeName, p.TemplateName, p.Phase)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\nWorkflow %s after %d rounds, creating %d pods\n", sim.Workflow.Status.Phase, sim.Rounds, len(sim.Pods))
+	return nil
+}
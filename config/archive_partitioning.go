@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArchivePartitioning partitions the archived workflows table by when the workflows finished, so that expired
+// workflows are removed by dropping whole partitions, rather than deleting them row by row
+type ArchivePartitioning struct {
+	// Interval is the period of time that the workflows in each partition finished within, a whole number of hours,
+	// e.g. "12h", or "7d". Default is "1d"
+	Interval TTL `json:"interval,omitempty"`
+	// Premake is the number of partitions created ahead of time. Default is 7
+	Premake int `json:"premake,omitempty"`
+}
+
+func (p ArchivePartitioning) GetInterval() time.Duration {
+	if p.Interval > 0 {
+		return time.Duration(p.Interval)
+	}
+	return 24 * time.Hour
+}
+
+func (p ArchivePartitioning) GetPremake() int {
+	if p.Premake > 0 {
+		return p.Premake
+	}
+	return 7
+}
+
+func (p *ArchivePartitioning) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Interval < 0 || time.Duration(p.Interval)%time.Hour != 0 {
+		return fmt.Errorf("archivePartitioning interval %v must be a whole number of hours", time.Duration(p.Interval))
+	}
+	if p.Premake < 0 {
+		return fmt.Errorf("archivePartitioning premake must not be negative")
+	}
+	return nil
+}
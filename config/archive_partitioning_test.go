@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchivePartitioning(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		p := ArchivePartitioning{}
+		assert.Equal(t, 24*time.Hour, p.GetInterval())
+		assert.Equal(t, 7, p.GetPremake())
+		assert.NoError(t, p.Validate())
+	})
+	t.Run("Nil", func(t *testing.T) {
+		var p *ArchivePartitioning
+		assert.NoError(t, p.Validate())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.EqualError(t, (&ArchivePartitioning{Interval: TTL(90 * time.Minute)}).Validate(), "archivePartitioning interval 1h30m0s must be a whole number of hours")
+		assert.EqualError(t, (&ArchivePartitioning{Premake: -1}).Validate(), "archivePartitioning premake must not be negative")
+	})
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ArtifactRetention is the retention tiers of the output artifacts of completed workflows, by the workflows' labels,
+// so that storage policies are enforced centrally, rather than by each template, e.g. workflows labelled
+// `retention: short` have their artifacts deleted 7 days after they complete, and those labelled `retention: audit`
+// never do. The first rule that selects a workflow applies, and the artifacts of workflows no rule selects are kept
+type ArtifactRetention []ArtifactRetentionRule
+
+// ArtifactRetentionRule is a retention tier
+type ArtifactRetentionRule struct {
+	// Selector selects workflows by their labels. An empty selector selects every workflow
+	Selector metav1.LabelSelector `json:"selector"`
+	// TTL is how long after a workflow completes its artifacts are deleted, e.g. "7d". They are never deleted if zero
+	TTL TTL `json:"ttl,omitempty"`
+}
+
+// Validate returns an error if the selector of any rule is not valid
+func (r ArtifactRetention) Validate() error {
+	for i, rule := range r {
+		if _, err := metav1.LabelSelectorAsSelector(&rule.Selector); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+		if rule.TTL < 0 {
+			return fmt.Errorf("rule %d: ttl must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// GetTTL returns the TTL of the first rule that selects the workflow with the labels, or false if none does
+func (r ArtifactRetention) GetTTL(workflowLabels labels.Labels) (time.Duration, bool) {
+	for _, rule := range r {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.Selector)
+		if err == nil && selector.Matches(workflowLabels) {
+			return time.Duration(rule.TTL), true
+		}
+	}
+	return 0, false
+}
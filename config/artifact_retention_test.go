@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+func TestArtifactRetention(t *testing.T) {
+	var r ArtifactRetention
+	err := yaml.Unmarshal([]byte(`
+- selector:
+    matchLabels:
+      retention: audit
+- selector:
+    matchLabels:
+      retention: short
+  ttl: 7d
+- selector: {}
+  ttl: 30d
+`), &r)
+	if assert.NoError(t, err) {
+		assert.NoError(t, r.Validate())
+		ttl, ok := r.GetTTL(labels.Set{"retention": "audit"})
+		assert.True(t, ok)
+		assert.Zero(t, ttl)
+		ttl, ok = r.GetTTL(labels.Set{"retention": "short"})
+		assert.True(t, ok)
+		assert.Equal(t, 7*24*time.Hour, ttl)
+		ttl, ok = r.GetTTL(labels.Set{})
+		assert.True(t, ok)
+		assert.Equal(t, 30*24*time.Hour, ttl)
+	}
+	t.Run("NotSelected", func(t *testing.T) {
+		_, ok := r[:2].GetTTL(labels.Set{})
+		assert.False(t, ok)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		r := ArtifactRetention{{Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "retention", Operator: "Like"}}}}}
+		assert.Error(t, r.Validate())
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -25,6 +26,27 @@ type Config struct {
 	// NodeEvents configures how node events are emitted
 	NodeEvents NodeEvents `json:"nodeEvents,omitempty"`
 
+	// Heartbeats configures the wait containers, and agents, to report heartbeats, which the controller surfaces as the
+	// AgentUnresponsive and ExecutorUnresponsive workflow conditions
+	Heartbeats *Heartbeats `json:"heartbeats,omitempty"`
+
+	// ResourceUsage configures the controller to sample the CPU, and memory, usage of the main containers, which is
+	// recorded in the nodes' statuses
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+
+	// OutputLimits limits the size of output parameters, and the number of output artifacts, of each node
+	OutputLimits *OutputLimits `json:"outputLimits,omitempty"`
+
+	// ArtifactParallelism is the number of input artifacts each node loads, or output artifacts it saves, at the same
+	// time. Default is one at a time
+	ArtifactParallelism int `json:"artifactParallelism,omitempty"`
+
+	// Logging configures the log levels of the controller
+	Logging *Logging `json:"logging,omitempty"`
+
+	// EventSinks publishes workflow and node lifecycle events to Kafka, NATS, or CloudEvents HTTP endpoints
+	EventSinks *EventSinks `json:"eventSinks,omitempty"`
+
 	// ExecutorImage is the image name of the executor to use when running pods
 	// DEPRECATED: use --executor-image flag to workflow-controller instead
 	ExecutorImage string `json:"executorImage,omitempty"`
@@ -87,9 +109,38 @@ type Config struct {
 	// NamespaceParallelism limits the max workflows that can execute at the same time in a namespace
 	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
 
+	// MaxTemplateDepth limits how deeply steps, and DAG, templates may be nested, e.g. by a recursive template, so
+	// that runaway recursion errors rather than creating nodes until the controller runs out of memory. Zero is
+	// unlimited
+	MaxTemplateDepth int `json:"maxTemplateDepth,omitempty"`
+
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
+	// PodCreationBackpressure delays the creation of pods while the cluster is saturated, i.e. too many pods are
+	// pending, or resource quotas have too little headroom
+	PodCreationBackpressure *PodCreationBackpressure `json:"podCreationBackpressure,omitempty"`
+
+	// FreezeWindows are recurring windows, e.g. for cluster maintenance, during which no pods are created for the
+	// workflows they select
+	FreezeWindows FreezeWindows `json:"freezeWindows,omitempty"`
+
+	// EphemeralNamespaces is the template of the namespaces created for the pods of the workflows it selects, one per
+	// workflow. Requires the controller to manage every namespace
+	EphemeralNamespaces *EphemeralNamespaces `json:"ephemeralNamespaces,omitempty"`
+
+	// FaultInjection is the faults injected into the pods of the workflows they select, to test the retry strategies,
+	// and exit handlers, of templates. Requires the controller to be run with --fault-injection
+	FaultInjection FaultInjection `json:"faultInjection,omitempty"`
+
+	// NetworkPolicyEgress is the egress always allowed from the pods of templates with a network policy, e.g. to the
+	// Kubernetes API, and the artifact repository, which the executor connects to
+	NetworkPolicyEgress []networkingv1.NetworkPolicyEgressRule `json:"networkPolicyEgress,omitempty"`
+
+	// NetworkPolicyDNS is the cluster DNS, which the pods of templates with a network policy can connect to on port 53.
+	// Defaults to the kube-dns pods of the kube-system namespace
+	NetworkPolicyDNS []networkingv1.NetworkPolicyPeer `json:"networkPolicyDNS,omitempty"`
+
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
@@ -125,8 +176,37 @@ type Config struct {
 	// https://argoproj.github.io/argo-workflows/workflow-executors/#emissary-emissary
 	Images map[string]Image `json:"images,omitempty"`
 
+	// ScriptRuntimes are the runtimes, by name, that script templates can use with `script.runtime`
+	ScriptRuntimes map[string]ScriptRuntime `json:"scriptRuntimes,omitempty"`
+
 	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
 
+	// ArtifactRetention is the retention tiers of the artifacts of completed workflows, by the workflows' labels
+	ArtifactRetention ArtifactRetention `json:"artifactRetention,omitempty"`
+
+	// Costs are the prices the estimated costs of workflows are computed with, if they are estimated
+	Costs *Costs `json:"costs,omitempty"`
+
+	// Spot configures the scheduling of the templates with a spot policy
+	Spot *Spot `json:"spot,omitempty"`
+
+	// SchedulingPresets are the default node selectors, tolerations and affinities of the pods of templates, by the
+	// labels of the templates' metadata
+	SchedulingPresets SchedulingPresets `json:"schedulingPresets,omitempty"`
+
+	// Sandbox is the default runtime class, and user namespace, of the pods of templates
+	Sandbox *Sandbox `json:"sandbox,omitempty"`
+
+	// ImagePolicy restricts the images of the containers of workflows, by namespace
+	ImagePolicy *ImagePolicy `json:"imagePolicy,omitempty"`
+
+	// PodTuning restricts the DNS policies, nameservers, and sysctls of the pods of templates
+	PodTuning *PodTuning `json:"podTuning,omitempty"`
+
+	// PlatformImages are the images the pods of templates with platforms run on each platform, instead of images that
+	// are not multi-arch
+	PlatformImages PlatformImages `json:"platformImages,omitempty"`
+
 	// NavColor is an ui navigation bar background color
 	NavColor string `json:"navColor,omitempty"`
 }
@@ -152,6 +232,18 @@ func (c Config) GetResourceRateLimit() ResourceRateLimit {
 	}
 }
 
+// GetNetworkPolicyDNS returns the cluster DNS, by default the kube-dns pods of the kube-system namespace, which is
+// what CoreDNS and kube-dns are both labelled as
+func (c Config) GetNetworkPolicyDNS() []networkingv1.NetworkPolicyPeer {
+	if len(c.NetworkPolicyDNS) > 0 {
+		return c.NetworkPolicyDNS
+	}
+	return []networkingv1.NetworkPolicyPeer{{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "kube-system"}},
+		PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}},
+	}}
+}
+
 func (c Config) GetPodGCDeleteDelayDuration() time.Duration {
 	if c.PodGCDeleteDelayDuration == nil {
 		return 5 * time.Second
@@ -188,12 +280,29 @@ type PersistConfig struct {
 	// ArchivelabelSelector holds LabelSelector to determine workflow persistence.
 	ArchiveLabelSelector *metav1.LabelSelector `json:"archiveLabelSelector,omitempty"`
 	// in days
-	ArchiveTTL     TTL               `json:"archiveTTL,omitempty"`
-	ClusterName    string            `json:"clusterName,omitempty"`
-	ConnectionPool *ConnectionPool   `json:"connectionPool,omitempty"`
-	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
-	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
-	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	ArchiveTTL TTL `json:"archiveTTL,omitempty"`
+	// ArchivePartitioning partitions the archive table, so that workflows older than the TTL are removed by dropping
+	// whole partitions
+	ArchivePartitioning *ArchivePartitioning `json:"archivePartitioning,omitempty"`
+	// WorkflowSnapshots periodically saves snapshots of the statuses of workflows, so their status can be got as of a time
+	WorkflowSnapshots *WorkflowSnapshots `json:"workflowSnapshots,omitempty"`
+	// PendingWorkflowQueue keeps the queues of the workflows pending because of the parallelism limits in the database,
+	// rather than in memory, so the order they are admitted in survives the controller restarting. Changing it requires
+	// restarting the controller
+	PendingWorkflowQueue bool              `json:"pendingWorkflowQueue,omitempty"`
+	ClusterName          string            `json:"clusterName,omitempty"`
+	ConnectionPool       *ConnectionPool   `json:"connectionPool,omitempty"`
+	PostgreSQL           *PostgreSQLConfig `json:"postgresql,omitempty"`
+	MySQL                *MySQLConfig      `json:"mysql,omitempty"`
+	SQLite               *SQLiteConfig     `json:"sqlite,omitempty"`
+	// Redis offloads node statuses to Redis, rather than to the database, which is only needed to archive workflows
+	Redis         *RedisConfig `json:"redis,omitempty"`
+	SkipMigration bool         `json:"skipMigration,omitempty"`
+}
+
+// HasDatabase returns true if a relational database is configured, which archiving workflows requires
+func (c PersistConfig) HasDatabase() bool {
+	return c.PostgreSQL != nil || c.MySQL != nil || c.SQLite != nil
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -223,6 +332,9 @@ type DatabaseConfig struct {
 	TableName      string                  `json:"tableName,omitempty"`
 	UsernameSecret apiv1.SecretKeySelector `json:"userNameSecret,omitempty"`
 	PasswordSecret apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+	// ReadReplica is a replica of the database, that the Argo Server lists, and searches, archived workflows from, so
+	// that those queries do not load the primary, that the controller writes to
+	ReadReplica *ReadReplicaConfig `json:"readReplica,omitempty"`
 }
 
 func (c DatabaseConfig) GetHostname() string {
@@ -232,6 +344,34 @@ func (c DatabaseConfig) GetHostname() string {
 	return fmt.Sprintf("%s:%v", c.Host, c.Port)
 }
 
+// GetReadReplica returns the config of the read replica, which is that of the database, except for the host, port, and,
+// if they are set, the secrets
+func (c DatabaseConfig) GetReadReplica() DatabaseConfig {
+	r := c
+	r.ReadReplica = nil
+	if c.ReadReplica == nil {
+		return r
+	}
+	r.Host = c.ReadReplica.Host
+	r.Port = c.ReadReplica.Port
+	if c.ReadReplica.UsernameSecret.Name != "" {
+		r.UsernameSecret = c.ReadReplica.UsernameSecret
+	}
+	if c.ReadReplica.PasswordSecret.Name != "" {
+		r.PasswordSecret = c.ReadReplica.PasswordSecret
+	}
+	return r
+}
+
+// ReadReplicaConfig is a read-only replica of a database
+type ReadReplicaConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port,omitempty"`
+	// UsernameSecret, and PasswordSecret, default to those of the database
+	UsernameSecret apiv1.SecretKeySelector `json:"userNameSecret,omitempty"`
+	PasswordSecret apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
 type PostgreSQLConfig struct {
 	DatabaseConfig
 	SSL     bool   `json:"ssl,omitempty"`
@@ -243,6 +383,44 @@ type MySQLConfig struct {
 	Options map[string]string `json:"options,omitempty"`
 }
 
+// SQLiteConfig is a database in a file, for installs with one controller replica, where running Postgres, or MySQL,
+// is not worth it
+type SQLiteConfig struct {
+	// Path of the database file, which must be on a local, persistent, volume
+	Path      string `json:"path"`
+	TableName string `json:"tableName,omitempty"`
+}
+
+// RedisConfig is a Redis server that node statuses are offloaded to
+type RedisConfig struct {
+	// Address is the host and port of the server, e.g. "redis:6379"
+	Address        string                  `json:"address"`
+	DB             int                     `json:"db,omitempty"`
+	UsernameSecret apiv1.SecretKeySelector `json:"userNameSecret,omitempty"`
+	PasswordSecret apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+	// TLS connects to the server with TLS
+	TLS bool `json:"tls,omitempty"`
+	// KeyPrefix is the prefix of the keys, so that installs may share a server. Default is "argo"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+	// CompletedTTL is how long the node statuses of completed workflows are kept, it should be longer than they are
+	// kept in the cluster. Default is 7d
+	CompletedTTL TTL `json:"completedTTL,omitempty"`
+}
+
+func (c RedisConfig) GetKeyPrefix() string {
+	if c.KeyPrefix == "" {
+		return "argo"
+	}
+	return c.KeyPrefix
+}
+
+func (c RedisConfig) GetCompletedTTL() time.Duration {
+	if c.CompletedTTL <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return time.Duration(c.CompletedTTL)
+}
+
 // MetricsConfig defines a config for a metrics server
 type MetricsConfig struct {
 	// Enabled controls metric emission. Default is true, set "enabled: false" to turn off
@@ -260,6 +438,57 @@ type MetricsConfig struct {
 	IgnoreErrors bool `json:"ignoreErrors,omitempty"`
 	// Secure is a flag that starts the metrics servers using TLS
 	Secure *bool `json:"secure,omitempty"`
+	// NamespaceLimit is the maximum number of namespaces that have their own per-namespace workflow queue and operation
+	// metrics. The metrics of any other namespaces are labelled "_other". Default is 50, set to 0 to turn them off
+	NamespaceLimit *int `json:"namespaceLimit,omitempty"`
+	// MaxCustomMetrics is the maximum number of custom metric series. Once reached, new series are not emitted until
+	// old ones expire, see MetricsTTL. Default is 0, no limit
+	MaxCustomMetrics int `json:"maxCustomMetrics,omitempty"`
+	// DeniedLabelKeys is a list of label keys that custom metrics must not have, e.g. labels whose values are unique to
+	// each item of a loop. Metrics with one of these keys are not emitted
+	DeniedLabelKeys []string `json:"deniedLabelKeys,omitempty"`
+	// Push pushes metrics to a remote endpoint, for when they cannot be scraped, e.g. from restricted networks
+	Push *MetricsPushConfig `json:"push,omitempty"`
+	// SLO emits the rolling success rate, and duration, objective metrics of workflows, by namespace and template
+	SLO *SLOConfig `json:"slo,omitempty"`
+}
+
+type MetricsPushProtocol string
+
+const (
+	MetricsPushProtocolRemoteWrite MetricsPushProtocol = "remote-write"
+	MetricsPushProtocolOTLP        MetricsPushProtocol = "otlp"
+)
+
+// MetricsPushConfig defines a config for pushing metrics
+type MetricsPushConfig struct {
+	// Protocol is "remote-write", to push to a Prometheus remote write endpoint, or "otlp", to push to an OTLP/gRPC
+	// endpoint. Default is "remote-write"
+	Protocol MetricsPushProtocol `json:"protocol,omitempty"`
+	// Endpoint is the URL of the remote write endpoint, e.g. "https://prometheus:9090/api/v1/write", or the address of
+	// the OTLP endpoint, e.g. "otel-collector:4317"
+	Endpoint string `json:"endpoint"`
+	// Interval is how often metrics are pushed. Default is 30s
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Insecure connects to an OTLP endpoint without TLS, or to an HTTPS remote write endpoint without verifying its
+	// certificate
+	Insecure bool `json:"insecure,omitempty"`
+	// BearerTokenFile is the path of a file that contains a token to authenticate with, e.g. a mounted secret
+	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+}
+
+func (c MetricsPushConfig) GetProtocol() MetricsPushProtocol {
+	if c.Protocol == "" {
+		return MetricsPushProtocolRemoteWrite
+	}
+	return c.Protocol
+}
+
+func (c MetricsPushConfig) GetInterval() time.Duration {
+	if c.Interval == nil || c.Interval.Duration <= 0 {
+		return 30 * time.Second
+	}
+	return c.Interval.Duration
 }
 
 func (mc MetricsConfig) GetSecure(defaultValue bool) bool {
@@ -269,6 +498,13 @@ func (mc MetricsConfig) GetSecure(defaultValue bool) bool {
 	return defaultValue
 }
 
+func (mc MetricsConfig) GetNamespaceLimit(defaultValue int) int {
+	if mc.NamespaceLimit != nil {
+		return *mc.NamespaceLimit
+	}
+	return defaultValue
+}
+
 type WorkflowRestrictions struct {
 	TemplateReferencing TemplateReferencing `json:"templateReferencing,omitempty"`
 }
@@ -2,8 +2,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -13,6 +15,44 @@ func TestDatabaseConfig(t *testing.T) {
 	assert.Equal(t, "my-host:1234", DatabaseConfig{Host: "my-host", Port: 1234}.GetHostname())
 }
 
+func TestDatabaseConfig_GetReadReplica(t *testing.T) {
+	primary := DatabaseConfig{
+		Host:           "my-host",
+		Port:           1234,
+		Database:       "my-db",
+		TableName:      "my-table",
+		UsernameSecret: apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "primary"}, Key: "username"},
+		PasswordSecret: apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "primary"}, Key: "password"},
+	}
+	t.Run("Secrets", func(t *testing.T) {
+		c := primary
+		c.ReadReplica = &ReadReplicaConfig{Host: "my-replica"}
+		r := c.GetReadReplica()
+		assert.Equal(t, "my-replica", r.GetHostname())
+		assert.Equal(t, "my-db", r.Database)
+		assert.Equal(t, "my-table", r.TableName)
+		assert.Equal(t, primary.UsernameSecret, r.UsernameSecret)
+		assert.Equal(t, primary.PasswordSecret, r.PasswordSecret)
+		assert.Nil(t, r.ReadReplica)
+	})
+	t.Run("OwnSecrets", func(t *testing.T) {
+		c := primary
+		replicaSecret := apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "replica"}, Key: "password"}
+		c.ReadReplica = &ReadReplicaConfig{Host: "my-replica", Port: 5678, PasswordSecret: replicaSecret}
+		r := c.GetReadReplica()
+		assert.Equal(t, "my-replica:5678", r.GetHostname())
+		assert.Equal(t, primary.UsernameSecret, r.UsernameSecret)
+		assert.Equal(t, replicaSecret, r.PasswordSecret)
+	})
+}
+
+func TestRedisConfig(t *testing.T) {
+	assert.Equal(t, "argo", RedisConfig{}.GetKeyPrefix())
+	assert.Equal(t, "my-prefix", RedisConfig{KeyPrefix: "my-prefix"}.GetKeyPrefix())
+	assert.Equal(t, 7*24*time.Hour, RedisConfig{}.GetCompletedTTL())
+	assert.Equal(t, time.Hour, RedisConfig{CompletedTTL: TTL(time.Hour)}.GetCompletedTTL())
+}
+
 func TestContainerRuntimeExecutor(t *testing.T) {
 	t.Run("Default", func(t *testing.T) {
 		c := Config{ContainerRuntimeExecutor: "foo"}
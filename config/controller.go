@@ -50,7 +50,12 @@ func (cc *controller) updateConfig(cm *apiv1.ConfigMap, onChange func(config int
 }
 
 func (cc *controller) parseConfigMap(cm *apiv1.ConfigMap) (interface{}, error) {
-	config := cc.emptyConfigFunc()
+	return ParseConfigMap(cm, cc.emptyConfigFunc)
+}
+
+// ParseConfigMap parses the config in the config map, as the controller does, into the config emptyConfigFunc returns
+func ParseConfigMap(cm *apiv1.ConfigMap, emptyConfigFunc func() interface{}) (interface{}, error) {
+	config := emptyConfigFunc()
 	if cm == nil {
 		return config, nil
 	}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/resource"
+)
+
+// Costs configures the estimated costs of workflows, computed from the resources duration of their pods, so that teams
+// can be charged back for the compute they use. Like resources duration, the estimates are indicative, but not accurate.
+// The costs are in the currency of the prices
+type Costs struct {
+	// Prices are the prices of an hour of each resource's base amount, as in the resources duration: a CPU, 1Gi of
+	// memory, 10Gi of storage, and one of any other resource, e.g. nvidia.com/gpu. Resources without a price are free
+	Prices map[apiv1.ResourceName]float64 `json:"prices,omitempty"`
+	// Multipliers are billing hints, e.g. that spot nodes are cheaper. The prices of a pod are multiplied by the first
+	// multiplier whose node selector the pod's node selector includes
+	Multipliers []CostMultiplier `json:"multipliers,omitempty"`
+}
+
+// CostMultiplier multiplies the prices of the pods that select its nodes
+type CostMultiplier struct {
+	// NodeSelector is the node labels a pod's node selector must include, e.g. karpenter.sh/capacity-type: spot
+	NodeSelector map[string]string `json:"nodeSelector"`
+	// Multiplier is what the prices are multiplied by, e.g. 0.3
+	Multiplier float64 `json:"multiplier"`
+}
+
+func (m CostMultiplier) matches(nodeSelector map[string]string) bool {
+	for k, v := range m.NodeSelector {
+		if nodeSelector[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// GetMultiplier returns the multiplier of the prices of a pod with the node selector
+func (c *Costs) GetMultiplier(nodeSelector map[string]string) float64 {
+	for _, m := range c.Multipliers {
+		if m.matches(nodeSelector) {
+			return m.Multiplier
+		}
+	}
+	return 1
+}
+
+// EstimateCost returns the estimated cost of a pod's resources duration, or nil if costs are not configured
+func (c *Costs) EstimateCost(d wfv1.ResourcesDuration, nodeSelector map[string]string) *wfv1.Amount {
+	if c == nil || len(c.Prices) == 0 {
+		return nil
+	}
+	cost := 0.0
+	for name, duration := range d {
+		cost += duration.Duration().Hours() * c.Prices[name]
+	}
+	return resource.NewEstimatedCost(cost * c.GetMultiplier(nodeSelector))
+}
+
+// Validate returns an error if a price, or multiplier, is negative, or a multiplier matches every pod
+func (c *Costs) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for name, price := range c.Prices {
+		if price < 0 {
+			return fmt.Errorf("costs price of %s must not be negative", name)
+		}
+	}
+	for i, m := range c.Multipliers {
+		if len(m.NodeSelector) == 0 {
+			return fmt.Errorf("costs multipliers[%d].nodeSelector must not be empty", i)
+		}
+		if m.Multiplier < 0 {
+			return fmt.Errorf("costs multipliers[%d].multiplier must not be negative", i)
+		}
+	}
+	return nil
+}
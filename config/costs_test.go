@@ -0,0 +1,42 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestCosts(t *testing.T) {
+	d := wfv1.ResourcesDuration{
+		apiv1.ResourceCPU:    wfv1.NewResourceDuration(2 * time.Hour),
+		apiv1.ResourceMemory: wfv1.NewResourceDuration(30 * time.Minute),
+		"nvidia.com/gpu":     wfv1.NewResourceDuration(time.Hour),
+	}
+	c := &Costs{
+		Prices:      map[apiv1.ResourceName]float64{apiv1.ResourceCPU: 0.04, apiv1.ResourceMemory: 0.005},
+		Multipliers: []CostMultiplier{{NodeSelector: map[string]string{"capacity-type": "spot"}, Multiplier: 0.5}},
+	}
+	t.Run("Nil", func(t *testing.T) {
+		var c *Costs
+		assert.Nil(t, c.EstimateCost(d, nil))
+		assert.NoError(t, c.Validate())
+	})
+	t.Run("NoPrices", func(t *testing.T) {
+		assert.Nil(t, (&Costs{}).EstimateCost(d, nil))
+	})
+	t.Run("EstimateCost", func(t *testing.T) {
+		assert.Equal(t, wfv1.NewAmount(0.0825), c.EstimateCost(d, nil))
+		assert.Equal(t, wfv1.NewAmount(0.0825), c.EstimateCost(d, map[string]string{"capacity-type": "on-demand"}))
+		assert.Equal(t, wfv1.NewAmount(0.04125), c.EstimateCost(d, map[string]string{"capacity-type": "spot", "zone": "a"}))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, c.Validate())
+		assert.EqualError(t, (&Costs{Prices: map[apiv1.ResourceName]float64{apiv1.ResourceCPU: -1}}).Validate(), "costs price of cpu must not be negative")
+		assert.EqualError(t, (&Costs{Multipliers: []CostMultiplier{{Multiplier: 0.5}}}).Validate(), "costs multipliers[0].nodeSelector must not be empty")
+		assert.EqualError(t, (&Costs{Multipliers: []CostMultiplier{{NodeSelector: map[string]string{"a": "b"}, Multiplier: -1}}}).Validate(), "costs multipliers[0].multiplier must not be negative")
+	})
+}
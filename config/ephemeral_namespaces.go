@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// EphemeralNamespaces is the template of the namespaces the controller creates for the pods of the workflows it
+// selects, one per workflow, which are deleted with the workflow. They isolate the pods of untrusted workflows from
+// the other workflows, and the other resources, of their namespace
+type EphemeralNamespaces struct {
+	// Namespaces are the namespaces of the workflows that have ephemeral namespaces. Default is every namespace
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects the workflows that have ephemeral namespaces by their labels. Default is every workflow
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Labels of the ephemeral namespaces, e.g. to enforce a pod security standard
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the ephemeral namespaces
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ResourceQuota limits the resources of the pods of a workflow
+	ResourceQuota *apiv1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange is the default, and limits of the, resources of the containers of a workflow
+	LimitRange *apiv1.LimitRangeSpec `json:"limitRange,omitempty"`
+	// NetworkPolicies restrict the traffic of the pods of a workflow, e.g. to deny ingress
+	NetworkPolicies []networkingv1.NetworkPolicySpec `json:"networkPolicies,omitempty"`
+	// ClusterRole is bound to the service accounts of the ephemeral namespace, e.g. so that the executor can patch
+	// the pods. The controller must be able to bind it
+	ClusterRole string `json:"clusterRole,omitempty"`
+	// Secrets are copied from the namespace of the workflow into the ephemeral namespace, e.g. the secrets of the
+	// artifact repository, or the image pull secrets
+	Secrets []string `json:"secrets,omitempty"`
+}
+
+// Validate returns an error if the selector is not valid
+func (n *EphemeralNamespaces) Validate() error {
+	if n == nil || n.Selector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(n.Selector); err != nil {
+		return fmt.Errorf("selector: %w", err)
+	}
+	return nil
+}
+
+// Selects returns whether the workflow in the namespace with the labels has an ephemeral namespace
+func (n *EphemeralNamespaces) Selects(namespace string, workflowLabels labels.Labels) bool {
+	if n == nil {
+		return false
+	}
+	if len(n.Namespaces) > 0 && !containsString(n.Namespaces, namespace) {
+		return false
+	}
+	if n.Selector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(n.Selector)
+	return err == nil && selector.Matches(workflowLabels)
+}
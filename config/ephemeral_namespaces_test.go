@@ -0,0 +1,23 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestEphemeralNamespaces(t *testing.T) {
+	var n *EphemeralNamespaces
+	assert.NoError(t, n.Validate())
+	assert.False(t, n.Selects("argo", labels.Set{}))
+	n = &EphemeralNamespaces{Namespaces: []string{"tenants"}, Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"trusted": "false"}}}
+	assert.NoError(t, n.Validate())
+	assert.True(t, n.Selects("tenants", labels.Set{"trusted": "false"}))
+	assert.False(t, n.Selects("tenants", labels.Set{}))
+	assert.False(t, n.Selects("argo", labels.Set{"trusted": "false"}))
+	assert.True(t, (&EphemeralNamespaces{}).Selects("argo", labels.Set{}))
+	n.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "trusted", Operator: "Is"}}
+	assert.Error(t, n.Validate())
+}
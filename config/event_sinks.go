@@ -0,0 +1,80 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// EventSinks configures where the controller publishes workflow and node lifecycle events, e.g. for data lineage
+type EventSinks struct {
+	// Sinks are where events are published, each sink is delivered to independently
+	Sinks []EventSink `json:"sinks,omitempty"`
+	// BufferSize is the maximum number of events each sink buffers until they are delivered. Once full, the oldest
+	// events are dropped. Default is 10000
+	BufferSize int `json:"bufferSize,omitempty"`
+	// BufferPath is a directory, e.g. on a persistent volume, where buffered events are saved, so they are delivered after
+	// the controller restarts. By default, events are only buffered in memory
+	BufferPath string `json:"bufferPath,omitempty"`
+}
+
+func (s EventSinks) GetBufferSize() int {
+	if s.BufferSize <= 0 {
+		return 10000
+	}
+	return s.BufferSize
+}
+
+// EventSink is a single destination of events, only one of Kafka, NATS, or HTTP may be set
+type EventSink struct {
+	// Name is the unique name of the sink, used to name its buffer
+	Name string `json:"name"`
+	// Kafka publishes events to a Kafka topic
+	Kafka *KafkaEventSink `json:"kafka,omitempty"`
+	// NATS publishes events to a NATS subject
+	NATS *NATSEventSink `json:"nats,omitempty"`
+	// HTTP posts events to a CloudEvents HTTP endpoint
+	HTTP *HTTPEventSink `json:"http,omitempty"`
+	// NodeEvents publishes node phase changes, as well as workflow phase changes. Default is true
+	NodeEvents *bool `json:"nodeEvents,omitempty"`
+}
+
+func (s EventSink) GetNodeEvents() bool {
+	return s.NodeEvents == nil || *s.NodeEvents
+}
+
+type KafkaEventSink struct {
+	// Brokers are the addresses of the Kafka brokers, e.g. "kafka:9092"
+	Brokers []string `json:"brokers"`
+	// Topic is the topic events are published to. Events are keyed by workflow UID, so each workflow's events are in
+	// order
+	Topic string `json:"topic"`
+	// TLS connects to the brokers using TLS
+	TLS bool `json:"tls,omitempty"`
+	// SASL authenticates with the brokers using SASL/PLAIN
+	SASL *KafkaSASL `json:"sasl,omitempty"`
+}
+
+type KafkaSASL struct {
+	// UserSecret is the secret, in the controller's namespace, that contains the user name
+	UserSecret apiv1.SecretKeySelector `json:"userSecret"`
+	// PasswordSecret is the secret, in the controller's namespace, that contains the password
+	PasswordSecret apiv1.SecretKeySelector `json:"passwordSecret"`
+}
+
+type NATSEventSink struct {
+	// URL is the URL of the NATS server, e.g. "nats://nats:4222"
+	URL string `json:"url"`
+	// Subject is the subject events are published to
+	Subject string `json:"subject"`
+	// JetStream publishes to a JetStream stream, which acknowledges each event. Without it, events are delivered at
+	// most once, as NATS does not acknowledge them
+	JetStream bool `json:"jetStream,omitempty"`
+	// TokenSecret is the secret, in the controller's namespace, that contains the token to authenticate with
+	TokenSecret *apiv1.SecretKeySelector `json:"tokenSecret,omitempty"`
+}
+
+type HTTPEventSink struct {
+	// URL is the URL events are posted to, as structured CloudEvents
+	URL string `json:"url"`
+	// Headers are added to each request, e.g. to authenticate
+	Headers map[string]string `json:"headers,omitempty"`
+}
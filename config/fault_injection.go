@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// FaultInjection is the faults injected into the pods of the workflows they select, so that the retry strategies, and
+// exit handlers, of templates can be tested before production. Faults are only injected if the controller is run with
+// --fault-injection
+type FaultInjection []Fault
+
+// Fault is a fault injected into the pods of the templates it selects
+type Fault struct {
+	// Name of the fault, which the pods it is injected into are annotated with
+	Name string `json:"name"`
+	// Namespaces of the workflows the fault is injected into. Default is every namespace
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects the workflows the fault is injected into by their labels. Default is every workflow
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Templates are the names of the templates the fault is injected into. Default is every template
+	Templates []string `json:"templates,omitempty"`
+	// Probability of the fault being injected into each pod, from 0 to 1. Default is 1
+	Probability *float64 `json:"probability,omitempty"`
+	// PodStartDelay delays creating the pod, from when its node started
+	PodStartDelay *metav1.Duration `json:"podStartDelay,omitempty"`
+	// PodFailure fails the node of the pod, even if the pod succeeds
+	PodFailure bool `json:"podFailure,omitempty"`
+	// ArtifactUploadFailure fails the upload of the pod's output artifacts
+	ArtifactUploadFailure bool `json:"artifactUploadFailure,omitempty"`
+}
+
+func (f Fault) GetProbability() float64 {
+	if f.Probability == nil {
+		return 1
+	}
+	return *f.Probability
+}
+
+// Validate returns an error if a fault has no name, the same name as another, an invalid selector or probability, or
+// does not inject anything
+func (i FaultInjection) Validate() error {
+	names := map[string]bool{}
+	for _, f := range i {
+		if f.Name == "" {
+			return fmt.Errorf("name is required")
+		}
+		if names[f.Name] {
+			return fmt.Errorf("%q is not unique", f.Name)
+		}
+		names[f.Name] = true
+		if f.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(f.Selector); err != nil {
+				return fmt.Errorf("%q selector: %w", f.Name, err)
+			}
+		}
+		if p := f.GetProbability(); p < 0 || p > 1 {
+			return fmt.Errorf("%q probability must be from 0 to 1", f.Name)
+		}
+		if f.PodStartDelay == nil && !f.PodFailure && !f.ArtifactUploadFailure {
+			return fmt.Errorf("%q must have a podStartDelay, podFailure, or artifactUploadFailure", f.Name)
+		}
+	}
+	return nil
+}
+
+// Select returns the faults injected into the pod, identified by the seed, of the template of the workflow in the
+// namespace with the labels. Whether a fault is injected, given its probability, is the same for the same seed, so
+// that it does not change each time the workflow is operated on
+func (i FaultInjection) Select(namespace string, workflowLabels labels.Labels, template, seed string) []Fault {
+	var faults []Fault
+	for _, f := range i {
+		if len(f.Namespaces) > 0 && !containsString(f.Namespaces, namespace) {
+			continue
+		}
+		if len(f.Templates) > 0 && !containsString(f.Templates, template) {
+			continue
+		}
+		if f.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(f.Selector)
+			if err != nil || !selector.Matches(workflowLabels) {
+				continue
+			}
+		}
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(f.Name + "/" + seed))
+		if float64(h.Sum64()%10000)/10000 >= f.GetProbability() {
+			continue
+		}
+		faults = append(faults, f)
+	}
+	return faults
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestFaultInjection(t *testing.T) {
+	half := 0.5
+	i := FaultInjection{
+		{Name: "fail", Namespaces: []string{"argo"}, Templates: []string{"main"}, PodFailure: true},
+		{Name: "slow", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"chaos": "true"}}, PodStartDelay: &metav1.Duration{Duration: time.Minute}},
+		{Name: "flaky", Probability: &half, ArtifactUploadFailure: true},
+	}
+	assert.NoError(t, i.Validate())
+
+	t.Run("Select", func(t *testing.T) {
+		faults := i.Select("argo", labels.Set{"chaos": "true"}, "main", "my-node")
+		assert.Contains(t, faults, i[0])
+		assert.Contains(t, faults, i[1])
+		assert.Equal(t, faults, i.Select("argo", labels.Set{"chaos": "true"}, "main", "my-node"), "the same for the same seed")
+		for _, f := range i.Select("default", labels.Set{}, "main", "my-node") {
+			assert.Equal(t, "flaky", f.Name)
+		}
+	})
+	t.Run("Probability", func(t *testing.T) {
+		injected := 0
+		for n := 0; n < 1000; n++ {
+			injected += len(i.Select("default", labels.Set{}, "main", fmt.Sprintf("node-%d", n)))
+		}
+		assert.InDelta(t, 500, injected, 100)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.EqualError(t, FaultInjection{{PodFailure: true}}.Validate(), "name is required")
+		assert.EqualError(t, FaultInjection{{Name: "a", PodFailure: true}, {Name: "a", PodFailure: true}}.Validate(), `"a" is not unique`)
+		assert.EqualError(t, FaultInjection{{Name: "a"}}.Validate(), `"a" must have a podStartDelay, podFailure, or artifactUploadFailure`)
+		p := 2.0
+		assert.EqualError(t, FaultInjection{{Name: "a", Probability: &p, PodFailure: true}}.Validate(), `"a" probability must be from 0 to 1`)
+		assert.Error(t, FaultInjection{{Name: "a", PodFailure: true, Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: "Is"}}}}}.Validate())
+	})
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// FreezeWindows are recurring windows, e.g. for cluster maintenance, during which no pods are created for the
+// workflows they select. The workflows' nodes stay Pending, and resume when the window ends, so cron workflows do not
+// need to be suspended one by one. Pods that already exist keep running
+type FreezeWindows []FreezeWindow
+
+// FreezeWindow is a recurring window during which no pods are created for the workflows it selects
+type FreezeWindow struct {
+	// Name of the window, which is in the message of the nodes it holds back
+	Name string `json:"name"`
+	// Schedule is when the window starts, in the standard cron format, e.g. "0 2 * * SAT"
+	Schedule string `json:"schedule"`
+	// Timezone of the schedule, e.g. "Europe/London". Default is the controller's timezone
+	Timezone string `json:"timezone,omitempty"`
+	// Duration is how long the window lasts, e.g. "4h"
+	Duration TTL `json:"duration"`
+	// Namespaces are the namespaces of the workflows the window selects. Default is every namespace
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector selects workflows by their labels. Default is every workflow
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// Validate returns an error if the name, schedule, timezone, duration, or selector of any window is not valid
+func (w FreezeWindows) Validate() error {
+	names := make(map[string]bool)
+	for i, window := range w {
+		if window.Name == "" {
+			return fmt.Errorf("window %d: name is required", i)
+		}
+		if names[window.Name] {
+			return fmt.Errorf("window %q: name is not unique", window.Name)
+		}
+		names[window.Name] = true
+		if _, err := window.parseSchedule(); err != nil {
+			return fmt.Errorf("window %q: schedule: %w", window.Name, err)
+		}
+		if window.Duration <= 0 {
+			return fmt.Errorf("window %q: duration must be positive", window.Name)
+		}
+		if window.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(window.Selector); err != nil {
+				return fmt.Errorf("window %q: selector: %w", window.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Active returns the window, that selects the workflow in the namespace with the labels, that is active at the time,
+// and when it ends, or nil if there is none. If several are active, it returns the one that ends last.
+func (w FreezeWindows) Active(now time.Time, namespace string, workflowLabels labels.Labels) (*FreezeWindow, time.Time) {
+	var active *FreezeWindow
+	var end time.Time
+	for i, window := range w {
+		if !window.selects(namespace, workflowLabels) {
+			continue
+		}
+		schedule, err := window.parseSchedule()
+		if err != nil {
+			continue
+		}
+		// the window is active if it last started no longer than its duration ago
+		duration := time.Duration(window.Duration)
+		start := schedule.Next(now.Add(-duration))
+		if start.IsZero() || start.After(now) {
+			continue
+		}
+		if e := start.Add(duration); e.After(end) {
+			active, end = &w[i], e
+		}
+	}
+	return active, end
+}
+
+func (w FreezeWindow) parseSchedule() (cron.Schedule, error) {
+	spec := w.Schedule
+	if w.Timezone != "" {
+		spec = "CRON_TZ=" + w.Timezone + " " + spec
+	}
+	return cron.ParseStandard(spec)
+}
+
+func (w FreezeWindow) selects(namespace string, workflowLabels labels.Labels) bool {
+	if len(w.Namespaces) > 0 && !containsString(w.Namespaces, namespace) {
+		return false
+	}
+	if w.Selector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(w.Selector)
+	return err == nil && selector.Matches(workflowLabels)
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestFreezeWindows_Validate(t *testing.T) {
+	window := FreezeWindow{Name: "maintenance", Schedule: "0 2 * * SAT", Timezone: "Europe/London", Duration: TTL(4 * time.Hour)}
+	assert.NoError(t, FreezeWindows{window}.Validate())
+	assert.EqualError(t, FreezeWindows{{Schedule: "0 2 * * SAT"}}.Validate(), "window 0: name is required")
+	assert.EqualError(t, FreezeWindows{window, window}.Validate(), `window "maintenance": name is not unique`)
+	assert.EqualError(t, FreezeWindows{{Name: "maintenance", Schedule: "0 2 * *", Duration: window.Duration}}.Validate(), `window "maintenance": schedule: expected exactly 5 fields, found 4: [0 2 * *]`)
+	assert.Error(t, FreezeWindows{{Name: "maintenance", Schedule: "0 2 * * SAT", Timezone: "Mars/Olympus", Duration: window.Duration}}.Validate())
+	assert.EqualError(t, FreezeWindows{{Name: "maintenance", Schedule: "0 2 * * SAT"}}.Validate(), `window "maintenance": duration must be positive`)
+}
+
+func TestFreezeWindows_Active(t *testing.T) {
+	windows := FreezeWindows{
+		{Name: "maintenance", Schedule: "CRON_TZ=UTC 0 2 * * SAT", Duration: TTL(4 * time.Hour), Namespaces: []string{"argo"}},
+		{Name: "batch", Schedule: "CRON_TZ=UTC 0 1 * * SAT", Duration: TTL(6 * time.Hour), Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "batch"}}},
+	}
+	// 2021-10-02 is a Saturday
+	at := func(hour int) time.Time { return time.Date(2021, 10, 2, hour, 30, 0, 0, time.UTC) }
+	t.Run("Before", func(t *testing.T) {
+		window, _ := windows.Active(at(0), "argo", labels.Set{})
+		assert.Nil(t, window)
+	})
+	t.Run("During", func(t *testing.T) {
+		window, end := windows.Active(at(3), "argo", labels.Set{})
+		if assert.NotNil(t, window) {
+			assert.Equal(t, "maintenance", window.Name)
+			assert.Equal(t, time.Date(2021, 10, 2, 6, 0, 0, 0, time.UTC), end)
+		}
+	})
+	t.Run("OtherNamespace", func(t *testing.T) {
+		window, _ := windows.Active(at(3), "other", labels.Set{})
+		assert.Nil(t, window)
+	})
+	t.Run("EndsLast", func(t *testing.T) {
+		window, end := windows.Active(at(3), "argo", labels.Set{"tier": "batch"})
+		if assert.NotNil(t, window) {
+			assert.Equal(t, "batch", window.Name)
+			assert.Equal(t, time.Date(2021, 10, 2, 7, 0, 0, 0, time.UTC), end)
+		}
+	})
+	t.Run("After", func(t *testing.T) {
+		window, _ := windows.Active(at(7), "argo", labels.Set{"tier": "batch"})
+		assert.Nil(t, window)
+	})
+}
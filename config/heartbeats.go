@@ -0,0 +1,30 @@
+package config
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Heartbeats configures the wait containers of pods, and the agents of workflows, to report that they are still
+// running, so that the controller can set a condition on workflows whose wait containers, or agent, stop reporting
+type Heartbeats struct {
+	// Interval is how often heartbeats are reported. Default is 1m
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout is how long since the last heartbeat until the wait container, or agent, is unresponsive. Default is 5m
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+func (h Heartbeats) GetInterval() time.Duration {
+	if h.Interval != nil {
+		return h.Interval.Duration
+	}
+	return time.Minute
+}
+
+func (h Heartbeats) GetTimeout() time.Duration {
+	if h.Timeout != nil {
+		return h.Timeout.Duration
+	}
+	return 5 * time.Minute
+}
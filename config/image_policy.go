@@ -0,0 +1,119 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ImagePolicy restricts the images of the containers of workflows, so that workflows that use images of unapproved
+// registries are rejected before any of their pods are created
+type ImagePolicy struct {
+	// ImageRules are the rules of the namespaces that do not have their own
+	ImageRules `json:",inline"`
+	// Namespaces are the rules of namespaces that have their own, which are used instead of the default rules
+	Namespaces map[string]ImageRules `json:"namespaces,omitempty"`
+}
+
+// ImageRules allow, or deny, images by patterns, which are matched with path.Match, e.g. "alpine:*", or, if they end
+// in "/", are prefixes of images, e.g. "my-registry.io/" allows every image of the registry
+type ImageRules struct {
+	// Allow are the patterns of the images that containers may use. Every image is allowed if there are none
+	Allow []string `json:"allow,omitempty"`
+	// Deny are the patterns of the images that containers must not use, even if they are allowed
+	Deny []string `json:"deny,omitempty"`
+	// RequireDigest is whether images must be pinned by digest, e.g. "alpine@sha256:..."
+	RequireDigest bool `json:"requireDigest,omitempty"`
+}
+
+// Validate returns an error if any of the patterns is not valid
+func (p *ImagePolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if err := p.ImageRules.validate(); err != nil {
+		return err
+	}
+	for namespace, rules := range p.Namespaces {
+		if err := rules.validate(); err != nil {
+			return fmt.Errorf("namespace %q: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// GetRules returns the rules of the namespace, or nil if there is no policy
+func (p *ImagePolicy) GetRules(namespace string) *ImageRules {
+	if p == nil {
+		return nil
+	}
+	if rules, ok := p.Namespaces[namespace]; ok {
+		return &rules
+	}
+	return &p.ImageRules
+}
+
+func (r ImageRules) validate() error {
+	for _, pattern := range append(r.Allow, r.Deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("pattern %q is not valid: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the image is not allowed
+func (r *ImageRules) Check(image string) error {
+	if r == nil {
+		return nil
+	}
+	if r.RequireDigest && !strings.Contains(image, "@") {
+		return fmt.Errorf("image %q is not pinned by digest", image)
+	}
+	if matchesImage(r.Deny, image) {
+		return fmt.Errorf("image %q is denied", image)
+	}
+	if len(r.Allow) > 0 && !matchesImage(r.Allow, image) {
+		return fmt.Errorf("image %q is not allowed", image)
+	}
+	return nil
+}
+
+// matchesImage returns whether any of the patterns matches the image, or its fully qualified name, e.g.
+// "docker.io/library/alpine:3.7" for "alpine:3.7", either as it is, or with the tag it implies, e.g. "alpine:latest" for
+// "alpine"
+func matchesImage(patterns []string, image string) bool {
+	tagged := taggedImage(image)
+	for _, pattern := range patterns {
+		for _, name := range []string{image, qualifiedImage(image), tagged, qualifiedImage(tagged)} {
+			if strings.HasSuffix(pattern, "/") {
+				if strings.HasPrefix(name, pattern) {
+					return true
+				}
+			} else if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// taggedImage returns the image with the "latest" tag, if it has neither a tag, nor a digest
+func taggedImage(image string) string {
+	if strings.Contains(image, "@") || strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+		return image
+	}
+	return image + ":latest"
+}
+
+// qualifiedImage returns the image with the registry, and repository, Docker implies for it
+func qualifiedImage(image string) string {
+	i := strings.Index(image, "/")
+	if i < 0 {
+		return "docker.io/library/" + image
+	}
+	if host := image[:i]; !strings.ContainsAny(host, ".:") && host != "localhost" {
+		return "docker.io/" + image
+	}
+	return image
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePolicy(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var p *ImagePolicy
+		assert.NoError(t, p.Validate())
+		assert.NoError(t, p.GetRules("my-ns").Check("alpine:3.7"))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.Error(t, (&ImagePolicy{ImageRules: ImageRules{Allow: []string{"["}}}).Validate())
+		assert.Error(t, (&ImagePolicy{Namespaces: map[string]ImageRules{"my-ns": {Deny: []string{"["}}}}).Validate())
+	})
+	p := &ImagePolicy{
+		ImageRules: ImageRules{Allow: []string{"my-registry.io/", "docker.io/library/*"}, Deny: []string{"docker.io/library/*:latest"}},
+		Namespaces: map[string]ImageRules{"prod": {Allow: []string{"my-registry.io/"}, RequireDigest: true}},
+	}
+	assert.NoError(t, p.Validate())
+	t.Run("Allow", func(t *testing.T) {
+		rules := p.GetRules("my-ns")
+		assert.NoError(t, rules.Check("my-registry.io/team/app:v1"))
+		assert.NoError(t, rules.Check("alpine:3.7"))
+		assert.EqualError(t, rules.Check("other-registry.io/app:v1"), `image "other-registry.io/app:v1" is not allowed`)
+		assert.EqualError(t, rules.Check("argoproj/argosay:v2"), `image "argoproj/argosay:v2" is not allowed`)
+	})
+	t.Run("Deny", func(t *testing.T) {
+		assert.EqualError(t, p.GetRules("my-ns").Check("alpine:latest"), `image "alpine:latest" is denied`)
+		assert.EqualError(t, p.GetRules("my-ns").Check("alpine"), `image "alpine" is denied`, "no tag implies latest")
+		assert.EqualError(t, p.GetRules("my-ns").Check("docker.io/library/alpine"), `image "docker.io/library/alpine" is denied`)
+		assert.NoError(t, p.GetRules("my-ns").Check("my-registry.io/app"))
+		assert.Equal(t, "my-registry.io:5000/app:latest", taggedImage("my-registry.io:5000/app"), "the port of the registry is not a tag")
+	})
+	t.Run("RequireDigest", func(t *testing.T) {
+		rules := p.GetRules("prod")
+		assert.EqualError(t, rules.Check("my-registry.io/app:v1"), `image "my-registry.io/app:v1" is not pinned by digest`)
+		assert.NoError(t, rules.Check("my-registry.io/app@sha256:0123456789abcdef"))
+		assert.EqualError(t, rules.Check("alpine@sha256:0123456789abcdef"), `image "alpine@sha256:0123456789abcdef" is not allowed`)
+	})
+}
@@ -0,0 +1,12 @@
+package config
+
+// Logging configures the log levels of the controller, which change without restarting it
+type Logging struct {
+	// Level is the level of every component without its own level, one of "debug", "info", "warn", or "error". Defaults
+	// to the level of the --loglevel flag
+	Level string `json:"level,omitempty"`
+	// Components are the levels of individual components: "controller", the rest of the controller,
+	// "podReconciliation", the reconciliation of nodes with their pods, and "agent", the agents of workflows created
+	// after the level changes
+	Components map[string]string `json:"components,omitempty"`
+}
@@ -1,10 +1,32 @@
 package config
 
+import "math"
+
 type NodeEvents struct {
 	Enabled   *bool `json:"enabled,omitempty"`
 	SendAsPod bool  `json:"sendAsPod,omitempty"`
+	// RateLimit limits how many node events are emitted about each workflow, so that workflows with many nodes, or
+	// nodes that are retried many times, do not flood the API server. Events over the limit are dropped. By default,
+	// node events are not limited
+	RateLimit *NodeEventsRateLimit `json:"rateLimit,omitempty"`
 }
 
 func (e NodeEvents) IsEnabled() bool {
 	return e.Enabled == nil || *e.Enabled
 }
+
+// NodeEventsRateLimit is a token bucket: a burst of events may be emitted about a workflow, after which events are
+// emitted at the rate
+type NodeEventsRateLimit struct {
+	// EventsPerSecond is the rate events may be emitted at
+	EventsPerSecond float64 `json:"eventsPerSecond"`
+	// Burst is the number of events that may be emitted at once, defaults to the rate rounded up
+	Burst int `json:"burst,omitempty"`
+}
+
+func (l NodeEventsRateLimit) GetBurst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return int(math.Ceil(l.EventsPerSecond))
+}
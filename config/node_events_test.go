@@ -12,3 +12,9 @@ func TestNodeEvents_IsEnabled(t *testing.T) {
 	assert.False(t, NodeEvents{Enabled: pointer.BoolPtr(false)}.IsEnabled())
 	assert.True(t, NodeEvents{Enabled: pointer.BoolPtr(true)}.IsEnabled())
 }
+
+func TestNodeEventsRateLimit_GetBurst(t *testing.T) {
+	assert.Equal(t, 1, NodeEventsRateLimit{EventsPerSecond: 0.1}.GetBurst())
+	assert.Equal(t, 3, NodeEventsRateLimit{EventsPerSecond: 2.5}.GetBurst())
+	assert.Equal(t, 10, NodeEventsRateLimit{EventsPerSecond: 2.5, Burst: 10}.GetBurst())
+}
@@ -0,0 +1,29 @@
+package config
+
+import "fmt"
+
+// OutputLimits limits the outputs the wait container reports for each node, so that a node's outputs cannot push the
+// workflow over the size limit of etcd. Outputs over the limits are truncated, or not saved, and the workflow gets the
+// OutputsTruncated condition
+type OutputLimits struct {
+	// MaxParameterSize is the maximum size, in bytes, of an output parameter. Larger parameters are truncated. Default
+	// is no limit
+	MaxParameterSize int `json:"maxParameterSize,omitempty"`
+	// MaxArtifacts is the maximum number of output artifacts saved for a node. Artifacts after the limit are not saved.
+	// Default is no limit
+	MaxArtifacts int `json:"maxArtifacts,omitempty"`
+}
+
+// Validate returns an error if either limit is negative
+func (l *OutputLimits) Validate() error {
+	if l == nil {
+		return nil
+	}
+	if l.MaxParameterSize < 0 {
+		return fmt.Errorf("maxParameterSize must not be negative")
+	}
+	if l.MaxArtifacts < 0 {
+		return fmt.Errorf("maxArtifacts must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputLimits_Validate(t *testing.T) {
+	var l *OutputLimits
+	assert.NoError(t, l.Validate())
+	assert.NoError(t, (&OutputLimits{MaxParameterSize: 1024, MaxArtifacts: 10}).Validate())
+	assert.EqualError(t, (&OutputLimits{MaxParameterSize: -1}).Validate(), "maxParameterSize must not be negative")
+	assert.EqualError(t, (&OutputLimits{MaxArtifacts: -1}).Validate(), "maxArtifacts must not be negative")
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// PlatformImages are the images to run instead of images that are not multi-arch, by the image and then the platform,
+// e.g. {"my-image:v1": {"linux/arm64": "my-image:v1-arm64"}}. They are run by the pods of templates with platforms
+type PlatformImages map[string]map[wfv1.Platform]string
+
+// Validate returns an error if a platform is not os/arch, or an image is empty
+func (p PlatformImages) Validate() error {
+	for image, platforms := range p {
+		for platform, platformImage := range platforms {
+			if _, _, err := platform.Parse(); err != nil {
+				return fmt.Errorf("image %q: %w", image, err)
+			}
+			if platformImage == "" {
+				return fmt.Errorf("image %q: platform %q: image is required", image, platform)
+			}
+		}
+	}
+	return nil
+}
+
+// Get returns the image to run instead of the image on the platform, if there is one
+func (p PlatformImages) Get(image string, platform wfv1.Platform) (string, bool) {
+	platformImage, ok := p[image][platform]
+	return platformImage, ok
+}
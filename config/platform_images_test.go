@@ -0,0 +1,21 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlatformImages(t *testing.T) {
+	images := PlatformImages{"my-image:v1": {"linux/arm64": "my-image:v1-arm64"}}
+	assert.NoError(t, images.Validate())
+	image, ok := images.Get("my-image:v1", "linux/arm64")
+	assert.True(t, ok)
+	assert.Equal(t, "my-image:v1-arm64", image)
+	_, ok = images.Get("my-image:v1", "linux/amd64")
+	assert.False(t, ok)
+	_, ok = images.Get("other:v1", "linux/arm64")
+	assert.False(t, ok)
+	assert.EqualError(t, PlatformImages{"my-image:v1": {"arm64": "my-image:v1-arm64"}}.Validate(), `image "my-image:v1": platform "arm64" must be os/arch, e.g. linux/arm64`)
+	assert.EqualError(t, PlatformImages{"my-image:v1": {"linux/arm64": ""}}.Validate(), `image "my-image:v1": platform "linux/arm64": image is required`)
+}
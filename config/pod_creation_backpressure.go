@@ -0,0 +1,36 @@
+package config
+
+import "fmt"
+
+// PodCreationBackpressure delays the creation of the pods of workflows while the cluster is saturated, i.e. too many
+// of them are pending, or a namespace's resource quotas have too little headroom, rather than creating pods that stay
+// pending. Delayed nodes are Pending, with a message of why, until the cluster has capacity again
+type PodCreationBackpressure struct {
+	// MaxPendingPods is the number of pending pods, of all the workflows, at which no more pods are created. Default is
+	// no limit
+	MaxPendingPods int `json:"maxPendingPods,omitempty"`
+	// MaxPendingPodsPerNamespace is the number of pending pods, of the workflows of a namespace, at which no more pods
+	// are created in the namespace. Default is no limit
+	MaxPendingPodsPerNamespace int `json:"maxPendingPodsPerNamespace,omitempty"`
+	// ResourceQuotaHeadroom is the percentage, of the hard limits of the pods, CPU and memory of each resource quota of
+	// a namespace, to keep free: a pod is not created while creating it would leave less, e.g. 10. With 0, pods are
+	// delayed that would exceed a quota. Default is to not check resource quotas
+	ResourceQuotaHeadroom *int `json:"resourceQuotaHeadroom,omitempty"`
+}
+
+// Validate returns an error if a limit is negative, or the headroom is not a percentage
+func (b *PodCreationBackpressure) Validate() error {
+	if b == nil {
+		return nil
+	}
+	if b.MaxPendingPods < 0 {
+		return fmt.Errorf("maxPendingPods must not be negative")
+	}
+	if b.MaxPendingPodsPerNamespace < 0 {
+		return fmt.Errorf("maxPendingPodsPerNamespace must not be negative")
+	}
+	if h := b.ResourceQuotaHeadroom; h != nil && (*h < 0 || *h >= 100) {
+		return fmt.Errorf("resourceQuotaHeadroom must be a percentage from 0 to 99")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCreationBackpressure_Validate(t *testing.T) {
+	zero, hundred := 0, 100
+	var b *PodCreationBackpressure
+	assert.NoError(t, b.Validate())
+	assert.NoError(t, (&PodCreationBackpressure{MaxPendingPods: 500, MaxPendingPodsPerNamespace: 100, ResourceQuotaHeadroom: &zero}).Validate())
+	assert.EqualError(t, (&PodCreationBackpressure{MaxPendingPods: -1}).Validate(), "maxPendingPods must not be negative")
+	assert.EqualError(t, (&PodCreationBackpressure{MaxPendingPodsPerNamespace: -1}).Validate(), "maxPendingPodsPerNamespace must not be negative")
+	assert.EqualError(t, (&PodCreationBackpressure{ResourceQuotaHeadroom: &hundred}).Validate(), "resourceQuotaHeadroom must be a percentage from 0 to 99")
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// PodTuning restricts the DNS settings, and sysctls, that workflows and templates may set on their pods, so that
+// steps that talk to legacy services can have a custom resolv.conf, or tuned kernel parameters, without every step
+// being able to
+type PodTuning struct {
+	// AllowedDNSPolicies are the DNS policies pods may use, e.g. "None". Every policy is allowed if there are none
+	AllowedDNSPolicies []apiv1.DNSPolicy `json:"allowedDNSPolicies,omitempty"`
+	// AllowedNameservers are the nameservers the DNS config of pods may list. Every nameserver is allowed if there
+	// are none
+	AllowedNameservers []string `json:"allowedNameservers,omitempty"`
+	// AllowedSysctls are the sysctls pods may set, e.g. "net.ipv4.tcp_keepalive_time", or, if they end in "*", the
+	// prefixes of them, e.g. "net.core.*". No sysctl is allowed if there are none
+	AllowedSysctls []string `json:"allowedSysctls,omitempty"`
+}
+
+// Validate returns an error if any of the DNS policies is not valid
+func (t *PodTuning) Validate() error {
+	if t == nil {
+		return nil
+	}
+	for _, policy := range t.AllowedDNSPolicies {
+		switch policy {
+		case apiv1.DNSClusterFirstWithHostNet, apiv1.DNSClusterFirst, apiv1.DNSDefault, apiv1.DNSNone:
+		default:
+			return fmt.Errorf("allowedDNSPolicies: %q is not a DNS policy", policy)
+		}
+	}
+	for _, pattern := range t.AllowedSysctls {
+		if pattern == "" || strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+			return fmt.Errorf("allowedSysctls: %q is not a sysctl, or a prefix of sysctls", pattern)
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the pod spec uses a DNS policy, a nameserver, or a sysctl that is not allowed
+func (t *PodTuning) Check(spec apiv1.PodSpec) error {
+	if t == nil {
+		return nil
+	}
+	if spec.DNSPolicy != "" && len(t.AllowedDNSPolicies) > 0 && !containsDNSPolicy(t.AllowedDNSPolicies, spec.DNSPolicy) {
+		return fmt.Errorf("DNS policy %q is not allowed", spec.DNSPolicy)
+	}
+	if spec.DNSConfig != nil && len(t.AllowedNameservers) > 0 {
+		for _, nameserver := range spec.DNSConfig.Nameservers {
+			if !containsString(t.AllowedNameservers, nameserver) {
+				return fmt.Errorf("nameserver %q is not allowed", nameserver)
+			}
+		}
+	}
+	if spec.SecurityContext != nil {
+		for _, sysctl := range spec.SecurityContext.Sysctls {
+			if !t.allowsSysctl(sysctl.Name) {
+				return fmt.Errorf("sysctl %q is not allowed", sysctl.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (t *PodTuning) allowsSysctl(name string) bool {
+	for _, pattern := range t.AllowedSysctls {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if pattern == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDNSPolicy(policies []apiv1.DNSPolicy, policy apiv1.DNSPolicy) bool {
+	for _, p := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
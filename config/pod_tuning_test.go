@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestPodTuning(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var tuning *PodTuning
+		assert.NoError(t, tuning.Validate())
+		assert.NoError(t, tuning.Check(apiv1.PodSpec{DNSPolicy: apiv1.DNSNone}))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, (&PodTuning{AllowedDNSPolicies: []apiv1.DNSPolicy{apiv1.DNSNone}, AllowedSysctls: []string{"net.core.*"}}).Validate())
+		assert.EqualError(t, (&PodTuning{AllowedDNSPolicies: []apiv1.DNSPolicy{"Custom"}}).Validate(), `allowedDNSPolicies: "Custom" is not a DNS policy`)
+		assert.EqualError(t, (&PodTuning{AllowedSysctls: []string{"net.*.somaxconn"}}).Validate(), `allowedSysctls: "net.*.somaxconn" is not a sysctl, or a prefix of sysctls`)
+	})
+	tuning := &PodTuning{
+		AllowedDNSPolicies: []apiv1.DNSPolicy{apiv1.DNSClusterFirst, apiv1.DNSNone},
+		AllowedNameservers: []string{"10.0.0.53"},
+		AllowedSysctls:     []string{"net.core.*", "net.ipv4.tcp_keepalive_time"},
+	}
+	t.Run("DNSPolicy", func(t *testing.T) {
+		assert.NoError(t, tuning.Check(apiv1.PodSpec{}))
+		assert.NoError(t, tuning.Check(apiv1.PodSpec{DNSPolicy: apiv1.DNSNone}))
+		assert.EqualError(t, tuning.Check(apiv1.PodSpec{DNSPolicy: apiv1.DNSDefault}), `DNS policy "Default" is not allowed`)
+	})
+	t.Run("Nameservers", func(t *testing.T) {
+		assert.NoError(t, tuning.Check(apiv1.PodSpec{DNSConfig: &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.53"}}}))
+		assert.EqualError(t, tuning.Check(apiv1.PodSpec{DNSConfig: &apiv1.PodDNSConfig{Nameservers: []string{"8.8.8.8"}}}), `nameserver "8.8.8.8" is not allowed`)
+	})
+	t.Run("Sysctls", func(t *testing.T) {
+		sysctls := func(names ...string) apiv1.PodSpec {
+			spec := apiv1.PodSpec{SecurityContext: &apiv1.PodSecurityContext{}}
+			for _, name := range names {
+				spec.SecurityContext.Sysctls = append(spec.SecurityContext.Sysctls, apiv1.Sysctl{Name: name, Value: "1"})
+			}
+			return spec
+		}
+		assert.NoError(t, tuning.Check(sysctls("net.core.somaxconn", "net.ipv4.tcp_keepalive_time")))
+		assert.EqualError(t, tuning.Check(sysctls("net.ipv4.tcp_keepalive_intvl")), `sysctl "net.ipv4.tcp_keepalive_intvl" is not allowed`)
+		assert.EqualError(t, (&PodTuning{}).Check(sysctls("net.core.somaxconn")), `sysctl "net.core.somaxconn" is not allowed`)
+	})
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceUsage configures the controller to sample the CPU, and memory, usage of the main containers of pods from the
+// metrics.k8s.io API, so that the peak, and average, usage of each node is recorded in its status
+type ResourceUsage struct {
+	// Interval is how often usage is sampled. Default is 30s
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+func (r *ResourceUsage) GetInterval() time.Duration {
+	if r != nil && r.Interval != nil {
+		return r.Interval.Duration
+	}
+	return 30 * time.Second
+}
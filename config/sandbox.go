@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Sandbox is the default isolation of the pods of templates, so that untrusted steps can be sandboxed more strongly
+// than the nodes' default container runtime does. Templates may override it, e.g. trusted steps may run with runc
+type Sandbox struct {
+	// RuntimeClassName is the default runtime class of pods, e.g. "gvisor", or "kata"
+	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+	// HostUsers is whether the containers of pods run in the host's user namespace by default. Only CRI-O supports
+	// false, as other container runtimes ignore the annotation that requests the user namespace
+	HostUsers *bool `json:"hostUsers,omitempty"`
+}
+
+// Validate returns an error if the runtime class name is not a valid name
+func (s *Sandbox) Validate() error {
+	if s == nil || s.RuntimeClassName == "" {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(s.RuntimeClassName); len(errs) > 0 {
+		return fmt.Errorf("runtimeClassName %q is not valid: %s", s.RuntimeClassName, strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandbox(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var s *Sandbox
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, (&Sandbox{RuntimeClassName: "gvisor"}).Validate())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.Error(t, (&Sandbox{RuntimeClassName: "gVisor!"}).Validate())
+	})
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type SchedulingPresets []SchedulingPreset
+
+// Validate returns an error if the selector of any preset is not valid
+func (p SchedulingPresets) Validate() error {
+	for i, preset := range p {
+		if _, err := metav1.LabelSelectorAsSelector(&preset.Selector); err != nil {
+			return fmt.Errorf("preset %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SchedulingPreset is the default node selector, tolerations and affinity of the pods of the templates it selects, so
+// that classes of steps, e.g. those labelled `workload-class: gpu`, are scheduled on a pool of nodes without each
+// template saying so. The templates' own, or their workflows', node selectors, and affinities, take precedence
+type SchedulingPreset struct {
+	// Selector selects templates by the labels of their metadata. An empty selector selects every template
+	Selector metav1.LabelSelector `json:"selector"`
+	// NodeSelector is added to the node selector of pods, apart from the labels it already has
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are added to the tolerations of pods
+	Tolerations []apiv1.Toleration `json:"tolerations,omitempty"`
+	// Affinity is the affinity of pods that have none
+	Affinity *apiv1.Affinity `json:"affinity,omitempty"`
+}
+
+// Matches returns whether the preset selects the template with the labels
+func (p SchedulingPreset) Matches(labels labels.Labels) (bool, error) {
+	x, err := metav1.LabelSelectorAsSelector(&p.Selector)
+	if err != nil {
+		return false, err
+	}
+	return x.Matches(labels), nil
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestSchedulingPresets(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		p := SchedulingPresets{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"workload-class": "gpu!"}}}}
+		assert.Error(t, p.Validate())
+	})
+	t.Run("Matches", func(t *testing.T) {
+		p := SchedulingPreset{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"workload-class": "gpu"}}}
+		ok, err := p.Matches(labels.Set{"workload-class": "gpu"})
+		if assert.NoError(t, err) {
+			assert.True(t, ok)
+		}
+		ok, err = p.Matches(labels.Set{})
+		if assert.NoError(t, err) {
+			assert.False(t, ok)
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		ok, err := SchedulingPreset{}.Matches(labels.Set{})
+		if assert.NoError(t, err) {
+			assert.True(t, ok)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// ScriptRuntime is a named runtime of script templates, e.g. "python311-slim", that provides the image, command, file
+// extension, and default resources of scripts that use it, so templates do not each hardcode them
+type ScriptRuntime struct {
+	// Image is the image of the script's container
+	Image string `json:"image"`
+	// Command runs the script, whose path is appended as the last argument, e.g. ["python"]
+	Command []string `json:"command,omitempty"`
+	// Extension is the file extension of the script, e.g. "py", for interpreters that require one
+	Extension string `json:"extension,omitempty"`
+	// Resources are the resources of the script's container, if the template does not set any
+	Resources apiv1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// GetExtension returns the file extension of the script, with a leading dot, or an empty string if it does not have one
+func (r ScriptRuntime) GetExtension() string {
+	if r.Extension == "" {
+		return ""
+	}
+	return "." + strings.TrimPrefix(r.Extension, ".")
+}
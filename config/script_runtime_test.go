@@ -0,0 +1,13 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptRuntime_GetExtension(t *testing.T) {
+	assert.Equal(t, "", ScriptRuntime{}.GetExtension())
+	assert.Equal(t, ".py", ScriptRuntime{Extension: "py"}.GetExtension())
+	assert.Equal(t, ".py", ScriptRuntime{Extension: ".py"}.GetExtension())
+}
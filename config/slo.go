@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SLOConfig configures the rolling success rate, and duration, service level objectives of workflows, which the
+// controller exposes as metrics by namespace and template, so that alerts can be written on their burn rates
+type SLOConfig struct {
+	// Windows are the windows the ratios and burn rates are computed over, each a whole number of minutes. Default is
+	// 5m, 30m, 1h, and 6h, the windows of multi-window burn rate alerts
+	Windows []metav1.Duration `json:"windows,omitempty"`
+	// SuccessObjective is the ratio of completed workflows that should succeed, e.g. 0.99. Default is 0.99
+	SuccessObjective float64 `json:"successObjective,omitempty"`
+	// Duration is how long a workflow may take and still meet the duration objective. By default, there is no duration
+	// objective, and its metrics are not emitted
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// DurationObjective is the ratio of completed workflows that should complete within the duration. Default is 0.99
+	DurationObjective float64 `json:"durationObjective,omitempty"`
+	// TemplateLabelKey is the label of workflows whose value is the template label of the metrics. Default is the name
+	// of the workflow template, or cluster workflow template, the workflow was submitted from
+	TemplateLabelKey string `json:"templateLabelKey,omitempty"`
+	// MaxSeries is the maximum number of namespace and template pairs that have their own metrics. The metrics of any
+	// others are labelled "_other". Default is 500
+	MaxSeries int `json:"maxSeries,omitempty"`
+}
+
+const defaultSLOObjective = 0.99
+
+var defaultSLOWindows = []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour, 6 * time.Hour}
+
+func (c SLOConfig) GetWindows() []time.Duration {
+	if len(c.Windows) == 0 {
+		return defaultSLOWindows
+	}
+	windows := make([]time.Duration, len(c.Windows))
+	for i, w := range c.Windows {
+		windows[i] = w.Duration
+	}
+	return windows
+}
+
+func (c SLOConfig) GetSuccessObjective() float64 {
+	if c.SuccessObjective == 0 {
+		return defaultSLOObjective
+	}
+	return c.SuccessObjective
+}
+
+func (c SLOConfig) GetDurationObjective() float64 {
+	if c.DurationObjective == 0 {
+		return defaultSLOObjective
+	}
+	return c.DurationObjective
+}
+
+func (c SLOConfig) GetMaxSeries() int {
+	if c.MaxSeries == 0 {
+		return 500
+	}
+	return c.MaxSeries
+}
+
+// Validate returns an error if the objectives are not ratios, or a window is not a whole number of minutes
+func (c *SLOConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, w := range c.GetWindows() {
+		if w < time.Minute || w%time.Minute != 0 {
+			return fmt.Errorf("slo window %v must be a whole number of minutes", w)
+		}
+	}
+	if o := c.GetSuccessObjective(); o <= 0 || o >= 1 {
+		return fmt.Errorf("slo successObjective %v must be greater than 0 and less than 1", o)
+	}
+	if o := c.GetDurationObjective(); o <= 0 || o >= 1 {
+		return fmt.Errorf("slo durationObjective %v must be greater than 0 and less than 1", o)
+	}
+	if c.Duration != nil && c.Duration.Duration <= 0 {
+		return fmt.Errorf("slo duration must be greater than zero")
+	}
+	if c.MaxSeries < 0 {
+		return fmt.Errorf("slo maxSeries must not be negative")
+	}
+	return nil
+}
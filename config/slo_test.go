@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSLOConfig(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		c := SLOConfig{}
+		assert.Equal(t, []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour, 6 * time.Hour}, c.GetWindows())
+		assert.Equal(t, 0.99, c.GetSuccessObjective())
+		assert.Equal(t, 0.99, c.GetDurationObjective())
+		assert.Equal(t, 500, c.GetMaxSeries())
+		assert.NoError(t, c.Validate())
+	})
+	t.Run("Nil", func(t *testing.T) {
+		var c *SLOConfig
+		assert.NoError(t, c.Validate())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.EqualError(t, (&SLOConfig{Windows: []metav1.Duration{{Duration: 90 * time.Second}}}).Validate(), "slo window 1m30s must be a whole number of minutes")
+		assert.EqualError(t, (&SLOConfig{SuccessObjective: 1}).Validate(), "slo successObjective 1 must be greater than 0 and less than 1")
+		assert.EqualError(t, (&SLOConfig{DurationObjective: -0.5}).Validate(), "slo durationObjective -0.5 must be greater than 0 and less than 1")
+		assert.EqualError(t, (&SLOConfig{Duration: &metav1.Duration{}}).Validate(), "slo duration must be greater than zero")
+		assert.EqualError(t, (&SLOConfig{MaxSeries: -1}).Validate(), "slo maxSeries must not be negative")
+	})
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// Spot configures how the pods of templates with a spot policy, e.g. `spot: prefer`, are scheduled on, or away from,
+// spot, or preemptible, nodes
+type Spot struct {
+	// Preset is the labels, and taints, of the spot nodes of a cloud, one of "eks", "gke", "aks" or "karpenter"
+	Preset string `json:"preset,omitempty"`
+	// NodeLabels are the labels of spot nodes, e.g. karpenter.sh/capacity-type: spot, instead of those of the preset
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// Tolerations are the tolerations of the taints of spot nodes, instead of those of the preset. They are added to the
+	// pods that prefer, or require, spot nodes
+	Tolerations []apiv1.Toleration `json:"tolerations,omitempty"`
+	// PreferenceWeight is the weight, 1-100, of the node affinity of pods that prefer spot nodes. Default 100
+	PreferenceWeight int32 `json:"preferenceWeight,omitempty"`
+}
+
+var spotPresets = map[string]Spot{
+	"eks":       {NodeLabels: map[string]string{"eks.amazonaws.com/capacityType": "SPOT"}},
+	"gke":       {NodeLabels: map[string]string{"cloud.google.com/gke-spot": "true"}},
+	"aks":       {NodeLabels: map[string]string{"kubernetes.azure.com/scalesetpriority": "spot"}, Tolerations: []apiv1.Toleration{{Key: "kubernetes.azure.com/scalesetpriority", Operator: apiv1.TolerationOpEqual, Value: "spot", Effect: apiv1.TaintEffectNoSchedule}}},
+	"karpenter": {NodeLabels: map[string]string{"karpenter.sh/capacity-type": "spot"}},
+}
+
+// GetNodeLabels returns the labels of spot nodes
+func (s *Spot) GetNodeLabels() map[string]string {
+	if s == nil {
+		return nil
+	}
+	if len(s.NodeLabels) > 0 {
+		return s.NodeLabels
+	}
+	return spotPresets[s.Preset].NodeLabels
+}
+
+// GetTolerations returns the tolerations of the taints of spot nodes
+func (s *Spot) GetTolerations() []apiv1.Toleration {
+	if s == nil {
+		return nil
+	}
+	if len(s.Tolerations) > 0 {
+		return s.Tolerations
+	}
+	return spotPresets[s.Preset].Tolerations
+}
+
+// GetPreferenceWeight returns the weight of the node affinity of pods that prefer spot nodes
+func (s *Spot) GetPreferenceWeight() int32 {
+	if s == nil || s.PreferenceWeight == 0 {
+		return 100
+	}
+	return s.PreferenceWeight
+}
+
+// Validate returns an error if the preset is unknown, the spot nodes have no labels, or the weight is out of range
+func (s *Spot) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if _, ok := spotPresets[s.Preset]; s.Preset != "" && !ok {
+		var presets []string
+		for preset := range spotPresets {
+			presets = append(presets, preset)
+		}
+		sort.Strings(presets)
+		return fmt.Errorf("spot preset %q must be one of %v", s.Preset, presets)
+	}
+	if len(s.GetNodeLabels()) == 0 {
+		return fmt.Errorf("spot must have a preset, or node labels")
+	}
+	if s.PreferenceWeight < 0 || s.PreferenceWeight > 100 {
+		return fmt.Errorf("spot preferenceWeight must be between 1 and 100")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestSpot(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		var s *Spot
+		assert.Empty(t, s.GetNodeLabels())
+		assert.Empty(t, s.GetTolerations())
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Preset", func(t *testing.T) {
+		s := &Spot{Preset: "gke"}
+		assert.Equal(t, map[string]string{"cloud.google.com/gke-spot": "true"}, s.GetNodeLabels())
+		assert.Empty(t, s.GetTolerations())
+		assert.Equal(t, int32(100), s.GetPreferenceWeight())
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Custom", func(t *testing.T) {
+		tolerations := []apiv1.Toleration{{Key: "spot", Operator: apiv1.TolerationOpExists}}
+		s := &Spot{Preset: "aks", NodeLabels: map[string]string{"spot": "true"}, Tolerations: tolerations, PreferenceWeight: 50}
+		assert.Equal(t, map[string]string{"spot": "true"}, s.GetNodeLabels())
+		assert.Equal(t, tolerations, s.GetTolerations())
+		assert.Equal(t, int32(50), s.GetPreferenceWeight())
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.EqualError(t, (&Spot{Preset: "ibm"}).Validate(), `spot preset "ibm" must be one of [aks eks gke karpenter]`)
+		assert.EqualError(t, (&Spot{}).Validate(), "spot must have a preset, or node labels")
+		assert.EqualError(t, (&Spot{Preset: "eks", PreferenceWeight: 101}).Validate(), "spot preferenceWeight must be between 1 and 100")
+	})
+}
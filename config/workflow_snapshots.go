@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkflowSnapshots periodically saves compressed snapshots of the statuses of workflows to the database, so that
+// their status can be got as of a time, e.g. when an alert fired
+type WorkflowSnapshots struct {
+	// Interval is the least time between the snapshots of a workflow, e.g. "30s", or "5m". Its status is also saved
+	// when it completes. Default is "1m"
+	Interval TTL `json:"interval,omitempty"`
+	// TTL is how long snapshots are kept for, e.g. "7d". Default is "7d"
+	TTL TTL `json:"ttl,omitempty"`
+}
+
+func (s WorkflowSnapshots) GetInterval() time.Duration {
+	if s.Interval > 0 {
+		return time.Duration(s.Interval)
+	}
+	return time.Minute
+}
+
+func (s WorkflowSnapshots) GetTTL() time.Duration {
+	if s.TTL > 0 {
+		return time.Duration(s.TTL)
+	}
+	return 7 * 24 * time.Hour
+}
+
+func (s *WorkflowSnapshots) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Interval < 0 {
+		return fmt.Errorf("workflowSnapshots interval must not be negative")
+	}
+	if s.TTL < 0 {
+		return fmt.Errorf("workflowSnapshots ttl must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowSnapshots(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		s := WorkflowSnapshots{}
+		assert.Equal(t, time.Minute, s.GetInterval())
+		assert.Equal(t, 7*24*time.Hour, s.GetTTL())
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Nil", func(t *testing.T) {
+		var s *WorkflowSnapshots
+		assert.NoError(t, s.Validate())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.EqualError(t, (&WorkflowSnapshots{Interval: TTL(-time.Minute)}).Validate(), "workflowSnapshots interval must not be negative")
+		assert.EqualError(t, (&WorkflowSnapshots{TTL: TTL(-time.Hour)}).Validate(), "workflowSnapshots ttl must not be negative")
+	})
+}
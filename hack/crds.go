@@ -39,6 +39,8 @@ func cleanCRD(filename string) {
 		// noop
 	case "workflowtasksets.argoproj.io":
 		// noop
+	case "workflowtemplategrants.argoproj.io":
+		// noop
 	default:
 		panic(name)
 	}
@@ -7,9 +7,12 @@ cat \
     | sed 's/github.com.argoproj.argo_events.pkg.apis.common./io.argoproj.events.v1alpha1./' \
     | sed 's/github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1./io.argoproj.events.v1alpha1./' \
     | sed 's/github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1./io.argoproj.events.v1alpha1./' \
+    | sed 's/apitoken\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/cronworkflow\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/event\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/info\./io.argoproj.REPLACEME.v1alpha1./' \
+    | sed 's/\(["/]\)plugin\./\1io.argoproj.REPLACEME.v1alpha1./' \
+    | sed 's/sharelink\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/workflowarchive\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/clusterworkflowtemplate\./io.argoproj.REPLACEME.v1alpha1./' \
     | sed 's/workflowtemplate\./io.argoproj.REPLACEME.v1alpha1./' \
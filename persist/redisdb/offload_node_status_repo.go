@@ -0,0 +1,235 @@
+package redisdb
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+)
+
+// NewOffloadNodeStatusRepo returns a repo that offloads node statuses to the Redis server. Each version of the nodes
+// of a workflow is a key, and the versions are indexed in a sorted set, scored by when they were saved, with a hash of
+// their namespaces, so that they can be listed, and the old ones deleted, as they are from a database.
+func NewOffloadNodeStatusRepo(kubectlConfig kubernetes.Interface, namespace, clusterName string, cfg *config.RedisConfig) (sqldb.OffloadNodeStatusRepo, error) {
+	if cfg.Address == "" {
+		return nil, errors.InternalError("address is empty")
+	}
+	ctx := context.Background()
+	options := &redis.Options{Addr: cfg.Address, DB: cfg.DB}
+	if cfg.UsernameSecret.Name != "" {
+		username, err := util.GetSecrets(ctx, kubectlConfig, namespace, cfg.UsernameSecret.Name, cfg.UsernameSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		options.Username = string(username)
+	}
+	if cfg.PasswordSecret.Name != "" {
+		password, err := util.GetSecrets(ctx, kubectlConfig, namespace, cfg.PasswordSecret.Name, cfg.PasswordSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		options.Password = string(password)
+	}
+	if cfg.TLS {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	client := redis.NewClient(options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis at %s: %w", cfg.Address, err)
+	}
+	// as for the database, this allows you to make Argo Workflows delete offloaded data more or less aggressively
+	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
+	log.WithFields(log.Fields{"address": cfg.Address, "ttl": ttl, "completedTTL": cfg.GetCompletedTTL()}).Info("Node status offloading to Redis config")
+	return newOffloadNodeStatusRepo(client, cfg.GetKeyPrefix()+":offload:"+clusterName, ttl, cfg.GetCompletedTTL()), nil
+}
+
+func newOffloadNodeStatusRepo(client redis.UniversalClient, prefix string, ttl, completedTTL time.Duration) *nodeOffloadRepo {
+	return &nodeOffloadRepo{client: client, prefix: prefix, ttl: ttl, completedTTL: completedTTL}
+}
+
+type nodeOffloadRepo struct {
+	client redis.UniversalClient
+	// prefix of the keys, which includes the cluster name
+	prefix string
+	// time to live - at what ttl an offload becomes old
+	ttl time.Duration
+	// completedTTL is how long the nodes of a completed workflow are kept
+	completedTTL time.Duration
+}
+
+func (r *nodeOffloadRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *nodeOffloadRepo) nodesKey(uid, version string) string {
+	return r.prefix + ":nodes:" + uid + ":" + version
+}
+
+// versionsKey is the sorted set of the "uid/version" of every offload, scored by the Unix time it was saved
+func (r *nodeOffloadRepo) versionsKey() string {
+	return r.prefix + ":versions"
+}
+
+// namespacesKey is the hash of the "uid/version" of every offload to its namespace
+func (r *nodeOffloadRepo) namespacesKey() string {
+	return r.prefix + ":namespaces"
+}
+
+func member(uid, version string) string {
+	return uid + "/" + version
+}
+
+func parseMember(m string) (string, string) {
+	parts := strings.SplitN(m, "/", 2)
+	if len(parts) != 2 {
+		return m, ""
+	}
+	return parts[0], parts[1]
+}
+
+func (r *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
+	marshalled, version, err := sqldb.NodeStatusVersion(nodes)
+	if err != nil {
+		return "", err
+	}
+	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
+	logCtx.Debug("Offloading nodes")
+	ctx := context.Background()
+	// saving the same version again is fine, it has the same nodes, and keeps when it was first saved
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, r.nodesKey(uid, version), marshalled, 0)
+		pipe.ZAddNX(ctx, r.versionsKey(), &redis.Z{Score: float64(time.Now().Unix()), Member: member(uid, version)})
+		pipe.HSet(ctx, r.namespacesKey(), member(uid, version), namespace)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	logCtx.Debug("Nodes offloaded")
+	return version, nil
+}
+
+func (r *nodeOffloadRepo) Get(uid, version string) (wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"uid": uid, "version": version}).Debug("Getting offloaded nodes")
+	data, err := r.client.Get(context.Background(), r.nodesKey(uid, version)).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("offloaded nodes of %s, version %s, not found, they may have expired", uid, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	nodes := wfv1.Nodes{}
+	if err := json.Unmarshal([]byte(data), &nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// members returns the "uid/version" of the offloads in the namespace, or all of them if it is empty, that were saved
+// no later than the time
+func (r *nodeOffloadRepo) members(namespace string, max time.Time) ([]string, error) {
+	ctx := context.Background()
+	members, err := r.client.ZRangeByScore(ctx, r.versionsKey(), &redis.ZRangeBy{Min: "-inf", Max: strconv.FormatInt(max.Unix(), 10)}).Result()
+	if err != nil || namespace == "" || len(members) == 0 {
+		return members, err
+	}
+	namespaces, err := r.client.HMGet(ctx, r.namespacesKey(), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	var inNamespace []string
+	for i, m := range members {
+		if namespaces[i] == namespace {
+			inNamespace = append(inNamespace, m)
+		}
+	}
+	return inNamespace, nil
+}
+
+func (r *nodeOffloadRepo) List(namespace string) (map[sqldb.UUIDVersion]wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing offloaded nodes")
+	members, err := r.members(namespace, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[sqldb.UUIDVersion]wfv1.Nodes)
+	if len(members) == 0 {
+		return res, nil
+	}
+	keys := make([]string, len(members))
+	for i, m := range members {
+		keys[i] = r.nodesKey(parseMember(m))
+	}
+	values, err := r.client.MGet(context.Background(), keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range members {
+		data, ok := values[i].(string)
+		if !ok {
+			// the nodes of a completed workflow have expired
+			continue
+		}
+		nodes := wfv1.Nodes{}
+		if err := json.Unmarshal([]byte(data), &nodes); err != nil {
+			return nil, err
+		}
+		uid, version := parseMember(m)
+		res[sqldb.UUIDVersion{UID: uid, Version: version}] = nodes
+	}
+	return res, nil
+}
+
+func (r *nodeOffloadRepo) ListOldOffloads(namespace string) (map[string][]string, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing old offloaded nodes")
+	members, err := r.members(namespace, time.Now().Add(-r.ttl))
+	if err != nil {
+		return nil, err
+	}
+	x := make(map[string][]string)
+	for _, m := range members {
+		uid, version := parseMember(m)
+		x[uid] = append(x[uid], version)
+	}
+	return x, nil
+}
+
+func (r *nodeOffloadRepo) Delete(uid, version string) error {
+	if uid == "" {
+		return fmt.Errorf("invalid uid")
+	}
+	if version == "" {
+		return fmt.Errorf("invalid version")
+	}
+	log.WithFields(log.Fields{"uid": uid, "version": version}).Debug("Deleting offloaded nodes")
+	ctx := context.Background()
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.nodesKey(uid, version))
+		pipe.ZRem(ctx, r.versionsKey(), member(uid, version))
+		pipe.HDel(ctx, r.namespacesKey(), member(uid, version))
+		return nil
+	})
+	return err
+}
+
+// Completed expires the nodes after the completed TTL, in case the controller is not running when the workflow is
+// deleted, their index entries are deleted by the controller, as they are for a database
+func (r *nodeOffloadRepo) Completed(uid, version string) error {
+	log.WithFields(log.Fields{"uid": uid, "version": version, "completedTTL": r.completedTTL}).Debug("Expiring offloaded nodes of completed workflow")
+	return r.client.Expire(context.Background(), r.nodesKey(uid, version), r.completedTTL).Err()
+}
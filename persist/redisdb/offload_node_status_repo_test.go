@@ -0,0 +1,114 @@
+package redisdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newTestRepo(t *testing.T) (*miniredis.Miniredis, *nodeOffloadRepo) {
+	s := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return s, newOffloadNodeStatusRepo(client, "argo:offload:my-cluster", time.Minute, time.Hour)
+}
+
+func TestNewOffloadNodeStatusRepo(t *testing.T) {
+	t.Run("EmptyAddress", func(t *testing.T) {
+		_, err := NewOffloadNodeStatusRepo(fake.NewSimpleClientset(), "argo", "my-cluster", &config.RedisConfig{})
+		assert.EqualError(t, err, "address is empty")
+	})
+	t.Run("Connected", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		repo, err := NewOffloadNodeStatusRepo(fake.NewSimpleClientset(), "argo", "my-cluster", &config.RedisConfig{Address: s.Addr()})
+		require.NoError(t, err)
+		assert.True(t, repo.IsEnabled())
+	})
+	t.Run("NotConnected", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		addr := s.Addr()
+		s.Close()
+		_, err := NewOffloadNodeStatusRepo(fake.NewSimpleClientset(), "argo", "my-cluster", &config.RedisConfig{Address: addr})
+		assert.Error(t, err)
+	})
+}
+
+func TestNodeOffloadRepo(t *testing.T) {
+	nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node"}}
+	t.Run("SaveAndGet", func(t *testing.T) {
+		s, repo := newTestRepo(t)
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		_, expected, _ := sqldb.NodeStatusVersion(nodes)
+		assert.Equal(t, expected, version)
+		assert.True(t, s.Exists("argo:offload:my-cluster:nodes:my-uid:"+version))
+		got, err := repo.Get("my-uid", version)
+		require.NoError(t, err)
+		assert.Equal(t, nodes, got)
+	})
+	t.Run("GetNotFound", func(t *testing.T) {
+		_, repo := newTestRepo(t)
+		_, err := repo.Get("my-uid", "fnv:1")
+		assert.EqualError(t, err, "offloaded nodes of my-uid, version fnv:1, not found, they may have expired")
+	})
+	t.Run("List", func(t *testing.T) {
+		_, repo := newTestRepo(t)
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		_, err = repo.Save("other-uid", "other-ns", nodes)
+		require.NoError(t, err)
+		list, err := repo.List("my-ns")
+		require.NoError(t, err)
+		assert.Equal(t, map[sqldb.UUIDVersion]wfv1.Nodes{{UID: "my-uid", Version: version}: nodes}, list)
+		all, err := repo.List("")
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+	t.Run("ListOldOffloads", func(t *testing.T) {
+		s, repo := newTestRepo(t)
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		old, err := repo.ListOldOffloads("my-ns")
+		require.NoError(t, err)
+		assert.Empty(t, old)
+		_, err = s.ZAdd("argo:offload:my-cluster:versions", float64(time.Now().Add(-2*time.Minute).Unix()), "my-uid/"+version)
+		require.NoError(t, err)
+		old, err = repo.ListOldOffloads("my-ns")
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"my-uid": {version}}, old)
+		old, err = repo.ListOldOffloads("other-ns")
+		require.NoError(t, err)
+		assert.Empty(t, old)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		s, repo := newTestRepo(t)
+		assert.EqualError(t, repo.Delete("", "fnv:1"), "invalid uid")
+		assert.EqualError(t, repo.Delete("my-uid", ""), "invalid version")
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete("my-uid", version))
+		assert.Empty(t, s.Keys())
+	})
+	t.Run("Completed", func(t *testing.T) {
+		s, repo := newTestRepo(t)
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		require.NoError(t, repo.Completed("my-uid", version))
+		key := "argo:offload:my-cluster:nodes:my-uid:" + version
+		assert.Equal(t, time.Hour, s.TTL(key))
+		s.FastForward(2 * time.Hour)
+		assert.False(t, s.Exists(key))
+		list, err := repo.List("my-ns")
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
@@ -5,7 +5,7 @@ import "upper.io/db.v3/lib/sqlbuilder"
 // represent a straight forward change that is compatible with all database providers
 type ansiSQLChange string
 
-func (s ansiSQLChange) apply(session sqlbuilder.Database) error {
+func (s ansiSQLChange) apply(session sqlbuilder.SQLBuilder) error {
 	_, err := session.Exec(string(s))
 	return err
 }
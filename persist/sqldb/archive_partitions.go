@@ -0,0 +1,368 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+const (
+	// partitionTimeLayout is the layout of the times in the names of partitions, which are "p<from>_<to>"
+	partitionTimeLayout = "2006010215"
+	// futurePartition holds the workflows that finished after the last partition, e.g. because the controller was not
+	// running to create it in time
+	futurePartition = "pfuture"
+	// archiveLabelsForeignKey is the name MySQL gives the foreign key from the labels table to the archive table
+	archiveLabelsForeignKey = archiveLabelsTableName + "_ibfk_1"
+)
+
+// ArchivePartitions partitions the archived workflows table by when the workflows finished, so that expired workflows
+// are removed by dropping whole partitions, rather than deleting them row by row
+type ArchivePartitions interface {
+	// Partition converts the table to a partitioned table, unless it already is one, and creates the partitions ahead
+	Partition(now time.Time) error
+	// Maintain creates the partitions ahead, and, unless the TTL is zero, drops those that only hold workflows that
+	// finished before the TTL. Partitions that also hold the workflows of other clusters, instance IDs, or managed
+	// namespaces, are not dropped, as their controllers may keep workflows for longer.
+	Maintain(ttl time.Duration, now time.Time) error
+}
+
+type archivePartitions struct {
+	session  sqlbuilder.Database
+	dbType   dbType
+	interval time.Duration
+	premake  int
+	// clusterName, managedNamespace, and instanceID are the workflows of this controller
+	clusterName      string
+	managedNamespace string
+	instanceID       string
+}
+
+func NewArchivePartitions(session sqlbuilder.Database, clusterName, managedNamespace, instanceID string, c config.ArchivePartitioning) ArchivePartitions {
+	return &archivePartitions{session: session, dbType: dbTypeFor(session), interval: c.GetInterval(), premake: c.GetPremake(), clusterName: clusterName, managedNamespace: managedNamespace, instanceID: instanceID}
+}
+
+// partition holds the workflows that finished at, or after, from, and before to
+type partition struct {
+	from, to time.Time
+}
+
+// legacyFrom is the start of the partition made of the table before it was partitioned, which holds every workflow that
+// finished before the first partition
+var legacyFrom = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (p partition) name() string {
+	return "p" + p.from.UTC().Format(partitionTimeLayout) + "_" + p.to.UTC().Format(partitionTimeLayout)
+}
+
+func (p partition) String() string {
+	return p.name()
+}
+
+// parsePartition parses the name of a partition, Postgres partitions are tables, so their names start with the name of
+// the archive table
+func parsePartition(name string) (partition, bool) {
+	name = strings.TrimPrefix(name, archiveTableName+"_")
+	if !strings.HasPrefix(name, "p") {
+		return partition{}, false
+	}
+	parts := strings.Split(strings.TrimPrefix(name, "p"), "_")
+	if len(parts) != 2 {
+		return partition{}, false
+	}
+	from, err := time.Parse(partitionTimeLayout, parts[0])
+	if err != nil {
+		return partition{}, false
+	}
+	to, err := time.Parse(partitionTimeLayout, parts[1])
+	if err != nil {
+		return partition{}, false
+	}
+	return partition{from, to}, true
+}
+
+// nextPartitions returns the partitions that follow on from the last one, until the premade partitions after the
+// current period. Partitions are aligned to the interval, so the first one is shorter if the last one was not.
+func nextPartitions(last time.Time, interval time.Duration, premake int, now time.Time) []partition {
+	until := now.UTC().Truncate(interval).Add(time.Duration(premake+1) * interval)
+	var partitions []partition
+	for from := last.UTC(); from.Before(until); {
+		to := from.Truncate(interval).Add(interval)
+		partitions = append(partitions, partition{from, to})
+		from = to
+	}
+	return partitions
+}
+
+func partitionTable(p partition) string {
+	return archiveTableName + "_" + p.name()
+}
+
+func postgresTimestamp(t time.Time) string {
+	return "'" + t.UTC().Format("2006-01-02 15:04:05") + "'"
+}
+
+// convertStatements returns the statements that convert the archive table into a partitioned table, with the existing
+// table as its first partition, and the future partition. The foreign key from the labels table is dropped, as
+// neither database supports foreign keys to partitioned tables, and MySQL does not support full-text indexes on them.
+func convertStatements(t dbType, legacy partition) []string {
+	if t == MySQL {
+		return []string{
+			`update ` + archiveTableName + ` set finishedat = startedat where finishedat is null`,
+			`alter table ` + archiveTableName + ` drop index ` + archiveTableName + `_i5, drop primary key, add primary key(clustername,uid,finishedat) partition by range (unix_timestamp(finishedat)) (partition ` + legacy.name() + ` values less than (` + fmt.Sprint(legacy.to.Unix()) + `), partition ` + futurePartition + ` values less than maxvalue)`,
+		}
+	}
+	table := partitionTable(legacy)
+	statements := []string{
+		`alter table ` + archiveLabelsTableName + ` drop constraint if exists ` + archiveLabelsTableName + `_clustername_uid_fkey`,
+		`update ` + archiveTableName + ` set finishedat = startedat where finishedat is null`,
+		`alter table ` + archiveTableName + ` rename to ` + table,
+		`alter table ` + table + ` rename constraint ` + archiveTableName + `_pkey to ` + table + `_pkey`,
+	}
+	for i := 1; i <= 5; i++ {
+		statements = append(statements, fmt.Sprintf(`alter index if exists %s_i%d rename to %s_i%d`, archiveTableName, i, table, i))
+	}
+	return append(statements,
+		`alter table `+table+` alter column finishedat set not null`,
+		`create table `+archiveTableName+` (like `+table+` including defaults) partition by range (finishedat)`,
+		`alter table `+archiveTableName+` add primary key(clustername,uid,finishedat)`,
+		`create index `+archiveTableName+`_i1 on `+archiveTableName+` (clustername,instanceid,namespace)`,
+		`create index `+archiveTableName+`_i2 on `+archiveTableName+` (clustername,instanceid,finishedat)`,
+		`create index `+archiveTableName+`_i3 on `+archiveTableName+` (clustername,instanceid,name)`,
+		`create index `+archiveTableName+`_i4 on `+archiveTableName+` (clustername,instanceid,startedat,uid)`,
+		`create index `+archiveTableName+`_i5 on `+archiveTableName+` using gin (to_tsvector('simple', searchtext))`,
+		`alter table `+archiveTableName+` attach partition `+table+` for values from (minvalue) to (`+postgresTimestamp(legacy.to)+`)`,
+		`create table `+archiveTableName+`_`+futurePartition+` partition of `+archiveTableName+` default`,
+	)
+}
+
+// createStatements returns the statements that create the partitions, moving any workflows in them out of the future
+// partition
+func createStatements(t dbType, partitions []partition) []string {
+	if len(partitions) == 0 {
+		return nil
+	}
+	if t == MySQL {
+		definitions := make([]string, 0, len(partitions)+1)
+		for _, p := range partitions {
+			definitions = append(definitions, fmt.Sprintf("partition %s values less than (%d)", p.name(), p.to.Unix()))
+		}
+		definitions = append(definitions, "partition "+futurePartition+" values less than maxvalue")
+		return []string{`alter table ` + archiveTableName + ` reorganize partition ` + futurePartition + ` into (` + strings.Join(definitions, ", ") + `)`}
+	}
+	var statements []string
+	for _, p := range partitions {
+		table := partitionTable(p)
+		statements = append(statements,
+			`create table `+table+` (like `+archiveTableName+` including defaults)`,
+			`with moved as (delete from `+archiveTableName+`_`+futurePartition+` where finishedat >= `+postgresTimestamp(p.from)+` and finishedat < `+postgresTimestamp(p.to)+` returning *) insert into `+table+` select * from moved`,
+			`alter table `+archiveTableName+` attach partition `+table+` for values from (`+postgresTimestamp(p.from)+`) to (`+postgresTimestamp(p.to)+`)`,
+		)
+	}
+	return statements
+}
+
+// othersQuery returns the query that selects a row if the partition holds any workflows that are not of the cluster,
+// instance ID, and, unless it is empty, managed namespace, of the arguments
+func othersQuery(t dbType, p partition, managedNamespace string) string {
+	from := partitionTable(p)
+	if t == MySQL {
+		from = archiveTableName + ` partition (` + p.name() + `)`
+	}
+	scope := `clustername = ? and instanceid = ?`
+	if managedNamespace != "" {
+		scope += ` and namespace = ?`
+	}
+	return `select 1 from ` + from + ` where not (` + scope + `) limit 1`
+}
+
+// dropStatements returns the statements that drop the partition, and the labels, and artifacts, of its workflows
+func dropStatements(t dbType, p partition) []string {
+	if t == MySQL {
+		return []string{
+			`delete l from ` + archiveLabelsTableName + ` l join ` + archiveTableName + ` partition (` + p.name() + `) w on l.clustername = w.clustername and l.uid = w.uid`,
+			`delete a from ` + archiveArtifactsTableName + ` a join ` + archiveTableName + ` partition (` + p.name() + `) w on a.clustername = w.clustername and a.uid = w.uid`,
+			`alter table ` + archiveTableName + ` drop partition ` + p.name(),
+		}
+	}
+	table := partitionTable(p)
+	return []string{
+		`delete from ` + archiveLabelsTableName + ` l using ` + table + ` w where l.clustername = w.clustername and l.uid = w.uid`,
+		`delete from ` + archiveArtifactsTableName + ` a using ` + table + ` w where a.clustername = w.clustername and a.uid = w.uid`,
+		`drop table ` + table,
+	}
+}
+
+func (a *archivePartitions) Partition(now time.Time) error {
+	partitions, err := a.partitions()
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		legacy := partition{legacyFrom, now.UTC().Truncate(a.interval).Add(a.interval)}
+		log.WithFields(log.Fields{"dbType": a.dbType, "partition": legacy}).Info("Partitioning the archived workflows table, this may take a long time")
+		statements := convertStatements(a.dbType, legacy)
+		if a.dbType == MySQL {
+			exists, err := a.mysqlLabelsForeignKeyExists()
+			if err != nil {
+				return err
+			}
+			if exists {
+				statements = append([]string{`alter table ` + archiveLabelsTableName + ` drop foreign key ` + archiveLabelsForeignKey}, statements...)
+			}
+		}
+		if err := a.exec(statements); err != nil {
+			return fmt.Errorf("failed to partition the archived workflows table: %w", err)
+		}
+		partitions = []partition{legacy}
+	}
+	return a.create(partitions, now)
+}
+
+func (a *archivePartitions) Maintain(ttl time.Duration, now time.Time) error {
+	partitions, err := a.partitions()
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf("the archived workflows table is not partitioned")
+	}
+	if err := a.create(partitions, now); err != nil {
+		return err
+	}
+	if ttl == 0 {
+		return nil
+	}
+	cutoff := now.Add(-ttl)
+	for _, p := range partitions {
+		if p.to.After(cutoff) {
+			break
+		}
+		others, err := a.holdsOthers(p)
+		if err != nil {
+			return fmt.Errorf("failed to check archived workflows partition %s: %w", p, err)
+		}
+		if others {
+			// this controller's workflows are deleted row by row instead
+			log.WithField("partition", p).Info("Not dropping expired archived workflows partition, as it holds the workflows of other controllers")
+			continue
+		}
+		log.WithField("partition", p).Info("Dropping expired archived workflows partition")
+		if err := a.exec(dropStatements(a.dbType, p)); err != nil {
+			return fmt.Errorf("failed to drop archived workflows partition %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// create creates the partitions that follow on from the last of the existing partitions
+func (a *archivePartitions) create(partitions []partition, now time.Time) error {
+	next := nextPartitions(partitions[len(partitions)-1].to, a.interval, a.premake, now)
+	if len(next) == 0 {
+		return nil
+	}
+	log.WithField("partitions", next).Info("Creating archived workflows partitions")
+	if err := a.exec(createStatements(a.dbType, next)); err != nil {
+		return fmt.Errorf("failed to create archived workflows partitions: %w", err)
+	}
+	return nil
+}
+
+// partitions returns the partitions of the archive table, except the future partition, ordered by when their workflows
+// finished, or none if the table is not partitioned
+func (a *archivePartitions) partitions() ([]partition, error) {
+	if a.dbType == SQLite {
+		return nil, fmt.Errorf("SQLite does not support partitioning")
+	}
+	query := `select c.relname from pg_inherits i join pg_class c on c.oid = i.inhrelid where i.inhparent = '` + archiveTableName + `'::regclass`
+	if a.dbType == MySQL {
+		query = `select partition_name from information_schema.partitions where table_schema = database() and table_name = '` + archiveTableName + `' and partition_name is not null`
+	}
+	rows, err := a.session.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var partitions []partition
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		if p, ok := parsePartition(name); ok {
+			partitions = append(partitions, p)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].to.Before(partitions[j].to) })
+	return partitions, nil
+}
+
+// holdsOthers returns true if the partition holds the workflows of other clusters, instance IDs, or managed namespaces
+func (a *archivePartitions) holdsOthers(p partition) (bool, error) {
+	args := []interface{}{a.clusterName, a.instanceID}
+	if a.managedNamespace != "" {
+		args = append(args, a.managedNamespace)
+	}
+	rows, err := a.session.Query(othersQuery(a.dbType, p, a.managedNamespace), args...)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = rows.Close() }()
+	others := rows.Next()
+	return others, rows.Err()
+}
+
+func (a *archivePartitions) mysqlLabelsForeignKeyExists() (bool, error) {
+	row, err := a.session.QueryRow(`select count(*) from information_schema.referential_constraints where constraint_schema = database() and constraint_name = ?`, archiveLabelsForeignKey)
+	if err != nil {
+		return false, err
+	}
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// exec executes the statements, in a transaction for Postgres, MySQL implicitly commits each statement that changes
+// the schema
+func (a *archivePartitions) exec(statements []string) error {
+	if a.dbType == MySQL {
+		for _, s := range statements {
+			log.WithField("statement", s).Debug("Executing archived workflows partitions statement")
+			if _, err := a.session.Exec(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return a.session.Tx(context.Background(), func(tx sqlbuilder.Tx) error {
+		for _, s := range statements {
+			log.WithField("statement", s).Debug("Executing archived workflows partitions statement")
+			if _, err := tx.Exec(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// isPartitioned returns true if the archive table is partitioned
+func isPartitioned(session sqlbuilder.Database) (bool, error) {
+	t := dbTypeFor(session)
+	if t == SQLite {
+		return false, nil
+	}
+	partitions, err := (&archivePartitions{session: session, dbType: t}).partitions()
+	return len(partitions) > 0, err
+}
@@ -0,0 +1,95 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func day(d int) time.Time {
+	return time.Date(2021, 11, d, 0, 0, 0, 0, time.UTC)
+}
+
+func Test_parsePartition(t *testing.T) {
+	p := partition{legacyFrom, day(2).Add(6 * time.Hour)}
+	assert.Equal(t, "p0001010100_2021110206", p.name())
+	for _, name := range []string{"p0001010100_2021110206", "argo_archived_workflows_p0001010100_2021110206"} {
+		got, ok := parsePartition(name)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, p, got)
+		}
+	}
+	for _, name := range []string{futurePartition, "argo_archived_workflows_pfuture", "p2021110100", "p2021110100_x"} {
+		_, ok := parsePartition(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func Test_nextPartitions(t *testing.T) {
+	now := day(3).Add(13 * time.Hour)
+	t.Run("UpToDate", func(t *testing.T) {
+		assert.Empty(t, nextPartitions(day(6), 24*time.Hour, 2, now))
+	})
+	t.Run("Premake", func(t *testing.T) {
+		assert.Equal(t, []partition{{day(4), day(5)}, {day(5), day(6)}}, nextPartitions(day(4), 24*time.Hour, 2, now))
+	})
+	t.Run("Unaligned", func(t *testing.T) {
+		assert.Equal(t, []partition{{day(4).Add(6 * time.Hour), day(5)}}, nextPartitions(day(4).Add(6*time.Hour), 24*time.Hour, 1, now))
+	})
+	t.Run("Hours", func(t *testing.T) {
+		assert.Equal(t, []partition{{day(4), day(4).Add(12 * time.Hour)}}, nextPartitions(day(4), 12*time.Hour, 0, now.Add(12*time.Hour)))
+	})
+}
+
+func Test_convertStatements(t *testing.T) {
+	legacy := partition{legacyFrom, day(2)}
+	t.Run("Postgres", func(t *testing.T) {
+		statements := convertStatements(Postgres, legacy)
+		assert.Contains(t, statements, "alter table argo_archived_workflows_labels drop constraint if exists argo_archived_workflows_labels_clustername_uid_fkey")
+		assert.Contains(t, statements, "alter table argo_archived_workflows rename to argo_archived_workflows_p0001010100_2021110200")
+		assert.Contains(t, statements, "alter index if exists argo_archived_workflows_i5 rename to argo_archived_workflows_p0001010100_2021110200_i5")
+		assert.Contains(t, statements, "alter table argo_archived_workflows attach partition argo_archived_workflows_p0001010100_2021110200 for values from (minvalue) to ('2021-11-02 00:00:00')")
+		assert.Equal(t, "create table argo_archived_workflows_pfuture partition of argo_archived_workflows default", statements[len(statements)-1])
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		statements := convertStatements(MySQL, legacy)
+		assert.Equal(t, "alter table argo_archived_workflows drop index argo_archived_workflows_i5, drop primary key, add primary key(clustername,uid,finishedat) partition by range (unix_timestamp(finishedat)) (partition p0001010100_2021110200 values less than (1635811200), partition pfuture values less than maxvalue)", statements[len(statements)-1])
+	})
+}
+
+func Test_createStatements(t *testing.T) {
+	partitions := []partition{{day(2), day(3)}, {day(3), day(4)}}
+	assert.Empty(t, createStatements(Postgres, nil))
+	t.Run("Postgres", func(t *testing.T) {
+		statements := createStatements(Postgres, partitions)
+		if assert.Len(t, statements, 6) {
+			assert.Equal(t, "create table argo_archived_workflows_p2021110200_2021110300 (like argo_archived_workflows including defaults)", statements[0])
+			assert.Equal(t, "with moved as (delete from argo_archived_workflows_pfuture where finishedat >= '2021-11-02 00:00:00' and finishedat < '2021-11-03 00:00:00' returning *) insert into argo_archived_workflows_p2021110200_2021110300 select * from moved", statements[1])
+			assert.Equal(t, "alter table argo_archived_workflows attach partition argo_archived_workflows_p2021110200_2021110300 for values from ('2021-11-02 00:00:00') to ('2021-11-03 00:00:00')", statements[2])
+		}
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		assert.Equal(t, []string{"alter table argo_archived_workflows reorganize partition pfuture into (partition p2021110200_2021110300 values less than (1635897600), partition p2021110300_2021110400 values less than (1635984000), partition pfuture values less than maxvalue)"}, createStatements(MySQL, partitions))
+	})
+}
+
+func Test_othersQuery(t *testing.T) {
+	p := partition{day(2), day(3)}
+	assert.Equal(t, "select 1 from argo_archived_workflows_p2021110200_2021110300 where not (clustername = ? and instanceid = ?) limit 1", othersQuery(Postgres, p, ""))
+	assert.Equal(t, "select 1 from argo_archived_workflows partition (p2021110200_2021110300) where not (clustername = ? and instanceid = ? and namespace = ?) limit 1", othersQuery(MySQL, p, "my-ns"))
+}
+
+func Test_dropStatements(t *testing.T) {
+	p := partition{day(2), day(3)}
+	assert.Equal(t, []string{
+		"delete from argo_archived_workflows_labels l using argo_archived_workflows_p2021110200_2021110300 w where l.clustername = w.clustername and l.uid = w.uid",
+		"delete from argo_archived_workflows_artifacts a using argo_archived_workflows_p2021110200_2021110300 w where a.clustername = w.clustername and a.uid = w.uid",
+		"drop table argo_archived_workflows_p2021110200_2021110300",
+	}, dropStatements(Postgres, p))
+	assert.Equal(t, []string{
+		"delete l from argo_archived_workflows_labels l join argo_archived_workflows partition (p2021110200_2021110300) w on l.clustername = w.clustername and l.uid = w.uid",
+		"delete a from argo_archived_workflows_artifacts a join argo_archived_workflows partition (p2021110200_2021110300) w on a.clustername = w.clustername and a.uid = w.uid",
+		"alter table argo_archived_workflows drop partition p2021110200_2021110300",
+	}, dropStatements(MySQL, p))
+}
@@ -0,0 +1,200 @@
+package sqldb
+
+import (
+	"encoding/json"
+	"sort"
+
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	archiveArtifactsTableName = archiveTableName + "_artifacts"
+	// logsArtifactName is the name of the output artifact that the wait container saves the main container's logs as
+	logsArtifactName = "main-logs"
+)
+
+// archivedWorkflowArtifactRecord is a row of the index of the artifacts of archived workflows, so that they can be
+// listed, and downloaded, without getting and unmarshalling the whole workflow
+type archivedWorkflowArtifactRecord struct {
+	ClusterName string `db:"clustername"`
+	UID         string `db:"uid"`
+	NodeID      string `db:"nodeid"`
+	Input       bool   `db:"input"`
+	Name        string `db:"name"`
+	Logs        bool   `db:"logs"`
+	Artifact    string `db:"artifact"`
+}
+
+// ArchivedArtifact is an input, or output, artifact of a node of an archived workflow
+type ArchivedArtifact struct {
+	NodeID string `json:"nodeId"`
+	Input  bool   `json:"input,omitempty"`
+	// Logs is true if the artifact is the logs of the node's main container
+	Logs bool `json:"logs,omitempty"`
+	// Key is the key of the artifact in its repository
+	Key      string        `json:"key,omitempty"`
+	Artifact wfv1.Artifact `json:"artifact"`
+}
+
+// ArchivedArtifacts are the artifacts of an archived workflow
+type ArchivedArtifacts struct {
+	Namespace string             `json:"namespace"`
+	Name      string             `json:"name"`
+	Items     []ArchivedArtifact `json:"items"`
+}
+
+// Get returns the artifact of the node with the name, or nil if there is none
+func (a ArchivedArtifacts) Get(nodeID, name string, input bool) *wfv1.Artifact {
+	for _, item := range a.Items {
+		if item.NodeID == nodeID && item.Artifact.Name == name && item.Input == input {
+			return &item.Artifact
+		}
+	}
+	return nil
+}
+
+// artifactRecords returns the index of the artifacts of the workflow. Artifacts that only have a key are relocated to
+// the workflow's artifact repository, so they can be downloaded without it.
+func artifactRecords(clusterName string, wf *wfv1.Workflow) ([]archivedWorkflowArtifactRecord, error) {
+	var location *wfv1.ArtifactLocation
+	if ref := wf.Status.ArtifactRepositoryRef; ref != nil && ref.ArtifactRepository != nil {
+		location = ref.ArtifactRepository.ToArtifactLocation()
+	}
+	var records []archivedWorkflowArtifactRecord
+	add := func(nodeID string, input bool, artifacts wfv1.Artifacts) error {
+		for _, art := range artifacts {
+			if !art.HasLocationOrKey() {
+				continue
+			}
+			if location != nil {
+				// the artifact keeps its key if it cannot be relocated, and is relocated by the Argo Server instead
+				_ = art.Relocate(location)
+			}
+			data, err := json.Marshal(art)
+			if err != nil {
+				return err
+			}
+			records = append(records, archivedWorkflowArtifactRecord{
+				ClusterName: clusterName,
+				UID:         string(wf.UID),
+				NodeID:      nodeID,
+				Input:       input,
+				Name:        art.Name,
+				Logs:        !input && art.Name == logsArtifactName,
+				Artifact:    string(data),
+			})
+		}
+		return nil
+	}
+	for nodeID, node := range wf.Status.Nodes {
+		if node.Inputs != nil {
+			if err := add(nodeID, true, node.Inputs.Artifacts); err != nil {
+				return nil, err
+			}
+		}
+		if node.Outputs != nil {
+			if err := add(nodeID, false, node.Outputs.Artifacts); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.NodeID != b.NodeID {
+			return a.NodeID < b.NodeID
+		}
+		if a.Input != b.Input {
+			return a.Input
+		}
+		return a.Name < b.Name
+	})
+	return records, nil
+}
+
+// insertArtifacts replaces the index of the artifacts of the workflow
+func (r *workflowArchive) insertArtifacts(sess sqlbuilder.Tx, wf *wfv1.Workflow) error {
+	_, err := sess.
+		DeleteFrom(archiveArtifactsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"uid": wf.UID}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	records, err := artifactRecords(r.clusterName, wf)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		_, err := sess.Collection(archiveArtifactsTableName).Insert(&record)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListWorkflowArtifacts returns the artifacts of the archived workflow, or, if the node ID is not empty, of the node,
+// or nil if the workflow is not archived. Workflows archived before the artifacts were indexed are unmarshalled.
+func (r *workflowArchive) ListWorkflowArtifacts(uid, nodeID string) (*ArchivedArtifacts, error) {
+	md := &archivedWorkflowMetadata{}
+	err := r.session.
+		Select("namespace", "name").
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(db.Cond{"uid": uid}).
+		One(md)
+	if err != nil {
+		if err == db.ErrNoMoreRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var records []archivedWorkflowArtifactRecord
+	err = r.session.
+		Select("nodeid", "input", "name", "logs", "artifact").
+		From(archiveArtifactsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"uid": uid}).
+		And(nodeIDEqual(nodeID)).
+		OrderBy("nodeid", "-input", "name").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		wf, err := r.GetWorkflow(uid)
+		if err != nil || wf == nil {
+			return nil, err
+		}
+		all, err := artifactRecords(r.clusterName, wf)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range all {
+			if nodeID == "" || record.NodeID == nodeID {
+				records = append(records, record)
+			}
+		}
+	}
+	artifacts := &ArchivedArtifacts{Namespace: md.Namespace, Name: md.Name, Items: make([]ArchivedArtifact, len(records))}
+	for i, record := range records {
+		item := ArchivedArtifact{NodeID: record.NodeID, Input: record.Input, Logs: record.Logs}
+		if err := json.Unmarshal([]byte(record.Artifact), &item.Artifact); err != nil {
+			return nil, err
+		}
+		item.Key, _ = item.Artifact.GetKey()
+		artifacts.Items[i] = item
+	}
+	return artifacts, nil
+}
+
+func nodeIDEqual(nodeID string) db.Cond {
+	if nodeID == "" {
+		return db.Cond{}
+	}
+	return db.Cond{"nodeid": nodeID}
+}
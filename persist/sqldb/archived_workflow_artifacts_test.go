@@ -0,0 +1,64 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func artifactsWorkflow() *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{
+			ArtifactRepositoryRef: &wfv1.ArtifactRepositoryRefStatus{ArtifactRepository: &wfv1.ArtifactRepository{
+				S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}},
+			}},
+			Nodes: wfv1.Nodes{
+				"my-node": {
+					Inputs: &wfv1.Inputs{Artifacts: wfv1.Artifacts{
+						{Name: "my-input", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-input.tgz"}}},
+					}},
+					Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+						{Name: "main-logs", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "main.log"}}},
+						{Name: "my-git", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: "https://github.com/argoproj/argo-workflows"}}},
+						// an optional artifact that was not saved
+						{Name: "my-optional", Optional: true},
+					}},
+				},
+				"no-artifacts-node": {},
+			},
+		},
+	}
+}
+
+func Test_artifactRecords(t *testing.T) {
+	records, err := artifactRecords("my-cluster", artifactsWorkflow())
+	require.NoError(t, err)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, "my-input", records[0].Name)
+		assert.True(t, records[0].Input)
+		assert.Contains(t, records[0].Artifact, `"bucket":"my-bucket"`, "relocated to the artifact repository")
+		assert.Equal(t, "main-logs", records[1].Name)
+		assert.True(t, records[1].Logs)
+		assert.Equal(t, "my-git", records[2].Name)
+		assert.False(t, records[2].Logs)
+		for _, record := range records {
+			assert.Equal(t, "my-cluster", record.ClusterName)
+			assert.Equal(t, "my-uid", record.UID)
+			assert.Equal(t, "my-node", record.NodeID)
+		}
+	}
+}
+
+func TestArchivedArtifacts_Get(t *testing.T) {
+	a := ArchivedArtifacts{Items: []ArchivedArtifact{
+		{NodeID: "my-node", Input: true, Artifact: wfv1.Artifact{Name: "my-art"}},
+	}}
+	assert.NotNil(t, a.Get("my-node", "my-art", true))
+	assert.Nil(t, a.Get("my-node", "my-art", false))
+	assert.Nil(t, a.Get("other-node", "my-art", true))
+}
@@ -0,0 +1,144 @@
+package sqldb
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// searchText returns the text that an archived workflow is found by when searching, i.e. its name, its labels and
+// annotations, and the display names and messages of its nodes
+func searchText(wf *wfv1.Workflow) string {
+	words := []string{wf.Name}
+	for _, m := range []map[string]string{wf.GetLabels(), wf.GetAnnotations()} {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			words = append(words, k, m[k])
+		}
+	}
+	ids := make([]string, 0, len(wf.Status.Nodes))
+	for id := range wf.Status.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		n := wf.Status.Nodes[id]
+		words = append(words, n.DisplayName)
+		if n.Message != "" {
+			words = append(words, n.Message)
+		}
+	}
+	if wf.Status.Message != "" {
+		words = append(words, wf.Status.Message)
+	}
+	return strings.Join(words, " ")
+}
+
+// searchClause matches the archived workflows whose search text contains every word in the search. It uses the
+// full-text index on the searchtext column, so words are matched whole, as tokenized by the database.
+func searchClause(t dbType, search string) db.Compound {
+	if strings.TrimSpace(search) == "" {
+		return db.And()
+	}
+	if t == MySQL {
+		// in boolean mode, "+" means the word must be present, and we must remove any other operators
+		words := strings.FieldsFunc(search, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' })
+		if len(words) == 0 {
+			return db.And()
+		}
+		return db.Raw("match (searchtext) against (? in boolean mode)", "+"+strings.Join(words, " +"))
+	}
+	return db.Raw("to_tsvector('simple', searchtext) @@ plainto_tsquery('simple', ?)", search)
+}
+
+// searchClause matches the archived workflows whose search text contains every word in the search. SQLite has no
+// full-text index, and MySQL does not support them on partitioned tables, so their search text is matched with LIKE
+// instead.
+func (r *workflowArchive) searchClause(search string) db.Compound {
+	if r.dbType == SQLite || (r.dbType == MySQL && r.partitioned) {
+		return likeSearchClause(r.dbType, search)
+	}
+	return searchClause(r.dbType, search)
+}
+
+// likeSearchClause matches the archived workflows whose search text contains every word in the search, anywhere, so
+// words are also matched as parts of other words
+func likeSearchClause(t dbType, search string) db.Compound {
+	var conds []db.Compound
+	for _, word := range strings.Fields(search) {
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(word) + "%"
+		if t == SQLite {
+			// SQLite has no escape character unless one is given
+			conds = append(conds, db.Raw(`searchtext like ? escape '\'`, pattern))
+		} else {
+			conds = append(conds, db.Cond{"searchtext LIKE": pattern})
+		}
+	}
+	return db.And(conds...)
+}
+
+// backfillSearchText sets the search text of the workflows that were archived before there was one
+type backfillSearchText struct{}
+
+func (s backfillSearchText) String() string {
+	return "backfillSearchText{}"
+}
+
+func (s backfillSearchText) apply(session sqlbuilder.SQLBuilder) (err error) {
+	log.Info("Backfill archived workflow search text")
+	rs, err := session.SelectFrom(archiveTableName).
+		Columns("clustername", "uid", "workflow").
+		Where(db.Cond{"searchtext": nil}).
+		Query()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmpErr := rs.Close()
+		if err == nil {
+			err = tmpErr
+		}
+	}()
+	type record struct {
+		clusterName, uid, searchText string
+	}
+	// we read every record before updating any, as some drivers do not allow an update while a query is open
+	var records []record
+	for rs.Next() {
+		var clusterName, uid, workflow string
+		if err := rs.Scan(&clusterName, &uid, &workflow); err != nil {
+			return err
+		}
+		var wf *wfv1.Workflow
+		if err := json.Unmarshal([]byte(workflow), &wf); err != nil {
+			return err
+		}
+		records = append(records, record{clusterName, uid, searchText(wf)})
+	}
+	if err := rs.Err(); err != nil {
+		return err
+	}
+	for _, r := range records {
+		_, err := session.Update(archiveTableName).
+			Set("searchtext", r.searchText).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": r.uid}).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+	log.WithField("count", len(records)).Info("Back-filled archived workflow search text")
+	return nil
+}
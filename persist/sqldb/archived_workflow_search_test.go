@@ -0,0 +1,60 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_searchText(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-wf",
+			Labels:      map[string]string{"team": "data", "app": "etl"},
+			Annotations: map[string]string{"owner": "alice"},
+		},
+		Status: wfv1.WorkflowStatus{
+			Message: "child failed",
+			Nodes: wfv1.Nodes{
+				"my-wf-2": {DisplayName: "load", Message: "OOMKilled"},
+				"my-wf-1": {DisplayName: "extract"},
+			},
+		},
+	}
+	assert.Equal(t, "my-wf app etl team data owner alice extract load OOMKilled child failed", searchText(wf))
+}
+
+func Test_searchClause(t *testing.T) {
+	tests := []struct {
+		name   string
+		dbType dbType
+		search string
+		want   db.Compound
+	}{
+		{"Empty", Postgres, " ", db.And()},
+		{"Postgres", Postgres, "my-wf OOMKilled", db.Raw("to_tsvector('simple', searchtext) @@ plainto_tsquery('simple', ?)", "my-wf OOMKilled")},
+		{"MySQL", MySQL, "my-wf +OOMKilled", db.Raw("match (searchtext) against (? in boolean mode)", "+my +wf +OOMKilled")},
+		{"MySQLOnlyOperators", MySQL, "+-*", db.And()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchClause(tt.dbType, tt.search)
+			assert.Equal(t, tt.want.Sentences(), got.Sentences())
+		})
+	}
+}
+
+func Test_likeSearchClause(t *testing.T) {
+	assert.Equal(t, db.And().Sentences(), likeSearchClause(MySQL, " ").Sentences())
+	assert.Equal(t, db.And(
+		db.Cond{"searchtext LIKE": "%my-wf%"},
+		db.Cond{"searchtext LIKE": `%100\%\_done%`},
+	).Sentences(), likeSearchClause(MySQL, "my-wf 100%_done").Sentences())
+	assert.Equal(t, db.And(
+		db.Raw(`searchtext like ? escape '\'`, "%my-wf%"),
+	).Sentences(), likeSearchClause(SQLite, "my-wf").Sentences())
+}
@@ -19,7 +19,7 @@ func (s backfillNodes) String() string {
 	return fmt.Sprintf("backfillNodes{%s}", s.tableName)
 }
 
-func (s backfillNodes) apply(session sqlbuilder.Database) (err error) {
+func (s backfillNodes) apply(session sqlbuilder.SQLBuilder) (err error) {
 	log.Info("Backfill node status")
 	rs, err := session.SelectFrom(s.tableName).
 		Columns("workflow").
@@ -47,7 +47,7 @@ func (s backfillNodes) apply(session sqlbuilder.Database) (err error) {
 		if err != nil {
 			return err
 		}
-		marshalled, version, err := nodeStatusVersion(wf.Status.Nodes)
+		marshalled, version, err := NodeStatusVersion(wf.Status.Nodes)
 		if err != nil {
 			return err
 		}
@@ -36,3 +36,7 @@ func (n *explosiveOffloadNodeStatusRepo) Delete(string, string) error {
 func (n *explosiveOffloadNodeStatusRepo) ListOldOffloads(string) (map[string][]string, error) {
 	return nil, OffloadNotSupportedError
 }
+
+func (n *explosiveOffloadNodeStatusRepo) Completed(string, string) error {
+	return OffloadNotSupportedError
+}
@@ -0,0 +1,36 @@
+package sqldb
+
+import (
+	"fmt"
+	"os"
+)
+
+// FileLock is an exclusive lock on a file, held until it is unlocked, or the process exits. The controller locks a
+// file next to a SQLite database, so that a second controller fails to start, rather than writing to it at the same
+// time.
+type FileLock struct {
+	path string
+	file *os.File
+}
+
+// LockFile locks the file, creating it if it does not exist, or returns an error if another process has locked it
+func LockFile(path string) (*FileLock, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(file); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to lock %s, is another controller using the database? %w", path, err)
+	}
+	return &FileLock{path: path, file: file}, nil
+}
+
+func (l *FileLock) Path() string {
+	return l.path
+}
+
+// Unlock releases the lock
+func (l *FileLock) Unlock() error {
+	return l.file.Close()
+}
@@ -0,0 +1,22 @@
+package sqldb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "argo.db.lock")
+	lock, err := LockFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, path, lock.Path())
+	_, err = LockFile(path)
+	assert.Error(t, err)
+	require.NoError(t, lock.Unlock())
+	lock, err = LockFile(path)
+	require.NoError(t, err)
+	assert.NoError(t, lock.Unlock())
+}
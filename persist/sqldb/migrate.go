@@ -2,13 +2,35 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 
 	log "github.com/sirupsen/logrus"
 	"upper.io/db.v3/lib/sqlbuilder"
 )
 
 type Migrate interface {
+	// Exec validates the schema, see Validate, and then applies the changes that have not been applied
 	Exec(ctx context.Context) error
+	// Validate returns an error if a change that has been applied differs from this version's change, i.e. the
+	// database was migrated by a different version, which has a different schema
+	Validate(ctx context.Context) error
+	// Plan returns the changes that Exec would apply, without applying them
+	Plan(ctx context.Context) ([]PlannedChange, error)
+	// Downgrade reverts the changes applied after the schema version, which must be no earlier than MinDowngradeVersion
+	Downgrade(ctx context.Context, version int) error
+	// PlanDowngrade returns the changes that Downgrade would revert, without reverting them
+	PlanDowngrade(ctx context.Context, version int) ([]PlannedChange, error)
+	// MinDowngradeVersion returns the earliest schema version that can be downgraded to
+	MinDowngradeVersion() int
+}
+
+// PlannedChange is a change to apply, or to revert, to the schema
+type PlannedChange struct {
+	// Version is the schema version once the change is applied, or before it is reverted
+	Version int
+	// Change is the SQL of the change, or a description of it if it is not SQL, e.g. a backfill of a column
+	Change string
 }
 
 func NewMigrate(session sqlbuilder.Database, clusterName string, tableName string) Migrate {
@@ -22,7 +44,7 @@ type migrate struct {
 }
 
 type change interface {
-	apply(session sqlbuilder.Database) error
+	apply(session sqlbuilder.SQLBuilder) error
 }
 
 func ternary(condition bool, left, right change) change {
@@ -56,15 +78,85 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 				return err
 			}
 		}
+		_, err = m.session.Exec("create table if not exists " + schemaChecksumsTableName + "(schema_version int not null, checksum varchar(64) not null, primary key (schema_version))")
+		if err != nil {
+			return err
+		}
 	}
 	dbType := dbTypeFor(m.session)
 
 	log.WithFields(log.Fields{"clusterName": m.clusterName, "dbType": dbType}).Info("Migrating database schema")
 
+	changes := m.changes(dbType)
+	if err := m.validate(changes); err != nil {
+		return err
+	}
+	if err := m.recordChecksums(changes); err != nil {
+		return err
+	}
+	for changeSchemaVersion, change := range changes {
+		err := m.applyChange(ctx, changeSchemaVersion, change)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m migrate) Plan(context.Context) ([]PlannedChange, error) {
+	changes := m.changes(dbTypeFor(m.session))
+	version, err := m.schemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	var plan []PlannedChange
+	for changeSchemaVersion := version + 1; changeSchemaVersion < len(changes); changeSchemaVersion++ {
+		plan = append(plan, PlannedChange{Version: changeSchemaVersion, Change: fmt.Sprint(changes[changeSchemaVersion])})
+	}
+	return plan, nil
+}
+
+// schemaVersion returns the version of the schema, i.e. of the last change applied, or -1 if none have been
+func (m migrate) schemaVersion() (int, error) {
+	exists, err := m.tableExists("schema_history")
+	if err != nil || !exists {
+		return -1, err
+	}
+	row, err := m.session.QueryRow("select schema_version from schema_history")
+	if err != nil {
+		return 0, err
+	}
+	version := -1
+	if err := row.Scan(&version); err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (m migrate) tableExists(name string) (bool, error) {
+	collections, err := m.session.Collections()
+	if err != nil {
+		return false, err
+	}
+	for _, c := range collections {
+		if c == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// changes returns the changes to the schema, in the order they are applied. The schema version is the index of the
+// last change applied. Changes must only be appended, as their checksums are validated.
+func (m migrate) changes(dbType dbType) []change {
+	if dbType == SQLite {
+		return sqliteChanges(m.tableName)
+	}
 	// try and make changes idempotent, as it is possible for the change to apply, but the archive update to fail
 	// and therefore try and apply again next try
 
-	for changeSchemaVersion, change := range []change{
+	return []change{
 		ansiSQLChange(`create table if not exists ` + m.tableName + ` (
     id varchar(128) ,
     name varchar(256),
@@ -253,14 +345,92 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
 		// add argo_archived_workflows name index for prefix searching performance
 		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
-	} {
-		err := m.applyChange(ctx, changeSchemaVersion, change)
-		if err != nil {
-			return err
-		}
+		// add argo_archived_workflows index for listing workflows in order using a cursor
+		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername,instanceid,startedat,uid)`),
+		// the Argo Server writes the audit log, but (as with all tables) the controller creates it
+		// the changes from here on can be reverted by Downgrade, reverting them drops the tables, and their data
+		withDowngrade(ansiSQLChange(`create table if not exists argo_audit_log (
+    clustername varchar(64) not null,
+    createdat timestamp not null,
+    method varchar(256) not null,
+    subject varchar(256),
+    sourceip varchar(64),
+    namespace varchar(256),
+    name varchar(256),
+    outcome varchar(32) not null,
+    entry json not null
+)`), ansiSQLChange(`drop table if exists argo_audit_log`)),
+		withDowngrade(ansiSQLChange(`create index argo_audit_log_i1 on argo_audit_log (clustername,createdat)`), dropIndexIfExists{dbType, "argo_audit_log", "argo_audit_log_i1"}),
+		// add argo_archived_workflows search text, and its full-text index, for searching archived workflows
+		withDowngrade(ansiSQLChange(`alter table argo_archived_workflows add column searchtext text`), ansiSQLChange(`alter table argo_archived_workflows drop column searchtext`)),
+		withDowngrade(backfillSearchText{}, nil),
+		withDowngrade(ternary(dbType == MySQL,
+			ansiSQLChange(`create fulltext index argo_archived_workflows_i5 on argo_archived_workflows (searchtext)`),
+			ansiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows using gin (to_tsvector('simple', searchtext))`),
+		), dropIndexIfExists{dbType, archiveTableName, "argo_archived_workflows_i5"}),
+		// the Argo Server saves events it could not dispatch to a workflow event binding, so they can be replayed
+		withDowngrade(ansiSQLChange(`create table if not exists argo_failed_events (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    workfloweventbinding varchar(256) not null,
+    createdat timestamp not null,
+    event json not null,
+    primary key (clustername, uid)
+)`), ansiSQLChange(`drop table if exists argo_failed_events`)),
+		withDowngrade(ansiSQLChange(`create index argo_failed_events_i1 on argo_failed_events (clustername,namespace,createdat)`), dropIndexIfExists{dbType, "argo_failed_events", "argo_failed_events_i1"}),
+		// the index of the artifacts of archived workflows, so the Argo Server can list, and download, them without
+		// getting the workflow. There is no foreign key, so the archive table can be partitioned, the rows are deleted
+		// with the workflow instead.
+		withDowngrade(ansiSQLChange(`create table if not exists argo_archived_workflows_artifacts (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    nodeid varchar(256) not null,
+    input boolean not null,
+    name varchar(256) not null,
+    logs boolean not null,
+    artifact json not null,
+    primary key (clustername, uid, nodeid, input, name)
+)`), ansiSQLChange(`drop table if exists argo_archived_workflows_artifacts`)),
+		// the controller periodically snapshots the statuses of workflows, so the Argo Server can get a workflow's
+		// status as of a time. The status is gzipped, so MySQL needs a longtext, rather than a text.
+		withDowngrade(ternary(dbType == MySQL,
+			ansiSQLChange(`create table if not exists argo_workflow_snapshots (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    name varchar(256) not null,
+    createdat timestamp not null,
+    status longtext not null,
+    primary key (clustername, uid, createdat)
+)`),
+			ansiSQLChange(`create table if not exists argo_workflow_snapshots (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    name varchar(256) not null,
+    createdat timestamp not null,
+    status text not null,
+    primary key (clustername, uid, createdat)
+)`),
+		), ansiSQLChange(`drop table if exists argo_workflow_snapshots`)),
+		withDowngrade(ansiSQLChange(`create index argo_workflow_snapshots_i1 on argo_workflow_snapshots (clustername,namespace,name,createdat)`), dropIndexIfExists{dbType, "argo_workflow_snapshots", "argo_workflow_snapshots_i1"}),
+		// the queues of the workflows pending admission, because of the parallelism limits, so the order they are
+		// admitted in survives the controller restarting
+		withDowngrade(ansiSQLChange(`create table if not exists argo_pending_workflows (
+    clustername varchar(64) not null,
+    queue varchar(64) not null,
+    bucket varchar(64) not null,
+    workflowkey varchar(317) not null,
+    priority int not null,
+    createdat timestamp not null,
+    queuedat bigint not null,
+    primary key (clustername, queue, workflowkey)
+)`), ansiSQLChange(`drop table if exists argo_pending_workflows`)),
+		withDowngrade(ansiSQLChange(`create index argo_pending_workflows_i1 on argo_pending_workflows (clustername,queue,bucket,priority,createdat,queuedat)`), dropIndexIfExists{dbType, "argo_pending_workflows", "argo_pending_workflows_i1"}),
+		// the expired snapshots are deleted by their creation time
+		withDowngrade(ansiSQLChange(`create index argo_workflow_snapshots_i2 on argo_workflow_snapshots (clustername,createdat)`), dropIndexIfExists{dbType, "argo_workflow_snapshots", "argo_workflow_snapshots_i2"}),
 	}
-
-	return nil
 }
 
 func (m migrate) applyChange(ctx context.Context, changeSchemaVersion int, c change) error {
@@ -279,10 +449,116 @@ func (m migrate) applyChange(ctx context.Context, changeSchemaVersion int, c cha
 	}
 	if rowsAffected == 1 {
 		log.WithFields(log.Fields{"changeSchemaVersion": changeSchemaVersion, "change": c}).Info("applying database change")
-		err := c.apply(m.session)
+		var session sqlbuilder.SQLBuilder = m.session
+		// SQLite only has one writer at a time, and the transaction is already writing, so the change must be applied in it
+		if dbTypeFor(m.session) == SQLite {
+			session = tx
+		}
+		err := c.apply(session)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("insert into "+schemaChecksumsTableName+" (schema_version, checksum) values (?, ?)", changeSchemaVersion, checksum(c))
 		if err != nil {
 			return err
 		}
 	}
 	return tx.Commit()
 }
+
+// sqliteChanges returns the changes to SQLite databases. SQLite cannot alter most of a table, and no SQLite database
+// has the schema before SQLite was supported, so the changes to it start from the schema at the time, and have their
+// own schema versions. Every change made to the other databases from then on must also be made here.
+func sqliteChanges(tableName string) []change {
+	return []change{
+		ansiSQLChange(`create table if not exists ` + tableName + ` (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    version varchar(64) not null,
+    namespace varchar(256) not null,
+    nodes text not null,
+    updatedat timestamp not null default current_timestamp,
+    primary key (clustername, uid, version)
+)`),
+		ansiSQLChange(`create index ` + tableName + `_i1 on ` + tableName + ` (clustername,namespace,updatedat)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    instanceid varchar(64) not null,
+    name varchar(256) not null,
+    phase varchar(25) not null,
+    namespace varchar(256) not null,
+    workflow text not null,
+    startedat timestamp not null default current_timestamp,
+    finishedat timestamp not null default current_timestamp,
+    searchtext text,
+    primary key (clustername, uid)
+)`),
+		ansiSQLChange(`create index argo_archived_workflows_i1 on argo_archived_workflows (clustername,instanceid,namespace)`),
+		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
+		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername,instanceid,startedat,uid)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows_labels (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(317) not null,
+    value varchar(63) not null,
+    primary key (clustername, uid, name),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		ansiSQLChange(`create table if not exists argo_audit_log (
+    clustername varchar(64) not null,
+    createdat timestamp not null,
+    method varchar(256) not null,
+    subject varchar(256),
+    sourceip varchar(64),
+    namespace varchar(256),
+    name varchar(256),
+    outcome varchar(32) not null,
+    entry text not null
+)`),
+		ansiSQLChange(`create index argo_audit_log_i1 on argo_audit_log (clustername,createdat)`),
+		ansiSQLChange(`create table if not exists argo_failed_events (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    workfloweventbinding varchar(256) not null,
+    createdat timestamp not null,
+    event text not null,
+    primary key (clustername, uid)
+)`),
+		ansiSQLChange(`create index argo_failed_events_i1 on argo_failed_events (clustername,namespace,createdat)`),
+		withDowngrade(ansiSQLChange(`create table if not exists argo_archived_workflows_artifacts (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    nodeid varchar(256) not null,
+    input boolean not null,
+    name varchar(256) not null,
+    logs boolean not null,
+    artifact text not null,
+    primary key (clustername, uid, nodeid, input, name)
+)`), ansiSQLChange(`drop table if exists argo_archived_workflows_artifacts`)),
+		withDowngrade(ansiSQLChange(`create table if not exists argo_workflow_snapshots (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    name varchar(256) not null,
+    createdat timestamp not null,
+    status text not null,
+    primary key (clustername, uid, createdat)
+)`), ansiSQLChange(`drop table if exists argo_workflow_snapshots`)),
+		withDowngrade(ansiSQLChange(`create index argo_workflow_snapshots_i1 on argo_workflow_snapshots (clustername,namespace,name,createdat)`), dropIndexIfExists{SQLite, "argo_workflow_snapshots", "argo_workflow_snapshots_i1"}),
+		withDowngrade(ansiSQLChange(`create table if not exists argo_pending_workflows (
+    clustername varchar(64) not null,
+    queue varchar(64) not null,
+    bucket varchar(64) not null,
+    workflowkey varchar(317) not null,
+    priority int not null,
+    createdat timestamp not null,
+    queuedat bigint not null,
+    primary key (clustername, queue, workflowkey)
+)`), ansiSQLChange(`drop table if exists argo_pending_workflows`)),
+		withDowngrade(ansiSQLChange(`create index argo_pending_workflows_i1 on argo_pending_workflows (clustername,queue,bucket,priority,createdat,queuedat)`), dropIndexIfExists{SQLite, "argo_pending_workflows", "argo_pending_workflows_i1"}),
+		withDowngrade(ansiSQLChange(`create index argo_workflow_snapshots_i2 on argo_workflow_snapshots (clustername,createdat)`), dropIndexIfExists{SQLite, "argo_workflow_snapshots", "argo_workflow_snapshots_i2"}),
+	}
+}
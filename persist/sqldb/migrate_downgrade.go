@@ -0,0 +1,146 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+// reversibleChange is a change that Downgrade can revert with its downgrade, which is nil if there is nothing to
+// revert, e.g. for a backfill. Only the recent changes are reversible, so that a failed upgrade can be rolled back.
+type reversibleChange struct {
+	change
+	downgrade change
+}
+
+func withDowngrade(c, downgrade change) change {
+	return reversibleChange{c, downgrade}
+}
+
+// String returns the change's, so that its checksum is the same as it was before it was reversible
+func (r reversibleChange) String() string {
+	return fmt.Sprint(r.change)
+}
+
+// dropIndexIfExists drops the index, unless it has already been dropped, e.g. MySQL's full-text index of a
+// partitioned archive table
+type dropIndexIfExists struct {
+	dbType dbType
+	table  string
+	name   string
+}
+
+func (d dropIndexIfExists) String() string {
+	if d.dbType == MySQL {
+		return fmt.Sprintf("drop index %s on %s", d.name, d.table)
+	}
+	return fmt.Sprintf("drop index if exists %s", d.name)
+}
+
+func (d dropIndexIfExists) apply(session sqlbuilder.SQLBuilder) error {
+	if d.dbType == MySQL {
+		row, err := session.QueryRow(`select count(*) from information_schema.statistics where table_schema = database() and table_name = ? and index_name = ?`, d.table, d.name)
+		if err != nil {
+			return err
+		}
+		count := 0
+		if err := row.Scan(&count); err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+	}
+	_, err := session.Exec(d.String())
+	return err
+}
+
+func minDowngradeVersion(changes []change) int {
+	version := len(changes) - 1
+	for version >= 0 {
+		if _, ok := changes[version].(reversibleChange); !ok {
+			break
+		}
+		version--
+	}
+	return version
+}
+
+func (m migrate) MinDowngradeVersion() int {
+	return minDowngradeVersion(m.changes(dbTypeFor(m.session)))
+}
+
+func (m migrate) PlanDowngrade(_ context.Context, version int) ([]PlannedChange, error) {
+	changes := m.changes(dbTypeFor(m.session))
+	if earliest := minDowngradeVersion(changes); version < earliest {
+		return nil, fmt.Errorf("the database schema can only be downgraded to version %d, or later", earliest)
+	}
+	current, err := m.schemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	if current >= len(changes) {
+		return nil, fmt.Errorf("the database schema version %d is later than this version's %d, downgrade it with the version that migrated it", current, len(changes)-1)
+	}
+	var plan []PlannedChange
+	for v := current; v > version; v-- {
+		r := changes[v].(reversibleChange)
+		description := fmt.Sprintf("nothing to revert for %v", r.change)
+		if r.downgrade != nil {
+			description = fmt.Sprint(r.downgrade)
+		}
+		plan = append(plan, PlannedChange{Version: v, Change: description})
+	}
+	return plan, nil
+}
+
+func (m migrate) Downgrade(ctx context.Context, version int) error {
+	plan, err := m.PlanDowngrade(ctx, version)
+	if err != nil {
+		return err
+	}
+	changes := m.changes(dbTypeFor(m.session))
+	for _, p := range plan {
+		if err := m.revertChange(ctx, p.Version, changes[p.Version].(reversibleChange)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m migrate) revertChange(ctx context.Context, changeSchemaVersion int, c reversibleChange) error {
+	tx, err := m.session.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	rs, err := tx.Exec("update schema_history set schema_version = ? where schema_version = ?", changeSchemaVersion-1, changeSchemaVersion)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("database schema version is no longer %d, is it being migrated?", changeSchemaVersion)
+	}
+	log.WithFields(log.Fields{"changeSchemaVersion": changeSchemaVersion, "change": c.change}).Info("reverting database change")
+	if c.downgrade != nil {
+		var session sqlbuilder.SQLBuilder = m.session
+		// as for applyChange, SQLite changes must be reverted in the transaction
+		if dbTypeFor(m.session) == SQLite {
+			session = tx
+		}
+		if err := c.downgrade.apply(session); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec("delete from "+schemaChecksumsTableName+" where schema_version = ?", changeSchemaVersion)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
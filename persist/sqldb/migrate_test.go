@@ -0,0 +1,98 @@
+package sqldb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func Test_minDowngradeVersion(t *testing.T) {
+	m := migrate{tableName: "argo_workflows"}
+	assert.Equal(t, 58, minDowngradeVersion(m.changes(Postgres)))
+	assert.Equal(t, 58, minDowngradeVersion(m.changes(MySQL)))
+	assert.Equal(t, 11, minDowngradeVersion(m.changes(SQLite)))
+}
+
+func Test_checksum(t *testing.T) {
+	c := ansiSQLChange(`create index foo_i1 on foo (bar)`)
+	assert.Equal(t, checksum(c), checksum(withDowngrade(c, ansiSQLChange(`drop index foo_i1`))), "making a change reversible does not change its checksum")
+	assert.NotEqual(t, checksum(c), checksum(ansiSQLChange(`create index foo_i1 on foo (baz)`)))
+}
+
+func Test_dropIndexIfExists(t *testing.T) {
+	assert.Equal(t, "drop index if exists foo_i1", dropIndexIfExists{Postgres, "foo", "foo_i1"}.String())
+	assert.Equal(t, "drop index foo_i1 on foo", dropIndexIfExists{MySQL, "foo", "foo_i1"}.String())
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	session, tableName, err := CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	require.NoError(t, err)
+	defer func() { _ = session.Close() }()
+	m := NewMigrate(session, "default", tableName)
+	latest := len(sqliteChanges(tableName)) - 1
+
+	t.Run("Plan", func(t *testing.T) {
+		plan, err := m.Plan(ctx)
+		require.NoError(t, err)
+		if assert.Len(t, plan, latest+1) {
+			assert.Equal(t, 0, plan[0].Version)
+			assert.Contains(t, plan[0].Change, "create table if not exists argo_workflows")
+		}
+		require.NoError(t, m.Validate(ctx), "an empty database is valid")
+	})
+	require.NoError(t, m.Exec(ctx))
+	t.Run("Migrated", func(t *testing.T) {
+		plan, err := m.Plan(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, plan)
+		count, err := session.Collection(schemaChecksumsTableName).Find().Count()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(latest+1), count)
+		require.NoError(t, m.Validate(ctx))
+	})
+	t.Run("PlanDowngrade", func(t *testing.T) {
+		_, err := m.PlanDowngrade(ctx, m.MinDowngradeVersion()-1)
+		assert.EqualError(t, err, "the database schema can only be downgraded to version 11, or later")
+		plan, err := m.PlanDowngrade(ctx, latest-3)
+		require.NoError(t, err)
+		assert.Equal(t, []PlannedChange{
+			{Version: latest, Change: "drop index if exists argo_workflow_snapshots_i2"},
+			{Version: latest - 1, Change: "drop index if exists argo_pending_workflows_i1"},
+			{Version: latest - 2, Change: "drop table if exists argo_pending_workflows"},
+		}, plan)
+	})
+	t.Run("Downgrade", func(t *testing.T) {
+		require.NoError(t, m.Downgrade(ctx, latest-3))
+		assert.False(t, session.Collection(pendingWorkflowsTableName).Exists())
+		plan, err := m.Plan(ctx)
+		require.NoError(t, err)
+		assert.Len(t, plan, 3)
+		// upgrading again re-applies the changes
+		require.NoError(t, m.Exec(ctx))
+		assert.True(t, session.Collection(pendingWorkflowsTableName).Exists())
+	})
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		_, err := session.Update(schemaChecksumsTableName).Set("checksum", "bad").Where("schema_version", 1).Exec()
+		require.NoError(t, err)
+		err = m.Validate(ctx)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "database schema change 1 has checksum bad")
+		}
+		assert.Error(t, m.Exec(ctx), "the database is not migrated")
+	})
+	t.Run("LaterVersion", func(t *testing.T) {
+		_, err := session.Update(schemaChecksumsTableName).Set("checksum", checksum(sqliteChanges(tableName)[1])).Where("schema_version", 1).Exec()
+		require.NoError(t, err)
+		_, err = session.Exec("update schema_history set schema_version = ?", latest+1)
+		require.NoError(t, err)
+		assert.NoError(t, m.Validate(ctx), "a later version's changes cannot be validated")
+		_, err = m.PlanDowngrade(ctx, latest)
+		assert.Error(t, err)
+	})
+}
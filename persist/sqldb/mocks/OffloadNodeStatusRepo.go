@@ -14,6 +14,20 @@ type OffloadNodeStatusRepo struct {
 	mock.Mock
 }
 
+// Completed provides a mock function with given fields: uid, version
+func (_m *OffloadNodeStatusRepo) Completed(uid string, version string) error {
+	ret := _m.Called(uid, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(uid, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: uid, version
 func (_m *OffloadNodeStatusRepo) Delete(uid string, version string) error {
 	ret := _m.Called(uid, version)
@@ -6,6 +6,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	labels "k8s.io/apimachinery/pkg/labels"
 
+	sqldb "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+
 	time "time"
 
 	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -44,13 +46,13 @@ func (_m *WorkflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
 	return r0
 }
 
-// DeleteWorkflow provides a mock function with given fields: uid
-func (_m *WorkflowArchive) DeleteWorkflow(uid string) error {
-	ret := _m.Called(uid)
+// DeleteWorkflow provides a mock function with given fields: namespace, uid
+func (_m *WorkflowArchive) DeleteWorkflow(namespace string, uid string) error {
+	ret := _m.Called(namespace, uid)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(uid)
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(namespace, uid)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,13 +97,36 @@ func (_m *WorkflowArchive) IsEnabled() bool {
 	return r0
 }
 
-// ListWorkflows provides a mock function with given fields: namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset
-func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (v1alpha1.Workflows, error) {
-	ret := _m.Called(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+// ListWorkflowArtifacts provides a mock function with given fields: uid, nodeID
+func (_m *WorkflowArchive) ListWorkflowArtifacts(uid string, nodeID string) (*sqldb.ArchivedArtifacts, error) {
+	ret := _m.Called(uid, nodeID)
+
+	var r0 *sqldb.ArchivedArtifacts
+	if rf, ok := ret.Get(0).(func(string, string) *sqldb.ArchivedArtifacts); ok {
+		r0 = rf(uid, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqldb.ArchivedArtifacts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(uid, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkflows provides a mock function with given fields: namespace, name, namePrefix, search, minStartAt, maxStartAt, labelRequirements, limit, cursor
+func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePrefix string, search string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, cursor *sqldb.ListCursor) (v1alpha1.Workflows, error) {
+	ret := _m.Called(namespace, name, namePrefix, search, minStartAt, maxStartAt, labelRequirements, limit, cursor)
 
 	var r0 v1alpha1.Workflows
-	if rf, ok := ret.Get(0).(func(string, string, string, time.Time, time.Time, labels.Requirements, int, int) v1alpha1.Workflows); ok {
-		r0 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, time.Time, time.Time, labels.Requirements, int, *sqldb.ListCursor) v1alpha1.Workflows); ok {
+		r0 = rf(namespace, name, namePrefix, search, minStartAt, maxStartAt, labelRequirements, limit, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(v1alpha1.Workflows)
@@ -109,8 +134,8 @@ func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePref
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, time.Time, time.Time, labels.Requirements, int, int) error); ok {
-		r1 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(1).(func(string, string, string, string, time.Time, time.Time, labels.Requirements, int, *sqldb.ListCursor) error); ok {
+		r1 = rf(namespace, name, namePrefix, search, minStartAt, maxStartAt, labelRequirements, limit, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -21,7 +21,7 @@ func (r *nullWorkflowArchive) ArchiveWorkflow(*wfv1.Workflow) error {
 	return nil
 }
 
-func (r *nullWorkflowArchive) ListWorkflows(string, string, string, time.Time, time.Time, labels.Requirements, int, int) (wfv1.Workflows, error) {
+func (r *nullWorkflowArchive) ListWorkflows(string, string, string, string, time.Time, time.Time, labels.Requirements, int, *ListCursor) (wfv1.Workflows, error) {
 	return wfv1.Workflows{}, nil
 }
 
@@ -29,7 +29,7 @@ func (r *nullWorkflowArchive) GetWorkflow(string) (*wfv1.Workflow, error) {
 	return nil, fmt.Errorf("getting archived workflows not supported")
 }
 
-func (r *nullWorkflowArchive) DeleteWorkflow(string) error {
+func (r *nullWorkflowArchive) DeleteWorkflow(string, string) error {
 	return fmt.Errorf("deleting archived workflows not supported")
 }
 
@@ -44,3 +44,7 @@ func (r *nullWorkflowArchive) ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error)
 func (r *nullWorkflowArchive) ListWorkflowsLabelValues(string) (*wfv1.LabelValues, error) {
 	return &wfv1.LabelValues{}, nil
 }
+
+func (r *nullWorkflowArchive) ListWorkflowArtifacts(string, string) (*ArchivedArtifacts, error) {
+	return nil, fmt.Errorf("listing archived workflow artifacts not supported")
+}
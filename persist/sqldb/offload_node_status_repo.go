@@ -1,6 +1,7 @@
 package sqldb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -28,6 +29,9 @@ type OffloadNodeStatusRepo interface {
 	List(namespace string) (map[UUIDVersion]wfv1.Nodes, error)
 	ListOldOffloads(namespace string) (map[string][]string, error)
 	Delete(uid, version string) error
+	// Completed is called with the version of the nodes, once their workflow has completed, so that they can be
+	// expired
+	Completed(uid, version string) error
 	IsEnabled() bool
 }
 
@@ -36,7 +40,7 @@ func NewOffloadNodeStatusRepo(session sqlbuilder.Database, clusterName, tableNam
 	// useful for testing
 	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
 	log.WithField("ttl", ttl).Info("Node status offloading config")
-	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl}, nil
+	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl, dbType: dbTypeFor(session)}, nil
 }
 
 type nodesRecord struct {
@@ -51,14 +55,17 @@ type nodeOffloadRepo struct {
 	clusterName string
 	tableName   string
 	// time to live - at what ttl an offload becomes old
-	ttl time.Duration
+	ttl    time.Duration
+	dbType dbType
 }
 
 func (wdc *nodeOffloadRepo) IsEnabled() bool {
 	return true
 }
 
-func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
+// NodeStatusVersion returns the nodes marshalled, and their version, which is a hash of them, so that every offload
+// repo versions the same nodes the same
+func NodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 	marshalled, err := json.Marshal(s)
 	if err != nil {
 		return "", "", err
@@ -70,7 +77,7 @@ func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 }
 
 func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
-	marshalled, version, err := nodeStatusVersion(nodes)
+	marshalled, version, err := NodeStatusVersion(nodes)
 	if err != nil {
 		return "", err
 	}
@@ -87,7 +94,11 @@ func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (strin
 
 	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
 	logCtx.Debug("Offloading nodes")
-	_, err = wdc.session.Collection(wdc.tableName).Insert(record)
+	// the insert is in its own transaction, as SQLite otherwise leaves the transaction it is in open if it fails
+	err = wdc.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.Collection(wdc.tableName).Insert(record)
+		return err
+	})
 	if err != nil {
 		// if we have a duplicate, then it must have the same clustername+uid+version, which MUST mean that we
 		// have already written this record
@@ -129,6 +140,10 @@ func isDuplicateKeyError(err error) bool {
 	if strings.Contains(err.Error(), "Duplicate entry") {
 		return true
 	}
+	// sqlite
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return true
+	}
 	return false
 }
 
@@ -224,6 +239,11 @@ func (wdc *nodeOffloadRepo) Delete(uid, version string) error {
 	return nil
 }
 
+// Completed does nothing, the nodes are deleted by the controller once their workflow is
+func (wdc *nodeOffloadRepo) Completed(string, string) error {
+	return nil
+}
+
 func (wdc *nodeOffloadRepo) oldOffload() string {
-	return fmt.Sprintf("updatedat < current_timestamp - interval '%d' second", int(wdc.ttl.Seconds()))
+	return wdc.dbType.olderThan("updatedat", wdc.ttl)
 }
@@ -0,0 +1,160 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/sync"
+)
+
+const pendingWorkflowsTableName = "argo_pending_workflows"
+
+type pendingWorkflowRecord struct {
+	ClusterName string `db:"clustername"`
+	// Queue is the name of the throttler's queue, e.g. "parallelism" or "namespaceParallelism"
+	Queue    string `db:"queue"`
+	Bucket   string `db:"bucket"`
+	Key      string `db:"workflowkey"`
+	Priority int32  `db:"priority"`
+	// CreatedAt is the creation timestamp of the workflow
+	CreatedAt time.Time `db:"createdat"`
+	// QueuedAt is when the workflow was first added to the queue, in nanoseconds, which orders workflows created in
+	// the same second
+	QueuedAt int64 `db:"queuedat"`
+}
+
+// NewPendingWorkflowQueue returns a pending queue, of the throttler of the name, in the database, so the order
+// workflows are admitted in survives the controller restarting. The session func returns the current session, which
+// changes when the configuration does.
+func NewPendingWorkflowQueue(session func() sqlbuilder.Database, clusterName, name string) sync.PendingQueue {
+	return &pendingWorkflowQueue{session: session, clusterName: clusterName, name: name}
+}
+
+type pendingWorkflowQueue struct {
+	session     func() sqlbuilder.Database
+	clusterName string
+	name        string
+	// the last time queued at, so that workflows queued in the same nanosecond are still ordered
+	lastQueuedAt int64
+}
+
+func (q *pendingWorkflowQueue) getSession() (sqlbuilder.Database, error) {
+	session := q.session()
+	if session == nil {
+		return nil, fmt.Errorf("the pending workflow queue %s requires a database session, but persistence is not configured", q.name)
+	}
+	return session, nil
+}
+
+func (q *pendingWorkflowQueue) cond(bucketKey sync.BucketKey) db.Cond {
+	return db.Cond{"clustername": q.clusterName, "queue": q.name, "bucket": bucketKey}
+}
+
+func (q *pendingWorkflowQueue) Add(bucketKey sync.BucketKey, key sync.Key, priority int32, creationTime time.Time) error {
+	session, err := q.getSession()
+	if err != nil {
+		return err
+	}
+	queuedAt := time.Now().UnixNano()
+	if queuedAt <= q.lastQueuedAt {
+		queuedAt = q.lastQueuedAt + 1
+	}
+	record := &pendingWorkflowRecord{
+		ClusterName: q.clusterName,
+		Queue:       q.name,
+		Bucket:      bucketKey,
+		Key:         key,
+		Priority:    priority,
+		CreatedAt:   creationTime.UTC().Truncate(time.Second),
+		QueuedAt:    queuedAt,
+	}
+	// the insert is in its own transaction, as SQLite otherwise leaves the transaction it is in open if it fails
+	err = session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.Collection(pendingWorkflowsTableName).Insert(record)
+		return err
+	})
+	if err == nil {
+		q.lastQueuedAt = queuedAt
+		return nil
+	}
+	if !isDuplicateKeyError(err) {
+		return err
+	}
+	// it is already queued, and keeps its place, unless its priority changed
+	_, err = session.
+		Update(pendingWorkflowsTableName).
+		Set("priority", priority).
+		Where(q.cond(bucketKey)).
+		And(db.Cond{"workflowkey": key}).
+		Exec()
+	return err
+}
+
+func (q *pendingWorkflowQueue) Pop(bucketKey sync.BucketKey) (sync.Key, error) {
+	session, err := q.getSession()
+	if err != nil {
+		return "", err
+	}
+	var records []pendingWorkflowRecord
+	err = session.
+		Select("workflowkey").
+		From(pendingWorkflowsTableName).
+		Where(q.cond(bucketKey)).
+		OrderBy("-priority", "createdat", "queuedat").
+		Limit(1).
+		All(&records)
+	if err != nil || len(records) == 0 {
+		return "", err
+	}
+	key := records[0].Key
+	return key, q.Remove(bucketKey, key)
+}
+
+func (q *pendingWorkflowQueue) Remove(bucketKey sync.BucketKey, key sync.Key) error {
+	session, err := q.getSession()
+	if err != nil {
+		return err
+	}
+	_, err = session.
+		DeleteFrom(pendingWorkflowsTableName).
+		Where(q.cond(bucketKey)).
+		And(db.Cond{"workflowkey": key}).
+		Exec()
+	return err
+}
+
+func (q *pendingWorkflowQueue) Position(bucketKey sync.BucketKey, key sync.Key) (int, int, error) {
+	session, err := q.getSession()
+	if err != nil {
+		return 0, 0, err
+	}
+	length, err := session.Collection(pendingWorkflowsTableName).Find(q.cond(bucketKey)).Count()
+	if err != nil {
+		return 0, 0, err
+	}
+	var records []pendingWorkflowRecord
+	err = session.
+		SelectFrom(pendingWorkflowsTableName).
+		Where(q.cond(bucketKey)).
+		And(db.Cond{"workflowkey": key}).
+		All(&records)
+	if err != nil || len(records) == 0 {
+		return 0, int(length), err
+	}
+	r := records[0]
+	ahead, err := session.Collection(pendingWorkflowsTableName).Find(q.cond(bucketKey)).And(db.Or(
+		db.Cond{"priority >": r.Priority},
+		db.And(db.Cond{"priority": r.Priority}, db.Cond{"createdat <": r.CreatedAt}),
+		db.And(db.Cond{"priority": r.Priority}, db.Cond{"createdat": r.CreatedAt}, db.Cond{"queuedat <": r.QueuedAt}),
+	)).Count()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(ahead) + 1, int(length), nil
+}
+
+var _ sync.PendingQueue = &pendingWorkflowQueue{}
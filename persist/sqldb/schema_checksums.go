@@ -0,0 +1,88 @@
+package sqldb
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// schemaChecksumsTableName is the table of the checksums of the changes applied to the schema, so that a database
+// migrated by a different version, with different changes, is detected, rather than the controller failing later
+const schemaChecksumsTableName = "schema_checksums"
+
+type schemaChecksumRecord struct {
+	SchemaVersion int    `db:"schema_version"`
+	Checksum      string `db:"checksum"`
+}
+
+// checksum returns the checksum of the change, i.e. of its SQL, or description
+func checksum(c change) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(c))))
+}
+
+func (m migrate) Validate(context.Context) error {
+	return m.validate(m.changes(dbTypeFor(m.session)))
+}
+
+func (m migrate) validate(changes []change) error {
+	version, err := m.schemaVersion()
+	if err != nil {
+		return err
+	}
+	if version >= len(changes) {
+		// the changes of later versions are additive, so this version can still use the database
+		log.WithFields(log.Fields{"schemaVersion": version, "latestSchemaVersion": len(changes) - 1}).
+			Warn("The database schema was migrated by a later version, its changes cannot be validated")
+	}
+	records, err := m.checksums()
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if r.SchemaVersion > version || r.SchemaVersion >= len(changes) {
+			continue
+		}
+		if expected := checksum(changes[r.SchemaVersion]); r.Checksum != expected {
+			return fmt.Errorf("database schema change %d has checksum %s, but this version's change has checksum %s, the database was migrated by a different version: %v", r.SchemaVersion, r.Checksum, expected, changes[r.SchemaVersion])
+		}
+	}
+	return nil
+}
+
+func (m migrate) checksums() ([]schemaChecksumRecord, error) {
+	exists, err := m.tableExists(schemaChecksumsTableName)
+	if err != nil || !exists {
+		return nil, err
+	}
+	var records []schemaChecksumRecord
+	err = m.session.SelectFrom(schemaChecksumsTableName).OrderBy("schema_version").All(&records)
+	return records, err
+}
+
+// recordChecksums records the checksums of the changes that were applied before checksums were
+func (m migrate) recordChecksums(changes []change) error {
+	version, err := m.schemaVersion()
+	if err != nil {
+		return err
+	}
+	records, err := m.checksums()
+	if err != nil {
+		return err
+	}
+	recorded := make(map[int]bool, len(records))
+	for _, r := range records {
+		recorded[r.SchemaVersion] = true
+	}
+	for v := 0; v <= version && v < len(changes); v++ {
+		if recorded[v] {
+			continue
+		}
+		_, err := m.session.Exec("insert into "+schemaChecksumsTableName+" (schema_version, checksum) values (?, ?)", v, checksum(changes[v]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
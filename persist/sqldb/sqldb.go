@@ -10,6 +10,7 @@ import (
 	"upper.io/db.v3/lib/sqlbuilder"
 	"upper.io/db.v3/mysql"
 	"upper.io/db.v3/postgresql"
+	"upper.io/db.v3/sqlite"
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -28,10 +29,39 @@ func CreateDBSession(kubectlConfig kubernetes.Interface, namespace string, persi
 		return CreatePostGresDBSession(kubectlConfig, namespace, persistConfig.PostgreSQL, persistConfig.ConnectionPool)
 	} else if persistConfig.MySQL != nil {
 		return CreateMySQLDBSession(kubectlConfig, namespace, persistConfig.MySQL, persistConfig.ConnectionPool)
+	} else if persistConfig.SQLite != nil {
+		return CreateSQLiteDBSession(persistConfig.SQLite, persistConfig.ConnectionPool)
 	}
 	return nil, "", fmt.Errorf("no databases are configured")
 }
 
+// CreateReadDBSession creates a session of the read replica of the database, or returns nil if it has none
+func CreateReadDBSession(kubectlConfig kubernetes.Interface, namespace string, persistConfig *config.PersistConfig) (sqlbuilder.Database, error) {
+	if persistConfig == nil {
+		return nil, errors.InternalError("Persistence config is not found")
+	}
+	var session sqlbuilder.Database
+	var err error
+	if c := persistConfig.PostgreSQL; c != nil && c.ReadReplica != nil {
+		if c.ReadReplica.Host == "" {
+			return nil, errors.InternalError("readReplica host is empty")
+		}
+		replica := *c
+		replica.DatabaseConfig = c.GetReadReplica()
+		log.WithField("host", replica.GetHostname()).Info("Creating read replica DB session")
+		session, _, err = CreatePostGresDBSession(kubectlConfig, namespace, &replica, persistConfig.ConnectionPool)
+	} else if c := persistConfig.MySQL; c != nil && c.ReadReplica != nil {
+		if c.ReadReplica.Host == "" {
+			return nil, errors.InternalError("readReplica host is empty")
+		}
+		replica := *c
+		replica.DatabaseConfig = c.GetReadReplica()
+		log.WithField("host", replica.GetHostname()).Info("Creating read replica DB session")
+		session, _, err = CreateMySQLDBSession(kubectlConfig, namespace, &replica, persistConfig.ConnectionPool)
+	}
+	return session, err
+}
+
 // CreatePostGresDBSession creates postgresDB session
 func CreatePostGresDBSession(kubectlConfig kubernetes.Interface, namespace string, cfg *config.PostgreSQLConfig, persistPool *config.ConnectionPool) (sqlbuilder.Database, string, error) {
 	if cfg.TableName == "" {
@@ -119,3 +149,34 @@ func CreateMySQLDBSession(kubectlConfig kubernetes.Interface, namespace string,
 	}
 	return session, cfg.TableName, nil
 }
+
+// CreateSQLiteDBSession creates SQLite DB session. The database is in WAL mode, so it can be read while it is written,
+// and waits for the lock rather than failing when another connection, or process, is writing. SQLite needs cgo, so the
+// binary must be built with CGO_ENABLED=1.
+func CreateSQLiteDBSession(cfg *config.SQLiteConfig, persistPool *config.ConnectionPool) (sqlbuilder.Database, string, error) {
+	if cfg.TableName == "" {
+		return nil, "", errors.InternalError("tableName is empty")
+	}
+	if cfg.Path == "" {
+		return nil, "", errors.InternalError("path is empty")
+	}
+
+	session, err := sqlite.Open(sqlite.ConnectionURL{
+		Database: cfg.Path,
+		Options: map[string]string{
+			"_journal_mode": "WAL",
+			"_busy_timeout": "5000",
+			"_foreign_keys": "1",
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if persistPool != nil {
+		session.SetMaxOpenConns(persistPool.MaxOpenConns)
+		session.SetMaxIdleConns(persistPool.MaxIdleConns)
+		session.SetConnMaxLifetime(time.Duration(persistPool.ConnMaxLifetime))
+	}
+	return session, cfg.TableName, nil
+}
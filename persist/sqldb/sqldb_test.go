@@ -0,0 +1,18 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestCreateReadDBSession(t *testing.T) {
+	session, err := CreateReadDBSession(nil, "argo", &config.PersistConfig{PostgreSQL: &config.PostgreSQLConfig{}})
+	require.NoError(t, err)
+	assert.Nil(t, session)
+	_, err = CreateReadDBSession(nil, "argo", &config.PersistConfig{PostgreSQL: &config.PostgreSQLConfig{DatabaseConfig: config.DatabaseConfig{ReadReplica: &config.ReadReplicaConfig{}}}})
+	assert.EqualError(t, err, "readReplica host is empty")
+}
@@ -0,0 +1,299 @@
+package sqldb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+func newSQLiteSession(t *testing.T) sqlbuilder.Database {
+	session, tableName, err := CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	assert.Equal(t, SQLite, dbTypeFor(session))
+	require.NoError(t, NewMigrate(session, "default", tableName).Exec(context.Background()))
+	// migrating again does nothing
+	require.NoError(t, NewMigrate(session, "default", tableName).Exec(context.Background()))
+	return session
+}
+
+func TestCreateSQLiteDBSession(t *testing.T) {
+	_, _, err := CreateSQLiteDBSession(&config.SQLiteConfig{Path: "argo.db"}, nil)
+	assert.EqualError(t, err, "tableName is empty")
+	_, _, err = CreateSQLiteDBSession(&config.SQLiteConfig{TableName: "argo_workflows"}, nil)
+	assert.EqualError(t, err, "path is empty")
+}
+
+func TestSQLiteWorkflowArchive(t *testing.T) {
+	session := newSQLiteSession(t)
+	archive := NewWorkflowArchive(session, session, "default", "", instanceid.NewService(""))
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, name := range []string{"my-wf", "other-wf"} {
+		err := archive.ArchiveWorkflow(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", UID: types.UID("uid-" + name), Labels: map[string]string{"team": name}},
+			Status: wfv1.WorkflowStatus{
+				Phase:      wfv1.WorkflowSucceeded,
+				StartedAt:  metav1.NewTime(now.Add(time.Duration(i) * time.Minute)),
+				FinishedAt: metav1.NewTime(now.Add(-time.Duration(i) * 48 * time.Hour)),
+				Message:    "100% done",
+			},
+		})
+		require.NoError(t, err)
+	}
+	// archiving again replaces the workflow
+	require.NoError(t, archive.ArchiveWorkflow(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "uid-my-wf", Labels: map[string]string{"team": "my-wf"}},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, StartedAt: metav1.NewTime(now), FinishedAt: metav1.NewTime(now), Message: "100% done"},
+	}))
+
+	t.Run("List", func(t *testing.T) {
+		wfs, err := archive.ListWorkflows("my-ns", "", "", "", time.Time{}, time.Time{}, nil, 0, nil)
+		require.NoError(t, err)
+		if assert.Len(t, wfs, 2) {
+			assert.Equal(t, "other-wf", wfs[0].Name)
+			assert.Equal(t, now.Add(time.Minute), wfs[0].Status.StartedAt.UTC())
+		}
+		wfs, err = archive.ListWorkflows("my-ns", "", "", "", time.Time{}, time.Time{}, nil, 1, &ListCursor{StartedAt: now.Add(time.Minute), UID: "uid-other-wf"})
+		require.NoError(t, err)
+		if assert.Len(t, wfs, 1) {
+			assert.Equal(t, "my-wf", wfs[0].Name)
+		}
+	})
+	t.Run("Search", func(t *testing.T) {
+		wfs, err := archive.ListWorkflows("", "", "", "other 100%", time.Time{}, time.Time{}, nil, 0, nil)
+		require.NoError(t, err)
+		if assert.Len(t, wfs, 1) {
+			assert.Equal(t, "other-wf", wfs[0].Name)
+		}
+		wfs, err = archive.ListWorkflows("", "", "", "100_", time.Time{}, time.Time{}, nil, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, wfs)
+	})
+	t.Run("Labels", func(t *testing.T) {
+		requirements, err := labels.ParseToRequirements("team=my-wf")
+		require.NoError(t, err)
+		wfs, err := archive.ListWorkflows("", "", "", "", time.Time{}, time.Time{}, requirements, 0, nil)
+		require.NoError(t, err)
+		if assert.Len(t, wfs, 1) {
+			assert.Equal(t, "my-wf", wfs[0].Name)
+		}
+		values, err := archive.ListWorkflowsLabelValues("team")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"my-wf", "other-wf"}, values.Items)
+	})
+	t.Run("Get", func(t *testing.T) {
+		wf, err := archive.GetWorkflow("uid-my-wf")
+		require.NoError(t, err)
+		if assert.NotNil(t, wf) {
+			assert.Equal(t, "my-wf", wf.Name)
+		}
+	})
+	t.Run("DeleteExpired", func(t *testing.T) {
+		require.NoError(t, archive.DeleteExpiredWorkflows(24*time.Hour))
+		wf, err := archive.GetWorkflow("uid-other-wf")
+		require.NoError(t, err)
+		assert.Nil(t, wf)
+		keys, err := archive.ListWorkflowsLabelValues("team")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf"}, keys.Items)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, archive.DeleteWorkflow("other-ns", "uid-my-wf"))
+		wf, err := archive.GetWorkflow("uid-my-wf")
+		require.NoError(t, err)
+		assert.NotNil(t, wf)
+		require.NoError(t, archive.DeleteWorkflow("my-ns", "uid-my-wf"))
+		wfs, err := archive.ListWorkflows("", "", "", "", time.Time{}, time.Time{}, nil, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, wfs)
+	})
+}
+
+func TestSQLiteOffloadNodeStatusRepo(t *testing.T) {
+	session := newSQLiteSession(t)
+	repo, err := NewOffloadNodeStatusRepo(session, "default", "argo_workflows")
+	require.NoError(t, err)
+	nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node"}}
+	version, err := repo.Save("my-uid", "my-ns", nodes)
+	require.NoError(t, err)
+	// saving the same nodes again is a duplicate, which is ignored
+	again, err := repo.Save("my-uid", "my-ns", nodes)
+	require.NoError(t, err)
+	assert.Equal(t, version, again)
+	got, err := repo.Get("my-uid", version)
+	require.NoError(t, err)
+	assert.Equal(t, nodes, got)
+	list, err := repo.List("my-ns")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+	old, err := repo.ListOldOffloads("my-ns")
+	require.NoError(t, err)
+	assert.Empty(t, old)
+	require.NoError(t, repo.Delete("my-uid", version))
+	_, err = repo.Get("my-uid", version)
+	assert.Error(t, err)
+}
+
+func TestSQLiteArchivePartitions(t *testing.T) {
+	session := newSQLiteSession(t)
+	err := NewArchivePartitions(session, "", "", "", config.ArchivePartitioning{}).Partition(time.Now())
+	assert.EqualError(t, err, "SQLite does not support partitioning")
+}
+
+func TestWorkflowArchiveReadSession(t *testing.T) {
+	session := newSQLiteSession(t)
+	// a replica that the workflow is not replicated to yet
+	readSession := newSQLiteSession(t)
+	archive := NewWorkflowArchive(session, readSession, "default", "", instanceid.NewService(""))
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, archive.ArchiveWorkflow(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid", Labels: map[string]string{"team": "my-team"}},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, StartedAt: metav1.NewTime(now), FinishedAt: metav1.NewTime(now)},
+	}))
+	wfs, err := archive.ListWorkflows("", "", "", "", time.Time{}, time.Time{}, nil, 0, nil)
+	require.NoError(t, err)
+	assert.Empty(t, wfs)
+	keys, err := archive.ListWorkflowsLabelKeys()
+	require.NoError(t, err)
+	assert.Empty(t, keys.Items)
+	values, err := archive.ListWorkflowsLabelValues("team")
+	require.NoError(t, err)
+	assert.Empty(t, values.Items)
+	wf, err := archive.GetWorkflow("my-uid")
+	require.NoError(t, err)
+	assert.Equal(t, "my-wf", wf.Name)
+}
+
+func TestSQLiteWorkflowArchiveArtifacts(t *testing.T) {
+	session := newSQLiteSession(t)
+	archive := NewWorkflowArchive(session, session, "default", "", instanceid.NewService(""))
+	wf := artifactsWorkflow()
+	require.NoError(t, archive.ArchiveWorkflow(wf))
+	// archiving again replaces the index
+	require.NoError(t, archive.ArchiveWorkflow(wf))
+
+	artifacts, err := archive.ListWorkflowArtifacts("my-uid", "")
+	require.NoError(t, err)
+	if assert.NotNil(t, artifacts) {
+		assert.Equal(t, "my-ns", artifacts.Namespace)
+		assert.Equal(t, "my-wf", artifacts.Name)
+		if assert.Len(t, artifacts.Items, 3) {
+			assert.Equal(t, "my-input.tgz", artifacts.Items[0].Key)
+			assert.True(t, artifacts.Items[0].Artifact.HasLocation())
+			assert.True(t, artifacts.Items[1].Logs)
+		}
+	}
+	artifacts, err = archive.ListWorkflowArtifacts("my-uid", "no-artifacts-node")
+	require.NoError(t, err)
+	assert.Empty(t, artifacts.Items)
+	artifacts, err = archive.ListWorkflowArtifacts("other-uid", "")
+	require.NoError(t, err)
+	assert.Nil(t, artifacts)
+
+	t.Run("NotIndexed", func(t *testing.T) {
+		_, err := session.DeleteFrom(archiveArtifactsTableName).Exec()
+		require.NoError(t, err)
+		artifacts, err := archive.ListWorkflowArtifacts("my-uid", "my-node")
+		require.NoError(t, err)
+		assert.Len(t, artifacts.Items, 3)
+	})
+
+	require.NoError(t, archive.ArchiveWorkflow(wf))
+	require.NoError(t, archive.DeleteWorkflow("my-ns", "my-uid"))
+	count, err := session.Collection(archiveArtifactsTableName).Find().Count()
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestSQLiteWorkflowSnapshotRepo(t *testing.T) {
+	session := newSQLiteSession(t)
+	repo := NewWorkflowSnapshotRepo(session, "default", 24*time.Hour)
+	assert.True(t, repo.IsEnabled())
+	now := time.Now().UTC().Truncate(time.Second)
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"}}
+	for i, phase := range []wfv1.WorkflowPhase{wfv1.WorkflowPending, wfv1.WorkflowRunning} {
+		wf.Status = wfv1.WorkflowStatus{Phase: phase, Nodes: wfv1.Nodes{"my-node": {ID: "my-node", Phase: wfv1.NodeRunning}}}
+		require.NoError(t, repo.Save(wf, now.Add(time.Duration(i)*time.Minute)))
+	}
+	// a later snapshot in the same second replaces the earlier one
+	wf.Status = wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded}
+	require.NoError(t, repo.Save(wf, now.Add(time.Minute)))
+
+	snapshot, err := repo.Get("my-ns", "my-wf", now.Add(30*time.Second))
+	require.NoError(t, err)
+	if assert.NotNil(t, snapshot) {
+		assert.Equal(t, now, snapshot.Time.UTC())
+		assert.Equal(t, wfv1.WorkflowPending, snapshot.Status.Phase)
+		assert.Contains(t, snapshot.Status.Nodes, "my-node")
+	}
+	snapshot, err = repo.Get("my-ns", "my-wf", now.Add(time.Hour))
+	require.NoError(t, err)
+	if assert.NotNil(t, snapshot) {
+		assert.Equal(t, wfv1.WorkflowSucceeded, snapshot.Status.Phase)
+	}
+	snapshot, err = repo.Get("my-ns", "my-wf", now.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, repo.DeleteExpired(now.Add(24*time.Hour)))
+		snapshot, err := repo.Get("my-ns", "my-wf", now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.NotNil(t, snapshot, "the latest snapshot is not expired yet")
+		require.NoError(t, repo.DeleteExpired(now.Add(25*time.Hour)))
+		snapshot, err = repo.Get("my-ns", "my-wf", now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Nil(t, snapshot)
+	})
+}
+
+func TestSQLitePendingWorkflowQueue(t *testing.T) {
+	session := newSQLiteSession(t)
+	q := NewPendingWorkflowQueue(func() sqlbuilder.Database { return session }, "default", "parallelism")
+	now := time.Now()
+	require.NoError(t, q.Add("", "my-ns/c", 0, now))
+	require.NoError(t, q.Add("", "my-ns/b", 0, now))
+	require.NoError(t, q.Add("", "my-ns/a", 1, now.Add(time.Hour)))
+	require.NoError(t, q.Add("other-bucket", "my-ns/d", 0, now))
+	// adding again keeps the place of the workflow
+	require.NoError(t, q.Add("", "my-ns/c", 0, now))
+
+	position, length, err := q.Position("", "my-ns/b")
+	require.NoError(t, err)
+	assert.Equal(t, 3, position, "after the higher priority, and earlier queued, workflows")
+	assert.Equal(t, 3, length)
+	position, length, err = q.Position("", "my-ns/d")
+	require.NoError(t, err)
+	assert.Zero(t, position, "in another bucket")
+	assert.Equal(t, 3, length)
+
+	t.Run("Restart", func(t *testing.T) {
+		q := NewPendingWorkflowQueue(func() sqlbuilder.Database { return session }, "default", "parallelism")
+		for _, expected := range []string{"my-ns/a", "my-ns/c", "my-ns/b", ""} {
+			key, err := q.Pop("")
+			require.NoError(t, err)
+			assert.Equal(t, expected, key)
+		}
+		require.NoError(t, q.Remove("other-bucket", "my-ns/d"))
+		key, err := q.Pop("other-bucket")
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+	t.Run("NoSession", func(t *testing.T) {
+		q := NewPendingWorkflowQueue(func() sqlbuilder.Database { return nil }, "default", "parallelism")
+		_, err := q.Pop("")
+		assert.EqualError(t, err, "the pending workflow queue parallelism requires a database session, but persistence is not configured")
+	})
+}
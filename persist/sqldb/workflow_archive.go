@@ -3,7 +3,6 @@ package sqldb
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -35,7 +34,8 @@ type archivedWorkflowMetadata struct {
 
 type archivedWorkflowRecord struct {
 	archivedWorkflowMetadata
-	Workflow string `db:"workflow"`
+	Workflow   string `db:"workflow"`
+	SearchText string `db:"searchtext"`
 }
 
 type archivedWorkflowLabelRecord struct {
@@ -46,35 +46,57 @@ type archivedWorkflowLabelRecord struct {
 	Value string `db:"value"`
 }
 
+// ListCursor is the position in the list of archived workflows after which the next page starts. The list is
+// ordered by started time and then UID, so the position is stable even while workflows are archived.
+type ListCursor struct {
+	StartedAt time.Time
+	UID       string
+}
+
 //go:generate mockery --name=WorkflowArchive
 
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
-	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
-	ListWorkflows(namespace string, name string, namePrefix string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
+	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent),
+	// starting after the cursor if it is not nil, and only including workflows matching the search if it is not empty
+	ListWorkflows(namespace string, name string, namePrefix string, search string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, cursor *ListCursor) (wfv1.Workflows, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
-	DeleteWorkflow(uid string) error
+	// delete the workflow of the UID, if it is of the namespace
+	DeleteWorkflow(namespace, uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
 	IsEnabled() bool
 	ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error)
 	ListWorkflowsLabelValues(key string) (*wfv1.LabelValues, error)
+	// list the artifacts of the workflow, or, if the node ID is not empty, of the node, returning nil if the workflow
+	// is not archived
+	ListWorkflowArtifacts(uid, nodeID string) (*ArchivedArtifacts, error)
 }
 
 type workflowArchive struct {
-	session           sqlbuilder.Database
+	session sqlbuilder.Database
+	// readSession is the session that workflows are listed, and searched, from, which may be of a read replica
+	readSession       sqlbuilder.Database
 	clusterName       string
 	managedNamespace  string
 	instanceIDService instanceid.Service
 	dbType            dbType
+	// partitioned is true if the archive table is partitioned, and, for MySQL, therefore has no full-text index
+	partitioned bool
 }
 
 func (r *workflowArchive) IsEnabled() bool {
 	return true
 }
 
-// NewWorkflowArchive returns a new workflowArchive
-func NewWorkflowArchive(session sqlbuilder.Database, clusterName, managedNamespace string, instanceIDService instanceid.Service) WorkflowArchive {
-	return &workflowArchive{session: session, clusterName: clusterName, managedNamespace: managedNamespace, instanceIDService: instanceIDService, dbType: dbTypeFor(session)}
+// NewWorkflowArchive returns a new workflowArchive, that lists, and searches, workflows from the read session, which is
+// either the session, or one of a read replica of its database. Workflows are got by UID from the session, as they may
+// have only just been archived.
+func NewWorkflowArchive(session, readSession sqlbuilder.Database, clusterName, managedNamespace string, instanceIDService instanceid.Service) WorkflowArchive {
+	partitioned, err := isPartitioned(session)
+	if err != nil {
+		log.WithError(err).Warn("Failed to determine if the archived workflows table is partitioned, assuming it is not")
+	}
+	return &workflowArchive{session: session, readSession: readSession, clusterName: clusterName, managedNamespace: managedNamespace, instanceIDService: instanceIDService, dbType: dbTypeFor(session), partitioned: partitioned}
 }
 
 func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
@@ -89,6 +111,7 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 			DeleteFrom(archiveTableName).
 			Where(r.clusterManagedNamespaceAndInstanceID()).
 			And(db.Cond{"uid": wf.UID}).
+			And(db.Cond{"namespace": wf.Namespace}).
 			Exec()
 		if err != nil {
 			return err
@@ -105,7 +128,8 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 					StartedAt:   wf.Status.StartedAt.Time,
 					FinishedAt:  wf.Status.FinishedAt.Time,
 				},
-				Workflow: string(workflow),
+				Workflow:   string(workflow),
+				SearchText: searchText(wf),
 			})
 		if err != nil {
 			return err
@@ -132,11 +156,11 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 				return err
 			}
 		}
-		return nil
+		return r.insertArtifacts(sess, wf)
 	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, search string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, cursor *ListCursor) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
 	clause, err := labelsClause(r.dbType, labelRequirements)
 	if err != nil {
@@ -147,21 +171,21 @@ func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefi
 	// to match the behavior of the `List` operations in the Kubernetes API
 	if limit == 0 {
 		limit = -1
-		offset = -1
 	}
 
-	err = r.session.
+	err = r.readSession.
 		Select("name", "namespace", "uid", "phase", "startedat", "finishedat").
 		From(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
 		And(namespaceEqual(namespace)).
 		And(nameEqual(name)).
 		And(namePrefixClause(namePrefix)).
+		And(r.searchClause(search)).
 		And(startedAtClause(minStartedAt, maxStartedAt)).
+		And(cursorClause(cursor)).
 		And(clause).
-		OrderBy("-startedat").
+		OrderBy("-startedat", "-uid").
 		Limit(limit).
-		Offset(offset).
 		All(&archivedWfs)
 	if err != nil {
 		return nil, err
@@ -204,6 +228,17 @@ func startedAtClause(from, to time.Time) db.Compound {
 	return db.And(conds...)
 }
 
+// cursorClause matches the workflows after the cursor, i.e. started earlier, or at the same time with a lower UID
+func cursorClause(cursor *ListCursor) db.Compound {
+	if cursor == nil {
+		return db.And()
+	}
+	return db.Or(
+		db.Cond{"startedat < ": cursor.StartedAt},
+		db.And(db.Cond{"startedat": cursor.StartedAt}, db.Cond{"uid < ": cursor.UID}),
+	)
+}
+
 func namespaceEqual(namespace string) db.Cond {
 	if namespace == "" {
 		return db.Cond{}
@@ -250,36 +285,70 @@ func (r *workflowArchive) GetWorkflow(uid string) (*wfv1.Workflow, error) {
 	return wf, nil
 }
 
-func (r *workflowArchive) DeleteWorkflow(uid string) error {
-	rs, err := r.session.
-		DeleteFrom(archiveTableName).
-		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(db.Cond{"uid": uid}).
-		Exec()
-	if err != nil {
-		return err
-	}
-	rowsAffected, err := rs.RowsAffected()
-	if err != nil {
-		return err
-	}
-	log.WithFields(log.Fields{"uid": uid, "rowsAffected": rowsAffected}).Debug("Deleted archived workflow")
-	return nil
+func (r *workflowArchive) DeleteWorkflow(namespace, uid string) error {
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		rs, err := sess.
+			DeleteFrom(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(db.Cond{"uid": uid}).
+			And(db.Cond{"namespace": namespace}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		// the labels are not deleted by a cascade if the archive table is partitioned, and the artifacts never are
+		if rowsAffected > 0 {
+			for _, table := range []string{archiveLabelsTableName, archiveArtifactsTableName} {
+				_, err = sess.
+					DeleteFrom(table).
+					Where(db.Cond{"clustername": r.clusterName}).
+					And(db.Cond{"uid": uid}).
+					Exec()
+				if err != nil {
+					return err
+				}
+			}
+		}
+		log.WithFields(log.Fields{"namespace": namespace, "uid": uid, "rowsAffected": rowsAffected}).Debug("Deleted archived workflow")
+		return nil
+	})
 }
 
 func (r *workflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
-	rs, err := r.session.
-		DeleteFrom(archiveTableName).
-		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(fmt.Sprintf("finishedat < current_timestamp - interval '%d' second", int(ttl.Seconds()))).
-		Exec()
-	if err != nil {
-		return err
-	}
-	rowsAffected, err := rs.RowsAffected()
-	if err != nil {
-		return err
-	}
-	log.WithFields(log.Fields{"rowsAffected": rowsAffected}).Info("Deleted archived workflows")
-	return nil
+	expired := r.dbType.olderThan("finishedat", ttl)
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		// the labels are not deleted by a cascade if the archive table is partitioned, and the artifacts never are
+		for _, table := range []string{archiveLabelsTableName, archiveArtifactsTableName} {
+			_, err := sess.
+				DeleteFrom(table).
+				Where(db.Cond{"clustername": r.clusterName}).
+				And(db.Cond{"uid IN": sess.
+					Select("uid").
+					From(archiveTableName).
+					Where(r.clusterManagedNamespaceAndInstanceID()).
+					And(expired)}).
+				Exec()
+			if err != nil {
+				return err
+			}
+		}
+		rs, err := sess.
+			DeleteFrom(archiveTableName).
+			Where(r.clusterManagedNamespaceAndInstanceID()).
+			And(expired).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{"rowsAffected": rowsAffected}).Info("Deleted archived workflows")
+		return nil
+	})
 }
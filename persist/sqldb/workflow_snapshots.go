@@ -0,0 +1,162 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/file"
+)
+
+const workflowSnapshotsTableName = "argo_workflow_snapshots"
+
+// WorkflowSnapshot is the status of a workflow at a time
+type WorkflowSnapshot struct {
+	Time   time.Time
+	Status wfv1.WorkflowStatus
+}
+
+type WorkflowSnapshotRepo interface {
+	IsEnabled() bool
+	// Save snapshots the status of the workflow, which must be hydrated
+	Save(wf *wfv1.Workflow, now time.Time) error
+	// DeleteExpired deletes the snapshots that are older than the TTL
+	DeleteExpired(now time.Time) error
+	// Get returns the latest snapshot of the workflow taken at, or before, the time, or nil if there is none
+	Get(namespace, name string, asOf time.Time) (*WorkflowSnapshot, error)
+}
+
+type workflowSnapshotRecord struct {
+	ClusterName string    `db:"clustername"`
+	UID         string    `db:"uid"`
+	Namespace   string    `db:"namespace"`
+	Name        string    `db:"name"`
+	CreatedAt   time.Time `db:"createdat"`
+	// Status is the workflow status, as JSON, gzipped and base64 encoded
+	Status string `db:"status"`
+}
+
+func NewWorkflowSnapshotRepo(session sqlbuilder.Database, clusterName string, ttl time.Duration) WorkflowSnapshotRepo {
+	return &workflowSnapshotRepo{session: session, clusterName: clusterName, ttl: ttl}
+}
+
+type workflowSnapshotRepo struct {
+	session     sqlbuilder.Database
+	clusterName string
+	// how long snapshots are kept for
+	ttl time.Duration
+}
+
+func (r *workflowSnapshotRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *workflowSnapshotRepo) Save(wf *wfv1.Workflow, now time.Time) error {
+	data, err := json.Marshal(wf.Status)
+	if err != nil {
+		return err
+	}
+	// every database stores timestamps to at least the second
+	now = now.UTC().Truncate(time.Second)
+	record := &workflowSnapshotRecord{
+		ClusterName: r.clusterName,
+		UID:         string(wf.UID),
+		Namespace:   wf.Namespace,
+		Name:        wf.Name,
+		CreatedAt:   now,
+		Status:      file.CompressEncodeString(string(data)),
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "name": wf.Name, "createdAt": now})
+	logCtx.Debug("Saving workflow snapshot")
+	// the insert is in its own transaction, as SQLite otherwise leaves the transaction it is in open if it fails
+	err = r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.Collection(workflowSnapshotsTableName).Insert(record)
+		return err
+	})
+	if err != nil {
+		if !isDuplicateKeyError(err) {
+			return err
+		}
+		// a snapshot was already taken this second, this one is the later status
+		_, err = r.session.
+			Update(workflowSnapshotsTableName).
+			Set("status", record.Status).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": record.UID}).
+			And(db.Cond{"createdat": now}).
+			Exec()
+		return err
+	}
+	return nil
+}
+
+func (r *workflowSnapshotRepo) DeleteExpired(now time.Time) error {
+	rs, err := r.session.
+		DeleteFrom(workflowSnapshotsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"createdat <": now.UTC().Add(-r.ttl)}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithField("rowsAffected", rowsAffected).Info("Deleted expired workflow snapshots")
+	return nil
+}
+
+func (r *workflowSnapshotRepo) Get(namespace, name string, asOf time.Time) (*WorkflowSnapshot, error) {
+	var records []workflowSnapshotRecord
+	err := r.session.
+		Select("createdat", "status").
+		From(workflowSnapshotsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"name": name}).
+		And(db.Cond{"createdat <=": asOf.UTC()}).
+		OrderBy("-createdat").
+		Limit(1).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	data, err := file.DecodeDecompressString(records[0].Status)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &WorkflowSnapshot{Time: records[0].CreatedAt}
+	if err := json.Unmarshal([]byte(data), &snapshot.Status); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+var NullWorkflowSnapshotRepo WorkflowSnapshotRepo = &nullWorkflowSnapshotRepo{}
+
+type nullWorkflowSnapshotRepo struct{}
+
+func (r *nullWorkflowSnapshotRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullWorkflowSnapshotRepo) Save(*wfv1.Workflow, time.Time) error {
+	return nil
+}
+
+func (r *nullWorkflowSnapshotRepo) DeleteExpired(time.Time) error {
+	return nil
+}
+
+func (r *nullWorkflowSnapshotRepo) Get(string, string, time.Time) (*WorkflowSnapshot, error) {
+	return nil, nil
+}
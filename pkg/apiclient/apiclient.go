@@ -10,6 +10,7 @@ import (
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
@@ -23,6 +24,7 @@ type Client interface {
 	NewWorkflowTemplateServiceClient() (workflowtemplatepkg.WorkflowTemplateServiceClient, error)
 	NewClusterWorkflowTemplateServiceClient() (clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient, error)
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
+	NewPluginServiceClient() (pluginpkg.PluginServiceClient, error)
 }
 
 type Opts struct {
@@ -33,7 +35,10 @@ type Opts struct {
 	ClientConfig         clientcmd.ClientConfig
 	ClientConfigSupplier func() clientcmd.ClientConfig
 	Offline              bool
-	Context              context.Context
+	// OfflineFiles are the files and directories the offline client resolves references to workflow templates and
+	// cluster workflow templates from
+	OfflineFiles []string
+	Context      context.Context
 }
 
 func (o Opts) String() string {
@@ -63,7 +68,7 @@ func NewClient(argoServer string, authSupplier func() string, clientConfig clien
 func NewClientFromOpts(opts Opts) (context.Context, Client, error) {
 	log.WithField("opts", opts).Debug("Client options")
 	if opts.Offline {
-		return newOfflineClient()
+		return newOfflineClient(opts.OfflineFiles)
 	}
 	if opts.ArgoServerOpts.URL != "" && opts.InstanceID != "" {
 		return nil, nil, fmt.Errorf("cannot use instance ID with Argo Server")
@@ -0,0 +1,1842 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/apitoken/apitoken.proto
+
+package apitoken
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// APIToken is a named token, issued by the Argo Server, that has the RBAC rules of a service account.
+// Only the hash of the token is stored.
+type APIToken struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the service account, in the Argo Server's namespace, whose RBAC rules apply to requests made with the token
+	ServiceAccountName string   `protobuf:"bytes,2,opt,name=serviceAccountName,proto3" json:"serviceAccountName,omitempty"`
+	CreationTimestamp  *v1.Time `protobuf:"bytes,3,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	// the token is not valid after this time, if not set the token never expires
+	ExpiresAt            *v1.Time `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIToken) Reset()         { *m = APIToken{} }
+func (m *APIToken) String() string { return proto.CompactTextString(m) }
+func (*APIToken) ProtoMessage()    {}
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{0}
+}
+func (m *APIToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIToken.Merge(m, src)
+}
+func (m *APIToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIToken proto.InternalMessageInfo
+
+func (m *APIToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *APIToken) GetServiceAccountName() string {
+	if m != nil {
+		return m.ServiceAccountName
+	}
+	return ""
+}
+
+func (m *APIToken) GetCreationTimestamp() *v1.Time {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
+func (m *APIToken) GetExpiresAt() *v1.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type APITokenList struct {
+	Items                []*APIToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *APITokenList) Reset()         { *m = APITokenList{} }
+func (m *APITokenList) String() string { return proto.CompactTextString(m) }
+func (*APITokenList) ProtoMessage()    {}
+func (*APITokenList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{1}
+}
+func (m *APITokenList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APITokenList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APITokenList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APITokenList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APITokenList.Merge(m, src)
+}
+func (m *APITokenList) XXX_Size() int {
+	return m.Size()
+}
+func (m *APITokenList) XXX_DiscardUnknown() {
+	xxx_messageInfo_APITokenList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APITokenList proto.InternalMessageInfo
+
+func (m *APITokenList) GetItems() []*APIToken {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CreateAPITokenRequest struct {
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceAccountName string `protobuf:"bytes,2,opt,name=serviceAccountName,proto3" json:"serviceAccountName,omitempty"`
+	// how long the token is valid for, e.g. "720h", if empty the token never expires
+	ExpiresIn            string   `protobuf:"bytes,3,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPITokenRequest) Reset()         { *m = CreateAPITokenRequest{} }
+func (m *CreateAPITokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPITokenRequest) ProtoMessage()    {}
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{2}
+}
+func (m *CreateAPITokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAPITokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAPITokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAPITokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPITokenRequest.Merge(m, src)
+}
+func (m *CreateAPITokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAPITokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPITokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPITokenRequest proto.InternalMessageInfo
+
+func (m *CreateAPITokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAPITokenRequest) GetServiceAccountName() string {
+	if m != nil {
+		return m.ServiceAccountName
+	}
+	return ""
+}
+
+func (m *CreateAPITokenRequest) GetExpiresIn() string {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return ""
+}
+
+type CreateAPITokenResponse struct {
+	ApiToken *APIToken `protobuf:"bytes,1,opt,name=apiToken,proto3" json:"apiToken,omitempty"`
+	// the token, to be sent as `Authorization: Bearer <token>`, this is the only time it is returned
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPITokenResponse) Reset()         { *m = CreateAPITokenResponse{} }
+func (m *CreateAPITokenResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPITokenResponse) ProtoMessage()    {}
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{3}
+}
+func (m *CreateAPITokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAPITokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAPITokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAPITokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPITokenResponse.Merge(m, src)
+}
+func (m *CreateAPITokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAPITokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPITokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPITokenResponse proto.InternalMessageInfo
+
+func (m *CreateAPITokenResponse) GetApiToken() *APIToken {
+	if m != nil {
+		return m.ApiToken
+	}
+	return nil
+}
+
+func (m *CreateAPITokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ListAPITokensRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAPITokensRequest) Reset()         { *m = ListAPITokensRequest{} }
+func (m *ListAPITokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAPITokensRequest) ProtoMessage()    {}
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{4}
+}
+func (m *ListAPITokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAPITokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAPITokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAPITokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPITokensRequest.Merge(m, src)
+}
+func (m *ListAPITokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAPITokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPITokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPITokensRequest proto.InternalMessageInfo
+
+type DeleteAPITokenRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAPITokenRequest) Reset()         { *m = DeleteAPITokenRequest{} }
+func (m *DeleteAPITokenRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAPITokenRequest) ProtoMessage()    {}
+func (*DeleteAPITokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{5}
+}
+func (m *DeleteAPITokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAPITokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAPITokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteAPITokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAPITokenRequest.Merge(m, src)
+}
+func (m *DeleteAPITokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAPITokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAPITokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAPITokenRequest proto.InternalMessageInfo
+
+func (m *DeleteAPITokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteAPITokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAPITokenResponse) Reset()         { *m = DeleteAPITokenResponse{} }
+func (m *DeleteAPITokenResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAPITokenResponse) ProtoMessage()    {}
+func (*DeleteAPITokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f1114b1d3efb89, []int{6}
+}
+func (m *DeleteAPITokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAPITokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAPITokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteAPITokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAPITokenResponse.Merge(m, src)
+}
+func (m *DeleteAPITokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAPITokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAPITokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAPITokenResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*APIToken)(nil), "apitoken.APIToken")
+	proto.RegisterType((*APITokenList)(nil), "apitoken.APITokenList")
+	proto.RegisterType((*CreateAPITokenRequest)(nil), "apitoken.CreateAPITokenRequest")
+	proto.RegisterType((*CreateAPITokenResponse)(nil), "apitoken.CreateAPITokenResponse")
+	proto.RegisterType((*ListAPITokensRequest)(nil), "apitoken.ListAPITokensRequest")
+	proto.RegisterType((*DeleteAPITokenRequest)(nil), "apitoken.DeleteAPITokenRequest")
+	proto.RegisterType((*DeleteAPITokenResponse)(nil), "apitoken.DeleteAPITokenResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/apitoken/apitoken.proto", fileDescriptor_98f1114b1d3efb89)
+}
+
+var fileDescriptor_98f1114b1d3efb89 = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6a, 0x13, 0x41,
+	0x14, 0x66, 0xd3, 0x56, 0x9a, 0xa9, 0x56, 0x1c, 0xd2, 0x18, 0xd7, 0x1a, 0xe3, 0x80, 0x10, 0x22,
+	0x9d, 0x25, 0xd1, 0x8b, 0xea, 0x5d, 0x54, 0xd0, 0x82, 0x88, 0xc4, 0x5e, 0x88, 0x17, 0xc2, 0x74,
+	0x3d, 0x6e, 0xc7, 0xcd, 0xce, 0xac, 0x33, 0x93, 0xad, 0x45, 0xbc, 0xf1, 0x15, 0x7c, 0x29, 0x2f,
+	0x05, 0x5f, 0x40, 0x82, 0x4f, 0xe0, 0x13, 0xc8, 0xcc, 0x66, 0x37, 0x49, 0xb3, 0x82, 0x42, 0xaf,
+	0x72, 0xe6, 0x9c, 0xef, 0xcc, 0xf7, 0x93, 0x64, 0xd0, 0xed, 0x34, 0x8e, 0x02, 0x96, 0xf2, 0x70,
+	0xcc, 0x41, 0x18, 0x5b, 0x19, 0x19, 0x83, 0x28, 0x0b, 0x9a, 0x2a, 0x69, 0x24, 0xde, 0x2c, 0xce,
+	0xfe, 0x6e, 0x24, 0x65, 0x34, 0x06, 0x0b, 0x08, 0x98, 0x10, 0xd2, 0x30, 0xc3, 0xa5, 0xd0, 0x39,
+	0xce, 0xbf, 0x17, 0xef, 0x6b, 0xca, 0xa5, 0x9d, 0x26, 0x2c, 0x3c, 0xe6, 0x02, 0xd4, 0x69, 0x30,
+	0xa3, 0xd0, 0x41, 0x02, 0x86, 0x05, 0x59, 0x3f, 0x88, 0x40, 0x80, 0x62, 0x06, 0xde, 0xe6, 0x5b,
+	0xe4, 0xb7, 0x87, 0x36, 0x87, 0x2f, 0x0e, 0x0e, 0x2d, 0x01, 0xc6, 0x68, 0x5d, 0xb0, 0x04, 0x5a,
+	0x5e, 0xc7, 0xeb, 0xd6, 0x47, 0xae, 0xc6, 0x14, 0x61, 0x0d, 0x2a, 0xe3, 0x21, 0x0c, 0xc3, 0x50,
+	0x4e, 0x84, 0x79, 0x6e, 0x11, 0x35, 0x87, 0xa8, 0x98, 0xe0, 0x57, 0xe8, 0x4a, 0xa8, 0xc0, 0x29,
+	0x3b, 0xe4, 0x09, 0x68, 0xc3, 0x92, 0xb4, 0xb5, 0xd6, 0xf1, 0xba, 0x5b, 0x83, 0x1e, 0xcd, 0x25,
+	0xd2, 0x45, 0x89, 0x34, 0x8d, 0x23, 0xdb, 0xd0, 0xd4, 0x4a, 0xa4, 0x59, 0x9f, 0xda, 0xb5, 0xd1,
+	0xea, 0x25, 0xf8, 0x29, 0xaa, 0xc3, 0xc7, 0x94, 0x2b, 0xd0, 0x43, 0xd3, 0x5a, 0xff, 0xef, 0x1b,
+	0xe7, 0xcb, 0x64, 0x1f, 0x5d, 0x2c, 0x3c, 0x3f, 0xe3, 0xda, 0xe0, 0x2e, 0xda, 0xe0, 0x06, 0x12,
+	0xdd, 0xf2, 0x3a, 0x6b, 0xdd, 0xad, 0x01, 0xa6, 0xe5, 0x57, 0x50, 0xc0, 0x46, 0x39, 0x80, 0x9c,
+	0xa2, 0x9d, 0x47, 0x56, 0x18, 0x94, 0x03, 0xf8, 0x30, 0x01, 0x6d, 0xce, 0x25, 0xba, 0xdd, 0xd2,
+	0xe0, 0x81, 0x70, 0x91, 0xd5, 0x47, 0xf3, 0x06, 0x79, 0x83, 0x9a, 0x67, 0xa9, 0x75, 0x2a, 0x85,
+	0xb6, 0x3c, 0xf6, 0x37, 0xe2, 0x7a, 0x8e, 0xbf, 0xda, 0x41, 0x89, 0xc1, 0x0d, 0xb4, 0xe1, 0x66,
+	0x33, 0x29, 0xf9, 0x81, 0x34, 0x51, 0xc3, 0x86, 0x51, 0xe0, 0xf5, 0xcc, 0x19, 0xb9, 0x83, 0x76,
+	0x1e, 0xc3, 0x18, 0xfe, 0xc9, 0x32, 0x69, 0xa1, 0xe6, 0x59, 0x70, 0x2e, 0x72, 0x30, 0xad, 0xa1,
+	0xcb, 0x45, 0xf3, 0x65, 0xee, 0x1d, 0x2b, 0xb4, 0xbd, 0x6c, 0x09, 0xdf, 0x9c, 0x0b, 0xaf, 0xcc,
+	0xd9, 0xef, 0xfc, 0x1d, 0x90, 0x13, 0x91, 0x1b, 0x5f, 0x7e, 0xfc, 0xfa, 0x5a, 0xbb, 0x4a, 0xb0,
+	0xfb, 0x9f, 0x64, 0x7d, 0xfb, 0xb1, 0xe7, 0x36, 0xf4, 0x03, 0xaf, 0x87, 0x43, 0x74, 0x69, 0xc9,
+	0x26, 0x6e, 0xcf, 0x6f, 0xac, 0xf2, 0xef, 0x37, 0x57, 0xb3, 0xb4, 0x38, 0xe2, 0x3b, 0x9e, 0x06,
+	0xae, 0xe0, 0xc1, 0x19, 0xda, 0x5e, 0x8e, 0x61, 0xd1, 0x58, 0x65, 0x9a, 0x8b, 0xc6, 0xaa, 0x13,
+	0x24, 0xb7, 0x1c, 0xe1, 0xf5, 0xde, 0xb5, 0x55, 0xc2, 0xe0, 0x93, 0x4d, 0xff, 0xf3, 0xc3, 0x27,
+	0xdf, 0xa6, 0x6d, 0xef, 0xfb, 0xb4, 0xed, 0xfd, 0x9c, 0xb6, 0xbd, 0xd7, 0xf7, 0x23, 0x6e, 0x8e,
+	0x27, 0x47, 0x34, 0x94, 0x49, 0xc0, 0x54, 0x24, 0x53, 0x25, 0xdf, 0xbb, 0x62, 0xef, 0x44, 0xaa,
+	0xf8, 0xdd, 0x58, 0x9e, 0xe8, 0xa0, 0xfa, 0x0d, 0x3a, 0xba, 0xe0, 0x5e, 0x87, 0xbb, 0x7f, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x41, 0xea, 0x66, 0x63, 0xa4, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APITokenServiceClient is the client API for APITokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APITokenServiceClient interface {
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*APITokenList, error)
+	DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error)
+}
+
+type aPITokenServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPITokenServiceClient(cc *grpc.ClientConn) APITokenServiceClient {
+	return &aPITokenServiceClient{cc}
+}
+
+func (c *aPITokenServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, "/apitoken.APITokenService/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPITokenServiceClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*APITokenList, error) {
+	out := new(APITokenList)
+	err := c.cc.Invoke(ctx, "/apitoken.APITokenService/ListAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPITokenServiceClient) DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error) {
+	out := new(DeleteAPITokenResponse)
+	err := c.cc.Invoke(ctx, "/apitoken.APITokenService/DeleteAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APITokenServiceServer is the server API for APITokenService service.
+type APITokenServiceServer interface {
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*APITokenList, error)
+	DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error)
+}
+
+// UnimplementedAPITokenServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPITokenServiceServer struct {
+}
+
+func (*UnimplementedAPITokenServiceServer) CreateAPIToken(ctx context.Context, req *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (*UnimplementedAPITokenServiceServer) ListAPITokens(ctx context.Context, req *ListAPITokensRequest) (*APITokenList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (*UnimplementedAPITokenServiceServer) DeleteAPIToken(ctx context.Context, req *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIToken not implemented")
+}
+
+func RegisterAPITokenServiceServer(s *grpc.Server, srv APITokenServiceServer) {
+	s.RegisterService(&_APITokenService_serviceDesc, srv)
+}
+
+func _APITokenService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apitoken.APITokenService/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APITokenService_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apitoken.APITokenService/ListAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APITokenService_DeleteAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APITokenServiceServer).DeleteAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apitoken.APITokenService/DeleteAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APITokenServiceServer).DeleteAPIToken(ctx, req.(*DeleteAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APITokenService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apitoken.APITokenService",
+	HandlerType: (*APITokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _APITokenService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _APITokenService_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "DeleteAPIToken",
+			Handler:    _APITokenService_DeleteAPIToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/apitoken/apitoken.proto",
+}
+
+func (m *APIToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApitoken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApitoken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceAccountName) > 0 {
+		i -= len(m.ServiceAccountName)
+		copy(dAtA[i:], m.ServiceAccountName)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.ServiceAccountName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *APITokenList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APITokenList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APITokenList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApitoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAPITokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAPITokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAPITokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExpiresIn) > 0 {
+		i -= len(m.ExpiresIn)
+		copy(dAtA[i:], m.ExpiresIn)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.ExpiresIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceAccountName) > 0 {
+		i -= len(m.ServiceAccountName)
+		copy(dAtA[i:], m.ServiceAccountName)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.ServiceAccountName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAPITokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAPITokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAPITokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApiToken != nil {
+		{
+			size, err := m.ApiToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApitoken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAPITokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAPITokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAPITokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAPITokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAPITokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAPITokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAPITokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAPITokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAPITokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApitoken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApitoken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *APIToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.ServiceAccountName)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *APITokenList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApitoken(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateAPITokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.ServiceAccountName)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.ExpiresIn)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateAPITokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApiToken != nil {
+		l = m.ApiToken.Size()
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAPITokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAPITokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAPITokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApitoken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApitoken(x uint64) (n int) {
+	return sovApitoken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *APIToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &v1.Time{}
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APITokenList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APITokenList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APITokenList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &APIToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateAPITokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAPITokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAPITokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateAPITokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAPITokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAPITokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApiToken == nil {
+				m.ApiToken = &APIToken{}
+			}
+			if err := m.ApiToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAPITokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAPITokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAPITokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAPITokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAPITokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAPITokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAPITokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAPITokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAPITokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApitoken(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApitoken
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApitoken
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApitoken
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApitoken        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApitoken          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApitoken = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -24,10 +25,22 @@ func (c *argoKubeWorkflowServiceClient) GetWorkflow(ctx context.Context, req *wo
 	return c.delegate.GetWorkflow(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) DiffWorkflow(ctx context.Context, req *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	return c.delegate.DiffWorkflow(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) ListWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
 	return c.delegate.ListWorkflows(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) GetWorkflowCosts(ctx context.Context, req *workflowpkg.WorkflowCostsRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCostsResponse, error) {
+	return c.delegate.GetWorkflowCosts(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) GetWorkflowSnapshot(ctx context.Context, req *workflowpkg.WorkflowSnapshotRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowSnapshot, error) {
+	return c.delegate.GetWorkflowSnapshot(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) WatchWorkflows(ctx context.Context, req *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	intermediary := newWorkflowWatchIntermediary(ctx)
 	go func() {
@@ -60,6 +73,10 @@ func (c *argoKubeWorkflowServiceClient) DeleteWorkflow(ctx context.Context, req
 	return c.delegate.DeleteWorkflow(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) BulkWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.BulkWorkflows(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) RetryWorkflow(ctx context.Context, req *workflowpkg.WorkflowRetryRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.RetryWorkflow(ctx, req)
 }
@@ -72,6 +89,14 @@ func (c *argoKubeWorkflowServiceClient) ResumeWorkflow(ctx context.Context, req
 	return c.delegate.ResumeWorkflow(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	return c.delegate.ApproveWorkflow(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	return c.delegate.RejectWorkflow(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) SuspendWorkflow(ctx context.Context, req *workflowpkg.WorkflowSuspendRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SuspendWorkflow(ctx, req)
 }
@@ -121,3 +146,19 @@ func (c *argoKubeWorkflowServiceClient) WorkflowLogs(ctx context.Context, req *w
 func (c *argoKubeWorkflowServiceClient) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SubmitWorkflow(ctx, req)
 }
+
+func (c *argoKubeWorkflowServiceClient) GetWorkflowNodePod(ctx context.Context, req *workflowpkg.WorkflowNodePodRequest, _ ...grpc.CallOption) (*corev1.Pod, error) {
+	return c.delegate.GetWorkflowNodePod(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) ExplainWorkflowNode(ctx context.Context, req *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	return c.delegate.ExplainWorkflowNode(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) GetWorkflowTimeline(ctx context.Context, req *workflowpkg.WorkflowTimelineRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowTimeline, error) {
+	return c.delegate.GetWorkflowTimeline(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) RenderWorkflow(ctx context.Context, req *workflowpkg.WorkflowRenderRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	return c.delegate.RenderWorkflow(ctx, req)
+}
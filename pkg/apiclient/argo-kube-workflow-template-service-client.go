@@ -38,3 +38,11 @@ func (a *argoKubeWorkflowTemplateServiceClient) DeleteWorkflowTemplate(ctx conte
 func (a *argoKubeWorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateLintRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
 	return a.delegate.LintWorkflowTemplate(ctx, req)
 }
+
+func (a *argoKubeWorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
+	return a.delegate.ListWorkflowTemplateRevisions(ctx, req)
+}
+
+func (a *argoKubeWorkflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	return a.delegate.RollbackWorkflowTemplate(ctx, req)
+}
@@ -342,11 +342,13 @@ func (m *ClusterWorkflowTemplateDeleteResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ClusterWorkflowTemplateDeleteResponse proto.InternalMessageInfo
 
 type ClusterWorkflowTemplateLintRequest struct {
-	Template             *v1alpha1.ClusterWorkflowTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	CreateOptions        *v1.CreateOptions                 `protobuf:"bytes,2,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	Template      *v1alpha1.ClusterWorkflowTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	CreateOptions *v1.CreateOptions                 `protobuf:"bytes,2,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
+	// strictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced templates
+	StrictTemplateRefArguments bool     `protobuf:"varint,3,opt,name=strictTemplateRefArguments,proto3" json:"strictTemplateRefArguments,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *ClusterWorkflowTemplateLintRequest) Reset()         { *m = ClusterWorkflowTemplateLintRequest{} }
@@ -396,6 +398,13 @@ func (m *ClusterWorkflowTemplateLintRequest) GetCreateOptions() *v1.CreateOption
 	return nil
 }
 
+func (m *ClusterWorkflowTemplateLintRequest) GetStrictTemplateRefArguments() bool {
+	if m != nil {
+		return m.StrictTemplateRefArguments
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ClusterWorkflowTemplateCreateRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateCreateRequest")
 	proto.RegisterType((*ClusterWorkflowTemplateGetRequest)(nil), "clusterworkflowtemplate.ClusterWorkflowTemplateGetRequest")
@@ -411,49 +420,51 @@ func init() {
 }
 
 var fileDescriptor_688d96b5f613e598 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
+	// 696 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x11, 0x9d, 0xd2, 0xcb, 0x1c, 0xb4, 0xc4, 0x76, 0xd1, 0xa1, 0x52, 0x5d, 0xed,
-	0xc4, 0xb4, 0x3d, 0x48, 0x45, 0x0f, 0x6d, 0xa5, 0x1e, 0x2a, 0x4a, 0xea, 0x0f, 0x2a, 0x88, 0x4c,
-	0xd3, 0x31, 0x1b, 0x37, 0x9b, 0x89, 0x99, 0xd9, 0x94, 0x22, 0x5e, 0xbc, 0x79, 0xf2, 0x20, 0xfe,
-	0x29, 0xfe, 0x0f, 0x1e, 0x15, 0xff, 0x01, 0x59, 0x44, 0xf4, 0xe4, 0x4d, 0x3c, 0x4a, 0x26, 0x3f,
-	0x17, 0x9d, 0x36, 0xbb, 0x74, 0x7b, 0xf0, 0x16, 0x66, 0xe6, 0xbd, 0xf7, 0x7d, 0xef, 0x7d, 0xf3,
-	0x65, 0xe0, 0xcd, 0xb0, 0xe3, 0x9a, 0x34, 0xf4, 0x1c, 0xdf, 0x63, 0x81, 0x34, 0x1d, 0xbf, 0x27,
-	0x24, 0x8b, 0x76, 0x79, 0xd4, 0x79, 0xea, 0xf3, 0x5d, 0xc9, 0xba, 0xa1, 0x4f, 0x25, 0xcb, 0xd7,
-	0xe7, 0xf3, 0x8d, 0xf9, 0x7c, 0x87, 0x84, 0x11, 0x97, 0x1c, 0x9d, 0xd6, 0x04, 0x1a, 0xd3, 0x2e,
-	0xe7, 0xae, 0xcf, 0x92, 0x12, 0x26, 0x0d, 0x02, 0x2e, 0xa9, 0xf4, 0x78, 0x20, 0xd2, 0x30, 0x63,
-	0xa9, 0x73, 0x55, 0x10, 0x8f, 0x27, 0xbb, 0x5d, 0xea, 0xb4, 0xbd, 0x80, 0x45, 0x7b, 0x66, 0x86,
-	0x48, 0x98, 0x5d, 0x26, 0xa9, 0x19, 0x5b, 0xa6, 0xcb, 0x02, 0x16, 0x51, 0xc9, 0x76, 0xb2, 0xa8,
-	0xdb, 0xae, 0x27, 0xdb, 0xbd, 0x6d, 0xe2, 0xf0, 0xae, 0x49, 0x23, 0x97, 0x87, 0x11, 0x7f, 0xa6,
-	0x3e, 0x0a, 0x78, 0xa2, 0x4c, 0x92, 0x2f, 0x99, 0xb1, 0x45, 0xfd, 0xb0, 0x4d, 0xff, 0x4a, 0x87,
-	0x7f, 0x03, 0x38, 0xbb, 0x9a, 0xc2, 0x7f, 0x98, 0x1d, 0xbe, 0x97, 0xc1, 0x5f, 0x8d, 0x18, 0x95,
-	0xcc, 0x66, 0xcf, 0x7b, 0x4c, 0x48, 0xd4, 0x83, 0x27, 0x72, 0x5e, 0x53, 0xe0, 0x2c, 0xb8, 0x30,
-	0xb1, 0xb0, 0x45, 0x4a, 0x28, 0x24, 0x87, 0xa2, 0x3e, 0x9e, 0x14, 0x50, 0x48, 0xbc, 0x48, 0xc2,
-	0x8e, 0x4b, 0x12, 0x34, 0x24, 0x5f, 0x25, 0x39, 0x1a, 0xa2, 0xa9, 0x6c, 0x17, 0xa5, 0xd0, 0x16,
-	0x9c, 0x74, 0x14, 0x8e, 0x3b, 0xa1, 0xea, 0xdd, 0x54, 0x43, 0xd5, 0x5e, 0x24, 0x69, 0xf3, 0x48,
-	0xb5, 0x79, 0x65, 0xa5, 0xa4, 0x79, 0x24, 0xb6, 0xc8, 0x6a, 0x35, 0xd4, 0x1e, 0xcc, 0x84, 0x5f,
-	0x03, 0x78, 0x4e, 0x03, 0x60, 0x9d, 0xc9, 0x9c, 0x37, 0x82, 0xc7, 0x02, 0xda, 0x4d, 0x39, 0x9f,
-	0xb4, 0xd5, 0x37, 0xba, 0x0b, 0xa1, 0xcb, 0xe4, 0x20, 0xa2, 0x2b, 0xf5, 0x10, 0xad, 0x17, 0x71,
-	0x76, 0x25, 0x07, 0xde, 0x83, 0x58, 0x03, 0x65, 0xc3, 0x13, 0x05, 0x96, 0x4d, 0x38, 0xe1, 0x7b,
-	0xa2, 0x28, 0x9c, 0x8e, 0xc1, 0xaa, 0x57, 0x78, 0xa3, 0x0c, 0xb4, 0xab, 0x59, 0xf0, 0x7b, 0xbd,
-	0x02, 0xee, 0x87, 0x3b, 0x15, 0x05, 0x9c, 0xaa, 0x76, 0x62, 0xa5, 0x31, 0x05, 0xb2, 0x6e, 0x54,
-	0x95, 0xd1, 0x38, 0x32, 0x65, 0xe0, 0x77, 0x7a, 0xdc, 0x6b, 0xcc, 0x67, 0x25, 0xee, 0x7f, 0x4d,
-	0x70, 0x0b, 0x4e, 0xee, 0xa8, 0x43, 0x23, 0xc9, 0x6a, 0xad, 0x1a, 0x6a, 0x0f, 0x66, 0xc2, 0x73,
-	0xf0, 0xfc, 0x01, 0xb0, 0x44, 0xc8, 0x03, 0xc1, 0xf0, 0x2f, 0xb0, 0xcf, 0xd0, 0x03, 0xf9, 0xdf,
-	0x5e, 0xbc, 0x85, 0x37, 0x13, 0xb0, 0xa9, 0x01, 0xb0, 0xc9, 0xa2, 0xd8, 0x73, 0x18, 0xfa, 0x0e,
-	0xe0, 0x4c, 0x9a, 0x43, 0x73, 0x10, 0x5d, 0x27, 0x1a, 0xd7, 0x25, 0x75, 0xec, 0xcc, 0x18, 0x5f,
-	0x0f, 0xf1, 0xfc, 0xab, 0xcf, 0x5f, 0xdf, 0x36, 0xe6, 0x30, 0x56, 0xbe, 0x1f, 0x5b, 0xfa, 0xff,
-	0x87, 0x58, 0x06, 0x2d, 0xf4, 0x0d, 0x40, 0x63, 0x9d, 0x49, 0x1d, 0xcf, 0xe5, 0x61, 0x79, 0x96,
-	0xde, 0x35, 0x4e, 0x92, 0x96, 0x22, 0x79, 0x09, 0x5d, 0x3c, 0x98, 0xa4, 0xf9, 0x22, 0xb9, 0x72,
-	0x2f, 0x13, 0xa2, 0xd3, 0x89, 0x0b, 0x69, 0x52, 0x0a, 0x74, 0x6d, 0x58, 0xaa, 0x15, 0x6f, 0x34,
-	0x1e, 0x8f, 0x8d, 0x6b, 0x52, 0x05, 0xb7, 0x14, 0xdf, 0x59, 0x54, 0x63, 0xa8, 0xe8, 0x27, 0x80,
-	0x33, 0xa9, 0x75, 0x1e, 0x9a, 0x78, 0x07, 0x9c, 0x78, 0x9c, 0x73, 0x5d, 0x52, 0x3c, 0x89, 0x51,
-	0x7f, 0xae, 0x89, 0x86, 0x3f, 0x01, 0x38, 0x93, 0xba, 0xdb, 0xa1, 0x31, 0x1e, 0xf0, 0x70, 0xe3,
-	0xc6, 0xa8, 0xe1, 0x99, 0xd7, 0x66, 0x72, 0x6d, 0x0d, 0x21, 0xd7, 0x1f, 0x00, 0x9e, 0x49, 0x7c,
-	0x58, 0xc7, 0x68, 0x04, 0xb5, 0x06, 0x47, 0x71, 0x33, 0x17, 0x14, 0xd5, 0xcb, 0x78, 0xae, 0x06,
-	0x55, 0xdf, 0x0b, 0xe4, 0x32, 0x68, 0xad, 0x3c, 0xf8, 0xd0, 0x6f, 0x82, 0x8f, 0xfd, 0x26, 0xf8,
-	0xd2, 0x6f, 0x82, 0x47, 0xb7, 0xea, 0x3f, 0x31, 0xf7, 0x7f, 0x39, 0x6f, 0x1f, 0x57, 0x8f, 0xcc,
-	0xc5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x0f, 0x8e, 0xa4, 0x69, 0x0b, 0x00, 0x00,
+	0x14, 0x66, 0x56, 0x91, 0x3a, 0xa5, 0x97, 0x39, 0xe8, 0x12, 0xdb, 0xa5, 0x0e, 0x95, 0xd6, 0xd5,
+	0x4e, 0xdc, 0xb6, 0x07, 0xa9, 0x58, 0xb0, 0xad, 0xd4, 0x43, 0x45, 0x49, 0xfd, 0x41, 0x05, 0x91,
+	0x34, 0x7d, 0x4d, 0xe3, 0x66, 0x33, 0x31, 0x33, 0xbb, 0xa5, 0x88, 0x17, 0x6f, 0x9e, 0x3c, 0x88,
+	0x7f, 0x8a, 0xfe, 0x0d, 0x1e, 0x15, 0xff, 0x01, 0x29, 0x22, 0x7a, 0xf2, 0xea, 0x51, 0x32, 0xf9,
+	0xb1, 0x59, 0x64, 0xb6, 0xe9, 0xd2, 0xed, 0xc1, 0x5b, 0xc8, 0xcc, 0x7b, 0xef, 0xfb, 0xde, 0xfb,
+	0xe6, 0x9b, 0xc1, 0xb7, 0xc3, 0xa6, 0x6b, 0xda, 0xa1, 0xe7, 0xf8, 0x1e, 0x04, 0xd2, 0x74, 0xfc,
+	0xb6, 0x90, 0x10, 0xed, 0xf1, 0xa8, 0xb9, 0xe3, 0xf3, 0x3d, 0x09, 0xad, 0xd0, 0xb7, 0x25, 0x64,
+	0xff, 0x67, 0xb3, 0x85, 0xd9, 0x6c, 0x85, 0x85, 0x11, 0x97, 0x9c, 0x9c, 0xd7, 0x04, 0x1a, 0xe3,
+	0x2e, 0xe7, 0xae, 0x0f, 0x71, 0x09, 0xd3, 0x0e, 0x02, 0x2e, 0x6d, 0xe9, 0xf1, 0x40, 0x24, 0x61,
+	0xc6, 0x42, 0xf3, 0xba, 0x60, 0x1e, 0x8f, 0x57, 0x5b, 0xb6, 0xb3, 0xeb, 0x05, 0x10, 0xed, 0x9b,
+	0x29, 0x22, 0x61, 0xb6, 0x40, 0xda, 0x66, 0xa7, 0x61, 0xba, 0x10, 0x40, 0x64, 0x4b, 0xd8, 0x4e,
+	0xa3, 0xee, 0xba, 0x9e, 0xdc, 0x6d, 0x6f, 0x31, 0x87, 0xb7, 0x4c, 0x3b, 0x72, 0x79, 0x18, 0xf1,
+	0xe7, 0xea, 0x23, 0x87, 0x27, 0xba, 0x49, 0xb2, 0x5f, 0x66, 0xa7, 0x61, 0xfb, 0xe1, 0xae, 0xfd,
+	0x4f, 0x3a, 0xfa, 0x07, 0xe1, 0xa9, 0x95, 0x04, 0xfe, 0xe3, 0x74, 0xf3, 0x83, 0x14, 0xfe, 0x4a,
+	0x04, 0xb6, 0x04, 0x0b, 0x5e, 0xb4, 0x41, 0x48, 0xd2, 0xc6, 0x23, 0x19, 0xaf, 0x2a, 0x9a, 0x44,
+	0x33, 0xa3, 0x73, 0x9b, 0xac, 0x0b, 0x85, 0x65, 0x50, 0xd4, 0xc7, 0xb3, 0x1c, 0x0a, 0xeb, 0xcc,
+	0xb3, 0xb0, 0xe9, 0xb2, 0x18, 0x0d, 0xcb, 0xfe, 0xb2, 0x0c, 0x0d, 0xd3, 0x54, 0xb6, 0xf2, 0x52,
+	0x64, 0x13, 0x8f, 0x39, 0x0a, 0xc7, 0xbd, 0x50, 0xf5, 0xae, 0x5a, 0x51, 0xb5, 0xe7, 0x59, 0xd2,
+	0x3c, 0x56, 0x6c, 0x5e, 0xb7, 0x52, 0xdc, 0x3c, 0xd6, 0x69, 0xb0, 0x95, 0x62, 0xa8, 0xd5, 0x9b,
+	0x89, 0xbe, 0x41, 0xf8, 0xa2, 0x06, 0xc0, 0x1a, 0xc8, 0x8c, 0x37, 0xc1, 0xa7, 0x03, 0xbb, 0x95,
+	0x70, 0x3e, 0x6b, 0xa9, 0x6f, 0x72, 0x1f, 0x63, 0x17, 0x64, 0x2f, 0xa2, 0x6b, 0xe5, 0x10, 0xad,
+	0xe5, 0x71, 0x56, 0x21, 0x07, 0xdd, 0xc7, 0x54, 0x03, 0x65, 0xdd, 0x13, 0x39, 0x96, 0x0d, 0x3c,
+	0xea, 0x7b, 0x22, 0x2f, 0x9c, 0x8c, 0xa1, 0x51, 0xae, 0xf0, 0x7a, 0x37, 0xd0, 0x2a, 0x66, 0xa1,
+	0x1f, 0xf4, 0x0a, 0x78, 0x18, 0x6e, 0x17, 0x14, 0x70, 0xae, 0xd8, 0x89, 0xe5, 0x4a, 0x15, 0xa5,
+	0xdd, 0x28, 0x2a, 0xa3, 0x72, 0x62, 0xca, 0xa0, 0xef, 0xf5, 0xb8, 0x57, 0xc1, 0x07, 0x09, 0xfd,
+	0x26, 0xb8, 0x89, 0xc7, 0xb6, 0xd5, 0xa6, 0x81, 0x64, 0xb5, 0x5a, 0x0c, 0xb5, 0x7a, 0x33, 0xd1,
+	0x69, 0x7c, 0xe9, 0x10, 0x58, 0x22, 0xe4, 0x81, 0x00, 0xfa, 0xb1, 0xd2, 0x67, 0xe8, 0x81, 0xfc,
+	0x6f, 0x0f, 0x1e, 0x59, 0xc2, 0x86, 0x90, 0x91, 0xe7, 0xc8, 0xbc, 0x2c, 0xec, 0xdc, 0x8a, 0xdc,
+	0x76, 0x0b, 0x02, 0x29, 0xaa, 0xa7, 0x26, 0xd1, 0xcc, 0x88, 0xd5, 0x67, 0xc7, 0xdc, 0xdb, 0x51,
+	0x5c, 0xd3, 0x10, 0xd8, 0x80, 0xa8, 0xe3, 0x39, 0x40, 0x7e, 0x22, 0x3c, 0x91, 0x60, 0xd0, 0x6c,
+	0x24, 0x37, 0x99, 0xc6, 0xb5, 0x59, 0x19, 0x3b, 0x34, 0x86, 0x37, 0x03, 0x3a, 0xfb, 0xfa, 0xeb,
+	0xf7, 0x77, 0x95, 0x69, 0x4a, 0xd5, 0xbd, 0xd1, 0x69, 0xe8, 0xef, 0x1f, 0xb1, 0x88, 0xea, 0xe4,
+	0x07, 0xc2, 0xc6, 0x1a, 0x48, 0x1d, 0xcf, 0xc5, 0xa3, 0xf2, 0xec, 0x7a, 0xdf, 0x30, 0x49, 0x36,
+	0x14, 0xc9, 0x2b, 0xe4, 0xf2, 0xe1, 0x24, 0xcd, 0x97, 0xf1, 0x91, 0x7d, 0x15, 0x13, 0x1d, 0x8f,
+	0x5d, 0x4c, 0x93, 0x52, 0x90, 0x1b, 0x47, 0xa5, 0x5a, 0xf0, 0x56, 0xe3, 0xe9, 0xd0, 0xb8, 0xc6,
+	0x55, 0x68, 0x5d, 0xf1, 0x9d, 0x22, 0x25, 0x86, 0x4a, 0x7e, 0x23, 0x3c, 0x91, 0x58, 0xef, 0xb1,
+	0x89, 0xb7, 0xc7, 0xc9, 0x87, 0x39, 0xd7, 0x05, 0xc5, 0x93, 0x19, 0xe5, 0xe7, 0x1a, 0x6b, 0xf8,
+	0x0b, 0xc2, 0x13, 0x89, 0x3b, 0x1e, 0x1b, 0xe3, 0x9e, 0x3b, 0xc0, 0x58, 0x1a, 0x34, 0x3c, 0xf5,
+	0xea, 0x54, 0xae, 0xf5, 0x23, 0xc8, 0xf5, 0x17, 0xc2, 0x17, 0x62, 0x1f, 0xd7, 0x31, 0x1a, 0x40,
+	0xad, 0xc1, 0x49, 0x9c, 0xcc, 0x39, 0x45, 0xf5, 0x2a, 0x9d, 0x2e, 0x41, 0xd5, 0xf7, 0x02, 0xb9,
+	0x88, 0xea, 0xcb, 0x8f, 0x3e, 0x1d, 0xd4, 0xd0, 0xe7, 0x83, 0x1a, 0xfa, 0x76, 0x50, 0x43, 0x4f,
+	0xee, 0x94, 0x7f, 0xa2, 0xf6, 0x7f, 0x79, 0x6f, 0x9d, 0x51, 0x8f, 0xd4, 0xf9, 0xbf, 0x03, 0x00,
+	0x84, 0xbc, 0x4f, 0x89, 0xa9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -995,6 +1006,16 @@ func (m *ClusterWorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StrictTemplateRefArguments {
+		i--
+		if m.StrictTemplateRefArguments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CreateOptions != nil {
 		{
 			size, err := m.CreateOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1155,6 +1176,9 @@ func (m *ClusterWorkflowTemplateLintRequest) Size() (n int) {
 		l = m.CreateOptions.Size()
 		n += 1 + l + sovClusterWorkflowTemplate(uint64(l))
 	}
+	if m.StrictTemplateRefArguments {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1886,6 +1910,26 @@ func (m *ClusterWorkflowTemplateLintRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictTemplateRefArguments", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictTemplateRefArguments = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterWorkflowTemplate(dAtA[iNdEx:])
@@ -30,11 +30,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type LintCronWorkflowRequest struct {
-	Namespace            string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	CronWorkflow         *v1alpha1.CronWorkflow `protobuf:"bytes,2,opt,name=cronWorkflow,proto3" json:"cronWorkflow,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Namespace    string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CronWorkflow *v1alpha1.CronWorkflow `protobuf:"bytes,2,opt,name=cronWorkflow,proto3" json:"cronWorkflow,omitempty"`
+	// strictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced templates
+	StrictTemplateRefArguments bool     `protobuf:"varint,3,opt,name=strictTemplateRefArguments,proto3" json:"strictTemplateRefArguments,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *LintCronWorkflowRequest) Reset()         { *m = LintCronWorkflowRequest{} }
@@ -84,6 +86,13 @@ func (m *LintCronWorkflowRequest) GetCronWorkflow() *v1alpha1.CronWorkflow {
 	return nil
 }
 
+func (m *LintCronWorkflowRequest) GetStrictTemplateRefArguments() bool {
+	if m != nil {
+		return m.StrictTemplateRefArguments
+	}
+	return false
+}
+
 type CreateCronWorkflowRequest struct {
 	Namespace            string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	CronWorkflow         *v1alpha1.CronWorkflow `protobuf:"bytes,2,opt,name=cronWorkflow,proto3" json:"cronWorkflow,omitempty"`
@@ -559,55 +568,57 @@ func init() {
 }
 
 var fileDescriptor_257f310938c448f8 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x99, 0xed, 0x8f, 0x1f, 0xf4, 0x69, 0x8b, 0x3a, 0x85, 0xba, 0x8d, 0xb5, 0x94, 0x50,
-	0x6d, 0xbb, 0xda, 0x49, 0xb7, 0xad, 0x22, 0x55, 0x2f, 0x6d, 0xa1, 0x07, 0xdb, 0x2a, 0x29, 0x22,
-	0xf5, 0x22, 0x69, 0x76, 0x4c, 0x63, 0xb3, 0x99, 0x98, 0x99, 0xdd, 0x22, 0xd2, 0x8b, 0x27, 0x2f,
-	0x82, 0xe0, 0x51, 0x5f, 0x80, 0xe0, 0x3b, 0xf0, 0xcf, 0x49, 0x04, 0x11, 0x04, 0xc1, 0x37, 0x20,
-	0xc5, 0x17, 0x22, 0x99, 0xfd, 0x97, 0xc9, 0x6e, 0x34, 0x2d, 0x41, 0xf0, 0x36, 0xd9, 0xcc, 0x3c,
-	0xf3, 0xf9, 0x7e, 0xe7, 0xc9, 0x37, 0x59, 0x20, 0xc1, 0x9e, 0x63, 0x58, 0x81, 0x6b, 0x7b, 0x2e,
-	0xf5, 0x85, 0x61, 0x87, 0xcc, 0xdf, 0x67, 0xe1, 0xde, 0x7d, 0x8f, 0xed, 0xcb, 0x8b, 0xd9, 0xd6,
-	0x15, 0x09, 0x42, 0x26, 0x18, 0x1e, 0x8c, 0xcf, 0xd0, 0xc6, 0x1c, 0xc6, 0x1c, 0x8f, 0x46, 0x05,
-	0x0c, 0xcb, 0xf7, 0x99, 0xb0, 0x84, 0xcb, 0x7c, 0xde, 0x98, 0xab, 0x2d, 0xee, 0x5d, 0xe1, 0xc4,
-	0x65, 0xd1, 0xdd, 0xaa, 0x65, 0xef, 0xba, 0x3e, 0x0d, 0x1f, 0x19, 0xcd, 0xfd, 0xb8, 0x51, 0xa5,
-	0xc2, 0x32, 0xea, 0x65, 0xc3, 0xa1, 0x3e, 0x0d, 0x2d, 0x41, 0x2b, 0xcd, 0x55, 0x1b, 0x8e, 0x2b,
-	0x76, 0x6b, 0x3b, 0xc4, 0x66, 0x55, 0xc3, 0x0a, 0x1d, 0x16, 0x84, 0xec, 0x81, 0x1c, 0xb4, 0x51,
-	0x78, 0xa7, 0x48, 0x9b, 0xb5, 0x5e, 0xb6, 0xbc, 0x60, 0xd7, 0xea, 0x2a, 0xa7, 0xbf, 0x41, 0x70,
-	0x7a, 0xdd, 0xf5, 0xc5, 0x4a, 0xc8, 0xfc, 0x3b, 0xcd, 0xd9, 0x26, 0x7d, 0x58, 0xa3, 0x5c, 0xe0,
-	0x31, 0xe8, 0xf7, 0xad, 0x2a, 0xe5, 0x81, 0x65, 0xd3, 0x22, 0x9a, 0x40, 0xd3, 0xfd, 0x66, 0xe7,
-	0x07, 0x1c, 0x82, 0x14, 0xdb, 0x5a, 0x54, 0x2c, 0x4c, 0xa0, 0xe9, 0x81, 0xf9, 0x4d, 0xd2, 0xe1,
-	0x23, 0x2d, 0x3e, 0x39, 0xb8, 0xd7, 0xe6, 0x23, 0xf5, 0x85, 0xc8, 0x57, 0x12, 0x21, 0x92, 0xb6,
-	0x81, 0x2d, 0x44, 0xa2, 0xa0, 0x28, 0x7b, 0xe8, 0x4f, 0x0b, 0x30, 0xba, 0x12, 0x52, 0x4b, 0xd0,
-	0x7f, 0x82, 0x17, 0x6f, 0xc3, 0x90, 0x2d, 0x71, 0x6f, 0x06, 0xf2, 0xe4, 0x8b, 0x7d, 0x72, 0xd3,
-	0x05, 0xd2, 0x38, 0x7a, 0x12, 0x3f, 0xfa, 0xce, 0x16, 0xd1, 0xd1, 0x93, 0x7a, 0x54, 0x38, 0xb6,
-	0xd4, 0x54, 0x2b, 0xe9, 0xcf, 0x10, 0x14, 0xd7, 0x5d, 0xae, 0x1c, 0x1c, 0xcf, 0xe6, 0xc4, 0x16,
-	0x0c, 0x78, 0x2e, 0x17, 0x2d, 0xa6, 0x86, 0x11, 0xe5, 0x6c, 0x4c, 0xeb, 0x9d, 0x85, 0x66, 0xbc,
-	0x8a, 0xfe, 0x0a, 0xc1, 0xc8, 0x1a, 0xed, 0xd9, 0x47, 0x18, 0xfe, 0x8b, 0x36, 0x6f, 0x82, 0xc8,
-	0xb1, 0x4a, 0x58, 0x48, 0x12, 0xde, 0x02, 0x70, 0xa8, 0x50, 0x4d, 0x9b, 0xcb, 0x06, 0xb8, 0xd6,
-	0x5e, 0x67, 0xc6, 0x6a, 0xe8, 0x9f, 0x10, 0x8c, 0xde, 0x0e, 0x2a, 0x29, 0x9d, 0x33, 0x12, 0x27,
-	0x5c, 0x2e, 0x14, 0x51, 0x26, 0xca, 0x64, 0x47, 0xf5, 0xfd, 0x85, 0x27, 0xe0, 0x35, 0x82, 0xd1,
-	0x55, 0xea, 0xd1, 0xde, 0x3a, 0x8e, 0xee, 0xf4, 0x36, 0x0c, 0x55, 0x64, 0xb9, 0x63, 0x75, 0xe8,
-	0x6a, 0x7c, 0xa9, 0xa9, 0x56, 0xd2, 0xcf, 0xc2, 0x99, 0x38, 0x63, 0x63, 0x6e, 0xc5, 0xa4, 0x3c,
-	0x60, 0x3e, 0xa7, 0xfa, 0x26, 0x68, 0xf1, 0xdb, 0x5b, 0x35, 0x1e, 0x50, 0xbf, 0x72, 0x6c, 0x25,
-	0xfa, 0x46, 0x14, 0x0d, 0x71, 0x4b, 0x78, 0xad, 0x4a, 0x8f, 0x5d, 0x6e, 0xfe, 0xf9, 0x20, 0x0c,
-	0x2b, 0x7c, 0x34, 0xac, 0xbb, 0x36, 0xc5, 0x1f, 0x10, 0x9c, 0x4c, 0x06, 0x26, 0x3e, 0x47, 0xe2,
-	0xb9, 0x4f, 0x52, 0x02, 0x55, 0xcb, 0xb9, 0x35, 0xf4, 0xf9, 0x27, 0xdf, 0x7f, 0xbe, 0x28, 0x5c,
-	0xd4, 0xa7, 0xe4, 0x1b, 0xa6, 0x5e, 0x56, 0x5f, 0x49, 0xdc, 0x78, 0xdc, 0x96, 0x73, 0x60, 0x78,
-	0xae, 0x2f, 0x96, 0x50, 0x09, 0xbf, 0x47, 0x80, 0xbb, 0x23, 0x14, 0x4f, 0xa9, 0x0a, 0x52, 0x43,
-	0x36, 0x77, 0x0d, 0xb3, 0x52, 0xc3, 0x94, 0xae, 0xff, 0x59, 0x43, 0x84, 0xff, 0x0e, 0xc1, 0xa9,
-	0xae, 0xd8, 0xc3, 0xe7, 0x93, 0xfe, 0xf7, 0xce, 0x45, 0xcd, 0xcc, 0x17, 0x3e, 0xda, 0x47, 0x2f,
-	0x49, 0x01, 0x93, 0x38, 0x83, 0x00, 0xfc, 0x16, 0xc1, 0x89, 0x44, 0x48, 0xe2, 0x49, 0x95, 0xbd,
-	0x77, 0x86, 0xe6, 0x6e, 0x7b, 0x59, 0x52, 0x5f, 0xc0, 0x33, 0x19, 0x5a, 0x47, 0x8e, 0x0f, 0xf0,
-	0x47, 0x04, 0xb8, 0x3b, 0x42, 0x93, 0x9d, 0x93, 0x1a, 0xb2, 0xb9, 0x4b, 0x58, 0x94, 0x12, 0x88,
-	0x96, 0x5d, 0x42, 0xd4, 0x40, 0x2f, 0x11, 0xe0, 0xee, 0x00, 0x4d, 0xaa, 0x48, 0x8d, 0x58, 0x6d,
-	0x26, 0xf9, 0xa0, 0xa4, 0x27, 0x5c, 0xd3, 0xe3, 0xd2, 0x11, 0x3c, 0xfe, 0x82, 0x00, 0x37, 0x92,
-	0xeb, 0xf7, 0x4f, 0x67, 0x4a, 0xce, 0xe5, 0xee, 0xf1, 0x55, 0x29, 0xe1, 0x92, 0x36, 0x97, 0x59,
-	0x82, 0x11, 0x4a, 0xa0, 0xc8, 0xea, 0xaf, 0x08, 0x86, 0x9b, 0xb1, 0xae, 0xa8, 0x99, 0x4e, 0x57,
-	0xa3, 0xbe, 0x05, 0x72, 0x97, 0x73, 0x4d, 0xca, 0xb9, 0xac, 0x95, 0xb3, 0xcb, 0xe1, 0x0d, 0xa2,
-	0x25, 0x54, 0x5a, 0xbe, 0xf1, 0xf9, 0x70, 0x1c, 0x7d, 0x3b, 0x1c, 0x47, 0x3f, 0x0e, 0xc7, 0xd1,
-	0xdd, 0xeb, 0xd9, 0x3f, 0xc4, 0x7b, 0xfc, 0x7b, 0xd8, 0xf9, 0x5f, 0x7e, 0x7f, 0x2f, 0xfc, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xc4, 0x13, 0xb8, 0x3b, 0x62, 0x0c, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x5d, 0x6b, 0xd4, 0x4a,
+	0x18, 0xc7, 0x99, 0xed, 0xe1, 0x70, 0x3a, 0x6d, 0x39, 0xe7, 0x4c, 0xa1, 0x67, 0x37, 0xa7, 0x96,
+	0x12, 0xaa, 0xdd, 0xae, 0x76, 0xd2, 0xdd, 0x56, 0x91, 0xfa, 0x02, 0xb6, 0x85, 0x5e, 0xd8, 0x56,
+	0x49, 0x15, 0xa9, 0x37, 0x92, 0x66, 0x9f, 0xa6, 0xb1, 0xd9, 0x4c, 0xcc, 0xcc, 0x6e, 0x11, 0xe9,
+	0x8d, 0x57, 0xde, 0x08, 0x82, 0x97, 0xfa, 0x01, 0xfc, 0x0c, 0xbe, 0x5c, 0x89, 0x20, 0x82, 0x20,
+	0xf8, 0x05, 0xa4, 0x78, 0xe7, 0x97, 0x90, 0xcc, 0xbe, 0x25, 0xd9, 0x4d, 0x8d, 0x25, 0x5e, 0x78,
+	0x37, 0x49, 0x66, 0x9e, 0xf9, 0xfd, 0x9f, 0xfd, 0xe7, 0x3f, 0x59, 0x4c, 0xbd, 0x3d, 0x4b, 0x33,
+	0x3c, 0xdb, 0x74, 0x6c, 0x70, 0x85, 0x66, 0xfa, 0xcc, 0xdd, 0x67, 0xfe, 0xde, 0x8e, 0xc3, 0xf6,
+	0xe5, 0xc5, 0x6c, 0xfb, 0x8a, 0x7a, 0x3e, 0x13, 0x8c, 0x0c, 0x87, 0x67, 0x28, 0xe3, 0x16, 0x63,
+	0x96, 0x03, 0x41, 0x01, 0xcd, 0x70, 0x5d, 0x26, 0x0c, 0x61, 0x33, 0x97, 0x37, 0xe7, 0x2a, 0x0b,
+	0x7b, 0xe7, 0x39, 0xb5, 0x59, 0xf0, 0xb4, 0x66, 0x98, 0xbb, 0xb6, 0x0b, 0xfe, 0x7d, 0xad, 0xb5,
+	0x1f, 0xd7, 0x6a, 0x20, 0x0c, 0xad, 0x51, 0xd6, 0x2c, 0x70, 0xc1, 0x37, 0x04, 0x54, 0x5b, 0xab,
+	0xd6, 0x2d, 0x5b, 0xec, 0xd6, 0xb7, 0xa9, 0xc9, 0x6a, 0x9a, 0xe1, 0x5b, 0xcc, 0xf3, 0xd9, 0x5d,
+	0x39, 0xe8, 0xa0, 0xf0, 0x6e, 0x91, 0x0e, 0x6b, 0xa3, 0x6c, 0x38, 0xde, 0xae, 0xd1, 0x53, 0x4e,
+	0xfd, 0x86, 0xf0, 0x7f, 0x6b, 0xb6, 0x2b, 0x96, 0x7d, 0xe6, 0xde, 0x6a, 0xcd, 0xd6, 0xe1, 0x5e,
+	0x1d, 0xb8, 0x20, 0xe3, 0x78, 0xd0, 0x35, 0x6a, 0xc0, 0x3d, 0xc3, 0x84, 0x3c, 0x9a, 0x44, 0xc5,
+	0x41, 0xbd, 0x7b, 0x83, 0xf8, 0x78, 0xd8, 0x0c, 0x2d, 0xca, 0xe7, 0x26, 0x51, 0x71, 0xa8, 0xb2,
+	0x41, 0xbb, 0x7c, 0xb4, 0xcd, 0x27, 0x07, 0x77, 0x3a, 0x7c, 0xb4, 0x31, 0x1f, 0xf4, 0x95, 0x06,
+	0x88, 0xb4, 0x7d, 0x97, 0xb6, 0x11, 0x69, 0x04, 0x25, 0xb2, 0x07, 0xb9, 0x8c, 0x15, 0x2e, 0x7c,
+	0xdb, 0x14, 0x37, 0xa0, 0xe6, 0x39, 0x86, 0x00, 0x1d, 0x76, 0xae, 0xf8, 0x56, 0xbd, 0x06, 0xae,
+	0xe0, 0xf9, 0x81, 0x49, 0x54, 0xfc, 0x4b, 0x3f, 0x62, 0x86, 0xfa, 0x28, 0x87, 0x0b, 0xcb, 0x3e,
+	0x18, 0x02, 0x7e, 0x0f, 0xbd, 0x5b, 0x78, 0xc4, 0x94, 0xb8, 0xd7, 0x3c, 0xe9, 0x1c, 0x29, 0x71,
+	0xa8, 0x32, 0x4f, 0x9b, 0xd6, 0xa1, 0x61, 0xeb, 0x74, 0xb7, 0x08, 0xac, 0x43, 0x1b, 0x41, 0xe1,
+	0xd0, 0x52, 0x3d, 0x5a, 0x49, 0x7d, 0x8c, 0x70, 0x7e, 0xcd, 0xe6, 0x91, 0x1f, 0x9e, 0xa7, 0xeb,
+	0xc4, 0x26, 0x1e, 0x72, 0x6c, 0x2e, 0xda, 0x4c, 0xcd, 0x46, 0x94, 0xd3, 0x31, 0xad, 0x75, 0x17,
+	0xea, 0xe1, 0x2a, 0xea, 0x73, 0x84, 0xc7, 0x56, 0xa1, 0xaf, 0x0f, 0x09, 0xfe, 0x23, 0xd8, 0xbc,
+	0x05, 0x22, 0xc7, 0x51, 0xc2, 0x5c, 0x9c, 0xf0, 0x3a, 0xc6, 0x16, 0x88, 0x68, 0xd3, 0xe6, 0xd2,
+	0x01, 0xae, 0x76, 0xd6, 0xe9, 0xa1, 0x1a, 0xea, 0x3b, 0x84, 0x0b, 0x37, 0xbd, 0x6a, 0x82, 0x73,
+	0xc6, 0xc2, 0x84, 0x4b, 0xb9, 0x3c, 0x4a, 0x45, 0x19, 0x77, 0xd4, 0xc0, 0xaf, 0x77, 0x94, 0xfa,
+	0x02, 0xe1, 0xc2, 0x0a, 0x38, 0x20, 0x20, 0x9b, 0x4e, 0x6f, 0xe1, 0x91, 0xaa, 0x2c, 0x77, 0x2c,
+	0x87, 0xae, 0x84, 0x97, 0xea, 0xd1, 0x4a, 0xea, 0x09, 0xfc, 0x7f, 0x98, 0xb1, 0x39, 0xb7, 0xaa,
+	0x03, 0xf7, 0x98, 0xcb, 0x41, 0xdd, 0xc0, 0x4a, 0xf8, 0xf1, 0x66, 0x9d, 0x7b, 0xe0, 0x56, 0x8f,
+	0xad, 0x44, 0x5d, 0xc7, 0x85, 0x70, 0x3d, 0x1d, 0x78, 0xbd, 0x06, 0xc7, 0x2e, 0x57, 0x79, 0x32,
+	0x8c, 0x47, 0x23, 0x7c, 0xe0, 0x37, 0x6c, 0x13, 0xc8, 0x1b, 0x84, 0xff, 0x89, 0x07, 0x2e, 0x39,
+	0x49, 0xc3, 0xe7, 0x06, 0x4d, 0x08, 0x64, 0x25, 0x63, 0x6b, 0xa8, 0x95, 0x87, 0x9f, 0xbf, 0x3e,
+	0xcd, 0x9d, 0x51, 0xa7, 0xe5, 0x09, 0xd5, 0x28, 0x47, 0x8f, 0x34, 0xae, 0x3d, 0xe8, 0xc8, 0x39,
+	0xd0, 0x1c, 0xdb, 0x15, 0x8b, 0xa8, 0x44, 0x5e, 0x23, 0x4c, 0x7a, 0x23, 0x94, 0x4c, 0x47, 0x15,
+	0x24, 0x86, 0x6c, 0xe6, 0x1a, 0x66, 0xa5, 0x86, 0x69, 0x55, 0xfd, 0xb1, 0x86, 0x00, 0xff, 0x15,
+	0xc2, 0xff, 0xf6, 0xc4, 0x1e, 0x39, 0x15, 0xef, 0x7f, 0xff, 0x5c, 0x54, 0xf4, 0x6c, 0xe1, 0x83,
+	0x7d, 0xd4, 0x92, 0x14, 0x30, 0x45, 0x52, 0x08, 0x20, 0x2f, 0x11, 0xfe, 0x3b, 0x16, 0x92, 0x64,
+	0x2a, 0xca, 0xde, 0x3f, 0x43, 0x33, 0x6f, 0x7b, 0x59, 0x52, 0x9f, 0x26, 0x33, 0x29, 0xac, 0x23,
+	0xc7, 0x07, 0xe4, 0x2d, 0xc2, 0xa4, 0x37, 0x42, 0xe3, 0xce, 0x49, 0x0c, 0xd9, 0xcc, 0x25, 0x2c,
+	0x48, 0x09, 0x54, 0x49, 0x2f, 0x21, 0x30, 0xd0, 0x33, 0x84, 0x49, 0x6f, 0x80, 0xc6, 0x55, 0x24,
+	0x46, 0xac, 0x32, 0x13, 0x7f, 0x51, 0x92, 0x13, 0xae, 0xd5, 0xe3, 0xd2, 0x4f, 0xf4, 0xf8, 0x03,
+	0xc2, 0xa4, 0x99, 0x5c, 0x47, 0xbf, 0x9d, 0x09, 0x39, 0x97, 0x79, 0x8f, 0x2f, 0x48, 0x09, 0x67,
+	0x95, 0xb9, 0xd4, 0x12, 0x34, 0x5f, 0x02, 0x05, 0xad, 0xfe, 0x88, 0xf0, 0x68, 0x2b, 0xd6, 0x23,
+	0x6a, 0x8a, 0xc9, 0x6a, 0xa2, 0xa7, 0x40, 0xe6, 0x72, 0x2e, 0x4a, 0x39, 0xe7, 0x94, 0x72, 0x7a,
+	0x39, 0xbc, 0x49, 0xb4, 0x88, 0x4a, 0x4b, 0x57, 0xdf, 0x1f, 0x4e, 0xa0, 0x4f, 0x87, 0x13, 0xe8,
+	0xcb, 0xe1, 0x04, 0xba, 0x7d, 0x29, 0xfd, 0x87, 0x7c, 0x9f, 0x7f, 0x1f, 0xdb, 0x7f, 0xca, 0xef,
+	0xf7, 0xf9, 0xef, 0x03, 0x00, 0xdf, 0x8c, 0x8b, 0xef, 0xa2, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -966,6 +977,16 @@ func (m *LintCronWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StrictTemplateRefArguments {
+		i--
+		if m.StrictTemplateRefArguments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CronWorkflow != nil {
 		{
 			size, err := m.CronWorkflow.MarshalToSizedBuffer(dAtA[:i])
@@ -1385,6 +1406,9 @@ func (m *LintCronWorkflowRequest) Size() (n int) {
 		l = m.CronWorkflow.Size()
 		n += 1 + l + sovCronWorkflow(uint64(l))
 	}
+	if m.StrictTemplateRefArguments {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1662,6 +1686,26 @@ func (m *LintCronWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictTemplateRefArguments", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictTemplateRefArguments = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
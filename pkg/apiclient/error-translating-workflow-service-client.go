@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -26,11 +27,26 @@ func (c *errorTranslatingWorkflowServiceClient) GetWorkflow(ctx context.Context,
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) DiffWorkflow(ctx context.Context, req *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	res, err := c.delegate.DiffWorkflow(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) ListWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
 	workflows, err := c.delegate.ListWorkflows(ctx, req)
 	return workflows, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) GetWorkflowCosts(ctx context.Context, req *workflowpkg.WorkflowCostsRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCostsResponse, error) {
+	res, err := c.delegate.GetWorkflowCosts(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) GetWorkflowSnapshot(ctx context.Context, req *workflowpkg.WorkflowSnapshotRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowSnapshot, error) {
+	res, err := c.delegate.GetWorkflowSnapshot(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) WatchWorkflows(ctx context.Context, req *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	workflows, err := c.delegate.WatchWorkflows(ctx, req)
 	return workflows, grpcutil.TranslateError(err)
@@ -46,6 +62,11 @@ func (c *errorTranslatingWorkflowServiceClient) DeleteWorkflow(ctx context.Conte
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) BulkWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.BulkWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) RetryWorkflow(ctx context.Context, req *workflowpkg.WorkflowRetryRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	workflow, err := c.delegate.RetryWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
@@ -61,6 +82,16 @@ func (c *errorTranslatingWorkflowServiceClient) ResumeWorkflow(ctx context.Conte
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	workflow, err := c.delegate.ApproveWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	workflow, err := c.delegate.RejectWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) SuspendWorkflow(ctx context.Context, req *workflowpkg.WorkflowSuspendRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	workflow, err := c.delegate.SuspendWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
@@ -100,3 +131,23 @@ func (c *errorTranslatingWorkflowServiceClient) SubmitWorkflow(ctx context.Conte
 	workflow, err := c.delegate.SubmitWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
 }
+
+func (c *errorTranslatingWorkflowServiceClient) GetWorkflowNodePod(ctx context.Context, req *workflowpkg.WorkflowNodePodRequest, _ ...grpc.CallOption) (*corev1.Pod, error) {
+	pod, err := c.delegate.GetWorkflowNodePod(ctx, req)
+	return pod, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) ExplainWorkflowNode(ctx context.Context, req *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	explanation, err := c.delegate.ExplainWorkflowNode(ctx, req)
+	return explanation, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) GetWorkflowTimeline(ctx context.Context, req *workflowpkg.WorkflowTimelineRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowTimeline, error) {
+	timeline, err := c.delegate.GetWorkflowTimeline(ctx, req)
+	return timeline, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) RenderWorkflow(ctx context.Context, req *workflowpkg.WorkflowRenderRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	workflow, err := c.delegate.RenderWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
@@ -45,3 +45,13 @@ func (a *errorTranslatingWorkflowTemplateServiceClient) LintWorkflowTemplate(ctx
 	template, err := a.delegate.LintWorkflowTemplate(ctx, req)
 	return template, grpcutil.TranslateError(err)
 }
+
+func (a *errorTranslatingWorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
+	templates, err := a.delegate.ListWorkflowTemplateRevisions(ctx, req)
+	return templates, grpcutil.TranslateError(err)
+}
+
+func (a *errorTranslatingWorkflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	template, err := a.delegate.RollbackWorkflowTemplate(ctx, req)
+	return template, grpcutil.TranslateError(err)
+}
@@ -194,47 +194,451 @@ func (m *ListWorkflowEventBindingsRequest) GetListOptions() *v1.ListOptions {
 	return nil
 }
 
+// FailedEvent is an event that could not be dispatched to a workflow event binding, e.g. because the workflow could not
+// be created
+type FailedEvent struct {
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the workflow event binding that the event could not be dispatched to.
+	WorkflowEventBinding string         `protobuf:"bytes,3,opt,name=workflowEventBinding,proto3" json:"workflowEventBinding,omitempty"`
+	Discriminator        string         `protobuf:"bytes,4,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	Payload              *v1alpha1.Item `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Why the event could not be dispatched.
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            *v1.Time `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailedEvent) Reset()         { *m = FailedEvent{} }
+func (m *FailedEvent) String() string { return proto.CompactTextString(m) }
+func (*FailedEvent) ProtoMessage()    {}
+func (*FailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{3}
+}
+func (m *FailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedEvent.Merge(m, src)
+}
+func (m *FailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedEvent proto.InternalMessageInfo
+
+func (m *FailedEvent) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetWorkflowEventBinding() string {
+	if m != nil {
+		return m.WorkflowEventBinding
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetPayload() *v1alpha1.Item {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FailedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetCreatedAt() *v1.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type FailedEventList struct {
+	Items                []*FailedEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FailedEventList) Reset()         { *m = FailedEventList{} }
+func (m *FailedEventList) String() string { return proto.CompactTextString(m) }
+func (*FailedEventList) ProtoMessage()    {}
+func (*FailedEventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{4}
+}
+func (m *FailedEventList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedEventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedEventList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedEventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedEventList.Merge(m, src)
+}
+func (m *FailedEventList) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedEventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedEventList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedEventList proto.InternalMessageInfo
+
+func (m *FailedEventList) GetItems() []*FailedEvent {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListFailedEventsRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListFailedEventsRequest) Reset()         { *m = ListFailedEventsRequest{} }
+func (m *ListFailedEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFailedEventsRequest) ProtoMessage()    {}
+func (*ListFailedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{5}
+}
+func (m *ListFailedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFailedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFailedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListFailedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFailedEventsRequest.Merge(m, src)
+}
+func (m *ListFailedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFailedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFailedEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFailedEventsRequest proto.InternalMessageInfo
+
+func (m *ListFailedEventsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ReplayFailedEventRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayFailedEventRequest) Reset()         { *m = ReplayFailedEventRequest{} }
+func (m *ReplayFailedEventRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayFailedEventRequest) ProtoMessage()    {}
+func (*ReplayFailedEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{6}
+}
+func (m *ReplayFailedEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayFailedEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayFailedEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayFailedEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayFailedEventRequest.Merge(m, src)
+}
+func (m *ReplayFailedEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayFailedEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayFailedEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayFailedEventRequest proto.InternalMessageInfo
+
+func (m *ReplayFailedEventRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReplayFailedEventRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ReplayFailedEventResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayFailedEventResponse) Reset()         { *m = ReplayFailedEventResponse{} }
+func (m *ReplayFailedEventResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayFailedEventResponse) ProtoMessage()    {}
+func (*ReplayFailedEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{7}
+}
+func (m *ReplayFailedEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayFailedEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayFailedEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayFailedEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayFailedEventResponse.Merge(m, src)
+}
+func (m *ReplayFailedEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayFailedEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayFailedEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayFailedEventResponse proto.InternalMessageInfo
+
+type DeleteFailedEventRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteFailedEventRequest) Reset()         { *m = DeleteFailedEventRequest{} }
+func (m *DeleteFailedEventRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteFailedEventRequest) ProtoMessage()    {}
+func (*DeleteFailedEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{8}
+}
+func (m *DeleteFailedEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteFailedEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteFailedEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteFailedEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFailedEventRequest.Merge(m, src)
+}
+func (m *DeleteFailedEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteFailedEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFailedEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteFailedEventRequest proto.InternalMessageInfo
+
+func (m *DeleteFailedEventRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteFailedEventRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DeleteFailedEventResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteFailedEventResponse) Reset()         { *m = DeleteFailedEventResponse{} }
+func (m *DeleteFailedEventResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteFailedEventResponse) ProtoMessage()    {}
+func (*DeleteFailedEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{9}
+}
+func (m *DeleteFailedEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteFailedEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteFailedEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteFailedEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFailedEventResponse.Merge(m, src)
+}
+func (m *DeleteFailedEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteFailedEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFailedEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteFailedEventResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventRequest)(nil), "event.EventRequest")
 	proto.RegisterType((*EventResponse)(nil), "event.EventResponse")
 	proto.RegisterType((*ListWorkflowEventBindingsRequest)(nil), "event.ListWorkflowEventBindingsRequest")
+	proto.RegisterType((*FailedEvent)(nil), "event.FailedEvent")
+	proto.RegisterType((*FailedEventList)(nil), "event.FailedEventList")
+	proto.RegisterType((*ListFailedEventsRequest)(nil), "event.ListFailedEventsRequest")
+	proto.RegisterType((*ReplayFailedEventRequest)(nil), "event.ReplayFailedEventRequest")
+	proto.RegisterType((*ReplayFailedEventResponse)(nil), "event.ReplayFailedEventResponse")
+	proto.RegisterType((*DeleteFailedEventRequest)(nil), "event.DeleteFailedEventRequest")
+	proto.RegisterType((*DeleteFailedEventResponse)(nil), "event.DeleteFailedEventResponse")
 }
 
 func init() { proto.RegisterFile("pkg/apiclient/event/event.proto", fileDescriptor_d80a0d2509a47d1c) }
 
 var fileDescriptor_d80a0d2509a47d1c = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6b, 0x14, 0x31,
-	0x18, 0x25, 0x15, 0x95, 0x66, 0x5b, 0x84, 0xe8, 0x61, 0x5d, 0xca, 0xba, 0x0c, 0x82, 0x45, 0xd9,
-	0x84, 0xd9, 0x55, 0x11, 0xbd, 0x15, 0x15, 0x84, 0x8a, 0x30, 0x3d, 0x08, 0xbd, 0x68, 0x76, 0xf6,
-	0x73, 0x36, 0xee, 0x4c, 0x12, 0x93, 0x34, 0xa5, 0x94, 0x5e, 0xfc, 0x0b, 0xe2, 0x3f, 0xf1, 0x47,
-	0x78, 0x14, 0xc4, 0xab, 0xc8, 0xe2, 0x0f, 0x91, 0xc9, 0xce, 0xec, 0xcc, 0xa2, 0x62, 0xc1, 0xcb,
-	0x90, 0x79, 0xc9, 0xf7, 0xf2, 0xde, 0xf7, 0xf2, 0xe1, 0x1b, 0x7a, 0x9e, 0x31, 0xae, 0x45, 0x9a,
-	0x0b, 0x90, 0x8e, 0x81, 0x5f, 0x7d, 0xa9, 0x36, 0xca, 0x29, 0x72, 0x31, 0xfc, 0xf4, 0x76, 0x32,
-	0xa5, 0xb2, 0x1c, 0xca, 0xa3, 0x8c, 0x4b, 0xa9, 0x1c, 0x77, 0x42, 0x49, 0xbb, 0x3c, 0xd4, 0xbb,
-	0x3b, 0x7f, 0x60, 0xa9, 0x50, 0xe5, 0x6e, 0xc1, 0xd3, 0x99, 0x90, 0x60, 0x4e, 0x58, 0xc5, 0x6c,
-	0x59, 0x01, 0x8e, 0x33, 0x1f, 0xb3, 0x0c, 0x24, 0x18, 0xee, 0x60, 0x5a, 0x55, 0x3d, 0xcf, 0x84,
-	0x9b, 0x1d, 0x4d, 0x68, 0xaa, 0x0a, 0xc6, 0x4d, 0xa6, 0xb4, 0x51, 0x6f, 0xc3, 0x62, 0x78, 0xac,
-	0xcc, 0xfc, 0x4d, 0xae, 0x8e, 0x6d, 0x43, 0x52, 0x43, 0xcc, 0xc7, 0x3c, 0xd7, 0x33, 0xfe, 0x1b,
-	0x5d, 0xf4, 0x09, 0xe1, 0xad, 0x27, 0xa5, 0xd8, 0x04, 0xde, 0x1d, 0x81, 0x75, 0x64, 0x07, 0x6f,
-	0x4a, 0x5e, 0x80, 0xd5, 0x3c, 0x85, 0x2e, 0x1a, 0xa0, 0xdd, 0xcd, 0xa4, 0x01, 0xc8, 0x4d, 0xbc,
-	0x3d, 0x15, 0x36, 0x35, 0xa2, 0x10, 0x92, 0x3b, 0x65, 0xba, 0x1b, 0xe1, 0xc4, 0x3a, 0x48, 0x5e,
-	0xe3, 0xcb, 0x9a, 0x9f, 0xe4, 0x8a, 0x4f, 0xbb, 0x17, 0x06, 0x68, 0xb7, 0x33, 0x7a, 0x4a, 0x1b,
-	0xd5, 0xb4, 0x56, 0x1d, 0x16, 0xaf, 0x56, 0xaa, 0xa9, 0x1f, 0x53, 0x3d, 0xcf, 0x68, 0x29, 0x9c,
-	0xd6, 0x28, 0xad, 0x85, 0xd3, 0x67, 0x0e, 0x8a, 0xa4, 0xa6, 0x8d, 0xae, 0xe0, 0xed, 0x4a, 0xb5,
-	0xd5, 0x4a, 0x5a, 0x88, 0x3e, 0x22, 0x3c, 0xd8, 0x17, 0xd6, 0xbd, 0xac, 0x0a, 0xc3, 0xee, 0x9e,
-	0x90, 0x53, 0x21, 0x33, 0x7b, 0x3e, 0x6f, 0x07, 0xb8, 0x93, 0x0b, 0xeb, 0x5e, 0xe8, 0x10, 0x52,
-	0x70, 0xd6, 0x19, 0xc5, 0x74, 0x99, 0x12, 0x6d, 0xa7, 0xd4, 0xe8, 0x2c, 0x53, 0xa2, 0x3e, 0xa6,
-	0xfb, 0x4d, 0x61, 0xd2, 0x66, 0x19, 0x7d, 0xdf, 0xa8, 0xfa, 0x7b, 0x00, 0xc6, 0x8b, 0x14, 0x88,
-	0xc7, 0x5b, 0x09, 0xa4, 0x20, 0x3c, 0x04, 0x98, 0x5c, 0xa5, 0xcb, 0x87, 0xd3, 0x0e, 0xa1, 0x77,
-	0x6d, 0x1d, 0xac, 0x3c, 0x3e, 0x7a, 0xff, 0xf5, 0xe7, 0x87, 0x8d, 0x7b, 0xd1, 0xed, 0xf0, 0xa0,
-	0x7c, 0xbc, 0x7c, 0x72, 0x96, 0x9d, 0xae, 0x3c, 0x9c, 0xb1, 0xd3, 0xb5, 0x24, 0xce, 0x1e, 0xd6,
-	0x1d, 0x23, 0xdf, 0x10, 0xbe, 0xfe, 0xd7, 0x06, 0x91, 0x5b, 0xd5, 0x85, 0xff, 0x6a, 0x61, 0xef,
-	0xf0, 0xff, 0x93, 0xfc, 0x13, 0x7f, 0x79, 0x6f, 0x34, 0x0e, 0xfe, 0x86, 0xe4, 0x4e, 0xed, 0xaf,
-	0xae, 0x1d, 0x06, 0x71, 0xc3, 0x49, 0xa5, 0xa5, 0x6d, 0x78, 0xef, 0xf1, 0xe7, 0x45, 0x1f, 0x7d,
-	0x59, 0xf4, 0xd1, 0x8f, 0x45, 0x1f, 0x1d, 0xde, 0x3f, 0xff, 0x74, 0xb4, 0x87, 0x77, 0x72, 0x29,
-	0x4c, 0xc3, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x7f, 0xc9, 0x38, 0xda, 0x03, 0x00,
-	0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x95, 0x8e, 0x6e, 0xaa, 0xbb, 0x69, 0x9b, 0x99, 0x46, 0x56, 0xa6, 0xae, 0x04, 0xa4,
+	0x75, 0x45, 0x75, 0xd4, 0x96, 0xd7, 0xed, 0xc4, 0x34, 0x26, 0x98, 0x86, 0x90, 0x32, 0x24, 0xa4,
+	0x5d, 0xc0, 0x4b, 0xbd, 0xcc, 0x34, 0x89, 0x43, 0xec, 0x66, 0x9a, 0xa6, 0x5d, 0xb8, 0x70, 0x46,
+	0x88, 0x6f, 0xc2, 0x87, 0xe0, 0x88, 0x84, 0x38, 0x71, 0x41, 0x13, 0xdf, 0x80, 0x2f, 0x80, 0xe2,
+	0x3a, 0x6d, 0xba, 0xb6, 0x5a, 0x25, 0xe0, 0x12, 0x39, 0xf6, 0xf3, 0xf2, 0x7b, 0xfc, 0xfc, 0x6d,
+	0x83, 0x95, 0xa0, 0xe5, 0x98, 0x38, 0xa0, 0xb6, 0x4b, 0x89, 0x2f, 0x4c, 0x12, 0x75, 0xbf, 0x28,
+	0x08, 0x99, 0x60, 0x30, 0x2b, 0x7f, 0x0a, 0xcb, 0x0e, 0x63, 0x8e, 0x4b, 0x62, 0x53, 0x13, 0xfb,
+	0x3e, 0x13, 0x58, 0x50, 0xe6, 0xf3, 0x8e, 0x51, 0xe1, 0x4e, 0xeb, 0x01, 0x47, 0x94, 0xc5, 0xab,
+	0x1e, 0xb6, 0x8f, 0xa8, 0x4f, 0xc2, 0x13, 0x53, 0x45, 0xe6, 0xa6, 0x47, 0x04, 0x36, 0xa3, 0x9a,
+	0xe9, 0x10, 0x9f, 0x84, 0x58, 0x90, 0xa6, 0xf2, 0x7a, 0xe6, 0x50, 0x71, 0xd4, 0x3e, 0x40, 0x36,
+	0xf3, 0x4c, 0x1c, 0x3a, 0x2c, 0x08, 0xd9, 0x1b, 0x39, 0xa8, 0x1e, 0xb3, 0xb0, 0x75, 0xe8, 0xb2,
+	0x63, 0xde, 0x0b, 0x92, 0x4c, 0x99, 0x51, 0x0d, 0xbb, 0xc1, 0x11, 0x1e, 0x08, 0x67, 0x7c, 0xd6,
+	0xc0, 0xf4, 0xe3, 0x18, 0xd6, 0x22, 0x6f, 0xdb, 0x84, 0x0b, 0xb8, 0x0c, 0x72, 0x3e, 0xf6, 0x08,
+	0x0f, 0xb0, 0x4d, 0x74, 0xad, 0xa4, 0x95, 0x73, 0x56, 0x6f, 0x02, 0xde, 0x02, 0x33, 0x4d, 0xca,
+	0xed, 0x90, 0x7a, 0xd4, 0xc7, 0x82, 0x85, 0x7a, 0x46, 0x5a, 0xf4, 0x4f, 0xc2, 0xd7, 0x60, 0x2a,
+	0xc0, 0x27, 0x2e, 0xc3, 0x4d, 0x7d, 0xa2, 0xa4, 0x95, 0xf3, 0xf5, 0x6d, 0xd4, 0xa3, 0x46, 0x09,
+	0xb5, 0x1c, 0xbc, 0xea, 0x52, 0xa3, 0xa8, 0x81, 0x82, 0x96, 0x83, 0x62, 0x70, 0x94, 0xcc, 0xa2,
+	0x04, 0x1c, 0x3d, 0x15, 0xc4, 0xb3, 0x92, 0xb0, 0xc6, 0x2c, 0x98, 0x51, 0xd4, 0x3c, 0x60, 0x3e,
+	0x27, 0xc6, 0x27, 0x0d, 0x94, 0x76, 0x29, 0x17, 0x2f, 0x95, 0xa3, 0x5c, 0xdd, 0xa4, 0x7e, 0x93,
+	0xfa, 0x0e, 0x1f, 0xaf, 0xb6, 0x3d, 0x90, 0x77, 0x29, 0x17, 0xcf, 0x03, 0xd9, 0x24, 0x59, 0x59,
+	0xbe, 0x5e, 0x43, 0x9d, 0x2e, 0xa1, 0x74, 0x97, 0x7a, 0x9c, 0x71, 0x97, 0x50, 0x54, 0x43, 0xbb,
+	0x3d, 0x47, 0x2b, 0x1d, 0xc5, 0xf8, 0x91, 0x01, 0xf9, 0x6d, 0x4c, 0x5d, 0xd2, 0x94, 0x44, 0x70,
+	0x0e, 0x4c, 0xb4, 0x69, 0x53, 0x25, 0x8f, 0x87, 0xfd, 0x50, 0x99, 0x8b, 0x50, 0x75, 0xb0, 0x70,
+	0x3c, 0xa4, 0x24, 0xb9, 0xaf, 0x39, 0x6b, 0xe8, 0xda, 0x60, 0x93, 0xae, 0x5c, 0xd2, 0xa4, 0xec,
+	0x7f, 0x69, 0x12, 0x5c, 0x04, 0x93, 0x21, 0xc1, 0x9c, 0xf9, 0xfa, 0xa4, 0x04, 0x50, 0x7f, 0xf0,
+	0x09, 0xc8, 0xd9, 0x21, 0x89, 0x45, 0xf8, 0x48, 0xe8, 0x53, 0x32, 0x77, 0x65, 0xbc, 0x6d, 0x7e,
+	0x41, 0x3d, 0x62, 0xf5, 0x9c, 0x8d, 0x0d, 0x30, 0x9b, 0xda, 0xdc, 0xb8, 0x09, 0xb0, 0x0c, 0xb2,
+	0x54, 0x10, 0x8f, 0xeb, 0x5a, 0x69, 0xa2, 0x9c, 0xaf, 0x43, 0xd4, 0x39, 0x97, 0x29, 0x33, 0xab,
+	0x63, 0x60, 0xdc, 0x07, 0xd7, 0x62, 0x8f, 0xd4, 0xca, 0x78, 0x42, 0x31, 0x76, 0x80, 0x6e, 0x91,
+	0xc0, 0xc5, 0x27, 0xe9, 0xa0, 0x63, 0x49, 0x4c, 0x75, 0x3f, 0xd3, 0xed, 0xbe, 0x71, 0x1d, 0x2c,
+	0x0d, 0x89, 0xa5, 0x44, 0xbd, 0x03, 0xf4, 0x2d, 0xe2, 0x12, 0x41, 0xfe, 0x4d, 0xa2, 0x21, 0xb1,
+	0x3a, 0x89, 0xea, 0xbf, 0xb3, 0xea, 0x16, 0xd8, 0x23, 0x61, 0x44, 0x6d, 0x02, 0x23, 0x30, 0x6d,
+	0x11, 0x9b, 0xd0, 0x88, 0x74, 0x64, 0x7b, 0x55, 0x6d, 0x63, 0x1a, 0xa1, 0xb0, 0xd0, 0x3f, 0xa9,
+	0xa0, 0x37, 0xde, 0x7d, 0xfb, 0xf5, 0x31, 0x73, 0xd7, 0xa8, 0xc8, 0x6b, 0x2f, 0xaa, 0x75, 0x2e,
+	0x46, 0x6e, 0x9e, 0x76, 0xe9, 0xce, 0xcc, 0xd3, 0x3e, 0x29, 0x9e, 0xad, 0x77, 0x25, 0xf3, 0x5d,
+	0x03, 0x4b, 0x23, 0x8f, 0x31, 0x5c, 0x55, 0x09, 0x2f, 0x3b, 0xe8, 0x85, 0xfd, 0xbf, 0x97, 0xf2,
+	0xb0, 0xf8, 0x71, 0x5e, 0xa3, 0x21, 0xeb, 0xab, 0xc2, 0xdb, 0x49, 0x7d, 0x89, 0x6f, 0x55, 0xc2,
+	0x55, 0x0f, 0x14, 0x4b, 0xba, 0x60, 0xd8, 0x06, 0x73, 0x17, 0xb5, 0x06, 0x8b, 0xa9, 0x6a, 0x86,
+	0x88, 0xb0, 0xb0, 0x38, 0x28, 0x5d, 0x09, 0xb0, 0x26, 0x01, 0x6e, 0xc2, 0x1b, 0x09, 0xc0, 0xa1,
+	0x34, 0xa8, 0x0e, 0xee, 0x33, 0xfc, 0xa0, 0x81, 0xf9, 0x01, 0x79, 0xc1, 0x15, 0x15, 0x78, 0x94,
+	0x88, 0x0b, 0xa5, 0xd1, 0x06, 0xaa, 0xc9, 0x0f, 0x25, 0x43, 0xa3, 0x80, 0x2e, 0x65, 0x30, 0x4f,
+	0xdb, 0xb4, 0x79, 0x66, 0x86, 0x32, 0xd4, 0xba, 0x56, 0x81, 0xef, 0x35, 0x30, 0x3f, 0xa0, 0xc4,
+	0x2e, 0xd3, 0x28, 0xbd, 0x77, 0x99, 0x46, 0x8a, 0xd8, 0x30, 0x25, 0xd3, 0x5a, 0x65, 0x75, 0x4c,
+	0xa6, 0xcd, 0xad, 0x2f, 0xe7, 0x45, 0xed, 0xeb, 0x79, 0x51, 0xfb, 0x79, 0x5e, 0xd4, 0xf6, 0xef,
+	0x8d, 0xff, 0xb0, 0xa6, 0xdf, 0xfd, 0x83, 0x49, 0xf9, 0x90, 0x36, 0xfe, 0x04, 0x00, 0x00, 0xff,
+	0xff, 0x54, 0x7b, 0x1f, 0x2c, 0x15, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -251,6 +655,9 @@ const _ = grpc.SupportPackageIsVersion4
 type EventServiceClient interface {
 	ReceiveEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	ListWorkflowEventBindings(ctx context.Context, in *ListWorkflowEventBindingsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowEventBindingList, error)
+	ListFailedEvents(ctx context.Context, in *ListFailedEventsRequest, opts ...grpc.CallOption) (*FailedEventList, error)
+	ReplayFailedEvent(ctx context.Context, in *ReplayFailedEventRequest, opts ...grpc.CallOption) (*ReplayFailedEventResponse, error)
+	DeleteFailedEvent(ctx context.Context, in *DeleteFailedEventRequest, opts ...grpc.CallOption) (*DeleteFailedEventResponse, error)
 }
 
 type eventServiceClient struct {
@@ -279,22 +686,61 @@ func (c *eventServiceClient) ListWorkflowEventBindings(ctx context.Context, in *
 	return out, nil
 }
 
-// EventServiceServer is the server API for EventService service.
-type EventServiceServer interface {
-	ReceiveEvent(context.Context, *EventRequest) (*EventResponse, error)
-	ListWorkflowEventBindings(context.Context, *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error)
+func (c *eventServiceClient) ListFailedEvents(ctx context.Context, in *ListFailedEventsRequest, opts ...grpc.CallOption) (*FailedEventList, error) {
+	out := new(FailedEventList)
+	err := c.cc.Invoke(ctx, "/event.EventService/ListFailedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedEventServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedEventServiceServer struct {
+func (c *eventServiceClient) ReplayFailedEvent(ctx context.Context, in *ReplayFailedEventRequest, opts ...grpc.CallOption) (*ReplayFailedEventResponse, error) {
+	out := new(ReplayFailedEventResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/ReplayFailedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedEventServiceServer) ReceiveEvent(ctx context.Context, req *EventRequest) (*EventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReceiveEvent not implemented")
-}
+func (c *eventServiceClient) DeleteFailedEvent(ctx context.Context, in *DeleteFailedEventRequest, opts ...grpc.CallOption) (*DeleteFailedEventResponse, error) {
+	out := new(DeleteFailedEventResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/DeleteFailedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+type EventServiceServer interface {
+	ReceiveEvent(context.Context, *EventRequest) (*EventResponse, error)
+	ListWorkflowEventBindings(context.Context, *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error)
+	ListFailedEvents(context.Context, *ListFailedEventsRequest) (*FailedEventList, error)
+	ReplayFailedEvent(context.Context, *ReplayFailedEventRequest) (*ReplayFailedEventResponse, error)
+	DeleteFailedEvent(context.Context, *DeleteFailedEventRequest) (*DeleteFailedEventResponse, error)
+}
+
+// UnimplementedEventServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEventServiceServer struct {
+}
+
+func (*UnimplementedEventServiceServer) ReceiveEvent(ctx context.Context, req *EventRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveEvent not implemented")
+}
 func (*UnimplementedEventServiceServer) ListWorkflowEventBindings(ctx context.Context, req *ListWorkflowEventBindingsRequest) (*v1alpha1.WorkflowEventBindingList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowEventBindings not implemented")
 }
+func (*UnimplementedEventServiceServer) ListFailedEvents(ctx context.Context, req *ListFailedEventsRequest) (*FailedEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedEvents not implemented")
+}
+func (*UnimplementedEventServiceServer) ReplayFailedEvent(ctx context.Context, req *ReplayFailedEventRequest) (*ReplayFailedEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFailedEvent not implemented")
+}
+func (*UnimplementedEventServiceServer) DeleteFailedEvent(ctx context.Context, req *DeleteFailedEventRequest) (*DeleteFailedEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFailedEvent not implemented")
+}
 
 func RegisterEventServiceServer(s *grpc.Server, srv EventServiceServer) {
 	s.RegisterService(&_EventService_serviceDesc, srv)
@@ -336,6 +782,60 @@ func _EventService_ListWorkflowEventBindings_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ListFailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListFailedEvents(ctx, req.(*ListFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReplayFailedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayFailedEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReplayFailedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ReplayFailedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReplayFailedEvent(ctx, req.(*ReplayFailedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteFailedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFailedEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteFailedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/DeleteFailedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteFailedEvent(ctx, req.(*DeleteFailedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EventService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "event.EventService",
 	HandlerType: (*EventServiceServer)(nil),
@@ -348,6 +848,18 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowEventBindings",
 			Handler:    _EventService_ListWorkflowEventBindings_Handler,
 		},
+		{
+			MethodName: "ListFailedEvents",
+			Handler:    _EventService_ListFailedEvents_Handler,
+		},
+		{
+			MethodName: "ReplayFailedEvent",
+			Handler:    _EventService_ReplayFailedEvent_Handler,
+		},
+		{
+			MethodName: "DeleteFailedEvent",
+			Handler:    _EventService_DeleteFailedEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/event/event.proto",
@@ -461,98 +973,1222 @@ func (m *ListWorkflowEventBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		{
 			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Discriminator) > 0 {
+		i -= len(m.Discriminator)
+		copy(dAtA[i:], m.Discriminator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Discriminator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowEventBinding) > 0 {
+		i -= len(m.WorkflowEventBinding)
+		copy(dAtA[i:], m.WorkflowEventBinding)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WorkflowEventBinding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedEventList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedEventList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedEventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFailedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFailedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFailedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayFailedEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayFailedEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayFailedEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayFailedEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayFailedEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayFailedEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteFailedEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFailedEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteFailedEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteFailedEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFailedEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteFailedEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListWorkflowEventBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.WorkflowEventBinding)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Discriminator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailedEventList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFailedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayFailedEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayFailedEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFailedEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFailedEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowEventBinding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowEventBinding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &v1alpha1.Item{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedEventList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedEventList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedEventList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &FailedEvent{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Discriminator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *EventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
 
-func (m *ListWorkflowEventBindingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFailedEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -575,10 +2211,10 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFailedEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFailedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -613,9 +2249,60 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayFailedEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayFailedEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayFailedEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discriminator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,13 +2330,13 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Discriminator = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -659,27 +2346,23 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &v1alpha1.Item{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -703,7 +2386,7 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplayFailedEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,10 +2409,10 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayFailedEventResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayFailedEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -754,7 +2437,7 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteFailedEventRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,10 +2460,10 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteFailedEventRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWorkflowEventBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteFailedEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -817,9 +2500,9 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -829,28 +2512,75 @@ func (m *ListWorkflowEventBindingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteFailedEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteFailedEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteFailedEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -27,6 +27,16 @@ func (h ArchivedWorkflowsServiceClient) DeleteArchivedWorkflow(_ context.Context
 	return out, h.Delete(in, out, "/api/v1/archived-workflows/{uid}")
 }
 
+func (h ArchivedWorkflowsServiceClient) CreateArchivedWorkflow(_ context.Context, in *workflowarchivepkg.CreateArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Post(in, out, "/api/v1/archived-workflows")
+}
+
+func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(_ context.Context, in *workflowarchivepkg.ResubmitArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/resubmit")
+}
+
 func (h ArchivedWorkflowsServiceClient) DeleteClusterWorkflowTemplate(_ context.Context, in *clusterworkflowtemplate.ClusterWorkflowTemplateDeleteRequest, _ ...grpc.CallOption) (*clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse, error) {
 	out := &clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse{}
 	return out, h.Delete(in, out, "/api/v1/cluster-workflow-templates/{name}")
@@ -46,3 +56,8 @@ func (h ArchivedWorkflowsServiceClient) ListArchivedWorkflowLabelValues(_ contex
 	out := &wfv1.LabelValues{}
 	return out, h.Get(in, out, "/api/v1/archived-workflows-label-values")
 }
+
+func (h ArchivedWorkflowsServiceClient) GetArchivedWorkflowTemplateStats(_ context.Context, in *workflowarchivepkg.GetArchivedWorkflowTemplateStatsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowTemplateStats, error) {
+	out := &workflowarchivepkg.ArchivedWorkflowTemplateStats{}
+	return out, h.Get(in, out, "/api/v1/archived-workflows-template-stats")
+}
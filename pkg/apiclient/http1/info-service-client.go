@@ -25,3 +25,8 @@ func (h InfoServiceClient) GetUserInfo(_ context.Context, in *infopkg.GetUserInf
 	out := &infopkg.GetUserInfoResponse{}
 	return out, h.Get(in, out, "/api/v1/userinfo")
 }
+
+func (h InfoServiceClient) GetRBACRule(_ context.Context, in *infopkg.GetRBACRuleRequest, _ ...grpc.CallOption) (*infopkg.GetRBACRuleResponse, error) {
+	out := &infopkg.GetRBACRuleResponse{}
+	return out, h.Get(in, out, "/api/v1/userinfo/rbac-rule")
+}
@@ -0,0 +1,21 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
+)
+
+type PluginServiceClient = Facade
+
+func (h PluginServiceClient) ListPlugins(_ context.Context, in *pluginpkg.ListPluginsRequest, _ ...grpc.CallOption) (*pluginpkg.InstalledPluginList, error) {
+	out := &pluginpkg.InstalledPluginList{}
+	return out, h.Get(in, out, "/api/v1/plugins/{namespace}")
+}
+
+func (h PluginServiceClient) GetPlugin(_ context.Context, in *pluginpkg.GetPluginRequest, _ ...grpc.CallOption) (*pluginpkg.InstalledPlugin, error) {
+	out := &pluginpkg.InstalledPlugin{}
+	return out, h.Get(in, out, "/api/v1/plugins/{namespace}/{name}")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -21,11 +22,26 @@ func (h WorkflowServiceClient) GetWorkflow(_ context.Context, in *workflowpkg.Wo
 	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}")
 }
 
+func (h WorkflowServiceClient) DiffWorkflow(_ context.Context, in *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	out := &workflowpkg.WorkflowDiffResponse{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/diff")
+}
+
 func (h WorkflowServiceClient) ListWorkflows(_ context.Context, in *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*wfv1.WorkflowList, error) {
 	out := &wfv1.WorkflowList{}
 	return out, h.Get(in, out, "/api/v1/workflows/{namespace}")
 }
 
+func (h WorkflowServiceClient) GetWorkflowCosts(_ context.Context, in *workflowpkg.WorkflowCostsRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCostsResponse, error) {
+	out := &workflowpkg.WorkflowCostsResponse{}
+	return out, h.Get(in, out, "/api/v1/workflow-costs/{namespace}")
+}
+
+func (h WorkflowServiceClient) GetWorkflowSnapshot(_ context.Context, in *workflowpkg.WorkflowSnapshotRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowSnapshot, error) {
+	out := &workflowpkg.WorkflowSnapshot{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/snapshot")
+}
+
 func (h WorkflowServiceClient) WatchWorkflows(ctx context.Context, in *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	reader, err := h.EventStreamReader(in, "/api/v1/workflow-events/{namespace}")
 	if err != nil {
@@ -47,6 +63,11 @@ func (h WorkflowServiceClient) DeleteWorkflow(_ context.Context, in *workflowpkg
 	return out, h.Delete(in, out, "/api/v1/workflows/{namespace}/{name}")
 }
 
+func (h WorkflowServiceClient) BulkWorkflows(_ context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/bulk")
+}
+
 func (h WorkflowServiceClient) RetryWorkflow(_ context.Context, in *workflowpkg.WorkflowRetryRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/{name}/retry")
@@ -62,6 +83,16 @@ func (h WorkflowServiceClient) ResumeWorkflow(_ context.Context, in *workflowpkg
 	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/{name}/resume")
 }
 
+func (h WorkflowServiceClient) ApproveWorkflow(_ context.Context, in *workflowpkg.WorkflowApproveRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/{name}/approve")
+}
+
+func (h WorkflowServiceClient) RejectWorkflow(_ context.Context, in *workflowpkg.WorkflowRejectRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/{name}/reject")
+}
+
 func (h WorkflowServiceClient) SuspendWorkflow(_ context.Context, in *workflowpkg.WorkflowSuspendRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(in, out, "/api/v1/workflows/{namespace}/{name}/suspend")
@@ -107,3 +138,23 @@ func (h WorkflowServiceClient) SubmitWorkflow(_ context.Context, in *workflowpkg
 	out := &wfv1.Workflow{}
 	return out, h.Post(in, out, "/api/v1/workflows/{namespace}/submit")
 }
+
+func (h WorkflowServiceClient) GetWorkflowNodePod(_ context.Context, in *workflowpkg.WorkflowNodePodRequest, _ ...grpc.CallOption) (*corev1.Pod, error) {
+	out := &corev1.Pod{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/nodes/{nodeId}/pod")
+}
+
+func (h WorkflowServiceClient) ExplainWorkflowNode(_ context.Context, in *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	out := &workflowpkg.WorkflowExplainResponse{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/explain")
+}
+
+func (h WorkflowServiceClient) GetWorkflowTimeline(_ context.Context, in *workflowpkg.WorkflowTimelineRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowTimeline, error) {
+	out := &workflowpkg.WorkflowTimeline{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/timeline")
+}
+
+func (h WorkflowServiceClient) RenderWorkflow(_ context.Context, in *workflowpkg.WorkflowRenderRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Post(in, out, "/api/v1/workflows/{namespace}/render")
+}
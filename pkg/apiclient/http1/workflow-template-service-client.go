@@ -40,3 +40,13 @@ func (h WorkflowTemplateServiceClient) LintWorkflowTemplate(_ context.Context, i
 	out := &wfv1.WorkflowTemplate{}
 	return out, h.Post(in, out, "/api/v1/workflow-templates/{namespace}/lint")
 }
+
+func (h WorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(_ context.Context, in *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplateList, error) {
+	out := &wfv1.WorkflowTemplateList{}
+	return out, h.Get(in, out, "/api/v1/workflow-templates/{namespace}/{name}/revisions")
+}
+
+func (h WorkflowTemplateServiceClient) RollbackWorkflowTemplate(_ context.Context, in *workflowtemplatepkg.WorkflowTemplateRollbackRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	out := &wfv1.WorkflowTemplate{}
+	return out, h.Put(in, out, "/api/v1/workflow-templates/{namespace}/{name}/rollback")
+}
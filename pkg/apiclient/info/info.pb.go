@@ -304,6 +304,169 @@ func (m *GetUserInfoResponse) GetServiceAccountName() string {
 	return ""
 }
 
+type GetRBACRuleRequest struct {
+	// the namespace of the request, if empty only the rules that apply to all namespaces are tried
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the claims to try, if none of groups, email, or subject are set, then the caller's own claims are used
+	Groups               []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Subject              string   `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRBACRuleRequest) Reset()         { *m = GetRBACRuleRequest{} }
+func (m *GetRBACRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRBACRuleRequest) ProtoMessage()    {}
+func (*GetRBACRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96940c93018255fa, []int{5}
+}
+func (m *GetRBACRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRBACRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRBACRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRBACRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRBACRuleRequest.Merge(m, src)
+}
+func (m *GetRBACRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRBACRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRBACRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRBACRuleRequest proto.InternalMessageInfo
+
+func (m *GetRBACRuleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetRBACRuleRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *GetRBACRuleRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GetRBACRuleRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+// GetRBACRuleResponse is the SSO RBAC rule that matched
+type GetRBACRuleResponse struct {
+	Rule                    string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Precedence              int32  `protobuf:"varint,2,opt,name=precedence,proto3" json:"precedence,omitempty"`
+	ServiceAccountNamespace string `protobuf:"bytes,3,opt,name=serviceAccountNamespace,proto3" json:"serviceAccountNamespace,omitempty"`
+	ServiceAccountName      string `protobuf:"bytes,4,opt,name=serviceAccountName,proto3" json:"serviceAccountName,omitempty"`
+	// whether users that match the rule can only make calls that do not change anything
+	ReadOnly bool `protobuf:"varint,5,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	// the only actions on workflows, e.g. "resume", that users that match the rule may perform, if it restricts them
+	WorkflowActions      []string `protobuf:"bytes,6,rep,name=workflowActions,proto3" json:"workflowActions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRBACRuleResponse) Reset()         { *m = GetRBACRuleResponse{} }
+func (m *GetRBACRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRBACRuleResponse) ProtoMessage()    {}
+func (*GetRBACRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96940c93018255fa, []int{6}
+}
+func (m *GetRBACRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRBACRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRBACRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRBACRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRBACRuleResponse.Merge(m, src)
+}
+func (m *GetRBACRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRBACRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRBACRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRBACRuleResponse proto.InternalMessageInfo
+
+func (m *GetRBACRuleResponse) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *GetRBACRuleResponse) GetPrecedence() int32 {
+	if m != nil {
+		return m.Precedence
+	}
+	return 0
+}
+
+func (m *GetRBACRuleResponse) GetServiceAccountNamespace() string {
+	if m != nil {
+		return m.ServiceAccountNamespace
+	}
+	return ""
+}
+
+func (m *GetRBACRuleResponse) GetServiceAccountName() string {
+	if m != nil {
+		return m.ServiceAccountName
+	}
+	return ""
+}
+
+func (m *GetRBACRuleResponse) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *GetRBACRuleResponse) GetWorkflowActions() []string {
+	if m != nil {
+		return m.WorkflowActions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetInfoRequest)(nil), "info.GetInfoRequest")
 	proto.RegisterType((*InfoResponse)(nil), "info.InfoResponse")
@@ -311,49 +474,60 @@ func init() {
 	proto.RegisterType((*GetVersionRequest)(nil), "info.GetVersionRequest")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "info.GetUserInfoRequest")
 	proto.RegisterType((*GetUserInfoResponse)(nil), "info.GetUserInfoResponse")
+	proto.RegisterType((*GetRBACRuleRequest)(nil), "info.GetRBACRuleRequest")
+	proto.RegisterType((*GetRBACRuleResponse)(nil), "info.GetRBACRuleResponse")
 }
 
 func init() { proto.RegisterFile("pkg/apiclient/info/info.proto", fileDescriptor_96940c93018255fa) }
 
 var fileDescriptor_96940c93018255fa = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xdd, 0x36, 0x6d, 0x37, 0xa5, 0xa4, 0xdb, 0x88, 0x1a, 0x0b, 0xa2, 0x2a, 0xe2, 0x50,
-	0x21, 0xb1, 0x56, 0x5a, 0x81, 0x80, 0x1b, 0x54, 0x10, 0x45, 0xa2, 0x1c, 0x8c, 0xe8, 0x01, 0x55,
-	0x42, 0x1b, 0x67, 0xe2, 0x6e, 0xed, 0xec, 0x9a, 0xdd, 0xb5, 0xab, 0x5e, 0xb9, 0x71, 0xe6, 0x23,
-	0xf8, 0x0f, 0x4e, 0x48, 0x5c, 0x90, 0xf8, 0x01, 0x14, 0xf1, 0x21, 0xc8, 0xeb, 0x75, 0x9a, 0xd0,
-	0x1e, 0x90, 0xb8, 0x58, 0x33, 0xb3, 0xb3, 0x6f, 0xde, 0xbc, 0x99, 0x35, 0xba, 0x9b, 0x25, 0x71,
-	0x40, 0x33, 0x16, 0xa5, 0x0c, 0xb8, 0x0e, 0x18, 0x1f, 0x0b, 0xf3, 0x21, 0x99, 0x14, 0x5a, 0xe0,
-	0xe5, 0xd2, 0xf6, 0xef, 0xc4, 0x42, 0xc4, 0x29, 0x94, 0x79, 0x01, 0xe5, 0x5c, 0x68, 0xaa, 0x99,
-	0xe0, 0xaa, 0xca, 0xf1, 0x8f, 0x62, 0xa6, 0x4f, 0xf3, 0x21, 0x89, 0xc4, 0x24, 0xa0, 0x32, 0x16,
-	0x99, 0x14, 0x67, 0xc6, 0x78, 0x70, 0x2e, 0x64, 0x32, 0x4e, 0xc5, 0xb9, 0x0a, 0x6c, 0x15, 0x15,
-	0xd4, 0xa1, 0xa0, 0xe8, 0xd1, 0x34, 0x3b, 0xa5, 0xbd, 0x20, 0x06, 0x0e, 0x92, 0x6a, 0x18, 0x55,
-	0x70, 0xdd, 0x16, 0xda, 0xec, 0x83, 0x1e, 0xf0, 0xb1, 0x08, 0xe1, 0x43, 0x0e, 0x4a, 0x77, 0xbf,
-	0xb8, 0x68, 0xa3, 0xf2, 0x55, 0x26, 0xb8, 0x02, 0x7c, 0x1f, 0xb5, 0x26, 0x94, 0xd3, 0x18, 0x46,
-	0xaf, 0xe9, 0x04, 0x54, 0x46, 0x23, 0xf0, 0x9c, 0x5d, 0x67, 0x6f, 0x3d, 0xbc, 0x12, 0xc7, 0x27,
-	0x68, 0x25, 0x65, 0x3c, 0x51, 0x9e, 0xbb, 0xbb, 0xb4, 0xd7, 0xdc, 0x7f, 0x49, 0x2e, 0xd9, 0x92,
-	0x9a, 0xad, 0x31, 0xde, 0xcf, 0xd8, 0x92, 0xe2, 0x80, 0x64, 0x49, 0x4c, 0x4a, 0xc2, 0xa4, 0x8e,
-	0x92, 0x9a, 0x30, 0x79, 0xc5, 0x78, 0x12, 0x56, 0xa0, 0xf8, 0x11, 0x6a, 0x4c, 0xc4, 0x88, 0xa6,
-	0xca, 0x5b, 0x32, 0xf0, 0x1d, 0x62, 0xc4, 0x9b, 0x67, 0x4b, 0x8e, 0x4c, 0xc2, 0x0b, 0xae, 0xe5,
-	0x45, 0x68, 0xb3, 0xb1, 0x8f, 0xd6, 0x38, 0x2d, 0x0e, 0x45, 0x2a, 0xa4, 0xb7, 0x6c, 0x98, 0xcf,
-	0x7c, 0xff, 0x09, 0x6a, 0xce, 0x5d, 0xc1, 0x2d, 0xb4, 0x94, 0xc0, 0x85, 0xed, 0xaf, 0x34, 0x71,
-	0x1b, 0xad, 0x14, 0x34, 0xcd, 0xc1, 0x73, 0x77, 0x9d, 0xbd, 0xb5, 0xb0, 0x72, 0x9e, 0xba, 0x8f,
-	0x9d, 0xee, 0x36, 0xda, 0xea, 0x83, 0x3e, 0x06, 0xa9, 0x98, 0xe0, 0xb5, 0x7c, 0x6d, 0x84, 0xfb,
-	0xa0, 0xdf, 0x2a, 0x90, 0xf3, 0xa2, 0x7e, 0x77, 0xd0, 0xf6, 0x42, 0xd8, 0x6a, 0x7b, 0x0b, 0x35,
-	0x98, 0x52, 0x39, 0x48, 0x5b, 0xd1, 0x7a, 0xd8, 0x43, 0xab, 0x2a, 0x1f, 0x9e, 0x41, 0xa4, 0x4d,
-	0xd9, 0xf5, 0xb0, 0x76, 0xcb, 0x1b, 0xb1, 0x14, 0x79, 0x56, 0x69, 0xb0, 0x1e, 0x5a, 0xaf, 0xa4,
-	0x09, 0x13, 0xca, 0x52, 0xdb, 0x60, 0xe5, 0xe0, 0x7b, 0xe8, 0x86, 0x31, 0x8e, 0x41, 0xb2, 0x31,
-	0x83, 0x91, 0xb7, 0x62, 0x9a, 0x58, 0x0c, 0x62, 0x82, 0xb0, 0x02, 0x59, 0xb0, 0x08, 0x9e, 0x45,
-	0x91, 0xc8, 0xb9, 0x2e, 0x07, 0xea, 0x35, 0x0c, 0xd0, 0x35, 0x27, 0xfb, 0x5f, 0x5d, 0xd4, 0x2c,
-	0xdb, 0x78, 0x53, 0x1d, 0xe1, 0x01, 0x5a, 0xb5, 0x4b, 0x84, 0xdb, 0xd5, 0x48, 0x16, 0x77, 0xca,
-	0xc7, 0x57, 0x07, 0xd5, 0x6d, 0x7f, 0xfc, 0xf9, 0xfb, 0xb3, 0xbb, 0x89, 0x37, 0xcc, 0xa2, 0x17,
-	0x3d, 0xf3, 0x10, 0xf0, 0x27, 0x07, 0xa1, 0x4b, 0x51, 0xf1, 0xce, 0x0c, 0x6e, 0x51, 0x66, 0x7f,
-	0xf0, 0xff, 0x9b, 0x65, 0x11, 0xbb, 0x3b, 0x86, 0xc8, 0x16, 0xbe, 0x59, 0x13, 0x29, 0x6c, 0xf1,
-	0x13, 0xd4, 0x9c, 0x9b, 0x19, 0xf6, 0x66, 0x5c, 0xfe, 0x9a, 0xae, 0x7f, 0xfb, 0x9a, 0x13, 0xdb,
-	0xa5, 0x67, 0xc0, 0x31, 0x6e, 0xd5, 0xe0, 0xb9, 0x02, 0x59, 0x66, 0x3f, 0x3f, 0xfc, 0x36, 0xed,
-	0x38, 0x3f, 0xa6, 0x1d, 0xe7, 0xd7, 0xb4, 0xe3, 0xbc, 0x7b, 0xf8, 0xef, 0xcf, 0x7a, 0xee, 0xe7,
-	0x31, 0x6c, 0x98, 0x57, 0x7c, 0xf0, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xf1, 0xc6, 0xc3, 0x59,
-	0x04, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0x47, 0xfe, 0x97, 0x78, 0x9c, 0x4d, 0x9c, 0x17, 0xb3, 0xd1, 0x8a, 0xac, 0x09, 0x62, 0x0f,
+	0x66, 0x21, 0x12, 0x49, 0xd8, 0x25, 0xbb, 0xb7, 0x24, 0xec, 0x86, 0x40, 0xd3, 0x82, 0x4a, 0x73,
+	0x28, 0x81, 0x32, 0x96, 0x9f, 0x15, 0xc5, 0xf2, 0x8c, 0x3a, 0x23, 0x39, 0xa4, 0xc7, 0xde, 0x7a,
+	0xee, 0x87, 0x28, 0xf4, 0x93, 0x14, 0x7a, 0x29, 0xf4, 0x0b, 0x94, 0xd0, 0x2f, 0xd1, 0x5b, 0xd1,
+	0x68, 0x64, 0xcb, 0x4e, 0x02, 0x85, 0x5e, 0xcc, 0x7b, 0x4f, 0xa3, 0xdf, 0xfb, 0xbd, 0x9f, 0x7e,
+	0x6f, 0x4c, 0x7e, 0x8f, 0x47, 0x81, 0x4b, 0xe3, 0xd0, 0x8f, 0x42, 0x64, 0x89, 0x1b, 0xb2, 0x21,
+	0x57, 0x3f, 0x4e, 0x2c, 0x78, 0xc2, 0xa1, 0x96, 0xc5, 0xd6, 0x56, 0xc0, 0x79, 0x10, 0x61, 0x76,
+	0xce, 0xa5, 0x8c, 0xf1, 0x84, 0x26, 0x21, 0x67, 0x32, 0x3f, 0x63, 0x9d, 0x05, 0x61, 0x72, 0x99,
+	0xf6, 0x1d, 0x9f, 0x8f, 0x5d, 0x2a, 0x02, 0x1e, 0x0b, 0x7e, 0xa5, 0x82, 0x9d, 0x6b, 0x2e, 0x46,
+	0xc3, 0x88, 0x5f, 0x4b, 0x57, 0x77, 0x91, 0x6e, 0x51, 0x72, 0x27, 0xbb, 0x34, 0x8a, 0x2f, 0xe9,
+	0xae, 0x1b, 0x20, 0x43, 0x41, 0x13, 0x1c, 0xe4, 0x70, 0x76, 0x9b, 0xac, 0x9e, 0x60, 0x72, 0xca,
+	0x86, 0xdc, 0xc3, 0x97, 0x29, 0xca, 0xc4, 0x7e, 0x57, 0x21, 0x2b, 0x79, 0x2e, 0x63, 0xce, 0x24,
+	0xc2, 0x9f, 0xa4, 0x3d, 0xa6, 0x8c, 0x06, 0x38, 0x78, 0x4c, 0xc7, 0x28, 0x63, 0xea, 0xa3, 0x69,
+	0x6c, 0x1b, 0xbd, 0xa6, 0x77, 0xa7, 0x0e, 0x17, 0xa4, 0x1e, 0x85, 0x6c, 0x24, 0xcd, 0xca, 0x76,
+	0xb5, 0xd7, 0xda, 0xfb, 0xdf, 0x99, 0xb1, 0x75, 0x0a, 0xb6, 0x2a, 0x78, 0x31, 0x65, 0xeb, 0x4c,
+	0xf6, 0x9d, 0x78, 0x14, 0x38, 0x19, 0x61, 0xa7, 0xa8, 0x3a, 0x05, 0x61, 0xe7, 0x51, 0xc8, 0x46,
+	0x5e, 0x0e, 0x0a, 0x7f, 0x93, 0xc6, 0x98, 0x0f, 0x68, 0x24, 0xcd, 0xaa, 0x82, 0xef, 0x3a, 0x4a,
+	0xbc, 0x32, 0x5b, 0xe7, 0x4c, 0x1d, 0xf8, 0x8f, 0x25, 0xe2, 0xc6, 0xd3, 0xa7, 0xc1, 0x22, 0xcb,
+	0x8c, 0x4e, 0x8e, 0x79, 0xc4, 0x85, 0x59, 0x53, 0xcc, 0xa7, 0xb9, 0xf5, 0x0f, 0x69, 0x95, 0x5e,
+	0x81, 0x36, 0xa9, 0x8e, 0xf0, 0x46, 0xcf, 0x97, 0x85, 0xd0, 0x21, 0xf5, 0x09, 0x8d, 0x52, 0x34,
+	0x2b, 0xdb, 0x46, 0x6f, 0xd9, 0xcb, 0x93, 0x7f, 0x2b, 0x07, 0x86, 0xbd, 0x41, 0xd6, 0x4f, 0x30,
+	0x39, 0x47, 0x21, 0x43, 0xce, 0x0a, 0xf9, 0x3a, 0x04, 0x4e, 0x30, 0x79, 0x26, 0x51, 0x94, 0x45,
+	0xfd, 0x68, 0x90, 0x8d, 0xb9, 0xb2, 0xd6, 0xf6, 0x57, 0xd2, 0x08, 0xa5, 0x4c, 0x51, 0xe8, 0x8e,
+	0x3a, 0x03, 0x93, 0x2c, 0xc9, 0xb4, 0x7f, 0x85, 0x7e, 0xa2, 0xda, 0x36, 0xbd, 0x22, 0xcd, 0xde,
+	0x08, 0x04, 0x4f, 0xe3, 0x5c, 0x83, 0xa6, 0xa7, 0xb3, 0x8c, 0x26, 0x8e, 0x69, 0x18, 0xe9, 0x01,
+	0xf3, 0x04, 0xfe, 0x20, 0xbf, 0xa8, 0xe0, 0x1c, 0x45, 0x38, 0x0c, 0x71, 0x60, 0xd6, 0xd5, 0x10,
+	0xf3, 0x45, 0x70, 0x08, 0x48, 0x14, 0x93, 0xd0, 0xc7, 0x43, 0xdf, 0xe7, 0x29, 0x4b, 0xb2, 0x0f,
+	0x6a, 0x36, 0x14, 0xd0, 0x3d, 0x4f, 0xec, 0x57, 0x6a, 0x46, 0xef, 0xe8, 0xf0, 0xd8, 0x4b, 0x23,
+	0xd4, 0x33, 0xc2, 0x16, 0x69, 0xb2, 0x05, 0x83, 0xcc, 0x0a, 0x25, 0xde, 0x95, 0xfb, 0x79, 0x57,
+	0xcb, 0xbc, 0x4b, 0xf3, 0xd7, 0xe6, 0xe6, 0xb7, 0xbf, 0xe5, 0x4a, 0xce, 0x9a, 0x6b, 0x25, 0x81,
+	0xd4, 0x44, 0x1a, 0x15, 0x8d, 0x55, 0x0c, 0x5d, 0x42, 0x62, 0x81, 0x3e, 0x0e, 0x90, 0xf9, 0xf9,
+	0xf7, 0xab, 0x7b, 0xa5, 0x0a, 0x1c, 0x90, 0xcd, 0xbb, 0xd3, 0xe5, 0xfc, 0x73, 0x36, 0x0f, 0x3d,
+	0x7e, 0x40, 0xb1, 0xda, 0x43, 0x8a, 0x65, 0x0e, 0x14, 0x48, 0x07, 0x4f, 0x58, 0x74, 0xa3, 0x3f,
+	0xc1, 0x34, 0x87, 0x1e, 0x59, 0x2b, 0x6c, 0x7f, 0xe8, 0xab, 0x55, 0x37, 0x1b, 0x4a, 0xa2, 0xc5,
+	0xf2, 0xde, 0xfb, 0x2a, 0x69, 0x65, 0xf6, 0x79, 0x9a, 0x37, 0x80, 0x53, 0xb2, 0xa4, 0x97, 0x17,
+	0x3a, 0xf9, 0x2a, 0xcc, 0xef, 0xb2, 0x05, 0x77, 0x17, 0xc4, 0xee, 0xbc, 0xfe, 0xfc, 0xf5, 0x6d,
+	0x65, 0x15, 0x56, 0xd4, 0x05, 0x33, 0xd9, 0x55, 0x17, 0x10, 0xbc, 0x31, 0x08, 0x99, 0x99, 0x19,
+	0x36, 0xa7, 0x70, 0xf3, 0xf6, 0xb6, 0x4e, 0x7f, 0x7e, 0xa3, 0x35, 0xa2, 0xbd, 0xa9, 0x88, 0xac,
+	0xc3, 0x5a, 0x41, 0x64, 0xa2, 0x9b, 0x5f, 0x90, 0x56, 0x69, 0x57, 0xc0, 0x9c, 0x72, 0x59, 0xd8,
+	0x2a, 0xeb, 0xb7, 0x7b, 0x9e, 0xe8, 0x29, 0x4d, 0x05, 0x0e, 0xd0, 0x2e, 0xc0, 0x53, 0x89, 0x42,
+	0x4d, 0x3a, 0x54, 0xe8, 0x85, 0x7f, 0x4a, 0xe8, 0x0b, 0x7e, 0x2e, 0xa1, 0x2f, 0x9a, 0xcd, 0xb6,
+	0x15, 0xfa, 0x16, 0x58, 0x8b, 0xe8, 0xae, 0xe8, 0x53, 0x7f, 0x27, 0x33, 0xdf, 0xd1, 0xf1, 0x87,
+	0xdb, 0xae, 0xf1, 0xe9, 0xb6, 0x6b, 0x7c, 0xb9, 0xed, 0x1a, 0xcf, 0xff, 0xfa, 0xf1, 0x6b, 0xbb,
+	0xf4, 0xe7, 0xd0, 0x6f, 0xa8, 0x5b, 0x7a, 0xff, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xa1,
+	0x19, 0xbb, 0x39, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -371,6 +545,8 @@ type InfoServiceClient interface {
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*v1alpha1.Version, error)
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
+	// GetRBACRule is a dry-run of SSO RBAC, it returns the rule that matches, and the service account that would be used
+	GetRBACRule(ctx context.Context, in *GetRBACRuleRequest, opts ...grpc.CallOption) (*GetRBACRuleResponse, error)
 }
 
 type infoServiceClient struct {
@@ -408,11 +584,22 @@ func (c *infoServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequ
 	return out, nil
 }
 
+func (c *infoServiceClient) GetRBACRule(ctx context.Context, in *GetRBACRuleRequest, opts ...grpc.CallOption) (*GetRBACRuleResponse, error) {
+	out := new(GetRBACRuleResponse)
+	err := c.cc.Invoke(ctx, "/info.InfoService/GetRBACRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InfoServiceServer is the server API for InfoService service.
 type InfoServiceServer interface {
 	GetInfo(context.Context, *GetInfoRequest) (*InfoResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*v1alpha1.Version, error)
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
+	// GetRBACRule is a dry-run of SSO RBAC, it returns the rule that matches, and the service account that would be used
+	GetRBACRule(context.Context, *GetRBACRuleRequest) (*GetRBACRuleResponse, error)
 }
 
 // UnimplementedInfoServiceServer can be embedded to have forward compatible implementations.
@@ -428,6 +615,9 @@ func (*UnimplementedInfoServiceServer) GetVersion(ctx context.Context, req *GetV
 func (*UnimplementedInfoServiceServer) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (*GetUserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
+func (*UnimplementedInfoServiceServer) GetRBACRule(ctx context.Context, req *GetRBACRuleRequest) (*GetRBACRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRBACRule not implemented")
+}
 
 func RegisterInfoServiceServer(s *grpc.Server, srv InfoServiceServer) {
 	s.RegisterService(&_InfoService_serviceDesc, srv)
@@ -487,6 +677,24 @@ func _InfoService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InfoService_GetRBACRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRBACRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfoServiceServer).GetRBACRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/info.InfoService/GetRBACRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfoServiceServer).GetRBACRule(ctx, req.(*GetRBACRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InfoService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "info.InfoService",
 	HandlerType: (*InfoServiceServer)(nil),
@@ -503,6 +711,10 @@ var _InfoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfo",
 			Handler:    _InfoService_GetUserInfo_Handler,
 		},
+		{
+			MethodName: "GetRBACRule",
+			Handler:    _InfoService_GetRBACRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/info/info.proto",
@@ -740,6 +952,135 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetRBACRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRBACRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRBACRuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintInfo(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRBACRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRBACRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRBACRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WorkflowActions) > 0 {
+		for iNdEx := len(m.WorkflowActions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WorkflowActions[iNdEx])
+			copy(dAtA[i:], m.WorkflowActions[iNdEx])
+			i = encodeVarintInfo(dAtA, i, uint64(len(m.WorkflowActions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ServiceAccountName) > 0 {
+		i -= len(m.ServiceAccountName)
+		copy(dAtA[i:], m.ServiceAccountName)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.ServiceAccountName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceAccountNamespace) > 0 {
+		i -= len(m.ServiceAccountNamespace)
+		copy(dAtA[i:], m.ServiceAccountNamespace)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.ServiceAccountNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Precedence != 0 {
+		i = encodeVarintInfo(dAtA, i, uint64(m.Precedence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rule) > 0 {
+		i -= len(m.Rule)
+		copy(dAtA[i:], m.Rule)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.Rule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInfo(v)
 	base := offset
@@ -858,16 +1199,82 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	return n
 }
 
-func sovInfo(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInfo(x uint64) (n int) {
-	return sovInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GetRBACRuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovInfo(uint64(l))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *GetInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *GetRBACRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rule)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	if m.Precedence != 0 {
+		n += 1 + sovInfo(uint64(m.Precedence))
+	}
+	l = len(m.ServiceAccountNamespace)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	l = len(m.ServiceAccountName)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if len(m.WorkflowActions) > 0 {
+		for _, s := range m.WorkflowActions {
+			l = len(s)
+			n += 1 + l + sovInfo(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInfo(x uint64) (n int) {
+	return sovInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1512,6 +1919,403 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetRBACRuleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRBACRuleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRBACRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRBACRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRBACRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRBACRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precedence", wireType)
+			}
+			m.Precedence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precedence |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowActions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowActions = append(m.WorkflowActions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInfo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,35 +3,155 @@ package apiclient
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
+	jsonpkg "github.com/argoproj/pkg/json"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/api/jsonschema"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
-type offlineClient struct{}
+// offlineClient lints without a cluster. References to workflow templates and cluster workflow templates are resolved
+// from the files it was created with.
+type offlineClient struct {
+	clusterWorkflowTemplateGetter       templateresolution.ClusterWorkflowTemplateGetter
+	namespacedWorkflowTemplateGetterMap offlineWorkflowTemplateGetterMap
+}
 
-var NotImplError error = fmt.Errorf("Not implemented for offline client, only valid for kind '--kinds=workflows'")
+var NotImplError error = fmt.Errorf("Not implemented for offline client, only valid for linting")
 
 var _ Client = &offlineClient{}
 
-func newOfflineClient() (context.Context, Client, error) {
-	return context.Background(), &offlineClient{}, nil
+var offlineExt = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+func newOfflineClient(paths []string) (context.Context, Client, error) {
+	clusterWorkflowTemplateGetter := offlineClusterWorkflowTemplateGetter{}
+	workflowTemplateGetters := offlineWorkflowTemplateGetterMap{}
+	for _, basePath := range paths {
+		// stdin can only be read once, by the linter
+		if basePath == "-" {
+			continue
+		}
+		err := filepath.Walk(basePath, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || !offlineExt[filepath.Ext(path)] {
+				return nil
+			}
+			data, err := ioutil.ReadFile(filepath.Clean(path))
+			if err != nil {
+				return err
+			}
+			if err := validateOfflineTemplates(path, data); err != nil {
+				return err
+			}
+			// invalid objects are reported by the linter, so are ignored here
+			for _, pr := range common.ParseObjects(data, false) {
+				switch v := pr.Object.(type) {
+				case *wfv1.ClusterWorkflowTemplate:
+					if _, ok := clusterWorkflowTemplateGetter[v.Name]; ok {
+						return fmt.Errorf("duplicate ClusterWorkflowTemplate %q in %s", v.Name, path)
+					}
+					clusterWorkflowTemplateGetter[v.Name] = v
+				case *wfv1.WorkflowTemplate:
+					getter, ok := workflowTemplateGetters[v.Namespace]
+					if !ok {
+						getter = offlineWorkflowTemplateNamespacedGetter{}
+						workflowTemplateGetters[v.Namespace] = getter
+					}
+					if _, ok := getter[v.Name]; ok {
+						return fmt.Errorf("duplicate WorkflowTemplate %q in %s", v.Name, path)
+					}
+					getter[v.Name] = v
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return context.Background(), &offlineClient{
+		clusterWorkflowTemplateGetter:       clusterWorkflowTemplateGetter,
+		namespacedWorkflowTemplateGetterMap: workflowTemplateGetters,
+	}, nil
+}
+
+var offlineYAMLSeparator = regexp.MustCompile(`\n---`)
+
+// validateOfflineTemplates validates the workflow templates, and cluster workflow templates, of the file against the
+// bundled schema, before any are used to resolve references
+func validateOfflineTemplates(path string, data []byte) error {
+	docs := []string{string(data)}
+	if !jsonpkg.IsJSON(data) {
+		docs = offlineYAMLSeparator.Split(string(data), -1)
+	}
+	for _, doc := range docs {
+		// invalid objects are reported by the linter, so are ignored here
+		data, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			continue
+		}
+		un := &unstructured.Unstructured{}
+		if err := un.UnmarshalJSON(data); err != nil {
+			continue
+		}
+		if kind := un.GetKind(); kind != workflow.WorkflowTemplateKind && kind != workflow.ClusterWorkflowTemplateKind {
+			continue
+		}
+		errs, err := jsonschema.Validate(data)
+		if err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("%s %q in %s is invalid: %s", un.GetKind(), un.GetName(), path, strings.Join(errs, ", "))
+		}
+	}
+	return nil
 }
 
 func (a *offlineClient) NewWorkflowServiceClient() workflowpkg.WorkflowServiceClient {
-	return &errorTranslatingWorkflowServiceClient{OfflineWorkflowServiceClient{}}
+	return &errorTranslatingWorkflowServiceClient{OfflineWorkflowServiceClient{
+		clusterWorkflowTemplateGetter:       a.clusterWorkflowTemplateGetter,
+		namespacedWorkflowTemplateGetterMap: a.namespacedWorkflowTemplateGetterMap,
+	}}
 }
 
 func (a *offlineClient) NewCronWorkflowServiceClient() (cronworkflow.CronWorkflowServiceClient, error) {
-	return nil, NotImplError
+	return &errorTranslatingCronWorkflowServiceClient{OfflineCronWorkflowServiceClient{
+		clusterWorkflowTemplateGetter:       a.clusterWorkflowTemplateGetter,
+		namespacedWorkflowTemplateGetterMap: a.namespacedWorkflowTemplateGetterMap,
+	}}, nil
 }
 
 func (a *offlineClient) NewWorkflowTemplateServiceClient() (workflowtemplate.WorkflowTemplateServiceClient, error) {
-	return nil, NotImplError
+	return &errorTranslatingWorkflowTemplateServiceClient{OfflineWorkflowTemplateServiceClient{
+		clusterWorkflowTemplateGetter:       a.clusterWorkflowTemplateGetter,
+		namespacedWorkflowTemplateGetterMap: a.namespacedWorkflowTemplateGetterMap,
+	}}, nil
 }
 
 func (a *offlineClient) NewArchivedWorkflowServiceClient() (workflowarchivepkg.ArchivedWorkflowServiceClient, error) {
@@ -42,6 +162,47 @@ func (a *offlineClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error
 	return nil, NotImplError
 }
 
-func (a *offlineClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
+func (a *offlineClient) NewPluginServiceClient() (pluginpkg.PluginServiceClient, error) {
 	return nil, NotImplError
 }
+
+func (a *offlineClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
+	return &errorTranslatingWorkflowClusterTemplateServiceClient{OfflineClusterWorkflowTemplateServiceClient{
+		clusterWorkflowTemplateGetter: a.clusterWorkflowTemplateGetter,
+	}}, nil
+}
+
+// offlineWorkflowTemplateGetterMap holds the workflow templates of each namespace
+type offlineWorkflowTemplateGetterMap map[string]offlineWorkflowTemplateNamespacedGetter
+
+func (m offlineWorkflowTemplateGetterMap) getNamespaceGetter(namespace string) templateresolution.WorkflowTemplateNamespacedGetter {
+	if getter, ok := m[namespace]; ok {
+		return getter
+	}
+	return offlineWorkflowTemplateNamespacedGetter{}
+}
+
+// Get gets the workflow template of another namespace. Grants are not linted, so every workflow template is granted.
+func (m offlineWorkflowTemplateGetterMap) Get(namespace, name string) (*wfv1.WorkflowTemplate, error) {
+	return m.getNamespaceGetter(namespace).Get(name)
+}
+
+var _ templateresolution.GrantedWorkflowTemplateGetter = offlineWorkflowTemplateGetterMap{}
+
+type offlineWorkflowTemplateNamespacedGetter map[string]*wfv1.WorkflowTemplate
+
+func (g offlineWorkflowTemplateNamespacedGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	if v, ok := g[name]; ok {
+		return v, nil
+	}
+	return nil, apierr.NewNotFound(schema.GroupResource{Group: workflow.Group, Resource: workflow.WorkflowTemplatePlural}, name)
+}
+
+type offlineClusterWorkflowTemplateGetter map[string]*wfv1.ClusterWorkflowTemplate
+
+func (g offlineClusterWorkflowTemplateGetter) Get(name string) (*wfv1.ClusterWorkflowTemplate, error) {
+	if v, ok := g[name]; ok {
+		return v, nil
+	}
+	return nil, apierr.NewNotFound(schema.GroupResource{Group: workflow.Group, Resource: workflow.ClusterWorkflowTemplatePlural}, name)
+}
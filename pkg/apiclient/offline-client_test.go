@@ -0,0 +1,130 @@
+package apiclient
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const offlineTemplates = `apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-wftmpl
+spec:
+  templates:
+    - name: main
+      container:
+        image: my-image
+---
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterWorkflowTemplate
+metadata:
+  name: my-cwftmpl
+spec:
+  templates:
+    - name: main
+      container:
+        image: my-image
+`
+
+func newTestOfflineClient(t *testing.T, files map[string]string) Client {
+	dir, err := ioutil.TempDir("", "offline")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	for name, data := range files {
+		if !assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0o600)) {
+			t.FailNow()
+		}
+	}
+	_, client, err := newOfflineClient([]string{dir, "-"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return client
+}
+
+func TestOfflineClient(t *testing.T) {
+	ctx := context.Background()
+	client := newTestOfflineClient(t, map[string]string{"templates.yaml": offlineTemplates, "README.md": "ignored"})
+	newWorkflow := func(templateName string, clusterScope bool) *wfv1.Workflow {
+		return &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Entrypoint: "main",
+				Templates: []wfv1.Template{{
+					Name: "main",
+					Steps: []wfv1.ParallelSteps{{Steps: []wfv1.WorkflowStep{{
+						Name:        "step",
+						TemplateRef: &wfv1.TemplateRef{Name: templateName, Template: "main", ClusterScope: clusterScope},
+					}}}},
+				}},
+			},
+		}
+	}
+	t.Run("Workflow", func(t *testing.T) {
+		workflowClient := client.NewWorkflowServiceClient()
+		_, err := workflowClient.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Workflow: newWorkflow("my-wftmpl", false)})
+		assert.NoError(t, err)
+		_, err = workflowClient.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Workflow: newWorkflow("my-cwftmpl", true)})
+		assert.NoError(t, err)
+		_, err = workflowClient.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Workflow: newWorkflow("not-found", false)})
+		assert.Error(t, err)
+		_, err = workflowClient.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Namespace: "other-ns", Workflow: newWorkflow("my-wftmpl", false)})
+		assert.Error(t, err, "workflow templates are namespaced")
+	})
+	t.Run("CronWorkflow", func(t *testing.T) {
+		cronClient, err := client.NewCronWorkflowServiceClient()
+		if assert.NoError(t, err) {
+			_, err := cronClient.LintCronWorkflow(ctx, &cronworkflowpkg.LintCronWorkflowRequest{CronWorkflow: &wfv1.CronWorkflow{
+				Spec: wfv1.CronWorkflowSpec{Schedule: "* * * * *", WorkflowSpec: newWorkflow("my-wftmpl", false).Spec},
+			}})
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := client.NewInfoServiceClient()
+		assert.Error(t, err)
+		_, err = client.NewWorkflowServiceClient().GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{})
+		assert.Equal(t, OfflineErr, err)
+	})
+}
+
+func TestOfflineClientDuplicate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "offline")
+	if assert.NoError(t, err) {
+		defer func() { _ = os.RemoveAll(dir) }()
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte(offlineTemplates), 0o600))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b.yml"), []byte(offlineTemplates), 0o600))
+		_, _, err := newOfflineClient([]string{dir})
+		assert.Error(t, err)
+	}
+}
+
+func TestOfflineClientInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "offline")
+	if assert.NoError(t, err) {
+		defer func() { _ = os.RemoveAll(dir) }()
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte(offlineTemplates+`---
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-invalid-wftmpl
+spec:
+  templates:
+    - name: main
+      container:
+        image: 1
+`), 0o600))
+		_, _, err := newOfflineClient([]string{dir})
+		assert.EqualError(t, err, `WorkflowTemplate "my-invalid-wftmpl" in `+filepath.Join(dir, "a.yaml")+` is invalid: spec.templates.0.container.image: Invalid type. Expected: string, given: integer`)
+	}
+}
@@ -0,0 +1,47 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
+)
+
+type OfflineClusterWorkflowTemplateServiceClient struct {
+	clusterWorkflowTemplateGetter templateresolution.ClusterWorkflowTemplateGetter
+}
+
+var _ clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient = &OfflineClusterWorkflowTemplateServiceClient{}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) CreateClusterWorkflowTemplate(context.Context, *clusterworkflowtmplpkg.ClusterWorkflowTemplateCreateRequest, ...grpc.CallOption) (*wfv1.ClusterWorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) GetClusterWorkflowTemplate(context.Context, *clusterworkflowtmplpkg.ClusterWorkflowTemplateGetRequest, ...grpc.CallOption) (*wfv1.ClusterWorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) ListClusterWorkflowTemplates(context.Context, *clusterworkflowtmplpkg.ClusterWorkflowTemplateListRequest, ...grpc.CallOption) (*wfv1.ClusterWorkflowTemplateList, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) UpdateClusterWorkflowTemplate(context.Context, *clusterworkflowtmplpkg.ClusterWorkflowTemplateUpdateRequest, ...grpc.CallOption) (*wfv1.ClusterWorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) DeleteClusterWorkflowTemplate(context.Context, *clusterworkflowtmplpkg.ClusterWorkflowTemplateDeleteRequest, ...grpc.CallOption) (*clusterworkflowtmplpkg.ClusterWorkflowTemplateDeleteResponse, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineClusterWorkflowTemplateServiceClient) LintClusterWorkflowTemplate(_ context.Context, req *clusterworkflowtmplpkg.ClusterWorkflowTemplateLintRequest, _ ...grpc.CallOption) (*wfv1.ClusterWorkflowTemplate, error) {
+	// cluster workflow templates may only reference other cluster workflow templates
+	_, err := validate.ValidateClusterWorkflowTemplate(offlineWorkflowTemplateNamespacedGetter{}, o.clusterWorkflowTemplateGetter, req.Template, validate.ValidateOpts{Lint: true, StrictTemplateRefArguments: req.StrictTemplateRefArguments})
+	if err != nil {
+		return nil, err
+	}
+	return req.Template, nil
+}
@@ -0,0 +1,55 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
+)
+
+type OfflineCronWorkflowServiceClient struct {
+	clusterWorkflowTemplateGetter       templateresolution.ClusterWorkflowTemplateGetter
+	namespacedWorkflowTemplateGetterMap offlineWorkflowTemplateGetterMap
+}
+
+var _ cronworkflowpkg.CronWorkflowServiceClient = &OfflineCronWorkflowServiceClient{}
+
+func (o OfflineCronWorkflowServiceClient) LintCronWorkflow(_ context.Context, req *cronworkflowpkg.LintCronWorkflowRequest, _ ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	err := validate.ValidateCronWorkflow(o.namespacedWorkflowTemplateGetterMap.getNamespaceGetter(req.Namespace), o.clusterWorkflowTemplateGetter, req.CronWorkflow, validate.ValidateOpts{GrantedWorkflowTemplateGetter: o.namespacedWorkflowTemplateGetterMap, StrictTemplateRefArguments: req.StrictTemplateRefArguments})
+	if err != nil {
+		return nil, err
+	}
+	return req.CronWorkflow, nil
+}
+
+func (o OfflineCronWorkflowServiceClient) CreateCronWorkflow(context.Context, *cronworkflowpkg.CreateCronWorkflowRequest, ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) ListCronWorkflows(context.Context, *cronworkflowpkg.ListCronWorkflowsRequest, ...grpc.CallOption) (*wfv1.CronWorkflowList, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) GetCronWorkflow(context.Context, *cronworkflowpkg.GetCronWorkflowRequest, ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) UpdateCronWorkflow(context.Context, *cronworkflowpkg.UpdateCronWorkflowRequest, ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) DeleteCronWorkflow(context.Context, *cronworkflowpkg.DeleteCronWorkflowRequest, ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowDeletedResponse, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) ResumeCronWorkflow(context.Context, *cronworkflowpkg.CronWorkflowResumeRequest, ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineCronWorkflowServiceClient) SuspendCronWorkflow(context.Context, *cronworkflowpkg.CronWorkflowSuspendRequest, ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	return nil, OfflineErr
+}
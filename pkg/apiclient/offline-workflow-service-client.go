@@ -5,15 +5,20 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
 
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 var OfflineErr = fmt.Errorf("not supported when you are in offline mode")
 
-type OfflineWorkflowServiceClient struct{}
+type OfflineWorkflowServiceClient struct {
+	clusterWorkflowTemplateGetter       templateresolution.ClusterWorkflowTemplateGetter
+	namespacedWorkflowTemplateGetterMap offlineWorkflowTemplateGetterMap
+}
 
 var _ workflowpkg.WorkflowServiceClient = &OfflineWorkflowServiceClient{}
 
@@ -25,10 +30,22 @@ func (o OfflineWorkflowServiceClient) GetWorkflow(context.Context, *workflowpkg.
 	return nil, OfflineErr
 }
 
+func (o OfflineWorkflowServiceClient) DiffWorkflow(context.Context, *workflowpkg.WorkflowDiffRequest, ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	return nil, OfflineErr
+}
+
 func (o OfflineWorkflowServiceClient) ListWorkflows(context.Context, *workflowpkg.WorkflowListRequest, ...grpc.CallOption) (*wfv1.WorkflowList, error) {
 	return nil, OfflineErr
 }
 
+func (o OfflineWorkflowServiceClient) GetWorkflowCosts(context.Context, *workflowpkg.WorkflowCostsRequest, ...grpc.CallOption) (*workflowpkg.WorkflowCostsResponse, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowServiceClient) GetWorkflowSnapshot(context.Context, *workflowpkg.WorkflowSnapshotRequest, ...grpc.CallOption) (*workflowpkg.WorkflowSnapshot, error) {
+	return nil, OfflineErr
+}
+
 func (o OfflineWorkflowServiceClient) WatchWorkflows(context.Context, *workflowpkg.WatchWorkflowsRequest, ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	return nil, OfflineErr
 }
@@ -41,6 +58,10 @@ func (o OfflineWorkflowServiceClient) DeleteWorkflow(context.Context, *workflowp
 	return nil, OfflineErr
 }
 
+func (o OfflineWorkflowServiceClient) BulkWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, OfflineErr
+}
+
 func (o OfflineWorkflowServiceClient) RetryWorkflow(context.Context, *workflowpkg.WorkflowRetryRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
@@ -53,36 +74,32 @@ func (o OfflineWorkflowServiceClient) ResumeWorkflow(context.Context, *workflowp
 	return nil, OfflineErr
 }
 
-func (o OfflineWorkflowServiceClient) SuspendWorkflow(context.Context, *workflowpkg.WorkflowSuspendRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+func (o OfflineWorkflowServiceClient) ApproveWorkflow(context.Context, *workflowpkg.WorkflowApproveRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
-func (o OfflineWorkflowServiceClient) TerminateWorkflow(context.Context, *workflowpkg.WorkflowTerminateRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+func (o OfflineWorkflowServiceClient) RejectWorkflow(context.Context, *workflowpkg.WorkflowRejectRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
-func (o OfflineWorkflowServiceClient) StopWorkflow(context.Context, *workflowpkg.WorkflowStopRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+func (o OfflineWorkflowServiceClient) SuspendWorkflow(context.Context, *workflowpkg.WorkflowSuspendRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
-func (o OfflineWorkflowServiceClient) SetWorkflow(context.Context, *workflowpkg.WorkflowSetRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+func (o OfflineWorkflowServiceClient) TerminateWorkflow(context.Context, *workflowpkg.WorkflowTerminateRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
-type offlineWorkflowTemplateNamespacedGetter struct{}
-
-func (w offlineWorkflowTemplateNamespacedGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+func (o OfflineWorkflowServiceClient) StopWorkflow(context.Context, *workflowpkg.WorkflowStopRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
-type offlineClusterWorkflowTemplateNamespacedGetter struct{}
-
-func (o offlineClusterWorkflowTemplateNamespacedGetter) Get(name string) (*wfv1.ClusterWorkflowTemplate, error) {
+func (o OfflineWorkflowServiceClient) SetWorkflow(context.Context, *workflowpkg.WorkflowSetRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
 
 func (o OfflineWorkflowServiceClient) LintWorkflow(_ context.Context, req *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
-	_, err := validate.ValidateWorkflow(&offlineWorkflowTemplateNamespacedGetter{}, &offlineClusterWorkflowTemplateNamespacedGetter{}, req.Workflow, validate.ValidateOpts{Lint: true})
+	_, err := validate.ValidateWorkflow(o.namespacedWorkflowTemplateGetterMap.getNamespaceGetter(req.Namespace), o.clusterWorkflowTemplateGetter, req.Workflow, validate.ValidateOpts{Lint: true, GrantedWorkflowTemplateGetter: o.namespacedWorkflowTemplateGetterMap, StrictTemplateRefArguments: req.StrictTemplateRefArguments})
 	if err != nil {
 		return nil, err
 	}
@@ -100,3 +117,19 @@ func (o OfflineWorkflowServiceClient) WorkflowLogs(context.Context, *workflowpkg
 func (o OfflineWorkflowServiceClient) SubmitWorkflow(context.Context, *workflowpkg.WorkflowSubmitRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, OfflineErr
 }
+
+func (o OfflineWorkflowServiceClient) GetWorkflowNodePod(context.Context, *workflowpkg.WorkflowNodePodRequest, ...grpc.CallOption) (*corev1.Pod, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowServiceClient) ExplainWorkflowNode(context.Context, *workflowpkg.WorkflowExplainRequest, ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowServiceClient) GetWorkflowTimeline(context.Context, *workflowpkg.WorkflowTimelineRequest, ...grpc.CallOption) (*workflowpkg.WorkflowTimeline, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowServiceClient) RenderWorkflow(context.Context, *workflowpkg.WorkflowRenderRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
+	return nil, OfflineErr
+}
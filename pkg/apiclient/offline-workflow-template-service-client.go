@@ -0,0 +1,55 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
+)
+
+type OfflineWorkflowTemplateServiceClient struct {
+	clusterWorkflowTemplateGetter       templateresolution.ClusterWorkflowTemplateGetter
+	namespacedWorkflowTemplateGetterMap offlineWorkflowTemplateGetterMap
+}
+
+var _ workflowtemplatepkg.WorkflowTemplateServiceClient = &OfflineWorkflowTemplateServiceClient{}
+
+func (o OfflineWorkflowTemplateServiceClient) CreateWorkflowTemplate(context.Context, *workflowtemplatepkg.WorkflowTemplateCreateRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) GetWorkflowTemplate(context.Context, *workflowtemplatepkg.WorkflowTemplateGetRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) ListWorkflowTemplates(context.Context, *workflowtemplatepkg.WorkflowTemplateListRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplateList, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) UpdateWorkflowTemplate(context.Context, *workflowtemplatepkg.WorkflowTemplateUpdateRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) DeleteWorkflowTemplate(context.Context, *workflowtemplatepkg.WorkflowTemplateDeleteRequest, ...grpc.CallOption) (*workflowtemplatepkg.WorkflowTemplateDeleteResponse, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) LintWorkflowTemplate(_ context.Context, req *workflowtemplatepkg.WorkflowTemplateLintRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	_, err := validate.ValidateWorkflowTemplate(o.namespacedWorkflowTemplateGetterMap.getNamespaceGetter(req.Namespace), o.clusterWorkflowTemplateGetter, req.Template, validate.ValidateOpts{Lint: true, GrantedWorkflowTemplateGetter: o.namespacedWorkflowTemplateGetterMap, StrictTemplateRefArguments: req.StrictTemplateRefArguments})
+	if err != nil {
+		return nil, err
+	}
+	return req.Template, nil
+}
+
+func (o OfflineWorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(context.Context, *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplateList, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) RollbackWorkflowTemplate(context.Context, *workflowtemplatepkg.WorkflowTemplateRollbackRequest, ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	return nil, OfflineErr
+}
@@ -0,0 +1,1070 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/sharelink/sharelink.proto
+
+package sharelink
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreateShareLinkRequest asks for a link to either an artifact of a workflow, if artifactName is set, or to its logs.
+type CreateShareLinkRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the name of the workflow
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// the pod whose logs are shared, if empty the logs of every pod of the workflow are shared
+	PodName string `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
+	// the container whose logs are shared, defaults to "main"
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// the node the artifact is an input or output of
+	NodeId       string `protobuf:"bytes,5,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	ArtifactName string `protobuf:"bytes,6,opt,name=artifactName,proto3" json:"artifactName,omitempty"`
+	// share an input artifact, rather than an output artifact
+	Input bool `protobuf:"varint,7,opt,name=input,proto3" json:"input,omitempty"`
+	// how long the link is valid for, e.g. "2h", defaults to "24h", at most "168h"
+	ExpiresIn string `protobuf:"bytes,8,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	// the link may only be used once
+	SingleUse            bool     `protobuf:"varint,9,opt,name=singleUse,proto3" json:"singleUse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShareLinkRequest) Reset()         { *m = CreateShareLinkRequest{} }
+func (m *CreateShareLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkRequest) ProtoMessage()    {}
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70c8e5368b362d2b, []int{0}
+}
+func (m *CreateShareLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateShareLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateShareLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateShareLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkRequest.Merge(m, src)
+}
+func (m *CreateShareLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateShareLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkRequest proto.InternalMessageInfo
+
+func (m *CreateShareLinkRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetArtifactName() string {
+	if m != nil {
+		return m.ArtifactName
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetInput() bool {
+	if m != nil {
+		return m.Input
+	}
+	return false
+}
+
+func (m *CreateShareLinkRequest) GetExpiresIn() string {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetSingleUse() bool {
+	if m != nil {
+		return m.SingleUse
+	}
+	return false
+}
+
+type CreateShareLinkResponse struct {
+	// the path of the link, relative to the Argo Server's URL, e.g. "/shared/<token>"
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ExpiresAt            *v1.Time `protobuf:"bytes,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShareLinkResponse) Reset()         { *m = CreateShareLinkResponse{} }
+func (m *CreateShareLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkResponse) ProtoMessage()    {}
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70c8e5368b362d2b, []int{1}
+}
+func (m *CreateShareLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateShareLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateShareLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateShareLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkResponse.Merge(m, src)
+}
+func (m *CreateShareLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateShareLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkResponse proto.InternalMessageInfo
+
+func (m *CreateShareLinkResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CreateShareLinkResponse) GetExpiresAt() *v1.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateShareLinkRequest)(nil), "sharelink.CreateShareLinkRequest")
+	proto.RegisterType((*CreateShareLinkResponse)(nil), "sharelink.CreateShareLinkResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/sharelink/sharelink.proto", fileDescriptor_70c8e5368b362d2b)
+}
+
+var fileDescriptor_70c8e5368b362d2b = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0x95, 0x43, 0x9b, 0x36, 0x06, 0x09, 0x64, 0xa1, 0xb2, 0x8a, 0xaa, 0xa8, 0xec, 0x01, 0xaa,
+	0xa2, 0xda, 0x4a, 0xe1, 0x80, 0x7a, 0x03, 0x2e, 0xad, 0x84, 0x38, 0xa4, 0x70, 0xe1, 0xe6, 0x6e,
+	0xa6, 0x8e, 0xd9, 0x5d, 0xdb, 0xd8, 0xce, 0x06, 0x84, 0x72, 0xe1, 0xca, 0x05, 0x89, 0x3f, 0xc0,
+	0xcf, 0xe1, 0x88, 0xc4, 0x1f, 0x40, 0x11, 0x3f, 0x04, 0xd9, 0x0e, 0xbb, 0x7c, 0xdf, 0x66, 0xde,
+	0xf8, 0xf9, 0xd9, 0xf3, 0x1e, 0xbe, 0x6d, 0x4a, 0xc1, 0xb8, 0x91, 0x45, 0x25, 0x41, 0x79, 0xe6,
+	0x66, 0xdc, 0x42, 0x25, 0x55, 0xd9, 0x55, 0xd4, 0x58, 0xed, 0x35, 0x19, 0xb4, 0xc0, 0x70, 0x57,
+	0x68, 0x2d, 0x2a, 0x08, 0x34, 0xc6, 0x95, 0xd2, 0x9e, 0x7b, 0xa9, 0x95, 0x4b, 0x07, 0x87, 0xf7,
+	0xca, 0xfb, 0x8e, 0x4a, 0x1d, 0xa6, 0x35, 0x2f, 0x66, 0x52, 0x81, 0x7d, 0xcd, 0xd6, 0x2a, 0x8e,
+	0xd5, 0xe0, 0x39, 0x6b, 0xc6, 0x4c, 0x80, 0x02, 0xcb, 0x3d, 0x4c, 0x13, 0x2b, 0x7f, 0xdf, 0xc3,
+	0x3b, 0x8f, 0x2c, 0x70, 0x0f, 0x67, 0x41, 0xe7, 0xb1, 0x54, 0xe5, 0x04, 0x5e, 0xce, 0xc1, 0x79,
+	0xb2, 0x8b, 0x07, 0x8a, 0xd7, 0xe0, 0x0c, 0x2f, 0x20, 0x43, 0x7b, 0x68, 0x7f, 0x30, 0xe9, 0x00,
+	0x42, 0xf0, 0x46, 0x68, 0xb2, 0x5e, 0x1c, 0xc4, 0x9a, 0x64, 0x78, 0xcb, 0xe8, 0xe9, 0x93, 0x00,
+	0x5f, 0x8a, 0xf0, 0x8f, 0x36, 0xdc, 0x55, 0x68, 0xe5, 0x79, 0x78, 0x55, 0xb6, 0x91, 0xee, 0x6a,
+	0x01, 0xb2, 0x83, 0xfb, 0x4a, 0x4f, 0xe1, 0x74, 0x9a, 0x6d, 0xc6, 0xd1, 0xba, 0x23, 0x39, 0xbe,
+	0xc2, 0xad, 0x97, 0x17, 0xbc, 0xf0, 0xf1, 0xd2, 0x7e, 0x9c, 0xfe, 0x82, 0x91, 0xeb, 0x78, 0x53,
+	0x2a, 0x33, 0xf7, 0xd9, 0xd6, 0x1e, 0xda, 0xdf, 0x9e, 0xa4, 0x26, 0xe8, 0xc1, 0x2b, 0x23, 0x2d,
+	0xb8, 0x53, 0x95, 0x6d, 0x27, 0xbd, 0x16, 0x08, 0x53, 0x27, 0x95, 0xa8, 0xe0, 0x99, 0x83, 0x6c,
+	0x10, 0x79, 0x1d, 0x90, 0x2f, 0xf0, 0x8d, 0x3f, 0x36, 0xe2, 0x8c, 0x56, 0x2e, 0x7e, 0xda, 0x70,
+	0x3f, 0x5b, 0x6f, 0x23, 0xd6, 0xe4, 0xa4, 0x95, 0x7a, 0xe0, 0xe3, 0x36, 0x2e, 0x1f, 0x1d, 0xd0,
+	0xe4, 0x05, 0xfd, 0xd9, 0x0b, 0x6a, 0x4a, 0x11, 0x00, 0x47, 0x83, 0x17, 0xb4, 0x19, 0xd3, 0xa7,
+	0xb2, 0x86, 0x49, 0x47, 0x3e, 0xfa, 0x88, 0xf0, 0xb5, 0x56, 0xf3, 0x0c, 0x6c, 0x23, 0x0b, 0x20,
+	0xef, 0x10, 0xbe, 0xfa, 0xdb, 0x73, 0xc8, 0x4d, 0xda, 0xa5, 0xe4, 0xef, 0xe6, 0x0d, 0xf3, 0xff,
+	0x1d, 0x49, 0xbf, 0xc9, 0xc7, 0x6f, 0xbf, 0x7c, 0xfb, 0xd0, 0xbb, 0x93, 0xdf, 0x8a, 0x89, 0x6a,
+	0xc6, 0x29, 0x7b, 0x87, 0x81, 0xe3, 0xd8, 0x9b, 0xd6, 0xea, 0x65, 0xaa, 0x97, 0xc7, 0xe8, 0xe0,
+	0xe1, 0xc9, 0xa7, 0xd5, 0x08, 0x7d, 0x5e, 0x8d, 0xd0, 0xd7, 0xd5, 0x08, 0x3d, 0x3f, 0x16, 0xd2,
+	0xcf, 0xe6, 0xe7, 0xb4, 0xd0, 0x35, 0xe3, 0x56, 0x68, 0x63, 0xf5, 0x8b, 0x58, 0x1c, 0x2e, 0xb4,
+	0x2d, 0x2f, 0x2a, 0xbd, 0x70, 0xec, 0x1f, 0x39, 0x3f, 0xef, 0xc7, 0xfc, 0xdd, 0xfd, 0x1e, 0x00,
+	0x00, 0xff, 0xff, 0x59, 0x61, 0x7c, 0x54, 0x09, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ShareLinkServiceClient is the client API for ShareLinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShareLinkServiceClient interface {
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+}
+
+type shareLinkServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewShareLinkServiceClient(cc *grpc.ClientConn) ShareLinkServiceClient {
+	return &shareLinkServiceClient{cc}
+}
+
+func (c *shareLinkServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/sharelink.ShareLinkService/CreateShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareLinkServiceServer is the server API for ShareLinkService service.
+type ShareLinkServiceServer interface {
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+}
+
+// UnimplementedShareLinkServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedShareLinkServiceServer struct {
+}
+
+func (*UnimplementedShareLinkServiceServer) CreateShareLink(ctx context.Context, req *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+
+func RegisterShareLinkServiceServer(s *grpc.Server, srv ShareLinkServiceServer) {
+	s.RegisterService(&_ShareLinkService_serviceDesc, srv)
+}
+
+func _ShareLinkService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareLinkServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sharelink.ShareLinkService/CreateShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareLinkServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ShareLinkService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sharelink.ShareLinkService",
+	HandlerType: (*ShareLinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _ShareLinkService_CreateShareLink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/sharelink/sharelink.proto",
+}
+
+func (m *CreateShareLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateShareLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateShareLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SingleUse {
+		i--
+		if m.SingleUse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExpiresIn) > 0 {
+		i -= len(m.ExpiresIn)
+		copy(dAtA[i:], m.ExpiresIn)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.ExpiresIn)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Input {
+		i--
+		if m.Input {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ArtifactName) > 0 {
+		i -= len(m.ArtifactName)
+		copy(dAtA[i:], m.ArtifactName)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.ArtifactName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateShareLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateShareLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateShareLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSharelink(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSharelink(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSharelink(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSharelink(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateShareLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	l = len(m.ArtifactName)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	if m.Input {
+		n += 2
+	}
+	l = len(m.ExpiresIn)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	if m.SingleUse {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateShareLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovSharelink(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSharelink(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSharelink(x uint64) (n int) {
+	return sovSharelink(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateShareLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharelink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateShareLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateShareLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Input = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleUse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleUse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharelink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateShareLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharelink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateShareLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateShareLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharelink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharelink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSharelink(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSharelink
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSharelink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSharelink
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSharelink
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSharelink
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSharelink        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSharelink          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSharelink = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	v1 "k8s.io/api/core/v1"
+
 	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
@@ -19,6 +21,66 @@ type WorkflowServiceClient struct {
 	mock.Mock
 }
 
+// ApproveWorkflow provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) ApproveWorkflow(ctx context.Context, in *workflow.WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.Workflow
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowApproveRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowApproveRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkWorkflows provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) BulkWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowBulkResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) CreateWorkflow(ctx context.Context, in *workflow.WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
@@ -79,6 +141,66 @@ func (_m *WorkflowServiceClient) DeleteWorkflow(ctx context.Context, in *workflo
 	return r0, r1
 }
 
+// DiffWorkflow provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) DiffWorkflow(ctx context.Context, in *workflow.WorkflowDiffRequest, opts ...grpc.CallOption) (*workflow.WorkflowDiffResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowDiffResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) *workflow.WorkflowDiffResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowDiffResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExplainWorkflowNode provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) ExplainWorkflowNode(ctx context.Context, in *workflow.WorkflowExplainRequest, opts ...grpc.CallOption) (*workflow.WorkflowExplainResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowExplainResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowExplainRequest, ...grpc.CallOption) *workflow.WorkflowExplainResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowExplainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowExplainRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) GetWorkflow(ctx context.Context, in *workflow.WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
@@ -109,6 +231,126 @@ func (_m *WorkflowServiceClient) GetWorkflow(ctx context.Context, in *workflow.W
 	return r0, r1
 }
 
+// GetWorkflowCosts provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) GetWorkflowCosts(ctx context.Context, in *workflow.WorkflowCostsRequest, opts ...grpc.CallOption) (*workflow.WorkflowCostsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowCostsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowCostsRequest, ...grpc.CallOption) *workflow.WorkflowCostsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowCostsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowCostsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowNodePod provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) GetWorkflowNodePod(ctx context.Context, in *workflow.WorkflowNodePodRequest, opts ...grpc.CallOption) (*v1.Pod, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1.Pod
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowNodePodRequest, ...grpc.CallOption) *v1.Pod); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Pod)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowNodePodRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) GetWorkflowSnapshot(ctx context.Context, in *workflow.WorkflowSnapshotRequest, opts ...grpc.CallOption) (*workflow.WorkflowSnapshot, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowSnapshot
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSnapshotRequest, ...grpc.CallOption) *workflow.WorkflowSnapshot); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowSnapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowTimeline provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) GetWorkflowTimeline(ctx context.Context, in *workflow.WorkflowTimelineRequest, opts ...grpc.CallOption) (*workflow.WorkflowTimeline, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowTimeline
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowTimelineRequest, ...grpc.CallOption) *workflow.WorkflowTimeline); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowTimeline)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowTimelineRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LintWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) LintWorkflow(ctx context.Context, in *workflow.WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
@@ -199,6 +441,66 @@ func (_m *WorkflowServiceClient) PodLogs(ctx context.Context, in *workflow.Workf
 	return r0, r1
 }
 
+// RejectWorkflow provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) RejectWorkflow(ctx context.Context, in *workflow.WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.Workflow
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRejectRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowRejectRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenderWorkflow provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) RenderWorkflow(ctx context.Context, in *workflow.WorkflowRenderRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.Workflow
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRenderRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowRenderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResubmitWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) ResubmitWorkflow(ctx context.Context, in *workflow.WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
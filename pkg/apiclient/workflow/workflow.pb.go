@@ -38,12 +38,14 @@ type WorkflowCreateRequest struct {
 	Namespace string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Workflow  *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
 	// This field is no longer used.
-	InstanceID           string            `protobuf:"bytes,3,opt,name=instanceID,proto3" json:"instanceID,omitempty"` // Deprecated: Do not use.
-	ServerDryRun         bool              `protobuf:"varint,4,opt,name=serverDryRun,proto3" json:"serverDryRun,omitempty"`
-	CreateOptions        *v1.CreateOptions `protobuf:"bytes,5,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	InstanceID    string            `protobuf:"bytes,3,opt,name=instanceID,proto3" json:"instanceID,omitempty"` // Deprecated: Do not use.
+	ServerDryRun  bool              `protobuf:"varint,4,opt,name=serverDryRun,proto3" json:"serverDryRun,omitempty"`
+	CreateOptions *v1.CreateOptions `protobuf:"bytes,5,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
+	// The options the server applies to the workflow, e.g. to submit it with a preset.
+	SubmitOptions        *v1alpha1.SubmitOpts `protobuf:"bytes,6,opt,name=submitOptions,proto3" json:"submitOptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *WorkflowCreateRequest) Reset()         { *m = WorkflowCreateRequest{} }
@@ -115,12 +117,22 @@ func (m *WorkflowCreateRequest) GetCreateOptions() *v1.CreateOptions {
 	return nil
 }
 
+func (m *WorkflowCreateRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
+	if m != nil {
+		return m.SubmitOptions
+	}
+	return nil
+}
+
 type WorkflowGetRequest struct {
 	Name       string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace  string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	GetOptions *v1.GetOptions `protobuf:"bytes,3,opt,name=getOptions,proto3" json:"getOptions,omitempty"`
 	// Fields to be included or excluded in the response. e.g. "spec,status.phase", "-status.nodes"
-	Fields               string   `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	Fields string `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	// The cluster to get the workflow from, when the Argo Server is configured with other clusters. Defaults to the
+	// cluster the Argo Server runs in.
+	Cluster              string   `protobuf:"bytes,5,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -187,11 +199,21 @@ func (m *WorkflowGetRequest) GetFields() string {
 	return ""
 }
 
+func (m *WorkflowGetRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
 type WorkflowListRequest struct {
 	Namespace   string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	ListOptions *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
 	// Fields to be included or excluded in the response. e.g. "items.spec,items.status.phase", "-items.status.nodes"
-	Fields               string   `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	Fields string `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	// The cluster to list workflows from, when the Argo Server is configured with other clusters. If empty, workflows
+	// are listed from every cluster.
+	Cluster              string   `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -251,6 +273,13 @@ func (m *WorkflowListRequest) GetFields() string {
 	return ""
 }
 
+func (m *WorkflowListRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
 type WorkflowResubmitRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -315,10 +344,16 @@ func (m *WorkflowResubmitRequest) GetMemoized() bool {
 }
 
 type WorkflowRetryRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	RestartSuccessful    bool     `protobuf:"varint,3,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
-	NodeFieldSelector    string   `protobuf:"bytes,4,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RestartSuccessful bool   `protobuf:"varint,3,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	NodeFieldSelector string `protobuf:"bytes,4,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	// Parameters to override the workflow's arguments with, of the form "NAME=VALUE".
+	Parameters []string `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// Names, or glob patterns matching the name or display name, of nodes to restart, with their children, even if they succeeded.
+	RestartNodes []string `protobuf:"bytes,6,rep,name=restartNodes,proto3" json:"restartNodes,omitempty"`
+	// The name or display name of a node to restart the workflow from. The node, and every node after it, are restarted. Unlike the other options, the workflow may have succeeded.
+	RestartFrom          string   `protobuf:"bytes,7,opt,name=restartFrom,proto3" json:"restartFrom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -385,6 +420,27 @@ func (m *WorkflowRetryRequest) GetNodeFieldSelector() string {
 	return ""
 }
 
+func (m *WorkflowRetryRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *WorkflowRetryRequest) GetRestartNodes() []string {
+	if m != nil {
+		return m.RestartNodes
+	}
+	return nil
+}
+
+func (m *WorkflowRetryRequest) GetRestartFrom() string {
+	if m != nil {
+		return m.RestartFrom
+	}
+	return ""
+}
+
 type WorkflowResumeRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -448,6 +504,148 @@ func (m *WorkflowResumeRequest) GetNodeFieldSelector() string {
 	return ""
 }
 
+type WorkflowApproveRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowApproveRequest) Reset()         { *m = WorkflowApproveRequest{} }
+func (m *WorkflowApproveRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowApproveRequest) ProtoMessage()    {}
+func (*WorkflowApproveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{6}
+}
+func (m *WorkflowApproveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowApproveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowApproveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowApproveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowApproveRequest.Merge(m, src)
+}
+func (m *WorkflowApproveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowApproveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowApproveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowApproveRequest proto.InternalMessageInfo
+
+func (m *WorkflowApproveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+func (m *WorkflowApproveRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type WorkflowRejectRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowRejectRequest) Reset()         { *m = WorkflowRejectRequest{} }
+func (m *WorkflowRejectRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowRejectRequest) ProtoMessage()    {}
+func (*WorkflowRejectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{7}
+}
+func (m *WorkflowRejectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowRejectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowRejectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowRejectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowRejectRequest.Merge(m, src)
+}
+func (m *WorkflowRejectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowRejectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowRejectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowRejectRequest proto.InternalMessageInfo
+
+func (m *WorkflowRejectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+func (m *WorkflowRejectRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
 type WorkflowTerminateRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -460,7 +658,7 @@ func (m *WorkflowTerminateRequest) Reset()         { *m = WorkflowTerminateReque
 func (m *WorkflowTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowTerminateRequest) ProtoMessage()    {}
 func (*WorkflowTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{6}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{8}
 }
 func (m *WorkflowTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +715,7 @@ func (m *WorkflowStopRequest) Reset()         { *m = WorkflowStopRequest{} }
 func (m *WorkflowStopRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowStopRequest) ProtoMessage()    {}
 func (*WorkflowStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{7}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{9}
 }
 func (m *WorkflowStopRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +788,7 @@ func (m *WorkflowSetRequest) Reset()         { *m = WorkflowSetRequest{} }
 func (m *WorkflowSetRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowSetRequest) ProtoMessage()    {}
 func (*WorkflowSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{8}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{10}
 }
 func (m *WorkflowSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +871,7 @@ func (m *WorkflowSuspendRequest) Reset()         { *m = WorkflowSuspendRequest{}
 func (m *WorkflowSuspendRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowSuspendRequest) ProtoMessage()    {}
 func (*WorkflowSuspendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{9}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{11}
 }
 func (m *WorkflowSuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,22 +915,25 @@ func (m *WorkflowSuspendRequest) GetNamespace() string {
 }
 
 type WorkflowLogRequest struct {
-	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PodName              string             `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
-	LogOptions           *v11.PodLogOptions `protobuf:"bytes,4,opt,name=logOptions,proto3" json:"logOptions,omitempty"`
-	Grep                 string             `protobuf:"bytes,5,opt,name=grep,proto3" json:"grep,omitempty"`
-	Selector             string             `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Name       string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace  string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName    string             `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
+	LogOptions *v11.PodLogOptions `protobuf:"bytes,4,opt,name=logOptions,proto3" json:"logOptions,omitempty"`
+	Grep       string             `protobuf:"bytes,5,opt,name=grep,proto3" json:"grep,omitempty"`
+	Selector   string             `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
+	// The cluster the workflow is in, when the Argo Server is configured with other clusters. Defaults to the cluster
+	// the Argo Server runs in.
+	Cluster              string   `protobuf:"bytes,7,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WorkflowLogRequest) Reset()         { *m = WorkflowLogRequest{} }
 func (m *WorkflowLogRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowLogRequest) ProtoMessage()    {}
 func (*WorkflowLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{10}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{12}
 }
 func (m *WorkflowLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,6 +1004,13 @@ func (m *WorkflowLogRequest) GetSelector() string {
 	return ""
 }
 
+func (m *WorkflowLogRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
 type WorkflowDeleteRequest struct {
 	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -816,7 +1024,7 @@ func (m *WorkflowDeleteRequest) Reset()         { *m = WorkflowDeleteRequest{} }
 func (m *WorkflowDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDeleteRequest) ProtoMessage()    {}
 func (*WorkflowDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{11}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{13}
 }
 func (m *WorkflowDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +1084,7 @@ func (m *WorkflowDeleteResponse) Reset()         { *m = WorkflowDeleteResponse{}
 func (m *WorkflowDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDeleteResponse) ProtoMessage()    {}
 func (*WorkflowDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{12}
+	return fileDescriptor_1f6bb75f9e833cb6, []int{14}
 }
 func (m *WorkflowDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,27 +1113,43 @@ func (m *WorkflowDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowDeleteResponse proto.InternalMessageInfo
 
-type WatchWorkflowsRequest struct {
-	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ListOptions          *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	Fields               string          `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type WorkflowBulkRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The operation to perform on each workflow, one of "retry", "stop", "terminate" or "delete".
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Names of the workflows to operate on. Either names or a label selector must be specified.
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	// Selects the workflows to operate on. Combined with names, if both are specified.
+	ListOptions *v1.ListOptions `protobuf:"bytes,4,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// Retry only: restart successful nodes matching the node field selector.
+	RestartSuccessful bool `protobuf:"varint,5,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	// Retry and stop only: selects the nodes to act on.
+	NodeFieldSelector string `protobuf:"bytes,6,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	// Stop only: the message to set on the nodes.
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Retry only: parameters to override the workflow's arguments with, of the form "NAME=VALUE".
+	Parameters []string `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// Retry only: names, or glob patterns, of nodes to restart, with their children, even if they succeeded.
+	RestartNodes []string `protobuf:"bytes,9,rep,name=restartNodes,proto3" json:"restartNodes,omitempty"`
+	// Retry only: the name or display name of a node to restart each workflow from.
+	RestartFrom          string   `protobuf:"bytes,10,opt,name=restartFrom,proto3" json:"restartFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchWorkflowsRequest) Reset()         { *m = WatchWorkflowsRequest{} }
-func (m *WatchWorkflowsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchWorkflowsRequest) ProtoMessage()    {}
-func (*WatchWorkflowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{13}
+func (m *WorkflowBulkRequest) Reset()         { *m = WorkflowBulkRequest{} }
+func (m *WorkflowBulkRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkRequest) ProtoMessage()    {}
+func (*WorkflowBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{15}
 }
-func (m *WatchWorkflowsRequest) XXX_Unmarshal(b []byte) error {
+func (m *WorkflowBulkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchWorkflowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchWorkflowsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowBulkRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -935,61 +1159,111 @@ func (m *WatchWorkflowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *WatchWorkflowsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchWorkflowsRequest.Merge(m, src)
+func (m *WorkflowBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkRequest.Merge(m, src)
 }
-func (m *WatchWorkflowsRequest) XXX_Size() int {
+func (m *WorkflowBulkRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchWorkflowsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchWorkflowsRequest.DiscardUnknown(m)
+func (m *WorkflowBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchWorkflowsRequest proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowBulkRequest proto.InternalMessageInfo
 
-func (m *WatchWorkflowsRequest) GetNamespace() string {
+func (m *WorkflowBulkRequest) GetNamespace() string {
 	if m != nil {
 		return m.Namespace
 	}
 	return ""
 }
 
-func (m *WatchWorkflowsRequest) GetListOptions() *v1.ListOptions {
+func (m *WorkflowBulkRequest) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *WorkflowBulkRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *WorkflowBulkRequest) GetListOptions() *v1.ListOptions {
 	if m != nil {
 		return m.ListOptions
 	}
 	return nil
 }
 
-func (m *WatchWorkflowsRequest) GetFields() string {
+func (m *WorkflowBulkRequest) GetRestartSuccessful() bool {
 	if m != nil {
-		return m.Fields
+		return m.RestartSuccessful
+	}
+	return false
+}
+
+func (m *WorkflowBulkRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
 	}
 	return ""
 }
 
-type WorkflowWatchEvent struct {
-	// the type of change
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// the workflow
-	Object               *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *WorkflowBulkRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *WorkflowWatchEvent) Reset()         { *m = WorkflowWatchEvent{} }
-func (m *WorkflowWatchEvent) String() string { return proto.CompactTextString(m) }
-func (*WorkflowWatchEvent) ProtoMessage()    {}
-func (*WorkflowWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{14}
+func (m *WorkflowBulkRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
 }
-func (m *WorkflowWatchEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *WorkflowBulkRequest) GetRestartNodes() []string {
+	if m != nil {
+		return m.RestartNodes
+	}
+	return nil
+}
+
+func (m *WorkflowBulkRequest) GetRestartFrom() string {
+	if m != nil {
+		return m.RestartFrom
+	}
+	return ""
+}
+
+type WorkflowBulkResult struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The phase of the workflow after the operation, empty for "delete".
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// The reason the operation failed for this workflow, empty on success.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowBulkResult) Reset()         { *m = WorkflowBulkResult{} }
+func (m *WorkflowBulkResult) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkResult) ProtoMessage()    {}
+func (*WorkflowBulkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{16}
+}
+func (m *WorkflowBulkResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WorkflowWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowBulkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WorkflowWatchEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowBulkResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,52 +1273,58 @@ func (m *WorkflowWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *WorkflowWatchEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkflowWatchEvent.Merge(m, src)
+func (m *WorkflowBulkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkResult.Merge(m, src)
 }
-func (m *WorkflowWatchEvent) XXX_Size() int {
+func (m *WorkflowBulkResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *WorkflowWatchEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkflowWatchEvent.DiscardUnknown(m)
+func (m *WorkflowBulkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WorkflowWatchEvent proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowBulkResult proto.InternalMessageInfo
 
-func (m *WorkflowWatchEvent) GetType() string {
+func (m *WorkflowBulkResult) GetName() string {
 	if m != nil {
-		return m.Type
+		return m.Name
 	}
 	return ""
 }
 
-func (m *WorkflowWatchEvent) GetObject() *v1alpha1.Workflow {
+func (m *WorkflowBulkResult) GetPhase() string {
 	if m != nil {
-		return m.Object
+		return m.Phase
 	}
-	return nil
+	return ""
 }
 
-type WatchEventsRequest struct {
-	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ListOptions          *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *WorkflowBulkResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
-func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchEventsRequest) ProtoMessage()    {}
-func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{15}
+type WorkflowBulkResponse struct {
+	Results              []*WorkflowBulkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *WorkflowBulkResponse) Reset()         { *m = WorkflowBulkResponse{} }
+func (m *WorkflowBulkResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkResponse) ProtoMessage()    {}
+func (*WorkflowBulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{17}
+}
+func (m *WorkflowBulkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowBulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowBulkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1054,52 +1334,48 @@ func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+func (m *WorkflowBulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkResponse.Merge(m, src)
 }
-func (m *WatchEventsRequest) XXX_Size() int {
+func (m *WorkflowBulkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
+func (m *WorkflowBulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
-
-func (m *WatchEventsRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
+var xxx_messageInfo_WorkflowBulkResponse proto.InternalMessageInfo
 
-func (m *WatchEventsRequest) GetListOptions() *v1.ListOptions {
+func (m *WorkflowBulkResponse) GetResults() []*WorkflowBulkResult {
 	if m != nil {
-		return m.ListOptions
+		return m.Results
 	}
 	return nil
 }
 
-type LogEntry struct {
-	Content              string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+type WorkflowDiffRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of another workflow in the namespace to compare with. If empty, the workflow is compared with the
+	// workflow template, cluster workflow template or cron workflow it was created from.
+	OtherWorkflow        string   `protobuf:"bytes,3,opt,name=otherWorkflow,proto3" json:"otherWorkflow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogEntry) Reset()         { *m = LogEntry{} }
-func (m *LogEntry) String() string { return proto.CompactTextString(m) }
-func (*LogEntry) ProtoMessage()    {}
-func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{16}
+func (m *WorkflowDiffRequest) Reset()         { *m = WorkflowDiffRequest{} }
+func (m *WorkflowDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDiffRequest) ProtoMessage()    {}
+func (*WorkflowDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{18}
 }
-func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+func (m *WorkflowDiffRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowDiffRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1109,52 +1385,63 @@ func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LogEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogEntry.Merge(m, src)
+func (m *WorkflowDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDiffRequest.Merge(m, src)
 }
-func (m *LogEntry) XXX_Size() int {
+func (m *WorkflowDiffRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LogEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+func (m *WorkflowDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDiffRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowDiffRequest proto.InternalMessageInfo
 
-func (m *LogEntry) GetContent() string {
+func (m *WorkflowDiffRequest) GetNamespace() string {
 	if m != nil {
-		return m.Content
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *LogEntry) GetPodName() string {
+func (m *WorkflowDiffRequest) GetName() string {
 	if m != nil {
-		return m.PodName
+		return m.Name
 	}
 	return ""
 }
 
-type WorkflowLintRequest struct {
-	Namespace            string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Workflow             *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *WorkflowDiffRequest) GetOtherWorkflow() string {
+	if m != nil {
+		return m.OtherWorkflow
+	}
+	return ""
 }
 
-func (m *WorkflowLintRequest) Reset()         { *m = WorkflowLintRequest{} }
-func (m *WorkflowLintRequest) String() string { return proto.CompactTextString(m) }
-func (*WorkflowLintRequest) ProtoMessage()    {}
-func (*WorkflowLintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{17}
+type WorkflowDiffEntry struct {
+	// The path of the field, e.g. "spec.arguments.parameters[0].value".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The value of the field in the base, as JSON, empty if the base does not have this field.
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// The value of the field in the workflow, as JSON, empty if the workflow does not have this field.
+	Workflow             string   `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *WorkflowLintRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *WorkflowDiffEntry) Reset()         { *m = WorkflowDiffEntry{} }
+func (m *WorkflowDiffEntry) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDiffEntry) ProtoMessage()    {}
+func (*WorkflowDiffEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{19}
+}
+func (m *WorkflowDiffEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WorkflowLintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowDiffEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WorkflowLintRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowDiffEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1164,54 +1451,62 @@ func (m *WorkflowLintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *WorkflowLintRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkflowLintRequest.Merge(m, src)
+func (m *WorkflowDiffEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDiffEntry.Merge(m, src)
 }
-func (m *WorkflowLintRequest) XXX_Size() int {
+func (m *WorkflowDiffEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *WorkflowLintRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkflowLintRequest.DiscardUnknown(m)
+func (m *WorkflowDiffEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDiffEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WorkflowLintRequest proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowDiffEntry proto.InternalMessageInfo
 
-func (m *WorkflowLintRequest) GetNamespace() string {
+func (m *WorkflowDiffEntry) GetPath() string {
 	if m != nil {
-		return m.Namespace
+		return m.Path
 	}
 	return ""
 }
 
-func (m *WorkflowLintRequest) GetWorkflow() *v1alpha1.Workflow {
+func (m *WorkflowDiffEntry) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *WorkflowDiffEntry) GetWorkflow() string {
 	if m != nil {
 		return m.Workflow
 	}
-	return nil
+	return ""
 }
 
-type WorkflowSubmitRequest struct {
-	Namespace            string               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ResourceKind         string               `protobuf:"bytes,2,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
-	ResourceName         string               `protobuf:"bytes,3,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
-	SubmitOptions        *v1alpha1.SubmitOpts `protobuf:"bytes,4,opt,name=submitOptions,proto3" json:"submitOptions,omitempty"`
+type WorkflowDiffResponse struct {
+	// The kind of resource the workflow was compared with, one of "Workflow", "WorkflowTemplate",
+	// "ClusterWorkflowTemplate" or "CronWorkflow".
+	BaseKind             string               `protobuf:"bytes,1,opt,name=baseKind,proto3" json:"baseKind,omitempty"`
+	BaseName             string               `protobuf:"bytes,2,opt,name=baseName,proto3" json:"baseName,omitempty"`
+	Entries              []*WorkflowDiffEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *WorkflowSubmitRequest) Reset()         { *m = WorkflowSubmitRequest{} }
-func (m *WorkflowSubmitRequest) String() string { return proto.CompactTextString(m) }
-func (*WorkflowSubmitRequest) ProtoMessage()    {}
-func (*WorkflowSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6bb75f9e833cb6, []int{18}
+func (m *WorkflowDiffResponse) Reset()         { *m = WorkflowDiffResponse{} }
+func (m *WorkflowDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDiffResponse) ProtoMessage()    {}
+func (*WorkflowDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{20}
 }
-func (m *WorkflowSubmitRequest) XXX_Unmarshal(b []byte) error {
+func (m *WorkflowDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WorkflowSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkflowDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WorkflowSubmitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkflowDiffResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1221,2413 +1516,9253 @@ func (m *WorkflowSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *WorkflowSubmitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkflowSubmitRequest.Merge(m, src)
+func (m *WorkflowDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDiffResponse.Merge(m, src)
 }
-func (m *WorkflowSubmitRequest) XXX_Size() int {
+func (m *WorkflowDiffResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *WorkflowSubmitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkflowSubmitRequest.DiscardUnknown(m)
+func (m *WorkflowDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDiffResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WorkflowSubmitRequest proto.InternalMessageInfo
+var xxx_messageInfo_WorkflowDiffResponse proto.InternalMessageInfo
 
-func (m *WorkflowSubmitRequest) GetNamespace() string {
+func (m *WorkflowDiffResponse) GetBaseKind() string {
 	if m != nil {
-		return m.Namespace
+		return m.BaseKind
 	}
 	return ""
 }
 
-func (m *WorkflowSubmitRequest) GetResourceKind() string {
+func (m *WorkflowDiffResponse) GetBaseName() string {
 	if m != nil {
-		return m.ResourceKind
+		return m.BaseName
 	}
 	return ""
 }
 
-func (m *WorkflowSubmitRequest) GetResourceName() string {
+func (m *WorkflowDiffResponse) GetEntries() []*WorkflowDiffEntry {
 	if m != nil {
-		return m.ResourceName
+		return m.Entries
+	}
+	return nil
+}
+
+type WorkflowCostsRequest struct {
+	Namespace   string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ListOptions *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// The label to group the workflows by, e.g. "team". If empty, the workflows are grouped by namespace.
+	GroupBy              string   `protobuf:"bytes,3,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowCostsRequest) Reset()         { *m = WorkflowCostsRequest{} }
+func (m *WorkflowCostsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCostsRequest) ProtoMessage()    {}
+func (*WorkflowCostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{21}
+}
+func (m *WorkflowCostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCostsRequest.Merge(m, src)
+}
+func (m *WorkflowCostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCostsRequest proto.InternalMessageInfo
+
+func (m *WorkflowCostsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *WorkflowSubmitRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
+func (m *WorkflowCostsRequest) GetListOptions() *v1.ListOptions {
 	if m != nil {
-		return m.SubmitOptions
+		return m.ListOptions
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
-	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
-	proto.RegisterType((*WorkflowListRequest)(nil), "workflow.WorkflowListRequest")
-	proto.RegisterType((*WorkflowResubmitRequest)(nil), "workflow.WorkflowResubmitRequest")
-	proto.RegisterType((*WorkflowRetryRequest)(nil), "workflow.WorkflowRetryRequest")
-	proto.RegisterType((*WorkflowResumeRequest)(nil), "workflow.WorkflowResumeRequest")
-	proto.RegisterType((*WorkflowTerminateRequest)(nil), "workflow.WorkflowTerminateRequest")
-	proto.RegisterType((*WorkflowStopRequest)(nil), "workflow.WorkflowStopRequest")
-	proto.RegisterType((*WorkflowSetRequest)(nil), "workflow.WorkflowSetRequest")
-	proto.RegisterType((*WorkflowSuspendRequest)(nil), "workflow.WorkflowSuspendRequest")
-	proto.RegisterType((*WorkflowLogRequest)(nil), "workflow.WorkflowLogRequest")
-	proto.RegisterType((*WorkflowDeleteRequest)(nil), "workflow.WorkflowDeleteRequest")
-	proto.RegisterType((*WorkflowDeleteResponse)(nil), "workflow.WorkflowDeleteResponse")
-	proto.RegisterType((*WatchWorkflowsRequest)(nil), "workflow.WatchWorkflowsRequest")
-	proto.RegisterType((*WorkflowWatchEvent)(nil), "workflow.WorkflowWatchEvent")
-	proto.RegisterType((*WatchEventsRequest)(nil), "workflow.WatchEventsRequest")
-	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
-	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
-	proto.RegisterType((*WorkflowSubmitRequest)(nil), "workflow.WorkflowSubmitRequest")
+func (m *WorkflowCostsRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("pkg/apiclient/workflow/workflow.proto", fileDescriptor_1f6bb75f9e833cb6)
+type WorkflowCosts struct {
+	// The namespace, or the value of the label, empty for workflows without the label.
+	Key                  string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Workflows            int64            `protobuf:"varint,2,opt,name=workflows,proto3" json:"workflows,omitempty"`
+	EstimatedCost        *v1alpha1.Amount `protobuf:"bytes,3,opt,name=estimatedCost,proto3" json:"estimatedCost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4f, 0x6f, 0xdc, 0xc4,
-	0x1b, 0xc7, 0x35, 0x9b, 0x36, 0x4d, 0x26, 0x7f, 0xda, 0xce, 0xaf, 0xed, 0x6f, 0xb1, 0xda, 0x34,
-	0x9d, 0x52, 0x48, 0xd3, 0xc6, 0xce, 0x26, 0x05, 0x5a, 0x24, 0x90, 0x68, 0x53, 0x22, 0xca, 0x52,
-	0x2a, 0x2f, 0x12, 0x82, 0x0b, 0x72, 0xbc, 0x13, 0xc7, 0x8d, 0xed, 0x31, 0x33, 0xb3, 0x5b, 0x85,
-	0x52, 0x24, 0xb8, 0xc0, 0x01, 0x89, 0x03, 0x47, 0x2e, 0x08, 0x81, 0xe0, 0x80, 0x00, 0x21, 0x21,
-	0x21, 0x21, 0x21, 0x8e, 0x1c, 0x2b, 0xf5, 0xca, 0x01, 0x55, 0xbc, 0x01, 0xde, 0x01, 0x9a, 0xb1,
-	0x3d, 0xb6, 0xb3, 0xdb, 0xc5, 0x24, 0x1b, 0xe8, 0x6d, 0xc6, 0xf6, 0xcc, 0xf3, 0x99, 0xef, 0x33,
-	0xf3, 0x3c, 0xcf, 0x18, 0x9e, 0x89, 0x37, 0x3d, 0xcb, 0x89, 0x7d, 0x37, 0xf0, 0x49, 0x24, 0xac,
-	0x5b, 0x94, 0x6d, 0xae, 0x07, 0xf4, 0x96, 0x6e, 0x98, 0x31, 0xa3, 0x82, 0xa2, 0xb1, 0xac, 0x6f,
-	0x1c, 0xf7, 0x28, 0xf5, 0x02, 0x22, 0xc7, 0x58, 0x4e, 0x14, 0x51, 0xe1, 0x08, 0x9f, 0x46, 0x3c,
-	0xf9, 0xce, 0xb8, 0xb0, 0x79, 0x91, 0x9b, 0x3e, 0x95, 0x6f, 0x43, 0xc7, 0xdd, 0xf0, 0x23, 0xc2,
-	0xb6, 0xac, 0xd4, 0x04, 0xb7, 0x42, 0x22, 0x1c, 0xab, 0xdb, 0xb0, 0x3c, 0x12, 0x11, 0xe6, 0x08,
-	0xd2, 0x4e, 0x47, 0xbd, 0xe4, 0xf9, 0x62, 0xa3, 0xb3, 0x66, 0xba, 0x34, 0xb4, 0x1c, 0xe6, 0xd1,
-	0x98, 0xd1, 0x9b, 0xaa, 0xb1, 0x90, 0x99, 0xe5, 0xf9, 0x24, 0x1a, 0xb1, 0xdb, 0x70, 0x82, 0x78,
-	0xc3, 0xe9, 0x9d, 0x0e, 0xe7, 0x10, 0x96, 0x4b, 0x19, 0xe9, 0x63, 0x12, 0xff, 0x52, 0x83, 0x47,
-	0x5f, 0x4d, 0x67, 0xba, 0xc2, 0x88, 0x23, 0x88, 0x4d, 0xde, 0xec, 0x10, 0x2e, 0xd0, 0x71, 0x38,
-	0x1e, 0x39, 0x21, 0xe1, 0xb1, 0xe3, 0x92, 0x3a, 0x98, 0x05, 0x73, 0xe3, 0x76, 0xfe, 0x00, 0xad,
-	0x43, 0x2d, 0x45, 0xbd, 0x36, 0x0b, 0xe6, 0x26, 0x96, 0xae, 0x99, 0x39, 0xbd, 0x99, 0xd1, 0xab,
-	0xc6, 0x1b, 0x9a, 0xde, 0xec, 0x2e, 0x9b, 0xf1, 0xa6, 0x67, 0xca, 0x05, 0x98, 0x5a, 0xda, 0x6c,
-	0x01, 0x66, 0x06, 0x62, 0xeb, 0xb9, 0x11, 0x86, 0xd0, 0x8f, 0xb8, 0x70, 0x22, 0x97, 0xbc, 0xb0,
-	0x52, 0x1f, 0x91, 0x18, 0x97, 0x6b, 0x75, 0x60, 0x17, 0x9e, 0x22, 0x0c, 0x27, 0x39, 0x61, 0x5d,
-	0xc2, 0x56, 0xd8, 0x96, 0xdd, 0x89, 0xea, 0xfb, 0x66, 0xc1, 0xdc, 0x98, 0x5d, 0x7a, 0x86, 0x5e,
-	0x83, 0x53, 0xae, 0x5a, 0xde, 0xcb, 0xb1, 0xf2, 0x53, 0x7d, 0xbf, 0x82, 0x5e, 0x36, 0x13, 0x8d,
-	0xcc, 0xa2, 0xa3, 0x72, 0x44, 0xe9, 0x28, 0xb3, 0xdb, 0x30, 0xaf, 0x14, 0x87, 0xda, 0xe5, 0x99,
-	0xf0, 0x77, 0x00, 0xa2, 0x8c, 0x7c, 0x95, 0x88, 0x4c, 0x3f, 0x04, 0xf7, 0x49, 0xb9, 0x52, 0xe9,
-	0x54, 0xbb, 0xac, 0x69, 0x6d, 0xbb, 0xa6, 0x37, 0x20, 0xf4, 0x88, 0xc8, 0x00, 0x47, 0x14, 0xe0,
-	0x62, 0x35, 0xc0, 0x55, 0x3d, 0xce, 0x2e, 0xcc, 0x81, 0x8e, 0xc1, 0xd1, 0x75, 0x9f, 0x04, 0x6d,
-	0xae, 0x34, 0x19, 0xb7, 0xd3, 0x1e, 0xfe, 0x14, 0xc0, 0xff, 0x65, 0xc8, 0x4d, 0x9f, 0x8b, 0x6a,
-	0x3e, 0x6f, 0xc1, 0x89, 0xc0, 0xe7, 0x1a, 0x30, 0x71, 0x7b, 0xa3, 0x1a, 0x60, 0x33, 0x1f, 0x68,
-	0x17, 0x67, 0x29, 0x20, 0x8e, 0x94, 0x10, 0x3d, 0xf8, 0x7f, 0xbd, 0x1d, 0x08, 0xef, 0xac, 0x85,
-	0xfe, 0x2e, 0x94, 0x35, 0xe0, 0x58, 0x48, 0x42, 0xea, 0xbf, 0x45, 0xda, 0xca, 0xcc, 0x98, 0xad,
-	0xfb, 0xf8, 0x73, 0x00, 0x8f, 0xe4, 0x96, 0x04, 0xdb, 0xda, 0xb9, 0x99, 0xf3, 0xf0, 0x30, 0x23,
-	0x5c, 0x38, 0x4c, 0xb4, 0x3a, 0xae, 0x4b, 0x38, 0x5f, 0xef, 0x04, 0xa9, 0xbd, 0xde, 0x17, 0xf2,
-	0xeb, 0x88, 0xb6, 0xc9, 0xf3, 0x72, 0xbd, 0x2d, 0x12, 0x10, 0x57, 0x50, 0x96, 0xfa, 0xa9, 0xf7,
-	0x05, 0xbe, 0x95, 0x9f, 0x53, 0xa9, 0x47, 0x48, 0x76, 0x85, 0xd9, 0x6b, 0x78, 0xe4, 0x41, 0x86,
-	0x9b, 0xb0, 0x9e, 0x19, 0x7e, 0x85, 0xb0, 0xd0, 0x8f, 0x0a, 0x31, 0xe2, 0x1f, 0xdb, 0xc6, 0x1f,
-	0x15, 0x76, 0x5e, 0x4b, 0xd0, 0xf8, 0x5f, 0x5a, 0x05, 0xaa, 0xc3, 0x03, 0x21, 0xe1, 0xdc, 0xf1,
-	0x48, 0x2a, 0x71, 0xd6, 0xc5, 0x77, 0x0b, 0xc7, 0xb7, 0xb5, 0x9b, 0xe3, 0x3b, 0x24, 0x20, 0x74,
-	0x04, 0xee, 0x8f, 0x37, 0x1c, 0x4e, 0x54, 0x88, 0x1a, 0xb7, 0x93, 0x0e, 0x9a, 0x87, 0x87, 0x68,
-	0x47, 0xc4, 0x1d, 0x71, 0xc3, 0x61, 0x4e, 0x48, 0x04, 0x61, 0xbc, 0x3e, 0xaa, 0x3e, 0xe8, 0x79,
-	0x8e, 0xaf, 0xc1, 0x63, 0x7a, 0x45, 0x1d, 0x1e, 0x93, 0xa8, 0xbd, 0x73, 0x87, 0xdd, 0x2b, 0xc8,
-	0xd3, 0xa4, 0xde, 0xce, 0xe5, 0xa9, 0xc3, 0x03, 0x31, 0x6d, 0x5f, 0x97, 0x83, 0x12, 0x51, 0xb2,
-	0x2e, 0x7a, 0x0e, 0xc2, 0x80, 0x7a, 0x59, 0x58, 0xd9, 0xa7, 0xc2, 0xca, 0xa9, 0x42, 0x58, 0x31,
-	0x65, 0xf2, 0x92, 0x41, 0xe4, 0x06, 0x6d, 0x37, 0xf5, 0x87, 0x76, 0x61, 0x90, 0xc4, 0xf1, 0x18,
-	0x89, 0x53, 0xc9, 0x54, 0x5b, 0x1e, 0x7a, 0x9e, 0xb9, 0x21, 0x51, 0x4a, 0xf7, 0xe5, 0xa1, 0xd7,
-	0xc7, 0x69, 0x85, 0x04, 0x64, 0x17, 0x5b, 0x5a, 0xa6, 0x96, 0xb6, 0x9a, 0xa2, 0x1c, 0xb9, 0x2b,
-	0xa6, 0x96, 0x95, 0xe2, 0x50, 0xbb, 0x3c, 0x13, 0xae, 0xe7, 0x8e, 0xcc, 0x28, 0x79, 0x4c, 0x23,
-	0x4e, 0xf0, 0x67, 0x72, 0x01, 0x8e, 0x70, 0x37, 0xb2, 0xf7, 0xfc, 0x21, 0x8c, 0xe1, 0x1f, 0x16,
-	0xf6, 0x8e, 0x82, 0xbd, 0xda, 0x25, 0x91, 0x92, 0x58, 0x6c, 0xc5, 0x5a, 0x62, 0xd9, 0x46, 0x6b,
-	0x70, 0x94, 0xae, 0xdd, 0x24, 0xae, 0xd8, 0x83, 0x6a, 0x22, 0x9d, 0x19, 0xbf, 0x2f, 0x71, 0x34,
-	0xc6, 0x7f, 0x28, 0x18, 0x7e, 0x16, 0x8e, 0x35, 0xa9, 0x77, 0x35, 0x12, 0x6c, 0x4b, 0x9e, 0x0b,
-	0x97, 0x46, 0x82, 0x44, 0x22, 0x35, 0x9e, 0x75, 0x8b, 0x27, 0xa6, 0x56, 0x3a, 0x31, 0xf8, 0x93,
-	0x52, 0xfe, 0x8e, 0xc4, 0x43, 0x55, 0xb3, 0xe1, 0x3f, 0x0b, 0x87, 0xab, 0x55, 0xca, 0xdc, 0x83,
-	0xf9, 0x30, 0x9c, 0x64, 0x84, 0xd3, 0x0e, 0x73, 0xc9, 0x8b, 0x7e, 0xd4, 0x4e, 0x17, 0x5d, 0x7a,
-	0x56, 0xfc, 0xa6, 0x10, 0x4a, 0x4a, 0xcf, 0x10, 0x83, 0x53, 0x49, 0xc1, 0x50, 0x0e, 0x29, 0xcd,
-	0xdd, 0x2f, 0xb6, 0x95, 0x4d, 0xcb, 0xed, 0xb2, 0x89, 0xa5, 0xdf, 0x8e, 0xc2, 0x83, 0x79, 0x16,
-	0x61, 0x5d, 0xdf, 0x25, 0xe8, 0x4b, 0x00, 0xa7, 0x93, 0xca, 0x31, 0x7b, 0x83, 0x4e, 0xe6, 0x93,
-	0xf6, 0xad, 0xba, 0x8d, 0x21, 0x7a, 0x04, 0xcf, 0xbd, 0x77, 0xef, 0x8f, 0x8f, 0x6b, 0x18, 0x9f,
-	0x50, 0x37, 0x80, 0x6e, 0xc3, 0xca, 0x6f, 0x11, 0xb7, 0xb5, 0xea, 0x77, 0x9e, 0x06, 0xf3, 0xe8,
-	0x0b, 0x00, 0x27, 0x56, 0x89, 0xd0, 0x98, 0xc7, 0x7b, 0x31, 0xf3, 0xca, 0x76, 0xa8, 0x8c, 0xe7,
-	0x15, 0xe3, 0x63, 0xe8, 0xd1, 0x81, 0x8c, 0x49, 0xfb, 0x8e, 0xe4, 0x9c, 0x92, 0x87, 0x4a, 0x07,
-	0x3d, 0x74, 0xa2, 0x97, 0xb4, 0x50, 0xd0, 0x1a, 0xd7, 0x87, 0x87, 0x2a, 0xa7, 0xc5, 0x67, 0x14,
-	0xee, 0x49, 0x34, 0x58, 0x52, 0xf4, 0x0e, 0x9c, 0x2e, 0x07, 0xe7, 0x92, 0xe3, 0xfb, 0x85, 0x6d,
-	0xa3, 0x8f, 0xe4, 0x79, 0xac, 0xc2, 0xe7, 0x94, 0xdd, 0x33, 0xe8, 0xf4, 0x76, 0xbb, 0x0b, 0x44,
-	0xc5, 0xb2, 0xa2, 0xf5, 0x45, 0x80, 0x38, 0x9c, 0x28, 0x04, 0xba, 0x92, 0x3b, 0x7b, 0xe2, 0x9f,
-	0xf1, 0x48, 0xbf, 0x54, 0x9b, 0x98, 0x3d, 0xab, 0xcc, 0x9e, 0x46, 0xa7, 0x32, 0xb3, 0x5c, 0x30,
-	0xe2, 0x84, 0x56, 0x5f, 0xa3, 0xef, 0x02, 0x38, 0x9d, 0x64, 0xa9, 0x41, 0xdb, 0xbd, 0x94, 0x6d,
-	0x8d, 0xd9, 0x07, 0x7f, 0x90, 0x26, 0xba, 0x74, 0x83, 0xcc, 0x57, 0xdb, 0x20, 0xdf, 0x03, 0x38,
-	0xa5, 0x8a, 0x78, 0x8d, 0x30, 0xd3, 0x6b, 0xa1, 0x58, 0xe5, 0x0f, 0x75, 0x33, 0x3f, 0xa1, 0x58,
-	0x2d, 0x63, 0xbe, 0x0a, 0xab, 0xc5, 0x24, 0x86, 0x3c, 0x7d, 0x3f, 0x01, 0x78, 0x28, 0xbb, 0xe3,
-	0x68, 0xee, 0x53, 0xfd, 0xb8, 0x4b, 0xf7, 0xa0, 0xa1, 0xa2, 0x5f, 0x54, 0xe8, 0x4b, 0xc6, 0x42,
-	0x45, 0xf4, 0x84, 0x44, 0xd2, 0xff, 0x00, 0xe0, 0x74, 0x72, 0x23, 0x19, 0xe4, 0xf6, 0xd2, 0x9d,
-	0x65, 0xa8, 0xe4, 0x4f, 0x2a, 0xf2, 0x45, 0xe3, 0x5c, 0x65, 0xf2, 0x90, 0x48, 0xee, 0x1f, 0x01,
-	0x3c, 0x98, 0x56, 0xc7, 0x1a, 0xbc, 0xcf, 0x76, 0x2c, 0x17, 0xd0, 0x43, 0x25, 0x7f, 0x4a, 0x91,
-	0x37, 0x8c, 0xf3, 0x95, 0xc8, 0x79, 0x02, 0x22, 0xd1, 0x7f, 0x06, 0xf0, 0xb0, 0xbe, 0x8b, 0x69,
-	0x78, 0xdc, 0x0b, 0xbf, 0xfd, 0xc2, 0x36, 0x54, 0xfc, 0x4b, 0x0a, 0x7f, 0xd9, 0x30, 0x2b, 0xe1,
-	0x8b, 0x0c, 0x45, 0x2e, 0xe0, 0x5b, 0x00, 0x27, 0xe5, 0xed, 0x4f, 0xb3, 0xf7, 0x09, 0xe3, 0x85,
-	0xdb, 0xe1, 0x50, 0xb1, 0x2f, 0x28, 0x6c, 0xd3, 0x38, 0x5b, 0x4d, 0x75, 0x41, 0x63, 0x49, 0xfc,
-	0x35, 0x80, 0x13, 0xad, 0xc1, 0x19, 0xb2, 0xb5, 0x37, 0x19, 0x72, 0x59, 0xf1, 0x2e, 0x18, 0x73,
-	0xd5, 0x78, 0x89, 0x3a, 0x94, 0x5f, 0x01, 0x38, 0x29, 0x0b, 0xc3, 0x41, 0x02, 0x17, 0x0a, 0xc7,
-	0xa1, 0x02, 0x2f, 0x28, 0xe0, 0xc7, 0x31, 0x1e, 0x0c, 0x1c, 0xf8, 0x91, 0x42, 0x7d, 0x1b, 0x1e,
-	0x48, 0xee, 0x75, 0xbc, 0x9f, 0xa8, 0xf9, 0x95, 0xd3, 0x40, 0xf9, 0xdb, 0xac, 0x78, 0xc6, 0xcf,
-	0x28, 0x5b, 0x17, 0xd0, 0x52, 0x25, 0x71, 0x6e, 0xa7, 0xf5, 0xf3, 0x1d, 0x2b, 0xa0, 0xde, 0x07,
-	0x35, 0xb0, 0x08, 0x90, 0x80, 0x93, 0x05, 0x53, 0x3b, 0x41, 0x58, 0x54, 0x08, 0xf3, 0xa8, 0x9a,
-	0x7f, 0x02, 0xea, 0x2d, 0x02, 0xf4, 0x0d, 0x80, 0xd3, 0xad, 0x72, 0xbc, 0x3f, 0xd9, 0x2f, 0xf4,
-	0xec, 0x55, 0xb4, 0xb7, 0x14, 0xf3, 0x59, 0xfc, 0x37, 0x49, 0x55, 0x07, 0xf9, 0xcb, 0xab, 0xbf,
-	0xde, 0x9f, 0x01, 0x77, 0xef, 0xcf, 0x80, 0xdf, 0xef, 0xcf, 0x80, 0xd7, 0x2f, 0x55, 0xff, 0x4f,
-	0xbd, 0xed, 0x7f, 0xfa, 0xda, 0xa8, 0xfa, 0xed, 0xbc, 0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd5, 0x18, 0x9f, 0xd1, 0x70, 0x17, 0x00, 0x00,
+func (m *WorkflowCosts) Reset()         { *m = WorkflowCosts{} }
+func (m *WorkflowCosts) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCosts) ProtoMessage()    {}
+func (*WorkflowCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{22}
+}
+func (m *WorkflowCosts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCosts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCosts.Merge(m, src)
+}
+func (m *WorkflowCosts) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCosts.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_WorkflowCosts proto.InternalMessageInfo
 
-// WorkflowServiceClient is the client API for WorkflowService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkflowServiceClient interface {
-	CreateWorkflow(ctx context.Context, in *WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	GetWorkflow(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	ListWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
-	WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error)
-	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (WorkflowService_WatchEventsClient, error)
-	DeleteWorkflow(ctx context.Context, in *WorkflowDeleteRequest, opts ...grpc.CallOption) (*WorkflowDeleteResponse, error)
-	RetryWorkflow(ctx context.Context, in *WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	ResubmitWorkflow(ctx context.Context, in *WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	ResumeWorkflow(ctx context.Context, in *WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	SuspendWorkflow(ctx context.Context, in *WorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
-	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
-	WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error)
-	SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+func (m *WorkflowCosts) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-type workflowServiceClient struct {
-	cc *grpc.ClientConn
+func (m *WorkflowCosts) GetWorkflows() int64 {
+	if m != nil {
+		return m.Workflows
+	}
+	return 0
 }
 
-func NewWorkflowServiceClient(cc *grpc.ClientConn) WorkflowServiceClient {
-	return &workflowServiceClient{cc}
+func (m *WorkflowCosts) GetEstimatedCost() *v1alpha1.Amount {
+	if m != nil {
+		return m.EstimatedCost
+	}
+	return nil
 }
 
-func (c *workflowServiceClient) CreateWorkflow(ctx context.Context, in *WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/CreateWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type WorkflowCostsResponse struct {
+	Items                []*WorkflowCosts `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *workflowServiceClient) GetWorkflow(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *WorkflowCostsResponse) Reset()         { *m = WorkflowCostsResponse{} }
+func (m *WorkflowCostsResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCostsResponse) ProtoMessage()    {}
+func (*WorkflowCostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{23}
 }
-
-func (c *workflowServiceClient) ListWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
-	out := new(v1alpha1.WorkflowList)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ListWorkflows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WorkflowCostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *WorkflowCostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCostsResponse.Merge(m, src)
+}
+func (m *WorkflowCostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCostsResponse.DiscardUnknown(m)
 }
 
-func (c *workflowServiceClient) WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[0], "/workflow.WorkflowService/WatchWorkflows", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workflowServiceWatchWorkflowsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowCostsResponse proto.InternalMessageInfo
+
+func (m *WorkflowCostsResponse) GetItems() []*WorkflowCosts {
+	if m != nil {
+		return m.Items
 	}
-	return x, nil
+	return nil
 }
 
-type WorkflowService_WatchWorkflowsClient interface {
-	Recv() (*WorkflowWatchEvent, error)
-	grpc.ClientStream
+type WorkflowSnapshotRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The time, in RFC3339 format, to get the workflow's status as of. If empty, the latest snapshot is got.
+	AsOf                 string   `protobuf:"bytes,3,opt,name=asOf,proto3" json:"asOf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type workflowServiceWatchWorkflowsClient struct {
-	grpc.ClientStream
+func (m *WorkflowSnapshotRequest) Reset()         { *m = WorkflowSnapshotRequest{} }
+func (m *WorkflowSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowSnapshotRequest) ProtoMessage()    {}
+func (*WorkflowSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{24}
 }
-
-func (x *workflowServiceWatchWorkflowsClient) Recv() (*WorkflowWatchEvent, error) {
-	m := new(WorkflowWatchEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *WorkflowSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *workflowServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (WorkflowService_WatchEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[1], "/workflow.WorkflowService/WatchEvents", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workflowServiceWatchEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *WorkflowSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type WorkflowService_WatchEventsClient interface {
-	Recv() (*v11.Event, error)
-	grpc.ClientStream
+func (m *WorkflowSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowSnapshotRequest.Merge(m, src)
 }
-
-type workflowServiceWatchEventsClient struct {
-	grpc.ClientStream
+func (m *WorkflowSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowSnapshotRequest.DiscardUnknown(m)
 }
 
-func (x *workflowServiceWatchEventsClient) Recv() (*v11.Event, error) {
-	m := new(v11.Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowSnapshotRequest proto.InternalMessageInfo
+
+func (m *WorkflowSnapshotRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return m, nil
+	return ""
 }
 
-func (c *workflowServiceClient) DeleteWorkflow(ctx context.Context, in *WorkflowDeleteRequest, opts ...grpc.CallOption) (*WorkflowDeleteResponse, error) {
-	out := new(WorkflowDeleteResponse)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/DeleteWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WorkflowSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workflowServiceClient) RetryWorkflow(ctx context.Context, in *WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RetryWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WorkflowSnapshotRequest) GetAsOf() string {
+	if m != nil {
+		return m.AsOf
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workflowServiceClient) ResubmitWorkflow(ctx context.Context, in *WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResubmitWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type WorkflowSnapshot struct {
+	// The time the snapshot was taken, which is the latest at, or before, the time requested.
+	Time                 *v1.Time                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Status               *v1alpha1.WorkflowStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *workflowServiceClient) ResumeWorkflow(ctx context.Context, in *WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResumeWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WorkflowSnapshot) Reset()         { *m = WorkflowSnapshot{} }
+func (m *WorkflowSnapshot) String() string { return proto.CompactTextString(m) }
+func (*WorkflowSnapshot) ProtoMessage()    {}
+func (*WorkflowSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{25}
+}
+func (m *WorkflowSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *WorkflowSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowSnapshot.Merge(m, src)
+}
+func (m *WorkflowSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowSnapshot.DiscardUnknown(m)
 }
 
-func (c *workflowServiceClient) SuspendWorkflow(ctx context.Context, in *WorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SuspendWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowSnapshot proto.InternalMessageInfo
+
+func (m *WorkflowSnapshot) GetTime() *v1.Time {
+	if m != nil {
+		return m.Time
 	}
-	return out, nil
+	return nil
 }
 
-func (c *workflowServiceClient) TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/TerminateWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WorkflowSnapshot) GetStatus() *v1alpha1.WorkflowStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *workflowServiceClient) StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/StopWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type WatchWorkflowsRequest struct {
+	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ListOptions          *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	Fields               string          `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (c *workflowServiceClient) SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SetWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchWorkflowsRequest) Reset()         { *m = WatchWorkflowsRequest{} }
+func (m *WatchWorkflowsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchWorkflowsRequest) ProtoMessage()    {}
+func (*WatchWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{26}
+}
+func (m *WatchWorkflowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchWorkflowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchWorkflowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *WatchWorkflowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchWorkflowsRequest.Merge(m, src)
+}
+func (m *WatchWorkflowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchWorkflowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchWorkflowsRequest.DiscardUnknown(m)
 }
 
-func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/LintWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchWorkflowsRequest proto.InternalMessageInfo
+
+func (m *WatchWorkflowsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (c *workflowServiceClient) PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[2], "/workflow.WorkflowService/PodLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workflowServicePodLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *WatchWorkflowsRequest) GetListOptions() *v1.ListOptions {
+	if m != nil {
+		return m.ListOptions
 	}
-	return x, nil
+	return nil
 }
 
-type WorkflowService_PodLogsClient interface {
-	Recv() (*LogEntry, error)
-	grpc.ClientStream
+func (m *WatchWorkflowsRequest) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
 }
 
-type workflowServicePodLogsClient struct {
-	grpc.ClientStream
+type WorkflowWatchEvent struct {
+	// the type of change
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// the workflow
+	Object               *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (x *workflowServicePodLogsClient) Recv() (*LogEntry, error) {
-	m := new(LogEntry)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *WorkflowWatchEvent) Reset()         { *m = WorkflowWatchEvent{} }
+func (m *WorkflowWatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WorkflowWatchEvent) ProtoMessage()    {}
+func (*WorkflowWatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{27}
+}
+func (m *WorkflowWatchEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowWatchEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *WorkflowWatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowWatchEvent.Merge(m, src)
+}
+func (m *WorkflowWatchEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowWatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowWatchEvent.DiscardUnknown(m)
 }
 
-func (c *workflowServiceClient) WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[3], "/workflow.WorkflowService/WorkflowLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workflowServiceWorkflowLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowWatchEvent proto.InternalMessageInfo
+
+func (m *WorkflowWatchEvent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return x, nil
+	return ""
 }
 
-type WorkflowService_WorkflowLogsClient interface {
-	Recv() (*LogEntry, error)
-	grpc.ClientStream
+func (m *WorkflowWatchEvent) GetObject() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Object
+	}
+	return nil
 }
 
-type workflowServiceWorkflowLogsClient struct {
-	grpc.ClientStream
+type WatchEventsRequest struct {
+	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ListOptions          *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (x *workflowServiceWorkflowLogsClient) Recv() (*LogEntry, error) {
-	m := new(LogEntry)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
+func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsRequest) ProtoMessage()    {}
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{28}
+}
+func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+}
+func (m *WatchEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
 }
 
-func (c *workflowServiceClient) SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SubmitWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
+
+func (m *WatchEventsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-// WorkflowServiceServer is the server API for WorkflowService service.
-type WorkflowServiceServer interface {
-	CreateWorkflow(context.Context, *WorkflowCreateRequest) (*v1alpha1.Workflow, error)
-	GetWorkflow(context.Context, *WorkflowGetRequest) (*v1alpha1.Workflow, error)
-	ListWorkflows(context.Context, *WorkflowListRequest) (*v1alpha1.WorkflowList, error)
-	WatchWorkflows(*WatchWorkflowsRequest, WorkflowService_WatchWorkflowsServer) error
-	WatchEvents(*WatchEventsRequest, WorkflowService_WatchEventsServer) error
-	DeleteWorkflow(context.Context, *WorkflowDeleteRequest) (*WorkflowDeleteResponse, error)
-	RetryWorkflow(context.Context, *WorkflowRetryRequest) (*v1alpha1.Workflow, error)
-	ResubmitWorkflow(context.Context, *WorkflowResubmitRequest) (*v1alpha1.Workflow, error)
-	ResumeWorkflow(context.Context, *WorkflowResumeRequest) (*v1alpha1.Workflow, error)
-	SuspendWorkflow(context.Context, *WorkflowSuspendRequest) (*v1alpha1.Workflow, error)
-	TerminateWorkflow(context.Context, *WorkflowTerminateRequest) (*v1alpha1.Workflow, error)
-	StopWorkflow(context.Context, *WorkflowStopRequest) (*v1alpha1.Workflow, error)
-	SetWorkflow(context.Context, *WorkflowSetRequest) (*v1alpha1.Workflow, error)
-	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
-	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
-	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
-	WorkflowLogs(*WorkflowLogRequest, WorkflowService_WorkflowLogsServer) error
-	SubmitWorkflow(context.Context, *WorkflowSubmitRequest) (*v1alpha1.Workflow, error)
+func (m *WatchEventsRequest) GetListOptions() *v1.ListOptions {
+	if m != nil {
+		return m.ListOptions
+	}
+	return nil
 }
 
-// UnimplementedWorkflowServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkflowServiceServer struct {
+type LogEntry struct {
+	Content              string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedWorkflowServiceServer) CreateWorkflow(ctx context.Context, req *WorkflowCreateRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflow not implemented")
-}
-func (*UnimplementedWorkflowServiceServer) GetWorkflow(ctx context.Context, req *WorkflowGetRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+func (m *LogEntry) Reset()         { *m = LogEntry{} }
+func (m *LogEntry) String() string { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()    {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{29}
 }
-func (*UnimplementedWorkflowServiceServer) ListWorkflows(ctx context.Context, req *WorkflowListRequest) (*v1alpha1.WorkflowList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflows not implemented")
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWorkflowServiceServer) WatchWorkflows(req *WatchWorkflowsRequest, srv WorkflowService_WatchWorkflowsServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchWorkflows not implemented")
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWorkflowServiceServer) WatchEvents(req *WatchEventsRequest, srv WorkflowService_WatchEventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+func (m *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(m, src)
 }
-func (*UnimplementedWorkflowServiceServer) DeleteWorkflow(ctx context.Context, req *WorkflowDeleteRequest) (*WorkflowDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflow not implemented")
+func (m *LogEntry) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedWorkflowServiceServer) RetryWorkflow(ctx context.Context, req *WorkflowRetryRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryWorkflow not implemented")
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
 }
-func (*UnimplementedWorkflowServiceServer) ResubmitWorkflow(ctx context.Context, req *WorkflowResubmitRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResubmitWorkflow not implemented")
+
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+
+func (m *LogEntry) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
 }
-func (*UnimplementedWorkflowServiceServer) ResumeWorkflow(ctx context.Context, req *WorkflowResumeRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflow not implemented")
+
+func (m *LogEntry) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
 }
-func (*UnimplementedWorkflowServiceServer) SuspendWorkflow(ctx context.Context, req *WorkflowSuspendRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflow not implemented")
+
+type WorkflowLintRequest struct {
+	Namespace string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Workflow  *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// strictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced templates
+	StrictTemplateRefArguments bool     `protobuf:"varint,3,opt,name=strictTemplateRefArguments,proto3" json:"strictTemplateRefArguments,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
-func (*UnimplementedWorkflowServiceServer) TerminateWorkflow(ctx context.Context, req *WorkflowTerminateRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflow not implemented")
+
+func (m *WorkflowLintRequest) Reset()         { *m = WorkflowLintRequest{} }
+func (m *WorkflowLintRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowLintRequest) ProtoMessage()    {}
+func (*WorkflowLintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{30}
 }
-func (*UnimplementedWorkflowServiceServer) StopWorkflow(ctx context.Context, req *WorkflowStopRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopWorkflow not implemented")
+func (m *WorkflowLintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWorkflowServiceServer) SetWorkflow(ctx context.Context, req *WorkflowSetRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflow not implemented")
+func (m *WorkflowLintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowLintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWorkflowServiceServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflow not implemented")
+func (m *WorkflowLintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowLintRequest.Merge(m, src)
 }
-func (*UnimplementedWorkflowServiceServer) PodLogs(req *WorkflowLogRequest, srv WorkflowService_PodLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method PodLogs not implemented")
+func (m *WorkflowLintRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedWorkflowServiceServer) WorkflowLogs(req *WorkflowLogRequest, srv WorkflowService_WorkflowLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method WorkflowLogs not implemented")
+func (m *WorkflowLintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowLintRequest.DiscardUnknown(m)
 }
-func (*UnimplementedWorkflowServiceServer) SubmitWorkflow(ctx context.Context, req *WorkflowSubmitRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
+
+var xxx_messageInfo_WorkflowLintRequest proto.InternalMessageInfo
+
+func (m *WorkflowLintRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func RegisterWorkflowServiceServer(s *grpc.Server, srv WorkflowServiceServer) {
-	s.RegisterService(&_WorkflowService_serviceDesc, srv)
+func (m *WorkflowLintRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
 }
 
-func _WorkflowService_CreateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).CreateWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/CreateWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).CreateWorkflow(ctx, req.(*WorkflowCreateRequest))
+func (m *WorkflowLintRequest) GetStrictTemplateRefArguments() bool {
+	if m != nil {
+		return m.StrictTemplateRefArguments
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _WorkflowService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).GetWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/GetWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).GetWorkflow(ctx, req.(*WorkflowGetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type WorkflowSubmitRequest struct {
+	Namespace            string               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ResourceKind         string               `protobuf:"bytes,2,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
+	ResourceName         string               `protobuf:"bytes,3,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	SubmitOptions        *v1alpha1.SubmitOpts `protobuf:"bytes,4,opt,name=submitOptions,proto3" json:"submitOptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func _WorkflowService_ListWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).ListWorkflows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/ListWorkflows",
+func (m *WorkflowSubmitRequest) Reset()         { *m = WorkflowSubmitRequest{} }
+func (m *WorkflowSubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowSubmitRequest) ProtoMessage()    {}
+func (*WorkflowSubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{31}
+}
+func (m *WorkflowSubmitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowSubmitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).ListWorkflows(ctx, req.(*WorkflowListRequest))
+}
+func (m *WorkflowSubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowSubmitRequest.Merge(m, src)
+}
+func (m *WorkflowSubmitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowSubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowSubmitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowSubmitRequest proto.InternalMessageInfo
+
+func (m *WorkflowSubmitRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_WatchWorkflows_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchWorkflowsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *WorkflowSubmitRequest) GetResourceKind() string {
+	if m != nil {
+		return m.ResourceKind
 	}
-	return srv.(WorkflowServiceServer).WatchWorkflows(m, &workflowServiceWatchWorkflowsServer{stream})
+	return ""
 }
 
-type WorkflowService_WatchWorkflowsServer interface {
-	Send(*WorkflowWatchEvent) error
-	grpc.ServerStream
+func (m *WorkflowSubmitRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
 }
 
-type workflowServiceWatchWorkflowsServer struct {
-	grpc.ServerStream
+func (m *WorkflowSubmitRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
+	if m != nil {
+		return m.SubmitOptions
+	}
+	return nil
 }
 
-func (x *workflowServiceWatchWorkflowsServer) Send(m *WorkflowWatchEvent) error {
-	return x.ServerStream.SendMsg(m)
+type WorkflowRenderRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The workflow to render, which may reference a workflow template with spec.workflowTemplateRef. If not specified,
+	// the workflow is created from the resource, as per submitting it.
+	Workflow             *v1alpha1.Workflow   `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	ResourceKind         string               `protobuf:"bytes,3,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
+	ResourceName         string               `protobuf:"bytes,4,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	SubmitOptions        *v1alpha1.SubmitOpts `protobuf:"bytes,5,opt,name=submitOptions,proto3" json:"submitOptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func _WorkflowService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchEventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *WorkflowRenderRequest) Reset()         { *m = WorkflowRenderRequest{} }
+func (m *WorkflowRenderRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowRenderRequest) ProtoMessage()    {}
+func (*WorkflowRenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{32}
+}
+func (m *WorkflowRenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowRenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowRenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(WorkflowServiceServer).WatchEvents(m, &workflowServiceWatchEventsServer{stream})
 }
-
-type WorkflowService_WatchEventsServer interface {
-	Send(*v11.Event) error
-	grpc.ServerStream
+func (m *WorkflowRenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowRenderRequest.Merge(m, src)
 }
-
-type workflowServiceWatchEventsServer struct {
-	grpc.ServerStream
+func (m *WorkflowRenderRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *workflowServiceWatchEventsServer) Send(m *v11.Event) error {
-	return x.ServerStream.SendMsg(m)
+func (m *WorkflowRenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowRenderRequest.DiscardUnknown(m)
 }
 
-func _WorkflowService_DeleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).DeleteWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/DeleteWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).DeleteWorkflow(ctx, req.(*WorkflowDeleteRequest))
+var xxx_messageInfo_WorkflowRenderRequest proto.InternalMessageInfo
+
+func (m *WorkflowRenderRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_RetryWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowRetryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).RetryWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/RetryWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).RetryWorkflow(ctx, req.(*WorkflowRetryRequest))
+func (m *WorkflowRenderRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WorkflowService_ResubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowResubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).ResubmitWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/ResubmitWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).ResubmitWorkflow(ctx, req.(*WorkflowResubmitRequest))
+func (m *WorkflowRenderRequest) GetResourceKind() string {
+	if m != nil {
+		return m.ResourceKind
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_ResumeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowResumeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/ResumeWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, req.(*WorkflowResumeRequest))
+func (m *WorkflowRenderRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_SuspendWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowSuspendRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).SuspendWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/SuspendWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).SuspendWorkflow(ctx, req.(*WorkflowSuspendRequest))
+func (m *WorkflowRenderRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
+	if m != nil {
+		return m.SubmitOptions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WorkflowService_TerminateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowTerminateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).TerminateWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/TerminateWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).TerminateWorkflow(ctx, req.(*WorkflowTerminateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type WorkflowNodePodRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID of the node, which must be a pod node.
+	NodeId               string   `protobuf:"bytes,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _WorkflowService_StopWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowStopRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).StopWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/StopWorkflow",
+func (m *WorkflowNodePodRequest) Reset()         { *m = WorkflowNodePodRequest{} }
+func (m *WorkflowNodePodRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowNodePodRequest) ProtoMessage()    {}
+func (*WorkflowNodePodRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{33}
+}
+func (m *WorkflowNodePodRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowNodePodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowNodePodRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).StopWorkflow(ctx, req.(*WorkflowStopRequest))
+}
+func (m *WorkflowNodePodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowNodePodRequest.Merge(m, src)
+}
+func (m *WorkflowNodePodRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowNodePodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowNodePodRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowNodePodRequest proto.InternalMessageInfo
+
+func (m *WorkflowNodePodRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_SetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *WorkflowNodePodRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).SetWorkflow(ctx, in)
+	return ""
+}
+
+func (m *WorkflowNodePodRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/SetWorkflow",
+	return ""
+}
+
+type WorkflowExplainRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID, name, or display name of the node, or the name of a step, or task, that does not have a node yet.
+	NodeName             string   `protobuf:"bytes,3,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExplainRequest) Reset()         { *m = WorkflowExplainRequest{} }
+func (m *WorkflowExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExplainRequest) ProtoMessage()    {}
+func (*WorkflowExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{34}
+}
+func (m *WorkflowExplainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExplainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).SetWorkflow(ctx, req.(*WorkflowSetRequest))
+}
+func (m *WorkflowExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExplainRequest.Merge(m, src)
+}
+func (m *WorkflowExplainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExplainRequest proto.InternalMessageInfo
+
+func (m *WorkflowExplainRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_LintWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowLintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *WorkflowExplainRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).LintWorkflow(ctx, in)
+	return ""
+}
+
+func (m *WorkflowExplainRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/LintWorkflow",
+	return ""
+}
+
+type NodeExplanation struct {
+	// The kind of the reason, e.g. Dependencies, When, Synchronization, Parallelism, Scheduling, Suspended, or Workflow.
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeExplanation) Reset()         { *m = NodeExplanation{} }
+func (m *NodeExplanation) String() string { return proto.CompactTextString(m) }
+func (*NodeExplanation) ProtoMessage()    {}
+func (*NodeExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{35}
+}
+func (m *NodeExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).LintWorkflow(ctx, req.(*WorkflowLintRequest))
+}
+func (m *NodeExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeExplanation.Merge(m, src)
+}
+func (m *NodeExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeExplanation proto.InternalMessageInfo
+
+func (m *NodeExplanation) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WorkflowService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WorkflowLogRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *NodeExplanation) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return srv.(WorkflowServiceServer).PodLogs(m, &workflowServicePodLogsServer{stream})
+	return ""
 }
 
-type WorkflowService_PodLogsServer interface {
-	Send(*LogEntry) error
-	grpc.ServerStream
+type WorkflowExplainResponse struct {
+	// The ID of the node, or empty if the step, or task, does not have a node yet.
+	NodeId   string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeName string `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	// The phase of the node, or empty if it does not have a node yet.
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// Why the node has not started, or completed, in the order they apply.
+	Reasons              []*NodeExplanation `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-type workflowServicePodLogsServer struct {
-	grpc.ServerStream
+func (m *WorkflowExplainResponse) Reset()         { *m = WorkflowExplainResponse{} }
+func (m *WorkflowExplainResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExplainResponse) ProtoMessage()    {}
+func (*WorkflowExplainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{36}
+}
+func (m *WorkflowExplainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExplainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExplainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExplainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExplainResponse.Merge(m, src)
+}
+func (m *WorkflowExplainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExplainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExplainResponse.DiscardUnknown(m)
 }
 
-func (x *workflowServicePodLogsServer) Send(m *LogEntry) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_WorkflowExplainResponse proto.InternalMessageInfo
+
+func (m *WorkflowExplainResponse) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
 }
 
-func _WorkflowService_WorkflowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WorkflowLogRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *WorkflowExplainResponse) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	return srv.(WorkflowServiceServer).WorkflowLogs(m, &workflowServiceWorkflowLogsServer{stream})
+	return ""
 }
 
-type WorkflowService_WorkflowLogsServer interface {
-	Send(*LogEntry) error
-	grpc.ServerStream
+func (m *WorkflowExplainResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
 }
 
-type workflowServiceWorkflowLogsServer struct {
-	grpc.ServerStream
+func (m *WorkflowExplainResponse) GetReasons() []*NodeExplanation {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
 }
 
-func (x *workflowServiceWorkflowLogsServer) Send(m *LogEntry) error {
-	return x.ServerStream.SendMsg(m)
+type WorkflowTimelineRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _WorkflowService_SubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowSubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *WorkflowTimelineRequest) Reset()         { *m = WorkflowTimelineRequest{} }
+func (m *WorkflowTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTimelineRequest) ProtoMessage()    {}
+func (*WorkflowTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{37}
+}
+func (m *WorkflowTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(WorkflowServiceServer).SubmitWorkflow(ctx, in)
+}
+func (m *WorkflowTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTimelineRequest.Merge(m, src)
+}
+func (m *WorkflowTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTimelineRequest proto.InternalMessageInfo
+
+func (m *WorkflowTimelineRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflow.WorkflowService/SubmitWorkflow",
+	return ""
+}
+
+func (m *WorkflowTimelineRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkflowServiceServer).SubmitWorkflow(ctx, req.(*WorkflowSubmitRequest))
+	return ""
+}
+
+type TimelineEvent struct {
+	Time *v1.Time `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The kind of the event: Workflow, Node, Retry, Pod, or Artifact.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The ID of the node the event is about, or empty if it is about the workflow.
+	NodeId string `protobuf:"bytes,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// The display name of the node the event is about.
+	NodeName string `protobuf:"bytes,4,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	// The reason for the event, e.g. the phase of the node, or the reason of the Kubernetes event.
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimelineEvent) Reset()         { *m = TimelineEvent{} }
+func (m *TimelineEvent) String() string { return proto.CompactTextString(m) }
+func (*TimelineEvent) ProtoMessage()    {}
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{38}
+}
+func (m *TimelineEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelineEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelineEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TimelineEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelineEvent.Merge(m, src)
+}
+func (m *TimelineEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelineEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelineEvent.DiscardUnknown(m)
 }
 
-var _WorkflowService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "workflow.WorkflowService",
-	HandlerType: (*WorkflowServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateWorkflow",
-			Handler:    _WorkflowService_CreateWorkflow_Handler,
-		},
-		{
-			MethodName: "GetWorkflow",
-			Handler:    _WorkflowService_GetWorkflow_Handler,
-		},
-		{
-			MethodName: "ListWorkflows",
-			Handler:    _WorkflowService_ListWorkflows_Handler,
-		},
-		{
-			MethodName: "DeleteWorkflow",
-			Handler:    _WorkflowService_DeleteWorkflow_Handler,
-		},
-		{
-			MethodName: "RetryWorkflow",
-			Handler:    _WorkflowService_RetryWorkflow_Handler,
-		},
-		{
-			MethodName: "ResubmitWorkflow",
-			Handler:    _WorkflowService_ResubmitWorkflow_Handler,
-		},
-		{
-			MethodName: "ResumeWorkflow",
-			Handler:    _WorkflowService_ResumeWorkflow_Handler,
-		},
-		{
-			MethodName: "SuspendWorkflow",
-			Handler:    _WorkflowService_SuspendWorkflow_Handler,
-		},
-		{
-			MethodName: "TerminateWorkflow",
-			Handler:    _WorkflowService_TerminateWorkflow_Handler,
-		},
-		{
-			MethodName: "StopWorkflow",
-			Handler:    _WorkflowService_StopWorkflow_Handler,
-		},
-		{
-			MethodName: "SetWorkflow",
-			Handler:    _WorkflowService_SetWorkflow_Handler,
-		},
-		{
-			MethodName: "LintWorkflow",
-			Handler:    _WorkflowService_LintWorkflow_Handler,
-		},
-		{
-			MethodName: "SubmitWorkflow",
-			Handler:    _WorkflowService_SubmitWorkflow_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WatchWorkflows",
-			Handler:       _WorkflowService_WatchWorkflows_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WatchEvents",
-			Handler:       _WorkflowService_WatchEvents_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PodLogs",
-			Handler:       _WorkflowService_PodLogs_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WorkflowLogs",
-			Handler:       _WorkflowService_WorkflowLogs_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/apiclient/workflow/workflow.proto",
-}
+var xxx_messageInfo_TimelineEvent proto.InternalMessageInfo
 
-func (m *WorkflowCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimelineEvent) GetTime() *v1.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WorkflowCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TimelineEvent) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-func (m *WorkflowCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CreateOptions != nil {
-		{
-			size, err := m.CreateOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ServerDryRun {
-		i--
-		if m.ServerDryRun {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.InstanceID) > 0 {
-		i -= len(m.InstanceID)
-		copy(dAtA[i:], m.InstanceID)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.InstanceID)))
-		i--
-		dAtA[i] = 0x1a
+func (m *TimelineEvent) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	if m.Workflow != nil {
-		{
-			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TimelineEvent) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TimelineEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *WorkflowGetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimelineEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WorkflowGetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowTimeline struct {
+	// The events, in the order they happened.
+	Events               []*TimelineEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *WorkflowGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Fields) > 0 {
-		i -= len(m.Fields)
-		copy(dAtA[i:], m.Fields)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GetOptions != nil {
-		{
-			size, err := m.GetOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+func (m *WorkflowTimeline) Reset()         { *m = WorkflowTimeline{} }
+func (m *WorkflowTimeline) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTimeline) ProtoMessage()    {}
+func (*WorkflowTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{39}
+}
+func (m *WorkflowTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WorkflowTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTimeline.Merge(m, src)
+}
+func (m *WorkflowTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTimeline.DiscardUnknown(m)
 }
 
-func (m *WorkflowListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowTimeline proto.InternalMessageInfo
+
+func (m *WorkflowTimeline) GetEvents() []*TimelineEvent {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WorkflowListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
+	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
+	proto.RegisterType((*WorkflowListRequest)(nil), "workflow.WorkflowListRequest")
+	proto.RegisterType((*WorkflowResubmitRequest)(nil), "workflow.WorkflowResubmitRequest")
+	proto.RegisterType((*WorkflowRetryRequest)(nil), "workflow.WorkflowRetryRequest")
+	proto.RegisterType((*WorkflowResumeRequest)(nil), "workflow.WorkflowResumeRequest")
+	proto.RegisterType((*WorkflowApproveRequest)(nil), "workflow.WorkflowApproveRequest")
+	proto.RegisterType((*WorkflowRejectRequest)(nil), "workflow.WorkflowRejectRequest")
+	proto.RegisterType((*WorkflowTerminateRequest)(nil), "workflow.WorkflowTerminateRequest")
+	proto.RegisterType((*WorkflowStopRequest)(nil), "workflow.WorkflowStopRequest")
+	proto.RegisterType((*WorkflowSetRequest)(nil), "workflow.WorkflowSetRequest")
+	proto.RegisterType((*WorkflowSuspendRequest)(nil), "workflow.WorkflowSuspendRequest")
+	proto.RegisterType((*WorkflowLogRequest)(nil), "workflow.WorkflowLogRequest")
+	proto.RegisterType((*WorkflowDeleteRequest)(nil), "workflow.WorkflowDeleteRequest")
+	proto.RegisterType((*WorkflowDeleteResponse)(nil), "workflow.WorkflowDeleteResponse")
+	proto.RegisterType((*WorkflowBulkRequest)(nil), "workflow.WorkflowBulkRequest")
+	proto.RegisterType((*WorkflowBulkResult)(nil), "workflow.WorkflowBulkResult")
+	proto.RegisterType((*WorkflowBulkResponse)(nil), "workflow.WorkflowBulkResponse")
+	proto.RegisterType((*WorkflowDiffRequest)(nil), "workflow.WorkflowDiffRequest")
+	proto.RegisterType((*WorkflowDiffEntry)(nil), "workflow.WorkflowDiffEntry")
+	proto.RegisterType((*WorkflowDiffResponse)(nil), "workflow.WorkflowDiffResponse")
+	proto.RegisterType((*WorkflowCostsRequest)(nil), "workflow.WorkflowCostsRequest")
+	proto.RegisterType((*WorkflowCosts)(nil), "workflow.WorkflowCosts")
+	proto.RegisterType((*WorkflowCostsResponse)(nil), "workflow.WorkflowCostsResponse")
+	proto.RegisterType((*WorkflowSnapshotRequest)(nil), "workflow.WorkflowSnapshotRequest")
+	proto.RegisterType((*WorkflowSnapshot)(nil), "workflow.WorkflowSnapshot")
+	proto.RegisterType((*WatchWorkflowsRequest)(nil), "workflow.WatchWorkflowsRequest")
+	proto.RegisterType((*WorkflowWatchEvent)(nil), "workflow.WorkflowWatchEvent")
+	proto.RegisterType((*WatchEventsRequest)(nil), "workflow.WatchEventsRequest")
+	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
+	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
+	proto.RegisterType((*WorkflowSubmitRequest)(nil), "workflow.WorkflowSubmitRequest")
+	proto.RegisterType((*WorkflowRenderRequest)(nil), "workflow.WorkflowRenderRequest")
+	proto.RegisterType((*WorkflowNodePodRequest)(nil), "workflow.WorkflowNodePodRequest")
+	proto.RegisterType((*WorkflowExplainRequest)(nil), "workflow.WorkflowExplainRequest")
+	proto.RegisterType((*NodeExplanation)(nil), "workflow.NodeExplanation")
+	proto.RegisterType((*WorkflowExplainResponse)(nil), "workflow.WorkflowExplainResponse")
+	proto.RegisterType((*WorkflowTimelineRequest)(nil), "workflow.WorkflowTimelineRequest")
+	proto.RegisterType((*TimelineEvent)(nil), "workflow.TimelineEvent")
+	proto.RegisterType((*WorkflowTimeline)(nil), "workflow.WorkflowTimeline")
 }
 
-func (m *WorkflowListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Fields) > 0 {
-		i -= len(m.Fields)
-		copy(dAtA[i:], m.Fields)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("pkg/apiclient/workflow/workflow.proto", fileDescriptor_1f6bb75f9e833cb6)
 }
 
-func (m *WorkflowResubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_1f6bb75f9e833cb6 = []byte{
+	// 2396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x57, 0xcf, 0x8c, 0xc7, 0xf6, 0xe7, 0x38, 0x8f, 0xca, 0x6b, 0xb6, 0xc9, 0x3a, 0x76, 0x6d,
+	0x02, 0x8e, 0x13, 0xcf, 0xf8, 0x91, 0x7d, 0x64, 0x25, 0xb2, 0xca, 0x9b, 0xdd, 0x35, 0xd9, 0xa8,
+	0x27, 0xd2, 0x0a, 0x2e, 0xab, 0xf6, 0x4c, 0xcd, 0xb8, 0xd7, 0xd3, 0x0f, 0xba, 0x6a, 0x1c, 0xcc,
+	0x12, 0x5e, 0x12, 0x02, 0x01, 0x12, 0xaf, 0xcb, 0x9e, 0x79, 0x08, 0x0e, 0x68, 0x57, 0x42, 0x20,
+	0x21, 0x40, 0xdc, 0x90, 0x80, 0xd3, 0x4a, 0x9c, 0x10, 0x17, 0x14, 0x71, 0xe5, 0xc0, 0x7f, 0xb0,
+	0xaa, 0xea, 0xaa, 0xee, 0xaa, 0x79, 0xa5, 0xe3, 0x8c, 0xd7, 0xb9, 0x75, 0x55, 0x57, 0x7f, 0xdf,
+	0xaf, 0x7e, 0x5f, 0xd5, 0xf7, 0xa8, 0x6a, 0x38, 0x1f, 0x6d, 0xb7, 0x6b, 0x6e, 0xe4, 0x35, 0x3a,
+	0x1e, 0x09, 0x58, 0xed, 0x41, 0x18, 0x6f, 0xb7, 0x3a, 0xe1, 0x83, 0xf4, 0xa1, 0x1a, 0xc5, 0x21,
+	0x0b, 0xd1, 0x94, 0x6a, 0xdb, 0x67, 0xda, 0x61, 0xd8, 0xee, 0x10, 0xfe, 0x4d, 0xcd, 0x0d, 0x82,
+	0x90, 0xb9, 0xcc, 0x0b, 0x03, 0x9a, 0x8c, 0xb3, 0x2f, 0x6f, 0xbf, 0x42, 0xab, 0x5e, 0xc8, 0xdf,
+	0xfa, 0x6e, 0x63, 0xcb, 0x0b, 0x48, 0xbc, 0x5b, 0x93, 0x2a, 0x68, 0xcd, 0x27, 0xcc, 0xad, 0xed,
+	0xac, 0xd6, 0xda, 0x24, 0x20, 0xb1, 0xcb, 0x48, 0x53, 0x7e, 0xf5, 0xf9, 0xb6, 0xc7, 0xb6, 0xba,
+	0x9b, 0xd5, 0x46, 0xe8, 0xd7, 0xdc, 0xb8, 0x1d, 0x46, 0x71, 0xf8, 0xae, 0x78, 0x58, 0x56, 0x6a,
+	0x69, 0x26, 0x24, 0x85, 0xb8, 0xb3, 0xea, 0x76, 0xa2, 0x2d, 0xb7, 0x5f, 0x1c, 0xce, 0x40, 0xd4,
+	0x1a, 0x61, 0x4c, 0x06, 0xa8, 0xc4, 0xbf, 0x2b, 0xc2, 0xc9, 0xb7, 0xa5, 0xa4, 0x1b, 0x31, 0x71,
+	0x19, 0x71, 0xc8, 0x97, 0xba, 0x84, 0x32, 0x74, 0x06, 0xa6, 0x03, 0xd7, 0x27, 0x34, 0x72, 0x1b,
+	0xa4, 0x62, 0xcd, 0x5b, 0x8b, 0xd3, 0x4e, 0xd6, 0x81, 0x5a, 0x90, 0x52, 0x51, 0x29, 0xcc, 0x5b,
+	0x8b, 0x33, 0x6b, 0x6f, 0x54, 0x33, 0xf4, 0x55, 0x85, 0x5e, 0x3c, 0xbc, 0x93, 0xa2, 0xaf, 0xee,
+	0xac, 0x57, 0xa3, 0xed, 0x76, 0x95, 0x4f, 0xa0, 0x9a, 0x52, 0xab, 0x26, 0x50, 0x55, 0x40, 0x9c,
+	0x54, 0x36, 0xc2, 0x00, 0x5e, 0x40, 0x99, 0x1b, 0x34, 0xc8, 0xeb, 0x37, 0x2b, 0x45, 0x0e, 0xe3,
+	0x7a, 0xa1, 0x62, 0x39, 0x5a, 0x2f, 0xc2, 0x70, 0x88, 0x92, 0x78, 0x87, 0xc4, 0x37, 0xe3, 0x5d,
+	0xa7, 0x1b, 0x54, 0x4a, 0xf3, 0xd6, 0xe2, 0x94, 0x63, 0xf4, 0xa1, 0x2f, 0xc0, 0x6c, 0x43, 0x4c,
+	0xef, 0xad, 0x48, 0xd8, 0xa9, 0x32, 0x21, 0x40, 0xaf, 0x57, 0x13, 0x8e, 0xaa, 0xba, 0xa1, 0x32,
+	0x88, 0xdc, 0x50, 0xd5, 0x9d, 0xd5, 0xea, 0x0d, 0xfd, 0x53, 0xc7, 0x94, 0x84, 0x62, 0x98, 0xa5,
+	0xdd, 0x4d, 0xdf, 0x63, 0x4a, 0x74, 0x59, 0x88, 0xde, 0x78, 0x7a, 0x3e, 0xea, 0x4a, 0x2c, 0x75,
+	0x4c, 0x15, 0xf8, 0x1f, 0x16, 0x20, 0xc5, 0xd6, 0x1d, 0xc2, 0x94, 0xcd, 0x10, 0x94, 0xb8, 0x89,
+	0xa4, 0xb9, 0xc4, 0xb3, 0x69, 0xc7, 0x42, 0xaf, 0x1d, 0xef, 0x01, 0xb4, 0x49, 0x8a, 0xbc, 0x28,
+	0x90, 0xaf, 0xe4, 0x23, 0xe5, 0x4e, 0xfa, 0x9d, 0xa3, 0xc9, 0x40, 0xa7, 0xa0, 0xdc, 0xf2, 0x48,
+	0xa7, 0x49, 0x85, 0x1d, 0xa6, 0x1d, 0xd9, 0x42, 0x15, 0x98, 0x6c, 0x74, 0xba, 0x94, 0x91, 0x58,
+	0x70, 0x3f, 0xed, 0xa8, 0x26, 0xfe, 0xa3, 0x05, 0xc7, 0xd5, 0x64, 0x36, 0x3c, 0xca, 0xf2, 0xad,
+	0xc0, 0x3a, 0xcc, 0x74, 0x3c, 0x9a, 0x42, 0x4f, 0x16, 0xe1, 0x6a, 0x3e, 0xe8, 0x1b, 0xd9, 0x87,
+	0x8e, 0x2e, 0x45, 0x03, 0x5f, 0x1c, 0x06, 0xbe, 0x64, 0x82, 0x6f, 0xc3, 0xe9, 0x74, 0xd9, 0x92,
+	0xc4, 0x48, 0x7b, 0xb7, 0x86, 0x0d, 0x53, 0x3e, 0xf1, 0x43, 0xef, 0x2b, 0xa4, 0x29, 0x00, 0x4c,
+	0x39, 0x69, 0x1b, 0x7f, 0xaf, 0x00, 0x27, 0x32, 0x4d, 0x2c, 0xde, 0xdd, 0xbb, 0x9a, 0x4b, 0x70,
+	0x2c, 0x26, 0x94, 0xb9, 0x31, 0xab, 0x77, 0x1b, 0x0d, 0x42, 0x69, 0xab, 0xdb, 0x91, 0xfa, 0xfa,
+	0x5f, 0xf0, 0xd1, 0x41, 0xd8, 0x24, 0xb7, 0x39, 0x13, 0x75, 0xd2, 0x21, 0x0d, 0x16, 0x2a, 0x16,
+	0xfa, 0x5f, 0xa0, 0x39, 0x80, 0xc8, 0x8d, 0x5d, 0x9f, 0x30, 0x12, 0xf3, 0x5d, 0x56, 0x5c, 0x9c,
+	0x76, 0xb4, 0x1e, 0xbe, 0x59, 0xa5, 0x8a, 0xbb, 0x61, 0x93, 0xf0, 0xcd, 0xc2, 0x47, 0x18, 0x7d,
+	0x68, 0x1e, 0x66, 0x64, 0xfb, 0x76, 0x1c, 0xfa, 0x95, 0x49, 0xa1, 0x4b, 0xef, 0xc2, 0x0f, 0xe0,
+	0xa4, 0xce, 0xba, 0x4f, 0x9e, 0x8a, 0x8c, 0xfe, 0xe9, 0x15, 0x87, 0x4c, 0x0f, 0xff, 0xc4, 0x82,
+	0x53, 0x4a, 0xf3, 0xb5, 0x28, 0x8a, 0xc3, 0x9d, 0x4f, 0x4a, 0xb5, 0x58, 0x83, 0xa1, 0xef, 0x93,
+	0x80, 0xa5, 0x6b, 0x30, 0x69, 0xe2, 0x1f, 0x5b, 0x3a, 0x1d, 0xef, 0x92, 0x06, 0x3b, 0x78, 0x4c,
+	0x1b, 0x50, 0x51, 0x90, 0xee, 0x93, 0xd8, 0xf7, 0x02, 0x2d, 0xb4, 0x3c, 0x31, 0x2a, 0xfc, 0x43,
+	0xcd, 0x45, 0xd4, 0x59, 0x18, 0x7d, 0x82, 0xf3, 0xf3, 0x09, 0xa5, 0x6e, 0x9b, 0xa8, 0xf9, 0xc9,
+	0x26, 0xfe, 0x48, 0xf3, 0xc0, 0x75, 0xc2, 0x0e, 0x1c, 0x10, 0x3a, 0x01, 0x13, 0xd1, 0x96, 0x4b,
+	0x89, 0xf4, 0xae, 0x49, 0x03, 0x2d, 0xc1, 0xd1, 0xb0, 0xcb, 0xa2, 0x2e, 0xbb, 0x97, 0x6d, 0xca,
+	0xb2, 0x18, 0xd0, 0xd7, 0x8f, 0xdf, 0xc8, 0x96, 0x76, 0xbd, 0x4b, 0x23, 0x12, 0x34, 0xf7, 0x6e,
+	0xb0, 0xff, 0x69, 0xf4, 0x6c, 0x84, 0xed, 0xbd, 0xd3, 0x53, 0x81, 0xc9, 0x28, 0x6c, 0xde, 0xe5,
+	0x1f, 0x25, 0xa4, 0xa8, 0x26, 0xba, 0x06, 0xd0, 0x09, 0xdb, 0xca, 0xff, 0x97, 0x84, 0xff, 0x5f,
+	0xd0, 0xfc, 0x7f, 0x95, 0xe7, 0x3c, 0xdc, 0xdb, 0xdf, 0x0b, 0x9b, 0x1b, 0xe9, 0x40, 0x47, 0xfb,
+	0x88, 0xc3, 0x69, 0xc7, 0x24, 0x92, 0x94, 0x89, 0x67, 0xee, 0x83, 0xa9, 0x32, 0x43, 0xc2, 0x54,
+	0xda, 0xd6, 0xc3, 0xc0, 0xa4, 0x19, 0x06, 0x7e, 0xa1, 0x6d, 0xc1, 0x9b, 0xa4, 0x43, 0x9e, 0x62,
+	0xb1, 0xf3, 0x5c, 0xa5, 0x29, 0x44, 0x98, 0x61, 0x39, 0x67, 0xae, 0x72, 0x53, 0xff, 0xd4, 0x31,
+	0x25, 0xe1, 0x4a, 0x66, 0x62, 0x85, 0x92, 0x46, 0x61, 0x40, 0x09, 0x7e, 0xbf, 0x98, 0xed, 0xb0,
+	0xeb, 0xdd, 0xce, 0x76, 0xbe, 0x20, 0x7c, 0x06, 0xa6, 0xc3, 0x88, 0xc4, 0x22, 0xf7, 0x55, 0x13,
+	0x49, 0x3b, 0xf8, 0x92, 0x14, 0x43, 0x2b, 0x45, 0xe1, 0xe4, 0x93, 0x46, 0x6f, 0xe0, 0x2e, 0x8d,
+	0x25, 0x70, 0x0f, 0x0c, 0x69, 0x13, 0x4f, 0x14, 0xd2, 0xca, 0x39, 0xf6, 0xdc, 0xa4, 0xb9, 0xe7,
+	0xcc, 0x60, 0x37, 0xf5, 0xd8, 0x60, 0x37, 0xfd, 0xf8, 0x60, 0x07, 0xfd, 0xc1, 0xee, 0x3e, 0x20,
+	0xd3, 0x32, 0xb4, 0xdb, 0x19, 0xbc, 0xae, 0x52, 0x1f, 0x50, 0xd0, 0x7d, 0xc0, 0x09, 0x98, 0x20,
+	0x71, 0x9c, 0x7a, 0x95, 0xa4, 0x81, 0xef, 0xc2, 0x89, 0x1e, 0xa9, 0x62, 0x21, 0xa0, 0x97, 0x60,
+	0x32, 0x16, 0x1a, 0x68, 0xc5, 0x9a, 0x2f, 0x2e, 0xce, 0xac, 0x9d, 0xc9, 0x32, 0xd3, 0x7e, 0x18,
+	0x8e, 0x1a, 0x8c, 0xfd, 0x6c, 0xfd, 0xdc, 0xf4, 0x5a, 0xad, 0x7c, 0xeb, 0x47, 0x4d, 0xa2, 0xa0,
+	0x4d, 0xe2, 0x1c, 0xcc, 0x86, 0x6c, 0x8b, 0xc4, 0x4a, 0x9a, 0x84, 0x6d, 0x76, 0xe2, 0xb7, 0xe1,
+	0x98, 0xae, 0xee, 0x56, 0xc0, 0xe2, 0x5d, 0x2e, 0x2e, 0x72, 0xd9, 0x96, 0xe2, 0x84, 0x3f, 0xf3,
+	0xbe, 0xcd, 0x8c, 0x12, 0xf1, 0xcc, 0xf7, 0xf8, 0x03, 0x53, 0x7a, 0xda, 0xc6, 0xdf, 0xb6, 0xe0,
+	0x84, 0x2e, 0x39, 0x25, 0xc6, 0x86, 0x29, 0xfe, 0xf1, 0x9b, 0x5e, 0xd0, 0x94, 0x0a, 0xd2, 0xb6,
+	0x7a, 0x77, 0x37, 0x9b, 0x4b, 0xda, 0x46, 0x2f, 0xc2, 0x24, 0x09, 0x58, 0xec, 0xc9, 0x7d, 0x30,
+	0xb3, 0xf6, 0xa9, 0x7e, 0x42, 0xd3, 0x29, 0x38, 0x6a, 0x2c, 0xfe, 0xb9, 0x86, 0xe3, 0x46, 0x48,
+	0x19, 0x3d, 0xc0, 0xb4, 0xb8, 0x02, 0x93, 0xed, 0x38, 0xec, 0x46, 0xd7, 0x77, 0x95, 0x13, 0x96,
+	0x4d, 0xfc, 0xa1, 0x05, 0xb3, 0x06, 0x4a, 0x74, 0x14, 0x8a, 0xdb, 0x64, 0x57, 0x02, 0xe3, 0x8f,
+	0x1c, 0xb0, 0x9a, 0x70, 0x02, 0xa8, 0xe8, 0x64, 0x1d, 0x28, 0x80, 0x59, 0x42, 0x99, 0xe7, 0xf3,
+	0xa2, 0x94, 0x4b, 0x90, 0xde, 0xee, 0x73, 0x4f, 0x5f, 0x3e, 0x5d, 0xf3, 0xc3, 0x6e, 0xc0, 0x1c,
+	0x53, 0x3c, 0xbe, 0x0d, 0x27, 0x0d, 0xc0, 0xa9, 0x7d, 0x97, 0x61, 0xc2, 0x63, 0xc4, 0x57, 0xcb,
+	0xfe, 0x74, 0xbf, 0x95, 0x92, 0xf1, 0xc9, 0x28, 0xfc, 0x4e, 0x96, 0xf8, 0xd7, 0x03, 0x37, 0xa2,
+	0x5b, 0x21, 0xdb, 0xfb, 0x9a, 0x47, 0x50, 0x72, 0xe9, 0x5b, 0x2d, 0xc9, 0xae, 0x78, 0xc6, 0x7f,
+	0xb6, 0xe0, 0x68, 0xaf, 0x06, 0x74, 0x15, 0x4a, 0xcc, 0x93, 0xbb, 0x7e, 0x66, 0x6d, 0x29, 0x9f,
+	0x5d, 0xef, 0x7b, 0x3e, 0x71, 0xc4, 0x77, 0x68, 0x0b, 0xca, 0x94, 0xb9, 0xac, 0xab, 0x56, 0xc6,
+	0xbd, 0xf1, 0x55, 0xed, 0x75, 0x21, 0xd7, 0x91, 0xf2, 0xf1, 0xcf, 0x78, 0x44, 0x74, 0x59, 0x63,
+	0x4b, 0xbd, 0xa7, 0xcf, 0x5e, 0x5d, 0x87, 0x7f, 0xa0, 0xa5, 0x29, 0x02, 0xec, 0xad, 0x1d, 0x12,
+	0x08, 0xdf, 0xca, 0x76, 0xa3, 0xd4, 0xb7, 0xf2, 0x67, 0xb4, 0x09, 0xe5, 0x70, 0x93, 0xe7, 0xd6,
+	0xfb, 0x70, 0xde, 0x21, 0x25, 0xe3, 0xef, 0x70, 0x38, 0x29, 0x8c, 0x03, 0x24, 0x0c, 0x5f, 0x85,
+	0xa9, 0x8d, 0xb0, 0x9d, 0x78, 0x55, 0x91, 0xe4, 0x07, 0x8c, 0x27, 0xf9, 0x96, 0x4a, 0xf2, 0x45,
+	0x53, 0x4f, 0xce, 0x0a, 0x46, 0x72, 0x86, 0xff, 0x6d, 0xd4, 0xf4, 0x01, 0x7b, 0xb6, 0x4e, 0x95,
+	0xae, 0x82, 0x4d, 0x59, 0xec, 0x35, 0xd8, 0x7d, 0xe2, 0x47, 0x1d, 0x51, 0x99, 0xb4, 0xae, 0xc5,
+	0xed, 0x2e, 0xaf, 0x5c, 0xa8, 0xac, 0x84, 0x47, 0x8c, 0xc0, 0xff, 0xd7, 0xb2, 0xbd, 0xba, 0x51,
+	0xf3, 0x8f, 0x9e, 0x5f, 0x92, 0x0f, 0x84, 0xdd, 0xb8, 0x91, 0x84, 0x91, 0x84, 0x34, 0xa3, 0x4f,
+	0x1f, 0xa3, 0x65, 0xbd, 0x46, 0x5f, 0xff, 0x91, 0x53, 0x69, 0xff, 0x8f, 0x9c, 0xfe, 0x55, 0xd0,
+	0x8b, 0xcc, 0xa0, 0x49, 0xe2, 0x67, 0xed, 0xa4, 0xd0, 0xe4, 0xb6, 0x98, 0x83, 0xdb, 0x52, 0x1e,
+	0x6e, 0x27, 0xf6, 0x9f, 0xdb, 0xcd, 0x2c, 0x2d, 0xe7, 0x49, 0xe1, 0xbd, 0xb0, 0xb9, 0xf7, 0x50,
+	0x72, 0x0a, 0xca, 0x3c, 0x85, 0x7d, 0x5d, 0x31, 0x20, 0x5b, 0xb8, 0x95, 0xe9, 0xb8, 0xf5, 0xe5,
+	0xa8, 0xe3, 0x7a, 0xc1, 0xde, 0x75, 0xd8, 0x30, 0xc5, 0xa5, 0x6a, 0xeb, 0x33, 0x6d, 0xe3, 0xd7,
+	0xe0, 0x08, 0x9f, 0x83, 0xd0, 0x11, 0x24, 0x75, 0x00, 0x82, 0xd2, 0x76, 0x96, 0x35, 0x89, 0x67,
+	0x3d, 0xa9, 0x2e, 0x98, 0x95, 0xf5, 0xfb, 0x56, 0x16, 0x59, 0x53, 0xa4, 0x32, 0x46, 0x67, 0x93,
+	0xb3, 0xf4, 0xc9, 0x19, 0x80, 0x0a, 0x26, 0xa0, 0x2c, 0x29, 0x2e, 0xea, 0x49, 0xf1, 0x3a, 0x4f,
+	0x73, 0x5d, 0x9a, 0x6c, 0x1e, 0x1e, 0xef, 0x9f, 0xcb, 0x2c, 0xd6, 0x83, 0xdf, 0x51, 0x23, 0xf1,
+	0x9b, 0x19, 0x32, 0x1e, 0x53, 0x3b, 0x5e, 0x40, 0xf6, 0x4c, 0x22, 0xfe, 0xbb, 0x05, 0xb3, 0x4a,
+	0x4a, 0x12, 0x76, 0x9e, 0x36, 0xb8, 0x2b, 0x9e, 0x0b, 0x1a, 0xcf, 0x43, 0x96, 0x83, 0xc1, 0x58,
+	0xa9, 0x87, 0xb1, 0x53, 0x50, 0x4e, 0x66, 0x2c, 0x0b, 0x63, 0xd9, 0xd2, 0x6d, 0x56, 0x36, 0x6d,
+	0x76, 0x23, 0x4b, 0x55, 0xd4, 0x94, 0x50, 0x0d, 0xca, 0x44, 0x84, 0xb1, 0xfe, 0x84, 0xca, 0x98,
+	0xb6, 0x23, 0x87, 0xad, 0xfd, 0x75, 0x01, 0x8e, 0x64, 0x47, 0x2a, 0xf1, 0x8e, 0xd7, 0x20, 0xe8,
+	0x57, 0x16, 0x1c, 0x4e, 0x4e, 0xdf, 0xd5, 0x1b, 0x74, 0x76, 0x40, 0x62, 0xa6, 0xdf, 0x5c, 0xd8,
+	0x63, 0xf4, 0x2f, 0x78, 0xf1, 0x5b, 0xff, 0xfc, 0xef, 0x4f, 0x0b, 0x18, 0x3f, 0x2f, 0x6e, 0x51,
+	0x76, 0x56, 0xd3, 0x6b, 0x17, 0x5a, 0x7b, 0x2f, 0x35, 0xef, 0xc3, 0x57, 0xad, 0x25, 0xf4, 0x4b,
+	0x0b, 0x66, 0xee, 0x10, 0x96, 0xc2, 0x1c, 0x50, 0x36, 0x65, 0x27, 0xf5, 0x63, 0xc5, 0x78, 0x49,
+	0x60, 0xfc, 0x34, 0x3a, 0x37, 0x12, 0x63, 0xf2, 0xfc, 0x10, 0x7d, 0xc3, 0x82, 0x43, 0xbc, 0xdc,
+	0x48, 0x81, 0x3e, 0x3f, 0xb8, 0x1c, 0x51, 0x48, 0xe7, 0x86, 0xbd, 0x96, 0x07, 0x07, 0xab, 0x42,
+	0xfb, 0x45, 0x74, 0x21, 0x8f, 0xf6, 0x5a, 0xd3, 0x6b, 0xb5, 0x38, 0x55, 0xb3, 0x3c, 0xf3, 0x50,
+	0xf2, 0xe8, 0x20, 0x0c, 0xda, 0x4d, 0x80, 0x7d, 0x77, 0x7c, 0x6c, 0x71, 0xb1, 0xf8, 0xbc, 0xc0,
+	0x7c, 0x16, 0x8d, 0xb6, 0x2a, 0xfa, 0x3a, 0x1c, 0xd5, 0x2c, 0x9a, 0x94, 0x37, 0x73, 0xc3, 0xca,
+	0x02, 0x09, 0xf5, 0xec, 0xd0, 0xf7, 0x92, 0xaf, 0x25, 0xa1, 0xfb, 0x1c, 0xc2, 0xbd, 0xba, 0x97,
+	0x1b, 0x7c, 0x9c, 0x01, 0xe0, 0xfb, 0x16, 0x1c, 0xd7, 0x10, 0xa4, 0x55, 0xc0, 0x42, 0xbf, 0x92,
+	0x9e, 0x1a, 0xc4, 0xb6, 0x87, 0x0f, 0xc1, 0x2f, 0x0a, 0x08, 0x35, 0xb4, 0x9c, 0xcb, 0x64, 0x54,
+	0x69, 0xfd, 0x1a, 0x1c, 0x36, 0x13, 0x7a, 0x63, 0x2b, 0x0e, 0x4a, 0xf5, 0xed, 0x01, 0x9b, 0x20,
+	0xcb, 0x6f, 0xf1, 0x45, 0x81, 0xe3, 0x3c, 0x7a, 0xa1, 0x8f, 0x8a, 0xc4, 0x23, 0xe8, 0x68, 0x56,
+	0x2c, 0x44, 0x61, 0x26, 0xfb, 0x98, 0x1a, 0x1b, 0xac, 0x2f, 0x67, 0xb6, 0x9f, 0x1b, 0x74, 0x12,
+	0x98, 0xa8, 0xbd, 0x20, 0xd4, 0xbe, 0x80, 0x16, 0x94, 0x5a, 0xca, 0x62, 0xe2, 0xfa, 0xb5, 0x81,
+	0x4a, 0xbf, 0x69, 0xc1, 0xe1, 0xe4, 0xa8, 0x6c, 0x94, 0x03, 0x32, 0x8e, 0xfc, 0xec, 0xf9, 0xe1,
+	0x03, 0xe4, 0x22, 0x90, 0x5b, 0x76, 0x29, 0xdf, 0x96, 0x7d, 0x08, 0xb3, 0xfc, 0xc4, 0x65, 0xe4,
+	0x76, 0xd1, 0xce, 0xec, 0xec, 0xb9, 0x61, 0xaf, 0xa5, 0xf6, 0x65, 0xa1, 0xfd, 0x33, 0x36, 0x1e,
+	0xad, 0x7d, 0xb3, 0xdb, 0xd9, 0xe6, 0x9e, 0xed, 0xb7, 0x16, 0xcc, 0x8a, 0x1b, 0xa7, 0x94, 0x81,
+	0x01, 0x0a, 0xf4, 0x2b, 0xa9, 0xb1, 0x7a, 0x37, 0xb9, 0x58, 0xed, 0xa5, 0x5c, 0x8b, 0x35, 0xe6,
+	0x30, 0x38, 0xe8, 0x3f, 0x59, 0x70, 0x54, 0x5d, 0xc8, 0xa5, 0xb8, 0x17, 0x06, 0xe1, 0x36, 0x2e,
+	0xed, 0xc6, 0x0a, 0xfd, 0x15, 0x01, 0x7d, 0xcd, 0x5e, 0xce, 0x09, 0x3d, 0x41, 0xc2, 0xd1, 0xff,
+	0xde, 0x82, 0xc3, 0xc9, 0xc5, 0xd6, 0xa8, 0x55, 0x67, 0x5c, 0x7d, 0x8d, 0x15, 0xf9, 0x4b, 0x02,
+	0xf9, 0x8a, 0x7d, 0x31, 0x37, 0x72, 0x9f, 0x70, 0xdc, 0x7f, 0xb0, 0xe0, 0x88, 0xbc, 0x16, 0x4b,
+	0x81, 0x0f, 0xd8, 0x0d, 0xe6, 0xcd, 0xd9, 0x58, 0x91, 0xbf, 0x2c, 0x90, 0xaf, 0xda, 0x97, 0x72,
+	0x21, 0x77, 0x13, 0x20, 0x19, 0xe5, 0xbc, 0x0c, 0x1f, 0x4d, 0xb9, 0x76, 0xbd, 0x76, 0xa0, 0x94,
+	0x73, 0x1c, 0x8a, 0x72, 0x79, 0x5d, 0x33, 0x8a, 0x72, 0xf3, 0x46, 0xe7, 0x00, 0x29, 0xa7, 0x09,
+	0x10, 0x0e, 0xfd, 0x2f, 0x16, 0x1c, 0x4b, 0x2f, 0x07, 0x53, 0xf0, 0xb8, 0x1f, 0x7c, 0xef, 0x0d,
+	0xe2, 0x58, 0xe1, 0x5f, 0x11, 0xf0, 0xd7, 0xed, 0x6a, 0x2e, 0xf8, 0x4c, 0x41, 0xe1, 0x13, 0xf8,
+	0xd0, 0x82, 0x43, 0xfc, 0x3a, 0x72, 0x54, 0x2e, 0xa5, 0x5d, 0x57, 0x8e, 0x15, 0xf6, 0x65, 0x01,
+	0xbb, 0x6a, 0xe7, 0xcb, 0xbb, 0x28, 0x0b, 0x23, 0x8e, 0xf8, 0x37, 0x16, 0xcc, 0xd4, 0x47, 0x67,
+	0xa9, 0xf5, 0xfd, 0xc9, 0x52, 0xd7, 0x05, 0xde, 0x65, 0x7b, 0x31, 0x1f, 0x5e, 0x22, 0x16, 0xf7,
+	0xaf, 0x2d, 0x38, 0xc4, 0x8f, 0x8f, 0x46, 0x11, 0xac, 0x1d, 0x2f, 0x8d, 0x15, 0xb0, 0x8c, 0x92,
+	0xf8, 0x31, 0x51, 0xb2, 0xe3, 0x05, 0x02, 0xea, 0x57, 0x61, 0x32, 0xb9, 0x68, 0xa4, 0x83, 0x48,
+	0xcd, 0xee, 0x40, 0x6d, 0x94, 0xbd, 0x55, 0x47, 0x6c, 0xf8, 0xb3, 0x42, 0xd7, 0x65, 0xb4, 0x96,
+	0x8b, 0x9c, 0xf7, 0xe4, 0x29, 0xdb, 0xc3, 0x5a, 0x27, 0x6c, 0x7f, 0xb7, 0x60, 0xad, 0x58, 0x88,
+	0xc1, 0x21, 0x4d, 0xd5, 0x5e, 0x20, 0xac, 0x08, 0x08, 0x4b, 0x28, 0x9f, 0x7d, 0x3a, 0x61, 0x7b,
+	0xc5, 0x42, 0x1f, 0x58, 0x70, 0xb8, 0x6e, 0x86, 0xd8, 0xb3, 0x83, 0x5c, 0xcf, 0x7e, 0x05, 0xd8,
+	0x9a, 0xc0, 0x7c, 0x01, 0x3f, 0x26, 0x8d, 0xca, 0xe2, 0xea, 0x8f, 0x2c, 0x40, 0x5a, 0x42, 0x2d,
+	0x0f, 0x5b, 0x06, 0xf9, 0x4b, 0xf3, 0x1c, 0xc6, 0x3e, 0x3d, 0xe4, 0x62, 0x19, 0xbf, 0x26, 0x20,
+	0x5c, 0x41, 0x2f, 0xe7, 0xa2, 0x8d, 0x97, 0xd8, 0xfc, 0x85, 0xa8, 0xc1, 0x1f, 0xd6, 0xa2, 0xb0,
+	0xc9, 0x21, 0x1d, 0x97, 0xc7, 0x1c, 0xba, 0xee, 0x41, 0x98, 0xcc, 0x73, 0x1b, 0x7b, 0x61, 0xc4,
+	0x08, 0x99, 0xe9, 0x49, 0x27, 0x81, 0xf2, 0xb9, 0x66, 0x92, 0x7c, 0xdd, 0x5b, 0x76, 0xa4, 0x15,
+	0xfd, 0x00, 0x85, 0x3d, 0xc7, 0x20, 0xb6, 0x3d, 0x7c, 0xc8, 0x13, 0x96, 0x1d, 0x4c, 0x69, 0xfd,
+	0x40, 0x04, 0x66, 0x7e, 0xe0, 0x38, 0x3a, 0x30, 0x6b, 0x47, 0x92, 0x07, 0xb1, 0xc8, 0x62, 0x01,
+	0xe0, 0x55, 0x6b, 0xe9, 0xfa, 0x9d, 0xbf, 0x3d, 0x9a, 0xb3, 0x3e, 0x7a, 0x34, 0x67, 0xfd, 0xe7,
+	0xd1, 0x9c, 0xf5, 0xc5, 0x2b, 0xf9, 0x7f, 0xea, 0xec, 0xf9, 0xf9, 0x74, 0xb3, 0x2c, 0xfe, 0xd1,
+	0x5c, 0xff, 0x78, 0x00, 0x47, 0x68, 0x80, 0x1d, 0x9d, 0x2a, 0x00, 0x00,
 }
 
-func (m *WorkflowResubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkflowServiceClient is the client API for WorkflowService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkflowServiceClient interface {
+	CreateWorkflow(ctx context.Context, in *WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	GetWorkflow(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	DiffWorkflow(ctx context.Context, in *WorkflowDiffRequest, opts ...grpc.CallOption) (*WorkflowDiffResponse, error)
+	ListWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
+	// GetWorkflowCosts returns the estimated cost of the workflows, grouped by namespace or label
+	GetWorkflowCosts(ctx context.Context, in *WorkflowCostsRequest, opts ...grpc.CallOption) (*WorkflowCostsResponse, error)
+	// GetWorkflowSnapshot returns the status of the workflow as of a time, from the snapshots the controller takes of it
+	GetWorkflowSnapshot(ctx context.Context, in *WorkflowSnapshotRequest, opts ...grpc.CallOption) (*WorkflowSnapshot, error)
+	WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (WorkflowService_WatchEventsClient, error)
+	DeleteWorkflow(ctx context.Context, in *WorkflowDeleteRequest, opts ...grpc.CallOption) (*WorkflowDeleteResponse, error)
+	BulkWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	RetryWorkflow(ctx context.Context, in *WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ResubmitWorkflow(ctx context.Context, in *WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ResumeWorkflow(ctx context.Context, in *WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ApproveWorkflow(ctx context.Context, in *WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	RejectWorkflow(ctx context.Context, in *WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	SuspendWorkflow(ctx context.Context, in *WorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
+	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
+	WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error)
+	SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	// GetWorkflowNodePod returns the pod that ran a node of a workflow, if it has not been deleted
+	GetWorkflowNodePod(ctx context.Context, in *WorkflowNodePodRequest, opts ...grpc.CallOption) (*v11.Pod, error)
+	// ExplainWorkflowNode explains why a node of a workflow has not started, or completed, e.g. its dependencies have
+	// not completed, its when expression was false, it is waiting for a lock, or its pod cannot be scheduled
+	ExplainWorkflowNode(ctx context.Context, in *WorkflowExplainRequest, opts ...grpc.CallOption) (*WorkflowExplainResponse, error)
+	// GetWorkflowTimeline returns the events of a workflow in the order they happened: its phase changes, the
+	// transitions, and retries, of its nodes, the Kubernetes events of its pods, and its artifact operations
+	GetWorkflowTimeline(ctx context.Context, in *WorkflowTimelineRequest, opts ...grpc.CallOption) (*WorkflowTimeline, error)
+	// RenderWorkflow returns the workflow, with the spec the controller would run, without creating it
+	RenderWorkflow(ctx context.Context, in *WorkflowRenderRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
 
-func (m *WorkflowResubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Memoized {
-		i--
-		if m.Memoized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type workflowServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *WorkflowRetryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewWorkflowServiceClient(cc *grpc.ClientConn) WorkflowServiceClient {
+	return &workflowServiceClient{cc}
+}
+
+func (c *workflowServiceClient) CreateWorkflow(ctx context.Context, in *WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/CreateWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowRetryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) GetWorkflow(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WorkflowRetryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.NodeFieldSelector) > 0 {
-		i -= len(m.NodeFieldSelector)
-		copy(dAtA[i:], m.NodeFieldSelector)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RestartSuccessful {
-		i--
-		if m.RestartSuccessful {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *workflowServiceClient) DiffWorkflow(ctx context.Context, in *WorkflowDiffRequest, opts ...grpc.CallOption) (*WorkflowDiffResponse, error) {
+	out := new(WorkflowDiffResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/DiffWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowResumeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) ListWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	out := new(v1alpha1.WorkflowList)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ListWorkflows", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowResumeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) GetWorkflowCosts(ctx context.Context, in *WorkflowCostsRequest, opts ...grpc.CallOption) (*WorkflowCostsResponse, error) {
+	out := new(WorkflowCostsResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflowCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.NodeFieldSelector) > 0 {
-		i -= len(m.NodeFieldSelector)
-		copy(dAtA[i:], m.NodeFieldSelector)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *workflowServiceClient) GetWorkflowSnapshot(ctx context.Context, in *WorkflowSnapshotRequest, opts ...grpc.CallOption) (*WorkflowSnapshot, error) {
+	out := new(WorkflowSnapshot)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflowSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowTerminateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[0], "/workflow.WorkflowService/WatchWorkflows", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workflowServiceWatchWorkflowsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *WorkflowTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_WatchWorkflowsClient interface {
+	Recv() (*WorkflowWatchEvent, error)
+	grpc.ClientStream
 }
 
-func (m *WorkflowTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+type workflowServiceWatchWorkflowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workflowServiceWatchWorkflowsClient) Recv() (*WorkflowWatchEvent, error) {
+	m := new(WorkflowWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *WorkflowStopRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (WorkflowService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[1], "/workflow.WorkflowService/WatchEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workflowServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *WorkflowStopRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_WatchEventsClient interface {
+	Recv() (*v11.Event, error)
+	grpc.ClientStream
 }
 
-func (m *WorkflowStopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NodeFieldSelector) > 0 {
-		i -= len(m.NodeFieldSelector)
-		copy(dAtA[i:], m.NodeFieldSelector)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+type workflowServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workflowServiceWatchEventsClient) Recv() (*v11.Event, error) {
+	m := new(v11.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *WorkflowSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) DeleteWorkflow(ctx context.Context, in *WorkflowDeleteRequest, opts ...grpc.CallOption) (*WorkflowDeleteResponse, error) {
+	out := new(WorkflowDeleteResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/DeleteWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) BulkWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/BulkWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WorkflowSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.OutputParameters) > 0 {
-		i -= len(m.OutputParameters)
-		copy(dAtA[i:], m.OutputParameters)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OutputParameters)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NodeFieldSelector) > 0 {
-		i -= len(m.NodeFieldSelector)
-		copy(dAtA[i:], m.NodeFieldSelector)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+func (c *workflowServiceClient) RetryWorkflow(ctx context.Context, in *WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RetryWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResubmitWorkflow(ctx context.Context, in *WorkflowResubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResubmitWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowSuspendRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) ResumeWorkflow(ctx context.Context, in *WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResumeWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) ApproveWorkflow(ctx context.Context, in *WorkflowApproveRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ApproveWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WorkflowSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *workflowServiceClient) RejectWorkflow(ctx context.Context, in *WorkflowRejectRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RejectWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *workflowServiceClient) SuspendWorkflow(ctx context.Context, in *WorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SuspendWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workflowServiceClient) TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/TerminateWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowLogRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/StopWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowLogRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SetWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WorkflowLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Selector) > 0 {
-		i -= len(m.Selector)
-		copy(dAtA[i:], m.Selector)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Selector)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Grep) > 0 {
-		i -= len(m.Grep)
-		copy(dAtA[i:], m.Grep)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Grep)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.LogOptions != nil {
-		{
-			size, err := m.LogOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/LintWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *workflowServiceClient) PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[2], "/workflow.WorkflowService/PodLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workflowServicePodLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *WorkflowDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_PodLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
 }
 
-func (m *WorkflowDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.DeleteOptions != nil {
-		{
-			size, err := m.DeleteOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+type workflowServicePodLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workflowServicePodLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *WorkflowDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[3], "/workflow.WorkflowService/WorkflowLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workflowServiceWorkflowLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *WorkflowDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_WorkflowLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
 }
 
-func (m *WorkflowDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type workflowServiceWorkflowLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workflowServiceWorkflowLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *WatchWorkflowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SubmitWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WatchWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) GetWorkflowNodePod(ctx context.Context, in *WorkflowNodePodRequest, opts ...grpc.CallOption) (*v11.Pod, error) {
+	out := new(v11.Pod)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflowNodePod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WatchWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Fields) > 0 {
-		i -= len(m.Fields)
-		copy(dAtA[i:], m.Fields)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *workflowServiceClient) ExplainWorkflowNode(ctx context.Context, in *WorkflowExplainRequest, opts ...grpc.CallOption) (*WorkflowExplainResponse, error) {
+	out := new(WorkflowExplainResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ExplainWorkflowNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetWorkflowTimeline(ctx context.Context, in *WorkflowTimelineRequest, opts ...grpc.CallOption) (*WorkflowTimeline, error) {
+	out := new(WorkflowTimeline)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/GetWorkflowTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WorkflowWatchEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workflowServiceClient) RenderWorkflow(ctx context.Context, in *WorkflowRenderRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RenderWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WorkflowWatchEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkflowServiceServer is the server API for WorkflowService service.
+type WorkflowServiceServer interface {
+	CreateWorkflow(context.Context, *WorkflowCreateRequest) (*v1alpha1.Workflow, error)
+	GetWorkflow(context.Context, *WorkflowGetRequest) (*v1alpha1.Workflow, error)
+	DiffWorkflow(context.Context, *WorkflowDiffRequest) (*WorkflowDiffResponse, error)
+	ListWorkflows(context.Context, *WorkflowListRequest) (*v1alpha1.WorkflowList, error)
+	// GetWorkflowCosts returns the estimated cost of the workflows, grouped by namespace or label
+	GetWorkflowCosts(context.Context, *WorkflowCostsRequest) (*WorkflowCostsResponse, error)
+	// GetWorkflowSnapshot returns the status of the workflow as of a time, from the snapshots the controller takes of it
+	GetWorkflowSnapshot(context.Context, *WorkflowSnapshotRequest) (*WorkflowSnapshot, error)
+	WatchWorkflows(*WatchWorkflowsRequest, WorkflowService_WatchWorkflowsServer) error
+	WatchEvents(*WatchEventsRequest, WorkflowService_WatchEventsServer) error
+	DeleteWorkflow(context.Context, *WorkflowDeleteRequest) (*WorkflowDeleteResponse, error)
+	BulkWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	RetryWorkflow(context.Context, *WorkflowRetryRequest) (*v1alpha1.Workflow, error)
+	ResubmitWorkflow(context.Context, *WorkflowResubmitRequest) (*v1alpha1.Workflow, error)
+	ResumeWorkflow(context.Context, *WorkflowResumeRequest) (*v1alpha1.Workflow, error)
+	ApproveWorkflow(context.Context, *WorkflowApproveRequest) (*v1alpha1.Workflow, error)
+	RejectWorkflow(context.Context, *WorkflowRejectRequest) (*v1alpha1.Workflow, error)
+	SuspendWorkflow(context.Context, *WorkflowSuspendRequest) (*v1alpha1.Workflow, error)
+	TerminateWorkflow(context.Context, *WorkflowTerminateRequest) (*v1alpha1.Workflow, error)
+	StopWorkflow(context.Context, *WorkflowStopRequest) (*v1alpha1.Workflow, error)
+	SetWorkflow(context.Context, *WorkflowSetRequest) (*v1alpha1.Workflow, error)
+	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
+	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
+	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
+	WorkflowLogs(*WorkflowLogRequest, WorkflowService_WorkflowLogsServer) error
+	SubmitWorkflow(context.Context, *WorkflowSubmitRequest) (*v1alpha1.Workflow, error)
+	// GetWorkflowNodePod returns the pod that ran a node of a workflow, if it has not been deleted
+	GetWorkflowNodePod(context.Context, *WorkflowNodePodRequest) (*v11.Pod, error)
+	// ExplainWorkflowNode explains why a node of a workflow has not started, or completed, e.g. its dependencies have
+	// not completed, its when expression was false, it is waiting for a lock, or its pod cannot be scheduled
+	ExplainWorkflowNode(context.Context, *WorkflowExplainRequest) (*WorkflowExplainResponse, error)
+	// GetWorkflowTimeline returns the events of a workflow in the order they happened: its phase changes, the
+	// transitions, and retries, of its nodes, the Kubernetes events of its pods, and its artifact operations
+	GetWorkflowTimeline(context.Context, *WorkflowTimelineRequest) (*WorkflowTimeline, error)
+	// RenderWorkflow returns the workflow, with the spec the controller would run, without creating it
+	RenderWorkflow(context.Context, *WorkflowRenderRequest) (*v1alpha1.Workflow, error)
 }
 
-func (m *WorkflowWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedWorkflowServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkflowServiceServer struct {
+}
+
+func (*UnimplementedWorkflowServiceServer) CreateWorkflow(ctx context.Context, req *WorkflowCreateRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) GetWorkflow(ctx context.Context, req *WorkflowGetRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) DiffWorkflow(ctx context.Context, req *WorkflowDiffRequest) (*WorkflowDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ListWorkflows(ctx context.Context, req *WorkflowListRequest) (*v1alpha1.WorkflowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) GetWorkflowCosts(ctx context.Context, req *WorkflowCostsRequest) (*WorkflowCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowCosts not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) GetWorkflowSnapshot(ctx context.Context, req *WorkflowSnapshotRequest) (*WorkflowSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowSnapshot not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) WatchWorkflows(req *WatchWorkflowsRequest, srv WorkflowService_WatchWorkflowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) WatchEvents(req *WatchEventsRequest, srv WorkflowService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) DeleteWorkflow(ctx context.Context, req *WorkflowDeleteRequest) (*WorkflowDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) BulkWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) RetryWorkflow(ctx context.Context, req *WorkflowRetryRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ResubmitWorkflow(ctx context.Context, req *WorkflowResubmitRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ResumeWorkflow(ctx context.Context, req *WorkflowResumeRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ApproveWorkflow(ctx context.Context, req *WorkflowApproveRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) RejectWorkflow(ctx context.Context, req *WorkflowRejectRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) SuspendWorkflow(ctx context.Context, req *WorkflowSuspendRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) TerminateWorkflow(ctx context.Context, req *WorkflowTerminateRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) StopWorkflow(ctx context.Context, req *WorkflowStopRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) SetWorkflow(ctx context.Context, req *WorkflowSetRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) PodLogs(req *WorkflowLogRequest, srv WorkflowService_PodLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PodLogs not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) WorkflowLogs(req *WorkflowLogRequest, srv WorkflowService_WorkflowLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WorkflowLogs not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) SubmitWorkflow(ctx context.Context, req *WorkflowSubmitRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) GetWorkflowNodePod(ctx context.Context, req *WorkflowNodePodRequest) (*v11.Pod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowNodePod not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ExplainWorkflowNode(ctx context.Context, req *WorkflowExplainRequest) (*WorkflowExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainWorkflowNode not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) GetWorkflowTimeline(ctx context.Context, req *WorkflowTimelineRequest) (*WorkflowTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowTimeline not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) RenderWorkflow(ctx context.Context, req *WorkflowRenderRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderWorkflow not implemented")
+}
+
+func RegisterWorkflowServiceServer(s *grpc.Server, srv WorkflowServiceServer) {
+	s.RegisterService(&_WorkflowService_serviceDesc, srv)
+}
+
+func _WorkflowService_CreateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Object != nil {
-		{
-			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CreateWorkflow(ctx, in)
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/CreateWorkflow",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CreateWorkflow(ctx, req.(*WorkflowCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _WorkflowService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowGetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/GetWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflow(ctx, req.(*WorkflowGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _WorkflowService_DiffWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DiffWorkflow(ctx, in)
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/DiffWorkflow",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DiffWorkflow(ctx, req.(*WorkflowDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LogEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _WorkflowService_ListWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ListWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ListWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ListWorkflows(ctx, req.(*WorkflowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _WorkflowService_GetWorkflowCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/GetWorkflowCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowCosts(ctx, req.(*WorkflowCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _WorkflowService_GetWorkflowSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowSnapshot(ctx, in)
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/GetWorkflowSnapshot",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowSnapshot(ctx, req.(*WorkflowSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowLintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _WorkflowService_WatchWorkflows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkflowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(WorkflowServiceServer).WatchWorkflows(m, &workflowServiceWatchWorkflowsServer{stream})
 }
 
-func (m *WorkflowLintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_WatchWorkflowsServer interface {
+	Send(*WorkflowWatchEvent) error
+	grpc.ServerStream
 }
 
-func (m *WorkflowLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Workflow != nil {
-		{
-			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type workflowServiceWatchWorkflowsServer struct {
+	grpc.ServerStream
 }
 
-func (m *WorkflowSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *workflowServiceWatchWorkflowsServer) Send(m *WorkflowWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkflowService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(WorkflowServiceServer).WatchEvents(m, &workflowServiceWatchEventsServer{stream})
 }
 
-func (m *WorkflowSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowService_WatchEventsServer interface {
+	Send(*v11.Event) error
+	grpc.ServerStream
 }
 
-func (m *WorkflowSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SubmitOptions != nil {
-		{
-			size, err := m.SubmitOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+type workflowServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workflowServiceWatchEventsServer) Send(m *v11.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkflowService_DeleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ResourceName) > 0 {
-		i -= len(m.ResourceName)
-		copy(dAtA[i:], m.ResourceName)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DeleteWorkflow(ctx, in)
 	}
-	if len(m.ResourceKind) > 0 {
-		i -= len(m.ResourceKind)
-		copy(dAtA[i:], m.ResourceKind)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceKind)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/DeleteWorkflow",
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DeleteWorkflow(ctx, req.(*WorkflowDeleteRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _WorkflowService_BulkWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *WorkflowCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).BulkWorkflows(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/BulkWorkflows",
 	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).BulkWorkflows(ctx, req.(*WorkflowBulkRequest))
 	}
-	l = len(m.InstanceID)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_RetryWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ServerDryRun {
-		n += 2
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RetryWorkflow(ctx, in)
 	}
-	if m.CreateOptions != nil {
-		l = m.CreateOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/RetryWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RetryWorkflow(ctx, req.(*WorkflowRetryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_ResubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowResubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GetOptions != nil {
-		l = m.GetOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResubmitWorkflow(ctx, in)
 	}
-	l = len(m.Fields)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ResubmitWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResubmitWorkflow(ctx, req.(*WorkflowResubmitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_ResumeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, in)
 	}
-	l = len(m.Fields)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ResumeWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, req.(*WorkflowResumeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowResubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_ApproveWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ApproveWorkflow(ctx, in)
 	}
-	if m.Memoized {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ApproveWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ApproveWorkflow(ctx, req.(*WorkflowApproveRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowRetryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_RejectWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RejectWorkflow(ctx, in)
 	}
-	if m.RestartSuccessful {
-		n += 2
-	}
-	l = len(m.NodeFieldSelector)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/RejectWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RejectWorkflow(ctx, req.(*WorkflowRejectRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowResumeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_SuspendWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SuspendWorkflow(ctx, in)
 	}
-	l = len(m.NodeFieldSelector)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/SuspendWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SuspendWorkflow(ctx, req.(*WorkflowSuspendRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowTerminateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _WorkflowService_TerminateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).TerminateWorkflow(ctx, in)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/TerminateWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).TerminateWorkflow(ctx, req.(*WorkflowTerminateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowStopRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_StopWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NodeFieldSelector)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).StopWorkflow(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/StopWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).StopWorkflow(ctx, req.(*WorkflowStopRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _WorkflowService_SetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SetWorkflow(ctx, in)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/SetWorkflow",
 	}
-	l = len(m.NodeFieldSelector)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SetWorkflow(ctx, req.(*WorkflowSetRequest))
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_LintWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).LintWorkflow(ctx, in)
 	}
-	l = len(m.OutputParameters)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/LintWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).LintWorkflow(ctx, req.(*WorkflowLintRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowSuspendRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WorkflowLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return srv.(WorkflowServiceServer).PodLogs(m, &workflowServicePodLogsServer{stream})
+}
+
+type WorkflowService_PodLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type workflowServicePodLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workflowServicePodLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkflowService_WorkflowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WorkflowLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(WorkflowServiceServer).WorkflowLogs(m, &workflowServiceWorkflowLogsServer{stream})
 }
 
-func (m *WorkflowLogRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type WorkflowService_WorkflowLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type workflowServiceWorkflowLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workflowServiceWorkflowLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkflowService_SubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SubmitWorkflow(ctx, in)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/SubmitWorkflow",
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SubmitWorkflow(ctx, req.(*WorkflowSubmitRequest))
 	}
-	if m.LogOptions != nil {
-		l = m.LogOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetWorkflowNodePod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowNodePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Grep)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowNodePod(ctx, in)
 	}
-	l = len(m.Selector)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/GetWorkflowNodePod",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowNodePod(ctx, req.(*WorkflowNodePodRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.DeleteOptions != nil {
-		l = m.DeleteOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_ExplainWorkflowNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ExplainWorkflowNode(ctx, in)
 	}
-	return n
-}
-
-func (m *WorkflowDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ExplainWorkflowNode",
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ExplainWorkflowNode(ctx, req.(*WorkflowExplainRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+func _WorkflowService_GetWorkflowTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowTimeline(ctx, in)
 	}
-	l = len(m.Fields)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/GetWorkflowTimeline",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowTimeline(ctx, req.(*WorkflowTimelineRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkflowWatchEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func _WorkflowService_RenderWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RenderWorkflow(ctx, in)
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/RenderWorkflow",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RenderWorkflow(ctx, req.(*WorkflowRenderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _WorkflowService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "workflow.WorkflowService",
+	HandlerType: (*WorkflowServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWorkflow",
+			Handler:    _WorkflowService_CreateWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _WorkflowService_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "DiffWorkflow",
+			Handler:    _WorkflowService_DiffWorkflow_Handler,
+		},
+		{
+			MethodName: "ListWorkflows",
+			Handler:    _WorkflowService_ListWorkflows_Handler,
+		},
+		{
+			MethodName: "GetWorkflowCosts",
+			Handler:    _WorkflowService_GetWorkflowCosts_Handler,
+		},
+		{
+			MethodName: "GetWorkflowSnapshot",
+			Handler:    _WorkflowService_GetWorkflowSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteWorkflow",
+			Handler:    _WorkflowService_DeleteWorkflow_Handler,
+		},
+		{
+			MethodName: "BulkWorkflows",
+			Handler:    _WorkflowService_BulkWorkflows_Handler,
+		},
+		{
+			MethodName: "RetryWorkflow",
+			Handler:    _WorkflowService_RetryWorkflow_Handler,
+		},
+		{
+			MethodName: "ResubmitWorkflow",
+			Handler:    _WorkflowService_ResubmitWorkflow_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflow",
+			Handler:    _WorkflowService_ResumeWorkflow_Handler,
+		},
+		{
+			MethodName: "ApproveWorkflow",
+			Handler:    _WorkflowService_ApproveWorkflow_Handler,
+		},
+		{
+			MethodName: "RejectWorkflow",
+			Handler:    _WorkflowService_RejectWorkflow_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflow",
+			Handler:    _WorkflowService_SuspendWorkflow_Handler,
+		},
+		{
+			MethodName: "TerminateWorkflow",
+			Handler:    _WorkflowService_TerminateWorkflow_Handler,
+		},
+		{
+			MethodName: "StopWorkflow",
+			Handler:    _WorkflowService_StopWorkflow_Handler,
+		},
+		{
+			MethodName: "SetWorkflow",
+			Handler:    _WorkflowService_SetWorkflow_Handler,
+		},
+		{
+			MethodName: "LintWorkflow",
+			Handler:    _WorkflowService_LintWorkflow_Handler,
+		},
+		{
+			MethodName: "SubmitWorkflow",
+			Handler:    _WorkflowService_SubmitWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflowNodePod",
+			Handler:    _WorkflowService_GetWorkflowNodePod_Handler,
+		},
+		{
+			MethodName: "ExplainWorkflowNode",
+			Handler:    _WorkflowService_ExplainWorkflowNode_Handler,
+		},
+		{
+			MethodName: "GetWorkflowTimeline",
+			Handler:    _WorkflowService_GetWorkflowTimeline_Handler,
+		},
+		{
+			MethodName: "RenderWorkflow",
+			Handler:    _WorkflowService_RenderWorkflow_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWorkflows",
+			Handler:       _WorkflowService_WatchWorkflows_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _WorkflowService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PodLogs",
+			Handler:       _WorkflowService_PodLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WorkflowLogs",
+			Handler:       _WorkflowService_WorkflowLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apiclient/workflow/workflow.proto",
 }
 
-func (m *LogEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *WorkflowCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WorkflowLintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *WorkflowCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmitOptions != nil {
+		{
+			size, err := m.SubmitOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreateOptions != nil {
+		{
+			size, err := m.CreateOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ServerDryRun {
+		i--
+		if m.ServerDryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InstanceID) > 0 {
+		i -= len(m.InstanceID)
+		copy(dAtA[i:], m.InstanceID)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.InstanceID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fields) > 0 {
+		i -= len(m.Fields)
+		copy(dAtA[i:], m.Fields)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GetOptions != nil {
+		{
+			size, err := m.GetOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fields) > 0 {
+		i -= len(m.Fields)
+		copy(dAtA[i:], m.Fields)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowResubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowResubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowResubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowRetryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRetryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRetryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestartFrom) > 0 {
+		i -= len(m.RestartFrom)
+		copy(dAtA[i:], m.RestartFrom)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.RestartFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RestartNodes) > 0 {
+		for iNdEx := len(m.RestartNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartNodes[iNdEx])
+			copy(dAtA[i:], m.RestartNodes[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.RestartNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowApproveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowApproveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowApproveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowRejectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRejectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRejectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTerminateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowStopRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowStopRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowStopRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OutputParameters) > 0 {
+		i -= len(m.OutputParameters)
+		copy(dAtA[i:], m.OutputParameters)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OutputParameters)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSuspendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Grep) > 0 {
+		i -= len(m.Grep)
+		copy(dAtA[i:], m.Grep)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Grep)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogOptions != nil {
+		{
+			size, err := m.LogOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeleteOptions != nil {
+		{
+			size, err := m.DeleteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestartFrom) > 0 {
+		i -= len(m.RestartFrom)
+		copy(dAtA[i:], m.RestartFrom)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.RestartFrom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RestartNodes) > 0 {
+		for iNdEx := len(m.RestartNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartNodes[iNdEx])
+			copy(dAtA[i:], m.RestartNodes[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.RestartNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBulkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBulkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OtherWorkflow) > 0 {
+		i -= len(m.OtherWorkflow)
+		copy(dAtA[i:], m.OtherWorkflow)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OtherWorkflow)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDiffEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDiffEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDiffEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseName) > 0 {
+		i -= len(m.BaseName)
+		copy(dAtA[i:], m.BaseName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.BaseName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseKind) > 0 {
+		i -= len(m.BaseKind)
+		copy(dAtA[i:], m.BaseKind)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.BaseKind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCosts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCosts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCosts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Workflows != 0 {
+		i = encodeVarintWorkflow(dAtA, i, uint64(m.Workflows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AsOf) > 0 {
+		i -= len(m.AsOf)
+		copy(dAtA[i:], m.AsOf)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.AsOf)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchWorkflowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fields) > 0 {
+		i -= len(m.Fields)
+		copy(dAtA[i:], m.Fields)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Fields)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowWatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowWatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowLintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowLintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StrictTemplateRefArguments {
+		i--
+		if m.StrictTemplateRefArguments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmitOptions != nil {
+		{
+			size, err := m.SubmitOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResourceName) > 0 {
+		i -= len(m.ResourceName)
+		copy(dAtA[i:], m.ResourceName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ResourceKind) > 0 {
+		i -= len(m.ResourceKind)
+		copy(dAtA[i:], m.ResourceKind)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceKind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowRenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubmitOptions != nil {
+		{
+			size, err := m.SubmitOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ResourceName) > 0 {
+		i -= len(m.ResourceName)
+		copy(dAtA[i:], m.ResourceName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResourceKind) > 0 {
+		i -= len(m.ResourceKind)
+		copy(dAtA[i:], m.ResourceKind)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.ResourceKind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowNodePodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowNodePodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowNodePodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExplainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExplainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExplainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExplainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExplainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExplainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelineEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelineEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelineEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.InstanceID)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ServerDryRun {
+		n += 2
+	}
+	if m.CreateOptions != nil {
+		l = m.CreateOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.SubmitOptions != nil {
+		l = m.SubmitOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.GetOptions != nil {
+		l = m.GetOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowResubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowRetryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if len(m.RestartNodes) > 0 {
+		for _, s := range m.RestartNodes {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	l = len(m.RestartFrom)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowApproveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowRejectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTerminateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowStopRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OutputParameters)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.LogOptions != nil {
+		l = m.LogOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Grep)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.DeleteOptions != nil {
+		l = m.DeleteOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if len(m.RestartNodes) > 0 {
+		for _, s := range m.RestartNodes {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	l = len(m.RestartFrom)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowBulkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowBulkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OtherWorkflow)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDiffEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseKind)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.BaseName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowCostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowCosts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflows != 0 {
+		n += 1 + sovWorkflow(uint64(m.Workflows))
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowCostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.AsOf)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowWatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowLintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.StrictTemplateRefArguments {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.ResourceKind)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.ResourceName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.SubmitOptions != nil {
+		l = m.SubmitOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowRenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.ResourceKind)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.ResourceName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.SubmitOptions != nil {
+		l = m.SubmitOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowNodePodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExplainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExplainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Reasons) > 0 {
+		for _, e := range m.Reasons {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimelineEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflow(x uint64) (n int) {
+	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServerDryRun = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateOptions == nil {
+				m.CreateOptions = &v1.CreateOptions{}
+			}
+			if err := m.CreateOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitOptions == nil {
+				m.SubmitOptions = &v1alpha1.SubmitOpts{}
+			}
+			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetOptions == nil {
+				m.GetOptions = &v1.GetOptions{}
+			}
+			if err := m.GetOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowResubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowResubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memoized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowRetryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowRetryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartNodes = append(m.RestartNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowApproveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowApproveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowApproveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowRejectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowRejectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowRejectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTerminateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowStopRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowStopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputParameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputParameters = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSuspendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogOptions == nil {
+				m.LogOptions = &v11.PodLogOptions{}
+			}
+			if err := m.LogOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grep = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteOptions == nil {
+				m.DeleteOptions = &v1.DeleteOptions{}
+			}
+			if err := m.DeleteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartNodes = append(m.RestartNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	l = len(m.ResourceKind)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.ResourceName)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	return nil
+}
+func (m *WorkflowBulkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBulkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBulkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if m.SubmitOptions != nil {
-		l = m.SubmitOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowBulkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBulkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &WorkflowBulkResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *WorkflowDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherWorkflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherWorkflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovWorkflow(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWorkflow(x uint64) (n int) {
-	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowDiffEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3637,28 +10772,60 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowWorkflow
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDiffEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDiffEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3686,13 +10853,13 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -3702,31 +10869,78 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Workflow == nil {
-				m.Workflow = &v1alpha1.Workflow{}
-			}
-			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseKind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3754,13 +10968,13 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstanceID = string(dAtA[iNdEx:postIndex])
+			m.BaseKind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -3770,15 +10984,27 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ServerDryRun = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3805,10 +11031,8 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateOptions == nil {
-				m.CreateOptions = &v1.CreateOptions{}
-			}
-			if err := m.CreateOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &WorkflowDiffEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3834,7 +11058,7 @@ func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowCostsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3857,15 +11081,15 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowGetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowCostsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowCostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3893,13 +11117,13 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -3909,29 +11133,33 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -3941,31 +11169,78 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GetOptions == nil {
-				m.GetOptions = &v1.GetOptions{}
-			}
-			if err := m.GetOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowCosts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCosts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3986,14 +11261,69 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
+			}
+			m.Workflows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workflows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = string(dAtA[iNdEx:postIndex])
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &v1alpha1.Amount{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4017,7 +11347,7 @@ func (m *WorkflowGetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowCostsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4040,47 +11370,15 @@ func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowCostsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowCostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4107,45 +11405,11 @@ func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &WorkflowCosts{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -4168,7 +11432,7 @@ func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4191,15 +11455,15 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowResubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowResubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4227,11 +11491,11 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4259,13 +11523,13 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4275,12 +11539,24 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Memoized = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -4303,7 +11579,7 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4326,17 +11602,17 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowRetryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowRetryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4346,29 +11622,33 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Time == nil {
+				m.Time = &v1.Time{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4378,75 +11658,27 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RestartSuccessful = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
+			if m.Status == nil {
+				m.Status = &v1alpha1.WorkflowStatus{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4470,7 +11702,7 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4493,15 +11725,15 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowResumeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchWorkflowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4529,13 +11761,13 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4545,27 +11777,31 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4593,7 +11829,7 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			m.Fields = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4617,7 +11853,7 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4640,15 +11876,15 @@ func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTerminateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowWatchEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4676,13 +11912,13 @@ func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4692,23 +11928,27 @@ func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Object == nil {
+				m.Object = &v1alpha1.Workflow{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4732,7 +11972,7 @@ func (m *WorkflowTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4755,15 +11995,15 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowStopRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowStopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4791,13 +12031,13 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4807,27 +12047,82 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4855,11 +12150,11 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4887,7 +12182,7 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4911,7 +12206,7 @@ func (m *WorkflowStopRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,15 +12229,15 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowLintRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowLintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4970,13 +12265,13 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -4986,29 +12281,33 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictTemplateRefArguments", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5018,59 +12317,66 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflow
+			m.StrictTemplateRefArguments = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +12404,11 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceKind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5124,68 +12430,17 @@ func (m *WorkflowSetRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthWorkflow
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputParameters = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowSuspendRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5213,13 +12468,13 @@ func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5229,23 +12484,27 @@ func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.SubmitOptions == nil {
+				m.SubmitOptions = &v1alpha1.SubmitOpts{}
+			}
+			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5269,7 +12528,7 @@ func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowRenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5292,15 +12551,15 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowRenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowRenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5328,13 +12587,13 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5344,27 +12603,31 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceKind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5392,47 +12655,11 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.ResourceKind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogOptions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LogOptions == nil {
-				m.LogOptions = &v11.PodLogOptions{}
-			}
-			if err := m.LogOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5460,13 +12687,13 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grep = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5476,23 +12703,27 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			if m.SubmitOptions == nil {
+				m.SubmitOptions = &v1alpha1.SubmitOpts{}
+			}
+			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5516,7 +12747,7 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowNodePodRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5539,15 +12770,15 @@ func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowNodePodRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowNodePodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5575,11 +12806,11 @@ func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5607,13 +12838,13 @@ func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5623,27 +12854,23 @@ func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteOptions == nil {
-				m.DeleteOptions = &v1.DeleteOptions{}
-			}
-			if err := m.DeleteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5667,58 +12894,7 @@ func (m *WorkflowDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowDeleteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowDeleteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowExplainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5741,10 +12917,10 @@ func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchWorkflowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowExplainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowExplainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5781,9 +12957,9 @@ func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5793,31 +12969,27 @@ func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5845,7 +13017,7 @@ func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5869,7 +13041,7 @@ func (m *WatchWorkflowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *NodeExplanation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,15 +13064,15 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeExplanation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5928,13 +13100,13 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -5944,27 +13116,23 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Object == nil {
-				m.Object = &v1alpha1.Workflow{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5988,7 +13156,7 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowExplainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6011,15 +13179,15 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowExplainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowExplainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6047,11 +13215,75 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6078,10 +13310,8 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reasons = append(m.Reasons, &NodeExplanation{})
+			if err := m.Reasons[len(m.Reasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6107,7 +13337,7 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+func (m *WorkflowTimelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6130,15 +13360,15 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTimelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6166,11 +13396,11 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6198,7 +13428,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6222,7 +13452,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
+func (m *TimelineEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6245,17 +13475,17 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowLintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelineEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowLintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelineEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6265,29 +13495,33 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Time == nil {
+				m.Time = &v1.Time{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6297,82 +13531,59 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Workflow == nil {
-				m.Workflow = &v1alpha1.Workflow{}
-			}
-			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWorkflow
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflow
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowSubmitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6400,11 +13611,11 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6432,11 +13643,11 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceKind = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6464,11 +13675,62 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6495,10 +13757,8 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitOptions == nil {
-				m.SubmitOptions = &v1alpha1.SubmitOpts{}
-			}
-			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, &TimelineEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30,11 +30,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ListArchivedWorkflowsRequest struct {
-	ListOptions          *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	NamePrefix           string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	NamePrefix  string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// Search matches the words against the name, labels, annotations, and node display names and messages of the
+	// archived workflows. Every word must match.
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// Fields to be included or excluded in the response. e.g. "items.spec,items.status.phase", "-items.metadata.managedFields"
+	Fields               string   `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListArchivedWorkflowsRequest) Reset()         { *m = ListArchivedWorkflowsRequest{} }
@@ -84,8 +89,24 @@ func (m *ListArchivedWorkflowsRequest) GetNamePrefix() string {
 	return ""
 }
 
+func (m *ListArchivedWorkflowsRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Fields to be included or excluded in the response. e.g. "spec,status.phase", "-status.nodes"
+	Fields               string   `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -131,6 +152,13 @@ func (m *GetArchivedWorkflowRequest) GetUid() string {
 	return ""
 }
 
+func (m *GetArchivedWorkflowRequest) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
 type DeleteArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -217,6 +245,137 @@ func (m *ArchivedWorkflowDeletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArchivedWorkflowDeletedResponse proto.InternalMessageInfo
 
+type CreateArchivedWorkflowRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The workflow to archive, e.g. one exported from another archive. It replaces any archived workflow with its UID.
+	Workflow             *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateArchivedWorkflowRequest) Reset()         { *m = CreateArchivedWorkflowRequest{} }
+func (m *CreateArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateArchivedWorkflowRequest) ProtoMessage()    {}
+func (*CreateArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{4}
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *CreateArchivedWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CreateArchivedWorkflowRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+type ResubmitArchivedWorkflowRequest struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The namespace to resubmit the workflow in, by default the namespace it ran in.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Memoized re-uses the outputs of the nodes that succeeded, as long as their output artifacts still exist and their
+	// inputs are unchanged.
+	Memoized bool `protobuf:"varint,3,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	// Parameters override the workflow's arguments, e.g. "message=hello".
+	Parameters           []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Reset()         { *m = ResubmitArchivedWorkflowRequest{} }
+func (m *ResubmitArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ResubmitArchivedWorkflowRequest) ProtoMessage()    {}
+func (*ResubmitArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{5}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *ResubmitArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetMemoized() bool {
+	if m != nil {
+		return m.Memoized
+	}
+	return false
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 type ListArchivedWorkflowLabelKeysRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -227,7 +386,7 @@ func (m *ListArchivedWorkflowLabelKeysRequest) Reset()         { *m = ListArchiv
 func (m *ListArchivedWorkflowLabelKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*ListArchivedWorkflowLabelKeysRequest) ProtoMessage()    {}
 func (*ListArchivedWorkflowLabelKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95ca9a2d33e8bb19, []int{4}
+	return fileDescriptor_95ca9a2d33e8bb19, []int{6}
 }
 func (m *ListArchivedWorkflowLabelKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +428,7 @@ func (m *ListArchivedWorkflowLabelValuesRequest) Reset() {
 func (m *ListArchivedWorkflowLabelValuesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListArchivedWorkflowLabelValuesRequest) ProtoMessage()    {}
 func (*ListArchivedWorkflowLabelValuesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95ca9a2d33e8bb19, []int{5}
+	return fileDescriptor_95ca9a2d33e8bb19, []int{7}
 }
 func (m *ListArchivedWorkflowLabelValuesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,601 +464,2496 @@ func (m *ListArchivedWorkflowLabelValuesRequest) GetListOptions() *v1.ListOption
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
-	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
-	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
-	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
-	proto.RegisterType((*ListArchivedWorkflowLabelKeysRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelKeysRequest")
-	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
+type GetArchivedWorkflowTemplateStatsRequest struct {
+	// The namespace of the workflows.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the workflow template, or cluster workflow template, the workflows were submitted from.
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ClusterScope bool   `protobuf:"varint,3,opt,name=clusterScope,proto3" json:"clusterScope,omitempty"`
+	// The number of most recent runs, default 10, at most 100.
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("pkg/apiclient/workflowarchive/workflow-archive.proto", fileDescriptor_95ca9a2d33e8bb19)
+func (m *GetArchivedWorkflowTemplateStatsRequest) Reset() {
+	*m = GetArchivedWorkflowTemplateStatsRequest{}
 }
-
-var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdf, 0x6a, 0x13, 0x4f,
-	0x14, 0xc7, 0x99, 0xfe, 0xe0, 0x27, 0x9d, 0x5e, 0x28, 0x23, 0x6a, 0x59, 0xd2, 0x34, 0x0e, 0xda,
-	0x46, 0x65, 0x67, 0xdc, 0xb6, 0xa2, 0x97, 0x2a, 0x82, 0x60, 0xd3, 0x2a, 0x29, 0x28, 0x78, 0x23,
-	0x93, 0xec, 0xe9, 0x66, 0xcc, 0x66, 0x67, 0xdd, 0x99, 0x6c, 0x2d, 0xd2, 0x1b, 0x5f, 0xa1, 0xaf,
-	0xe0, 0x43, 0x88, 0x4f, 0xe0, 0xa5, 0xe8, 0x9d, 0x57, 0x12, 0xbc, 0xf2, 0x29, 0x64, 0x27, 0xd9,
-	0x24, 0xe6, 0x3f, 0x58, 0xef, 0x26, 0x27, 0xe7, 0x7c, 0xe7, 0x73, 0xce, 0x7e, 0x0f, 0x83, 0x77,
-	0xe2, 0x66, 0xc0, 0x45, 0x2c, 0xeb, 0xa1, 0x84, 0xc8, 0xf0, 0x23, 0x95, 0x34, 0x0f, 0x43, 0x75,
-	0x24, 0x92, 0x7a, 0x43, 0xa6, 0xd0, 0xff, 0xed, 0xf6, 0x02, 0x2c, 0x4e, 0x94, 0x51, 0xe4, 0xfc,
-	0x48, 0x9e, 0x53, 0x08, 0x94, 0x0a, 0x42, 0xc8, 0x94, 0xb8, 0x88, 0x22, 0x65, 0x84, 0x91, 0x2a,
-	0xd2, 0xdd, 0x74, 0x67, 0xa7, 0x79, 0x4f, 0x33, 0xa9, 0xb2, 0x7f, 0x5b, 0xa2, 0xde, 0x90, 0x11,
-	0x24, 0xc7, 0xbc, 0x77, 0xb1, 0xe6, 0x2d, 0x30, 0x82, 0xa7, 0x1e, 0x0f, 0x20, 0x82, 0x44, 0x18,
-	0xf0, 0x7b, 0x55, 0x7b, 0x81, 0x34, 0x8d, 0x76, 0x8d, 0xd5, 0x55, 0x8b, 0x8b, 0x24, 0x50, 0x71,
-	0xa2, 0x5e, 0xdb, 0x83, 0x9b, 0xdf, 0xae, 0x07, 0x22, 0x79, 0x88, 0xa7, 0x9e, 0x08, 0xe3, 0x86,
-	0x18, 0x93, 0xa3, 0xa7, 0x08, 0x17, 0x2a, 0x52, 0x9b, 0x07, 0x5d, 0x64, 0xff, 0x45, 0x2e, 0x52,
-	0x85, 0x37, 0x6d, 0xd0, 0x86, 0x1c, 0xe0, 0x95, 0x50, 0x6a, 0xf3, 0x34, 0xb6, 0xe8, 0xab, 0xa8,
-	0x84, 0xca, 0x2b, 0x5b, 0x1e, 0xeb, 0xb2, 0xb3, 0x61, 0x76, 0x16, 0x37, 0x83, 0x2c, 0xa0, 0x59,
-	0xc6, 0xce, 0x52, 0x8f, 0x55, 0x06, 0x85, 0xd5, 0x61, 0x15, 0x52, 0xc4, 0x38, 0x12, 0x2d, 0x78,
-	0x96, 0xc0, 0xa1, 0x7c, 0xbb, 0xba, 0x54, 0x42, 0xe5, 0xe5, 0xea, 0x50, 0x84, 0x32, 0xec, 0x3c,
-	0x86, 0x31, 0xa6, 0x1c, 0xe9, 0x02, 0xfe, 0xaf, 0x2d, 0x7d, 0x8b, 0xb2, 0x5c, 0xcd, 0x8e, 0xd4,
-	0xc3, 0x6b, 0x8f, 0x20, 0x04, 0x03, 0x8b, 0x97, 0x5c, 0xc5, 0xeb, 0xa3, 0xc9, 0x5d, 0x09, 0xbf,
-	0x0a, 0x3a, 0x56, 0x91, 0x06, 0xba, 0x81, 0xaf, 0x4d, 0x1a, 0x4d, 0x45, 0xd4, 0x20, 0xdc, 0x85,
-	0xe3, 0x7c, 0x44, 0xf4, 0x04, 0x6f, 0x4c, 0xcd, 0x7b, 0x2e, 0xc2, 0x36, 0xfc, 0xd3, 0x61, 0x6e,
-	0xfd, 0x3a, 0x87, 0xaf, 0x8c, 0xde, 0x7d, 0x00, 0x49, 0x2a, 0xeb, 0x40, 0x3e, 0x21, 0x7c, 0x69,
-	0xe2, 0xe7, 0x25, 0x2e, 0x1b, 0x71, 0x2b, 0x9b, 0x65, 0x03, 0x67, 0x9f, 0x0d, 0x7c, 0xc7, 0x72,
-	0xdf, 0xd9, 0xc3, 0xab, 0xbe, 0xef, 0x58, 0xba, 0x3d, 0xc0, 0xce, 0xa3, 0x2c, 0xb7, 0x1e, 0xeb,
-	0xcf, 0x45, 0x6a, 0x43, 0xe9, 0xfb, 0x6f, 0x3f, 0x4f, 0x97, 0x0a, 0xc4, 0xb1, 0xcb, 0x91, 0x7a,
-	0xbc, 0x47, 0xe1, 0x0f, 0x6c, 0x4c, 0x3e, 0x22, 0x7c, 0x71, 0x82, 0x0d, 0xc8, 0xad, 0x31, 0xf4,
-	0xe9, 0x66, 0x71, 0x9e, 0x9c, 0x1d, 0x38, 0x2d, 0x5b, 0x68, 0x4a, 0x4a, 0xd3, 0xa1, 0xf9, 0xbb,
-	0xb6, 0xf4, 0x4f, 0xc8, 0x07, 0x84, 0x2f, 0x4f, 0x76, 0x24, 0x61, 0x63, 0xf4, 0x33, 0xad, 0xeb,
-	0xdc, 0x1e, 0xcb, 0x9f, 0xe7, 0xdb, 0x1e, 0xe6, 0xcd, 0xf9, 0x98, 0x5f, 0x11, 0x5e, 0x9b, 0x69,
-	0x71, 0x72, 0x67, 0x21, 0x9b, 0x8c, 0xae, 0x84, 0xb3, 0xfb, 0xf7, 0x53, 0xef, 0x6b, 0x52, 0xd7,
-	0xf6, 0xb3, 0x49, 0xae, 0x4f, 0xef, 0xc7, 0x0d, 0xb3, 0x6c, 0xb7, 0x99, 0x21, 0x7f, 0x47, 0x78,
-	0x7d, 0xce, 0x3e, 0x92, 0xbb, 0x8b, 0xb7, 0xf5, 0xc7, 0x06, 0x3b, 0x7b, 0x67, 0xd4, 0x58, 0x57,
-	0x95, 0x72, 0xdb, 0xda, 0x0d, 0xb2, 0x39, 0xb7, 0xb5, 0xd4, 0x16, 0x3c, 0xdc, 0xff, 0xdc, 0x29,
-	0xa2, 0x2f, 0x9d, 0x22, 0xfa, 0xd1, 0x29, 0xa2, 0x97, 0xf7, 0x17, 0x7f, 0x0c, 0x26, 0x3f, 0x65,
-	0xb5, 0xff, 0xed, 0x33, 0xb0, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x42, 0xe1, 0x0d, 0xc1, 0xf2,
-	0x06, 0x00, 0x00,
+func (m *GetArchivedWorkflowTemplateStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArchivedWorkflowTemplateStatsRequest) ProtoMessage()    {}
+func (*GetArchivedWorkflowTemplateStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ArchivedWorkflowServiceClient is the client API for ArchivedWorkflowService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ArchivedWorkflowServiceClient interface {
-	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
-	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
-	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
-	ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error)
-	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
+func (m *GetArchivedWorkflowTemplateStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type archivedWorkflowServiceClient struct {
-	cc *grpc.ClientConn
+func (m *GetArchivedWorkflowTemplateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetArchivedWorkflowTemplateStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewArchivedWorkflowServiceClient(cc *grpc.ClientConn) ArchivedWorkflowServiceClient {
-	return &archivedWorkflowServiceClient{cc}
+func (m *GetArchivedWorkflowTemplateStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArchivedWorkflowTemplateStatsRequest.Merge(m, src)
 }
-
-func (c *archivedWorkflowServiceClient) ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
-	out := new(v1alpha1.WorkflowList)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetArchivedWorkflowTemplateStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetArchivedWorkflowTemplateStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArchivedWorkflowTemplateStatsRequest.DiscardUnknown(m)
 }
 
-func (c *archivedWorkflowServiceClient) GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetArchivedWorkflowTemplateStatsRequest proto.InternalMessageInfo
+
+func (m *GetArchivedWorkflowTemplateStatsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error) {
-	out := new(ArchivedWorkflowDeletedResponse)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetArchivedWorkflowTemplateStatsRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error) {
-	out := new(v1alpha1.LabelKeys)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetArchivedWorkflowTemplateStatsRequest) GetClusterScope() bool {
+	if m != nil {
+		return m.ClusterScope
 	}
-	return out, nil
+	return false
 }
 
-func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error) {
-	out := new(v1alpha1.LabelValues)
-	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelValues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetArchivedWorkflowTemplateStatsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-// ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
-type ArchivedWorkflowServiceServer interface {
-	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
-	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
-	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
-	ListArchivedWorkflowLabelKeys(context.Context, *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error)
-	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
+type ArchivedWorkflowRun struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                  string           `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Phase                string           `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	StartedAt            *v1.Time         `protobuf:"bytes,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	DurationSeconds      int64            `protobuf:"varint,5,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	ResourcesDuration    map[string]int64 `protobuf:"bytes,6,rep,name=resourcesDuration,proto3" json:"resourcesDuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-// UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedArchivedWorkflowServiceServer struct {
+func (m *ArchivedWorkflowRun) Reset()         { *m = ArchivedWorkflowRun{} }
+func (m *ArchivedWorkflowRun) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowRun) ProtoMessage()    {}
+func (*ArchivedWorkflowRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{9}
 }
-
-func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflows(ctx context.Context, req *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflows not implemented")
+func (m *ArchivedWorkflowRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx context.Context, req *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflow not implemented")
+func (m *ArchivedWorkflowRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
+func (m *ArchivedWorkflowRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowRun.Merge(m, src)
 }
-func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelKeys(ctx context.Context, req *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelKeys not implemented")
+func (m *ArchivedWorkflowRun) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelValues(ctx context.Context, req *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelValues not implemented")
+func (m *ArchivedWorkflowRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowRun.DiscardUnknown(m)
 }
 
-func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
-	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
+var xxx_messageInfo_ArchivedWorkflowRun proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowRun) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func _ArchivedWorkflowService_ListArchivedWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArchivedWorkflowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ArchivedWorkflowRun) GetUid() string {
+	if m != nil {
+		return m.Uid
 	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, in)
+	return ""
+}
+
+func (m *ArchivedWorkflowRun) GetPhase() string {
+	if m != nil {
+		return m.Phase
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows",
+	return ""
+}
+
+func (m *ArchivedWorkflowRun) GetStartedAt() *v1.Time {
+	if m != nil {
+		return m.StartedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, req.(*ListArchivedWorkflowsRequest))
+	return nil
+}
+
+func (m *ArchivedWorkflowRun) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ArchivedWorkflowService_GetArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArchivedWorkflowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, req.(*GetArchivedWorkflowRequest))
+func (m *ArchivedWorkflowRun) GetResourcesDuration() map[string]int64 {
+	if m != nil {
+		return m.ResourcesDuration
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteArchivedWorkflowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, req.(*DeleteArchivedWorkflowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ArchivedTemplateStats struct {
+	// The name of the template, or for templates of template refs, the workflow template's name, a slash, and the
+	// template's name.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// The number of runs the template was run in.
+	Runs int32 `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	// The number of nodes of the template, e.g. more than the runs for templates run in loops, or retried.
+	Nodes       int32 `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	FailedNodes int32 `protobuf:"varint,4,opt,name=failedNodes,proto3" json:"failedNodes,omitempty"`
+	// The statistics of the durations of the nodes.
+	MeanDurationSeconds int64 `protobuf:"varint,5,opt,name=meanDurationSeconds,proto3" json:"meanDurationSeconds,omitempty"`
+	P50DurationSeconds  int64 `protobuf:"varint,6,opt,name=p50DurationSeconds,proto3" json:"p50DurationSeconds,omitempty"`
+	P95DurationSeconds  int64 `protobuf:"varint,7,opt,name=p95DurationSeconds,proto3" json:"p95DurationSeconds,omitempty"`
+	MaxDurationSeconds  int64 `protobuf:"varint,8,opt,name=maxDurationSeconds,proto3" json:"maxDurationSeconds,omitempty"`
+	// The mean resources duration of the nodes, e.g. {"cpu": 10, "memory": 20}.
+	MeanResourcesDuration map[string]int64 `protobuf:"bytes,9,rep,name=meanResourcesDuration,proto3" json:"meanResourcesDuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The trend of the total duration of the template's nodes in each run, in the order of the runs, 0 if the template
+	// was not run.
+	DurationSeconds      []int64  `protobuf:"varint,10,rep,packed,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ArchivedWorkflowService_ListArchivedWorkflowLabelKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArchivedWorkflowLabelKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelKeys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelKeys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelKeys(ctx, req.(*ListArchivedWorkflowLabelKeysRequest))
+func (m *ArchivedTemplateStats) Reset()         { *m = ArchivedTemplateStats{} }
+func (m *ArchivedTemplateStats) String() string { return proto.CompactTextString(m) }
+func (*ArchivedTemplateStats) ProtoMessage()    {}
+func (*ArchivedTemplateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{10}
+}
+func (m *ArchivedTemplateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedTemplateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedTemplateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ArchivedTemplateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedTemplateStats.Merge(m, src)
+}
+func (m *ArchivedTemplateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedTemplateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedTemplateStats.DiscardUnknown(m)
 }
 
-func _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArchivedWorkflowLabelValuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelValues(ctx, req.(*ListArchivedWorkflowLabelValuesRequest))
+var xxx_messageInfo_ArchivedTemplateStats proto.InternalMessageInfo
+
+func (m *ArchivedTemplateStats) GetTemplate() string {
+	if m != nil {
+		return m.Template
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "workflowarchive.ArchivedWorkflowService",
-	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListArchivedWorkflows",
-			Handler:    _ArchivedWorkflowService_ListArchivedWorkflows_Handler,
-		},
-		{
-			MethodName: "GetArchivedWorkflow",
-			Handler:    _ArchivedWorkflowService_GetArchivedWorkflow_Handler,
-		},
-		{
-			MethodName: "DeleteArchivedWorkflow",
-			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
-		},
-		{
-			MethodName: "ListArchivedWorkflowLabelKeys",
-			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelKeys_Handler,
-		},
-		{
-			MethodName: "ListArchivedWorkflowLabelValues",
-			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
+func (m *ArchivedTemplateStats) GetRuns() int32 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
 }
 
-func (m *ListArchivedWorkflowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedTemplateStats) GetNodes() int32 {
+	if m != nil {
+		return m.Nodes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListArchivedWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedTemplateStats) GetFailedNodes() int32 {
+	if m != nil {
+		return m.FailedNodes
+	}
+	return 0
 }
 
-func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.NamePrefix) > 0 {
-		i -= len(m.NamePrefix)
-		copy(dAtA[i:], m.NamePrefix)
-		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NamePrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ArchivedTemplateStats) GetMeanDurationSeconds() int64 {
+	if m != nil {
+		return m.MeanDurationSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedTemplateStats) GetP50DurationSeconds() int64 {
+	if m != nil {
+		return m.P50DurationSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedTemplateStats) GetP95DurationSeconds() int64 {
+	if m != nil {
+		return m.P95DurationSeconds
+	}
+	return 0
 }
 
-func (m *GetArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0xa
+func (m *ArchivedTemplateStats) GetMaxDurationSeconds() int64 {
+	if m != nil {
+		return m.MaxDurationSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DeleteArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedTemplateStats) GetMeanResourcesDuration() map[string]int64 {
+	if m != nil {
+		return m.MeanResourcesDuration
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchivedTemplateStats) GetDurationSeconds() []int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return nil
 }
 
-func (m *DeleteArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ArchivedWorkflowTemplateStats struct {
+	// The most recent runs, most recent first.
+	Runs []*ArchivedWorkflowRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	// The statistics of each template, sorted by name.
+	Templates            []*ArchivedTemplateStats `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ArchivedWorkflowDeletedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedWorkflowTemplateStats) Reset()         { *m = ArchivedWorkflowTemplateStats{} }
+func (m *ArchivedWorkflowTemplateStats) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowTemplateStats) ProtoMessage()    {}
+func (*ArchivedWorkflowTemplateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{11}
+}
+func (m *ArchivedWorkflowTemplateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowTemplateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowTemplateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ArchivedWorkflowTemplateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowTemplateStats.Merge(m, src)
+}
+func (m *ArchivedWorkflowTemplateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowTemplateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowTemplateStats.DiscardUnknown(m)
 }
 
-func (m *ArchivedWorkflowDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ArchivedWorkflowTemplateStats proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowTemplateStats) GetRuns() []*ArchivedWorkflowRun {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
 }
 
-func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ArchivedWorkflowTemplateStats) GetTemplates() []*ArchivedTemplateStats {
+	if m != nil {
+		return m.Templates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ListArchivedWorkflowLabelKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
+	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
+	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
+	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
+	proto.RegisterType((*CreateArchivedWorkflowRequest)(nil), "workflowarchive.CreateArchivedWorkflowRequest")
+	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*ListArchivedWorkflowLabelKeysRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelKeysRequest")
+	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
+	proto.RegisterType((*GetArchivedWorkflowTemplateStatsRequest)(nil), "workflowarchive.GetArchivedWorkflowTemplateStatsRequest")
+	proto.RegisterType((*ArchivedWorkflowRun)(nil), "workflowarchive.ArchivedWorkflowRun")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowarchive.ArchivedWorkflowRun.ResourcesDurationEntry")
+	proto.RegisterType((*ArchivedTemplateStats)(nil), "workflowarchive.ArchivedTemplateStats")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowarchive.ArchivedTemplateStats.MeanResourcesDurationEntry")
+	proto.RegisterType((*ArchivedWorkflowTemplateStats)(nil), "workflowarchive.ArchivedWorkflowTemplateStats")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/workflowarchive/workflow-archive.proto", fileDescriptor_95ca9a2d33e8bb19)
+}
+
+var fileDescriptor_95ca9a2d33e8bb19 = []byte{
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xd7, 0xec, 0x76, 0x43, 0xf6, 0x6d, 0xa5, 0xc2, 0xb4, 0x0d, 0x2b, 0x2b, 0x1f, 0x8b, 0xd5,
+	0x26, 0xdb, 0x54, 0x6b, 0x67, 0xd3, 0x44, 0xa4, 0xe5, 0x42, 0x20, 0x40, 0x45, 0x93, 0x80, 0x9c,
+	0x0a, 0x24, 0x2e, 0x68, 0xb2, 0x7e, 0xd9, 0x98, 0xf5, 0x17, 0x1e, 0x7b, 0xd3, 0x80, 0x7a, 0xe1,
+	0xce, 0x09, 0x71, 0xe6, 0x02, 0x57, 0xce, 0x88, 0x1e, 0x38, 0x70, 0xe1, 0x84, 0x10, 0xdc, 0x38,
+	0xa1, 0x88, 0xbf, 0x81, 0x33, 0x9a, 0xb1, 0xbd, 0x1f, 0x5e, 0xef, 0x47, 0x45, 0xb8, 0xcd, 0x7b,
+	0xf3, 0xde, 0x9b, 0xdf, 0x7b, 0xef, 0x37, 0xe3, 0x67, 0xd8, 0xf2, 0x3b, 0x6d, 0x9d, 0xf9, 0x56,
+	0xcb, 0xb6, 0xd0, 0x0d, 0xf5, 0x33, 0x2f, 0xe8, 0x9c, 0xd8, 0xde, 0x19, 0x0b, 0x5a, 0xa7, 0x56,
+	0x17, 0x7b, 0x72, 0x23, 0x51, 0x68, 0x7e, 0xe0, 0x85, 0x1e, 0xbd, 0x96, 0xb1, 0x53, 0x16, 0xdb,
+	0x9e, 0xd7, 0xb6, 0x51, 0x44, 0xd2, 0x99, 0xeb, 0x7a, 0x21, 0x0b, 0x2d, 0xcf, 0xe5, 0xb1, 0xb9,
+	0xb2, 0xd5, 0xd9, 0xe1, 0x9a, 0xe5, 0x89, 0x5d, 0x87, 0xb5, 0x4e, 0x2d, 0x17, 0x83, 0x73, 0x3d,
+	0x39, 0x98, 0xeb, 0x0e, 0x86, 0x4c, 0xef, 0x36, 0xf5, 0x36, 0xba, 0x18, 0xb0, 0x10, 0xcd, 0xc4,
+	0xeb, 0xa0, 0x6d, 0x85, 0xa7, 0xd1, 0xb1, 0xd6, 0xf2, 0x1c, 0x9d, 0x05, 0x6d, 0xcf, 0x0f, 0xbc,
+	0x4f, 0xe4, 0xa2, 0x91, 0x9e, 0xce, 0xfb, 0x41, 0x52, 0x95, 0xde, 0x6d, 0x32, 0xdb, 0x3f, 0x65,
+	0x23, 0xe1, 0xd4, 0x9f, 0x09, 0x2c, 0xee, 0x5b, 0x3c, 0xdc, 0x8d, 0x21, 0x9b, 0x1f, 0xa6, 0x41,
+	0x0c, 0xfc, 0x34, 0x42, 0x1e, 0xd2, 0x23, 0xa8, 0xd8, 0x16, 0x0f, 0xdf, 0xf3, 0x25, 0xf4, 0x2a,
+	0xa9, 0x91, 0x7a, 0x65, 0xb3, 0xa9, 0xc5, 0xd8, 0xb5, 0x41, 0xec, 0x9a, 0xdf, 0x69, 0x0b, 0x05,
+	0xd7, 0x04, 0x76, 0xad, 0xdb, 0xd4, 0xf6, 0xfb, 0x8e, 0xc6, 0x60, 0x14, 0xba, 0x0c, 0xe0, 0x32,
+	0x07, 0xdf, 0x0f, 0xf0, 0xc4, 0x7a, 0x52, 0x2d, 0xd4, 0x48, 0xbd, 0x6c, 0x0c, 0x68, 0xe8, 0x02,
+	0xcc, 0x71, 0x14, 0x55, 0xac, 0x16, 0xe5, 0x5e, 0x22, 0x09, 0xfd, 0x89, 0x85, 0xb6, 0xc9, 0xab,
+	0x57, 0x62, 0x7d, 0x2c, 0xa9, 0x6f, 0x83, 0xf2, 0x0e, 0x8e, 0xe4, 0x90, 0xa6, 0xf0, 0x22, 0x14,
+	0x23, 0xcb, 0x94, 0xd0, 0xcb, 0x86, 0x58, 0x0e, 0xc4, 0x29, 0x0c, 0xc5, 0x69, 0xc2, 0xd2, 0x1e,
+	0xda, 0x18, 0xe2, 0xcc, 0xa1, 0xd4, 0x57, 0x60, 0x25, 0x6b, 0x1c, 0x87, 0x30, 0x0d, 0xe4, 0xbe,
+	0xe7, 0x72, 0x54, 0xbf, 0x23, 0xb0, 0xf4, 0x66, 0x80, 0x6c, 0x7c, 0xd8, 0x45, 0x28, 0x8b, 0xec,
+	0xb9, 0xcf, 0x5a, 0x98, 0x04, 0xef, 0x2b, 0xe8, 0x09, 0xcc, 0xa7, 0x8d, 0x94, 0x78, 0x2b, 0x9b,
+	0xef, 0x6a, 0x7d, 0x16, 0x68, 0x29, 0x0b, 0xe4, 0xe2, 0xe3, 0x1e, 0x0b, 0xb4, 0xee, 0xbd, 0x7e,
+	0x47, 0x52, 0xad, 0x96, 0x12, 0x41, 0xeb, 0x41, 0xe8, 0xc5, 0x56, 0xbf, 0x24, 0xb0, 0x62, 0x20,
+	0x8f, 0x8e, 0x1d, 0xeb, 0x39, 0x6a, 0x39, 0x84, 0xbd, 0x90, 0xc5, 0xae, 0xc0, 0xbc, 0x83, 0x8e,
+	0x67, 0x7d, 0x86, 0xa6, 0xec, 0xe5, 0xbc, 0xd1, 0x93, 0x05, 0x0b, 0x7c, 0x16, 0x30, 0x07, 0x43,
+	0x0c, 0x44, 0x47, 0x8b, 0x82, 0x05, 0x7d, 0x8d, 0xba, 0x0a, 0xb7, 0xf2, 0xa8, 0xb9, 0xcf, 0x8e,
+	0xd1, 0x7e, 0x84, 0xe7, 0x29, 0x45, 0xd5, 0xa7, 0xb0, 0x3a, 0xd6, 0xee, 0x03, 0x66, 0x47, 0xf8,
+	0xbf, 0x92, 0x59, 0xfd, 0x9a, 0xc0, 0x5a, 0x0e, 0xfb, 0x1e, 0xa3, 0xe3, 0xdb, 0x2c, 0xc4, 0xa3,
+	0x90, 0x85, 0x7c, 0xb6, 0x46, 0x53, 0xb8, 0x22, 0x84, 0xa4, 0x8a, 0x72, 0x4d, 0x55, 0xb8, 0xda,
+	0xb2, 0x23, 0x1e, 0x62, 0x70, 0xd4, 0xf2, 0x7c, 0x4c, 0x8a, 0x38, 0xa4, 0xa3, 0x37, 0xa0, 0x64,
+	0x5b, 0x8e, 0x15, 0xca, 0x5b, 0x51, 0x32, 0x62, 0x41, 0xfd, 0xa7, 0x00, 0xd7, 0x47, 0xda, 0x18,
+	0xb9, 0xbd, 0x53, 0xc8, 0xc0, 0x29, 0x49, 0x5b, 0x0b, 0xfd, 0xb6, 0xde, 0x80, 0x92, 0x7f, 0xca,
+	0x38, 0x26, 0x37, 0x30, 0x16, 0xe8, 0x43, 0x28, 0xf3, 0x90, 0x05, 0x21, 0x9a, 0xbb, 0xf1, 0x69,
+	0x95, 0xcd, 0xf5, 0xd9, 0xca, 0xf7, 0xd8, 0x72, 0xd0, 0xe8, 0x3b, 0xd3, 0x3a, 0x5c, 0x33, 0xa3,
+	0x40, 0x3e, 0x88, 0x47, 0xd8, 0xf2, 0x5c, 0x93, 0x57, 0x4b, 0x35, 0x52, 0x2f, 0x1a, 0x59, 0x35,
+	0xb5, 0xe0, 0xa5, 0x00, 0xb9, 0x17, 0x05, 0x2d, 0xe4, 0x7b, 0xc9, 0x5e, 0x75, 0xae, 0x56, 0xac,
+	0x57, 0x36, 0x5f, 0xd3, 0x32, 0x4f, 0xae, 0x96, 0x93, 0xb0, 0x66, 0x64, 0xbd, 0xdf, 0x72, 0xc3,
+	0xe0, 0xdc, 0x18, 0x8d, 0xaa, 0xec, 0xc1, 0x42, 0xbe, 0xb1, 0x28, 0x50, 0x07, 0xcf, 0x53, 0xde,
+	0x77, 0xf0, 0x5c, 0x14, 0xa8, 0x2b, 0xc8, 0x25, 0x8b, 0x56, 0x34, 0x62, 0xe1, 0x41, 0x61, 0x87,
+	0xa8, 0xdf, 0x5f, 0x81, 0x9b, 0x29, 0x8e, 0x21, 0x16, 0x88, 0xdb, 0x10, 0x26, 0x8a, 0x24, 0x54,
+	0x4f, 0x16, 0x6d, 0x09, 0x22, 0x37, 0x7e, 0x91, 0x4a, 0x86, 0x5c, 0x8b, 0x33, 0x5c, 0xcf, 0x44,
+	0x2e, 0x9b, 0x50, 0x32, 0x62, 0x81, 0xd6, 0xa0, 0x72, 0xc2, 0x2c, 0x1b, 0xcd, 0x43, 0xb9, 0x17,
+	0x37, 0x7d, 0x50, 0x45, 0x37, 0xe0, 0xba, 0x83, 0xcc, 0xdd, 0xcb, 0x2d, 0x70, 0xde, 0x16, 0xd5,
+	0x80, 0xfa, 0xdb, 0x1b, 0x59, 0x87, 0x39, 0xe9, 0x90, 0xb3, 0x23, 0xed, 0xef, 0x6f, 0x67, 0xed,
+	0x5f, 0x48, 0xec, 0xef, 0x6f, 0xe7, 0xd8, 0x3b, 0xec, 0x49, 0xd6, 0x7e, 0x3e, 0xb6, 0x1f, 0xdd,
+	0xa1, 0x67, 0x70, 0x53, 0xc0, 0x1c, 0xe9, 0x46, 0xb5, 0x2c, 0x1b, 0xbf, 0x3b, 0xb6, 0xf1, 0x43,
+	0x05, 0xd7, 0x0e, 0xf2, 0x62, 0xc4, 0xed, 0xcf, 0x8f, 0x9f, 0xc7, 0x4b, 0xa8, 0x15, 0x73, 0x78,
+	0xa9, 0x3c, 0x04, 0x65, 0x7c, 0xf8, 0xe7, 0x22, 0xcc, 0x37, 0x04, 0x96, 0x26, 0x3e, 0x1f, 0x74,
+	0x27, 0x21, 0x07, 0x91, 0xd9, 0xdf, 0x9a, 0x85, 0xf6, 0x09, 0x85, 0xf6, 0xa0, 0x9c, 0x52, 0x4c,
+	0x70, 0x4b, 0xb8, 0xaf, 0xce, 0x56, 0x3c, 0xa3, 0xef, 0xb8, 0xf9, 0xec, 0x2a, 0xbc, 0x9c, 0x3d,
+	0xe3, 0x08, 0x83, 0xae, 0xd5, 0x42, 0xfa, 0x23, 0x81, 0x9b, 0xb9, 0x23, 0x04, 0x6d, 0x8c, 0x1c,
+	0x34, 0x69, 0xd4, 0x50, 0x0e, 0x2f, 0xef, 0xab, 0x26, 0xce, 0x51, 0xd5, 0x2f, 0xfe, 0xf8, 0xfb,
+	0xab, 0xc2, 0x22, 0x55, 0xe4, 0x00, 0xd6, 0x6d, 0xea, 0x09, 0x0a, 0xb3, 0x3f, 0x2a, 0xd1, 0x1f,
+	0x08, 0x5c, 0xcf, 0x79, 0xbc, 0xe9, 0xdd, 0x11, 0xe8, 0xe3, 0x07, 0x0c, 0xe5, 0x12, 0x3f, 0xc7,
+	0x6a, 0x5d, 0x82, 0x56, 0x69, 0x6d, 0x3c, 0x68, 0xfd, 0xf3, 0xc8, 0x32, 0x9f, 0xd2, 0x6f, 0x09,
+	0x2c, 0xe4, 0x4f, 0x2b, 0x54, 0x1b, 0x41, 0x3f, 0x71, 0xac, 0x51, 0x36, 0xa6, 0x12, 0x2a, 0x3b,
+	0xd3, 0x24, 0x30, 0xd7, 0xa7, 0xc3, 0x7c, 0x46, 0x60, 0x21, 0x7f, 0xfa, 0xc9, 0x81, 0x39, 0x71,
+	0x4c, 0xba, 0xd4, 0x3a, 0xdf, 0x96, 0x09, 0xac, 0xa8, 0x13, 0xc8, 0xf1, 0x80, 0xac, 0xd3, 0x5f,
+	0x09, 0x54, 0xc7, 0xcd, 0x44, 0x74, 0xb4, 0x6c, 0x53, 0xc6, 0xa7, 0x4b, 0xcd, 0x60, 0x4b, 0x66,
+	0xa0, 0x29, 0x77, 0xa6, 0xb5, 0x40, 0x0f, 0x12, 0x54, 0x22, 0xa1, 0xdf, 0x09, 0x2c, 0x4d, 0x9c,
+	0xaa, 0xe8, 0xf6, 0x4c, 0xb7, 0x36, 0x3b, 0x85, 0x29, 0x8f, 0xfe, 0x7b, 0x6a, 0xbd, 0x98, 0x6a,
+	0x43, 0xe6, 0xb6, 0x46, 0x6f, 0x8f, 0xcf, 0xad, 0x61, 0x0b, 0xeb, 0x46, 0x47, 0x40, 0xfe, 0x93,
+	0xc0, 0xca, 0x94, 0x11, 0x90, 0xbe, 0x3a, 0x7b, 0x5a, 0x43, 0x43, 0xa3, 0x72, 0x70, 0x49, 0x89,
+	0xc5, 0x51, 0x55, 0x5d, 0xa6, 0x76, 0x87, 0xae, 0x4d, 0x4d, 0xad, 0x1b, 0x03, 0xff, 0x89, 0x40,
+	0x6d, 0xda, 0x7c, 0x49, 0x77, 0x66, 0x79, 0xaf, 0xf2, 0x46, 0x52, 0x45, 0x9b, 0x7a, 0xf7, 0x87,
+	0xdc, 0xd4, 0xa6, 0xc4, 0x7f, 0x97, 0x4e, 0xa0, 0x5d, 0x23, 0xfd, 0x70, 0x34, 0xb8, 0x70, 0x79,
+	0xe3, 0xf0, 0x97, 0x8b, 0x65, 0xf2, 0xdb, 0xc5, 0x32, 0xf9, 0xeb, 0x62, 0x99, 0x7c, 0xf4, 0xfa,
+	0xec, 0x7f, 0xb0, 0xf9, 0xff, 0xdf, 0xc7, 0x73, 0xf2, 0xdf, 0xf5, 0xde, 0xbf, 0x03, 0x00, 0x8a,
+	0xe5, 0x45, 0x4d, 0xa7, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ArchivedWorkflowServiceClient is the client API for ArchivedWorkflowService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ArchivedWorkflowServiceClient interface {
+	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
+	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
+	CreateArchivedWorkflow(ctx context.Context, in *CreateArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error)
+	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
+	GetArchivedWorkflowTemplateStats(ctx context.Context, in *GetArchivedWorkflowTemplateStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowTemplateStats, error)
+}
+
+type archivedWorkflowServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewArchivedWorkflowServiceClient(cc *grpc.ClientConn) ArchivedWorkflowServiceClient {
+	return &archivedWorkflowServiceClient{cc}
+}
+
+func (c *archivedWorkflowServiceClient) ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	out := new(v1alpha1.WorkflowList)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListArchivedWorkflowLabelKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *archivedWorkflowServiceClient) GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListArchivedWorkflowLabelKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error) {
+	out := new(ArchivedWorkflowDeletedResponse)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListArchivedWorkflowLabelValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *archivedWorkflowServiceClient) CreateArchivedWorkflow(ctx context.Context, in *CreateArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/CreateArchivedWorkflow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListArchivedWorkflowLabelValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListArchivedWorkflowLabelValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error) {
+	out := new(v1alpha1.LabelKeys)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ListOptions != nil {
-		{
-			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error) {
+	out := new(v1alpha1.LabelValues)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelValues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowArchive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *archivedWorkflowServiceClient) GetArchivedWorkflowTemplateStats(ctx context.Context, in *GetArchivedWorkflowTemplateStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowTemplateStats, error) {
+	out := new(ArchivedWorkflowTemplateStats)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowTemplateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *ListArchivedWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
+type ArchivedWorkflowServiceServer interface {
+	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
+	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
+	CreateArchivedWorkflow(context.Context, *CreateArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	ListArchivedWorkflowLabelKeys(context.Context, *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error)
+	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
+	GetArchivedWorkflowTemplateStats(context.Context, *GetArchivedWorkflowTemplateStatsRequest) (*ArchivedWorkflowTemplateStats, error)
+}
+
+// UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedArchivedWorkflowServiceServer struct {
+}
+
+func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflows(ctx context.Context, req *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflows not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx context.Context, req *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) CreateArchivedWorkflow(ctx context.Context, req *CreateArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelKeys(ctx context.Context, req *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelKeys not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelValues(ctx context.Context, req *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelValues not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflowTemplateStats(ctx context.Context, req *GetArchivedWorkflowTemplateStatsRequest) (*ArchivedWorkflowTemplateStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflowTemplateStats not implemented")
+}
+
+func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
+	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
+}
+
+func _ArchivedWorkflowService_ListArchivedWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, in)
 	}
-	l = len(m.NamePrefix)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflows",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflows(ctx, req.(*ListArchivedWorkflowsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ArchivedWorkflowService_GetArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflow(ctx, req.(*GetArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/DeleteArchivedWorkflow",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).DeleteArchivedWorkflow(ctx, req.(*DeleteArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ArchivedWorkflowService_CreateArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).CreateArchivedWorkflow(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/CreateArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).CreateArchivedWorkflow(ctx, req.(*CreateArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListArchivedWorkflowLabelKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, req.(*ResubmitArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_ListArchivedWorkflowLabelKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedWorkflowLabelKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelKeys(ctx, req.(*ListArchivedWorkflowLabelKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedWorkflowLabelValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArchivedWorkflowLabelValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ListArchivedWorkflowLabelValues(ctx, req.(*ListArchivedWorkflowLabelValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_GetArchivedWorkflowTemplateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedWorkflowTemplateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowTemplateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowTemplateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowTemplateStats(ctx, req.(*GetArchivedWorkflowTemplateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "workflowarchive.ArchivedWorkflowService",
+	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListArchivedWorkflows",
+			Handler:    _ArchivedWorkflowService_ListArchivedWorkflows_Handler,
+		},
+		{
+			MethodName: "GetArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_GetArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "DeleteArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "CreateArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_CreateArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "ResubmitArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "ListArchivedWorkflowLabelKeys",
+			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelKeys_Handler,
+		},
+		{
+			MethodName: "ListArchivedWorkflowLabelValues",
+			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler,
+		},
+		{
+			MethodName: "GetArchivedWorkflowTemplateStats",
+			Handler:    _ArchivedWorkflowService_GetArchivedWorkflowTemplateStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
+}
+
+func (m *ListArchivedWorkflowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListArchivedWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Fields) > 0 {
+		i -= len(m.Fields)
+		copy(dAtA[i:], m.Fields)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Fields)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListArchivedWorkflowLabelValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fields) > 0 {
+		i -= len(m.Fields)
+		copy(dAtA[i:], m.Fields)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Fields)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowDeletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListArchivedWorkflowLabelKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListArchivedWorkflowLabelKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArchivedWorkflowLabelKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListArchivedWorkflowLabelValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListArchivedWorkflowLabelValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArchivedWorkflowLabelValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetArchivedWorkflowTemplateStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetArchivedWorkflowTemplateStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetArchivedWorkflowTemplateStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClusterScope {
+		i--
+		if m.ClusterScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k := range m.ResourcesDuration {
+			v := m.ResourcesDuration[k]
+			baseI := i
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedTemplateStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedTemplateStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedTemplateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DurationSeconds) > 0 {
+		dAtA6 := make([]byte, len(m.DurationSeconds)*10)
+		var j5 int
+		for _, num1 := range m.DurationSeconds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MeanResourcesDuration) > 0 {
+		for k := range m.MeanResourcesDuration {
+			v := m.MeanResourcesDuration[k]
+			baseI := i
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxDurationSeconds != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.MaxDurationSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.P95DurationSeconds != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.P95DurationSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.P50DurationSeconds != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.P50DurationSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MeanDurationSeconds != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.MeanDurationSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FailedNodes != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.FailedNodes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nodes != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Nodes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Runs != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowTemplateStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowTemplateStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowTemplateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Runs) > 0 {
+		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListArchivedWorkflowLabelKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListArchivedWorkflowLabelValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowTemplateStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.ClusterScope {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.DurationSeconds))
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowArchive(uint64(len(k))) + 1 + sovWorkflowArchive(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowArchive(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedTemplateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Runs))
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Nodes))
+	}
+	if m.FailedNodes != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.FailedNodes))
+	}
+	if m.MeanDurationSeconds != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.MeanDurationSeconds))
+	}
+	if m.P50DurationSeconds != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.P50DurationSeconds))
+	}
+	if m.P95DurationSeconds != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.P95DurationSeconds))
+	}
+	if m.MaxDurationSeconds != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.MaxDurationSeconds))
+	}
+	if len(m.MeanResourcesDuration) > 0 {
+		for k, v := range m.MeanResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowArchive(uint64(len(k))) + 1 + sovWorkflowArchive(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowArchive(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DurationSeconds) > 0 {
+		l = 0
+		for _, e := range m.DurationSeconds {
+			l += sovWorkflowArchive(uint64(e))
+		}
+		n += 1 + sovWorkflowArchive(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowTemplateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflowArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflowArchive(x uint64) (n int) {
+	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memoized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListArchivedWorkflowLabelKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListArchivedWorkflowLabelKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListArchivedWorkflowLabelKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovWorkflowArchive(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWorkflowArchive(x uint64) (n int) {
-	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListArchivedWorkflowLabelValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -922,10 +2976,10 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListArchivedWorkflowLabelValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListArchivedWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListArchivedWorkflowLabelValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -964,38 +3018,6 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkflowArchive
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkflowArchive
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflowArchive
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -1018,7 +3040,7 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *GetArchivedWorkflowTemplateStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,15 +3063,15 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetArchivedWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetArchivedWorkflowTemplateStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetArchivedWorkflowTemplateStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1077,8 +3099,79 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -1101,7 +3194,7 @@ func (m *GetArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchivedWorkflowRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,13 +3217,45 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedWorkflowRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedWorkflowRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
@@ -1153,14 +3278,214 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflowArchive
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflowArchive
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1184,7 +3509,7 @@ func (m *DeleteArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ArchivedTemplateStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,63 +3532,366 @@ func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedTemplateStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchivedWorkflowDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedTemplateStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflowArchive
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListArchivedWorkflowLabelKeysRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflowArchive
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			if iNdEx >= l {
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedNodes", wireType)
+			}
+			m.FailedNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanDurationSeconds", wireType)
+			}
+			m.MeanDurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeanDurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50DurationSeconds", wireType)
+			}
+			m.P50DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P50DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95DurationSeconds", wireType)
+			}
+			m.P95DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P95DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDurationSeconds", wireType)
+			}
+			m.MaxDurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.MeanResourcesDuration == nil {
+				m.MeanResourcesDuration = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MeanResourcesDuration[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DurationSeconds = append(m.DurationSeconds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWorkflowArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthWorkflowArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DurationSeconds) == 0 {
+					m.DurationSeconds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DurationSeconds = append(m.DurationSeconds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListArchivedWorkflowLabelKeysRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListArchivedWorkflowLabelKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -1286,7 +3914,7 @@ func (m *ListArchivedWorkflowLabelKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListArchivedWorkflowLabelValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchivedWorkflowTemplateStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,15 +3937,15 @@ func (m *ListArchivedWorkflowLabelValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListArchivedWorkflowLabelValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedWorkflowTemplateStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListArchivedWorkflowLabelValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedWorkflowTemplateStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1344,10 +3972,42 @@ func (m *ListArchivedWorkflowLabelValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
+			m.Runs = append(m.Runs, &ArchivedWorkflowRun{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &ArchivedTemplateStats{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -139,6 +139,36 @@ func (_m *WorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Contex
 	return r0, r1
 }
 
+// ListWorkflowTemplateRevisions provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *workflowtemplate.WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.WorkflowTemplateList
+	if rf, ok := ret.Get(0).(func(context.Context, *workflowtemplate.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) *v1alpha1.WorkflowTemplateList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowTemplateList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflowtemplate.WorkflowTemplateRevisionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListWorkflowTemplates provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowTemplateServiceClient) ListWorkflowTemplates(ctx context.Context, in *workflowtemplate.WorkflowTemplateListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
 	_va := make([]interface{}, len(opts))
@@ -169,6 +199,36 @@ func (_m *WorkflowTemplateServiceClient) ListWorkflowTemplates(ctx context.Conte
 	return r0, r1
 }
 
+// RollbackWorkflowTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, in *workflowtemplate.WorkflowTemplateRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1alpha1.WorkflowTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, *workflowtemplate.WorkflowTemplateRollbackRequest, ...grpc.CallOption) *v1alpha1.WorkflowTemplate); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflowtemplate.WorkflowTemplateRollbackRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateWorkflowTemplate provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowTemplateServiceClient) UpdateWorkflowTemplate(ctx context.Context, in *workflowtemplate.WorkflowTemplateUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
 	_va := make([]interface{}, len(opts))
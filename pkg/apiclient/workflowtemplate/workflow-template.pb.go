@@ -382,12 +382,14 @@ func (m *WorkflowTemplateDeleteResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_WorkflowTemplateDeleteResponse proto.InternalMessageInfo
 
 type WorkflowTemplateLintRequest struct {
-	Namespace            string                     `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Template             *v1alpha1.WorkflowTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
-	CreateOptions        *v1.CreateOptions          `protobuf:"bytes,3,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Namespace     string                     `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Template      *v1alpha1.WorkflowTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	CreateOptions *v1.CreateOptions          `protobuf:"bytes,3,opt,name=createOptions,proto3" json:"createOptions,omitempty"`
+	// strictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced templates
+	StrictTemplateRefArguments bool     `protobuf:"varint,4,opt,name=strictTemplateRefArguments,proto3" json:"strictTemplateRefArguments,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *WorkflowTemplateLintRequest) Reset()         { *m = WorkflowTemplateLintRequest{} }
@@ -444,6 +446,132 @@ func (m *WorkflowTemplateLintRequest) GetCreateOptions() *v1.CreateOptions {
 	return nil
 }
 
+func (m *WorkflowTemplateLintRequest) GetStrictTemplateRefArguments() bool {
+	if m != nil {
+		return m.StrictTemplateRefArguments
+	}
+	return false
+}
+
+type WorkflowTemplateRevisionsRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRevisionsRequest) Reset()         { *m = WorkflowTemplateRevisionsRequest{} }
+func (m *WorkflowTemplateRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRevisionsRequest) ProtoMessage()    {}
+func (*WorkflowTemplateRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{7}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.Merge(m, src)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevisionsRequest proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevisionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRevisionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type WorkflowTemplateRollbackRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The revision to roll back to, which becomes the template's next revision.
+	Revision             int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRollbackRequest) Reset()         { *m = WorkflowTemplateRollbackRequest{} }
+func (m *WorkflowTemplateRollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRollbackRequest) ProtoMessage()    {}
+func (*WorkflowTemplateRollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{8}
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRollbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRollbackRequest.Merge(m, src)
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRollbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRollbackRequest proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRollbackRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRollbackRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRollbackRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*WorkflowTemplateCreateRequest)(nil), "workflowtemplate.WorkflowTemplateCreateRequest")
 	proto.RegisterType((*WorkflowTemplateGetRequest)(nil), "workflowtemplate.WorkflowTemplateGetRequest")
@@ -452,6 +580,8 @@ func init() {
 	proto.RegisterType((*WorkflowTemplateDeleteRequest)(nil), "workflowtemplate.WorkflowTemplateDeleteRequest")
 	proto.RegisterType((*WorkflowTemplateDeleteResponse)(nil), "workflowtemplate.WorkflowTemplateDeleteResponse")
 	proto.RegisterType((*WorkflowTemplateLintRequest)(nil), "workflowtemplate.WorkflowTemplateLintRequest")
+	proto.RegisterType((*WorkflowTemplateRevisionsRequest)(nil), "workflowtemplate.WorkflowTemplateRevisionsRequest")
+	proto.RegisterType((*WorkflowTemplateRollbackRequest)(nil), "workflowtemplate.WorkflowTemplateRollbackRequest")
 }
 
 func init() {
@@ -459,51 +589,58 @@ func init() {
 }
 
 var fileDescriptor_215375a0ab97a62a = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4f, 0x4f, 0x14, 0x3f,
-	0x18, 0x4e, 0x97, 0x5f, 0x7e, 0x91, 0x12, 0x12, 0x53, 0x75, 0xdd, 0x8c, 0xb8, 0x21, 0x73, 0x30,
-	0x04, 0xdc, 0x96, 0x05, 0x25, 0x84, 0x9b, 0x40, 0xc2, 0x05, 0x83, 0x19, 0xfc, 0x13, 0xbc, 0x98,
-	0x32, 0xd4, 0x61, 0xdc, 0xd9, 0x76, 0x9c, 0x96, 0x21, 0xc6, 0x70, 0xf1, 0x60, 0x3c, 0x9a, 0xf8,
-	0x05, 0xfc, 0x00, 0x9e, 0xfc, 0x0e, 0x26, 0x9e, 0x0c, 0xc6, 0x83, 0x57, 0x43, 0xfc, 0x02, 0x7e,
-	0x03, 0x33, 0x9d, 0xff, 0xb3, 0x20, 0x03, 0x71, 0x4f, 0xde, 0x9a, 0x6e, 0xdf, 0xf7, 0x7d, 0x9e,
-	0xa7, 0xcf, 0x3e, 0x53, 0xb8, 0xe0, 0xf7, 0x1c, 0x42, 0x7d, 0xd7, 0xf6, 0x5c, 0xc6, 0x15, 0xd9,
-	0x17, 0x41, 0xef, 0xa9, 0x27, 0xf6, 0x15, 0xeb, 0xfb, 0x1e, 0x55, 0x2c, 0xdb, 0xe8, 0xa4, 0x3b,
-	0xd8, 0x0f, 0x84, 0x12, 0xe8, 0x62, 0xf5, 0xa4, 0x31, 0xe1, 0x08, 0xe1, 0x78, 0x2c, 0x6a, 0x46,
-	0x28, 0xe7, 0x42, 0x51, 0xe5, 0x0a, 0x2e, 0xe3, 0xf3, 0xc6, 0xad, 0xde, 0xa2, 0xc4, 0xae, 0x88,
-	0x7e, 0xed, 0x53, 0x7b, 0xd7, 0xe5, 0x2c, 0x78, 0x41, 0x92, 0xd9, 0x92, 0xf4, 0x99, 0xa2, 0x24,
-	0xec, 0x12, 0x87, 0x71, 0x16, 0x50, 0xc5, 0x76, 0x92, 0xaa, 0xbb, 0x8e, 0xab, 0x76, 0xf7, 0xb6,
-	0xb1, 0x2d, 0xfa, 0x84, 0x06, 0x8e, 0xf0, 0x03, 0xf1, 0x4c, 0x2f, 0x3a, 0xe9, 0x78, 0x99, 0x37,
-	0x49, 0xb7, 0x48, 0xd8, 0xa5, 0x9e, 0xbf, 0x4b, 0x07, 0xda, 0x99, 0x6f, 0x1a, 0xf0, 0xfa, 0xa3,
-	0xe4, 0xd4, 0xfd, 0x04, 0xf7, 0x4a, 0xc0, 0xa8, 0x62, 0x16, 0x7b, 0xbe, 0xc7, 0xa4, 0x42, 0x13,
-	0x70, 0x94, 0xd3, 0x3e, 0x93, 0x3e, 0xb5, 0x59, 0x0b, 0x4c, 0x82, 0xa9, 0x51, 0x2b, 0xdf, 0x40,
-	0x1c, 0x5e, 0x48, 0xe9, 0xb6, 0x1a, 0x93, 0x60, 0x6a, 0x6c, 0xce, 0xc2, 0x39, 0x42, 0x9c, 0x22,
-	0xd4, 0x8b, 0x27, 0x19, 0x42, 0x1c, 0xce, 0x63, 0xbf, 0xe7, 0xe0, 0x08, 0x24, 0x4e, 0x77, 0x71,
-	0x0a, 0x12, 0x57, 0x01, 0x59, 0xd9, 0x0c, 0xb4, 0x05, 0xc7, 0x6d, 0x0d, 0x6f, 0xc3, 0xd7, 0x5a,
-	0xb6, 0x46, 0xf4, 0xd0, 0x79, 0x1c, 0x8b, 0x89, 0x8b, 0x62, 0xe6, 0x23, 0x22, 0x31, 0x71, 0xd8,
-	0xc5, 0x2b, 0xc5, 0x52, 0xab, 0xdc, 0xc9, 0x7c, 0x0f, 0xa0, 0x51, 0x9d, 0xbc, 0xc6, 0x54, 0xaa,
-	0x03, 0x82, 0xff, 0x45, 0xb4, 0x13, 0x09, 0xf4, 0xba, 0xac, 0x4d, 0xa3, 0xaa, 0xcd, 0x3d, 0x08,
-	0x1d, 0xa6, 0xca, 0x40, 0x67, 0xeb, 0x01, 0x5d, 0xcb, 0xea, 0xac, 0x42, 0x0f, 0xf3, 0x2d, 0x80,
-	0xd7, 0xaa, 0x10, 0xd7, 0x5d, 0xa9, 0xea, 0xdd, 0xd5, 0x26, 0x1c, 0xf3, 0x5c, 0x99, 0x01, 0x8a,
-	0xaf, 0xab, 0x5b, 0x0f, 0xd0, 0x7a, 0x5e, 0x68, 0x15, 0xbb, 0x98, 0x9f, 0xc0, 0xa0, 0x81, 0x1e,
-	0xf8, 0x3b, 0x05, 0x03, 0x35, 0x8b, 0xc2, 0x2d, 0x37, 0x5a, 0xa0, 0x96, 0x78, 0x45, 0x63, 0x8d,
-	0x0c, 0xdf, 0x58, 0xe6, 0x87, 0x63, 0x78, 0xac, 0x32, 0x8f, 0xe5, 0x3c, 0xce, 0x6e, 0x80, 0x2d,
-	0x38, 0xbe, 0xa3, 0x5b, 0x9c, 0xcb, 0xac, 0xab, 0xc5, 0x52, 0xab, 0xdc, 0xc9, 0x9c, 0x84, 0xed,
-	0x93, 0xd0, 0x4a, 0x5f, 0x70, 0xc9, 0xcc, 0xd7, 0x8d, 0xe3, 0xbc, 0xc2, 0xd5, 0xbf, 0xf6, 0xbf,
-	0x9e, 0xfb, 0x35, 0x0a, 0xaf, 0x56, 0x27, 0x6f, 0xb2, 0x20, 0x74, 0x6d, 0x86, 0x0e, 0x01, 0x6c,
-	0xc6, 0xc5, 0xd5, 0x13, 0x88, 0xe0, 0x6a, 0x9e, 0xe3, 0x3f, 0x06, 0xa5, 0x31, 0x04, 0x81, 0xcc,
-	0xee, 0xab, 0x6f, 0x3f, 0xdf, 0x35, 0x66, 0xcc, 0x1b, 0xfa, 0x1b, 0x12, 0x76, 0x07, 0x3f, 0x3e,
-	0x92, 0xbc, 0xcc, 0x6e, 0xed, 0x60, 0x09, 0x4c, 0xa3, 0x2f, 0x00, 0x5e, 0x5a, 0x63, 0x6a, 0x80,
-	0xcf, 0xcd, 0xd3, 0xf9, 0xe4, 0x69, 0x37, 0x14, 0x32, 0xb7, 0x35, 0x19, 0x82, 0x3a, 0xf5, 0xc8,
-	0xc4, 0xeb, 0x83, 0x88, 0xd0, 0x95, 0x28, 0x7e, 0xaa, 0xfd, 0x24, 0xea, 0x9c, 0x4e, 0xa9, 0x90,
-	0x8e, 0xc6, 0xc3, 0xbf, 0xcf, 0x29, 0x6a, 0x6f, 0x62, 0xcd, 0x6b, 0x0a, 0xd5, 0xbc, 0x24, 0xf4,
-	0x1d, 0xc0, 0x66, 0x1c, 0x91, 0xe7, 0x31, 0x5d, 0x29, 0x5c, 0x87, 0x72, 0x4f, 0x8b, 0x9a, 0xcf,
-	0x9c, 0x71, 0xb6, 0x7b, 0x8a, 0xbc, 0xf7, 0x11, 0xc0, 0x66, 0x1c, 0x43, 0xe7, 0x61, 0x56, 0x8a,
-	0x5b, 0x63, 0xb6, 0x7e, 0x41, 0x92, 0x78, 0x89, 0xbf, 0xa6, 0xcf, 0xe8, 0xaf, 0xaf, 0x00, 0x5e,
-	0x8e, 0x82, 0x71, 0x00, 0x72, 0x2d, 0x7b, 0xf1, 0xa1, 0xfe, 0x65, 0x16, 0x34, 0xa5, 0x59, 0x73,
-	0xa6, 0x26, 0x25, 0xcf, 0xe5, 0x6a, 0x09, 0x4c, 0x2f, 0x6f, 0x7c, 0x3e, 0x6a, 0x83, 0xc3, 0xa3,
-	0x36, 0xf8, 0x71, 0xd4, 0x06, 0x8f, 0xef, 0xd4, 0x7f, 0x33, 0x9e, 0xf0, 0xe8, 0xdd, 0xfe, 0x5f,
-	0x3f, 0x17, 0xe7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x65, 0x92, 0x47, 0x1d, 0x0b, 0x00,
-	0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xd6, 0xa4, 0xd5, 0x55, 0x3b, 0x55, 0xa5, 0xab, 0xb9, 0x97, 0x10, 0x99, 0x36, 0x44, 0x5e,
+	0xa0, 0xa8, 0x25, 0xe3, 0x26, 0x85, 0x52, 0x8a, 0x04, 0xea, 0x8f, 0xd4, 0x4d, 0x51, 0x91, 0x5b,
+	0x40, 0x65, 0x83, 0xa6, 0xee, 0xd4, 0x35, 0x71, 0x3c, 0xc6, 0x33, 0x4d, 0x85, 0x50, 0x37, 0xac,
+	0x58, 0x22, 0xf1, 0x02, 0x3c, 0x00, 0x2b, 0xde, 0x81, 0x9f, 0x15, 0x2a, 0x62, 0xc1, 0x16, 0x55,
+	0x5d, 0xf2, 0x10, 0xc8, 0x13, 0xdb, 0x71, 0xec, 0xfe, 0x38, 0x11, 0x5e, 0xb1, 0x9b, 0x4c, 0x66,
+	0xce, 0xf9, 0xbe, 0xef, 0x7c, 0x73, 0x8e, 0x0c, 0xe7, 0xdc, 0xa6, 0xa9, 0x11, 0xd7, 0x32, 0x6c,
+	0x8b, 0x3a, 0x42, 0x3b, 0x60, 0x5e, 0x73, 0xd7, 0x66, 0x07, 0x82, 0xb6, 0x5c, 0x9b, 0x08, 0x1a,
+	0x6d, 0xd4, 0xc2, 0x1d, 0xec, 0x7a, 0x4c, 0x30, 0xf4, 0x6f, 0xf2, 0xa4, 0x32, 0x61, 0x32, 0x66,
+	0xda, 0xd4, 0x0f, 0xa6, 0x11, 0xc7, 0x61, 0x82, 0x08, 0x8b, 0x39, 0xbc, 0x73, 0x5e, 0xb9, 0xd1,
+	0x9c, 0xe7, 0xd8, 0x62, 0xfe, 0xbf, 0x2d, 0x62, 0xec, 0x59, 0x0e, 0xf5, 0x5e, 0x68, 0x41, 0x6e,
+	0xae, 0xb5, 0xa8, 0x20, 0x5a, 0xbb, 0xae, 0x99, 0xd4, 0xa1, 0x1e, 0x11, 0x74, 0x27, 0xb8, 0x75,
+	0xdf, 0xb4, 0xc4, 0xde, 0xfe, 0x36, 0x36, 0x58, 0x4b, 0x23, 0x9e, 0xc9, 0x5c, 0x8f, 0x3d, 0x93,
+	0x8b, 0x5a, 0x98, 0x9e, 0x77, 0x83, 0x84, 0x5b, 0x5a, 0xbb, 0x4e, 0x6c, 0x77, 0x8f, 0xa4, 0xc2,
+	0xa9, 0xaf, 0x0b, 0x70, 0xf2, 0x71, 0x70, 0x6a, 0x33, 0xc0, 0xbd, 0xec, 0x51, 0x22, 0xa8, 0x4e,
+	0x9f, 0xef, 0x53, 0x2e, 0xd0, 0x04, 0x1c, 0x75, 0x48, 0x8b, 0x72, 0x97, 0x18, 0xb4, 0x04, 0x2a,
+	0xa0, 0x3a, 0xaa, 0x77, 0x37, 0x90, 0x03, 0x47, 0x42, 0xba, 0xa5, 0x42, 0x05, 0x54, 0xc7, 0x1a,
+	0x3a, 0xee, 0x22, 0xc4, 0x21, 0x42, 0xb9, 0x78, 0x1a, 0x21, 0xc4, 0xed, 0x59, 0xec, 0x36, 0x4d,
+	0xec, 0x83, 0xc4, 0xe1, 0x2e, 0x0e, 0x41, 0xe2, 0x24, 0x20, 0x3d, 0xca, 0x81, 0xb6, 0xe0, 0xb8,
+	0x21, 0xe1, 0xad, 0xbb, 0x52, 0xcb, 0xd2, 0x90, 0x4c, 0x3a, 0x8b, 0x3b, 0x62, 0xe2, 0xb8, 0x98,
+	0xdd, 0x14, 0xbe, 0x98, 0xb8, 0x5d, 0xc7, 0xcb, 0xf1, 0xab, 0x7a, 0x6f, 0x24, 0xf5, 0x1d, 0x80,
+	0x4a, 0x32, 0xf3, 0x2a, 0x15, 0xa1, 0x0e, 0x08, 0x0e, 0xfb, 0xb4, 0x03, 0x09, 0xe4, 0xba, 0x57,
+	0x9b, 0x42, 0x52, 0x9b, 0x07, 0x10, 0x9a, 0x54, 0xf4, 0x02, 0x9d, 0xc9, 0x06, 0x74, 0x35, 0xba,
+	0xa7, 0xc7, 0x62, 0xa8, 0x6f, 0x00, 0xbc, 0x92, 0x84, 0xb8, 0x66, 0x71, 0x91, 0xad, 0x56, 0x1b,
+	0x70, 0xcc, 0xb6, 0x78, 0x04, 0xa8, 0x53, 0xae, 0x7a, 0x36, 0x40, 0x6b, 0xdd, 0x8b, 0x7a, 0x3c,
+	0x8a, 0xfa, 0x11, 0xa4, 0x0d, 0xf4, 0xd0, 0xdd, 0x89, 0x19, 0xa8, 0x18, 0x17, 0x6e, 0xa9, 0x50,
+	0x02, 0x99, 0xc4, 0x8b, 0x1b, 0x6b, 0x28, 0x7f, 0x63, 0xa9, 0xef, 0x4f, 0xe1, 0xb1, 0x42, 0x6d,
+	0x2a, 0xe8, 0xe0, 0x06, 0xd8, 0x82, 0xe3, 0x3b, 0x32, 0xc4, 0x40, 0x66, 0x5d, 0x89, 0x5f, 0xd5,
+	0x7b, 0x23, 0xa9, 0x15, 0x58, 0x3e, 0x0b, 0x2d, 0x77, 0x99, 0xc3, 0xa9, 0xfa, 0xa9, 0x70, 0x9a,
+	0x57, 0x1c, 0xf1, 0xb7, 0xbd, 0x6b, 0x74, 0x17, 0x2a, 0x5c, 0x78, 0x96, 0x21, 0xa2, 0xb4, 0x74,
+	0x77, 0xd1, 0x33, 0xf7, 0x5b, 0xd4, 0x11, 0xbc, 0x34, 0x5c, 0x01, 0xd5, 0x11, 0xfd, 0x9c, 0x13,
+	0xea, 0x26, 0xac, 0xa4, 0x80, 0xd3, 0xb6, 0xc5, 0x65, 0xae, 0x41, 0xbd, 0xa1, 0x32, 0x78, 0x35,
+	0x15, 0x95, 0xd9, 0xf6, 0x36, 0x31, 0x9a, 0x83, 0x1b, 0x4e, 0x81, 0x23, 0x5e, 0x00, 0x4d, 0x0a,
+	0x38, 0xa4, 0x47, 0xbf, 0x1b, 0x9f, 0xc7, 0xe1, 0xe5, 0x64, 0xc6, 0x0d, 0xea, 0xb5, 0x2d, 0x83,
+	0xa2, 0x23, 0x00, 0x8b, 0x1d, 0x0d, 0x93, 0x27, 0x90, 0x86, 0x93, 0x63, 0x0d, 0x9f, 0x3b, 0x2f,
+	0x94, 0x1c, 0x7c, 0xa2, 0xd6, 0x5f, 0x7d, 0x3f, 0x79, 0x5b, 0x98, 0x56, 0xaf, 0xc9, 0x51, 0xda,
+	0xae, 0xa7, 0x67, 0x30, 0xd7, 0x5e, 0x46, 0x32, 0x1c, 0x2e, 0x80, 0x29, 0xf4, 0x15, 0xc0, 0xff,
+	0x56, 0xa9, 0x48, 0xf1, 0xb9, 0x7e, 0x31, 0x9f, 0x6e, 0xd3, 0xcf, 0x85, 0xcc, 0x4d, 0x49, 0x46,
+	0x43, 0xb5, 0x6c, 0x64, 0x3a, 0xeb, 0x43, 0x9f, 0xd0, 0x25, 0xbf, 0x0b, 0x27, 0xe3, 0x71, 0x54,
+	0xbb, 0x98, 0x52, 0x6c, 0x48, 0x28, 0x8f, 0xfe, 0x3c, 0x27, 0x3f, 0xbc, 0x8a, 0x25, 0xaf, 0x2a,
+	0xca, 0x58, 0x24, 0xf4, 0x03, 0xc0, 0x62, 0x67, 0x52, 0x0c, 0x62, 0xba, 0x9e, 0x19, 0x93, 0x4b,
+	0x9d, 0xe6, 0x25, 0x9f, 0x86, 0xd2, 0x5f, 0x9d, 0x7c, 0xef, 0x7d, 0x00, 0xb0, 0xd8, 0xe9, 0xc6,
+	0x83, 0x30, 0xeb, 0x99, 0x3a, 0xca, 0x4c, 0xf6, 0x0b, 0x41, 0xe3, 0x0f, 0xfc, 0x35, 0xd5, 0xa7,
+	0xbf, 0xbe, 0x01, 0xf8, 0xbf, 0x3f, 0x1f, 0x52, 0x90, 0x33, 0xd9, 0xcb, 0xc9, 0xf5, 0xc9, 0xcc,
+	0x49, 0x4a, 0x33, 0xea, 0x74, 0x46, 0x4a, 0xb6, 0xe5, 0x08, 0xbf, 0x10, 0xbf, 0x00, 0x9c, 0x3c,
+	0xed, 0xcd, 0x44, 0xfd, 0x1b, 0x35, 0x2e, 0x26, 0x97, 0x6c, 0xf6, 0xb9, 0x3d, 0xa0, 0x7b, 0x92,
+	0xe5, 0x6d, 0x74, 0xab, 0xaf, 0xc2, 0x69, 0x5e, 0x44, 0xe6, 0x04, 0xc0, 0x52, 0x38, 0x44, 0x52,
+	0x65, 0xac, 0x67, 0x60, 0xda, 0x3b, 0x80, 0x72, 0x29, 0xe5, 0xa2, 0x24, 0x79, 0x47, 0x99, 0xeb,
+	0x93, 0x64, 0x00, 0x6d, 0x01, 0x4c, 0x2d, 0xad, 0x7f, 0x39, 0x2e, 0x83, 0xa3, 0xe3, 0x32, 0xf8,
+	0x79, 0x5c, 0x06, 0x4f, 0x16, 0xb3, 0x7f, 0x10, 0x9d, 0xf1, 0x45, 0xb7, 0xfd, 0x8f, 0xfc, 0x16,
+	0x9a, 0xfd, 0x3d, 0x00, 0x2b, 0x2c, 0xc5, 0x0d, 0xfa, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -524,6 +661,10 @@ type WorkflowTemplateServiceClient interface {
 	UpdateWorkflowTemplate(ctx context.Context, in *WorkflowTemplateUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(ctx context.Context, in *WorkflowTemplateDeleteRequest, opts ...grpc.CallOption) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(ctx context.Context, in *WorkflowTemplateLintRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
+	// ListWorkflowTemplateRevisions lists the revisions of the workflow template, most recent first
+	ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error)
+	// RollbackWorkflowTemplate replaces the spec of the workflow template with the spec of an earlier revision
+	RollbackWorkflowTemplate(ctx context.Context, in *WorkflowTemplateRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
 }
 
 type workflowTemplateServiceClient struct {
@@ -588,6 +729,24 @@ func (c *workflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context
 	return out, nil
 }
 
+func (c *workflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplateList, error) {
+	out := new(v1alpha1.WorkflowTemplateList)
+	err := c.cc.Invoke(ctx, "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, in *WorkflowTemplateRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	out := new(v1alpha1.WorkflowTemplate)
+	err := c.cc.Invoke(ctx, "/workflowtemplate.WorkflowTemplateService/RollbackWorkflowTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowTemplateServiceServer is the server API for WorkflowTemplateService service.
 type WorkflowTemplateServiceServer interface {
 	CreateWorkflowTemplate(context.Context, *WorkflowTemplateCreateRequest) (*v1alpha1.WorkflowTemplate, error)
@@ -596,6 +755,10 @@ type WorkflowTemplateServiceServer interface {
 	UpdateWorkflowTemplate(context.Context, *WorkflowTemplateUpdateRequest) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(context.Context, *WorkflowTemplateDeleteRequest) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(context.Context, *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error)
+	// ListWorkflowTemplateRevisions lists the revisions of the workflow template, most recent first
+	ListWorkflowTemplateRevisions(context.Context, *WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateList, error)
+	// RollbackWorkflowTemplate replaces the spec of the workflow template with the spec of an earlier revision
+	RollbackWorkflowTemplate(context.Context, *WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error)
 }
 
 // UnimplementedWorkflowTemplateServiceServer can be embedded to have forward compatible implementations.
@@ -620,6 +783,12 @@ func (*UnimplementedWorkflowTemplateServiceServer) DeleteWorkflowTemplate(ctx co
 func (*UnimplementedWorkflowTemplateServiceServer) LintWorkflowTemplate(ctx context.Context, req *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflowTemplate not implemented")
 }
+func (*UnimplementedWorkflowTemplateServiceServer) ListWorkflowTemplateRevisions(ctx context.Context, req *WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowTemplateRevisions not implemented")
+}
+func (*UnimplementedWorkflowTemplateServiceServer) RollbackWorkflowTemplate(ctx context.Context, req *WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackWorkflowTemplate not implemented")
+}
 
 func RegisterWorkflowTemplateServiceServer(s *grpc.Server, srv WorkflowTemplateServiceServer) {
 	s.RegisterService(&_WorkflowTemplateService_serviceDesc, srv)
@@ -733,6 +902,42 @@ func _WorkflowTemplateService_LintWorkflowTemplate_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTemplateRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, req.(*WorkflowTemplateRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTemplateService_RollbackWorkflowTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTemplateRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).RollbackWorkflowTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowtemplate.WorkflowTemplateService/RollbackWorkflowTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).RollbackWorkflowTemplate(ctx, req.(*WorkflowTemplateRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowtemplate.WorkflowTemplateService",
 	HandlerType: (*WorkflowTemplateServiceServer)(nil),
@@ -761,6 +966,14 @@ var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LintWorkflowTemplate",
 			Handler:    _WorkflowTemplateService_LintWorkflowTemplate_Handler,
 		},
+		{
+			MethodName: "ListWorkflowTemplateRevisions",
+			Handler:    _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackWorkflowTemplate",
+			Handler:    _WorkflowTemplateService_RollbackWorkflowTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/workflowtemplate/workflow-template.proto",
@@ -1080,6 +1293,16 @@ func (m *WorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StrictTemplateRefArguments {
+		i--
+		if m.StrictTemplateRefArguments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.CreateOptions != nil {
 		{
 			size, err := m.CreateOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1114,6 +1337,93 @@ func (m *WorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowTemplateRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateRollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revision != 0 {
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflowTemplate(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflowTemplate(v)
 	base := offset
@@ -1271,6 +1581,52 @@ func (m *WorkflowTemplateLintRequest) Size() (n int) {
 		l = m.CreateOptions.Size()
 		n += 1 + l + sovWorkflowTemplate(uint64(l))
 	}
+	if m.StrictTemplateRefArguments {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRollbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovWorkflowTemplate(uint64(m.Revision))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2194,6 +2550,275 @@ func (m *WorkflowTemplateLintRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictTemplateRefArguments", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictTemplateRefArguments = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRollbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRollbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
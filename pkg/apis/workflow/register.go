@@ -32,4 +32,9 @@ const (
 	WorkflowTaskSetPlural            string = "workflowtasksets"
 	WorkflowTaskSetShortName         string = "wfts"
 	WorkflowTaskSetFullName          string = WorkflowTaskSetPlural + "." + Group
+	WorkflowTemplateGrantKind        string = "WorkflowTemplateGrant"
+	WorkflowTemplateGrantSingular    string = "workflowtemplategrant"
+	WorkflowTemplateGrantPlural      string = "workflowtemplategrants"
+	WorkflowTemplateGrantShortName   string = "wftmplgrant"
+	WorkflowTemplateGrantFullName    string = WorkflowTemplateGrantPlural + "." + Group
 )
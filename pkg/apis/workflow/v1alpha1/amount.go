@@ -31,3 +31,8 @@ func (a Amount) OpenAPISchemaFormat() string {
 func (a *Amount) Float64() (float64, error) {
 	return strconv.ParseFloat(string(a.Value), 64)
 }
+
+// NewAmount returns the amount of the float
+func NewAmount(f float64) *Amount {
+	return &Amount{Value: json.Number(strconv.FormatFloat(f, 'f', -1, 64))}
+}
@@ -0,0 +1,99 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Approval is a gate on a suspend template: the node only succeeds once enough of its approvers approve it, and fails
+// if any of them rejects it. Who decided, when, and why, is recorded on the node
+type Approval struct {
+	// Approvers are the users who can approve, by email, or by subject when the identity does not have an email
+	Approvers []string `json:"approvers,omitempty" protobuf:"bytes,1,rep,name=approvers"`
+	// ApproverGroups are the groups whose members can approve
+	ApproverGroups []string `json:"approverGroups,omitempty" protobuf:"bytes,2,rep,name=approverGroups"`
+	// RequiredApprovals is the number of distinct approvers who must approve the node. Default 1
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty" protobuf:"varint,3,opt,name=requiredApprovals"`
+}
+
+// GetRequiredApprovals returns the number of distinct approvers who must approve the node
+func (a *Approval) GetRequiredApprovals() int {
+	if a == nil || a.RequiredApprovals < 1 {
+		return 1
+	}
+	return int(a.RequiredApprovals)
+}
+
+// IsApprover returns whether the user, or one of their groups, can approve. When neither approvers, nor approver
+// groups, are listed, anyone who can resume the workflow can approve
+func (a *Approval) IsApprover(name string, groups []string) bool {
+	if a == nil || len(a.Approvers) == 0 && len(a.ApproverGroups) == 0 {
+		return true
+	}
+	for _, approver := range a.Approvers {
+		if approver == name {
+			return true
+		}
+	}
+	for _, approverGroup := range a.ApproverGroups {
+		for _, group := range groups {
+			if approverGroup == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ApprovalStatus is who approved, rejected, or resumed, a suspend node
+type ApprovalStatus struct {
+	// Gate is the approval gate of the node, if any, copied from its suspend template
+	Gate *Approval `json:"gate,omitempty" protobuf:"bytes,1,opt,name=gate"`
+	// Decisions are the approvals, and rejections, of the node, in the order they were made
+	Decisions []ApprovalDecision `json:"decisions,omitempty" protobuf:"bytes,2,rep,name=decisions"`
+}
+
+// ApprovalDecision is an approval, or rejection, of a suspend node
+type ApprovalDecision struct {
+	// Approver is the email, or subject, of the user who decided
+	Approver string `json:"approver" protobuf:"bytes,1,opt,name=approver"`
+	// Approved is whether the node was approved, rather than rejected
+	Approved bool `json:"approved,omitempty" protobuf:"varint,2,opt,name=approved"`
+	// Comment is the reason for the decision
+	Comment string `json:"comment,omitempty" protobuf:"bytes,3,opt,name=comment"`
+	// Time is when the decision was made
+	Time metav1.Time `json:"time,omitempty" protobuf:"bytes,4,opt,name=time"`
+}
+
+// IsGated returns whether the node can only be approved, or rejected, rather than resumed
+func (s *ApprovalStatus) IsGated() bool {
+	return s != nil && s.Gate != nil
+}
+
+// Approvers returns the distinct users who approved the node
+func (s *ApprovalStatus) Approvers() []string {
+	var approvers []string
+	if s == nil {
+		return approvers
+	}
+	seen := make(map[string]bool)
+	for _, d := range s.Decisions {
+		if d.Approved && !seen[d.Approver] {
+			seen[d.Approver] = true
+			approvers = append(approvers, d.Approver)
+		}
+	}
+	return approvers
+}
+
+// IsApproved returns whether enough distinct users approved the node
+func (s *ApprovalStatus) IsApproved() bool {
+	return len(s.Approvers()) >= s.GetGate().GetRequiredApprovals()
+}
+
+// GetGate returns the approval gate of the node, if any
+func (s *ApprovalStatus) GetGate() *Approval {
+	if s == nil {
+		return nil
+	}
+	return s.Gate
+}
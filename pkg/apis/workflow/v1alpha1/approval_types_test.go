@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproval_IsApprover(t *testing.T) {
+	assert.True(t, (&Approval{}).IsApprover("alice", nil))
+	a := &Approval{Approvers: []string{"alice"}, ApproverGroups: []string{"cab"}}
+	assert.True(t, a.IsApprover("alice", nil))
+	assert.True(t, a.IsApprover("bob", []string{"devs", "cab"}))
+	assert.False(t, a.IsApprover("bob", []string{"devs"}))
+}
+
+func TestApprovalStatus_IsApproved(t *testing.T) {
+	var s *ApprovalStatus
+	assert.False(t, s.IsApproved())
+	s = &ApprovalStatus{Gate: &Approval{RequiredApprovals: 2}}
+	s.Decisions = append(s.Decisions, ApprovalDecision{Approver: "alice", Approved: true})
+	assert.False(t, s.IsApproved())
+	s.Decisions = append(s.Decisions, ApprovalDecision{Approver: "alice", Approved: true}, ApprovalDecision{Approver: "bob"})
+	assert.False(t, s.IsApproved())
+	s.Decisions = append(s.Decisions, ApprovalDecision{Approver: "bob", Approved: true})
+	assert.True(t, s.IsApproved())
+	assert.Equal(t, []string{"alice", "bob"}, s.Approvers())
+}
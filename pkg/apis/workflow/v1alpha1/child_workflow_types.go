@@ -0,0 +1,31 @@
+package v1alpha1
+
+// ChildWorkflow is a workflow, created from a workflow template, that runs as a child of the workflow that creates it.
+// Unlike a steps, or DAG, template, the child workflow has its own lifecycle: it can be viewed, and retried, on its own
+type ChildWorkflow struct {
+	// WorkflowTemplateRef is the workflow template the child workflow is created from
+	WorkflowTemplateRef WorkflowTemplateRef `json:"workflowTemplateRef" protobuf:"bytes,1,opt,name=workflowTemplateRef"`
+	// Arguments are the arguments of the child workflow
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+	// Propagate is which changes to this workflow's lifecycle are propagated to the child workflow
+	Propagate *ChildWorkflowPropagation `json:"propagate,omitempty" protobuf:"bytes,3,opt,name=propagate"`
+}
+
+// ChildWorkflowPropagation is which changes to a workflow's lifecycle are propagated to its child workflows
+type ChildWorkflowPropagation struct {
+	// Shutdown is whether stopping, or terminating, this workflow stops, or terminates, its child workflows. Default true
+	Shutdown *bool `json:"shutdown,omitempty" protobuf:"varint,1,opt,name=shutdown"`
+	// Retry is whether retrying this workflow retries the failed nodes of its child workflows, rather than deleting
+	// them, and creating them again. Default true
+	Retry *bool `json:"retry,omitempty" protobuf:"varint,2,opt,name=retry"`
+}
+
+// ShouldPropagateShutdown returns whether stopping, or terminating, the workflow stops, or terminates, the child
+func (c *ChildWorkflow) ShouldPropagateShutdown() bool {
+	return c.Propagate == nil || c.Propagate.Shutdown == nil || *c.Propagate.Shutdown
+}
+
+// ShouldPropagateRetry returns whether retrying the workflow retries the child, rather than creating it again
+func (c *ChildWorkflow) ShouldPropagateRetry() bool {
+	return c.Propagate == nil || c.Propagate.Retry == nil || *c.Propagate.Retry
+}
@@ -68,4 +68,7 @@ type SubmitOpts struct {
 	// Priority is used if controller is configured to process limited number of workflows in parallel, higher priority workflows
 	// are processed first.
 	Priority *int32 `json:"priority,omitempty" protobuf:"bytes,14,opt,name=priority"`
+	// Preset is the name of a config map, in the workflow's namespace, with parameters and labels to submit the workflow
+	// with. Parameters and labels that are passed take precedence over those of the preset.
+	Preset string `json:"preset,omitempty" protobuf:"bytes,15,opt,name=preset"`
 }
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// ContainerPipe is a named pipe, provisioned by the executor, that streams the stdout of one container of a pod to
+// the stdin of another, like a unix pipeline, without writing the data to disk. Both containers run at the same
+// time, so neither may depend on the other.
+type ContainerPipe struct {
+	// Name of the pipe
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// From is the name of the container whose stdout is written to the pipe. Its stdout is not logged
+	From string `json:"from" protobuf:"bytes,2,opt,name=from"`
+	// To is the name of the container whose stdin is read from the pipe
+	To string `json:"to" protobuf:"bytes,3,opt,name=to"`
+}
+
+// GetPath returns the path of the pipe, in the directory shared by the executor and the containers
+func (p ContainerPipe) GetPath(varRunArgo string) string {
+	return filepath.Join(varRunArgo, "pipes", p.Name)
+}
+
+// GetStdoutPipe returns the pipe the container's stdout is written to, if there is one
+func (tmpl *Template) GetStdoutPipe(containerName string) *ContainerPipe {
+	for _, p := range tmpl.GetPipes() {
+		if p.From == containerName {
+			return &p
+		}
+	}
+	return nil
+}
+
+// GetStdinPipe returns the pipe the container's stdin is read from, if there is one
+func (tmpl *Template) GetStdinPipe(containerName string) *ContainerPipe {
+	for _, p := range tmpl.GetPipes() {
+		if p.To == containerName {
+			return &p
+		}
+	}
+	return nil
+}
+
+func (tmpl *Template) GetPipes() []ContainerPipe {
+	if tmpl == nil {
+		return nil
+	}
+	return tmpl.Pipes
+}
+
+// ValidatePipes checks that each pipe connects two different containers of the template, neither of which depends on
+// the other, and that a container's stdout, or stdin, is connected to at most one pipe
+func (tmpl *Template) ValidatePipes() error {
+	var names []string
+	for _, p := range tmpl.Pipes {
+		names = append(names, p.Name)
+	}
+	if err := validateWorkflowFieldNames(names, false); err != nil {
+		return err
+	}
+	containers := make(map[string]bool)
+	for _, name := range append(tmpl.GetMainContainerNames(), tmpl.GetSidecarNames()...) {
+		containers[name] = true
+	}
+	from, to := make(map[string]string), make(map[string]string)
+	for _, p := range tmpl.Pipes {
+		switch {
+		case !containers[p.From]:
+			return fmt.Errorf(".%s.from '%s' is not a container of the template", p.Name, p.From)
+		case !containers[p.To]:
+			return fmt.Errorf(".%s.to '%s' is not a container of the template", p.Name, p.To)
+		case p.From == p.To:
+			return fmt.Errorf(".%s cannot connect container '%s' to itself", p.Name, p.From)
+		case from[p.From] != "":
+			return fmt.Errorf(".%s: the stdout of container '%s' is already written to pipe '%s'", p.Name, p.From, from[p.From])
+		case to[p.To] != "":
+			return fmt.Errorf(".%s: the stdin of container '%s' is already read from pipe '%s'", p.Name, p.To, to[p.To])
+		case tmpl.ContainerSet.dependsOn(p.From, p.To) || tmpl.ContainerSet.dependsOn(p.To, p.From):
+			return fmt.Errorf(".%s: containers '%s' and '%s' must run at the same time, so neither may depend on the other", p.Name, p.From, p.To)
+		}
+		from[p.From], to[p.To] = p.Name, p.Name
+	}
+	return nil
+}
+
+// dependsOn returns whether the container depends on the other, directly or through its dependencies
+func (in *ContainerSetTemplate) dependsOn(name, other string) bool {
+	dependencies := make(map[string][]string)
+	for _, c := range in.GetGraph() {
+		dependencies[c.Name] = c.Dependencies
+	}
+	visited := make(map[string]bool)
+	var visit func(string) bool
+	visit = func(n string) bool {
+		if visited[n] {
+			return false
+		}
+		visited[n] = true
+		for _, d := range dependencies[n] {
+			if d == other || visit(d) {
+				return true
+			}
+		}
+		return false
+	}
+	return visit(name)
+}
@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func validatePipes(yamlStr string) error {
+	var tmpl Template
+	if err := yaml.Unmarshal([]byte(yamlStr), &tmpl); err != nil {
+		panic(err)
+	}
+	return tmpl.ValidatePipes()
+}
+
+func TestValidatePipes(t *testing.T) {
+	containerSet := `
+containerSet:
+  containers:
+    - name: producer
+    - name: filter
+    - name: consumer
+      dependencies: [filter]
+sidecars:
+  - name: sidecar
+pipes:
+`
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, validatePipes(containerSet+`
+  - {name: a, from: producer, to: filter}
+  - {name: b, from: filter, to: sidecar}
+`))
+	})
+	t.Run("Name", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: producer, to: filter}
+  - {name: a, from: filter, to: sidecar}
+`), "[1].name 'a' is not unique")
+	})
+	t.Run("NotAContainer", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: main, to: filter}
+`), ".a.from 'main' is not a container of the template")
+	})
+	t.Run("Itself", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: filter, to: filter}
+`), ".a cannot connect container 'filter' to itself")
+	})
+	t.Run("Stdout", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: producer, to: filter}
+  - {name: b, from: producer, to: sidecar}
+`), ".b: the stdout of container 'producer' is already written to pipe 'a'")
+	})
+	t.Run("Stdin", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: producer, to: filter}
+  - {name: b, from: sidecar, to: filter}
+`), ".b: the stdin of container 'filter' is already read from pipe 'a'")
+	})
+	t.Run("Dependency", func(t *testing.T) {
+		assert.EqualError(t, validatePipes(containerSet+`
+  - {name: a, from: filter, to: consumer}
+`), ".a: containers 'filter' and 'consumer' must run at the same time, so neither may depend on the other")
+	})
+	t.Run("Sidecar", func(t *testing.T) {
+		assert.NoError(t, validatePipes(`
+container: {}
+sidecars:
+  - name: sidecar
+pipes:
+  - {name: a, from: main, to: sidecar}
+`))
+	})
+}
@@ -2,9 +2,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -15,6 +17,38 @@ type ContainerSetTemplate struct {
 	// RetryStrategy describes how to retry a container nodes in the container set if it fails.
 	// Nbr of retries(default 0) and sleep duration between retries(default 0s, instant retry) can be set.
 	RetryStrategy *ContainerSetRetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,5,opt,name=retryStrategy"`
+	// Workspace is an empty-dir volume shared by all the containers, e.g. to pass files from one to the next.
+	// Containers that do not specify a workingDir use the workspace as their working directory.
+	Workspace *ContainerSetWorkspace `json:"workspace,omitempty" protobuf:"bytes,6,opt,name=workspace"`
+}
+
+// ContainerSetWorkspaceVolumeName is the name of the volume used for the container set workspace
+const ContainerSetWorkspaceVolumeName = "container-set-workspace"
+
+// ContainerSetWorkspace is an empty-dir volume that is mounted in all the containers of a container set
+type ContainerSetWorkspace struct {
+	// MountPath is the path the workspace is mounted at in every container. Defaults to "/workspace".
+	MountPath string `json:"mountPath,omitempty" protobuf:"bytes,1,opt,name=mountPath"`
+	// SizeLimit is the maximum size of the workspace.
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty" protobuf:"bytes,2,opt,name=sizeLimit"`
+}
+
+func (w *ContainerSetWorkspace) GetMountPath() string {
+	if w == nil || w.MountPath == "" {
+		return "/workspace"
+	}
+	return w.MountPath
+}
+
+// GetVolume returns the empty-dir volume for the workspace, or nil if there is no workspace
+func (w *ContainerSetWorkspace) GetVolume() *corev1.Volume {
+	if w == nil {
+		return nil
+	}
+	return &corev1.Volume{
+		Name:         ContainerSetWorkspaceVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: w.SizeLimit}},
+	}
 }
 
 type ContainerSetRetryStrategy struct {
@@ -53,12 +87,36 @@ func (in *ContainerSetTemplate) GetContainers() []corev1.Container {
 	var ctrs []corev1.Container
 	for _, t := range in.GetGraph() {
 		c := t.Container
-		c.VolumeMounts = append(c.VolumeMounts, in.VolumeMounts...)
+		c.VolumeMounts = append(c.VolumeMounts, in.GetVolumeMounts()...)
+		if in.Workspace != nil && c.WorkingDir == "" {
+			c.WorkingDir = in.Workspace.GetMountPath()
+		}
 		ctrs = append(ctrs, c)
 	}
 	return ctrs
 }
 
+func (in *ContainerSetTemplate) GetWorkspace() *ContainerSetWorkspace {
+	if in == nil {
+		return nil
+	}
+	return in.Workspace
+}
+
+// GetVolumeMounts returns the volume mounts shared by all containers, including the workspace
+func (in *ContainerSetTemplate) GetVolumeMounts() []corev1.VolumeMount {
+	if in == nil {
+		return nil
+	}
+	if in.Workspace == nil {
+		return in.VolumeMounts
+	}
+	return append(append([]corev1.VolumeMount{}, in.VolumeMounts...), corev1.VolumeMount{
+		Name:      ContainerSetWorkspaceVolumeName,
+		MountPath: in.Workspace.GetMountPath(),
+	})
+}
+
 func (in *ContainerSetTemplate) HasContainerNamed(n string) bool {
 	for _, c := range in.GetContainers() {
 		if n == c.Name {
@@ -101,12 +159,15 @@ func (in *ContainerSetTemplate) Validate() error {
 
 	// Ensure there are no collisions with volume mountPaths and artifact load paths
 	mountPaths := make(map[string]string)
-	for i, volMount := range in.VolumeMounts {
+	for i, volMount := range in.GetVolumeMounts() {
 		if prev, ok := mountPaths[volMount.MountPath]; ok {
 			return fmt.Errorf("volumeMounts[%d].mountPath '%s' already mounted in %s", i, volMount.MountPath, prev)
 		}
 		mountPaths[volMount.MountPath] = fmt.Sprintf("volumeMounts.%s", volMount.Name)
 	}
+	if in.Workspace != nil && !filepath.IsAbs(in.Workspace.GetMountPath()) {
+		return fmt.Errorf("workspace.mountPath '%s' must be an absolute path", in.Workspace.MountPath)
+	}
 
 	// Ensure the dependencies are defined
 	nameToContainer := make(map[string]ContainerNode)
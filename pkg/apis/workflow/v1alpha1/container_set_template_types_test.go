@@ -77,6 +77,18 @@ func TestContainerSetTemplate(t *testing.T) {
 		assert.True(t, x.HasSequencedContainers())
 		assert.True(t, x.HasContainerNamed("a"))
 	})
+	t.Run("Workspace", func(t *testing.T) {
+		x := &ContainerSetTemplate{
+			Workspace:  &ContainerSetWorkspace{},
+			Containers: []ContainerNode{{}, {Container: corev1.Container{WorkingDir: "/tmp"}}},
+		}
+		ctrs := x.GetContainers()
+		if assert.Len(t, ctrs, 2) {
+			assert.Equal(t, "/workspace", ctrs[0].WorkingDir)
+			assert.Equal(t, "/tmp", ctrs[1].WorkingDir)
+			assert.Equal(t, []corev1.VolumeMount{{Name: ContainerSetWorkspaceVolumeName, MountPath: "/workspace"}}, ctrs[1].VolumeMounts)
+		}
+	})
 }
 
 func TestInvalidContainerSetEmpty(t *testing.T) {
@@ -108,6 +120,36 @@ containers:
 	}
 }
 
+func TestInvalidContainerSetWorkspaceVolumeMounting(t *testing.T) {
+	invalidContainerSetWorkspaceVolumeMounting := `
+volumeMounts:
+  - name: workspace
+    mountPath: /workspace
+workspace: {}
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+`
+	err := validateContainerSetTemplate(invalidContainerSetWorkspaceVolumeMounting)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "volumeMounts[1].mountPath '/workspace' already mounted in volumeMounts.workspace")
+	}
+}
+
+func TestInvalidContainerSetWorkspaceRelativePath(t *testing.T) {
+	invalidContainerSetWorkspaceRelativePath := `
+workspace:
+  mountPath: workspace
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+`
+	err := validateContainerSetTemplate(invalidContainerSetWorkspaceRelativePath)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "workspace.mountPath 'workspace' must be an absolute path")
+	}
+}
+
 func TestInvalidContainerSetDuplicateNames(t *testing.T) {
 	invalidContainerSetDuplicateNames := `
 volumeMounts:
@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -36,14 +38,23 @@ const (
 	ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
+type PreviousRunPolicy string
+
+const (
+	PreviousRunSkip  PreviousRunPolicy = "Skip"
+	PreviousRunDelay PreviousRunPolicy = "Delay"
+)
+
 const annotationKeyLatestSchedule = workflow.CronWorkflowFullName + "/last-used-schedule"
 
 // CronWorkflowSpec is the specification of a CronWorkflow
 type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
-	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Schedule is a schedule to run the Workflow in Cron format, or an interval, either "@every <duration>", e.g.
+	// "@every 37m", or an ISO 8601 repeating interval, e.g. "R/PT6H", which starts when the CronWorkflow was created,
+	// unless it has a start time, e.g. "R/2021-10-01T00:00:00Z/PT6H"
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -59,6 +70,96 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules is a list of schedules to run the Workflow in Cron format, or intervals, instead of Schedule. The Workflow is run once
+	// at a time that more than one of them match.
+	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// Catchup is what to do about the times the Workflow was scheduled for, but not run, e.g. when the workflow-controller
+	// was down or the CronWorkflow was suspended. If not set, they are only run within StartingDeadlineSeconds.
+	Catchup *Catchup `json:"catchup,omitempty" protobuf:"bytes,11,opt,name=catchup"`
+	// DaylightSavingPolicy is what to do at the times skipped and repeated when the clock of the timezone moves forward
+	// and back: "Skip" does not run the Workflow at a time that is skipped, and runs it once at a time that is repeated,
+	// while "Fire" runs it when the clock moves forward, instead of at the times that are skipped, and twice at a time
+	// that is repeated. If not set, the Workflow is not run at a time that is skipped, and twice at a time that is
+	// repeated.
+	DaylightSavingPolicy DaylightSavingPolicy `json:"daylightSavingPolicy,omitempty" protobuf:"bytes,12,opt,name=daylightSavingPolicy,casttype=DaylightSavingPolicy"`
+	// Splay is the most time after the times it is scheduled for that the Workflow is run, e.g. "5m", so CronWorkflows
+	// scheduled for the same time are not all run at once. Each CronWorkflow is run the same offset within the splay,
+	// based on its namespace and name, after each of its scheduled times.
+	Splay string `json:"splay,omitempty" protobuf:"bytes,13,opt,name=splay"`
+	// StopStrategy is when to stop running the Workflow, by suspending the CronWorkflow, e.g. after it has failed too
+	// many times in a row
+	StopStrategy *StopStrategy `json:"stopStrategy,omitempty" protobuf:"bytes,14,opt,name=stopStrategy"`
+	// PreviousRunPolicy is what to do at a scheduled time unless the previous Workflow succeeded, e.g. because it is
+	// still running, or it failed: "Skip" does not run the Workflow, while "Delay" runs it when the previous Workflow
+	// succeeds, e.g. after it is retried, for the latest of the times it was delayed. If not set, the Workflow is run
+	// whatever the outcome of the previous one.
+	PreviousRunPolicy PreviousRunPolicy `json:"previousRunPolicy,omitempty" protobuf:"bytes,15,opt,name=previousRunPolicy,casttype=PreviousRunPolicy"`
+	// When is an expression, evaluated at each scheduled time, that runs the Workflow only if it is true, e.g.
+	// "weekday != 'Saturday' && !(date in ['2021-12-25', '2022-01-01'])". Its variables are "scheduledTime", "date" as
+	// "2006-01-02", and "weekday", in the timezone, whether the "previousRunSucceeded", the number of Workflows that
+	// "succeeded", "failed", and that have failed in a row, "consecutiveFailures", and the data of the WhenConfigMaps,
+	// e.g. "configMaps['my-flags'].enabled == 'true'".
+	When string `json:"when,omitempty" protobuf:"bytes,16,opt,name=when"`
+	// WhenConfigMaps are the names of ConfigMaps, in the namespace of the CronWorkflow, whose data is available to When
+	WhenConfigMaps []string `json:"whenConfigMaps,omitempty" protobuf:"bytes,17,rep,name=whenConfigMaps"`
+}
+
+// StopStrategy is when a CronWorkflow is suspended, based on the Workflows that have completed since it was created
+type StopStrategy struct {
+	// SuspendAfterFailures is the number of Workflows that fail in a row after which the CronWorkflow is suspended
+	SuspendAfterFailures *int32 `json:"suspendAfterFailures,omitempty" protobuf:"varint,1,opt,name=suspendAfterFailures"`
+	// Expression is an expression that suspends the CronWorkflow when it is true, e.g. "failed > 10 && succeeded == 0",
+	// of the number of Workflows that "succeeded", "failed", and that have failed in a row, "consecutiveFailures"
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+}
+
+type DaylightSavingPolicy string
+
+const (
+	DaylightSavingSkip DaylightSavingPolicy = "Skip"
+	DaylightSavingFire DaylightSavingPolicy = "Fire"
+)
+
+type CatchupPolicy string
+
+const (
+	CatchupAll    CatchupPolicy = "All"
+	CatchupLatest CatchupPolicy = "Latest"
+	CatchupNone   CatchupPolicy = "None"
+)
+
+// Catchup is how a CronWorkflow catches up on the times the Workflow was scheduled for, but not run
+type Catchup struct {
+	// Policy is which of the missed times to run the Workflow for: "All" of them, oldest first, only the "Latest" one, or
+	// "None" of them. Default "Latest".
+	Policy CatchupPolicy `json:"policy,omitempty" protobuf:"bytes,1,opt,name=policy,casttype=CatchupPolicy"`
+	// Limit is the most missed times to run the Workflow for, which are the latest ones. Default 10.
+	Limit *int32 `json:"limit,omitempty" protobuf:"varint,2,opt,name=limit"`
+	// Parameter is the name of a parameter of the Workflow, which is set to the time the Workflow was scheduled for, in
+	// RFC 3339 format, whether or not it was missed
+	Parameter string `json:"parameter,omitempty" protobuf:"bytes,3,opt,name=parameter"`
+}
+
+// GetPolicy returns the policy, which defaults to "Latest"
+func (c *Catchup) GetPolicy() CatchupPolicy {
+	if c == nil || c.Policy == "" {
+		return CatchupLatest
+	}
+	return c.Policy
+}
+
+// GetLimit returns the most missed times to run the Workflow for, which is 1 for "Latest", and 0 for "None"
+func (c *Catchup) GetLimit() int {
+	switch c.GetPolicy() {
+	case CatchupNone:
+		return 0
+	case CatchupLatest:
+		return 1
+	}
+	if c.Limit == nil {
+		return 10
+	}
+	return int(*c.Limit)
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
@@ -69,6 +170,17 @@ type CronWorkflowStatus struct {
 	LastScheduledTime *metav1.Time `json:"lastScheduledTime" protobuf:"bytes,2,opt,name=lastScheduledTime"`
 	// Conditions is a list of conditions the CronWorkflow may have
 	Conditions Conditions `json:"conditions" protobuf:"bytes,3,rep,name=conditions"`
+	// NextScheduledTimes are the next times the Workflow will be run at
+	NextScheduledTimes []metav1.Time `json:"nextScheduledTimes,omitempty" protobuf:"bytes,4,rep,name=nextScheduledTimes"`
+	// Succeeded is the number of Workflows that have succeeded
+	Succeeded int64 `json:"succeeded,omitempty" protobuf:"varint,5,opt,name=succeeded"`
+	// Failed is the number of Workflows that have failed, or errored
+	Failed int64 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+	// ConsecutiveFailures is the number of Workflows that have failed, or errored, since the last one that succeeded
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,7,opt,name=consecutiveFailures"`
+	// DelayedScheduledTime is the latest time the Workflow was scheduled for, but delayed by the PreviousRunPolicy, until
+	// the previous one succeeds
+	DelayedScheduledTime *metav1.Time `json:"delayedScheduledTime,omitempty" protobuf:"bytes,8,opt,name=delayedScheduledTime"`
 }
 
 func (c *CronWorkflow) IsUsingNewSchedule() bool {
@@ -89,12 +201,29 @@ func (c *CronWorkflow) GetLatestSchedule() string {
 	return c.Annotations[annotationKeyLatestSchedule]
 }
 
-func (c *CronWorkflowSpec) GetScheduleString() string {
-	scheduleString := c.Schedule
-	if c.Timezone != "" {
-		scheduleString = "CRON_TZ=" + c.Timezone + " " + scheduleString
+// GetSchedules returns the schedules the Workflow is run on, which is either Schedule or Schedules
+func (c *CronWorkflowSpec) GetSchedules() []string {
+	if c.Schedule != "" {
+		return []string{c.Schedule}
 	}
-	return scheduleString
+	return c.Schedules
+}
+
+// GetSchedulesWithTimezone returns the schedules, prefixed with the timezone, if there is one
+func (c *CronWorkflowSpec) GetSchedulesWithTimezone() []string {
+	var schedules []string
+	for _, schedule := range c.GetSchedules() {
+		if c.Timezone != "" {
+			schedule = "CRON_TZ=" + c.Timezone + " " + schedule
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// GetScheduleString returns the schedules, with the timezone, separated by semicolons
+func (c *CronWorkflowSpec) GetScheduleString() string {
+	return strings.Join(c.GetSchedulesWithTimezone(), ";")
 }
 
 func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
@@ -106,7 +235,18 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 	return false
 }
 
+// GetStopStrategyEnv returns the variables of a stop strategy expression
+func (c *CronWorkflowStatus) GetStopStrategyEnv() map[string]interface{} {
+	return map[string]interface{}{
+		"succeeded":           c.Succeeded,
+		"failed":              c.Failed,
+		"consecutiveFailures": c.ConsecutiveFailures,
+	}
+}
+
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeStopped signifies that the CronWorkflow was suspended by its stop strategy
+	ConditionTypeStopped ConditionType = "Stopped"
 )
@@ -27,3 +27,34 @@ func TestCronWorkflowSpec_GetScheduleString(t *testing.T) {
 	cwfSpec.Timezone = "America/Los_Angeles"
 	assert.Equal(t, "CRON_TZ=America/Los_Angeles * * * * *", cwfSpec.GetScheduleString())
 }
+
+func TestCronWorkflowSpec_GetSchedules(t *testing.T) {
+	cwfSpec := CronWorkflowSpec{
+		Schedules: []string{"0 * * * 1-5", "0 6 * * 6"},
+	}
+
+	assert.Equal(t, []string{"0 * * * 1-5", "0 6 * * 6"}, cwfSpec.GetSchedules())
+	assert.Equal(t, "0 * * * 1-5;0 6 * * 6", cwfSpec.GetScheduleString())
+
+	cwfSpec.Timezone = "America/Los_Angeles"
+	assert.Equal(t, []string{"CRON_TZ=America/Los_Angeles 0 * * * 1-5", "CRON_TZ=America/Los_Angeles 0 6 * * 6"}, cwfSpec.GetSchedulesWithTimezone())
+
+	cwfSpec.Schedule = "* * * * *"
+	assert.Equal(t, []string{"* * * * *"}, cwfSpec.GetSchedules())
+}
+
+func TestCatchup(t *testing.T) {
+	var catchup *Catchup
+	assert.Equal(t, CatchupLatest, catchup.GetPolicy())
+	assert.Equal(t, 1, catchup.GetLimit())
+
+	catchup = &Catchup{Policy: CatchupAll}
+	assert.Equal(t, 10, catchup.GetLimit())
+
+	limit := int32(3)
+	catchup.Limit = &limit
+	assert.Equal(t, 3, catchup.GetLimit())
+
+	catchup.Policy = CatchupNone
+	assert.Equal(t, 0, catchup.GetLimit())
+}
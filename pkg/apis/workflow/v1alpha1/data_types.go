@@ -1,5 +1,7 @@
 package v1alpha1
 
+import "fmt"
+
 // Data is a data template
 type Data struct {
 	// Source sources external data into a data template
@@ -20,7 +22,35 @@ type Transformation []TransformationStep
 
 type TransformationStep struct {
 	// Expression defines an expr expression to apply
-	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
+
+	// Filter defines an expr expression evaluated for each item of the data, which is available as `item`.
+	// Only the items for which the expression is true are kept
+	Filter string `json:"filter,omitempty" protobuf:"bytes,2,opt,name=filter"`
+
+	// Map defines an expr expression evaluated for each item of the data, which is available as `item`.
+	// Each item is replaced with the result of the expression
+	Map string `json:"map,omitempty" protobuf:"bytes,3,opt,name=map"`
+
+	// Chunk groups the items of the data into batches of this size. The last batch may be smaller
+	Chunk int32 `json:"chunk,omitempty" protobuf:"varint,4,opt,name=chunk"`
+}
+
+// Validate checks that exactly one transformation is defined by the step
+func (s TransformationStep) Validate() error {
+	n := 0
+	for _, defined := range []bool{s.Expression != "", s.Filter != "", s.Map != "", s.Chunk != 0} {
+		if defined {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("exactly one of expression, filter, map or chunk must be specified")
+	}
+	if s.Chunk < 0 {
+		return fmt.Errorf("chunk must be a positive integer")
+	}
+	return nil
 }
 
 // DataSource sources external data into a data template
@@ -14,6 +14,8 @@ import (
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/policy/v1beta1"
+	k8s_io_apimachinery_pkg_api_resource "k8s.io/apimachinery/pkg/api/resource"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -36,10 +38,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AWSSecretsManagerSecretProvider) Reset()      { *m = AWSSecretsManagerSecretProvider{} }
+func (*AWSSecretsManagerSecretProvider) ProtoMessage() {}
+func (*AWSSecretsManagerSecretProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{0}
+}
+func (m *AWSSecretsManagerSecretProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSSecretsManagerSecretProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSSecretsManagerSecretProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSSecretsManagerSecretProvider.Merge(m, src)
+}
+func (m *AWSSecretsManagerSecretProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSSecretsManagerSecretProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSSecretsManagerSecretProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSSecretsManagerSecretProvider proto.InternalMessageInfo
+
 func (m *Amount) Reset()      { *m = Amount{} }
 func (*Amount) ProtoMessage() {}
 func (*Amount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{0}
+	return fileDescriptor_724696e352c3df5f, []int{1}
 }
 func (m *Amount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -64,10 +94,94 @@ func (m *Amount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Amount proto.InternalMessageInfo
 
+func (m *Approval) Reset()      { *m = Approval{} }
+func (*Approval) ProtoMessage() {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{2}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func (m *ApprovalDecision) Reset()      { *m = ApprovalDecision{} }
+func (*ApprovalDecision) ProtoMessage() {}
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{3}
+}
+func (m *ApprovalDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApprovalDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalDecision.Merge(m, src)
+}
+func (m *ApprovalDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalDecision proto.InternalMessageInfo
+
+func (m *ApprovalStatus) Reset()      { *m = ApprovalStatus{} }
+func (*ApprovalStatus) ProtoMessage() {}
+func (*ApprovalStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{4}
+}
+func (m *ApprovalStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApprovalStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalStatus.Merge(m, src)
+}
+func (m *ApprovalStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalStatus proto.InternalMessageInfo
+
 func (m *ArchiveStrategy) Reset()      { *m = ArchiveStrategy{} }
 func (*ArchiveStrategy) ProtoMessage() {}
 func (*ArchiveStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{1}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *ArchiveStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +209,7 @@ var xxx_messageInfo_ArchiveStrategy proto.InternalMessageInfo
 func (m *Arguments) Reset()      { *m = Arguments{} }
 func (*Arguments) ProtoMessage() {}
 func (*Arguments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{2}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *Arguments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +237,7 @@ var xxx_messageInfo_Arguments proto.InternalMessageInfo
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{3}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +265,7 @@ var xxx_messageInfo_Artifact proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +293,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +321,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +349,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +377,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +405,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +433,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +461,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +489,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +517,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,10 +542,122 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *Catchup) Reset()      { *m = Catchup{} }
+func (*Catchup) ProtoMessage() {}
+func (*Catchup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{18}
+}
+func (m *Catchup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Catchup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Catchup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Catchup.Merge(m, src)
+}
+func (m *Catchup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Catchup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Catchup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Catchup proto.InternalMessageInfo
+
+func (m *Checkpoint) Reset()      { *m = Checkpoint{} }
+func (*Checkpoint) ProtoMessage() {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{19}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *ChildWorkflow) Reset()      { *m = ChildWorkflow{} }
+func (*ChildWorkflow) ProtoMessage() {}
+func (*ChildWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{20}
+}
+func (m *ChildWorkflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflow.Merge(m, src)
+}
+func (m *ChildWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflow proto.InternalMessageInfo
+
+func (m *ChildWorkflowPropagation) Reset()      { *m = ChildWorkflowPropagation{} }
+func (*ChildWorkflowPropagation) ProtoMessage() {}
+func (*ChildWorkflowPropagation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{21}
+}
+func (m *ChildWorkflowPropagation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowPropagation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflowPropagation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowPropagation.Merge(m, src)
+}
+func (m *ChildWorkflowPropagation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowPropagation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowPropagation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowPropagation proto.InternalMessageInfo
+
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +685,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +713,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +741,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,10 +766,38 @@ func (m *ContainerNode) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 
+func (m *ContainerPipe) Reset()      { *m = ContainerPipe{} }
+func (*ContainerPipe) ProtoMessage() {}
+func (*ContainerPipe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{26}
+}
+func (m *ContainerPipe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerPipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerPipe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerPipe.Merge(m, src)
+}
+func (m *ContainerPipe) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerPipe) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerPipe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerPipe proto.InternalMessageInfo
+
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +825,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,10 +850,38 @@ func (m *ContainerSetTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 
+func (m *ContainerSetWorkspace) Reset()      { *m = ContainerSetWorkspace{} }
+func (*ContainerSetWorkspace) ProtoMessage() {}
+func (*ContainerSetWorkspace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{29}
+}
+func (m *ContainerSetWorkspace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerSetWorkspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerSetWorkspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetWorkspace.Merge(m, src)
+}
+func (m *ContainerSetWorkspace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerSetWorkspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetWorkspace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerSetWorkspace proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +909,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +937,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +965,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +993,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +1021,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +1049,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1077,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1105,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1133,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1161,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,10 +1186,38 @@ func (m *DataSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataSource proto.InternalMessageInfo
 
+func (m *EgressDestination) Reset()      { *m = EgressDestination{} }
+func (*EgressDestination) ProtoMessage() {}
+func (*EgressDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{41}
+}
+func (m *EgressDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressDestination.Merge(m, src)
+}
+func (m *EgressDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressDestination proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1245,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,10 +1270,38 @@ func (m *ExecutorConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 
+func (m *ExternalSecretSelector) Reset()      { *m = ExternalSecretSelector{} }
+func (*ExternalSecretSelector) ProtoMessage() {}
+func (*ExternalSecretSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{44}
+}
+func (m *ExternalSecretSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalSecretSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExternalSecretSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalSecretSelector.Merge(m, src)
+}
+func (m *ExternalSecretSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalSecretSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalSecretSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalSecretSelector proto.InternalMessageInfo
+
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1329,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1357,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,10 +1382,66 @@ func (m *GCSBucket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 
+func (m *GRPC) Reset()      { *m = GRPC{} }
+func (*GRPC) ProtoMessage() {}
+func (*GRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{48}
+}
+func (m *GRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPC.Merge(m, src)
+}
+func (m *GRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPC proto.InternalMessageInfo
+
+func (m *GRPCTLS) Reset()      { *m = GRPCTLS{} }
+func (*GRPCTLS) ProtoMessage() {}
+func (*GRPCTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{49}
+}
+func (m *GRPCTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCTLS.Merge(m, src)
+}
+func (m *GRPCTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCTLS proto.InternalMessageInfo
+
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1469,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1497,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1525,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1553,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1581,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1609,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1637,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1268,10 +1662,38 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPCache) Reset()      { *m = HTTPCache{} }
+func (*HTTPCache) ProtoMessage() {}
+func (*HTTPCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{58}
+}
+func (m *HTTPCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPCache.Merge(m, src)
+}
+func (m *HTTPCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPCache proto.InternalMessageInfo
+
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1721,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1749,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1777,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1805,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1833,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1861,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1889,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1917,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1945,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,10 +1970,66 @@ func (m *Link) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Link proto.InternalMessageInfo
 
+func (m *Matrix) Reset()      { *m = Matrix{} }
+func (*Matrix) ProtoMessage() {}
+func (*Matrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{69}
+}
+func (m *Matrix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Matrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Matrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Matrix.Merge(m, src)
+}
+func (m *Matrix) XXX_Size() int {
+	return m.Size()
+}
+func (m *Matrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_Matrix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Matrix proto.InternalMessageInfo
+
+func (m *MatrixAxis) Reset()      { *m = MatrixAxis{} }
+func (*MatrixAxis) ProtoMessage() {}
+func (*MatrixAxis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{70}
+}
+func (m *MatrixAxis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatrixAxis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MatrixAxis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatrixAxis.Merge(m, src)
+}
+func (m *MatrixAxis) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatrixAxis) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatrixAxis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatrixAxis proto.InternalMessageInfo
+
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2057,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2085,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2113,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2141,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2169,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2197,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2225,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2253,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2281,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2309,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2337,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,10 +2362,38 @@ func (m *NoneStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{83}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2421,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2449,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2477,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2505,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2533,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2561,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2589,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2108,10 +2614,38 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParameterSchema) Reset()      { *m = ParameterSchema{} }
+func (*ParameterSchema) ProtoMessage() {}
+func (*ParameterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{92}
+}
+func (m *ParameterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchema.Merge(m, src)
+}
+func (m *ParameterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchema proto.InternalMessageInfo
+
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2673,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2701,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2729,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2757,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,10 +2782,38 @@ func (m *ResourceTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 
+func (m *ResourceUsage) Reset()      { *m = ResourceUsage{} }
+func (*ResourceUsage) ProtoMessage() {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{98}
+}
+func (m *ResourceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUsage.Merge(m, src)
+}
+func (m *ResourceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUsage proto.InternalMessageInfo
+
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2841,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2869,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2897,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2925,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2953,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2981,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2444,10 +3006,66 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *S3Mirror) Reset()      { *m = S3Mirror{} }
+func (*S3Mirror) ProtoMessage() {}
+func (*S3Mirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{106}
+}
+func (m *S3Mirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *S3Mirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *S3Mirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Mirror.Merge(m, src)
+}
+func (m *S3Mirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *S3Mirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Mirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_S3Mirror proto.InternalMessageInfo
+
+func (m *SQL) Reset()      { *m = SQL{} }
+func (*SQL) ProtoMessage() {}
+func (*SQL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{107}
+}
+func (m *SQL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQL.Merge(m, src)
+}
+func (m *SQL) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQL) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQL proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2472,10 +3090,38 @@ func (m *ScriptTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 
+func (m *SecretProvider) Reset()      { *m = SecretProvider{} }
+func (*SecretProvider) ProtoMessage() {}
+func (*SecretProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{109}
+}
+func (m *SecretProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SecretProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretProvider.Merge(m, src)
+}
+func (m *SecretProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretProvider proto.InternalMessageInfo
+
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +3149,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +3177,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +3205,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2584,10 +3230,38 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
+func (*StopStrategy) ProtoMessage() {}
+func (*StopStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{114}
+}
+func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopStrategy.Merge(m, src)
+}
+func (m *StopStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
+
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3289,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3317,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3345,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3373,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +3401,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3429,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3457,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2808,10 +3482,38 @@ func (m *TarStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 
+func (m *TaskStrategy) Reset()      { *m = TaskStrategy{} }
+func (*TaskStrategy) ProtoMessage() {}
+func (*TaskStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{123}
+}
+func (m *TaskStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TaskStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskStrategy.Merge(m, src)
+}
+func (m *TaskStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskStrategy proto.InternalMessageInfo
+
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2836,10 +3538,38 @@ func (m *Template) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Template proto.InternalMessageInfo
 
+func (m *TemplateNetworkPolicy) Reset()      { *m = TemplateNetworkPolicy{} }
+func (*TemplateNetworkPolicy) ProtoMessage() {}
+func (*TemplateNetworkPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{125}
+}
+func (m *TemplateNetworkPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateNetworkPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateNetworkPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateNetworkPolicy.Merge(m, src)
+}
+func (m *TemplateNetworkPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateNetworkPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateNetworkPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateNetworkPolicy proto.InternalMessageInfo
+
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3597,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3625,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3653,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2948,10 +3678,38 @@ func (m *ValueFrom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 
+func (m *VaultSecretProvider) Reset()      { *m = VaultSecretProvider{} }
+func (*VaultSecretProvider) ProtoMessage() {}
+func (*VaultSecretProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{130}
+}
+func (m *VaultSecretProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSecretProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VaultSecretProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSecretProvider.Merge(m, src)
+}
+func (m *VaultSecretProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSecretProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSecretProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSecretProvider proto.InternalMessageInfo
+
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3737,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3765,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3793,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3821,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3849,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3877,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3905,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3933,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3961,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3989,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +4017,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +4045,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +4073,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +4101,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3368,10 +4126,94 @@ func (m *WorkflowTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 
+func (m *WorkflowTemplateGrant) Reset()      { *m = WorkflowTemplateGrant{} }
+func (*WorkflowTemplateGrant) ProtoMessage() {}
+func (*WorkflowTemplateGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{146}
+}
+func (m *WorkflowTemplateGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowTemplateGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateGrant.Merge(m, src)
+}
+func (m *WorkflowTemplateGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateGrant proto.InternalMessageInfo
+
+func (m *WorkflowTemplateGrantList) Reset()      { *m = WorkflowTemplateGrantList{} }
+func (*WorkflowTemplateGrantList) ProtoMessage() {}
+func (*WorkflowTemplateGrantList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{147}
+}
+func (m *WorkflowTemplateGrantList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateGrantList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowTemplateGrantList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateGrantList.Merge(m, src)
+}
+func (m *WorkflowTemplateGrantList) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateGrantList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateGrantList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateGrantList proto.InternalMessageInfo
+
+func (m *WorkflowTemplateGrantSpec) Reset()      { *m = WorkflowTemplateGrantSpec{} }
+func (*WorkflowTemplateGrantSpec) ProtoMessage() {}
+func (*WorkflowTemplateGrantSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{148}
+}
+func (m *WorkflowTemplateGrantSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateGrantSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowTemplateGrantSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateGrantSpec.Merge(m, src)
+}
+func (m *WorkflowTemplateGrantSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateGrantSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateGrantSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateGrantSpec proto.InternalMessageInfo
+
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +4241,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +4269,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{151}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +4297,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3481,7 +4323,11 @@ func (m *ZipStrategy) XXX_DiscardUnknown() {
 var xxx_messageInfo_ZipStrategy proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*AWSSecretsManagerSecretProvider)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AWSSecretsManagerSecretProvider")
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
+	proto.RegisterType((*Approval)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Approval")
+	proto.RegisterType((*ApprovalDecision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ApprovalDecision")
+	proto.RegisterType((*ApprovalStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ApprovalStatus")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Artifact")
@@ -3495,12 +4341,18 @@ func init() {
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*Catchup)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Catchup")
+	proto.RegisterType((*Checkpoint)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Checkpoint")
+	proto.RegisterType((*ChildWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ChildWorkflow")
+	proto.RegisterType((*ChildWorkflowPropagation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ChildWorkflowPropagation")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Condition")
 	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerPipe)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerPipe")
 	proto.RegisterType((*ContainerSetRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetRetryStrategy")
 	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
+	proto.RegisterType((*ContainerSetWorkspace)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetWorkspace")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
@@ -3513,11 +4365,15 @@ func init() {
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*EgressDestination)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EgressDestination")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
+	proto.RegisterType((*ExternalSecretSelector)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExternalSecretSelector")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifactRepository")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
+	proto.RegisterType((*GRPC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GRPC")
+	proto.RegisterType((*GRPCTLS)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GRPCTLS")
 	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Gauge")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GitArtifact")
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifact")
@@ -3526,6 +4382,7 @@ func init() {
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
 	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPCache")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
@@ -3536,6 +4393,8 @@ func init() {
 	proto.RegisterType((*LabelValues)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LabelValues")
 	proto.RegisterType((*LifecycleHook)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LifecycleHook")
 	proto.RegisterType((*Link)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Link")
+	proto.RegisterType((*Matrix)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Matrix")
+	proto.RegisterType((*MatrixAxis)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MatrixAxis")
 	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MemoizationStatus")
 	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Memoize")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Metadata")
@@ -3551,6 +4410,7 @@ func init() {
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NoneStrategy")
+	proto.RegisterType((*Notification)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Notification")
 	proto.RegisterType((*OSSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifact")
 	proto.RegisterType((*OSSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifactRepository")
 	proto.RegisterType((*OSSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSBucket")
@@ -3559,11 +4419,15 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
+	proto.RegisterType((*ResourceUsage)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceUsage.AverageEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceUsage.PeakEntry")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
@@ -3571,11 +4435,15 @@ func init() {
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*S3Mirror)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Mirror")
+	proto.RegisterType((*SQL)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SQL")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
+	proto.RegisterType((*SecretProvider)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SecretProvider")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*StopStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopStrategy")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
@@ -3584,12 +4452,15 @@ func init() {
 	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TTLStrategy")
 	proto.RegisterType((*TarStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TarStrategy")
+	proto.RegisterType((*TaskStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TaskStrategy")
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template.NodeSelectorEntry")
+	proto.RegisterType((*TemplateNetworkPolicy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateNetworkPolicy")
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateRef")
 	proto.RegisterType((*TransformationStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TransformationStep")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.UserContainer")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ValueFrom")
+	proto.RegisterType((*VaultSecretProvider)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.VaultSecretProvider")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Version")
 	proto.RegisterType((*VolumeClaimGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.VolumeClaimGC")
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Workflow")
@@ -3613,6 +4484,9 @@ func init() {
 	proto.RegisterType((*WorkflowTaskSetStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTaskSetStatus")
 	proto.RegisterMapType((map[string]NodeResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTaskSetStatus.NodesEntry")
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
+	proto.RegisterType((*WorkflowTemplateGrant)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateGrant")
+	proto.RegisterType((*WorkflowTemplateGrantList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateGrantList")
+	proto.RegisterType((*WorkflowTemplateGrantSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateGrantSpec")
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
 	proto.RegisterType((*WorkflowTemplateSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateSpec")
@@ -3624,575 +4498,825 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 9085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x24, 0xc9,
-	0x71, 0x18, 0x7c, 0x3d, 0xc0, 0x00, 0x33, 0x09, 0x60, 0x81, 0xad, 0x7d, 0xcd, 0xe1, 0xf6, 0x16,
-	0xab, 0x3e, 0xdd, 0x7d, 0xb7, 0xd2, 0x11, 0xd0, 0xed, 0xf2, 0x3e, 0x9f, 0xc9, 0x30, 0x45, 0x0c,
-	0xb0, 0x58, 0xe0, 0xf0, 0xbc, 0x1a, 0xec, 0xae, 0x79, 0x3c, 0x53, 0x6c, 0xcc, 0x14, 0x66, 0xfa,
-	0x30, 0xd3, 0x3d, 0xd7, 0xdd, 0x83, 0xc7, 0x3d, 0x48, 0x9a, 0x7a, 0xf0, 0xce, 0xa2, 0x24, 0x3f,
-	0x25, 0x8a, 0xb6, 0x23, 0x14, 0xb4, 0x68, 0x29, 0x64, 0x85, 0x1d, 0x8c, 0xf0, 0x2f, 0xf9, 0xaf,
-	0xc3, 0x41, 0x87, 0x1d, 0xb6, 0x1c, 0xa2, 0x2d, 0x46, 0xd8, 0x06, 0x4d, 0xd8, 0xd6, 0x0f, 0x3b,
-	0xe4, 0x70, 0x28, 0x4c, 0x9a, 0xb1, 0xf6, 0x0f, 0x47, 0x3d, 0xbb, 0xaa, 0xa7, 0x07, 0x3b, 0xd8,
-	0x6d, 0x60, 0x2f, 0x2c, 0xff, 0x9b, 0xc9, 0xca, 0xca, 0xac, 0xaa, 0xae, 0xca, 0xca, 0xca, 0xcc,
-	0xca, 0x82, 0x8d, 0xba, 0x1b, 0x35, 0x3a, 0x5b, 0xd3, 0x55, 0xbf, 0x35, 0xe3, 0x04, 0x75, 0xbf,
-	0x1d, 0xf8, 0x6f, 0xb1, 0x1f, 0x1f, 0xdb, 0xf3, 0x83, 0x9d, 0xed, 0xa6, 0xbf, 0x17, 0xce, 0xec,
-	0xde, 0x9a, 0x69, 0xef, 0xd4, 0x67, 0x9c, 0xb6, 0x1b, 0xce, 0x48, 0xe8, 0xcc, 0xee, 0xcb, 0x4e,
-	0xb3, 0xdd, 0x70, 0x5e, 0x9e, 0xa9, 0x13, 0x8f, 0x04, 0x4e, 0x44, 0x6a, 0xd3, 0xed, 0xc0, 0x8f,
-	0x7c, 0xf4, 0xe9, 0x98, 0xe2, 0xb4, 0xa4, 0xc8, 0x7e, 0xfc, 0x8c, 0xa2, 0x38, 0xbd, 0x7b, 0x6b,
-	0xba, 0xbd, 0x53, 0x9f, 0xa6, 0x14, 0xa7, 0x25, 0x74, 0x5a, 0x52, 0x9c, 0xfc, 0x98, 0xd6, 0xa6,
-	0xba, 0x5f, 0xf7, 0x67, 0x18, 0xe1, 0xad, 0xce, 0x36, 0xfb, 0xc7, 0xfe, 0xb0, 0x5f, 0x9c, 0xe1,
-	0xa4, 0xbd, 0xf3, 0x6a, 0x38, 0xed, 0xfa, 0xb4, 0x7d, 0x33, 0x55, 0x3f, 0x20, 0x33, 0xbb, 0x5d,
-	0x8d, 0x9a, 0xbc, 0xa1, 0xe1, 0xb4, 0xfd, 0xa6, 0x5b, 0x3d, 0x98, 0xd9, 0x7d, 0x79, 0x8b, 0x44,
-	0xdd, 0xed, 0x9f, 0xfc, 0x78, 0x8c, 0xda, 0x72, 0xaa, 0x0d, 0xd7, 0x23, 0xc1, 0x41, 0xdc, 0xff,
-	0x16, 0x89, 0x9c, 0x34, 0x06, 0x33, 0xbd, 0x6a, 0x05, 0x1d, 0x2f, 0x72, 0x5b, 0xa4, 0xab, 0xc2,
-	0xff, 0xff, 0xb0, 0x0a, 0x61, 0xb5, 0x41, 0x5a, 0x4e, 0x57, 0xbd, 0x5b, 0xbd, 0xea, 0x75, 0x22,
-	0xb7, 0x39, 0xe3, 0x7a, 0x51, 0x18, 0x05, 0xc9, 0x4a, 0xf6, 0x6d, 0x18, 0x9a, 0x6d, 0xf9, 0x1d,
-	0x2f, 0x42, 0x9f, 0x84, 0xfc, 0xae, 0xd3, 0xec, 0x90, 0x92, 0x75, 0xdd, 0x7a, 0xb1, 0x58, 0x7e,
-	0xfe, 0xdb, 0x87, 0x53, 0x4f, 0x1d, 0x1d, 0x4e, 0xe5, 0xef, 0x51, 0xe0, 0x83, 0xc3, 0xa9, 0x8b,
-	0xc4, 0xab, 0xfa, 0x35, 0xd7, 0xab, 0xcf, 0xbc, 0x15, 0xfa, 0xde, 0xf4, 0x5a, 0xa7, 0xb5, 0x45,
-	0x02, 0xcc, 0xeb, 0xd8, 0x7f, 0x90, 0x83, 0xf1, 0xd9, 0xa0, 0xda, 0x70, 0x77, 0x49, 0x25, 0xa2,
-	0xf4, 0xeb, 0x07, 0xa8, 0x01, 0x03, 0x91, 0x13, 0x30, 0x72, 0x23, 0x37, 0x57, 0xa7, 0x1f, 0xf7,
-	0xe3, 0x4f, 0x6f, 0x3a, 0x81, 0xa4, 0x5d, 0x1e, 0x3e, 0x3a, 0x9c, 0x1a, 0xd8, 0x74, 0x02, 0x4c,
-	0x59, 0xa0, 0x26, 0x0c, 0x7a, 0xbe, 0x47, 0x4a, 0x39, 0xc6, 0x6a, 0xed, 0xf1, 0x59, 0xad, 0xf9,
-	0x9e, 0xea, 0x47, 0xb9, 0x70, 0x74, 0x38, 0x35, 0x48, 0x21, 0x98, 0x71, 0xa1, 0xfd, 0x7a, 0xc7,
-	0x6d, 0x97, 0x06, 0xb2, 0xea, 0xd7, 0x1b, 0x6e, 0xdb, 0xec, 0xd7, 0x1b, 0x6e, 0x1b, 0x53, 0x16,
-	0xf6, 0x87, 0x39, 0x28, 0xce, 0x06, 0xf5, 0x4e, 0x8b, 0x78, 0x51, 0x88, 0xbe, 0x08, 0xd0, 0x76,
-	0x02, 0xa7, 0x45, 0x22, 0x12, 0x84, 0x25, 0xeb, 0xfa, 0xc0, 0x8b, 0x23, 0x37, 0x97, 0x1f, 0x9f,
-	0xfd, 0x86, 0xa4, 0x59, 0x46, 0xe2, 0x93, 0x83, 0x02, 0x85, 0x58, 0x63, 0x89, 0xde, 0x85, 0xa2,
-	0x13, 0x44, 0xee, 0xb6, 0x53, 0x8d, 0xc2, 0x52, 0x8e, 0xf1, 0x7f, 0xed, 0xf1, 0xf9, 0xcf, 0x0a,
-	0x92, 0xe5, 0xf3, 0x82, 0x7d, 0x51, 0x42, 0x42, 0x1c, 0xf3, 0xb3, 0x7f, 0x2b, 0x0f, 0x05, 0x59,
-	0x80, 0xae, 0xc3, 0xa0, 0xe7, 0xb4, 0xe4, 0x54, 0x1d, 0x15, 0x15, 0x07, 0xd7, 0x9c, 0x16, 0xfd,
-	0x48, 0x4e, 0x8b, 0x50, 0x8c, 0xb6, 0x13, 0x35, 0xd8, 0x94, 0xd0, 0x30, 0x36, 0x9c, 0xa8, 0x81,
-	0x59, 0x09, 0xba, 0x0a, 0x83, 0x2d, 0xbf, 0x46, 0xd8, 0x77, 0xcc, 0xf3, 0x8f, 0xbc, 0xea, 0xd7,
-	0x08, 0x66, 0x50, 0x5a, 0x7f, 0x3b, 0xf0, 0x5b, 0xa5, 0x41, 0xb3, 0xfe, 0x42, 0xe0, 0xb7, 0x30,
-	0x2b, 0x41, 0x5f, 0xb3, 0x60, 0x42, 0x36, 0x6f, 0xc5, 0xaf, 0x3a, 0x91, 0xeb, 0x7b, 0xa5, 0x3c,
-	0x9b, 0x14, 0x38, 0xbb, 0x51, 0x91, 0x94, 0xcb, 0x25, 0xd1, 0x84, 0x89, 0x64, 0x09, 0xee, 0x6a,
-	0x05, 0xba, 0x09, 0x50, 0x6f, 0xfa, 0x5b, 0x4e, 0x93, 0x0e, 0x48, 0x69, 0x88, 0x75, 0x41, 0x7d,
-	0xdc, 0x3b, 0xaa, 0x04, 0x6b, 0x58, 0x68, 0x1f, 0x86, 0x1d, 0xbe, 0x80, 0x4b, 0xc3, 0xac, 0x13,
-	0xaf, 0x67, 0xd1, 0x09, 0x43, 0x22, 0x94, 0x47, 0x8e, 0x0e, 0xa7, 0x86, 0x05, 0x10, 0x4b, 0x76,
-	0xe8, 0x25, 0x28, 0xf8, 0x6d, 0xda, 0x6e, 0xa7, 0x59, 0x2a, 0x5c, 0xb7, 0x5e, 0x2c, 0x94, 0x27,
-	0x44, 0x5b, 0x0b, 0xeb, 0x02, 0x8e, 0x15, 0x06, 0xba, 0x01, 0xc3, 0x61, 0x67, 0x8b, 0x7e, 0xc7,
-	0x52, 0x91, 0x75, 0x6c, 0x5c, 0x20, 0x0f, 0x57, 0x38, 0x18, 0xcb, 0x72, 0xf4, 0x0a, 0x8c, 0x04,
-	0xa4, 0xda, 0x09, 0x42, 0x42, 0x3f, 0x6c, 0x09, 0x18, 0xed, 0x0b, 0x02, 0x7d, 0x04, 0xc7, 0x45,
-	0x58, 0xc7, 0x43, 0x9f, 0x82, 0x73, 0xf4, 0x03, 0xdf, 0xde, 0x6f, 0x07, 0x24, 0x0c, 0xe9, 0x57,
-	0x1d, 0x61, 0x8c, 0x2e, 0x8b, 0x9a, 0xe7, 0x16, 0x8c, 0x52, 0x9c, 0xc0, 0xb6, 0x7f, 0x6f, 0x18,
-	0xba, 0x3e, 0x12, 0x7a, 0x19, 0x46, 0x44, 0x7f, 0x57, 0xfc, 0x7a, 0xc8, 0x26, 0x6e, 0xa1, 0x3c,
-	0x4e, 0xdb, 0x31, 0x1b, 0x83, 0xb1, 0x8e, 0x83, 0x6a, 0x90, 0x0b, 0x6f, 0x09, 0x99, 0xb6, 0xf2,
-	0xf8, 0x1f, 0xa3, 0x72, 0x4b, 0xad, 0xb4, 0xa1, 0xa3, 0xc3, 0xa9, 0x5c, 0xe5, 0x16, 0xce, 0x85,
-	0xb7, 0xa8, 0x34, 0xab, 0xbb, 0x51, 0x76, 0xd2, 0xec, 0x8e, 0x1b, 0x29, 0x3e, 0x4c, 0x9a, 0xdd,
-	0x71, 0x23, 0x4c, 0x59, 0x50, 0x29, 0xdd, 0x88, 0xa2, 0x36, 0x5b, 0x52, 0x99, 0x48, 0xe9, 0xc5,
-	0xcd, 0xcd, 0x0d, 0xc5, 0x8b, 0x2d, 0x60, 0x0a, 0xc1, 0x8c, 0x0b, 0xfa, 0xc0, 0xa2, 0x23, 0xce,
-	0x0b, 0xfd, 0xe0, 0x40, 0xac, 0xcc, 0xbb, 0xd9, 0xad, 0x4c, 0x3f, 0x38, 0x50, 0xcc, 0xc5, 0x87,
-	0x54, 0x05, 0x58, 0x67, 0xcd, 0x3a, 0x5e, 0xdb, 0x0e, 0xd9, 0x42, 0xcc, 0xa6, 0xe3, 0xf3, 0x0b,
-	0x95, 0x44, 0xc7, 0xe7, 0x17, 0x2a, 0x98, 0x71, 0xa1, 0x1f, 0x34, 0x70, 0xf6, 0xc4, 0x22, 0xce,
-	0xe0, 0x83, 0x62, 0x67, 0xcf, 0xfc, 0xa0, 0xd8, 0xd9, 0xc3, 0x94, 0x05, 0xe5, 0xe4, 0x87, 0x21,
-	0x5b, 0xb3, 0x99, 0x70, 0x5a, 0xaf, 0x54, 0x4c, 0x4e, 0xeb, 0x95, 0x0a, 0xa6, 0x2c, 0xd8, 0x24,
-	0xad, 0x86, 0x6c, 0xc1, 0x67, 0x33, 0x49, 0xe7, 0x12, 0x9c, 0xee, 0xcc, 0x55, 0x30, 0x65, 0x61,
-	0x7f, 0x68, 0xc1, 0x98, 0x2c, 0xa2, 0x42, 0x24, 0x44, 0xfb, 0x50, 0x90, 0x1f, 0x53, 0xe8, 0x32,
-	0x59, 0x6e, 0x7a, 0x4a, 0xd4, 0x49, 0x08, 0x56, 0xdc, 0xec, 0xdf, 0xcd, 0x03, 0x52, 0x60, 0xd2,
-	0xf6, 0x43, 0x97, 0x4d, 0xa7, 0x47, 0x10, 0x25, 0x9e, 0x26, 0x4a, 0xee, 0x65, 0x29, 0x4a, 0xe2,
-	0x66, 0x19, 0x42, 0xe5, 0xaf, 0x26, 0x16, 0x1f, 0x97, 0x2e, 0x3f, 0x73, 0x2a, 0x8b, 0x4f, 0x6b,
-	0xc2, 0xf1, 0xcb, 0x70, 0x57, 0x2c, 0x43, 0x2e, 0x7f, 0xfe, 0x7c, 0xb6, 0xcb, 0x50, 0x6b, 0x45,
-	0x72, 0x41, 0x06, 0x7c, 0x99, 0x70, 0x01, 0x74, 0x3f, 0xd3, 0x65, 0xa2, 0x71, 0x35, 0x17, 0x4c,
-	0xc0, 0x17, 0xcc, 0x50, 0x56, 0x3c, 0xb5, 0x05, 0x93, 0xe4, 0xa9, 0x96, 0xce, 0xdb, 0x70, 0xa9,
-	0x1b, 0x07, 0x93, 0x6d, 0x34, 0x03, 0xc5, 0xaa, 0xef, 0x6d, 0xbb, 0xf5, 0x55, 0xa7, 0x2d, 0x54,
-	0x36, 0xa5, 0xeb, 0xcd, 0xc9, 0x02, 0x1c, 0xe3, 0xa0, 0x67, 0x61, 0x60, 0x87, 0x1c, 0x08, 0xdd,
-	0x6d, 0x44, 0xa0, 0x0e, 0x2c, 0x93, 0x03, 0x4c, 0xe1, 0x9f, 0x28, 0x7c, 0xed, 0x37, 0xa6, 0x9e,
-	0xfa, 0xd2, 0xbf, 0xbb, 0xfe, 0x94, 0xfd, 0xaf, 0x06, 0xe0, 0x99, 0x54, 0x9e, 0x95, 0xc8, 0x89,
-	0x3a, 0x21, 0xfa, 0x5d, 0x0b, 0x2e, 0x39, 0x69, 0xe5, 0x62, 0x25, 0xdf, 0xcf, 0x6e, 0x46, 0x1a,
-	0xe4, 0xcb, 0xcf, 0x8a, 0x46, 0xa7, 0x8f, 0x08, 0x4e, 0x6f, 0x14, 0x1d, 0x28, 0xaa, 0xbc, 0x86,
-	0x6d, 0xa7, 0x4a, 0x44, 0xef, 0xd5, 0x40, 0xad, 0xc9, 0x02, 0x1c, 0xe3, 0x50, 0x65, 0xa8, 0x46,
-	0xb6, 0x9d, 0x4e, 0x93, 0x6f, 0xe0, 0x85, 0x58, 0x19, 0x9a, 0xe7, 0x60, 0x2c, 0xcb, 0xd1, 0xdf,
-	0xb2, 0x00, 0x75, 0x73, 0x15, 0x8b, 0x61, 0xf3, 0x34, 0xc6, 0xa1, 0x7c, 0xf9, 0xe8, 0x70, 0x2a,
-	0x45, 0x80, 0xe1, 0x94, 0x76, 0x68, 0xdf, 0xf4, 0x9f, 0x59, 0x70, 0x21, 0x65, 0x99, 0xd3, 0x49,
-	0xd1, 0x09, 0x9a, 0x62, 0xfe, 0xa8, 0x49, 0x71, 0x17, 0xaf, 0x60, 0x0a, 0x47, 0x7f, 0xdd, 0x82,
-	0x71, 0x6d, 0xb5, 0xcf, 0x76, 0x84, 0xf2, 0x9f, 0x91, 0x22, 0x6b, 0x10, 0x2e, 0x5f, 0x11, 0xec,
-	0xc7, 0x13, 0x05, 0x38, 0xd9, 0x04, 0xfb, 0xfb, 0x16, 0x3c, 0x7b, 0xac, 0xd0, 0x4a, 0x6d, 0xb8,
-	0xf5, 0xc4, 0x1b, 0x4e, 0xa7, 0x56, 0x40, 0xda, 0xfe, 0x5d, 0xbc, 0x22, 0x66, 0xa2, 0x9a, 0x5a,
-	0x98, 0x83, 0xb1, 0x2c, 0xb7, 0xff, 0xd0, 0x82, 0x24, 0x3d, 0xe4, 0xc0, 0xb9, 0x4e, 0x48, 0x02,
-	0x3a, 0x55, 0x2b, 0xa4, 0x1a, 0x10, 0xb9, 0x77, 0x3e, 0x3f, 0xcd, 0xad, 0x14, 0xb4, 0xc1, 0xd3,
-	0x55, 0x3f, 0x20, 0xd3, 0xbb, 0x2f, 0x4f, 0x73, 0x8c, 0x65, 0x72, 0x50, 0x21, 0x4d, 0x42, 0x69,
-	0x94, 0x11, 0xd5, 0xb3, 0xef, 0x1a, 0x04, 0x70, 0x82, 0x20, 0x65, 0xd1, 0x76, 0xc2, 0x70, 0xcf,
-	0x0f, 0x6a, 0x82, 0x45, 0xee, 0xc4, 0x2c, 0x36, 0x0c, 0x02, 0x38, 0x41, 0xd0, 0xfe, 0xc7, 0x16,
-	0x0c, 0x97, 0x9d, 0xea, 0x8e, 0xbf, 0xbd, 0x4d, 0x8f, 0x29, 0xb5, 0x4e, 0xc0, 0x8f, 0x79, 0x7c,
-	0x12, 0xaa, 0xbd, 0x7b, 0x5e, 0xc0, 0xb1, 0xc2, 0x40, 0x9b, 0x30, 0xc4, 0x87, 0x43, 0x34, 0xea,
-	0xa7, 0xb4, 0x46, 0x29, 0xeb, 0x0c, 0xfb, 0x72, 0x9d, 0xc8, 0x6d, 0x4e, 0x73, 0xeb, 0xcc, 0xf4,
-	0x92, 0x17, 0xad, 0x07, 0x95, 0x28, 0x70, 0xbd, 0x7a, 0x19, 0x8e, 0x0e, 0xa7, 0x86, 0x16, 0x18,
-	0x0d, 0x2c, 0x68, 0xd1, 0x13, 0x4d, 0xcb, 0xd9, 0x97, 0xec, 0xd8, 0x9a, 0x2f, 0xc6, 0x27, 0x9a,
-	0xd5, 0xb8, 0x08, 0xeb, 0x78, 0xf6, 0xe7, 0x20, 0x3f, 0xe7, 0x54, 0x1b, 0x04, 0xdd, 0x4d, 0x4a,
-	0xe2, 0x91, 0x9b, 0x2f, 0xa6, 0x8d, 0x96, 0x92, 0xca, 0xfa, 0x80, 0x8d, 0xf5, 0x92, 0xd7, 0xf6,
-	0x0f, 0x2c, 0xb8, 0x32, 0xd7, 0xec, 0x84, 0x11, 0x09, 0xee, 0x8b, 0x29, 0xb8, 0x49, 0x5a, 0xed,
-	0xa6, 0x13, 0x11, 0xf4, 0x79, 0x28, 0xb4, 0x48, 0xe4, 0xd4, 0x9c, 0xc8, 0x11, 0x1c, 0x7b, 0x0f,
-	0x05, 0x9b, 0xc4, 0x14, 0x9b, 0xb6, 0x61, 0x7d, 0xeb, 0x2d, 0x52, 0x8d, 0x56, 0x49, 0xe4, 0xc4,
-	0x67, 0xd7, 0x18, 0x86, 0x15, 0x55, 0xb4, 0x0f, 0x83, 0x61, 0x9b, 0x54, 0xb3, 0x53, 0x6f, 0x92,
-	0x7d, 0xa8, 0xb4, 0x49, 0x35, 0x36, 0x01, 0xd0, 0x7f, 0x98, 0x71, 0xb4, 0xff, 0x97, 0x05, 0xcf,
-	0xf4, 0xe8, 0xf7, 0x8a, 0x1b, 0x46, 0xe8, 0xcd, 0xae, 0xbe, 0x4f, 0xf7, 0xd7, 0x77, 0x5a, 0x9b,
-	0xf5, 0x5c, 0x4d, 0x31, 0x09, 0xd1, 0xfa, 0xfd, 0x05, 0xc8, 0xbb, 0x11, 0x69, 0x49, 0x53, 0xcc,
-	0x67, 0x1e, 0xbf, 0xe3, 0x3d, 0xfa, 0x52, 0x1e, 0x93, 0xb6, 0xc0, 0x25, 0xca, 0x0f, 0x73, 0xb6,
-	0xf6, 0x3f, 0xb5, 0x80, 0x4e, 0x87, 0x9a, 0x2b, 0x0e, 0xb8, 0x83, 0xd1, 0x41, 0x5b, 0x9a, 0x64,
-	0xe4, 0xfe, 0x37, 0xb8, 0x79, 0xd0, 0x26, 0x0f, 0x0e, 0xa7, 0xc6, 0x14, 0x22, 0x05, 0x60, 0x86,
-	0x8a, 0x3e, 0x07, 0x43, 0x21, 0xdb, 0xa7, 0x85, 0x84, 0x59, 0x10, 0x95, 0x86, 0xf8, 0xee, 0xfd,
-	0xe0, 0x70, 0xaa, 0x2f, 0x8b, 0xeb, 0xb4, 0xa2, 0xcd, 0xeb, 0x61, 0x41, 0x95, 0x8a, 0xb0, 0x16,
-	0x09, 0x43, 0xa7, 0x4e, 0xc4, 0x4a, 0x51, 0x22, 0x6c, 0x95, 0x83, 0xb1, 0x2c, 0xb7, 0xff, 0x86,
-	0x05, 0xb4, 0x89, 0x91, 0x43, 0x59, 0xac, 0xf9, 0x35, 0x82, 0xd6, 0xd8, 0x52, 0xe1, 0x00, 0xf1,
-	0xf1, 0x9e, 0xed, 0xb1, 0x54, 0x38, 0x92, 0xa1, 0xd3, 0x70, 0x10, 0x8e, 0x49, 0xa0, 0x8f, 0xc3,
-	0x68, 0x8d, 0xb4, 0x89, 0x57, 0x23, 0x5e, 0xd5, 0x25, 0xfc, 0xa3, 0x15, 0xcb, 0x13, 0x47, 0x87,
-	0x53, 0xa3, 0xf3, 0x1a, 0x1c, 0x1b, 0x58, 0xf6, 0x37, 0x2c, 0x78, 0x5a, 0x91, 0xab, 0x90, 0x08,
-	0x93, 0x28, 0x38, 0x50, 0x16, 0xd6, 0x93, 0x89, 0xa4, 0xfb, 0x54, 0xa2, 0x47, 0x01, 0x67, 0xfe,
-	0x68, 0x32, 0x69, 0x84, 0xcb, 0x7f, 0x46, 0x04, 0x4b, 0x6a, 0xf6, 0x2f, 0x0f, 0xc0, 0x45, 0xbd,
-	0x91, 0x6a, 0xed, 0xff, 0xac, 0x05, 0xa0, 0x46, 0x80, 0x2a, 0xde, 0x74, 0x9e, 0xae, 0x67, 0x30,
-	0x4f, 0xf5, 0x2f, 0x15, 0x4b, 0x07, 0x05, 0x0e, 0xb1, 0xc6, 0x16, 0x7d, 0x06, 0x46, 0x77, 0xfd,
-	0x66, 0xa7, 0x45, 0x56, 0xfd, 0x8e, 0x17, 0x85, 0xa5, 0x01, 0xd6, 0x8c, 0xa9, 0xb4, 0x8f, 0x79,
-	0x2f, 0xc6, 0x2b, 0x5f, 0x14, 0x64, 0x47, 0x35, 0x60, 0x88, 0x0d, 0x52, 0x74, 0xef, 0x1e, 0x0b,
-	0xf4, 0x4f, 0x22, 0xb4, 0xfc, 0xcf, 0x66, 0xd8, 0xc7, 0xe4, 0x57, 0x2f, 0x9f, 0x3f, 0x3a, 0x9c,
-	0x1a, 0x33, 0x40, 0xd8, 0x6c, 0x84, 0xfd, 0x19, 0x60, 0x63, 0xe1, 0x7a, 0x1d, 0xb2, 0xee, 0xa1,
-	0xe7, 0x20, 0x4f, 0x82, 0xc0, 0x0f, 0xc4, 0x49, 0x51, 0x2d, 0xe6, 0xdb, 0x14, 0x88, 0x79, 0x19,
-	0x7a, 0x81, 0xee, 0x57, 0x6e, 0x93, 0xd4, 0xd8, 0xdc, 0x28, 0x94, 0xcf, 0xc9, 0xb5, 0xb8, 0xc0,
-	0xa0, 0x58, 0x94, 0xda, 0xd3, 0x30, 0x3c, 0x47, 0xfb, 0x4e, 0x02, 0x4a, 0x57, 0x77, 0x18, 0x8c,
-	0x19, 0x0e, 0x03, 0xe9, 0x18, 0xd8, 0x84, 0x4b, 0x73, 0x01, 0xa1, 0x42, 0xf4, 0x56, 0xb9, 0x53,
-	0xdd, 0x21, 0x11, 0x37, 0xe9, 0x85, 0xe8, 0x93, 0x30, 0xe6, 0x33, 0x69, 0xbe, 0xe2, 0x57, 0x77,
-	0x5c, 0xaf, 0x2e, 0x14, 0xd8, 0x4b, 0x82, 0xca, 0xd8, 0xba, 0x5e, 0x88, 0x4d, 0x5c, 0xfb, 0x3f,
-	0xe5, 0x60, 0x74, 0x2e, 0xf0, 0x3d, 0x29, 0xa9, 0xce, 0x60, 0x97, 0x89, 0x8c, 0x5d, 0x26, 0x03,
-	0x0b, 0xaf, 0xde, 0xfe, 0x5e, 0x3b, 0x0c, 0x7a, 0x4f, 0x89, 0xc8, 0x81, 0xac, 0x14, 0x75, 0x83,
-	0x2f, 0xa3, 0x1d, 0x7f, 0x6c, 0x53, 0x80, 0xda, 0xff, 0xd9, 0x82, 0x09, 0x1d, 0xfd, 0x0c, 0x36,
-	0xb5, 0xd0, 0xdc, 0xd4, 0xd6, 0xb2, 0xed, 0x6f, 0x8f, 0x9d, 0xec, 0xc3, 0x21, 0xb3, 0x9f, 0xf4,
-	0x03, 0xa0, 0xaf, 0x59, 0x30, 0xba, 0xa7, 0x01, 0x44, 0x67, 0xd7, 0xb2, 0xd3, 0x2f, 0xd8, 0x57,
-	0xff, 0x71, 0x29, 0x66, 0x74, 0xe8, 0x83, 0xc4, 0x7f, 0x6c, 0xb4, 0x84, 0xca, 0xfd, 0xb0, 0xda,
-	0x20, 0xb5, 0x4e, 0x53, 0x1e, 0x13, 0xd5, 0x90, 0x56, 0x04, 0x1c, 0x2b, 0x0c, 0xf4, 0x26, 0x9c,
-	0xaf, 0xfa, 0x5e, 0xb5, 0x13, 0x04, 0xc4, 0xab, 0x1e, 0x6c, 0x30, 0x1f, 0xa7, 0xd8, 0x10, 0xa7,
-	0x45, 0xb5, 0xf3, 0x73, 0x49, 0x84, 0x07, 0x69, 0x40, 0xdc, 0x4d, 0x88, 0xdb, 0xe3, 0x43, 0xba,
-	0x65, 0xb1, 0xb3, 0x64, 0x41, 0xb7, 0xc7, 0x33, 0x30, 0x96, 0xe5, 0xe8, 0x2e, 0x5c, 0x09, 0x23,
-	0x7a, 0xce, 0xf0, 0xea, 0xf3, 0xc4, 0xa9, 0x35, 0x5d, 0x8f, 0xaa, 0xf2, 0xbe, 0x57, 0xe3, 0xc6,
-	0x91, 0x81, 0xf2, 0x33, 0x47, 0x87, 0x53, 0x57, 0x2a, 0xe9, 0x28, 0xb8, 0x57, 0x5d, 0xf4, 0x39,
-	0x98, 0x0c, 0x3b, 0xd5, 0x2a, 0x09, 0xc3, 0xed, 0x4e, 0xf3, 0x35, 0x7f, 0x2b, 0x5c, 0x74, 0x43,
-	0x7a, 0x0e, 0x59, 0x71, 0x5b, 0x6e, 0xc4, 0x4c, 0x20, 0xf9, 0xf2, 0xb5, 0xa3, 0xc3, 0xa9, 0xc9,
-	0x4a, 0x4f, 0x2c, 0x7c, 0x0c, 0x05, 0x84, 0xe1, 0x32, 0x17, 0x7e, 0x5d, 0xb4, 0x87, 0x19, 0xed,
-	0xc9, 0xa3, 0xc3, 0xa9, 0xcb, 0x0b, 0xa9, 0x18, 0xb8, 0x47, 0x4d, 0xfa, 0x05, 0x23, 0xb7, 0x45,
-	0xde, 0xf1, 0x3d, 0xc2, 0xec, 0xa7, 0xda, 0x17, 0xdc, 0x14, 0x70, 0xac, 0x30, 0xd0, 0x5b, 0xf1,
-	0x4c, 0xa4, 0xcb, 0x45, 0xd8, 0x41, 0x4f, 0x2e, 0xe1, 0x2e, 0x1e, 0x1d, 0x4e, 0x4d, 0xdc, 0xd7,
-	0x28, 0xd1, 0x25, 0x87, 0x0d, 0xda, 0xf6, 0x1f, 0xe4, 0x00, 0x75, 0x8b, 0x08, 0xb4, 0x0c, 0x43,
-	0x4e, 0x35, 0x72, 0x77, 0x89, 0x70, 0x3c, 0x3e, 0x97, 0xb6, 0x7d, 0x72, 0x56, 0x98, 0x6c, 0x13,
-	0x3a, 0x43, 0x48, 0x2c, 0x57, 0x66, 0x59, 0x55, 0x2c, 0x48, 0x20, 0x1f, 0xce, 0x37, 0x9d, 0x30,
-	0x92, 0x73, 0xb5, 0x46, 0xbb, 0x2c, 0x04, 0xeb, 0x4f, 0xf4, 0xd7, 0x29, 0x5a, 0xa3, 0x7c, 0x89,
-	0xce, 0xdc, 0x95, 0x24, 0x21, 0xdc, 0x4d, 0x1b, 0x7d, 0x91, 0xe9, 0x21, 0x5c, 0x49, 0x94, 0x0a,
-	0xc0, 0x72, 0x26, 0x7b, 0x34, 0xa7, 0x69, 0xe8, 0x20, 0x82, 0x0d, 0xd6, 0x58, 0xda, 0xff, 0x1c,
-	0x60, 0x78, 0x7e, 0xf6, 0xce, 0xa6, 0x13, 0xee, 0xf4, 0xe1, 0xbc, 0xa4, 0xb3, 0x43, 0xe8, 0x50,
-	0xc9, 0xf5, 0x2d, 0x75, 0x2b, 0xac, 0x30, 0x90, 0x07, 0x43, 0xae, 0x47, 0x17, 0x44, 0xe9, 0x5c,
-	0x56, 0xe6, 0x69, 0xa5, 0xf9, 0xb3, 0x43, 0xe8, 0x12, 0xa3, 0x8e, 0x05, 0x17, 0xf4, 0x1e, 0x14,
-	0x1d, 0xe9, 0x94, 0x16, 0xdb, 0xd2, 0x72, 0x16, 0x96, 0x0a, 0x41, 0x52, 0xf7, 0x03, 0x0b, 0x10,
-	0x8e, 0x19, 0xa2, 0x2f, 0x59, 0x30, 0x22, 0xbb, 0x8e, 0xc9, 0xb6, 0x30, 0x60, 0xad, 0x66, 0xd7,
-	0x67, 0x4c, 0xb6, 0xb9, 0x21, 0x59, 0x03, 0x60, 0x9d, 0x65, 0x97, 0x2a, 0x9f, 0xef, 0x47, 0x95,
-	0x47, 0x7b, 0x50, 0xdc, 0x73, 0xa3, 0x06, 0xdb, 0x78, 0x4a, 0x43, 0x6c, 0x0a, 0x2e, 0x3c, 0x7e,
-	0xab, 0x29, 0xb9, 0x78, 0xc4, 0xee, 0x4b, 0x06, 0x38, 0xe6, 0x85, 0x66, 0x38, 0x63, 0xe6, 0xd4,
-	0x67, 0x22, 0xab, 0x68, 0x56, 0x60, 0x05, 0x38, 0xc6, 0xa1, 0x43, 0x3c, 0x4a, 0xff, 0x55, 0xc8,
-	0xdb, 0x1d, 0xba, 0x8e, 0x85, 0x87, 0x27, 0x83, 0x79, 0x25, 0x29, 0xf2, 0xc1, 0xba, 0xaf, 0xf1,
-	0xc0, 0x06, 0x47, 0xba, 0x46, 0xf6, 0x1a, 0xc4, 0x13, 0x2e, 0x5e, 0xb5, 0x46, 0xee, 0x37, 0x88,
-	0x87, 0x59, 0x09, 0x7a, 0x8f, 0x1f, 0x2d, 0xb8, 0x8e, 0xcb, 0x7c, 0xbb, 0x99, 0x78, 0x49, 0x63,
-	0xbd, 0xb9, 0x7c, 0x4e, 0x9e, 0x29, 0xf8, 0x7f, 0xac, 0xf1, 0xa3, 0xea, 0xb2, 0xef, 0xdd, 0xde,
-	0x77, 0x23, 0xe1, 0x1b, 0x56, 0x92, 0x6e, 0x9d, 0x41, 0xb1, 0x28, 0xe5, 0x06, 0x5a, 0x3a, 0x09,
-	0xc2, 0xd2, 0xa8, 0x79, 0x04, 0xe5, 0x33, 0x25, 0xc4, 0xb2, 0x1c, 0xfd, 0x6d, 0x0b, 0xf2, 0x0d,
-	0xdf, 0xdf, 0x09, 0x4b, 0x63, 0x6c, 0x72, 0x64, 0xa0, 0xea, 0x09, 0x89, 0x33, 0xbd, 0x48, 0xc9,
-	0xde, 0xf6, 0xa2, 0xe0, 0xa0, 0xfc, 0xb2, 0x54, 0x80, 0x18, 0xec, 0xc1, 0xe1, 0xd4, 0xb9, 0x15,
-	0x77, 0x9b, 0x54, 0x0f, 0xaa, 0x4d, 0xc2, 0x20, 0x5f, 0xfe, 0x9e, 0x06, 0xb9, 0xbd, 0x4b, 0xbc,
-	0x08, 0xf3, 0x56, 0x4d, 0x7e, 0x68, 0x01, 0xc4, 0x84, 0xd0, 0x04, 0xb7, 0xd1, 0x33, 0x21, 0xc6,
-	0xcc, 0xf2, 0x88, 0xc8, 0xf3, 0x00, 0x97, 0xe4, 0x19, 0x9c, 0xf3, 0x8c, 0xa6, 0x89, 0x13, 0xc5,
-	0x27, 0x72, 0xaf, 0x5a, 0xf6, 0xbf, 0xb4, 0x60, 0x84, 0x76, 0x4e, 0x8a, 0xc0, 0x17, 0x60, 0x28,
-	0x72, 0x82, 0xba, 0xb0, 0x32, 0x6a, 0x9f, 0x63, 0x93, 0x41, 0xb1, 0x28, 0x45, 0x1e, 0xe4, 0x23,
-	0x27, 0xdc, 0x91, 0xda, 0xe5, 0x52, 0x66, 0x43, 0x1c, 0x2b, 0x96, 0xf4, 0x5f, 0x88, 0x39, 0x1b,
-	0xf4, 0x22, 0x14, 0xa8, 0x02, 0xb0, 0xe0, 0x84, 0xd2, 0x40, 0x3f, 0x4a, 0x85, 0xf8, 0x82, 0x80,
-	0x61, 0x55, 0x6a, 0xff, 0xb5, 0x1c, 0x0c, 0xce, 0xf3, 0x73, 0xc6, 0x50, 0xe8, 0x77, 0x82, 0x2a,
-	0x11, 0xfa, 0x66, 0x06, 0x73, 0x9a, 0xd2, 0xad, 0x30, 0x9a, 0x9a, 0xa6, 0xcf, 0xfe, 0x63, 0xc1,
-	0x8b, 0x1e, 0x64, 0xcf, 0x45, 0x81, 0xe3, 0x85, 0xdb, 0x7e, 0xd0, 0xe2, 0x06, 0x85, 0x5c, 0x56,
-	0xb3, 0x70, 0xd3, 0xa0, 0x5b, 0x89, 0x48, 0x3b, 0x0e, 0xa5, 0x30, 0xcb, 0x70, 0xa2, 0x0d, 0xf6,
-	0xaf, 0x59, 0x00, 0x71, 0xeb, 0xd1, 0x07, 0x16, 0x8c, 0x39, 0xba, 0x73, 0x56, 0x8c, 0xd1, 0x7a,
-	0x76, 0x86, 0x72, 0x46, 0x96, 0x1f, 0xb1, 0x0d, 0x10, 0x36, 0x19, 0xdb, 0xaf, 0x40, 0x9e, 0xad,
-	0x0e, 0xa6, 0x8b, 0x0b, 0x0b, 0x69, 0xd2, 0x06, 0x23, 0x2d, 0xa7, 0x58, 0x61, 0xd8, 0x6f, 0xc2,
-	0xb9, 0xdb, 0xfb, 0xa4, 0xda, 0x89, 0xfc, 0x80, 0x5b, 0x52, 0xd1, 0x6b, 0x80, 0x42, 0x12, 0xec,
-	0xba, 0x55, 0x32, 0x5b, 0xad, 0xd2, 0x93, 0xf5, 0x5a, 0xac, 0x1b, 0x4c, 0x0a, 0x4a, 0xa8, 0xd2,
-	0x85, 0x81, 0x53, 0x6a, 0xd9, 0xbf, 0x63, 0xc1, 0x88, 0xe6, 0xa9, 0xa3, 0x3b, 0x75, 0x7d, 0xae,
-	0xc2, 0xcf, 0xdd, 0x62, 0xa8, 0x96, 0x33, 0xf1, 0x05, 0x72, 0x92, 0xf1, 0x36, 0xa2, 0x40, 0x38,
-	0x66, 0xf8, 0x10, 0x2f, 0x9e, 0xfd, 0x4f, 0x2c, 0xb8, 0x94, 0xea, 0x56, 0x7c, 0xc2, 0xcd, 0x9e,
-	0x81, 0xe2, 0x0e, 0x39, 0x58, 0x60, 0x73, 0x30, 0xe9, 0x84, 0x5b, 0x96, 0x05, 0x38, 0xc6, 0xb1,
-	0xbf, 0x65, 0x41, 0x4c, 0x89, 0x8a, 0xa2, 0xad, 0xb8, 0xe5, 0x9a, 0x28, 0x12, 0x9c, 0x44, 0x29,
-	0x7a, 0x0f, 0xae, 0x98, 0x5f, 0x90, 0x99, 0xda, 0x4f, 0xee, 0xc6, 0xe0, 0x67, 0xa6, 0x74, 0x4a,
-	0xb8, 0x17, 0x0b, 0xfb, 0x1e, 0xe4, 0xef, 0x38, 0x9d, 0x3a, 0xe9, 0xcb, 0x88, 0x43, 0xc5, 0x58,
-	0x40, 0x9c, 0x66, 0x24, 0xd5, 0x74, 0x21, 0xc6, 0xb0, 0x80, 0x61, 0x55, 0x6a, 0xff, 0x60, 0x10,
-	0x46, 0xb4, 0x08, 0x20, 0xba, 0x8f, 0x07, 0xa4, 0xed, 0x27, 0x75, 0x5d, 0xfa, 0xb1, 0x31, 0x2b,
-	0xa1, 0xeb, 0x27, 0x20, 0xbb, 0x6e, 0xc8, 0x45, 0x8e, 0xb1, 0x7e, 0xb0, 0x80, 0x63, 0x85, 0x81,
-	0xa6, 0x20, 0x5f, 0x23, 0xed, 0xa8, 0xc1, 0xa4, 0xe9, 0x60, 0xb9, 0x48, 0x9b, 0x3a, 0x4f, 0x01,
-	0x98, 0xc3, 0x29, 0xc2, 0x36, 0x89, 0xaa, 0x0d, 0x66, 0x6c, 0x2c, 0x72, 0x84, 0x05, 0x0a, 0xc0,
-	0x1c, 0x9e, 0xe2, 0x98, 0xca, 0x9f, 0xbe, 0x63, 0x6a, 0x28, 0x63, 0xc7, 0x14, 0x6a, 0xc3, 0x85,
-	0x30, 0x6c, 0x6c, 0x04, 0xee, 0xae, 0x13, 0x91, 0x78, 0xe6, 0x0c, 0x9f, 0x84, 0xcf, 0x95, 0xa3,
-	0xc3, 0xa9, 0x0b, 0x95, 0xca, 0x62, 0x92, 0x0a, 0x4e, 0x23, 0x8d, 0x2a, 0x70, 0xc9, 0xf5, 0x42,
-	0x52, 0xed, 0x04, 0x64, 0xa9, 0xee, 0xf9, 0x01, 0x59, 0xf4, 0x43, 0x4a, 0x4e, 0x84, 0xec, 0x29,
-	0x87, 0xf7, 0x52, 0x1a, 0x12, 0x4e, 0xaf, 0x8b, 0xee, 0xc0, 0xf9, 0x9a, 0x1b, 0x3a, 0x5b, 0x4d,
-	0x52, 0xe9, 0x6c, 0xb5, 0x7c, 0x7a, 0x60, 0xe3, 0x51, 0x3e, 0x85, 0xf2, 0xd3, 0xd2, 0x34, 0x31,
-	0x9f, 0x44, 0xc0, 0xdd, 0x75, 0xec, 0xef, 0x5a, 0x30, 0xaa, 0x87, 0x63, 0x50, 0x1d, 0x16, 0x1a,
-	0xf3, 0x0b, 0x15, 0x2e, 0x65, 0xb3, 0xdb, 0x4b, 0x17, 0x15, 0xcd, 0xf8, 0xcc, 0x17, 0xc3, 0xb0,
-	0xc6, 0xb3, 0x8f, 0x10, 0xd4, 0xe7, 0x20, 0xbf, 0xed, 0xd3, 0xad, 0x7e, 0xc0, 0xb4, 0xcc, 0x2e,
-	0x50, 0x20, 0xe6, 0x65, 0xf6, 0xff, 0xb0, 0xe0, 0x72, 0x7a, 0xa4, 0xc9, 0x47, 0xa1, 0x93, 0x37,
-	0x01, 0x68, 0x57, 0x0c, 0x71, 0xa9, 0xc5, 0x11, 0xcb, 0x12, 0xac, 0x61, 0xf5, 0xd7, 0xed, 0x1f,
-	0x52, 0x75, 0x33, 0xe6, 0xf3, 0x55, 0x0b, 0xc6, 0x28, 0xdb, 0xe5, 0x60, 0xcb, 0xe8, 0xed, 0x7a,
-	0x36, 0xbd, 0x55, 0x64, 0x63, 0x03, 0xb4, 0x01, 0xc6, 0x26, 0x73, 0xf4, 0x93, 0x50, 0x74, 0x6a,
-	0xb5, 0x80, 0x84, 0xa1, 0x72, 0xe5, 0x30, 0xf7, 0xe8, 0xac, 0x04, 0xe2, 0xb8, 0x9c, 0x8a, 0xb8,
-	0x46, 0x6d, 0x3b, 0xa4, 0x52, 0x43, 0xd8, 0xdd, 0x94, 0x88, 0xa3, 0x4c, 0x28, 0x1c, 0x2b, 0x0c,
-	0xfb, 0x97, 0x06, 0xc1, 0xe4, 0x8d, 0x6a, 0x30, 0xbe, 0x13, 0x6c, 0xcd, 0x31, 0x17, 0xee, 0xa3,
-	0x38, 0xd3, 0x2f, 0x1c, 0x1d, 0x4e, 0x8d, 0x2f, 0x9b, 0x14, 0x70, 0x92, 0xa4, 0xe0, 0xb2, 0x4c,
-	0x0e, 0x22, 0x67, 0xeb, 0x51, 0x36, 0x22, 0xc9, 0x45, 0xa7, 0x80, 0x93, 0x24, 0xd1, 0x2b, 0x30,
-	0xb2, 0x13, 0x6c, 0x49, 0x01, 0x9a, 0xf4, 0x60, 0x2f, 0xc7, 0x45, 0x58, 0xc7, 0xa3, 0x43, 0xb8,
-	0x13, 0x6c, 0xd1, 0x0d, 0x47, 0x86, 0x64, 0xab, 0x21, 0x5c, 0x16, 0x70, 0xac, 0x30, 0x50, 0x1b,
-	0xd0, 0x8e, 0x1c, 0x3d, 0xe5, 0xb0, 0x16, 0x72, 0xbe, 0x7f, 0x7f, 0x37, 0x0b, 0x5f, 0x59, 0xee,
-	0xa2, 0x83, 0x53, 0x68, 0xa3, 0xcf, 0xc0, 0x95, 0x9d, 0x60, 0x4b, 0x6c, 0xc3, 0x1b, 0x81, 0xeb,
-	0x55, 0xdd, 0xb6, 0x11, 0x7e, 0x3d, 0x25, 0x9a, 0x7b, 0x65, 0x39, 0x1d, 0x0d, 0xf7, 0xaa, 0x6f,
-	0xff, 0x97, 0x1c, 0xb0, 0xb8, 0x56, 0xaa, 0x59, 0xb4, 0x48, 0xd4, 0xf0, 0x6b, 0x49, 0xcd, 0x62,
-	0x95, 0x41, 0xb1, 0x28, 0x95, 0x81, 0x32, 0xb9, 0x1e, 0x81, 0x32, 0x7b, 0x30, 0xdc, 0x20, 0x4e,
-	0x8d, 0x04, 0xd2, 0x10, 0xb6, 0x92, 0x4d, 0x24, 0xee, 0x22, 0x23, 0x1a, 0x1f, 0x70, 0xf9, 0xff,
-	0x10, 0x4b, 0x6e, 0xe8, 0x13, 0x70, 0x8e, 0xea, 0x08, 0x7e, 0x27, 0x92, 0x56, 0xdf, 0x41, 0x66,
-	0xf5, 0x65, 0xfb, 0xdd, 0xa6, 0x51, 0x82, 0x13, 0x98, 0x68, 0x1e, 0x26, 0x84, 0x85, 0x56, 0x19,
-	0xd8, 0xc4, 0xc0, 0xaa, 0xb8, 0xf8, 0x4a, 0xa2, 0x1c, 0x77, 0xd5, 0xa0, 0x12, 0x79, 0xcb, 0xaf,
-	0x71, 0x27, 0x9d, 0x26, 0x91, 0xcb, 0x7e, 0xed, 0x00, 0xb3, 0x12, 0xfb, 0x1b, 0x74, 0x1f, 0xd1,
-	0xc2, 0x8a, 0x1f, 0x16, 0x75, 0x14, 0xc6, 0x83, 0xc9, 0xcf, 0x4b, 0x8b, 0x19, 0x0c, 0xe6, 0x43,
-	0x06, 0xd2, 0xfe, 0x0e, 0x15, 0x8d, 0x6a, 0xc4, 0xfb, 0xb0, 0x27, 0x3e, 0xa7, 0x9f, 0xcc, 0x7b,
-	0x29, 0x79, 0x5f, 0x84, 0x22, 0xfb, 0xb1, 0x10, 0xf8, 0x2d, 0x61, 0xd6, 0xc3, 0x59, 0xce, 0x0c,
-	0x71, 0x02, 0x65, 0x62, 0xf2, 0x9e, 0x64, 0x84, 0x63, 0x9e, 0xb6, 0x0f, 0x13, 0x49, 0x6c, 0xf4,
-	0x59, 0x18, 0x0d, 0xa5, 0xa4, 0x89, 0xc3, 0xf6, 0xfa, 0x94, 0x48, 0xcc, 0xc8, 0x54, 0xd1, 0xaa,
-	0x63, 0x83, 0x98, 0xbd, 0x0e, 0x43, 0x99, 0x0e, 0xa1, 0xfd, 0x4d, 0x0b, 0x8a, 0xcc, 0xcc, 0x5f,
-	0x0f, 0x9c, 0x56, 0x5c, 0x65, 0xe0, 0x98, 0x51, 0x0f, 0x61, 0x98, 0x1f, 0x08, 0xa4, 0x7b, 0x3c,
-	0x83, 0x09, 0xc4, 0x2f, 0x74, 0xc5, 0x13, 0x88, 0x9f, 0x3c, 0x42, 0x2c, 0x39, 0xd9, 0xbf, 0x90,
-	0x83, 0xa1, 0x25, 0xaf, 0xdd, 0xf9, 0x53, 0x7f, 0xa9, 0x68, 0x15, 0x06, 0x97, 0x22, 0xd2, 0x32,
-	0xef, 0xbe, 0x8d, 0x96, 0x9f, 0xd7, 0xef, 0xbd, 0x95, 0xcc, 0x7b, 0x6f, 0xd8, 0xd9, 0x93, 0xd1,
-	0x23, 0xc2, 0x20, 0x15, 0x87, 0x2e, 0xbe, 0x04, 0xc5, 0x15, 0x67, 0x8b, 0x34, 0x97, 0xc9, 0x41,
-	0x48, 0x4f, 0x22, 0xdc, 0x93, 0x69, 0xc5, 0x27, 0x11, 0xc3, 0xeb, 0x38, 0x0d, 0x23, 0x0c, 0x9b,
-	0x31, 0xea, 0x03, 0xff, 0x4f, 0x72, 0x30, 0x66, 0x58, 0xc4, 0x0c, 0x3f, 0x81, 0xf5, 0x50, 0x3f,
-	0x81, 0x61, 0xb7, 0xcf, 0x3d, 0x69, 0xbb, 0xfd, 0xc0, 0xd9, 0xdb, 0xed, 0x6f, 0x02, 0x90, 0xf8,
-	0x52, 0xcf, 0xa0, 0xa9, 0xab, 0x6a, 0x17, 0x7a, 0x34, 0x2c, 0xbb, 0x09, 0x83, 0x2b, 0xae, 0xb7,
-	0xd3, 0x9f, 0x84, 0x08, 0xab, 0x7e, 0xbb, 0x4b, 0x42, 0x54, 0x28, 0x10, 0xf3, 0x32, 0xb9, 0x9d,
-	0x0c, 0xa4, 0x6f, 0x27, 0xf6, 0x97, 0x2d, 0x38, 0xbf, 0x4a, 0x5a, 0xbe, 0xfb, 0x8e, 0x13, 0xc7,
-	0x33, 0xd1, 0x4a, 0x0d, 0x37, 0x12, 0xe1, 0x1b, 0xaa, 0xd2, 0xa2, 0x1b, 0x61, 0x0a, 0x7f, 0x88,
-	0x9d, 0x85, 0x45, 0x5f, 0x53, 0x35, 0x6f, 0x2d, 0xd6, 0xb7, 0xe2, 0x48, 0x25, 0x59, 0x80, 0x63,
-	0x1c, 0xfb, 0xf7, 0x2c, 0x18, 0xe6, 0x8d, 0x20, 0x92, 0xb6, 0xd5, 0x83, 0x76, 0x03, 0xf2, 0xac,
-	0x9e, 0x98, 0x4e, 0x77, 0x32, 0xb0, 0xbf, 0x53, 0x72, 0x7c, 0xf2, 0xb3, 0x9f, 0x98, 0x33, 0x60,
-	0xca, 0x8f, 0xb3, 0x3f, 0xab, 0x42, 0xb9, 0x62, 0xe5, 0x87, 0x41, 0xb1, 0x28, 0xb5, 0xbf, 0x3e,
-	0x00, 0x05, 0xe9, 0xd9, 0xe4, 0xd7, 0x10, 0x3c, 0xcf, 0x8f, 0x1c, 0xee, 0xf8, 0xe3, 0xe2, 0x2d,
-	0x83, 0xe0, 0x1c, 0xc9, 0x61, 0x7a, 0x36, 0xa6, 0xce, 0xed, 0xeb, 0x4a, 0x95, 0xd5, 0x4a, 0xb0,
-	0xde, 0x08, 0xf4, 0x05, 0x18, 0x6a, 0xd2, 0x65, 0x2f, 0xa5, 0xdd, 0xbd, 0x0c, 0x9b, 0xc3, 0xe4,
-	0x89, 0x68, 0x89, 0x1a, 0x21, 0x0e, 0xc4, 0x82, 0xeb, 0xe4, 0xa7, 0x60, 0x22, 0xd9, 0xea, 0x14,
-	0x63, 0xfe, 0x45, 0x63, 0xbf, 0xd3, 0x6c, 0xef, 0x93, 0x7f, 0x56, 0x88, 0xad, 0x93, 0x57, 0xb5,
-	0x5f, 0x87, 0x91, 0x55, 0x12, 0x05, 0x6e, 0x95, 0x11, 0x78, 0xd8, 0xe4, 0xea, 0x6b, 0xcb, 0xfd,
-	0x0a, 0x9b, 0xac, 0x94, 0x66, 0x88, 0xde, 0x03, 0x68, 0x07, 0x3e, 0xd5, 0x82, 0x49, 0x47, 0x7e,
-	0xec, 0x0c, 0x94, 0xdb, 0x0d, 0x45, 0x93, 0xbb, 0x84, 0xe2, 0xff, 0x58, 0xe3, 0x67, 0xdf, 0x80,
-	0xfc, 0x6a, 0x27, 0x22, 0xfb, 0x0f, 0x17, 0x15, 0xf6, 0x67, 0x61, 0x94, 0xa1, 0x2e, 0xfa, 0x4d,
-	0xba, 0xb1, 0xd0, 0x9e, 0xb6, 0xe8, 0xff, 0xa4, 0x11, 0x8e, 0x21, 0x61, 0x5e, 0x46, 0x57, 0x40,
-	0xc3, 0x6f, 0xd6, 0x48, 0x20, 0xc6, 0x43, 0x7d, 0xdf, 0x45, 0x06, 0xc5, 0xa2, 0xd4, 0xfe, 0xd9,
-	0x1c, 0x8c, 0xb0, 0x8a, 0x42, 0x7a, 0x1c, 0xc0, 0x70, 0x83, 0xf3, 0x11, 0x43, 0x92, 0x41, 0x04,
-	0x8b, 0xde, 0x7a, 0x4d, 0x51, 0xe5, 0x00, 0x2c, 0xf9, 0x51, 0xd6, 0x7b, 0x8e, 0x1b, 0x51, 0xd6,
-	0xb9, 0xd3, 0x65, 0x7d, 0x9f, 0xb3, 0xc1, 0x92, 0x9f, 0xfd, 0x6f, 0x2d, 0x80, 0x35, 0xbf, 0x46,
-	0x30, 0x09, 0x3b, 0xcd, 0x08, 0xfd, 0x14, 0xe4, 0xdb, 0x0d, 0x27, 0x4c, 0x1a, 0xd6, 0xf3, 0x1b,
-	0x14, 0xf8, 0xe0, 0x70, 0xaa, 0x48, 0x71, 0xd9, 0x1f, 0xcc, 0x11, 0xf5, 0xe0, 0xd1, 0xdc, 0xf1,
-	0xc1, 0xa3, 0xa8, 0x0d, 0xc3, 0x7e, 0x27, 0xa2, 0xea, 0x94, 0xd8, 0xd5, 0x32, 0xf0, 0x2b, 0xad,
-	0x73, 0x82, 0x3c, 0xe2, 0x52, 0xfc, 0xc1, 0x92, 0x8d, 0xfd, 0x47, 0xe3, 0xbc, 0x77, 0xe2, 0x13,
-	0x4f, 0x42, 0xce, 0x95, 0xa7, 0x42, 0x10, 0xcd, 0xcc, 0x2d, 0xcd, 0xe3, 0x9c, 0x5b, 0x53, 0xb3,
-	0x31, 0xd7, 0x73, 0xe3, 0x7a, 0x05, 0x46, 0x6a, 0x6e, 0xd8, 0x6e, 0x3a, 0x07, 0x6b, 0x29, 0x47,
-	0xf2, 0xf9, 0xb8, 0x08, 0xeb, 0x78, 0xe8, 0x25, 0x11, 0xf0, 0x3b, 0x68, 0x1c, 0xc3, 0x64, 0xc0,
-	0x6f, 0x81, 0x36, 0x4f, 0x8b, 0xf5, 0x7d, 0x15, 0x46, 0xe5, 0x56, 0xcc, 0xb8, 0xf0, 0x23, 0x98,
-	0x8a, 0xb1, 0xdc, 0xd4, 0xca, 0xb0, 0x81, 0xd9, 0xa5, 0x38, 0x0c, 0x9d, 0xbd, 0xe2, 0xf0, 0x49,
-	0x18, 0x93, 0x7f, 0xd9, 0x6e, 0x5e, 0xba, 0xc8, 0x5a, 0xaf, 0x4c, 0x45, 0x9b, 0x7a, 0x21, 0x36,
-	0x71, 0xe3, 0xa9, 0x37, 0xdc, 0xef, 0xd4, 0xbb, 0x09, 0xb0, 0xe5, 0x77, 0xbc, 0x9a, 0x13, 0x1c,
-	0x2c, 0xcd, 0x8b, 0xf0, 0x20, 0xa5, 0xa7, 0x94, 0x55, 0x09, 0xd6, 0xb0, 0xf4, 0xe9, 0x5a, 0x7c,
-	0xc8, 0x74, 0xfd, 0x2c, 0x14, 0x59, 0x28, 0x15, 0xa9, 0xcd, 0x46, 0xc2, 0x71, 0x7e, 0x92, 0xa8,
-	0x1b, 0xa5, 0x3c, 0x54, 0x24, 0x11, 0x1c, 0xd3, 0x43, 0x9f, 0x03, 0xd8, 0x76, 0x3d, 0x37, 0x6c,
-	0x30, 0xea, 0x23, 0x27, 0xa6, 0xae, 0xfa, 0xb9, 0xa0, 0xa8, 0x60, 0x8d, 0x22, 0x7a, 0x13, 0xce,
-	0x93, 0x30, 0x72, 0x5b, 0x4e, 0x44, 0x6a, 0xea, 0x1e, 0x44, 0x89, 0xd9, 0x11, 0x54, 0x30, 0xdb,
-	0xed, 0x24, 0xc2, 0x83, 0x34, 0x20, 0xee, 0x26, 0x84, 0x5e, 0x85, 0x42, 0x3b, 0xf0, 0xeb, 0x54,
-	0xf9, 0x2b, 0x4d, 0xb2, 0x61, 0xbc, 0x2a, 0x15, 0xea, 0x0d, 0x01, 0x7f, 0xa0, 0xfd, 0xc6, 0x0a,
-	0x1b, 0xfd, 0xc8, 0x82, 0xf3, 0x01, 0xe1, 0xde, 0xd4, 0x50, 0x35, 0xec, 0x12, 0x93, 0x7a, 0xd5,
-	0x2c, 0x12, 0x52, 0xc8, 0xc5, 0x3e, 0x8d, 0x93, 0x5c, 0xf8, 0x76, 0x4f, 0x64, 0xef, 0xbb, 0xca,
-	0x1f, 0xa4, 0x01, 0xbf, 0xfc, 0xbd, 0xa9, 0xa9, 0xee, 0xec, 0x28, 0x8a, 0x38, 0x5d, 0x79, 0x7f,
-	0xe9, 0x7b, 0x53, 0x13, 0xf2, 0x7f, 0x3c, 0x68, 0x5d, 0x9d, 0xa4, 0xbb, 0x57, 0xdb, 0xaf, 0x2d,
-	0x6d, 0x88, 0x08, 0x07, 0xb5, 0x7b, 0x6d, 0x50, 0x20, 0xe6, 0x65, 0xe8, 0x45, 0x28, 0xd4, 0x1c,
-	0xd2, 0xf2, 0x3d, 0x52, 0x2b, 0x8d, 0xc5, 0x2e, 0xa4, 0x79, 0x01, 0xc3, 0xaa, 0x14, 0x35, 0x61,
-	0xc8, 0x65, 0x67, 0x53, 0x11, 0xce, 0x94, 0xc1, 0x81, 0x98, 0x9f, 0x75, 0x65, 0x30, 0x13, 0x13,
-	0xa5, 0x82, 0x87, 0x2e, 0xbb, 0xc7, 0xcf, 0x44, 0x76, 0xd3, 0x91, 0xa8, 0x36, 0xdc, 0x66, 0x2d,
-	0x20, 0x5e, 0x69, 0x82, 0x1d, 0xf5, 0xd8, 0x48, 0xcc, 0x09, 0x18, 0x56, 0xa5, 0xe8, 0xcf, 0xc0,
-	0x98, 0xdf, 0x89, 0xd8, 0x22, 0xa7, 0xdf, 0x3f, 0x2c, 0x9d, 0x67, 0xe8, 0xcc, 0x39, 0xbd, 0xae,
-	0x17, 0x60, 0x13, 0x8f, 0x0a, 0xdb, 0x86, 0x1f, 0x46, 0xf4, 0x0f, 0x13, 0xb6, 0x97, 0x4d, 0x61,
-	0xbb, 0xa8, 0x95, 0x61, 0x03, 0x13, 0x7d, 0xcd, 0x82, 0xf3, 0xad, 0xe4, 0x01, 0xa4, 0x74, 0x85,
-	0x8d, 0x4c, 0x25, 0x0b, 0x45, 0x35, 0x41, 0x9a, 0xc7, 0xf0, 0x75, 0x81, 0x71, 0x77, 0x23, 0xd8,
-	0x5d, 0xce, 0xf0, 0xc0, 0xab, 0x36, 0x02, 0xdf, 0x33, 0x9b, 0xf7, 0x74, 0x56, 0x31, 0xf7, 0x6c,
-	0x95, 0xa5, 0xb1, 0x28, 0x3f, 0x7d, 0x74, 0x38, 0x75, 0x29, 0xb5, 0x08, 0xa7, 0x37, 0x6a, 0x72,
-	0x1e, 0x2e, 0xa7, 0xaf, 0xd4, 0x87, 0x69, 0xcc, 0x03, 0xba, 0xc6, 0xbc, 0x00, 0x4f, 0xf7, 0x6c,
-	0x14, 0x95, 0xf9, 0x52, 0xbd, 0xb2, 0x4c, 0x99, 0xdf, 0xa5, 0x0e, 0x9d, 0x83, 0x51, 0x3d, 0xa7,
-	0x0d, 0x8b, 0x14, 0xd0, 0xee, 0x11, 0xa3, 0xf7, 0xa0, 0xe8, 0x57, 0x32, 0x77, 0xb9, 0xaf, 0x57,
-	0xba, 0x5c, 0xee, 0x0a, 0x84, 0x63, 0x86, 0xfd, 0x44, 0x0a, 0xa4, 0x5e, 0x7a, 0x7e, 0xc2, 0xcd,
-	0x3e, 0x71, 0xa4, 0xc0, 0xbf, 0x19, 0x84, 0x98, 0x12, 0x7a, 0x09, 0x0a, 0xc4, 0xab, 0xb5, 0x7d,
-	0xd7, 0x8b, 0x92, 0xd6, 0x9b, 0xdb, 0x02, 0x8e, 0x15, 0x86, 0x16, 0x57, 0x90, 0x3b, 0x36, 0xae,
-	0xa0, 0x06, 0xe3, 0x0e, 0x33, 0x7b, 0xc7, 0x5e, 0xe1, 0x81, 0x13, 0xbb, 0x71, 0x66, 0x4d, 0x0a,
-	0x38, 0x49, 0x92, 0x72, 0x09, 0xe3, 0xaa, 0x8c, 0xcb, 0xe0, 0x89, 0xb9, 0x54, 0x4c, 0x0a, 0x38,
-	0x49, 0x12, 0xbd, 0x09, 0xa5, 0x2a, 0xbb, 0x3c, 0xc2, 0xfb, 0xb8, 0xb4, 0xbd, 0xe6, 0x47, 0x1b,
-	0x01, 0x09, 0x89, 0xc7, 0xbd, 0xf6, 0x85, 0xf2, 0x75, 0x31, 0x0a, 0xa5, 0xb9, 0x1e, 0x78, 0xb8,
-	0x27, 0x05, 0xaa, 0xd5, 0x31, 0x9f, 0xb4, 0x1b, 0x1d, 0x6c, 0xfa, 0x3b, 0x44, 0x3a, 0x14, 0x94,
-	0x56, 0x57, 0xd1, 0x0b, 0xb1, 0x89, 0x8b, 0x7e, 0xd1, 0x82, 0xb1, 0xa6, 0x34, 0xc6, 0xe1, 0x4e,
-	0x53, 0x66, 0xcd, 0xc1, 0x99, 0x4c, 0xbf, 0x15, 0x9d, 0x32, 0x17, 0xf8, 0x06, 0x08, 0x9b, 0xbc,
-	0xed, 0xef, 0x58, 0x30, 0x91, 0xac, 0x86, 0x76, 0xe0, 0xd9, 0x96, 0x13, 0xec, 0x2c, 0x79, 0xdb,
-	0x01, 0x0b, 0xab, 0x8c, 0xf8, 0x57, 0x9d, 0xdd, 0x8e, 0x48, 0x30, 0xef, 0x1c, 0xf0, 0xe0, 0xa9,
-	0xbc, 0x4a, 0xf4, 0xf5, 0xec, 0xea, 0x71, 0xc8, 0xf8, 0x78, 0x5a, 0xa8, 0x02, 0x97, 0x28, 0xc2,
-	0x3c, 0x69, 0x12, 0x2a, 0xa1, 0x62, 0x26, 0x39, 0xc6, 0x44, 0x85, 0x07, 0xac, 0xa6, 0x21, 0xe1,
-	0xf4, 0xba, 0x76, 0x01, 0x86, 0x78, 0x48, 0xb9, 0xfd, 0xaf, 0x73, 0x20, 0x77, 0xd2, 0x3f, 0xdd,
-	0x26, 0x6b, 0x64, 0xc3, 0x50, 0xc0, 0xce, 0xb4, 0xe2, 0xa0, 0xc6, 0x94, 0x1a, 0x7e, 0xca, 0xc5,
-	0xa2, 0x84, 0xaa, 0x18, 0x64, 0xdf, 0x8d, 0xe6, 0xfc, 0x9a, 0x3c, 0x9e, 0x31, 0x15, 0xe3, 0xb6,
-	0x80, 0x61, 0x55, 0x6a, 0xff, 0x9c, 0x05, 0x63, 0xb4, 0x97, 0xcd, 0x26, 0x69, 0x56, 0x22, 0xd2,
-	0x0e, 0x51, 0x08, 0xf9, 0x90, 0xfe, 0xc8, 0xce, 0x58, 0x10, 0xdf, 0x24, 0x20, 0x6d, 0xcd, 0x2c,
-	0x4a, 0x99, 0x60, 0xce, 0xcb, 0xfe, 0xed, 0x01, 0x28, 0xaa, 0xc1, 0xee, 0xc3, 0xd6, 0x7a, 0x33,
-	0xce, 0x7b, 0xc0, 0xa5, 0x61, 0x49, 0xcb, 0x79, 0x40, 0xcf, 0x54, 0xb3, 0xde, 0x01, 0xbf, 0xb2,
-	0x18, 0x27, 0x40, 0x78, 0xc9, 0x74, 0xc7, 0x5c, 0xd6, 0x6d, 0xfc, 0x1a, 0xbe, 0xf0, 0xcb, 0xec,
-	0xeb, 0xde, 0xb0, 0xc1, 0xac, 0x76, 0x16, 0xe5, 0xf7, 0xea, 0xed, 0x06, 0x4b, 0x24, 0xef, 0xca,
-	0xf7, 0x95, 0xbc, 0xeb, 0x06, 0x0c, 0x12, 0xaf, 0xd3, 0x62, 0x61, 0xe5, 0x45, 0xa6, 0x53, 0x0d,
-	0xde, 0xf6, 0x3a, 0x2d, 0xb3, 0x67, 0x0c, 0x05, 0x7d, 0x0a, 0x46, 0x6a, 0x24, 0xac, 0x06, 0x2e,
-	0xbb, 0x87, 0x27, 0x0e, 0xa5, 0x57, 0xd9, 0x49, 0x3f, 0x06, 0x9b, 0x15, 0xf5, 0x0a, 0xf6, 0x3b,
-	0x30, 0xb4, 0xd1, 0xec, 0xd4, 0x5d, 0x0f, 0xb5, 0x61, 0x88, 0xdf, 0xca, 0x13, 0x3b, 0x6f, 0x06,
-	0x8a, 0x3a, 0x5f, 0xed, 0x5a, 0x34, 0x35, 0xbf, 0x50, 0x22, 0xf8, 0xd8, 0xff, 0xd0, 0x02, 0x7a,
-	0xaa, 0xb8, 0x33, 0x87, 0xfe, 0x1c, 0x14, 0x42, 0x79, 0xe5, 0x92, 0x4f, 0x93, 0x1f, 0x53, 0x51,
-	0x97, 0x02, 0xfe, 0xe0, 0x70, 0x6a, 0x8c, 0x21, 0xab, 0x5b, 0x92, 0xaa, 0x0a, 0x6a, 0xc2, 0x18,
-	0xb3, 0x86, 0xca, 0xfd, 0x48, 0xd8, 0xaf, 0x6f, 0xf5, 0x79, 0x91, 0x4d, 0xaf, 0x2a, 0xa4, 0xb3,
-	0x0e, 0xc2, 0x26, 0x71, 0xfb, 0x1f, 0x0d, 0x82, 0x66, 0x34, 0xec, 0x63, 0x7a, 0xbf, 0x9d, 0x30,
-	0x11, 0xaf, 0x66, 0x62, 0x22, 0x96, 0x76, 0x57, 0x2e, 0x32, 0x4c, 0xab, 0x30, 0x6d, 0x54, 0x83,
-	0x34, 0xdb, 0x62, 0x71, 0xa8, 0x46, 0x2d, 0x92, 0x66, 0x1b, 0xb3, 0x12, 0x15, 0x92, 0x3f, 0xd8,
-	0x33, 0x24, 0xbf, 0x01, 0xf9, 0xba, 0xd3, 0xa9, 0x13, 0x11, 0x69, 0x91, 0x81, 0x37, 0x80, 0xc5,
-	0x28, 0x72, 0x6f, 0x00, 0xfb, 0x89, 0x39, 0x03, 0xba, 0x3a, 0x1b, 0xd2, 0xcf, 0x2a, 0x0c, 0x42,
-	0x19, 0xac, 0x4e, 0xe5, 0xba, 0xe5, 0xab, 0x53, 0xfd, 0xc5, 0x31, 0x33, 0x7a, 0x5e, 0xac, 0xf2,
-	0xfb, 0xaf, 0x62, 0xc3, 0x5f, 0xca, 0xe2, 0xce, 0x01, 0x23, 0xc8, 0xcf, 0x8b, 0xe2, 0x0f, 0x96,
-	0x6c, 0xec, 0x19, 0x18, 0xd1, 0x52, 0x70, 0xd1, 0xcf, 0xa0, 0xae, 0x5e, 0x6a, 0x9f, 0x61, 0xde,
-	0x89, 0x1c, 0xcc, 0x4a, 0xec, 0xbf, 0x39, 0x00, 0xea, 0xdc, 0xae, 0x47, 0xc8, 0x3b, 0x55, 0xed,
-	0xa2, 0xb8, 0x71, 0x35, 0xcb, 0xf7, 0xb0, 0x28, 0xa5, 0x4a, 0x51, 0x8b, 0x04, 0x75, 0x75, 0x52,
-	0x10, 0xf2, 0x55, 0x29, 0x45, 0xab, 0x7a, 0x21, 0x36, 0x71, 0xa9, 0x46, 0xdb, 0x72, 0x3c, 0x77,
-	0x9b, 0x84, 0x51, 0x32, 0xd0, 0x69, 0x55, 0xc0, 0xb1, 0xc2, 0x40, 0x77, 0xe0, 0x7c, 0x48, 0xa2,
-	0xf5, 0x3d, 0x8f, 0x04, 0xea, 0xca, 0x98, 0xb8, 0x43, 0xa8, 0x82, 0xff, 0x2a, 0x49, 0x04, 0xdc,
-	0x5d, 0x27, 0x35, 0x38, 0x24, 0x7f, 0xe2, 0xe0, 0x90, 0x79, 0x98, 0xd8, 0x76, 0xdc, 0x66, 0x27,
-	0x20, 0x3d, 0x43, 0x4c, 0x16, 0x12, 0xe5, 0xb8, 0xab, 0x06, 0x8b, 0x3f, 0x6d, 0x3a, 0xf5, 0xb0,
-	0x34, 0xac, 0xc5, 0x9f, 0x52, 0x00, 0xe6, 0x70, 0xfb, 0xef, 0x59, 0xc0, 0x2f, 0x6f, 0xcf, 0x6e,
-	0x6f, 0xbb, 0x9e, 0x1b, 0x1d, 0xa0, 0x5f, 0xb7, 0x60, 0xc2, 0xf3, 0x6b, 0x64, 0xd6, 0x8b, 0x5c,
-	0x09, 0xcc, 0x2e, 0x3f, 0x11, 0xe3, 0xb5, 0x96, 0x20, 0xcf, 0x6f, 0x02, 0x26, 0xa1, 0xb8, 0xab,
-	0x19, 0xf6, 0x15, 0xb8, 0x94, 0x4a, 0xc0, 0xfe, 0xce, 0x00, 0x98, 0x77, 0xd0, 0xd1, 0xeb, 0x90,
-	0x6f, 0xb2, 0x5b, 0x91, 0xd6, 0x23, 0x26, 0x17, 0x60, 0x63, 0xc5, 0xaf, 0x4d, 0x72, 0x4a, 0x68,
-	0x1e, 0x46, 0xd8, 0xc5, 0x76, 0x71, 0x67, 0x95, 0x4f, 0x45, 0x3b, 0x4e, 0xe0, 0xa8, 0x8a, 0x1e,
-	0x98, 0x7f, 0xb1, 0x5e, 0x0d, 0xbd, 0x0b, 0xc3, 0x5b, 0x3c, 0x87, 0x4b, 0x76, 0xe6, 0x79, 0x91,
-	0x14, 0x86, 0x69, 0x11, 0x32, 0x43, 0xcc, 0x83, 0xf8, 0x27, 0x96, 0x1c, 0xd1, 0x01, 0x14, 0x1c,
-	0xf9, 0x4d, 0x07, 0xb3, 0x8a, 0x58, 0x34, 0xe6, 0x0f, 0xd7, 0xed, 0xd4, 0x37, 0x54, 0xec, 0x12,
-	0xee, 0xee, 0x7c, 0x5f, 0xee, 0xee, 0x6f, 0x5a, 0x00, 0x71, 0x76, 0x37, 0xb4, 0x0f, 0x85, 0xf0,
-	0x96, 0x71, 0xbc, 0xce, 0xe2, 0x12, 0x98, 0xa0, 0xa8, 0x5d, 0x94, 0x10, 0x10, 0xac, 0xb8, 0x3d,
-	0xcc, 0x24, 0xf0, 0x27, 0x16, 0x5c, 0x4c, 0xcb, 0x42, 0xf7, 0x04, 0x5b, 0x7c, 0x52, 0x6b, 0x80,
-	0xa8, 0xb0, 0x11, 0x90, 0x6d, 0x77, 0x3f, 0xe9, 0x98, 0x5f, 0x96, 0x05, 0x38, 0xc6, 0xb1, 0xbf,
-	0x35, 0x04, 0x8a, 0xf1, 0x29, 0x59, 0x0f, 0x5e, 0xa0, 0xa7, 0x8b, 0x7a, 0x9c, 0x5b, 0x48, 0xe1,
-	0x61, 0x06, 0xc5, 0xa2, 0x94, 0x9e, 0x30, 0x64, 0x44, 0xb7, 0x10, 0xd9, 0x6c, 0x16, 0xca, 0xe0,
-	0x6f, 0xac, 0x4a, 0xd3, 0xec, 0x11, 0xf9, 0x33, 0xb1, 0x47, 0x0c, 0x65, 0x6f, 0x8f, 0xb8, 0x01,
-	0xc3, 0x81, 0xdf, 0x24, 0xb3, 0x78, 0x4d, 0xe8, 0xcd, 0x71, 0x4e, 0x2c, 0x0e, 0xc6, 0xb2, 0x1c,
-	0xbd, 0x02, 0x23, 0x9d, 0x90, 0x54, 0xe6, 0x97, 0xe7, 0x02, 0x52, 0x0b, 0x45, 0x90, 0xbc, 0x72,
-	0xaa, 0xdd, 0x8d, 0x8b, 0xb0, 0x8e, 0x87, 0xbe, 0x65, 0x1d, 0x63, 0xf2, 0x28, 0x66, 0xb5, 0x27,
-	0xa4, 0x66, 0xe4, 0x60, 0x87, 0x80, 0x47, 0xb1, 0xa3, 0x7c, 0xdd, 0x82, 0xf3, 0xc4, 0xab, 0x06,
-	0x07, 0x8c, 0x8e, 0xa0, 0x26, 0x1c, 0x4b, 0x77, 0xb3, 0x58, 0x7c, 0xb7, 0x93, 0xc4, 0xb9, 0xd5,
-	0xb8, 0x0b, 0x8c, 0xbb, 0x9b, 0x61, 0xff, 0x51, 0x0e, 0x2e, 0xa4, 0x50, 0x60, 0x01, 0xc5, 0x2d,
-	0x3a, 0x81, 0x96, 0x6a, 0xc9, 0xe5, 0xb3, 0x2c, 0xe0, 0x58, 0x61, 0xa0, 0x0d, 0xb8, 0xb8, 0xd3,
-	0x0a, 0x63, 0x2a, 0x73, 0xbe, 0x17, 0x91, 0x7d, 0xb9, 0x98, 0xa4, 0x8f, 0xe8, 0xe2, 0x72, 0x0a,
-	0x0e, 0x4e, 0xad, 0x49, 0xb5, 0x0d, 0xe2, 0x39, 0x5b, 0x4d, 0x12, 0x17, 0x89, 0x70, 0x78, 0xa5,
-	0x6d, 0xdc, 0x4e, 0x94, 0xe3, 0xae, 0x1a, 0xe8, 0x03, 0x0b, 0x9e, 0x09, 0x49, 0xb0, 0x4b, 0x82,
-	0x8a, 0x5b, 0x23, 0x73, 0x9d, 0x30, 0xf2, 0x5b, 0x24, 0x78, 0x44, 0x9b, 0xdc, 0xd4, 0xd1, 0xe1,
-	0xd4, 0x33, 0x95, 0xde, 0xd4, 0xf0, 0x71, 0xac, 0xec, 0x0f, 0x2c, 0x38, 0x57, 0x61, 0xa7, 0x44,
-	0xa5, 0x73, 0x66, 0x9d, 0x42, 0xe9, 0x05, 0x75, 0x35, 0x32, 0x21, 0xc4, 0xcc, 0xcb, 0x8c, 0xf6,
-	0x5b, 0x30, 0x51, 0x21, 0x2d, 0xa7, 0xdd, 0x60, 0x37, 0x4d, 0x78, 0x28, 0xc2, 0x0c, 0x14, 0x43,
-	0x09, 0x4b, 0xe6, 0xa0, 0x54, 0xc8, 0x38, 0xc6, 0x41, 0xcf, 0xf3, 0xb0, 0x09, 0x19, 0xd9, 0x5b,
-	0xe4, 0xda, 0x39, 0x8f, 0xb5, 0x08, 0xb1, 0x2c, 0xb3, 0xf7, 0x60, 0x34, 0xae, 0x4e, 0xb6, 0x51,
-	0x1d, 0xc6, 0xab, 0x5a, 0x30, 0x79, 0x1c, 0xb3, 0xda, 0x7f, 0xdc, 0x39, 0x93, 0x45, 0x73, 0x26,
-	0x11, 0x9c, 0xa4, 0x6a, 0xff, 0x4a, 0x0e, 0xc6, 0x15, 0x67, 0xe1, 0x10, 0x78, 0x3f, 0x19, 0xea,
-	0x81, 0xb3, 0xb8, 0xb2, 0x6d, 0x8e, 0xe4, 0x31, 0xe1, 0x1e, 0xef, 0x27, 0xc3, 0x3d, 0x4e, 0x95,
-	0x7d, 0x97, 0x8f, 0xe3, 0x9b, 0x39, 0x28, 0xa8, 0x0b, 0xe4, 0xaf, 0x43, 0x9e, 0x1d, 0xa0, 0x1e,
-	0x4f, 0x1b, 0x65, 0x87, 0x31, 0xcc, 0x29, 0x51, 0x92, 0xcc, 0xcf, 0xfd, 0xc8, 0xd9, 0xb3, 0x8a,
-	0xdc, 0xee, 0xe5, 0x04, 0x11, 0xe6, 0x94, 0xd0, 0x32, 0x0c, 0x10, 0xaf, 0x26, 0xd4, 0xd2, 0x93,
-	0x13, 0x64, 0xf9, 0x57, 0x6f, 0x7b, 0x35, 0x4c, 0xa9, 0xb0, 0x14, 0x4e, 0x5c, 0xfb, 0x18, 0x34,
-	0x97, 0x87, 0x50, 0x3d, 0x44, 0xa9, 0xfd, 0x8b, 0x03, 0x30, 0x54, 0xe9, 0x6c, 0x51, 0x05, 0xfb,
-	0x37, 0x2d, 0xb8, 0xb0, 0x97, 0xc8, 0xf6, 0x16, 0x4f, 0xd9, 0xbb, 0xd9, 0xa7, 0xd2, 0xc3, 0x64,
-	0xbb, 0xfc, 0x8c, 0x68, 0xd7, 0x85, 0x94, 0x42, 0x9c, 0xd6, 0x1c, 0x23, 0xbb, 0xd3, 0xc0, 0x29,
-	0xe5, 0x10, 0x3c, 0xdd, 0xd8, 0xd8, 0xb1, 0x5e, 0x71, 0xb1, 0xf6, 0x8f, 0xf2, 0x00, 0xfc, 0x6b,
-	0xac, 0xb7, 0xa3, 0x7e, 0x8c, 0x43, 0xaf, 0xc2, 0xa8, 0x7c, 0xc4, 0x63, 0x2d, 0x0e, 0xec, 0x51,
-	0xce, 0xdd, 0x3b, 0x5a, 0x19, 0x36, 0x30, 0xd9, 0x81, 0xc0, 0x8b, 0x82, 0x03, 0xae, 0x34, 0x26,
-	0xe3, 0x5f, 0x55, 0x09, 0xd6, 0xb0, 0xd0, 0xb4, 0x61, 0x6c, 0xe7, 0x99, 0x2e, 0xce, 0x1d, 0x63,
-	0x1b, 0xff, 0x24, 0x8c, 0xa9, 0x7f, 0x0b, 0x6e, 0x93, 0x24, 0x9d, 0x2a, 0x1b, 0x7a, 0x21, 0x36,
-	0x71, 0xd1, 0xa7, 0xe0, 0x9c, 0x79, 0x61, 0x55, 0xa8, 0x59, 0xea, 0xba, 0xb8, 0x79, 0xcf, 0x15,
-	0x27, 0xb0, 0xe9, 0x0a, 0xa8, 0x05, 0x07, 0xb8, 0xe3, 0x09, 0x7d, 0x4b, 0xad, 0x80, 0x79, 0x06,
-	0xc5, 0xa2, 0x94, 0x0e, 0x21, 0xdf, 0xca, 0x38, 0x5c, 0xdc, 0x38, 0x54, 0x43, 0x58, 0xd1, 0xca,
-	0xb0, 0x81, 0x49, 0x39, 0x08, 0xcb, 0x1c, 0x98, 0x6b, 0x2c, 0x61, 0x4e, 0x6b, 0xc3, 0x39, 0xdf,
-	0x34, 0x6c, 0xf0, 0x50, 0x98, 0x8f, 0xf7, 0x39, 0x6f, 0x8d, 0xba, 0xfc, 0x86, 0x4c, 0xc2, 0x0e,
-	0x92, 0xa0, 0x4f, 0x15, 0x4e, 0x3d, 0xd4, 0x75, 0xd4, 0x8c, 0xe2, 0xea, 0x19, 0x8d, 0xba, 0x01,
-	0x17, 0xdb, 0x7e, 0x6d, 0x23, 0x70, 0xfd, 0xc0, 0x8d, 0x0e, 0xe6, 0x9a, 0x4e, 0x18, 0xb2, 0x59,
-	0x35, 0x66, 0x6a, 0x36, 0x1b, 0x29, 0x38, 0x38, 0xb5, 0x26, 0x3d, 0x1a, 0xb4, 0x05, 0x90, 0x45,
-	0x70, 0xe4, 0xf9, 0xd1, 0x40, 0x22, 0x62, 0x55, 0x6a, 0x5f, 0x80, 0xf3, 0x95, 0x4e, 0xbb, 0xdd,
-	0x74, 0x49, 0x4d, 0x59, 0xc2, 0xed, 0x9f, 0x86, 0x71, 0x91, 0x38, 0x4a, 0xe9, 0x11, 0x27, 0x4a,
-	0x73, 0x68, 0xff, 0xc8, 0x82, 0xf1, 0x84, 0xbf, 0x1c, 0xbd, 0x9b, 0xdc, 0xfd, 0x33, 0x71, 0x6c,
-	0xe8, 0x1b, 0x3f, 0x5f, 0xe1, 0xa9, 0x9a, 0x44, 0x43, 0x46, 0x77, 0x66, 0x16, 0x24, 0xcd, 0x62,
-	0x20, 0xf9, 0x76, 0xa2, 0x87, 0x88, 0xda, 0x5f, 0xc9, 0x41, 0x7a, 0x90, 0x02, 0xfa, 0x42, 0xf7,
-	0x00, 0xbc, 0x9e, 0xe1, 0x00, 0x88, 0x28, 0x89, 0xde, 0x63, 0xe0, 0x99, 0x63, 0xb0, 0x9a, 0xd1,
-	0x18, 0x08, 0xbe, 0xdd, 0x23, 0xf1, 0x3f, 0x2d, 0x18, 0xd9, 0xdc, 0x5c, 0x51, 0xc6, 0x29, 0x0c,
-	0x97, 0x43, 0x7e, 0x95, 0x8c, 0x79, 0x17, 0xe7, 0xfc, 0x56, 0x9b, 0x3b, 0x1b, 0x85, 0x13, 0x94,
-	0xe5, 0xf0, 0xaa, 0xa4, 0x62, 0xe0, 0x1e, 0x35, 0xd1, 0x12, 0x5c, 0xd0, 0x4b, 0x84, 0x89, 0x51,
-	0x38, 0x3c, 0xf9, 0xe5, 0xea, 0xee, 0x62, 0x9c, 0x56, 0x27, 0x49, 0x4a, 0xd8, 0x19, 0xc5, 0xd3,
-	0x34, 0x5d, 0xa4, 0x44, 0x31, 0x4e, 0xab, 0x63, 0xaf, 0xc3, 0x88, 0xf6, 0x50, 0x12, 0xfa, 0x34,
-	0x4c, 0x54, 0xfd, 0x96, 0xb4, 0xef, 0xac, 0x90, 0x5d, 0xd2, 0x14, 0x5d, 0x66, 0x26, 0xc0, 0xb9,
-	0x44, 0x19, 0xee, 0xc2, 0xb6, 0xff, 0xfb, 0x35, 0x50, 0xb7, 0x49, 0xfa, 0xd8, 0x9e, 0xda, 0x2a,
-	0x7c, 0x2b, 0x9f, 0x71, 0xf8, 0x96, 0x92, 0xb5, 0x89, 0x10, 0xae, 0x28, 0x0e, 0xe1, 0x1a, 0xca,
-	0x3a, 0x84, 0x4b, 0x69, 0x9b, 0x5d, 0x61, 0x5c, 0xbf, 0x6a, 0xc1, 0xa8, 0xe7, 0xd7, 0x88, 0x72,
-	0x21, 0x0d, 0x33, 0x95, 0xf7, 0xcd, 0xec, 0xe2, 0x52, 0x79, 0x38, 0x92, 0x20, 0xcf, 0x83, 0xfc,
-	0xd4, 0x16, 0xa5, 0x17, 0x61, 0xa3, 0x1d, 0x68, 0x41, 0xb3, 0x38, 0xf2, 0xc4, 0x4d, 0x57, 0xd3,
-	0x8e, 0x1e, 0x0f, 0x35, 0x1f, 0xee, 0x6b, 0x4a, 0x57, 0x31, 0x2b, 0x4b, 0x9a, 0xbc, 0xa9, 0xa0,
-	0x39, 0x06, 0x64, 0x1a, 0xba, 0x58, 0x19, 0xb3, 0x61, 0x88, 0x47, 0x03, 0x8a, 0x27, 0x7b, 0x98,
-	0xbf, 0x8a, 0x47, 0x0a, 0x62, 0x51, 0x82, 0x22, 0xe9, 0xa6, 0x1e, 0xc9, 0x2a, 0xa9, 0xac, 0xe1,
-	0x06, 0x4f, 0xf7, 0x53, 0xa3, 0xd7, 0xf4, 0x13, 0xed, 0x68, 0x3f, 0x27, 0xda, 0xb1, 0x9e, 0xa7,
-	0xd9, 0xaf, 0x5a, 0x30, 0x5a, 0xd5, 0x92, 0xbc, 0x96, 0x5e, 0xcc, 0x2a, 0x7f, 0x75, 0x5a, 0x2e,
-	0x5e, 0x7e, 0x19, 0xd2, 0x48, 0x2a, 0x6b, 0x70, 0x67, 0x79, 0x87, 0xd8, 0xf1, 0x9d, 0x6d, 0xfd,
-	0x23, 0x37, 0x37, 0x32, 0xd8, 0x1e, 0x0c, 0x73, 0x00, 0xff, 0x8c, 0x1c, 0x86, 0x05, 0x2f, 0xf4,
-	0x1e, 0x14, 0x64, 0x40, 0xa9, 0x08, 0xf7, 0xc4, 0x59, 0x98, 0xc7, 0x4d, 0xe7, 0x97, 0xcc, 0x56,
-	0xc2, 0xa1, 0x58, 0x71, 0x44, 0x0d, 0x18, 0xa8, 0x39, 0x75, 0x11, 0xf8, 0xb9, 0x9a, 0x4d, 0x32,
-	0x28, 0xc9, 0x93, 0x9d, 0xcd, 0xe6, 0x67, 0xef, 0x60, 0xca, 0x02, 0xed, 0xc7, 0x59, 0x32, 0x27,
-	0x32, 0xdb, 0x7d, 0x4d, 0x35, 0x89, 0x1b, 0x28, 0xba, 0x92, 0x6e, 0xd6, 0x84, 0xbf, 0xf0, 0xff,
-	0x63, 0x6c, 0x17, 0xb2, 0xc9, 0x26, 0xc5, 0xdf, 0x38, 0x89, 0x7d, 0x8e, 0x94, 0x0b, 0x7b, 0xdb,
-	0xe9, 0x27, 0xb2, 0xe2, 0xb2, 0xb8, 0xb9, 0xb9, 0xd1, 0xf5, 0xa6, 0x53, 0x13, 0x86, 0xda, 0x2c,
-	0xf6, 0xa0, 0xf4, 0x93, 0x59, 0xed, 0x2d, 0x3c, 0x96, 0x81, 0xcf, 0x4d, 0xfe, 0x1b, 0x0b, 0x1e,
-	0xe8, 0x36, 0x0c, 0xf3, 0x64, 0xcf, 0x3c, 0xf0, 0x76, 0xe4, 0xe6, 0x64, 0xef, 0x94, 0xd1, 0xf1,
-	0x46, 0xc1, 0xff, 0x87, 0x58, 0xd6, 0x45, 0xbf, 0x62, 0xc1, 0x39, 0x2a, 0x51, 0xe3, 0xec, 0xd4,
-	0x25, 0x94, 0x95, 0xcc, 0xba, 0x1b, 0x52, 0x8d, 0x44, 0xca, 0x1a, 0x75, 0x4c, 0x5a, 0x32, 0xd8,
-	0xe1, 0x04, 0x7b, 0xf4, 0x3e, 0x14, 0x42, 0xb7, 0x46, 0xaa, 0x4e, 0x10, 0x96, 0x2e, 0x9c, 0x4e,
-	0x53, 0x62, 0x47, 0x89, 0x60, 0x84, 0x15, 0x4b, 0xf4, 0x57, 0xd8, 0x83, 0x17, 0xe2, 0x71, 0x22,
-	0xf1, 0x6e, 0xde, 0xc5, 0x53, 0x7b, 0x37, 0x8f, 0xfb, 0x0f, 0x4c, 0x76, 0x38, 0xc9, 0x1f, 0xfd,
-	0x45, 0x0b, 0x2e, 0xf1, 0xe4, 0xa4, 0xc9, 0xcc, 0xb4, 0x97, 0x1e, 0xd1, 0x36, 0xc3, 0x22, 0x86,
-	0x67, 0xd3, 0x48, 0xe2, 0x74, 0x4e, 0x2c, 0xbb, 0x99, 0x99, 0x4c, 0xfc, 0x72, 0xa6, 0x0e, 0xc3,
-	0xfe, 0x13, 0x88, 0xa3, 0x97, 0x61, 0xa4, 0x2d, 0xb6, 0x43, 0x37, 0x6c, 0xb1, 0xf8, 0xef, 0x01,
-	0x7e, 0x47, 0x66, 0x23, 0x06, 0x63, 0x1d, 0xc7, 0x48, 0x75, 0x77, 0xe3, 0xb8, 0x54, 0x77, 0xe8,
-	0x2e, 0x8c, 0x44, 0x7e, 0x93, 0x04, 0xe2, 0xa4, 0x5a, 0x62, 0x33, 0xf0, 0x5a, 0xda, 0xda, 0xda,
-	0x54, 0x68, 0xf1, 0x49, 0x36, 0x86, 0x85, 0x58, 0xa7, 0xc3, 0xc2, 0x39, 0x45, 0xd2, 0xd7, 0x80,
-	0x1d, 0x61, 0x9f, 0x4e, 0x84, 0x73, 0xea, 0x85, 0xd8, 0xc4, 0x45, 0x77, 0xe0, 0x7c, 0xbb, 0xeb,
-	0x0c, 0xcc, 0x6f, 0x80, 0xa8, 0x58, 0x84, 0xee, 0x03, 0x70, 0x77, 0x1d, 0xe3, 0xf4, 0xfb, 0xcc,
-	0x71, 0xa7, 0xdf, 0x1e, 0x89, 0xdf, 0xae, 0x3e, 0x4a, 0xe2, 0x37, 0x54, 0x83, 0xab, 0x4e, 0x27,
-	0xf2, 0xd9, 0xbd, 0x7f, 0xb3, 0x0a, 0x8f, 0x6c, 0xbd, 0xce, 0x83, 0x65, 0x8f, 0x0e, 0xa7, 0xae,
-	0xce, 0x1e, 0x83, 0x87, 0x8f, 0xa5, 0x82, 0xde, 0x81, 0x02, 0x11, 0xc9, 0xeb, 0x4a, 0x3f, 0x96,
-	0x95, 0x92, 0x60, 0xa6, 0xc3, 0x93, 0x81, 0x8a, 0x1c, 0x86, 0x15, 0x3f, 0xb4, 0x09, 0x23, 0x0d,
-	0x3f, 0x8c, 0x66, 0x9b, 0xae, 0x13, 0x92, 0xb0, 0xf4, 0x2c, 0x9b, 0x34, 0xa9, 0xba, 0xd7, 0xa2,
-	0x44, 0x8b, 0xe7, 0xcc, 0x62, 0x5c, 0x13, 0xeb, 0x64, 0x10, 0x61, 0x6e, 0x43, 0x16, 0xd6, 0x2b,
-	0x5d, 0x3a, 0xd7, 0x58, 0xc7, 0x5e, 0x48, 0xa3, 0xbc, 0xe1, 0xd7, 0x2a, 0x26, 0xb6, 0xf2, 0x1b,
-	0xea, 0x40, 0x9c, 0xa4, 0x89, 0x5e, 0x85, 0xd1, 0xb6, 0x5f, 0xab, 0xb4, 0x49, 0x75, 0xc3, 0x89,
-	0xaa, 0x8d, 0xd2, 0x94, 0x69, 0xb2, 0xdb, 0xd0, 0xca, 0xb0, 0x81, 0x89, 0xda, 0x30, 0xdc, 0xe2,
-	0xb7, 0x5b, 0x4b, 0xcf, 0x65, 0x75, 0xb6, 0x11, 0xd7, 0x65, 0xb9, 0xbe, 0x20, 0xfe, 0x60, 0xc9,
-	0x06, 0xfd, 0x1d, 0x0b, 0xc6, 0x13, 0x37, 0x1a, 0x4a, 0x3f, 0x9e, 0x99, 0xca, 0x62, 0x12, 0x2e,
-	0xbf, 0xc0, 0x86, 0xcf, 0x04, 0x3e, 0xe8, 0x06, 0xe1, 0x64, 0x8b, 0xf8, 0xb8, 0xb0, 0x2b, 0xea,
-	0xa5, 0xe7, 0xb3, 0x1b, 0x17, 0x46, 0x50, 0x8e, 0x0b, 0xfb, 0x83, 0x25, 0x1b, 0x74, 0x03, 0x86,
-	0x45, 0x4e, 0x9a, 0xd2, 0x0b, 0xa6, 0xef, 0x57, 0xa4, 0xae, 0xc1, 0xb2, 0x7c, 0xf2, 0xa7, 0xe1,
-	0x7c, 0xd7, 0xd1, 0xed, 0x44, 0xf7, 0xa4, 0x7f, 0xcd, 0x02, 0xfd, 0x32, 0x62, 0xe6, 0x19, 0xa3,
-	0x5f, 0x85, 0xd1, 0x2a, 0x7f, 0xea, 0x85, 0x5f, 0x67, 0x1c, 0x34, 0xed, 0x9f, 0x73, 0x5a, 0x19,
-	0x36, 0x30, 0xed, 0x45, 0x40, 0xdd, 0xe9, 0x3c, 0x13, 0x91, 0x26, 0x56, 0x5f, 0x91, 0x26, 0xbf,
-	0x65, 0xc1, 0x98, 0xa1, 0x33, 0x64, 0xee, 0x2e, 0x5c, 0x00, 0xd4, 0x72, 0x83, 0xc0, 0x0f, 0xf4,
-	0x07, 0x3c, 0x44, 0xfe, 0x42, 0x96, 0xdb, 0x69, 0xb5, 0xab, 0x14, 0xa7, 0xd4, 0xb0, 0xff, 0xc1,
-	0x20, 0xc4, 0x91, 0xba, 0x2a, 0xab, 0x9b, 0xd5, 0x33, 0xab, 0xdb, 0x4b, 0x50, 0x78, 0x2b, 0xf4,
-	0xbd, 0x8d, 0x38, 0xf7, 0x9b, 0xfa, 0x16, 0xaf, 0x55, 0xd6, 0xd7, 0x18, 0xa6, 0xc2, 0x60, 0xd8,
-	0x6f, 0x2f, 0xb8, 0xcd, 0xa8, 0x3b, 0x39, 0xd8, 0x6b, 0xaf, 0x73, 0x38, 0x56, 0x18, 0xec, 0x2d,
-	0x8f, 0x5d, 0xa2, 0x0c, 0xe3, 0xf1, 0x5b, 0x1e, 0x3c, 0x53, 0x2f, 0x2b, 0x43, 0x33, 0x50, 0x54,
-	0x76, 0x75, 0x61, 0xe6, 0x57, 0x23, 0xa5, 0xec, 0xef, 0x38, 0xc6, 0x61, 0x0a, 0xa1, 0x30, 0xc4,
-	0x0a, 0x13, 0x4a, 0x25, 0x8b, 0xe3, 0x49, 0xc2, 0xb4, 0xcb, 0x65, 0xbb, 0x04, 0x63, 0xc5, 0x32,
-	0xcd, 0x67, 0x5a, 0x3c, 0x0d, 0x9f, 0xa9, 0x1e, 0x36, 0x9e, 0xef, 0x37, 0x6c, 0xdc, 0x9c, 0xdb,
-	0x85, 0xbe, 0xe6, 0xf6, 0xcf, 0x0f, 0xc0, 0xf0, 0x3d, 0x12, 0xb0, 0x8c, 0x95, 0x37, 0x60, 0x78,
-	0x97, 0xff, 0x4c, 0x5e, 0xd2, 0x12, 0x18, 0x58, 0x96, 0xd3, 0xef, 0xb6, 0xd5, 0x71, 0x9b, 0xb5,
-	0xf9, 0x78, 0x15, 0xab, 0xef, 0x56, 0x96, 0x05, 0x38, 0xc6, 0xa1, 0x15, 0xea, 0x54, 0xb3, 0x6f,
-	0xb5, 0xdc, 0x28, 0x19, 0x41, 0x74, 0x47, 0x16, 0xe0, 0x18, 0x07, 0xbd, 0x00, 0x43, 0x75, 0x37,
-	0xda, 0x74, 0xea, 0x49, 0x17, 0xe1, 0x1d, 0x06, 0xc5, 0xa2, 0x94, 0xf9, 0x98, 0xdc, 0x68, 0x33,
-	0x20, 0xcc, 0xb2, 0xdb, 0x75, 0x5b, 0xfb, 0x8e, 0x56, 0x86, 0x0d, 0x4c, 0xd6, 0x24, 0x5f, 0xf4,
-	0x4c, 0xf8, 0x7e, 0xe2, 0x26, 0xc9, 0x02, 0x1c, 0xe3, 0xd0, 0xf9, 0x5f, 0xf5, 0x5b, 0x6d, 0xb7,
-	0x29, 0x22, 0x6a, 0xb5, 0xf9, 0x3f, 0x27, 0xe0, 0x58, 0x61, 0x50, 0x6c, 0x2a, 0xc2, 0xa8, 0xf8,
-	0x49, 0xbe, 0x9b, 0xb0, 0x21, 0xe0, 0x58, 0x61, 0xd8, 0xf7, 0x60, 0x8c, 0xaf, 0xe4, 0xb9, 0xa6,
-	0xe3, 0xb6, 0xee, 0xcc, 0xa1, 0xdb, 0x5d, 0x61, 0xe3, 0x37, 0x52, 0xc2, 0xc6, 0x2f, 0x19, 0x95,
-	0xba, 0xc3, 0xc7, 0xed, 0xef, 0xe6, 0xa0, 0x70, 0x86, 0x4f, 0xcf, 0xb4, 0x8d, 0xa7, 0x67, 0xb2,
-	0x7e, 0x80, 0x24, 0xed, 0xd9, 0x99, 0xfd, 0xc4, 0xb3, 0x33, 0x1b, 0x59, 0xde, 0x02, 0x39, 0xf6,
-	0xc9, 0x99, 0x1f, 0x5a, 0x70, 0x51, 0xa2, 0x32, 0xa1, 0x56, 0x76, 0x3d, 0x16, 0x5c, 0x70, 0xfa,
-	0xc3, 0xfc, 0x9e, 0x31, 0xcc, 0x6f, 0x64, 0xd7, 0x65, 0xbd, 0x1f, 0x3d, 0xdf, 0x92, 0xfb, 0x81,
-	0x05, 0xa5, 0xb4, 0x0a, 0x67, 0xf0, 0xe6, 0xce, 0xbb, 0xe6, 0x9b, 0x3b, 0xf7, 0x4e, 0xa7, 0xe7,
-	0x3d, 0xde, 0xde, 0xf9, 0x61, 0x8f, 0x7e, 0xb3, 0x87, 0x6e, 0x9a, 0x72, 0xbb, 0xb3, 0xb2, 0x72,
-	0x9d, 0x71, 0x16, 0xe9, 0xfb, 0x66, 0x13, 0x86, 0x42, 0xe6, 0x89, 0x17, 0x53, 0x60, 0x31, 0x8b,
-	0x4d, 0x90, 0xd2, 0x13, 0xa6, 0x4f, 0xf6, 0x1b, 0x0b, 0x1e, 0xf6, 0xbf, 0xb7, 0x60, 0xf4, 0x0c,
-	0x1f, 0x56, 0xf2, 0xcd, 0x8f, 0xfc, 0x5a, 0x76, 0x1f, 0xb9, 0xc7, 0x87, 0xfd, 0x17, 0xd7, 0xc1,
-	0x78, 0xc3, 0x08, 0xbd, 0x0b, 0x45, 0xa9, 0x81, 0xca, 0xdb, 0x65, 0x59, 0x3e, 0x55, 0xa2, 0xb6,
-	0x19, 0x09, 0x09, 0x71, 0xcc, 0x2f, 0x11, 0xfb, 0x90, 0xeb, 0x2b, 0xf6, 0xe1, 0xc9, 0x3e, 0x74,
-	0x92, 0x6e, 0x1f, 0x18, 0x3c, 0x15, 0xfb, 0xc0, 0xd5, 0xcc, 0xed, 0x03, 0xcf, 0x9e, 0xb1, 0x7d,
-	0x40, 0x33, 0xd6, 0xe6, 0x1f, 0xc3, 0x58, 0xfb, 0x2e, 0x5c, 0xdc, 0x8d, 0x37, 0x7f, 0x35, 0x93,
-	0xc4, 0x7b, 0x2d, 0x37, 0x52, 0xad, 0x02, 0x54, 0x91, 0x09, 0x23, 0xe2, 0x45, 0x9a, 0xda, 0x10,
-	0x47, 0x4e, 0xdc, 0x4b, 0x21, 0x87, 0x53, 0x99, 0x24, 0xad, 0x6e, 0xc3, 0x7d, 0x58, 0xdd, 0x7e,
-	0xbb, 0xe7, 0x03, 0xd7, 0x85, 0xd3, 0x7d, 0xe0, 0xfa, 0xe9, 0x13, 0x3f, 0x6e, 0xfd, 0x7c, 0xec,
-	0x02, 0xe1, 0xf1, 0x36, 0xe9, 0xfe, 0x8a, 0xaf, 0x27, 0xfd, 0xaa, 0xc0, 0x86, 0xfe, 0xf3, 0xd9,
-	0x6a, 0x3d, 0x19, 0xf8, 0x56, 0x47, 0x1e, 0xc3, 0xb7, 0x9a, 0x30, 0x81, 0x8e, 0x66, 0x64, 0x02,
-	0xf5, 0x60, 0xc2, 0x6d, 0x39, 0x75, 0xb2, 0xd1, 0x69, 0x36, 0x79, 0x58, 0xae, 0x7c, 0x4c, 0x26,
-	0xf5, 0x24, 0xb5, 0xe2, 0x57, 0x9d, 0x66, 0xf2, 0xcd, 0x2e, 0x15, 0x7e, 0xbc, 0x94, 0xa0, 0x84,
-	0xbb, 0x68, 0xd3, 0x09, 0xcb, 0xb2, 0x87, 0x90, 0x88, 0x8e, 0x36, 0x73, 0xe0, 0x15, 0xf8, 0x84,
-	0x5d, 0x8c, 0xc1, 0x58, 0xc7, 0x41, 0xcb, 0x50, 0xac, 0x79, 0xa1, 0xb8, 0xd0, 0x33, 0xce, 0x84,
-	0xd9, 0xc7, 0xa8, 0x08, 0x9c, 0x5f, 0xab, 0xa8, 0xab, 0x3c, 0x57, 0x53, 0x12, 0xd3, 0xa8, 0x72,
-	0x1c, 0xd7, 0x47, 0xab, 0x8c, 0x98, 0xc8, 0x07, 0xce, 0xfd, 0x6a, 0xd7, 0x7b, 0x18, 0xee, 0xe6,
-	0xd7, 0x64, 0x46, 0xf3, 0x31, 0xc1, 0x4e, 0x24, 0xf6, 0x8e, 0x29, 0x68, 0x8f, 0xfa, 0x9c, 0x3f,
-	0xf6, 0x51, 0x1f, 0x96, 0x91, 0x2a, 0x6a, 0x2a, 0x33, 0xfd, 0xb5, 0xcc, 0x32, 0x52, 0xc5, 0x11,
-	0x2b, 0x22, 0x23, 0x55, 0x0c, 0xc0, 0x3a, 0x4b, 0xb4, 0xde, 0xcb, 0x5d, 0x71, 0x81, 0x09, 0x8d,
-	0x93, 0x3b, 0x1f, 0x74, 0xbb, 0xf5, 0xc5, 0x63, 0xed, 0xd6, 0x5d, 0x76, 0xf6, 0x4b, 0x27, 0xb0,
-	0xb3, 0x37, 0x58, 0xae, 0xa0, 0x3b, 0x73, 0xc2, 0xb5, 0x91, 0x81, 0x42, 0xc7, 0xae, 0xf8, 0xf2,
-	0x08, 0x20, 0xf6, 0x13, 0x73, 0x06, 0x3d, 0x03, 0xdb, 0xae, 0x3c, 0x72, 0x60, 0x1b, 0x15, 0xcf,
-	0x31, 0x9c, 0x25, 0x9d, 0xca, 0x0b, 0xf1, 0x1c, 0x83, 0xb1, 0x8e, 0x93, 0xb4, 0x5a, 0x3f, 0x7d,
-	0x6a, 0x56, 0xeb, 0xc9, 0x33, 0xb0, 0x5a, 0x3f, 0xd3, 0xb7, 0xd5, 0xfa, 0x7d, 0xb8, 0xd0, 0xf6,
-	0x6b, 0xf3, 0x6e, 0x18, 0x74, 0xd8, 0x3d, 0x85, 0x72, 0xa7, 0x56, 0x27, 0x11, 0x33, 0x7b, 0x8f,
-	0xdc, 0xbc, 0xa9, 0x37, 0xb2, 0xcd, 0x16, 0xf2, 0xf4, 0xee, 0xcb, 0x5b, 0x24, 0xe2, 0x1f, 0x33,
-	0x59, 0x8b, 0x1d, 0x98, 0x58, 0x08, 0x54, 0x4a, 0x21, 0x4e, 0xe3, 0xa3, 0x1b, 0xcd, 0xaf, 0x9f,
-	0x8d, 0xd1, 0xfc, 0xd3, 0x50, 0x08, 0x1b, 0x9d, 0xa8, 0xe6, 0xef, 0x79, 0xcc, 0x33, 0x52, 0x54,
-	0xcf, 0x7a, 0x16, 0x2a, 0x02, 0xfe, 0xe0, 0x70, 0x6a, 0x42, 0xfe, 0xd6, 0x4c, 0x0a, 0x02, 0x82,
-	0x7e, 0xa3, 0x47, 0x24, 0xb6, 0x7d, 0x9a, 0x91, 0xd8, 0x57, 0x4e, 0x14, 0x85, 0x9d, 0xe6, 0x19,
-	0x78, 0xee, 0x23, 0xe7, 0x19, 0xf8, 0x75, 0x0b, 0xc6, 0x76, 0x75, 0xfb, 0x8d, 0xf0, 0x5e, 0x64,
-	0xe0, 0x45, 0x35, 0xcc, 0x42, 0x65, 0x9b, 0x0a, 0x3b, 0x03, 0xf4, 0x20, 0x09, 0xc0, 0x66, 0x4b,
-	0x52, 0x3c, 0xbc, 0xcf, 0x3f, 0x29, 0x0f, 0xef, 0xfb, 0x4c, 0x98, 0xc9, 0xe0, 0x2b, 0xe6, 0xd2,
-	0xc8, 0x36, 0xc0, 0x4b, 0x0a, 0x46, 0x15, 0xdf, 0xa5, 0xf3, 0x43, 0x5f, 0xb5, 0x60, 0x42, 0x1e,
-	0xce, 0x84, 0xfd, 0x35, 0x14, 0x21, 0x2a, 0x59, 0x9e, 0x09, 0x59, 0x8c, 0xe3, 0x66, 0x82, 0x0f,
-	0xee, 0xe2, 0x4c, 0x45, 0xbb, 0x8a, 0x08, 0xa8, 0x87, 0x2c, 0x12, 0x4b, 0x28, 0x32, 0xb3, 0x31,
-	0x18, 0xeb, 0x38, 0xe8, 0x1b, 0xea, 0xb9, 0xbe, 0x1b, 0x59, 0x3d, 0xbf, 0x6f, 0x28, 0xa8, 0x99,
-	0xbc, 0xd9, 0xf7, 0xb8, 0x9e, 0xa8, 0x8f, 0xd4, 0xa3, 0x7f, 0x7f, 0x88, 0xe0, 0x5c, 0xe2, 0x55,
-	0xda, 0x8f, 0x9b, 0x69, 0x5d, 0xaf, 0x25, 0x73, 0x6b, 0x8e, 0x49, 0x7c, 0x23, 0xbf, 0xa6, 0x91,
-	0x00, 0x33, 0x77, 0xaa, 0x09, 0x30, 0x07, 0xce, 0x26, 0x01, 0xe6, 0xc4, 0x69, 0x24, 0xc0, 0x3c,
-	0x7f, 0xa2, 0x04, 0x98, 0x5a, 0x02, 0xd2, 0xc1, 0x87, 0x24, 0x20, 0x9d, 0x85, 0x71, 0x19, 0x65,
-	0x4c, 0x44, 0x66, 0x43, 0xee, 0x60, 0xb8, 0x22, 0xaa, 0x8c, 0xcf, 0x99, 0xc5, 0x38, 0x89, 0x8f,
-	0x3e, 0xb4, 0x20, 0xef, 0xb1, 0x9a, 0x43, 0x59, 0xe5, 0xf4, 0x36, 0xa7, 0x16, 0x3b, 0x20, 0x8a,
-	0xf5, 0x27, 0xe3, 0xaa, 0xf2, 0x0c, 0xf6, 0x40, 0xfe, 0xc0, 0xbc, 0x05, 0xe8, 0x4d, 0x28, 0xf9,
-	0xdb, 0xdb, 0x4d, 0xdf, 0xa9, 0xc5, 0x59, 0x3a, 0xa5, 0x07, 0x84, 0xdf, 0x12, 0x51, 0x59, 0xca,
-	0xd6, 0x7b, 0xe0, 0xe1, 0x9e, 0x14, 0xe8, 0x09, 0x7f, 0x3c, 0x8c, 0xfc, 0x80, 0xd4, 0x62, 0x6b,
-	0x44, 0x91, 0xf5, 0x99, 0x64, 0xde, 0xe7, 0x8a, 0xc9, 0x87, 0xf7, 0x5e, 0x7d, 0x94, 0x44, 0x29,
-	0x4e, 0x36, 0x0b, 0x05, 0x70, 0xb9, 0x9d, 0x66, 0x0c, 0x09, 0x45, 0x6c, 0xf4, 0x71, 0x26, 0x19,
-	0xb9, 0x74, 0x2f, 0xa7, 0x9a, 0x53, 0x42, 0xdc, 0x83, 0xb2, 0x9e, 0xbf, 0xb3, 0x70, 0x36, 0xf9,
-	0x3b, 0xcd, 0xb7, 0xa4, 0xc7, 0xce, 0xfc, 0x2d, 0x69, 0xf4, 0xbf, 0x53, 0x53, 0xcd, 0x72, 0x1b,
-	0x42, 0x3d, 0xf3, 0x39, 0xf1, 0x91, 0x4b, 0x37, 0xfb, 0x77, 0x2d, 0x98, 0xe4, 0x33, 0x2f, 0xa9,
-	0xb9, 0xb2, 0x57, 0xfa, 0xcf, 0x9d, 0x8a, 0x93, 0x8c, 0xc5, 0x0b, 0x54, 0x0c, 0xae, 0xcc, 0x77,
-	0x73, 0x4c, 0x4b, 0xd0, 0xaf, 0xa6, 0xe8, 0xcb, 0xe3, 0x59, 0x59, 0xe5, 0xd2, 0xd3, 0x94, 0x5e,
-	0x38, 0xea, 0x47, 0x45, 0xfe, 0xfb, 0x3d, 0x8d, 0x86, 0x88, 0x35, 0xef, 0x2f, 0x9c, 0x92, 0xd1,
-	0x50, 0xcf, 0xa5, 0x7a, 0x12, 0xd3, 0xe1, 0xe4, 0x2f, 0x88, 0x64, 0xee, 0x3d, 0xb5, 0x90, 0x2d,
-	0x53, 0x0b, 0x59, 0xc9, 0x32, 0xe1, 0xb2, 0xae, 0x0e, 0xfd, 0xb2, 0x05, 0x17, 0xd3, 0x84, 0x64,
-	0x4a, 0x93, 0x3e, 0x6f, 0x36, 0x29, 0x43, 0xad, 0x56, 0x6f, 0x50, 0x36, 0x59, 0x66, 0x7f, 0x50,
-	0xd4, 0x5c, 0x35, 0x11, 0x69, 0x9f, 0xe2, 0x13, 0xf5, 0x63, 0xff, 0xef, 0x89, 0xfa, 0xb3, 0xc8,
-	0x58, 0x6f, 0x3c, 0x36, 0x9f, 0x7f, 0x52, 0x8f, 0xcd, 0x0f, 0x3d, 0xca, 0x63, 0xf3, 0xc3, 0x4f,
-	0xec, 0xb1, 0xf9, 0x42, 0x9f, 0x8f, 0xcd, 0x17, 0x3f, 0xa2, 0x8f, 0xcd, 0xc7, 0x47, 0xd2, 0xd1,
-	0xcc, 0x8f, 0xa4, 0x11, 0x69, 0xff, 0xdf, 0xf7, 0x8c, 0xfc, 0x1f, 0xe7, 0x60, 0x5c, 0x6d, 0xdd,
-	0x4e, 0xb8, 0x53, 0x21, 0xd1, 0x19, 0xc4, 0x99, 0xec, 0x19, 0x71, 0x26, 0x59, 0x9a, 0xf6, 0x78,
-	0x17, 0x7a, 0x46, 0xf5, 0x7c, 0x31, 0x11, 0xd5, 0x73, 0x3f, 0x7b, 0xd6, 0xc7, 0x07, 0xf7, 0xfc,
-	0x57, 0x0b, 0x2e, 0x24, 0x6a, 0x9c, 0x41, 0xe4, 0xc3, 0xae, 0x19, 0xf9, 0xf0, 0x7a, 0xe6, 0xbd,
-	0xee, 0x11, 0x00, 0xf1, 0x9b, 0xb9, 0xae, 0xde, 0x32, 0xbd, 0xf0, 0xe7, 0x2d, 0xc8, 0x47, 0x4e,
-	0xb8, 0x23, 0x83, 0x20, 0x3e, 0x7f, 0x2a, 0x33, 0x60, 0x9a, 0xfe, 0x16, 0xab, 0x55, 0xb5, 0x8f,
-	0xc1, 0x30, 0xe7, 0x3e, 0xf9, 0x73, 0x16, 0x40, 0x8c, 0xf4, 0xa4, 0x54, 0x18, 0xfb, 0x77, 0x72,
-	0x70, 0x29, 0x75, 0x1a, 0xa1, 0xaf, 0xa8, 0x43, 0x3e, 0x1f, 0xa8, 0xad, 0x53, 0x9a, 0xaf, 0xfa,
-	0x59, 0x7f, 0xcc, 0x38, 0xeb, 0x8b, 0x23, 0xfe, 0x93, 0x52, 0x40, 0x45, 0x5a, 0x67, 0x6d, 0xb0,
-	0xfe, 0x9b, 0x05, 0x13, 0xc9, 0xc3, 0xc6, 0x19, 0x88, 0xac, 0x7d, 0x43, 0x64, 0xdd, 0xcb, 0xde,
-	0x1b, 0xd1, 0x33, 0x2c, 0xee, 0x8f, 0xb5, 0x78, 0x40, 0x89, 0x7c, 0x06, 0x32, 0x63, 0xcf, 0x94,
-	0x19, 0x38, 0xfb, 0x1e, 0xf7, 0x10, 0x1a, 0x6f, 0x43, 0x9a, 0x43, 0xa6, 0xbf, 0xb4, 0x30, 0x46,
-	0x4c, 0x7f, 0xae, 0xef, 0x98, 0xfe, 0x5f, 0xca, 0x75, 0x0f, 0x31, 0x13, 0x54, 0x1f, 0x50, 0xd5,
-	0x4c, 0x3b, 0xed, 0x66, 0x97, 0x39, 0xc3, 0x38, 0x5b, 0xab, 0x36, 0x1a, 0x27, 0x6b, 0x83, 0x33,
-	0x7a, 0x2b, 0x6e, 0x09, 0xfd, 0x52, 0x0f, 0x4d, 0xc1, 0xd4, 0x6b, 0x9a, 0x33, 0x87, 0xc0, 0x7d,
-	0x8d, 0x12, 0x73, 0x4d, 0x18, 0xb4, 0xed, 0x31, 0x18, 0x79, 0xc3, 0x6d, 0x2b, 0x5f, 0xca, 0xf4,
-	0xb7, 0xbf, 0x7f, 0xed, 0xa9, 0xdf, 0xff, 0xfe, 0xb5, 0xa7, 0xbe, 0xfb, 0xfd, 0x6b, 0x4f, 0x7d,
-	0xe9, 0xe8, 0x9a, 0xf5, 0xed, 0xa3, 0x6b, 0xd6, 0xef, 0x1f, 0x5d, 0xb3, 0xbe, 0x7b, 0x74, 0xcd,
-	0xfa, 0x0f, 0x47, 0xd7, 0xac, 0xbf, 0xfc, 0x1f, 0xaf, 0x3d, 0xf5, 0x46, 0x41, 0xf6, 0xed, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x39, 0x75, 0x16, 0x7f, 0x38, 0xad, 0x00, 0x00,
+	// 12175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x90, 0x24, 0x57,
+	0x56, 0x98, 0xb2, 0xaa, 0xab, 0x1f, 0xb7, 0x9f, 0x93, 0xf3, 0x4a, 0xb5, 0xa4, 0xe9, 0x21, 0x85,
+	0x84, 0x04, 0x52, 0x0f, 0x9a, 0xd1, 0xb2, 0x62, 0xd7, 0x2c, 0xf4, 0x63, 0xa6, 0x67, 0x34, 0xdd,
+	0xd3, 0x3d, 0xa7, 0x5a, 0xd3, 0xac, 0x24, 0x2f, 0x9b, 0x5d, 0x75, 0xbb, 0x2a, 0xd5, 0x55, 0x99,
+	0xa5, 0xcc, 0xac, 0x7e, 0xe8, 0xc1, 0xae, 0x77, 0x01, 0xb1, 0x06, 0xcc, 0x9a, 0x97, 0x81, 0x30,
+	0x66, 0x8d, 0x81, 0x20, 0x30, 0x61, 0x07, 0x61, 0xf3, 0x41, 0x84, 0xc3, 0x3f, 0x76, 0x38, 0xd6,
+	0xe1, 0x0f, 0xe3, 0x30, 0x61, 0xef, 0x07, 0x9e, 0xf5, 0x8e, 0xc1, 0x7c, 0x38, 0x70, 0x04, 0xd8,
+	0xb0, 0xeb, 0xb1, 0x23, 0xec, 0x38, 0xf7, 0x95, 0xf7, 0x66, 0x65, 0xf5, 0x63, 0x26, 0x7b, 0xb4,
+	0x98, 0xaf, 0xee, 0x3a, 0xe7, 0xe4, 0x39, 0xf7, 0xde, 0xbc, 0x79, 0xef, 0xb9, 0xe7, 0x75, 0xc9,
+	0x5a, 0xc3, 0x4f, 0x9a, 0xdd, 0xcd, 0xd9, 0x5a, 0xd8, 0xbe, 0xe4, 0x45, 0x8d, 0xb0, 0x13, 0x85,
+	0x6f, 0xb1, 0x7f, 0x5e, 0xdc, 0x0d, 0xa3, 0xed, 0xad, 0x56, 0xb8, 0x1b, 0x5f, 0xda, 0xb9, 0x72,
+	0xa9, 0xb3, 0xdd, 0xb8, 0xe4, 0x75, 0xfc, 0xf8, 0x92, 0x84, 0x5e, 0xda, 0x79, 0xc9, 0x6b, 0x75,
+	0x9a, 0xde, 0x4b, 0x97, 0x1a, 0x34, 0xa0, 0x91, 0x97, 0xd0, 0xfa, 0x6c, 0x27, 0x0a, 0x93, 0xd0,
+	0xfe, 0xbe, 0x94, 0xe3, 0xac, 0xe4, 0xc8, 0xfe, 0xf9, 0x01, 0xc5, 0x71, 0x76, 0xe7, 0xca, 0x6c,
+	0x67, 0xbb, 0x31, 0x8b, 0x1c, 0x67, 0x25, 0x74, 0x56, 0x72, 0x9c, 0x7e, 0x51, 0x6b, 0x53, 0x23,
+	0x6c, 0x84, 0x97, 0x18, 0xe3, 0xcd, 0xee, 0x16, 0xfb, 0xc5, 0x7e, 0xb0, 0xff, 0xb8, 0xc0, 0x69,
+	0x77, 0xfb, 0x95, 0x78, 0xd6, 0x0f, 0xb1, 0x7d, 0x97, 0x6a, 0x61, 0x44, 0x2f, 0xed, 0xf4, 0x34,
+	0x6a, 0xfa, 0x79, 0x8d, 0xa6, 0x13, 0xb6, 0xfc, 0xda, 0xfe, 0xa5, 0x9d, 0x97, 0x36, 0x69, 0xd2,
+	0xdb, 0xfe, 0xe9, 0x97, 0x53, 0xd2, 0xb6, 0x57, 0x6b, 0xfa, 0x01, 0x8d, 0xf6, 0x65, 0xff, 0x2f,
+	0x45, 0x34, 0x0e, 0xbb, 0x51, 0x8d, 0x1e, 0xeb, 0xa9, 0xf8, 0x52, 0x9b, 0x26, 0x5e, 0x5e, 0xb3,
+	0x2e, 0xf5, 0x7b, 0x2a, 0xea, 0x06, 0x89, 0xdf, 0xee, 0x15, 0xf3, 0x5d, 0x87, 0x3d, 0x10, 0xd7,
+	0x9a, 0xb4, 0xed, 0xf5, 0x3c, 0x77, 0xa5, 0xdf, 0x73, 0xdd, 0xc4, 0x6f, 0x5d, 0xf2, 0x83, 0x24,
+	0x4e, 0xa2, 0xec, 0x43, 0xee, 0xbf, 0x28, 0x91, 0x99, 0xb9, 0x8d, 0x6a, 0x95, 0xd6, 0x22, 0x9a,
+	0xc4, 0x2b, 0x5e, 0xe0, 0x35, 0x68, 0xc4, 0x7f, 0xad, 0x45, 0xe1, 0x8e, 0x5f, 0xa7, 0x91, 0xfd,
+	0x2c, 0x19, 0x8c, 0x68, 0xc3, 0x0f, 0x03, 0xc7, 0xba, 0x68, 0x3d, 0x37, 0x32, 0x3f, 0xf1, 0xe5,
+	0xbb, 0x33, 0x8f, 0xdd, 0xbb, 0x3b, 0x33, 0x08, 0x0c, 0x0a, 0x02, 0x6b, 0xbf, 0x40, 0x86, 0x69,
+	0x50, 0xef, 0x84, 0x7e, 0x90, 0x38, 0x25, 0x46, 0x39, 0x25, 0x28, 0x87, 0xaf, 0x0a, 0x38, 0x28,
+	0x0a, 0xbb, 0x4e, 0x26, 0xbd, 0x5a, 0x8d, 0xc6, 0xf1, 0x4d, 0xba, 0xcf, 0x05, 0x3a, 0xe5, 0x8b,
+	0xd6, 0x73, 0xa3, 0x97, 0x9f, 0x99, 0xe5, 0x1d, 0xc1, 0xa9, 0x33, 0x8b, 0x2f, 0x7b, 0x76, 0xe7,
+	0xa5, 0x59, 0x4e, 0xc1, 0x48, 0x5b, 0xb4, 0x96, 0x84, 0xd1, 0xfc, 0xe9, 0x7b, 0x77, 0x67, 0x26,
+	0xe7, 0x4c, 0x0e, 0x90, 0x65, 0x89, 0x52, 0xe2, 0xf4, 0x51, 0x26, 0x65, 0xe0, 0xd8, 0x52, 0xaa,
+	0x26, 0x07, 0xc8, 0xb2, 0x74, 0xaf, 0x92, 0xc1, 0xb9, 0x76, 0xd8, 0x0d, 0x12, 0xfb, 0xe3, 0xa4,
+	0xb2, 0xe3, 0xb5, 0xba, 0x54, 0x0c, 0xd5, 0x33, 0x62, 0x00, 0x2a, 0x77, 0x10, 0x78, 0xff, 0xee,
+	0xcc, 0x19, 0x1a, 0xd4, 0xc2, 0xba, 0x1f, 0x34, 0x2e, 0xbd, 0x15, 0x87, 0xc1, 0xec, 0xad, 0x6e,
+	0x7b, 0x93, 0x46, 0xc0, 0x9f, 0x71, 0xff, 0x99, 0x45, 0x86, 0xe7, 0x3a, 0x9d, 0x28, 0xdc, 0xf1,
+	0x5a, 0xf6, 0x77, 0x90, 0x11, 0x8f, 0xfd, 0x4f, 0xa3, 0xd8, 0xb1, 0x2e, 0x96, 0x9f, 0x1b, 0x99,
+	0x1f, 0xbf, 0x77, 0x77, 0x66, 0x64, 0x4e, 0x02, 0x21, 0xc5, 0xdb, 0x1f, 0x23, 0x13, 0xf2, 0xc7,
+	0x52, 0x14, 0x76, 0x3b, 0xb1, 0x53, 0x62, 0x4f, 0xd8, 0xf7, 0xee, 0xce, 0x4c, 0xcc, 0x19, 0x18,
+	0xc8, 0x50, 0xda, 0x4b, 0xe4, 0x54, 0x44, 0xdf, 0xee, 0xfa, 0x11, 0xad, 0x4b, 0xe1, 0x31, 0x7b,
+	0x15, 0x95, 0xf9, 0xc7, 0x45, 0xf3, 0x4f, 0x41, 0x96, 0x00, 0x7a, 0x9f, 0x71, 0xff, 0xd8, 0x22,
+	0x53, 0xf2, 0xd7, 0x22, 0xad, 0xf9, 0xb1, 0x98, 0x14, 0x52, 0x9e, 0x63, 0x99, 0x93, 0x42, 0xb6,
+	0x0b, 0x14, 0x85, 0x46, 0x5d, 0x67, 0x53, 0x68, 0xb8, 0x87, 0xba, 0xae, 0xa8, 0xeb, 0xf6, 0xf3,
+	0x64, 0xa8, 0x16, 0xb6, 0xdb, 0x34, 0xe0, 0x53, 0x67, 0x64, 0x7e, 0x52, 0x10, 0x0f, 0x2d, 0x70,
+	0x30, 0x48, 0xbc, 0xbd, 0x4c, 0x06, 0xf0, 0xdb, 0x11, 0x2f, 0xff, 0xdb, 0xb5, 0x97, 0xaf, 0xbe,
+	0x95, 0x74, 0xb9, 0xc2, 0x4f, 0x19, 0xa7, 0xc3, 0xba, 0xdf, 0xa6, 0xf3, 0x63, 0x82, 0xe7, 0x00,
+	0xfe, 0x02, 0xc6, 0xc5, 0xfd, 0x6c, 0x89, 0x4c, 0xc8, 0x9e, 0x56, 0x13, 0x2f, 0xe9, 0xc6, 0x76,
+	0x93, 0x0c, 0x34, 0xbc, 0x84, 0xbf, 0xf7, 0xd1, 0xcb, 0xaf, 0xce, 0x3e, 0xec, 0x0a, 0x39, 0x2b,
+	0xf9, 0xcf, 0x0f, 0xa3, 0xf0, 0x25, 0x2f, 0xa1, 0xc0, 0x24, 0xd8, 0x9f, 0xb7, 0xc8, 0x48, 0x5d,
+	0x0c, 0x2f, 0x7f, 0xcf, 0xa3, 0x97, 0xa1, 0x38, 0x79, 0xf2, 0xcd, 0xcd, 0x9f, 0x12, 0x1d, 0x1f,
+	0x91, 0x90, 0x18, 0x52, 0xb9, 0xee, 0xbf, 0x2f, 0x91, 0xc9, 0xb9, 0xa8, 0xd6, 0xf4, 0x77, 0x68,
+	0x35, 0xc1, 0x15, 0xa5, 0xb1, 0x6f, 0x37, 0x49, 0x39, 0xf1, 0x22, 0x31, 0x04, 0x2b, 0x0f, 0xdf,
+	0xa4, 0x75, 0x2f, 0x92, 0xbc, 0xe7, 0x87, 0xee, 0xdd, 0x9d, 0x29, 0xaf, 0x7b, 0x11, 0xa0, 0x08,
+	0xbb, 0x45, 0x06, 0x82, 0x30, 0xa0, 0x6c, 0x8e, 0x8c, 0x5e, 0xbe, 0xf5, 0xf0, 0xa2, 0x6e, 0x85,
+	0x81, 0xea, 0x07, 0x1f, 0x71, 0x84, 0x00, 0x93, 0x82, 0xfd, 0x7a, 0xc7, 0xef, 0x38, 0xe5, 0xa2,
+	0xfa, 0xf5, 0xba, 0xdf, 0x31, 0xfb, 0xf5, 0xba, 0xdf, 0x01, 0x14, 0xe1, 0x7e, 0xa1, 0x44, 0x46,
+	0xe6, 0xa2, 0x46, 0x17, 0xe7, 0x6c, 0x6c, 0x7f, 0x86, 0x90, 0x8e, 0x17, 0x79, 0x6d, 0x9a, 0xc8,
+	0x35, 0x60, 0xf4, 0xf2, 0xcd, 0x87, 0x17, 0xbf, 0x26, 0x79, 0xce, 0xdb, 0xe2, 0x15, 0x13, 0x05,
+	0x8a, 0x41, 0x13, 0x69, 0xbf, 0x4b, 0x46, 0xbc, 0x28, 0xf1, 0xb7, 0xbc, 0x5a, 0x22, 0x67, 0x5a,
+	0x11, 0x33, 0x5b, 0xb0, 0x4c, 0x67, 0x98, 0x84, 0xe0, 0x9a, 0x26, 0xff, 0x75, 0xff, 0xb4, 0x42,
+	0x86, 0x25, 0xc2, 0xbe, 0x48, 0x06, 0x02, 0xaf, 0x2d, 0x97, 0x55, 0xf5, 0x4d, 0xde, 0xf2, 0xf0,
+	0x9b, 0x44, 0x0c, 0x52, 0x74, 0xbc, 0xa4, 0xe9, 0x94, 0x4c, 0x8a, 0x35, 0x2f, 0x69, 0x02, 0xc3,
+	0xd8, 0x4f, 0x92, 0x81, 0x76, 0x58, 0xa7, 0x62, 0x6d, 0x63, 0x2f, 0x79, 0x25, 0xac, 0x53, 0x60,
+	0x50, 0x7c, 0x7e, 0x2b, 0x0a, 0xdb, 0xce, 0x80, 0xf9, 0xfc, 0xb5, 0x28, 0x6c, 0x03, 0xc3, 0xd8,
+	0x3f, 0x6f, 0x91, 0x29, 0xd9, 0xbc, 0xe5, 0xb0, 0xe6, 0x25, 0xb8, 0x25, 0x56, 0x2e, 0x5a, 0x05,
+	0x7d, 0x7f, 0x19, 0xce, 0xf3, 0x8e, 0x68, 0xc2, 0x54, 0x16, 0x03, 0x3d, 0xad, 0xb0, 0x2f, 0x13,
+	0xd2, 0x68, 0x85, 0x9b, 0x5e, 0x0b, 0x07, 0xc4, 0x19, 0x64, 0x5d, 0x50, 0x2f, 0x77, 0x49, 0x61,
+	0x40, 0xa3, 0xb2, 0xf7, 0xc8, 0x90, 0xc7, 0x3f, 0x60, 0x67, 0x88, 0x75, 0xe2, 0x76, 0x11, 0x9d,
+	0x30, 0x56, 0x84, 0xf9, 0x51, 0x5c, 0x8c, 0x05, 0x10, 0xa4, 0x38, 0x5c, 0xe5, 0xc3, 0x0e, 0xb6,
+	0xdb, 0x6b, 0x39, 0xc3, 0xe6, 0x2a, 0xbf, 0x2a, 0xe0, 0xa0, 0x28, 0x70, 0x95, 0x8f, 0xbb, 0x9b,
+	0xf8, 0x1e, 0x9d, 0x11, 0x73, 0x95, 0xaf, 0x72, 0x30, 0x48, 0xbc, 0xfd, 0x11, 0x32, 0x1a, 0xd1,
+	0x5a, 0x37, 0x8a, 0x29, 0xbe, 0x58, 0x87, 0x30, 0xde, 0xa7, 0x05, 0xf9, 0x28, 0xa4, 0x28, 0xd0,
+	0xe9, 0xec, 0x4f, 0x90, 0x09, 0x7c, 0xc1, 0x57, 0xf7, 0x3a, 0x11, 0x8d, 0x71, 0x79, 0x73, 0x46,
+	0x99, 0xa0, 0x73, 0xe2, 0xc9, 0x89, 0x6b, 0x06, 0x16, 0x32, 0xd4, 0x38, 0x75, 0x76, 0x9b, 0x34,
+	0x70, 0xc6, 0xcc, 0xa9, 0xb3, 0xd1, 0xa4, 0x01, 0x30, 0x0c, 0xaa, 0x50, 0x75, 0xbf, 0x41, 0xe3,
+	0xc4, 0x19, 0x37, 0x55, 0xa8, 0x45, 0x06, 0x05, 0x81, 0x75, 0xff, 0x78, 0x88, 0xf4, 0xbc, 0x6e,
+	0xfb, 0x25, 0x32, 0x2a, 0x46, 0x6e, 0x39, 0x6c, 0xc4, 0xec, 0x13, 0x18, 0x9e, 0x9f, 0xc4, 0x1e,
+	0xcd, 0xa5, 0x60, 0xd0, 0x69, 0xec, 0x3a, 0x29, 0xc5, 0x57, 0xc4, 0xea, 0xb8, 0xfc, 0xf0, 0xaf,
+	0xb5, 0x7a, 0x45, 0x7d, 0xb3, 0x83, 0xf7, 0xee, 0xce, 0x94, 0xaa, 0x57, 0xa0, 0x14, 0x5f, 0xc1,
+	0x75, 0xb1, 0xe1, 0x27, 0xc5, 0xad, 0x8b, 0x4b, 0x7e, 0xa2, 0xe4, 0xb0, 0x75, 0x71, 0xc9, 0x4f,
+	0x00, 0x45, 0xe0, 0x7a, 0xdf, 0x4c, 0x92, 0x8e, 0x33, 0x50, 0xd4, 0x7a, 0x7f, 0x7d, 0x7d, 0x7d,
+	0x4d, 0xc9, 0x62, 0x4b, 0x01, 0x42, 0x80, 0x49, 0xb1, 0x7f, 0xd4, 0xc2, 0x11, 0xe7, 0xc8, 0x30,
+	0xda, 0x17, 0xdf, 0xf8, 0x6b, 0xc5, 0x7d, 0xe3, 0x61, 0xb4, 0xaf, 0x84, 0x8b, 0x17, 0xa9, 0x10,
+	0xa0, 0x8b, 0x66, 0x1d, 0xaf, 0x6f, 0xc5, 0xce, 0x60, 0x61, 0x1d, 0x5f, 0xbc, 0x56, 0xcd, 0x74,
+	0x7c, 0xf1, 0x5a, 0x15, 0x98, 0x14, 0x7c, 0xa1, 0x91, 0xb7, 0xeb, 0x0c, 0x15, 0xf5, 0x42, 0xc1,
+	0xdb, 0x35, 0x5f, 0x28, 0x78, 0xbb, 0x80, 0x22, 0x50, 0x52, 0x18, 0xc7, 0xce, 0x70, 0x51, 0x92,
+	0x56, 0xab, 0x55, 0x53, 0xd2, 0x6a, 0xb5, 0x0a, 0x28, 0x82, 0x4d, 0xd2, 0x5a, 0xec, 0x8c, 0x14,
+	0x25, 0x69, 0x69, 0x21, 0x23, 0x69, 0x69, 0xa1, 0x0a, 0x28, 0x02, 0x35, 0xf6, 0xb8, 0xd3, 0xf2,
+	0x13, 0xf6, 0x95, 0xf2, 0xb5, 0x87, 0x69, 0xec, 0x55, 0x09, 0x84, 0x14, 0xef, 0x7e, 0xc1, 0x22,
+	0xe3, 0x92, 0x0f, 0xae, 0x5d, 0xb1, 0xbd, 0x47, 0x86, 0xe5, 0x9b, 0x2f, 0x50, 0x8b, 0x94, 0x4d,
+	0x4d, 0xf5, 0x68, 0x01, 0x01, 0x25, 0xcd, 0xfd, 0xad, 0x0a, 0xb1, 0x15, 0x98, 0x76, 0xc2, 0xd8,
+	0x67, 0x73, 0xef, 0x01, 0xd6, 0x9d, 0x40, 0x5b, 0x77, 0xee, 0x14, 0xb9, 0xee, 0xa4, 0xcd, 0x32,
+	0x56, 0xa0, 0x9f, 0xca, 0x7c, 0xa9, 0x7c, 0x29, 0xfa, 0x81, 0x13, 0xf9, 0x52, 0xb5, 0x26, 0x1c,
+	0xfc, 0xcd, 0xee, 0x88, 0x6f, 0x96, 0x2f, 0x56, 0xdf, 0x5f, 0xec, 0x37, 0xab, 0xb5, 0x22, 0xfb,
+	0xf5, 0x46, 0xfc, 0x9b, 0xe2, 0xab, 0xd5, 0x46, 0xa1, 0xdf, 0x94, 0x26, 0xd5, 0xfc, 0xba, 0x22,
+	0xfe, 0x75, 0x0d, 0x16, 0x25, 0x73, 0x69, 0xa1, 0xaf, 0x4c, 0xf9, 0x9d, 0xb9, 0x6f, 0x93, 0xb3,
+	0xbd, 0x34, 0x40, 0xb7, 0xec, 0x4b, 0x64, 0xa4, 0x16, 0x06, 0x5b, 0x7e, 0x63, 0xc5, 0xeb, 0x08,
+	0x4d, 0x51, 0xa9, 0x98, 0x0b, 0x12, 0x01, 0x29, 0x8d, 0xfd, 0x14, 0x29, 0x6f, 0xd3, 0x7d, 0xa1,
+	0x32, 0x8e, 0x0a, 0xd2, 0xf2, 0x4d, 0xba, 0x0f, 0x08, 0xff, 0xd8, 0xf0, 0xcf, 0x7f, 0x69, 0xe6,
+	0xb1, 0xcf, 0xfe, 0xc1, 0xc5, 0xc7, 0xdc, 0x7f, 0x57, 0x26, 0x4f, 0xe4, 0xca, 0x14, 0xa7, 0xbf,
+	0xdf, 0xb2, 0xc8, 0x59, 0x2f, 0x0f, 0xef, 0x58, 0x45, 0x8d, 0x4c, 0xae, 0xf8, 0xf9, 0xa7, 0x44,
+	0xa3, 0xf3, 0x47, 0x04, 0xce, 0x7a, 0xfd, 0x06, 0x0a, 0x75, 0xe6, 0xb8, 0xe3, 0xd5, 0xa8, 0x53,
+	0x32, 0x07, 0xea, 0x96, 0x44, 0x40, 0x4a, 0x83, 0x3a, 0x58, 0x9d, 0x6e, 0x79, 0xdd, 0x16, 0xdf,
+	0xed, 0x87, 0x53, 0x1d, 0x6c, 0x91, 0x83, 0x41, 0xe2, 0xed, 0xbf, 0x6b, 0x11, 0xbb, 0x57, 0xaa,
+	0xf8, 0x18, 0xd6, 0x4f, 0x62, 0x1c, 0xe6, 0xcf, 0xdd, 0xbb, 0x3b, 0x93, 0xb3, 0x80, 0x41, 0x4e,
+	0x3b, 0xb4, 0x77, 0xfa, 0x6f, 0x2c, 0x72, 0x3a, 0xe7, 0x33, 0xc7, 0x49, 0xd1, 0x8d, 0x5a, 0x8e,
+	0x65, 0x4e, 0x8a, 0xd7, 0x60, 0x19, 0x10, 0x6e, 0xff, 0x8c, 0x45, 0x26, 0xb5, 0xaf, 0x7d, 0xae,
+	0x2b, 0xce, 0x1c, 0x05, 0xe9, 0xcf, 0x06, 0xe3, 0xf9, 0xf3, 0x42, 0xfc, 0x64, 0x06, 0x01, 0xd9,
+	0x26, 0xb8, 0x5f, 0xb3, 0xc8, 0x53, 0x07, 0x2e, 0x5a, 0xb9, 0x0d, 0xb7, 0x3e, 0xf4, 0x86, 0xe3,
+	0xd4, 0x8a, 0x68, 0x27, 0x7c, 0x0d, 0x96, 0xc5, 0x4c, 0x54, 0x53, 0x0b, 0x38, 0x18, 0x24, 0xde,
+	0xfd, 0x8f, 0x16, 0xc9, 0xf2, 0xb3, 0x3d, 0x32, 0xd1, 0x8d, 0x69, 0x84, 0x53, 0x55, 0xd8, 0xf7,
+	0xac, 0xe3, 0xd8, 0xf7, 0x98, 0x81, 0xec, 0x35, 0x83, 0x01, 0x64, 0x18, 0xa2, 0x88, 0x8e, 0x17,
+	0xc7, 0xbb, 0x61, 0x54, 0x17, 0x22, 0x4a, 0xc7, 0x16, 0xb1, 0x66, 0x30, 0x80, 0x0c, 0x43, 0xf7,
+	0x5f, 0x5a, 0x64, 0x68, 0xde, 0xab, 0x6d, 0x87, 0x5b, 0x5b, 0x78, 0x3a, 0xaa, 0x77, 0x23, 0x7e,
+	0xba, 0xcc, 0x58, 0xcc, 0x16, 0x05, 0x1c, 0x14, 0x85, 0xbd, 0x4e, 0x06, 0xf9, 0x70, 0x88, 0x46,
+	0x7d, 0x67, 0x5f, 0xd3, 0x16, 0x9a, 0x81, 0x67, 0xb9, 0x19, 0x78, 0xf6, 0x46, 0x90, 0xac, 0xa2,
+	0x6d, 0xc5, 0x0f, 0x1a, 0xf3, 0x04, 0xcf, 0x21, 0xd7, 0x18, 0x0f, 0x10, 0xbc, 0xf0, 0x20, 0xd5,
+	0xf6, 0xf6, 0xa4, 0x38, 0x61, 0x5d, 0x53, 0x07, 0xa9, 0x95, 0x14, 0x05, 0x3a, 0x9d, 0xfb, 0x29,
+	0x52, 0x59, 0xf0, 0x6a, 0x4d, 0x6a, 0xbf, 0x96, 0x5d, 0x89, 0x47, 0x2f, 0x3f, 0x97, 0x37, 0x5a,
+	0x6a, 0x55, 0xd6, 0x07, 0x6c, 0xbc, 0xdf, 0x7a, 0xed, 0xfe, 0x8c, 0x45, 0x86, 0x16, 0xbc, 0xa4,
+	0xd6, 0xec, 0x76, 0xec, 0x8f, 0x92, 0x41, 0x6e, 0xe5, 0x17, 0x83, 0x34, 0x23, 0x8f, 0x54, 0x6b,
+	0x0c, 0x7a, 0xff, 0xee, 0xcc, 0xb8, 0x20, 0xe5, 0x00, 0x10, 0xe4, 0xf6, 0x0c, 0xa9, 0xb4, 0xfc,
+	0xb6, 0xcf, 0xdf, 0x62, 0x65, 0x7e, 0x04, 0xcd, 0xb3, 0xcb, 0x08, 0x00, 0x0e, 0xc7, 0xd5, 0x51,
+	0xd9, 0x40, 0x9c, 0xb2, 0xb9, 0x3a, 0x2a, 0x43, 0x09, 0xa4, 0x34, 0xee, 0xbb, 0x84, 0x2c, 0x34,
+	0x69, 0x6d, 0x9b, 0x1b, 0xb6, 0xa5, 0x21, 0xc2, 0xea, 0x6b, 0x88, 0x78, 0x81, 0x0c, 0xfb, 0x41,
+	0x42, 0xa3, 0x1d, 0xaf, 0x95, 0x35, 0x94, 0xdf, 0x10, 0x70, 0x50, 0x14, 0x72, 0x93, 0x2a, 0xe7,
+	0x6f, 0x52, 0xee, 0x3f, 0x2f, 0x93, 0xf1, 0x85, 0xa6, 0xdf, 0xaa, 0x6f, 0x88, 0x8f, 0xd2, 0xfe,
+	0x55, 0x8b, 0x9c, 0x96, 0x5f, 0xe8, 0x3a, 0x6d, 0x77, 0x5a, 0x68, 0x3b, 0x54, 0x5b, 0x51, 0x01,
+	0xc7, 0x98, 0x8d, 0x5e, 0xe6, 0xf3, 0x4f, 0x88, 0x86, 0x9d, 0xce, 0x41, 0x42, 0x5e, 0x73, 0xec,
+	0xf7, 0xd0, 0xb8, 0x24, 0x4c, 0x5d, 0x62, 0xf2, 0xde, 0x2c, 0x62, 0x21, 0x12, 0x2c, 0x75, 0xeb,
+	0x92, 0x00, 0x41, 0x2a, 0xd0, 0xfe, 0xc0, 0x22, 0x23, 0x9d, 0x28, 0xec, 0x78, 0xcc, 0x6a, 0xcb,
+	0xf5, 0xc6, 0xd7, 0x1f, 0x5e, 0xbc, 0xf1, 0x26, 0xd6, 0x04, 0x7f, 0xb4, 0xe6, 0xb0, 0x49, 0x2d,
+	0x01, 0x14, 0x52, 0xd9, 0x2e, 0x25, 0x4e, 0xbf, 0xa7, 0xec, 0xe7, 0xc8, 0x70, 0xdc, 0xec, 0x26,
+	0xf5, 0x70, 0x37, 0x10, 0xfa, 0xf7, 0x18, 0xce, 0x92, 0xaa, 0x80, 0x81, 0xc2, 0xe2, 0xac, 0x8e,
+	0x68, 0x12, 0xed, 0x0b, 0xb3, 0x39, 0x9b, 0xd5, 0x80, 0x00, 0xe0, 0x70, 0xf7, 0xcf, 0x2d, 0x72,
+	0x7e, 0xa1, 0xd5, 0x8d, 0x13, 0x1a, 0x65, 0x5f, 0x91, 0xfd, 0x69, 0x32, 0x8c, 0x36, 0xef, 0xba,
+	0x97, 0x78, 0x8e, 0x75, 0xc8, 0x32, 0x62, 0x58, 0xc8, 0x57, 0x37, 0xdf, 0xa2, 0xb5, 0x64, 0x85,
+	0x26, 0x5e, 0x6a, 0x6e, 0x4a, 0x61, 0xa0, 0xb8, 0xda, 0x7b, 0x64, 0x20, 0xee, 0xd0, 0x5a, 0x71,
+	0x47, 0x83, 0x6c, 0x1f, 0xaa, 0x1d, 0x5a, 0x4b, 0x3f, 0x36, 0xfc, 0x05, 0x4c, 0xa2, 0xfb, 0xbf,
+	0x2d, 0xf2, 0x44, 0x9f, 0x7e, 0x2f, 0xfb, 0x71, 0x62, 0xbf, 0xd9, 0xd3, 0xf7, 0xd9, 0xa3, 0xf5,
+	0x1d, 0x9f, 0x66, 0x3d, 0x57, 0x1f, 0xaf, 0x84, 0x68, 0xfd, 0xfe, 0x41, 0x52, 0xf1, 0x13, 0xda,
+	0x96, 0xd6, 0xd3, 0x4f, 0x16, 0x30, 0xc3, 0xf2, 0xfb, 0x32, 0x3f, 0x2e, 0x5d, 0x4d, 0x37, 0x50,
+	0x1e, 0x70, 0xb1, 0xee, 0xbf, 0xb6, 0x08, 0x2e, 0xa5, 0x75, 0x5f, 0x58, 0x92, 0x06, 0x92, 0xfd,
+	0x8e, 0xb4, 0xa2, 0x3e, 0xa5, 0x3c, 0x1b, 0xfb, 0x1d, 0xca, 0xd6, 0x4b, 0x49, 0x88, 0x00, 0x60,
+	0xa4, 0xf6, 0xa7, 0xc8, 0x60, 0xcc, 0x74, 0x5c, 0xb1, 0x52, 0x5d, 0x93, 0xcb, 0x2c, 0xd7, 0x7c,
+	0xef, 0xdf, 0x9d, 0x39, 0x92, 0x5b, 0x74, 0x56, 0xf1, 0xe6, 0xcf, 0x81, 0xe0, 0x8a, 0xdb, 0x7f,
+	0x9b, 0xc6, 0xb1, 0xd7, 0xa0, 0x59, 0x1f, 0xce, 0x0a, 0x07, 0x83, 0xc4, 0xbb, 0x3f, 0x6b, 0x11,
+	0x6c, 0x62, 0xe2, 0xa1, 0x88, 0x5b, 0x68, 0xb8, 0xbb, 0xc5, 0xb6, 0x19, 0x0e, 0x10, 0x2f, 0xef,
+	0xa9, 0x3e, 0xdb, 0x0c, 0x27, 0x32, 0xce, 0x03, 0x1c, 0x04, 0x29, 0x0b, 0xfb, 0x65, 0x32, 0x56,
+	0xa7, 0x1d, 0x1a, 0xd4, 0x69, 0x50, 0xf3, 0xa9, 0x74, 0xa2, 0x4d, 0xdd, 0xbb, 0x3b, 0x33, 0xb6,
+	0xa8, 0xc1, 0xc1, 0xa0, 0x72, 0x43, 0xad, 0x59, 0x6b, 0x7e, 0x87, 0x1e, 0xcd, 0x58, 0xcd, 0x8c,
+	0xcd, 0xa5, 0xbe, 0xc6, 0xe6, 0x69, 0x52, 0x4a, 0x42, 0x31, 0x24, 0x44, 0xe0, 0x4b, 0xeb, 0x21,
+	0x94, 0x92, 0xd0, 0xfd, 0x15, 0x8b, 0x3c, 0xae, 0x24, 0x56, 0x69, 0xc2, 0xbe, 0x73, 0xe5, 0x85,
+	0x39, 0x9e, 0xfe, 0xb0, 0x81, 0xea, 0x57, 0x12, 0xf1, 0xde, 0x3e, 0x98, 0x02, 0x31, 0xca, 0x95,
+	0x35, 0xc6, 0x04, 0x24, 0x37, 0xf7, 0x67, 0x07, 0xc8, 0x19, 0xbd, 0x91, 0x6a, 0xb1, 0xf9, 0xbc,
+	0x45, 0x88, 0x1a, 0x72, 0x3c, 0x25, 0xe3, 0x87, 0xb1, 0x5a, 0xc0, 0x87, 0xa1, 0x4f, 0x8d, 0x74,
+	0x39, 0x52, 0xe0, 0x18, 0x34, 0xb1, 0xf6, 0x27, 0xc9, 0xd8, 0x4e, 0xd8, 0xea, 0xb6, 0xe9, 0x0a,
+	0xfa, 0x6d, 0xd1, 0xe1, 0x89, 0xcd, 0x98, 0xc9, 0x9b, 0x3d, 0x77, 0x52, 0xba, 0xf9, 0x33, 0x82,
+	0xed, 0x98, 0x06, 0x8c, 0xc1, 0x60, 0x85, 0x8a, 0xf6, 0x78, 0xa4, 0xbf, 0x12, 0x71, 0x24, 0x7f,
+	0xa3, 0xc0, 0x3e, 0x66, 0xdf, 0xfa, 0xfc, 0xa9, 0x7b, 0x77, 0x67, 0xc6, 0x0d, 0x10, 0x98, 0x8d,
+	0xb0, 0x7f, 0xc8, 0x22, 0x23, 0xc8, 0x91, 0x9f, 0xfa, 0x0a, 0x3b, 0xb1, 0xeb, 0x4d, 0xda, 0x90,
+	0xec, 0xf9, 0x76, 0xa7, 0x7e, 0x42, 0x2a, 0xd8, 0xfd, 0x35, 0x8b, 0x9c, 0xcd, 0x7d, 0x06, 0xf5,
+	0x2e, 0xe6, 0x44, 0x5f, 0x4b, 0xb5, 0x27, 0xf5, 0xb9, 0xae, 0x48, 0x04, 0xa4, 0x34, 0xf6, 0x1b,
+	0x64, 0x24, 0xf6, 0xdf, 0xa1, 0xcb, 0x4a, 0x9b, 0x3b, 0x64, 0xed, 0x9e, 0x95, 0xa1, 0x1d, 0xb3,
+	0xb7, 0xbb, 0x5e, 0x90, 0xf8, 0xc9, 0xbe, 0x30, 0xd0, 0x49, 0x26, 0x90, 0xf2, 0x73, 0x3f, 0x49,
+	0xd8, 0xd4, 0xf1, 0x83, 0x2e, 0x5d, 0x0d, 0xec, 0xa7, 0x49, 0x85, 0x46, 0x51, 0x18, 0x89, 0x5d,
+	0x58, 0x2d, 0xb6, 0x57, 0x11, 0x08, 0x1c, 0x87, 0x56, 0xfe, 0x2d, 0xcf, 0x6f, 0x29, 0xdf, 0xb5,
+	0xb2, 0xf2, 0x5f, 0x63, 0x50, 0x10, 0x58, 0x77, 0x96, 0x0c, 0x2d, 0x60, 0x27, 0x68, 0x84, 0x7c,
+	0xf5, 0x78, 0x81, 0x71, 0x23, 0x5e, 0x40, 0xc6, 0x05, 0xac, 0x93, 0xb3, 0x0b, 0x11, 0xc5, 0x4d,
+	0xee, 0xca, 0x7c, 0xb7, 0xb6, 0x4d, 0x13, 0xee, 0x25, 0x89, 0xed, 0x8f, 0x93, 0xf1, 0x90, 0xed,
+	0xb6, 0xcb, 0x61, 0x6d, 0xdb, 0x0f, 0x1a, 0xe2, 0x70, 0x7e, 0x56, 0x70, 0x19, 0x5f, 0xd5, 0x91,
+	0x60, 0xd2, 0xba, 0x7f, 0x58, 0x22, 0x63, 0x0b, 0x51, 0x18, 0x28, 0xbd, 0xf1, 0xe4, 0xb5, 0x80,
+	0xc4, 0xd0, 0x02, 0x0a, 0x70, 0x9a, 0xe9, 0xed, 0xef, 0xa7, 0x01, 0xd8, 0xef, 0xa9, 0x2d, 0xac,
+	0x5c, 0x94, 0x11, 0xc2, 0x90, 0xcb, 0x78, 0xa7, 0x2f, 0xdb, 0xdc, 0xe0, 0xdc, 0x3f, 0xb2, 0xc8,
+	0x94, 0x4e, 0xfe, 0x08, 0x94, 0x8e, 0xd8, 0x54, 0x3a, 0x6e, 0x15, 0xdb, 0xdf, 0x3e, 0x9a, 0xc6,
+	0x7d, 0x62, 0xf6, 0x13, 0x5f, 0x00, 0xba, 0x4c, 0xc7, 0x76, 0x35, 0x80, 0xe8, 0xec, 0xad, 0xe2,
+	0xf4, 0x3f, 0xf6, 0xd6, 0xbf, 0x55, 0xae, 0xca, 0x3a, 0xf4, 0x7e, 0xe6, 0x37, 0x18, 0x2d, 0xc1,
+	0x6d, 0x12, 0x03, 0xa9, 0xea, 0xdd, 0x16, 0xcd, 0x1e, 0xc2, 0xaa, 0x02, 0x0e, 0x8a, 0xc2, 0x7e,
+	0x93, 0x9c, 0xaa, 0x85, 0x41, 0xad, 0x1b, 0x45, 0x34, 0xa8, 0xed, 0xf3, 0x13, 0xa5, 0xd8, 0x9d,
+	0x67, 0x65, 0x90, 0xcc, 0x42, 0x96, 0xe0, 0x7e, 0x1e, 0x10, 0x7a, 0x19, 0x71, 0x17, 0x67, 0x8c,
+	0x2a, 0x85, 0x33, 0x60, 0x9a, 0xd7, 0xaa, 0x1c, 0x0c, 0x12, 0x6f, 0xbf, 0x46, 0xce, 0xc7, 0x89,
+	0x17, 0x25, 0x7e, 0xd0, 0x58, 0xa4, 0x5e, 0xbd, 0xe5, 0x07, 0x68, 0xa6, 0x08, 0x83, 0x3a, 0x37,
+	0xfc, 0x96, 0xe7, 0x9f, 0xb8, 0x77, 0x77, 0xe6, 0x7c, 0x35, 0x9f, 0x04, 0xfa, 0x3d, 0x6b, 0x7f,
+	0x8a, 0x4c, 0xc7, 0x5d, 0x16, 0x3b, 0xb5, 0xd5, 0x6d, 0xbd, 0x1a, 0x6e, 0xc6, 0xd7, 0xfd, 0x18,
+	0x6d, 0x2c, 0x7c, 0x6d, 0x1d, 0x64, 0x27, 0xe5, 0x0b, 0xf7, 0xee, 0xce, 0x4c, 0x57, 0xfb, 0x52,
+	0xc1, 0x01, 0x1c, 0x6c, 0x20, 0xe7, 0xf8, 0xe2, 0xd7, 0xc3, 0x7b, 0x88, 0xf1, 0x9e, 0xbe, 0x77,
+	0x77, 0xe6, 0xdc, 0xb5, 0x5c, 0x0a, 0xe8, 0xf3, 0x24, 0xbe, 0x41, 0x8c, 0xc6, 0x79, 0x07, 0x03,
+	0x41, 0x86, 0xcd, 0x37, 0xb8, 0x2e, 0xe0, 0xa0, 0x28, 0xec, 0xb7, 0xd2, 0x99, 0x88, 0x9f, 0x8b,
+	0x33, 0xf2, 0x80, 0x2b, 0xdc, 0x19, 0x74, 0xc9, 0x6f, 0x68, 0x9c, 0xf0, 0x93, 0x03, 0x83, 0x37,
+	0xf3, 0x04, 0x89, 0x99, 0x83, 0x9e, 0x20, 0x15, 0xbb, 0x25, 0x27, 0x16, 0x7a, 0x82, 0xe4, 0xbf,
+	0x76, 0x87, 0x0c, 0xd5, 0xb8, 0xa1, 0x82, 0xb9, 0x9d, 0x47, 0x2f, 0xdf, 0x28, 0xe0, 0x7b, 0xe5,
+	0x0c, 0xb9, 0x6a, 0x26, 0x7e, 0x80, 0x14, 0x63, 0x37, 0xc9, 0x99, 0xba, 0xb7, 0xdf, 0xf2, 0x1b,
+	0xcd, 0xa4, 0xea, 0xed, 0xf8, 0x41, 0x43, 0xcc, 0x67, 0xee, 0xbf, 0x7e, 0x59, 0x0c, 0xe2, 0x99,
+	0xc5, 0x1c, 0x9a, 0xfb, 0x7d, 0xe0, 0x90, 0xcb, 0x11, 0xb7, 0xb7, 0xb8, 0xd3, 0xf2, 0xf6, 0x85,
+	0xdb, 0x5b, 0xad, 0x1c, 0x55, 0x04, 0x02, 0xc7, 0xa1, 0x62, 0x32, 0x16, 0x27, 0xa1, 0x8a, 0x89,
+	0x71, 0x26, 0x8a, 0x5a, 0x24, 0xaa, 0x1a, 0x57, 0xae, 0xc6, 0xeb, 0x10, 0x30, 0xa4, 0xe2, 0x27,
+	0xde, 0x89, 0xe8, 0x8e, 0x1f, 0x76, 0x63, 0xe8, 0x06, 0x62, 0x48, 0x26, 0xcd, 0x4f, 0x7c, 0x2d,
+	0x4b, 0x70, 0x3f, 0x0f, 0x08, 0xbd, 0x8c, 0x54, 0x8c, 0xc0, 0x54, 0xdf, 0x18, 0x81, 0x8f, 0x91,
+	0x09, 0xfc, 0xab, 0x0c, 0x5f, 0xb1, 0x73, 0x2a, 0x8d, 0xe1, 0xdb, 0x30, 0x30, 0x90, 0xa1, 0x74,
+	0xff, 0xa4, 0x42, 0xec, 0xde, 0x3d, 0xc9, 0xbe, 0x49, 0x06, 0xbd, 0x5a, 0x82, 0x11, 0x1e, 0x3c,
+	0x78, 0xe8, 0xe9, 0x3c, 0xf5, 0x96, 0xcf, 0x6d, 0xa0, 0x5b, 0x14, 0x97, 0x24, 0x9a, 0x6e, 0x64,
+	0x73, 0xec, 0x51, 0x10, 0x2c, 0xec, 0x90, 0x9c, 0x6a, 0x79, 0x71, 0x22, 0xe7, 0x70, 0x1d, 0xbf,
+	0x31, 0xa7, 0x74, 0xec, 0x78, 0xba, 0xb3, 0x38, 0x8e, 0xcb, 0x59, 0x46, 0xd0, 0xcb, 0x1b, 0xc3,
+	0x9f, 0x6a, 0xf2, 0xd4, 0x28, 0x15, 0xf4, 0x9b, 0x85, 0x28, 0xac, 0x9c, 0xa7, 0x71, 0x46, 0x10,
+	0x62, 0x40, 0x13, 0x69, 0xef, 0x10, 0x3b, 0xa0, 0x7b, 0x66, 0xab, 0xe4, 0x81, 0xe5, 0x38, 0x5d,
+	0x9e, 0x16, 0x72, 0xec, 0x5b, 0x3d, 0xdc, 0x20, 0x47, 0x02, 0x2a, 0xc2, 0x6c, 0x29, 0xa5, 0x75,
+	0x5a, 0x17, 0xab, 0xba, 0x52, 0x84, 0xab, 0x12, 0x01, 0x29, 0x8d, 0xa6, 0x78, 0x0e, 0x32, 0xea,
+	0x3e, 0x8a, 0xa7, 0xbd, 0x42, 0x4e, 0xd7, 0xc2, 0x20, 0xa6, 0xb5, 0x2e, 0xbe, 0x51, 0x44, 0x76,
+	0x23, 0x1a, 0xb3, 0x25, 0xb8, 0x9c, 0x5a, 0xf0, 0x16, 0x7a, 0x49, 0x20, 0xef, 0x39, 0x7b, 0x8f,
+	0x9c, 0xa9, 0xd3, 0x96, 0xb7, 0x4f, 0xeb, 0xe6, 0xa4, 0x18, 0x3e, 0xf6, 0xa4, 0x70, 0xd8, 0x7a,
+	0x93, 0xc3, 0x0b, 0x72, 0x25, 0xb8, 0x9f, 0x1d, 0x23, 0x43, 0x8b, 0x73, 0x4b, 0xeb, 0x5e, 0xbc,
+	0x7d, 0x84, 0xd3, 0x36, 0x6e, 0x14, 0xe2, 0xf4, 0x99, 0xdd, 0xea, 0x95, 0x41, 0x52, 0x51, 0xd8,
+	0x01, 0x19, 0xf4, 0x03, 0xdc, 0x1b, 0x9d, 0x89, 0xa2, 0xbc, 0xf0, 0x52, 0x0a, 0xb7, 0xb5, 0xdf,
+	0x60, 0xdc, 0x41, 0x48, 0x31, 0xed, 0xa0, 0xe5, 0x47, 0x6d, 0x07, 0xfd, 0xac, 0x45, 0x46, 0x13,
+	0xcd, 0x48, 0x3c, 0x50, 0x58, 0xf0, 0x66, 0xca, 0x94, 0xfb, 0xcb, 0x35, 0x00, 0xe8, 0x22, 0x7b,
+	0xac, 0x2e, 0x95, 0xa3, 0x58, 0x5d, 0xec, 0x5d, 0x32, 0xb2, 0xeb, 0x27, 0x4d, 0xa6, 0x83, 0x3a,
+	0x83, 0xec, 0x9b, 0xbc, 0xf6, 0xf0, 0xad, 0x46, 0x76, 0xe9, 0x88, 0x6d, 0x48, 0x01, 0x90, 0xca,
+	0xc2, 0xaf, 0x13, 0x7f, 0x30, 0x4f, 0x80, 0x33, 0x64, 0x1e, 0x53, 0x37, 0x24, 0x02, 0x52, 0x1a,
+	0x1c, 0xe2, 0x31, 0xfc, 0x55, 0xa5, 0x6f, 0x77, 0x71, 0x85, 0x75, 0x86, 0x8b, 0x9a, 0x57, 0x92,
+	0x23, 0x1f, 0xac, 0x0d, 0x4d, 0x06, 0x18, 0x12, 0xed, 0x57, 0x78, 0x0b, 0xa4, 0xf3, 0x4c, 0x6c,
+	0x6b, 0xca, 0x98, 0xb1, 0xa1, 0xe1, 0xc0, 0xa0, 0xc4, 0xa8, 0x94, 0x58, 0xee, 0xcb, 0x53, 0x45,
+	0xed, 0xcb, 0xf8, 0xdd, 0xaa, 0x7d, 0x99, 0x5b, 0xb4, 0xc5, 0x2f, 0x50, 0xd2, 0xd4, 0x8e, 0x39,
+	0xd2, 0x77, 0xc7, 0x7c, 0x8f, 0x1b, 0x92, 0xf8, 0x11, 0xdd, 0x21, 0x45, 0x45, 0xbb, 0xa5, 0xc7,
+	0xfe, 0xf9, 0x09, 0x69, 0x41, 0xe2, 0xbf, 0x41, 0x93, 0x87, 0x8b, 0x6e, 0x18, 0x5c, 0xdd, 0xf3,
+	0x13, 0x11, 0x2d, 0xa8, 0x16, 0xdd, 0x55, 0x06, 0x05, 0x81, 0xe5, 0xbe, 0x73, 0x9c, 0xb8, 0xb1,
+	0x50, 0xb0, 0x34, 0xdf, 0x39, 0x03, 0x83, 0xc4, 0xdb, 0xbf, 0x64, 0x91, 0x4a, 0x33, 0x0c, 0xb7,
+	0x63, 0x67, 0xfc, 0x62, 0xb9, 0x98, 0x93, 0xaa, 0x58, 0x25, 0x67, 0xaf, 0x23, 0xdb, 0xab, 0x41,
+	0x12, 0xed, 0xcf, 0xbf, 0x24, 0xb5, 0x30, 0x06, 0xbb, 0x7f, 0x77, 0x66, 0x62, 0xd9, 0xdf, 0xa2,
+	0xb5, 0xfd, 0x5a, 0x8b, 0x32, 0xc8, 0xe7, 0xbe, 0xaa, 0x41, 0xae, 0xee, 0x60, 0x1c, 0x3d, 0x6f,
+	0xd5, 0xf4, 0x17, 0x2c, 0x42, 0x52, 0x46, 0xf6, 0x14, 0xf7, 0x4c, 0xb1, 0x85, 0x97, 0x39, 0xa3,
+	0x6c, 0x2a, 0xcd, 0x19, 0x5c, 0x2f, 0x28, 0xc0, 0xaa, 0x67, 0x34, 0x4d, 0x18, 0x44, 0x3e, 0x56,
+	0x7a, 0xc5, 0x72, 0xff, 0xad, 0x45, 0x46, 0xb1, 0x73, 0x72, 0xd9, 0x7e, 0x96, 0x0c, 0x26, 0x5e,
+	0xd4, 0x10, 0x0e, 0x60, 0xed, 0x75, 0xac, 0x33, 0x28, 0x08, 0xac, 0x1d, 0x90, 0x4a, 0xe2, 0xc5,
+	0xdb, 0xf2, 0x70, 0x7c, 0xa3, 0xb0, 0x21, 0x4e, 0xb5, 0x5b, 0xfc, 0x15, 0x03, 0x17, 0x83, 0x2e,
+	0x1c, 0xdc, 0x7d, 0xaf, 0x79, 0xb1, 0x8c, 0x9d, 0x60, 0x13, 0xfe, 0x9a, 0x80, 0x81, 0xc2, 0xba,
+	0x3f, 0x5d, 0x22, 0x03, 0x8b, 0xdc, 0x4c, 0x32, 0xc8, 0xed, 0x54, 0x8e, 0x55, 0xd4, 0x9c, 0x46,
+	0xbe, 0x55, 0xc6, 0x53, 0x33, 0x54, 0xb0, 0xdf, 0x20, 0x64, 0xa1, 0xd9, 0x72, 0x22, 0x89, 0xbc,
+	0x20, 0xde, 0x0a, 0xa3, 0x36, 0x37, 0x1f, 0x97, 0x8a, 0x9a, 0x85, 0xeb, 0x06, 0xdf, 0x6a, 0x42,
+	0x3b, 0x69, 0x70, 0xad, 0x89, 0x83, 0x4c, 0x1b, 0xdc, 0xbf, 0x63, 0x11, 0x92, 0xb6, 0x1e, 0x63,
+	0x33, 0xc7, 0x3d, 0x3d, 0x6e, 0xce, 0xb1, 0x8a, 0x9a, 0x6a, 0x46, 0x38, 0x1e, 0x37, 0xa8, 0x1a,
+	0x20, 0x30, 0x05, 0xbb, 0x77, 0xc8, 0xa9, 0xab, 0x8d, 0x88, 0xc6, 0xf1, 0x22, 0x8d, 0x13, 0x3f,
+	0xe0, 0xf6, 0xf4, 0x8b, 0x64, 0xa0, 0xe6, 0xd7, 0xa3, 0xac, 0x36, 0xb2, 0x70, 0x63, 0x11, 0x80,
+	0x61, 0xd0, 0x53, 0xd7, 0x09, 0x23, 0x11, 0x50, 0x2f, 0xfc, 0xcf, 0x6b, 0x08, 0x00, 0x0e, 0x77,
+	0x3f, 0x42, 0x2a, 0xec, 0xab, 0x63, 0x26, 0x0a, 0xe1, 0x14, 0xcf, 0x5a, 0xf2, 0xa5, 0xb3, 0x1c,
+	0x14, 0x85, 0xfb, 0x26, 0x99, 0xb8, 0xba, 0x87, 0x2a, 0x5a, 0x18, 0xf1, 0x93, 0x81, 0xfd, 0x2a,
+	0xb1, 0x63, 0x1a, 0xed, 0xf8, 0x35, 0x3a, 0x57, 0xab, 0xa1, 0xc1, 0xf1, 0x56, 0xaa, 0x27, 0x29,
+	0x9d, 0xb4, 0xda, 0x43, 0x01, 0x39, 0x4f, 0xb9, 0x1f, 0x58, 0xe4, 0xdc, 0xd5, 0xbd, 0x84, 0x46,
+	0x81, 0xd7, 0xe2, 0x41, 0x0b, 0xb2, 0x09, 0xd8, 0xcc, 0x8e, 0xc8, 0x15, 0xcb, 0x36, 0x53, 0xe6,
+	0x90, 0x81, 0xa2, 0x38, 0x42, 0x9c, 0xfe, 0x21, 0x0e, 0xef, 0xdf, 0xb4, 0xc8, 0xa8, 0x16, 0x26,
+	0x86, 0xfa, 0x53, 0x63, 0xa1, 0xca, 0x0d, 0xa3, 0x8e, 0x55, 0x94, 0xfe, 0xb4, 0x24, 0x59, 0xa6,
+	0x9b, 0xbb, 0x02, 0x41, 0x2a, 0xf0, 0x90, 0x10, 0x32, 0xf7, 0x5f, 0x59, 0xe4, 0x6c, 0x6e, 0x4c,
+	0xdb, 0x87, 0xdc, 0xec, 0x4b, 0x64, 0x64, 0x9b, 0xee, 0x5f, 0x63, 0x5f, 0x59, 0x36, 0x02, 0xec,
+	0xa6, 0x44, 0x40, 0x4a, 0xe3, 0xfe, 0xb6, 0x45, 0x52, 0x4e, 0xb8, 0xd8, 0x6e, 0xa6, 0x2d, 0xd7,
+	0x16, 0x5b, 0x21, 0x49, 0x60, 0xed, 0xf7, 0xc8, 0x79, 0x73, 0x2e, 0xa5, 0x69, 0x78, 0xc7, 0x8a,
+	0xa1, 0xe1, 0x46, 0xad, 0x7c, 0x4e, 0xd0, 0x4f, 0x84, 0xfb, 0xe5, 0x01, 0x32, 0xb0, 0x04, 0x6b,
+	0x0b, 0x47, 0xde, 0x1b, 0x9e, 0x25, 0x83, 0x6d, 0x9a, 0x34, 0xc3, 0xba, 0x53, 0x32, 0xe9, 0x56,
+	0x18, 0x14, 0x04, 0xd6, 0xf6, 0xc8, 0x78, 0x9d, 0xc6, 0xb5, 0xc8, 0xef, 0x24, 0x21, 0xfa, 0x30,
+	0x9c, 0xf2, 0x31, 0x43, 0x5c, 0xd8, 0xe2, 0xb2, 0xa8, 0xb3, 0x00, 0x93, 0x23, 0x0f, 0x8b, 0x7a,
+	0xbb, 0x8b, 0x29, 0x03, 0x03, 0xd9, 0xb0, 0x28, 0x06, 0x06, 0x89, 0xb7, 0xdf, 0xd1, 0x8c, 0xc9,
+	0x95, 0x8b, 0xe5, 0x62, 0x36, 0x0c, 0x0c, 0x87, 0xbf, 0x4e, 0xbd, 0x3a, 0x8d, 0xd2, 0xaf, 0x59,
+	0x59, 0xbb, 0x94, 0x3c, 0xbb, 0x4e, 0xca, 0x49, 0x4b, 0xc6, 0x7f, 0x16, 0xb0, 0x97, 0xe2, 0xeb,
+	0x5a, 0x5f, 0xae, 0x8a, 0x74, 0xaf, 0xe5, 0x2a, 0x20, 0x7b, 0x34, 0x8d, 0xa0, 0x1d, 0x2f, 0xec,
+	0x26, 0xd2, 0xd6, 0xc9, 0x8f, 0xac, 0xcc, 0x34, 0xb2, 0x6e, 0x60, 0x20, 0x43, 0x69, 0x2f, 0x92,
+	0x29, 0x61, 0x97, 0x54, 0xa7, 0x7c, 0x61, 0x2d, 0x54, 0x09, 0x36, 0xd5, 0x0c, 0x1e, 0x7a, 0x9e,
+	0x70, 0x7f, 0xa7, 0x4c, 0x86, 0x44, 0xdb, 0x30, 0xd9, 0x06, 0x67, 0x1c, 0x8d, 0xb4, 0xe5, 0x54,
+	0x99, 0x12, 0xaa, 0x0a, 0x03, 0x1a, 0x15, 0x2e, 0xc5, 0x3e, 0x3b, 0x40, 0x47, 0xb4, 0xba, 0xed,
+	0x77, 0xee, 0xd0, 0xc8, 0xdf, 0x92, 0xb1, 0x1a, 0x6a, 0x29, 0xbe, 0xd1, 0x43, 0x01, 0x39, 0x4f,
+	0xd9, 0x6f, 0x90, 0xb1, 0x9a, 0xb7, 0x40, 0xa3, 0xe4, 0x41, 0xd2, 0x66, 0xd9, 0x49, 0x61, 0x61,
+	0x2e, 0x7d, 0x1c, 0x0c, 0x66, 0x76, 0x83, 0x4c, 0xd5, 0x5a, 0x3e, 0x0d, 0x12, 0x4d, 0xc0, 0xb1,
+	0x32, 0x66, 0x99, 0x7d, 0x74, 0x21, 0xc3, 0x02, 0x7a, 0x98, 0x62, 0x66, 0x2e, 0x87, 0xa5, 0x4b,
+	0x42, 0xe5, 0xd8, 0x99, 0xb9, 0x0b, 0x26, 0x07, 0xc8, 0xb2, 0x74, 0xef, 0x90, 0xca, 0x92, 0xd7,
+	0x6d, 0xd0, 0x23, 0x39, 0xda, 0x50, 0x57, 0x8b, 0xa8, 0xd7, 0x4a, 0xa4, 0x65, 0x4b, 0xe8, 0x6a,
+	0x20, 0x60, 0xa0, 0xb0, 0xee, 0x9f, 0x0f, 0x90, 0x51, 0x2d, 0x5d, 0x05, 0x77, 0xb5, 0x88, 0x76,
+	0xc2, 0xec, 0xb6, 0x8f, 0xeb, 0x3d, 0x30, 0x0c, 0xee, 0x92, 0x68, 0x14, 0x8c, 0xb9, 0x5e, 0x65,
+	0xec, 0x92, 0x20, 0xe0, 0xa0, 0x28, 0x50, 0x49, 0xa8, 0xd3, 0x4e, 0xd2, 0x64, 0x2f, 0x77, 0x80,
+	0x2b, 0x09, 0x8b, 0x08, 0x00, 0x0e, 0x47, 0x82, 0x2d, 0x9a, 0xd4, 0x9a, 0xcc, 0x1c, 0x35, 0xc2,
+	0x09, 0xae, 0x21, 0x00, 0x38, 0x3c, 0x27, 0x30, 0xb2, 0x72, 0xf2, 0x81, 0x91, 0x83, 0x05, 0x07,
+	0x46, 0xda, 0x1d, 0x72, 0x3a, 0x8e, 0x9b, 0x6b, 0x91, 0xbf, 0xe3, 0x25, 0x34, 0x9d, 0x29, 0x43,
+	0xc7, 0x91, 0x73, 0x1e, 0x8d, 0x5a, 0xd5, 0xea, 0xf5, 0x2c, 0x17, 0xc8, 0x63, 0x6d, 0x57, 0xc9,
+	0x59, 0xf9, 0xcd, 0xdd, 0x68, 0x04, 0x61, 0x44, 0xaf, 0x87, 0x31, 0xb2, 0x13, 0x99, 0x6a, 0x2a,
+	0xe0, 0xfa, 0x46, 0x1e, 0x11, 0xe4, 0x3f, 0x8b, 0x39, 0xd6, 0x75, 0x3f, 0xf6, 0x36, 0x5b, 0xb4,
+	0xda, 0xdd, 0x6c, 0x87, 0xdc, 0x31, 0x30, 0xc2, 0x18, 0xaa, 0x1c, 0xeb, 0xc5, 0x2c, 0x01, 0xf4,
+	0x3e, 0xe3, 0x7e, 0xc5, 0x22, 0x63, 0x7a, 0x3a, 0x00, 0x1a, 0x17, 0x48, 0x73, 0xf1, 0x5a, 0x95,
+	0x6f, 0x33, 0xc5, 0x1d, 0x18, 0xae, 0x2b, 0x9e, 0xe9, 0xda, 0x96, 0xc2, 0x40, 0x93, 0x79, 0x04,
+	0x8d, 0xee, 0x69, 0x52, 0xd9, 0x0a, 0xf1, 0x3c, 0x53, 0x36, 0xbd, 0xe7, 0xd7, 0x10, 0x08, 0x1c,
+	0xe7, 0xfe, 0x4f, 0x8b, 0x9c, 0xcb, 0xcf, 0x74, 0xf8, 0x66, 0xe8, 0xe4, 0x65, 0xcc, 0xc5, 0x4d,
+	0x9a, 0x86, 0xc6, 0xa4, 0xa5, 0xcf, 0x4a, 0x0c, 0x68, 0x54, 0x47, 0xeb, 0xf6, 0x5f, 0xe0, 0x99,
+	0x3a, 0x95, 0xf3, 0xe3, 0x16, 0x19, 0x47, 0xb1, 0x37, 0xa3, 0x4d, 0xa3, 0xb7, 0xab, 0xc5, 0xf4,
+	0x56, 0xb1, 0x4d, 0x83, 0x04, 0x0c, 0x30, 0x98, 0xc2, 0x59, 0x15, 0x82, 0x7a, 0x1d, 0x0f, 0x39,
+	0x2a, 0x1c, 0x8a, 0x57, 0x21, 0x90, 0x40, 0x48, 0xf1, 0xb8, 0xc4, 0x61, 0x22, 0x0a, 0xae, 0x1a,
+	0x4e, 0xd9, 0x5c, 0xe2, 0x50, 0x08, 0xc2, 0x41, 0x51, 0xb8, 0x3f, 0x31, 0x40, 0x4c, 0xd9, 0xb8,
+	0x25, 0x6c, 0x47, 0x9b, 0x0b, 0x2c, 0x84, 0xf8, 0x41, 0x82, 0xb9, 0xd9, 0x96, 0x70, 0xd3, 0xe4,
+	0x00, 0x59, 0x96, 0x42, 0xca, 0x4d, 0xba, 0x9f, 0x78, 0x9b, 0x0f, 0xa2, 0x8b, 0x4a, 0x29, 0x3a,
+	0x07, 0xc8, 0xb2, 0xc4, 0x08, 0xea, 0xed, 0x68, 0x53, 0x2e, 0xa0, 0xd9, 0x08, 0xea, 0x9b, 0x29,
+	0x0a, 0x74, 0x3a, 0x1c, 0xc2, 0xed, 0x68, 0x13, 0x37, 0x1c, 0x99, 0x89, 0xac, 0x86, 0xf0, 0xa6,
+	0x80, 0x83, 0xa2, 0xb0, 0x3b, 0xc4, 0xde, 0x96, 0xa3, 0xa7, 0xf4, 0x4c, 0xa7, 0x72, 0x4c, 0x65,
+	0x94, 0xa5, 0x4f, 0xdc, 0xec, 0xe1, 0x03, 0x39, 0xbc, 0xed, 0x4f, 0x92, 0xf3, 0xdb, 0xd1, 0xa6,
+	0xd0, 0xc4, 0xd7, 0x22, 0x3f, 0xa8, 0xf9, 0x1d, 0x23, 0xeb, 0x58, 0x86, 0x61, 0x9f, 0xbf, 0x99,
+	0x4f, 0x06, 0xfd, 0x9e, 0x77, 0xbf, 0x52, 0x26, 0x2c, 0x09, 0x53, 0xd3, 0xc2, 0xad, 0x03, 0xb5,
+	0x70, 0x91, 0xa8, 0x51, 0xea, 0x93, 0xa8, 0xb1, 0x4b, 0x86, 0x9a, 0x4c, 0x81, 0x95, 0xbe, 0xa3,
+	0x62, 0xb5, 0x62, 0xa5, 0x8f, 0xf3, 0xdf, 0x31, 0x48, 0x69, 0x39, 0xda, 0xea, 0xc0, 0x43, 0x69,
+	0xab, 0x83, 0xc7, 0xd5, 0x56, 0x71, 0x45, 0xde, 0x0c, 0xeb, 0x3c, 0xee, 0x4c, 0x5b, 0x91, 0xe7,
+	0xc3, 0xfa, 0x3e, 0x30, 0x8c, 0xdd, 0x22, 0x95, 0x1a, 0x7e, 0x13, 0xce, 0x50, 0x51, 0x27, 0x4f,
+	0x1c, 0x1a, 0xf6, 0x99, 0x71, 0x5d, 0x84, 0xfd, 0x0b, 0x5c, 0x08, 0x06, 0x2c, 0x8e, 0xe9, 0x19,
+	0xb7, 0x87, 0xe5, 0xd8, 0xc4, 0xe9, 0xab, 0xe3, 0x26, 0xa8, 0xeb, 0x05, 0xb4, 0xef, 0x90, 0xd7,
+	0xe6, 0x7e, 0xc9, 0x22, 0x23, 0xaa, 0x13, 0xf2, 0x5c, 0x6f, 0xe5, 0x9f, 0xeb, 0x11, 0x9d, 0x24,
+	0x3d, 0x73, 0x6f, 0x7d, 0x7d, 0x19, 0x10, 0x6e, 0xbf, 0x46, 0x86, 0xda, 0xde, 0x1e, 0xc6, 0x95,
+	0x39, 0xe5, 0xc3, 0xc3, 0x7b, 0x72, 0xe2, 0xd2, 0x98, 0xe3, 0x7e, 0x85, 0xb3, 0x00, 0xc9, 0xcb,
+	0xfd, 0x7d, 0xdc, 0x2b, 0xd4, 0x14, 0x3c, 0x82, 0xe7, 0xeb, 0x69, 0xdd, 0x1e, 0xdb, 0x4f, 0xeb,
+	0xfd, 0x0c, 0x19, 0x61, 0xff, 0x60, 0xf4, 0xa9, 0x53, 0x2e, 0x2a, 0x34, 0x2b, 0x6d, 0xa7, 0xb0,
+	0x3b, 0xb2, 0x7d, 0xe3, 0x8e, 0x14, 0x04, 0xa9, 0x4c, 0x37, 0x24, 0x53, 0x59, 0x6a, 0x3c, 0xe4,
+	0xa8, 0x2a, 0x3b, 0x69, 0xf2, 0xc2, 0x71, 0x0e, 0x39, 0x55, 0xed, 0x71, 0x30, 0x98, 0xb9, 0xab,
+	0x64, 0xb0, 0xd0, 0x21, 0xc4, 0xa0, 0xc6, 0x11, 0x16, 0x9b, 0xd2, 0x40, 0x87, 0x8f, 0x7a, 0xa4,
+	0x7c, 0xc0, 0xa8, 0xc7, 0x64, 0x88, 0x1b, 0x49, 0xa4, 0x47, 0xb9, 0x80, 0x39, 0xce, 0x8b, 0x10,
+	0xa5, 0x73, 0x9c, 0x5b, 0x63, 0x62, 0x90, 0x92, 0xdc, 0x1f, 0x29, 0x91, 0xc1, 0x1b, 0x41, 0xa7,
+	0xfb, 0x57, 0xbe, 0xb8, 0xc8, 0x0a, 0x19, 0x40, 0x6f, 0x9e, 0x59, 0xaf, 0x69, 0x6c, 0xfe, 0x19,
+	0xbd, 0x56, 0x93, 0x63, 0xd6, 0x6a, 0x02, 0x6f, 0x57, 0x86, 0xa4, 0x0b, 0x37, 0x44, 0x9a, 0x4b,
+	0xf8, 0x02, 0x19, 0x59, 0xf6, 0x36, 0x69, 0xeb, 0x26, 0xdd, 0x8f, 0xf1, 0x68, 0xc6, 0xc3, 0xef,
+	0xac, 0xf4, 0x68, 0x66, 0x84, 0xca, 0xcd, 0x92, 0x51, 0x46, 0xcd, 0x04, 0x1d, 0x81, 0xfe, 0xcf,
+	0x4a, 0x64, 0xdc, 0xf0, 0x83, 0x18, 0x1e, 0x6d, 0xeb, 0x50, 0x8f, 0xf6, 0x87, 0x9b, 0x69, 0x93,
+	0xf5, 0x30, 0x97, 0x1f, 0xbd, 0x87, 0xf9, 0x32, 0x21, 0x34, 0x2d, 0xee, 0x31, 0x60, 0x2a, 0xef,
+	0x5a, 0x61, 0x0f, 0x8d, 0xca, 0x6d, 0x91, 0x81, 0x65, 0x3f, 0xd8, 0x3e, 0xda, 0x0a, 0x11, 0xd7,
+	0xc2, 0x4e, 0xcf, 0x0a, 0x51, 0x45, 0x20, 0x70, 0x9c, 0xdc, 0xf1, 0xca, 0xf9, 0x3b, 0x9e, 0x7b,
+	0xb7, 0x44, 0x06, 0x57, 0xbc, 0x24, 0xf2, 0xf7, 0xec, 0x80, 0x0c, 0x78, 0x7b, 0x54, 0x7e, 0x92,
+	0x05, 0x28, 0x2d, 0x9c, 0xef, 0xdc, 0x9e, 0x1f, 0xa7, 0xcd, 0x9f, 0xdb, 0xa3, 0x31, 0x30, 0x39,
+	0xf6, 0xdb, 0x64, 0x88, 0xee, 0xd5, 0x5a, 0xdd, 0x3a, 0x75, 0x4a, 0x85, 0xba, 0xd1, 0xd5, 0x32,
+	0x74, 0x95, 0xb3, 0x07, 0x29, 0x07, 0x45, 0xfa, 0x01, 0x17, 0x59, 0x3e, 0x19, 0x91, 0x37, 0x02,
+	0x21, 0x52, 0xc8, 0x71, 0xff, 0x9e, 0x45, 0x48, 0x3a, 0x10, 0x47, 0x78, 0xab, 0x01, 0x19, 0x64,
+	0x5f, 0x79, 0x5c, 0xf0, 0xa8, 0x28, 0x6d, 0x96, 0x7f, 0xfd, 0x20, 0xa4, 0xb8, 0x9f, 0xb3, 0xc8,
+	0xa9, 0x15, 0xda, 0x0e, 0xfd, 0x77, 0xbc, 0x34, 0x4d, 0x06, 0xa7, 0x4d, 0xd3, 0x4f, 0x44, 0xd4,
+	0xb9, 0x9a, 0x36, 0xd7, 0xb1, 0x2e, 0x4a, 0xd3, 0x3f, 0xcc, 0xfb, 0xc0, 0x12, 0xe2, 0x51, 0x9b,
+	0xb9, 0x95, 0x1e, 0x41, 0xd2, 0x04, 0x18, 0x89, 0x80, 0x94, 0xc6, 0xfd, 0x03, 0x8b, 0x0c, 0xf1,
+	0x46, 0x1c, 0xaa, 0x01, 0x35, 0xa5, 0x06, 0xc9, 0x17, 0x94, 0xa5, 0x22, 0x82, 0x16, 0x73, 0xb5,
+	0x47, 0x76, 0x1e, 0xf0, 0xf6, 0xe6, 0x54, 0x86, 0x50, 0x7a, 0x1e, 0x60, 0x50, 0x10, 0x58, 0x7c,
+	0xa7, 0x5e, 0x37, 0x09, 0x45, 0x08, 0x6d, 0x3a, 0xd5, 0xbb, 0x49, 0x08, 0x0c, 0xe3, 0xfe, 0x62,
+	0x99, 0x28, 0x23, 0x36, 0xaf, 0x1d, 0x11, 0x04, 0x61, 0xe2, 0xf1, 0x00, 0x33, 0xfe, 0xbd, 0x15,
+	0x90, 0xa4, 0x21, 0x25, 0xcc, 0xce, 0xa5, 0xdc, 0xb9, 0xe7, 0x5d, 0x9d, 0xff, 0x34, 0x0c, 0xe8,
+	0x8d, 0xb0, 0x7f, 0x90, 0x0c, 0xb6, 0x70, 0x6b, 0x90, 0xb3, 0xee, 0x4e, 0x81, 0xcd, 0x61, 0x7b,
+	0x8e, 0x68, 0x89, 0x1a, 0x43, 0x0e, 0x04, 0x21, 0x75, 0xfa, 0x13, 0x64, 0x2a, 0xdb, 0xea, 0x1c,
+	0x37, 0xff, 0x19, 0x43, 0x27, 0xd2, 0xbc, 0xf2, 0xd3, 0xdf, 0x2d, 0xb6, 0xb6, 0xe3, 0x3f, 0xea,
+	0xde, 0x26, 0xa3, 0x2b, 0x34, 0x89, 0xfc, 0x1a, 0x63, 0x70, 0xd8, 0xf4, 0x3b, 0x92, 0x5a, 0xf6,
+	0x01, 0x9b, 0xce, 0xc8, 0x33, 0xc6, 0x60, 0x91, 0x4e, 0x14, 0xe2, 0xd1, 0x91, 0x76, 0x0b, 0x5c,
+	0x5c, 0xd7, 0x14, 0x4f, 0x1e, 0x2c, 0x92, 0xfe, 0x06, 0x4d, 0x9e, 0xfb, 0x3c, 0xa9, 0xac, 0x74,
+	0x13, 0xba, 0x77, 0xf8, 0xc2, 0xe3, 0xbe, 0x41, 0xc6, 0x18, 0xe9, 0xf5, 0xb0, 0x85, 0xca, 0x07,
+	0xf6, 0xb4, 0x8d, 0xbf, 0xb3, 0x96, 0x6b, 0x46, 0x04, 0x1c, 0x87, 0xdf, 0x48, 0x33, 0x6c, 0xa1,
+	0x07, 0x36, 0xe3, 0xb9, 0xba, 0xce, 0xa0, 0x20, 0xb0, 0xee, 0xe7, 0x4b, 0x64, 0x94, 0x3d, 0x28,
+	0xd6, 0x97, 0x7d, 0x32, 0xd4, 0xe4, 0x72, 0xc4, 0x90, 0x14, 0x10, 0xdd, 0xa3, 0xb7, 0x5e, 0x3b,
+	0x6f, 0x71, 0x00, 0x48, 0x79, 0x28, 0x7a, 0xd7, 0xf3, 0x31, 0x18, 0xdd, 0x29, 0x9d, 0xac, 0xe8,
+	0x0d, 0x2e, 0x06, 0xa4, 0x3c, 0xf7, 0xef, 0x97, 0x08, 0xc1, 0x2c, 0x31, 0xa0, 0x31, 0x96, 0xac,
+	0xf8, 0x4e, 0x52, 0xe9, 0x34, 0xbd, 0x38, 0xeb, 0x1a, 0xaf, 0xac, 0x21, 0xf0, 0x3e, 0xd6, 0xc4,
+	0x08, 0xeb, 0x94, 0xfd, 0x00, 0x4e, 0xa8, 0x67, 0x2d, 0x96, 0x0e, 0xce, 0x5a, 0xc4, 0xf0, 0xee,
+	0xb0, 0x9b, 0xa0, 0xca, 0xed, 0x94, 0x8b, 0xf2, 0x92, 0xad, 0x72, 0x86, 0xfc, 0x94, 0x28, 0x7e,
+	0x80, 0x14, 0x83, 0x36, 0x04, 0xf1, 0xef, 0xea, 0xd6, 0x56, 0x2b, 0xf4, 0x30, 0x8a, 0x94, 0xaf,
+	0x89, 0xca, 0x86, 0xb0, 0x9a, 0xc1, 0x43, 0xcf, 0x13, 0xee, 0x9f, 0xda, 0x7c, 0x8c, 0xc4, 0x44,
+	0x99, 0x26, 0x25, 0x5f, 0x1a, 0x64, 0x54, 0x3e, 0xe2, 0x8d, 0x45, 0x28, 0xf9, 0x75, 0x35, 0xa7,
+	0x4b, 0x7d, 0x37, 0xd3, 0x8f, 0x90, 0xd1, 0xba, 0xcf, 0xa2, 0xbd, 0x6f, 0xe5, 0x58, 0xc3, 0x16,
+	0x53, 0x14, 0xe8, 0x74, 0xf6, 0x0b, 0x22, 0x5f, 0x75, 0xc0, 0xb0, 0x80, 0xc8, 0x7c, 0xd5, 0x61,
+	0x6c, 0x9e, 0x96, 0xaa, 0xfa, 0x0a, 0x19, 0x93, 0x4a, 0x1f, 0x93, 0x52, 0x31, 0x83, 0xdc, 0xd6,
+	0x35, 0x1c, 0x18, 0x94, 0x3d, 0x2a, 0xea, 0xe0, 0xa3, 0x57, 0x51, 0x3f, 0x4e, 0xc6, 0xe5, 0x4f,
+	0xa6, 0x37, 0x3a, 0x67, 0x58, 0xeb, 0x95, 0x95, 0x76, 0x5d, 0x47, 0x82, 0x49, 0x9b, 0x4e, 0xe0,
+	0xa1, 0xa3, 0x4e, 0xe0, 0xcb, 0x84, 0x6c, 0x86, 0xdd, 0xa0, 0xee, 0x45, 0xfb, 0x37, 0x16, 0x9d,
+	0x61, 0x53, 0x23, 0x9e, 0x57, 0x18, 0xd0, 0xa8, 0xf4, 0x49, 0x3f, 0x72, 0xc8, 0xa4, 0xc7, 0xcc,
+	0xbc, 0xc4, 0x8b, 0x12, 0x5a, 0x9f, 0x4b, 0x1c, 0x72, 0xec, 0x70, 0xe0, 0x34, 0xda, 0x59, 0x32,
+	0x81, 0x94, 0x9f, 0xfd, 0x29, 0x42, 0xb6, 0xfc, 0xc0, 0x8f, 0x9b, 0x8c, 0xfb, 0xe8, 0xb1, 0xb9,
+	0xab, 0x7e, 0x5e, 0x53, 0x5c, 0x40, 0xe3, 0x88, 0x89, 0x00, 0x34, 0x4e, 0xfc, 0xb6, 0x97, 0xd0,
+	0xba, 0x2a, 0x81, 0xe1, 0x30, 0x13, 0x9e, 0x4a, 0x04, 0xb8, 0x9a, 0x25, 0xb8, 0x9f, 0x07, 0x84,
+	0x5e, 0x46, 0xf6, 0x2b, 0x2c, 0x5a, 0x86, 0xc5, 0x0d, 0x39, 0xd3, 0x6c, 0x18, 0x9f, 0xd4, 0xa2,
+	0x65, 0x18, 0xfc, 0xbe, 0xf6, 0x3f, 0x28, 0x6a, 0xfb, 0x1b, 0x16, 0x56, 0xea, 0xe5, 0x96, 0xa2,
+	0x58, 0x35, 0xec, 0x2c, 0x5b, 0x3b, 0x6b, 0x45, 0x94, 0x40, 0x95, 0x1f, 0xfb, 0x2c, 0x64, 0xa5,
+	0x70, 0xa5, 0x81, 0xa6, 0xe5, 0x80, 0x33, 0xf8, 0xfb, 0x79, 0xc0, 0xcf, 0x7d, 0x75, 0x66, 0xa6,
+	0xb7, 0x6e, 0xb7, 0x62, 0x8e, 0x5f, 0xde, 0xdf, 0xfc, 0xea, 0xcc, 0x94, 0xfc, 0x9d, 0x0e, 0x5a,
+	0x4f, 0x27, 0xed, 0xbf, 0x61, 0x91, 0x71, 0x35, 0x94, 0x0b, 0x61, 0x9c, 0x38, 0x4f, 0x5e, 0xb4,
+	0x0a, 0xb5, 0x99, 0xb0, 0x88, 0x8c, 0xab, 0xba, 0x08, 0x30, 0x25, 0xb2, 0xc8, 0x33, 0xd9, 0xb2,
+	0xd7, 0xd8, 0x57, 0xf0, 0x54, 0x51, 0x9e, 0x19, 0xd0, 0xd9, 0xca, 0x54, 0x5e, 0x0d, 0x04, 0xa6,
+	0x60, 0x54, 0x09, 0x3a, 0x61, 0xfd, 0xc6, 0x9a, 0x33, 0x66, 0xaa, 0x04, 0x6b, 0x08, 0x04, 0x8e,
+	0x43, 0x67, 0x76, 0xdd, 0xa3, 0xed, 0x30, 0xa0, 0x75, 0x67, 0x3c, 0x75, 0x66, 0x2f, 0x0a, 0x18,
+	0x28, 0xac, 0xdd, 0xc2, 0x88, 0x77, 0xb6, 0x43, 0x4d, 0x14, 0x35, 0xaa, 0xdc, 0xc8, 0x24, 0xe3,
+	0xdd, 0xf1, 0x7f, 0x10, 0x32, 0xf4, 0x0d, 0x71, 0xf2, 0xd1, 0x6c, 0x88, 0xcf, 0x91, 0xe1, 0x1a,
+	0x56, 0xd8, 0x88, 0x58, 0xfe, 0x0d, 0xda, 0x58, 0xd8, 0x48, 0x2c, 0x08, 0x18, 0x28, 0xac, 0xfd,
+	0x51, 0x32, 0x1e, 0x76, 0x13, 0xb6, 0xe6, 0xe1, 0xe7, 0x20, 0x53, 0x70, 0xd8, 0x1b, 0x59, 0xd5,
+	0x11, 0x60, 0xd2, 0xe1, 0xde, 0xd3, 0x0c, 0xe3, 0x04, 0x7f, 0xb0, 0xbd, 0xe7, 0x9c, 0xb9, 0xf7,
+	0x5c, 0xd7, 0x70, 0x60, 0x50, 0x62, 0x8a, 0xe4, 0xa9, 0x76, 0xf6, 0xdc, 0xe7, 0x9c, 0x67, 0x23,
+	0x53, 0x2d, 0x42, 0xfb, 0xcf, 0xb0, 0xe6, 0x09, 0x38, 0x3d, 0x60, 0xe8, 0x6d, 0x04, 0xab, 0x6a,
+	0x16, 0xef, 0x07, 0xb5, 0x66, 0x14, 0x06, 0x66, 0xf3, 0x1e, 0x2f, 0x2a, 0xa1, 0x9d, 0x2d, 0x3a,
+	0x79, 0x22, 0xe6, 0x1f, 0x47, 0x27, 0x7b, 0x2e, 0x0a, 0xf2, 0x1b, 0x85, 0x71, 0x50, 0x9e, 0x28,
+	0x62, 0xed, 0x3c, 0xc1, 0x1a, 0xb8, 0x56, 0x5c, 0x59, 0x6c, 0xd1, 0xaa, 0xb1, 0xb4, 0x14, 0x39,
+	0x16, 0xe9, 0x91, 0xf2, 0xa6, 0x17, 0xc9, 0xb9, 0xfc, 0x45, 0xf3, 0xb0, 0x23, 0x50, 0x59, 0x3f,
+	0x02, 0x5d, 0x23, 0x8f, 0xf7, 0x1d, 0x10, 0xdc, 0x7e, 0xa5, 0xbe, 0x6c, 0x99, 0xdb, 0x6f, 0x8f,
+	0x7e, 0x3b, 0x41, 0xc6, 0xf4, 0x82, 0xd6, 0xee, 0x2f, 0x95, 0x11, 0x90, 0xf8, 0x5b, 0xbe, 0x28,
+	0x29, 0xbb, 0xd8, 0x13, 0xb2, 0xf9, 0x5c, 0x36, 0x64, 0x13, 0x33, 0xfd, 0xf4, 0x67, 0x72, 0x42,
+	0x39, 0xb7, 0xc9, 0xd4, 0x2e, 0xdd, 0xc4, 0xd0, 0xf0, 0xd7, 0x60, 0xf9, 0x41, 0x5c, 0xa9, 0x4a,
+	0x1f, 0xdd, 0xc8, 0xb0, 0x81, 0x1e, 0xc6, 0xd8, 0xfd, 0x5a, 0xd3, 0x0b, 0x02, 0xda, 0xea, 0x29,
+	0xf6, 0xce, 0xc1, 0x20, 0xf1, 0xb8, 0x3c, 0x26, 0x7e, 0xd2, 0x92, 0x7a, 0x63, 0x1a, 0x97, 0x8d,
+	0x40, 0xe0, 0x38, 0x5d, 0x9b, 0xa9, 0x1c, 0xa2, 0xcd, 0x3c, 0x4d, 0x2a, 0xb5, 0xb0, 0x15, 0x46,
+	0xce, 0xa0, 0xc9, 0x6f, 0x01, 0x81, 0xc0, 0x71, 0x0f, 0x13, 0xa3, 0xc6, 0x42, 0x5a, 0xb5, 0x6a,
+	0x8b, 0x68, 0xb0, 0x0d, 0xab, 0x85, 0xc7, 0x86, 0xae, 0x56, 0x7b, 0x62, 0x43, 0x15, 0x08, 0x52,
+	0x81, 0x47, 0x09, 0x69, 0xcd, 0x2d, 0x0d, 0xf9, 0x21, 0x37, 0xfb, 0xd8, 0x21, 0xad, 0xff, 0x61,
+	0x80, 0xa4, 0x9c, 0x8c, 0xdb, 0x2b, 0xac, 0x43, 0x6f, 0xaf, 0x48, 0x03, 0x60, 0x4b, 0x07, 0x06,
+	0xc0, 0xfe, 0x7f, 0x74, 0xcb, 0x85, 0xfd, 0x26, 0x71, 0x6a, 0xac, 0x0c, 0x05, 0xef, 0xe3, 0x8d,
+	0xad, 0x5b, 0x61, 0xb2, 0x16, 0xd1, 0x18, 0xef, 0x5f, 0xa8, 0x30, 0x05, 0xe3, 0xa2, 0x18, 0x05,
+	0x67, 0xa1, 0x0f, 0x1d, 0xf4, 0xe5, 0x80, 0x07, 0x20, 0x16, 0x39, 0xe5, 0x27, 0xfb, 0xeb, 0xe1,
+	0x36, 0x95, 0x6e, 0x6f, 0x75, 0x00, 0xaa, 0xea, 0x48, 0x30, 0x69, 0xed, 0x1f, 0xb3, 0xc8, 0x78,
+	0x4b, 0x7a, 0x48, 0x00, 0x53, 0xfa, 0x87, 0x8a, 0xf2, 0x63, 0xae, 0x56, 0xab, 0xcb, 0x3a, 0x67,
+	0xae, 0x0c, 0x18, 0x20, 0x30, 0x65, 0xa3, 0x9b, 0x76, 0x2a, 0xfb, 0x98, 0xbd, 0x4d, 0x9e, 0x6a,
+	0x7b, 0xd1, 0xf6, 0x8d, 0x60, 0x8b, 0xc5, 0xed, 0x06, 0x09, 0x7f, 0xab, 0x73, 0x5b, 0x09, 0x8d,
+	0x16, 0xbd, 0x7d, 0x9e, 0xc7, 0x50, 0x51, 0x37, 0x86, 0x3c, 0xb5, 0x72, 0x10, 0x31, 0x1c, 0xcc,
+	0x0b, 0x83, 0xd8, 0x90, 0x60, 0x91, 0xb6, 0x28, 0xae, 0xdd, 0xa9, 0x10, 0x5e, 0xf3, 0x4e, 0x05,
+	0xb1, 0xad, 0xe4, 0x11, 0x41, 0xfe, 0xb3, 0xee, 0x30, 0x19, 0xe4, 0xb9, 0xc2, 0xee, 0xff, 0x2a,
+	0x11, 0xa9, 0x65, 0xfd, 0xd5, 0xf6, 0x23, 0xda, 0x2e, 0xde, 0x8d, 0x13, 0xcb, 0xba, 0xa8, 0x23,
+	0x5c, 0xe1, 0xe5, 0x66, 0x25, 0x10, 0x18, 0x54, 0x3f, 0xe9, 0x9e, 0x9f, 0x2c, 0x60, 0x49, 0x7a,
+	0x71, 0xbb, 0x00, 0x5b, 0x55, 0x04, 0x0c, 0x14, 0x16, 0xb9, 0xc5, 0x49, 0x9d, 0x46, 0x91, 0x53,
+	0x49, 0xb9, 0x55, 0x19, 0x04, 0x04, 0xc6, 0xfd, 0x21, 0x8b, 0x8c, 0xe3, 0x48, 0xb4, 0x5a, 0xb4,
+	0x85, 0x89, 0x34, 0x31, 0x96, 0xfb, 0x88, 0xf1, 0x9f, 0xe2, 0x2c, 0x78, 0x69, 0x1a, 0x39, 0xed,
+	0x68, 0xfe, 0x2c, 0x14, 0x02, 0x5c, 0x96, 0xfb, 0xcb, 0x03, 0x24, 0x2d, 0x86, 0x78, 0x04, 0x77,
+	0xca, 0xe5, 0xb4, 0x82, 0x2c, 0x5f, 0x31, 0x1d, 0xad, 0x7a, 0x2c, 0x9a, 0x28, 0xe6, 0x82, 0x7d,
+	0x5e, 0x4f, 0x2a, 0x2d, 0x25, 0xfb, 0x82, 0xe9, 0x47, 0x3f, 0xa7, 0x3b, 0x67, 0x35, 0x7a, 0x4e,
+	0x64, 0xef, 0xe9, 0x61, 0x0c, 0x03, 0x45, 0xed, 0x3e, 0x2a, 0x60, 0xa1, 0x7f, 0xfc, 0x42, 0xe6,
+	0xf6, 0x85, 0xca, 0x91, 0x6e, 0x5f, 0x78, 0x9e, 0x0c, 0xd0, 0xa0, 0xdb, 0x66, 0x99, 0xab, 0x23,
+	0x4c, 0x27, 0x1f, 0xb8, 0x1a, 0x74, 0xdb, 0x66, 0xcf, 0x18, 0x89, 0xfd, 0x09, 0x32, 0x2a, 0x73,
+	0x03, 0xf0, 0xc0, 0xcf, 0x6d, 0x3c, 0x4f, 0x32, 0xc3, 0x59, 0x0a, 0x36, 0x1f, 0xd4, 0x1f, 0xb0,
+	0xbb, 0x64, 0x90, 0x5f, 0x19, 0xe5, 0x0c, 0x17, 0x55, 0xee, 0x55, 0xbd, 0xf9, 0x2a, 0x63, 0x2c,
+	0x26, 0x2a, 0xfb, 0x1f, 0x84, 0x30, 0xf7, 0xeb, 0x25, 0x32, 0x99, 0xa1, 0xe3, 0x41, 0xd0, 0xfc,
+	0xde, 0x20, 0xe1, 0xd3, 0xd2, 0x82, 0xa0, 0x39, 0x1c, 0x14, 0x85, 0x1a, 0xa3, 0xd2, 0xe1, 0x63,
+	0xf4, 0x3c, 0x19, 0xea, 0x78, 0x49, 0x42, 0xa3, 0x20, 0xab, 0x1d, 0xae, 0x71, 0x30, 0x48, 0xbc,
+	0x1d, 0x92, 0xa1, 0xb6, 0x1f, 0xf8, 0xed, 0xae, 0x9c, 0x25, 0xc5, 0x19, 0x11, 0x78, 0xd4, 0x0e,
+	0x67, 0x0e, 0x52, 0x0a, 0x13, 0xe8, 0xed, 0x31, 0x81, 0x95, 0x13, 0x11, 0xc8, 0x99, 0x83, 0x94,
+	0xe2, 0xbe, 0x43, 0x06, 0xd7, 0x5a, 0xdd, 0x86, 0x1f, 0xd8, 0x1d, 0x32, 0xc8, 0x8b, 0x3e, 0x39,
+	0x56, 0x51, 0x92, 0xf9, 0x16, 0xa0, 0x65, 0xbb, 0xb2, 0xdf, 0x20, 0xe4, 0xb8, 0xff, 0xd4, 0x22,
+	0x68, 0x86, 0x58, 0x5a, 0xb0, 0xbf, 0x47, 0xcb, 0x1c, 0xe6, 0xeb, 0xc2, 0xb7, 0xa8, 0xec, 0x35,
+	0x01, 0xc7, 0xa2, 0x83, 0x8c, 0x38, 0x27, 0xfd, 0xb7, 0x45, 0xc6, 0x99, 0x4f, 0x4a, 0x2a, 0x29,
+	0xe2, 0x64, 0x71, 0xe5, 0x88, 0x75, 0x92, 0xf4, 0x47, 0xc5, 0x96, 0xad, 0x83, 0xc0, 0x64, 0xee,
+	0xfe, 0xd1, 0x00, 0xd1, 0x5c, 0x37, 0x47, 0x58, 0xcf, 0xde, 0xce, 0x38, 0xea, 0x56, 0x0a, 0x71,
+	0xd4, 0x49, 0xef, 0x17, 0xff, 0xa0, 0x4c, 0xdf, 0x1c, 0x36, 0xaa, 0x49, 0x5b, 0x1d, 0xa7, 0x6c,
+	0x36, 0xea, 0x3a, 0x6d, 0x75, 0x80, 0x61, 0x54, 0xca, 0xf4, 0x40, 0xdf, 0x94, 0xe9, 0x26, 0xa9,
+	0x34, 0x30, 0x1f, 0xc2, 0xa9, 0x14, 0xe5, 0xb5, 0x65, 0xe9, 0x15, 0xdc, 0x6b, 0xcb, 0xfe, 0x05,
+	0x2e, 0x00, 0x97, 0xe3, 0xa6, 0x8c, 0x88, 0x72, 0x06, 0x8b, 0x5a, 0x8e, 0x55, 0x90, 0x15, 0x5f,
+	0x8e, 0xd5, 0x4f, 0x48, 0x85, 0xb1, 0x82, 0x3a, 0xbc, 0xbc, 0x9a, 0x33, 0x54, 0x94, 0x81, 0x49,
+	0xd4, 0x6b, 0x13, 0x05, 0x75, 0xf8, 0x0f, 0x90, 0x62, 0xf8, 0x0e, 0xcf, 0x2c, 0xf2, 0x91, 0x48,
+	0x13, 0x10, 0x3b, 0x3c, 0x87, 0x81, 0xc2, 0xba, 0x97, 0xc8, 0xa8, 0x76, 0x29, 0x06, 0xbe, 0x30,
+	0x55, 0x03, 0x4c, 0x7b, 0x61, 0x98, 0xef, 0x0a, 0x0c, 0xe3, 0xfe, 0x61, 0x99, 0x28, 0x0b, 0xa9,
+	0x9e, 0xeb, 0xec, 0xd5, 0x92, 0x9c, 0x1b, 0xf9, 0xe6, 0x18, 0x14, 0x04, 0x16, 0x75, 0xea, 0x36,
+	0x8d, 0x1a, 0xca, 0x10, 0xe0, 0x94, 0x4c, 0x9d, 0x7a, 0x45, 0x47, 0x82, 0x49, 0x8b, 0x6b, 0x75,
+	0xdb, 0x0b, 0xfc, 0x2d, 0x1a, 0x27, 0xd9, 0x68, 0xee, 0x15, 0x01, 0x07, 0x45, 0x81, 0x19, 0x0e,
+	0x31, 0x4d, 0x56, 0x77, 0x03, 0x1a, 0xa9, 0x52, 0x32, 0xce, 0x80, 0x99, 0xe1, 0x50, 0xcd, 0x12,
+	0x40, 0xef, 0x33, 0xb9, 0x11, 0xb0, 0x95, 0x63, 0x47, 0xc0, 0x2e, 0x92, 0xa9, 0x2d, 0x5e, 0xa6,
+	0xa4, 0x6f, 0x1c, 0xed, 0xb5, 0x0c, 0x1e, 0x7a, 0x9e, 0x60, 0x49, 0x36, 0x2d, 0xaf, 0x81, 0x47,
+	0xf9, 0x34, 0xc9, 0x06, 0x01, 0xc0, 0xe1, 0xd8, 0x6b, 0x55, 0x2f, 0x66, 0xd9, 0x0b, 0x1a, 0x5d,
+	0x34, 0x27, 0x70, 0x6f, 0xca, 0xe3, 0x5a, 0x59, 0x30, 0x93, 0x00, 0x7a, 0x9f, 0x71, 0x3f, 0x18,
+	0x24, 0xa6, 0xc9, 0xd7, 0xfe, 0x1f, 0x16, 0x19, 0xe8, 0x50, 0x6f, 0xdb, 0xb1, 0x8a, 0xaa, 0x1c,
+	0x6b, 0xf0, 0x9f, 0x5d, 0xa3, 0xde, 0x36, 0x37, 0xeb, 0x7f, 0xde, 0x52, 0x29, 0x1b, 0xd4, 0xdb,
+	0xbe, 0x7f, 0xf7, 0x40, 0xab, 0x3d, 0x96, 0x9b, 0x3b, 0x9a, 0x61, 0xff, 0xc5, 0xa3, 0x5c, 0xb2,
+	0xa9, 0x22, 0x5e, 0x81, 0x75, 0xd6, 0xfe, 0xbf, 0x16, 0x19, 0xf2, 0x76, 0x68, 0xc4, 0x3d, 0xab,
+	0xd8, 0xf1, 0x37, 0x8b, 0xee, 0xf8, 0x1c, 0x67, 0xcf, 0xfb, 0xfe, 0x23, 0xb2, 0xef, 0x43, 0x02,
+	0xfc, 0x61, 0x75, 0x5f, 0xf6, 0x9a, 0xd5, 0x82, 0xf3, 0xda, 0x1d, 0x4c, 0x10, 0x2a, 0x33, 0x03,
+	0x52, 0x5a, 0x0b, 0x8e, 0x83, 0x41, 0xe2, 0xa7, 0x1b, 0x64, 0x44, 0xbd, 0xc5, 0x1c, 0x3b, 0xe3,
+	0xa2, 0x59, 0x8c, 0xe1, 0x98, 0x21, 0xc8, 0x7a, 0x54, 0xc7, 0x5b, 0x64, 0x4c, 0x1f, 0xb5, 0x93,
+	0x94, 0xe5, 0xfe, 0x43, 0x8b, 0xf0, 0x3a, 0xa6, 0x73, 0x5b, 0xe8, 0x93, 0x4b, 0xf6, 0xed, 0x5f,
+	0xb0, 0xc8, 0x54, 0x10, 0xd6, 0xe9, 0x5c, 0x90, 0xf8, 0x12, 0x58, 0xdc, 0xbd, 0x1a, 0x4c, 0xd6,
+	0xad, 0x0c, 0x7b, 0x9e, 0xc5, 0x98, 0x85, 0x42, 0x4f, 0x33, 0xdc, 0xf3, 0xe4, 0x6c, 0x2e, 0x03,
+	0xf7, 0xf7, 0xcb, 0xc4, 0x2c, 0xc7, 0x6a, 0xdf, 0x96, 0x75, 0xe7, 0xad, 0x07, 0xac, 0xb3, 0xdb,
+	0x5b, 0xa9, 0x7e, 0x11, 0xef, 0x3b, 0x4b, 0x22, 0x59, 0x8f, 0x90, 0xaf, 0xee, 0x6e, 0x7a, 0xdf,
+	0x99, 0x42, 0xdd, 0x37, 0x7f, 0x82, 0xfe, 0x98, 0xfd, 0x2e, 0x19, 0xda, 0xe4, 0x77, 0x0f, 0x14,
+	0x17, 0xa1, 0x20, 0x2e, 0x33, 0x60, 0x67, 0x36, 0x79, 0xb3, 0xc1, 0xfd, 0xf4, 0x5f, 0x90, 0x12,
+	0xed, 0x7d, 0x32, 0xec, 0xc9, 0x77, 0x3a, 0x50, 0x9c, 0x3f, 0x4d, 0x9b, 0x3f, 0xc2, 0x66, 0x2f,
+	0xdf, 0xa1, 0x12, 0x97, 0x89, 0x0a, 0xad, 0x1c, 0x29, 0x2a, 0xf4, 0xd7, 0x2c, 0x42, 0xaa, 0x57,
+	0x8c, 0xea, 0x38, 0x57, 0x0c, 0x83, 0x67, 0x11, 0x55, 0x7d, 0x04, 0x47, 0xad, 0xda, 0x83, 0x80,
+	0x80, 0x92, 0x76, 0x98, 0x91, 0xf6, 0xcf, 0x2c, 0x72, 0x26, 0xef, 0xf6, 0xa4, 0x0f, 0xb1, 0xc5,
+	0xc7, 0xb5, 0xcf, 0x8a, 0x07, 0xd6, 0x22, 0xba, 0xe5, 0xef, 0x65, 0xa3, 0x17, 0x6f, 0x4a, 0x04,
+	0xa4, 0x34, 0xee, 0x6f, 0x0c, 0x11, 0x25, 0xf8, 0x84, 0xec, 0xb9, 0xe9, 0x5d, 0xc8, 0xe5, 0x03,
+	0xef, 0x42, 0x7e, 0x0e, 0xaf, 0x78, 0xe0, 0x99, 0xa0, 0x32, 0x1e, 0x91, 0x5f, 0xef, 0xc0, 0x61,
+	0xa0, 0xb0, 0x79, 0x16, 0xe2, 0xca, 0x23, 0xb1, 0x10, 0x0f, 0x16, 0x6f, 0x21, 0xc6, 0xa2, 0x05,
+	0x61, 0x8b, 0xce, 0xc1, 0x2d, 0x61, 0xa5, 0x48, 0x8b, 0x16, 0x70, 0x30, 0x48, 0x3c, 0x46, 0x04,
+	0x75, 0x63, 0x5a, 0x5d, 0xbc, 0xb9, 0x10, 0xd1, 0x7a, 0x2c, 0xb4, 0x66, 0x15, 0x11, 0xf4, 0x5a,
+	0x8a, 0x02, 0x9d, 0xce, 0xfe, 0x6d, 0xeb, 0x00, 0x23, 0xf4, 0x48, 0x61, 0x35, 0xad, 0xf3, 0xaa,
+	0x2d, 0xcf, 0x3f, 0xf9, 0x80, 0x96, 0xed, 0x5f, 0xb4, 0xc8, 0x29, 0x1a, 0xd4, 0xa2, 0x7d, 0xc6,
+	0x47, 0x70, 0x73, 0x48, 0x51, 0xb7, 0x71, 0x54, 0xaf, 0x5c, 0xcd, 0x32, 0xe7, 0x3e, 0xde, 0x1e,
+	0x30, 0xf4, 0x36, 0xc3, 0xee, 0xa2, 0x35, 0x24, 0x8a, 0xc2, 0x28, 0x76, 0x46, 0x8b, 0xb2, 0x9d,
+	0x56, 0xaf, 0xac, 0x30, 0x96, 0x9a, 0x4b, 0x8d, 0x8b, 0x00, 0x29, 0xcb, 0xfd, 0xaf, 0x25, 0x72,
+	0x3a, 0xa7, 0xe1, 0x2c, 0xff, 0xb1, 0x8d, 0xf3, 0xf6, 0x46, 0x3d, 0xfb, 0xd5, 0xde, 0x14, 0x70,
+	0x50, 0x14, 0xf6, 0x1a, 0x39, 0xb3, 0xdd, 0x8e, 0x53, 0x2e, 0x58, 0xa9, 0x8b, 0xee, 0xc9, 0x6f,
+	0x58, 0xc6, 0xd5, 0x9c, 0xb9, 0x99, 0x43, 0x03, 0xb9, 0x4f, 0xe2, 0xb9, 0x81, 0x06, 0x98, 0x73,
+	0x9d, 0xa2, 0x44, 0xf6, 0xae, 0x3a, 0x37, 0x5c, 0xcd, 0xe0, 0xa1, 0xe7, 0x09, 0x0c, 0x15, 0x79,
+	0x82, 0x17, 0x7f, 0xa8, 0xfa, 0x75, 0xba, 0xd0, 0x8d, 0x93, 0xb0, 0x4d, 0xa3, 0x07, 0x74, 0xce,
+	0xcc, 0xdc, 0xbb, 0x3b, 0xf3, 0x44, 0xb5, 0x3f, 0x37, 0x38, 0x48, 0x94, 0xfb, 0xbb, 0x16, 0xae,
+	0x89, 0x7c, 0xfc, 0x3f, 0xe4, 0x35, 0xf1, 0x12, 0x19, 0x89, 0x68, 0xa7, 0x85, 0xfe, 0x64, 0xb9,
+	0x28, 0xaa, 0xf5, 0x1c, 0x24, 0x02, 0x52, 0x1a, 0xf7, 0x77, 0x4b, 0xa4, 0x5c, 0xbd, 0xbd, 0x8c,
+	0x02, 0xea, 0x91, 0x9f, 0xde, 0x20, 0x9e, 0xde, 0x9e, 0xca, 0xa0, 0x20, 0xb0, 0xf6, 0x1d, 0x32,
+	0x52, 0x8f, 0x83, 0x07, 0x71, 0x44, 0xa7, 0x77, 0x5d, 0x57, 0x6f, 0x89, 0x51, 0x4d, 0x59, 0xa1,
+	0xff, 0xf7, 0xed, 0x2e, 0x8d, 0xf6, 0xb3, 0xf9, 0x5c, 0xb7, 0x11, 0x08, 0x1c, 0x87, 0xb7, 0x0b,
+	0x7b, 0x51, 0x23, 0x16, 0xf5, 0x18, 0xd8, 0xdd, 0x7c, 0x73, 0x51, 0x03, 0x93, 0x2c, 0xa2, 0x46,
+	0x6c, 0x5f, 0x21, 0x83, 0xbc, 0xa4, 0x95, 0xd0, 0x33, 0x9e, 0x50, 0x15, 0x3a, 0x19, 0x14, 0x4d,
+	0x9a, 0xd5, 0xdb, 0xcb, 0xfc, 0x07, 0x08, 0xd2, 0x1c, 0x97, 0xf2, 0xe0, 0x91, 0x5d, 0xca, 0xff,
+	0xc4, 0x22, 0x13, 0x55, 0x66, 0x02, 0x56, 0x56, 0x83, 0xa2, 0x6f, 0xcb, 0x78, 0x56, 0x95, 0x29,
+	0xcb, 0xcc, 0x8f, 0x4c, 0x61, 0x31, 0xdc, 0x15, 0xba, 0x01, 0xb6, 0x2f, 0x6b, 0x9b, 0x05, 0x0e,
+	0x06, 0x89, 0xc7, 0x5b, 0xc5, 0x27, 0xf8, 0xa0, 0xaf, 0x69, 0xf5, 0xa2, 0x0e, 0x31, 0xc5, 0xed,
+	0xe0, 0xd9, 0x43, 0x3a, 0x16, 0x0a, 0x59, 0x52, 0xef, 0x20, 0x3b, 0xb3, 0x1d, 0x5c, 0xfb, 0x66,
+	0x08, 0xe0, 0xe2, 0xec, 0x5f, 0xb7, 0xc8, 0x29, 0x6f, 0x37, 0xe6, 0x74, 0xf1, 0x8a, 0x17, 0x78,
+	0x0d, 0x91, 0xf8, 0x3e, 0x7a, 0xd9, 0x2b, 0xc0, 0xc4, 0xbb, 0x51, 0x35, 0x59, 0x67, 0x1a, 0xc4,
+	0xd6, 0xf8, 0x1e, 0x22, 0xe8, 0x6d, 0x92, 0xfb, 0x16, 0x99, 0xaa, 0xd2, 0xb6, 0xd7, 0x69, 0xb2,
+	0x8a, 0x14, 0x3c, 0xfa, 0x1a, 0x6b, 0xcc, 0x4a, 0x58, 0xf6, 0xb2, 0x05, 0x45, 0x0c, 0x29, 0x8d,
+	0xfd, 0x0c, 0x8f, 0x14, 0x97, 0x39, 0xb9, 0x23, 0xdc, 0x14, 0xc6, 0xc3, 0xcb, 0x63, 0x90, 0x38,
+	0x77, 0x97, 0x8c, 0xa5, 0x8f, 0xd3, 0x2d, 0xbb, 0x41, 0x26, 0x6b, 0x5a, 0xd2, 0x79, 0x9a, 0xca,
+	0x79, 0xf4, 0xfc, 0x74, 0x5e, 0xe9, 0xc5, 0x64, 0x02, 0x59, 0xae, 0xee, 0x4f, 0x96, 0xc8, 0xa4,
+	0x92, 0x2c, 0x42, 0x66, 0xde, 0xcf, 0x46, 0xb7, 0x17, 0xe0, 0x0f, 0xce, 0x8e, 0xe4, 0x01, 0x11,
+	0xee, 0xef, 0x67, 0x23, 0xdc, 0x4f, 0x54, 0x7c, 0x4f, 0x14, 0xd0, 0xaf, 0x95, 0xc8, 0xb0, 0xaa,
+	0x00, 0x7a, 0x1b, 0x63, 0x58, 0xba, 0xc1, 0x43, 0x9e, 0x3e, 0x99, 0xe5, 0x13, 0x38, 0x27, 0x64,
+	0xc9, 0x82, 0x72, 0x9d, 0xd2, 0xc3, 0xb0, 0x64, 0x21, 0xbe, 0xc0, 0x39, 0xd9, 0x37, 0x49, 0x19,
+	0x8b, 0xe0, 0x97, 0x1f, 0x90, 0x21, 0xab, 0x1a, 0x75, 0x35, 0xa8, 0x03, 0x72, 0x61, 0x55, 0x91,
+	0xf9, 0x9a, 0x3b, 0x60, 0xae, 0x4f, 0xe6, 0x32, 0xeb, 0x7e, 0xd1, 0x22, 0x46, 0x5d, 0x70, 0x7b,
+	0x99, 0x9c, 0x11, 0xe5, 0xf6, 0x99, 0xf3, 0x5b, 0xd5, 0x49, 0xe6, 0x1e, 0x7a, 0x56, 0xab, 0xb8,
+	0x9a, 0x83, 0x87, 0xdc, 0xa7, 0x32, 0xc7, 0xcc, 0xd2, 0x91, 0x8e, 0x99, 0x3f, 0x56, 0x26, 0x83,
+	0x58, 0xf5, 0xc5, 0x4f, 0xfe, 0xb2, 0xdc, 0xe6, 0xa6, 0x5f, 0x1e, 0x52, 0x3e, 0xa1, 0x2b, 0xc4,
+	0x4e, 0x36, 0x8b, 0x75, 0xbc, 0x5f, 0x06, 0xab, 0xfb, 0xa5, 0x41, 0x42, 0xf8, 0xdb, 0x58, 0xed,
+	0x24, 0x47, 0x71, 0x0e, 0xbd, 0x42, 0xc6, 0x1a, 0x34, 0xa0, 0x91, 0xcc, 0x44, 0x28, 0x99, 0xd1,
+	0xa0, 0x4b, 0x1a, 0x0e, 0x0c, 0x4a, 0x36, 0x59, 0xd0, 0xc4, 0xc6, 0x75, 0xb4, 0x6c, 0xa6, 0xaa,
+	0xc2, 0x80, 0x46, 0x65, 0xcf, 0x1a, 0x11, 0x18, 0xbc, 0x7a, 0xf2, 0xc4, 0x01, 0x01, 0x13, 0x1f,
+	0x27, 0xe3, 0xea, 0xd7, 0x35, 0xbf, 0x45, 0xb3, 0x91, 0x36, 0x6b, 0x3a, 0x12, 0x4c, 0x5a, 0xbc,
+	0x2b, 0xdf, 0x2c, 0xb7, 0x27, 0x4e, 0x7a, 0xaa, 0x9c, 0xa7, 0x59, 0xa5, 0x0f, 0x32, 0xd4, 0x5c,
+	0x97, 0xdb, 0x87, 0x6e, 0x20, 0x8e, 0x7c, 0x9a, 0x2e, 0x87, 0x50, 0x10, 0x58, 0x1c, 0x42, 0xae,
+	0xd6, 0x72, 0xb8, 0x28, 0x96, 0xa4, 0x86, 0xb0, 0xaa, 0xe1, 0xc0, 0xa0, 0x44, 0x09, 0xc2, 0x33,
+	0x47, 0xcc, 0xcf, 0x3e, 0xe3, 0x4e, 0xeb, 0x90, 0x89, 0xd0, 0x74, 0x57, 0xf0, 0x54, 0x82, 0x97,
+	0x8f, 0x38, 0x6f, 0x8d, 0x67, 0xb9, 0x4e, 0x66, 0xc2, 0x20, 0xc3, 0x1f, 0xcf, 0xbc, 0x7a, 0xc2,
+	0xe1, 0x98, 0x99, 0x05, 0xd3, 0x37, 0x27, 0x70, 0x8d, 0x9c, 0xe9, 0x84, 0xf5, 0xb5, 0xc8, 0x0f,
+	0x31, 0xe0, 0x69, 0xa1, 0xe5, 0xc5, 0x31, 0x9b, 0x55, 0xe3, 0xe6, 0x29, 0x67, 0x2d, 0x87, 0x06,
+	0x72, 0x9f, 0x44, 0xeb, 0x44, 0x47, 0x00, 0x59, 0xc8, 0x77, 0x85, 0x5b, 0x27, 0x24, 0x21, 0x28,
+	0x2c, 0x0e, 0x26, 0x2e, 0x4a, 0x54, 0x96, 0x8c, 0x56, 0x83, 0xc9, 0x0e, 0xb7, 0x09, 0x08, 0xac,
+	0x7b, 0x9a, 0x9c, 0xaa, 0x76, 0x3b, 0x9d, 0x96, 0x4f, 0xeb, 0x2a, 0x44, 0xc2, 0xfd, 0x1d, 0x8b,
+	0x4c, 0x8a, 0x85, 0x52, 0x29, 0xa1, 0xc7, 0xbb, 0x9d, 0x2c, 0xd1, 0x42, 0x7a, 0x4b, 0x85, 0xdd,
+	0x89, 0x2e, 0x38, 0xf6, 0x0b, 0xe6, 0x75, 0xbf, 0x81, 0xed, 0x36, 0x63, 0x70, 0x31, 0xca, 0xc8,
+	0xd4, 0x97, 0x8a, 0xb9, 0xa0, 0x42, 0x53, 0x95, 0xc4, 0x15, 0x21, 0x79, 0xba, 0x57, 0x53, 0xa6,
+	0x00, 0x16, 0x96, 0x6b, 0xcb, 0x12, 0xe5, 0xf8, 0x06, 0xac, 0xe7, 0x11, 0xba, 0x1f, 0x94, 0x48,
+	0x7e, 0xd0, 0xb5, 0xfd, 0x83, 0xbd, 0x03, 0x70, 0xbb, 0xc0, 0x01, 0xe0, 0x52, 0x0e, 0x18, 0x83,
+	0xc0, 0x1c, 0x83, 0x95, 0x82, 0xc6, 0x40, 0xc8, 0xed, 0x1d, 0x89, 0xaf, 0x5b, 0x64, 0x74, 0x7d,
+	0x7d, 0x59, 0x29, 0x05, 0x40, 0xce, 0xc5, 0xfc, 0x6c, 0xc5, 0xb6, 0xf7, 0x85, 0x10, 0x7d, 0x30,
+	0x6a, 0x1a, 0x8b, 0x1b, 0x6c, 0xaa, 0xb9, 0x14, 0xd0, 0xe7, 0x49, 0xfb, 0x06, 0x39, 0xad, 0x63,
+	0x84, 0x5f, 0x53, 0x04, 0xe9, 0xf1, 0xb2, 0x75, 0xbd, 0x68, 0xc8, 0x7b, 0x26, 0xcb, 0x4a, 0x68,
+	0x1f, 0x4e, 0x39, 0x9f, 0x95, 0x40, 0x43, 0xde, 0x33, 0xee, 0x2a, 0x19, 0x5d, 0xf7, 0x22, 0xd5,
+	0xf1, 0xef, 0x23, 0x53, 0xb5, 0xb0, 0x2d, 0x55, 0x93, 0x65, 0xba, 0x43, 0x5b, 0xa2, 0xcb, 0xbc,
+	0xd4, 0x63, 0x06, 0x07, 0x3d, 0xd4, 0xee, 0x7b, 0x64, 0x4c, 0xaf, 0xf8, 0x8e, 0xf9, 0x26, 0x6d,
+	0x96, 0x8a, 0x5f, 0x5c, 0x54, 0x0a, 0x4f, 0xed, 0xe7, 0x61, 0x13, 0xfc, 0x7f, 0x10, 0x32, 0xdc,
+	0x9f, 0x7a, 0x91, 0xa8, 0xa2, 0x18, 0x47, 0xd8, 0xbb, 0x3b, 0x2a, 0x19, 0xa6, 0x52, 0x70, 0x32,
+	0x8c, 0x5a, 0x3b, 0x33, 0x09, 0x31, 0x49, 0x9a, 0x10, 0x33, 0x58, 0x74, 0x42, 0x8c, 0x3a, 0x1d,
+	0xf4, 0x24, 0xc5, 0xfc, 0x9c, 0x45, 0xc6, 0xd0, 0x9d, 0xa5, 0xe2, 0x6b, 0x86, 0x8a, 0x72, 0xb7,
+	0xca, 0xc1, 0x9e, 0xbd, 0xa5, 0xb1, 0xe7, 0xee, 0x56, 0xb5, 0x7f, 0xeb, 0x28, 0x30, 0xda, 0x61,
+	0x5f, 0xd3, 0x3c, 0x42, 0x3c, 0x60, 0xed, 0xc9, 0xbc, 0xa3, 0xe2, 0xa1, 0xee, 0x9d, 0x3d, 0x4d,
+	0x23, 0x1d, 0x29, 0x6a, 0xef, 0x90, 0xc9, 0xf4, 0x07, 0x16, 0xc5, 0x75, 0xc9, 0x20, 0xcf, 0xad,
+	0x62, 0x1a, 0xc8, 0x30, 0x9f, 0x95, 0x3c, 0xef, 0x0a, 0x04, 0xc6, 0x4e, 0x64, 0xd0, 0xe6, 0x68,
+	0x51, 0xf7, 0x5f, 0x1a, 0x41, 0xa1, 0xf9, 0x51, 0x9b, 0xf6, 0xab, 0xba, 0x09, 0x68, 0xec, 0x28,
+	0x26, 0xa0, 0xf1, 0xbe, 0xe6, 0x9f, 0x1f, 0xb7, 0xc8, 0x58, 0x4d, 0xbb, 0xc8, 0xd1, 0x79, 0xae,
+	0xa8, 0xbb, 0x7d, 0xf3, 0xae, 0x0d, 0x15, 0x85, 0x6b, 0x35, 0x0c, 0x18, 0xd2, 0x59, 0xd1, 0x7c,
+	0x66, 0xef, 0x72, 0xc6, 0x8b, 0xca, 0xfd, 0x31, 0xed, 0x67, 0x32, 0xc8, 0x11, 0x61, 0x20, 0x64,
+	0xd9, 0xef, 0x61, 0x40, 0xa3, 0xb0, 0x82, 0x4d, 0x14, 0x15, 0x72, 0x9e, 0x8d, 0xf7, 0x91, 0x55,
+	0x68, 0x39, 0x14, 0x94, 0x44, 0xbb, 0x49, 0xca, 0x75, 0xaf, 0xe1, 0x4c, 0x16, 0xb5, 0x23, 0x6a,
+	0xf7, 0x29, 0xf0, 0xb3, 0xf4, 0xe2, 0xdc, 0x12, 0xa0, 0x08, 0x7b, 0x2f, 0xbd, 0xa1, 0x6e, 0xaa,
+	0xb0, 0xbd, 0xdf, 0x54, 0x0d, 0xb9, 0x41, 0xa9, 0xe7, 0xc2, 0xbb, 0xba, 0x08, 0x91, 0xfa, 0xb6,
+	0x8b, 0x56, 0x31, 0x55, 0x58, 0x30, 0xb8, 0x8a, 0xdb, 0x67, 0xd3, 0x30, 0x2b, 0x94, 0xd2, 0x4c,
+	0x92, 0x8e, 0xf3, 0xed, 0x45, 0x49, 0xc1, 0x82, 0x66, 0x5c, 0x0a, 0xfe, 0x07, 0x8c, 0x3b, 0x6e,
+	0x7c, 0x1d, 0x16, 0x98, 0xe9, 0x7c, 0x47, 0x51, 0x7b, 0x0b, 0x0f, 0xf4, 0xe4, 0x73, 0x93, 0xff,
+	0x0f, 0x42, 0x06, 0xf6, 0xa9, 0x11, 0x75, 0x6a, 0xce, 0x8b, 0x45, 0xf5, 0x09, 0x0b, 0x60, 0xf3,
+	0x3e, 0xe1, 0x7f, 0xc0, 0xb8, 0xdb, 0x9f, 0x26, 0xe5, 0xf8, 0xed, 0x96, 0x33, 0xcb, 0x84, 0x5c,
+	0x2d, 0x60, 0x56, 0xdc, 0x5e, 0xe6, 0x73, 0xaf, 0x7a, 0x7b, 0x19, 0x90, 0x35, 0xbb, 0x1f, 0x2e,
+	0xd0, 0x32, 0xd1, 0x9c, 0xef, 0x2e, 0x4a, 0xfd, 0xd6, 0xf3, 0xdb, 0xf8, 0x02, 0xa3, 0x43, 0xc0,
+	0x90, 0xca, 0xf2, 0x7f, 0x6b, 0xfa, 0x45, 0xed, 0xce, 0x4b, 0x45, 0xc5, 0x2b, 0x18, 0xf7, 0xbf,
+	0xf3, 0x68, 0x55, 0x03, 0x04, 0xa6, 0x60, 0xfb, 0x2a, 0x19, 0xe2, 0x37, 0x0e, 0xf3, 0x04, 0xd5,
+	0xd1, 0xcb, 0xd3, 0xfd, 0xef, 0x2d, 0x4e, 0x55, 0x00, 0xfe, 0x3b, 0x06, 0xf9, 0xac, 0xfd, 0x93,
+	0x16, 0x99, 0xc0, 0xbd, 0x32, 0xbd, 0x22, 0xd9, 0xb1, 0x8b, 0xda, 0x8d, 0xb0, 0xa2, 0x69, 0xba,
+	0x8b, 0x28, 0xeb, 0xc0, 0x0d, 0x43, 0x1c, 0x64, 0xc4, 0xdb, 0xef, 0x93, 0xe1, 0xd8, 0xaf, 0xd3,
+	0x9a, 0x17, 0xc5, 0xce, 0xe9, 0x93, 0x69, 0x4a, 0x1a, 0xa2, 0x20, 0x04, 0x81, 0x12, 0x69, 0x7f,
+	0x37, 0x9a, 0x1f, 0x77, 0x9c, 0x4b, 0xfd, 0xc7, 0xf4, 0x6a, 0xb0, 0x73, 0xc7, 0x8b, 0xd2, 0x80,
+	0x8b, 0xab, 0xc1, 0x0e, 0x1a, 0x1b, 0x77, 0xec, 0x65, 0x32, 0x44, 0x83, 0x1d, 0x96, 0x7b, 0xf0,
+	0x9d, 0xec, 0xf1, 0x6f, 0xe9, 0xf3, 0x38, 0x92, 0x88, 0x8a, 0x88, 0x69, 0x81, 0x2c, 0x0e, 0x06,
+	0xc9, 0xc2, 0xfe, 0xdb, 0x16, 0x99, 0xf4, 0xa2, 0x5a, 0xd3, 0xdf, 0xa1, 0xcb, 0xa1, 0x98, 0xf5,
+	0x67, 0x8a, 0xda, 0x5e, 0x64, 0x54, 0x88, 0xe4, 0x2c, 0x42, 0x08, 0x4c, 0x71, 0x90, 0x95, 0x6f,
+	0xff, 0x86, 0x45, 0xce, 0x7a, 0x3d, 0x01, 0x25, 0x68, 0x8a, 0x7c, 0xa1, 0x28, 0xbf, 0xfb, 0x5c,
+	0x1e, 0x7b, 0x9e, 0x09, 0x9c, 0x8b, 0x82, 0xfc, 0x06, 0x61, 0xb9, 0x80, 0xb3, 0xfc, 0xd6, 0xc2,
+	0xec, 0x1d, 0xa9, 0x67, 0x1f, 0xd0, 0xb2, 0xcc, 0xdb, 0x90, 0xc7, 0x12, 0xf2, 0x25, 0x89, 0x72,
+	0x01, 0xfa, 0x2d, 0xe0, 0xe7, 0x0a, 0x0d, 0x6f, 0x3a, 0xc6, 0xcd, 0xdf, 0xaf, 0x90, 0xb1, 0x5a,
+	0xe4, 0x27, 0x7e, 0xcd, 0x63, 0xca, 0xa1, 0x73, 0xd9, 0xb4, 0xa5, 0x2d, 0x68, 0x38, 0x30, 0x28,
+	0xed, 0x59, 0xbc, 0xb0, 0x39, 0x4c, 0x9c, 0x2b, 0x46, 0x5d, 0x91, 0x81, 0x6a, 0x27, 0x44, 0x97,
+	0x25, 0xc1, 0xbf, 0x22, 0xa2, 0x8c, 0xd1, 0xa1, 0xfb, 0x5c, 0xb8, 0xf2, 0x52, 0x33, 0xd5, 0xcb,
+	0x66, 0xd8, 0x2d, 0x64, 0xf0, 0xd0, 0xf3, 0x04, 0x16, 0x9d, 0xc6, 0x14, 0x79, 0xfc, 0x6e, 0x63,
+	0xe7, 0x23, 0xbc, 0x76, 0x36, 0x8b, 0xf6, 0x96, 0x40, 0x48, 0xf1, 0xec, 0x12, 0xb0, 0x26, 0xad,
+	0x6d, 0x73, 0x8b, 0xe9, 0x77, 0x15, 0x76, 0x09, 0x98, 0xe2, 0x29, 0x2e, 0x01, 0x53, 0xbf, 0x41,
+	0x93, 0x87, 0xf6, 0xda, 0x30, 0xa8, 0x36, 0xbb, 0x49, 0x3d, 0xdc, 0x0d, 0x9c, 0x8f, 0x9a, 0xf6,
+	0xda, 0x55, 0x85, 0x01, 0x8d, 0x8a, 0xdd, 0xb5, 0x2b, 0xfe, 0x5f, 0x8a, 0xbc, 0x1a, 0x5d, 0xa3,
+	0x91, 0x1f, 0xd6, 0xe5, 0x0c, 0x7d, 0x85, 0xb9, 0x78, 0xf9, 0x5d, 0xbb, 0x7d, 0xa9, 0xe0, 0x00,
+	0x0e, 0xf6, 0x4b, 0x64, 0xb4, 0x23, 0xce, 0x02, 0x7e, 0xdc, 0x66, 0xa5, 0x04, 0xca, 0xbc, 0xfa,
+	0xcc, 0x5a, 0x0a, 0x06, 0x9d, 0xc6, 0xb8, 0xa4, 0xea, 0xf9, 0x83, 0x2e, 0xa9, 0xb2, 0x5f, 0x23,
+	0xa3, 0x49, 0xd8, 0xa2, 0x91, 0xb0, 0x61, 0x3a, 0x6c, 0xad, 0xbb, 0x90, 0xb7, 0xd6, 0xad, 0x2b,
+	0xb2, 0xd4, 0xc6, 0x99, 0xc2, 0x62, 0xd0, 0xf9, 0xb0, 0xec, 0x4f, 0x71, 0xc3, 0x23, 0xbf, 0x56,
+	0xe3, 0xf1, 0x4c, 0xf6, 0xa7, 0x8e, 0x04, 0x93, 0x16, 0xa3, 0xb0, 0x3b, 0x3d, 0xd6, 0xd1, 0x69,
+	0x33, 0x0a, 0xbb, 0xd7, 0x34, 0xda, 0xfb, 0x8c, 0x61, 0x17, 0x7d, 0xe2, 0x40, 0xbb, 0x68, 0xfe,
+	0xd5, 0x4a, 0x4f, 0x3e, 0xc8, 0xd5, 0x4a, 0x76, 0x9d, 0x3c, 0xe9, 0x75, 0x93, 0x90, 0x65, 0xf4,
+	0x98, 0x8f, 0xf0, 0x44, 0xd8, 0x8b, 0x3c, 0xb7, 0xf6, 0xde, 0xdd, 0x99, 0x27, 0xe7, 0x0e, 0xa0,
+	0x83, 0x03, 0xb9, 0x60, 0x75, 0x04, 0x2a, 0xae, 0x87, 0x72, 0xbe, 0xa5, 0xa8, 0x13, 0x92, 0x79,
+	0xe1, 0x94, 0xca, 0x7a, 0x60, 0x30, 0x50, 0xf2, 0xec, 0x75, 0x32, 0x8a, 0x1f, 0xec, 0x5c, 0xcb,
+	0xf7, 0x62, 0x1a, 0x3b, 0x4f, 0x5d, 0x2c, 0xf7, 0x3b, 0x78, 0x5e, 0x97, 0x64, 0xe9, 0x9c, 0xb9,
+	0x9e, 0x3e, 0x09, 0x3a, 0x1b, 0xfb, 0x26, 0x19, 0xa9, 0x07, 0xb1, 0x88, 0x7d, 0xfd, 0x18, 0x1b,
+	0xfa, 0x17, 0x59, 0x0c, 0xc7, 0xad, 0xaa, 0x8a, 0x7a, 0x7d, 0x32, 0x27, 0x50, 0x5b, 0xe1, 0x21,
+	0x7d, 0xde, 0x5e, 0x61, 0xcc, 0x78, 0x3f, 0x9c, 0x8f, 0xb3, 0xf1, 0xb9, 0x98, 0xd7, 0xc0, 0xb5,
+	0xb0, 0xbe, 0x78, 0x4b, 0x5e, 0x1a, 0x30, 0x2e, 0xc4, 0xf1, 0x9f, 0x90, 0x72, 0x40, 0x0d, 0x2d,
+	0xde, 0x8f, 0x6b, 0x78, 0x37, 0xce, 0x5f, 0xeb, 0xaf, 0x4d, 0x54, 0x19, 0x89, 0x16, 0xe1, 0xcd,
+	0x1f, 0x01, 0xf9, 0x2c, 0x5a, 0x09, 0x3a, 0x7e, 0x87, 0xc6, 0xce, 0xf7, 0x14, 0xa5, 0x0c, 0x29,
+	0x45, 0x68, 0xcd, 0xef, 0x50, 0xad, 0x72, 0x0c, 0x4a, 0x01, 0x2e, 0xcc, 0xfe, 0x28, 0x19, 0xc1,
+	0x13, 0x19, 0xba, 0x47, 0x63, 0xe7, 0x13, 0xcc, 0x9f, 0x84, 0xfb, 0xdf, 0xc8, 0x9a, 0x04, 0xb2,
+	0x02, 0x45, 0xe2, 0x07, 0xa4, 0xb4, 0xf6, 0x17, 0x2d, 0x32, 0x1e, 0xd0, 0x04, 0xa5, 0x8a, 0xd7,
+	0xf2, 0xbd, 0x45, 0xa9, 0x06, 0xaa, 0x7a, 0x97, 0xce, 0x9e, 0xef, 0x7d, 0x06, 0x08, 0xcc, 0x06,
+	0xd8, 0x94, 0x4c, 0xca, 0x54, 0x71, 0x19, 0x1d, 0x76, 0x81, 0xb5, 0xe9, 0xd9, 0x3e, 0x6f, 0xb7,
+	0x6a, 0x52, 0xab, 0xc8, 0x47, 0x1d, 0x08, 0x59, 0x9e, 0xb8, 0xc5, 0x76, 0xc2, 0x3a, 0x5e, 0x2c,
+	0xbf, 0xe6, 0xe1, 0xad, 0x2c, 0x33, 0xa6, 0xc7, 0x6f, 0x4d, 0xc3, 0x81, 0x41, 0x89, 0xd9, 0x4a,
+	0x6d, 0x5e, 0xa2, 0xd0, 0x79, 0xba, 0x28, 0xeb, 0x9f, 0xa8, 0x79, 0x28, 0xd2, 0x03, 0xf9, 0x0f,
+	0x90, 0x62, 0xec, 0x7f, 0x60, 0x91, 0xc9, 0x4c, 0x05, 0x15, 0xe7, 0x5b, 0x0b, 0x3b, 0xd4, 0x9b,
+	0x8c, 0xe7, 0x9f, 0x65, 0xc3, 0x67, 0x02, 0xef, 0xf7, 0x82, 0x20, 0xdb, 0x22, 0x3e, 0x2e, 0xac,
+	0x12, 0xa9, 0xf3, 0x4c, 0x71, 0xe3, 0xc2, 0x18, 0xca, 0x71, 0x61, 0x3f, 0x40, 0x8a, 0xc1, 0x38,
+	0x25, 0x11, 0x44, 0xe5, 0x3c, 0x6b, 0xc6, 0x29, 0x89, 0x58, 0x2b, 0x90, 0xf8, 0xe9, 0xef, 0x25,
+	0xa7, 0x7a, 0x8c, 0x9b, 0xc7, 0x2a, 0x76, 0xf9, 0xc7, 0x16, 0x39, 0x9b, 0x3b, 0xa5, 0xed, 0x8f,
+	0x88, 0x9a, 0x77, 0x66, 0xca, 0xa4, 0xac, 0x79, 0x77, 0xca, 0x20, 0xd6, 0x8a, 0xdf, 0xfd, 0x9c,
+	0x45, 0x26, 0x29, 0xab, 0x13, 0x36, 0xd7, 0x6a, 0x85, 0xbb, 0x2d, 0x3f, 0x4e, 0x44, 0xd0, 0x49,
+	0x01, 0x45, 0x84, 0x7a, 0xae, 0x39, 0x9c, 0x3f, 0x2f, 0xda, 0x35, 0x79, 0xd5, 0x94, 0x09, 0xd9,
+	0x46, 0xb8, 0x5f, 0x46, 0x3f, 0x8a, 0x16, 0x28, 0x50, 0xf4, 0x75, 0xcd, 0xa8, 0xdc, 0xb6, 0xba,
+	0x31, 0xcb, 0x31, 0x0e, 0x3b, 0x32, 0xb0, 0x30, 0x55, 0x6e, 0x35, 0x1c, 0x18, 0x94, 0x18, 0x02,
+	0x85, 0xf2, 0xe2, 0x8e, 0x57, 0x93, 0x59, 0xdb, 0x2a, 0x04, 0xea, 0x96, 0x44, 0x40, 0x4a, 0x83,
+	0xb9, 0xad, 0x76, 0xef, 0x4d, 0x96, 0x99, 0x00, 0x0f, 0xeb, 0x28, 0x01, 0x1e, 0x2c, 0x36, 0xc5,
+	0x6f, 0x25, 0xbd, 0xf5, 0x3a, 0xaf, 0x31, 0x28, 0x08, 0x2c, 0x86, 0xf9, 0xb7, 0xbd, 0x4e, 0xb6,
+	0x6c, 0x34, 0xde, 0xce, 0x81, 0x70, 0x56, 0x99, 0xa6, 0xd9, 0x0d, 0xb6, 0x59, 0xaf, 0x2b, 0x5a,
+	0x65, 0x1a, 0x04, 0x02, 0xc7, 0xb9, 0x5f, 0xb3, 0xc8, 0xb8, 0x71, 0x06, 0x2e, 0x3c, 0x12, 0xf0,
+	0x1a, 0xb1, 0x79, 0x60, 0x2f, 0x37, 0x31, 0xac, 0xa0, 0xd6, 0x11, 0x8b, 0x1b, 0xb4, 0xd8, 0xed,
+	0x22, 0x2b, 0x3d, 0x58, 0xc8, 0x79, 0x02, 0xdf, 0x25, 0x06, 0x2f, 0x5d, 0x0b, 0x23, 0xa0, 0x5e,
+	0x7d, 0xdf, 0x29, 0x9b, 0xef, 0x72, 0x43, 0xc3, 0x81, 0x41, 0xe9, 0x7e, 0xbd, 0x42, 0xd2, 0xdc,
+	0x7c, 0x75, 0x23, 0x91, 0xd5, 0xf7, 0x46, 0xa2, 0x17, 0xc8, 0x30, 0x16, 0x75, 0x5f, 0x4b, 0xef,
+	0x2d, 0x52, 0x73, 0xec, 0xd5, 0xea, 0xea, 0x2d, 0x46, 0xa9, 0x28, 0x18, 0xf5, 0xdb, 0xfc, 0xcd,
+	0x64, 0x53, 0x21, 0x5f, 0xbd, 0x2d, 0xde, 0x98, 0xa2, 0xc0, 0x97, 0x42, 0x77, 0xa8, 0x8a, 0x8c,
+	0x50, 0x2f, 0x45, 0x5c, 0xa5, 0xcb, 0x70, 0x38, 0xf9, 0x54, 0x60, 0x85, 0x88, 0xf3, 0x50, 0x63,
+	0xac, 0x02, 0x30, 0x20, 0xa5, 0x61, 0xa6, 0x11, 0xe1, 0x61, 0x17, 0x6e, 0xa2, 0x6a, 0x11, 0x26,
+	0xd8, 0x8c, 0xcf, 0x5e, 0xdc, 0xc6, 0x2c, 0xc0, 0xa0, 0x44, 0xe6, 0xc5, 0xf1, 0x8d, 0x9c, 0x44,
+	0x1c, 0x9f, 0x5e, 0x28, 0xa2, 0x72, 0xd4, 0x42, 0x11, 0xe6, 0x17, 0x38, 0x7c, 0xa4, 0x2f, 0xf0,
+	0x12, 0x19, 0x69, 0x85, 0x8d, 0x18, 0x68, 0x83, 0xee, 0x39, 0xc4, 0x7c, 0x01, 0xcb, 0x12, 0x01,
+	0x29, 0x0d, 0xbb, 0x1f, 0x97, 0x1a, 0x37, 0xa0, 0x8a, 0x80, 0x91, 0xef, 0x2f, 0x42, 0x8f, 0xce,
+	0xbb, 0x59, 0x95, 0x07, 0x95, 0x98, 0x38, 0xc8, 0xb4, 0xc1, 0xfd, 0xcd, 0x12, 0x39, 0x9d, 0x13,
+	0xaf, 0x8a, 0xbb, 0x99, 0xb8, 0x99, 0x29, 0x5b, 0x2e, 0x4c, 0xdc, 0xdd, 0x04, 0x12, 0x8f, 0x9f,
+	0x4b, 0x14, 0xb6, 0x7a, 0xea, 0xb7, 0x42, 0xd8, 0xa2, 0xc0, 0x30, 0x78, 0x3c, 0xf3, 0xba, 0x49,
+	0x93, 0x7d, 0xa6, 0xec, 0x9b, 0x29, 0x9b, 0xc7, 0xb3, 0x39, 0x1d, 0x09, 0x26, 0xad, 0xfd, 0xfd,
+	0x78, 0x64, 0xdc, 0xa6, 0xc1, 0x83, 0x04, 0xbf, 0xf3, 0xa2, 0xa9, 0xe9, 0xd3, 0xa0, 0xb3, 0x3a,
+	0xfe, 0x0a, 0xfe, 0xc3, 0x65, 0x32, 0x74, 0x87, 0x46, 0x6c, 0x02, 0x3c, 0x4f, 0x86, 0x76, 0xf8,
+	0xbf, 0xd9, 0x01, 0x12, 0x14, 0x20, 0xf1, 0x28, 0x67, 0xb3, 0xeb, 0xb7, 0xea, 0x8b, 0xe9, 0x96,
+	0xa4, 0xe4, 0xcc, 0x4b, 0x04, 0xa4, 0x34, 0xf8, 0x40, 0x03, 0x0d, 0x9b, 0x6d, 0xcc, 0xf7, 0xcb,
+	0xa4, 0x2e, 0x2d, 0x49, 0x04, 0xa4, 0x34, 0xb8, 0x1f, 0x34, 0xfc, 0x64, 0xdd, 0x6b, 0x64, 0x63,
+	0x15, 0x97, 0x18, 0x14, 0x04, 0x96, 0x45, 0x96, 0xf9, 0xc9, 0x7a, 0x44, 0x59, 0xc0, 0x44, 0x4f,
+	0x8d, 0xdb, 0x25, 0x0d, 0x07, 0x06, 0x25, 0x6b, 0x52, 0x28, 0x7a, 0xe6, 0x0c, 0x66, 0x9a, 0x24,
+	0x11, 0x90, 0xd2, 0xe0, 0xa2, 0x87, 0x9e, 0x7c, 0xbf, 0x25, 0xf2, 0xe8, 0xb5, 0x45, 0x6f, 0x41,
+	0xc0, 0x41, 0x51, 0x20, 0xb5, 0x3c, 0x07, 0x38, 0xc3, 0x26, 0xb5, 0x3a, 0x29, 0x28, 0x0a, 0xf7,
+	0x0e, 0x19, 0xe7, 0x0b, 0xff, 0x42, 0xcb, 0xf3, 0xdb, 0x4b, 0x0b, 0xf6, 0xd5, 0x9e, 0x62, 0x11,
+	0xcf, 0xe7, 0x14, 0x8b, 0x38, 0x6b, 0x3c, 0xd4, 0x5b, 0x34, 0xc2, 0xfd, 0x4a, 0x89, 0x0c, 0x2b,
+	0x2b, 0xb9, 0x1e, 0x92, 0x68, 0x9d, 0x48, 0x48, 0x62, 0x07, 0xcd, 0x63, 0xb4, 0xe6, 0x94, 0x8a,
+	0x72, 0x48, 0xc8, 0xb6, 0xe3, 0xf1, 0x20, 0xfd, 0x10, 0xf1, 0x17, 0x30, 0x49, 0xf6, 0x1e, 0x56,
+	0x08, 0x62, 0x25, 0x18, 0xcb, 0x45, 0x9d, 0xe1, 0x95, 0x4c, 0xc6, 0x57, 0x8b, 0xe2, 0x67, 0xbf,
+	0x41, 0xc8, 0xc3, 0x7b, 0xea, 0xce, 0x48, 0x52, 0xb6, 0x93, 0xcd, 0xfb, 0x01, 0x8b, 0x72, 0x3e,
+	0xf9, 0x61, 0x7e, 0xcf, 0x18, 0xe6, 0xd7, 0x8b, 0xeb, 0xb2, 0xde, 0x8f, 0x7e, 0x43, 0xee, 0xfe,
+	0xb9, 0x45, 0x9c, 0xbc, 0x07, 0x30, 0x15, 0xdc, 0x7e, 0xb3, 0xa7, 0xf3, 0xb3, 0x47, 0x2c, 0x50,
+	0xe2, 0xc7, 0xbc, 0xeb, 0xea, 0x33, 0x91, 0x10, 0xad, 0xe3, 0xef, 0xca, 0x9b, 0x61, 0x0a, 0xbb,
+	0x0c, 0x20, 0xaf, 0x23, 0xa9, 0x86, 0x62, 0xdc, 0x3a, 0xf3, 0x17, 0x7d, 0xfa, 0x8d, 0x43, 0x83,
+	0xf7, 0x87, 0x71, 0x1d, 0xc7, 0x2a, 0x2a, 0x22, 0x8d, 0x8b, 0xc8, 0x57, 0x96, 0x5a, 0x64, 0x30,
+	0x66, 0xf1, 0xb7, 0x4e, 0xa9, 0x28, 0xbf, 0x29, 0x8f, 0xe7, 0x15, 0x3e, 0x7d, 0xf6, 0x3f, 0x08,
+	0x19, 0xee, 0x7f, 0xb2, 0xc8, 0x98, 0xec, 0xf8, 0x23, 0x78, 0xc9, 0xa1, 0xf9, 0x92, 0x5f, 0x2d,
+	0xee, 0x25, 0xf7, 0x79, 0xb1, 0x3f, 0xe9, 0xa6, 0xfd, 0x63, 0x2f, 0xf3, 0x5d, 0x32, 0x22, 0x8f,
+	0x53, 0xb2, 0x88, 0xd8, 0xab, 0xc5, 0x59, 0x6c, 0xd2, 0x6d, 0x46, 0x42, 0x62, 0x48, 0xe5, 0x65,
+	0x22, 0x9e, 0x4b, 0x47, 0x8a, 0x78, 0x36, 0x2e, 0x34, 0x2a, 0x3f, 0xea, 0x0b, 0x8d, 0xf2, 0x6d,
+	0xbf, 0x03, 0x27, 0x62, 0xfb, 0x7d, 0xb2, 0x70, 0xdb, 0xef, 0x53, 0x8f, 0xd8, 0xf6, 0xab, 0xf9,
+	0xaa, 0x2b, 0x0f, 0xe1, 0xab, 0x7e, 0x97, 0x9c, 0xd9, 0x49, 0x37, 0x7f, 0x35, 0x93, 0x58, 0xfd,
+	0xb4, 0xd1, 0xcb, 0xcf, 0xe7, 0x1a, 0xf3, 0x50, 0x91, 0x89, 0x13, 0x1a, 0x24, 0x9a, 0xda, 0x90,
+	0xc6, 0x4b, 0xdf, 0xc9, 0x61, 0x07, 0xb9, 0x42, 0xb2, 0x1e, 0x95, 0xa1, 0x23, 0x78, 0x54, 0xfa,
+	0x7b, 0x4b, 0x87, 0xbf, 0xd9, 0xbc, 0xa5, 0xcf, 0xa4, 0xb1, 0x3d, 0x3c, 0xca, 0x3e, 0x3f, 0x10,
+	0xe7, 0x17, 0xb3, 0x01, 0x83, 0x84, 0x0d, 0xfd, 0xa7, 0x8b, 0xd5, 0x7a, 0x0a, 0x08, 0x1a, 0x1c,
+	0x7d, 0x88, 0xa0, 0xc1, 0x8c, 0x7b, 0x6b, 0xac, 0x20, 0xf7, 0x56, 0x40, 0xa6, 0xfc, 0xb6, 0xd7,
+	0xa0, 0x6b, 0xdd, 0x96, 0x38, 0xb7, 0xc5, 0xce, 0xf8, 0xc5, 0x72, 0xbf, 0xe3, 0x33, 0xfa, 0xdc,
+	0x5b, 0xa2, 0xb0, 0x9a, 0xca, 0x30, 0x50, 0x1e, 0xd4, 0x1b, 0x19, 0x4e, 0xd0, 0xc3, 0x1b, 0x27,
+	0x2c, 0x2b, 0x32, 0xce, 0x8d, 0x85, 0x2c, 0x32, 0x6d, 0x78, 0x7e, 0x52, 0x7a, 0x53, 0x04, 0x18,
+	0x74, 0x1a, 0xd3, 0x9b, 0x32, 0x59, 0xa4, 0x37, 0x65, 0xea, 0xa1, 0xbd, 0x29, 0xcf, 0x92, 0xc1,
+	0x30, 0xc0, 0x7a, 0x99, 0xce, 0x29, 0xf3, 0x74, 0xb4, 0xca, 0xa0, 0x20, 0xb0, 0xfc, 0x1e, 0x8f,
+	0xa4, 0xa5, 0x3c, 0xee, 0x17, 0x0a, 0xbb, 0xc7, 0x23, 0x0d, 0x04, 0x17, 0x47, 0xd2, 0x14, 0x00,
+	0xba, 0x48, 0x7b, 0xb5, 0x5f, 0xe4, 0xc1, 0x69, 0xb6, 0x68, 0x1c, 0x3f, 0x8e, 0x40, 0xf7, 0x49,
+	0x9e, 0x39, 0xd0, 0x27, 0xd9, 0xe3, 0x43, 0x3d, 0x7b, 0x0c, 0x1f, 0x6a, 0x93, 0x5d, 0x29, 0xb0,
+	0xb4, 0xe0, 0x9c, 0x2b, 0x4a, 0xa1, 0x63, 0x85, 0xfd, 0x78, 0x60, 0x3d, 0xfb, 0x17, 0xb8, 0x80,
+	0xbe, 0xe9, 0x2c, 0xe7, 0x1f, 0x38, 0x9d, 0x05, 0x97, 0xe7, 0x14, 0xce, 0xae, 0xea, 0xa8, 0x88,
+	0xe5, 0x39, 0x05, 0x83, 0x4e, 0x93, 0xf5, 0x48, 0x3e, 0x5e, 0x8c, 0x47, 0x32, 0xc7, 0xd9, 0x34,
+	0xfd, 0x08, 0x9c, 0x4d, 0x4f, 0x1c, 0xd9, 0xd9, 0xf4, 0x3e, 0x39, 0xdd, 0x09, 0xeb, 0x8b, 0x7e,
+	0x1c, 0x75, 0x59, 0xa5, 0x82, 0xf9, 0x6e, 0xbd, 0x41, 0x13, 0xe6, 0xad, 0x1a, 0xbd, 0x7c, 0x59,
+	0x6f, 0x64, 0x87, 0x7d, 0xc8, 0xb3, 0x3b, 0x2f, 0x6d, 0xd2, 0x84, 0xbf, 0xcc, 0xec, 0x53, 0xec,
+	0xc0, 0xc4, 0x32, 0x0b, 0x72, 0x90, 0x90, 0x27, 0x47, 0xf7, 0x75, 0x5d, 0x7c, 0x34, 0xbe, 0xae,
+	0xef, 0x23, 0xc3, 0x32, 0x52, 0x82, 0x79, 0xbd, 0x47, 0xe6, 0xbf, 0x55, 0xd9, 0x15, 0x04, 0xfc,
+	0x3e, 0x56, 0x94, 0x13, 0xff, 0x6b, 0x26, 0x05, 0x01, 0xb1, 0xbf, 0xd4, 0x27, 0xff, 0xd2, 0x3d,
+	0xc9, 0xfc, 0xcb, 0xf3, 0xc7, 0xca, 0xbd, 0xcc, 0x73, 0xe8, 0x3d, 0xfd, 0x4d, 0xe7, 0xd0, 0xfb,
+	0x05, 0x8b, 0x8c, 0xef, 0xe8, 0xf6, 0x1b, 0xe1, 0x74, 0x2c, 0xc0, 0xa9, 0x6d, 0x98, 0x85, 0xe6,
+	0x5d, 0x5c, 0xec, 0x0c, 0xd0, 0xfd, 0x2c, 0x00, 0xcc, 0x96, 0xe4, 0x04, 0x6b, 0x3d, 0xf3, 0x61,
+	0x05, 0x6b, 0xbd, 0xcf, 0x16, 0x33, 0x99, 0x55, 0xc0, 0x3c, 0x91, 0xc5, 0x66, 0x2e, 0xc8, 0x85,
+	0x51, 0x02, 0x40, 0x97, 0x87, 0x51, 0xfd, 0x53, 0xf2, 0x70, 0x26, 0x8c, 0xee, 0xb1, 0xf3, 0x6d,
+	0x45, 0x35, 0x42, 0x9d, 0x09, 0x59, 0xea, 0xd0, 0x7a, 0x46, 0x0e, 0xf4, 0x48, 0xc6, 0xa5, 0x5d,
+	0xc5, 0x21, 0x36, 0x62, 0xe7, 0xb9, 0x54, 0x91, 0x99, 0x4b, 0xc1, 0xa0, 0xd3, 0xd8, 0xbf, 0x62,
+	0x91, 0x4a, 0x33, 0x0c, 0xb7, 0x63, 0xe7, 0xf9, 0xa2, 0x2a, 0x27, 0x1a, 0x0a, 0x2a, 0x5e, 0xc0,
+	0x2b, 0x6e, 0x51, 0x7c, 0x49, 0x9e, 0xaf, 0x19, 0xec, 0xfe, 0xdd, 0x99, 0x09, 0xe3, 0x9a, 0xde,
+	0xf8, 0x73, 0x5f, 0xd5, 0x20, 0xc2, 0xa2, 0xc1, 0x9a, 0xc6, 0xea, 0x15, 0x47, 0xe1, 0x16, 0x66,
+	0xdf, 0x7e, 0x7b, 0xa6, 0x5e, 0x31, 0x07, 0x83, 0xc4, 0xdb, 0x3f, 0x6d, 0xc9, 0xda, 0x4d, 0xd2,
+	0xb6, 0x1f, 0x3b, 0xdf, 0x71, 0xb1, 0x5c, 0xcc, 0x21, 0x2e, 0x53, 0x53, 0x42, 0xb9, 0x75, 0x4d,
+	0x78, 0x0c, 0xd9, 0x16, 0x3c, 0xb4, 0x07, 0x7c, 0xfa, 0x0b, 0x78, 0x97, 0xb9, 0x1a, 0xca, 0x9c,
+	0x47, 0xa9, 0x59, 0x52, 0xb0, 0x80, 0x4f, 0xd1, 0x78, 0x39, 0xba, 0x37, 0xfe, 0x67, 0xcf, 0x90,
+	0x09, 0xd3, 0x0c, 0x6a, 0xbf, 0x6c, 0xde, 0x09, 0x78, 0x21, 0x7b, 0xa5, 0xda, 0xb8, 0xa4, 0x37,
+	0xae, 0x55, 0x33, 0xee, 0x3d, 0x2b, 0x9d, 0xe8, 0xbd, 0x67, 0xe5, 0x47, 0x73, 0xef, 0xd9, 0xd4,
+	0x49, 0xdc, 0x7b, 0x76, 0xea, 0x58, 0xf7, 0x9e, 0x69, 0x37, 0xb5, 0x0c, 0x1c, 0x72, 0x53, 0xcb,
+	0x1c, 0x99, 0x94, 0xd9, 0x87, 0x54, 0xdc, 0xe0, 0xc4, 0x3d, 0x24, 0x6a, 0x62, 0x2f, 0x98, 0x68,
+	0xc8, 0xd2, 0xdb, 0x5f, 0xb0, 0x48, 0x25, 0x08, 0xeb, 0xca, 0xb4, 0xf0, 0x46, 0xd1, 0x16, 0x76,
+	0x76, 0xc2, 0x15, 0x0b, 0x88, 0x8c, 0x8b, 0xaf, 0x30, 0xd8, 0x7d, 0xf9, 0x0f, 0xf0, 0x16, 0xe0,
+	0x8d, 0x1b, 0x21, 0xbf, 0x90, 0x31, 0xbd, 0x9c, 0x4d, 0xba, 0x70, 0xb8, 0xcb, 0x52, 0xdd, 0xb8,
+	0xb1, 0xda, 0x87, 0x0e, 0xfa, 0x72, 0x40, 0x13, 0xc5, 0x64, 0x9c, 0x84, 0x11, 0xad, 0xa7, 0xe6,
+	0x94, 0x11, 0xd6, 0x67, 0x5a, 0x78, 0x9f, 0xab, 0xa6, 0x1c, 0xde, 0xfb, 0x74, 0xb5, 0x31, 0xb1,
+	0x90, 0x6d, 0x96, 0x1d, 0x91, 0x73, 0x9d, 0x3c, 0x6b, 0x4e, 0xec, 0x0c, 0x1d, 0x6a, 0x53, 0x92,
+	0x9f, 0xee, 0xb9, 0x5c, 0x7b, 0x50, 0x0c, 0x7d, 0x38, 0x0b, 0x2d, 0x5b, 0xb9, 0x11, 0x9d, 0x33,
+	0x3d, 0x5a, 0xb6, 0xc2, 0x81, 0x41, 0xa9, 0xdf, 0x70, 0x36, 0xfc, 0x68, 0x6e, 0x38, 0xfb, 0x0c,
+	0x21, 0xaa, 0xa6, 0xb0, 0xb4, 0x2c, 0xdc, 0x2c, 0x24, 0x58, 0x90, 0xf3, 0x4c, 0xd7, 0x0e, 0x05,
+	0x8a, 0x41, 0x13, 0x69, 0xff, 0x9f, 0xdc, 0xbb, 0x09, 0xb9, 0xf9, 0xa4, 0x51, 0xf8, 0x6c, 0xfa,
+	0x4b, 0x70, 0x3f, 0xe1, 0xe9, 0x47, 0x7e, 0x3f, 0xe1, 0xaf, 0x5b, 0x64, 0x9a, 0x7f, 0x37, 0xd9,
+	0x83, 0x03, 0xaa, 0x2d, 0xce, 0xc4, 0x89, 0xf8, 0x28, 0x59, 0x74, 0x4f, 0xd5, 0x90, 0x8a, 0x70,
+	0x38, 0xa0, 0x25, 0x2c, 0x54, 0x2d, 0x7b, 0x5c, 0x99, 0x2c, 0xca, 0x28, 0x9a, 0x7f, 0x99, 0xdc,
+	0xe9, 0x7b, 0x47, 0x39, 0xa1, 0xfc, 0xa3, 0xbe, 0x36, 0x5b, 0x9b, 0x35, 0xef, 0xaf, 0x9f, 0x90,
+	0xcd, 0x56, 0xbf, 0xf1, 0xee, 0x38, 0x96, 0xdb, 0xe9, 0x1f, 0xb1, 0xf8, 0x1d, 0xbd, 0x7d, 0x75,
+	0xa8, 0x4d, 0x53, 0x87, 0x5a, 0x2e, 0xf2, 0x96, 0x50, 0x5d, 0x99, 0xfb, 0x5b, 0x58, 0x6e, 0x36,
+	0x67, 0x89, 0xcf, 0x69, 0xd2, 0xa7, 0xcd, 0x26, 0x15, 0x78, 0xa8, 0xd0, 0x1b, 0x54, 0xcc, 0x7d,
+	0x7c, 0xff, 0x98, 0x68, 0x9e, 0x32, 0x0c, 0xf5, 0x2b, 0x3a, 0x78, 0x31, 0xc0, 0x62, 0x03, 0x68,
+	0xed, 0x73, 0xc6, 0x8b, 0x1e, 0x0d, 0x79, 0xf7, 0x26, 0x72, 0x07, 0x21, 0xe5, 0x43, 0x76, 0x9c,
+	0x65, 0xaf, 0x59, 0x1e, 0x78, 0xf4, 0xd7, 0x2c, 0xef, 0x92, 0x91, 0x5d, 0x3f, 0x69, 0x32, 0x7f,
+	0xa8, 0xf0, 0x47, 0x15, 0x90, 0x18, 0x8b, 0xec, 0xd2, 0xbe, 0x6f, 0x48, 0x01, 0x90, 0xca, 0xc2,
+	0xf0, 0x1b, 0xfc, 0xc1, 0x42, 0xfb, 0xb2, 0xe1, 0x37, 0x1b, 0x12, 0x01, 0x29, 0x0d, 0x0e, 0xd6,
+	0x18, 0xfe, 0x92, 0x15, 0xdc, 0x9c, 0xa1, 0xa2, 0x66, 0x88, 0xe4, 0xc8, 0x33, 0x5e, 0x37, 0x34,
+	0x19, 0x60, 0x48, 0x64, 0xe1, 0x98, 0x7e, 0xd2, 0x94, 0x4b, 0x92, 0x33, 0x61, 0x6a, 0x40, 0x1b,
+	0x1a, 0x0e, 0x0c, 0x4a, 0x75, 0x11, 0xc9, 0x70, 0xdf, 0x8b, 0x48, 0xde, 0x63, 0x1a, 0x4b, 0xe2,
+	0x07, 0x5d, 0xba, 0x1a, 0x38, 0x23, 0x45, 0x2d, 0x4f, 0x0b, 0x8a, 0xa7, 0x48, 0xdb, 0x52, 0xbf,
+	0x41, 0x93, 0xa7, 0x39, 0x14, 0x46, 0x0f, 0x74, 0x28, 0xa4, 0xb6, 0x84, 0xb1, 0xc2, 0x6d, 0x09,
+	0x09, 0xed, 0x14, 0x62, 0x4b, 0xf8, 0xa6, 0x3a, 0x49, 0xff, 0x49, 0x89, 0x4c, 0xaa, 0x4d, 0x1f,
+	0x6b, 0xbe, 0xd0, 0xe4, 0x11, 0x04, 0x08, 0xed, 0x1a, 0x01, 0x42, 0x45, 0xda, 0x64, 0x79, 0x17,
+	0xfa, 0x86, 0x63, 0x7d, 0x26, 0x13, 0x8e, 0xb5, 0x51, 0xbc, 0xe8, 0x83, 0xa3, 0xb2, 0xfe, 0x9b,
+	0x45, 0x4e, 0x67, 0x9e, 0x78, 0x04, 0x21, 0x2b, 0x3b, 0x66, 0xc8, 0xca, 0xed, 0xc2, 0x7b, 0xdd,
+	0x27, 0x72, 0xe5, 0x57, 0x4b, 0x3d, 0xbd, 0x65, 0x1a, 0xe5, 0x0f, 0x5b, 0xa4, 0x92, 0x78, 0xf1,
+	0xb6, 0x8c, 0x5e, 0xf9, 0xf4, 0x89, 0xcc, 0x80, 0x59, 0xfc, 0x5f, 0x7c, 0xad, 0xe9, 0x9d, 0xb2,
+	0x08, 0x03, 0x2e, 0x7d, 0xfa, 0x87, 0x2c, 0x42, 0x52, 0xa2, 0x0f, 0x4b, 0xf9, 0xc1, 0x90, 0xe0,
+	0xb3, 0xb9, 0xd3, 0xc8, 0xfe, 0x40, 0x19, 0x37, 0xf8, 0x40, 0x6d, 0x9e, 0xd0, 0x7c, 0xd5, 0x6d,
+	0x1c, 0xe3, 0x86, 0x8d, 0x43, 0x98, 0x36, 0x3e, 0x2c, 0xd5, 0x55, 0x5c, 0xcd, 0xa8, 0x0d, 0xd6,
+	0x7f, 0xb7, 0xc8, 0x54, 0xf6, 0x98, 0xf2, 0x08, 0x96, 0xac, 0x3d, 0x63, 0xc9, 0xba, 0x53, 0xbc,
+	0x1b, 0xa9, 0x6f, 0x3c, 0xe3, 0x37, 0x2c, 0x72, 0x36, 0x4b, 0xbc, 0x14, 0x79, 0xc1, 0xa3, 0x58,
+	0xa8, 0xdf, 0x37, 0x7a, 0xfd, 0x46, 0xf1, 0xbd, 0x66, 0x1d, 0xe9, 0xdb, 0xf5, 0xaf, 0x5b, 0xe4,
+	0xf1, 0xdc, 0x27, 0x1e, 0xc1, 0x9a, 0xf9, 0x9e, 0xb9, 0x66, 0x6e, 0x9c, 0x50, 0xdf, 0xfb, 0xac,
+	0x9c, 0x5f, 0xec, 0xd7, 0x73, 0xb6, 0x7e, 0xce, 0x12, 0xa2, 0x62, 0xe4, 0xf9, 0xd2, 0x20, 0x2a,
+	0x88, 0x2a, 0x9b, 0x56, 0x0c, 0x1a, 0x85, 0xbd, 0x40, 0x4e, 0x65, 0xfd, 0x90, 0xb1, 0x76, 0x4b,
+	0xe3, 0xa9, 0xac, 0xa4, 0x18, 0x7a, 0xe9, 0xdd, 0x3f, 0xd1, 0x02, 0x8a, 0x25, 0xf4, 0x11, 0xbc,
+	0x87, 0x5d, 0xf3, 0x3d, 0x40, 0xf1, 0xef, 0xa1, 0xcf, 0x2b, 0xf8, 0x65, 0x7d, 0xab, 0x3e, 0x56,
+	0x42, 0x5c, 0x36, 0xc5, 0xad, 0x74, 0xe4, 0x14, 0x37, 0x76, 0xdf, 0xe6, 0x8e, 0x1f, 0xcb, 0xe2,
+	0xfc, 0x65, 0xfd, 0xbe, 0x4d, 0x0e, 0x07, 0x45, 0xe1, 0xfe, 0x44, 0xa9, 0xf7, 0x8d, 0xb0, 0xf9,
+	0xf1, 0xa3, 0x78, 0x16, 0xd1, 0xcc, 0x3b, 0xc5, 0x15, 0xc0, 0x34, 0x8c, 0x49, 0xe9, 0xc9, 0x42,
+	0x83, 0x82, 0x21, 0xd9, 0x7e, 0x2b, 0x6d, 0x09, 0xbe, 0xd8, 0x43, 0x6b, 0x4f, 0xf7, 0x5b, 0xa7,
+	0x98, 0x03, 0x72, 0x43, 0xe3, 0xc4, 0x5c, 0xa1, 0x06, 0x6f, 0x77, 0x9c, 0x8c, 0xbe, 0xee, 0xab,
+	0xb2, 0xd0, 0xf3, 0xb3, 0x5f, 0xfe, 0xda, 0x85, 0xc7, 0x7e, 0xef, 0x6b, 0x17, 0x1e, 0xfb, 0xca,
+	0xd7, 0x2e, 0x3c, 0xf6, 0xd9, 0x7b, 0x17, 0xac, 0x2f, 0xdf, 0xbb, 0x60, 0xfd, 0xde, 0xbd, 0x0b,
+	0xd6, 0x57, 0xee, 0x5d, 0xb0, 0xfe, 0xf3, 0xbd, 0x0b, 0xd6, 0x17, 0xff, 0xcb, 0x85, 0xc7, 0x5e,
+	0x1f, 0x96, 0x7d, 0xfb, 0x7f, 0x03, 0x00, 0x62, 0xe8, 0x65, 0x61, 0x78, 0xe1, 0x00, 0x00,
+}
+
+func (m *AWSSecretsManagerSecretProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSSecretsManagerSecretProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSSecretsManagerSecretProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecretKeySecret != nil {
+		{
+			size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccessKeySecret != nil {
+		{
+			size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -4223,6 +5347,150 @@ func (m *Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequiredApprovals))
+	i--
+	dAtA[i] = 0x18
+	if len(m.ApproverGroups) > 0 {
+		for iNdEx := len(m.ApproverGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApproverGroups[iNdEx])
+			copy(dAtA[i:], m.ApproverGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApproverGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Comment)
+	copy(dAtA[i:], m.Comment)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Comment)))
+	i--
+	dAtA[i] = 0x1a
+	i--
+	if m.Approved {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Approver)
+	copy(dAtA[i:], m.Approver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Approver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Gate != nil {
+		{
+			size, err := m.Gate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ArchiveStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4353,6 +5621,16 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x62
 	i -= len(m.FromExpression)
 	copy(dAtA[i:], m.FromExpression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
@@ -4449,6 +5727,16 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SplitLogs != nil {
+		i--
+		if *m.SplitLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4978,6 +6266,180 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Catchup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Catchup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Catchup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Limit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Propagate != nil {
+		{
+			size, err := m.Propagate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowPropagation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowPropagation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowPropagation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retry != nil {
+		i--
+		if *m.Retry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Shutdown != nil {
+		i--
+		if *m.Shutdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5148,6 +6610,44 @@ func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerPipe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerPipe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerPipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.To)
+	copy(dAtA[i:], m.To)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.To)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerSetRetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5208,6 +6708,18 @@ func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Workspace != nil {
+		{
+			size, err := m.Workspace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.RetryStrategy != nil {
 		{
 			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
@@ -5251,6 +6763,46 @@ func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerSetWorkspace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerSetWorkspace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerSetWorkspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeLimit != nil {
+		{
+			size, err := m.SizeLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.MountPath)
+	copy(dAtA[i:], m.MountPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MountPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5469,6 +7021,72 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhenConfigMaps) > 0 {
+		for iNdEx := len(m.WhenConfigMaps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhenConfigMaps[iNdEx])
+			copy(dAtA[i:], m.WhenConfigMaps[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.WhenConfigMaps[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i -= len(m.PreviousRunPolicy)
+	copy(dAtA[i:], m.PreviousRunPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PreviousRunPolicy)))
+	i--
+	dAtA[i] = 0x7a
+	if m.StopStrategy != nil {
+		{
+			size, err := m.StopStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i -= len(m.Splay)
+	copy(dAtA[i:], m.Splay)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Splay)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.DaylightSavingPolicy)
+	copy(dAtA[i:], m.DaylightSavingPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DaylightSavingPolicy)))
+	i--
+	dAtA[i] = 0x62
+	if m.Catchup != nil {
+		{
+			size, err := m.Catchup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Schedules[iNdEx])
+			copy(dAtA[i:], m.Schedules[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedules[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.WorkflowMetadata != nil {
 		{
 			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -5552,6 +7170,41 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelayedScheduledTime != nil {
+		{
+			size, err := m.DelayedScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x28
+	if len(m.NextScheduledTimes) > 0 {
+		for iNdEx := len(m.NextScheduledTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextScheduledTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Conditions) > 0 {
 		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5615,6 +7268,25 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	i -= len(m.WithArtifact)
+	copy(dAtA[i:], m.WithArtifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithArtifact)))
+	i--
+	dAtA[i] = 0x7a
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -5892,6 +7564,41 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EgressDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Ports[iNdEx]))
+			i--
+			dAtA[i] = 0x10
+		}
+	}
+	i -= len(m.CIDR)
+	copy(dAtA[i:], m.CIDR)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CIDR)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5948,6 +7655,44 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExternalSecretSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalSecretSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalSecretSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6064,6 +7809,164 @@ func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x42
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Request)
+	copy(dAtA[i:], m.Request)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Request)))
+	i--
+	dAtA[i] = 0x22
+	if m.DescriptorSet != nil {
+		{
+			size, err := m.DescriptorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Target)
+	copy(dAtA[i:], m.Target)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GRPCTLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCTLS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCTLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientKeySecret != nil {
+		{
+			size, err := m.ClientKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClientCertSecret != nil {
+		{
+			size, err := m.ClientCertSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CACertSecret != nil {
+		{
+			size, err := m.CACertSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.ServerName)
+	copy(dAtA[i:], m.ServerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6434,6 +8337,18 @@ func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cache != nil {
+		{
+			size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i -= len(m.SuccessCondition)
 	copy(dAtA[i:], m.SuccessCondition)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
@@ -6518,6 +8433,51 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != nil {
+		{
+			size, err := m.MaxSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.TTL)
+	copy(dAtA[i:], m.TTL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TTL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6911,6 +8871,113 @@ func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Matrix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Matrix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Matrix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Include) > 0 {
+		for iNdEx := len(m.Include) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Include[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exclude[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Axes) > 0 {
+		for iNdEx := len(m.Axes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Axes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatrixAxis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatrixAxis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatrixAxis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6972,6 +9039,14 @@ func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Auto {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
 	i -= len(m.MaxAge)
 	copy(dAtA[i:], m.MaxAge)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
@@ -7270,6 +9345,14 @@ func (m *NodeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.OutputsOffloaded {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
 	if m.Outputs != nil {
 		{
 			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
@@ -7315,6 +9398,48 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceUsage != nil {
+		{
+			size, err := m.ResourceUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -7580,6 +9705,69 @@ func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	i -= len(m.Color)
+	copy(dAtA[i:], m.Color)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Color)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Title)
+	copy(dAtA[i:], m.Title)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.WebhookURLSecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OSSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7810,6 +9998,13 @@ func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stderr != nil {
+		i -= len(*m.Stderr)
+		copy(dAtA[i:], *m.Stderr)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Stderr)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ExitCode != nil {
 		i -= len(*m.ExitCode)
 		copy(dAtA[i:], *m.ExitCode)
@@ -7912,6 +10107,18 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Description != nil {
 		i -= len(*m.Description)
 		copy(dAtA[i:], *m.Description)
@@ -7967,6 +10174,75 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Maximum != nil {
+		{
+			size, err := m.Maximum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Minimum != nil {
+		{
+			size, err := m.Minimum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Enum) > 0 {
+		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enum[iNdEx])
+			copy(dAtA[i:], m.Enum[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Enum[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i--
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Plugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8060,6 +10336,16 @@ func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Exemplar != nil {
+		i--
+		if *m.Exemplar {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Counter != nil {
 		{
 			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
@@ -8176,6 +10462,11 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ConditionLanguage)
+	copy(dAtA[i:], m.ConditionLanguage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConditionLanguage)))
+	i--
+	dAtA[i] = 0x42
 	if len(m.Flags) > 0 {
 		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Flags[iNdEx])
@@ -8221,6 +10512,90 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Samples))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Average) > 0 {
+		keysForAverage := make([]string, 0, len(m.Average))
+		for k := range m.Average {
+			keysForAverage = append(keysForAverage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAverage)
+		for iNdEx := len(keysForAverage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Average[k8s_io_api_core_v1.ResourceName(keysForAverage[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAverage[iNdEx])
+			copy(dAtA[i:], keysForAverage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAverage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Peak) > 0 {
+		keysForPeak := make([]string, 0, len(m.Peak))
+		for k := range m.Peak {
+			keysForPeak = append(keysForPeak, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPeak)
+		for iNdEx := len(keysForPeak) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Peak[k8s_io_api_core_v1.ResourceName(keysForPeak[iNdEx])]
+			baseI := i
+			{
+				size, err := ((*resource.Quantity)(&v)).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPeak[iNdEx])
+			copy(dAtA[i:], keysForPeak[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPeak[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8449,6 +10824,20 @@ func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mirrors) > 0 {
+		for iNdEx := len(m.Mirrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mirrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.EncryptionOptions != nil {
 		{
 			size, err := m.EncryptionOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -8591,6 +10980,114 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *S3Mirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *S3Mirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Mirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Replicate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SQL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DSNSecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Driver)
+	copy(dAtA[i:], m.Driver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Driver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8611,6 +11108,11 @@ func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Runtime)
+	copy(dAtA[i:], m.Runtime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Runtime)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Source)
 	copy(dAtA[i:], m.Source)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
@@ -8629,6 +11131,58 @@ func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SecretProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AWSSecretsManager != nil {
+		{
+			size, err := m.AWSSecretsManager.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SemaphoreHolding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8816,6 +11370,39 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StopStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	if m.SuspendAfterFailures != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SuspendAfterFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Submit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8891,6 +11478,11 @@ func (m *SubmitOpts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Preset)
+	copy(dAtA[i:], m.Preset)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Preset)))
+	i--
+	dAtA[i] = 0x7a
 	if m.Priority != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
 		i--
@@ -9019,37 +11611,9 @@ func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Duration)
-	copy(dAtA[i:], m.Duration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Synchronization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Synchronization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Mutex != nil {
+	if m.Approval != nil {
 		{
-			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9059,22 +11623,15 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Semaphore != nil {
-		{
-			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
+func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9084,12 +11641,59 @@ func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Synchronization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mutex != nil {
+		{
+			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Semaphore != nil {
+		{
+			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9187,6 +11791,41 @@ func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matrix != nil {
+		{
+			size, err := m.Matrix.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Template) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9207,6 +11846,252 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NetworkPolicy != nil {
+		{
+			size, err := m.NetworkPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.Platforms) > 0 {
+		for iNdEx := len(m.Platforms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Platforms[iNdEx])
+			copy(dAtA[i:], m.Platforms[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Platforms[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Pipes) > 0 {
+		for iNdEx := len(m.Pipes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Sysctls) > 0 {
+		for iNdEx := len(m.Sysctls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sysctls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.DNSConfig != nil {
+		{
+			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.DNSPolicy != nil {
+		i -= len(*m.DNSPolicy)
+		copy(dAtA[i:], *m.DNSPolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Notification != nil {
+		{
+			size, err := m.Notification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ShutdownGracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ShutdownGracePeriodSeconds))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	i -= len(m.OnShutdown)
+	copy(dAtA[i:], m.OnShutdown)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnShutdown)))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xba
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.HostUsers != nil {
+		i--
+		if *m.HostUsers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	i -= len(m.RuntimeClassName)
+	copy(dAtA[i:], m.RuntimeClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RuntimeClassName)))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	i -= len(m.Spot)
+	copy(dAtA[i:], m.Spot)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Spot)))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	i--
+	if m.CriticalStep {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x90
+	if m.ChildWorkflow != nil {
+		{
+			size, err := m.ChildWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.EnvFrom) > 0 {
+		for iNdEx := len(m.EnvFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EnvFrom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.ArtifactRepositoryRef != nil {
+		{
+			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -9688,6 +12573,48 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TemplateNetworkPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateNetworkPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateNetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EgressAllowlist) > 0 {
+		for iNdEx := len(m.EgressAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EgressAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9708,6 +12635,11 @@ func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x2a
 	i--
 	if m.ClusterScope {
 		dAtA[i] = 1
@@ -9749,6 +12681,19 @@ func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Chunk))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Filter)
+	copy(dAtA[i:], m.Filter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Filter)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -9777,6 +12722,14 @@ func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.WaitForReady {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	if m.MirrorVolumeMounts != nil {
 		i--
 		if *m.MirrorVolumeMounts {
@@ -9820,6 +12773,23 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExternalSecret != nil {
+		{
+			size, err := m.ExternalSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.LogsRegex)
+	copy(dAtA[i:], m.LogsRegex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogsRegex)))
+	i--
+	dAtA[i] = 0x52
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -9884,6 +12854,61 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultSecretProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSecretProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSecretProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TokenSecret != nil {
+		{
+			size, err := m.TokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.AuthMountPath)
+	copy(dAtA[i:], m.AuthMountPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthMountPath)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Version) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10230,6 +13255,29 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SecretProviders) > 0 {
+		for iNdEx := len(m.SecretProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecretProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	i -= len(m.Profile)
+	copy(dAtA[i:], m.Profile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Profile)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
 	if len(m.Hooks) > 0 {
 		keysForHooks := make([]string, 0, len(m.Hooks))
 		for k := range m.Hooks {
@@ -10732,6 +13780,27 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PodNamespace)
+	copy(dAtA[i:], m.PodNamespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodNamespace)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.ArtifactRepositoryRef != nil {
 		{
 			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
@@ -10965,6 +14034,11 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.WithArtifact)
+	copy(dAtA[i:], m.WithArtifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithArtifact)))
+	i--
+	dAtA[i] = 0x72
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -11341,6 +14415,137 @@ func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowTemplateGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateGrantList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateGrantList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateGrantList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateGrantSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateGrantSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateGrantSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkflowTemplates) > 0 {
+		for iNdEx := len(m.WorkflowTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WorkflowTemplates[iNdEx])
+			copy(dAtA[i:], m.WorkflowTemplates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11408,6 +14613,9 @@ func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x18
 	i--
 	if m.ClusterScope {
 		dAtA[i] = 1
@@ -11503,6 +14711,27 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *AWSSecretsManagerSecretProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKeySecret != nil {
+		l = m.AccessKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKeySecret != nil {
+		l = m.SecretKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Amount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11514,6 +14743,63 @@ func (m *Amount) Size() (n int) {
 	return n
 }
 
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ApproverGroups) > 0 {
+		for _, s := range m.ApproverGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.RequiredApprovals))
+	return n
+}
+
+func (m *ApprovalDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Comment)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApprovalStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gate != nil {
+		l = m.Gate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ArchiveStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11585,6 +14871,10 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11629,6 +14919,9 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SplitLogs != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -11779,6 +15072,69 @@ func (m *Cache) Size() (n int) {
 	return n
 }
 
+func (m *Catchup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Limit != nil {
+		n += 1 + sovGenerated(uint64(*m.Limit))
+	}
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChildWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WorkflowTemplateRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Propagate != nil {
+		l = m.Propagate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChildWorkflowPropagation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shutdown != nil {
+		n += 2
+	}
+	if m.Retry != nil {
+		n += 2
+	}
+	return n
+}
+
 func (m *ClusterWorkflowTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11841,6 +15197,21 @@ func (m *ContainerNode) Size() (n int) {
 	return n
 }
 
+func (m *ContainerPipe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.To)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ContainerSetRetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11878,6 +15249,25 @@ func (m *ContainerSetTemplate) Size() (n int) {
 		l = m.RetryStrategy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Workspace != nil {
+		l = m.Workspace.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerSetWorkspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MountPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SizeLimit != nil {
+		l = m.SizeLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11973,6 +15363,34 @@ func (m *CronWorkflowSpec) Size() (n int) {
 		l = m.WorkflowMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, s := range m.Schedules {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Catchup != nil {
+		l = m.Catchup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.DaylightSavingPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Splay)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StopStrategy != nil {
+		l = m.StopStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PreviousRunPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.When)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.WhenConfigMaps) > 0 {
+		for _, s := range m.WhenConfigMaps {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11998,6 +15416,19 @@ func (m *CronWorkflowStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.NextScheduledTimes) > 0 {
+		for _, e := range m.NextScheduledTimes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	if m.DelayedScheduledTime != nil {
+		l = m.DelayedScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12058,6 +15489,12 @@ func (m *DAGTask) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.WithArtifact)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12111,6 +15548,22 @@ func (m *DataSource) Size() (n int) {
 	return n
 }
 
+func (m *EgressDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CIDR)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12133,6 +15586,21 @@ func (m *ExecutorConfig) Size() (n int) {
 	return n
 }
 
+func (m *ExternalSecretSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GCSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12174,6 +15642,64 @@ func (m *GCSBucket) Size() (n int) {
 	return n
 }
 
+func (m *GRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DescriptorSet != nil {
+		l = m.DescriptorSet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Request)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GRPCTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.CACertSecret != nil {
+		l = m.CACertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClientCertSecret != nil {
+		l = m.ClientCertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClientKeySecret != nil {
+		l = m.ClientKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Gauge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12321,6 +15847,10 @@ func (m *HTTP) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SuccessCondition)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12341,6 +15871,23 @@ func (m *HTTPArtifact) Size() (n int) {
 	return n
 }
 
+func (m *HTTPCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TTL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxSize != nil {
+		l = m.MaxSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *HTTPHeader) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12499,6 +16046,50 @@ func (m *Link) Size() (n int) {
 	return n
 }
 
+func (m *Matrix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Axes) > 0 {
+		for _, e := range m.Axes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Exclude) > 0 {
+		for _, e := range m.Exclude {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Include) > 0 {
+		for _, e := range m.Include {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MatrixAxis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MemoizationStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12527,6 +16118,7 @@ func (m *Memoize) Size() (n int) {
 	}
 	l = len(m.MaxAge)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -12642,6 +16234,7 @@ func (m *NodeResult) Size() (n int) {
 		l = m.Outputs.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -12723,6 +16316,18 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ResourceUsage != nil {
+		l = m.ResourceUsage.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12746,6 +16351,30 @@ func (m *NoneStrategy) Size() (n int) {
 	return n
 }
 
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.WebhookURLSecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Title)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Color)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	return n
+}
+
 func (m *OSSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12846,6 +16475,10 @@ func (m *Outputs) Size() (n int) {
 		l = len(*m.ExitCode)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Stderr != nil {
+		l = len(*m.Stderr)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12896,6 +16529,36 @@ func (m *Parameter) Size() (n int) {
 		l = len(*m.Description)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ParameterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if len(m.Enum) > 0 {
+		for _, s := range m.Enum {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Minimum != nil {
+		l = m.Minimum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maximum != nil {
+		l = m.Maximum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12955,6 +16618,9 @@ func (m *Prometheus) Size() (n int) {
 		l = m.Counter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Exemplar != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -12992,6 +16658,36 @@ func (m *ResourceTemplate) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ConditionLanguage)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peak) > 0 {
+		for k, v := range m.Peak {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Average) > 0 {
+		for k, v := range m.Average {
+			_ = k
+			_ = v
+			l = ((*resource.Quantity)(&v)).Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Samples))
 	return n
 }
 
@@ -13104,6 +16800,12 @@ func (m *S3Bucket) Size() (n int) {
 		l = m.EncryptionOptions.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Mirrors) > 0 {
+		for _, e := range m.Mirrors {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13125,6 +16827,48 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *S3Mirror) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *SQL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Driver)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DSNSecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13135,6 +16879,27 @@ func (m *ScriptTemplate) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Source)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Runtime)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SecretProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSSecretsManager != nil {
+		l = m.AWSSecretsManager.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13212,6 +16977,20 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *StopStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuspendAfterFailures != nil {
+		n += 1 + sovGenerated(uint64(*m.SuspendAfterFailures))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Submit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13266,6 +17045,8 @@ func (m *SubmitOpts) Size() (n int) {
 	if m.Priority != nil {
 		n += 1 + sovGenerated(uint64(*m.Priority))
 	}
+	l = len(m.Preset)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13286,6 +17067,10 @@ func (m *SuspendTemplate) Size() (n int) {
 	_ = l
 	l = len(m.Duration)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13353,6 +17138,19 @@ func (m *TarStrategy) Size() (n int) {
 	return n
 }
 
+func (m *TaskStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Matrix != nil {
+		l = m.Matrix.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Template) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13508,6 +17306,102 @@ func (m *Template) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactRepositoryRef != nil {
+		l = m.ArtifactRepositoryRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.EnvFrom) > 0 {
+		for _, e := range m.EnvFrom {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ChildWorkflow != nil {
+		l = m.ChildWorkflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	l = len(m.Spot)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.RuntimeClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.HostUsers != nil {
+		n += 3
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnShutdown)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.ShutdownGracePeriodSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ShutdownGracePeriodSeconds))
+	}
+	if m.Notification != nil {
+		l = m.Notification.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSPolicy != nil {
+		l = len(*m.DNSPolicy)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSConfig != nil {
+		l = m.DNSConfig.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Sysctls) > 0 {
+		for _, e := range m.Sysctls {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Pipes) > 0 {
+		for _, e := range m.Pipes {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.NetworkPolicy != nil {
+		l = m.NetworkPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TemplateNetworkPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.EgressAllowlist) > 0 {
+		for _, e := range m.EgressAllowlist {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13522,6 +17416,8 @@ func (m *TemplateRef) Size() (n int) {
 	l = len(m.Template)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13533,6 +17429,11 @@ func (m *TransformationStep) Size() (n int) {
 	_ = l
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Filter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Map)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Chunk))
 	return n
 }
 
@@ -13547,6 +17448,7 @@ func (m *UserContainer) Size() (n int) {
 	if m.MirrorVolumeMounts != nil {
 		n += 2
 	}
+	n += 2
 	return n
 }
 
@@ -13580,6 +17482,33 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.LogsRegex)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExternalSecret != nil {
+		l = m.ExternalSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultSecretProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AuthMountPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TokenSecret != nil {
+		l = m.TokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13859,6 +17788,14 @@ func (m *WorkflowSpec) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.Profile)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.SecretProviders) > 0 {
+		for _, e := range m.SecretProviders {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13937,6 +17874,12 @@ func (m *WorkflowStatus) Size() (n int) {
 		l = m.ArtifactRepositoryRef.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13989,6 +17932,8 @@ func (m *WorkflowStep) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.WithArtifact)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14073,6 +18018,57 @@ func (m *WorkflowTemplate) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowTemplateGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowTemplateGrantList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowTemplateGrantSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.WorkflowTemplates) > 0 {
+		for _, s := range m.WorkflowTemplates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *WorkflowTemplateList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14099,6 +18095,7 @@ func (m *WorkflowTemplateRef) Size() (n int) {
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	n += 1 + sovGenerated(uint64(m.Revision))
 	return n
 }
 
@@ -14132,6 +18129,19 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AWSSecretsManagerSecretProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AWSSecretsManagerSecretProvider{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Amount) String() string {
 	if this == nil {
 		return "nil"
@@ -14142,6 +18152,47 @@ func (this *Amount) String() string {
 	}, "")
 	return s
 }
+func (this *Approval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Approval{`,
+		`Approvers:` + fmt.Sprintf("%v", this.Approvers) + `,`,
+		`ApproverGroups:` + fmt.Sprintf("%v", this.ApproverGroups) + `,`,
+		`RequiredApprovals:` + fmt.Sprintf("%v", this.RequiredApprovals) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApprovalDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApprovalDecision{`,
+		`Approver:` + fmt.Sprintf("%v", this.Approver) + `,`,
+		`Approved:` + fmt.Sprintf("%v", this.Approved) + `,`,
+		`Comment:` + fmt.Sprintf("%v", this.Comment) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApprovalStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDecisions := "[]ApprovalDecision{"
+	for _, f := range this.Decisions {
+		repeatedStringForDecisions += strings.Replace(strings.Replace(f.String(), "ApprovalDecision", "ApprovalDecision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDecisions += "}"
+	s := strings.Join([]string{`&ApprovalStatus{`,
+		`Gate:` + strings.Replace(this.Gate.String(), "Approval", "Approval", 1) + `,`,
+		`Decisions:` + repeatedStringForDecisions + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArchiveStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -14191,6 +18242,8 @@ func (this *Artifact) String() string {
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14209,6 +18262,7 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`SplitLogs:` + valueToStringGenerated(this.SplitLogs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14293,6 +18347,53 @@ func (this *Cache) String() string {
 	}, "")
 	return s
 }
+func (this *Catchup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Catchup{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Limit:` + valueToStringGenerated(this.Limit) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Checkpoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Checkpoint{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflow{`,
+		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`Propagate:` + strings.Replace(this.Propagate.String(), "ChildWorkflowPropagation", "ChildWorkflowPropagation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflowPropagation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflowPropagation{`,
+		`Shutdown:` + valueToStringGenerated(this.Shutdown) + `,`,
+		`Retry:` + valueToStringGenerated(this.Retry) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterWorkflowTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -14343,6 +18444,18 @@ func (this *ContainerNode) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerPipe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerPipe{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContainerSetRetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -14372,6 +18485,18 @@ func (this *ContainerSetTemplate) String() string {
 		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
 		`Containers:` + repeatedStringForContainers + `,`,
 		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "ContainerSetRetryStrategy", "ContainerSetRetryStrategy", 1) + `,`,
+		`Workspace:` + strings.Replace(this.Workspace.String(), "ContainerSetWorkspace", "ContainerSetWorkspace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerSetWorkspace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerSetWorkspace{`,
+		`MountPath:` + fmt.Sprintf("%v", this.MountPath) + `,`,
+		`SizeLimit:` + strings.Replace(fmt.Sprintf("%v", this.SizeLimit), "Quantity", "resource.Quantity", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14449,6 +18574,14 @@ func (this *CronWorkflowSpec) String() string {
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
 		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
+		`Catchup:` + strings.Replace(this.Catchup.String(), "Catchup", "Catchup", 1) + `,`,
+		`DaylightSavingPolicy:` + fmt.Sprintf("%v", this.DaylightSavingPolicy) + `,`,
+		`Splay:` + fmt.Sprintf("%v", this.Splay) + `,`,
+		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
+		`PreviousRunPolicy:` + fmt.Sprintf("%v", this.PreviousRunPolicy) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`WhenConfigMaps:` + fmt.Sprintf("%v", this.WhenConfigMaps) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14467,10 +18600,20 @@ func (this *CronWorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForNextScheduledTimes := "[]Time{"
+	for _, f := range this.NextScheduledTimes {
+		repeatedStringForNextScheduledTimes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForNextScheduledTimes += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
 		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
+		`NextScheduledTimes:` + repeatedStringForNextScheduledTimes + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`DelayedScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.DelayedScheduledTime), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14509,6 +18652,8 @@ func (this *DAGTask) String() string {
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`WithArtifact:` + fmt.Sprintf("%v", this.WithArtifact) + `,`,
+		`Strategy:` + strings.Replace(this.Strategy.String(), "TaskStrategy", "TaskStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14556,6 +18701,17 @@ func (this *DataSource) String() string {
 	}, "")
 	return s
 }
+func (this *EgressDestination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EgressDestination{`,
+		`CIDR:` + fmt.Sprintf("%v", this.CIDR) + `,`,
+		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Event) String() string {
 	if this == nil {
 		return "nil"
@@ -14576,6 +18732,18 @@ func (this *ExecutorConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ExternalSecretSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExternalSecretSelector{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GCSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -14609,6 +18777,42 @@ func (this *GCSBucket) String() string {
 	}, "")
 	return s
 }
+func (this *GRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetadata := "[]HTTPHeader{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&GRPC{`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`DescriptorSet:` + strings.Replace(fmt.Sprintf("%v", this.DescriptorSet), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "GRPCTLS", "GRPCTLS", 1) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GRPCTLS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCTLS{`,
+		`ServerName:` + fmt.Sprintf("%v", this.ServerName) + `,`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`CACertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CACertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Gauge) String() string {
 	if this == nil {
 		return "nil"
@@ -14705,6 +18909,7 @@ func (this *HTTP) String() string {
 		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
 		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
 		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`Cache:` + strings.Replace(this.Cache.String(), "HTTPCache", "HTTPCache", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14725,6 +18930,18 @@ func (this *HTTPArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPCache{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`TTL:` + fmt.Sprintf("%v", this.TTL) + `,`,
+		`MaxSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPHeader) String() string {
 	if this == nil {
 		return "nil"
@@ -14840,6 +19057,49 @@ func (this *Link) String() string {
 	}, "")
 	return s
 }
+func (this *Matrix) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAxes := "[]MatrixAxis{"
+	for _, f := range this.Axes {
+		repeatedStringForAxes += strings.Replace(strings.Replace(f.String(), "MatrixAxis", "MatrixAxis", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAxes += "}"
+	repeatedStringForExclude := "[]Item{"
+	for _, f := range this.Exclude {
+		repeatedStringForExclude += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForExclude += "}"
+	repeatedStringForInclude := "[]Item{"
+	for _, f := range this.Include {
+		repeatedStringForInclude += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForInclude += "}"
+	s := strings.Join([]string{`&Matrix{`,
+		`Axes:` + repeatedStringForAxes + `,`,
+		`Exclude:` + repeatedStringForExclude + `,`,
+		`Include:` + repeatedStringForInclude + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MatrixAxis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValues := "[]Item{"
+	for _, f := range this.Values {
+		repeatedStringForValues += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForValues += "}"
+	s := strings.Join([]string{`&MatrixAxis{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Values:` + repeatedStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MemoizationStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -14860,6 +19120,7 @@ func (this *Memoize) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
 		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`Auto:` + fmt.Sprintf("%v", this.Auto) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14971,6 +19232,7 @@ func (this *NodeResult) String() string {
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`OutputsOffloaded:` + fmt.Sprintf("%v", this.OutputsOffloaded) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15014,6 +19276,9 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "ApprovalStatus", "ApprovalStatus", 1) + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`ResourceUsage:` + strings.Replace(this.ResourceUsage.String(), "ResourceUsage", "ResourceUsage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15037,6 +19302,22 @@ func (this *NoneStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Notification{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`WebhookURLSecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WebhookURLSecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Color:` + fmt.Sprintf("%v", this.Color) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OSSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -15114,6 +19395,7 @@ func (this *Outputs) String() string {
 		`Artifacts:` + repeatedStringForArtifacts + `,`,
 		`Result:` + valueToStringGenerated(this.Result) + `,`,
 		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
+		`Stderr:` + valueToStringGenerated(this.Stderr) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15145,6 +19427,21 @@ func (this *Parameter) String() string {
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
+		`Schema:` + strings.Replace(this.Schema.String(), "ParameterSchema", "ParameterSchema", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterSchema{`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
+		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`Minimum:` + strings.Replace(this.Minimum.String(), "Amount", "Amount", 1) + `,`,
+		`Maximum:` + strings.Replace(this.Maximum.String(), "Amount", "Amount", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15187,6 +19484,7 @@ func (this *Prometheus) String() string {
 		`Gauge:` + strings.Replace(this.Gauge.String(), "Gauge", "Gauge", 1) + `,`,
 		`Histogram:` + strings.Replace(this.Histogram.String(), "Histogram", "Histogram", 1) + `,`,
 		`Counter:` + strings.Replace(this.Counter.String(), "Counter", "Counter", 1) + `,`,
+		`Exemplar:` + valueToStringGenerated(this.Exemplar) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15213,6 +19511,39 @@ func (this *ResourceTemplate) String() string {
 		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
 		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
 		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
+		`ConditionLanguage:` + fmt.Sprintf("%v", this.ConditionLanguage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPeak := make([]string, 0, len(this.Peak))
+	for k := range this.Peak {
+		keysForPeak = append(keysForPeak, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPeak)
+	mapStringForPeak := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForPeak {
+		mapStringForPeak += fmt.Sprintf("%v: %v,", k, this.Peak[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForPeak += "}"
+	keysForAverage := make([]string, 0, len(this.Average))
+	for k := range this.Average {
+		keysForAverage = append(keysForAverage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAverage)
+	mapStringForAverage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForAverage {
+		mapStringForAverage += fmt.Sprintf("%v: %v,", k, this.Average[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForAverage += "}"
+	s := strings.Join([]string{`&ResourceUsage{`,
+		`Peak:` + mapStringForPeak + `,`,
+		`Average:` + mapStringForAverage + `,`,
+		`Samples:` + fmt.Sprintf("%v", this.Samples) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15277,6 +19608,11 @@ func (this *S3Bucket) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForMirrors := "[]S3Mirror{"
+	for _, f := range this.Mirrors {
+		repeatedStringForMirrors += strings.Replace(strings.Replace(f.String(), "S3Mirror", "S3Mirror", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMirrors += "}"
 	s := strings.Join([]string{`&S3Bucket{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
@@ -15288,6 +19624,7 @@ func (this *S3Bucket) String() string {
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`CreateBucketIfNotPresent:` + strings.Replace(this.CreateBucketIfNotPresent.String(), "CreateS3BucketOptions", "CreateS3BucketOptions", 1) + `,`,
 		`EncryptionOptions:` + strings.Replace(this.EncryptionOptions.String(), "S3EncryptionOptions", "S3EncryptionOptions", 1) + `,`,
+		`Mirrors:` + repeatedStringForMirrors + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15305,6 +19642,34 @@ func (this *S3EncryptionOptions) String() string {
 	}, "")
 	return s
 }
+func (this *S3Mirror) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Mirror{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Replicate:` + fmt.Sprintf("%v", this.Replicate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQL{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DSNSecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DSNSecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -15312,6 +19677,19 @@ func (this *ScriptTemplate) String() string {
 	s := strings.Join([]string{`&ScriptTemplate{`,
 		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Runtime:` + fmt.Sprintf("%v", this.Runtime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretProvider{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Vault:` + strings.Replace(this.Vault.String(), "VaultSecretProvider", "VaultSecretProvider", 1) + `,`,
+		`AWSSecretsManager:` + strings.Replace(this.AWSSecretsManager.String(), "AWSSecretsManagerSecretProvider", "AWSSecretsManagerSecretProvider", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15371,6 +19749,17 @@ func (this *Sequence) String() string {
 	}, "")
 	return s
 }
+func (this *StopStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopStrategy{`,
+		`SuspendAfterFailures:` + valueToStringGenerated(this.SuspendAfterFailures) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Submit) String() string {
 	if this == nil {
 		return "nil"
@@ -15401,6 +19790,7 @@ func (this *SubmitOpts) String() string {
 		`Annotations:` + fmt.Sprintf("%v", this.Annotations) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`Preset:` + fmt.Sprintf("%v", this.Preset) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15420,6 +19810,7 @@ func (this *SuspendTemplate) String() string {
 	}
 	s := strings.Join([]string{`&SuspendTemplate{`,
 		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "Approval", "Approval", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15468,6 +19859,16 @@ func (this *TarStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *TaskStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskStrategy{`,
+		`Matrix:` + strings.Replace(this.Matrix.String(), "Matrix", "Matrix", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Template) String() string {
 	if this == nil {
 		return "nil"
@@ -15502,6 +19903,26 @@ func (this *Template) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	repeatedStringForEnvFrom := "[]EnvFromSource{"
+	for _, f := range this.EnvFrom {
+		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnvFrom += "}"
+	repeatedStringForSysctls := "[]Sysctl{"
+	for _, f := range this.Sysctls {
+		repeatedStringForSysctls += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSysctls += "}"
+	repeatedStringForPipes := "[]ContainerPipe{"
+	for _, f := range this.Pipes {
+		repeatedStringForPipes += strings.Replace(strings.Replace(f.String(), "ContainerPipe", "ContainerPipe", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPipes += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -15552,6 +19973,42 @@ func (this *Template) String() string {
 		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
+		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPC", "GRPC", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQL", "SQL", 1) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
+		`ChildWorkflow:` + strings.Replace(this.ChildWorkflow.String(), "ChildWorkflow", "ChildWorkflow", 1) + `,`,
+		`CriticalStep:` + fmt.Sprintf("%v", this.CriticalStep) + `,`,
+		`Spot:` + fmt.Sprintf("%v", this.Spot) + `,`,
+		`RuntimeClassName:` + fmt.Sprintf("%v", this.RuntimeClassName) + `,`,
+		`HostUsers:` + valueToStringGenerated(this.HostUsers) + `,`,
+		`Checkpoint:` + strings.Replace(this.Checkpoint.String(), "Checkpoint", "Checkpoint", 1) + `,`,
+		`OnShutdown:` + fmt.Sprintf("%v", this.OnShutdown) + `,`,
+		`ShutdownGracePeriodSeconds:` + valueToStringGenerated(this.ShutdownGracePeriodSeconds) + `,`,
+		`Notification:` + strings.Replace(this.Notification.String(), "Notification", "Notification", 1) + `,`,
+		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`Sysctls:` + repeatedStringForSysctls + `,`,
+		`Pipes:` + repeatedStringForPipes + `,`,
+		`Platforms:` + fmt.Sprintf("%v", this.Platforms) + `,`,
+		`NetworkPolicy:` + strings.Replace(this.NetworkPolicy.String(), "TemplateNetworkPolicy", "TemplateNetworkPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateNetworkPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEgressAllowlist := "[]EgressDestination{"
+	for _, f := range this.EgressAllowlist {
+		repeatedStringForEgressAllowlist += strings.Replace(strings.Replace(f.String(), "EgressDestination", "EgressDestination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEgressAllowlist += "}"
+	s := strings.Join([]string{`&TemplateNetworkPolicy{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`EgressAllowlist:` + repeatedStringForEgressAllowlist + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15564,6 +20021,7 @@ func (this *TemplateRef) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
 		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15574,6 +20032,9 @@ func (this *TransformationStep) String() string {
 	}
 	s := strings.Join([]string{`&TransformationStep{`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
+		`Map:` + fmt.Sprintf("%v", this.Map) + `,`,
+		`Chunk:` + fmt.Sprintf("%v", this.Chunk) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15585,6 +20046,7 @@ func (this *UserContainer) String() string {
 	s := strings.Join([]string{`&UserContainer{`,
 		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
 		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
+		`WaitForReady:` + fmt.Sprintf("%v", this.WaitForReady) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15603,6 +20065,22 @@ func (this *ValueFrom) String() string {
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`LogsRegex:` + fmt.Sprintf("%v", this.LogsRegex) + `,`,
+		`ExternalSecret:` + strings.Replace(this.ExternalSecret.String(), "ExternalSecretSelector", "ExternalSecretSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VaultSecretProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VaultSecretProvider{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`AuthMountPath:` + fmt.Sprintf("%v", this.AuthMountPath) + `,`,
+		`TokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15734,6 +20212,11 @@ func (this *WorkflowSpec) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForSecretProviders := "[]SecretProvider{"
+	for _, f := range this.SecretProviders {
+		repeatedStringForSecretProviders += strings.Replace(strings.Replace(f.String(), "SecretProvider", "SecretProvider", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSecretProviders += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -15795,6 +20278,8 @@ func (this *WorkflowSpec) String() string {
 		`TemplateDefaults:` + strings.Replace(this.TemplateDefaults.String(), "Template", "Template", 1) + `,`,
 		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`SecretProviders:` + repeatedStringForSecretProviders + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15861,6 +20346,8 @@ func (this *WorkflowStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15897,6 +20384,7 @@ func (this *WorkflowStep) String() string {
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`WithArtifact:` + fmt.Sprintf("%v", this.WithArtifact) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15980,6 +20468,44 @@ func (this *WorkflowTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowTemplateGrant) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplateGrant{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateGrantSpec", "WorkflowTemplateGrantSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateGrantList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowTemplateGrant{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplateGrant", "WorkflowTemplateGrant", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowTemplateGrantList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateGrantSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplateGrantSpec{`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`WorkflowTemplates:` + fmt.Sprintf("%v", this.WorkflowTemplates) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowTemplateList) String() string {
 	if this == nil {
 		return "nil"
@@ -16003,6 +20529,7 @@ func (this *WorkflowTemplateRef) String() string {
 	s := strings.Join([]string{`&WorkflowTemplateRef{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16035,7 +20562,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *Amount) Unmarshal(dAtA []byte) error {
+func (m *AWSSecretsManagerSecretProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16058,15 +20585,15 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSSecretsManagerSecretProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSSecretsManagerSecretProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16094,63 +20621,13 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16160,31 +20637,27 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tar == nil {
-				m.Tar = &TarStrategy{}
-			}
-			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16211,16 +20684,16 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.None == nil {
-				m.None = &NoneStrategy{}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16247,10 +20720,10 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zip == nil {
-				m.Zip = &ZipStrategy{}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Zip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16275,7 +20748,7 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Arguments) Unmarshal(dAtA []byte) error {
+func (m *Amount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16298,51 +20771,17 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16352,25 +20791,23 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16393,7 +20830,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Artifact) Unmarshal(dAtA []byte) error {
+func (m *Approval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16416,15 +20853,15 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16452,11 +20889,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproverGroups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16484,13 +20921,13 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ApproverGroups = append(m.ApproverGroups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
 			}
-			var v int32
+			m.RequiredApprovals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16500,15 +20937,64 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.RequiredApprovals |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mode = &v
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovalDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16536,13 +21022,13 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16552,28 +21038,15 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.Approved = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16601,11 +21074,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16632,38 +21105,65 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Archive == nil {
-				m.Archive = &ArchiveStrategy{}
-			}
-			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Optional = bool(v != 0)
-		case 9:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovalStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16673,49 +21173,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecurseMode", wireType)
+			if m.Gate == nil {
+				m.Gate = &Approval{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Gate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RecurseMode = bool(v != 0)
-		case 11:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16725,23 +21209,25 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromExpression = string(dAtA[iNdEx:postIndex])
+			m.Decisions = append(m.Decisions, ApprovalDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16764,7 +21250,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16787,17 +21273,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16807,16 +21293,31 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tar == nil {
+				m.Tar = &TarStrategy{}
+			}
+			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16843,16 +21344,16 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
+			if m.None == nil {
+				m.None = &NoneStrategy{}
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zip", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16879,16 +21380,66 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
+			if m.Zip == nil {
+				m.Zip = &ZipStrategy{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Arguments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16915,16 +21466,14 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPArtifact{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16951,18 +21500,98 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifact{}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16972,31 +21601,79 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifact{}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			m.Mode = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17023,16 +21700,45 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Raw == nil {
-				m.Raw = &RawArtifact{}
-			}
-			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17059,18 +21765,38 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OSS == nil {
-				m.OSS = &OSSArtifact{}
+			if m.Archive == nil {
+				m.Archive = &ArchiveStrategy{}
 			}
-			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17080,83 +21806,113 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GCS == nil {
-				m.GCS = &GCSArtifact{}
+			m.SubPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurseMode", wireType)
 			}
-			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.RecurseMode = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.FromExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactPaths: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactPaths: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17166,24 +21922,23 @@ func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17206,7 +21961,7 @@ func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17229,10 +21984,10 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17286,7 +22041,7 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.S3 == nil {
-				m.S3 = &S3ArtifactRepository{}
+				m.S3 = &S3Artifact{}
 			}
 			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -17294,7 +22049,7 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17321,16 +22076,16 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifactRepository{}
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
 			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17357,16 +22112,16 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifactRepository{}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPArtifact{}
 			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17393,16 +22148,16 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OSS == nil {
-				m.OSS = &OSSArtifactRepository{}
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifact{}
 			}
-			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17429,24 +22184,487 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GCS == nil {
-				m.GCS = &GCSArtifactRepository{}
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifact{}
 			}
-			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Raw == nil {
+				m.Raw = &RawArtifact{}
+			}
+			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OSS == nil {
+				m.OSS = &OSSArtifact{}
+			}
+			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GCS == nil {
+				m.GCS = &GCSArtifact{}
+			}
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SplitLogs = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactPaths: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactPaths: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3ArtifactRepository{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifactRepository{}
+			}
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifactRepository{}
+			}
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OSS == nil {
+				m.OSS = &OSSArtifactRepository{}
+			}
+			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GCS == nil {
+				m.GCS = &GCSArtifactRepository{}
+			}
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -18330,7 +23548,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *Catchup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18353,17 +23571,17 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Catchup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Catchup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18373,113 +23591,29 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policy = CatchupPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18489,30 +23623,17 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.Limit = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18522,25 +23643,23 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18563,7 +23682,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18586,15 +23705,15 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18622,11 +23741,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18654,11 +23773,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18686,7 +23805,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18709,7 +23828,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18732,15 +23851,15 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18767,15 +23886,15 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18785,23 +23904,60 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propagate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Propagate == nil {
+				m.Propagate = &ChildWorkflowPropagation{}
+			}
+			if err := m.Propagate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18824,7 +23980,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflowPropagation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18847,17 +24003,17 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflowPropagation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflowPropagation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shutdown", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18867,29 +24023,18 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Shutdown = &b
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18899,28 +24044,13 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Retries == nil {
-				m.Retries = &intstr.IntOrString{}
-			}
-			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Retry = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18942,7 +24072,7 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18965,15 +24095,15 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19000,48 +24130,13 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19068,10 +24163,7 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &ContainerSetRetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19096,7 +24188,7 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19119,17 +24211,17 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19139,17 +24231,30 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19159,12 +24264,26 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19186,7 +24305,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19209,15 +24328,15 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19245,7 +24364,71 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19268,7 +24451,7 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19291,17 +24474,17 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19311,12 +24494,57 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ObjectLocking = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19338,7 +24566,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *ContainerPipe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19361,17 +24589,17 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerPipe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerPipe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19381,30 +24609,29 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19414,30 +24641,29 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19447,24 +24673,23 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19487,7 +24712,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19510,17 +24735,17 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19530,28 +24755,27 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19578,8 +24802,10 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
+			}
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19604,7 +24830,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19627,15 +24853,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19662,15 +24888,16 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19680,29 +24907,31 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19712,29 +24941,33 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &ContainerSetRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workspace", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19744,75 +24977,81 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Workspace == nil {
+				m.Workspace = &ContainerSetWorkspace{}
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			if err := m.Workspace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetWorkspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetWorkspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetWorkspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19840,11 +25079,11 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.MountPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19871,10 +25110,10 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
+			if m.SizeLimit == nil {
+				m.SizeLimit = &resource.Quantity{}
 			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SizeLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19899,7 +25138,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19922,17 +25161,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19942,31 +25181,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Error = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19976,33 +25201,67 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20012,25 +25271,23 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20053,7 +25310,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20076,17 +25333,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20096,29 +25353,67 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ObjectLocking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20128,27 +25423,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20175,13 +25471,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20208,48 +25504,63 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20276,46 +25587,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20342,18 +25620,66 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
-			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20363,29 +25689,30 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20395,31 +25722,27 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
-			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20447,11 +25770,91 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20479,11 +25882,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
+			m.Timezone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20510,109 +25913,48 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hooks == nil {
-				m.Hooks = make(LifecycleHooks)
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
 			}
-			var mapkey LifecycleEvent
-			mapvalue := &LifecycleHook{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LifecycleHook{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Catchup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20639,66 +25981,48 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inline == nil {
-				m.Inline = &Template{}
+			if m.Catchup == nil {
+				m.Catchup = &Catchup{}
 			}
-			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Catchup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaylightSavingPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DaylightSavingPolicy = DaylightSavingPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20726,11 +26050,11 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Splay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20757,16 +26081,18 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StopStrategy == nil {
+				m.StopStrategy = &StopStrategy{}
+			}
+			if err := m.StopStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRunPolicy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20776,68 +26102,29 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Data) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PreviousRunPolicy = PreviousRunPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20847,30 +26134,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhenConfigMaps", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20880,25 +26166,23 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transformation = append(m.Transformation, TransformationStep{})
-			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WhenConfigMaps = append(m.WhenConfigMaps, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20921,7 +26205,7 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataSource) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20944,15 +26228,15 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20979,68 +26263,16 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactPaths == nil {
-				m.ArtifactPaths = &ArtifactPaths{}
-			}
-			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
-			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21050,79 +26282,33 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21132,77 +26318,29 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTimes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21229,15 +26367,73 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NextScheduledTimes = append(m.NextScheduledTimes, v11.Time{})
+			if err := m.NextScheduledTimes[len(m.NextScheduledTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21247,23 +26443,27 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.DelayedScheduledTime == nil {
+				m.DelayedScheduledTime = &v11.Time{}
+			}
+			if err := m.DelayedScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21286,7 +26486,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21309,17 +26509,17 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21329,28 +26529,27 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21378,63 +26577,13 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21444,27 +26593,28 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21491,66 +26641,16 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21578,13 +26678,13 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21594,66 +26694,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Realtime = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21681,13 +26744,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21697,29 +26760,33 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21729,15 +26796,63 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Depth = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21765,13 +26880,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21781,31 +26896,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depends = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21832,16 +26943,109 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey LifecycleEvent
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21868,18 +27072,18 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			if m.Inline == nil {
+				m.Inline = &Template{}
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifact", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21889,17 +27093,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithArtifact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21909,12 +27125,28 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableSubmodules = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &TaskStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21936,7 +27168,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21959,17 +27191,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21979,30 +27211,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22012,27 +27243,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22049,7 +27282,8 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			b := bool(v != 0)
+			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22071,7 +27305,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22094,15 +27328,15 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22129,15 +27363,15 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22147,44 +27381,26 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Force = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22206,7 +27422,7 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *DataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22229,15 +27445,15 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22264,13 +27480,66 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactPaths{}
+			}
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22298,11 +27567,137 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.CIDR = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22330,7 +27725,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22353,7 +27748,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22376,17 +27771,17 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22396,67 +27791,77 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalSecretSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalSecretSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalSecretSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22484,11 +27889,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22516,47 +27921,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22584,7 +27953,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22607,7 +27976,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTP) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22630,17 +27999,17 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22650,27 +28019,28 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22698,67 +28068,63 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, HTTPHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.TimeoutSeconds = &v
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22768,27 +28134,28 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22816,7 +28183,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22839,7 +28206,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22862,15 +28229,15 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22898,11 +28265,11 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22929,8 +28296,10 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22955,7 +28324,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+func (m *GRPC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22978,15 +28347,15 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: GRPC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GRPC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23014,11 +28383,11 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23046,11 +28415,11 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptorSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23077,66 +28446,48 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
+			if m.DescriptorSet == nil {
+				m.DescriptorSet = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DescriptorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23163,68 +28514,16 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metadata = append(m.Metadata, HTTPHeader{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Header) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23234,27 +28533,51 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &GRPCTLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23282,7 +28605,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23305,7 +28628,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *GRPCTLS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23328,15 +28651,15 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: GRPCTLS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GRPCTLS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23364,11 +28687,31 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23395,64 +28738,16 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.CACertSecret == nil {
+				m.CACertSecret = &v1.SecretKeySelector{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CACertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Inputs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23479,14 +28774,16 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientCertSecret == nil {
+				m.ClientCertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23513,8 +28810,10 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientKeySecret == nil {
+				m.ClientKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23539,7 +28838,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23562,17 +28861,17 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23582,26 +28881,45 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23623,7 +28941,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelKeys) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23646,15 +28964,15 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23682,61 +29000,11 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelValues) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23764,61 +29032,31 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23846,11 +29084,11 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23877,13 +29115,16 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23910,18 +29151,18 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23931,47 +29172,91 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Link) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSubmodules = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -23993,17 +29278,17 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24013,27 +29298,28 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24061,13 +29347,13 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24077,24 +29363,12 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24116,7 +29390,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24139,17 +29413,17 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24159,15 +29433,28 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hit = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24195,13 +29482,13 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.PathFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24211,24 +29498,12 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24250,7 +29525,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Memoize) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24273,17 +29548,17 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24293,29 +29568,30 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24325,31 +29601,27 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
-			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24377,7 +29649,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24400,7 +29672,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24423,15 +29695,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24458,107 +29730,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24585,157 +29766,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetricLabel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24763,11 +29803,11 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24795,63 +29835,49 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metrics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24861,25 +29887,23 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24902,7 +29926,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutex) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24925,15 +29949,15 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24961,61 +29985,97 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MutexHolding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25043,11 +30103,11 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25075,7 +30135,43 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &HTTPCache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25098,7 +30194,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25121,17 +30217,17 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25141,29 +30237,27 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25190,8 +30284,8 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25216,7 +30310,7 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeResult) Unmarshal(dAtA []byte) error {
+func (m *HTTPCache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25239,15 +30333,15 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPCache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPCache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25275,11 +30369,11 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25307,11 +30401,11 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.TTL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25338,10 +30432,10 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
+			if m.MaxSize == nil {
+				m.MaxSize = &resource.Quantity{}
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25366,7 +30460,7 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25389,15 +30483,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25425,11 +30519,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25457,13 +30551,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25473,91 +30567,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25584,16 +30668,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25621,11 +30755,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25653,11 +30787,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25685,11 +30869,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25716,13 +30900,64 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Inputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25749,15 +30984,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25767,50 +31003,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.Daemoned = &b
-		case 14:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25820,67 +31087,79 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
-			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 16:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25908,43 +31187,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 20:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25972,124 +31269,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourcesDuration == nil {
-				m.ResourcesDuration = make(ResourcesDuration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			var mapvalue int64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
-			iNdEx = postIndex
-		case 22:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26117,11 +31351,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26148,35 +31382,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
-			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
-			}
-			m.EstimatedDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26203,16 +31415,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26240,7 +31452,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26263,7 +31475,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Link) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26286,15 +31498,15 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26322,58 +31534,72 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26395,7 +31621,7 @@ func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+func (m *Matrix) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26418,15 +31644,15 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Matrix: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Matrix: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Axes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26453,15 +31679,16 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Axes = append(m.Axes, MatrixAxis{})
+			if err := m.Axes[len(m.Axes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26471,23 +31698,59 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Exclude = append(m.Exclude, Item{})
+			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = append(m.Include, Item{})
+			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26510,7 +31773,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *MatrixAxis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26533,17 +31796,17 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatrixAxis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatrixAxis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26553,30 +31816,29 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26586,23 +31848,25 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Values = append(m.Values, Item{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26625,7 +31889,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26648,17 +31912,17 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26668,27 +31932,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Hit = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26716,13 +31968,13 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26732,33 +31984,79 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memoize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26768,33 +32066,29 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26804,15 +32098,31 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26840,13 +32150,13 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auto", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26856,28 +32166,12 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LifecycleRule == nil {
-				m.LifecycleRule = &OSSLifecycleRule{}
-			}
-			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Auto = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26899,7 +32193,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26922,17 +32216,17 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			m.MarkInfrequentAccessAfterDays = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26942,16 +32236,124 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			m.MarkDeletionAfterDays = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26961,61 +32363,119 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Object) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27037,7 +32497,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27060,83 +32520,15 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27164,12 +32556,11 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27197,8 +32588,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27221,7 +32611,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *Metrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27244,15 +32634,15 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27279,8 +32669,8 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27305,7 +32695,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *Mutex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27328,10 +32718,10 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -27366,143 +32756,59 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27530,11 +32836,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			m.Mutex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27562,8 +32868,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27586,7 +32891,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27609,15 +32914,15 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27644,7 +32949,42 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27669,7 +33009,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27692,15 +33032,15 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27728,11 +33068,43 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27759,13 +33131,33 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputsOffloaded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutputsOffloaded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27787,7 +33179,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27810,15 +33202,15 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27846,13 +33238,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27862,29 +33254,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27912,11 +33302,11 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Help = string(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27944,13 +33334,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27960,31 +33350,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
-			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28011,18 +33397,18 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Histogram == nil {
-				m.Histogram = &Histogram{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28032,81 +33418,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Counter == nil {
-				m.Counter = &Counter{}
-			}
-			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28134,61 +33466,11 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28216,13 +33498,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28232,29 +33514,30 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28264,27 +33547,60 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -28301,12 +33617,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SetOwnerReference = bool(v != 0)
-		case 5:
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28316,27 +33633,67 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28364,11 +33721,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28396,61 +33753,43 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28477,116 +33816,180 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
 			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28613,16 +34016,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &intstr.IntOrString{}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28650,11 +34053,11 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28681,16 +34084,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.Approval == nil {
+				m.Approval = &ApprovalStatus{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28717,18 +34120,18 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28738,23 +34141,27 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			if m.ResourceUsage == nil {
+				m.ResourceUsage = &ResourceUsage{}
+			}
+			if err := m.ResourceUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28777,7 +34184,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28800,48 +34207,15 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28869,7 +34243,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Waiting = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28892,7 +34266,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28915,109 +34289,12 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3ArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29039,7 +34316,7 @@ func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+func (m *Notification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29062,15 +34339,15 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29098,13 +34375,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Provider = NotificationProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURLSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29114,27 +34391,28 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.WebhookURLSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29162,34 +34440,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Insecure = &b
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29199,33 +34456,29 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29235,31 +34488,27 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29287,69 +34536,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Color = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseSDKCreds = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreateBucketIfNotPresent == nil {
-				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
-			}
-			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29359,28 +34552,12 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EncryptionOptions == nil {
-				m.EncryptionOptions = &S3EncryptionOptions{}
-			}
-			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.TimeoutSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29402,7 +34579,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29425,17 +34602,17 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29445,27 +34622,28 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29493,63 +34671,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableEncryption = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29572,7 +34694,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29595,15 +34717,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29630,13 +34752,13 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29664,7 +34786,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29687,7 +34809,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29710,15 +34832,15 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29746,11 +34868,11 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29778,61 +34900,11 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29859,66 +34931,16 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29945,16 +34967,18 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29964,23 +34988,77 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LifecycleRule == nil {
+				m.LifecycleRule = &OSSLifecycleRule{}
+			}
+			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30005,7 +35083,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30028,15 +35106,153 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			}
+			m.MarkInfrequentAccessAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			}
+			m.MarkDeletionAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30063,16 +35279,14 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30099,18 +35313,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30120,31 +35332,61 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30172,7 +35414,8 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Stderr = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30195,7 +35438,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Submit) Unmarshal(dAtA []byte) error {
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30218,84 +35461,15 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Arguments == nil {
-				m.Arguments = &Arguments{}
-			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30322,7 +35496,8 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30347,7 +35522,7 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30370,10 +35545,10 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30410,7 +35585,7 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30438,11 +35613,12 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenerateName = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30470,11 +35646,48 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entrypoint = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30502,11 +35715,11 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParameterFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30534,11 +35747,11 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParameterFile = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30566,13 +35779,14 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30582,15 +35796,81 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ParameterSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParameterSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -30607,10 +35887,10 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ServerDryRun = bool(v != 0)
-		case 10:
+			m.Required = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30638,13 +35918,13 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30654,33 +35934,29 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OwnerReference == nil {
-				m.OwnerReference = &v11.OwnerReference{}
-			}
-			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30690,29 +35966,33 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = string(dAtA[iNdEx:postIndex])
+			if m.Minimum == nil {
+				m.Minimum = &Amount{}
+			}
+			if err := m.Minimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30722,94 +36002,28 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Maximum == nil {
+				m.Maximum = &Amount{}
 			}
-			m.Priority = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Maximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30831,7 +36045,7 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+func (m *Plugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30854,17 +36068,17 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30874,23 +36088,24 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30913,7 +36128,7 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Synchronization) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30936,17 +36151,17 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Synchronization: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Synchronization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30956,31 +36171,27 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Semaphore == nil {
-				m.Semaphore = &SemaphoreRef{}
-			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31007,10 +36218,10 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &Mutex{}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
 			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31035,7 +36246,7 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31058,17 +36269,17 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31078,31 +36289,27 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Semaphore == nil {
-				m.Semaphore = &SemaphoreStatus{}
-			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31129,68 +36336,116 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &MutexStatus{}
-			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Help = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v int32
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31200,17 +36455,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterCompletion = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31220,17 +36491,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterSuccess = &v
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemplar", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31240,12 +36527,13 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterFailure = &v
+			b := bool(v != 0)
+			m.Exemplar = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31267,7 +36555,7 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TarStrategy) Unmarshal(dAtA []byte) error {
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31290,17 +36578,17 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31310,12 +36598,24 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CompressionLevel = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31337,7 +36637,7 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31360,15 +36660,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31396,13 +36696,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31412,30 +36712,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31445,30 +36744,49 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Manifest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31478,36 +36796,214 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionLanguage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionLanguage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peak", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peak == nil {
+				m.Peak = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
 					b := dAtA[iNdEx]
 					iNdEx++
 					wire |= uint64(b&0x7F) << shift
@@ -31543,10 +37039,10 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -31556,24 +37052,26 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31589,11 +37087,4943 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.Peak[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Average == nil {
+				m.Average = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Average[k8s_io_api_core_v1.ResourceName(mapkey)] = ((k8s_io_apimachinery_pkg_api_resource.Quantity)(*mapvalue))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3ArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3ArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Insecure = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateBucketIfNotPresent == nil {
+				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
+			}
+			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptionOptions == nil {
+				m.EncryptionOptions = &S3EncryptionOptions{}
+			}
+			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mirrors = append(m.Mirrors, S3Mirror{})
+			if err := m.Mirrors[len(m.Mirrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEncryption = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServerSideCustomerKeySecret == nil {
+				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3Mirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Mirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Mirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SQL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Driver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DSNSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DSNSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = SQLFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vault == nil {
+				m.Vault = &VaultSecretProvider{}
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSSecretsManager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSSecretsManager == nil {
+				m.AWSSecretsManager = &AWSSecretsManagerSecretProvider{}
+			}
+			if err := m.AWSSecretsManager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendAfterFailures", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuspendAfterFailures = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Submit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Arguments == nil {
+				m.Arguments = &Arguments{}
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenerateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entrypoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServerDryRun = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OwnerReference == nil {
+				m.OwnerReference = &v11.OwnerReference{}
+			}
+			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &Approval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Synchronization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Synchronization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Synchronization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreRef{}
+			}
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mutex == nil {
+				m.Mutex = &Mutex{}
+			}
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreStatus{}
+			}
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mutex == nil {
+				m.Mutex = &MutexStatus{}
+			}
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterCompletion = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterSuccess = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterFailure = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TarStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompressionLevel = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Matrix == nil {
+				m.Matrix = &Matrix{}
+			}
+			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Template) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Daemon = &b
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, ParallelSteps{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &v1.Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &ScriptTemplate{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceTemplate{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DAG == nil {
+				m.DAG = &DAGTemplate{}
+			}
+			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspend == nil {
+				m.Suspend = &SuspendTemplate{}
+			}
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainers = append(m.InitContainers, UserContainer{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sidecars = append(m.Sidecars, UserContainer{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArchiveLocation == nil {
+				m.ArchiveLocation = &ArtifactLocation{}
+			}
+			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveDeadlineSeconds == nil {
+				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
+			}
+			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31620,18 +42050,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31641,28 +42071,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -31680,10 +42109,10 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.Daemon = &b
-		case 11:
+			m.AutomountServiceAccountToken = &b
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31710,14 +42139,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ParallelSteps{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Executor == nil {
+				m.Executor = &ExecutorConfig{}
+			}
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31744,16 +42175,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31780,16 +42211,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Script == nil {
-				m.Script = &ScriptTemplate{}
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
 			}
-			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31816,18 +42247,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceTemplate{}
+			if m.Memoize == nil {
+				m.Memoize = &Memoize{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31837,31 +42268,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DAG == nil {
-				m.DAG = &DAGTemplate{}
-			}
-			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31888,16 +42315,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Suspend == nil {
-				m.Suspend = &SuspendTemplate{}
+			if m.Data == nil {
+				m.Data = &Data{}
 			}
-			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31924,14 +42351,37 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContainerSet == nil {
+				m.ContainerSet = &ContainerSetTemplate{}
+			}
+			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailFast = &b
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31958,14 +42408,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainers = append(m.InitContainers, UserContainer{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31992,14 +42444,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sidecars = append(m.Sidecars, UserContainer{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Plugin == nil {
+				m.Plugin = &Plugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32026,16 +42480,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArchiveLocation == nil {
-				m.ArchiveLocation = &ArtifactLocation{}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRef{}
 			}
-			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32062,16 +42516,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveDeadlineSeconds == nil {
-				m.ActiveDeadlineSeconds = &intstr.IntOrString{}
+			if m.GRPC == nil {
+				m.GRPC = &GRPC{}
 			}
-			if err := m.ActiveDeadlineSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32098,36 +42552,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
+			if m.SQL == nil {
+				m.SQL = &SQL{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Parallelism = &v
-		case 24:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32154,16 +42588,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32173,29 +42607,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 26:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32205,29 +42641,33 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.ChildWorkflow == nil {
+				m.ChildWorkflow = &ChildWorkflow{}
+			}
+			if err := m.ChildWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 27:
+		case 50:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalStep", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32237,15 +42677,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Priority = &v
-		case 28:
+			m.CriticalStep = bool(v != 0)
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32273,13 +42713,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Spot = SpotPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32289,29 +42729,48 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
-			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RuntimeClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostUsers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 30:
+			b := bool(v != 0)
+			m.HostUsers = &b
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32338,16 +42797,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &Checkpoint{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnShutdown", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32375,13 +42834,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			m.OnShutdown = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
+		case 56:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShutdownGracePeriodSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32391,16 +42850,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 33:
+			m.ShutdownGracePeriodSeconds = &v
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32427,18 +42885,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Executor == nil {
-				m.Executor = &ExecutorConfig{}
+			if m.Notification == nil {
+				m.Notification = &Notification{}
 			}
-			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32448,31 +42906,28 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = &Metrics{}
-			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := k8s_io_api_core_v1.DNSPolicy(dAtA[iNdEx:postIndex])
+			m.DNSPolicy = &s
 			iNdEx = postIndex
-		case 36:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32499,16 +42954,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.DNSConfig == nil {
+				m.DNSConfig = &v1.PodDNSConfig{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sysctls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32535,18 +42990,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memoize == nil {
-				m.Memoize = &Memoize{}
-			}
-			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sysctls = append(m.Sysctls, v1.Sysctl{})
+			if err := m.Sysctls[len(m.Sysctls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32556,29 +43009,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			m.Pipes = append(m.Pipes, ContainerPipe{})
+			if err := m.Pipes[len(m.Pipes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 39:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platforms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32588,31 +43043,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &Data{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Platforms = append(m.Platforms, Platform(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 40:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32639,39 +43090,68 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerSet == nil {
-				m.ContainerSet = &ContainerSetTemplate{}
+			if m.NetworkPolicy == nil {
+				m.NetworkPolicy = &TemplateNetworkPolicy{}
 			}
-			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
-		case 42:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateNetworkPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateNetworkPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateNetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32681,31 +43161,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTP{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = NetworkPolicyType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 43:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32732,10 +43208,8 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &Plugin{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EgressAllowlist = append(m.EgressAllowlist, EgressDestination{})
+			if err := m.EgressAllowlist[len(m.EgressAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32873,6 +43347,38 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClusterScope = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32955,6 +43461,89 @@ func (m *TransformationStep) Unmarshal(dAtA []byte) error {
 			}
 			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			m.Chunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunk |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33059,6 +43648,26 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.MirrorVolumeMounts = &b
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForReady", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitForReady = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33235,13 +43844,214 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameter = string(dAtA[iNdEx:postIndex])
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplied == nil {
+				m.Supplied = &SuppliedValueFrom{}
+			}
+			if err := m.Supplied.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogsRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33251,30 +44061,83 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			if m.ExternalSecret == nil {
+				m.ExternalSecret = &ExternalSecretSelector{}
+			}
+			if err := m.ExternalSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultSecretProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultSecretProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultSecretProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplied", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33284,31 +44147,27 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplied == nil {
-				m.Supplied = &SuppliedValueFrom{}
-			}
-			if err := m.Supplied.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33336,11 +44195,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthMountPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33368,11 +44227,11 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.AuthMountPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33399,13 +44258,45 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.TokenSecret == nil {
+				m.TokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35916,6 +46807,72 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretProviders = append(m.SecretProviders, SecretProvider{})
+			if err := m.SecretProviders[len(m.SecretProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36787,12 +47744,80 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactRepositoryRef == nil {
-				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
-			}
-			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
+			}
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37344,6 +48369,38 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithArtifact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37537,15 +48594,490 @@ func (m *WorkflowTaskSetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTaskSetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTaskSetList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTaskSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, WorkflowTaskSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTaskSetSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTaskSetSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTaskSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = make(map[string]Template)
+			}
+			var mapkey string
+			mapvalue := &Template{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Template{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tasks[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTaskSetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTaskSetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTaskSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nodes == nil {
+				m.Nodes = make(map[string]NodeResult)
+			}
+			var mapkey string
+			mapvalue := &NodeResult{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeResult{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Nodes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTaskSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37572,13 +49104,13 @@ func (m *WorkflowTaskSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37605,8 +49137,7 @@ func (m *WorkflowTaskSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, WorkflowTaskSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37631,7 +49162,7 @@ func (m *WorkflowTaskSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowTaskSetSpec) Unmarshal(dAtA []byte) error {
+func (m *WorkflowTemplateGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37654,15 +49185,15 @@ func (m *WorkflowTaskSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTaskSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTemplateGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTaskSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTemplateGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37689,105 +49220,42 @@ func (m *WorkflowTaskSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tasks == nil {
-				m.Tasks = make(map[string]Template)
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &Template{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Template{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Tasks[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37810,7 +49278,7 @@ func (m *WorkflowTaskSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowTaskSetStatus) Unmarshal(dAtA []byte) error {
+func (m *WorkflowTemplateGrantList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37833,15 +49301,15 @@ func (m *WorkflowTaskSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTaskSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTemplateGrantList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTaskSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTemplateGrantList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37868,105 +49336,43 @@ func (m *WorkflowTaskSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nodes == nil {
-				m.Nodes = make(map[string]NodeResult)
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &NodeResult{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NodeResult{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Nodes[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, WorkflowTemplateGrant{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37989,7 +49395,7 @@ func (m *WorkflowTaskSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *WorkflowTemplateGrantSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38012,17 +49418,17 @@ func (m *WorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTemplateGrantSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTemplateGrantSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38032,30 +49438,29 @@ func (m *WorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplates", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38065,24 +49470,23 @@ func (m *WorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowTemplates = append(m.WorkflowTemplates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38303,6 +49707,25 @@ func (m *WorkflowTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClusterScope = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+type GRPC struct {
+	// Target is the host and port of the gRPC server, e.g. "my-service:50051"
+	Target string `json:"target" protobuf:"bytes,1,opt,name=target"`
+	// Method is the full name of the method, e.g. "my.package.MyService/MyMethod"
+	Method string `json:"method" protobuf:"bytes,2,opt,name=method"`
+	// DescriptorSet is a key of a config map that contains a serialized FileDescriptorSet, that describes the method,
+	// e.g. made with `protoc --include_imports --descriptor_set_out`. If it is not set, the method is described by the
+	// server's reflection service
+	DescriptorSet *v1.ConfigMapKeySelector `json:"descriptorSet,omitempty" protobuf:"bytes,3,opt,name=descriptorSet"`
+	// Request is the request message, as JSON. Default is an empty message
+	Request string `json:"request,omitempty" protobuf:"bytes,4,opt,name=request"`
+	// Metadata is an optional list of metadata to send with the request
+	Metadata HTTPHeaders `json:"metadata,omitempty" protobuf:"bytes,5,rep,name=metadata"`
+	// TLS connects to the server with TLS, rather than in plain text
+	TLS *GRPCTLS `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// TimeoutSeconds is the timeout of the call. Default is 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"bytes,7,opt,name=timeoutSeconds"`
+	// SuccessCondition is an expression if evaluated to true is considered successful
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,8,opt,name=successCondition"`
+}
+
+// GetTimeoutSeconds returns the timeout of the call, in seconds
+func (g *GRPC) GetTimeoutSeconds() int64 {
+	if g.TimeoutSeconds == nil {
+		return 30
+	}
+	return *g.TimeoutSeconds
+}
+
+type GRPCTLS struct {
+	// ServerName is the name the server's certificate is verified against. Default is the host of the target
+	ServerName string `json:"serverName,omitempty" protobuf:"bytes,1,opt,name=serverName"`
+	// InsecureSkipVerify skips verifying the server's certificate
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,2,opt,name=insecureSkipVerify"`
+	// CACertSecret is a secret key of the PEM encoded certificate of the CA that signed the server's certificate.
+	// Default is the system's CAs
+	CACertSecret *v1.SecretKeySelector `json:"caCertSecret,omitempty" protobuf:"bytes,3,opt,name=caCertSecret"`
+	// ClientCertSecret is a secret key of the PEM encoded client certificate, for mutual TLS
+	ClientCertSecret *v1.SecretKeySelector `json:"clientCertSecret,omitempty" protobuf:"bytes,4,opt,name=clientCertSecret"`
+	// ClientKeySecret is a secret key of the PEM encoded private key of the client certificate
+	ClientKeySecret *v1.SecretKeySelector `json:"clientKeySecret,omitempty" protobuf:"bytes,5,opt,name=clientKeySecret"`
+}
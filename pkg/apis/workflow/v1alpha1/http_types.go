@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"net/http"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 type HTTPHeaderSource struct {
@@ -43,4 +45,31 @@ type HTTP struct {
 	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,6,opt,name=successCondition"`
 	// Body is content of the HTTP Request
 	Body string `json:"body,omitempty" protobuf:"bytes,5,opt,name=body"`
+	// Cache caches the response in the agent, so that the same GET request, e.g. polled in a loop, or made by many
+	// nodes of the workflow, is sent to the server at most once per TTL
+	Cache *HTTPCache `json:"cache,omitempty" protobuf:"bytes,7,opt,name=cache"`
+}
+
+// HTTPCache is how the agent caches the response of an idempotent GET request. Only 2xx responses are cached
+type HTTPCache struct {
+	// Key of the cached response, e.g. "{{inputs.parameters.id}}". Requests to the same URL with the same key share
+	// the response. Default is the headers, and body, of the request
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+	// TTL is how long (e.g. "30s", "5m") the response is cached for
+	TTL string `json:"ttl" protobuf:"bytes,2,opt,name=ttl"`
+	// MaxSize is the size of the largest response body that is cached, larger responses are not. Default is 1Mi
+	MaxSize *resource.Quantity `json:"maxSize,omitempty" protobuf:"bytes,3,opt,name=maxSize"`
+}
+
+// GetTTL returns how long the response is cached for
+func (c *HTTPCache) GetTTL() (time.Duration, error) {
+	return time.ParseDuration(c.TTL)
+}
+
+// GetMaxSize returns the size, in bytes, of the largest response body that is cached
+func (c *HTTPCache) GetMaxSize() int64 {
+	if c.MaxSize == nil {
+		return 1024 * 1024
+	}
+	return c.MaxSize.Value()
 }
@@ -0,0 +1,35 @@
+package v1alpha1
+
+// TaskStrategy is how a task is fanned out
+type TaskStrategy struct {
+	// Matrix expands the task over the cross product of the values of its axes
+	Matrix *Matrix `json:"matrix,omitempty" protobuf:"bytes,1,opt,name=matrix"`
+}
+
+// Matrix expands a task into a task per combination of the values of its axes. Each combination is an item, a map
+// keyed by the name of the axis, e.g. `{{item.os}}`
+type Matrix struct {
+	// Axes are the named parameters of the matrix, in the order they are expanded
+	Axes []MatrixAxis `json:"axes" protobuf:"bytes,1,rep,name=axes"`
+	// Exclude removes the combinations that match all of the values of an entry
+	Exclude []Item `json:"exclude,omitempty" protobuf:"bytes,2,rep,name=exclude"`
+	// Include adds the values of an entry to each combination that it matches the axes of, without overwriting any
+	// of its values, or, if it matches none, adds it as a combination
+	Include []Item `json:"include,omitempty" protobuf:"bytes,3,rep,name=include"`
+}
+
+// MatrixAxis is a named parameter of a matrix
+type MatrixAxis struct {
+	// Name is the name of the axis, which is the key of its value in each item
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Values are the values of the axis
+	Values []Item `json:"values" protobuf:"bytes,2,rep,name=values"`
+}
+
+// GetMatrix returns the matrix of the strategy, if any
+func (s *TaskStrategy) GetMatrix() *Matrix {
+	if s == nil {
+		return nil
+	}
+	return s.Matrix
+}
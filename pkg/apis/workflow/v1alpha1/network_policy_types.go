@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+)
+
+// NetworkPolicyType is how the network traffic of the pods of a template is restricted
+type NetworkPolicyType string
+
+const (
+	// NetworkPolicyIsolated denies all ingress to, and egress from, the pods
+	NetworkPolicyIsolated NetworkPolicyType = "Isolated"
+	// NetworkPolicyEgressAllowlist denies all ingress to the pods, and all egress from them but to the allowlist
+	NetworkPolicyEgressAllowlist NetworkPolicyType = "EgressAllowlist"
+)
+
+// TemplateNetworkPolicy restricts the network traffic of the pods of a template, with a network policy the controller
+// creates for them, which is deleted with the workflow. Egress to DNS, and to the destinations in the controller's
+// networkPolicyEgress, e.g. the Kubernetes API, and the artifact repository, is always allowed
+type TemplateNetworkPolicy struct {
+	// Type is "Isolated", or "EgressAllowlist"
+	Type NetworkPolicyType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=NetworkPolicyType"`
+	// EgressAllowlist are the destinations the pods may connect to, if the type is "EgressAllowlist"
+	EgressAllowlist []EgressDestination `json:"egressAllowlist,omitempty" protobuf:"bytes,2,rep,name=egressAllowlist"`
+}
+
+// EgressDestination is a destination the pods of a template may connect to
+type EgressDestination struct {
+	// CIDR of the IP addresses of the destination, e.g. "10.0.0.0/8", or "203.0.113.7/32"
+	CIDR string `json:"cidr" protobuf:"bytes,1,opt,name=cidr"`
+	// Ports are the TCP ports of the destination. Default is every port
+	Ports []int32 `json:"ports,omitempty" protobuf:"varint,2,rep,name=ports"`
+}
+
+// Validate checks the type, and that the allowlist, which only the "EgressAllowlist" type may have, has valid CIDRs
+// and ports
+func (p *TemplateNetworkPolicy) Validate() error {
+	switch p.Type {
+	case NetworkPolicyIsolated:
+		if len(p.EgressAllowlist) > 0 {
+			return fmt.Errorf(".egressAllowlist is only valid for the %s type", NetworkPolicyEgressAllowlist)
+		}
+	case NetworkPolicyEgressAllowlist:
+		if len(p.EgressAllowlist) == 0 {
+			return fmt.Errorf(".egressAllowlist is required for the %s type", NetworkPolicyEgressAllowlist)
+		}
+	default:
+		return fmt.Errorf(".type must be one of %s or %s", NetworkPolicyIsolated, NetworkPolicyEgressAllowlist)
+	}
+	for i, d := range p.EgressAllowlist {
+		if _, _, err := net.ParseCIDR(d.CIDR); err != nil {
+			return fmt.Errorf(".egressAllowlist[%d].cidr %q is not a valid CIDR", i, d.CIDR)
+		}
+		for _, port := range d.Ports {
+			if port < 1 || port > 65535 {
+				return fmt.Errorf(".egressAllowlist[%d].ports %d must be from 1 to 65535", i, port)
+			}
+		}
+	}
+	return nil
+}
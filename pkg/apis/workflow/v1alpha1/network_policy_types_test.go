@@ -0,0 +1,17 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateNetworkPolicy_Validate(t *testing.T) {
+	assert.NoError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyIsolated}).Validate())
+	assert.NoError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyEgressAllowlist, EgressAllowlist: []EgressDestination{{CIDR: "10.0.0.0/8", Ports: []int32{443}}}}).Validate())
+	assert.EqualError(t, (&TemplateNetworkPolicy{Type: "Open"}).Validate(), ".type must be one of Isolated or EgressAllowlist")
+	assert.EqualError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyEgressAllowlist}).Validate(), ".egressAllowlist is required for the EgressAllowlist type")
+	assert.EqualError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyIsolated, EgressAllowlist: []EgressDestination{{CIDR: "10.0.0.0/8"}}}).Validate(), ".egressAllowlist is only valid for the EgressAllowlist type")
+	assert.EqualError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyEgressAllowlist, EgressAllowlist: []EgressDestination{{CIDR: "10.0.0.0"}}}).Validate(), `.egressAllowlist[0].cidr "10.0.0.0" is not a valid CIDR`)
+	assert.EqualError(t, (&TemplateNetworkPolicy{Type: NetworkPolicyEgressAllowlist, EgressAllowlist: []EgressDestination{{CIDR: "10.0.0.0/8", Ports: []int32{0}}}}).Validate(), ".egressAllowlist[0].ports 0 must be from 1 to 65535")
+}
@@ -0,0 +1,44 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NotificationProvider is the chat service a notification is sent to
+type NotificationProvider string
+
+const (
+	// NotificationProviderSlack sends the notification to a Slack incoming webhook
+	NotificationProviderSlack NotificationProvider = "slack"
+	// NotificationProviderMSTeams sends the notification to a Microsoft Teams incoming webhook
+	NotificationProviderMSTeams NotificationProvider = "msteams"
+)
+
+// Notification sends a message to a chat service, e.g. from an exit handler, to tell a team that a workflow completed.
+// Workflow variables, e.g. "{{workflow.name}}", "{{workflow.status}}", or "{{workflow.failures}}", are substituted into
+// the title and message like those of any template.
+type Notification struct {
+	// Provider is the chat service, either "slack" or "msteams"
+	Provider NotificationProvider `json:"provider" protobuf:"bytes,1,opt,name=provider,casttype=NotificationProvider"`
+	// WebhookURLSecret is a secret key of the URL of the incoming webhook the message is posted to
+	WebhookURLSecret v1.SecretKeySelector `json:"webhookURLSecret" protobuf:"bytes,2,opt,name=webhookURLSecret"`
+	// Channel is the channel the message is posted to, rather than the webhook's own, e.g. "#my-team". Slack only
+	Channel string `json:"channel,omitempty" protobuf:"bytes,3,opt,name=channel"`
+	// Title is the title of the message
+	Title string `json:"title,omitempty" protobuf:"bytes,4,opt,name=title"`
+	// Message is the text of the message, in the provider's markdown
+	Message string `json:"message" protobuf:"bytes,5,opt,name=message"`
+	// Color is the hex color of the bar beside the message, e.g. "#ff0000". Default is green if the workflow
+	// succeeded, or red if it did not
+	Color string `json:"color,omitempty" protobuf:"bytes,6,opt,name=color"`
+	// TimeoutSeconds is the timeout of the request. Default is 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"bytes,7,opt,name=timeoutSeconds"`
+}
+
+// GetTimeoutSeconds returns the timeout of the request, in seconds
+func (n *Notification) GetTimeoutSeconds() int64 {
+	if n.TimeoutSeconds == nil {
+		return 30
+	}
+	return *n.TimeoutSeconds
+}
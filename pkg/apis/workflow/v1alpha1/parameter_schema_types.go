@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParameterSchema is the schema of the value of a parameter
+type ParameterSchema struct {
+	// Required values must not be empty
+	Required bool `json:"required,omitempty" protobuf:"varint,1,opt,name=required"`
+
+	// Enum is the values the value must be one of
+	Enum []AnyString `json:"enum,omitempty" protobuf:"bytes,2,rep,name=enum"`
+
+	// Pattern is a regular expression the value must match. Anchor it with ^ and $ to match the whole value
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,3,opt,name=pattern"`
+
+	// Minimum is the least number the value may be
+	Minimum *Amount `json:"minimum,omitempty" protobuf:"bytes,4,opt,name=minimum"`
+
+	// Maximum is the greatest number the value may be
+	Maximum *Amount `json:"maximum,omitempty" protobuf:"bytes,5,opt,name=maximum"`
+}
+
+// Validate returns an error if the schema itself is not valid, e.g. its pattern does not compile
+func (s *ParameterSchema) Validate() error {
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return fmt.Errorf("pattern %q is not a valid regular expression: %w", s.Pattern, err)
+		}
+	}
+	minimum, err := parseBound("minimum", s.Minimum)
+	if err != nil {
+		return err
+	}
+	maximum, err := parseBound("maximum", s.Maximum)
+	if err != nil {
+		return err
+	}
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		return fmt.Errorf("minimum %v must not be greater than maximum %v", *minimum, *maximum)
+	}
+	return nil
+}
+
+func parseBound(name string, a *Amount) (*float64, error) {
+	if a == nil {
+		return nil, nil
+	}
+	f, err := a.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("%s %q must be a number", name, a.Value)
+	}
+	return &f, nil
+}
+
+// ValidateValue returns an error, that says what the value should be, if the value does not match the schema
+func (s *ParameterSchema) ValidateValue(value string) error {
+	if s.Required && value == "" {
+		return errors.New("value is required, but is empty")
+	}
+	if len(s.Enum) > 0 {
+		var values []string
+		for _, v := range s.Enum {
+			if v.String() == value {
+				values = nil
+				break
+			}
+			values = append(values, v.String())
+		}
+		if values != nil {
+			return fmt.Errorf("value %q must be one of: %s", value, strings.Join(values, ", "))
+		}
+	}
+	if s.Pattern != "" {
+		if matched, err := regexp.MatchString(s.Pattern, value); err != nil {
+			return err
+		} else if !matched {
+			return fmt.Errorf("value %q must match %q", value, s.Pattern)
+		}
+	}
+	if s.Minimum == nil && s.Maximum == nil {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("value %q must be a number", value)
+	}
+	if minimum, err := parseBound("minimum", s.Minimum); err != nil {
+		return err
+	} else if minimum != nil && f < *minimum {
+		return fmt.Errorf("value %q must be at least %v", value, *minimum)
+	}
+	if maximum, err := parseBound("maximum", s.Maximum); err != nil {
+		return err
+	} else if maximum != nil && f > *maximum {
+		return fmt.Errorf("value %q must be at most %v", value, *maximum)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterSchema_Validate(t *testing.T) {
+	assert.NoError(t, (&ParameterSchema{Pattern: "^[a-z]+$", Minimum: NewAmount(1), Maximum: NewAmount(1)}).Validate())
+	assert.EqualError(t, (&ParameterSchema{Pattern: "("}).Validate(), "pattern \"(\" is not a valid regular expression: error parsing regexp: missing closing ): `(`")
+	assert.EqualError(t, (&ParameterSchema{Minimum: &Amount{Value: "one"}}).Validate(), `minimum "one" must be a number`)
+	assert.EqualError(t, (&ParameterSchema{Minimum: NewAmount(2), Maximum: NewAmount(1)}).Validate(), "minimum 2 must not be greater than maximum 1")
+}
+
+func TestParameterSchema_ValidateValue(t *testing.T) {
+	t.Run("Required", func(t *testing.T) {
+		s := &ParameterSchema{Required: true}
+		assert.NoError(t, s.ValidateValue("x"))
+		assert.EqualError(t, s.ValidateValue(""), "value is required, but is empty")
+	})
+	t.Run("Enum", func(t *testing.T) {
+		s := &ParameterSchema{Enum: []AnyString{"dev", "prod"}}
+		assert.NoError(t, s.ValidateValue("prod"))
+		assert.EqualError(t, s.ValidateValue("staging"), `value "staging" must be one of: dev, prod`)
+	})
+	t.Run("Pattern", func(t *testing.T) {
+		s := &ParameterSchema{Pattern: "^v[0-9]+$"}
+		assert.NoError(t, s.ValidateValue("v1"))
+		assert.EqualError(t, s.ValidateValue("1"), `value "1" must match "^v[0-9]+$"`)
+	})
+	t.Run("Range", func(t *testing.T) {
+		s := &ParameterSchema{Minimum: NewAmount(0.5), Maximum: NewAmount(10)}
+		assert.NoError(t, s.ValidateValue("0.5"))
+		assert.NoError(t, s.ValidateValue("10"))
+		assert.EqualError(t, s.ValidateValue("0.1"), `value "0.1" must be at least 0.5`)
+		assert.EqualError(t, s.ValidateValue("11"), `value "11" must be at most 10`)
+		assert.EqualError(t, s.ValidateValue("ten"), `value "ten" must be a number`)
+	})
+}
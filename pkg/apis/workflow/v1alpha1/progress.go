@@ -39,7 +39,7 @@ func (in Progress) Complete() Progress {
 }
 
 func (in Progress) IsValid() bool {
-	return in != "" && in.N() >= 0 && in.N() <= in.M() && in.M() > 0
+	return len(in.parts()) == 2 && in.N() >= 0 && in.N() <= in.M() && in.M() > 0
 }
 
 func parseInt64(s string) int64 {
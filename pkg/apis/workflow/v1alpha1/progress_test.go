@@ -16,6 +16,7 @@ func TestProgress(t *testing.T) {
 	})
 	t.Run("IsValid", func(t *testing.T) {
 		assert.False(t, Progress("").IsValid())
+		assert.False(t, Progress("1").IsValid())
 		assert.False(t, Progress("/0").IsValid())
 		assert.False(t, Progress("0/").IsValid())
 		assert.False(t, Progress("0/0").IsValid())
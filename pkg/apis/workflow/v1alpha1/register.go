@@ -44,6 +44,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterWorkflowTemplateList{},
 		&WorkflowTaskSet{},
 		&WorkflowTaskSetList{},
+		&WorkflowTemplateGrant{},
+		&WorkflowTemplateGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
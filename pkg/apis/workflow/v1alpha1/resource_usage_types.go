@@ -0,0 +1,16 @@
+package v1alpha1
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// ResourceUsage is the CPU, and memory, usage of the main containers of a pod, which the controller sampled from the
+// metrics.k8s.io API while they ran, e.g. to right-size the resource requests of the template
+type ResourceUsage struct {
+	// Peak is the highest usage sampled, e.g. `{"cpu": "1500m", "memory": "2Gi"}`
+	Peak apiv1.ResourceList `json:"peak,omitempty" protobuf:"bytes,1,rep,name=peak,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+	// Average is the mean usage sampled
+	Average apiv1.ResourceList `json:"average,omitempty" protobuf:"bytes,2,rep,name=average,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName,castvalue=k8s.io/apimachinery/pkg/api/resource.Quantity"`
+	// Samples is how many times the usage was sampled
+	Samples int64 `json:"samples,omitempty" protobuf:"varint,3,opt,name=samples"`
+}
@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SecretProvider is an external secret store that input parameters can read secrets from. Secrets are read by the
+// init container of the pod that uses them, at run time, so they are never stored in the workflow
+type SecretProvider struct {
+	// Name is the name input parameters refer to the provider by
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Vault is a HashiCorp Vault server
+	Vault *VaultSecretProvider `json:"vault,omitempty" protobuf:"bytes,2,opt,name=vault"`
+	// AWSSecretsManager is AWS Secrets Manager
+	AWSSecretsManager *AWSSecretsManagerSecretProvider `json:"awsSecretsManager,omitempty" protobuf:"bytes,3,opt,name=awsSecretsManager"`
+}
+
+// VaultSecretProvider reads secrets from a HashiCorp Vault server. It logs in with the Kubernetes auth method, as the
+// service account of the pod, unless a token secret is specified
+type VaultSecretProvider struct {
+	// Address of the server, e.g. "https://vault.vault:8200"
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Role is the Kubernetes auth role to log in as
+	Role string `json:"role,omitempty" protobuf:"bytes,2,opt,name=role"`
+	// AuthMountPath is the path the Kubernetes auth method is mounted at. Default "kubernetes"
+	AuthMountPath string `json:"authMountPath,omitempty" protobuf:"bytes,3,opt,name=authMountPath"`
+	// TokenSecret is a secret key of a Vault token, used rather than logging in
+	TokenSecret *v1.SecretKeySelector `json:"tokenSecret,omitempty" protobuf:"bytes,4,opt,name=tokenSecret"`
+	// Namespace is the Vault Enterprise namespace of the secrets
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+}
+
+// GetAuthMountPath returns the path the Kubernetes auth method is mounted at
+func (v *VaultSecretProvider) GetAuthMountPath() string {
+	if v.AuthMountPath == "" {
+		return "kubernetes"
+	}
+	return v.AuthMountPath
+}
+
+// AWSSecretsManagerSecretProvider reads secrets from AWS Secrets Manager. Without access key secrets, it uses the
+// credentials of the pod, e.g. of its service account's IAM role
+type AWSSecretsManagerSecretProvider struct {
+	// Region of the secrets
+	Region string `json:"region" protobuf:"bytes,1,opt,name=region"`
+	// Endpoint of the service, rather than the default endpoint of the region
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,2,opt,name=endpoint"`
+	// AccessKeySecret is the secret selector to the access key ID
+	AccessKeySecret *v1.SecretKeySelector `json:"accessKeySecret,omitempty" protobuf:"bytes,3,opt,name=accessKeySecret"`
+	// SecretKeySecret is the secret selector to the secret access key
+	SecretKeySecret *v1.SecretKeySelector `json:"secretKeySecret,omitempty" protobuf:"bytes,4,opt,name=secretKeySecret"`
+}
+
+// ExternalSecretSelector selects a secret of a secret provider
+type ExternalSecretSelector struct {
+	// Provider is the name of the secret provider
+	Provider string `json:"provider" protobuf:"bytes,1,opt,name=provider"`
+	// Path of the secret, e.g. "secret/data/my-app" in Vault, or the name, or ARN, of the secret in AWS Secrets Manager
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	// Key of the value in the secret. Default is the whole secret, which, in Vault, is JSON encoded
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+}
+
+type SecretProviders []SecretProvider
+
+// Get returns the secret provider with the name, or nil if there is none
+func (s SecretProviders) Get(name string) *SecretProvider {
+	for i, p := range s {
+		if p.Name == name {
+			return &s[i]
+		}
+	}
+	return nil
+}
@@ -33,6 +33,8 @@ type NodeResult struct {
 	Phase   NodePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=NodePhase"`
 	Message string    `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Outputs *Outputs  `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
+	// OutputsOffloaded is true if the outputs were too large to store in the task set, so were stored in a config map
+	OutputsOffloaded bool `json:"outputsOffloaded,omitempty" protobuf:"varint,4,opt,name=outputsOffloaded"`
 }
 
 func (in NodeResult) Fulfilled() bool {
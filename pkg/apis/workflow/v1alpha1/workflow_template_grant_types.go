@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkflowTemplateGrant grants the workflows of other namespaces read access to the workflow templates of its
+// namespace, so that they can refer to them, with a templateRef that specifies the namespace
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:resource:shortName=wftmplgrant
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkflowTemplateGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              WorkflowTemplateGrantSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// WorkflowTemplateGrantList is list of WorkflowTemplateGrant resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkflowTemplateGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []WorkflowTemplateGrant `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// WorkflowTemplateGrantSpec is the spec of a WorkflowTemplateGrant
+type WorkflowTemplateGrantSpec struct {
+	// Namespaces are the namespaces whose workflows are granted read access, "*" is every namespace
+	Namespaces []string `json:"namespaces" protobuf:"bytes,1,rep,name=namespaces"`
+	// WorkflowTemplates are the names of the workflow templates granted. Default is all the workflow templates of the
+	// namespace
+	WorkflowTemplates []string `json:"workflowTemplates,omitempty" protobuf:"bytes,2,rep,name=workflowTemplates"`
+}
+
+// Grants returns whether the workflows of the namespace are granted read access to the workflow template of the name
+func (s WorkflowTemplateGrantSpec) Grants(namespace, name string) bool {
+	return grantsName(s.Namespaces, namespace) && (len(s.WorkflowTemplates) == 0 || grantsName(s.WorkflowTemplates, name))
+}
+
+func grantsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name || n == "*" {
+			return true
+		}
+	}
+	return false
+}
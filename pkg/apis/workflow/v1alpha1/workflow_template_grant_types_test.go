@@ -0,0 +1,23 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowTemplateGrantSpec_Grants(t *testing.T) {
+	assert.False(t, WorkflowTemplateGrantSpec{}.Grants("my-ns", "my-wftmpl"))
+	s := WorkflowTemplateGrantSpec{Namespaces: []string{"my-ns"}}
+	assert.True(t, s.Grants("my-ns", "my-wftmpl"))
+	assert.False(t, s.Grants("other-ns", "my-wftmpl"))
+	s = WorkflowTemplateGrantSpec{Namespaces: []string{"*"}, WorkflowTemplates: []string{"my-wftmpl"}}
+	assert.True(t, s.Grants("other-ns", "my-wftmpl"))
+	assert.False(t, s.Grants("other-ns", "other-wftmpl"))
+}
+
+func TestNodeStatus_GetTemplateScope_Namespace(t *testing.T) {
+	scope, name := NodeStatus{TemplateScope: "namespaced/my-ns/my-wftmpl"}.GetTemplateScope()
+	assert.Equal(t, ResourceScopeNamespaced, scope)
+	assert.Equal(t, "my-ns/my-wftmpl", name)
+}
@@ -36,6 +36,10 @@ const (
 	TemplateTypeSuspend      TemplateType = "Suspend"
 	TemplateTypeData         TemplateType = "Data"
 	TemplateTypeHTTP         TemplateType = "HTTP"
+	TemplateTypeGRPC         TemplateType = "GRPC"
+	TemplateTypeSQL          TemplateType = "SQL"
+	TemplateTypeNotification TemplateType = "Notification"
+	TemplateTypeWorkflow     TemplateType = "Workflow"
 	TemplateTypePlugin       TemplateType = "Plugin"
 	TemplateTypeUnknown      TemplateType = "Unknown"
 )
@@ -66,17 +70,21 @@ type NodeType string
 
 // Node types
 const (
-	NodeTypePod       NodeType = "Pod"
-	NodeTypeContainer NodeType = "Container"
-	NodeTypeSteps     NodeType = "Steps"
-	NodeTypeStepGroup NodeType = "StepGroup"
-	NodeTypeDAG       NodeType = "DAG"
-	NodeTypeTaskGroup NodeType = "TaskGroup"
-	NodeTypeRetry     NodeType = "Retry"
-	NodeTypeSkipped   NodeType = "Skipped"
-	NodeTypeSuspend   NodeType = "Suspend"
-	NodeTypeHTTP      NodeType = "HTTP"
-	NodeTypePlugin    NodeType = "Plugin"
+	NodeTypePod          NodeType = "Pod"
+	NodeTypeContainer    NodeType = "Container"
+	NodeTypeSteps        NodeType = "Steps"
+	NodeTypeStepGroup    NodeType = "StepGroup"
+	NodeTypeDAG          NodeType = "DAG"
+	NodeTypeTaskGroup    NodeType = "TaskGroup"
+	NodeTypeRetry        NodeType = "Retry"
+	NodeTypeSkipped      NodeType = "Skipped"
+	NodeTypeSuspend      NodeType = "Suspend"
+	NodeTypeHTTP         NodeType = "HTTP"
+	NodeTypeGRPC         NodeType = "GRPC"
+	NodeTypeSQL          NodeType = "SQL"
+	NodeTypeNotification NodeType = "Notification"
+	NodeTypeWorkflow     NodeType = "Workflow"
+	NodeTypePlugin       NodeType = "Plugin"
 )
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -385,6 +393,15 @@ type WorkflowSpec struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,41,opt,name=hooks"`
+
+	// Profile is the name of a profile, a config map in the workflow's namespace labelled
+	// `workflows.argoproj.io/configmap-type: Profile`. Its `spec` key is merged into this spec, which takes precedence,
+	// and its other keys are available as `{{workflow.profile.<key>}}`
+	Profile string `json:"profile,omitempty" protobuf:"bytes,42,opt,name=profile"`
+
+	// SecretProviders are the external secret stores, e.g. Vault, that input parameters can read secrets from, with
+	// `valueFrom.externalSecret`
+	SecretProviders SecretProviders `json:"secretProviders,omitempty" protobuf:"bytes,43,rep,name=secretProviders"`
 }
 
 func (wfs *WorkflowSpec) GetExitHook(args Arguments) *LifecycleHook {
@@ -590,6 +607,19 @@ type Template struct {
 	// Plugin is a plugin template
 	Plugin *Plugin `json:"plugin,omitempty" protobuf:"bytes,43,opt,name=plugin"`
 
+	// GRPC calls a gRPC method
+	GRPC *GRPC `json:"grpc,omitempty" protobuf:"bytes,45,opt,name=grpc"`
+
+	// SQL runs a SQL query
+	SQL *SQL `json:"sql,omitempty" protobuf:"bytes,46,opt,name=sql"`
+
+	// Notification sends a message to Slack, or Microsoft Teams
+	Notification *Notification `json:"notification,omitempty" protobuf:"bytes,57,opt,name=notification"`
+
+	// ChildWorkflow creates a workflow, from a workflow template, as a child of this workflow, and waits for it to
+	// complete
+	ChildWorkflow *ChildWorkflow `json:"childWorkflow,omitempty" protobuf:"bytes,49,opt,name=childWorkflow"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -606,12 +636,26 @@ type Template struct {
 	// +patchMergeKey=name
 	Sidecars []UserContainer `json:"sidecars,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,19,opt,name=sidecars"`
 
+	// Env is a list of environment variables to set in the template's main, init, and sidecar containers. A
+	// container's own variable of the same name takes precedence. This is typically set in `templateDefaults`.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Env []apiv1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,47,rep,name=env"`
+
+	// EnvFrom is a list of sources to populate environment variables in the template's main, init, and sidecar
+	// containers. A container's own sources take precedence. This is typically set in `templateDefaults`.
+	EnvFrom []apiv1.EnvFromSource `json:"envFrom,omitempty" protobuf:"bytes,48,rep,name=envFrom"`
+
 	// Location in which all files related to the step will be stored (logs, artifacts, etc...).
 	// Can be overridden by individual items in Outputs. If omitted, will use the default
 	// artifact repository location configured in the controller, appended with the
 	// <workflowname>/<nodename> in the key.
 	ArchiveLocation *ArtifactLocation `json:"archiveLocation,omitempty" protobuf:"bytes,20,opt,name=archiveLocation"`
 
+	// ArtifactRepositoryRef specifies the configMap name and key containing the artifact repository config to use for
+	// this template, overriding the workflow's artifact repository.
+	ArtifactRepositoryRef *ArtifactRepositoryRef `json:"artifactRepositoryRef,omitempty" protobuf:"bytes,44,opt,name=artifactRepositoryRef"`
+
 	// Optional duration in seconds relative to the StartTime that the pod may be active on a node
 	// before the system actively tries to terminate the pod; value must be positive integer
 	// This field is only applicable to container and script templates.
@@ -620,6 +664,43 @@ type Template struct {
 	// RetryStrategy describes how to retry a template when it fails
 	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,22,opt,name=retryStrategy"`
 
+	// CriticalStep protects the pods of a long running step, which cannot be checkpointed, from node drains: the pods
+	// are annotated to not be safe to evict, and, if a pod is evicted or deleted nonetheless, it is retried, regardless
+	// of the retry strategy. This field is only applicable to templates that run pods, e.g. container and script templates.
+	CriticalStep bool `json:"criticalStep,omitempty" protobuf:"varint,50,opt,name=criticalStep"`
+
+	// Spot is whether the pods prefer, require, or avoid, spot, or preemptible, nodes, as configured in the controller's
+	// config map. The pods of templates that prefer, or require, spot nodes are retried when they are preempted,
+	// regardless of the retry strategy. This field is only applicable to templates that run pods.
+	Spot SpotPolicy `json:"spot,omitempty" protobuf:"bytes,51,opt,name=spot,casttype=SpotPolicy"`
+
+	// RuntimeClassName is the runtime class of the pods, e.g. of a sandboxed runtime, such as gVisor, or Kata
+	// Containers. Default is the controller's sandbox runtime class, if configured in its config map.
+	// This field is only applicable to templates that run pods.
+	RuntimeClassName string `json:"runtimeClassName,omitempty" protobuf:"bytes,52,opt,name=runtimeClassName"`
+
+	// HostUsers is whether the containers of the pods run in the host's user namespace. If false, they run in a user
+	// namespace of their own, so root in the containers is not root on the node. This is only supported by CRI-O, as
+	// the user namespace is requested with its "io.kubernetes.cri-o.userns-mode" annotation, which other container
+	// runtimes ignore. Default is the controller's sandbox hostUsers, if configured in its config map.
+	// This field is only applicable to templates that run pods.
+	HostUsers *bool `json:"hostUsers,omitempty" protobuf:"varint,53,opt,name=hostUsers"`
+
+	// Checkpoint is a directory, or file, the step saves its state to, so that it can resume, rather than restart,
+	// when it is retried, or preempted. The wait container saves it periodically, and it is loaded into the pods of
+	// later attempts. This field is only applicable to container and script templates.
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty" protobuf:"bytes,54,opt,name=checkpoint"`
+
+	// OnShutdown is the name of a container, or script, template to run, as a cleanup hook, if the workflow is stopped,
+	// or terminated, while the pod of this template is running, e.g. to flush results, or release external resources.
+	// It runs regardless of the shutdown strategy. This field is only applicable to templates that run pods.
+	OnShutdown string `json:"onShutdown,omitempty" protobuf:"bytes,55,opt,name=onShutdown"`
+
+	// ShutdownGracePeriodSeconds is how long to wait, once the workflow is stopped, or terminated, before the pod of
+	// this template is shut down. If the template has an onShutdown hook, the pod is shut down as soon as the hook
+	// completes. This field is only applicable to templates that run pods.
+	ShutdownGracePeriodSeconds *int64 `json:"shutdownGracePeriodSeconds,omitempty" protobuf:"varint,56,opt,name=shutdownGracePeriodSeconds"`
+
 	// Parallelism limits the max total parallel pods that can execute at the same time within the
 	// boundaries of this template invocation. If additional steps/dag templates are invoked, the
 	// pods created by those templates will not be counted towards this total.
@@ -661,6 +742,32 @@ type Template struct {
 	// +patchMergeKey=ip
 	HostAliases []apiv1.HostAlias `json:"hostAliases,omitempty" patchStrategy:"merge" patchMergeKey:"ip" protobuf:"bytes,29,opt,name=hostAliases"`
 
+	// DNSPolicy is the DNS policy of the pod, rather than the workflow's, e.g. "None" for steps that need a custom
+	// resolv.conf. The controller's podTuning may restrict the policies allowed
+	DNSPolicy *apiv1.DNSPolicy `json:"dnsPolicy,omitempty" protobuf:"bytes,58,opt,name=dnsPolicy"`
+
+	// DNSConfig is the DNS parameters of the pod, rather than the workflow's, in addition to those generated from
+	// the DNS policy
+	DNSConfig *apiv1.PodDNSConfig `json:"dnsConfig,omitempty" protobuf:"bytes,59,opt,name=dnsConfig"`
+
+	// Sysctls are the kernel parameters of the pod, in addition to those of its security context. The controller's
+	// podTuning must allow them
+	Sysctls []apiv1.Sysctl `json:"sysctls,omitempty" protobuf:"bytes,60,rep,name=sysctls"`
+
+	// Pipes stream the stdout of one container of the pod, e.g. of a container set, or a sidecar, to the stdin of
+	// another, through named pipes the executor provisions. Requires the emissary executor
+	Pipes []ContainerPipe `json:"pipes,omitempty" protobuf:"bytes,61,rep,name=pipes"`
+
+	// Platforms are the platforms, e.g. "linux/arm64", that the pods may run on, in order of preference. The pods
+	// require nodes of one of the platforms. If the controller's config map has an image of a container for one of
+	// the platforms, e.g. because the image is not multi-arch, the pods run that image on the first such platform.
+	// This field is only applicable to templates that run pods.
+	Platforms []Platform `json:"platforms,omitempty" protobuf:"bytes,62,rep,name=platforms,casttype=Platform"`
+
+	// NetworkPolicy restricts the network traffic of the pods, with a network policy the controller creates for them,
+	// which is deleted with the workflow. This field is only applicable to templates that run pods.
+	NetworkPolicy *TemplateNetworkPolicy `json:"networkPolicy,omitempty" protobuf:"bytes,63,opt,name=networkPolicy"`
+
 	// SecurityContext holds pod-level security attributes and common container settings.
 	// Optional: Defaults to empty.  See type description for default values of each field.
 	// +optional
@@ -733,6 +840,17 @@ func (tmpl *Template) GetSidecarNames() []string {
 	return containerNames
 }
 
+// GetWaitForReadySidecarNames returns the names of the sidecars that must be ready before the main container starts
+func (tmpl *Template) GetWaitForReadySidecarNames() []string {
+	var containerNames []string
+	for _, s := range tmpl.Sidecars {
+		if s.WaitForReady {
+			containerNames = append(containerNames, s.Name)
+		}
+	}
+	return containerNames
+}
+
 func (tmpl *Template) IsFailFast() bool {
 	return tmpl.FailFast != nil && *tmpl.FailFast
 }
@@ -799,6 +917,10 @@ type Parameter struct {
 
 	// Description is the parameter description
 	Description *AnyString `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+
+	// Schema the value of the parameter must match, checked when the workflow is submitted, and when the template is
+	// run, so that a bad value is rejected before any of the workflow runs
+	Schema *ParameterSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -806,7 +928,8 @@ type ValueFrom struct {
 	// Path in the container to retrieve an output parameter value from in container templates
 	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
 
-	// JSONPath of a resource to retrieve an output parameter value from in resource templates
+	// JSONPath of a resource to retrieve an output parameter value from in resource templates, or of the response body
+	// in HTTP templates
 	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,2,opt,name=jsonPath"`
 
 	// JQFilter expression against the resource object in resource templates
@@ -830,6 +953,16 @@ type ValueFrom struct {
 
 	// Expression, if defined, is evaluated to specify the value for the parameter
 	Expression string `json:"expression,omitempty" protobuf:"bytes,8,rep,name=expression"`
+
+	// LogsRegex is a regular expression that is matched against the logs of the main container in container and
+	// script templates. The value of the parameter is the first capture group (or the whole match if the expression
+	// has no capture group) of the last match in the logs
+	LogsRegex string `json:"logsRegex,omitempty" protobuf:"bytes,10,opt,name=logsRegex"`
+
+	// ExternalSecret is a secret of one of the workflow's secret providers, that the input parameter of a container, or
+	// script, template is read from. The secret is written to a file by the init container, and the value of the
+	// parameter is the path of the file
+	ExternalSecret *ExternalSecretSelector `json:"externalSecret,omitempty" protobuf:"bytes,11,opt,name=externalSecret"`
 }
 
 func (p *Parameter) HasValue() bool {
@@ -885,6 +1018,13 @@ type Artifact struct {
 
 	// FromExpression, if defined, is evaluated to specify the value for the artifact
 	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,11,opt,name=fromExpression"`
+
+	// When is an expression, evaluated after the template has run, that decides whether or not an output artifact
+	// is saved. An artifact that is not saved is treated as a missing optional artifact
+	When string `json:"when,omitempty" protobuf:"bytes,12,opt,name=when"`
+
+	// Digest is the SHA256 digest of the contents of the artifact, which is set when an output artifact is saved
+	Digest string `json:"digest,omitempty" protobuf:"bytes,13,opt,name=digest"`
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -987,6 +1127,10 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// SplitLogs indicates that, in addition to the combined logs, the stdout and stderr streams of the container
+	// should be archived as separate artifacts (`main-stdout` and `main-stderr`)
+	SplitLogs *bool `json:"splitLogs,omitempty" protobuf:"varint,10,opt,name=splitLogs"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1091,6 +1235,10 @@ func (a *ArtifactLocation) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+func (a *ArtifactLocation) IsSplitLogs() bool {
+	return a != nil && a.SplitLogs != nil && *a.SplitLogs
+}
+
 func (a *ArtifactLocation) GetKey() (string, error) {
 	v, err := a.Get()
 	if err != nil {
@@ -1166,6 +1314,9 @@ type Outputs struct {
 
 	// ExitCode holds the exit code of a script template
 	ExitCode *string `json:"exitCode,omitempty" protobuf:"bytes,4,opt,name=exitCode"`
+
+	// Stderr holds the stderr of a script or container template, captured separately from the result
+	Stderr *string `json:"stderr,omitempty" protobuf:"bytes,5,opt,name=stderr"`
 }
 
 // WorkflowStep is a reference to a template to execute in a series of step
@@ -1195,6 +1346,11 @@ type WorkflowStep struct {
 	// WithSequence expands a step into a numeric sequence
 	WithSequence *Sequence `json:"withSequence,omitempty" protobuf:"bytes,7,opt,name=withSequence"`
 
+	// WithArtifact expands a step into multiple parallel steps from the items in the artifact, e.g.
+	// "{{inputs.artifacts.items}}", which is expected to be a JSON, or YAML, list. It is read by the controller, so
+	// the items do not need to be output as a parameter first
+	WithArtifact string `json:"withArtifact,omitempty" protobuf:"bytes,14,opt,name=withArtifact"`
+
 	// When is an expression in which the step should conditionally execute
 	When string `json:"when,omitempty" protobuf:"bytes,8,opt,name=when"`
 
@@ -1282,7 +1438,7 @@ func (step *WorkflowStep) GetTemplateRef() *TemplateRef {
 }
 
 func (step *WorkflowStep) ShouldExpand() bool {
-	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil
+	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil || step.WithArtifact != ""
 }
 
 // Sequence expands a workflow step into numeric range
@@ -1308,6 +1464,9 @@ type TemplateRef struct {
 	Template string `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
 	// ClusterScope indicates the referred template is cluster scoped (i.e. a ClusterWorkflowTemplate).
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,4,opt,name=clusterScope"`
+	// Namespace is the namespace of the workflow template, if it is not the workflow's. A WorkflowTemplateGrant in the
+	// namespace must grant the workflow's namespace read access to it.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
 }
 
 // Synchronization holds synchronization lock configuration
@@ -1360,6 +1519,10 @@ type WorkflowTemplateRef struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	// ClusterScope indicates the referred template is cluster scoped (i.e. a ClusterWorkflowTemplate).
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Revision pins the revision of the workflow template, as recorded by the Argo Server each time the template is
+	// created or changed. If it is not specified, the latest revision is used. Not supported for cluster workflow
+	// templates.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
 }
 
 func (ref *WorkflowTemplateRef) ToTemplateRef(entrypoint string) *TemplateRef {
@@ -1469,6 +1632,10 @@ type UserContainer struct {
 	// dind daemon to partially see the same filesystem as the main container in
 	// order to use features such as docker volume binding
 	MirrorVolumeMounts *bool `json:"mirrorVolumeMounts,omitempty" protobuf:"varint,2,opt,name=mirrorVolumeMounts"`
+
+	// WaitForReady delays the start of the main container until this sidecar is ready, as reported by its
+	// readiness probe. Only supported by the emissary executor.
+	WaitForReady bool `json:"waitForReady,omitempty" protobuf:"varint,3,opt,name=waitForReady"`
 }
 
 // WorkflowStatus contains overall status information about a workflow
@@ -1508,6 +1675,10 @@ type WorkflowStatus struct {
 	// The contents of this list are drained at the end of the workflow.
 	PersistentVolumeClaims []apiv1.Volume `json:"persistentVolumeClaims,omitempty" protobuf:"bytes,7,rep,name=persistentVolumeClaims"`
 
+	// PodNamespace is the ephemeral namespace the controller created for the workflow's pods, which is deleted with the
+	// workflow. Empty if the pods run in the workflow's namespace
+	PodNamespace string `json:"podNamespace,omitempty" protobuf:"bytes,20,opt,name=podNamespace"`
+
 	// Outputs captures output values and artifact locations produced by the workflow via global outputs
 	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,8,opt,name=outputs"`
 
@@ -1517,6 +1688,9 @@ type WorkflowStatus struct {
 	// ResourcesDuration is the total for the workflow
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,12,opt,name=resourcesDuration"`
 
+	// EstimatedCost is the total for the workflow, in the currency of the controller's configured prices
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,19,opt,name=estimatedCost"`
+
 	// StoredWorkflowSpec stores the WorkflowTemplate spec for future execution.
 	StoredWorkflowSpec *WorkflowSpec `json:"storedWorkflowTemplateSpec,omitempty" protobuf:"bytes,14,opt,name=storedWorkflowTemplateSpec"`
 
@@ -1539,6 +1713,63 @@ func (wf *Workflow) GetOffloadNodeStatusVersion() string {
 	return wf.Status.GetOffloadNodeStatusVersion()
 }
 
+// GetPodNamespace returns the namespace of the workflow's pods, which is its ephemeral namespace, if it has one
+func (wf *Workflow) GetPodNamespace() string {
+	if wf.Status.PodNamespace != "" {
+		return wf.Status.PodNamespace
+	}
+	return wf.Namespace
+}
+
+// Checkpoint is the state of a step, that is saved periodically in the artifact repository, and loaded into the pods
+// of the step's later attempts
+type Checkpoint struct {
+	// Path of the checkpoint, which must be in a volume of the main container, e.g. an emptyDir, so that the wait
+	// container can save it while the main container runs
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Interval between saves of the checkpoint, e.g. "10m". Default "5m"
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// Key of the checkpoint in the artifact repository. Default "{{workflow.name}}/checkpoints/<node ID>.tgz", where the
+	// node ID is the same for each attempt of the step
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+}
+
+// GetInterval returns the interval between saves of the checkpoint
+func (c *Checkpoint) GetInterval() time.Duration {
+	if c != nil {
+		if d, err := time.ParseDuration(c.Interval); err == nil && d > 0 {
+			return d
+		}
+	}
+	return 5 * time.Minute
+}
+
+// SpotPolicy is whether a template's pods run on spot, or preemptible, nodes
+type SpotPolicy string
+
+const (
+	SpotPolicyPrefer  SpotPolicy = "prefer"
+	SpotPolicyRequire SpotPolicy = "require"
+	SpotPolicyAvoid   SpotPolicy = "avoid"
+)
+
+// RunsOnSpot returns whether the pods may run on spot nodes, and so may be preempted
+func (p SpotPolicy) RunsOnSpot() bool {
+	return p == SpotPolicyPrefer || p == SpotPolicyRequire
+}
+
+// Platform is the operating system and CPU architecture of nodes, as "os/arch", e.g. "linux/amd64", or "linux/arm64"
+type Platform string
+
+// Parse returns the operating system and architecture of the platform
+func (p Platform) Parse() (os, arch string, err error) {
+	parts := strings.Split(string(p), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("platform %q must be os/arch, e.g. linux/arm64", p)
+	}
+	return parts[0], parts[1], nil
+}
+
 type RetryPolicy string
 
 const (
@@ -1708,6 +1939,16 @@ const (
 	ConditionTypeSpecError ConditionType = "SpecError"
 	// ConditionTypeMetricsError is an error during metric emission
 	ConditionTypeMetricsError ConditionType = "MetricsError"
+	// ConditionTypeAgentUnresponsive is the agent of the workflow not reporting heartbeats
+	ConditionTypeAgentUnresponsive ConditionType = "AgentUnresponsive"
+	// ConditionTypeExecutorUnresponsive is the wait containers of any of the workflow's pods not reporting heartbeats
+	ConditionTypeExecutorUnresponsive ConditionType = "ExecutorUnresponsive"
+	// ConditionTypeOutputsTruncated is the outputs of any of the workflow's nodes being truncated, or not saved, because
+	// they were over the output limits
+	ConditionTypeOutputsTruncated ConditionType = "OutputsTruncated"
+	// ConditionTypeQueued is the workflow waiting, because of parallelism limits, or for a synchronization lock. Its
+	// message is the reason, and the position of the workflow in the queue
+	ConditionTypeQueued ConditionType = "Queued"
 )
 
 type Condition struct {
@@ -1772,6 +2013,14 @@ type NodeStatus struct {
 	// ResourcesDuration is indicative, but not accurate, resource duration. This is populated when the nodes completes.
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,21,opt,name=resourcesDuration"`
 
+	// EstimatedCost is an indicative, but not accurate, cost of the node's resources duration, in the currency of the
+	// controller's configured prices. It is populated when the node completes, if prices are configured.
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,28,opt,name=estimatedCost"`
+
+	// ResourceUsage is the CPU, and memory, usage of the main containers of the node's pod, if the controller is
+	// configured to sample it. It is populated when the node completes.
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty" protobuf:"bytes,29,opt,name=resourceUsage"`
+
 	// PodIP captures the IP of the pod for daemoned steps
 	PodIP string `json:"podIP,omitempty" protobuf:"bytes,12,opt,name=podIP"`
 
@@ -1809,6 +2058,9 @@ type NodeStatus struct {
 
 	// SynchronizationStatus is the synchronization status of the node
 	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,25,opt,name=synchronizationStatus"`
+
+	// Approval is who approved, rejected, or resumed, a suspend node
+	Approval *ApprovalStatus `json:"approval,omitempty" protobuf:"bytes,27,opt,name=approval"`
 }
 
 // Fulfilled returns whether a phase is fulfilled, i.e. it completed execution or was skipped or omitted
@@ -1913,7 +2165,9 @@ func (n NodeStatus) GetTemplateScope() (ResourceScope, string) {
 	if n.TemplateScope == "" {
 		return ResourceScopeLocal, ""
 	}
-	split := strings.Split(n.TemplateScope, "/")
+	// the resource name of a workflow template of another namespace is qualified by its namespace, e.g.
+	// "namespaced/my-ns/my-wftmpl"
+	split := strings.SplitN(n.TemplateScope, "/", 2)
 	// For compatibility: an unspecified ResourceScope in a TemplateScope is a namespaced scope
 	if len(split) == 1 {
 		return ResourceScopeNamespaced, split[0]
@@ -1987,6 +2241,26 @@ type S3Bucket struct {
 	CreateBucketIfNotPresent *CreateS3BucketOptions `json:"createBucketIfNotPresent,omitempty" protobuf:"bytes,9,opt,name=createBucketIfNotPresent"`
 
 	EncryptionOptions *S3EncryptionOptions `json:"encryptionOptions,omitempty" protobuf:"bytes,10,opt,name=encryptionOptions"`
+
+	// Mirrors are copies of the bucket, e.g. in other regions, that artifacts are loaded from if they cannot be loaded
+	// from the bucket. Mirrors in the region of the executor, from the AWS_REGION environment variable, are tried first.
+	Mirrors []S3Mirror `json:"mirrors,omitempty" protobuf:"bytes,11,rep,name=mirrors"`
+}
+
+// S3Mirror is a copy of an S3 bucket, which is accessed with the bucket's credentials
+type S3Mirror struct {
+	// Endpoint is the hostname of the mirror's endpoint. Defaults to the bucket's endpoint
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Bucket is the name of the mirror's bucket. Defaults to the bucket's name
+	Bucket string `json:"bucket,omitempty" protobuf:"bytes,2,opt,name=bucket"`
+
+	// Region is the region of the mirror's bucket
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+
+	// Replicate saves artifacts to the mirror, after they are saved to the bucket, rather than relying on the bucket
+	// being replicated, e.g. by S3 replication. Failing to save to the mirror does not fail the step
+	Replicate bool `json:"replicate,omitempty" protobuf:"varint,4,opt,name=replicate"`
 }
 
 // S3EncryptionOptions used to determine encryption options during s3 operations
@@ -2339,6 +2613,10 @@ type ScriptTemplate struct {
 
 	// Source contains the source code of the script to execute
 	Source string `json:"source" protobuf:"bytes,2,opt,name=source"`
+
+	// Runtime is the name of a script runtime, registered in the controller's configuration, that provides the image,
+	// command, file extension, and resources of the script, where they are not set
+	Runtime string `json:"runtime,omitempty" protobuf:"bytes,3,opt,name=runtime"`
 }
 
 // ResourceTemplate is a template subtype to manipulate kubernetes resources
@@ -2357,12 +2635,12 @@ type ResourceTemplate struct {
 	// SetOwnerReference sets the reference to the workflow on the OwnerReference of generated resource.
 	SetOwnerReference bool `json:"setOwnerReference,omitempty" protobuf:"varint,4,opt,name=setOwnerReference"`
 
-	// SuccessCondition is a label selector expression which describes the conditions
-	// of the k8s resource in which it is acceptable to proceed to the following step
+	// SuccessCondition is a label selector expression (or an expr expression, see ConditionLanguage) which describes
+	// the conditions of the k8s resource in which it is acceptable to proceed to the following step
 	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,5,opt,name=successCondition"`
 
-	// FailureCondition is a label selector expression which describes the conditions
-	// of the k8s resource in which the step was considered failed
+	// FailureCondition is a label selector expression (or an expr expression, see ConditionLanguage) which describes
+	// the conditions of the k8s resource in which the step was considered failed
 	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,6,opt,name=failureCondition"`
 
 	// Flags is a set of additional options passed to kubectl before submitting a resource
@@ -2371,6 +2649,17 @@ type ResourceTemplate struct {
 	// 	"--validate=false"  # disable resource validation
 	// ]
 	Flags []string `json:"flags,omitempty" protobuf:"varint,7,opt,name=flags"`
+
+	// ConditionLanguage is the language of the success and failure conditions. It defaults to "selector".
+	// Must be one of: selector, expr
+	// If "expr", the conditions are expr expressions evaluated against the full resource,
+	// e.g. `status.applicationState.state == "COMPLETED"`
+	ConditionLanguage string `json:"conditionLanguage,omitempty" protobuf:"bytes,8,opt,name=conditionLanguage"`
+}
+
+// IsExprConditions returns whether the success and failure conditions are expr expressions
+func (r *ResourceTemplate) IsExprConditions() bool {
+	return r != nil && r.ConditionLanguage == "expr"
 }
 
 // GetType returns the type of this template
@@ -2402,6 +2691,18 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.HTTP != nil {
 		return TemplateTypeHTTP
 	}
+	if tmpl.GRPC != nil {
+		return TemplateTypeGRPC
+	}
+	if tmpl.SQL != nil {
+		return TemplateTypeSQL
+	}
+	if tmpl.Notification != nil {
+		return TemplateTypeNotification
+	}
+	if tmpl.ChildWorkflow != nil {
+		return TemplateTypeWorkflow
+	}
 	if tmpl.Plugin != nil {
 		return TemplateTypePlugin
 	}
@@ -2439,7 +2740,7 @@ func (tmpl *Template) IsPodType() bool {
 // IsLeaf returns whether or not the template is a leaf
 func (tmpl *Template) IsLeaf() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeContainerSet, TemplateTypeScript, TemplateTypeResource, TemplateTypeData, TemplateTypeHTTP, TemplateTypePlugin:
+	case TemplateTypeContainer, TemplateTypeContainerSet, TemplateTypeScript, TemplateTypeResource, TemplateTypeData, TemplateTypeHTTP, TemplateTypeGRPC, TemplateTypeSQL, TemplateTypeNotification, TemplateTypePlugin:
 		return true
 	}
 	return false
@@ -2476,14 +2777,14 @@ func (tmpl *Template) GetVolumeMounts() []apiv1.VolumeMount {
 	} else if tmpl.Script != nil {
 		return tmpl.Script.VolumeMounts
 	} else if tmpl.ContainerSet != nil {
-		return tmpl.ContainerSet.VolumeMounts
+		return tmpl.ContainerSet.GetVolumeMounts()
 	}
 	return nil
 }
 
 // whether or not the template can and will have outputs (i.e. exit code and result)
 func (tmpl *Template) HasOutput() bool {
-	return tmpl.Container != nil || tmpl.ContainerSet.HasContainerNamed("main") || tmpl.Script != nil || tmpl.Data != nil || tmpl.HTTP != nil
+	return tmpl.Container != nil || tmpl.ContainerSet.HasContainerNamed("main") || tmpl.Script != nil || tmpl.Data != nil || tmpl.HTTP != nil || tmpl.GRPC != nil || tmpl.SQL != nil
 }
 
 // if logs should be saved as an artifact
@@ -2491,6 +2792,11 @@ func (tmpl *Template) SaveLogsAsArtifact() bool {
 	return tmpl != nil && tmpl.ArchiveLocation.IsArchiveLogs() && (tmpl.ContainerSet == nil || tmpl.ContainerSet.HasContainerNamed("main"))
 }
 
+// if stdout and stderr should be saved as separate artifacts
+func (tmpl *Template) SaveSplitLogsAsArtifacts() bool {
+	return tmpl.SaveLogsAsArtifact() && tmpl.ArchiveLocation.IsSplitLogs()
+}
+
 func (t *Template) GetRetryStrategy() (wait.Backoff, error) {
 	return t.ContainerSet.GetRetryStrategy()
 }
@@ -2544,6 +2850,14 @@ type DAGTask struct {
 	// WithSequence expands a task into a numeric sequence
 	WithSequence *Sequence `json:"withSequence,omitempty" protobuf:"bytes,8,opt,name=withSequence"`
 
+	// WithArtifact expands a task into multiple parallel tasks from the items in the artifact, e.g.
+	// "{{tasks.generate.outputs.artifacts.items}}", which is expected to be a JSON, or YAML, list. It is read by the
+	// controller, so the items do not need to be output as a parameter first
+	WithArtifact string `json:"withArtifact,omitempty" protobuf:"bytes,15,opt,name=withArtifact"`
+
+	// Strategy expands a task into multiple parallel tasks, e.g. over the combinations of a matrix
+	Strategy *TaskStrategy `json:"strategy,omitempty" protobuf:"bytes,16,opt,name=strategy"`
+
 	// When is an expression in which the task should conditionally execute
 	When string `json:"when,omitempty" protobuf:"bytes,9,opt,name=when"`
 
@@ -2594,13 +2908,17 @@ func (t *DAGTask) GetTemplateRef() *TemplateRef {
 }
 
 func (t *DAGTask) ShouldExpand() bool {
-	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil
+	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil || t.WithArtifact != "" || t.Strategy.GetMatrix() != nil
 }
 
 // SuspendTemplate is a template subtype to suspend a workflow at a predetermined point in time
 type SuspendTemplate struct {
 	// Duration is the seconds to wait before automatically resuming a template
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+
+	// Approval makes the suspend template a gate, that can only be approved, or rejected, by its approvers, rather than
+	// resumed. With a duration, the node fails if it is not approved in that time
+	Approval *Approval `json:"approval,omitempty" protobuf:"bytes,2,opt,name=approval"`
 }
 
 // GetArtifactByName returns an input artifact by its name
@@ -2632,6 +2950,26 @@ func (in *Inputs) HasInputs() bool {
 	return false
 }
 
+// HasExternalSecrets returns whether any input parameter is read from a secret provider
+func (in *Inputs) HasExternalSecrets() bool {
+	for _, param := range in.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.ExternalSecret != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasLogsRegexParameters returns true if any output parameter is extracted from the container logs
+func (out *Outputs) HasLogsRegexParameters() bool {
+	for _, p := range out.Parameters {
+		if p.ValueFrom != nil && p.ValueFrom.LogsRegex != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // HasOutputs returns whether or not there are any outputs
 func (out *Outputs) HasOutputs() bool {
 	if out.Result != nil {
@@ -2640,6 +2978,9 @@ func (out *Outputs) HasOutputs() bool {
 	if out.ExitCode != nil {
 		return true
 	}
+	if out.Stderr != nil {
+		return true
+	}
 	if len(out.Artifacts) > 0 {
 		return true
 	}
@@ -2767,10 +3108,16 @@ func resolveTemplateReference(callerScope ResourceScope, resourceName string, ca
 		// We are calling an external WorkflowTemplate or ClusterWorkflowTemplate. Template storage is needed
 		// We need to determine if we're calling a WorkflowTemplate or a ClusterWorkflowTemplate
 		referenceScope := ResourceScopeNamespaced
+		referenceName := tmplRef.Name
 		if tmplRef.ClusterScope {
 			referenceScope = ResourceScopeCluster
+		} else if tmplRef.Namespace != "" {
+			referenceName = tmplRef.Namespace + "/" + referenceName
+		} else if i := strings.Index(resourceName, "/"); i >= 0 && callerScope == ResourceScopeNamespaced {
+			// A WorkflowTemplate of another namespace is calling a WorkflowTemplate of its namespace
+			referenceName = resourceName[:i] + "/" + referenceName
 		}
-		return fmt.Sprintf("%s/%s/%s", referenceScope, tmplRef.Name, tmplRef.Template), true
+		return fmt.Sprintf("%s/%s/%s", referenceScope, referenceName, tmplRef.Template), true
 	} else if callerScope != ResourceScopeLocal {
 		// Either a WorkflowTemplate or a ClusterWorkflowTemplate is calling a template inside itself. Template storage is needed
 		return fmt.Sprintf("%s/%s/%s", callerScope, resourceName, caller.GetTemplateName()), true
@@ -2843,6 +3190,9 @@ type Prometheus struct {
 	Histogram *Histogram `json:"histogram,omitempty" protobuf:"bytes,6,opt,name=histogram"`
 	// Counter is a counter metric
 	Counter *Counter `json:"counter,omitempty" protobuf:"bytes,7,opt,name=counter"`
+	// Exemplar attaches the UID of the workflow, as the "workflow_uid" label of an exemplar, to each observation of a
+	// histogram, or increment of a counter
+	Exemplar *bool `json:"exemplar,omitempty" protobuf:"varint,8,opt,name=exemplar"`
 }
 
 func (p *Prometheus) GetMetricLabels() map[string]string {
@@ -2922,6 +3272,10 @@ func (p *Prometheus) IsRealtime() bool {
 	return p.GetMetricType() == MetricTypeGauge && p.Gauge.Realtime != nil && *p.Gauge.Realtime
 }
 
+func (p *Prometheus) HasExemplar() bool {
+	return p.Exemplar != nil && *p.Exemplar
+}
+
 // MetricLabel is a single label for a prometheus metric
 type MetricLabel struct {
 	Key   string `json:"key" protobuf:"bytes,1,opt,name=key"`
@@ -2940,7 +3294,7 @@ type Gauge struct {
 type Histogram struct {
 	// Value is the value of the metric
 	Value string `json:"value" protobuf:"bytes,3,opt,name=value"`
-	// Buckets is a list of bucket divisors for the histogram
+	// Buckets is a list of bucket divisors for the histogram, in any order
 	Buckets []Amount `json:"buckets" protobuf:"bytes,4,rep,name=buckets"`
 }
 
@@ -2960,13 +3314,16 @@ type Counter struct {
 
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
-	// Key is the key to use as the caching key
-	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Key is the key to use as the caching key. If Auto is true, it prefixes the derived key
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
 	// Cache sets and configures the kind of cache
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
 	// MaxAge is the maximum age (e.g. "180s", "24h") of an entry that is still considered valid. If an entry is older
 	// than the MaxAge, it will be ignored.
 	MaxAge string `json:"maxAge" protobuf:"bytes,3,opt,name=maxAge"`
+	// Auto derives the key from a hash of the template, with its inputs resolved, so that the outputs are only reused if
+	// the template, its parameters, and the digests, or else the locations, of its artifacts, are unchanged
+	Auto bool `json:"auto,omitempty" protobuf:"varint,4,opt,name=auto"`
 }
 
 // MemoizationStatus is the status of this memoized node
@@ -15,6 +15,32 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecretsManagerSecretProvider) DeepCopyInto(out *AWSSecretsManagerSecretProvider) {
+	*out = *in
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeySecret != nil {
+		in, out := &in.SecretKeySecret, &out.SecretKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretsManagerSecretProvider.
+func (in *AWSSecretsManagerSecretProvider) DeepCopy() *AWSSecretsManagerSecretProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecretsManagerSecretProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Amount) DeepCopyInto(out *Amount) {
 	*out = *in
@@ -31,6 +57,77 @@ func (in *Amount) DeepCopy() *Amount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApproverGroups != nil {
+		in, out := &in.ApproverGroups, &out.ApproverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalDecision) DeepCopyInto(out *ApprovalDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalDecision.
+func (in *ApprovalDecision) DeepCopy() *ApprovalDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
+	*out = *in
+	if in.Gate != nil {
+		in, out := &in.Gate, &out.Gate
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]ApprovalDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 	*out = *in
@@ -167,6 +264,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(GCSArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SplitLogs != nil {
+		in, out := &in.SplitLogs, &out.SplitLogs
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -405,6 +507,92 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Catchup) DeepCopyInto(out *Catchup) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Catchup.
+func (in *Catchup) DeepCopy() *Catchup {
+	if in == nil {
+		return nil
+	}
+	out := new(Catchup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Checkpoint) DeepCopyInto(out *Checkpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Checkpoint.
+func (in *Checkpoint) DeepCopy() *Checkpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Checkpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflow) DeepCopyInto(out *ChildWorkflow) {
+	*out = *in
+	out.WorkflowTemplateRef = in.WorkflowTemplateRef
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	if in.Propagate != nil {
+		in, out := &in.Propagate, &out.Propagate
+		*out = new(ChildWorkflowPropagation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflow.
+func (in *ChildWorkflow) DeepCopy() *ChildWorkflow {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflowPropagation) DeepCopyInto(out *ChildWorkflowPropagation) {
+	*out = *in
+	if in.Shutdown != nil {
+		in, out := &in.Shutdown, &out.Shutdown
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflowPropagation.
+func (in *ChildWorkflowPropagation) DeepCopy() *ChildWorkflowPropagation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflowPropagation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
 	*out = *in
@@ -545,6 +733,22 @@ func (in *ContainerNode) DeepCopy() *ContainerNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerPipe) DeepCopyInto(out *ContainerPipe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerPipe.
+func (in *ContainerPipe) DeepCopy() *ContainerPipe {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerPipe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerSetRetryStrategy) DeepCopyInto(out *ContainerSetRetryStrategy) {
 	*out = *in
@@ -588,6 +792,11 @@ func (in *ContainerSetTemplate) DeepCopyInto(out *ContainerSetTemplate) {
 		*out = new(ContainerSetRetryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(ContainerSetWorkspace)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -601,6 +810,27 @@ func (in *ContainerSetTemplate) DeepCopy() *ContainerSetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSetWorkspace) DeepCopyInto(out *ContainerSetWorkspace) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSetWorkspace.
+func (in *ContainerSetWorkspace) DeepCopy() *ContainerSetWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSetWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -734,6 +964,26 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = new(metav1.ObjectMeta)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Catchup != nil {
+		in, out := &in.Catchup, &out.Catchup
+		*out = new(Catchup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StopStrategy != nil {
+		in, out := &in.StopStrategy, &out.StopStrategy
+		*out = new(StopStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WhenConfigMaps != nil {
+		in, out := &in.WhenConfigMaps, &out.WhenConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -764,6 +1014,17 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.NextScheduledTimes != nil {
+		in, out := &in.NextScheduledTimes, &out.NextScheduledTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DelayedScheduledTime != nil {
+		in, out := &in.DelayedScheduledTime, &out.DelayedScheduledTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -808,6 +1069,11 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 		*out = new(Sequence)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(TaskStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContinueOn != nil {
 		in, out := &in.ContinueOn, &out.ContinueOn
 		*out = new(ContinueOn)
@@ -904,6 +1170,27 @@ func (in *DataSource) DeepCopy() *DataSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -936,6 +1223,22 @@ func (in *ExecutorConfig) DeepCopy() *ExecutorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretSelector) DeepCopyInto(out *ExternalSecretSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSelector.
+func (in *ExternalSecretSelector) DeepCopy() *ExternalSecretSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCSArtifact) DeepCopyInto(out *GCSArtifact) {
 	*out = *in
@@ -991,6 +1294,75 @@ func (in *GCSBucket) DeepCopy() *GCSBucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPC) DeepCopyInto(out *GRPC) {
+	*out = *in
+	if in.DescriptorSet != nil {
+		in, out := &in.DescriptorSet, &out.DescriptorSet
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(HTTPHeaders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(GRPCTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPC.
+func (in *GRPC) DeepCopy() *GRPC {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCTLS) DeepCopyInto(out *GRPCTLS) {
+	*out = *in
+	if in.CACertSecret != nil {
+		in, out := &in.CACertSecret, &out.CACertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertSecret != nil {
+		in, out := &in.ClientCertSecret, &out.ClientCertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKeySecret != nil {
+		in, out := &in.ClientKeySecret, &out.ClientKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCTLS.
+func (in *GRPCTLS) DeepCopy() *GRPCTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gauge) DeepCopyInto(out *Gauge) {
 	*out = *in
@@ -1155,6 +1527,11 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(HTTPCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1189,6 +1566,27 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCache) DeepCopyInto(out *HTTPCache) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCache.
+func (in *HTTPCache) DeepCopy() *HTTPCache {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
 	*out = *in
@@ -1432,6 +1830,66 @@ func (in *Link) DeepCopy() *Link {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Matrix) DeepCopyInto(out *Matrix) {
+	*out = *in
+	if in.Axes != nil {
+		in, out := &in.Axes, &out.Axes
+		*out = make([]MatrixAxis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]Item, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]Item, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matrix.
+func (in *Matrix) DeepCopy() *Matrix {
+	if in == nil {
+		return nil
+	}
+	out := new(Matrix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatrixAxis) DeepCopyInto(out *MatrixAxis) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]Item, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatrixAxis.
+func (in *MatrixAxis) DeepCopy() *MatrixAxis {
+	if in == nil {
+		return nil
+	}
+	out := new(MatrixAxis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoizationStatus) DeepCopyInto(out *MemoizationStatus) {
 	*out = *in
@@ -1638,6 +2096,16 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(ResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Daemoned != nil {
 		in, out := &in.Daemoned, &out.Daemoned
 		*out = new(bool)
@@ -1673,6 +2141,11 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(NodeSynchronizationStatus)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1740,6 +2213,28 @@ func (in *NoneStrategy) DeepCopy() *NoneStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	in.WebhookURLSecret.DeepCopyInto(&out.WebhookURLSecret)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSSArtifact) DeepCopyInto(out *OSSArtifact) {
 	*out = *in
@@ -1869,6 +2364,11 @@ func (in *Outputs) DeepCopyInto(out *Outputs) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Stderr != nil {
+		in, out := &in.Stderr, &out.Stderr
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1933,6 +2433,11 @@ func (in *Parameter) DeepCopyInto(out *Parameter) {
 		*out = new(AnyString)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(ParameterSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1946,6 +2451,37 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSchema) DeepCopyInto(out *ParameterSchema) {
+	*out = *in
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]AnyString, len(*in))
+		copy(*out, *in)
+	}
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(Amount)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSchema.
+func (in *ParameterSchema) DeepCopy() *ParameterSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Plugin) DeepCopyInto(out *Plugin) {
 	*out = *in
@@ -2013,6 +2549,11 @@ func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 		*out = new(Counter)
 		**out = **in
 	}
+	if in.Exemplar != nil {
+		in, out := &in.Exemplar, &out.Exemplar
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2053,12 +2594,42 @@ func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTemplate.
-func (in *ResourceTemplate) DeepCopy() *ResourceTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTemplate.
+func (in *ResourceTemplate) DeepCopy() *ResourceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
+	*out = *in
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Average != nil {
+		in, out := &in.Average, &out.Average
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsage.
+func (in *ResourceUsage) DeepCopy() *ResourceUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceTemplate)
+	out := new(ResourceUsage)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2215,6 +2786,11 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(S3EncryptionOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]S3Mirror, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2249,6 +2825,49 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Mirror) DeepCopyInto(out *S3Mirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Mirror.
+func (in *S3Mirror) DeepCopy() *S3Mirror {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Mirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQL) DeepCopyInto(out *SQL) {
+	*out = *in
+	in.DSNSecret.DeepCopyInto(&out.DSNSecret)
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQL.
+func (in *SQL) DeepCopy() *SQL {
+	if in == nil {
+		return nil
+	}
+	out := new(SQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -2266,6 +2885,54 @@ func (in *ScriptTemplate) DeepCopy() *ScriptTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretProvider) DeepCopyInto(out *SecretProvider) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSecretsManager != nil {
+		in, out := &in.AWSSecretsManager, &out.AWSSecretsManager
+		*out = new(AWSSecretsManagerSecretProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretProvider.
+func (in *SecretProvider) DeepCopy() *SecretProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SecretProviders) DeepCopyInto(out *SecretProviders) {
+	{
+		in := &in
+		*out = make(SecretProviders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretProviders.
+func (in SecretProviders) DeepCopy() SecretProviders {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretProviders)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SemaphoreHolding) DeepCopyInto(out *SemaphoreHolding) {
 	*out = *in
@@ -2369,6 +3036,27 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopStrategy) DeepCopyInto(out *StopStrategy) {
+	*out = *in
+	if in.SuspendAfterFailures != nil {
+		in, out := &in.SuspendAfterFailures, &out.SuspendAfterFailures
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopStrategy.
+func (in *StopStrategy) DeepCopy() *StopStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StopStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Submit) DeepCopyInto(out *Submit) {
 	*out = *in
@@ -2442,6 +3130,11 @@ func (in *SuppliedValueFrom) DeepCopy() *SuppliedValueFrom {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendTemplate) DeepCopyInto(out *SuspendTemplate) {
 	*out = *in
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2559,6 +3252,27 @@ func (in *TarStrategy) DeepCopy() *TarStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskStrategy) DeepCopyInto(out *TaskStrategy) {
+	*out = *in
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = new(Matrix)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStrategy.
+func (in *TaskStrategy) DeepCopy() *TaskStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
@@ -2617,7 +3331,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(SuspendTemplate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
@@ -2634,6 +3348,26 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(Plugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(Notification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChildWorkflow != nil {
+		in, out := &in.ChildWorkflow, &out.ChildWorkflow
+		*out = new(ChildWorkflow)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -2655,11 +3389,30 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ArchiveLocation != nil {
 		in, out := &in.ArchiveLocation, &out.ArchiveLocation
 		*out = new(ArtifactLocation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactRepositoryRef != nil {
+		in, out := &in.ArtifactRepositoryRef, &out.ArtifactRepositoryRef
+		*out = new(ArtifactRepositoryRef)
+		**out = **in
+	}
 	if in.ActiveDeadlineSeconds != nil {
 		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
 		*out = new(intstr.IntOrString)
@@ -2670,6 +3423,21 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(RetryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HostUsers != nil {
+		in, out := &in.HostUsers, &out.HostUsers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(Checkpoint)
+		**out = **in
+	}
+	if in.ShutdownGracePeriodSeconds != nil {
+		in, out := &in.ShutdownGracePeriodSeconds, &out.ShutdownGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Parallelism != nil {
 		in, out := &in.Parallelism, &out.Parallelism
 		*out = new(int64)
@@ -2709,6 +3477,36 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DNSPolicy != nil {
+		in, out := &in.DNSPolicy, &out.DNSPolicy
+		*out = new(v1.DNSPolicy)
+		**out = **in
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make([]v1.Sysctl, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pipes != nil {
+		in, out := &in.Pipes, &out.Pipes
+		*out = make([]ContainerPipe, len(*in))
+		copy(*out, *in)
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]Platform, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(TemplateNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
 		*out = new(v1.PodSecurityContext)
@@ -2742,6 +3540,29 @@ func (in *Template) DeepCopy() *Template {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateNetworkPolicy) DeepCopyInto(out *TemplateNetworkPolicy) {
+	*out = *in
+	if in.EgressAllowlist != nil {
+		in, out := &in.EgressAllowlist, &out.EgressAllowlist
+		*out = make([]EgressDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateNetworkPolicy.
+func (in *TemplateNetworkPolicy) DeepCopy() *TemplateNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
 	*out = *in
@@ -2834,6 +3655,11 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(AnyString)
 		**out = **in
 	}
+	if in.ExternalSecret != nil {
+		in, out := &in.ExternalSecret, &out.ExternalSecret
+		*out = new(ExternalSecretSelector)
+		**out = **in
+	}
 	return
 }
 
@@ -2847,6 +3673,27 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretProvider) DeepCopyInto(out *VaultSecretProvider) {
+	*out = *in
+	if in.TokenSecret != nil {
+		in, out := &in.TokenSecret, &out.TokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretProvider.
+func (in *VaultSecretProvider) DeepCopy() *VaultSecretProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
@@ -3200,6 +4047,13 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SecretProviders != nil {
+		in, out := &in.SecretProviders, &out.SecretProviders
+		*out = make(SecretProviders, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3256,6 +4110,11 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(Amount)
+		**out = **in
+	}
 	if in.StoredWorkflowSpec != nil {
 		in, out := &in.StoredWorkflowSpec, &out.StoredWorkflowSpec
 		*out = new(WorkflowSpec)
@@ -3469,6 +4328,92 @@ func (in *WorkflowTemplate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateGrant) DeepCopyInto(out *WorkflowTemplateGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateGrant.
+func (in *WorkflowTemplateGrant) DeepCopy() *WorkflowTemplateGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplateGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateGrantList) DeepCopyInto(out *WorkflowTemplateGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTemplateGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateGrantList.
+func (in *WorkflowTemplateGrantList) DeepCopy() *WorkflowTemplateGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplateGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateGrantSpec) DeepCopyInto(out *WorkflowTemplateGrantSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkflowTemplates != nil {
+		in, out := &in.WorkflowTemplates, &out.WorkflowTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateGrantSpec.
+func (in *WorkflowTemplateGrantSpec) DeepCopy() *WorkflowTemplateGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowTemplateList) DeepCopyInto(out *WorkflowTemplateList) {
 	*out = *in
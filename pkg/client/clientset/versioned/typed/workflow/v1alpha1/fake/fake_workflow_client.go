@@ -36,6 +36,10 @@ func (c *FakeArgoprojV1alpha1) WorkflowTemplates(namespace string) v1alpha1.Work
 	return &FakeWorkflowTemplates{c, namespace}
 }
 
+func (c *FakeArgoprojV1alpha1) WorkflowTemplateGrants(namespace string) v1alpha1.WorkflowTemplateGrantInterface {
+	return &FakeWorkflowTemplateGrants{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeArgoprojV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,114 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkflowTemplateGrants implements WorkflowTemplateGrantInterface
+type FakeWorkflowTemplateGrants struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var workflowtemplategrantsResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflowtemplategrants"}
+
+var workflowtemplategrantsKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "WorkflowTemplateGrant"}
+
+// Get takes name of the workflowTemplateGrant, and returns the corresponding workflowTemplateGrant object, and an error if there is any.
+func (c *FakeWorkflowTemplateGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workflowtemplategrantsResource, c.ns, name), &v1alpha1.WorkflowTemplateGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowTemplateGrant), err
+}
+
+// List takes label and field selectors, and returns the list of WorkflowTemplateGrants that match those selectors.
+func (c *FakeWorkflowTemplateGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowTemplateGrantList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workflowtemplategrantsResource, workflowtemplategrantsKind, c.ns, opts), &v1alpha1.WorkflowTemplateGrantList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WorkflowTemplateGrantList{ListMeta: obj.(*v1alpha1.WorkflowTemplateGrantList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WorkflowTemplateGrantList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workflowTemplateGrants.
+func (c *FakeWorkflowTemplateGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workflowtemplategrantsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workflowTemplateGrant and creates it.  Returns the server's representation of the workflowTemplateGrant, and an error, if there is any.
+func (c *FakeWorkflowTemplateGrants) Create(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.CreateOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(workflowtemplategrantsResource, c.ns, workflowTemplateGrant), &v1alpha1.WorkflowTemplateGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowTemplateGrant), err
+}
+
+// Update takes the representation of a workflowTemplateGrant and updates it. Returns the server's representation of the workflowTemplateGrant, and an error, if there is any.
+func (c *FakeWorkflowTemplateGrants) Update(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.UpdateOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(workflowtemplategrantsResource, c.ns, workflowTemplateGrant), &v1alpha1.WorkflowTemplateGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowTemplateGrant), err
+}
+
+// Delete takes name of the workflowTemplateGrant and deletes it. Returns an error if one occurs.
+func (c *FakeWorkflowTemplateGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(workflowtemplategrantsResource, c.ns, name), &v1alpha1.WorkflowTemplateGrant{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkflowTemplateGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(workflowtemplategrantsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WorkflowTemplateGrantList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workflowTemplateGrant.
+func (c *FakeWorkflowTemplateGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(workflowtemplategrantsResource, c.ns, name, pt, data, subresources...), &v1alpha1.WorkflowTemplateGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowTemplateGrant), err
+}
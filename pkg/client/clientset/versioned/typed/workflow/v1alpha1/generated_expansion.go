@@ -13,3 +13,5 @@ type WorkflowEventBindingExpansion interface{}
 type WorkflowTaskSetExpansion interface{}
 
 type WorkflowTemplateExpansion interface{}
+
+type WorkflowTemplateGrantExpansion interface{}
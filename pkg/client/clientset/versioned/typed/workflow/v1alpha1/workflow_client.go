@@ -16,6 +16,7 @@ type ArgoprojV1alpha1Interface interface {
 	WorkflowEventBindingsGetter
 	WorkflowTaskSetsGetter
 	WorkflowTemplatesGetter
+	WorkflowTemplateGrantsGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -47,6 +48,10 @@ func (c *ArgoprojV1alpha1Client) WorkflowTemplates(namespace string) WorkflowTem
 	return newWorkflowTemplates(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) WorkflowTemplateGrants(namespace string) WorkflowTemplateGrantInterface {
+	return newWorkflowTemplateGrants(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*ArgoprojV1alpha1Client, error) {
 	config := *c
@@ -0,0 +1,162 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	scheme "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkflowTemplateGrantsGetter has a method to return a WorkflowTemplateGrantInterface.
+// A group's client should implement this interface.
+type WorkflowTemplateGrantsGetter interface {
+	WorkflowTemplateGrants(namespace string) WorkflowTemplateGrantInterface
+}
+
+// WorkflowTemplateGrantInterface has methods to work with WorkflowTemplateGrant resources.
+type WorkflowTemplateGrantInterface interface {
+	Create(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.CreateOptions) (*v1alpha1.WorkflowTemplateGrant, error)
+	Update(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.UpdateOptions) (*v1alpha1.WorkflowTemplateGrant, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WorkflowTemplateGrant, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WorkflowTemplateGrantList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowTemplateGrant, err error)
+	WorkflowTemplateGrantExpansion
+}
+
+// workflowTemplateGrants implements WorkflowTemplateGrantInterface
+type workflowTemplateGrants struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWorkflowTemplateGrants returns a WorkflowTemplateGrants
+func newWorkflowTemplateGrants(c *ArgoprojV1alpha1Client, namespace string) *workflowTemplateGrants {
+	return &workflowTemplateGrants{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the workflowTemplateGrant, and returns the corresponding workflowTemplateGrant object, and an error if there is any.
+func (c *workflowTemplateGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	result = &v1alpha1.WorkflowTemplateGrant{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkflowTemplateGrants that match those selectors.
+func (c *workflowTemplateGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowTemplateGrantList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WorkflowTemplateGrantList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workflowTemplateGrants.
+func (c *workflowTemplateGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a workflowTemplateGrant and creates it.  Returns the server's representation of the workflowTemplateGrant, and an error, if there is any.
+func (c *workflowTemplateGrants) Create(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.CreateOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	result = &v1alpha1.WorkflowTemplateGrant{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowTemplateGrant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workflowTemplateGrant and updates it. Returns the server's representation of the workflowTemplateGrant, and an error, if there is any.
+func (c *workflowTemplateGrants) Update(ctx context.Context, workflowTemplateGrant *v1alpha1.WorkflowTemplateGrant, opts v1.UpdateOptions) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	result = &v1alpha1.WorkflowTemplateGrant{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		Name(workflowTemplateGrant.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowTemplateGrant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the workflowTemplateGrant and deletes it. Returns an error if one occurs.
+func (c *workflowTemplateGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workflowTemplateGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched workflowTemplateGrant.
+func (c *workflowTemplateGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowTemplateGrant, err error) {
+	result = &v1alpha1.WorkflowTemplateGrant{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("workflowtemplategrants").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,6 +20,8 @@ type Interface interface {
 	WorkflowTaskSets() WorkflowTaskSetInformer
 	// WorkflowTemplates returns a WorkflowTemplateInformer.
 	WorkflowTemplates() WorkflowTemplateInformer
+	// WorkflowTemplateGrants returns a WorkflowTemplateGrantInformer.
+	WorkflowTemplateGrants() WorkflowTemplateGrantInformer
 }
 
 type version struct {
@@ -62,3 +64,8 @@ func (v *version) WorkflowTaskSets() WorkflowTaskSetInformer {
 func (v *version) WorkflowTemplates() WorkflowTemplateInformer {
 	return &workflowTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// WorkflowTemplateGrants returns a WorkflowTemplateGrantInformer.
+func (v *version) WorkflowTemplateGrants() WorkflowTemplateGrantInformer {
+	return &workflowTemplateGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
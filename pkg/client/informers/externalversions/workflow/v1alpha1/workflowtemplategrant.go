@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	workflowv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	versioned "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/listers/workflow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// WorkflowTemplateGrantInformer provides access to a shared informer and lister for
+// WorkflowTemplateGrants.
+type WorkflowTemplateGrantInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.WorkflowTemplateGrantLister
+}
+
+type workflowTemplateGrantInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewWorkflowTemplateGrantInformer constructs a new informer for WorkflowTemplateGrant type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewWorkflowTemplateGrantInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredWorkflowTemplateGrantInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredWorkflowTemplateGrantInformer constructs a new informer for WorkflowTemplateGrant type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredWorkflowTemplateGrantInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().WorkflowTemplateGrants(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().WorkflowTemplateGrants(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&workflowv1alpha1.WorkflowTemplateGrant{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *workflowTemplateGrantInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredWorkflowTemplateGrantInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *workflowTemplateGrantInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&workflowv1alpha1.WorkflowTemplateGrant{}, f.defaultInformer)
+}
+
+func (f *workflowTemplateGrantInformer) Lister() v1alpha1.WorkflowTemplateGrantLister {
+	return v1alpha1.NewWorkflowTemplateGrantLister(f.Informer().GetIndexer())
+}
@@ -45,3 +45,11 @@ type WorkflowTemplateListerExpansion interface{}
 // WorkflowTemplateNamespaceListerExpansion allows custom methods to be added to
 // WorkflowTemplateNamespaceLister.
 type WorkflowTemplateNamespaceListerExpansion interface{}
+
+// WorkflowTemplateGrantListerExpansion allows custom methods to be added to
+// WorkflowTemplateGrantLister.
+type WorkflowTemplateGrantListerExpansion interface{}
+
+// WorkflowTemplateGrantNamespaceListerExpansion allows custom methods to be added to
+// WorkflowTemplateGrantNamespaceLister.
+type WorkflowTemplateGrantNamespaceListerExpansion interface{}
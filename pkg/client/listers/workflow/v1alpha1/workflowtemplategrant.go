@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkflowTemplateGrantLister helps list WorkflowTemplateGrants.
+// All objects returned here must be treated as read-only.
+type WorkflowTemplateGrantLister interface {
+	// List lists all WorkflowTemplateGrants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowTemplateGrant, err error)
+	// WorkflowTemplateGrants returns an object that can list and get WorkflowTemplateGrants.
+	WorkflowTemplateGrants(namespace string) WorkflowTemplateGrantNamespaceLister
+	WorkflowTemplateGrantListerExpansion
+}
+
+// workflowTemplateGrantLister implements the WorkflowTemplateGrantLister interface.
+type workflowTemplateGrantLister struct {
+	indexer cache.Indexer
+}
+
+// NewWorkflowTemplateGrantLister returns a new WorkflowTemplateGrantLister.
+func NewWorkflowTemplateGrantLister(indexer cache.Indexer) WorkflowTemplateGrantLister {
+	return &workflowTemplateGrantLister{indexer: indexer}
+}
+
+// List lists all WorkflowTemplateGrants in the indexer.
+func (s *workflowTemplateGrantLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowTemplateGrant, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowTemplateGrant))
+	})
+	return ret, err
+}
+
+// WorkflowTemplateGrants returns an object that can list and get WorkflowTemplateGrants.
+func (s *workflowTemplateGrantLister) WorkflowTemplateGrants(namespace string) WorkflowTemplateGrantNamespaceLister {
+	return workflowTemplateGrantNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WorkflowTemplateGrantNamespaceLister helps list and get WorkflowTemplateGrants.
+// All objects returned here must be treated as read-only.
+type WorkflowTemplateGrantNamespaceLister interface {
+	// List lists all WorkflowTemplateGrants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowTemplateGrant, err error)
+	// Get retrieves the WorkflowTemplateGrant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WorkflowTemplateGrant, error)
+	WorkflowTemplateGrantNamespaceListerExpansion
+}
+
+// workflowTemplateGrantNamespaceLister implements the WorkflowTemplateGrantNamespaceLister
+// interface.
+type workflowTemplateGrantNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WorkflowTemplateGrants in the indexer for a given namespace.
+func (s workflowTemplateGrantNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowTemplateGrant, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowTemplateGrant))
+	})
+	return ret, err
+}
+
+// Get retrieves the WorkflowTemplateGrant from the indexer for a given namespace and name.
+func (s workflowTemplateGrantNamespaceLister) Get(name string) (*v1alpha1.WorkflowTemplateGrant, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("workflowtemplategrant"), name)
+	}
+	return obj.(*v1alpha1.WorkflowTemplateGrant), nil
+}
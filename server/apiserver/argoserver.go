@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/handlers"
@@ -13,6 +14,7 @@ import (
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
@@ -25,41 +27,57 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/env"
+	"upper.io/db.v3/lib/sqlbuilder"
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/redisdb"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	apitokenpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/apitoken"
 	clusterwftemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	eventsourcepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/eventsource"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	pipelinepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/pipeline"
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
 	sensorpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sensor"
+	sharelinkpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sharelink"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/apitoken"
 	"github.com/argoproj/argo-workflows/v3/server/artifacts"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 	"github.com/argoproj/argo-workflows/v3/server/auth/webhook"
 	"github.com/argoproj/argo-workflows/v3/server/cache"
+	"github.com/argoproj/argo-workflows/v3/server/cluster"
 	"github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/server/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/server/event"
 	"github.com/argoproj/argo-workflows/v3/server/eventsource"
 	"github.com/argoproj/argo-workflows/v3/server/info"
+	"github.com/argoproj/argo-workflows/v3/server/metrics"
 	pipeline "github.com/argoproj/argo-workflows/v3/server/pipeline"
+	"github.com/argoproj/argo-workflows/v3/server/plugin"
+	"github.com/argoproj/argo-workflows/v3/server/ratelimit"
 	"github.com/argoproj/argo-workflows/v3/server/sensor"
+	"github.com/argoproj/argo-workflows/v3/server/sharelink"
 	"github.com/argoproj/argo-workflows/v3/server/static"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	"github.com/argoproj/argo-workflows/v3/server/workflow"
 	"github.com/argoproj/argo-workflows/v3/server/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/server/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/util/diagnostics"
 	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/json"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/metricspush"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -74,6 +92,7 @@ type argoServer struct {
 	hsts                     bool
 	namespace                string
 	managedNamespace         string
+	ssoNamespace             string
 	clients                  *types.Clients
 	gatekeeper               auth.Gatekeeper
 	oAuth2Service            sso.Interface
@@ -82,6 +101,7 @@ type argoServer struct {
 	eventQueueSize           int
 	eventWorkerCount         int
 	eventAsyncDispatch       bool
+	readOnly                 bool
 	xframeOptions            string
 	accessControlAllowOrigin string
 	cache                    *cache.ResourceCache
@@ -103,6 +123,7 @@ type ArgoServerOpts struct {
 	EventOperationQueueSize  int
 	EventWorkerCount         int
 	EventAsyncDispatch       bool
+	ReadOnly                 bool
 	XFrameOptions            string
 	AccessControlAllowOrigin string
 }
@@ -150,6 +171,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 		hsts:                     opts.HSTS,
 		namespace:                opts.Namespace,
 		managedNamespace:         opts.ManagedNamespace,
+		ssoNamespace:             opts.SSONameSpace,
 		clients:                  opts.Clients,
 		gatekeeper:               gatekeeper,
 		oAuth2Service:            ssoIf,
@@ -158,6 +180,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 		eventQueueSize:           opts.EventOperationQueueSize,
 		eventWorkerCount:         opts.EventWorkerCount,
 		eventAsyncDispatch:       opts.EventAsyncDispatch,
+		readOnly:                 opts.ReadOnly,
 		xframeOptions:            opts.XFrameOptions,
 		accessControlAllowOrigin: opts.AccessControlAllowOrigin,
 		cache:                    resourceCache,
@@ -181,28 +204,79 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	offloadRepo := sqldb.ExplosiveOffloadNodeStatusRepo
 	wfArchive := sqldb.NullWorkflowArchive
+	wfSnapshots := sqldb.NullWorkflowSnapshotRepo
 	persistence := config.Persistence
-	if persistence != nil {
-		session, tableName, err := sqldb.CreateDBSession(as.clients.Kubernetes, as.namespace, persistence)
+	var session sqlbuilder.Database
+	clusterName := ""
+	if persistence != nil && persistence.HasDatabase() {
+		var tableName string
+		session, tableName, err = sqldb.CreateDBSession(as.clients.Kubernetes, as.namespace, persistence)
 		if err != nil {
 			log.Fatal(err)
 		}
+		clusterName = persistence.GetClusterName()
 		// we always enable node offload, as this is read-only for the Argo Server, i.e. you can turn it off if you
 		// like and the controller won't offload newly created workflows, but you can still read them
 		offloadRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
 		if err != nil {
 			log.Fatal(err)
 		}
+		// archived workflows are listed, and searched, from the read replica, if there is one, so those queries do not
+		// load the primary, that the controller offloads node statuses to
+		readSession, err := sqldb.CreateReadDBSession(as.clients.Kubernetes, as.namespace, persistence)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if readSession == nil {
+			readSession = session
+		}
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
-		wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		wfArchive = sqldb.NewWorkflowArchive(session, readSession, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		// snapshots are read from the read replica too, as the controller writes them often
+		if s := persistence.WorkflowSnapshots; s != nil {
+			wfSnapshots = sqldb.NewWorkflowSnapshotRepo(readSession, persistence.GetClusterName(), s.GetTTL())
+		}
+	}
+	if persistence != nil && persistence.Redis != nil {
+		// the controller offloads to Redis, rather than the database, when it is configured
+		offloadRepo, err = redisdb.NewOffloadNodeStatusRepo(as.clients.Kubernetes, as.namespace, persistence.GetClusterName(), persistence.Redis)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	auditSinks, err := audit.NewSinks(config.Audit, session, clusterName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	clusters, err := cluster.New(ctx, config.Clusters, localClusterName(persistence), as.clients.Kubernetes.CoreV1().Secrets(as.namespace))
+	if err != nil {
+		log.Fatal(err)
+	}
+	rateLimitInterceptor, err := ratelimit.UnaryServerInterceptor(config.RateLimit)
+	if err != nil {
+		log.Fatal(err)
 	}
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
 	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
-	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories)
-	eventServer := event.NewController(instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch)
-	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, eventServer, config.Links, config.NavColor)
-	httpServer := as.newHTTPServer(ctx, port, artifactServer)
+	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories, as.clients.Workflow, auditSinks)
+	shareLinkServer := sharelink.NewShareLinkServer(as.namespace, as.baseHRef, as.clients, instanceIDService, hydrator.New(offloadRepo), artifactServer)
+	failedEventRepo, err := event.NewFailedEventRepo(config.FailedEvents, session, clusterName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	eventServer := event.NewController(instanceIDService, eventRecorderManager, failedEventRepo, config.FailedEvents.Webhook, as.eventQueueSize, as.eventWorkerCount, as.eventAsyncDispatch)
+	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, wfSnapshots, artifactRepositories, eventServer, shareLinkServer, auditSinks, rateLimitInterceptor, localClusterName(persistence), clusters, config.WorkflowDefaults, config.Links, config.NavColor)
+	httpServer := as.newHTTPServer(ctx, port, artifactServer, shareLinkServer)
+	diagnostics.Register("server", func() interface{} { return as.diagnostics(eventServer) })
+	if config.MetricsConfig.Push != nil {
+		// the metrics of the server are those of the default registry, which it serves at /metrics
+		pusher, err := metricspush.New(*config.MetricsConfig.Push, "argo-server", prometheus.DefaultGatherer)
+		if err != nil {
+			log.Fatal(err)
+		}
+		go pusher.Run(ctx)
+	}
 
 	// Start listener
 	var conn net.Listener
@@ -248,7 +322,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, eventServer *event.Controller, links []*v1alpha1.Link, navColor string) *grpc.Server {
+func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, wfSnapshots sqldb.WorkflowSnapshotRepo, artifactRepositories artifactrepositories.Interface, eventServer *event.Controller, shareLinkServer *sharelink.ShareLinkServer, auditSinks []audit.Sink, rateLimitInterceptor grpc.UnaryServerInterceptor, localClusterName string, clusters []cluster.Cluster, wfDefaults *v1alpha1.Workflow, links []*v1alpha1.Link, navColor string) *grpc.Server {
 	serverLog := log.NewEntry(log.StandardLogger())
 
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs. However, since it is bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default. To enable them please call the following in your server initialization code:"
@@ -262,15 +336,22 @@ func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloa
 		grpc.MaxSendMsgSize(MaxGRPCMessageSize),
 		grpc.ConnectionTimeout(300 * time.Second),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			tracing.UnaryServerInterceptor(),
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_logrus.UnaryServerInterceptor(serverLog),
+			logs.UnaryServerInterceptor(),
 			grpcutil.PanicLoggerUnaryServerInterceptor(serverLog),
 			grpcutil.ErrorTranslationUnaryServerInterceptor,
 			as.gatekeeper.UnaryServerInterceptor(),
+			rateLimitInterceptor,
+			auth.ReadOnlyUnaryServerInterceptor(as.readOnly),
+			audit.UnaryServerInterceptor(auditSinks...),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			tracing.StreamServerInterceptor(),
 			grpc_prometheus.StreamServerInterceptor,
 			grpc_logrus.StreamServerInterceptor(serverLog),
+			logs.StreamServerInterceptor(),
 			grpcutil.PanicLoggerStreamServerInterceptor(serverLog),
 			grpcutil.ErrorTranslationStreamServerInterceptor,
 			as.gatekeeper.StreamServerInterceptor(),
@@ -279,23 +360,26 @@ func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloa
 
 	grpcServer := grpc.NewServer(sOpts...)
 
-	infopkg.RegisterInfoServiceServer(grpcServer, info.NewInfoServer(as.managedNamespace, links, navColor))
+	infopkg.RegisterInfoServiceServer(grpcServer, info.NewInfoServer(as.managedNamespace, links, navColor, as.oAuth2Service.RBACConfig(), as.ssoNamespace))
 	eventpkg.RegisterEventServiceServer(grpcServer, eventServer)
 	eventsourcepkg.RegisterEventSourceServiceServer(grpcServer, eventsource.NewEventSourceServer())
 	pipelinepkg.RegisterPipelineServiceServer(grpcServer, pipeline.NewPipelineServer())
 	sensorpkg.RegisterSensorServiceServer(grpcServer, sensor.NewSensorServer())
-	workflowpkg.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(instanceIDService, offloadNodeStatusRepo))
-	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService))
-	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService))
-	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive))
+	workflowpkg.RegisterWorkflowServiceServer(grpcServer, cluster.NewWorkflowServer(workflow.NewWorkflowServer(instanceIDService, offloadNodeStatusRepo, wfDefaults, wfSnapshots, as.clients.Workflow), localClusterName, clusters))
+	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService, as.clients.Workflow))
+	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService, as.clients.Workflow))
+	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive, artifactRepositories, as.clients.Workflow))
 	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService))
+	apitokenpkg.RegisterAPITokenServiceServer(grpcServer, apitoken.NewAPITokenServer(as.namespace, env.GetString("ARGO_SERVER_SERVICE_ACCOUNT", "argo-server")))
+	sharelinkpkg.RegisterShareLinkServiceServer(grpcServer, shareLinkServer)
+	pluginpkg.RegisterPluginServiceServer(grpcServer, plugin.NewPluginServer(as.namespace, as.clients.Kubernetes))
 	grpc_prometheus.Register(grpcServer)
 	return grpcServer
 }
 
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
 // using grpc-gateway as a proxy to the gRPC server.
-func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServer *artifacts.ArtifactServer) *http.Server {
+func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServer *artifacts.ArtifactServer, shareLinkServer *sharelink.ShareLinkServer) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 
 	mux := http.NewServeMux()
@@ -324,7 +408,7 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(json.JSONMarshaler))
 	gwmux := runtime.NewServeMux(gwMuxOpts,
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) { return key, true }),
-		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+		runtime.WithProtoErrorHandler(protoErrorHandler),
 	)
 	mustRegisterGWHandler(infopkg.RegisterInfoServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(eventpkg.RegisterEventServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
@@ -336,14 +420,29 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(cronworkflowpkg.RegisterCronWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowarchivepkg.RegisterArchivedWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(apitokenpkg.RegisterAPITokenServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(sharelinkpkg.RegisterShareLinkServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(pluginpkg.RegisterPluginServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 
-	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) { webhookInterceptor(w, r, gwmux) })
-	mux.HandleFunc("/artifacts/", artifactServer.GetOutputArtifact)
-	mux.HandleFunc("/input-artifacts/", artifactServer.GetInputArtifact)
-	mux.HandleFunc("/artifacts-by-uid/", artifactServer.GetOutputArtifactByUID)
-	mux.HandleFunc("/input-artifacts-by-uid/", artifactServer.GetInputArtifactByUID)
-	mux.Handle("/oauth2/redirect", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleRedirect)))
-	mux.Handle("/oauth2/callback", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleCallback)))
+	apiHandler := compressionHandler(eventStreamHandler(gwmux))
+	mux.Handle("/api/", metrics.InstrumentHandler("api", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { webhookInterceptor(w, r, apiHandler) })))
+	mux.Handle("/artifacts/", metrics.InstrumentHandler("artifacts", http.HandlerFunc(artifactServer.GetOutputArtifact)))
+	mux.Handle("/input-artifacts/", metrics.InstrumentHandler("input-artifacts", http.HandlerFunc(artifactServer.GetInputArtifact)))
+	mux.Handle("/artifacts-by-uid/", metrics.InstrumentHandler("artifacts-by-uid", http.HandlerFunc(artifactServer.GetOutputArtifactByUID)))
+	mux.Handle("/input-artifacts-by-uid/", metrics.InstrumentHandler("input-artifacts-by-uid", http.HandlerFunc(artifactServer.GetInputArtifactByUID)))
+	mux.Handle("/archived-workflow-artifacts/", metrics.InstrumentHandler("archived-workflow-artifacts", http.HandlerFunc(artifactServer.ListArtifactsByUID)))
+	if as.readOnly {
+		mux.Handle("/submit-with-artifacts/", metrics.InstrumentHandler("submit-with-artifacts", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "the Argo Server is read-only", http.StatusForbidden)
+		})))
+	} else {
+		mux.Handle("/submit-with-artifacts/", metrics.InstrumentHandler("submit-with-artifacts", http.HandlerFunc(artifactServer.SubmitWorkflow)))
+	}
+	mux.Handle("/shared/", metrics.InstrumentHandler("shared", http.HandlerFunc(shareLinkServer.ServeShared)))
+	mux.Handle("/oauth2/redirect", metrics.InstrumentHandler("oauth2-redirect", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleRedirect))))
+	mux.Handle("/oauth2/callback", metrics.InstrumentHandler("oauth2-callback", handlers.ProxyHeaders(http.HandlerFunc(as.oAuth2Service.HandleCallback))))
+	mux.Handle("/oauth2/device", metrics.InstrumentHandler("oauth2-device", http.HandlerFunc(as.oAuth2Service.HandleDeviceCode)))
+	mux.Handle("/oauth2/token", metrics.InstrumentHandler("oauth2-token", http.HandlerFunc(as.oAuth2Service.HandleToken)))
 	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
 		if os.Getenv("ARGO_SERVER_METRICS_AUTH") != "false" {
 			header := metadata.New(map[string]string{"authorization": r.Header.Get("Authorization")})
@@ -362,6 +461,14 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	return &httpServer
 }
 
+// protoErrorHandler adds a Retry-After header to rate limited responses
+func protoErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if delay, ok := ratelimit.RetryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(ratelimit.RetryAfterSeconds(delay)))
+	}
+	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
 type registerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
 // mustRegisterGWHandler is a convenience function to register a gateway handler
@@ -372,6 +479,15 @@ func mustRegisterGWHandler(register registerFunc, ctx context.Context, mux *runt
 	}
 }
 
+// localClusterName returns the name of the cluster the Argo Server runs in, which is the same as the name used for
+// its database records
+func localClusterName(persistence *config.PersistConfig) string {
+	if persistence == nil {
+		persistence = &config.PersistConfig{}
+	}
+	return persistence.GetClusterName()
+}
+
 // Unlike the controller, the server creates object based on the config map at init time, and will not pick-up on
 // changes unless we restart.
 // Instead of opting to re-write the server, instead we'll just listen for any old change and restart.
@@ -0,0 +1,145 @@
+package apiserver
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	log "github.com/sirupsen/logrus"
+)
+
+// the encodings we can compress responses with, most preferred first
+var contentEncodings = []string{"zstd", "gzip"}
+
+// encoder is implemented by both gzip.Writer and zstd.Encoder
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// creating an encoder allocates large buffers, so we re-use them between responses
+var encoderPools = map[string]*sync.Pool{
+	"gzip": {New: func() interface{} { return gzip.NewWriter(nil) }},
+	"zstd": {New: func() interface{} {
+		// a response is written by one goroutine, so there is nothing to gain from concurrent encoding
+		e, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return e
+	}},
+}
+
+// contentEncoding returns the encoding the client most prefers, as per its `Accept-Encoding` headers, or the empty
+// string if it accepts none of ours. If the client has no preference between encodings, we prefer zstd.
+func contentEncoding(acceptEncodings []string) string {
+	qualities := map[string]float64{}
+	for _, acceptEncoding := range acceptEncodings {
+		for _, coding := range strings.Split(acceptEncoding, ",") {
+			parts := strings.Split(coding, ";")
+			name := strings.ToLower(strings.TrimSpace(parts[0]))
+			quality := 1.0
+			for _, param := range parts[1:] {
+				if v := strings.TrimSpace(param); strings.HasPrefix(v, "q=") {
+					q, err := strconv.ParseFloat(strings.TrimPrefix(v, "q="), 64)
+					if err == nil {
+						quality = q
+					}
+				}
+			}
+			qualities[name] = quality
+		}
+	}
+	best, bestQuality := "", 0.0
+	for _, name := range contentEncodings {
+		quality, ok := qualities[name]
+		if !ok {
+			quality = qualities["*"]
+		}
+		if quality > bestQuality {
+			best, bestQuality = name, quality
+		}
+	}
+	return best
+}
+
+// compressionHandler compresses responses with zstd or gzip, if the client accepts them. Listing many workflows
+// returns a large, very repetitive, response, which compresses well. Streamed responses (watches and logs) are
+// compressed too, each message being flushed to the client as it is written.
+func compressionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		name := contentEncoding(r.Header.Values("Accept-Encoding"))
+		if name == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressResponseWriter{ResponseWriter: w, name: name}
+		defer func() {
+			if err := cw.close(); err != nil {
+				log.WithError(err).Debug("failed to close compressed response")
+			}
+		}()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+type compressResponseWriter struct {
+	http.ResponseWriter
+	name        string
+	wroteHeader bool
+	// nil if the response is not compressed
+	encoder encoder
+}
+
+func (w *compressResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	header := w.Header()
+	// responses without a body, or that are already encoded, are left alone
+	if statusCode >= http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotModified && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", w.name)
+		header.Del("Content-Length")
+		w.encoder = encoderPools[w.name].Get().(encoder)
+		w.encoder.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *compressResponseWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.encoder == nil {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.encoder.Write(data)
+}
+
+// Flush is needed for streamed responses
+func (w *compressResponseWriter) Flush() {
+	if w.encoder != nil {
+		if err := w.encoder.Flush(); err != nil {
+			log.WithError(err).Debug("failed to flush compressed response")
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *compressResponseWriter) close() error {
+	if w.encoder == nil {
+		return nil
+	}
+	err := w.encoder.Close()
+	// do not hold on to the response
+	w.encoder.Reset(nil)
+	encoderPools[w.name].Put(w.encoder)
+	w.encoder = nil
+	return err
+}
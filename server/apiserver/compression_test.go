@@ -0,0 +1,84 @@
+package apiserver
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_contentEncoding(t *testing.T) {
+	for acceptEncoding, want := range map[string]string{
+		"":                        "",
+		"identity":                "",
+		"br":                      "",
+		"gzip":                    "gzip",
+		"gzip, deflate, br":       "gzip",
+		"gzip, zstd":              "zstd",
+		"zstd;q=0.5, gzip":        "gzip",
+		"GZIP;q=0.8":              "gzip",
+		"*":                       "zstd",
+		"gzip;q=0, *":             "zstd",
+		"zstd;q=0, gzip;q=0":      "",
+		"*;q=0.5, gzip;q=invalid": "gzip",
+	} {
+		t.Run(acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, want, contentEncoding([]string{acceptEncoding}))
+		})
+	}
+}
+
+func Test_compressionHandler(t *testing.T) {
+	const body = `{"items":[{"metadata":{"name":"my-wf"}},{"metadata":{"name":"my-wf"}}]}`
+	statusCode := http.StatusOK
+	h := compressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body[:10]))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte(body[10:]))
+	}))
+	serve := func(acceptEncoding string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/api/v1/workflows/argo", nil)
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	t.Run("None", func(t *testing.T) {
+		w := serve("")
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.Equal(t, body, w.Body.String())
+	})
+	t.Run("Gzip", func(t *testing.T) {
+		w := serve("gzip")
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		r, err := gzip.NewReader(w.Body)
+		if assert.NoError(t, err) {
+			data, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, body, string(data))
+		}
+	})
+	t.Run("Zstd", func(t *testing.T) {
+		w := serve("zstd")
+		assert.Equal(t, "zstd", w.Header().Get("Content-Encoding"))
+		r, err := zstd.NewReader(w.Body)
+		if assert.NoError(t, err) {
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, body, string(data))
+		}
+	})
+	t.Run("NotModified", func(t *testing.T) {
+		statusCode = http.StatusNotModified
+		defer func() { statusCode = http.StatusOK }()
+		w := serve("gzip")
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+	})
+}
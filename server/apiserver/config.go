@@ -2,7 +2,11 @@ package apiserver
 
 import (
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+	"github.com/argoproj/argo-workflows/v3/server/cluster"
+	"github.com/argoproj/argo-workflows/v3/server/event"
+	"github.com/argoproj/argo-workflows/v3/server/ratelimit"
 )
 
 var emptyConfigFunc = func() interface{} { return &Config{} }
@@ -11,4 +15,12 @@ type Config struct {
 	config.Config
 	// SSO in settings for single-sign on
 	SSO sso.Config `json:"sso,omitempty"`
+	// Audit configures where the audit log of mutating API calls is written
+	Audit audit.Config `json:"audit,omitempty"`
+	// Clusters are other clusters whose workflows are listed, got, and have their logs shown, by the Argo Server
+	Clusters []cluster.Config `json:"clusters,omitempty"`
+	// FailedEvents configures what is done with events that could not be dispatched to a workflow event binding
+	FailedEvents event.FailedEventsConfig `json:"failedEvents,omitempty"`
+	// RateLimit limits how many API calls each identity may make in each namespace
+	RateLimit ratelimit.Config `json:"rateLimit,omitempty"`
 }
@@ -0,0 +1,31 @@
+package apiserver
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-workflows/v3/server/event"
+)
+
+// serverDiagnostics is the state of the server, for the diagnostics dump
+type serverDiagnostics struct {
+	// Caches are the number of objects in each cache, there are none unless SSO is enabled
+	Caches map[string]int `json:"caches"`
+	// Queues are the number of items waiting in each queue
+	Queues map[string]int `json:"queues"`
+}
+
+func (as *argoServer) diagnostics(eventServer *event.Controller) serverDiagnostics {
+	d := serverDiagnostics{
+		Caches: map[string]int{},
+		Queues: map[string]int{"event_operation_queue": eventServer.PendingOperations()},
+	}
+	if as.cache != nil {
+		if x, err := as.cache.ServiceAccountLister.List(labels.Everything()); err == nil {
+			d.Caches["serviceAccounts"] = len(x)
+		}
+		if x, err := as.cache.SecretLister.List(labels.Everything()); err == nil {
+			d.Caches["secrets"] = len(x)
+		}
+	}
+	return d
+}
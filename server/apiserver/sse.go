@@ -0,0 +1,41 @@
+package apiserver
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const eventStreamContentType = "text/event-stream"
+
+// isEventStreamRequest returns true if the client asked for Server-Sent Events, either by accepting
+// `text/event-stream` or, for clients that cannot set headers, with the `sse=true` query parameter.
+func isEventStreamRequest(r *http.Request) bool {
+	if r.URL.Query().Get("sse") == "true" {
+		return true
+	}
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+			if err == nil && mediaType == eventStreamContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// eventStreamHandler serves the Server-Sent Events variant of the streaming endpoints (watches and logs) to any client
+// that asks for it. The stream forwarder only recognises an exact `Accept: text/event-stream`, so we normalise the
+// header. We also ask proxies not to cache or buffer the response, otherwise events are not delivered until the
+// stream ends.
+func eventStreamHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && isEventStreamRequest(r) {
+			r.Header.Set("Accept", eventStreamContentType)
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("X-Accel-Buffering", "no")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
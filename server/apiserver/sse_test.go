@@ -0,0 +1,49 @@
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_eventStreamHandler(t *testing.T) {
+	var accept string
+	h := eventStreamHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+	}))
+	serve := func(method, target, acceptHeader string) http.Header {
+		accept = ""
+		r := httptest.NewRequest(method, target, nil)
+		if acceptHeader != "" {
+			r.Header.Set("Accept", acceptHeader)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Header()
+	}
+	t.Run("Accept", func(t *testing.T) {
+		header := serve("GET", "/api/v1/workflow-events/argo", "text/event-stream")
+		assert.Equal(t, "text/event-stream", accept)
+		assert.Equal(t, "no-cache", header.Get("Cache-Control"))
+		assert.Equal(t, "no", header.Get("X-Accel-Buffering"))
+	})
+	t.Run("AcceptList", func(t *testing.T) {
+		serve("GET", "/api/v1/workflow-events/argo", "application/json;q=0.9, text/event-stream; charset=utf-8")
+		assert.Equal(t, "text/event-stream", accept)
+	})
+	t.Run("QueryParameter", func(t *testing.T) {
+		serve("GET", "/api/v1/workflows/argo/my-wf/log?sse=true", "")
+		assert.Equal(t, "text/event-stream", accept)
+	})
+	t.Run("JSON", func(t *testing.T) {
+		header := serve("GET", "/api/v1/workflows/argo", "application/json")
+		assert.Equal(t, "application/json", accept)
+		assert.Empty(t, header.Get("X-Accel-Buffering"))
+	})
+	t.Run("NotGet", func(t *testing.T) {
+		serve("POST", "/api/v1/workflows/argo?sse=true", "")
+		assert.Empty(t, accept)
+	})
+}
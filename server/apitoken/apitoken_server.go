@@ -0,0 +1,110 @@
+package apitoken
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	apitokenpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/apitoken"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// The tokens are stored as secrets in the Argo Server's namespace. The caller's own Kubernetes client is used to
+// manage them, so only users who may create secrets in that namespace, and impersonate the token's service account,
+// can issue tokens.
+type apiTokenServer struct {
+	namespace string
+	// serviceAccount is the Argo Server's own service account, which tokens cannot be issued for
+	serviceAccount string
+}
+
+// NewAPITokenServer returns a server that manages API tokens in the namespace
+func NewAPITokenServer(namespace, serviceAccount string) apitokenpkg.APITokenServiceServer {
+	return &apiTokenServer{namespace: namespace, serviceAccount: serviceAccount}
+}
+
+func (s *apiTokenServer) CreateAPIToken(ctx context.Context, req *apitokenpkg.CreateAPITokenRequest) (*apitokenpkg.CreateAPITokenResponse, error) {
+	if errs := validation.IsDNS1123Label(req.Name); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name %q: %s", req.Name, strings.Join(errs, ", "))
+	}
+	if req.ServiceAccountName == "" {
+		return nil, status.Error(codes.InvalidArgument, "serviceAccountName is required")
+	}
+	var expiresAt *metav1.Time
+	if req.ExpiresIn != "" {
+		expiresIn, err := time.ParseDuration(req.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expiresIn %q: must be a positive duration, e.g. \"720h\"", req.ExpiresIn)
+		}
+		expiresAt = &metav1.Time{Time: time.Now().Add(expiresIn).Truncate(time.Second)}
+	}
+	if req.ServiceAccountName == s.serviceAccount {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot create API tokens for the Argo Server's service account %q", req.ServiceAccountName)
+	}
+	// otherwise anyone who can create the secret could act as any service account in the namespace
+	allowed, err := auth.CanIImpersonateServiceAccount(ctx, s.namespace, req.ServiceAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot create API tokens for service account %q, as you cannot impersonate it", req.ServiceAccountName)
+	}
+	kubeClient := auth.GetKubeClient(ctx)
+	if _, err := kubeClient.CoreV1().ServiceAccounts(s.namespace).Get(ctx, req.ServiceAccountName, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	token, secret, err := apitoken.New(req.Name, s.namespace, req.ServiceAccountName, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	secret, err = kubeClient.CoreV1().Secrets(s.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	apiToken, err := apitoken.FromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &apitokenpkg.CreateAPITokenResponse{ApiToken: apiToken, Token: token}, nil
+}
+
+func (s *apiTokenServer) ListAPITokens(ctx context.Context, _ *apitokenpkg.ListAPITokensRequest) (*apitokenpkg.APITokenList, error) {
+	list, err := auth.GetKubeClient(ctx).CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyAPIToken})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*apitokenpkg.APIToken, 0, len(list.Items))
+	for i := range list.Items {
+		apiToken, err := apitoken.FromSecret(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, apiToken)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return &apitokenpkg.APITokenList{Items: items}, nil
+}
+
+func (s *apiTokenServer) DeleteAPIToken(ctx context.Context, req *apitokenpkg.DeleteAPITokenRequest) (*apitokenpkg.DeleteAPITokenResponse, error) {
+	secrets := auth.GetKubeClient(ctx).CoreV1().Secrets(s.namespace)
+	secret, err := secrets.Get(ctx, apitoken.SecretName(req.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// do not allow other secrets to be deleted
+	if secret.Labels[common.LabelKeyAPIToken] != req.Name {
+		return nil, status.Errorf(codes.NotFound, "API token %q not found", req.Name)
+	}
+	if err := secrets.Delete(ctx, secret.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &secret.UID}}); err != nil {
+		return nil, err
+	}
+	return &apitokenpkg.DeleteAPITokenResponse{}, nil
+}
@@ -0,0 +1,94 @@
+package apitoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+
+	apitokenpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/apitoken"
+)
+
+func TestAPITokenServer(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "argo"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "other-sa", Namespace: "argo"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "argo-server", Namespace: "argo"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "argo-api-token-not-a-token", Namespace: "argo"}},
+	)
+	// the caller can impersonate every service account but other-sa
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+		review := action.(ktesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = attrs.Verb == "impersonate" && attrs.Resource == "serviceaccounts" && attrs.Namespace == "argo" && attrs.Name != "other-sa"
+		return true, review, nil
+	})
+	ctx := context.WithValue(context.Background(), auth.KubeKey, kubeClient)
+	s := NewAPITokenServer("argo", "argo-server")
+	t.Run("CreateAPIToken", func(t *testing.T) {
+		t.Run("InvalidName", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "My_Token", ServiceAccountName: "my-sa"})
+			assert.Error(t, err)
+		})
+		t.Run("NoServiceAccount", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token"})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = serviceAccountName is required")
+		})
+		t.Run("ServiceAccountNotFound", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token", ServiceAccountName: "not-found"})
+			assert.Error(t, err)
+		})
+		t.Run("ServerServiceAccount", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token", ServiceAccountName: "argo-server"})
+			assert.EqualError(t, err, `rpc error: code = PermissionDenied desc = cannot create API tokens for the Argo Server's service account "argo-server"`)
+		})
+		t.Run("CannotImpersonate", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token", ServiceAccountName: "other-sa"})
+			assert.EqualError(t, err, `rpc error: code = PermissionDenied desc = cannot create API tokens for service account "other-sa", as you cannot impersonate it`)
+		})
+		t.Run("InvalidExpiresIn", func(t *testing.T) {
+			_, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token", ServiceAccountName: "my-sa", ExpiresIn: "-1h"})
+			assert.Error(t, err)
+		})
+		t.Run("Valid", func(t *testing.T) {
+			resp, err := s.CreateAPIToken(ctx, &apitokenpkg.CreateAPITokenRequest{Name: "my-token", ServiceAccountName: "my-sa", ExpiresIn: "720h"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-token", resp.ApiToken.Name)
+				assert.Equal(t, "my-sa", resp.ApiToken.ServiceAccountName)
+				assert.NotNil(t, resp.ApiToken.ExpiresAt)
+				secret, err := kubeClient.CoreV1().Secrets("argo").Get(ctx, "argo-api-token-my-token", metav1.GetOptions{})
+				if assert.NoError(t, err) {
+					assert.NoError(t, apitoken.Verify(secret, resp.Token, resp.ApiToken.CreationTimestamp.Time))
+				}
+			}
+		})
+	})
+	t.Run("ListAPITokens", func(t *testing.T) {
+		list, err := s.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "my-token", list.Items[0].Name)
+		}
+	})
+	t.Run("DeleteAPIToken", func(t *testing.T) {
+		t.Run("NotAToken", func(t *testing.T) {
+			_, err := s.DeleteAPIToken(ctx, &apitokenpkg.DeleteAPITokenRequest{Name: "not-a-token"})
+			assert.EqualError(t, err, `rpc error: code = NotFound desc = API token "not-a-token" not found`)
+		})
+		t.Run("Valid", func(t *testing.T) {
+			_, err := s.DeleteAPIToken(ctx, &apitokenpkg.DeleteAPITokenRequest{Name: "my-token"})
+			assert.NoError(t, err)
+			list, err := s.ListAPITokens(ctx, &apitokenpkg.ListAPITokensRequest{})
+			if assert.NoError(t, err) {
+				assert.Empty(t, list.Items)
+			}
+		})
+	})
+}
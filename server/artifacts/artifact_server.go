@@ -2,9 +2,11 @@ package artifacts
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"path"
@@ -16,16 +18,23 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
@@ -36,14 +45,21 @@ type ArtifactServer struct {
 	instanceIDService    instanceid.Service
 	artDriverFactory     artifact.NewDriverFunc
 	artifactRepositories artifactrepositories.Interface
+	// serverWfClient is the server's own workflow clientset, which evaluates workflow template grants, and gets the
+	// workflow templates they grant, as callers need not be able to read the namespaces that grant them
+	serverWfClient versioned.Interface
+	// auditSinks record the workflows submitted with artifacts, as the gRPC interceptor does not see them
+	auditSinks []audit.Sink
+	// signedURLExpiry is how long the signed URLs that downloads are redirected to are valid for, zero disables them
+	signedURLExpiry time.Duration
 }
 
-func NewArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artifactRepositories artifactrepositories.Interface) *ArtifactServer {
-	return newArtifactServer(authN, hydrator, wfArchive, instanceIDService, artifact.NewDriver, artifactRepositories)
+func NewArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artifactRepositories artifactrepositories.Interface, serverWfClient versioned.Interface, auditSinks []audit.Sink) *ArtifactServer {
+	return newArtifactServer(authN, hydrator, wfArchive, instanceIDService, artifact.NewDriver, artifactRepositories, serverWfClient, auditSinks, env.LookupEnvDurationOr("ARGO_SERVER_ARTIFACT_SIGNED_URL_EXPIRY", 0))
 }
 
-func newArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artDriverFactory artifact.NewDriverFunc, artifactRepositories artifactrepositories.Interface) *ArtifactServer {
-	return &ArtifactServer{authN, hydrator, wfArchive, instanceIDService, artDriverFactory, artifactRepositories}
+func newArtifactServer(authN auth.Gatekeeper, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, artDriverFactory artifact.NewDriverFunc, artifactRepositories artifactrepositories.Interface, serverWfClient versioned.Interface, auditSinks []audit.Sink, signedURLExpiry time.Duration) *ArtifactServer {
+	return &ArtifactServer{authN, hydrator, wfArchive, instanceIDService, artDriverFactory, artifactRepositories, serverWfClient, auditSinks, signedURLExpiry}
 }
 
 func (a *ArtifactServer) GetOutputArtifact(w http.ResponseWriter, r *http.Request) {
@@ -105,28 +121,42 @@ func (a *ArtifactServer) getArtifactByUID(w http.ResponseWriter, r *http.Request
 	nodeId := requestPath[3]
 	artifactName := requestPath[4]
 
-	// We need to know the namespace before we can do gate keeping
-	wf, err := a.wfArchive.GetWorkflow(uid)
+	// We need to know the namespace before we can do gate keeping. The artifacts are indexed, so the workflow need
+	// not be got, unless the artifact could not be relocated when it was archived.
+	artifacts, err := a.wfArchive.ListWorkflowArtifacts(uid, nodeId)
 	if err != nil {
 		a.serverInternalError(err, w)
 		return
 	}
+	if artifacts == nil {
+		a.serverInternalError(fmt.Errorf("archived workflow %q not found", uid), w)
+		return
+	}
 
-	ctx, err := a.gateKeeping(r, types.NamespaceHolder(wf.GetNamespace()))
+	ctx, err := a.gateKeeping(r, types.NamespaceHolder(artifacts.Namespace))
 	if err != nil {
 		a.unauthorizedError(err, w)
 		return
 	}
 
 	// return 401 if the client does not have permission to get wf
-	err = a.validateAccess(ctx, wf)
+	err = a.validateAccess(ctx, artifacts.Namespace, artifacts.Name)
 	if err != nil {
 		a.unauthorizedError(err, w)
 		return
 	}
 
 	log.WithFields(log.Fields{"uid": uid, "nodeId": nodeId, "artifactName": artifactName, "isInput": isInput}).Info("Download artifact")
-	err = a.returnArtifact(ctx, w, r, wf, nodeId, artifactName, isInput)
+	art := artifacts.Get(nodeId, artifactName, isInput)
+	if art != nil && art.HasLocation() {
+		err = a.serveArtifact(ctx, w, r, artifacts.Namespace, art)
+	} else {
+		var wf *wfv1.Workflow
+		wf, err = a.wfArchive.GetWorkflow(uid)
+		if err == nil {
+			err = a.returnArtifact(ctx, w, r, wf, nodeId, artifactName, isInput)
+		}
+	}
 
 	if err != nil {
 		a.serverInternalError(err, w)
@@ -134,6 +164,55 @@ func (a *ArtifactServer) getArtifactByUID(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// ListArtifactsByUID responds with the artifacts of the nodes of the archived workflow, from its index of them
+func (a *ArtifactServer) ListArtifactsByUID(w http.ResponseWriter, r *http.Request) {
+	requestPath := strings.SplitN(r.URL.Path, "/", 3)
+	if len(requestPath) != 3 || requestPath[2] == "" {
+		a.serverInternalError(errors.New("request path is not valid"), w)
+		return
+	}
+	uid := requestPath[2]
+
+	artifacts, err := a.wfArchive.ListWorkflowArtifacts(uid, "")
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+	if artifacts == nil {
+		a.serverInternalError(fmt.Errorf("archived workflow %q not found", uid), w)
+		return
+	}
+
+	ctx, err := a.gateKeeping(r, types.NamespaceHolder(artifacts.Namespace))
+	if err != nil {
+		a.unauthorizedError(err, w)
+		return
+	}
+
+	err = a.validateAccess(ctx, artifacts.Namespace, artifacts.Name)
+	if err != nil {
+		a.unauthorizedError(err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(artifacts)
+}
+
+// ServeSharedArtifact serves an artifact of a share link, the link has already been verified, so no-one is authorized.
+// The workflow must have the UID the link was minted for, so that a link does not share a later workflow of the same
+// name.
+func (a *ArtifactServer) ServeSharedArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, workflowName string, uid k8stypes.UID, nodeId, artifactName string, isInput bool) error {
+	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
+	if err != nil {
+		return err
+	}
+	if wf.UID != uid {
+		return fmt.Errorf("workflow %q not found", workflowName)
+	}
+	return a.returnArtifact(ctx, w, r, wf, nodeId, artifactName, isInput)
+}
+
 func (a *ArtifactServer) gateKeeping(r *http.Request, ns types.NamespacedRequest) (context.Context, error) {
 	token := r.Header.Get("Authorization")
 	if token == "" {
@@ -146,7 +225,11 @@ func (a *ArtifactServer) gateKeeping(r *http.Request, ns types.NamespacedRequest
 			token = cookie.Value
 		}
 	}
-	ctx := metadata.NewIncomingContext(r.Context(), metadata.MD{"authorization": []string{token}})
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.MD{"authorization": []string{token}, "x-forwarded-for": r.Header.Values("X-Forwarded-For")})
+	// as for gRPC calls, so the audit log has the client's address
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
 	return a.gatekeeper.ContextWithRequest(ctx, ns)
 }
 
@@ -161,8 +244,6 @@ func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
 }
 
 func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactName string, isInput bool) error {
-	kubeClient := auth.GetKubeClient(ctx)
-
 	var art *wfv1.Artifact
 	if isInput {
 		art = wf.Status.Nodes[nodeId].Inputs.GetArtifactByName(artifactName)
@@ -183,10 +264,26 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 		return err
 	}
 
-	driver, err := a.artDriverFactory(ctx, art, resources{kubeClient, wf.Namespace})
+	return a.serveArtifact(ctx, w, r, wf.Namespace, art)
+}
+
+// serveArtifact serves the artifact, which must have been relocated to its repository
+func (a *ArtifactServer) serveArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace string, art *wfv1.Artifact) error {
+	kubeClient := auth.GetKubeClient(ctx)
+
+	driver, err := a.artDriverFactory(ctx, art, resource.New(kubeClient, namespace))
 	if err != nil {
 		return err
 	}
+	// redirect to the storage, if we can, so the download does not go through the server
+	if signer, ok := driver.(artifactscommon.ArtifactURLSigner); ok && a.signedURLExpiry > 0 {
+		signedURL, err := signer.SignedURL(art, a.signedURLExpiry)
+		if err != nil {
+			return err
+		}
+		http.Redirect(w, r, signedURL, http.StatusFound)
+		return nil
+	}
 	tmp, err := ioutil.TempFile("/tmp", "artifact")
 	if err != nil {
 		return err
@@ -220,8 +317,8 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 
 	key, _ := art.GetKey()
 	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
-	w.WriteHeader(200)
 
+	// this writes the status, so range requests, e.g. to resume a download, are partial content
 	http.ServeContent(w, r, "", time.Time{}, file)
 
 	return nil
@@ -244,8 +341,8 @@ func (a *ArtifactServer) getWorkflowAndValidate(ctx context.Context, namespace s
 	return wf, nil
 }
 
-func (a *ArtifactServer) validateAccess(ctx context.Context, wf *wfv1.Workflow) error {
-	allowed, err := auth.CanI(ctx, "get", "workflows", wf.Namespace, wf.Name)
+func (a *ArtifactServer) validateAccess(ctx context.Context, namespace, name string) error {
+	allowed, err := auth.CanI(ctx, "get", "workflows", namespace, name)
 	if err != nil {
 		return err
 	}
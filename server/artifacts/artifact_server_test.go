@@ -7,13 +7,18 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	testhttp "github.com/stretchr/testify/http"
 	"github.com/stretchr/testify/mock"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfv1 "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -48,12 +53,17 @@ func (a *fakeArtifactDriver) Save(_ string, _ *wfv1.Artifact) error {
 	return fmt.Errorf("not implemented")
 }
 
+func (a *fakeArtifactDriver) SignedURL(artifact *wfv1.Artifact, expires time.Duration) (string, error) {
+	key, _ := artifact.GetKey()
+	return fmt.Sprintf("https://my-storage/%s?expires=%v", key, expires), nil
+}
+
 func newServer() *ArtifactServer {
 	gatekeeper := &authmocks.Gatekeeper{}
 	kube := kubefake.NewSimpleClientset()
 	instanceId := "my-instanceid"
 	wf := &wfv1.Workflow{
-		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", Labels: map[string]string{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid", Labels: map[string]string{
 			common.LabelKeyControllerInstanceID: instanceId,
 		}},
 		Status: wfv1.WorkflowStatus{
@@ -119,8 +129,29 @@ func newServer() *ArtifactServer {
 	})
 	ctx := context.WithValue(context.WithValue(context.Background(), auth.KubeKey, kube), auth.WfKey, argo)
 	gatekeeper.On("ContextWithRequest", mock.Anything, mock.Anything).Return(ctx, nil)
+	// only the workflows in "my-archived-ns" may be got
+	kube.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "my-archived-ns"
+		return true, review, nil
+	})
 	a := &sqldbmocks.WorkflowArchive{}
 	a.On("GetWorkflow", "my-uuid").Return(wf, nil)
+	a.On("ListWorkflowArtifacts", "my-uuid", "my-node").Return(&sqldb.ArchivedArtifacts{Namespace: "my-ns", Name: "my-wf"}, nil)
+	archivedArtifacts := &sqldb.ArchivedArtifacts{Namespace: "my-archived-ns", Name: "my-archived-wf", Items: []sqldb.ArchivedArtifact{
+		{NodeID: "my-node", Key: "my-wf/my-node/my-s3-artifact.tgz", Artifact: wfv1.Artifact{
+			Name: "my-s3-artifact",
+			ArtifactLocation: wfv1.ArtifactLocation{
+				S3: &wfv1.S3Artifact{
+					S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"},
+					Key:      "my-wf/my-node/my-s3-artifact.tgz",
+				},
+			},
+		}},
+	}}
+	a.On("ListWorkflowArtifacts", "my-archived-uid", "my-node").Return(archivedArtifacts, nil)
+	a.On("ListWorkflowArtifacts", "my-archived-uid", "").Return(archivedArtifacts, nil)
+	a.On("ListWorkflowArtifacts", "other-uid", "").Return(nil, nil)
 
 	fakeArtifactDriverFactory := func(_ context.Context, _ *wfv1.Artifact, _ resource.Interface) (artifactscommon.ArtifactDriver, error) {
 		return &fakeArtifactDriver{data: []byte("my-data")}, nil
@@ -135,7 +166,7 @@ func newServer() *ArtifactServer {
 		},
 	})
 
-	return newArtifactServer(gatekeeper, hydratorfake.Noop, a, instanceid.NewService(instanceId), fakeArtifactDriverFactory, artifactRepositories)
+	return newArtifactServer(gatekeeper, hydratorfake.Noop, a, instanceid.NewService(instanceId), fakeArtifactDriverFactory, artifactRepositories, nil, nil, 0)
 }
 
 func TestArtifactServer_GetOutputArtifact(t *testing.T) {
@@ -173,6 +204,18 @@ func TestArtifactServer_GetOutputArtifact(t *testing.T) {
 	}
 }
 
+func TestArtifactServer_GetOutputArtifactSignedURL(t *testing.T) {
+	s := newServer()
+	s.signedURLExpiry = time.Hour
+	r := &http.Request{}
+	r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-gcs-artifact")
+	w := &testhttp.TestResponseWriter{}
+	s.GetOutputArtifact(w, r)
+	if assert.Equal(t, 302, w.StatusCode) {
+		assert.Equal(t, "https://my-storage/my-wf/my-node/my-gcs-artifact?expires=1h0m0s", w.Header().Get("Location"))
+	}
+}
+
 func TestArtifactServer_GetInputArtifact(t *testing.T) {
 	s := newServer()
 
@@ -232,6 +275,65 @@ func TestArtifactServer_GetOutputArtifactByUID(t *testing.T) {
 	assert.Equal(t, 401, w.StatusCode)
 }
 
+func TestArtifactServer_GetOutputArtifactByUIDFromIndex(t *testing.T) {
+	s := newServer()
+	r := &http.Request{}
+	r.URL = mustParse("/artifacts-by-uid/my-archived-uid/my-node/my-s3-artifact")
+	w := &testhttp.TestResponseWriter{}
+	s.GetOutputArtifactByUID(w, r)
+	if assert.Equal(t, 200, w.StatusCode) {
+		assert.Equal(t, "my-data", w.Output)
+	}
+}
+
+func TestArtifactServer_ListArtifactsByUID(t *testing.T) {
+	s := newServer()
+	t.Run("Found", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/archived-workflow-artifacts/my-archived-uid")
+		w := &testhttp.TestResponseWriter{}
+		s.ListArtifactsByUID(w, r)
+		if assert.Equal(t, 200, w.StatusCode) {
+			assert.Contains(t, w.Output, `"name":"my-archived-wf"`)
+			assert.Contains(t, w.Output, `"key":"my-wf/my-node/my-s3-artifact.tgz"`)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/archived-workflow-artifacts/other-uid")
+		w := &testhttp.TestResponseWriter{}
+		s.ListArtifactsByUID(w, r)
+		assert.Equal(t, 500, w.StatusCode)
+		assert.Equal(t, `archived workflow "other-uid" not found`, w.Output)
+	})
+	t.Run("InvalidRequestPath", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/archived-workflow-artifacts/")
+		w := &testhttp.TestResponseWriter{}
+		s.ListArtifactsByUID(w, r)
+		assert.Equal(t, 500, w.StatusCode)
+	})
+}
+
+func TestArtifactServer_ServeSharedArtifact(t *testing.T) {
+	s := newServer()
+	ctx, _ := s.gatekeeper.ContextWithRequest(context.Background(), nil)
+	r := &http.Request{}
+	r.URL = mustParse("/shared/my-token")
+	t.Run("Found", func(t *testing.T) {
+		w := &testhttp.TestResponseWriter{}
+		err := s.ServeSharedArtifact(ctx, w, r, "my-ns", "my-wf", "my-uid", "my-node", "my-s3-artifact", false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 200, w.StatusCode)
+			assert.Equal(t, "my-data", w.Output)
+		}
+	})
+	t.Run("OtherUID", func(t *testing.T) {
+		err := s.ServeSharedArtifact(ctx, &testhttp.TestResponseWriter{}, r, "my-ns", "my-wf", "other-uid", "my-node", "my-s3-artifact", false)
+		assert.EqualError(t, err, `workflow "my-wf" not found`)
+	})
+}
+
 func TestArtifactServer_GetArtifactByUIDInvalidRequestPath(t *testing.T) {
 	s := newServer()
 	r := &http.Request{}
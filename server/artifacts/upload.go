@@ -0,0 +1,303 @@
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/yaml"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/types"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// uploaded files up to this size are kept in memory, larger files are written to temporary files
+const maxUploadMemory = 32 << 20
+
+// submitWorkflowMethod is the method of the audit log entries of submitted workflows
+const submitWorkflowMethod = "POST /submit-with-artifacts"
+
+// SubmitWorkflow submits a workflow with files, uploaded to the artifact repository, as its artifact arguments. The
+// request is a multipart form with either:
+//
+// * "workflow" - the workflow, as YAML or JSON
+// * "resourceKind" and "resourceName" - the cron workflow, workflow template or cluster workflow template to submit
+//
+// As well as optional "submitOptions", as JSON, and a file for each artifact argument, named after the argument.
+//
+// A browser sends the authorization cookie with a form posted from any site, so a request authenticated by the cookie
+// must also have the "X-Requested-With" header, which other sites cannot add.
+func (a *ArtifactServer) SubmitWorkflow(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if _, err := r.Cookie("authorization"); err == nil && r.Header.Get("Authorization") == "" && r.Header.Get("X-Requested-With") == "" {
+		w.WriteHeader(403)
+		_, _ = w.Write([]byte("the X-Requested-With header is required"))
+		return
+	}
+	requestPath := strings.Split(r.URL.Path, "/")
+	if len(requestPath) != 3 || requestPath[2] == "" {
+		a.badRequestError(fmt.Errorf("request path is not valid"), w)
+		return
+	}
+	namespace := requestPath[2]
+
+	ctx, err := a.gateKeeping(r, types.NamespaceHolder(namespace))
+	if err != nil {
+		a.unauthorizedError(err, w)
+		return
+	}
+	if auth.IsReadOnly(ctx) {
+		w.WriteHeader(403)
+		_, _ = w.Write([]byte("you only have read-only access"))
+		return
+	}
+
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		a.badRequestError(err, w)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	wf, err := a.submitWorkflow(ctx, namespace, r.MultipartForm)
+	audit.Write(ctx, a.auditSinks, submitWorkflowMethod, newSubmitWorkflowRequest(namespace, r.MultipartForm), err)
+	if err != nil {
+		a.submitError(err, w)
+		return
+	}
+	data, err := json.Marshal(wf)
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, _ = w.Write(data)
+}
+
+// submitWorkflowRequest is the request of the audit log entry, i.e. the form, without the contents of the files
+type submitWorkflowRequest struct {
+	Namespace     string `json:"namespace"`
+	Workflow      string `json:"workflow,omitempty"`
+	ResourceKind  string `json:"resourceKind,omitempty"`
+	ResourceName  string `json:"resourceName,omitempty"`
+	SubmitOptions string `json:"submitOptions,omitempty"`
+	// Files are the names of the uploaded files, by artifact
+	Files map[string]string `json:"files,omitempty"`
+}
+
+func newSubmitWorkflowRequest(namespace string, form *multipart.Form) submitWorkflowRequest {
+	req := submitWorkflowRequest{
+		Namespace:     namespace,
+		Workflow:      formValue(form, "workflow"),
+		ResourceKind:  formValue(form, "resourceKind"),
+		ResourceName:  formValue(form, "resourceName"),
+		SubmitOptions: formValue(form, "submitOptions"),
+	}
+	for name, headers := range form.File {
+		if len(headers) > 0 {
+			if req.Files == nil {
+				req.Files = map[string]string{}
+			}
+			req.Files[name] = headers[0].Filename
+		}
+	}
+	return req
+}
+
+func (r submitWorkflowRequest) GetNamespace() string {
+	return r.Namespace
+}
+
+func (r submitWorkflowRequest) GetName() string {
+	return r.ResourceName
+}
+
+func (a *ArtifactServer) submitWorkflow(ctx context.Context, namespace string, form *multipart.Form) (_ *wfv1.Workflow, err error) {
+	var uploaded []wfv1.Artifact
+	defer func() {
+		// nothing refers to the files of a workflow that was not submitted
+		if err != nil {
+			a.deleteUploaded(ctx, namespace, uploaded)
+		}
+	}()
+	wfClient := auth.GetWfClient(ctx)
+	var wf *wfv1.Workflow
+	if manifest := formValue(form, "workflow"); manifest != "" {
+		wf = &wfv1.Workflow{}
+		if err := yaml.UnmarshalStrict([]byte(manifest), wf); err != nil {
+			return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "workflow is invalid: %v", err)
+		}
+	} else if kind := formValue(form, "resourceKind"); kind != "" {
+		var err error
+		wf, err = util.NewWorkflowFromResource(ctx, wfClient, namespace, kind, formValue(form, "resourceName"))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, argoerrs.New(argoerrs.CodeBadRequest, "workflow or resourceKind must be specified")
+	}
+	wf.Namespace = namespace
+
+	opts := &wfv1.SubmitOpts{}
+	if v := formValue(form, "submitOptions"); v != "" {
+		if err := json.Unmarshal([]byte(v), opts); err != nil {
+			return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "submitOptions is invalid: %v", err)
+		}
+	}
+
+	a.instanceIDService.Label(wf)
+	creator.Label(ctx, wf)
+
+	if len(form.File) > 0 {
+		ref, err := a.artifactRepositories.Resolve(ctx, wf.Spec.ArtifactRepositoryRef, namespace)
+		if err != nil {
+			return nil, err
+		}
+		ar, err := a.artifactRepositories.Get(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		if ar == nil {
+			return nil, argoerrs.New(argoerrs.CodeBadRequest, "there is no artifact repository to upload the files to")
+		}
+		names := make([]string, 0, len(form.File))
+		for name := range form.File {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if len(form.File[name]) != 1 {
+				return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "exactly one file must be uploaded for artifact %q", name)
+			}
+			art, err := a.uploadArtifact(ctx, namespace, name, ar, form.File[name][0])
+			if err != nil {
+				return nil, fmt.Errorf("failed to upload artifact %q: %w", name, err)
+			}
+			uploaded = append(uploaded, *art)
+			wf.Spec.Arguments.Artifacts = setArtifact(wf.Spec.Arguments.Artifacts, *art)
+		}
+	}
+	return util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(namespace), wfClient, a.serverWfClient, namespace, wf, opts)
+}
+
+// uploadArtifact saves the file to a unique key in the artifact repository, and returns it as an artifact
+func (a *ArtifactServer) uploadArtifact(ctx context.Context, namespace, name string, ar *wfv1.ArtifactRepository, header *multipart.FileHeader) (*wfv1.Artifact, error) {
+	art := &wfv1.Artifact{Name: name, ArtifactLocation: *ar.ToArtifactLocation()}
+	art.ArchiveLogs = nil
+	filename := path.Base(header.Filename)
+	if filename == "." || filename == "/" {
+		filename = name
+	}
+	if err := art.SetKey(path.Join("uploads", namespace, string(uuid.NewUUID()), filename)); err != nil {
+		return nil, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	// drivers can only save files, and small uploads are only in memory
+	tmp, err := ioutil.TempFile("", "upload")
+	if err != nil {
+		return nil, err
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+	_, err = io.Copy(tmp, file)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := a.artDriverFactory(ctx, art, resource.New(auth.GetKubeClient(ctx), namespace))
+	if err != nil {
+		return nil, err
+	}
+	if err := driver.Save(tmpPath, art); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"namespace": namespace, "artifactName": name, "size": header.Size}).Info("Uploaded artifact")
+	return art, nil
+}
+
+// deleteUploaded deletes the uploaded artifacts, if their drivers can delete artifacts
+func (a *ArtifactServer) deleteUploaded(ctx context.Context, namespace string, arts []wfv1.Artifact) {
+	for i := range arts {
+		art := &arts[i]
+		logCtx := log.WithFields(log.Fields{"namespace": namespace, "artifactName": art.Name})
+		driver, err := a.artDriverFactory(ctx, art, resource.New(auth.GetKubeClient(ctx), namespace))
+		if err != nil {
+			logCtx.WithError(err).Warn("Failed to delete uploaded artifact")
+			continue
+		}
+		deleter, ok := driver.(artifactscommon.ArtifactDeleter)
+		if !ok {
+			logCtx.Warn("Cannot delete uploaded artifact, as its driver cannot delete artifacts")
+			continue
+		}
+		if err := deleter.Delete(art); err != nil {
+			logCtx.WithError(err).Warn("Failed to delete uploaded artifact")
+			continue
+		}
+		logCtx.Info("Deleted uploaded artifact of workflow that was not submitted")
+	}
+}
+
+// setArtifact replaces the artifact with the same name, or adds it if there is none
+func setArtifact(arts wfv1.Artifacts, art wfv1.Artifact) wfv1.Artifacts {
+	for i := range arts {
+		if arts[i].Name == art.Name {
+			arts[i] = art
+			return arts
+		}
+	}
+	return append(arts, art)
+}
+
+func formValue(form *multipart.Form, key string) string {
+	if values := form.Value[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (a *ArtifactServer) badRequestError(err error, w http.ResponseWriter) {
+	w.WriteHeader(400)
+	_, _ = w.Write([]byte(err.Error()))
+}
+
+// submitError writes the error with the status code of the error, so validation errors are bad requests
+func (a *ArtifactServer) submitError(err error, w http.ResponseWriter) {
+	code := 500
+	if argoErr, ok := err.(argoerrs.ArgoError); ok && argoErr.Code() == argoerrs.CodeBadRequest {
+		code = 400
+	} else if statusErr, ok := err.(apierr.APIStatus); ok {
+		code = int(statusErr.Status().Code)
+	}
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,168 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const uploadWorkflow = `
+metadata:
+  generateName: my-wf-
+spec:
+  entrypoint: main
+  arguments:
+    artifacts:
+      - name: my-csv
+  templates:
+    - name: main
+      inputs:
+        artifacts:
+          - name: my-csv
+            path: /tmp/my.csv
+      container:
+        image: argoproj/argosay:v2
+`
+
+type fakeUploadDriver struct {
+	artifactscommon.ArtifactDriver
+	saved map[string]string
+}
+
+func (d *fakeUploadDriver) Delete(art *wfv1.Artifact) error {
+	delete(d.saved, art.S3.Key)
+	return nil
+}
+
+type fakeAuditSink struct {
+	entries []audit.Entry
+}
+
+func (s *fakeAuditSink) Write(_ context.Context, entry audit.Entry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (d *fakeUploadDriver) Save(path string, art *wfv1.Artifact) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d.saved[art.S3.Key] = string(data)
+	return nil
+}
+
+func newUploadRequest(t *testing.T, target string, values map[string]string, files map[string]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range values {
+		assert.NoError(t, writer.WriteField(k, v))
+	}
+	for name, data := range files {
+		part, err := writer.CreateFormFile(name, "my.csv")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte(data))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+	r := httptest.NewRequest("POST", target, body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return r
+}
+
+func TestArtifactServer_SubmitWorkflow(t *testing.T) {
+	s := newServer()
+	driver := &fakeUploadDriver{saved: map[string]string{}}
+	s.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	}
+	sink := &fakeAuditSink{}
+	s.auditSinks = []audit.Sink{sink}
+	t.Run("Workflow", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"workflow": uploadWorkflow}, map[string]string{"my-csv": "a,b\n1,2\n"}))
+		if assert.Equal(t, 200, w.Code, w.Body.String()) {
+			wf := &wfv1.Workflow{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), wf))
+			assert.Equal(t, "my-ns", wf.Namespace)
+			assert.Contains(t, wf.Labels, common.LabelKeyControllerInstanceID)
+			art := wf.Spec.Arguments.GetArtifactByName("my-csv")
+			if assert.NotNil(t, art) && assert.NotNil(t, art.S3) {
+				assert.Equal(t, "my-bucket", art.S3.Bucket)
+				assert.True(t, strings.HasPrefix(art.S3.Key, "uploads/my-ns/"))
+				assert.True(t, strings.HasSuffix(art.S3.Key, "/my.csv"))
+				assert.Equal(t, "a,b\n1,2\n", driver.saved[art.S3.Key])
+			}
+		}
+		if assert.NotEmpty(t, sink.entries) {
+			entry := sink.entries[len(sink.entries)-1]
+			assert.Equal(t, "POST /submit-with-artifacts", entry.Method)
+			assert.Equal(t, "my-ns", entry.Namespace)
+			assert.Equal(t, "OK", entry.Outcome)
+			assert.Contains(t, string(entry.Request), `"files":{"my-csv":"my.csv"}`)
+		}
+	})
+	t.Run("NotSubmitted", func(t *testing.T) {
+		driver.saved = map[string]string{}
+		w := httptest.NewRecorder()
+		invalid := strings.Replace(uploadWorkflow, "entrypoint: main", "entrypoint: not-found", 1)
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"workflow": invalid}, map[string]string{"my-csv": "a,b\n1,2\n"}))
+		assert.Equal(t, 400, w.Code)
+		assert.Empty(t, driver.saved)
+		if assert.NotEmpty(t, sink.entries) {
+			assert.Contains(t, sink.entries[len(sink.entries)-1].Error, "not-found")
+		}
+	})
+	t.Run("CookieWithoutHeader", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"workflow": uploadWorkflow}, nil)
+		r.AddCookie(&http.Cookie{Name: "authorization", Value: "Bearer my-token"})
+		s.SubmitWorkflow(w, r)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		r = newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"workflow": uploadWorkflow}, map[string]string{"my-csv": "a,b\n1,2\n"})
+		r.AddCookie(&http.Cookie{Name: "authorization", Value: "Bearer my-token"})
+		r.Header.Set("X-Requested-With", "XMLHttpRequest")
+		w = httptest.NewRecorder()
+		s.SubmitWorkflow(w, r)
+		assert.NotEqual(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+	t.Run("NoWorkflow", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/my-ns", nil, nil))
+		assert.Equal(t, 400, w.Code)
+	})
+	t.Run("ResourceNotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"resourceKind": "WorkflowTemplate", "resourceName": "not-found"}, nil))
+		assert.Equal(t, 404, w.Code)
+	})
+	t.Run("InvalidSubmitOptions", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/my-ns", map[string]string{"workflow": uploadWorkflow, "submitOptions": "{"}, nil))
+		assert.Equal(t, 400, w.Code)
+	})
+	t.Run("InvalidPath", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, newUploadRequest(t, "/submit-with-artifacts/", map[string]string{"workflow": uploadWorkflow}, nil))
+		assert.Equal(t, 400, w.Code)
+	})
+	t.Run("NotPost", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.SubmitWorkflow(w, httptest.NewRequest("GET", "/submit-with-artifacts/my-ns", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
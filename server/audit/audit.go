@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+// Config configures where the audit log is written. Each configured sink receives every entry.
+type Config struct {
+	// File appends entries, one JSON object per line, to the file at this path
+	File *FileConfig `json:"file,omitempty"`
+	// Database inserts entries into the `argo_audit_log` table of the persistence database
+	Database bool `json:"database,omitempty"`
+	// Webhook POSTs each entry, as JSON, to a URL
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+}
+
+type FileConfig struct {
+	Path string `json:"path"`
+}
+
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Headers to add to the request, e.g. for authorization
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Entry records a single mutating API call
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Method is the full gRPC method, e.g. "/workflow.WorkflowService/SubmitWorkflow", or, for an HTTP endpoint, its
+	// method and path, e.g. "POST /submit-with-artifacts"
+	Method             string   `json:"method"`
+	Subject            string   `json:"subject,omitempty"`
+	Email              string   `json:"email,omitempty"`
+	Groups             []string `json:"groups,omitempty"`
+	ServiceAccountName string   `json:"serviceAccountName,omitempty"`
+	SourceIP           string   `json:"sourceIP,omitempty"`
+	Namespace          string   `json:"namespace,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	// Request is the request as JSON, i.e. the change that was asked for
+	Request json.RawMessage `json:"request,omitempty"`
+	// Outcome is the gRPC status code of the response, e.g. "OK" or "PermissionDenied"
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+type Sink interface {
+	Write(ctx context.Context, entry Entry) error
+}
+
+// NewSinks returns the sinks for the config. The session is only needed for the database sink, and is nil if
+// persistence is not configured.
+func NewSinks(c Config, session sqlbuilder.Database, clusterName string) ([]Sink, error) {
+	var sinks []Sink
+	if c.File != nil {
+		s, err := newFileSink(c.File.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, s)
+	}
+	if c.Database {
+		if session == nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "audit.database requires persistence to be configured")
+		}
+		sinks = append(sinks, newDatabaseSink(session, clusterName))
+	}
+	if c.Webhook != nil {
+		if c.Webhook.URL == "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "audit.webhook.url is required")
+		}
+		sinks = append(sinks, newWebhookSink(*c.Webhook))
+	}
+	return sinks, nil
+}
+
+// writeTimeout stops a slow sink from holding up the call for too long
+const writeTimeout = 10 * time.Second
+
+// UnaryServerInterceptor writes an entry to every sink for each mutating call. It must come after the gatekeeper, so
+// that the caller's claims are available. A failure to write an entry is logged, but does not fail the call.
+func UnaryServerInterceptor(sinks ...Sink) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if len(sinks) == 0 || !auth.IsMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		Write(ctx, sinks, info.FullMethod, req, err)
+		return resp, err
+	}
+}
+
+// Write writes an entry for the call to every sink. The interceptor writes the entries of gRPC calls, so this is for
+// the mutating HTTP endpoints.
+func Write(ctx context.Context, sinks []Sink, method string, req interface{}, err error) {
+	if len(sinks) == 0 {
+		return
+	}
+	entry := newEntry(ctx, method, req, err)
+	writeCtx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	for _, s := range sinks {
+		if err := s.Write(writeCtx, entry); err != nil {
+			log.WithField("method", entry.Method).WithError(err).Error("failed to write audit log entry")
+		}
+	}
+}
+
+func newEntry(ctx context.Context, fullMethod string, req interface{}, err error) Entry {
+	entry := Entry{
+		Time:     time.Now().UTC(),
+		Method:   fullMethod,
+		SourceIP: grpcutil.SourceIP(ctx),
+		Outcome:  status.Code(grpcutil.TranslateError(err)).String(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if claims := auth.GetClaims(ctx); claims != nil {
+		entry.Subject = claims.Subject
+		entry.Email = claims.Email
+		entry.Groups = claims.Groups
+		entry.ServiceAccountName = claims.ServiceAccountName
+	}
+	if x, ok := req.(interface{ GetNamespace() string }); ok {
+		entry.Namespace = x.GetNamespace()
+	}
+	if x, ok := req.(interface{ GetName() string }); ok {
+		entry.Name = x.GetName()
+	}
+	if data, err := json.Marshal(req); err == nil {
+		entry.Request = data
+	}
+	return entry
+}
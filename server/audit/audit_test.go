@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+type testSink struct{ entries []Entry }
+
+func (s *testSink) Write(_ context.Context, entry Entry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &testSink{}
+	interceptor := UnaryServerInterceptor(sink)
+	ctx := context.WithValue(context.Background(), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "me@example.com", Groups: []string{"my-group"}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "10.0.0.1, 10.0.0.2"))
+	req := &workflowpkg.WorkflowStopRequest{Namespace: "my-ns", Name: "my-wf", Message: "stop"}
+	t.Run("Read", func(t *testing.T) {
+		_, err := interceptor(ctx, &workflowpkg.WorkflowGetRequest{}, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/GetWorkflow"}, func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+		assert.NoError(t, err)
+		assert.Empty(t, sink.entries)
+	})
+	t.Run("Success", func(t *testing.T) {
+		sink.entries = nil
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/StopWorkflow"}, func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+		assert.NoError(t, err)
+		if assert.Len(t, sink.entries, 1) {
+			entry := sink.entries[0]
+			assert.Equal(t, "/workflow.WorkflowService/StopWorkflow", entry.Method)
+			assert.Equal(t, "my-sub", entry.Subject)
+			assert.Equal(t, "me@example.com", entry.Email)
+			assert.Equal(t, []string{"my-group"}, entry.Groups)
+			assert.Equal(t, "10.0.0.1", entry.SourceIP)
+			assert.Equal(t, "my-ns", entry.Namespace)
+			assert.Equal(t, "my-wf", entry.Name)
+			assert.JSONEq(t, `{"namespace":"my-ns","name":"my-wf","message":"stop"}`, string(entry.Request))
+			assert.Equal(t, "OK", entry.Outcome)
+			assert.Empty(t, entry.Error)
+		}
+	})
+	t.Run("Failure", func(t *testing.T) {
+		sink.entries = nil
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/StopWorkflow"}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, apierr.NewNotFound(schema.GroupResource{Resource: "workflows"}, "my-wf")
+		})
+		assert.Error(t, err)
+		if assert.Len(t, sink.entries, 1) {
+			assert.Equal(t, "NotFound", sink.entries[0].Outcome)
+			assert.Equal(t, `workflows "my-wf" not found`, sink.entries[0].Error)
+		}
+	})
+}
+
+func TestNewSinks(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		sinks, err := NewSinks(Config{}, nil, "")
+		assert.NoError(t, err)
+		assert.Empty(t, sinks)
+	})
+	t.Run("DatabaseWithoutPersistence", func(t *testing.T) {
+		_, err := NewSinks(Config{Database: true}, nil, "")
+		assert.Error(t, err)
+	})
+	t.Run("File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		sinks, err := NewSinks(Config{File: &FileConfig{Path: path}}, nil, "")
+		if assert.NoError(t, err) && assert.Len(t, sinks, 1) {
+			assert.NoError(t, sinks[0].Write(context.Background(), Entry{Method: "my-method", Outcome: "OK"}))
+			assert.NoError(t, sinks[0].Write(context.Background(), Entry{Method: "my-method", Outcome: "OK"}))
+			data, err := ioutil.ReadFile(path)
+			if assert.NoError(t, err) {
+				var entry Entry
+				lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+				assert.Len(t, lines, 2)
+				assert.NoError(t, json.Unmarshal(lines[0], &entry))
+				assert.Equal(t, "my-method", entry.Method)
+			}
+		}
+	})
+}
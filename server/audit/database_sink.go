@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+// tableName is created by the controller's schema migration
+const tableName = "argo_audit_log"
+
+type auditLogRecord struct {
+	ClusterName string    `db:"clustername"`
+	CreatedAt   time.Time `db:"createdat"`
+	Method      string    `db:"method"`
+	Subject     string    `db:"subject"`
+	SourceIP    string    `db:"sourceip"`
+	Namespace   string    `db:"namespace"`
+	Name        string    `db:"name"`
+	Outcome     string    `db:"outcome"`
+	Entry       string    `db:"entry"`
+}
+
+type databaseSink struct {
+	session     sqlbuilder.Database
+	clusterName string
+}
+
+func newDatabaseSink(session sqlbuilder.Database, clusterName string) Sink {
+	return &databaseSink{session: session, clusterName: clusterName}
+}
+
+func (s *databaseSink) Write(ctx context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = s.session.WithContext(ctx).Collection(tableName).Insert(&auditLogRecord{
+		ClusterName: s.clusterName,
+		CreatedAt:   entry.Time,
+		Method:      entry.Method,
+		Subject:     entry.Subject,
+		SourceIP:    entry.SourceIP,
+		Namespace:   entry.Namespace,
+		Name:        entry.Name,
+		Outcome:     entry.Outcome,
+		Entry:       string(data),
+	})
+	return err
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type webhookSink struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+func newWebhookSink(config WebhookConfig) Sink {
+	return &webhookSink{config: config, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *webhookSink) Write(ctx context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", s.config.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.config.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded %s", s.config.URL, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apitokenpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/apitoken"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Prefix identifies an API token, tokens look like "argo-api-token:<name>:<secret>"
+const Prefix = "argo-api-token:"
+
+const (
+	secretNamePrefix      = "argo-api-token-"
+	hashKey               = "sha256"
+	serviceAccountNameKey = "serviceAccountName"
+	expiresAtKey          = "expiresAt"
+)
+
+// SecretName is the name of the secret that stores the named token
+func SecretName(name string) string {
+	return secretNamePrefix + name
+}
+
+// New returns a new token, and the secret that stores its hash
+func New(name, namespace, serviceAccountName string, expiresAt *metav1.Time) (string, *corev1.Secret, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", nil, err
+	}
+	token := Prefix + name + ":" + base64.RawURLEncoding.EncodeToString(data)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecretName(name),
+			Namespace: namespace,
+			Labels:    map[string]string{common.LabelKeyAPIToken: name},
+		},
+		Data: map[string][]byte{
+			hashKey:               []byte(hash(token)),
+			serviceAccountNameKey: []byte(serviceAccountName),
+		},
+	}
+	if expiresAt != nil {
+		secret.Data[expiresAtKey] = []byte(expiresAt.UTC().Format(time.RFC3339))
+	}
+	return token, secret, nil
+}
+
+// Name returns the name of the token, and false if it is not an API token
+func Name(token string) (string, bool) {
+	if !strings.HasPrefix(token, Prefix) {
+		return "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(token, Prefix), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+// Verify checks the token matches the hash stored in the secret, and has not expired
+func Verify(secret *corev1.Secret, token string, now time.Time) error {
+	if subtle.ConstantTimeCompare(secret.Data[hashKey], []byte(hash(token))) != 1 {
+		return fmt.Errorf("token does not match")
+	}
+	expiresAt, err := getExpiresAt(secret)
+	if err != nil {
+		return err
+	}
+	if expiresAt != nil && !now.Before(expiresAt.Time) {
+		return fmt.Errorf("token expired at %s", expiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ServiceAccountName returns the service account whose RBAC rules apply to the token stored in the secret
+func ServiceAccountName(secret *corev1.Secret) string {
+	return string(secret.Data[serviceAccountNameKey])
+}
+
+// FromSecret returns the API token stored in the secret, without the hash
+func FromSecret(secret *corev1.Secret) (*apitokenpkg.APIToken, error) {
+	expiresAt, err := getExpiresAt(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &apitokenpkg.APIToken{
+		Name:               secret.Labels[common.LabelKeyAPIToken],
+		ServiceAccountName: ServiceAccountName(secret),
+		CreationTimestamp:  &secret.CreationTimestamp,
+		ExpiresAt:          expiresAt,
+	}, nil
+}
+
+func getExpiresAt(secret *corev1.Secret) (*metav1.Time, error) {
+	v, ok := secret.Data[expiresAtKey]
+	if !ok {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, string(v))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", expiresAtKey, v, err)
+	}
+	return &metav1.Time{Time: t}, nil
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
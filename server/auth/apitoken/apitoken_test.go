@@ -0,0 +1,65 @@
+package apitoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestNew(t *testing.T) {
+	expiresAt := metav1.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	token, secret, err := New("my-token", "my-ns", "my-sa", &expiresAt)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasPrefix(token, "argo-api-token:my-token:"))
+		assert.Equal(t, "argo-api-token-my-token", secret.Name)
+		assert.Equal(t, "my-ns", secret.Namespace)
+		assert.Equal(t, "my-token", secret.Labels[common.LabelKeyAPIToken])
+		assert.NotContains(t, string(secret.Data["sha256"]), token, "the token must not be stored")
+		assert.Equal(t, "2021-09-01T12:00:00Z", string(secret.Data["expiresAt"]))
+		apiToken, err := FromSecret(secret)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-token", apiToken.Name)
+			assert.Equal(t, "my-sa", apiToken.ServiceAccountName)
+			assert.True(t, expiresAt.Equal(apiToken.ExpiresAt))
+		}
+	}
+	other, _, err := New("my-token", "my-ns", "my-sa", nil)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, token, other)
+	}
+}
+
+func TestName(t *testing.T) {
+	name, ok := Name("argo-api-token:my-token:secret")
+	assert.True(t, ok)
+	assert.Equal(t, "my-token", name)
+	for _, token := range []string{"", "argo-api-token:", "argo-api-token:my-token", "argo-api-token::secret", "argo-api-token:a:b:c", "my-token:secret"} {
+		_, ok := Name(token)
+		assert.False(t, ok, token)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+	token, secret, err := New("my-token", "my-ns", "my-sa", &metav1.Time{Time: now.Add(time.Hour)})
+	assert.NoError(t, err)
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, Verify(secret, token, now))
+	})
+	t.Run("DoesNotMatch", func(t *testing.T) {
+		assert.EqualError(t, Verify(secret, token+"x", now), "token does not match")
+	})
+	t.Run("Expired", func(t *testing.T) {
+		assert.Error(t, Verify(secret, token, now.Add(time.Hour)))
+	})
+	t.Run("NeverExpires", func(t *testing.T) {
+		token, secret, err := New("my-token", "my-ns", "my-sa", nil)
+		assert.NoError(t, err)
+		assert.NoError(t, Verify(secret, token, now.Add(24*365*time.Hour)))
+	})
+}
@@ -14,3 +14,11 @@ func CanI(ctx context.Context, verb, resource, namespace, name string) (bool, er
 	}
 	return allowed, nil
 }
+
+func CanIGetPodLogs(ctx context.Context, namespace, name string) (bool, error) {
+	return authUtil.CanIGetPodLogs(ctx, GetKubeClient(ctx), namespace, name)
+}
+
+func CanIImpersonateServiceAccount(ctx context.Context, namespace, name string) (bool, error) {
+	return authUtil.CanIImpersonateServiceAccount(ctx, GetKubeClient(ctx), namespace, name)
+}
@@ -7,25 +7,32 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	eventsource "github.com/argoproj/argo-events/pkg/client/eventsource/clientset/versioned"
 	sensor "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
+	"github.com/go-jose/go-jose/v3/jwt"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/auth/apitoken"
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
 	"github.com/argoproj/argo-workflows/v3/server/auth/serviceaccount"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	"github.com/argoproj/argo-workflows/v3/server/cache"
+	"github.com/argoproj/argo-workflows/v3/server/metrics"
 	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	jsonutil "github.com/argoproj/argo-workflows/v3/util/json"
@@ -168,7 +175,9 @@ func getAuthHeaders(md metadata.MD) []string {
 	return authorizations
 }
 
-func (s gatekeeper) getClients(ctx context.Context, req interface{}) (*servertypes.Clients, *types.Claims, error) {
+func (s gatekeeper) getClients(ctx context.Context, req interface{}) (_ *servertypes.Clients, _ *types.Claims, err error) {
+	var mode Mode
+	defer func() { metrics.AuthRequest(string(mode), err) }()
 	md, _ := metadata.FromIncomingContext(ctx)
 	authorizations := getAuthHeaders(md)
 	// Required for GetMode() with Server auth when no auth header specified
@@ -176,7 +185,6 @@ func (s gatekeeper) getClients(ctx context.Context, req interface{}) (*servertyp
 		authorizations = append(authorizations, "")
 	}
 	valid := false
-	var mode Mode
 	var authorization string
 
 	for _, token := range authorizations {
@@ -206,6 +214,14 @@ func (s gatekeeper) getClients(ctx context.Context, req interface{}) (*servertyp
 		if err != nil {
 			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
 		}
+		if impersonate := s.ssoIf.ImpersonateConfig(); impersonate.IsEnabled() {
+			clients, err := s.impersonateClients(impersonate, claims)
+			if err != nil {
+				log.WithError(err).Error("failed to impersonate user")
+				return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
+			}
+			return clients, claims, nil
+		}
 		if s.ssoIf.IsRBACEnabled() {
 			clients, err := s.rbacAuthorization(claims, req)
 			if err != nil {
@@ -218,6 +234,13 @@ func (s gatekeeper) getClients(ctx context.Context, req interface{}) (*servertyp
 			log.WithFields(addClaimsLogFields(claims, nil)).Info("using the default service account for user")
 			return s.clients, claims, nil
 		}
+	case APIToken:
+		clients, claims, err := s.apiTokenAuthorization(ctx, strings.TrimPrefix(authorization, "Bearer "))
+		if err != nil {
+			log.WithError(err).Info("failed to authorize API token")
+			return nil, nil, status.Error(codes.Unauthenticated, "token not valid")
+		}
+		return clients, claims, nil
 	default:
 		panic("this should never happen")
 	}
@@ -289,10 +312,23 @@ func (s *gatekeeper) getClientsForServiceAccount(claims *types.Claims, serviceAc
 		return nil, err
 	}
 	claims.ServiceAccountName = serviceAccount.Name
+	claims.ReadOnly = serviceAccount.Annotations[common.AnnotationKeyRBACReadOnly] == "true"
+	claims.WorkflowActions = nil
+	if v, ok := serviceAccount.Annotations[common.AnnotationKeyRBACWorkflowActions]; ok {
+		claims.WorkflowActions = []string{}
+		for _, action := range strings.Split(v, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				claims.WorkflowActions = append(claims.WorkflowActions, action)
+			}
+		}
+	}
 	return clients, nil
 }
 
 func (s *gatekeeper) rbacAuthorization(claims *types.Claims, req interface{}) (*servertypes.Clients, error) {
+	if config := s.ssoIf.RBACConfig(); config.HasRules() {
+		return s.rbacRuleAuthorization(claims, config, getNamespace(req))
+	}
 	ssoDelegationAllowed, ssoDelegated := false, false
 	loginAccount, err := s.getServiceAccount(claims, s.ssoNamespace)
 	if err != nil {
@@ -314,6 +350,33 @@ func (s *gatekeeper) rbacAuthorization(claims *types.Claims, req interface{}) (*
 	return s.getClientsForServiceAccount(claims, delegatedAccount)
 }
 
+// rbacRuleAuthorization uses the service account of the first rule that matches the user
+func (s *gatekeeper) rbacRuleAuthorization(claims *types.Claims, config *rbac.Config, namespace string) (*servertypes.Clients, error) {
+	rule, err := config.Match(claims, namespace)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccountNamespace := rule.Namespace
+	if serviceAccountNamespace == "" {
+		serviceAccountNamespace = s.ssoNamespace
+	}
+	serviceAccount, err := s.cache.ServiceAccountLister.ServiceAccounts(serviceAccountNamespace).Get(rule.ServiceAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account for rule %q: %w", rule.Name, err)
+	}
+	// important! write an audit entry (i.e. log entry) so we know which user performed an operation
+	log.WithFields(log.Fields{"serviceAccount": serviceAccount.Name, "serviceAccountNamespace": serviceAccountNamespace, "rule": rule.Name, "subject": claims.Subject, "email": claims.Email, "readOnly": rule.ReadOnly}).Info("selected SSO RBAC service account for user")
+	clients, err := s.getClientsForServiceAccount(claims, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	claims.ReadOnly = claims.ReadOnly || rule.ReadOnly
+	if rule.WorkflowActions != nil {
+		claims.WorkflowActions = rule.WorkflowActions
+	}
+	return clients, nil
+}
+
 func (s *gatekeeper) authorizationForServiceAccount(serviceAccount *corev1.ServiceAccount) (string, error) {
 	if len(serviceAccount.Secrets) == 0 {
 		return "", fmt.Errorf("expected at least one secret for SSO RBAC service account: %s", serviceAccount.GetName())
@@ -325,6 +388,52 @@ func (s *gatekeeper) authorizationForServiceAccount(serviceAccount *corev1.Servi
 	return "Bearer " + string(secret.Data["token"]), nil
 }
 
+func (s *gatekeeper) apiTokenAuthorization(ctx context.Context, token string) (*servertypes.Clients, *types.Claims, error) {
+	name, ok := apitoken.Name(token)
+	if !ok {
+		return nil, nil, fmt.Errorf("malformed API token")
+	}
+	secret, err := s.clients.Kubernetes.CoreV1().Secrets(s.namespace).Get(ctx, apitoken.SecretName(name), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get API token %q: %w", name, err)
+	}
+	if err := apitoken.Verify(secret, token, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("API token %q not valid: %w", name, err)
+	}
+	serviceAccount, err := s.clients.Kubernetes.CoreV1().ServiceAccounts(s.namespace).Get(ctx, apitoken.ServiceAccountName(secret), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get service account for API token %q: %w", name, err)
+	}
+	if len(serviceAccount.Secrets) == 0 {
+		return nil, nil, fmt.Errorf("expected at least one secret for API token service account: %s", serviceAccount.Name)
+	}
+	serviceAccountSecret, err := s.clients.Kubernetes.CoreV1().Secrets(s.namespace).Get(ctx, serviceAccount.Secrets[0].Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get service account secret: %w", err)
+	}
+	_, clients, err := s.clientForAuthorization("Bearer " + string(serviceAccountSecret.Data["token"]))
+	if err != nil {
+		return nil, nil, err
+	}
+	claims := &types.Claims{Claims: jwt.Claims{Subject: "api-token:" + name}, ServiceAccountName: serviceAccount.Name}
+	// important! write an audit entry (i.e. log entry) so we know which token performed an operation
+	log.WithFields(log.Fields{"apiToken": name, "serviceAccount": serviceAccount.Name}).Info("selected service account for API token")
+	return clients, claims, nil
+}
+
+// impersonateClients returns clients that make requests as the user, so Kubernetes RBAC applies to them
+func (s *gatekeeper) impersonateClients(c *sso.ImpersonateConfig, claims *types.Claims) (*servertypes.Clients, error) {
+	impersonate, err := c.ImpersonationConfig(claims)
+	if err != nil {
+		return nil, err
+	}
+	restConfig := rest.CopyConfig(s.restConfig)
+	restConfig.Impersonate = impersonate
+	// important! write an audit entry (i.e. log entry) so we know which user performed an operation
+	log.WithFields(addClaimsLogFields(claims, log.Fields{"impersonateUser": impersonate.UserName, "impersonateGroups": impersonate.Groups})).Info("impersonating user")
+	return clientsForRestConfig(restConfig)
+}
+
 func addClaimsLogFields(claims *types.Claims, fields log.Fields) log.Fields {
 	if fields == nil {
 		fields = log.Fields{}
@@ -341,27 +450,36 @@ func DefaultClientForAuthorization(authorization string) (*rest.Config, *servert
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create REST config: %w", err)
 	}
+	clients, err := clientsForRestConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return restConfig, clients, nil
+}
+
+// clientsForRestConfig is a variable so that tests can replace it
+var clientsForRestConfig = func(restConfig *rest.Config) (*servertypes.Clients, error) {
 	dynamicClient, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure to create dynamic client: %w", err)
+		return nil, fmt.Errorf("failure to create dynamic client: %w", err)
 	}
 	wfClient, err := workflow.NewForConfig(restConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure to create workflow client: %w", err)
+		return nil, fmt.Errorf("failure to create workflow client: %w", err)
 	}
 	eventSourceClient, err := eventsource.NewForConfig(restConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure to create event source client: %w", err)
+		return nil, fmt.Errorf("failure to create event source client: %w", err)
 	}
 	sensorClient, err := sensor.NewForConfig(restConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure to create sensor client: %w", err)
+		return nil, fmt.Errorf("failure to create sensor client: %w", err)
 	}
 	kubeClient, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failure to create kubernetes client: %w", err)
+		return nil, fmt.Errorf("failure to create kubernetes client: %w", err)
 	}
-	return restConfig, &servertypes.Clients{
+	return &servertypes.Clients{
 		Dynamic:     dynamicClient,
 		Workflow:    wfClient,
 		Sensor:      sensorClient,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	log "github.com/sirupsen/logrus"
@@ -16,7 +17,11 @@ import (
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth/apitoken"
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
+	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 	ssomocks "github.com/argoproj/argo-workflows/v3/server/auth/sso/mocks"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	"github.com/argoproj/argo-workflows/v3/server/cache"
@@ -36,6 +41,7 @@ func TestServer_GetWFClient(t *testing.T) {
 				Annotations: map[string]string{
 					common.AnnotationKeyRBACRule:           "'other-group' in groups",
 					common.AnnotationKeyRBACRulePrecedence: "0",
+					common.AnnotationKeyRBACReadOnly:       "true",
 				},
 			},
 			Secrets: []corev1.ObjectReference{{Name: "my-secret"}},
@@ -44,8 +50,9 @@ func TestServer_GetWFClient(t *testing.T) {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "my-sa", Namespace: "my-ns",
 				Annotations: map[string]string{
-					common.AnnotationKeyRBACRule:           "'my-group' in groups",
-					common.AnnotationKeyRBACRulePrecedence: "1",
+					common.AnnotationKeyRBACRule:            "'my-group' in groups",
+					common.AnnotationKeyRBACRulePrecedence:  "1",
+					common.AnnotationKeyRBACWorkflowActions: "resume, suspend",
 				},
 			},
 			Secrets: []corev1.ObjectReference{{Name: "my-secret"}},
@@ -151,6 +158,7 @@ func TestServer_GetWFClient(t *testing.T) {
 	t.Run("SSO", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(false)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
 		if assert.NoError(t, err) {
@@ -170,7 +178,9 @@ func TestServer_GetWFClient(t *testing.T) {
 	t.Run("SSO+RBAC,precedence=1", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer v2:whatever"))
@@ -181,6 +191,8 @@ func TestServer_GetWFClient(t *testing.T) {
 					assert.Equal(t, []string{"my-group", "other-group"}, GetClaims(ctx).Groups)
 					assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
 				}
+				assert.False(t, IsReadOnly(ctx))
+				assert.Equal(t, []string{"resume", "suspend"}, GetClaims(ctx).WorkflowActions)
 				assert.Equal(t, "my-sa", hook.LastEntry().Data["serviceAccount"])
 			}
 		}
@@ -189,7 +201,9 @@ func TestServer_GetWFClient(t *testing.T) {
 		os.Setenv("SSO_DELEGATE_RBAC_TO_NAMESPACE", "true")
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", false, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), servertypes.NamespaceHolder("user1-ns"))
@@ -208,7 +222,9 @@ func TestServer_GetWFClient(t *testing.T) {
 	t.Run("SSO+RBAC, Namespace delegation OFF, precedence=2, Not Delegated", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), servertypes.NamespaceHolder("user1-ns"))
@@ -227,7 +243,9 @@ func TestServer_GetWFClient(t *testing.T) {
 		os.Setenv("SSO_DELEGATE_RBAC_TO_NAMESPACE", "true")
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", false, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), servertypes.NamespaceHolder("user2-ns"))
@@ -247,7 +265,9 @@ func TestServer_GetWFClient(t *testing.T) {
 		os.Setenv("SSO_DELEGATE_RBAC_TO_NAMESPACE", "true")
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", false, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), servertypes.NamespaceHolder("user3-ns"))
@@ -266,20 +286,75 @@ func TestServer_GetWFClient(t *testing.T) {
 	t.Run("SSO+RBAC,precedence=0", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"other-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer v2:whatever"))
 			if assert.NoError(t, err) {
 				assert.Equal(t, "my-other-sa", hook.LastEntry().Data["serviceAccount"])
 				assert.Equal(t, "my-other-sa", GetClaims(ctx).ServiceAccountName)
+				assert.True(t, IsReadOnly(ctx), "the service account is read-only")
+				assert.Nil(t, GetClaims(ctx).WorkflowActions, "the service account does not restrict actions")
+			}
+		}
+	})
+	t.Run("SSO+RBAC,rules", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
+		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(&rbac.Config{Enabled: true, Rules: []rbac.Rule{
+			{Name: "default", ServiceAccountName: "my-other-sa"},
+			{Name: "my-group", Groups: []string{"my-group"}, ServiceAccountName: "my-sa", Precedence: 1},
+			{Name: "user1", Groups: []string{"my-group"}, Namespace: "user1-ns", ServiceAccountName: "user1-sa", Precedence: 2, ReadOnly: true, WorkflowActions: []string{"resume"}},
+		}})
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", false, resourceCache)
+		if assert.NoError(t, err) {
+			ctx, err := g.ContextWithRequest(x("Bearer v2:whatever"), &workflowpkg.WorkflowListRequest{Namespace: "user1-ns"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "user1", hook.LastEntry().Data["rule"])
+				assert.Equal(t, "user1-sa", GetClaims(ctx).ServiceAccountName)
+				assert.True(t, IsReadOnly(ctx), "the rule is read-only")
+				assert.Equal(t, []string{"resume"}, GetClaims(ctx).WorkflowActions, "the rule's actions are used")
+			}
+			ctx, err = g.ContextWithRequest(x("Bearer v2:whatever"), &workflowpkg.WorkflowListRequest{Namespace: "user2-ns"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-group", hook.LastEntry().Data["rule"])
+				assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+				assert.False(t, IsReadOnly(ctx))
+				assert.Equal(t, []string{"resume", "suspend"}, GetClaims(ctx).WorkflowActions, "the service account's actions are used")
+			}
+		}
+	})
+	t.Run("SSO+Impersonate", func(t *testing.T) {
+		defer func(f func(*rest.Config) (*servertypes.Clients, error)) { clientsForRestConfig = f }(clientsForRestConfig)
+		var impersonate rest.ImpersonationConfig
+		clientsForRestConfig = func(restConfig *rest.Config) (*servertypes.Clients, error) {
+			impersonate = restConfig.Impersonate
+			return &servertypes.Clients{Workflow: &fakewfclientset.Clientset{}, Kubernetes: &kubefake.Clientset{}}, nil
+		}
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Email: "me@example.com", Groups: []string{"my-group"}}, nil)
+		ssoIf.On("ImpersonateConfig").Return(&sso.ImpersonateConfig{Enabled: true, UsernameClaim: "email", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"})
+		restConfig := &rest.Config{Host: "https://kubernetes"}
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, restConfig, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
+		if assert.NoError(t, err) {
+			ctx, err := g.Context(x("Bearer v2:whatever"))
+			if assert.NoError(t, err) {
+				assert.Equal(t, rest.ImpersonationConfig{UserName: "oidc:me@example.com", Groups: []string{"oidc:my-group"}}, impersonate)
+				assert.Empty(t, restConfig.Impersonate.UserName, "the server's own config must not be changed")
+				assert.NotEqual(t, wfClient, GetWfClient(ctx))
 			}
 		}
 	})
 	t.Run("SSO+RBAC,denied", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{}, nil)
+		ssoIf.On("ImpersonateConfig").Return(nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
+		ssoIf.On("RBACConfig").Return(nil)
 		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns", "my-ns", true, resourceCache)
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("Bearer v2:whatever"))
@@ -288,6 +363,53 @@ func TestServer_GetWFClient(t *testing.T) {
 	})
 }
 
+func TestServer_APIToken(t *testing.T) {
+	token, secret, err := apitoken.New("my-token", "my-ns", "my-sa", nil)
+	assert.NoError(t, err)
+	_, expiredSecret, err := apitoken.New("my-expired-token", "my-ns", "my-sa", &metav1.Time{Time: time.Now().Add(-time.Hour)})
+	assert.NoError(t, err)
+	kubeClient := kubefake.NewSimpleClientset(
+		secret,
+		expiredSecret,
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "my-sa-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-sa-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-sa-token")},
+		},
+	)
+	var authorization string
+	var clientForAuthorization ClientForAuthorization = func(v string) (*rest.Config, *servertypes.Clients, error) {
+		authorization = v
+		return &rest.Config{}, &servertypes.Clients{Workflow: &fakewfclientset.Clientset{}, Kubernetes: &kubefake.Clientset{}}, nil
+	}
+	clients := &servertypes.Clients{Workflow: fakewfclientset.NewSimpleClientset(), Kubernetes: kubeClient}
+	g, err := NewGatekeeper(Modes{APIToken: true, Client: true}, clients, nil, nil, clientForAuthorization, "my-ns", "my-ns", true, nil)
+	assert.NoError(t, err)
+	t.Run("Valid", func(t *testing.T) {
+		ctx, err := g.Context(x("Bearer " + token))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Bearer my-sa-token", authorization)
+			assert.Equal(t, "api-token:my-token", GetClaims(ctx).Subject)
+			assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+		}
+	})
+	t.Run("WrongSecret", func(t *testing.T) {
+		_, err := g.Context(x("Bearer " + apitoken.Prefix + "my-token:wrong"))
+		assert.EqualError(t, err, "rpc error: code = Unauthenticated desc = token not valid")
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, err := g.Context(x("Bearer " + apitoken.Prefix + "my-expired-token:whatever"))
+		assert.Error(t, err)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := g.Context(x("Bearer " + apitoken.Prefix + "not-found:whatever"))
+		assert.EqualError(t, err, "rpc error: code = Unauthenticated desc = token not valid")
+	})
+}
+
 func x(authorization string) context.Context {
 	return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"authorization": authorization}))
 }
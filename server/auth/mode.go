@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/argoproj/argo-workflows/v3/server/auth/apitoken"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 )
 
@@ -15,11 +16,13 @@ const (
 	Client Mode = "client"
 	Server Mode = "server"
 	SSO    Mode = "sso"
+	// APIToken is for tokens issued by the Argo Server
+	APIToken Mode = "api-token"
 )
 
 func (m Modes) Add(value string) error {
 	switch value {
-	case "client", "server", "sso":
+	case "client", "server", "sso", "api-token":
 		m[Mode(value)] = true
 	case "hybrid":
 		m[Client] = true
@@ -31,6 +34,9 @@ func (m Modes) Add(value string) error {
 }
 
 func (m Modes) GetMode(authorisation string) (Mode, bool) {
+	if m[APIToken] && strings.HasPrefix(authorisation, "Bearer "+apitoken.Prefix) {
+		return APIToken, true
+	}
 	if m[SSO] && strings.HasPrefix(authorisation, sso.Prefix) {
 		return SSO, true
 	}
@@ -35,14 +35,27 @@ func TestModes_Add(t *testing.T) {
 			assert.Contains(t, m, SSO)
 		}
 	})
+	t.Run("APIToken", func(t *testing.T) {
+		m := Modes{}
+		if assert.NoError(t, m.Add("api-token")) {
+			assert.Contains(t, m, APIToken)
+		}
+	})
 }
 
 func TestModes_GetMode(t *testing.T) {
 	m := Modes{
-		Client: true,
-		SSO:    true,
-		Server: true,
+		APIToken: true,
+		Client:   true,
+		SSO:      true,
+		Server:   true,
 	}
+	t.Run("APIToken", func(t *testing.T) {
+		mode, valid := m.GetMode("Bearer argo-api-token:my-token:secret")
+		if assert.True(t, valid) {
+			assert.Equal(t, APIToken, mode)
+		}
+	})
 	t.Run("Client", func(t *testing.T) {
 		mode, valid := m.GetMode("Bearer ")
 		if assert.True(t, valid) {
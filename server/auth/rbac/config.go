@@ -1,9 +1,128 @@
 package rbac
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	jsonutil "github.com/argoproj/argo-workflows/v3/util/json"
+)
+
 type Config struct {
 	Enabled bool `json:"enabled,omitempty"`
+	// Rules map users to service accounts. If there are any rules, the `workflows.argoproj.io/rbac-rule` annotations
+	// on service accounts are ignored.
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// Rule maps the users that match it to a service account
+type Rule struct {
+	// Name of the rule, shown in the logs, and by the dry-run endpoint
+	Name string `json:"name"`
+	// Groups the user must be a member of (any one of them). If empty, the rule matches any groups.
+	Groups []string `json:"groups,omitempty"`
+	// Expr is an expression evaluated against the user's claims, e.g. "email matches '@example.com$'".
+	// If empty, the rule matches any claims.
+	Expr string `json:"expr,omitempty"`
+	// Namespace the rule applies to, i.e. the rule is only used for requests for resources in this namespace, and the
+	// service account is in this namespace. If empty, the rule applies to all requests, and the service account is
+	// in the SSO namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// ServiceAccountName is the service account whose RBAC rules apply to the user
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Precedence of the rule, rules with higher precedence are tried first, rules with the same precedence are tried
+	// in the order they are listed
+	Precedence int `json:"precedence,omitempty"`
+	// ReadOnly only allows users that match the rule to make calls that do not change anything, e.g. to list and get
+	// workflows, view their logs, and download their artifacts
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// WorkflowActions, if not nil, are the only actions on workflows (e.g. "resume") that users that match the rule
+	// may perform. An empty list allows no actions.
+	WorkflowActions []string `json:"workflowActions,omitempty"`
 }
 
 func (c *Config) IsEnabled() bool {
 	return c != nil && c.Enabled
 }
+
+// HasRules returns true if users are mapped to service accounts by rules, rather than annotations
+func (c *Config) HasRules() bool {
+	return c.IsEnabled() && len(c.Rules) > 0
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for i, r := range c.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("rbac.rules[%d].name is required", i)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("rbac.rules[%d].name %q is not unique", i, r.Name)
+		}
+		names[r.Name] = true
+		if r.ServiceAccountName == "" {
+			return fmt.Errorf("rbac.rules[%d].serviceAccountName is required", i)
+		}
+		for _, action := range r.WorkflowActions {
+			if !types.IsWorkflowAction(action) {
+				return fmt.Errorf("rbac.rules[%d].workflowActions %q is not one of: %s", i, action, strings.Join(types.WorkflowActions, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// Match returns the first rule, in precedence order, that applies to the namespace and matches the claims
+func (c *Config) Match(claims *types.Claims, namespace string) (*Rule, error) {
+	var rules []Rule
+	for _, r := range c.Rules {
+		if r.Namespace == "" || r.Namespace == namespace {
+			rules = append(rules, r)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Precedence > rules[j].Precedence })
+	v, err := jsonutil.Jsonify(claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall claims: %w", err)
+	}
+	for _, r := range rules {
+		ok, err := r.matches(claims, v)
+		if err != nil {
+			// e.g. the expression uses a claim the user does not have, try the next rule
+			log.WithField("rule", r.Name).WithError(err).Warn("failed to evaluate SSO RBAC rule")
+			continue
+		}
+		if ok {
+			return &r, nil
+		}
+	}
+	return nil, fmt.Errorf("no rule matches")
+}
+
+func (r Rule) matches(claims *types.Claims, v map[string]interface{}) (bool, error) {
+	if len(r.Groups) > 0 && !anyGroup(r.Groups, claims.Groups) {
+		return false, nil
+	}
+	if r.Expr == "" {
+		return true, nil
+	}
+	return argoexpr.EvalBool(r.Expr, v)
+}
+
+func anyGroup(want, have []string) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if w == h {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, (*Config)(nil).Validate())
+	assert.NoError(t, (&Config{Rules: []Rule{{Name: "a", ServiceAccountName: "a"}}}).Validate())
+	assert.EqualError(t, (&Config{Rules: []Rule{{ServiceAccountName: "a"}}}).Validate(), "rbac.rules[0].name is required")
+	assert.EqualError(t, (&Config{Rules: []Rule{{Name: "a", ServiceAccountName: "a"}, {Name: "a", ServiceAccountName: "b"}}}).Validate(), `rbac.rules[1].name "a" is not unique`)
+	assert.EqualError(t, (&Config{Rules: []Rule{{Name: "a"}}}).Validate(), "rbac.rules[0].serviceAccountName is required")
+	assert.NoError(t, (&Config{Rules: []Rule{{Name: "a", ServiceAccountName: "a", WorkflowActions: []string{"resume"}}}}).Validate())
+	assert.EqualError(t, (&Config{Rules: []Rule{{Name: "a", ServiceAccountName: "a", WorkflowActions: []string{"approve"}}}}).Validate(), `rbac.rules[0].workflowActions "approve" is not one of: delete, resubmit, resume, retry, set, stop, suspend, terminate`)
+}
+
+func TestConfig_HasRules(t *testing.T) {
+	assert.False(t, (*Config)(nil).HasRules())
+	assert.False(t, (&Config{Rules: []Rule{{Name: "a"}}}).HasRules())
+	assert.False(t, (&Config{Enabled: true}).HasRules())
+	assert.True(t, (&Config{Enabled: true, Rules: []Rule{{Name: "a"}}}).HasRules())
+}
+
+func TestConfig_Match(t *testing.T) {
+	c := &Config{Rules: []Rule{
+		{Name: "default", ServiceAccountName: "viewer"},
+		{Name: "admins", Groups: []string{"admins"}, ServiceAccountName: "admin", Precedence: 10},
+		{Name: "example", Expr: "email matches '@example.com$'", ServiceAccountName: "editor", Precedence: 5},
+		{Name: "team-a", Groups: []string{"team-a"}, Namespace: "team-a", ServiceAccountName: "team-a", Precedence: 5},
+	}}
+	match := func(claims *types.Claims, namespace string) string {
+		r, err := c.Match(claims, namespace)
+		if err != nil {
+			return err.Error()
+		}
+		return r.Name
+	}
+	assert.Equal(t, "default", match(&types.Claims{}, ""))
+	assert.Equal(t, "admins", match(&types.Claims{Groups: []string{"team-a", "admins"}}, "team-a"))
+	assert.Equal(t, "example", match(&types.Claims{Email: "me@example.com", Groups: []string{"team-a"}}, "team-a"), "same precedence, so the first listed wins")
+	assert.Equal(t, "team-a", match(&types.Claims{Groups: []string{"team-a"}}, "team-a"))
+	assert.Equal(t, "default", match(&types.Claims{Groups: []string{"team-a"}}, "team-b"))
+	none := &Config{Rules: []Rule{{Name: "admins", Groups: []string{"admins"}, ServiceAccountName: "admin"}}}
+	_, err := none.Match(&types.Claims{}, "")
+	assert.EqualError(t, err, "no rule matches")
+	invalid := &Config{Rules: []Rule{{Name: "invalid", Expr: "!!!", ServiceAccountName: "admin"}}}
+	_, err = invalid.Match(&types.Claims{}, "")
+	assert.EqualError(t, err, "no rule matches")
+}
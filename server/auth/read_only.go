@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/server/metrics"
+)
+
+// mutatingVerbs are the prefixes of the names of methods that change something
+var mutatingVerbs = []string{"Approve", "Bulk", "Create", "Delete", "Receive", "Reject", "Replay", "Restart", "Resubmit", "Resume", "Retry", "Rollback", "Set", "Stop", "Submit", "Suspend", "Terminate", "Update"}
+
+// IsMutating returns true if the gRPC method, e.g. "/workflow.WorkflowService/SubmitWorkflow", changes something
+func IsMutating(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, verb := range mutatingVerbs {
+		if strings.HasPrefix(name, verb) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReadOnly returns true if the user may only make calls that do not change anything, because the SSO RBAC rule or
+// service account they were mapped to is read-only
+func IsReadOnly(ctx context.Context) bool {
+	claims := GetClaims(ctx)
+	return claims != nil && claims.ReadOnly
+}
+
+// ReadOnlyUnaryServerInterceptor denies every mutating call if the server is read-only, or if the user is. It must come
+// after the gatekeeper, so that the user's claims are available. Streams are not intercepted, as none of them mutate.
+func ReadOnlyUnaryServerInterceptor(readOnly bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if IsMutating(info.FullMethod) {
+			if readOnly {
+				metrics.RejectedRequest(info.FullMethod, metrics.ReasonReadOnly)
+				return nil, status.Error(codes.PermissionDenied, "the Argo Server is read-only")
+			}
+			if IsReadOnly(ctx) {
+				metrics.RejectedRequest(info.FullMethod, metrics.ReasonReadOnly)
+				return nil, status.Error(codes.PermissionDenied, "you only have read-only access")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
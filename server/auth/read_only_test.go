@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestIsMutating(t *testing.T) {
+	assert.True(t, IsMutating("/workflow.WorkflowService/SubmitWorkflow"))
+	assert.True(t, IsMutating("/workflowtemplate.WorkflowTemplateService/UpdateWorkflowTemplate"))
+	assert.True(t, IsMutating("/event.EventService/ReceiveEvent"))
+	assert.True(t, IsMutating("/event.EventService/ReplayFailedEvent"))
+	assert.True(t, IsMutating("/pipeline.PipelineService/RestartPipeline"))
+	assert.True(t, IsMutating("/workflowtemplate.WorkflowTemplateService/RollbackWorkflowTemplate"))
+	assert.True(t, IsMutating("/workflow.WorkflowService/ApproveWorkflow"))
+	assert.True(t, IsMutating("/workflow.WorkflowService/RejectWorkflow"))
+	assert.False(t, IsMutating("/workflow.WorkflowService/GetWorkflow"))
+	assert.False(t, IsMutating("/workflow.WorkflowService/LintWorkflow"))
+	assert.False(t, IsMutating("/workflow.WorkflowService/ListWorkflows"))
+}
+
+func TestReadOnlyUnaryServerInterceptor(t *testing.T) {
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	get := &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/GetWorkflow"}
+	submit := &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/SubmitWorkflow"}
+	ctx := context.Background()
+	readOnlyCtx := context.WithValue(ctx, ClaimsKey, &types.Claims{ReadOnly: true})
+	t.Run("Writable", func(t *testing.T) {
+		_, err := ReadOnlyUnaryServerInterceptor(false)(ctx, nil, submit, handler)
+		assert.NoError(t, err)
+	})
+	t.Run("ReadOnlyServer", func(t *testing.T) {
+		_, err := ReadOnlyUnaryServerInterceptor(true)(ctx, nil, get, handler)
+		assert.NoError(t, err)
+		_, err = ReadOnlyUnaryServerInterceptor(true)(ctx, nil, submit, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("ReadOnlyUser", func(t *testing.T) {
+		_, err := ReadOnlyUnaryServerInterceptor(false)(readOnlyCtx, nil, get, handler)
+		assert.NoError(t, err)
+		_, err = ReadOnlyUnaryServerInterceptor(false)(readOnlyCtx, nil, submit, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	for _, method := range []string{"ApproveWorkflow", "RejectWorkflow"} {
+		t.Run(method, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/" + method}
+			_, err := ReadOnlyUnaryServerInterceptor(true)(ctx, nil, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = ReadOnlyUnaryServerInterceptor(false)(readOnlyCtx, nil, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// DeviceCodeGrantType is the grant type a token is requested with, once the user has entered the code, as per RFC 8628
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// DeviceCode is the provider's response to a device authorization request, as per RFC 8628
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// TokenError is the response to a token request that failed, as per RFC 6749. While the user has not entered the code,
+// the error is "authorization_pending", or "slow_down" if the token is requested too often.
+type TokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Token is the response to a token request that succeeded. It holds an authorization for the Argo Server, when it
+// expires, and a refresh token to request a new one with, if the provider issued one.
+type Token struct {
+	Authorization string    `json:"authorization"`
+	Expiry        time.Time `json:"expiry"`
+	RefreshToken  string    `json:"refreshToken,omitempty"`
+}
+
+// the most of a provider's response that is read
+const maxResponseSize = 1 << 20
+
+// HandleDeviceCode requests a device code from the provider, for the user to enter on another device, such as their
+// laptop, so a CLI on a machine without a browser can be authorized
+func (s *sso) HandleDeviceCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if s.deviceAuthURL == "" {
+		writeTokenError(w, http.StatusNotImplemented, "unsupported_grant_type", "the SSO provider does not support the device authorization grant")
+		return
+	}
+	status, body, err := s.postForm(r.Context(), s.deviceAuthURL, url.Values{"scope": {strings.Join(s.config.Scopes, " ")}})
+	if err != nil {
+		log.WithError(err).Error("failed to request device code")
+		writeTokenError(w, http.StatusBadGateway, "server_error", fmt.Sprintf("failed to request device code: %v", err))
+		return
+	}
+	if status != http.StatusOK {
+		writeJSON(w, status, json.RawMessage(body))
+		return
+	}
+	code := &DeviceCode{}
+	if err := json.Unmarshal(body, code); err != nil || code.DeviceCode == "" {
+		writeTokenError(w, http.StatusBadGateway, "server_error", "the SSO provider returned an invalid device code")
+		return
+	}
+	writeJSON(w, http.StatusOK, code)
+}
+
+// HandleToken returns an authorization for the Argo Server, for either a device code the user has entered, or a
+// refresh token returned with an earlier authorization
+func (s *sso) HandleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	var oauth2Token *oauth2.Token
+	switch grantType := r.PostFormValue("grant_type"); grantType {
+	case DeviceCodeGrantType:
+		deviceCode := r.PostFormValue("device_code")
+		if deviceCode == "" {
+			writeTokenError(w, http.StatusBadRequest, "invalid_request", "device_code is required")
+			return
+		}
+		status, body, err := s.postForm(ctx, s.config.Endpoint.TokenURL, url.Values{"grant_type": {grantType}, "device_code": {deviceCode}})
+		if err != nil {
+			log.WithError(err).Error("failed to request token")
+			writeTokenError(w, http.StatusBadGateway, "server_error", fmt.Sprintf("failed to request token: %v", err))
+			return
+		}
+		if status != http.StatusOK {
+			// e.g. the user has not entered the code yet
+			writeJSON(w, status, json.RawMessage(body))
+			return
+		}
+		oauth2Token, err = parseToken(body)
+		if err != nil {
+			writeTokenError(w, http.StatusBadGateway, "server_error", err.Error())
+			return
+		}
+	case "refresh_token":
+		refreshToken := r.PostFormValue("refresh_token")
+		if refreshToken == "" {
+			writeTokenError(w, http.StatusBadRequest, "invalid_request", "refresh_token is required")
+			return
+		}
+		var err error
+		oauth2Token, err = s.config.TokenSource(context.WithValue(ctx, oauth2.HTTPClient, s.httpClient), &oauth2.Token{RefreshToken: refreshToken}).Token()
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			writeJSON(w, retrieveErr.Response.StatusCode, json.RawMessage(retrieveErr.Body))
+			return
+		}
+		if err != nil {
+			writeTokenError(w, http.StatusUnauthorized, "invalid_grant", fmt.Sprintf("failed to refresh token: %v", err))
+			return
+		}
+	default:
+		writeTokenError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("grant_type must be %q or \"refresh_token\"", DeviceCodeGrantType))
+		return
+	}
+	authorization, err := s.newAuthorization(ctx, oauth2Token)
+	if err != nil {
+		writeTokenError(w, http.StatusUnauthorized, "access_denied", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, &Token{
+		Authorization: authorization,
+		Expiry:        time.Now().Add(s.expiry),
+		RefreshToken:  oauth2Token.RefreshToken,
+	})
+}
+
+// postForm posts the values to the provider, authenticated as the Argo Server's client, and returns the response
+func (s *sso) postForm(ctx context.Context, u string, values url.Values) (int, []byte, error) {
+	values.Set("client_id", s.config.ClientID)
+	values.Set("client_secret", s.config.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(values.Encode()))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, err
+	}
+	if !json.Valid(body) {
+		// so it can be returned as is
+		body, _ = json.Marshal(&TokenError{Error: "server_error", ErrorDescription: fmt.Sprintf("the SSO provider returned %s", resp.Status)})
+	}
+	return resp.StatusCode, body, nil
+}
+
+// parseToken parses the provider's response to a token request, as per RFC 6749
+func parseToken(body []byte) (*oauth2.Token, error) {
+	var res struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+		IDToken      string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	token := &oauth2.Token{AccessToken: res.AccessToken, TokenType: res.TokenType, RefreshToken: res.RefreshToken}
+	if res.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+	return token.WithExtra(map[string]interface{}{"id_token": res.IDToken}), nil
+}
+
+func writeTokenError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, &TokenError{Error: code, ErrorDescription: description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,171 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// fakeKeySet verifies ID tokens signed with the key
+type fakeKeySet struct{ key *rsa.PrivateKey }
+
+func (k fakeKeySet) VerifySignature(_ context.Context, raw string) ([]byte, error) {
+	sig, err := jose.ParseSigned(raw)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Verify(&k.key.PublicKey)
+}
+
+// newDeviceTestSSO returns an sso, for a fake provider, which issues ID tokens signed with the key
+func newDeviceTestSSO(t *testing.T, deviceAuth bool) *sso {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+	var provider *httptest.Server
+	idToken := func(subject string) string {
+		raw, err := jwt.Signed(signer).Claims(jwt.Claims{
+			Issuer:   provider.URL,
+			Subject:  subject,
+			Audience: jwt.Audience{"my-client"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}).CompactSerialize()
+		require.NoError(t, err)
+		return raw
+	}
+	provider = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("client_id") != "my-client" || r.PostFormValue("client_secret") != "my-secret" {
+			writeTokenError(w, http.StatusUnauthorized, "invalid_client", "")
+			return
+		}
+		switch r.URL.Path + " " + r.PostFormValue("grant_type") {
+		case "/device ":
+			writeJSON(w, http.StatusOK, &DeviceCode{DeviceCode: "my-device-code", UserCode: "ABCD-EFGH", VerificationURI: "https://sso/device", ExpiresIn: 600, Interval: 5})
+		case "/token " + DeviceCodeGrantType:
+			if r.PostFormValue("device_code") != "entered" {
+				writeTokenError(w, http.StatusBadRequest, "authorization_pending", "")
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"access_token": "my-access-token", "token_type": "Bearer", "refresh_token": "my-refresh-token", "id_token": idToken("my-user")})
+		case "/token refresh_token":
+			if r.PostFormValue("refresh_token") != "my-refresh-token" {
+				writeTokenError(w, http.StatusBadRequest, "invalid_grant", "")
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"access_token": "my-access-token", "token_type": "Bearer", "id_token": idToken("my-refreshed-user")})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(provider.Close)
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: privateKey.Public()}, nil)
+	require.NoError(t, err)
+	s := &sso{
+		config: &oauth2.Config{
+			ClientID:     "my-client",
+			ClientSecret: "my-secret",
+			Endpoint:     oauth2.Endpoint{TokenURL: provider.URL + "/token", AuthStyle: oauth2.AuthStyleInParams},
+			Scopes:       []string{oidc.ScopeOpenID},
+		},
+		idTokenVerifier: oidc.NewVerifier(provider.URL, fakeKeySet{key}, &oidc.Config{ClientID: "my-client"}),
+		httpClient:      provider.Client(),
+		privateKey:      privateKey,
+		encrypter:       encrypter,
+		expiry:          time.Hour,
+	}
+	if deviceAuth {
+		s.deviceAuthURL = provider.URL + "/device"
+	}
+	return s
+}
+
+func postToken(s *sso, values url.Values) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/token", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	s.HandleToken(w, r)
+	return w
+}
+
+func TestHandleDeviceCode(t *testing.T) {
+	t.Run("NotSupported", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newDeviceTestSSO(t, false).HandleDeviceCode(w, httptest.NewRequest(http.MethodPost, "/oauth2/device", nil))
+		assert.Equal(t, http.StatusNotImplemented, w.Code)
+	})
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newDeviceTestSSO(t, true).HandleDeviceCode(w, httptest.NewRequest(http.MethodGet, "/oauth2/device", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+	t.Run("DeviceCode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newDeviceTestSSO(t, true).HandleDeviceCode(w, httptest.NewRequest(http.MethodPost, "/oauth2/device", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		code := &DeviceCode{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), code))
+		assert.Equal(t, "my-device-code", code.DeviceCode)
+		assert.Equal(t, "ABCD-EFGH", code.UserCode)
+		assert.Equal(t, int64(5), code.Interval)
+	})
+}
+
+func TestHandleToken(t *testing.T) {
+	s := newDeviceTestSSO(t, true)
+	t.Run("UnsupportedGrantType", func(t *testing.T) {
+		w := postToken(s, url.Values{"grant_type": {"password"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "unsupported_grant_type")
+	})
+	t.Run("AuthorizationPending", func(t *testing.T) {
+		w := postToken(s, url.Values{"grant_type": {DeviceCodeGrantType}, "device_code": {"not-entered"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		tokenErr := &TokenError{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), tokenErr))
+		assert.Equal(t, "authorization_pending", tokenErr.Error)
+	})
+	t.Run("DeviceCode", func(t *testing.T) {
+		w := postToken(s, url.Values{"grant_type": {DeviceCodeGrantType}, "device_code": {"entered"}})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		token := &Token{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), token))
+		assert.Equal(t, "my-refresh-token", token.RefreshToken)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+		claims, err := s.Authorize(token.Authorization)
+		require.NoError(t, err)
+		assert.Equal(t, "my-user", claims.Subject)
+	})
+	t.Run("RefreshToken", func(t *testing.T) {
+		w := postToken(s, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"my-refresh-token"}})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		token := &Token{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), token))
+		// the provider did not issue a new one, so the old one can still be used
+		assert.Equal(t, "my-refresh-token", token.RefreshToken)
+		claims, err := s.Authorize(token.Authorization)
+		require.NoError(t, err)
+		assert.Equal(t, "my-refreshed-user", claims.Subject)
+	})
+	t.Run("InvalidRefreshToken", func(t *testing.T) {
+		w := postToken(s, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"revoked"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid_grant")
+	})
+}
@@ -0,0 +1,59 @@
+package sso
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+// ImpersonateConfig configures the Argo Server to make Kubernetes requests as the user, using the
+// `Impersonate-User` and `Impersonate-Group` headers, so that Kubernetes RBAC governs what each user can do.
+// The username and groups should match those the Kubernetes API server gets from the same OIDC provider.
+type ImpersonateConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// UsernameClaim is the claim used as the username, one of "sub", "email", or "preferred_username". Defaults to "sub".
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// UsernamePrefix is prepended to the username, e.g. "oidc:", as per the API server's `--oidc-username-prefix`
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+	// GroupsPrefix is prepended to each group, e.g. "oidc:", as per the API server's `--oidc-groups-prefix`
+	GroupsPrefix string `json:"groupsPrefix,omitempty"`
+}
+
+func (c *ImpersonateConfig) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+func (c *ImpersonateConfig) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	switch c.UsernameClaim {
+	case "", "sub", "email", "preferred_username":
+		return nil
+	default:
+		return fmt.Errorf("impersonate.usernameClaim %q must be one of sub, email, or preferred_username", c.UsernameClaim)
+	}
+}
+
+// ImpersonationConfig returns the user to impersonate for the claims
+func (c *ImpersonateConfig) ImpersonationConfig(claims *types.Claims) (rest.ImpersonationConfig, error) {
+	var username string
+	switch c.UsernameClaim {
+	case "email":
+		username = claims.Email
+	case "preferred_username":
+		username = claims.PreferredUsername
+	default:
+		username = claims.Subject
+	}
+	if username == "" {
+		return rest.ImpersonationConfig{}, fmt.Errorf("cannot impersonate a user without a username")
+	}
+	groups := make([]string, len(claims.Groups))
+	for i, group := range claims.Groups {
+		groups[i] = c.GroupsPrefix + group
+	}
+	return rest.ImpersonationConfig{UserName: c.UsernamePrefix + username, Groups: groups}, nil
+}
@@ -0,0 +1,40 @@
+package sso
+
+import (
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestImpersonateConfig(t *testing.T) {
+	claims := &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "me@example.com", PreferredUsername: "me", Groups: []string{"a", "b"}}
+	t.Run("IsEnabled", func(t *testing.T) {
+		assert.False(t, (*ImpersonateConfig)(nil).IsEnabled())
+		assert.True(t, (&ImpersonateConfig{Enabled: true}).IsEnabled())
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, (*ImpersonateConfig)(nil).Validate())
+		assert.NoError(t, (&ImpersonateConfig{Enabled: true, UsernameClaim: "email"}).Validate())
+		assert.Error(t, (&ImpersonateConfig{Enabled: true, UsernameClaim: "groups"}).Validate())
+	})
+	t.Run("Subject", func(t *testing.T) {
+		c, err := (&ImpersonateConfig{Enabled: true}).ImpersonationConfig(claims)
+		if assert.NoError(t, err) {
+			assert.Equal(t, rest.ImpersonationConfig{UserName: "my-sub", Groups: []string{"a", "b"}}, c)
+		}
+	})
+	t.Run("PreferredUsername", func(t *testing.T) {
+		c, err := (&ImpersonateConfig{Enabled: true, UsernameClaim: "preferred_username", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"}).ImpersonationConfig(claims)
+		if assert.NoError(t, err) {
+			assert.Equal(t, rest.ImpersonationConfig{UserName: "oidc:me", Groups: []string{"oidc:a", "oidc:b"}}, c)
+		}
+	})
+	t.Run("NoUsername", func(t *testing.T) {
+		_, err := (&ImpersonateConfig{Enabled: true, UsernameClaim: "email"}).ImpersonationConfig(&types.Claims{})
+		assert.EqualError(t, err, "cannot impersonate a user without a username")
+	})
+}
@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	rbac "github.com/argoproj/argo-workflows/v3/server/auth/rbac"
+
+	sso "github.com/argoproj/argo-workflows/v3/server/auth/sso"
+
 	types "github.com/argoproj/argo-workflows/v3/server/auth/types"
 )
 
@@ -43,11 +47,37 @@ func (_m *Interface) HandleCallback(writer http.ResponseWriter, request *http.Re
 	_m.Called(writer, request)
 }
 
+// HandleDeviceCode provides a mock function with given fields: writer, request
+func (_m *Interface) HandleDeviceCode(writer http.ResponseWriter, request *http.Request) {
+	_m.Called(writer, request)
+}
+
 // HandleRedirect provides a mock function with given fields: writer, request
 func (_m *Interface) HandleRedirect(writer http.ResponseWriter, request *http.Request) {
 	_m.Called(writer, request)
 }
 
+// HandleToken provides a mock function with given fields: writer, request
+func (_m *Interface) HandleToken(writer http.ResponseWriter, request *http.Request) {
+	_m.Called(writer, request)
+}
+
+// ImpersonateConfig provides a mock function with given fields:
+func (_m *Interface) ImpersonateConfig() *sso.ImpersonateConfig {
+	ret := _m.Called()
+
+	var r0 *sso.ImpersonateConfig
+	if rf, ok := ret.Get(0).(func() *sso.ImpersonateConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sso.ImpersonateConfig)
+		}
+	}
+
+	return r0
+}
+
 // IsRBACEnabled provides a mock function with given fields:
 func (_m *Interface) IsRBACEnabled() bool {
 	ret := _m.Called()
@@ -61,3 +91,19 @@ func (_m *Interface) IsRBACEnabled() bool {
 
 	return r0
 }
+
+// RBACConfig provides a mock function with given fields:
+func (_m *Interface) RBACConfig() *rbac.Config {
+	ret := _m.Called()
+
+	var r0 *rbac.Config
+	if rf, ok := ret.Get(0).(func() *rbac.Config); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rbac.Config)
+		}
+	}
+
+	return r0
+}
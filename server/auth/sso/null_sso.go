@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 )
 
@@ -15,6 +16,14 @@ func (n nullService) IsRBACEnabled() bool {
 	return false
 }
 
+func (n nullService) RBACConfig() *rbac.Config {
+	return nil
+}
+
+func (n nullService) ImpersonateConfig() *ImpersonateConfig {
+	return nil
+}
+
 func (n nullService) Authorize(string) (*types.Claims, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -26,3 +35,11 @@ func (n nullService) HandleRedirect(w http.ResponseWriter, _ *http.Request) {
 func (n nullService) HandleCallback(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
+
+func (n nullService) HandleDeviceCode(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (n nullService) HandleToken(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
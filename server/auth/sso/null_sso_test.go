@@ -24,3 +24,15 @@ func Test_nullSSO_HandleRedirect(t *testing.T) {
 	NullSSO.HandleRedirect(w, &http.Request{})
 	assert.Equal(t, http.StatusNotImplemented, w.StatusCode)
 }
+
+func Test_nullSSO_HandleDeviceCode(t *testing.T) {
+	w := &testhttp.TestResponseWriter{}
+	NullSSO.HandleDeviceCode(w, &http.Request{})
+	assert.Equal(t, http.StatusNotImplemented, w.StatusCode)
+}
+
+func Test_nullSSO_HandleToken(t *testing.T) {
+	w := &testhttp.TestResponseWriter{}
+	NullSSO.HandleToken(w, &http.Request{})
+	assert.Equal(t, http.StatusNotImplemented, w.StatusCode)
+}
@@ -40,30 +40,45 @@ type Interface interface {
 	Authorize(authorization string) (*types.Claims, error)
 	HandleRedirect(writer http.ResponseWriter, request *http.Request)
 	HandleCallback(writer http.ResponseWriter, request *http.Request)
+	HandleDeviceCode(writer http.ResponseWriter, request *http.Request)
+	HandleToken(writer http.ResponseWriter, request *http.Request)
 	IsRBACEnabled() bool
+	RBACConfig() *rbac.Config
+	ImpersonateConfig() *ImpersonateConfig
 }
 
 var _ Interface = &sso{}
 
 type sso struct {
-	config          *oauth2.Config
-	issuer          string
-	idTokenVerifier *oidc.IDTokenVerifier
-	httpClient      *http.Client
-	baseHRef        string
-	secure          bool
-	privateKey      crypto.PrivateKey
-	encrypter       jose.Encrypter
-	rbacConfig      *rbac.Config
-	expiry          time.Duration
-	customClaimName string
-	userInfoPath    string
+	config            *oauth2.Config
+	issuer            string
+	idTokenVerifier   *oidc.IDTokenVerifier
+	httpClient        *http.Client
+	baseHRef          string
+	secure            bool
+	privateKey        crypto.PrivateKey
+	encrypter         jose.Encrypter
+	rbacConfig        *rbac.Config
+	impersonateConfig *ImpersonateConfig
+	expiry            time.Duration
+	customClaimName   string
+	userInfoPath      string
+	// the provider's device authorization endpoint, empty if it does not support the device authorization grant
+	deviceAuthURL string
 }
 
 func (s *sso) IsRBACEnabled() bool {
 	return s.rbacConfig.IsEnabled()
 }
 
+func (s *sso) RBACConfig() *rbac.Config {
+	return s.rbacConfig
+}
+
+func (s *sso) ImpersonateConfig() *ImpersonateConfig {
+	return s.impersonateConfig
+}
+
 type Config struct {
 	Issuer       string                  `json:"issuer"`
 	IssuerAlias  string                  `json:"issuerAlias,omitempty"`
@@ -71,6 +86,8 @@ type Config struct {
 	ClientSecret apiv1.SecretKeySelector `json:"clientSecret"`
 	RedirectURL  string                  `json:"redirectUrl"`
 	RBAC         *rbac.Config            `json:"rbac,omitempty"`
+	// Impersonate makes Kubernetes requests as the user, rather than as a service account. It may not be used with RBAC.
+	Impersonate *ImpersonateConfig `json:"impersonate,omitempty"`
 	// additional scopes (on top of "openid")
 	Scopes        []string        `json:"scopes,omitempty"`
 	SessionExpiry metav1.Duration `json:"sessionExpiry,omitempty"`
@@ -94,6 +111,7 @@ func (c Config) GetSessionExpiry() time.Duration {
 type providerInterface interface {
 	Endpoint() oauth2.Endpoint
 	Verifier(config *oidc.Config) *oidc.IDTokenVerifier
+	Claims(v interface{}) error
 }
 
 type providerFactory func(ctx context.Context, issuer string) (providerInterface, error)
@@ -122,6 +140,15 @@ func newSso(
 	if c.ClientSecret.Name == "" || c.ClientSecret.Key == "" {
 		return nil, fmt.Errorf("clientSecret empty")
 	}
+	if err := c.RBAC.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.Impersonate.Validate(); err != nil {
+		return nil, err
+	}
+	if c.RBAC.IsEnabled() && c.Impersonate.IsEnabled() {
+		return nil, fmt.Errorf("rbac and impersonate may not both be enabled")
+	}
 	ctx := context.Background()
 	clientSecretObj, err := secretsIf.Get(ctx, c.ClientSecret.Name, metav1.GetOptions{})
 	if err != nil {
@@ -189,29 +216,37 @@ func newSso(
 		Scopes:       append(c.Scopes, oidc.ScopeOpenID),
 	}
 	idTokenVerifier := provider.Verifier(&oidc.Config{ClientID: config.ClientID})
+	var discovery struct {
+		DeviceAuthURL string `json:"device_authorization_endpoint"`
+	}
+	if err := provider.Claims(&discovery); err != nil {
+		return nil, fmt.Errorf("failed to read provider metadata: %w", err)
+	}
 	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: privateKey.Public()}, &jose.EncrypterOptions{Compression: jose.DEFLATE})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JWT encrpytor: %w", err)
 	}
-	lf := log.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "issuerAlias": "DISABLED", "clientId": c.ClientID, "scopes": config.Scopes, "insecureSkipVerify": c.InsecureSkipVerify}
+	lf := log.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "issuerAlias": "DISABLED", "clientId": c.ClientID, "scopes": config.Scopes, "insecureSkipVerify": c.InsecureSkipVerify, "deviceAuthUrl": discovery.DeviceAuthURL}
 	if c.IssuerAlias != "" {
 		lf["issuerAlias"] = c.IssuerAlias
 	}
 	log.WithFields(lf).Info("SSO configuration")
 
 	return &sso{
-		config:          config,
-		idTokenVerifier: idTokenVerifier,
-		baseHRef:        baseHRef,
-		httpClient:      httpClient,
-		secure:          secure,
-		privateKey:      privateKey,
-		encrypter:       encrypter,
-		rbacConfig:      c.RBAC,
-		expiry:          c.GetSessionExpiry(),
-		customClaimName: c.CustomGroupClaimName,
-		userInfoPath:    c.UserInfoPath,
-		issuer:          c.Issuer,
+		config:            config,
+		idTokenVerifier:   idTokenVerifier,
+		baseHRef:          baseHRef,
+		httpClient:        httpClient,
+		secure:            secure,
+		privateKey:        privateKey,
+		encrypter:         encrypter,
+		rbacConfig:        c.RBAC,
+		impersonateConfig: c.Impersonate,
+		expiry:            c.GetSessionExpiry(),
+		customClaimName:   c.CustomGroupClaimName,
+		userInfoPath:      c.UserInfoPath,
+		issuer:            c.Issuer,
+		deviceAuthURL:     discovery.DeviceAuthURL,
 	}, nil
 }
 
@@ -255,23 +290,49 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte(fmt.Sprintf("failed to exchange token: %v", err)))
 		return
 	}
-	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
-	if !ok {
+	value, err := s.newAuthorization(ctx, oauth2Token)
+	if err != nil {
 		w.WriteHeader(401)
-		_, _ = w.Write([]byte("failed to get id_token"))
+		_, _ = w.Write([]byte(err.Error()))
 		return
 	}
+	log.Debugf("handing oauth2 callback %v", value)
+	http.SetCookie(w, &http.Cookie{
+		Value:    value,
+		Name:     "authorization",
+		Path:     s.baseHRef,
+		Expires:  time.Now().Add(s.expiry),
+		SameSite: http.SameSiteStrictMode,
+		Secure:   s.secure,
+	})
+	redirect := s.baseHRef
+
+	proto := "http"
+	if s.secure {
+		proto = "https"
+	}
+	prefix := fmt.Sprintf("%s://%s%s", proto, r.Host, s.baseHRef)
+
+	if strings.HasPrefix(cookie.Value, prefix) {
+		redirect = cookie.Value
+	}
+	http.Redirect(w, r, redirect, 302)
+}
+
+// newAuthorization verifies the ID token the provider issued, and returns an authorization for the Argo Server, which
+// holds the user's claims
+func (s *sso) newAuthorization(ctx context.Context, oauth2Token *oauth2.Token) (string, error) {
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return "", fmt.Errorf("failed to get id_token")
+	}
 	idToken, err := s.idTokenVerifier.Verify(ctx, rawIDToken)
 	if err != nil {
-		w.WriteHeader(401)
-		_, _ = w.Write([]byte(fmt.Sprintf("failed to verify token: %v", err)))
-		return
+		return "", fmt.Errorf("failed to verify token: %v", err)
 	}
 	c := &types.Claims{}
 	if err := idToken.Claims(c); err != nil {
-		w.WriteHeader(401)
-		_, _ = w.Write([]byte(fmt.Sprintf("failed to get claims: %v", err)))
-		return
+		return "", fmt.Errorf("failed to get claims: %v", err)
 	}
 
 	// Default to groups claim but if customClaimName is set
@@ -280,9 +341,7 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	if s.customClaimName != "" {
 		groups, err = c.GetCustomGroup(s.customClaimName)
 		if err != nil {
-			w.WriteHeader(401)
-			_, _ = w.Write([]byte(fmt.Sprintf("failed to get custom claim: %v", err)))
-			return
+			return "", fmt.Errorf("failed to get custom claim: %v", err)
 		}
 	}
 
@@ -291,9 +350,7 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	if s.userInfoPath != "" {
 		groups, err = c.GetUserInfoGroups(oauth2Token.AccessToken, s.issuer, s.userInfoPath)
 		if err != nil {
-			w.WriteHeader(401)
-			_, _ = w.Write([]byte(fmt.Sprintf("failed to get groups claim: %v", err)))
-			return
+			return "", fmt.Errorf("failed to get groups claim: %v", err)
 		}
 	}
 
@@ -313,32 +370,9 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 
 	raw, err := jwt.Encrypted(s.encrypter).Claims(argoClaims).CompactSerialize()
 	if err != nil {
-		w.WriteHeader(401)
-		_, _ = w.Write([]byte(fmt.Sprintf("failed to encode claims: %v", err)))
-		return
+		return "", fmt.Errorf("failed to encode claims: %v", err)
 	}
-	value := Prefix + raw
-	log.Debugf("handing oauth2 callback %v", value)
-	http.SetCookie(w, &http.Cookie{
-		Value:    value,
-		Name:     "authorization",
-		Path:     s.baseHRef,
-		Expires:  time.Now().Add(s.expiry),
-		SameSite: http.SameSiteStrictMode,
-		Secure:   s.secure,
-	})
-	redirect := s.baseHRef
-
-	proto := "http"
-	if s.secure {
-		proto = "https"
-	}
-	prefix := fmt.Sprintf("%s://%s%s", proto, r.Host, s.baseHRef)
-
-	if strings.HasPrefix(cookie.Value, prefix) {
-		redirect = cookie.Value
-	}
-	http.Redirect(w, r, redirect, 302)
+	return Prefix + raw, nil
 }
 
 // authorize verifies a bearer token and pulls user information form the claims.
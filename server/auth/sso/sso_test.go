@@ -11,6 +11,8 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
 )
 
 const testNamespace = "argo"
@@ -28,6 +30,10 @@ func (fakeOidcProvider) Verifier(config *oidc.Config) *oidc.IDTokenVerifier {
 	return nil
 }
 
+func (fakeOidcProvider) Claims(v interface{}) error {
+	return nil
+}
+
 func fakeOidcFactory(ctx context.Context, issuer string) (providerInterface, error) {
 	return fakeOidcProvider{ctx, issuer}, nil
 }
@@ -126,6 +132,20 @@ func TestLoadSsoClientIdFromSecretNoKeyFails(t *testing.T) {
 	assert.Regexp(t, "key nonexistent missing in secret argo-sso-secret", err.Error())
 }
 
+func TestNewSsoRBACAndImpersonateFails(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(ssoConfigSecret).CoreV1().Secrets(testNamespace)
+	config := Config{
+		Issuer:       "https://test-issuer",
+		ClientID:     getSecretKeySelector("argo-sso-secret", "client-id"),
+		ClientSecret: getSecretKeySelector("argo-sso-secret", "client-secret"),
+		RedirectURL:  "https://dummy",
+		RBAC:         &rbac.Config{Enabled: true},
+		Impersonate:  &ImpersonateConfig{Enabled: true},
+	}
+	_, err := newSso(fakeOidcFactory, config, fakeClient, "/", false)
+	assert.EqualError(t, err, "rbac and impersonate may not both be enabled")
+}
+
 func TestGetSessionExpiry(t *testing.T) {
 	config := Config{
 		SessionExpiry: metav1.Duration{Duration: 5 * time.Hour},
@@ -16,6 +16,11 @@ type Claims struct {
 	ServiceAccountName string                 `json:"service_account_name,omitempty"`
 	PreferredUsername  string                 `json:"preferred_username,omitempty"`
 	RawClaim           map[string]interface{} `json:"-"`
+	// ReadOnly is true if the SSO RBAC rule, or service account, the user was mapped to is read-only
+	ReadOnly bool `json:"-"`
+	// WorkflowActions are the only actions on workflows the user may perform, as per the SSO RBAC rule or service
+	// account they were mapped to. If nil, the user may perform any action.
+	WorkflowActions []string `json:"-"`
 }
 
 type UserInfo struct {
@@ -0,0 +1,35 @@
+package types
+
+// The actions on workflows that SSO RBAC can allow separately, e.g. so users can approve (resume) workflows, but not
+// terminate or delete them. Each is still subject to the service account's Kubernetes RBAC.
+const (
+	WorkflowActionDelete    = "delete"
+	WorkflowActionResubmit  = "resubmit"
+	WorkflowActionResume    = "resume"
+	WorkflowActionRetry     = "retry"
+	WorkflowActionSet       = "set"
+	WorkflowActionStop      = "stop"
+	WorkflowActionSuspend   = "suspend"
+	WorkflowActionTerminate = "terminate"
+)
+
+var WorkflowActions = []string{
+	WorkflowActionDelete,
+	WorkflowActionResubmit,
+	WorkflowActionResume,
+	WorkflowActionRetry,
+	WorkflowActionSet,
+	WorkflowActionStop,
+	WorkflowActionSuspend,
+	WorkflowActionTerminate,
+}
+
+// IsWorkflowAction returns true if the action is one of WorkflowActions
+func IsWorkflowAction(action string) bool {
+	for _, a := range WorkflowActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/server/metrics"
+)
+
+// CanPerformWorkflowAction returns a permission denied error if the user may not perform the action, one of
+// types.WorkflowActions, because their SSO RBAC rule or service account does not allow it
+func CanPerformWorkflowAction(ctx context.Context, action string) error {
+	claims := GetClaims(ctx)
+	if claims == nil || claims.WorkflowActions == nil {
+		return nil
+	}
+	for _, a := range claims.WorkflowActions {
+		if a == action {
+			return nil
+		}
+	}
+	method, _ := grpc.Method(ctx)
+	metrics.RejectedRequest(method, metrics.ReasonWorkflowAction)
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("you are not allowed to %s workflows", action))
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestCanPerformWorkflowAction(t *testing.T) {
+	t.Run("NoClaims", func(t *testing.T) {
+		assert.NoError(t, CanPerformWorkflowAction(context.Background(), types.WorkflowActionTerminate))
+	})
+	t.Run("Unrestricted", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ClaimsKey, &types.Claims{})
+		assert.NoError(t, CanPerformWorkflowAction(ctx, types.WorkflowActionTerminate))
+	})
+	t.Run("Restricted", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ClaimsKey, &types.Claims{WorkflowActions: []string{types.WorkflowActionResume}})
+		assert.NoError(t, CanPerformWorkflowAction(ctx, types.WorkflowActionResume))
+		err := CanPerformWorkflowAction(ctx, types.WorkflowActionTerminate)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = you are not allowed to terminate workflows")
+	})
+	t.Run("None", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ClaimsKey, &types.Claims{WorkflowActions: []string{}})
+		assert.Error(t, CanPerformWorkflowAction(ctx, types.WorkflowActionResume))
+	})
+}
@@ -0,0 +1,96 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+)
+
+// Config is another cluster whose workflows the Argo Server lists, gets, and shows the logs of, as well as the
+// workflows in the cluster it runs in
+type Config struct {
+	// Name of the cluster, shown with its workflows, and used to select it in requests
+	Name string `json:"name"`
+	// Kubeconfig is a key of a secret, in the Argo Server's namespace, containing the kubeconfig used to access the
+	// cluster. The credentials are used to impersonate each user, so they need only be allowed to impersonate.
+	Kubeconfig apiv1.SecretKeySelector `json:"kubeconfig"`
+}
+
+// Cluster is another cluster, and how to access it
+type Cluster struct {
+	Name string
+	// NewClients returns clients for the cluster that impersonate the user
+	NewClients func(impersonate rest.ImpersonationConfig) (versioned.Interface, kubernetes.Interface, error)
+}
+
+// withClients returns the context with the cluster's clients, impersonating the user, in place of the user's clients,
+// so servers act on the cluster with the user's permissions in it
+func (c Cluster) withClients(ctx context.Context) (context.Context, error) {
+	claims := auth.GetClaims(ctx)
+	if claims == nil || claims.Subject == "" {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("cannot access cluster %q without a user to impersonate", c.Name))
+	}
+	wfClient, kubeClient, err := c.NewClients(rest.ImpersonationConfig{UserName: claims.Subject, Groups: claims.Groups})
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, auth.WfKey, wfClient)
+	return context.WithValue(ctx, auth.KubeKey, kubeClient), nil
+}
+
+// New returns the clusters for the configs. localName is the name of the cluster the Argo Server runs in, which a
+// config may not use.
+func New(ctx context.Context, configs []Config, localName string, secrets corev1.SecretInterface) ([]Cluster, error) {
+	names := map[string]bool{localName: true}
+	var clusters []Cluster
+	for i, c := range configs {
+		if c.Name == "" {
+			return nil, fmt.Errorf("clusters[%d].name is required", i)
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("clusters[%d].name %q is not unique", i, c.Name)
+		}
+		names[c.Name] = true
+		secret, err := secrets.Get(ctx, c.Kubeconfig.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kubeconfig for cluster %q: %w", c.Name, err)
+		}
+		data, ok := secret.Data[c.Kubeconfig.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s missing in secret %s for cluster %q", c.Kubeconfig.Key, c.Kubeconfig.Name, c.Name)
+		}
+		restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig for cluster %q: %w", c.Name, err)
+		}
+		clusters = append(clusters, Cluster{Name: c.Name, NewClients: newClientsFunc(c.Name, restConfig)})
+	}
+	return clusters, nil
+}
+
+func newClientsFunc(name string, restConfig *rest.Config) func(rest.ImpersonationConfig) (versioned.Interface, kubernetes.Interface, error) {
+	return func(impersonate rest.ImpersonationConfig) (versioned.Interface, kubernetes.Interface, error) {
+		restConfig := rest.CopyConfig(restConfig)
+		restConfig.Impersonate = impersonate
+		wfClient, err := versioned.NewForConfig(restConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failure to create workflow client for cluster %q: %w", name, err)
+		}
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failure to create kubernetes client for cluster %q: %w", name, err)
+		}
+		return wfClient, kubeClient, nil
+	}
+}
@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging
+  cluster:
+    server: https://staging:6443
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: staging
+current-context: staging
+users:
+- name: staging
+  user:
+    token: my-token
+`
+
+func TestNew(t *testing.T) {
+	secrets := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "clusters", Namespace: "argo"},
+		Data:       map[string][]byte{"staging": []byte(kubeconfig)},
+	}).CoreV1().Secrets("argo")
+	newConfig := func(name, key string) Config {
+		return Config{Name: name, Kubeconfig: apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "clusters"}, Key: key}}
+	}
+	ctx := context.Background()
+	t.Run("None", func(t *testing.T) {
+		clusters, err := New(ctx, nil, "prod", secrets)
+		assert.NoError(t, err)
+		assert.Empty(t, clusters)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		clusters, err := New(ctx, []Config{newConfig("staging", "staging")}, "prod", secrets)
+		if assert.NoError(t, err) && assert.Len(t, clusters, 1) {
+			assert.Equal(t, "staging", clusters[0].Name)
+			wfClient, kubeClient, err := clusters[0].NewClients(rest.ImpersonationConfig{UserName: "my-user"})
+			if assert.NoError(t, err) {
+				assert.NotNil(t, wfClient)
+				assert.NotNil(t, kubeClient)
+			}
+		}
+	})
+	t.Run("NoName", func(t *testing.T) {
+		_, err := New(ctx, []Config{newConfig("", "staging")}, "prod", secrets)
+		assert.EqualError(t, err, "clusters[0].name is required")
+	})
+	t.Run("LocalName", func(t *testing.T) {
+		_, err := New(ctx, []Config{newConfig("prod", "staging")}, "prod", secrets)
+		assert.EqualError(t, err, `clusters[0].name "prod" is not unique`)
+	})
+	t.Run("DuplicateName", func(t *testing.T) {
+		_, err := New(ctx, []Config{newConfig("staging", "staging"), newConfig("staging", "staging")}, "prod", secrets)
+		assert.EqualError(t, err, `clusters[1].name "staging" is not unique`)
+	})
+	t.Run("MissingKey", func(t *testing.T) {
+		_, err := New(ctx, []Config{newConfig("staging", "missing")}, "prod", secrets)
+		assert.EqualError(t, err, `key missing missing in secret clusters for cluster "staging"`)
+	})
+}
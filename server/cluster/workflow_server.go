@@ -0,0 +1,247 @@
+package cluster
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+type workflowServer struct {
+	// the server for the cluster the Argo Server runs in, this serves every request that is not for another cluster
+	workflowpkg.WorkflowServiceServer
+	localName string
+	clusters  []Cluster
+}
+
+// NewWorkflowServer returns a server that lists, gets, and shows the logs of workflows in the other clusters, as
+// well as in the cluster the Argo Server runs in. Every other request is served by the delegate. If there are no
+// other clusters, the delegate is returned.
+func NewWorkflowServer(delegate workflowpkg.WorkflowServiceServer, localName string, clusters []Cluster) workflowpkg.WorkflowServiceServer {
+	if len(clusters) == 0 {
+		return delegate
+	}
+	return &workflowServer{delegate, localName, clusters}
+}
+
+// clusterContext returns the context for requests to the named cluster, which is the cluster the Argo Server runs
+// in if the name is empty
+func (s *workflowServer) clusterContext(ctx context.Context, name string) (context.Context, error) {
+	if name == "" || name == s.localName {
+		return ctx, nil
+	}
+	for _, c := range s.clusters {
+		if c.Name == name {
+			return c.withClients(ctx)
+		}
+	}
+	return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown cluster %q", name))
+}
+
+func setCluster(wf *wfv1.Workflow, name string) {
+	if wf.Annotations == nil {
+		wf.Annotations = map[string]string{}
+	}
+	wf.Annotations[common.AnnotationKeyCluster] = name
+}
+
+// GetWorkflow gets the workflow from the cluster in the request. If the request does not have one, the workflow is
+// got from the cluster the Argo Server runs in, or if it is not found there, from the first other cluster it is in.
+func (s *workflowServer) GetWorkflow(ctx context.Context, req *workflowpkg.WorkflowGetRequest) (*wfv1.Workflow, error) {
+	var wf *wfv1.Workflow
+	err := s.firstCluster(ctx, req.Cluster, func(ctx context.Context, name string) error {
+		var err error
+		wf, err = s.WorkflowServiceServer.GetWorkflow(ctx, req)
+		if err != nil {
+			return err
+		}
+		setCluster(wf, name)
+		return nil
+	})
+	return wf, err
+}
+
+// findCluster returns the name of the cluster the workflow is in, found in the same way as GetWorkflow
+func (s *workflowServer) findCluster(ctx context.Context, cluster, namespace, name string) (string, error) {
+	var found string
+	err := s.firstCluster(ctx, cluster, func(ctx context.Context, clusterName string) error {
+		_, err := s.WorkflowServiceServer.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: namespace, Name: name, Fields: "metadata.name"})
+		found = clusterName
+		return err
+	})
+	return found, err
+}
+
+// firstCluster calls f for the cluster, or if it is empty, for each cluster in turn until f returns anything but a
+// not found error. An error from another cluster is logged and the next cluster is tried, so that a cluster that is
+// down does not stop workflows in the other clusters being found.
+func (s *workflowServer) firstCluster(ctx context.Context, cluster string, f func(ctx context.Context, name string) error) error {
+	names := []string{cluster}
+	if cluster == "" {
+		names = s.names()
+	}
+	var notFound error
+	for _, name := range names {
+		clusterCtx, err := s.clusterContext(ctx, name)
+		if err != nil {
+			return err
+		}
+		err = f(clusterCtx, name)
+		switch {
+		case err == nil:
+			return nil
+		case apierr.IsNotFound(err):
+			if notFound == nil {
+				notFound = err
+			}
+		case len(names) == 1 || name == s.localName:
+			return err
+		default:
+			log.WithField("cluster", name).WithError(err).Warn("failed to get workflow in cluster")
+		}
+	}
+	if notFound == nil {
+		notFound = status.Error(codes.NotFound, "workflow not found")
+	}
+	return notFound
+}
+
+// ListWorkflows lists the workflows in the cluster in the request, or in every cluster if the request does not have
+// one. When listing every cluster, the limit applies to each cluster, and the continue token holds the position in
+// each cluster. A cluster that cannot be listed is left out, so that every other cluster can still be seen.
+func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest) (*wfv1.WorkflowList, error) {
+	if req.Cluster != "" {
+		clusterCtx, err := s.clusterContext(ctx, req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		list, err := s.WorkflowServiceServer.ListWorkflows(clusterCtx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			setCluster(&list.Items[i], req.Cluster)
+		}
+		return list, nil
+	}
+	var continues map[string]string
+	if req.ListOptions != nil && req.ListOptions.Continue != "" {
+		var err error
+		continues, err = parseContinue(req.ListOptions.Continue)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "listOptions.continue is invalid")
+		}
+	}
+	res := &wfv1.WorkflowList{}
+	nextContinues := map[string]string{}
+	for _, name := range s.names() {
+		clusterReq := *req
+		if req.ListOptions != nil {
+			listOptions := *req.ListOptions
+			clusterReq.ListOptions = &listOptions
+		}
+		if continues != nil {
+			// a cluster without a continue token was finished on an earlier page
+			if continues[name] == "" {
+				continue
+			}
+			clusterReq.ListOptions.Continue = continues[name]
+		}
+		clusterCtx, err := s.clusterContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		list, err := s.WorkflowServiceServer.ListWorkflows(clusterCtx, &clusterReq)
+		if err != nil {
+			if name == s.localName {
+				return nil, err
+			}
+			log.WithField("cluster", name).WithError(err).Warn("failed to list workflows in cluster")
+			continue
+		}
+		for i := range list.Items {
+			setCluster(&list.Items[i], name)
+		}
+		res.Items = append(res.Items, list.Items...)
+		if list.Continue != "" {
+			nextContinues[name] = list.Continue
+		}
+	}
+	sort.Sort(res.Items)
+	if len(nextContinues) > 0 {
+		res.Continue = formatContinue(nextContinues)
+	}
+	return res, nil
+}
+
+// names returns the names of every cluster, starting with the one the Argo Server runs in
+func (s *workflowServer) names() []string {
+	names := []string{s.localName}
+	for _, c := range s.clusters {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// formatContinue returns a continue token holding the continue token of each cluster. It is opaque to clients.
+func formatContinue(continues map[string]string) string {
+	data, _ := json.Marshal(continues)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func parseContinue(token string) (map[string]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	continues := map[string]string{}
+	return continues, json.Unmarshal(data, &continues)
+}
+
+type podLogsServer struct {
+	workflowpkg.WorkflowService_PodLogsServer
+	ctx context.Context
+}
+
+func (s podLogsServer) Context() context.Context { return s.ctx }
+
+func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflowpkg.WorkflowService_PodLogsServer) error {
+	name, err := s.findCluster(ws.Context(), req.Cluster, req.Namespace, req.Name)
+	if err != nil {
+		return err
+	}
+	ctx, err := s.clusterContext(ws.Context(), name)
+	if err != nil {
+		return err
+	}
+	return s.WorkflowServiceServer.PodLogs(req, podLogsServer{ws, ctx})
+}
+
+type workflowLogsServer struct {
+	workflowpkg.WorkflowService_WorkflowLogsServer
+	ctx context.Context
+}
+
+func (s workflowLogsServer) Context() context.Context { return s.ctx }
+
+func (s *workflowServer) WorkflowLogs(req *workflowpkg.WorkflowLogRequest, ws workflowpkg.WorkflowService_WorkflowLogsServer) error {
+	name, err := s.findCluster(ws.Context(), req.Cluster, req.Namespace, req.Name)
+	if err != nil {
+		return err
+	}
+	ctx, err := s.clusterContext(ws.Context(), name)
+	if err != nil {
+		return err
+	}
+	return s.WorkflowServiceServer.WorkflowLogs(req, workflowLogsServer{ws, ctx})
+}
@@ -0,0 +1,151 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/server/workflow"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newWorkflow(name string) *wfv1.Workflow {
+	return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns"}}
+}
+
+func newClients(wfClient versioned.Interface, impersonated *[]rest.ImpersonationConfig) func(rest.ImpersonationConfig) (versioned.Interface, kubernetes.Interface, error) {
+	return func(impersonate rest.ImpersonationConfig) (versioned.Interface, kubernetes.Interface, error) {
+		*impersonated = append(*impersonated, impersonate)
+		return wfClient, fake.NewSimpleClientset(), nil
+	}
+}
+
+func newTestServer() (workflowpkg.WorkflowServiceServer, context.Context, *[]rest.ImpersonationConfig) {
+	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
+	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
+	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]wfv1.Nodes{}, nil)
+	delegate := workflow.NewWorkflowServer(instanceid.NewService(""), offloadNodeStatusRepo, nil, sqldb.NullWorkflowSnapshotRepo, nil)
+	local := wffake.NewSimpleClientset(newWorkflow("local-wf"))
+	staging := wffake.NewSimpleClientset(newWorkflow("staging-wf"))
+	down := wffake.NewSimpleClientset()
+	down.PrependReactor("*", "workflows", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("cluster is down")
+	})
+	var impersonated []rest.ImpersonationConfig
+	s := NewWorkflowServer(delegate, "prod", []Cluster{
+		{Name: "staging", NewClients: newClients(staging, &impersonated)},
+		{Name: "down", NewClients: newClients(down, &impersonated)},
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, local), auth.KubeKey, fake.NewSimpleClientset())
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-user"}, Groups: []string{"my-group"}})
+	return s, ctx, &impersonated
+}
+
+func TestNewWorkflowServer(t *testing.T) {
+	delegate := workflow.NewWorkflowServer(instanceid.NewService(""), nil, nil, nil, nil)
+	assert.Equal(t, delegate, NewWorkflowServer(delegate, "prod", nil))
+}
+
+func TestWorkflowServer_ListWorkflows(t *testing.T) {
+	s, ctx, impersonated := newTestServer()
+	t.Run("AllClusters", func(t *testing.T) {
+		list, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			clusters := map[string]string{}
+			for _, wf := range list.Items {
+				clusters[wf.Name] = wf.Annotations[common.AnnotationKeyCluster]
+			}
+			assert.Equal(t, map[string]string{"local-wf": "prod", "staging-wf": "staging"}, clusters)
+			assert.Empty(t, list.Continue)
+		}
+	})
+	t.Run("OneCluster", func(t *testing.T) {
+		list, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", Cluster: "staging"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "staging-wf", list.Items[0].Name)
+		}
+	})
+	t.Run("Impersonate", func(t *testing.T) {
+		*impersonated = nil
+		_, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", Cluster: "staging"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []rest.ImpersonationConfig{{UserName: "my-user", Groups: []string{"my-group"}}}, *impersonated)
+		}
+	})
+	t.Run("NoUser", func(t *testing.T) {
+		_, err := s.ListWorkflows(context.WithValue(ctx, auth.ClaimsKey, nil), &workflowpkg.WorkflowListRequest{Namespace: "my-ns", Cluster: "staging"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("ClusterDown", func(t *testing.T) {
+		_, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", Cluster: "down"})
+		assert.EqualError(t, err, "cluster is down")
+	})
+	t.Run("UnknownCluster", func(t *testing.T) {
+		_, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", Cluster: "unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("InvalidContinue", func(t *testing.T) {
+		_, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", ListOptions: &metav1.ListOptions{Continue: "!"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Continue", func(t *testing.T) {
+		// only staging has more workflows
+		list, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "my-ns", ListOptions: &metav1.ListOptions{Continue: formatContinue(map[string]string{"staging": "my-continue"})}})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "staging-wf", list.Items[0].Name)
+		}
+	})
+}
+
+func TestWorkflowServer_GetWorkflow(t *testing.T) {
+	s, ctx, _ := newTestServer()
+	t.Run("Local", func(t *testing.T) {
+		wf, err := s.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "local-wf"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "prod", wf.Annotations[common.AnnotationKeyCluster])
+		}
+	})
+	t.Run("Other", func(t *testing.T) {
+		wf, err := s.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "staging-wf"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "staging", wf.Annotations[common.AnnotationKeyCluster])
+		}
+	})
+	t.Run("Cluster", func(t *testing.T) {
+		_, err := s.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "local-wf", Cluster: "staging"})
+		assert.True(t, apierr.IsNotFound(err), "the cluster in the request is the only one looked in")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := s.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "not-found"})
+		assert.True(t, apierr.IsNotFound(err))
+	})
+}
+
+func Test_formatContinue(t *testing.T) {
+	continues, err := parseContinue(formatContinue(map[string]string{"prod": "a", "staging": "b"}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]string{"prod": "a", "staging": "b"}, continues)
+	}
+}
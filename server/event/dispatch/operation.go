@@ -28,15 +28,36 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
+// Failure is an event that could not be dispatched to a binding, with everything needed to dispatch it again
+type Failure struct {
+	Binding       wfv1.WorkflowEventBinding
+	Namespace     string
+	Discriminator string
+	Payload       *wfv1.Item
+	// Metadata is the `X-` headers of the event
+	Metadata map[string][]string
+	Err      error
+}
+
+// FailedFunc is called for each binding the event could not be dispatched to
+type FailedFunc func(failure Failure)
+
 type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
 	instanceIDService instanceid.Service
 	events            []wfv1.WorkflowEventBinding
+	namespace         string
+	discriminator     string
+	payload           *wfv1.Item
+	metadata          map[string][]string
 	env               map[string]interface{}
+	failed            FailedFunc
 }
 
-func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
+// NewOperation returns an operation that dispatches the event to the bindings. If failed is not nil, it is called
+// for each binding the event could not be dispatched to.
+func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item, failed FailedFunc) (*Operation, error) {
 	env, err := expressionEnvironment(ctx, namespace, discriminator, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow template expression environment: %w", err)
@@ -46,7 +67,12 @@ func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eve
 		eventRecorder:     eventRecorder,
 		instanceIDService: instanceIDService,
 		events:            events,
+		namespace:         namespace,
+		discriminator:     discriminator,
+		payload:           payload,
+		metadata:          metaData(ctx),
 		env:               env,
+		failed:            failed,
 	}, nil
 }
 
@@ -66,6 +92,9 @@ func (o *Operation) Dispatch(ctx context.Context) error {
 			log.WithError(err).WithFields(log.Fields{"namespace": event.Namespace, "event": event.Name}).Error("failed to dispatch from event")
 			o.eventRecorder.Event(&event, corev1.EventTypeWarning, "WorkflowEventBindingError", "failed to dispatch event: "+err.Error())
 			errs = append(errs, err)
+			if o.failed != nil {
+				o.failed(Failure{Binding: event, Namespace: o.namespace, Discriminator: o.discriminator, Payload: o.payload, Metadata: o.metadata, Err: err})
+			}
 		}
 	}
 	if len(errs) > 0 {
@@ -196,8 +225,8 @@ func expressionEnvironment(ctx context.Context, namespace, discriminator string,
 	return jsonutil.Jsonify(src)
 }
 
-func metaData(ctx context.Context) map[string]interface{} {
-	meta := make(map[string]interface{})
+func metaData(ctx context.Context) map[string][]string {
+	meta := make(map[string][]string)
 	md, _ := metadata.FromIncomingContext(ctx)
 	for k, v := range md {
 		// only allow headers `X-`  headers, e.g. `X-Github-Action`
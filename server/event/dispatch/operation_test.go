@@ -58,6 +58,7 @@ func TestNewOperation(t *testing.T) {
 	)
 	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
 	recorder := record.NewFakeRecorder(6)
+	var failures []Failure
 
 	// act
 	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
@@ -161,7 +162,9 @@ func TestNewOperation(t *testing.T) {
 				},
 			},
 		},
-	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz"}, "formatted": "My%Test%"}`)})
+	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz"}, "formatted": "My%Test%"}`)}, func(failure Failure) {
+		failures = append(failures, failure)
+	})
 	assert.NoError(t, err)
 	err = operation.Dispatch(ctx)
 	assert.Error(t, err)
@@ -192,6 +195,14 @@ func TestNewOperation(t *testing.T) {
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to evaluate workflow template expression: unable to evaluate expression 'garbage!!!!!!': unexpected token Operator(\"!\") (1:8)\n | garbage!!!!!!\n | .......^", <-recorder.Events)
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to evaluate workflow template expression: unable to cast expression result 'garbage' to bool", <-recorder.Events)
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to evaluate workflow template parameter \"my-param\" expression: unexpected token Operator(\"!\") (1:8)\n | rubbish!!!\n | .......^", <-recorder.Events)
+	if assert.Len(t, failures, 6) {
+		failure := failures[0]
+		assert.Equal(t, "malformed", failure.Binding.Name)
+		assert.Equal(t, "my-ns", failure.Namespace)
+		assert.Equal(t, "my-discriminator", failure.Discriminator)
+		assert.JSONEq(t, `{"foo": {"bar": "baz"}, "formatted": "My%Test%"}`, string(failure.Payload.Value))
+		assert.Error(t, failure.Err)
+	}
 }
 
 func Test_populateWorkflowMetadata(t *testing.T) {
@@ -339,7 +350,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 			},
 		},
 	}, "my-ns", "my-discriminator",
-		&wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz", "numeric": 8675309, "bool": true}, "list": ["one", "two"]}`)})
+		&wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz", "numeric": 8675309, "bool": true}, "list": ["one", "two"]}`)}, nil)
 
 	assert.NoError(t, err)
 	err = operation.Dispatch(ctx)
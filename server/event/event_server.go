@@ -2,14 +2,22 @@ package event
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/event/dispatch"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
@@ -19,6 +27,9 @@ import (
 type Controller struct {
 	instanceIDService    instanceid.Service
 	eventRecorderManager events.EventRecorderManager
+	failedEventRepo      FailedEventRepo
+	// nil if failed events are not sent to a webhook
+	deadLetter *deadLetter
 	// a channel for operations to be executed async on
 	operationQueue chan dispatch.Operation
 	workerCount    int
@@ -27,12 +38,16 @@ type Controller struct {
 
 var _ eventpkg.EventServiceServer = &Controller{}
 
-func NewController(instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int, asyncDispatch bool) *Controller {
-	log.WithFields(log.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "asyncDispatch": asyncDispatch}).Info("Creating event controller")
+// NewController returns the event controller. Events that could not be dispatched are saved to the repo, if it is
+// enabled, and sent to the dead-letter webhook, if it is not nil.
+func NewController(instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, failedEventRepo FailedEventRepo, deadLetterWebhook *audit.WebhookConfig, operationQueueSize, workerCount int, asyncDispatch bool) *Controller {
+	log.WithFields(log.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize, "asyncDispatch": asyncDispatch, "failedEventRepo": failedEventRepo.IsEnabled(), "deadLetter": deadLetterWebhook != nil}).Info("Creating event controller")
 
 	return &Controller{
 		instanceIDService:    instanceIDService,
 		eventRecorderManager: eventRecorderManager,
+		failedEventRepo:      failedEventRepo,
+		deadLetter:           newDeadLetter(deadLetterWebhook),
 		//  so we can have `operationQueueSize` operations outstanding before we start putting back pressure on the senders
 		operationQueue: make(chan dispatch.Operation, operationQueueSize),
 		workerCount:    workerCount,
@@ -40,6 +55,11 @@ func NewController(instanceIDService instanceid.Service, eventRecorderManager ev
 	}
 }
 
+// PendingOperations returns the number of operations waiting to be dispatched
+func (s *Controller) PendingOperations() int {
+	return len(s.operationQueue)
+}
+
 func (s *Controller) Run(stopCh <-chan struct{}) {
 	// this `WaitGroup` allows us to wait for all events to dispatch before exiting
 	wg := sync.WaitGroup{}
@@ -74,7 +94,7 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 		return nil, err
 	}
 
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload, s.failed)
 	if err != nil {
 		return nil, err
 	}
@@ -101,3 +121,116 @@ func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg
 	}
 	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(in.Namespace).List(ctx, listOptions)
 }
+
+// writeTimeout stops a slow database or webhook from holding up dispatch for too long
+const writeTimeout = 10 * time.Second
+
+// failed keeps the event, so it is not lost, and can be replayed
+func (s *Controller) failed(failure dispatch.Failure) {
+	if !s.failedEventRepo.IsEnabled() && s.deadLetter == nil {
+		return
+	}
+	now := metav1.Now()
+	event := failedEvent{
+		FailedEvent: &eventpkg.FailedEvent{
+			Uid:                  string(uuid.NewUUID()),
+			Namespace:            failure.Binding.Namespace,
+			WorkflowEventBinding: failure.Binding.Name,
+			Discriminator:        failure.Discriminator,
+			Payload:              failure.Payload,
+			Reason:               failure.Err.Error(),
+			CreatedAt:            &now,
+		},
+		Metadata: failure.Metadata,
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": event.Namespace, "event": event.WorkflowEventBinding, "uid": event.Uid})
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	if s.failedEventRepo.IsEnabled() {
+		if err := s.failedEventRepo.Save(ctx, event); err != nil {
+			logCtx.WithError(err).Error("failed to save failed event")
+		}
+	}
+	if s.deadLetter != nil {
+		if err := s.deadLetter.send(ctx, event); err != nil {
+			logCtx.WithError(err).Error("failed to send failed event to dead-letter webhook")
+		}
+	}
+}
+
+// canListFailedEvents checks the user can list the bindings in the namespace, as failed events show the payloads
+// of events sent to them
+func (s *Controller) canListFailedEvents(ctx context.Context, namespace string) error {
+	if !s.failedEventRepo.IsEnabled() {
+		return status.Error(codes.Unimplemented, "failed events are not saved, failedEvents.database must be configured")
+	}
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowEventBindingPlural, namespace, "")
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflow event bindings in namespace \"%s\"", namespace))
+	}
+	return nil
+}
+
+func (s *Controller) ListFailedEvents(ctx context.Context, req *eventpkg.ListFailedEventsRequest) (*eventpkg.FailedEventList, error) {
+	if err := s.canListFailedEvents(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	events, err := s.failedEventRepo.List(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	list := &eventpkg.FailedEventList{Items: make([]*eventpkg.FailedEvent, len(events))}
+	for i, event := range events {
+		list.Items[i] = event.FailedEvent
+	}
+	return list, nil
+}
+
+// ReplayFailedEvent dispatches the event to the binding again, using the binding as it is now, so a binding can be
+// fixed and then its failed events replayed. If it succeeds, the failed event is deleted.
+func (s *Controller) ReplayFailedEvent(ctx context.Context, req *eventpkg.ReplayFailedEventRequest) (*eventpkg.ReplayFailedEventResponse, error) {
+	if err := s.canListFailedEvents(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	event, err := s.failedEventRepo.Get(ctx, req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, status.Error(codes.NotFound, "failed event not found")
+	}
+	binding, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).Get(ctx, event.WorkflowEventBinding, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.instanceIDService.Validate(binding); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// the metadata is as it was received, so selectors and parameters are evaluated as they were originally
+	eventCtx := metadata.NewIncomingContext(ctx, metadata.MD(event.Metadata))
+	// a replay that fails again leaves the failed event as it is, rather than saving another
+	operation, err := dispatch.NewOperation(eventCtx, s.instanceIDService, s.eventRecorderManager.Get(req.Namespace), []wfv1.WorkflowEventBinding{*binding}, event.Namespace, event.Discriminator, event.Payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := operation.Dispatch(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.failedEventRepo.Delete(ctx, req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+	return &eventpkg.ReplayFailedEventResponse{}, nil
+}
+
+func (s *Controller) DeleteFailedEvent(ctx context.Context, req *eventpkg.DeleteFailedEventRequest) (*eventpkg.DeleteFailedEventResponse, error) {
+	if err := s.canListFailedEvents(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	if err := s.failedEventRepo.Delete(ctx, req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+	return &eventpkg.DeleteFailedEventResponse{}, nil
+}
@@ -2,17 +2,29 @@ package event
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	fakekube "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 )
 
@@ -22,7 +34,7 @@ func TestController(t *testing.T) {
 	instanceIDService := instanceid.NewService("my-instanceid")
 	eventRecorderManager := events.NewEventRecorderManager(fakekube.NewSimpleClientset())
 	newController := func(asyncDispatch bool) *Controller {
-		return NewController(instanceIDService, eventRecorderManager, 1, 1, asyncDispatch)
+		return NewController(instanceIDService, eventRecorderManager, nullFailedEventRepo{}, nil, 1, 1, asyncDispatch)
 	}
 	e1 := &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{}}
 	e2 := &eventpkg.EventRequest{}
@@ -34,6 +46,7 @@ func TestController(t *testing.T) {
 		assert.NoError(t, err)
 
 		assert.Len(t, s.operationQueue, 1, "one event to be processed")
+		assert.Equal(t, 1, s.PendingOperations())
 
 		_, err = s.ReceiveEvent(ctx, e2)
 		assert.EqualError(t, err, "operation queue full", "backpressure when queue is full")
@@ -62,3 +75,140 @@ func TestController(t *testing.T) {
 		assert.EqualError(t, err, "failed to create workflow template expression environment: json: error calling MarshalJSON for type *v1alpha1.Item: invalid character '!' looking for beginning of value")
 	})
 }
+
+type testFailedEventRepo struct{ events []failedEvent }
+
+func (r *testFailedEventRepo) IsEnabled() bool { return true }
+
+func (r *testFailedEventRepo) Save(_ context.Context, event failedEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *testFailedEventRepo) List(_ context.Context, namespace string) ([]failedEvent, error) {
+	var events []failedEvent
+	for _, event := range r.events {
+		if event.Namespace == namespace {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (r *testFailedEventRepo) Get(_ context.Context, namespace, uid string) (*failedEvent, error) {
+	for _, event := range r.events {
+		if event.Namespace == namespace && event.Uid == uid {
+			return &event, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *testFailedEventRepo) Delete(_ context.Context, namespace, uid string) error {
+	var events []failedEvent
+	for _, event := range r.events {
+		if event.Namespace != namespace || event.Uid != uid {
+			events = append(events, event)
+		}
+	}
+	r.events = events
+	return nil
+}
+
+func TestController_FailedEvents(t *testing.T) {
+	labels := map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}
+	clientset := fake.NewSimpleClientset(&wfv1.WorkflowEventBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns", Labels: labels},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event:  wfv1.Event{Selector: `metadata["x-my-header"][0] == "my-value"`},
+			Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+		},
+	})
+	kubeClient := fakekube.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, clientset), auth.KubeKey, kubeClient)
+	var sent []map[string]interface{}
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		event := map[string]interface{}{}
+		_ = json.Unmarshal(data, &event)
+		sent = append(sent, event)
+	}))
+	defer webhook.Close()
+	repo := &testFailedEventRepo{}
+	s := NewController(instanceid.NewService("my-instanceid"), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), repo, &audit.WebhookConfig{URL: webhook.URL}, 1, 1, false)
+
+	// the template does not exist, so submission fails
+	eventCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-my-header", "my-value", "authorization", "my-token"))
+	_, err := s.ReceiveEvent(eventCtx, &eventpkg.EventRequest{Namespace: "my-ns", Discriminator: "my-d", Payload: &wfv1.Item{Value: json.RawMessage(`{"foo":"bar"}`)}})
+	assert.Error(t, err)
+	if assert.Len(t, repo.events, 1) {
+		event := repo.events[0]
+		assert.NotEmpty(t, event.Uid)
+		assert.Equal(t, "my-ns", event.Namespace)
+		assert.Equal(t, "my-wfeb", event.WorkflowEventBinding)
+		assert.Equal(t, "my-d", event.Discriminator)
+		assert.Contains(t, event.Reason, `"my-wft" not found`)
+		assert.Equal(t, map[string][]string{"x-my-header": {"my-value"}}, event.Metadata, "only X- headers are kept")
+	}
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "my-wfeb", sent[0]["workflowEventBinding"])
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, sent[0]["payload"])
+		assert.Equal(t, map[string]interface{}{"x-my-header": []interface{}{"my-value"}}, sent[0]["metadata"])
+	}
+
+	t.Run("List", func(t *testing.T) {
+		list, err := s.ListFailedEvents(ctx, &eventpkg.ListFailedEventsRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "my-wfeb", list.Items[0].WorkflowEventBinding)
+		}
+	})
+	t.Run("ReplayNotFound", func(t *testing.T) {
+		_, err := s.ReplayFailedEvent(ctx, &eventpkg.ReplayFailedEventRequest{Namespace: "my-ns", Uid: "not-found"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	uid := repo.events[0].Uid
+	t.Run("ReplayFailure", func(t *testing.T) {
+		_, err := s.ReplayFailedEvent(ctx, &eventpkg.ReplayFailedEventRequest{Namespace: "my-ns", Uid: uid})
+		assert.Error(t, err)
+		assert.Len(t, repo.events, 1, "the failed event is kept, and not saved again")
+		assert.Len(t, sent, 1)
+	})
+	t.Run("Replay", func(t *testing.T) {
+		_, err := clientset.ArgoprojV1alpha1().WorkflowTemplates("my-ns").Create(ctx, &wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: labels}}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		_, err = s.ReplayFailedEvent(ctx, &eventpkg.ReplayFailedEventRequest{Namespace: "my-ns", Uid: uid})
+		assert.NoError(t, err)
+		assert.Empty(t, repo.events)
+		list, err := clientset.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Len(t, list.Items, 1, "the workflow is submitted, as the metadata still matches the selector")
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		repo.events = []failedEvent{{FailedEvent: &eventpkg.FailedEvent{Uid: "my-uid", Namespace: "my-ns"}}}
+		_, err := s.DeleteFailedEvent(ctx, &eventpkg.DeleteFailedEventRequest{Namespace: "my-ns", Uid: "my-uid"})
+		assert.NoError(t, err)
+		assert.Empty(t, repo.events)
+	})
+	t.Run("NotEnabled", func(t *testing.T) {
+		s := NewController(instanceid.NewService("my-instanceid"), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), nullFailedEventRepo{}, nil, 1, 1, false)
+		_, err := s.ListFailedEvents(ctx, &eventpkg.ListFailedEventsRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestNewFailedEventRepo(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		repo, err := NewFailedEventRepo(FailedEventsConfig{}, nil, "")
+		if assert.NoError(t, err) {
+			assert.False(t, repo.IsEnabled())
+		}
+	})
+	t.Run("DatabaseWithoutPersistence", func(t *testing.T) {
+		_, err := NewFailedEventRepo(FailedEventsConfig{Database: true}, nil, "")
+		assert.Error(t, err)
+	})
+}
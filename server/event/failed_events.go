@@ -0,0 +1,201 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
+)
+
+// FailedEventsConfig configures what is done with events that could not be dispatched to a workflow event binding,
+// which are otherwise only logged and recorded as Kubernetes events
+type FailedEventsConfig struct {
+	// Database inserts failed events into the `argo_failed_events` table of the persistence database, so they can be
+	// listed and replayed
+	Database bool `json:"database,omitempty"`
+	// Webhook POSTs each failed event, as JSON, to a URL, i.e. a dead-letter queue
+	Webhook *audit.WebhookConfig `json:"webhook,omitempty"`
+}
+
+// failedEvent is a failed event as it is stored and sent to the webhook. Unlike the API, it includes the event's
+// metadata, which is needed to replay it.
+type failedEvent struct {
+	*eventpkg.FailedEvent
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+type FailedEventRepo interface {
+	IsEnabled() bool
+	Save(ctx context.Context, event failedEvent) error
+	// List lists the failed events in the namespace, most recent first
+	List(ctx context.Context, namespace string) ([]failedEvent, error)
+	Get(ctx context.Context, namespace, uid string) (*failedEvent, error)
+	Delete(ctx context.Context, namespace, uid string) error
+}
+
+// NewFailedEventRepo returns the repo for the config. The session is only needed to save failed events in the database,
+// and is nil if persistence is not configured.
+func NewFailedEventRepo(c FailedEventsConfig, session sqlbuilder.Database, clusterName string) (FailedEventRepo, error) {
+	if !c.Database {
+		return nullFailedEventRepo{}, nil
+	}
+	if session == nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "failedEvents.database requires persistence to be configured")
+	}
+	return &databaseFailedEventRepo{session: session, clusterName: clusterName}, nil
+}
+
+// failedEventsTableName is created by the controller's schema migration
+const failedEventsTableName = "argo_failed_events"
+
+type failedEventRecord struct {
+	ClusterName          string    `db:"clustername"`
+	UID                  string    `db:"uid"`
+	Namespace            string    `db:"namespace"`
+	WorkflowEventBinding string    `db:"workfloweventbinding"`
+	CreatedAt            time.Time `db:"createdat"`
+	Event                string    `db:"event"`
+}
+
+type databaseFailedEventRepo struct {
+	session     sqlbuilder.Database
+	clusterName string
+}
+
+func (r *databaseFailedEventRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *databaseFailedEventRepo) Save(ctx context.Context, event failedEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = r.session.WithContext(ctx).Collection(failedEventsTableName).Insert(&failedEventRecord{
+		ClusterName:          r.clusterName,
+		UID:                  event.Uid,
+		Namespace:            event.Namespace,
+		WorkflowEventBinding: event.WorkflowEventBinding,
+		CreatedAt:            event.CreatedAt.UTC(),
+		Event:                string(data),
+	})
+	return err
+}
+
+func (r *databaseFailedEventRepo) List(ctx context.Context, namespace string) ([]failedEvent, error) {
+	var records []failedEventRecord
+	err := r.session.WithContext(ctx).
+		Select("event").
+		From(failedEventsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		OrderBy("-createdat").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]failedEvent, len(records))
+	for i, record := range records {
+		if err := json.Unmarshal([]byte(record.Event), &events[i]); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (r *databaseFailedEventRepo) Get(ctx context.Context, namespace, uid string) (*failedEvent, error) {
+	var records []failedEventRecord
+	err := r.session.WithContext(ctx).
+		Select("event").
+		From(failedEventsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"uid": uid}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	event := &failedEvent{}
+	return event, json.Unmarshal([]byte(records[0].Event), event)
+}
+
+func (r *databaseFailedEventRepo) Delete(ctx context.Context, namespace, uid string) error {
+	_, err := r.session.WithContext(ctx).
+		DeleteFrom(failedEventsTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"uid": uid}).
+		Exec()
+	return err
+}
+
+type nullFailedEventRepo struct{}
+
+func (nullFailedEventRepo) IsEnabled() bool {
+	return false
+}
+
+func (nullFailedEventRepo) Save(context.Context, failedEvent) error {
+	return nil
+}
+
+func (nullFailedEventRepo) List(context.Context, string) ([]failedEvent, error) {
+	return nil, nil
+}
+
+func (nullFailedEventRepo) Get(context.Context, string, string) (*failedEvent, error) {
+	return nil, nil
+}
+
+func (nullFailedEventRepo) Delete(context.Context, string, string) error {
+	return nil
+}
+
+// deadLetter sends failed events to a webhook
+type deadLetter struct {
+	config audit.WebhookConfig
+	client *http.Client
+}
+
+func newDeadLetter(config *audit.WebhookConfig) *deadLetter {
+	if config == nil {
+		return nil
+	}
+	return &deadLetter{config: *config, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (d *deadLetter) send(ctx context.Context, event failedEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", d.config.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range d.config.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded %s", d.config.URL, resp.Status)
+	}
+	return nil
+}
@@ -4,16 +4,24 @@ import (
 	"context"
 	"os"
 
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/argoproj/argo-workflows/v3"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 )
 
 type infoServer struct {
 	managedNamespace string
 	links            []*wfv1.Link
 	navColor         string
+	rbacConfig       *rbac.Config
+	ssoNamespace     string
 }
 
 func (i *infoServer) GetUserInfo(ctx context.Context, _ *infopkg.GetUserInfoRequest) (*infopkg.GetUserInfoResponse, error) {
@@ -31,6 +39,35 @@ func (i *infoServer) GetUserInfo(ctx context.Context, _ *infopkg.GetUserInfoRequ
 	return &infopkg.GetUserInfoResponse{}, nil
 }
 
+func (i *infoServer) GetRBACRule(ctx context.Context, req *infopkg.GetRBACRuleRequest) (*infopkg.GetRBACRuleResponse, error) {
+	if !i.rbacConfig.HasRules() {
+		return nil, status.Error(codes.FailedPrecondition, "SSO RBAC rules are not configured")
+	}
+	claims := &types.Claims{Claims: jwt.Claims{Subject: req.Subject}, Groups: req.Groups, Email: req.Email}
+	if len(req.Groups) == 0 && req.Email == "" && req.Subject == "" {
+		claims = auth.GetClaims(ctx)
+		if claims == nil {
+			claims = &types.Claims{}
+		}
+	}
+	rule, err := i.rbacConfig.Match(claims, req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	serviceAccountNamespace := rule.Namespace
+	if serviceAccountNamespace == "" {
+		serviceAccountNamespace = i.ssoNamespace
+	}
+	return &infopkg.GetRBACRuleResponse{
+		Rule:                    rule.Name,
+		Precedence:              int32(rule.Precedence),
+		ServiceAccountNamespace: serviceAccountNamespace,
+		ServiceAccountName:      rule.ServiceAccountName,
+		ReadOnly:                rule.ReadOnly,
+		WorkflowActions:         rule.WorkflowActions,
+	}, nil
+}
+
 func (i *infoServer) GetInfo(context.Context, *infopkg.GetInfoRequest) (*infopkg.InfoResponse, error) {
 	modals := map[string]bool{
 		"feedback":      os.Getenv("FEEDBACK_MODAL") != "false",
@@ -50,6 +87,6 @@ func (i *infoServer) GetVersion(context.Context, *infopkg.GetVersionRequest) (*w
 	return &version, nil
 }
 
-func NewInfoServer(managedNamespace string, links []*wfv1.Link, navColor string) infopkg.InfoServiceServer {
-	return &infoServer{managedNamespace, links, navColor}
+func NewInfoServer(managedNamespace string, links []*wfv1.Link, navColor string, rbacConfig *rbac.Config, ssoNamespace string) infopkg.InfoServiceServer {
+	return &infoServer{managedNamespace, links, navColor, rbacConfig, ssoNamespace}
 }
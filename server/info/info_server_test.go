@@ -7,8 +7,10 @@ import (
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 
+	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/rbac"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 )
 
@@ -53,3 +55,42 @@ func Test_infoServer_GetInfo(t *testing.T) {
 		}
 	})
 }
+
+func Test_infoServer_GetRBACRule(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		i := &infoServer{}
+		_, err := i.GetRBACRule(context.TODO(), &infopkg.GetRBACRuleRequest{})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = SSO RBAC rules are not configured")
+	})
+	i := &infoServer{
+		ssoNamespace: "argo",
+		rbacConfig: &rbac.Config{Enabled: true, Rules: []rbac.Rule{
+			{Name: "admins", Groups: []string{"admins"}, ServiceAccountName: "admin", Precedence: 1},
+			{Name: "team-a", Groups: []string{"team-a"}, Namespace: "team-a", ServiceAccountName: "team-a", ReadOnly: true},
+		}},
+	}
+	t.Run("Caller", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), auth.ClaimsKey, &types.Claims{Groups: []string{"admins"}})
+		rule, err := i.GetRBACRule(ctx, &infopkg.GetRBACRuleRequest{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "admins", rule.Rule)
+			assert.Equal(t, int32(1), rule.Precedence)
+			assert.Equal(t, "argo", rule.ServiceAccountNamespace)
+			assert.Equal(t, "admin", rule.ServiceAccountName)
+			assert.False(t, rule.ReadOnly)
+		}
+	})
+	t.Run("Groups", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), auth.ClaimsKey, &types.Claims{Groups: []string{"admins"}})
+		rule, err := i.GetRBACRule(ctx, &infopkg.GetRBACRuleRequest{Namespace: "team-a", Groups: []string{"team-a"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "team-a", rule.Rule)
+			assert.Equal(t, "team-a", rule.ServiceAccountNamespace)
+			assert.True(t, rule.ReadOnly)
+		}
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		_, err := i.GetRBACRule(context.TODO(), &infopkg.GetRBACRuleRequest{Groups: []string{"team-a"}})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = no rule matches")
+	})
+}
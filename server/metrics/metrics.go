@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons a call may be rejected after the caller has been authenticated
+const (
+	// ReasonReadOnly is a mutating call by a read-only user, or to a read-only server
+	ReasonReadOnly = "ReadOnly"
+	// ReasonRateLimited is a call over the caller's rate limit
+	ReasonRateLimited = "RateLimited"
+	// ReasonWorkflowAction is a workflow action the caller's SSO RBAC rule or service account does not allow
+	ReasonWorkflowAction = "WorkflowAction"
+)
+
+// The Argo Server's metrics are registered with the default registry, which is served at "/metrics", alongside the
+// gRPC metrics.
+var (
+	authRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "argo_server",
+		Name:      "auth_requests_total",
+		Help:      "Number of requests authenticated, by auth mode and outcome, which is the gRPC code, e.g. OK or Unauthenticated",
+	}, []string{"mode", "outcome"})
+	rejectedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "argo_server",
+		Name:      "rejected_requests_total",
+		Help:      "Number of authenticated requests rejected, by gRPC method and reason",
+	}, []string{"method", "reason"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "argo_server",
+		Name:      "http_request_duration_seconds",
+		Help:      "Histogram of the latency of HTTP requests, by handler, HTTP method and response code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(authRequests, rejectedRequests, httpRequestDuration)
+}
+
+// AuthRequest records the outcome of authenticating a request. The mode is empty if no mode accepted the token.
+func AuthRequest(mode string, err error) {
+	if mode == "" {
+		mode = "none"
+	}
+	authRequests.WithLabelValues(mode, status.Code(err).String()).Inc()
+}
+
+// RejectedRequest records that a gRPC method, e.g. "/workflow.WorkflowService/SubmitWorkflow", was rejected
+func RejectedRequest(method, reason string) {
+	rejectedRequests.WithLabelValues(method, reason).Inc()
+}
+
+// InstrumentHandler records the latency and response code of each request served by the handler. The name labels
+// the handler, rather than the request's path, as paths contain names.
+func InstrumentHandler(name string, handler http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(prometheus.Labels{"handler": name}), handler)
+}
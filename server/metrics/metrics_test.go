@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthRequest(t *testing.T) {
+	AuthRequest("sso", nil)
+	AuthRequest("sso", status.Error(codes.Unauthenticated, "token not valid"))
+	AuthRequest("", status.Error(codes.Unauthenticated, "token not valid for running mode"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(authRequests.WithLabelValues("sso", "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(authRequests.WithLabelValues("sso", "Unauthenticated")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(authRequests.WithLabelValues("none", "Unauthenticated")))
+}
+
+func TestRejectedRequest(t *testing.T) {
+	RejectedRequest("/workflow.WorkflowService/SubmitWorkflow", ReasonRateLimited)
+	assert.Equal(t, float64(1), testutil.ToFloat64(rejectedRequests.WithLabelValues("/workflow.WorkflowService/SubmitWorkflow", ReasonRateLimited)))
+}
+
+func TestInstrumentHandler(t *testing.T) {
+	h := InstrumentHandler("my-handler", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, 1, testutil.CollectAndCount(httpRequestDuration))
+	assert.Equal(t, 1, testutil.CollectAndCount(httpRequestDuration.MustCurryWith(map[string]string{"handler": "my-handler", "method": "get", "code": "418"})))
+}
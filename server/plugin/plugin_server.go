@@ -0,0 +1,195 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
+)
+
+const (
+	healthHealthy   = "Healthy"
+	healthUnhealthy = "Unhealthy"
+	healthUnknown   = "Unknown"
+)
+
+// pluginServer lists the executor plugins available to the workflows of a namespace, i.e. those installed in the
+// namespace, and those installed in the Argo Server's namespace, which the caller does not need to be able to get.
+type pluginServer struct {
+	namespace  string
+	kubeClient kubernetes.Interface
+}
+
+// NewPluginServer returns a server of the plugins in the namespace, which it gets with the client, as well as of those
+// in the caller's namespace, which it gets as the caller
+func NewPluginServer(namespace string, kubeClient kubernetes.Interface) pluginpkg.PluginServiceServer {
+	return &pluginServer{namespace: namespace, kubeClient: kubeClient}
+}
+
+func (s *pluginServer) ListPlugins(ctx context.Context, req *pluginpkg.ListPluginsRequest) (*pluginpkg.InstalledPluginList, error) {
+	plugins, err := s.listPlugins(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginpkg.InstalledPluginList{Items: plugins}, nil
+}
+
+func (s *pluginServer) GetPlugin(ctx context.Context, req *pluginpkg.GetPluginRequest) (*pluginpkg.InstalledPlugin, error) {
+	plugins, err := s.listPlugins(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range plugins {
+		if p.Name == req.Name {
+			return p, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "plugin %s is not installed in namespace %s, or %s", req.Name, req.Namespace, s.namespace)
+}
+
+// listPlugins returns the plugins available to the namespace, sorted by name. A plugin in the namespace hides a plugin
+// of the same name in the Argo Server's namespace.
+func (s *pluginServer) listPlugins(ctx context.Context, namespace string) ([]*pluginpkg.InstalledPlugin, error) {
+	kubeClient := auth.GetKubeClient(ctx)
+	plugins, err := getPlugins(ctx, kubeClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace != s.namespace {
+		installed, err := getPlugins(ctx, s.kubeClient, s.namespace)
+		if err != nil {
+			return nil, err
+		}
+		for name, p := range installed {
+			if _, ok := plugins[name]; !ok {
+				plugins[name] = p
+			}
+		}
+	}
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyAgent + "=true"})
+	if err != nil {
+		return nil, err
+	}
+	var items []*pluginpkg.InstalledPlugin
+	for _, p := range plugins {
+		p.Health = health(p.containerName, pods.Items)
+		items = append(items, p.InstalledPlugin)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return items, nil
+}
+
+// installedPlugin is an installed plugin, and the name of its sidecar container, which is not returned, as only the
+// plugin's name, version, image, and templates are for the caller
+type installedPlugin struct {
+	*pluginpkg.InstalledPlugin
+	containerName string
+}
+
+// getPlugins returns the plugins in the namespace by name. Plugins that are invalid are logged and left out, as the
+// controller does not load them either.
+func getPlugins(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (map[string]installedPlugin, error) {
+	list, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapExecutorPlugin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	plugins := make(map[string]installedPlugin)
+	for _, cm := range list.Items {
+		p, err := plugin.FromConfigMap(&cm)
+		if err != nil {
+			log.WithFields(log.Fields{"namespace": cm.Namespace, "name": cm.Name}).WithError(err).Warn("Failed to convert configmap to plugin")
+			continue
+		}
+		plugins[p.Name] = installedPlugin{newInstalledPlugin(p, namespace), p.Spec.Sidecar.Container.Name}
+	}
+	return plugins, nil
+}
+
+func newInstalledPlugin(p *spec.Plugin, namespace string) *pluginpkg.InstalledPlugin {
+	sidecar := p.Spec.Sidecar.Container
+	version := p.Annotations[common.AnnotationKeyPluginVersion]
+	if version == "" {
+		version = imageTag(sidecar.Image)
+	}
+	templateKinds := []string{p.Name}
+	if x := p.Annotations[common.AnnotationKeyPluginTemplates]; x != "" {
+		templateKinds = nil
+		for _, kind := range strings.Split(x, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				templateKinds = append(templateKinds, kind)
+			}
+		}
+	}
+	return &pluginpkg.InstalledPlugin{
+		Name:          p.Name,
+		Namespace:     namespace,
+		Version:       version,
+		Description:   strings.TrimSpace(p.Annotations[common.AnnotationKeyDescription]),
+		TemplateKinds: templateKinds,
+		Image:         sidecar.Image,
+	}
+}
+
+// imageTag returns the tag of the image, e.g. "v1" of "argoproj/hello:v1", or "latest" if it has no tag or digest
+func imageTag(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return "latest"
+}
+
+// health returns the health of the plugin's sidecar container, as reported by the statuses of the running agent pods
+func health(containerName string, pods []corev1.Pod) *pluginpkg.InstalledPluginHealth {
+	h := &pluginpkg.InstalledPluginHealth{Status: healthUnknown}
+	var messages []string
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		for _, s := range pod.Status.ContainerStatuses {
+			if s.Name != containerName {
+				continue
+			}
+			h.Agents++
+			h.Restarts += s.RestartCount
+			if s.Ready {
+				h.ReadyAgents++
+				continue
+			}
+			reason := "not ready"
+			if w := s.State.Waiting; w != nil && w.Reason != "" {
+				reason = w.Reason
+			} else if t := s.State.Terminated; t != nil && t.Reason != "" {
+				reason = t.Reason
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s", pod.Name, reason))
+		}
+	}
+	if h.Agents > 0 {
+		h.Status = healthHealthy
+		if h.ReadyAgents < h.Agents {
+			h.Status = healthUnhealthy
+		}
+	}
+	sort.Strings(messages)
+	h.Message = strings.Join(messages, ", ")
+	return h
+}
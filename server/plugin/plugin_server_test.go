@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	pluginpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/plugin"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const sidecar = `
+name: %s
+image: argoproj/%s:v1
+ports:
+  - containerPort: 4355
+resources:
+  requests: {cpu: 100m}
+  limits: {cpu: 200m}
+securityContext: {}
+`
+
+func newPluginConfigMap(namespace, name string, annotations map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + "-executor-plugin",
+			Namespace:   namespace,
+			Labels:      map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapExecutorPlugin},
+			Annotations: annotations,
+		},
+		Data: map[string]string{"sidecar.container": fmt.Sprintf(sidecar, name, name)},
+	}
+}
+
+func newAgentPod(name string, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: map[string]string{common.LabelKeyAgent: "true"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: statuses},
+	}
+}
+
+func TestPluginServer(t *testing.T) {
+	serverKubeClient := fake.NewSimpleClientset(
+		newPluginConfigMap("argo", "hello", nil),
+		newPluginConfigMap("argo", "shadowed", nil),
+	)
+	kubeClient := fake.NewSimpleClientset(
+		newPluginConfigMap("my-ns", "shadowed", map[string]string{
+			common.AnnotationKeyPluginVersion:   "v2",
+			common.AnnotationKeyPluginTemplates: "foo, bar",
+			common.AnnotationKeyDescription:     "My plugin.\n",
+		}),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "invalid-executor-plugin",
+				Namespace: "my-ns",
+				Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapExecutorPlugin},
+			},
+		},
+		newAgentPod("agent-0", corev1.ContainerStatus{Name: "hello", Ready: true}, corev1.ContainerStatus{Name: "shadowed", Ready: true}),
+		newAgentPod("agent-1", corev1.ContainerStatus{Name: "hello", RestartCount: 2, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}),
+	)
+	ctx := context.WithValue(context.Background(), auth.KubeKey, kubeClient)
+	s := NewPluginServer("argo", serverKubeClient)
+
+	t.Run("ListPlugins", func(t *testing.T) {
+		list, err := s.ListPlugins(ctx, &pluginpkg.ListPluginsRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			hello := list.Items[0]
+			assert.Equal(t, "hello", hello.Name)
+			assert.Equal(t, "argo", hello.Namespace)
+			assert.Equal(t, "v1", hello.Version)
+			assert.Equal(t, []string{"hello"}, hello.TemplateKinds)
+			assert.Equal(t, &pluginpkg.InstalledPluginHealth{Status: healthUnhealthy, Message: "agent-1: CrashLoopBackOff", Agents: 2, ReadyAgents: 1, Restarts: 2}, hello.Health)
+			shadowed := list.Items[1]
+			assert.Equal(t, "shadowed", shadowed.Name)
+			assert.Equal(t, "my-ns", shadowed.Namespace)
+			assert.Equal(t, "v2", shadowed.Version)
+			assert.Equal(t, "My plugin.", shadowed.Description)
+			assert.Equal(t, []string{"foo", "bar"}, shadowed.TemplateKinds)
+			assert.Equal(t, &pluginpkg.InstalledPluginHealth{Status: healthHealthy, Agents: 1, ReadyAgents: 1}, shadowed.Health)
+		}
+	})
+	t.Run("ListPluginsNoAgents", func(t *testing.T) {
+		list, err := s.ListPlugins(ctx, &pluginpkg.ListPluginsRequest{Namespace: "other-ns"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			assert.Equal(t, "argo", list.Items[1].Namespace)
+			assert.Equal(t, healthUnknown, list.Items[1].Health.Status)
+		}
+	})
+	t.Run("GetPlugin", func(t *testing.T) {
+		p, err := s.GetPlugin(ctx, &pluginpkg.GetPluginRequest{Namespace: "my-ns", Name: "hello"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "argoproj/hello:v1", p.Image)
+		}
+	})
+	t.Run("GetPluginNotFound", func(t *testing.T) {
+		_, err := s.GetPlugin(ctx, &pluginpkg.GetPluginRequest{Namespace: "my-ns", Name: "invalid"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestImageTag(t *testing.T) {
+	assert.Equal(t, "v1", imageTag("argoproj/hello:v1"))
+	assert.Equal(t, "latest", imageTag("argoproj/hello"))
+	assert.Equal(t, "latest", imageTag("localhost:5000/hello"))
+	assert.Equal(t, "v1", imageTag("localhost:5000/hello:v1"))
+	assert.Equal(t, "sha256:abc", imageTag("argoproj/hello@sha256:abc"))
+}
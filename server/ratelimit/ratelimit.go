@@ -0,0 +1,189 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/metrics"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+// Config configures how many API calls each identity may make in each namespace. Limits are token buckets: an identity
+// may make a burst of calls, after which it may make calls at the rate.
+type Config struct {
+	// All limits every call
+	All *Limit `json:"all,omitempty"`
+	// Mutating limits mutating calls, e.g. to submit workflows, as well as the limit on all calls
+	Mutating *Limit `json:"mutating,omitempty"`
+	// TrustedProxies are the CIDRs of the proxies in front of the Argo Server, e.g. "10.0.0.0/8", whose
+	// X-Forwarded-For is trusted to identify callers by their address
+	TrustedProxies []string `json:"trustedProxies,omitempty"`
+}
+
+type Limit struct {
+	// RequestsPerSecond is the rate calls may be made at
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// Burst is the number of calls that may be made at once, defaults to the rate rounded up
+	Burst int `json:"burst,omitempty"`
+}
+
+func (l Limit) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return int(math.Ceil(l.RequestsPerSecond))
+}
+
+// buckets that have not been used for this long are forgotten, as they are full again by then
+const idleTimeout = 10 * time.Minute
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// limiter holds a bucket for each key
+type limiter struct {
+	limit     Limit
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newLimiter(l *Limit) (*limiter, error) {
+	if l == nil {
+		return nil, nil
+	}
+	if l.RequestsPerSecond <= 0 {
+		return nil, errors.Errorf(errors.CodeBadRequest, "rateLimit requestsPerSecond must be greater than zero")
+	}
+	return &limiter{limit: *l, buckets: map[string]*bucket{}}, nil
+}
+
+// reserve takes a token from the key's bucket. The reservation is cancelled if the call is rejected.
+func (l *limiter) reserve(key string, now time.Time) *rate.Reservation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) > idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastUsed) > idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.limit.RequestsPerSecond), l.limit.burst())}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b.limiter.ReserveN(now, 1)
+}
+
+// key identifies who is making the call, and in which namespace. Callers with no claims, i.e. in server auth mode,
+// are identified by their address, which is only taken from X-Forwarded-For if it was added by a trusted proxy.
+func key(ctx context.Context, req interface{}, trustedProxies []*net.IPNet) string {
+	identity := grpcutil.ClientIP(ctx, trustedProxies)
+	if claims := auth.GetClaims(ctx); claims != nil && claims.Subject != "" {
+		identity = claims.Subject
+	}
+	if x, ok := req.(interface{ GetNamespace() string }); ok {
+		return identity + "/" + x.GetNamespace()
+	}
+	return identity
+}
+
+// UnaryServerInterceptor rejects calls over the limits with a ResourceExhausted error, which the gateway returns as
+// "429 Too Many Requests". The error's details include how long to wait before retrying. It must come after the
+// gatekeeper, so that the caller's claims are available. Streams are not limited.
+func UnaryServerInterceptor(c Config) (grpc.UnaryServerInterceptor, error) {
+	all, err := newLimiter(c.All)
+	if err != nil {
+		return nil, err
+	}
+	mutating, err := newLimiter(c.Mutating)
+	if err != nil {
+		return nil, err
+	}
+	var trustedProxies []*net.IPNet
+	for _, cidr := range c.TrustedProxies {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "rateLimit trustedProxies %q is not a CIDR: %v", cidr, err)
+		}
+		trustedProxies = append(trustedProxies, n)
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limiters := []*limiter{all}
+		if auth.IsMutating(info.FullMethod) {
+			limiters = append(limiters, mutating)
+		}
+		k := key(ctx, req, trustedProxies)
+		now := time.Now()
+		var reservations []*rate.Reservation
+		var delay time.Duration
+		for _, l := range limiters {
+			if l == nil {
+				continue
+			}
+			r := l.reserve(k, now)
+			reservations = append(reservations, r)
+			if d := r.DelayFrom(now); d > delay {
+				delay = d
+			}
+		}
+		if delay > 0 {
+			// a rejected call does not use up any of the caller's limits
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+			log.WithFields(log.Fields{"key": k, "method": info.FullMethod}).Debug("Rate limited")
+			metrics.RejectedRequest(info.FullMethod, metrics.ReasonRateLimited)
+			return nil, tooManyRequests(delay)
+		}
+		return handler(ctx, req)
+	}, nil
+}
+
+func tooManyRequests(delay time.Duration) error {
+	s := status.New(codes.ResourceExhausted, "too many requests, retry after "+strconv.Itoa(RetryAfterSeconds(delay))+"s")
+	withDetails, err := s.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return s.Err()
+	}
+	return withDetails.Err()
+}
+
+// RetryAfterSeconds is the value of the Retry-After header for the delay, which is a whole number of seconds
+func RetryAfterSeconds(delay time.Duration) int {
+	return int(math.Ceil(delay.Seconds()))
+}
+
+// RetryAfter returns how long the error says to wait before retrying, if it is a rate limit error
+func RetryAfter(err error) (time.Duration, bool) {
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
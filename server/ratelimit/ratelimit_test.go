@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func Test_limiter(t *testing.T) {
+	l, err := newLimiter(&Limit{RequestsPerSecond: 1, Burst: 2})
+	if assert.NoError(t, err) {
+		now := time.Now()
+		delay := func(key string, now time.Time) time.Duration { return l.reserve(key, now).DelayFrom(now) }
+		assert.Zero(t, delay("a", now))
+		assert.Zero(t, delay("a", now))
+		assert.Equal(t, time.Second, delay("a", now))
+		assert.Zero(t, delay("b", now), "each key has its own bucket")
+		assert.Zero(t, delay("c", now.Add(idleTimeout+time.Minute)))
+		assert.Len(t, l.buckets, 1, "idle buckets are forgotten")
+	}
+	_, err = newLimiter(&Limit{})
+	assert.EqualError(t, err, "rateLimit requestsPerSecond must be greater than zero")
+}
+
+func TestLimit_burst(t *testing.T) {
+	assert.Equal(t, 3, Limit{RequestsPerSecond: 2.5}.burst())
+	assert.Equal(t, 5, Limit{RequestsPerSecond: 2.5, Burst: 5}.burst())
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	i, err := UnaryServerInterceptor(Config{All: &Limit{RequestsPerSecond: 1, Burst: 2}, Mutating: &Limit{RequestsPerSecond: 0.5, Burst: 1}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(subject, method string, req interface{}) error {
+		ctx := context.WithValue(context.Background(), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: subject}})
+		_, err := i(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const submit = "/workflow.WorkflowService/SubmitWorkflow"
+	const get = "/workflow.WorkflowService/GetWorkflow"
+	assert.NoError(t, call("alice", submit, &workflowpkg.WorkflowSubmitRequest{Namespace: "my-ns"}))
+	err = call("alice", submit, &workflowpkg.WorkflowSubmitRequest{Namespace: "my-ns"})
+	if assert.Equal(t, codes.ResourceExhausted, status.Code(err)) {
+		delay, ok := RetryAfter(err)
+		assert.True(t, ok)
+		assert.Equal(t, 2, RetryAfterSeconds(delay))
+	}
+	assert.NoError(t, call("alice", get, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns"}), "read-only calls are only limited by the limit on all calls, which the rejected call did not use")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("alice", get, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns"})))
+	assert.NoError(t, call("alice", submit, &workflowpkg.WorkflowSubmitRequest{Namespace: "other-ns"}), "each namespace has its own limit")
+	assert.NoError(t, call("bob", submit, &workflowpkg.WorkflowSubmitRequest{Namespace: "my-ns"}), "each identity has its own limit")
+}
+
+func Test_key(t *testing.T) {
+	_, trusted, _ := net.ParseCIDR("10.0.0.0/8")
+	call := func(peerAddr string, forwardedFor ...string) string {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerAddr), Port: 1234}})
+		if len(forwardedFor) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", strings.Join(forwardedFor, ", ")))
+		}
+		return key(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns"}, []*net.IPNet{trusted})
+	}
+	assert.Equal(t, "192.168.0.1/my-ns", call("192.168.0.1"))
+	assert.Equal(t, "192.168.0.1/my-ns", call("192.168.0.1", "1.2.3.4"), "an untrusted peer cannot spoof its address")
+	assert.Equal(t, "192.168.0.1/my-ns", call("127.0.0.1", "1.2.3.4", "192.168.0.1"), "the gateway appends the address it received the request from")
+	assert.Equal(t, "192.168.0.1/my-ns", call("127.0.0.1", "1.2.3.4", "192.168.0.1", "10.0.0.1"), "a trusted proxy appends the address it received the request from")
+	ctx := context.WithValue(context.Background(), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "alice"}})
+	assert.Equal(t, "alice", key(ctx, nil, nil))
+}
+
+func TestUnaryServerInterceptor_TrustedProxies(t *testing.T) {
+	_, err := UnaryServerInterceptor(Config{TrustedProxies: []string{"10.0.0.1"}})
+	assert.EqualError(t, err, `rateLimit trustedProxies "10.0.0.1" is not a CIDR: invalid CIDR address: 10.0.0.1`)
+}
+
+func TestUnaryServerInterceptor_Disabled(t *testing.T) {
+	i, err := UnaryServerInterceptor(Config{})
+	if assert.NoError(t, err) {
+		for n := 0; n < 10; n++ {
+			_, err := i(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/SubmitWorkflow"}, func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+			assert.NoError(t, err)
+		}
+	}
+	_, err = UnaryServerInterceptor(Config{Mutating: &Limit{RequestsPerSecond: -1}})
+	assert.Error(t, err)
+}
+
+func TestRetryAfter(t *testing.T) {
+	_, ok := RetryAfter(status.Error(codes.ResourceExhausted, "no details"))
+	assert.False(t, ok)
+	_, ok = RetryAfter(status.Error(codes.NotFound, "not found"))
+	assert.False(t, ok)
+	assert.Equal(t, 2, RetryAfterSeconds(1500*time.Millisecond))
+}
@@ -0,0 +1,122 @@
+package sharelink
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// where we store the key links are signed with, deleting it invalidates every link
+	secretName = "argo-server-share-links"
+	keyKey     = "key"
+)
+
+// link is what a link shares, it is signed, so cannot be changed by whoever has it
+type link struct {
+	// ID is unique to the link, a single-use link is recorded by it
+	ID           string    `json:"id"`
+	Namespace    string    `json:"namespace"`
+	WorkflowName string    `json:"workflowName"`
+	WorkflowUID  types.UID `json:"workflowUID"`
+	PodName      string    `json:"podName,omitempty"`
+	Container    string    `json:"container,omitempty"`
+	NodeID       string    `json:"nodeId,omitempty"`
+	ArtifactName string    `json:"artifactName,omitempty"`
+	Input        bool      `json:"input,omitempty"`
+	ExpiresAt    int64     `json:"expiresAt"`
+	SingleUse    bool      `json:"singleUse,omitempty"`
+}
+
+func (l link) isArtifact() bool {
+	return l.ArtifactName != ""
+}
+
+func newID() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	// a config map is named after it, so it is hex encoded
+	return hex.EncodeToString(data), nil
+}
+
+// sign returns the token for the link, which is "<link>.<signature>", both base 64 URL encoded
+func sign(key []byte, l link) (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signature(key, payload)), nil
+}
+
+// verify returns the link of the token, if its signature is valid and it has not expired
+func verify(key []byte, token string, now time.Time) (*link, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("link is not valid")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, signature(key, parts[0])) {
+		return nil, fmt.Errorf("link is not valid")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("link is not valid")
+	}
+	l := &link{}
+	if err := json.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("link is not valid")
+	}
+	if !now.Before(time.Unix(l.ExpiresAt, 0)) {
+		return nil, fmt.Errorf("link expired at %s", time.Unix(l.ExpiresAt, 0).UTC().Format(time.RFC3339))
+	}
+	return l, nil
+}
+
+func signature(key []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// getOrCreateKey returns the key links are signed with, creating it if it does not exist
+func getOrCreateKey(ctx context.Context, secrets v1.SecretInterface) ([]byte, error) {
+	secret, err := secrets.Get(ctx, secretName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		secret, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName},
+			Data:       map[string][]byte{keyKey: key},
+		}, metav1.CreateOptions{})
+		// another replica may have created it first, in which case we use theirs
+		if apierr.IsAlreadyExists(err) {
+			secret, err = secrets.Get(ctx, secretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share link key: %w", err)
+	}
+	key := secret.Data[keyKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key %s missing in secret %s", keyKey, secretName)
+	}
+	return key, nil
+}
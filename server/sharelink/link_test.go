@@ -0,0 +1,72 @@
+package sharelink
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_sign(t *testing.T) {
+	key := []byte("my-key")
+	now := time.Now()
+	l := link{ID: "my-id", Namespace: "my-ns", WorkflowName: "my-wf", PodName: "my-pod", ExpiresAt: now.Add(time.Hour).Unix()}
+	token, err := sign(key, l)
+	if !assert.NoError(t, err) {
+		return
+	}
+	t.Run("Valid", func(t *testing.T) {
+		verified, err := verify(key, token, now)
+		if assert.NoError(t, err) {
+			assert.Equal(t, l, *verified)
+		}
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		_, err := verify([]byte("other-key"), token, now)
+		assert.EqualError(t, err, "link is not valid")
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		other, err := sign([]byte("other-key"), link{ID: "my-id", Namespace: "my-ns", WorkflowName: "other-wf", ExpiresAt: l.ExpiresAt})
+		if assert.NoError(t, err) {
+			// the other link's payload, with this link's signature
+			_, err := verify(key, strings.Split(other, ".")[0]+"."+strings.Split(token, ".")[1], now)
+			assert.EqualError(t, err, "link is not valid")
+		}
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, err := verify(key, token, now.Add(time.Hour))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "link expired at")
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		for _, token := range []string{"", ".", "a.b.c", "!.!", token + "x"} {
+			_, err := verify(key, token, now)
+			assert.EqualError(t, err, "link is not valid", token)
+		}
+	})
+}
+
+func Test_newID(t *testing.T) {
+	id, err := newID()
+	if assert.NoError(t, err) {
+		assert.Len(t, id, 32)
+		other, _ := newID()
+		assert.NotEqual(t, id, other)
+	}
+}
+
+func Test_getOrCreateKey(t *testing.T) {
+	ctx := context.Background()
+	secrets := fake.NewSimpleClientset().CoreV1().Secrets("argo")
+	key, err := getOrCreateKey(ctx, secrets)
+	if assert.NoError(t, err) {
+		assert.Len(t, key, 32)
+		again, err := getOrCreateKey(ctx, secrets)
+		if assert.NoError(t, err) {
+			assert.Equal(t, key, again, "the key is only created once")
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package sharelink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sharelinkpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sharelink"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+)
+
+const (
+	defaultExpiresIn = 24 * time.Hour
+	maxExpiresIn     = 7 * 24 * time.Hour
+	// the path links are served from
+	sharedPath = "/shared/"
+)
+
+type artifactServer interface {
+	ServeSharedArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, workflowName string, uid types.UID, nodeID, artifactName string, isInput bool) error
+}
+
+// ShareLinkServer mints links to the logs or an artifact of a workflow, and serves them to anyone who has the link,
+// without them needing access to Argo. Minting a link needs the caller to be able to get the workflow. Links are
+// served using the Argo Server's own clients.
+type ShareLinkServer struct {
+	namespace         string
+	baseHRef          string
+	clients           *servertypes.Clients
+	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
+	artifacts         artifactServer
+	mu                sync.Mutex
+	key               []byte
+}
+
+// NewShareLinkServer returns a server whose links are signed with a key stored in the namespace
+func NewShareLinkServer(namespace, baseHRef string, clients *servertypes.Clients, instanceIDService instanceid.Service, hydrator hydrator.Interface, artifacts artifactServer) *ShareLinkServer {
+	return &ShareLinkServer{
+		namespace:         namespace,
+		baseHRef:          baseHRef,
+		clients:           clients,
+		instanceIDService: instanceIDService,
+		hydrator:          hydrator,
+		artifacts:         artifacts,
+	}
+}
+
+func (s *ShareLinkServer) getKey(ctx context.Context) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.key == nil {
+		key, err := getOrCreateKey(ctx, s.clients.Kubernetes.CoreV1().Secrets(s.namespace))
+		if err != nil {
+			return nil, err
+		}
+		s.key = key
+	}
+	return s.key, nil
+}
+
+func (s *ShareLinkServer) CreateShareLink(ctx context.Context, req *sharelinkpkg.CreateShareLinkRequest) (*sharelinkpkg.CreateShareLinkResponse, error) {
+	expiresIn := defaultExpiresIn
+	if req.ExpiresIn != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(req.ExpiresIn)
+		if err != nil || expiresIn <= 0 || expiresIn > maxExpiresIn {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expiresIn %q: must be a positive duration of at most %v", req.ExpiresIn, maxExpiresIn)
+		}
+	}
+	wf, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.instanceIDService.Validate(wf); err != nil {
+		return nil, err
+	}
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(expiresIn).Truncate(time.Second)
+	l := link{
+		ID:           id,
+		Namespace:    wf.Namespace,
+		WorkflowName: wf.Name,
+		WorkflowUID:  wf.UID,
+		ExpiresAt:    expiresAt.Unix(),
+		SingleUse:    req.SingleUse,
+	}
+	if req.ArtifactName != "" {
+		if err := s.validateArtifact(wf, req); err != nil {
+			return nil, err
+		}
+		l.NodeID = req.NodeId
+		l.ArtifactName = req.ArtifactName
+		l.Input = req.Input
+	} else {
+		// the logs are shared with the Argo Server's permissions, so the caller must have them too
+		allowed, err := auth.CanIGetPodLogs(ctx, wf.Namespace, req.PodName)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+		l.PodName = req.PodName
+		l.Container = req.Container
+		if l.Container == "" {
+			l.Container = common.MainContainerName
+		}
+	}
+	key, err := s.getKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := sign(key, l)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "id": l.ID, "artifactName": l.ArtifactName, "podName": l.PodName, "expiresAt": expiresAt, "singleUse": l.SingleUse}).Info("Created share link")
+	return &sharelinkpkg.CreateShareLinkResponse{
+		Path:      strings.TrimSuffix(s.baseHRef, "/") + sharedPath + token,
+		ExpiresAt: &metav1.Time{Time: expiresAt},
+	}, nil
+}
+
+func (s *ShareLinkServer) validateArtifact(wf *wfv1.Workflow, req *sharelinkpkg.CreateShareLinkRequest) error {
+	if req.NodeId == "" {
+		return status.Error(codes.InvalidArgument, "nodeId is required to share an artifact")
+	}
+	if err := s.hydrator.Hydrate(wf); err != nil {
+		return err
+	}
+	node, ok := wf.Status.Nodes[req.NodeId]
+	if !ok {
+		return status.Errorf(codes.NotFound, "node %q not found", req.NodeId)
+	}
+	var art *wfv1.Artifact
+	if req.Input {
+		art = node.Inputs.GetArtifactByName(req.ArtifactName)
+	} else {
+		art = node.Outputs.GetArtifactByName(req.ArtifactName)
+	}
+	if art == nil {
+		return status.Errorf(codes.NotFound, "artifact %q not found", req.ArtifactName)
+	}
+	return nil
+}
+
+func singleUseConfigMapName(id string) string {
+	return "argo-share-link-" + id
+}
+
+// useSingleUse records that the single-use link has been used. Creating the record is atomic, so only one request may
+// use it. The record is owned by the workflow, so it is deleted with it.
+func (s *ShareLinkServer) useSingleUse(ctx context.Context, l *link) error {
+	_, err := s.clients.Kubernetes.CoreV1().ConfigMaps(l.Namespace).Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   singleUseConfigMapName(l.ID),
+			Labels: map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapShareLink},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: wfv1.SchemeGroupVersion.String(),
+				Kind:       workflow.WorkflowKind,
+				Name:       l.WorkflowName,
+				UID:        l.WorkflowUID,
+			}},
+		},
+	}, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		return fmt.Errorf("link has already been used")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record use of single-use share link: %w", err)
+	}
+	return nil
+}
+
+// ServeShared serves the logs or artifact of the link at "/shared/<token>". Anyone with the link may get it, until it
+// expires.
+func (s *ShareLinkServer) ServeShared(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	key, err := s.getKey(ctx)
+	if err != nil {
+		s.serverInternalError(err, w)
+		return
+	}
+	l, err := verify(key, strings.TrimPrefix(r.URL.Path, sharedPath), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": l.Namespace, "workflow": l.WorkflowName, "id": l.ID})
+	if l.SingleUse {
+		if err := s.useSingleUse(ctx, l); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+	logCtx.WithFields(log.Fields{"artifactName": l.ArtifactName, "podName": l.PodName}).Info("Serving share link")
+	ctx = context.WithValue(context.WithValue(ctx, auth.WfKey, s.clients.Workflow), auth.KubeKey, s.clients.Kubernetes)
+	sw := &startedResponseWriter{ResponseWriter: w}
+	if l.isArtifact() {
+		err = s.artifacts.ServeSharedArtifact(ctx, sw, r, l.Namespace, l.WorkflowName, l.WorkflowUID, l.NodeID, l.ArtifactName, l.Input)
+	} else {
+		err = s.serveLogs(ctx, sw, l)
+	}
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to serve share link")
+		if sw.started {
+			// too late to write an error, so abort the connection, rather than end a truncated response as if it succeeded
+			panic(http.ErrAbortHandler)
+		}
+		s.serverInternalError(err, w)
+	}
+}
+
+// startedResponseWriter records whether the response has been started, after which its status cannot be changed
+type startedResponseWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (w *startedResponseWriter) WriteHeader(statusCode int) {
+	w.started = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *startedResponseWriter) Write(b []byte) (int, error) {
+	w.started = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *startedResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *ShareLinkServer) serveLogs(ctx context.Context, w http.ResponseWriter, l *link) error {
+	wf, err := s.clients.Workflow.ArgoprojV1alpha1().Workflows(l.Namespace).Get(ctx, l.WorkflowName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if wf.UID != l.WorkflowUID {
+		return fmt.Errorf("workflow %q not found", l.WorkflowName)
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	req := &workflowpkg.WorkflowLogRequest{
+		Namespace:  l.Namespace,
+		Name:       l.WorkflowName,
+		PodName:    l.PodName,
+		LogOptions: &corev1.PodLogOptions{Container: l.Container},
+	}
+	return logs.WorkflowLogs(ctx, s.clients.Workflow, s.clients.Kubernetes, req, &logWriter{w: w, withPodName: l.PodName == ""})
+}
+
+// logWriter writes log entries as text, prefixed with the pod's name if the logs are of more than one pod
+type logWriter struct {
+	w           http.ResponseWriter
+	withPodName bool
+}
+
+func (l *logWriter) Send(entry *workflowpkg.LogEntry) error {
+	var err error
+	if l.withPodName {
+		_, err = fmt.Fprintf(l.w, "%s: %s\n", entry.PodName, entry.Content)
+	} else {
+		_, err = fmt.Fprintln(l.w, entry.Content)
+	}
+	if f, ok := l.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return err
+}
+
+func (s *ShareLinkServer) serverInternalError(err error, w http.ResponseWriter) {
+	w.WriteHeader(500)
+	_, _ = w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,146 @@
+package sharelink
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	sharelinkpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sharelink"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+)
+
+type testArtifactServer struct{}
+
+func (testArtifactServer) ServeSharedArtifact(_ context.Context, w http.ResponseWriter, _ *http.Request, namespace, workflowName string, uid types.UID, nodeID, artifactName string, isInput bool) error {
+	_, _ = w.Write([]byte(strings.Join([]string{namespace, workflowName, string(uid), nodeID, artifactName}, "/")))
+	if artifactName == "my-truncated-art" {
+		return errors.New("connection reset")
+	}
+	return nil
+}
+
+func TestShareLinkServer(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-node": {Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "my-art"}, {Name: "my-truncated-art"}}}},
+		}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflow: "my-wf"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+	wfClient := wffake.NewSimpleClientset(wf)
+	kubeClient := fake.NewSimpleClientset(pod)
+	var reviewed *authorizationv1.ResourceAttributes
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviewed = action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).Spec.ResourceAttributes
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
+	s := NewShareLinkServer("argo", "/argo/", &servertypes.Clients{Workflow: wfClient, Kubernetes: kubeClient}, instanceid.NewService(""), hydratorfake.Noop, testArtifactServer{})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.ServeShared(w, httptest.NewRequest("GET", strings.TrimPrefix(path, "/argo"), nil))
+		return w
+	}
+
+	t.Run("CreateShareLink", func(t *testing.T) {
+		t.Run("InvalidExpiresIn", func(t *testing.T) {
+			for _, expiresIn := range []string{"1", "-1h", "169h"} {
+				_, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", ExpiresIn: expiresIn})
+				assert.Error(t, err, expiresIn)
+			}
+		})
+		t.Run("WorkflowNotFound", func(t *testing.T) {
+			_, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "not-found"})
+			assert.Error(t, err)
+		})
+		t.Run("NoNodeID", func(t *testing.T) {
+			_, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", ArtifactName: "my-art"})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = nodeId is required to share an artifact")
+		})
+		t.Run("ArtifactNotFound", func(t *testing.T) {
+			_, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", NodeId: "my-node", ArtifactName: "my-art", Input: true})
+			assert.EqualError(t, err, `rpc error: code = NotFound desc = artifact "my-art" not found`)
+		})
+	})
+	t.Run("Artifact", func(t *testing.T) {
+		resp, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", NodeId: "my-node", ArtifactName: "my-art", ExpiresIn: "1h"})
+		if assert.NoError(t, err) {
+			assert.True(t, strings.HasPrefix(resp.Path, "/argo/shared/"))
+			assert.NotNil(t, resp.ExpiresAt)
+			w := serve(resp.Path)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "my-ns/my-wf/my-uid/my-node/my-art", w.Body.String())
+		}
+	})
+	t.Run("ArtifactTruncated", func(t *testing.T) {
+		resp, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", NodeId: "my-node", ArtifactName: "my-truncated-art", ExpiresIn: "1h"})
+		if assert.NoError(t, err) {
+			assert.PanicsWithValue(t, http.ErrAbortHandler, func() { serve(resp.Path) }, "the connection is aborted, as the status has been written")
+		}
+	})
+	t.Run("Logs", func(t *testing.T) {
+		resp, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, &authorizationv1.ResourceAttributes{Namespace: "my-ns", Verb: "get", Resource: "pods", Subresource: "log"}, reviewed)
+			w := serve(resp.Path)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Equal(t, "my-pod: fake logs\n", w.Body.String())
+		}
+	})
+	t.Run("SingleUse", func(t *testing.T) {
+		resp, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf", PodName: "my-pod", SingleUse: true})
+		if assert.NoError(t, err) {
+			w := serve(resp.Path)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "fake logs\n", w.Body.String())
+			list, err := kubeClient.CoreV1().ConfigMaps("my-ns").List(ctx, metav1.ListOptions{})
+			if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+				assert.Equal(t, common.LabelValueTypeConfigMapShareLink, list.Items[0].Labels[common.LabelKeyConfigMapType])
+				assert.Equal(t, types.UID("my-uid"), list.Items[0].OwnerReferences[0].UID)
+			}
+			w = serve(resp.Path)
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Equal(t, "link has already been used\n", w.Body.String())
+		}
+	})
+	t.Run("InvalidLink", func(t *testing.T) {
+		w := serve("/shared/not-a-link")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+	t.Run("WorkflowRecreated", func(t *testing.T) {
+		resp, err := s.CreateShareLink(ctx, &sharelinkpkg.CreateShareLinkRequest{Namespace: "my-ns", Name: "my-wf"})
+		if assert.NoError(t, err) {
+			other := wf.DeepCopy()
+			other.UID = "other-uid"
+			_, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").Update(ctx, other, metav1.UpdateOptions{})
+			if assert.NoError(t, err) {
+				w := serve(resp.Path)
+				assert.Equal(t, http.StatusInternalServerError, w.Code)
+				assert.Equal(t, `workflow "my-wf" not found`, w.Body.String())
+			}
+		}
+	})
+}
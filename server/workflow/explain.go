@@ -0,0 +1,478 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// the kinds of the reasons a node has not started, or completed
+const (
+	explainKindWorkflow        = "Workflow"
+	explainKindDependencies    = "Dependencies"
+	explainKindSteps           = "Steps"
+	explainKindWhen            = "When"
+	explainKindSynchronization = "Synchronization"
+	explainKindParallelism     = "Parallelism"
+	explainKindScheduling      = "Scheduling"
+	explainKindSuspended       = "Suspended"
+	explainKindMessage         = "Message"
+)
+
+// ExplainWorkflowNode returns why a node of a workflow has not started, or completed: the dependencies, or steps, it
+// waits for, its when expression, the lock it waits for and who holds it, the parallelism limits it is held back by,
+// and, if its pod is pending, why the pod has not been scheduled
+func (s *workflowServer) ExplainWorkflowNode(ctx context.Context, req *workflowpkg.WorkflowExplainRequest) (*workflowpkg.WorkflowExplainResponse, error) {
+	wf, err := s.getWorkflow(ctx, auth.GetWfClient(ctx), req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkflow(wf); err != nil {
+		return nil, err
+	}
+	if err := s.hydrator.Hydrate(wf); err != nil {
+		return nil, err
+	}
+	resp, node := explainNode(wf, req.NodeName)
+	if resp == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("workflow %s has no node, step, or task %q", wf.Name, req.NodeName))
+	}
+	if node != nil && node.Type == wfv1.NodeTypePod && node.Phase == wfv1.NodePending {
+		reasons, err := explainPod(ctx, auth.GetKubeClient(ctx), wf, node)
+		if err != nil {
+			return nil, err
+		}
+		resp.Reasons = append(resp.Reasons, reasons...)
+	}
+	return resp, nil
+}
+
+// explainNode explains the node with the ID, name, or display name, or the step, or task, with the name that does not
+// have a node yet. It returns nil if there is neither.
+func explainNode(wf *wfv1.Workflow, nodeName string) (*workflowpkg.WorkflowExplainResponse, *wfv1.NodeStatus) {
+	resp := &workflowpkg.WorkflowExplainResponse{NodeName: nodeName, Reasons: explainWorkflow(wf)}
+	node := findNode(wf, nodeName)
+	if node == nil {
+		reasons, ok := explainMissingNode(wf, nodeName)
+		if !ok {
+			return nil, nil
+		}
+		resp.Reasons = append(resp.Reasons, reasons...)
+		return resp, nil
+	}
+	resp.NodeId = node.ID
+	resp.NodeName = node.Name
+	resp.Phase = string(node.Phase)
+	resp.Reasons = append(resp.Reasons, explainExistingNode(wf, node)...)
+	return resp, node
+}
+
+func findNode(wf *wfv1.Workflow, nodeName string) *wfv1.NodeStatus {
+	if node, ok := wf.Status.Nodes[nodeName]; ok {
+		return &node
+	}
+	if node := wf.GetNodeByName(nodeName); node != nil {
+		return node
+	}
+	return wf.Status.Nodes.FindByDisplayName(nodeName)
+}
+
+func explainWorkflow(wf *wfv1.Workflow) []*workflowpkg.NodeExplanation {
+	var reasons []*workflowpkg.NodeExplanation
+	if wf.Status.Fulfilled() {
+		return append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindWorkflow, Message: fmt.Sprintf("the workflow has completed: %s", wf.Status.Phase)})
+	}
+	if wf.Spec.Suspend != nil && *wf.Spec.Suspend {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindSuspended, Message: "the workflow is suspended"})
+	}
+	for _, condition := range wf.Status.Conditions {
+		if condition.Type == wfv1.ConditionTypeQueued && condition.Status == metav1.ConditionTrue {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindWorkflow, Message: fmt.Sprintf("the workflow is queued: %s", condition.Message)})
+		}
+	}
+	if wf.Status.Phase == wfv1.WorkflowPending && wf.Status.Message != "" {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindWorkflow, Message: fmt.Sprintf("the workflow is pending: %s", wf.Status.Message)})
+	}
+	return reasons
+}
+
+func explainExistingNode(wf *wfv1.Workflow, node *wfv1.NodeStatus) []*workflowpkg.NodeExplanation {
+	if node.Fulfilled() {
+		kind := explainKindMessage
+		if node.Phase == wfv1.NodeSkipped && strings.HasPrefix(node.Message, "when ") {
+			kind = explainKindWhen
+		}
+		message := fmt.Sprintf("the node has completed: %s", node.Phase)
+		if node.Message != "" {
+			message += ": " + node.Message
+		}
+		return []*workflowpkg.NodeExplanation{{Kind: kind, Message: message}}
+	}
+	var reasons []*workflowpkg.NodeExplanation
+	if node.IsActiveSuspendNode() {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindSuspended, Message: "the node is suspended until it is resumed"})
+	}
+	if node.SynchronizationStatus != nil && node.SynchronizationStatus.Waiting != "" {
+		reasons = append(reasons, explainLock(wf, node.SynchronizationStatus.Waiting))
+	}
+	if node.Type == wfv1.NodeTypeDAG || node.Type == wfv1.NodeTypeSteps {
+		var waiting []string
+		for _, childID := range node.Children {
+			if child, ok := wf.Status.Nodes[childID]; ok && !child.Fulfilled() {
+				waiting = append(waiting, fmt.Sprintf("%s (%s)", child.DisplayName, child.Phase))
+			}
+		}
+		if len(waiting) > 0 {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindMessage, Message: fmt.Sprintf("waiting for %s", strings.Join(waiting, ", "))})
+		}
+	}
+	if node.Message != "" {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindMessage, Message: node.Message})
+	}
+	return reasons
+}
+
+// explainLock explains the lock, and who holds it
+func explainLock(wf *wfv1.Workflow, lockName string) *workflowpkg.NodeExplanation {
+	var holders []string
+	if sync := wf.Status.Synchronization; sync != nil {
+		if sync.Semaphore != nil {
+			if _, waiting := sync.Semaphore.GetWaiting(lockName); waiting.Semaphore != "" {
+				holders = append(holders, waiting.Holders...)
+			}
+		}
+		if sync.Mutex != nil {
+			for _, waiting := range sync.Mutex.Waiting {
+				if waiting.Mutex == lockName && waiting.Holder != "" {
+					holders = append(holders, waiting.Holder)
+				}
+			}
+		}
+	}
+	message := fmt.Sprintf("waiting for lock %s", lockName)
+	if len(holders) > 0 {
+		message += fmt.Sprintf(", held by %s", strings.Join(holders, ", "))
+	}
+	return &workflowpkg.NodeExplanation{Kind: explainKindSynchronization, Message: message}
+}
+
+// explainMissingNode finds the step, or task, that would create the node with the name, and explains why it has not
+func explainMissingNode(wf *wfv1.Workflow, nodeName string) ([]*workflowpkg.NodeExplanation, bool) {
+	var parents []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeDAG || node.Type == wfv1.NodeTypeSteps {
+			parents = append(parents, node)
+		}
+	}
+	sort.Slice(parents, func(i, j int) bool { return parents[i].Name < parents[j].Name })
+	for _, parent := range parents {
+		parent := parent
+		tmpl := nodeTemplate(wf, &parent)
+		if tmpl == nil {
+			continue
+		}
+		switch {
+		case tmpl.DAG != nil:
+			for _, task := range tmpl.DAG.Tasks {
+				if nodeName == task.Name || nodeName == parent.Name+"."+task.Name {
+					return explainTask(wf, &parent, tmpl, task), true
+				}
+			}
+		case tmpl.Steps != nil:
+			for i, group := range tmpl.Steps {
+				for _, step := range group.Steps {
+					if nodeName == step.Name || nodeName == fmt.Sprintf("%s[%d].%s", parent.Name, i, step.Name) {
+						return explainStep(wf, &parent, tmpl, i, step), true
+					}
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+func explainTask(wf *wfv1.Workflow, dagNode *wfv1.NodeStatus, tmpl *wfv1.Template, task wfv1.DAGTask) []*workflowpkg.NodeExplanation {
+	var reasons []*workflowpkg.NodeExplanation
+	dagCtx := &explainDAGContext{wf: wf, dagNode: dagNode, tasks: tmpl.DAG.Tasks}
+	dependencies, depends := common.GetTaskDependencies(&task, dagCtx)
+	var names []string
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var waiting []string
+	for _, name := range names {
+		phase := wfv1.NodePhase("not started")
+		if node := wf.GetNodeByName(dagNode.Name + "." + name); node != nil {
+			if node.Fulfilled() {
+				continue
+			}
+			phase = node.Phase
+		}
+		waiting = append(waiting, fmt.Sprintf("%s (%s)", name, phase))
+	}
+	if len(waiting) > 0 {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindDependencies, Message: fmt.Sprintf("waiting for %s to complete, depends on %q", strings.Join(waiting, ", "), depends)})
+	} else if depends != "" {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindDependencies, Message: fmt.Sprintf("the dependencies have completed, depends on %q, which must be true", depends)})
+	}
+	if task.When != "" {
+		scope := map[string]*wfv1.NodeStatus{}
+		for _, t := range tmpl.DAG.Tasks {
+			scope["tasks."+t.Name] = wf.GetNodeByName(dagNode.Name + "." + t.Name)
+		}
+		reasons = append(reasons, explainWhen(wf, dagNode, scope, task.When, "the dependencies have completed"))
+	}
+	return append(reasons, explainParallelism(wf, dagNode, tmpl)...)
+}
+
+func explainStep(wf *wfv1.Workflow, stepsNode *wfv1.NodeStatus, tmpl *wfv1.Template, i int, step wfv1.WorkflowStep) []*workflowpkg.NodeExplanation {
+	var reasons []*workflowpkg.NodeExplanation
+	if i > 0 {
+		groupName := fmt.Sprintf("%s[%d]", stepsNode.Name, i-1)
+		phase := wfv1.NodePhase("not started")
+		if group := wf.GetNodeByName(groupName); group != nil {
+			phase = group.Phase
+		}
+		if !phase.Fulfilled() {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindSteps, Message: fmt.Sprintf("waiting for the previous steps %s (%s) to complete", groupName, phase)})
+		}
+	}
+	if step.When != "" {
+		scope := map[string]*wfv1.NodeStatus{}
+		for j, group := range tmpl.Steps[:i] {
+			for _, s := range group.Steps {
+				scope["steps."+s.Name] = wf.GetNodeByName(fmt.Sprintf("%s[%d].%s", stepsNode.Name, j, s.Name))
+			}
+		}
+		reasons = append(reasons, explainWhen(wf, stepsNode, scope, step.When, "the previous steps have completed"))
+	}
+	return append(reasons, explainParallelism(wf, stepsNode, tmpl)...)
+}
+
+// explainWhen evaluates the when expression against the workflow's parameters, the parent's inputs, and the outputs of
+// the nodes of the scope, by their prefixes, e.g. "tasks.a", as the controller would. If it refers to anything that is not
+// known yet, e.g. the outputs of a node that has not completed, it is evaluated once the nodes have completed.
+func explainWhen(wf *wfv1.Workflow, parent *wfv1.NodeStatus, scope map[string]*wfv1.NodeStatus, when string, once string) *workflowpkg.NodeExplanation {
+	params := map[string]string{
+		common.GlobalVarWorkflowName:      wf.Name,
+		common.GlobalVarWorkflowNamespace: wf.Namespace,
+		common.GlobalVarWorkflowUID:       string(wf.UID),
+	}
+	for _, p := range wf.Spec.Arguments.Parameters {
+		if p.Value != nil {
+			params["workflow.parameters."+p.Name] = p.Value.String()
+		}
+	}
+	if outputs := wf.Status.Outputs; outputs != nil {
+		for _, p := range outputs.Parameters {
+			if p.Value != nil {
+				params["workflow.outputs.parameters."+p.Name] = p.Value.String()
+			}
+		}
+	}
+	if inputs := parent.Inputs; inputs != nil {
+		for _, p := range inputs.Parameters {
+			if p.Value != nil {
+				params["inputs.parameters."+p.Name] = p.Value.String()
+			}
+		}
+	}
+	for prefix, node := range scope {
+		addNodeToScope(wf, params, prefix, node)
+	}
+	resolved := when
+	data, err := json.Marshal(when)
+	if err == nil {
+		var replaced string
+		if replaced, err = template.Replace(string(data), params, true); err == nil {
+			err = json.Unmarshal([]byte(replaced), &resolved)
+		}
+	}
+	if err != nil {
+		return &workflowpkg.NodeExplanation{Kind: explainKindWhen, Message: fmt.Sprintf("when '%s' cannot be evaluated: %v", when, err)}
+	}
+	if strings.Contains(resolved, "{{") {
+		return &workflowpkg.NodeExplanation{Kind: explainKindWhen, Message: fmt.Sprintf("when '%s' is evaluated once %s", when, once)}
+	}
+	proceed, err := common.ShouldExecute(resolved)
+	if err != nil {
+		return &workflowpkg.NodeExplanation{Kind: explainKindWhen, Message: fmt.Sprintf("when '%s' cannot be evaluated: %v", when, err)}
+	}
+	message := fmt.Sprintf("when '%s' evaluates %t", when, proceed)
+	if resolved != when {
+		message = fmt.Sprintf("when '%s', i.e. '%s', evaluates %t", when, resolved, proceed)
+	}
+	return &workflowpkg.NodeExplanation{Kind: explainKindWhen, Message: message}
+}
+
+// addNodeToScope adds the status, and outputs, of the node, if it has completed, to the parameters with the prefix. The
+// outputs of a retry node are those of its last child, as in the controller
+func addNodeToScope(wf *wfv1.Workflow, params map[string]string, prefix string, node *wfv1.NodeStatus) {
+	if node == nil || !node.Fulfilled() {
+		return
+	}
+	if node.Type == wfv1.NodeTypeRetry && len(node.Children) > 0 {
+		if child, ok := wf.Status.Nodes[node.Children[len(node.Children)-1]]; ok {
+			node = &child
+		}
+	}
+	params[prefix+".id"] = node.ID
+	params[prefix+".status"] = string(node.Phase)
+	if !node.StartedAt.IsZero() {
+		params[prefix+".startedAt"] = node.StartedAt.Format(time.RFC3339)
+	}
+	if !node.FinishedAt.IsZero() {
+		params[prefix+".finishedAt"] = node.FinishedAt.Format(time.RFC3339)
+	}
+	if node.PodIP != "" {
+		params[prefix+".ip"] = node.PodIP
+	}
+	outputs := node.Outputs
+	if outputs == nil {
+		return
+	}
+	if outputs.Result != nil {
+		params[prefix+".outputs.result"] = *outputs.Result
+	}
+	if outputs.ExitCode != nil {
+		params[prefix+".exitCode"] = *outputs.ExitCode
+	}
+	for _, p := range outputs.Parameters {
+		if p.Value != nil {
+			params[prefix+".outputs.parameters."+p.Name] = p.Value.String()
+		}
+	}
+}
+
+// explainParallelism explains the workflow's, and the parent template's, parallelism limits, if they are reached
+func explainParallelism(wf *wfv1.Workflow, parent *wfv1.NodeStatus, tmpl *wfv1.Template) []*workflowpkg.NodeExplanation {
+	var reasons []*workflowpkg.NodeExplanation
+	if wf.Spec.Parallelism != nil {
+		if active := countActivePods(wf, ""); active >= *wf.Spec.Parallelism {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindParallelism, Message: fmt.Sprintf("the workflow's parallelism %d is reached by %d active pods", *wf.Spec.Parallelism, active)})
+		}
+	}
+	if tmpl.HasParallelism() {
+		if active := countActivePods(wf, parent.ID); active >= *tmpl.Parallelism {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindParallelism, Message: fmt.Sprintf("template %s's parallelism %d is reached by %d active pods", tmpl.Name, *tmpl.Parallelism, active)})
+		}
+	}
+	return reasons
+}
+
+// countActivePods counts the pending, or running, pods of the boundary, or of the workflow if it is empty, in the same
+// way as the controller does
+func countActivePods(wf *wfv1.Workflow, boundaryID string) int64 {
+	var count int64
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod && (boundaryID == "" || node.BoundaryID == boundaryID) &&
+			(node.Phase == wfv1.NodePending || node.Phase == wfv1.NodeRunning) &&
+			(node.SynchronizationStatus == nil || node.SynchronizationStatus.Waiting == "") {
+			count++
+		}
+	}
+	return count
+}
+
+// nodeTemplate returns the template of the node, from the workflow, or its stored templates
+func nodeTemplate(wf *wfv1.Workflow, node *wfv1.NodeStatus) *wfv1.Template {
+	scope, resourceName := node.GetTemplateScope()
+	if tmpl := wf.GetStoredTemplate(scope, resourceName, node); tmpl != nil {
+		return tmpl
+	}
+	if node.TemplateName == "" {
+		return nil
+	}
+	return wf.GetTemplateByName(node.TemplateName)
+}
+
+type explainDAGContext struct {
+	wf      *wfv1.Workflow
+	dagNode *wfv1.NodeStatus
+	tasks   []wfv1.DAGTask
+}
+
+func (d *explainDAGContext) GetTask(taskName string) *wfv1.DAGTask {
+	for _, task := range d.tasks {
+		if task.Name == taskName {
+			return &task
+		}
+	}
+	return nil
+}
+
+func (d *explainDAGContext) GetTaskDependencies(taskName string) []string {
+	task := d.GetTask(taskName)
+	if task == nil {
+		return nil
+	}
+	dependencies, _ := common.GetTaskDependencies(task, d)
+	var names []string
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (d *explainDAGContext) GetTaskFinishedAtTime(taskName string) time.Time {
+	if node := d.wf.GetNodeByName(d.dagNode.Name + "." + taskName); node != nil {
+		return node.FinishedAt.Time
+	}
+	return time.Time{}
+}
+
+// explainPod explains why the pod of the pending node has not started: it has not been created, it cannot be
+// scheduled, its containers are waiting, or the warning events about it
+func explainPod(ctx context.Context, kubeClient kubernetes.Interface, wf *wfv1.Workflow, node *wfv1.NodeStatus) ([]*workflowpkg.NodeExplanation, error) {
+	podName := util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf))
+	pod, err := kubeClient.CoreV1().Pods(wf.GetPodNamespace()).Get(ctx, podName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return []*workflowpkg.NodeExplanation{{Kind: explainKindScheduling, Message: fmt.Sprintf("pod %s has not been created", podName)}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var reasons []*workflowpkg.NodeExplanation
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+			reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindScheduling, Message: fmt.Sprintf("pod %s is unschedulable: %s: %s", podName, condition.Reason, condition.Message)})
+		}
+	}
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, s := range statuses {
+			if s.State.Waiting != nil && s.State.Waiting.Reason != "" && s.State.Waiting.Reason != "PodInitializing" && s.State.Waiting.Reason != "ContainerCreating" {
+				reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindScheduling, Message: fmt.Sprintf("container %s is waiting: %s: %s", s.Name, s.State.Waiting.Reason, s.State.Waiting.Message)})
+			}
+		}
+	}
+	events, err := kubeClient.CoreV1().Events(wf.GetPodNamespace()).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": podName, "type": corev1.EventTypeWarning}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(events.Items, func(i, j int) bool { return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp) })
+	for _, event := range events.Items {
+		reasons = append(reasons, &workflowpkg.NodeExplanation{Kind: explainKindScheduling, Message: fmt.Sprintf("%s: %s", event.Reason, event.Message)})
+	}
+	return reasons, nil
+}
@@ -0,0 +1,167 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var explainWf = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  parallelism: 1
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: pod
+          - name: b
+            template: pod
+            depends: a
+            when: "{{tasks.a.outputs.result}} == yes"
+          - name: c
+            template: pod
+            when: "false"
+          - name: d
+            template: pod
+    - name: pod
+      container:
+        image: argoproj/argosay:v2
+status:
+  phase: Running
+  synchronization:
+    mutex:
+      waiting:
+        - mutex: my-ns/Mutex/my-mutex
+          holder: my-ns/other-wf
+`
+
+func newExplainWorkflow() *wfv1.Workflow {
+	wf := wfv1.MustUnmarshalWorkflow(explainWf)
+	node := func(name, displayName string, nodeType wfv1.NodeType, phase wfv1.NodePhase, templateName string) wfv1.NodeStatus {
+		return wfv1.NodeStatus{ID: wf.NodeID(name), Name: name, DisplayName: displayName, Type: nodeType, Phase: phase, TemplateName: templateName, BoundaryID: wf.NodeID("my-wf")}
+	}
+	root := node("my-wf", "my-wf", wfv1.NodeTypeDAG, wfv1.NodeRunning, "main")
+	root.BoundaryID = ""
+	a := node("my-wf.a", "a", wfv1.NodeTypePod, wfv1.NodePending, "pod")
+	c := node("my-wf.c", "c", wfv1.NodeTypeSkipped, wfv1.NodeSkipped, "pod")
+	c.Message = "when 'false' evaluated false"
+	d := node("my-wf.d", "d", wfv1.NodeTypePod, wfv1.NodePending, "pod")
+	d.SynchronizationStatus = &wfv1.NodeSynchronizationStatus{Waiting: "my-ns/Mutex/my-mutex"}
+	root.Children = []string{a.ID, c.ID, d.ID}
+	wf.Status.Nodes = wfv1.Nodes{root.ID: root, a.ID: a, c.ID: c, d.ID: d}
+	return wf
+}
+
+func TestExplainNode(t *testing.T) {
+	wf := newExplainWorkflow()
+	t.Run("NotFound", func(t *testing.T) {
+		resp, _ := explainNode(wf, "not-found")
+		assert.Nil(t, resp)
+	})
+	t.Run("DAG", func(t *testing.T) {
+		resp, node := explainNode(wf, "my-wf")
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, wf.NodeID("my-wf"), node.ID)
+			assert.Equal(t, []*workflowpkg.NodeExplanation{{Kind: explainKindMessage, Message: "waiting for a (Pending), d (Pending)"}}, resp.Reasons)
+		}
+	})
+	t.Run("Dependencies", func(t *testing.T) {
+		resp, node := explainNode(wf, "b")
+		if assert.NotNil(t, resp) {
+			assert.Nil(t, node)
+			assert.Empty(t, resp.NodeId)
+			assert.Equal(t, []*workflowpkg.NodeExplanation{
+				{Kind: explainKindDependencies, Message: `waiting for a (Pending) to complete, depends on "(a.Succeeded || a.Skipped || a.Daemoned)"`},
+				{Kind: explainKindWhen, Message: "when '{{tasks.a.outputs.result}} == yes' is evaluated once the dependencies have completed"},
+				{Kind: explainKindParallelism, Message: "the workflow's parallelism 1 is reached by 1 active pods"},
+			}, resp.Reasons)
+		}
+	})
+	t.Run("WhenEvaluated", func(t *testing.T) {
+		for result, proceed := range map[string]string{"yes": "true", "no": "false"} {
+			wf := wf.DeepCopy()
+			a := wf.Status.Nodes[wf.NodeID("my-wf.a")]
+			a.Phase = wfv1.NodeSucceeded
+			a.Outputs = &wfv1.Outputs{Result: pointer.StringPtr(result)}
+			wf.Status.Nodes[a.ID] = a
+			resp, _ := explainNode(wf, "b")
+			if assert.NotNil(t, resp) {
+				assert.Equal(t, []*workflowpkg.NodeExplanation{
+					{Kind: explainKindDependencies, Message: `the dependencies have completed, depends on "(a.Succeeded || a.Skipped || a.Daemoned)", which must be true`},
+					{Kind: explainKindWhen, Message: "when '{{tasks.a.outputs.result}} == yes', i.e. '" + result + " == yes', evaluates " + proceed},
+				}, resp.Reasons)
+			}
+		}
+	})
+	t.Run("When", func(t *testing.T) {
+		resp, _ := explainNode(wf, "my-wf.c")
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, string(wfv1.NodeSkipped), resp.Phase)
+			assert.Equal(t, []*workflowpkg.NodeExplanation{{Kind: explainKindWhen, Message: "the node has completed: Skipped: when 'false' evaluated false"}}, resp.Reasons)
+		}
+	})
+	t.Run("Synchronization", func(t *testing.T) {
+		resp, _ := explainNode(wf, wf.NodeID("my-wf.d"))
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, []*workflowpkg.NodeExplanation{{Kind: explainKindSynchronization, Message: "waiting for lock my-ns/Mutex/my-mutex, held by my-ns/other-wf"}}, resp.Reasons)
+		}
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Suspend = pointer.BoolPtr(true)
+		resp, _ := explainNode(wf, "a")
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, []*workflowpkg.NodeExplanation{{Kind: explainKindSuspended, Message: "the workflow is suspended"}}, resp.Reasons)
+		}
+	})
+}
+
+func TestExplainPod(t *testing.T) {
+	ctx := context.Background()
+	wf := newExplainWorkflow()
+	node := wf.Status.Nodes[wf.NodeID("my-wf.a")]
+	kubeClient := fake.NewSimpleClientset()
+	t.Run("NotCreated", func(t *testing.T) {
+		reasons, err := explainPod(ctx, kubeClient, wf, &node)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []*workflowpkg.NodeExplanation{{Kind: explainKindScheduling, Message: "pod my-wf-3772703586 has not been created"}}, reasons)
+		}
+	})
+	t.Run("Unschedulable", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-wf-3772703586", Namespace: "my-ns"},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available: 3 Insufficient cpu."}},
+				},
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "my-event", Namespace: "my-ns"},
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "my-wf-3772703586"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "FailedScheduling",
+				Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+			},
+		)
+		reasons, err := explainPod(ctx, kubeClient, wf, &node)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []*workflowpkg.NodeExplanation{
+				{Kind: explainKindScheduling, Message: "pod my-wf-3772703586 is unschedulable: Unschedulable: 0/3 nodes are available: 3 Insufficient cpu."},
+				{Kind: explainKindScheduling, Message: "FailedScheduling: 0/3 nodes are available: 3 Insufficient cpu."},
+			}, reasons)
+		}
+	})
+}
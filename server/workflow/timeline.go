@@ -0,0 +1,183 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// the kinds of the events of a timeline, in the order events that happen at the same time are listed in
+const (
+	timelineKindWorkflow = "Workflow"
+	timelineKindNode     = "Node"
+	timelineKindRetry    = "Retry"
+	timelineKindPod      = "Pod"
+	timelineKindArtifact = "Artifact"
+)
+
+var timelineKindOrder = map[string]int{timelineKindWorkflow: 0, timelineKindRetry: 1, timelineKindNode: 2, timelineKindPod: 3, timelineKindArtifact: 4}
+
+// the reasons of the Kubernetes events of a workflow that its status already has, and so are not in its timeline
+var statusEventReasons = map[string]bool{"WorkflowRunning": true, "WorkflowSucceeded": true, "WorkflowFailed": true}
+
+// GetWorkflowTimeline returns the events of a workflow in the order they happened, so that the course of an incident
+// can be followed in one view: when the workflow was created, started and completed, when each node started and
+// completed, and which were retries, the Kubernetes events of the workflow, and its pods, and when artifacts were
+// saved, and deleted
+func (s *workflowServer) GetWorkflowTimeline(ctx context.Context, req *workflowpkg.WorkflowTimelineRequest) (*workflowpkg.WorkflowTimeline, error) {
+	wf, err := s.getWorkflow(ctx, auth.GetWfClient(ctx), req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkflow(wf); err != nil {
+		return nil, err
+	}
+	if err := s.hydrator.Hydrate(wf); err != nil {
+		return nil, err
+	}
+	events, err := listTimelineEvents(ctx, auth.GetKubeClient(ctx), wf)
+	if err != nil {
+		return nil, err
+	}
+	return workflowTimeline(wf, events), nil
+}
+
+// listTimelineEvents lists the Kubernetes events of the workflow, and of each of its pods, rather than of every pod in
+// the namespace
+func listTimelineEvents(ctx context.Context, kubeClient kubernetes.Interface, wf *wfv1.Workflow) ([]corev1.Event, error) {
+	list, err := kubeClient.CoreV1().Events(wf.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"involvedObject.kind": workflow.WorkflowKind, "involvedObject.name": wf.Name}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := list.Items
+	for _, podName := range timelinePodNames(wf) {
+		list, err := kubeClient.CoreV1().Events(wf.GetPodNamespace()).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": podName}).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, list.Items...)
+	}
+	return events, nil
+}
+
+// timelinePodNames returns the names of the pods of the workflow's pod nodes, sorted
+func timelinePodNames(wf *wfv1.Workflow) []string {
+	var podNames []string
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			podNames = append(podNames, util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf)))
+		}
+	}
+	sort.Strings(podNames)
+	return podNames
+}
+
+// workflowTimeline returns the timeline of the workflow, with those of the Kubernetes events that are about the
+// workflow, or its pods
+func workflowTimeline(wf *wfv1.Workflow, events []corev1.Event) *workflowpkg.WorkflowTimeline {
+	timeline := &workflowpkg.WorkflowTimeline{}
+	add := func(t metav1.Time, kind string, node *wfv1.NodeStatus, reason, message string) {
+		if t.IsZero() {
+			return
+		}
+		e := &workflowpkg.TimelineEvent{Time: &t, Kind: kind, Reason: reason, Message: message}
+		if node != nil {
+			e.NodeId = node.ID
+			e.NodeName = node.DisplayName
+		}
+		timeline.Events = append(timeline.Events, e)
+	}
+
+	add(wf.CreationTimestamp, timelineKindWorkflow, nil, "Created", "")
+	add(wf.Status.StartedAt, timelineKindWorkflow, nil, string(wfv1.WorkflowRunning), "")
+	if wf.Status.Fulfilled() {
+		add(wf.Status.FinishedAt, timelineKindWorkflow, nil, string(wf.Status.Phase), wf.Status.Message)
+	}
+
+	pods := map[string]*wfv1.NodeStatus{}
+	for _, node := range wf.Status.Nodes {
+		node := node
+		add(node.StartedAt, timelineKindNode, &node, "Started", "")
+		if node.Fulfilled() {
+			add(node.FinishedAt, timelineKindNode, &node, string(node.Phase), node.Message)
+		}
+		if node.Type == wfv1.NodeTypeRetry {
+			for i, childID := range node.Children {
+				if child, ok := wf.Status.Nodes[childID]; ok && i > 0 {
+					add(child.StartedAt, timelineKindRetry, &node, "Retried", fmt.Sprintf("retry %d started as %s", i, child.DisplayName))
+				}
+			}
+		}
+		if node.Type == wfv1.NodeTypePod {
+			pods[util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf))] = &node
+		}
+		if node.Outputs != nil && node.Phase == wfv1.NodeSucceeded {
+			for _, art := range node.Outputs.Artifacts {
+				message := fmt.Sprintf("saved artifact %s", art.Name)
+				if key, err := art.GetKey(); err == nil && key != "" {
+					message += " to " + key
+				}
+				add(node.FinishedAt, timelineKindArtifact, &node, "Saved", message)
+			}
+		}
+	}
+
+	if deleted, err := time.Parse(time.RFC3339, wf.Annotations[common.AnnotationKeyArtifactsDeleted]); err == nil {
+		add(metav1.NewTime(deleted), timelineKindArtifact, nil, "Deleted", "the workflow's output artifacts were deleted")
+	}
+
+	for _, event := range events {
+		t := event.FirstTimestamp
+		if t.IsZero() {
+			t = metav1.NewTime(event.EventTime.Time)
+		}
+		message := event.Message
+		if event.Count > 1 {
+			message += fmt.Sprintf(" (x%d)", event.Count)
+		}
+		switch event.InvolvedObject.Kind {
+		case workflow.WorkflowKind:
+			if event.InvolvedObject.Name != wf.Name || strings.HasPrefix(event.Reason, "WorkflowNode") || statusEventReasons[event.Reason] {
+				continue
+			}
+			add(t, timelineKindWorkflow, nil, event.Reason, message)
+		case "Pod":
+			if node, ok := pods[event.InvolvedObject.Name]; ok {
+				add(t, timelineKindPod, node, event.Reason, message)
+			}
+		}
+	}
+
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		a, b := timeline.Events[i], timeline.Events[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		if timelineKindOrder[a.Kind] != timelineKindOrder[b.Kind] {
+			return timelineKindOrder[a.Kind] < timelineKindOrder[b.Kind]
+		}
+		if a.NodeName != b.NodeName {
+			return a.NodeName < b.NodeName
+		}
+		return a.NodeId < b.NodeId
+	})
+	return timeline
+}
@@ -0,0 +1,89 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func TestWorkflowTimeline(t *testing.T) {
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) metav1.Time { return metav1.NewTime(t0.Add(time.Duration(seconds) * time.Second)) }
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-wf",
+			Namespace:         "my-ns",
+			CreationTimestamp: at(0),
+			Annotations:       map[string]string{common.AnnotationKeyArtifactsDeleted: t0.Add(time.Hour).Format(time.RFC3339)},
+		},
+		Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, StartedAt: at(1), FinishedAt: at(30), Message: "child failed"},
+	}
+	retry := wfv1.NodeStatus{ID: "my-wf", Name: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeRetry, Phase: wfv1.NodeFailed, StartedAt: at(1), FinishedAt: at(30), Children: []string{"my-wf-1", "my-wf-2"}}
+	first := wfv1.NodeStatus{ID: "my-wf-1", Name: "my-wf(0)", DisplayName: "my-wf(0)", Type: wfv1.NodeTypePod, TemplateName: "main", Phase: wfv1.NodeSucceeded, StartedAt: at(1), FinishedAt: at(10),
+		Outputs: &wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-art.tgz"}}}}}}
+	second := wfv1.NodeStatus{ID: "my-wf-2", Name: "my-wf(1)", DisplayName: "my-wf(1)", Type: wfv1.NodeTypePod, TemplateName: "main", Phase: wfv1.NodeFailed, StartedAt: at(20), FinishedAt: at(30), Message: "Error (exit code 1)"}
+	wf.Status.Nodes = wfv1.Nodes{retry.ID: retry, first.ID: first, second.ID: second}
+	podName := util.PodName(wf.Name, second.Name, second.TemplateName, second.ID, util.GetWorkflowPodNameVersion(wf))
+	events := []corev1.Event{
+		{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: podName}, Reason: "BackOff", Message: "Back-off pulling image", FirstTimestamp: at(21), Count: 2},
+		{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-pod"}, Reason: "Scheduled", FirstTimestamp: at(21)},
+		{InvolvedObject: corev1.ObjectReference{Kind: "Workflow", Name: "my-wf"}, Reason: "WorkflowRunning", FirstTimestamp: at(1)},
+		{InvolvedObject: corev1.ObjectReference{Kind: "Workflow", Name: "my-wf"}, Reason: "WorkflowNodeFailed", FirstTimestamp: at(30)},
+		{InvolvedObject: corev1.ObjectReference{Kind: "Workflow", Name: "my-wf"}, Reason: "WorkflowTimedOut", Message: "Workflow timed out", EventTime: metav1.NewMicroTime(at(29).Time)},
+	}
+
+	timeline := workflowTimeline(wf, events)
+
+	var got []string
+	for _, e := range timeline.Events {
+		got = append(got, e.Time.UTC().Format("15:04:05")+" "+e.Kind+" "+e.NodeName+" "+e.Reason+" "+e.Message)
+	}
+	assert.Equal(t, []string{
+		"00:00:00 Workflow  Created ",
+		"00:00:01 Workflow  Running ",
+		"00:00:01 Node my-wf Started ",
+		"00:00:01 Node my-wf(0) Started ",
+		"00:00:10 Node my-wf(0) Succeeded ",
+		"00:00:10 Artifact my-wf(0) Saved saved artifact my-art to my-wf/my-art.tgz",
+		"00:00:20 Retry my-wf Retried retry 1 started as my-wf(1)",
+		"00:00:20 Node my-wf(1) Started ",
+		"00:00:21 Pod my-wf(1) BackOff Back-off pulling image (x2)",
+		"00:00:29 Workflow  WorkflowTimedOut Workflow timed out",
+		"00:00:30 Workflow  Failed child failed",
+		"00:00:30 Node my-wf Failed ",
+		"00:00:30 Node my-wf(1) Failed Error (exit code 1)",
+		"01:00:00 Artifact  Deleted the workflow's output artifacts were deleted",
+	}, got)
+	assert.Equal(t, "my-wf-2", timeline.Events[8].NodeId)
+}
+
+func TestListTimelineEvents(t *testing.T) {
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}
+	node := wfv1.NodeStatus{ID: "my-wf-1", Name: "my-wf", Type: wfv1.NodeTypePod, TemplateName: "main"}
+	wf.Status.Nodes = wfv1.Nodes{node.ID: node}
+	kubeClient := fake.NewSimpleClientset()
+	var selectors []string
+	kubeClient.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		selectors = append(selectors, action.(k8stesting.ListAction).GetListRestrictions().Fields.String())
+		return true, &corev1.EventList{}, nil
+	})
+	_, err := listTimelineEvents(context.Background(), kubeClient, wf)
+	if assert.NoError(t, err) {
+		podName := util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf))
+		assert.Equal(t, []string{
+			"involvedObject.kind=Workflow,involvedObject.name=my-wf",
+			"involvedObject.kind=Pod,involvedObject.name=" + podName,
+		}, selectors)
+	}
+}
@@ -0,0 +1,91 @@
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// workflowListFilter filters workflows by the field selector requirements that the Kubernetes API does not support
+// for custom resources
+type workflowListFilter struct {
+	namePrefix    string
+	namePattern   *regexp.Regexp
+	minStartedAt  time.Time
+	maxStartedAt  time.Time
+	minFinishedAt time.Time
+	maxFinishedAt time.Time
+}
+
+// newWorkflowListFilter removes the field selector requirements that the Kubernetes API does not support from the
+// list options, and returns a filter for them. Requirements on the phase are pushed down into the label selector.
+//
+// As well as the Kubernetes field selectors, the following are supported:
+//
+// * `status.phase=Running`, `status.phase!=Running`
+// * `metadata.name^=prefix`
+// * `metadata.name~=regexp`
+// * `status.startedAt>2006-01-02T15:04:05Z`, `status.startedAt<...`, `status.finishedAt>...`, `status.finishedAt<...`
+func newWorkflowListFilter(opts *metav1.ListOptions) (*workflowListFilter, error) {
+	f := &workflowListFilter{}
+	var fieldSelector, labelSelector []string
+	if opts.LabelSelector != "" {
+		labelSelector = append(labelSelector, opts.LabelSelector)
+	}
+	var err error
+	for _, selector := range strings.Split(opts.FieldSelector, ",") {
+		switch {
+		case selector == "":
+		case strings.HasPrefix(selector, "status.phase!="):
+			labelSelector = append(labelSelector, common.LabelKeyPhase+"!="+strings.TrimPrefix(selector, "status.phase!="))
+		case strings.HasPrefix(selector, "status.phase=="):
+			labelSelector = append(labelSelector, common.LabelKeyPhase+"="+strings.TrimPrefix(selector, "status.phase=="))
+		case strings.HasPrefix(selector, "status.phase="):
+			labelSelector = append(labelSelector, common.LabelKeyPhase+"="+strings.TrimPrefix(selector, "status.phase="))
+		case strings.HasPrefix(selector, "metadata.name^="):
+			f.namePrefix = strings.TrimPrefix(selector, "metadata.name^=")
+		case strings.HasPrefix(selector, "metadata.name~="):
+			f.namePattern, err = regexp.Compile(strings.TrimPrefix(selector, "metadata.name~="))
+		case strings.HasPrefix(selector, "status.startedAt>"):
+			f.minStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "status.startedAt>"))
+		case strings.HasPrefix(selector, "status.startedAt<"):
+			f.maxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "status.startedAt<"))
+		case strings.HasPrefix(selector, "status.finishedAt>"):
+			f.minFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "status.finishedAt>"))
+		case strings.HasPrefix(selector, "status.finishedAt<"):
+			f.maxFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "status.finishedAt<"))
+		default:
+			fieldSelector = append(fieldSelector, selector)
+		}
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid field selector requirement %q: %v", selector, err))
+		}
+	}
+	opts.FieldSelector = strings.Join(fieldSelector, ",")
+	opts.LabelSelector = strings.Join(labelSelector, ",")
+	return f, nil
+}
+
+// isEmpty returns true if every workflow matches
+func (f *workflowListFilter) isEmpty() bool {
+	return *f == workflowListFilter{}
+}
+
+func (f *workflowListFilter) matches(wf wfv1.Workflow) bool {
+	startedAt := wf.Status.StartedAt.Time
+	finishedAt := wf.Status.FinishedAt.Time
+	return strings.HasPrefix(wf.Name, f.namePrefix) &&
+		(f.namePattern == nil || f.namePattern.MatchString(wf.Name)) &&
+		(f.minStartedAt.IsZero() || startedAt.After(f.minStartedAt)) &&
+		(f.maxStartedAt.IsZero() || (!startedAt.IsZero() && startedAt.Before(f.maxStartedAt))) &&
+		(f.minFinishedAt.IsZero() || finishedAt.After(f.minFinishedAt)) &&
+		(f.maxFinishedAt.IsZero() || (!finishedAt.IsZero() && finishedAt.Before(f.maxFinishedAt)))
+}
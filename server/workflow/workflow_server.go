@@ -5,29 +5,40 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiwatch "k8s.io/apimachinery/pkg/watch"
 
-	"github.com/argoproj/argo-workflows/v3/errors"
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	argoutil "github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/diff"
 	"github.com/argoproj/argo-workflows/v3/util/fields"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/resource"
+	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/preset"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -36,13 +47,19 @@ type workflowServer struct {
 	instanceIDService     instanceid.Service
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	// the workflow defaults from the controller's config, nil if there are none
+	wfDefaults  *wfv1.Workflow
+	wfSnapshots sqldb.WorkflowSnapshotRepo
+	// serverWfClient is the server's own workflow clientset, which evaluates workflow template grants, and gets the
+	// workflow templates they grant, as callers need not be able to read the namespaces that grant them
+	serverWfClient versioned.Interface
 }
 
 const latestAlias = "@latest"
 
 // NewWorkflowServer returns a new workflowServer
-func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowpkg.WorkflowServiceServer {
-	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo)}
+func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfDefaults *wfv1.Workflow, wfSnapshots sqldb.WorkflowSnapshotRepo, serverWfClient versioned.Interface) workflowpkg.WorkflowServiceServer {
+	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), wfDefaults, wfSnapshots, serverWfClient}
 }
 
 func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.WorkflowCreateRequest) (*wfv1.Workflow, error) {
@@ -58,11 +75,23 @@ func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.Wo
 
 	s.instanceIDService.Label(req.Workflow)
 	creator.Label(ctx, req.Workflow)
+	if req.SubmitOptions != nil {
+		opts, err := applyPreset(ctx, req.Namespace, req.SubmitOptions)
+		if err != nil {
+			return nil, err
+		}
+		if err := util.ApplySubmitOpts(req.Workflow, opts); err != nil {
+			return nil, err
+		}
+	}
 
-	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
+	wftmplGetter, err := pinnedWorkflowTemplateGetter(ctx, req.Namespace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
-	_, err := validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{})
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, s.serverWfClient)})
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +147,105 @@ func (s *workflowServer) GetWorkflow(ctx context.Context, req *workflowpkg.Workf
 	return wf, nil
 }
 
+// DiffWorkflow compares the spec of a workflow with the spec of another workflow, or with the spec of the workflow
+// template, cluster workflow template or cron workflow it was created from.
+func (s *workflowServer) DiffWorkflow(ctx context.Context, req *workflowpkg.WorkflowDiffRequest) (*workflowpkg.WorkflowDiffResponse, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkflow(wf); err != nil {
+		return nil, err
+	}
+	res := &workflowpkg.WorkflowDiffResponse{}
+	var baseSpec *wfv1.WorkflowSpec
+	if req.OtherWorkflow != "" {
+		other, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.OtherWorkflow, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateWorkflow(other); err != nil {
+			return nil, err
+		}
+		res.BaseKind, res.BaseName = workflow.WorkflowKind, other.Name
+		baseSpec = effectiveWorkflowSpec(other)
+	} else {
+		res.BaseKind, res.BaseName, baseSpec, err = getWorkflowBaseSpec(ctx, wfClient, wf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	spec := effectiveWorkflowSpec(wf)
+	if wf.Spec.WorkflowTemplateRef != nil && wf.Status.StoredWorkflowSpec == nil && req.OtherWorkflow == "" {
+		// the workflow has not started yet, so we work out the spec it will run with
+		merged, err := util.JoinWorkflowSpec(&wf.Spec, baseSpec, nil)
+		if err != nil {
+			return nil, err
+		}
+		spec = &merged.Spec
+		spec.WorkflowTemplateRef = nil
+	}
+	changes, err := diff.Changes(map[string]interface{}{"spec": baseSpec}, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		res.Entries = append(res.Entries, &workflowpkg.WorkflowDiffEntry{Path: c.Path, Base: c.Old, Workflow: c.New})
+	}
+	return res, nil
+}
+
+// effectiveWorkflowSpec returns the spec the workflow ran with, i.e. including the spec of any referenced template
+func effectiveWorkflowSpec(wf *wfv1.Workflow) *wfv1.WorkflowSpec {
+	spec := wf.Spec.DeepCopy()
+	if wf.Status.StoredWorkflowSpec != nil {
+		spec = wf.Status.StoredWorkflowSpec.DeepCopy()
+	}
+	// templates never have a reference, so this would always be reported as a difference
+	spec.WorkflowTemplateRef = nil
+	return spec
+}
+
+// getWorkflowBaseSpec returns the kind, name and spec of the resource the workflow was created from
+func getWorkflowBaseSpec(ctx context.Context, wfClient versioned.Interface, wf *wfv1.Workflow) (string, string, *wfv1.WorkflowSpec, error) {
+	labels := wf.GetLabels()
+	switch {
+	case wf.Spec.WorkflowTemplateRef != nil && wf.Spec.WorkflowTemplateRef.ClusterScope:
+		return getClusterWorkflowTemplateSpec(ctx, wfClient, wf.Spec.WorkflowTemplateRef.Name)
+	case wf.Spec.WorkflowTemplateRef != nil:
+		return getWorkflowTemplateSpec(ctx, wfClient, wf.Namespace, wf.Spec.WorkflowTemplateRef.Name)
+	case labels[common.LabelKeyCronWorkflow] != "":
+		cronWf, err := wfClient.ArgoprojV1alpha1().CronWorkflows(wf.Namespace).Get(ctx, labels[common.LabelKeyCronWorkflow], metav1.GetOptions{})
+		if err != nil {
+			return "", "", nil, err
+		}
+		return workflow.CronWorkflowKind, cronWf.Name, &cronWf.Spec.WorkflowSpec, nil
+	case labels[common.LabelKeyClusterWorkflowTemplate] != "":
+		return getClusterWorkflowTemplateSpec(ctx, wfClient, labels[common.LabelKeyClusterWorkflowTemplate])
+	case labels[common.LabelKeyWorkflowTemplate] != "":
+		return getWorkflowTemplateSpec(ctx, wfClient, wf.Namespace, labels[common.LabelKeyWorkflowTemplate])
+	default:
+		return "", "", nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("workflow %q was not created from a workflow template, cluster workflow template or cron workflow, another workflow to compare with must be specified", wf.Name))
+	}
+}
+
+func getWorkflowTemplateSpec(ctx context.Context, wfClient versioned.Interface, namespace, name string) (string, string, *wfv1.WorkflowSpec, error) {
+	wfTmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", nil, err
+	}
+	return workflow.WorkflowTemplateKind, wfTmpl.Name, wfTmpl.GetWorkflowSpec(), nil
+}
+
+func getClusterWorkflowTemplateSpec(ctx context.Context, wfClient versioned.Interface, name string) (string, string, *wfv1.WorkflowSpec, error) {
+	cwfTmpl, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", nil, err
+	}
+	return workflow.ClusterWorkflowTemplateKind, cwfTmpl.Name, cwfTmpl.GetWorkflowSpec(), nil
+}
+
 func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest) (*wfv1.WorkflowList, error) {
 	wfClient := auth.GetWfClient(ctx)
 
@@ -125,8 +253,12 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 	if req.ListOptions != nil {
 		listOption = req.ListOptions
 	}
+	filter, err := newWorkflowListFilter(listOption)
+	if err != nil {
+		return nil, err
+	}
 	s.instanceIDService.With(listOption)
-	wfList, err := wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).List(ctx, *listOption)
+	wfList, err := listFilteredWorkflows(ctx, wfClient, req.Namespace, *listOption, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +292,129 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 	return res, nil
 }
 
+// listFilteredWorkflows lists a page of the workflows that match the filter. As the filter is applied after listing,
+// pages are listed until the page has the limit of workflows, each no longer than the workflows still needed, so that
+// the continue token of the last page continues after the last workflow returned.
+func listFilteredWorkflows(ctx context.Context, wfClient versioned.Interface, namespace string, opts metav1.ListOptions, filter *workflowListFilter) (*wfv1.WorkflowList, error) {
+	if filter.isEmpty() {
+		return wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, opts)
+	}
+	limit := opts.Limit
+	res := &wfv1.WorkflowList{}
+	for {
+		if limit > 0 {
+			opts.Limit = limit - int64(len(res.Items))
+		}
+		wfList, err := wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		res.ListMeta = wfList.ListMeta
+		res.Items = append(res.Items, wfList.Items.Filter(filter.matches)...)
+		if wfList.Continue == "" || (limit > 0 && int64(len(res.Items)) >= limit) {
+			return res, nil
+		}
+		opts.Continue = wfList.Continue
+	}
+}
+
+// GetWorkflowCosts sums the estimated costs of the workflows, by namespace, or by the value of a label, e.g. a team
+func (s *workflowServer) GetWorkflowCosts(ctx context.Context, req *workflowpkg.WorkflowCostsRequest) (*workflowpkg.WorkflowCostsResponse, error) {
+	wfClient := auth.GetWfClient(ctx)
+
+	listOption := &metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOption = req.ListOptions
+	}
+	filter, err := newWorkflowListFilter(listOption)
+	if err != nil {
+		return nil, err
+	}
+	s.instanceIDService.With(listOption)
+	costs := make(map[string]*workflowCosts)
+	// unlike listing, we must aggregate every page
+	for {
+		wfList, err := wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).List(ctx, *listOption)
+		if err != nil {
+			return nil, err
+		}
+		for _, wf := range wfList.Items {
+			if !filter.isEmpty() && !filter.matches(wf) {
+				continue
+			}
+			key := wf.Namespace
+			if req.GroupBy != "" {
+				key = wf.Labels[req.GroupBy]
+			}
+			if costs[key] == nil {
+				costs[key] = &workflowCosts{}
+			}
+			costs[key].add(wf.Status.EstimatedCost)
+		}
+		if wfList.Continue == "" {
+			break
+		}
+		listOption.Continue = wfList.Continue
+	}
+	res := &workflowpkg.WorkflowCostsResponse{}
+	for key, c := range costs {
+		item := &workflowpkg.WorkflowCosts{Key: key, Workflows: c.workflows}
+		if c.estimated {
+			item.EstimatedCost = resource.NewEstimatedCost(c.estimatedCost)
+		}
+		res.Items = append(res.Items, item)
+	}
+	sort.Slice(res.Items, func(i, j int) bool { return res.Items[i].Key < res.Items[j].Key })
+	return res, nil
+}
+
+type workflowCosts struct {
+	workflows     int64
+	estimatedCost float64
+	// whether any of the workflows has an estimated cost
+	estimated bool
+}
+
+func (c *workflowCosts) add(estimatedCost *wfv1.Amount) {
+	c.workflows++
+	if estimatedCost != nil {
+		f, _ := estimatedCost.Float64()
+		c.estimatedCost += f
+		c.estimated = true
+	}
+}
+
+// GetWorkflowSnapshot gets the latest snapshot of the workflow's status taken at, or before, the time in the request.
+// Snapshots outlive their workflows, so whether the user can get the workflow is checked, rather than getting it.
+func (s *workflowServer) GetWorkflowSnapshot(ctx context.Context, req *workflowpkg.WorkflowSnapshotRequest) (*workflowpkg.WorkflowSnapshot, error) {
+	if !s.wfSnapshots.IsEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "workflow snapshots are not enabled")
+	}
+	asOf := time.Now()
+	if req.AsOf != "" {
+		var err error
+		asOf, err = time.Parse(time.RFC3339, req.AsOf)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("asOf is invalid: %v", err))
+		}
+	}
+	allowed, err := auth.CanI(ctx, "get", workflow.WorkflowPlural, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	snapshot, err := s.wfSnapshots.Get(req.Namespace, req.Name, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("workflow %s/%s has no snapshot as of %s", req.Namespace, req.Name, asOf.Format(time.RFC3339)))
+	}
+	return &workflowpkg.WorkflowSnapshot{Time: &metav1.Time{Time: snapshot.Time}, Status: &snapshot.Status}, nil
+}
+
 func (s *workflowServer) WatchWorkflows(req *workflowpkg.WatchWorkflowsRequest, ws workflowpkg.WorkflowService_WatchWorkflowsServer) error {
 	ctx := ws.Context()
 	wfClient := auth.GetWfClient(ctx)
@@ -183,7 +438,10 @@ func (s *workflowServer) WatchWorkflows(req *workflowpkg.WatchWorkflowsRequest,
 	if err != nil {
 		return err
 	}
-	defer watch.Stop()
+	defer func() { watch.Stop() }()
+	// the resource version of the last event, or bookmark, sent, which the watch is resumed from when the Kubernetes
+	// API server closes it, so the client does not see it close
+	resourceVersion := opts.ResourceVersion
 	cleaner := fields.NewCleaner(req.Fields).WithoutPrefix("result.object.")
 
 	clean := func(x *wfv1.Workflow) (*wfv1.Workflow, error) {
@@ -214,14 +472,34 @@ func (s *workflowServer) WatchWorkflows(req *workflowpkg.WatchWorkflowsRequest,
 			return nil
 		case event, open := <-watch.ResultChan():
 			if !open {
-				return io.EOF
+				// without a resource version, we cannot resume without missing, or repeating, events
+				if resourceVersion == "" || resourceVersion == "0" {
+					return io.EOF
+				}
+				log.WithField("resourceVersion", resourceVersion).Debug("Resuming workflow watch")
+				opts.ResourceVersion = resourceVersion
+				watch, err = wfIf.Watch(ctx, *opts)
+				if err != nil {
+					return err
+				}
+				continue
 			}
 			log.Debug("Received workflow event")
 			wf, ok := event.Object.(*wfv1.Workflow)
 			if !ok {
-				// object is probably metav1.Status, `FromObject` can deal with anything
+				// object is probably metav1.Status, `FromObject` can deal with anything, e.g. the resource version is
+				// too old to resume from, and the client must list again
 				return apierr.FromObject(event.Object)
 			}
+			resourceVersion = wf.ResourceVersion
+			if event.Type == apiwatch.Bookmark {
+				// a bookmark only has the resource version, that the client can resume from
+				err = ws.Send(&workflowpkg.WorkflowWatchEvent{Type: string(event.Type), Object: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{ResourceVersion: resourceVersion}}})
+				if err != nil {
+					return err
+				}
+				continue
+			}
 			logCtx := log.WithFields(log.Fields{"workflow": wf.Name, "type": event.Type, "phase": wf.Status.Phase})
 			if !cleaner.WillExclude("status.nodes") {
 				if err := s.hydrator.Hydrate(wf); err != nil {
@@ -289,6 +567,9 @@ func (s *workflowServer) WatchEvents(req *workflowpkg.WatchEventsRequest, ws wor
 }
 
 func (s *workflowServer) DeleteWorkflow(ctx context.Context, req *workflowpkg.WorkflowDeleteRequest) (*workflowpkg.WorkflowDeleteResponse, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionDelete); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
 	if err != nil {
@@ -305,7 +586,90 @@ func (s *workflowServer) DeleteWorkflow(ctx context.Context, req *workflowpkg.Wo
 	return &workflowpkg.WorkflowDeleteResponse{}, nil
 }
 
+// BulkWorkflows performs the same operation on each of the named workflows, and each of the workflows matching the
+// list options. A failure for one workflow does not stop the others, instead it is reported in its result.
+func (s *workflowServer) BulkWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	var op func(name string) (*wfv1.Workflow, error)
+	switch req.Operation {
+	case "retry":
+		op = func(name string) (*wfv1.Workflow, error) {
+			return s.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: name, Namespace: req.Namespace, RestartSuccessful: req.RestartSuccessful, NodeFieldSelector: req.NodeFieldSelector, Parameters: req.Parameters, RestartNodes: req.RestartNodes, RestartFrom: req.RestartFrom})
+		}
+	case "stop":
+		op = func(name string) (*wfv1.Workflow, error) {
+			return s.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{Name: name, Namespace: req.Namespace, NodeFieldSelector: req.NodeFieldSelector, Message: req.Message})
+		}
+	case "terminate":
+		op = func(name string) (*wfv1.Workflow, error) {
+			return s.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: name, Namespace: req.Namespace})
+		}
+	case "delete":
+		op = func(name string) (*wfv1.Workflow, error) {
+			_, err := s.DeleteWorkflow(ctx, &workflowpkg.WorkflowDeleteRequest{Name: name, Namespace: req.Namespace})
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown operation %q, must be one of: retry, stop, terminate, delete", req.Operation))
+	}
+	// fail the whole request, rather than each workflow
+	if err := auth.CanPerformWorkflowAction(ctx, req.Operation); err != nil {
+		return nil, err
+	}
+	names, err := s.bulkWorkflowNames(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &workflowpkg.WorkflowBulkResponse{}
+	for _, name := range names {
+		result := &workflowpkg.WorkflowBulkResult{Name: name}
+		wf, err := op(name)
+		if err != nil {
+			result.Error = err.Error()
+		} else if wf != nil {
+			result.Phase = string(wf.Status.Phase)
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// bulkWorkflowNames returns the sorted, de-duplicated names of the workflows a bulk request applies to
+func (s *workflowServer) bulkWorkflowNames(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) ([]string, error) {
+	selected := make(map[string]bool)
+	for _, name := range req.Names {
+		selected[name] = true
+	}
+	if req.ListOptions != nil && (req.ListOptions.LabelSelector != "" || req.ListOptions.FieldSelector != "") {
+		opts := req.ListOptions.DeepCopy()
+		for {
+			list, err := s.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: req.Namespace, ListOptions: opts, Fields: "metadata.continue,items.metadata.name"})
+			if err != nil {
+				return nil, err
+			}
+			for _, wf := range list.Items {
+				selected[wf.Name] = true
+			}
+			if list.Continue == "" {
+				break
+			}
+			opts.Continue = list.Continue
+		}
+	} else if len(req.Names) == 0 {
+		// we never want an empty request to act on every workflow in the namespace
+		return nil, status.Error(codes.InvalidArgument, "either names or a label or field selector must be specified")
+	}
+	names := make([]string, 0, len(selected))
+	for name := range selected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 func (s *workflowServer) RetryWorkflow(ctx context.Context, req *workflowpkg.WorkflowRetryRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionRetry); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	kubeClient := auth.GetKubeClient(ctx)
 
@@ -319,7 +683,7 @@ func (s *workflowServer) RetryWorkflow(ctx context.Context, req *workflowpkg.Wor
 		return nil, err
 	}
 
-	wf, err = util.RetryWorkflow(ctx, kubeClient, s.hydrator, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name, req.RestartSuccessful, req.NodeFieldSelector)
+	wf, err = util.RetryWorkflow(ctx, kubeClient, s.hydrator, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name, req.RestartSuccessful, req.NodeFieldSelector, req.Parameters, req.RestartNodes, req.RestartFrom)
 	if err != nil {
 		return nil, err
 	}
@@ -327,6 +691,9 @@ func (s *workflowServer) RetryWorkflow(ctx context.Context, req *workflowpkg.Wor
 }
 
 func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowResubmitRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionResubmit); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
 	if err != nil {
@@ -338,12 +705,12 @@ func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.
 		return nil, err
 	}
 
-	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized)
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	created, err := util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wfClient, req.Namespace, newWF, &wfv1.SubmitOpts{})
+	created, err := util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wfClient, s.serverWfClient, req.Namespace, newWF, &wfv1.SubmitOpts{})
 	if err != nil {
 		return nil, err
 	}
@@ -351,6 +718,9 @@ func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.
 }
 
 func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.WorkflowResumeRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionResume); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
 	if err != nil {
@@ -362,7 +732,7 @@ func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, err
 	}
 
-	err = util.ResumeWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector)
+	err = util.ResumeWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, approver(ctx).Name)
 	if err != nil {
 		log.Warnf("Failed to resume %s: %+v", wf.Name, err)
 		return nil, err
@@ -376,7 +746,60 @@ func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.Wo
 	return wf, nil
 }
 
+func (s *workflowServer) ApproveWorkflow(ctx context.Context, req *workflowpkg.WorkflowApproveRequest) (*wfv1.Workflow, error) {
+	return s.approveWorkflow(ctx, req.Namespace, req.Name, req.NodeFieldSelector, true, req.Comment)
+}
+
+func (s *workflowServer) RejectWorkflow(ctx context.Context, req *workflowpkg.WorkflowRejectRequest) (*wfv1.Workflow, error) {
+	return s.approveWorkflow(ctx, req.Namespace, req.Name, req.NodeFieldSelector, false, req.Comment)
+}
+
+// approveWorkflow approves, or rejects, the approval gates of the workflow as the user. Approving is a kind of resume,
+// so it is allowed by the same workflow action
+func (s *workflowServer) approveWorkflow(ctx context.Context, namespace, name, nodeFieldSelector string, approved bool, comment string) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionResume); err != nil {
+		return nil, err
+	}
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := s.getWorkflow(ctx, wfClient, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateWorkflow(wf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.ApproveWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(namespace), s.hydrator, wf.Name, nodeFieldSelector, approver(ctx), approved, comment)
+	if argoErr, ok := err.(argoerrs.ArgoError); ok && argoErr.Code() == argoerrs.CodeForbidden {
+		return nil, status.Error(codes.PermissionDenied, argoErr.Error())
+	}
+	if err != nil {
+		log.Warnf("Failed to approve, or reject, %s: %+v", wf.Name, err)
+		return nil, err
+	}
+
+	return wfClient.ArgoprojV1alpha1().Workflows(namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+}
+
+// approver returns the identity of the user, from their claims, that is recorded as the approver of suspend nodes
+func approver(ctx context.Context) util.Approver {
+	claims := auth.GetClaims(ctx)
+	if claims == nil {
+		return util.Approver{}
+	}
+	name := claims.Email
+	if name == "" {
+		name = claims.Subject
+	}
+	return util.Approver{Name: name, Groups: claims.Groups}
+}
+
 func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.WorkflowSuspendRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionSuspend); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
@@ -403,6 +826,9 @@ func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.W
 }
 
 func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg.WorkflowTerminateRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionTerminate); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
@@ -428,6 +854,9 @@ func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg
 }
 
 func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.WorkflowStopRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionStop); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
 	if err != nil {
@@ -450,6 +879,9 @@ func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.Work
 }
 
 func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.WorkflowSetRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionSet); err != nil {
+		return nil, err
+	}
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
 	if err != nil {
@@ -499,12 +931,15 @@ func (s *workflowServer) LintWorkflow(ctx context.Context, req *workflowpkg.Work
 		return nil, fmt.Errorf("unable to get a workflow")
 	}
 	wfClient := auth.GetWfClient(ctx)
-	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
+	wftmplGetter, err := pinnedWorkflowTemplateGetter(ctx, req.Namespace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 	s.instanceIDService.Label(req.Workflow)
 	creator.Label(ctx, req.Workflow)
 
-	_, err := validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{Lint: true})
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{Lint: true, GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, s.serverWfClient), StrictTemplateRefArguments: req.StrictTemplateRefArguments})
 	if err != nil {
 		return nil, err
 	}
@@ -539,6 +974,30 @@ func (s *workflowServer) WorkflowLogs(req *workflowpkg.WorkflowLogRequest, ws wo
 	return s.PodLogs(req, ws)
 }
 
+// GetWorkflowNodePod returns the pod that ran a node of a workflow, which is not found once it has been deleted, e.g. by
+// the pod GC strategy
+func (s *workflowServer) GetWorkflowNodePod(ctx context.Context, req *workflowpkg.WorkflowNodePodRequest) (*corev1.Pod, error) {
+	wf, err := s.getWorkflow(ctx, auth.GetWfClient(ctx), req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkflow(wf); err != nil {
+		return nil, err
+	}
+	if err := s.hydrator.Hydrate(wf); err != nil {
+		return nil, err
+	}
+	node, ok := wf.Status.Nodes[req.NodeId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("workflow %s has no node %q", wf.Name, req.NodeId))
+	}
+	if node.Type != wfv1.NodeTypePod {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("node %q is a %s node, only pod nodes have a pod", req.NodeId, node.Type))
+	}
+	podName := util.PodName(wf.Name, node.Name, node.TemplateName, node.ID, util.GetWorkflowPodNameVersion(wf))
+	return auth.GetKubeClient(ctx).CoreV1().Pods(wf.GetPodNamespace()).Get(ctx, podName, metav1.GetOptions{})
+}
+
 func (s *workflowServer) getWorkflow(ctx context.Context, wfClient versioned.Interface, namespace string, name string, options metav1.GetOptions) (*wfv1.Workflow, error) {
 	if name == latestAlias {
 		latest, err := getLatestWorkflow(ctx, wfClient, namespace)
@@ -559,6 +1018,26 @@ func (s *workflowServer) validateWorkflow(wf *wfv1.Workflow) error {
 	return s.instanceIDService.Validate(wf)
 }
 
+// pinnedWorkflowTemplateGetter returns the getter of the workflow templates in the namespace, which gets the revision of
+// the workflow template the workflow pins, if it does
+func pinnedWorkflowTemplateGetter(ctx context.Context, namespace string, wf *wfv1.Workflow) (templateresolution.WorkflowTemplateNamespacedGetter, error) {
+	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowTemplates(namespace))
+	return templaterevision.WrapGetter(ctx, auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace), wf, wftmplGetter)
+}
+
+// applyPreset returns the submit options with the parameters and labels of the preset they name, if any, from the
+// namespace
+func applyPreset(ctx context.Context, namespace string, opts *wfv1.SubmitOpts) (*wfv1.SubmitOpts, error) {
+	if opts == nil || opts.Preset == "" {
+		return opts, nil
+	}
+	p, err := preset.Get(ctx, auth.GetKubeClient(ctx), namespace, opts.Preset)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return p.SubmitOpts(opts)
+}
+
 func getLatestWorkflow(ctx context.Context, wfClient versioned.Interface, namespace string) (*wfv1.Workflow, error) {
 	wfList, err := wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -578,43 +1057,113 @@ func getLatestWorkflow(ctx context.Context, wfClient versioned.Interface, namesp
 
 func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
-	var wf *wfv1.Workflow
-	switch req.ResourceKind {
-	case workflow.CronWorkflowKind, workflow.CronWorkflowSingular, workflow.CronWorkflowPlural, workflow.CronWorkflowShortName:
-		cronWf, err := wfClient.ArgoprojV1alpha1().CronWorkflows(req.Namespace).Get(ctx, req.ResourceName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
-		}
-		wf = common.ConvertCronWorkflowToWorkflow(cronWf)
-	case workflow.WorkflowTemplateKind, workflow.WorkflowTemplateSingular, workflow.WorkflowTemplatePlural, workflow.WorkflowTemplateShortName:
-		wfTmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Get(ctx, req.ResourceName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
+	wf, err := util.NewWorkflowFromResource(ctx, wfClient, req.Namespace, req.ResourceKind, req.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.instanceIDService.Label(wf)
+	creator.Label(ctx, wf)
+	opts, err := applyPreset(ctx, req.Namespace, req.SubmitOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = util.ApplySubmitOpts(wf, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	wftmplGetter, err := pinnedWorkflowTemplateGetter(ctx, req.Namespace, wf)
+	if err != nil {
+		return nil, err
+	}
+	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{Submit: true, GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, s.serverWfClient)})
+	if err != nil {
+		return nil, err
+	}
+	return wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+}
+
+// RenderWorkflow returns the workflow with the spec the controller would run, without creating it. The spec is joined
+// with the workflow template it references and the workflow defaults, and the template defaults are applied to each of
+// its templates. As when submitting, the workflow is validated, as are the expressions in it.
+func (s *workflowServer) RenderWorkflow(ctx context.Context, req *workflowpkg.WorkflowRenderRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf := req.Workflow
+	if wf == nil {
+		if req.ResourceKind == "" {
+			return nil, status.Error(codes.InvalidArgument, "workflow or resourceKind must be specified")
 		}
-		wf = common.NewWorkflowFromWorkflowTemplate(req.ResourceName, wfTmpl.Spec.WorkflowMetadata, false)
-	case workflow.ClusterWorkflowTemplateKind, workflow.ClusterWorkflowTemplateSingular, workflow.ClusterWorkflowTemplatePlural, workflow.ClusterWorkflowTemplateShortName:
-		cwfTmpl, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, req.ResourceName, metav1.GetOptions{})
+		var err error
+		wf, err = util.NewWorkflowFromResource(ctx, wfClient, req.Namespace, req.ResourceKind, req.ResourceName)
 		if err != nil {
 			return nil, err
 		}
-		wf = common.NewWorkflowFromWorkflowTemplate(req.ResourceName, cwfTmpl.Spec.WorkflowMetadata, true)
-	default:
-		return nil, errors.Errorf(errors.CodeBadRequest, "Resource kind '%s' is not supported for submitting", req.ResourceKind)
+	}
+	if wf.Namespace == "" {
+		wf.Namespace = req.Namespace
 	}
 
 	s.instanceIDService.Label(wf)
 	creator.Label(ctx, wf)
-	err := util.ApplySubmitOpts(wf, req.SubmitOptions)
+	opts, err := applyPreset(ctx, req.Namespace, req.SubmitOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = util.ApplySubmitOpts(wf, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
+	wftmplGetter, err := pinnedWorkflowTemplateGetter(ctx, req.Namespace, wf)
+	if err != nil {
+		return nil, err
+	}
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
-	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{Submit: true})
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{Submit: true, GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, s.serverWfClient)})
 	if err != nil {
 		return nil, err
 	}
-	return wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+
+	// as per the controller's setExecWorkflow
+	wfDefaults := s.wfDefaults
+	if wfDefaults == nil {
+		wfDefaults = &wfv1.Workflow{}
+	}
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil {
+		var specHolder wfv1.WorkflowSpecHolder
+		if ref.ClusterScope {
+			specHolder, err = cwftmplGetter.Get(ref.Name)
+		} else {
+			specHolder, err = wftmplGetter.Get(ref.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		util.JoinWorkflowMetaData(&wf.ObjectMeta, specHolder.GetWorkflowMetadata(), &wfDefaults.ObjectMeta)
+		mergedWf, err := util.JoinWorkflowSpec(&wf.Spec, specHolder.GetWorkflowSpec(), &wfDefaults.Spec)
+		if err != nil {
+			return nil, err
+		}
+		wf.Spec = mergedWf.Spec
+	} else if err := util.MergeTo(wfDefaults, wf); err != nil {
+		return nil, err
+	}
+	for i := range wf.Spec.Templates {
+		if err := util.MergeTemplateDefaultsTo(wf.Spec.TemplateDefaults, &wf.Spec.Templates[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(wf.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := template.ValidateExpressions(string(data)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return wf, nil
 }
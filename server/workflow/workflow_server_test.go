@@ -4,14 +4,22 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
 
@@ -584,14 +592,41 @@ func getWorkflowServer() (workflowpkg.WorkflowServiceServer, context.Context) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo)
+	wfDefaults := &v1alpha1.Workflow{Spec: v1alpha1.WorkflowSpec{ServiceAccountName: "my-sa"}}
 	kubeClientSet := fake.NewSimpleClientset()
 	wfClientset := v1alpha.NewSimpleClientset(&unlabelledObj, &wfObj1, &wfObj2, &wfObj3, &wfObj4, &wfObj5, &failedWfObj, &wftmpl, &cronwfObj, &cwfTmpl)
+	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo, wfDefaults, sqldb.NullWorkflowSnapshotRepo, wfClientset)
 	wfClientset.PrependReactor("create", "workflows", generateNameReactor)
 	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
 	return server, ctx
 }
 
+// pagedListReactor lists one workflow per page, as the fake client does not paginate, continuing until the last one
+func pagedListReactor(wfClientset *v1alpha.Clientset) ktesting.ReactionFunc {
+	page := 0
+	return func(action ktesting.Action) (bool, runtime.Object, error) {
+		obj, err := wfClientset.Tracker().List(action.GetResource(), v1alpha1.SchemeGroupVersion.WithKind("Workflow"), action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		var items v1alpha1.Workflows
+		for _, wf := range obj.(*v1alpha1.WorkflowList).Items {
+			if action.(ktesting.ListAction).GetListRestrictions().Labels.Matches(labels.Set(wf.Labels)) {
+				items = append(items, wf)
+			}
+		}
+		list := &v1alpha1.WorkflowList{}
+		if page < len(items) {
+			list.Items = items[page : page+1]
+		}
+		page++
+		if page < len(items) {
+			list.Continue = fmt.Sprint(page)
+		}
+		return true, list, nil
+	}
+}
+
 // generateNameReactor implements the logic required for the GenerateName field to work when using
 // the fake client. Add it with client.PrependReactor to your fake client.
 func generateNameReactor(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
@@ -662,6 +697,57 @@ func TestWatchLatestWorkflow(t *testing.T) {
 	cancel()
 }
 
+type recordingWatchWorkflowServer struct {
+	testServerStream
+	events chan *workflowpkg.WorkflowWatchEvent
+}
+
+func (t recordingWatchWorkflowServer) Send(event *workflowpkg.WorkflowWatchEvent) error {
+	t.events <- event
+	return nil
+}
+
+func TestWatchWorkflowsResume(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	watchers := make(chan *watch.FakeWatcher, 1)
+	var resourceVersions []string
+	auth.GetWfClient(ctx).(*v1alpha.Clientset).PrependWatchReactor("workflows", func(action ktesting.Action) (bool, watch.Interface, error) {
+		resourceVersions = append(resourceVersions, action.(ktesting.WatchActionImpl).WatchRestrictions.ResourceVersion)
+		w := watch.NewFake()
+		watchers <- w
+		return true, w, nil
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events := make(chan *workflowpkg.WorkflowWatchEvent)
+	done := make(chan error)
+	go func() {
+		done <- server.WatchWorkflows(&workflowpkg.WatchWorkflowsRequest{
+			Namespace:   "workflows",
+			ListOptions: &metav1.ListOptions{ResourceVersion: "1", AllowWatchBookmarks: true},
+		}, &recordingWatchWorkflowServer{testServerStream{ctx}, events})
+	}()
+
+	w := <-watchers
+	w.Modify(&v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", ResourceVersion: "2"}})
+	event := <-events
+	assert.Equal(t, "MODIFIED", event.Type)
+	assert.Equal(t, "my-wf", event.Object.Name)
+
+	w.Action(watch.Bookmark, &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "3"}})
+	event = <-events
+	assert.Equal(t, "BOOKMARK", event.Type)
+	assert.Equal(t, "3", event.Object.ResourceVersion)
+
+	// the Kubernetes API server closes the watch, which is resumed from the bookmark
+	w.Stop()
+	w = <-watchers
+	w.Error(&metav1.Status{Status: metav1.StatusFailure, Code: 410, Reason: metav1.StatusReasonExpired, Message: "too old resource version: 3"})
+	err := <-done
+	assert.True(t, apierr.IsResourceExpired(err))
+	assert.Equal(t, []string{"1", "3"}, resourceVersions)
+}
+
 func TestGetWorkflowWithNotFound(t *testing.T) {
 	server, ctx := getWorkflowServer()
 	t.Run("Labelled", func(t *testing.T) {
@@ -719,6 +805,60 @@ func TestListWorkflow(t *testing.T) {
 	}
 }
 
+func TestListWorkflowFiltered(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	list := func(fieldSelector string) ([]string, error) {
+		wfl, err := server.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "workflows", ListOptions: &metav1.ListOptions{FieldSelector: fieldSelector}})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, wf := range wfl.Items {
+			names = append(names, wf.Name)
+		}
+		return names, nil
+	}
+	for selector, expected := range map[string][]string{
+		"status.phase=Running":                                          {"hello-world-9tql2-run"},
+		"status.phase!=Succeeded":                                       {"failed", "hello-world-9tql2-run"},
+		"metadata.name^=hello-world-9tql2":                              {"hello-world-9tql2", "hello-world-9tql2-run"},
+		"metadata.name~=^hello-world-[a-z0-9]+$":                        {"hello-world-9tql2", "hello-world-b6h5m"},
+		"status.startedAt>2019-12-13T20:00:00Z":                         {"hello-world-9tql2", "hello-world-9tql2-run"},
+		"status.finishedAt<2019-12-13T20:00:00Z,status.phase=Succeeded": {"hello-world-b6h5m"},
+	} {
+		t.Run(selector, func(t *testing.T) {
+			names, err := list(selector)
+			if assert.NoError(t, err) {
+				assert.ElementsMatch(t, expected, names)
+			}
+		})
+	}
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := list("status.startedAt>yesterday")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Pages", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		wfClientset := auth.GetWfClient(ctx).(*v1alpha.Clientset)
+		wfClientset.PrependReactor("list", "workflows", pagedListReactor(wfClientset))
+		opts := &metav1.ListOptions{FieldSelector: "metadata.name^=hello-world-", Limit: 2}
+		var names []string
+		wfl, err := server.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "workflows", ListOptions: opts})
+		if assert.NoError(t, err) && assert.Len(t, wfl.Items, 2, "the page is filled from the following pages") && assert.NotEmpty(t, wfl.Continue) {
+			for _, wf := range wfl.Items {
+				names = append(names, wf.Name)
+			}
+			opts = &metav1.ListOptions{FieldSelector: "metadata.name^=hello-world-", Limit: 2, Continue: wfl.Continue}
+			wfl, err = server.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "workflows", ListOptions: opts})
+			if assert.NoError(t, err) && assert.Len(t, wfl.Items, 1) {
+				assert.Empty(t, wfl.Continue)
+				names = append(names, wfl.Items[0].Name)
+			}
+		}
+		assert.ElementsMatch(t, []string{"hello-world-9tql2", "hello-world-9tql2-run", "hello-world-b6h5m"}, names)
+	})
+}
+
 func TestDeleteWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer()
 	t.Run("Labelled", func(t *testing.T) {
@@ -737,8 +877,108 @@ func TestDeleteWorkflow(t *testing.T) {
 	})
 }
 
+func TestBulkWorkflows(t *testing.T) {
+	t.Run("Terminate", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		res, err := server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{
+			Namespace:   "workflows",
+			Operation:   "terminate",
+			Names:       []string{"hello-world-9tql2-run", "not-found"},
+			ListOptions: &metav1.ListOptions{FieldSelector: "status.phase=Running"},
+		})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			assert.Equal(t, "hello-world-9tql2-run", res.Results[0].Name)
+			assert.Equal(t, "Running", res.Results[0].Phase)
+			assert.Empty(t, res.Results[0].Error)
+			assert.Equal(t, "not-found", res.Results[1].Name)
+			assert.NotEmpty(t, res.Results[1].Error)
+		}
+		wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		res, err := server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{
+			Namespace:   "workflows",
+			Operation:   "delete",
+			ListOptions: &metav1.ListOptions{FieldSelector: "metadata.name^=hello-world-9tql2"},
+		})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			assert.Equal(t, "hello-world-9tql2", res.Results[0].Name)
+			assert.Empty(t, res.Results[0].Error)
+			assert.Equal(t, "hello-world-9tql2-run", res.Results[1].Name)
+			assert.Empty(t, res.Results[1].Error)
+		}
+		_, err = getWorkflow(ctx, server, "workflows", "hello-world-9tql2")
+		assert.Error(t, err)
+	})
+	t.Run("Pages", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		wfClientset := auth.GetWfClient(ctx).(*v1alpha.Clientset)
+		wfClientset.PrependReactor("list", "workflows", pagedListReactor(wfClientset))
+		res, err := server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{
+			Namespace:   "workflows",
+			Operation:   "delete",
+			ListOptions: &metav1.ListOptions{LabelSelector: "workflows.argoproj.io/phase=Succeeded", Limit: 1},
+		})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			for i, name := range []string{"hello-world-9tql2", "hello-world-b6h5m"} {
+				assert.Equal(t, name, res.Results[i].Name)
+				assert.Empty(t, res.Results[i].Error)
+			}
+		}
+	})
+	t.Run("UnknownOperation", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		_, err := server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Operation: "suspend", Names: []string{"failed"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NoSelection", func(t *testing.T) {
+		server, ctx := getWorkflowServer()
+		_, err := server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Operation: "delete"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDiffWorkflow(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	t.Run("WorkflowTemplate", func(t *testing.T) {
+		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"message=hello"}},
+		})
+		if assert.NoError(t, err) {
+			res, err := server.DiffWorkflow(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: wf.Name})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "WorkflowTemplate", res.BaseKind)
+				assert.Equal(t, "workflow-template-whalesay-template", res.BaseName)
+				assert.Equal(t, []*workflowpkg.WorkflowDiffEntry{{Path: "spec.arguments.parameters[0].value", Workflow: `"hello"`}}, res.Entries)
+			}
+		}
+	})
+	t.Run("OtherWorkflow", func(t *testing.T) {
+		res, err := server.DiffWorkflow(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherWorkflow: "hello-world-9tql2"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Workflow", res.BaseKind)
+			assert.Empty(t, res.Entries)
+		}
+	})
+	t.Run("NoBase", func(t *testing.T) {
+		_, err := server.DiffWorkflow(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestRetryWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer()
+	t.Run("InvalidParameter", func(t *testing.T) {
+		_, err := server.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: "failed", Namespace: "workflows", Parameters: []string{"message"}})
+		assert.EqualError(t, err, "expected parameter of the form: NAME=VALUE. Received: message")
+	})
 	t.Run("Labelled", func(t *testing.T) {
 		retried, err := server.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: "failed", Namespace: "workflows"})
 		if assert.NoError(t, err) {
@@ -811,6 +1051,22 @@ func TestTerminateWorkflow(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestWorkflowActions(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	// the user may only approve workflows
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{WorkflowActions: []string{types.WorkflowActionResume}})
+	_, err := server.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: "hello-world-9tql2-run", Namespace: "workflows"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.BulkWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Operation: "delete", Names: []string{"hello-world-9tql2-run"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
+	if assert.NoError(t, err) {
+		assert.Empty(t, wf.Spec.Shutdown)
+	}
+	_, err = server.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{Name: "hello-world-9tql2-run", Namespace: "workflows"})
+	assert.NotEqual(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestStopWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer()
 	wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
@@ -855,6 +1111,45 @@ func TestLintWorkflow(t *testing.T) {
 	}
 }
 
+func TestLintWorkflowWithGrantedTemplate(t *testing.T) {
+	wfClientset := v1alpha.NewSimpleClientset()
+	wfClientset.PrependReactor("*", "*", func(action ktesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "shared" {
+			return true, nil, apierr.NewForbidden(schema.GroupResource{Resource: action.GetResource().Resource}, "", nil)
+		}
+		return false, nil, nil
+	})
+	serverWfClientset := v1alpha.NewSimpleClientset(
+		&v1alpha1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-wftmpl"},
+			Spec:       v1alpha1.WorkflowTemplateSpec{WorkflowSpec: v1alpha1.WorkflowSpec{Templates: []v1alpha1.Template{{Name: "main", Container: &corev1.Container{Image: "my-image"}}}}},
+		},
+		&v1alpha1.WorkflowTemplateGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-grant"},
+			Spec:       v1alpha1.WorkflowTemplateGrantSpec{Namespaces: []string{"my-ns"}, WorkflowTemplates: []string{"my-wftmpl"}},
+		},
+	)
+	server := NewWorkflowServer(instanceid.NewService(""), nil, nil, sqldb.NullWorkflowSnapshotRepo, serverWfClientset)
+	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, fake.NewSimpleClientset()), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	wf := v1alpha1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            templateRef:
+              name: my-wftmpl
+              namespace: shared
+              template: main
+`)
+	_, err := server.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Namespace: "my-ns", Workflow: wf})
+	assert.NoError(t, err, "the grant, and the template, are got with the server's client, not the caller's")
+}
+
 type testPodLogsServer struct {
 	testServerStream
 }
@@ -877,6 +1172,28 @@ func TestPodLogs(t *testing.T) {
 	cancel()
 }
 
+func TestGetWorkflowNodePod(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	req := &workflowpkg.WorkflowNodePodRequest{Name: "hello-world-9tql2", Namespace: "workflows", NodeId: "hello-world-9tql2"}
+	t.Run("NoNode", func(t *testing.T) {
+		_, err := server.GetWorkflowNodePod(ctx, &workflowpkg.WorkflowNodePodRequest{Name: "hello-world-9tql2", Namespace: "workflows", NodeId: "not-found"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("PodDeleted", func(t *testing.T) {
+		_, err := server.GetWorkflowNodePod(ctx, req)
+		assert.True(t, apierr.IsNotFound(err))
+	})
+	t.Run("Pod", func(t *testing.T) {
+		_, err := auth.GetKubeClient(ctx).CoreV1().Pods("workflows").Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "hello-world-9tql2"}, Spec: corev1.PodSpec{ServiceAccountName: "my-sa"}}, metav1.CreateOptions{})
+		if assert.NoError(t, err) {
+			pod, err := server.GetWorkflowNodePod(ctx, req)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "my-sa", pod.Spec.ServiceAccountName)
+			}
+		}
+	})
+}
+
 func TestSubmitWorkflowFromResource(t *testing.T) {
 	server, ctx := getWorkflowServer()
 	t.Run("SubmitFromWorkflowTemplate fails if missing parameters", func(t *testing.T) {
@@ -934,3 +1251,252 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 		}
 	})
 }
+
+func TestSubmitWorkflowWithPreset(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	_, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps("workflows").Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-eu", Labels: map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapParameterPreset}},
+		Data:       map[string]string{"parameters": "message: hello from the preset\n", "labels": "schedule: nightly\nlabelTest: preset\n"},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	t.Run("Submit", func(t *testing.T) {
+		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Preset: "nightly-eu", Labels: "labelTest=passed"},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "hello from the preset", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+			assert.Equal(t, "nightly", wf.Labels["schedule"])
+			assert.Equal(t, "passed", wf.Labels["labelTest"])
+			assert.Equal(t, "nightly-eu", wf.Annotations[common.AnnotationKeyPreset])
+		}
+	})
+	t.Run("Create", func(t *testing.T) {
+		var req workflowpkg.WorkflowCreateRequest
+		v1alpha1.MustUnmarshal(workflow1, &req)
+		req.Namespace = "workflows"
+		req.SubmitOptions = &v1alpha1.SubmitOpts{Preset: "nightly-eu", Parameters: []string{"message=hello"}}
+		wf, err := server.CreateWorkflow(ctx, &req)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "hello", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+			assert.Equal(t, "nightly", wf.Labels["schedule"])
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Preset: "nightly-us"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRenderWorkflow(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	newWorkflow := func(args ...string) *v1alpha1.Workflow {
+		return &v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "my-wf-"},
+			Spec: v1alpha1.WorkflowSpec{
+				Entrypoint:       "main",
+				TemplateDefaults: &v1alpha1.Template{Timeout: "1m"},
+				Templates:        []v1alpha1.Template{{Name: "main", Container: &corev1.Container{Image: "argoproj/argosay:v2", Args: args}}},
+			},
+		}
+	}
+	t.Run("NoWorkflow", func(t *testing.T) {
+		_, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{Namespace: "workflows"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Workflow", func(t *testing.T) {
+		wf, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{Namespace: "workflows", Workflow: newWorkflow("{{=sprig.upper(\"hello\")}}")})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "workflows", wf.Namespace)
+			assert.Contains(t, wf.Labels, common.LabelKeyControllerInstanceID)
+			assert.Equal(t, "my-sa", wf.Spec.ServiceAccountName)
+			assert.Equal(t, "1m", wf.Spec.Templates[0].Timeout)
+		}
+	})
+	t.Run("TemplateOverridesDefaults", func(t *testing.T) {
+		wf := newWorkflow()
+		wf.Spec.Templates[0].Timeout = "2m"
+		wf, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{Namespace: "workflows", Workflow: wf})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2m", wf.Spec.Templates[0].Timeout)
+		}
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{Namespace: "workflows", Workflow: newWorkflow("{{=1 +}}")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{
+			Namespace: "workflows",
+			Workflow: &v1alpha1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "my-wf-"},
+				Spec:       v1alpha1.WorkflowSpec{WorkflowTemplateRef: &v1alpha1.WorkflowTemplateRef{Name: "workflow-template-whalesay-template"}},
+			},
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"message=hello"}},
+		})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wf.Labels, "labelTest")
+			assert.Equal(t, "whalesay-template", wf.Spec.Entrypoint)
+			assert.Equal(t, "hello", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+			assert.Len(t, wf.Spec.Templates, 1)
+			assert.Equal(t, "my-sa", wf.Spec.ServiceAccountName)
+		}
+	})
+	t.Run("Resource", func(t *testing.T) {
+		_, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{Namespace: "workflows", ResourceKind: "workflowtemplate", ResourceName: "workflow-template-whalesay-template"})
+		assert.EqualError(t, err, "spec.arguments.message.value is required")
+		wf, err := server.RenderWorkflow(ctx, &workflowpkg.WorkflowRenderRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"message=hello"}},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "whalesay-template", wf.Spec.Entrypoint)
+			assert.Len(t, wf.Spec.Templates, 1)
+			assert.Equal(t, "my-sa", wf.Spec.ServiceAccountName)
+		}
+	})
+}
+
+func createApprovalGateWorkflow(ctx context.Context, t *testing.T, name string) {
+	_, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("workflows").Create(ctx, &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "workflows", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		Status: v1alpha1.WorkflowStatus{
+			Phase: v1alpha1.WorkflowRunning,
+			Nodes: v1alpha1.Nodes{name: {
+				ID:          name,
+				Name:        name,
+				DisplayName: name,
+				Type:        v1alpha1.NodeTypeSuspend,
+				Phase:       v1alpha1.NodeRunning,
+				Approval:    &v1alpha1.ApprovalStatus{Gate: &v1alpha1.Approval{Approvers: []string{"alice@example.com"}}},
+			}},
+		},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+}
+
+func TestApproveWorkflow(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	aliceCtx := context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "alice"}, Email: "alice@example.com"})
+	createApprovalGateWorkflow(ctx, t, "approve")
+	createApprovalGateWorkflow(ctx, t, "reject")
+	t.Run("NotApprover", func(t *testing.T) {
+		_, err := server.ApproveWorkflow(ctx, &workflowpkg.WorkflowApproveRequest{Name: "approve", Namespace: "workflows"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("Resume", func(t *testing.T) {
+		_, err := server.ResumeWorkflow(aliceCtx, &workflowpkg.WorkflowResumeRequest{Name: "approve", Namespace: "workflows"})
+		assert.EqualError(t, err, "node approve is an approval gate, so it can only be approved, or rejected")
+	})
+	t.Run("Approve", func(t *testing.T) {
+		wf, err := server.ApproveWorkflow(aliceCtx, &workflowpkg.WorkflowApproveRequest{Name: "approve", Namespace: "workflows", Comment: "LGTM"})
+		if assert.NoError(t, err) {
+			node := wf.Status.Nodes["approve"]
+			assert.Equal(t, v1alpha1.NodeSucceeded, node.Phase)
+			assert.Equal(t, "approved by alice@example.com", node.Message)
+			if assert.Len(t, node.Approval.Decisions, 1) {
+				assert.Equal(t, "alice@example.com", node.Approval.Decisions[0].Approver)
+				assert.True(t, node.Approval.Decisions[0].Approved)
+				assert.Equal(t, "LGTM", node.Approval.Decisions[0].Comment)
+			}
+		}
+	})
+	t.Run("Reject", func(t *testing.T) {
+		wf, err := server.RejectWorkflow(aliceCtx, &workflowpkg.WorkflowRejectRequest{Name: "reject", Namespace: "workflows", Comment: "not now"})
+		if assert.NoError(t, err) {
+			node := wf.Status.Nodes["reject"]
+			assert.Equal(t, v1alpha1.NodeFailed, node.Phase)
+			assert.Equal(t, "rejected by alice@example.com: not now", node.Message)
+		}
+	})
+}
+
+func TestGetWorkflowCosts(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	for name, team := range map[string]string{"data-0": "data", "data-1": "data", "ml": "ml"} {
+		_, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("costs").Create(ctx, &v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "costs", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid", "team": team}},
+			Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded, EstimatedCost: v1alpha1.NewAmount(1.25)},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	t.Run("ByNamespace", func(t *testing.T) {
+		res, err := server.GetWorkflowCosts(ctx, &workflowpkg.WorkflowCostsRequest{})
+		if assert.NoError(t, err) && assert.Len(t, res.Items, 3) {
+			assert.Equal(t, &workflowpkg.WorkflowCosts{Key: "costs", Workflows: 3, EstimatedCost: v1alpha1.NewAmount(3.75)}, res.Items[0])
+			assert.Equal(t, "test", res.Items[1].Key)
+			assert.Nil(t, res.Items[1].EstimatedCost)
+			assert.Equal(t, "workflows", res.Items[2].Key)
+		}
+	})
+	t.Run("ByLabel", func(t *testing.T) {
+		res, err := server.GetWorkflowCosts(ctx, &workflowpkg.WorkflowCostsRequest{Namespace: "costs", GroupBy: "team"})
+		if assert.NoError(t, err) && assert.Len(t, res.Items, 2) {
+			assert.Equal(t, &workflowpkg.WorkflowCosts{Key: "data", Workflows: 2, EstimatedCost: v1alpha1.NewAmount(2.5)}, res.Items[0])
+			assert.Equal(t, &workflowpkg.WorkflowCosts{Key: "ml", Workflows: 1, EstimatedCost: v1alpha1.NewAmount(1.25)}, res.Items[1])
+		}
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		res, err := server.GetWorkflowCosts(ctx, &workflowpkg.WorkflowCostsRequest{Namespace: "costs", ListOptions: &metav1.ListOptions{FieldSelector: "metadata.name^=data"}})
+		if assert.NoError(t, err) && assert.Len(t, res.Items, 1) {
+			assert.Equal(t, int64(2), res.Items[0].Workflows)
+		}
+	})
+}
+
+type fakeWorkflowSnapshotRepo struct {
+	sqldb.WorkflowSnapshotRepo
+	snapshot sqldb.WorkflowSnapshot
+}
+
+func (r *fakeWorkflowSnapshotRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *fakeWorkflowSnapshotRepo) Get(_, _ string, asOf time.Time) (*sqldb.WorkflowSnapshot, error) {
+	if asOf.Before(r.snapshot.Time) {
+		return nil, nil
+	}
+	return &r.snapshot, nil
+}
+
+func TestGetWorkflowSnapshot(t *testing.T) {
+	taken := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	ctx := context.WithValue(context.Background(), auth.KubeKey, kubeClient)
+	t.Run("Disabled", func(t *testing.T) {
+		server := NewWorkflowServer(instanceid.NewService(""), nil, nil, sqldb.NullWorkflowSnapshotRepo, nil)
+		_, err := server.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{Namespace: "my-ns", Name: "my-wf"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	server := NewWorkflowServer(instanceid.NewService(""), nil, nil, &fakeWorkflowSnapshotRepo{snapshot: sqldb.WorkflowSnapshot{Time: taken, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning}}}, nil)
+	t.Run("Latest", func(t *testing.T) {
+		snapshot, err := server.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{Namespace: "my-ns", Name: "my-wf"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, taken, snapshot.Time.Time)
+			assert.Equal(t, v1alpha1.WorkflowRunning, snapshot.Status.Phase)
+		}
+	})
+	t.Run("AsOf", func(t *testing.T) {
+		_, err := server.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{Namespace: "my-ns", Name: "my-wf", AsOf: "2021-01-01T12:30:00Z"})
+		assert.NoError(t, err)
+		_, err = server.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{Namespace: "my-ns", Name: "my-wf", AsOf: "2021-01-01T11:30:00Z"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("InvalidAsOf", func(t *testing.T) {
+		_, err := server.GetWorkflowSnapshot(ctx, &workflowpkg.WorkflowSnapshotRequest{Namespace: "my-ns", Name: "my-wf", AsOf: "yesterday"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
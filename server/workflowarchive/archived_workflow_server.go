@@ -2,12 +2,13 @@ package workflowarchive
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"sort"
-	"strconv"
 	"strings"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,16 +19,32 @@ import (
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/util/fields"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type archivedWorkflowServer struct {
-	wfArchive sqldb.WorkflowArchive
+	wfArchive            sqldb.WorkflowArchive
+	artifactRepositories artifactrepositories.Interface
+	artDriverFactory     artifact.NewDriverFunc
+	// serverWfClient is the server's own workflow clientset, which evaluates workflow template grants, and gets the
+	// workflow templates they grant, as callers need not be able to read the namespaces that grant them
+	serverWfClient versioned.Interface
 }
 
 // NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive: wfArchive}
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, artifactRepositories artifactrepositories.Interface, serverWfClient versioned.Interface) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return newWorkflowArchiveServer(wfArchive, artifactRepositories, artifact.NewDriver, serverWfClient)
+}
+
+func newWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, artifactRepositories artifactrepositories.Interface, artDriverFactory artifact.NewDriverFunc, serverWfClient versioned.Interface) *archivedWorkflowServer {
+	return &archivedWorkflowServer{wfArchive: wfArchive, artifactRepositories: artifactRepositories, artDriverFactory: artDriverFactory, serverWfClient: serverWfClient}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -36,17 +53,11 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 	if options == nil {
 		options = &metav1.ListOptions{}
 	}
-	if options.Continue == "" {
-		options.Continue = "0"
-	}
 	limit := int(options.Limit)
 
-	offset, err := strconv.Atoi(options.Continue)
+	cursor, err := parseContinue(options.Continue)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must be int")
-	}
-	if offset < 0 {
-		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0")
+		return nil, status.Error(codes.InvalidArgument, "listOptions.continue is invalid")
 	}
 
 	namespace := ""
@@ -99,7 +110,7 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		limitWithMore = limit + 1
 	}
 
-	items, err := w.wfArchive.ListWorkflows(namespace, name, namePrefix, minStartedAt, maxStartedAt, requirements, limitWithMore, offset)
+	items, err := w.wfArchive.ListWorkflows(namespace, name, namePrefix, req.Search, minStartedAt, maxStartedAt, requirements, limitWithMore, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -108,11 +119,44 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 
 	if !loadAll && len(items) > limit {
 		items = items[0:limit]
-		meta.Continue = fmt.Sprintf("%v", offset+limit)
+		// the items are in cursor order, so the next page starts after the last item
+		meta.Continue = formatContinue(items[limit-1])
 	}
 
 	sort.Sort(items)
-	return &wfv1.WorkflowList{ListMeta: meta, Items: items}, nil
+	res := &wfv1.WorkflowList{ListMeta: meta, Items: items}
+	newRes := &wfv1.WorkflowList{}
+	if ok, err := fields.NewCleaner(req.Fields).Clean(res, &newRes); err != nil {
+		return nil, fmt.Errorf("unable to CleanFields in request: %w", err)
+	} else if ok {
+		return newRes, nil
+	}
+	return res, nil
+}
+
+// formatContinue returns the continue token for the page after the workflow. It is opaque to clients.
+func formatContinue(wf wfv1.Workflow) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(wf.Status.StartedAt.UTC().Format(time.RFC3339Nano) + "," + string(wf.UID)))
+}
+
+// parseContinue parses a continue token, returning nil for the first page
+func parseContinue(token string) (*sqldb.ListCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(data), ",", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("malformed continue token")
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	return &sqldb.ListCursor{StartedAt: startedAt, UID: parts[1]}, nil
 }
 
 func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowRequest) (*wfv1.Workflow, error) {
@@ -130,10 +174,19 @@ func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *w
 	if !allowed {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
-	return wf, err
+	newWf := &wfv1.Workflow{}
+	if ok, err := fields.NewCleaner(req.Fields).Clean(wf, &newWf); err != nil {
+		return nil, fmt.Errorf("unable to CleanFields in request: %w", err)
+	} else if ok {
+		return newWf, nil
+	}
+	return wf, nil
 }
 
 func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.DeleteArchivedWorkflowRequest) (*workflowarchivepkg.ArchivedWorkflowDeletedResponse, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionDelete); err != nil {
+		return nil, err
+	}
 	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: req.Uid})
 	if err != nil {
 		return nil, err
@@ -145,13 +198,143 @@ func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req
 	if !allowed {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
-	err = w.wfArchive.DeleteWorkflow(req.Uid)
+	err = w.wfArchive.DeleteWorkflow(wf.Namespace, req.Uid)
 	if err != nil {
 		return nil, err
 	}
 	return &workflowarchivepkg.ArchivedWorkflowDeletedResponse{}, nil
 }
 
+func (w *archivedWorkflowServer) CreateArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.CreateArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wf := req.Workflow
+	if wf == nil {
+		return nil, status.Error(codes.InvalidArgument, "workflow is required")
+	}
+	if wf.UID == "" {
+		return nil, status.Error(codes.InvalidArgument, "workflow must have a UID")
+	}
+	if !wf.Status.Fulfilled() {
+		return nil, status.Error(codes.InvalidArgument, "only completed workflows can be archived")
+	}
+	if req.Namespace != "" {
+		wf.Namespace = req.Namespace
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, wf.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if !w.wfArchive.IsEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "the workflow archive is not enabled")
+	}
+	// archiving replaces the workflow of the same UID, which must be of the same namespace, and updatable
+	existing, err := w.wfArchive.GetWorkflow(string(wf.UID))
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.Namespace != wf.Namespace {
+			return nil, status.Error(codes.AlreadyExists, "a workflow of the same UID is archived in another namespace")
+		}
+		allowed, err := auth.CanI(ctx, "update", workflow.WorkflowPlural, existing.Namespace, existing.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+	}
+	if err := w.wfArchive.ArchiveWorkflow(wf); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	if err := auth.CanPerformWorkflowAction(ctx, types.WorkflowActionResubmit); err != nil {
+		return nil, err
+	}
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: req.Uid})
+	if err != nil {
+		return nil, err
+	}
+	// the archived workflow may have been deleted from the cluster, or be resubmitted somewhere else
+	if req.Namespace != "" {
+		wf.Namespace = req.Namespace
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, wf.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.Memoized {
+		if err := w.unsetMissingOutputs(ctx, wf); err != nil {
+			return nil, err
+		}
+	}
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	wfClient := auth.GetWfClient(ctx)
+	created, err := util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wfClient, w.serverWfClient, wf.Namespace, newWF, &wfv1.SubmitOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// unsetMissingOutputs marks the successful pods with an output artifact that no longer exists, e.g. because it
+// expired from its bucket, as errored, so that a memoized resubmission re-runs them rather than re-using the outputs.
+func (w *archivedWorkflowServer) unsetMissingOutputs(ctx context.Context, wf *wfv1.Workflow) error {
+	ar, err := w.artifactRepositories.Get(ctx, wf.Status.ArtifactRepositoryRef)
+	if err != nil {
+		return err
+	}
+	l := ar.ToArtifactLocation()
+	for id, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.Phase != wfv1.NodeSucceeded || node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if w.artifactExists(ctx, wf.Namespace, art, l) {
+				continue
+			}
+			node.Phase = wfv1.NodeError
+			node.Message = fmt.Sprintf("output artifact %q no longer exists", art.Name)
+			wf.Status.Nodes[id] = node
+			break
+		}
+	}
+	return nil
+}
+
+// artifactExists returns false if the artifact cannot be found, and true if it exists or its driver cannot tell
+func (w *archivedWorkflowServer) artifactExists(ctx context.Context, namespace string, art wfv1.Artifact, l *wfv1.ArtifactLocation) bool {
+	if !art.HasLocationOrKey() || art.Raw != nil {
+		return true
+	}
+	if err := art.Relocate(l); err != nil {
+		return false
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": namespace, "artifactName": art.Name})
+	driver, err := w.artDriverFactory(ctx, &art, resource.New(auth.GetKubeClient(ctx), namespace))
+	if err != nil {
+		logCtx.WithError(err).Warn("failed to create the artifact driver, assuming the artifact exists")
+		return true
+	}
+	objects, err := driver.ListObjects(&art)
+	if err != nil {
+		logCtx.WithError(err).Warn("failed to list the artifact, assuming it exists")
+		return true
+	}
+	return len(objects) > 0
+}
+
 func (w *archivedWorkflowServer) ListArchivedWorkflowLabelKeys(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowLabelKeysRequest) (*wfv1.LabelKeys, error) {
 	labelkeys, err := w.wfArchive.ListWorkflowsLabelKeys()
 	if err != nil {
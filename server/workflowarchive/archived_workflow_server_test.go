@@ -2,10 +2,12 @@ package workflowarchive
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -13,21 +15,48 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	armocks "github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories/mocks"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 )
 
+type fakeArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	keys []string
+}
+
+func (d *fakeArtifactDriver) ListObjects(art *wfv1.Artifact) ([]string, error) {
+	var objects []string
+	for _, key := range d.keys {
+		if key == art.S3.Key {
+			objects = append(objects, key)
+		}
+	}
+	return objects, nil
+}
+
 func Test_archivedWorkflowServer(t *testing.T) {
 	repo := &mocks.WorkflowArchive{}
 	kubeClient := &kubefake.Clientset{}
 	wfClient := &argofake.Clientset{}
-	w := NewWorkflowArchiveServer(repo)
+	artifactRepositories := armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{
+		S3: &wfv1.S3ArtifactRepository{
+			S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"},
+		},
+	})
+	w := newWorkflowArchiveServer(repo, artifactRepositories, func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return &fakeArtifactDriver{keys: []string{"my-wf/my-name-1/my-art.tgz"}}, nil
+	}, wfClient)
 	allowed := true
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
@@ -46,30 +75,58 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	// two pages of results for limit 1
-	repo.On("ListWorkflows", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}, {}}, nil)
-	repo.On("ListWorkflows", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 1).Return(wfv1.Workflows{{}}, nil)
+	firstStartedAt, _ := time.Parse(time.RFC3339, "2020-01-01T12:00:00Z")
+	noCursor := (*sqldb.ListCursor)(nil)
+	repo.On("ListWorkflows", "", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{UID: "first-uid"}, Status: wfv1.WorkflowStatus{StartedAt: metav1.Time{Time: firstStartedAt}}},
+		{},
+	}, nil)
+	repo.On("ListWorkflows", "", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, &sqldb.ListCursor{StartedAt: firstStartedAt, UID: "first-uid"}).Return(wfv1.Workflows{{}}, nil)
 	minStartAt, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
 	maxStartAt, _ := time.Parse(time.RFC3339, "2020-01-02T00:00:00Z")
-	repo.On("ListWorkflows", "", "", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "my-name", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "", "my-", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "my-name", "my-", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", "", "", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", "my-name", "", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", "", "my-", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", "my-name", "my-", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", "", "", "my search", time.Time{}, time.Time{}, labels.Requirements(nil), 2, noCursor).Return(wfv1.Workflows{{}}, nil)
 	repo.On("GetWorkflow", "").Return(nil, nil)
 	repo.On("GetWorkflow", "my-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-name"},
 		Spec: wfv1.WorkflowSpec{
 			Entrypoint: "my-entrypoint",
 			Templates: []wfv1.Template{
-				{Name: "my-entrypoint", Container: &apiv1.Container{}},
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
+			},
+		},
+	}, nil)
+	repo.On("GetWorkflow", "my-succeeded-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "my-entrypoint",
+			Arguments:  wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+			Templates: []wfv1.Template{
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowSucceeded,
+			Nodes: wfv1.Nodes{
+				"my-wf-1": {Name: "my-wf.existing", Type: wfv1.NodeTypePod, TemplateName: "my-entrypoint", Phase: wfv1.NodeSucceeded, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+					{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-name-1/my-art.tgz"}}},
+				}}},
+				"my-wf-2": {Name: "my-wf.missing", Type: wfv1.NodeTypePod, TemplateName: "my-entrypoint", Phase: wfv1.NodeSucceeded, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+					{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-name-2/my-art.tgz"}}},
+				}}},
 			},
 		},
 	}, nil)
 	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
-		return true, &wfv1.Workflow{
-			ObjectMeta: metav1.ObjectMeta{Name: "my-name-resubmitted"},
-		}, nil
+		return true, action.(k8stesting.CreateAction).GetObject(), nil
 	})
-	repo.On("DeleteWorkflow", "my-uid").Return(nil)
+	repo.On("GetWorkflow", "my-new-uid").Return(nil, nil)
+	repo.On("DeleteWorkflow", "", "my-uid").Return(nil)
+	repo.On("IsEnabled").Return(true)
+	repo.On("ArchiveWorkflow", mock.Anything).Return(nil)
 	repo.On("ListWorkflowsLabelKeys").Return(&wfv1.LabelKeys{
 		Items: []string{"foo", "bar"},
 	}, nil)
@@ -77,6 +134,11 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		Items: []string{"my-key=foo", "my-key=bar"},
 	}, nil)
 
+	templateRequirement, _ := labels.NewRequirement("workflows.argoproj.io/workflow-template", selection.Equals, []string{"my-template"})
+	repo.On("ListWorkflows", "my-ns", "", "", "", time.Time{}, time.Time{}, labels.Requirements{*templateRequirement}, 10, noCursor).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{UID: "my-uid"}},
+	}, nil)
+
 	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
 	t.Run("ListArchivedWorkflows", func(t *testing.T) {
 		allowed = false
@@ -86,13 +148,15 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		resp, err := w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
-			assert.Equal(t, "1", resp.Continue)
+			assert.NotEmpty(t, resp.Continue)
 		}
-		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "1", Limit: 1}})
+		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: resp.Continue, Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
 			assert.Empty(t, resp.Continue)
 		}
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "1", Limit: 1}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{FieldSelector: "spec.startedAt>2020-01-01T00:00:00Z,spec.startedAt<2020-01-02T00:00:00Z", Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
@@ -113,6 +177,16 @@ func Test_archivedWorkflowServer(t *testing.T) {
 			assert.Len(t, resp.Items, 1)
 			assert.Empty(t, resp.Continue)
 		}
+		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Limit: 1}, Search: "my search"})
+		if assert.NoError(t, err) {
+			assert.Len(t, resp.Items, 1)
+			assert.Empty(t, resp.Continue)
+		}
+		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Limit: 1}, Fields: "metadata.continue"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, resp.Items)
+			assert.NotEmpty(t, resp.Continue)
+		}
 	})
 	t.Run("GetArchivedWorkflow", func(t *testing.T) {
 		allowed = false
@@ -124,6 +198,11 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 		assert.NotNil(t, wf)
+		wf, err = w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: "my-uid", Fields: "metadata.name"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-name", wf.Name)
+			assert.Empty(t, wf.Spec.Templates)
+		}
 	})
 	t.Run("DeleteArchivedWorkflow", func(t *testing.T) {
 		allowed = false
@@ -133,6 +212,54 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("CreateArchivedWorkflow", func(t *testing.T) {
+		_, err := w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Workflow: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{UID: "my-uid"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}}})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "only completed workflows can be archived"), err)
+		completed := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-name", Namespace: "my-ns", UID: "my-new-uid"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded}}
+		allowed = false
+		_, err = w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Workflow: completed.DeepCopy()})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied"), err)
+		allowed = true
+		wf, err := w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Namespace: "other-ns", Workflow: completed.DeepCopy()})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "other-ns", wf.Namespace)
+			repo.AssertCalled(t, "ArchiveWorkflow", wf)
+		}
+		// my-uid is archived in the "" namespace
+		_, err = w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{Namespace: "other-ns", Workflow: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-name", UID: "my-uid"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded}}})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("ResubmitArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied"), err)
+		allowed = true
+		wf, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid", Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-ns", wfClient.Actions()[len(wfClient.Actions())-1].GetNamespace())
+			assert.Equal(t, "my-name-", wf.GenerateName)
+		}
+		_, err = w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-succeeded-uid", Memoized: true, Parameters: []string{"message=goodbye"}})
+		if assert.NoError(t, err) {
+			wf := wfClient.Actions()[len(wfClient.Actions())-1].(k8stesting.CreateAction).GetObject().(*wfv1.Workflow)
+			assert.Equal(t, "goodbye", wf.Spec.Arguments.Parameters[0].Value.String())
+			phases := make(map[string]wfv1.NodePhase)
+			for _, node := range wf.Status.Nodes {
+				phases[strings.TrimPrefix(node.Name, wf.Name)] = node.Phase
+			}
+			assert.Equal(t, map[string]wfv1.NodePhase{".existing": wfv1.NodeSkipped, ".missing": wfv1.NodePending}, phases)
+		}
+	})
+	t.Run("GetArchivedWorkflowTemplateStats", func(t *testing.T) {
+		_, err := w.GetArchivedWorkflowTemplateStats(ctx, &workflowarchivepkg.GetArchivedWorkflowTemplateStatsRequest{Namespace: "my-ns", Name: "my-template", Limit: 101})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "limit must not be more than 100"), err)
+		stats, err := w.GetArchivedWorkflowTemplateStats(ctx, &workflowarchivepkg.GetArchivedWorkflowTemplateStatsRequest{Namespace: "my-ns", Name: "my-template"})
+		if assert.NoError(t, err) && assert.Len(t, stats.Runs, 1) {
+			assert.Equal(t, "my-name", stats.Runs[0].Name)
+		}
+	})
 	t.Run("ListArchivedWorkflowLabelKeys", func(t *testing.T) {
 		resp, err := w.ListArchivedWorkflowLabelKeys(ctx, &workflowarchivepkg.ListArchivedWorkflowLabelKeysRequest{})
 		assert.NoError(t, err)
@@ -0,0 +1,165 @@
+package workflowarchive
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const (
+	defaultTemplateStatsLimit = 10
+	maxTemplateStatsLimit     = 100
+)
+
+// GetArchivedWorkflowTemplateStats returns the durations, and resources durations, of the templates of the most recent
+// archived runs of a workflow template, so that a regression in the performance of a pipeline can be spotted without
+// exporting the archive
+func (w *archivedWorkflowServer) GetArchivedWorkflowTemplateStats(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowTemplateStatsRequest) (*workflowarchivepkg.ArchivedWorkflowTemplateStats, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultTemplateStatsLimit
+	}
+	if limit > maxTemplateStatsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be more than %d", maxTemplateStatsLimit)
+	}
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\"", req.Namespace))
+	}
+	labelKey := common.LabelKeyWorkflowTemplate
+	if req.ClusterScope {
+		labelKey = common.LabelKeyClusterWorkflowTemplate
+	}
+	requirement, err := labels.NewRequirement(labelKey, selection.Equals, []string{req.Name})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// the list only has the workflows' metadata, and phases, so each is got for its nodes
+	items, err := w.wfArchive.ListWorkflows(req.Namespace, "", "", "", time.Time{}, time.Time{}, labels.Requirements{*requirement}, limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	var runs wfv1.Workflows
+	for _, item := range items {
+		wf, err := w.wfArchive.GetWorkflow(string(item.UID))
+		if err != nil {
+			return nil, err
+		}
+		// it may have been deleted since it was listed
+		if wf != nil {
+			runs = append(runs, *wf)
+		}
+	}
+	return templateStats(runs), nil
+}
+
+// templateStats returns the statistics of the runs, which are most recent first
+func templateStats(runs wfv1.Workflows) *workflowarchivepkg.ArchivedWorkflowTemplateStats {
+	stats := &workflowarchivepkg.ArchivedWorkflowTemplateStats{}
+	templates := map[string]*workflowarchivepkg.ArchivedTemplateStats{}
+	durations := map[string][]int64{}
+	resourcesDurations := map[string]wfv1.ResourcesDuration{}
+	for i, wf := range runs {
+		startedAt := wf.Status.StartedAt
+		stats.Runs = append(stats.Runs, &workflowarchivepkg.ArchivedWorkflowRun{
+			Name:              wf.Name,
+			Uid:               string(wf.UID),
+			Phase:             string(wf.Status.Phase),
+			StartedAt:         &startedAt,
+			DurationSeconds:   int64(wf.Status.GetDuration().Seconds()),
+			ResourcesDuration: toSeconds(wf.Status.ResourcesDuration),
+		})
+		ran := map[string]bool{}
+		for _, node := range wf.Status.Nodes {
+			name := nodeTemplateName(node)
+			// retry nodes are counted by their attempts
+			if name == "" || node.Type == wfv1.NodeTypeRetry || node.StartedAt.IsZero() || node.FinishedAt.IsZero() {
+				continue
+			}
+			t, ok := templates[name]
+			if !ok {
+				t = &workflowarchivepkg.ArchivedTemplateStats{Template: name, DurationSeconds: make([]int64, len(runs))}
+				templates[name] = t
+			}
+			duration := int64(node.GetDuration().Seconds())
+			if !ran[name] {
+				ran[name] = true
+				t.Runs++
+			}
+			t.DurationSeconds[i] += duration
+			t.Nodes++
+			if node.FailedOrError() {
+				t.FailedNodes++
+			}
+			durations[name] = append(durations[name], duration)
+			resourcesDurations[name] = resourcesDurations[name].Add(node.ResourcesDuration)
+		}
+	}
+	for name, t := range templates {
+		d := durations[name]
+		sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+		var total int64
+		for _, v := range d {
+			total += v
+		}
+		t.MeanDurationSeconds = total / int64(len(d))
+		t.P50DurationSeconds = percentile(d, 50)
+		t.P95DurationSeconds = percentile(d, 95)
+		t.MaxDurationSeconds = d[len(d)-1]
+		if r := resourcesDurations[name]; len(r) > 0 {
+			t.MeanResourcesDuration = map[string]int64{}
+			for resourceName, v := range toSeconds(r) {
+				t.MeanResourcesDuration[resourceName] = v / int64(t.Nodes)
+			}
+		}
+		stats.Templates = append(stats.Templates, t)
+	}
+	sort.Slice(stats.Templates, func(i, j int) bool { return stats.Templates[i].Template < stats.Templates[j].Template })
+	return stats
+}
+
+// nodeTemplateName returns the name of the node's template, qualified by its workflow template for template refs
+func nodeTemplateName(node wfv1.NodeStatus) string {
+	if ref := node.TemplateRef; ref != nil {
+		return ref.Name + "/" + ref.Template
+	}
+	return node.TemplateName
+}
+
+// percentile returns the nearest-rank percentile of the sorted values
+func percentile(sorted []int64, p int) int64 {
+	i := (len(sorted)*p + 99) / 100
+	if i < 1 {
+		i = 1
+	}
+	return sorted[i-1]
+}
+
+func toSeconds(r wfv1.ResourcesDuration) map[string]int64 {
+	if len(r) == 0 {
+		return nil
+	}
+	m := map[string]int64{}
+	for name, d := range r {
+		m[string(name)] = int64(d)
+	}
+	return m
+}
@@ -0,0 +1,86 @@
+package workflowarchive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_templateStats(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	node := func(template string, seconds int, phase wfv1.NodePhase) wfv1.NodeStatus {
+		return wfv1.NodeStatus{
+			Type:              wfv1.NodeTypePod,
+			TemplateName:      template,
+			Phase:             phase,
+			StartedAt:         startedAt,
+			FinishedAt:        metav1.NewTime(startedAt.Add(time.Duration(seconds) * time.Second)),
+			ResourcesDuration: wfv1.ResourcesDuration{apiv1.ResourceCPU: wfv1.ResourceDuration(seconds)},
+		}
+	}
+	runs := wfv1.Workflows{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-2", UID: "2"},
+			Status: wfv1.WorkflowStatus{
+				Phase:      wfv1.WorkflowFailed,
+				StartedAt:  startedAt,
+				FinishedAt: metav1.NewTime(startedAt.Add(time.Minute)),
+				Nodes: wfv1.Nodes{
+					"build-0": node("build", 30, wfv1.NodeSucceeded),
+					"build-1": node("build", 50, wfv1.NodeSucceeded),
+					"test":    node("test", 10, wfv1.NodeFailed),
+					"retry":   {Type: wfv1.NodeTypeRetry, TemplateName: "test", StartedAt: startedAt, FinishedAt: startedAt},
+					"deploy":  {Type: wfv1.NodeTypePod, TemplateRef: &wfv1.TemplateRef{Name: "shared", Template: "deploy"}, Phase: wfv1.NodeRunning, StartedAt: startedAt},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-1", UID: "1"},
+			Status: wfv1.WorkflowStatus{
+				Phase:             wfv1.WorkflowSucceeded,
+				StartedAt:         startedAt,
+				FinishedAt:        metav1.NewTime(startedAt.Add(30 * time.Second)),
+				ResourcesDuration: wfv1.ResourcesDuration{apiv1.ResourceCPU: 20},
+				Nodes: wfv1.Nodes{
+					"build": node("build", 20, wfv1.NodeSucceeded),
+					"ref":   {Type: wfv1.NodeTypePod, TemplateRef: &wfv1.TemplateRef{Name: "shared", Template: "deploy"}, Phase: wfv1.NodeSucceeded, StartedAt: startedAt, FinishedAt: metav1.NewTime(startedAt.Add(5 * time.Second))},
+				},
+			},
+		},
+	}
+	stats := templateStats(runs)
+	if assert.Len(t, stats.Runs, 2) {
+		assert.Equal(t, "my-wf-2", stats.Runs[0].Name)
+		assert.Equal(t, "Failed", stats.Runs[0].Phase)
+		assert.Equal(t, int64(60), stats.Runs[0].DurationSeconds)
+		assert.Equal(t, map[string]int64{"cpu": 20}, stats.Runs[1].ResourcesDuration)
+	}
+	if assert.Len(t, stats.Templates, 3) {
+		build := stats.Templates[0]
+		assert.Equal(t, "build", build.Template)
+		assert.Equal(t, int32(2), build.Runs)
+		assert.Equal(t, int32(3), build.Nodes)
+		assert.Equal(t, int32(0), build.FailedNodes)
+		assert.Equal(t, int64(33), build.MeanDurationSeconds)
+		assert.Equal(t, int64(30), build.P50DurationSeconds)
+		assert.Equal(t, int64(50), build.P95DurationSeconds)
+		assert.Equal(t, int64(50), build.MaxDurationSeconds)
+		assert.Equal(t, map[string]int64{"cpu": 33}, build.MeanResourcesDuration)
+		assert.Equal(t, []int64{80, 20}, build.DurationSeconds)
+
+		deploy := stats.Templates[1]
+		assert.Equal(t, "shared/deploy", deploy.Template)
+		assert.Equal(t, int32(1), deploy.Nodes, "running nodes are not counted")
+		assert.Equal(t, []int64{0, 5}, deploy.DurationSeconds)
+
+		test := stats.Templates[2]
+		assert.Equal(t, "test", test.Template)
+		assert.Equal(t, int32(1), test.Nodes, "retry nodes are not counted")
+		assert.Equal(t, int32(1), test.FailedNodes)
+	}
+}
@@ -5,23 +5,30 @@ import (
 	"fmt"
 	"sort"
 
+	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 type WorkflowTemplateServer struct {
 	instanceIDService instanceid.Service
+	// serverWfClient is the server's own workflow clientset, which evaluates workflow template grants, and gets the
+	// workflow templates they grant, as callers need not be able to read the namespaces that grant them
+	serverWfClient versioned.Interface
 }
 
-func NewWorkflowTemplateServer(instanceIDService instanceid.Service) workflowtemplatepkg.WorkflowTemplateServiceServer {
-	return &WorkflowTemplateServer{instanceIDService}
+func NewWorkflowTemplateServer(instanceIDService instanceid.Service, serverWfClient versioned.Interface) workflowtemplatepkg.WorkflowTemplateServiceServer {
+	return &WorkflowTemplateServer{instanceIDService, serverWfClient}
 }
 
 func (wts *WorkflowTemplateServer) CreateWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateCreateRequest) (*v1alpha1.WorkflowTemplate, error) {
@@ -33,11 +40,35 @@ func (wts *WorkflowTemplateServer) CreateWorkflowTemplate(ctx context.Context, r
 	creator.Label(ctx, req.Template)
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	_, err := validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, req.Template, validate.ValidateOpts{})
+	_, err := validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, req.Template, validate.ValidateOpts{GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, wts.serverWfClient)})
 	if err != nil {
 		return nil, err
 	}
-	return wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Create(ctx, req.Template, v1.CreateOptions{})
+	templaterevision.SetNext(nil, req.Template)
+	templates := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace)
+	res, err := templates.Create(ctx, req.Template, v1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the revision is owned by the template, so can only be saved once the template is created, which we undo if it
+	// cannot be, rather than leave a template whose revision cannot be pinned
+	err = wts.saveRevision(ctx, req.Namespace, res)
+	if err != nil {
+		if deleteErr := templates.Delete(ctx, res.Name, v1.DeleteOptions{Preconditions: &v1.Preconditions{UID: &res.UID}}); deleteErr != nil {
+			log.WithError(deleteErr).WithField("name", res.Name).Error("failed to delete workflow template whose revision could not be saved")
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// saveRevision saves the revision of the template, so workflows can pin it, and it can be rolled back to
+func (wts *WorkflowTemplateServer) saveRevision(ctx context.Context, namespace string, wfTmpl *v1alpha1.WorkflowTemplate) error {
+	err := templaterevision.Save(ctx, auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace), wfTmpl)
+	if err != nil {
+		return fmt.Errorf("failed to save revision %d of workflow template %q: %w", templaterevision.Revision(wfTmpl), wfTmpl.Name, err)
+	}
+	return nil
 }
 
 func (wts *WorkflowTemplateServer) GetWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateGetRequest) (*v1alpha1.WorkflowTemplate, error) {
@@ -93,7 +124,7 @@ func (wts *WorkflowTemplateServer) LintWorkflowTemplate(ctx context.Context, req
 	creator.Label(ctx, req.Template)
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	_, err := validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, req.Template, validate.ValidateOpts{Lint: true})
+	_, err := validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, req.Template, validate.ValidateOpts{Lint: true, GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, req.Namespace, wts.serverWfClient), StrictTemplateRefArguments: req.StrictTemplateRefArguments})
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +139,55 @@ func (wts *WorkflowTemplateServer) UpdateWorkflowTemplate(ctx context.Context, r
 	if err != nil {
 		return nil, err
 	}
+	return wts.updateWorkflowTemplate(ctx, req.Namespace, req.Template)
+}
+
+func (wts *WorkflowTemplateServer) updateWorkflowTemplate(ctx context.Context, namespace string, wfTmpl *v1alpha1.WorkflowTemplate) (*v1alpha1.WorkflowTemplate, error) {
 	wfClient := auth.GetWfClient(ctx)
-	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
+	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	_, err = validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, req.Template, validate.ValidateOpts{})
+	_, err := validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, wfTmpl, validate.ValidateOpts{GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, namespace, wts.serverWfClient)})
+	if err != nil {
+		return nil, err
+	}
+	current, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Get(ctx, wfTmpl.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the revision is owned by the template's UID, which the request need not have
+	if wfTmpl.UID == "" {
+		wfTmpl.UID = current.UID
+	}
+	templaterevision.SetNext(current, wfTmpl)
+	// save the revision before the template has it, so workflows can always pin the template's revision
+	err = wts.saveRevision(ctx, namespace, wfTmpl)
+	if err != nil {
+		return nil, err
+	}
+	return wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Update(ctx, wfTmpl, v1.UpdateOptions{})
+}
+
+func (wts *WorkflowTemplateServer) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest) (*v1alpha1.WorkflowTemplateList, error) {
+	wfTmpl, err := wts.getTemplateAndValidate(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := templaterevision.List(ctx, auth.GetKubeClient(ctx).CoreV1().ConfigMaps(req.Namespace), wfTmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.WorkflowTemplateList{Items: revisions}, nil
+}
+
+func (wts *WorkflowTemplateServer) RollbackWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error) {
+	wfTmpl, err := wts.getTemplateAndValidate(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := templaterevision.Get(ctx, auth.GetKubeClient(ctx).CoreV1().ConfigMaps(req.Namespace), wfTmpl, req.Revision)
 	if err != nil {
 		return nil, err
 	}
-	res, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Update(ctx, req.Template, v1.UpdateOptions{})
-	return res, err
+	wfTmpl.Spec = revision.Spec
+	return wts.updateWorkflowTemplate(ctx, req.Namespace, wfTmpl)
 }
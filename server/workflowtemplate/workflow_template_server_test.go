@@ -2,12 +2,17 @@ package workflowtemplate
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
 
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -160,15 +165,26 @@ const wftStr3 = `{
   }
 }`
 
+// uidReactor sets the UID of created objects, as the fake client does not
+func uidReactor(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
+	obj := action.(ktesting.CreateAction).GetObject().(metav1.Object)
+	obj.SetUID(uuid.NewUUID())
+	return false, nil, nil
+}
+
 func getWorkflowTemplateServer() (workflowtemplatepkg.WorkflowTemplateServiceServer, context.Context) {
 	var unlabelledObj, wftObj1, wftObj2 v1alpha1.WorkflowTemplate
 	v1alpha1.MustUnmarshal(unlabelled, &unlabelledObj)
 	v1alpha1.MustUnmarshal(wftStr2, &wftObj1)
 	v1alpha1.MustUnmarshal(wftStr3, &wftObj2)
+	for _, obj := range []*v1alpha1.WorkflowTemplate{&unlabelledObj, &wftObj1, &wftObj2} {
+		obj.SetUID(uuid.NewUUID())
+	}
 	kubeClientSet := fake.NewSimpleClientset()
 	wfClientset := wftFake.NewSimpleClientset(&unlabelledObj, &wftObj1, &wftObj2)
+	wfClientset.PrependReactor("create", "workflowtemplates", uidReactor)
 	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
-	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid")), ctx
+	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid"), wfClientset), ctx
 }
 
 func TestWorkflowTemplateServer_CreateWorkflowTemplate(t *testing.T) {
@@ -281,3 +297,75 @@ func TestWorkflowTemplateServer_UpdateWorkflowTemplate(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestWorkflowTemplateServer_Revisions(t *testing.T) {
+	server, ctx := getWorkflowTemplateServer()
+	var wftReq workflowtemplatepkg.WorkflowTemplateCreateRequest
+	v1alpha1.MustUnmarshal(wftStr1, &wftReq)
+	wftReq.Template.Name = "my-revisioned"
+	created, err := server.CreateWorkflowTemplate(ctx, &wftReq)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", created.Annotations[common.AnnotationKeyWorkflowTemplateRevision])
+	}
+	update := func(image string) *v1alpha1.WorkflowTemplate {
+		wfTmpl, err := server.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{Namespace: "default", Name: "my-revisioned"})
+		if assert.NoError(t, err) {
+			wfTmpl.Spec.Templates[0].Container.Image = image
+			wfTmpl, err = server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: "default", Template: wfTmpl})
+			assert.NoError(t, err)
+		}
+		return wfTmpl
+	}
+	t.Run("Update", func(t *testing.T) {
+		assert.Equal(t, "2", update("alpine:latest").Annotations[common.AnnotationKeyWorkflowTemplateRevision])
+	})
+	t.Run("UpdateUnchanged", func(t *testing.T) {
+		assert.Equal(t, "2", update("alpine:latest").Annotations[common.AnnotationKeyWorkflowTemplateRevision])
+	})
+	t.Run("List", func(t *testing.T) {
+		list, err := server.ListWorkflowTemplateRevisions(ctx, &workflowtemplatepkg.WorkflowTemplateRevisionsRequest{Namespace: "default", Name: "my-revisioned"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			assert.Equal(t, "alpine:latest", list.Items[0].Spec.Templates[0].Container.Image)
+			assert.Equal(t, "docker/whalesay", list.Items[1].Spec.Templates[0].Container.Image)
+		}
+	})
+	t.Run("Rollback", func(t *testing.T) {
+		wfTmpl, err := server.RollbackWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateRollbackRequest{Namespace: "default", Name: "my-revisioned", Revision: 1})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "3", wfTmpl.Annotations[common.AnnotationKeyWorkflowTemplateRevision])
+			assert.Equal(t, "docker/whalesay", wfTmpl.Spec.Templates[0].Container.Image)
+		}
+	})
+	t.Run("RollbackNotFound", func(t *testing.T) {
+		_, err := server.RollbackWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateRollbackRequest{Namespace: "default", Name: "my-revisioned", Revision: 9})
+		assert.EqualError(t, err, `revision 9 of workflow template "my-revisioned" not found`)
+	})
+}
+
+func TestWorkflowTemplateServer_RevisionNotSaved(t *testing.T) {
+	server, ctx := getWorkflowTemplateServer()
+	auth.GetKubeClient(ctx).(*fake.Clientset).PrependReactor("create", "configmaps", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("my-error")
+	})
+	templates := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowTemplates("default")
+	t.Run("Create", func(t *testing.T) {
+		var wftReq workflowtemplatepkg.WorkflowTemplateCreateRequest
+		v1alpha1.MustUnmarshal(wftStr1, &wftReq)
+		_, err := server.CreateWorkflowTemplate(ctx, &wftReq)
+		assert.EqualError(t, err, `failed to save revision 1 of workflow template "workflow-template-whalesay-template": my-error`)
+		_, err = templates.Get(ctx, "workflow-template-whalesay-template", metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err), "the template is deleted")
+	})
+	t.Run("Update", func(t *testing.T) {
+		var wfTmpl v1alpha1.WorkflowTemplate
+		v1alpha1.MustUnmarshal(wftStr2, &wfTmpl)
+		wfTmpl.Spec.Templates[0].Container.Image = "alpine:latest"
+		_, err := server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: "default", Template: &wfTmpl})
+		assert.EqualError(t, err, `failed to save revision 1 of workflow template "workflow-template-whalesay-template2": my-error`)
+		current, err := templates.Get(ctx, "workflow-template-whalesay-template2", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "docker/whalesay", current.Spec.Templates[0].Container.Image, "the template is not updated")
+			assert.Empty(t, current.Annotations[common.AnnotationKeyWorkflowTemplateRevision])
+		}
+	})
+}
@@ -1245,12 +1245,11 @@ spec:
 		})
 	}
 
-	s.Run("ListWithLimitAndOffset", func() {
+	s.Run("ListWithLimitAndContinue", func() {
 		j := s.e().GET("/api/v1/archived-workflows").
 			WithQuery("listOptions.labelSelector", "workflows.argoproj.io/test").
 			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
 			WithQuery("listOptions.limit", 1).
-			WithQuery("listOptions.offset", 1).
 			Expect().
 			Status(200).
 			JSON()
@@ -1259,9 +1258,18 @@ spec:
 			Array().
 			Length().
 			Equal(1)
-		j.
-			Path("$.metadata.continue").
-			Equal("1")
+		s.e().GET("/api/v1/archived-workflows").
+			WithQuery("listOptions.labelSelector", "workflows.argoproj.io/test").
+			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
+			WithQuery("listOptions.limit", 1).
+			WithQuery("listOptions.continue", j.Path("$.metadata.continue").String().NotEmpty().Raw()).
+			Expect().
+			Status(200).
+			JSON().
+			Path("$.items").
+			Array().
+			Length().
+			Equal(1)
 	})
 
 	s.Run("ListWithMinStartedAtGood", func() {
@@ -145,10 +145,10 @@ func (s *E2ESuite) DeleteResources() {
 		archive := s.Persistence.workflowArchive
 		parse, err := labels.ParseToRequirements(Label)
 		s.CheckError(err)
-		workflows, err := archive.ListWorkflows(Namespace, "", "", time.Time{}, time.Time{}, parse, 0, 0)
+		workflows, err := archive.ListWorkflows(Namespace, "", "", "", time.Time{}, time.Time{}, parse, 0, nil)
 		s.CheckError(err)
 		for _, w := range workflows {
-			err := archive.DeleteWorkflow(string(w.UID))
+			err := archive.DeleteWorkflow(w.Namespace, string(w.UID))
 			s.CheckError(err)
 		}
 	}
@@ -16,12 +16,13 @@ interface ArchivedWorkflowFilterProps {
     namespace: string;
     name: string;
     namePrefix: string;
+    search: string;
     phaseItems: string[];
     selectedPhases: string[];
     selectedLabels: string[];
     minStartedAt?: Date;
     maxStartedAt?: Date;
-    onChange: (namespace: string, name: string, namePrefix: string, selectedPhases: string[], labels: string[], minStartedAt: Date, maxStartedAt: Date) => void;
+    onChange: (namespace: string, name: string, namePrefix: string, search: string, selectedPhases: string[], labels: string[], minStartedAt: Date, maxStartedAt: Date) => void;
 }
 
 interface State {
@@ -53,6 +54,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     ns,
                                     this.props.name,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     this.props.selectedPhases,
                                     this.props.selectedLabels,
                                     this.props.minStartedAt,
@@ -71,6 +73,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     wfname,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     this.props.selectedPhases,
                                     this.props.selectedLabels,
                                     this.props.minStartedAt,
@@ -89,6 +92,26 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     this.props.name,
                                     wfnamePrefix,
+                                    this.props.search,
+                                    this.props.selectedPhases,
+                                    this.props.selectedLabels,
+                                    this.props.minStartedAt,
+                                    this.props.maxStartedAt
+                                );
+                            }}
+                        />
+                    </div>
+                    <div className='columns small-2 xlarge-12'>
+                        <p className='wf-filters-container__title'>Search</p>
+                        <InputFilter
+                            value={this.props.search}
+                            name='wfsearch'
+                            onChange={wfsearch => {
+                                this.props.onChange(
+                                    this.props.namespace,
+                                    this.props.name,
+                                    this.props.namePrefix,
+                                    wfsearch,
                                     this.props.selectedPhases,
                                     this.props.selectedLabels,
                                     this.props.minStartedAt,
@@ -109,6 +132,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     this.props.name,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     this.props.selectedPhases,
                                     tags,
                                     this.props.minStartedAt,
@@ -126,6 +150,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     this.props.name,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     selected,
                                     this.props.selectedLabels,
                                     this.props.minStartedAt,
@@ -145,6 +170,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     this.props.name,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     this.props.selectedPhases,
                                     this.props.selectedLabels,
                                     date,
@@ -163,6 +189,7 @@ export class ArchivedWorkflowFilters extends React.Component<ArchivedWorkflowFil
                                     this.props.namespace,
                                     this.props.name,
                                     this.props.namePrefix,
+                                    this.props.search,
                                     this.props.selectedPhases,
                                     this.props.selectedLabels,
                                     this.props.minStartedAt,
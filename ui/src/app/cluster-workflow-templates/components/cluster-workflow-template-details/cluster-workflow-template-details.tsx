@@ -131,6 +131,7 @@ export const ClusterWorkflowTemplateDetails = ({history, location, match}: Route
                         entrypoint={template.spec.entrypoint}
                         templates={template.spec.templates || []}
                         workflowParameters={template.spec.arguments.parameters || []}
+                        workflowArtifacts={template.spec.arguments.artifacts || []}
                     />
                 </SlidingPanel>
             )}
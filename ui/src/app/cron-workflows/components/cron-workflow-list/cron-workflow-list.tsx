@@ -11,7 +11,7 @@ import {Loading} from '../../../shared/components/loading';
 import {Timestamp} from '../../../shared/components/timestamp';
 import {ZeroState} from '../../../shared/components/zero-state';
 import {Context} from '../../../shared/context';
-import {getNextScheduledTime} from '../../../shared/cron';
+import {getNextScheduledTime, getSchedules} from '../../../shared/cron';
 import {Footnote} from '../../../shared/footnote';
 import {historyUrl} from '../../../shared/history';
 import {services} from '../../../shared/services';
@@ -141,9 +141,13 @@ export const CronWorkflowList = ({match, location, history}: RouteComponentProps
                                         <div className='columns small-1'>{w.spec.suspend ? <i className='fa fa-pause' /> : <i className='fa fa-clock' />}</div>
                                         <div className='columns small-3'>{w.metadata.name}</div>
                                         <div className='columns small-2'>{w.metadata.namespace}</div>
-                                        <div className='columns small-1'>{w.spec.schedule}</div>
+                                        <div className='columns small-1'>{getSchedules(w.spec).join('; ')}</div>
                                         <div className='columns small-3'>
-                                            <PrettySchedule schedule={w.spec.schedule} />
+                                            {getSchedules(w.spec).map(schedule => (
+                                                <div key={schedule}>
+                                                    <PrettySchedule schedule={schedule} />
+                                                </div>
+                                            ))}
                                         </div>
                                         <div className='columns small-1'>
                                             <Timestamp date={w.metadata.creationTimestamp} />
@@ -152,7 +156,7 @@ export const CronWorkflowList = ({match, location, history}: RouteComponentProps
                                             {w.spec.suspend ? (
                                                 ''
                                             ) : (
-                                                <Ticker intervalMs={1000}>{() => <Timestamp date={getNextScheduledTime(w.spec.schedule, w.spec.timezone)} />}</Ticker>
+                                                <Ticker intervalMs={1000}>{() => <Timestamp date={getNextScheduledTime(getSchedules(w.spec), w.spec.timezone, w.status?.nextScheduledTimes)} />}</Ticker>
                                             )}
                                         </div>
                                     </Link>
@@ -9,13 +9,33 @@ export const CronWorkflowSpecEditor = ({onChange, spec}: {spec: CronWorkflowSpec
     return (
         <div className='white-box'>
             <div className='white-box__details'>
-                <div className='row white-box__details-row'>
-                    <div className='columns small-3'>Schedule</div>
-                    <div className='columns small-9'>
-                        <TextInput value={spec.schedule} onChange={schedule => onChange({...spec, schedule})} />
-                        <ScheduleValidator schedule={spec.schedule} />
+                {spec.schedules && spec.schedules.length > 0 ? (
+                    spec.schedules.map((schedule, i) => (
+                        <div className='row white-box__details-row' key={i}>
+                            <div className='columns small-3'>{i === 0 && 'Schedules'}</div>
+                            <div className='columns small-9'>
+                                <TextInput
+                                    value={schedule}
+                                    onChange={x =>
+                                        onChange({
+                                            ...spec,
+                                            schedules: spec.schedules.map((y, j) => (i === j ? x : y))
+                                        })
+                                    }
+                                />
+                                <ScheduleValidator schedule={schedule} />
+                            </div>
+                        </div>
+                    ))
+                ) : (
+                    <div className='row white-box__details-row'>
+                        <div className='columns small-3'>Schedule</div>
+                        <div className='columns small-9'>
+                            <TextInput value={spec.schedule} onChange={schedule => onChange({...spec, schedule})} />
+                            <ScheduleValidator schedule={spec.schedule} />
+                        </div>
                     </div>
-                </div>
+                )}
                 <div className='row white-box__details-row'>
                     <div className='columns small-3'>Timezone</div>
                     <div className='columns small-9'>
@@ -3,6 +3,7 @@ import * as React from 'react';
 import {CronWorkflowSpec, CronWorkflowStatus} from '../../../models';
 import {Timestamp} from '../../shared/components/timestamp';
 import {ConditionsPanel} from '../../shared/conditions-panel';
+import {getSchedules} from '../../shared/cron';
 import {WorkflowLink} from '../../workflows/components/workflow-link';
 import {PrettySchedule} from './pretty-schedule';
 
@@ -17,13 +18,25 @@ export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec
                     {title: 'Active', value: status.active ? getCronWorkflowActiveWorkflowList(status.active) : <i>No Workflows Active</i>},
                     {
                         title: 'Schedule',
-                        value: (
-                            <>
-                                <code>{spec.schedule}</code> <PrettySchedule schedule={spec.schedule} />
-                            </>
-                        )
+                        value: getSchedules(spec).map(schedule => (
+                            <div key={schedule}>
+                                <code>{schedule}</code> <PrettySchedule schedule={schedule} />
+                            </div>
+                        ))
                     },
                     {title: 'Last Scheduled Time', value: <Timestamp date={status.lastScheduledTime} />},
+                    {
+                        title: 'Next Scheduled Times',
+                        value: status.nextScheduledTimes ? (
+                            status.nextScheduledTimes.map(time => (
+                                <div key={time}>
+                                    <Timestamp date={time} />
+                                </div>
+                            ))
+                        ) : (
+                            <i>None</i>
+                        )
+                    },
                     {title: 'Conditions', value: <ConditionsPanel conditions={status.conditions} />}
                 ].map(attr => (
                     <div className='row white-box__details-row' key={attr.title}>
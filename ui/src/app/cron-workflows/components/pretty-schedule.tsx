@@ -13,6 +13,10 @@ const x = require('cronstrue');
  */
 
 export const PrettySchedule = ({schedule}: {schedule: string}) => {
+    if (schedule.startsWith('@every ') || schedule.startsWith('R')) {
+        // an interval, which cronstrue does not understand, but is readable as it is
+        return null;
+    }
     try {
         if (schedule.split(' ').length >= 6) {
             throw new Error('cron schedules must consist of 5 values only');
@@ -1,14 +1,32 @@
 import parser = require('cron-parser');
+import {CronWorkflowSpec} from '../../models';
 
-export function getNextScheduledTime(schedule: string, tz: string): Date {
-    let out: Date;
-    try {
-        out = parser
-            .parseExpression(schedule, {utc: !tz, tz})
-            .next()
-            .toDate();
-    } catch (e) {
-        // Do nothing
+// getSchedules returns the schedules of the cron workflow, which is either its schedule or its schedules
+export function getSchedules(spec: CronWorkflowSpec): string[] {
+    return spec.schedule ? [spec.schedule] : spec.schedules || [];
+}
+
+// getNextScheduledTime returns the earliest time that any of the schedules is next due, preferring the times the
+// controller computed, as intervals, e.g. "@every 37m" or "R/PT6H", cannot be computed here
+export function getNextScheduledTime(schedules: string[], tz: string, nextScheduledTimes?: string[]): Date {
+    const now = new Date();
+    const computed = (nextScheduledTimes || []).map(time => new Date(time)).find(time => time > now);
+    if (computed) {
+        return computed;
     }
+    let out: Date;
+    schedules.forEach(schedule => {
+        try {
+            const next = parser
+                .parseExpression(schedule, {utc: !tz, tz})
+                .next()
+                .toDate();
+            if (!out || next < out) {
+                out = next;
+            }
+        } catch (e) {
+            // Do nothing
+        }
+    });
     return out;
 }
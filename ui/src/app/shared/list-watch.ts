@@ -1,6 +1,6 @@
 import * as kubernetes from 'argo-ui/src/models/kubernetes';
 import {Observable} from 'rxjs';
-import {RetryWatch} from './retry-watch';
+import {isExpired, RetryWatch} from './retry-watch';
 
 interface Resource {
     metadata: kubernetes.ObjectMeta;
@@ -15,7 +15,8 @@ export const sortByYouth: Sorter = (a: Resource, b: Resource) => b.metadata.crea
 const reconnectAfterMs = 3000;
 
 /**
- * ListWatch allows you to start watching for changes, automatically reconnecting on error.
+ * ListWatch allows you to start watching for changes, automatically reconnecting on error, and listing again if the
+ * changes since the list are no longer available.
  */
 export class ListWatch<T extends Resource> {
     private readonly list: () => Promise<{metadata: kubernetes.ListMeta; items: T[]}>;
@@ -48,7 +49,13 @@ export class ListWatch<T extends Resource> {
                 this.items = mergeItem(e.object, e.type, this.items).sort(sorter);
                 onChange(this.items, e.object, e.type);
             },
-            onError
+            e => {
+                onError(e);
+                // the changes since the resource version are gone, so we must list again
+                if (isExpired(e)) {
+                    this.start();
+                }
+            }
         );
     }
 
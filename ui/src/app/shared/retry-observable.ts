@@ -37,8 +37,9 @@ export class RetryObservable<E, V> {
             },
             e => {
                 this.stop();
-                this.onError(e);
+                // set before the error is handled, so the handler can stop, or restart, us
                 this.timeout = setTimeout(() => this.start(null), reconnectAfterMs);
+                this.onError(e);
             }
         );
     }
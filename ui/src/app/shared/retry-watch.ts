@@ -7,20 +7,57 @@ interface Resource {
     metadata: kubernetes.ObjectMeta;
 }
 
+/**
+ * parseWatchEvent returns the event in the data of a watch, or throws the error the watch failed with instead.
+ */
+export const parseWatchEvent = <T>(data: string): WatchEvent<T> => {
+    const x = JSON.parse(data);
+    if (x.error) {
+        throw new Error(x.error.message);
+    }
+    return x.result as WatchEvent<T>;
+};
+
+/**
+ * isExpired returns whether the watch failed because the resource version is too old to resume from, so the list
+ * must be loaded again.
+ */
+export const isExpired = (e: Error) => e.message.includes('too old resource version');
+
 /**
  * RetryWatch allows you to watch for changes, automatically reconnecting on error.
  *
+ * It reconnects from the resource version of the last event, or bookmark, so no changes are missed. Bookmarks are not
+ * passed to onEvent.
+ *
  * See @RetryObservable
  */
 export class RetryWatch<T extends Resource> {
     private readonly ro: RetryObservable<WatchEvent<T>, string>;
+    private resourceVersion: string;
 
     constructor(watch: (resourceVersion?: string) => Observable<WatchEvent<T>>, onOpen: () => void, onEvent: (event: WatchEvent<T>) => void, onError: (error: Error) => void) {
-        this.ro = new RetryObservable<kubernetes.WatchEvent<T>, string>(watch, onOpen, onEvent, onError);
+        this.ro = new RetryObservable<kubernetes.WatchEvent<T>, string>(
+            resourceVersion => watch(resourceVersion || this.resourceVersion),
+            onOpen,
+            e => {
+                this.resourceVersion = (e.object && e.object.metadata && e.object.metadata.resourceVersion) || this.resourceVersion;
+                if ((e.type as string) !== 'BOOKMARK') {
+                    onEvent(e);
+                }
+            },
+            e => {
+                if (isExpired(e)) {
+                    this.resourceVersion = null;
+                }
+                onError(e);
+            }
+        );
     }
 
     public start(resourceVersion?: string) {
         this.stop();
+        this.resourceVersion = resourceVersion;
         this.ro.start(resourceVersion);
     }
 
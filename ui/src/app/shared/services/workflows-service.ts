@@ -4,6 +4,7 @@ import * as models from '../../../models';
 import {Event, LogEntry, NodeStatus, Workflow, WorkflowList, WorkflowPhase} from '../../../models';
 import {SubmitOpts} from '../../../models/submit-opts';
 import {Pagination} from '../pagination';
+import {parseWatchEvent} from '../retry-watch';
 import {Utils} from '../utils';
 import requests from './requests';
 import {WorkflowDeleteResponse} from './responses';
@@ -92,8 +93,12 @@ export class WorkflowsService {
             'result.object.spec.suspend'
         ];
         params.push(`fields=${fields.join(',')}`);
+        if (query.resourceVersion) {
+            // bookmarks keep the resource version to resume from up to date, even when no workflows change
+            params.push('listOptions.allowWatchBookmarks=true');
+        }
         const url = `api/v1/workflow-events/${query.namespace || ''}?${params.join('&')}`;
-        return requests.loadEventSource(url).pipe(map(data => data && (JSON.parse(data).result as models.kubernetes.WatchEvent<Workflow>)));
+        return requests.loadEventSource(url).pipe(map(data => data && parseWatchEvent<Workflow>(data)));
     }
 
     public retry(name: string, namespace: string) {
@@ -134,6 +139,17 @@ export class WorkflowsService {
             .then(res => res.body as Workflow);
     }
 
+    public submitWithArtifacts(kind: string, name: string, namespace: string, submitOptions: SubmitOpts, files: {[artifactName: string]: File}) {
+        const req = requests
+            .post(`submit-with-artifacts/${namespace}`)
+            .set('X-Requested-With', 'XMLHttpRequest')
+            .field('resourceKind', kind)
+            .field('resourceName', name)
+            .field('submitOptions', JSON.stringify(submitOptions || {}));
+        Object.entries(files).forEach(([artifactName, file]) => req.attach(artifactName, file));
+        return req.then(res => JSON.parse(res.text) as Workflow);
+    }
+
     public getContainerLogsFromCluster(workflow: Workflow, podName: string, container: string, grep: string): Observable<LogEntry> {
         const namespace = workflow.metadata.namespace;
         const name = workflow.metadata.name;
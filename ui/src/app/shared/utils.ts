@@ -108,6 +108,7 @@ export const Utils = {
         namespace?: string;
         name?: string;
         namePrefix?: string;
+        search?: string;
         phases?: Array<string>;
         labels?: Array<string>;
         minStartedAt?: Date;
@@ -135,6 +136,9 @@ export const Utils = {
         if (filter.namePrefix) {
             queryParams.push(`namePrefix=${filter.namePrefix}`);
         }
+        if (filter.search) {
+            queryParams.push(`search=${encodeURIComponent(filter.search)}`);
+        }
         if (filter.resourceVersion) {
             queryParams.push(`listOptions.resourceVersion=${filter.resourceVersion}`);
         }
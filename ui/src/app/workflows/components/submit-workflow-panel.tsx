@@ -1,5 +1,5 @@
 import * as React from 'react';
-import {Parameter, Template, Workflow} from '../../../models';
+import {Artifact, Parameter, Template, Workflow} from '../../../models';
 import {uiUrl} from '../../shared/base';
 import {ErrorNotice} from '../../shared/components/error-notice';
 import {services} from '../../shared/services';
@@ -14,6 +14,7 @@ interface Props {
     entrypoint: string;
     templates: Template[];
     workflowParameters: Parameter[];
+    workflowArtifacts?: Artifact[];
 }
 
 interface State {
@@ -23,6 +24,7 @@ interface State {
     selectedTemplate: Template;
     templates: Template[];
     labels: string[];
+    files: {[name: string]: File};
     error?: Error;
     isSubmitting: boolean;
 }
@@ -45,6 +47,7 @@ export class SubmitWorkflowPanel extends React.Component<Props, State> {
             parameters: this.props.workflowParameters || [],
             templates: [defaultTemplate].concat(this.props.templates),
             labels: ['submit-from-ui=true'],
+            files: {},
             isSubmitting: false
         };
         this.state = state;
@@ -100,6 +103,30 @@ export class SubmitWorkflowPanel extends React.Component<Props, State> {
                             </>
                         )}
                     </div>
+                    {(this.props.workflowArtifacts || []).length > 0 && (
+                        <div key='artifacts' style={{marginBottom: 25}}>
+                            <label>Artifacts</label>
+                            {this.props.workflowArtifacts.map(artifact => (
+                                <div key={artifact.name} style={{marginBottom: 14}}>
+                                    <label>{artifact.name}</label>
+                                    <input
+                                        type='file'
+                                        className='argo-field'
+                                        onChange={event => {
+                                            const file = event.target.files[0];
+                                            const files = {...this.state.files};
+                                            if (file) {
+                                                files[artifact.name] = file;
+                                            } else {
+                                                delete files[artifact.name];
+                                            }
+                                            this.setState({files});
+                                        }}
+                                    />
+                                </div>
+                            ))}
+                        </div>
+                    )}
                     <div key='labels' style={{marginBottom: 25}}>
                         <label>Labels</label>
                         <TagsInput tags={this.state.labels} onChange={labels => this.setState({labels})} />
@@ -173,12 +200,16 @@ export class SubmitWorkflowPanel extends React.Component<Props, State> {
 
     private submit() {
         this.setState({isSubmitting: true});
-        services.workflows
-            .submit(this.props.kind, this.props.name, this.props.namespace, {
-                entryPoint: this.state.entrypoint === workflowEntrypoint ? null : this.state.entrypoint,
-                parameters: this.state.parameters.filter(p => this.getValue(p) !== undefined).map(p => p.name + '=' + this.getValue(p)),
-                labels: this.state.labels.join(',')
-            })
+        const submitOptions = {
+            entryPoint: this.state.entrypoint === workflowEntrypoint ? null : this.state.entrypoint,
+            parameters: this.state.parameters.filter(p => this.getValue(p) !== undefined).map(p => p.name + '=' + this.getValue(p)),
+            labels: this.state.labels.join(',')
+        };
+        // files can only be uploaded with a multipart request
+        (Object.keys(this.state.files).length > 0
+            ? services.workflows.submitWithArtifacts(this.props.kind, this.props.name, this.props.namespace, submitOptions, this.state.files)
+            : services.workflows.submit(this.props.kind, this.props.name, this.props.namespace, submitOptions)
+        )
             .then((submitted: Workflow) => (document.location.href = uiUrl(`workflows/${submitted.metadata.namespace}/${submitted.metadata.name}`)))
             .catch(error => this.setState({error, isSubmitting: false}));
     }
@@ -11,21 +11,51 @@ export interface CronWorkflow {
 
 export type ConcurrencyPolicy = 'Allow' | 'Forbid' | 'Replace';
 
+export type CatchupPolicy = 'All' | 'Latest' | 'None';
+
+export type DaylightSavingPolicy = 'Skip' | 'Fire';
+
+export interface Catchup {
+    policy?: CatchupPolicy;
+    limit?: number;
+    parameter?: string;
+}
+
+export type PreviousRunPolicy = 'Skip' | 'Delay';
+
+export interface StopStrategy {
+    suspendAfterFailures?: number;
+    expression?: string;
+}
+
 export interface CronWorkflowSpec {
     workflowSpec: WorkflowSpec;
     workflowMetadata?: kubernetes.ObjectMeta;
-    schedule: string;
+    schedule?: string;
+    schedules?: string[];
     concurrencyPolicy?: ConcurrencyPolicy;
     suspend?: boolean;
     startingDeadlineSeconds?: number;
     successfulJobsHistoryLimit?: number;
     failedJobsHistoryLimit?: number;
     timezone?: string;
+    catchup?: Catchup;
+    daylightSavingPolicy?: DaylightSavingPolicy;
+    splay?: string;
+    stopStrategy?: StopStrategy;
+    previousRunPolicy?: PreviousRunPolicy;
+    when?: string;
+    whenConfigMaps?: string[];
 }
 
 export interface CronWorkflowStatus {
     active: kubernetes.ObjectReference[];
     lastScheduledTime: kubernetes.Time;
+    nextScheduledTimes?: kubernetes.Time[];
+    succeeded?: number;
+    failed?: number;
+    consecutiveFailures?: number;
+    delayedScheduledTime?: kubernetes.Time;
     conditions?: Condition[];
 }
 
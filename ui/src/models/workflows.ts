@@ -764,6 +764,11 @@ export interface WorkflowTemplateRef {
      * ClusterScope indicates the referred template is cluster scoped (i.e., a ClusterWorkflowTemplate).
      */
     clusterScope?: boolean;
+
+    /**
+     * Revision pins the revision of the workflow template. If it is not specified, the latest revision is used.
+     */
+    revision?: number;
 }
 
 export interface DAGTemplate {
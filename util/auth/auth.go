@@ -29,3 +29,49 @@ func CanI(ctx context.Context, kubeclientset kubernetes.Interface, verb, resourc
 	logCtx.WithField("status", review.Status).Debug("CanI")
 	return review.Status.Allowed, nil
 }
+
+// CanIImpersonateServiceAccount returns whether the user can impersonate the service account, i.e. act as it
+func CanIImpersonateServiceAccount(ctx context.Context, kubeclientset kubernetes.Interface, namespace, name string) (bool, error) {
+	logCtx := log.WithFields(log.Fields{"namespace": namespace, "name": name})
+	logCtx.Debug("CanIImpersonateServiceAccount")
+
+	review, err := kubeclientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &auth.SelfSubjectAccessReview{
+		Spec: auth.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &auth.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "impersonate",
+				Resource:  "serviceaccounts",
+				Name:      name,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	logCtx.WithField("status", review.Status).Debug("CanIImpersonateServiceAccount")
+	return review.Status.Allowed, nil
+}
+
+// CanIGetPodLogs returns whether the user can get the logs of the pod, or, if the name is empty, of every pod in the
+// namespace
+func CanIGetPodLogs(ctx context.Context, kubeclientset kubernetes.Interface, namespace, name string) (bool, error) {
+	logCtx := log.WithFields(log.Fields{"namespace": namespace, "name": name})
+	logCtx.Debug("CanIGetPodLogs")
+
+	review, err := kubeclientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &auth.SelfSubjectAccessReview{
+		Spec: auth.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &auth.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        name,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	logCtx.WithField("status", review.Status).Debug("CanIGetPodLogs")
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// the most the clock moves back, and how far back to look for the time it did
+const maxDaylightSavingShift = 3 * time.Hour
+
+// daylightSavingSchedule is a schedule in a location, which is due at the times that are skipped and repeated when the
+// clock moves forward and back, as per the policy
+type daylightSavingSchedule struct {
+	// schedule is due at the wall clock times, which exist, in the location
+	schedule cron.Schedule
+	// wallClock is due at the same wall clock times in UTC, which never moves, so includes the times that are skipped
+	wallClock cron.Schedule
+	loc       *time.Location
+	policy    wfv1.DaylightSavingPolicy
+}
+
+func (s daylightSavingSchedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t)
+	switch s.policy {
+	case wfv1.DaylightSavingSkip:
+		for !next.IsZero() && isRepeated(next.In(s.loc)) {
+			next = s.schedule.Next(next)
+		}
+	case wfv1.DaylightSavingFire:
+		until := next
+		if until.IsZero() {
+			until = t.AddDate(5, 0, 0)
+		}
+		if movedForward := s.nextMovedForward(t, until); !movedForward.IsZero() {
+			next = movedForward
+		}
+	}
+	return next
+}
+
+// nextMovedForward returns the first time after t, and not after until, that the clock moved forward past a time the
+// schedule is due at, or the zero time if it did not
+func (s daylightSavingSchedule) nextMovedForward(t, until time.Time) time.Time {
+	for from := t.Truncate(time.Second); from.Before(until); {
+		to := from.Add(24 * time.Hour)
+		if to.After(until) {
+			to = until
+		}
+		_, fromOffset := from.In(s.loc).Zone()
+		_, toOffset := to.In(s.loc).Zone()
+		if toOffset > fromOffset {
+			movedAt := s.offsetChange(from, to)
+			// the wall clock times from before the clock moved, until after, were skipped
+			skippedFrom := wallClockTime(movedAt.In(s.loc)).Add(-time.Duration(toOffset-fromOffset) * time.Second)
+			if movedAt.After(t) && s.wallClock.Next(skippedFrom.Add(-time.Second)).Before(wallClockTime(movedAt.In(s.loc))) {
+				return movedAt
+			}
+		}
+		from = to
+	}
+	return time.Time{}
+}
+
+// offsetChange returns the first second after from, and not after to, that the offset of the location is that of to
+func (s daylightSavingSchedule) offsetChange(from, to time.Time) time.Time {
+	_, toOffset := to.In(s.loc).Zone()
+	lo, hi := from.Unix(), to.Unix()
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if _, offset := time.Unix(mid, 0).In(s.loc).Zone(); offset == toOffset {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return time.Unix(hi, 0)
+}
+
+// isRepeated returns true if the wall clock time of t was also earlier, before the clock moved back
+func isRepeated(t time.Time) bool {
+	_, offset := t.Zone()
+	_, earlierOffset := t.Add(-maxDaylightSavingShift).Zone()
+	if earlierOffset <= offset {
+		return false
+	}
+	// if this was before the clock moved back, it was the same wall clock time
+	_, offset = t.Add(-time.Duration(earlierOffset-offset) * time.Second).Zone()
+	return offset == earlierOffset
+}
+
+// wallClockTime returns the wall clock time of t in UTC
+func wallClockTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
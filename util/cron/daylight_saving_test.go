@@ -0,0 +1,59 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// nextTimes returns the next times the schedule is due at, after the time, in Los Angeles
+func nextTimes(t *testing.T, spec *wfv1.CronWorkflowSpec, after time.Time, n int) []string {
+	schedule, err := ParseCronWorkflowSchedule(&wfv1.CronWorkflow{Spec: *spec})
+	require.NoError(t, err)
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	var times []string
+	for next := after; len(times) < n; {
+		next = schedule.Next(next)
+		times = append(times, next.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+	return times
+}
+
+func TestDaylightSavingSchedule(t *testing.T) {
+	// the clock moved forward from 2am to 3am on 2020-03-08, and back from 2am to 1am on 2020-11-01
+	forward := time.Date(2020, 3, 7, 12, 0, 0, 0, time.UTC)
+	back := time.Date(2020, 10, 31, 12, 0, 0, 0, time.UTC)
+	t.Run("Default", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedule: "30 1,2 * * *", Timezone: "America/Los_Angeles"}
+		assert.Equal(t, []string{"2020-03-08 01:30 PST", "2020-03-09 01:30 PDT"}, nextTimes(t, spec, forward, 2))
+		assert.Equal(t, []string{"2020-11-01 01:30 PDT", "2020-11-01 01:30 PST", "2020-11-01 02:30 PST"}, nextTimes(t, spec, back, 3))
+	})
+	t.Run("Skip", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedule: "30 1,2 * * *", Timezone: "America/Los_Angeles", DaylightSavingPolicy: wfv1.DaylightSavingSkip}
+		assert.Equal(t, []string{"2020-03-08 01:30 PST", "2020-03-09 01:30 PDT"}, nextTimes(t, spec, forward, 2))
+		assert.Equal(t, []string{"2020-11-01 01:30 PDT", "2020-11-01 02:30 PST"}, nextTimes(t, spec, back, 2))
+	})
+	t.Run("Fire", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedule: "30 1,2 * * *", Timezone: "America/Los_Angeles", DaylightSavingPolicy: wfv1.DaylightSavingFire}
+		assert.Equal(t, []string{"2020-03-08 01:30 PST", "2020-03-08 03:00 PDT", "2020-03-09 01:30 PDT"}, nextTimes(t, spec, forward, 3))
+		assert.Equal(t, []string{"2020-11-01 01:30 PDT", "2020-11-01 01:30 PST", "2020-11-01 02:30 PST"}, nextTimes(t, spec, back, 3))
+	})
+	t.Run("FireOnce", func(t *testing.T) {
+		// 2am and 3am are both due when the clock moves forward, so it is only run once
+		spec := &wfv1.CronWorkflowSpec{Schedule: "0 2,3 * * *", Timezone: "America/Los_Angeles", DaylightSavingPolicy: wfv1.DaylightSavingFire}
+		assert.Equal(t, []string{"2020-03-08 03:00 PDT", "2020-03-09 02:00 PDT"}, nextTimes(t, spec, forward, 2))
+	})
+	t.Run("NotSkipped", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedule: "0 12 * * *", Timezone: "America/Los_Angeles", DaylightSavingPolicy: wfv1.DaylightSavingFire}
+		assert.Equal(t, []string{"2020-03-07 12:00 PST", "2020-03-08 12:00 PDT"}, nextTimes(t, spec, forward.Add(-time.Hour), 2))
+	})
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		_, err := ParseCronWorkflowSchedule(&wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedule: "0 12 * * *", Timezone: "Mars/Olympus_Mons", DaylightSavingPolicy: wfv1.DaylightSavingSkip}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,107 @@
+package cron
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	repetitionsRegexp = regexp.MustCompile(`^R(\d*)$`)
+	durationRegexp    = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+// interval is due at a start time, and every period after it, in years, months and days, as per the calendar of its
+// location, plus a duration
+type interval struct {
+	start               time.Time
+	years, months, days int
+	duration            time.Duration
+	loc                 *time.Location
+	// repetitions is the number of times it is due, or -1 if there is no limit
+	repetitions int
+}
+
+// at returns the k-th time it is due, where the 0-th is the start
+func (i interval) at(k int) time.Time {
+	return i.start.In(i.loc).AddDate(k*i.years, k*i.months, k*i.days).Add(time.Duration(k) * i.duration)
+}
+
+func (i interval) Next(t time.Time) time.Time {
+	k := 0
+	if !t.Before(i.start) {
+		// this is exact if there are no years, months, or days, and close if there are
+		approx := time.Duration(i.years)*8766*time.Hour + time.Duration(i.months)*730*time.Hour + time.Duration(i.days)*24*time.Hour + i.duration
+		k = int(t.Sub(i.start) / approx)
+		for k > 0 && i.at(k-1).After(t) {
+			k--
+		}
+		for !i.at(k).After(t) {
+			k++
+		}
+	}
+	if i.repetitions >= 0 && k >= i.repetitions {
+		return time.Time{}
+	}
+	return i.at(k)
+}
+
+// isInterval returns true if the spec, without its timezone, is an interval, rather than in the cron format
+func isInterval(spec string) bool {
+	return strings.HasPrefix(spec, "@every ") || strings.HasPrefix(spec, "R")
+}
+
+// parseInterval parses "@every <duration>", e.g. "@every 37m", or an ISO 8601 repeating interval,
+// "R[n]/[start/]<duration>", e.g. "R/PT6H" or "R5/2021-10-01T00:00:00Z/P1D", which starts at the anchor if it does not
+// have a start
+func parseInterval(spec string, anchor time.Time, loc *time.Location) (interval, error) {
+	i := interval{start: anchor, loc: loc, repetitions: -1}
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return i, err
+		}
+		i.duration = d.Truncate(time.Second)
+		if i.duration <= 0 {
+			return i, fmt.Errorf("@every duration must be at least 1s")
+		}
+		return i, nil
+	}
+	parts := strings.Split(spec, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return i, fmt.Errorf("repeating interval %q must be R[n]/[start/]duration", spec)
+	}
+	repetitions := repetitionsRegexp.FindStringSubmatch(parts[0])
+	if repetitions == nil {
+		return i, fmt.Errorf("repeating interval %q must start with R[n]", spec)
+	}
+	if repetitions[1] != "" {
+		i.repetitions, _ = strconv.Atoi(repetitions[1])
+		if i.repetitions < 1 {
+			return i, fmt.Errorf("repeating interval %q must repeat at least once", spec)
+		}
+	}
+	if len(parts) == 3 {
+		start, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return i, fmt.Errorf("repeating interval %q has an invalid start: %w", spec, err)
+		}
+		i.start = start
+	}
+	d := durationRegexp.FindStringSubmatch(parts[len(parts)-1])
+	if d == nil || strings.HasSuffix(parts[len(parts)-1], "T") {
+		return i, fmt.Errorf("repeating interval %q has an invalid ISO 8601 duration", spec)
+	}
+	n := make([]int, len(d))
+	for j := 1; j < len(d); j++ {
+		n[j], _ = strconv.Atoi(d[j])
+	}
+	i.years, i.months, i.days = n[1], n[2], 7*n[3]+n[4]
+	i.duration = time.Duration(n[5])*time.Hour + time.Duration(n[6])*time.Minute + time.Duration(n[7])*time.Second
+	if i.years == 0 && i.months == 0 && i.days == 0 && i.duration == 0 {
+		return i, fmt.Errorf("repeating interval %q must have a duration of at least 1s", spec)
+	}
+	return i, nil
+}
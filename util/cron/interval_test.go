@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParseInterval(t *testing.T) {
+	anchor := time.Date(2021, 10, 1, 9, 30, 0, 0, time.UTC)
+	next := func(t *testing.T, spec string, after time.Time, n int) []string {
+		i, err := parseInterval(spec, anchor, time.UTC)
+		require.NoError(t, err)
+		var times []string
+		for next := after; len(times) < n; {
+			next = i.Next(next)
+			if next.IsZero() {
+				break
+			}
+			times = append(times, next.Format(time.RFC3339))
+		}
+		return times
+	}
+	t.Run("Every", func(t *testing.T) {
+		assert.Equal(t, []string{"2021-10-01T10:07:00Z", "2021-10-01T10:44:00Z"}, next(t, "@every 37m", anchor.Add(time.Second), 2))
+		// anchored, whenever it is asked
+		assert.Equal(t, []string{"2021-10-02T09:33:00Z"}, next(t, "@every 37m", time.Date(2021, 10, 2, 9, 30, 0, 0, time.UTC), 1))
+	})
+	t.Run("Anchored", func(t *testing.T) {
+		assert.Equal(t, []string{"2021-10-01T09:30:00Z", "2021-10-01T15:30:00Z", "2021-10-01T21:30:00Z"}, next(t, "R/PT6H", anchor.Add(-time.Second), 3))
+	})
+	t.Run("Start", func(t *testing.T) {
+		assert.Equal(t, []string{"2021-11-01T00:00:00Z", "2021-11-02T00:00:00Z"}, next(t, "R/2021-11-01T00:00:00Z/P1D", anchor, 2))
+	})
+	t.Run("Repetitions", func(t *testing.T) {
+		assert.Equal(t, []string{"2021-10-01T09:30:00Z", "2021-10-15T09:30:00Z"}, next(t, "R2/P2W", anchor.Add(-time.Second), 3))
+	})
+	t.Run("Calendar", func(t *testing.T) {
+		assert.Equal(t, []string{"2022-01-31T00:00:00Z", "2022-03-03T00:00:00Z"}, next(t, "R/2021-12-31T00:00:00Z/P1M", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 2))
+		assert.Equal(t, []string{"2031-12-31T00:00:00Z"}, next(t, "R/2021-12-31T00:00:00Z/P1Y", time.Date(2031, 6, 1, 0, 0, 0, 0, time.UTC), 1))
+		assert.Equal(t, []string{"2021-10-02T11:00:00Z"}, next(t, "R/P1DT1H30M", anchor, 1))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for _, spec := range []string{"@every soon", "@every 0s", "R", "R0/PT1H", "Rx/PT1H", "R/PT", "R/P", "R/PT0S", "R/1H", "R/yesterday/PT1H", "R/a/b/c"} {
+			_, err := parseInterval(spec, anchor, time.UTC)
+			assert.Error(t, err, spec)
+		}
+	})
+}
+
+func TestParseCronWorkflowScheduleWithInterval(t *testing.T) {
+	created := time.Date(2021, 10, 1, 9, 30, 0, 0, time.UTC)
+	cwf := &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}, Spec: wfv1.CronWorkflowSpec{Schedules: []string{"R/PT6H", "0 0 * * *"}, Timezone: "Asia/Tokyo"}}
+	schedule, err := ParseCronWorkflowSchedule(cwf)
+	require.NoError(t, err)
+	// midnight in Tokyo
+	assert.Equal(t, time.Date(2021, 10, 1, 15, 0, 0, 0, time.UTC), schedule.Next(created).UTC())
+	// the interval starts when it was created
+	assert.Equal(t, created.Add(6*time.Hour), schedule.Next(time.Date(2021, 10, 1, 15, 0, 0, 0, time.UTC)).UTC())
+}
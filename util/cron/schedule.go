@@ -0,0 +1,120 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// schedules is due whenever any of its schedules is due
+type schedules []cron.Schedule
+
+// Next returns the earliest time after t that any of the schedules is due, so a time more than one of them is due at
+// is only returned once, or the zero time if none of them is due
+func (s schedules) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range s {
+		n := schedule.Next(t)
+		if !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// ParseSchedules parses the schedules, in the standard cron format, or intervals, optionally prefixed with "CRON_TZ=",
+// as one schedule, which is due whenever any of them is. Intervals without a start time start at the Unix epoch.
+func ParseSchedules(specs ...string) (cron.Schedule, error) {
+	return parseSchedules(time.Unix(0, 0), specs...)
+}
+
+// parseSchedules parses the schedules as one schedule, with intervals without a start time starting at the anchor
+func parseSchedules(anchor time.Time, specs ...string) (cron.Schedule, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("there must be at least one schedule")
+	}
+	var s schedules
+	for _, spec := range specs {
+		schedule, err := parseSchedule(spec, anchor)
+		if err != nil && len(specs) > 1 {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		} else if err != nil {
+			return nil, err
+		}
+		s = append(s, schedule)
+	}
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// parseSchedule parses a schedule in the standard cron format, or an interval, as either "@every <duration>" or an ISO
+// 8601 repeating interval
+func parseSchedule(spec string, anchor time.Time) (cron.Schedule, error) {
+	loc := time.Local
+	withoutTimezone := spec
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		i := strings.Index(spec, " ")
+		if i == -1 {
+			return nil, fmt.Errorf("schedule %q must follow its timezone", spec)
+		}
+		var err error
+		loc, err = time.LoadLocation(spec[strings.Index(spec, "=")+1 : i])
+		if err != nil {
+			return nil, err
+		}
+		withoutTimezone = strings.TrimSpace(spec[i:])
+	}
+	if isInterval(withoutTimezone) {
+		return parseInterval(withoutTimezone, anchor, loc)
+	}
+	return cron.ParseStandard(spec)
+}
+
+// ParseCronWorkflowSchedule parses the schedules of the CronWorkflow as one schedule, in its timezone, which is due at
+// the times skipped and repeated when the clock moves as per its daylight saving policy, and after its splay offset
+func ParseCronWorkflowSchedule(cronWf *wfv1.CronWorkflow) (cron.Schedule, error) {
+	anchor := time.Unix(0, 0)
+	if !cronWf.CreationTimestamp.IsZero() {
+		// intervals without a start time start when the CronWorkflow was created
+		anchor = cronWf.CreationTimestamp.Time
+	}
+	schedule, err := parseTimezoneSchedule(&cronWf.Spec, anchor)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := SplayOffset(cronWf)
+	if err != nil || offset == 0 {
+		return schedule, err
+	}
+	return splaySchedule{schedule: schedule, offset: offset}, nil
+}
+
+// parseTimezoneSchedule parses the schedules in the timezone, as per the daylight saving policy
+func parseTimezoneSchedule(spec *wfv1.CronWorkflowSpec, anchor time.Time) (cron.Schedule, error) {
+	schedule, err := parseSchedules(anchor, spec.GetSchedulesWithTimezone()...)
+	if err != nil || spec.DaylightSavingPolicy == "" {
+		return schedule, err
+	}
+	loc := time.Local
+	if spec.Timezone != "" {
+		loc, err = time.LoadLocation(spec.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var wallClockSpecs []string
+	for _, s := range spec.GetSchedules() {
+		wallClockSpecs = append(wallClockSpecs, "CRON_TZ=UTC "+s)
+	}
+	wallClock, err := parseSchedules(anchor, wallClockSpecs...)
+	if err != nil {
+		return nil, err
+	}
+	return daylightSavingSchedule{schedule: schedule, wallClock: wallClock, loc: loc, policy: spec.DaylightSavingPolicy}, nil
+}
@@ -0,0 +1,46 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedules(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		_, err := ParseSchedules()
+		assert.Error(t, err)
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := ParseSchedules("0 * * * *", "not a schedule")
+		assert.EqualError(t, err, `schedule "not a schedule": expected exactly 5 fields, found 3: [not a schedule]`)
+	})
+	t.Run("Schedules", func(t *testing.T) {
+		// hourly on weekdays, plus 6am and 8am on Saturdays, and 6am every day, which overlaps the others
+		schedule, err := ParseSchedules("0 * * * 1-5", "0 6,8 * * 6", "0 6 * * *")
+		require.NoError(t, err)
+		// Friday 10pm
+		next := time.Date(2021, 10, 1, 22, 0, 0, 0, time.UTC)
+		var times []string
+		for i := 0; i < 6; i++ {
+			next = schedule.Next(next)
+			times = append(times, next.Format("Mon 15:04"))
+		}
+		assert.Equal(t, []string{"Fri 23:00", "Sat 06:00", "Sat 08:00", "Sun 06:00", "Mon 00:00", "Mon 01:00"}, times)
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		schedule, err := ParseSchedules("CRON_TZ=Asia/Tokyo 0 9 * * *", "CRON_TZ=Asia/Tokyo 0 18 * * *")
+		require.NoError(t, err)
+		// 10am in Tokyo
+		next := schedule.Next(time.Date(2021, 10, 1, 1, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2021, 10, 1, 9, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("Interval", func(t *testing.T) {
+		// anchored at the Unix epoch
+		schedule, err := ParseSchedules("CRON_TZ=Asia/Tokyo @every 7h")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 10, 1, 4, 0, 0, 0, time.UTC), schedule.Next(time.Date(2021, 10, 1, 1, 0, 0, 0, time.UTC)).UTC())
+	})
+}
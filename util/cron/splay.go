@@ -0,0 +1,48 @@
+package cron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// splaySchedule is due a fixed offset after the times another schedule is due
+type splaySchedule struct {
+	schedule cron.Schedule
+	offset   time.Duration
+}
+
+func (s splaySchedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t.Add(-s.offset))
+	if next.IsZero() {
+		return next
+	}
+	return next.Add(s.offset)
+}
+
+// SplayOffset returns how long after the times it is scheduled for the CronWorkflow is run, which is within its splay,
+// to the second, and always the same for the same CronWorkflow, so CronWorkflows scheduled for the same time are run
+// spread out over the splay
+func SplayOffset(cronWf *wfv1.CronWorkflow) (time.Duration, error) {
+	if cronWf.Spec.Splay == "" {
+		return 0, nil
+	}
+	splay, err := wfv1.ParseStringToDuration(cronWf.Spec.Splay)
+	if err != nil {
+		return 0, err
+	}
+	if splay < 0 {
+		return 0, fmt.Errorf("splay must not be negative")
+	}
+	seconds := int64(splay / time.Second)
+	if seconds == 0 {
+		return 0, nil
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(cronWf.Namespace + "/" + cronWf.Name))
+	return time.Duration(int64(h.Sum32())%seconds) * time.Second, nil
+}
@@ -0,0 +1,64 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestSplayOffset(t *testing.T) {
+	cronWf := func(name, splay string) *wfv1.CronWorkflow {
+		return &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: name}, Spec: wfv1.CronWorkflowSpec{Schedule: "0 0 * * *", Splay: splay}}
+	}
+	t.Run("None", func(t *testing.T) {
+		offset, err := SplayOffset(cronWf("my-cwf", ""))
+		require.NoError(t, err)
+		assert.Zero(t, offset)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := SplayOffset(cronWf("my-cwf", "soon"))
+		assert.Error(t, err)
+		_, err = SplayOffset(cronWf("my-cwf", "-5m"))
+		assert.EqualError(t, err, "splay must not be negative")
+	})
+	t.Run("Splay", func(t *testing.T) {
+		offsets := map[time.Duration]bool{}
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			offset, err := SplayOffset(cronWf(name, "10m"))
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, offset, time.Duration(0))
+			assert.Less(t, offset, 10*time.Minute)
+			assert.Equal(t, offset.Truncate(time.Second), offset)
+			// always the same for the same CronWorkflow
+			again, _ := SplayOffset(cronWf(name, "10m"))
+			assert.Equal(t, offset, again)
+			offsets[offset] = true
+		}
+		assert.Greater(t, len(offsets), 1)
+	})
+	t.Run("Seconds", func(t *testing.T) {
+		offset, err := SplayOffset(cronWf("my-cwf", "600"))
+		require.NoError(t, err)
+		assert.Less(t, offset, 10*time.Minute)
+	})
+}
+
+func TestParseCronWorkflowScheduleWithSplay(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "my-cwf"}, Spec: wfv1.CronWorkflowSpec{Schedule: "0 0 * * *", Timezone: "UTC", Splay: "1h"}}
+	offset, err := SplayOffset(cwf)
+	require.NoError(t, err)
+	require.NotZero(t, offset)
+	schedule, err := ParseCronWorkflowSchedule(cwf)
+	require.NoError(t, err)
+	midnight := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	next := schedule.Next(midnight.Add(-time.Minute))
+	assert.Equal(t, midnight.Add(offset), next.UTC())
+	// the next one is the next day, not the same one again
+	assert.Equal(t, midnight.AddDate(0, 0, 1).Add(offset), schedule.Next(next).UTC())
+	assert.Equal(t, next, schedule.Next(midnight))
+}
@@ -0,0 +1,104 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3"
+)
+
+var (
+	mu        sync.Mutex
+	reporters = map[string]func() interface{}{}
+)
+
+// Register adds the state returned by the function to the dump, under the name, e.g. "controller"
+func Register(name string, report func() interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+	reporters[name] = report
+}
+
+// Enable serves the dump at /diagnostics, on the default mux, alongside the pprof endpoints
+func Enable() {
+	log.Info("enabling diagnostics endpoint")
+	http.HandleFunc("/diagnostics", Handler)
+}
+
+type Memory struct {
+	// HeapAlloc is the bytes of live, and not yet collected, heap objects
+	HeapAlloc uint64 `json:"heapAlloc"`
+	// HeapInuse is the bytes of heap spans in use
+	HeapInuse   uint64 `json:"heapInuse"`
+	HeapObjects uint64 `json:"heapObjects"`
+	// Sys is the bytes obtained from the OS
+	Sys          uint64        `json:"sys"`
+	NumGC        uint32        `json:"numGC"`
+	PauseTotal   time.Duration `json:"pauseTotal"`
+	LastGC       time.Time     `json:"lastGC,omitempty"`
+	NextGCTarget uint64        `json:"nextGCTarget"`
+}
+
+type Runtime struct {
+	Version    string `json:"version"`
+	GoVersion  string `json:"goVersion"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	Goroutines int    `json:"goroutines"`
+	Memory     Memory `json:"memory"`
+}
+
+type Dump struct {
+	Time    time.Time `json:"time"`
+	Runtime Runtime   `json:"runtime"`
+	// Components are the states of each registered component
+	Components map[string]interface{} `json:"components"`
+}
+
+// Collect returns a dump of the runtime, and the state of each registered component
+func Collect() Dump {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	d := Dump{
+		Time: time.Now().UTC(),
+		Runtime: Runtime{
+			Version:    argo.GetVersion().Version,
+			GoVersion:  runtime.Version(),
+			GOMAXPROCS: runtime.GOMAXPROCS(0),
+			Goroutines: runtime.NumGoroutine(),
+			Memory: Memory{
+				HeapAlloc:    m.HeapAlloc,
+				HeapInuse:    m.HeapInuse,
+				HeapObjects:  m.HeapObjects,
+				Sys:          m.Sys,
+				NumGC:        m.NumGC,
+				PauseTotal:   time.Duration(m.PauseTotalNs),
+				NextGCTarget: m.NextGC,
+			},
+		},
+		Components: map[string]interface{}{},
+	}
+	if m.LastGC > 0 {
+		d.Runtime.Memory.LastGC = time.Unix(0, int64(m.LastGC)).UTC()
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for name, report := range reporters {
+		d.Components[name] = report()
+	}
+	return d
+}
+
+// Handler responds with the dump, as JSON
+func Handler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(Collect()); err != nil {
+		log.WithError(err).Error("failed to write diagnostics")
+	}
+}
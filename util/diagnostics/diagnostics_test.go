@@ -0,0 +1,36 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollect(t *testing.T) {
+	Register("my-component", func() interface{} { return map[string]int{"my-queue": 1} })
+	defer func() { delete(reporters, "my-component") }()
+	d := Collect()
+	assert.NotEmpty(t, d.Runtime.GoVersion)
+	assert.Greater(t, d.Runtime.Goroutines, 0)
+	assert.Greater(t, d.Runtime.Memory.Sys, uint64(0))
+	assert.Equal(t, map[string]int{"my-queue": 1}, d.Components["my-component"])
+}
+
+func TestHandler(t *testing.T) {
+	Register("my-component", func() interface{} { return map[string]int{"my-queue": 1} })
+	defer func() { delete(reporters, "my-component") }()
+	w := httptest.NewRecorder()
+	Handler(w, httptest.NewRequest(http.MethodGet, "/diagnostics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var d struct {
+		Runtime    map[string]interface{}    `json:"runtime"`
+		Components map[string]map[string]int `json:"components"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &d))
+	assert.Contains(t, d.Runtime, "goroutines")
+	assert.Equal(t, 1, d.Components["my-component"]["my-queue"])
+}
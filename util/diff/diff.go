@@ -2,6 +2,9 @@ package diff
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -16,3 +19,103 @@ func LogChanges(old, new interface{}) {
 	patch, _ := jsonpatch.CreateMergePatch(a, b)
 	log.Debugf("Log changes patch: %s", string(patch))
 }
+
+// Change is a field whose value differs. Old and New are the values as JSON, and are empty if the field is missing.
+type Change struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// Changes returns the fields that differ between the JSON representations of old and new, sorted by path.
+// Objects are compared field by field, and lists item by item, so the paths are of the form "a.b[0].c".
+func Changes(old, new interface{}) ([]Change, error) {
+	a, err := toJSONValue(old)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toJSONValue(new)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	if err := walk("", a, b, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	return out, json.Unmarshal(data, &out)
+}
+
+func walk(path string, old, new interface{}, changes *[]Change) error {
+	switch o := old.(type) {
+	case map[string]interface{}:
+		if n, ok := new.(map[string]interface{}); ok {
+			keys := make(map[string]bool)
+			for k := range o {
+				keys[k] = true
+			}
+			for k := range n {
+				keys[k] = true
+			}
+			sorted := make([]string, 0, len(keys))
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				p := k
+				if path != "" {
+					p = path + "." + k
+				}
+				if err := walk(p, o[k], n[k], changes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if n, ok := new.([]interface{}); ok {
+			for i := 0; i < len(o) || i < len(n); i++ {
+				var x, y interface{}
+				if i < len(o) {
+					x = o[i]
+				}
+				if i < len(n) {
+					y = n[i]
+				}
+				if err := walk(fmt.Sprintf("%s[%d]", path, i), x, y, changes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if reflect.DeepEqual(old, new) {
+		return nil
+	}
+	change := Change{Path: path}
+	if old != nil {
+		data, err := json.Marshal(old)
+		if err != nil {
+			return err
+		}
+		change.Old = string(data)
+	}
+	if new != nil {
+		data, err := json.Marshal(new)
+		if err != nil {
+			return err
+		}
+		change.New = string(data)
+	}
+	*changes = append(*changes, change)
+	return nil
+}
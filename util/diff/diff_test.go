@@ -0,0 +1,33 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanges(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		changes, err := Changes(map[string]interface{}{"a": 1, "b": []string{"x"}}, map[string]interface{}{"a": 1, "b": []string{"x"}})
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+	t.Run("Different", func(t *testing.T) {
+		changes, err := Changes(
+			map[string]interface{}{"a": 1, "b": []interface{}{map[string]string{"name": "p", "value": "1"}}, "c": "removed"},
+			map[string]interface{}{"a": 2, "b": []interface{}{map[string]string{"name": "p", "value": "2"}, "added"}},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []Change{
+			{Path: "a", Old: "1", New: "2"},
+			{Path: "b[0].value", Old: `"1"`, New: `"2"`},
+			{Path: "b[1]", New: `"added"`},
+			{Path: "c", Old: `"removed"`},
+		}, changes)
+	})
+	t.Run("DifferentTypes", func(t *testing.T) {
+		changes, err := Changes(map[string]interface{}{"a": []int{1}}, map[string]interface{}{"a": map[string]int{"b": 1}})
+		assert.NoError(t, err)
+		assert.Equal(t, []Change{{Path: "a", Old: "[1]", New: `{"b":1}`}}, changes)
+	})
+}
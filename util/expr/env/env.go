@@ -6,6 +6,7 @@ import (
 	sprig "github.com/Masterminds/sprig/v3"
 	exprpkg "github.com/argoproj/pkg/expr"
 
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/expand"
 )
 
@@ -21,7 +22,17 @@ func GetFuncMap(m map[string]interface{}) map[string]interface{} {
 	for k, v := range exprpkg.GetExprEnvFunctionMap() {
 		env[k] = v
 	}
+	for _, name := range sprigFunctions {
+		// variables take precedence, so a new function cannot change what an existing expression means
+		if _, ok := env[name]; !ok {
+			env[name] = sprigFuncMap[name]
+		}
+	}
 	env["toJson"] = toJson
+	env["exists"] = exists
+	env["jsonpath"] = jsonpath
+	env["regexCapture"] = regexCapture
+	env["regexCaptureNamed"] = regexCaptureNamed
 	env["sprig"] = sprigFuncMap
 	return env
 }
@@ -33,3 +44,19 @@ func toJson(v interface{}) string {
 	}
 	return string(output)
 }
+
+// exists returns whether or not an artifact was saved, e.g. an optional output artifact, or a parameter is not empty
+func exists(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return false
+	case wfv1.Artifact:
+		return x.HasLocationOrKey()
+	case *wfv1.Artifact:
+		return x != nil && x.HasLocationOrKey()
+	case string:
+		return x != ""
+	default:
+		return true
+	}
+}
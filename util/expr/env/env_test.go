@@ -0,0 +1,39 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/antonmedv/expr"
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestExists(t *testing.T) {
+	saved := wfv1.Artifact{Name: "report", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "report.tgz"}}}
+	missing := wfv1.Artifact{Name: "report", Optional: true}
+	assert.True(t, exists(saved))
+	assert.True(t, exists(&saved))
+	assert.False(t, exists(missing))
+	assert.False(t, exists(&missing))
+	assert.False(t, exists((*wfv1.Artifact)(nil)))
+	assert.False(t, exists(nil))
+	assert.False(t, exists(""))
+	assert.True(t, exists("foo"))
+	assert.True(t, exists(1))
+}
+
+func TestGetFuncMap(t *testing.T) {
+	env := GetFuncMap(map[string]interface{}{
+		"tasks.gen.outputs.artifacts.report": wfv1.Artifact{Name: "report", Optional: true},
+		"tasks.gen.outputs.parameters.count": "1",
+	})
+	result, err := expr.Eval("exists(tasks.gen.outputs.artifacts.report) ? 'report' : 'none'", env)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "none", result)
+	}
+	result, err = expr.Eval("exists(tasks.gen.outputs.parameters.count)", env)
+	if assert.NoError(t, err) {
+		assert.Equal(t, true, result)
+	}
+}
@@ -0,0 +1,61 @@
+package env
+
+import (
+	"encoding/json"
+	"regexp"
+
+	exprpkg "github.com/argoproj/pkg/expr"
+)
+
+// sprigFunctions are the sprig functions that may be called without the `sprig.` prefix. They are a curated set of
+// string, and date, functions whose names do not clash with expr's operators, or the variables of a workflow, e.g.
+// `duration`, which is the duration of a step in a metric, so Sprig's `duration` needs the prefix
+var sprigFunctions = []string{
+	// strings
+	"abbrev", "b64dec", "b64enc", "camelcase", "default", "empty", "indent", "join", "kebabcase", "lower", "nospace",
+	"quote", "repeat", "replace", "sha256sum", "snakecase", "splitList", "squote", "substr", "title", "trim",
+	"trimAll", "trimPrefix", "trimSuffix", "trunc", "upper",
+	// dates
+	"ago", "date", "dateInZone", "dateModify", "durationRound", "htmlDate", "now", "toDate", "unixEpoch",
+}
+
+// regexCapture returns the capture groups of the first match of the regular expression, or an empty list if there is
+// no match, e.g. `regexCapture('v(\\d+)\\.(\\d+)', 'v1.2')` is `["1", "2"]`
+func regexCapture(pattern, s string) []string {
+	match := regexp.MustCompile(pattern).FindStringSubmatch(s)
+	if match == nil {
+		return []string{}
+	}
+	return match[1:]
+}
+
+// regexCaptureNamed returns the named capture groups of the first match of the regular expression, or an empty map
+// if there is no match, e.g. `regexCaptureNamed('v(?P<major>\\d+)', 'v1').major` is `"1"`
+func regexCaptureNamed(pattern, s string) map[string]string {
+	r := regexp.MustCompile(pattern)
+	groups := map[string]string{}
+	match := r.FindStringSubmatch(s)
+	if match == nil {
+		return groups
+	}
+	for i, name := range r.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+	return groups
+}
+
+// jsonpath extracts the value at the path of JSON, which may be a string, or a value that has already been parsed,
+// e.g. by `sprig.fromJson`
+func jsonpath(v interface{}, path string) interface{} {
+	s, ok := v.(string)
+	if !ok {
+		data, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
+		}
+		s = string(data)
+	}
+	return exprpkg.JsonPath(s, path)
+}
@@ -0,0 +1,68 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/antonmedv/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexCapture(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, regexCapture(`v(\d+)\.(\d+)`, "release v1.2"))
+	assert.Equal(t, []string{}, regexCapture(`v(\d+)`, "release"))
+	assert.Panics(t, func() { regexCapture(`(`, "") })
+}
+
+func TestRegexCaptureNamed(t *testing.T) {
+	assert.Equal(t, map[string]string{"major": "1", "minor": "2"}, regexCaptureNamed(`v(?P<major>\d+)\.(?P<minor>\d+)(\.\d+)?`, "v1.2.3"))
+	assert.Equal(t, map[string]string{}, regexCaptureNamed(`v(?P<major>\d+)`, "release"))
+}
+
+func TestJsonpath(t *testing.T) {
+	assert.Equal(t, "bar", jsonpath(`{"foo": "bar"}`, "$.foo"))
+	assert.Equal(t, "bar", jsonpath(map[string]interface{}{"foo": "bar"}, "$.foo"))
+	assert.Panics(t, func() { jsonpath(`{`, "$.foo") })
+}
+
+func TestFunctions(t *testing.T) {
+	env := GetFuncMap(map[string]interface{}{
+		"inputs.parameters.version": "v1.2",
+		"inputs.parameters.json":    `{"foo": {"bar": "baz"}}`,
+	})
+	for expression, expected := range map[string]interface{}{
+		`regexCapture('v(\\d+)\\.(\\d+)', inputs.parameters.version)[1]`:         "2",
+		`regexCaptureNamed('v(?P<major>\\d+)', inputs.parameters.version).major`: "1",
+		`jsonpath(inputs.parameters.json, '$.foo.bar')`:                          "baz",
+		`jsonpath(sprig.fromJson(inputs.parameters.json), '$.foo.bar')`:          "baz",
+		`upper(trimPrefix('v', inputs.parameters.version))`:                      "1.2",
+		`default('foo', '')`: "foo",
+		`date('2006-01-02', toDate('2006-01-02T15:04:05Z07:00', '2021-03-04T05:06:07Z'))`: "2021-03-04",
+	} {
+		result, err := expr.Eval(expression, env)
+		if assert.NoError(t, err, expression) {
+			assert.Equal(t, expected, result, expression)
+		}
+	}
+	_, err := expr.Eval(`regexCapture('(', inputs.parameters.version)`, env)
+	assert.Error(t, err)
+	t.Run("Variables", func(t *testing.T) {
+		env := GetFuncMap(map[string]interface{}{"duration": "1.5", "title": "my-title"})
+		for expression, expected := range map[string]interface{}{
+			`duration`:              "1.5",
+			`asFloat(duration) > 1`: true,
+			`title`:                 "my-title",
+			`sprig.duration('90')`:  "1m30s",
+		} {
+			result, err := expr.Eval(expression, env)
+			if assert.NoError(t, err, expression) {
+				assert.Equal(t, expected, result, expression)
+			}
+		}
+	})
+}
+
+func TestSprigFunctions(t *testing.T) {
+	for _, name := range sprigFunctions {
+		assert.NotNil(t, sprigFuncMap[name], name)
+	}
+}
@@ -29,6 +29,9 @@ func TranslateError(err error) error {
 		return status.Error(codes.PermissionDenied, err.Error())
 	case apierr.IsTimeout(err):
 		return status.Error(codes.DeadlineExceeded, err.Error())
+	case apierr.IsResourceExpired(err), apierr.IsGone(err):
+		// the resource version is too old to watch from, so the client must list again
+		return status.Error(codes.OutOfRange, err.Error())
 	case apierr.IsInternalError(err):
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"net"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// SourceIP returns the address of the client, as forwarded by the gateway for HTTP requests
+func SourceIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("x-forwarded-for") {
+			// the first address is the original client
+			return strings.TrimSpace(strings.Split(v, ",")[0])
+		}
+	}
+	return peerIP(ctx)
+}
+
+// ClientIP returns the address of the client, which, unlike SourceIP, the client cannot spoof. It is the peer's
+// address, unless the peer is the gateway, i.e. a loopback address, or one of the trusted proxies. Then, it is the
+// address the peer received the request from, which the peer appended to X-Forwarded-For, and so on.
+func ClientIP(ctx context.Context, trustedProxies []*net.IPNet) string {
+	addr := peerIP(ctx)
+	var forwarded []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("x-forwarded-for") {
+			for _, a := range strings.Split(v, ",") {
+				forwarded = append(forwarded, strings.TrimSpace(a))
+			}
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0 && isTrustedProxy(addr, trustedProxies); i-- {
+		addr = forwarded[i]
+	}
+	return addr
+}
+
+func isTrustedProxy(addr string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func peerIP(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package logs
+
+import (
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The fields that correlate log entries with the workflow, and node, they are about. The controller, server and
+// executor all use them, so log pipelines can correlate their entries, e.g. when they are formatted as JSON, without
+// parsing messages.
+const (
+	FieldNamespace = "namespace"
+	FieldWorkflow  = "workflow"
+	FieldUID       = "uid"
+	FieldNodeID    = "nodeID"
+)
+
+// WorkflowFields returns the fields of a workflow, without its UID if it does not have one
+func WorkflowFields(namespace, name string, uid types.UID) log.Fields {
+	fields := log.Fields{FieldNamespace: namespace, FieldWorkflow: name}
+	if uid != "" {
+		fields[FieldUID] = string(uid)
+	}
+	return fields
+}
+
+// fieldsHook adds its fields to every entry that does not already have them
+type fieldsHook log.Fields
+
+func (h fieldsHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h fieldsHook) Fire(entry *log.Entry) error {
+	// the data of the entry is shared with the entry it was logged from, so it is copied, rather than changed
+	data := make(log.Fields, len(entry.Data)+len(h))
+	for k, v := range h {
+		data[k] = v
+	}
+	for k, v := range entry.Data {
+		data[k] = v
+	}
+	entry.Data = data
+	return nil
+}
+
+// AddFieldsHook adds the fields to every entry of the standard logger, for a process that only works on one workflow,
+// or node, such as the executor
+func AddFieldsHook(fields log.Fields) {
+	for k, v := range fields {
+		if v == "" {
+			delete(fields, k)
+		}
+	}
+	log.AddHook(fieldsHook(fields))
+}
@@ -0,0 +1,34 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowFields(t *testing.T) {
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf"}, WorkflowFields("my-ns", "my-wf", ""))
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf", "uid": "my-uid"}, WorkflowFields("my-ns", "my-wf", "my-uid"))
+}
+
+func TestFieldsHook(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := log.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&log.JSONFormatter{})
+	logger.AddHook(fieldsHook{FieldWorkflow: "my-wf", FieldNodeID: "my-node"})
+
+	entry := logger.WithField(FieldNodeID, "other-node")
+	entry.Info("hello")
+	assert.Equal(t, log.Fields{FieldNodeID: "other-node"}, entry.Data, "the data of the entry is not changed")
+
+	data := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &data))
+	assert.Equal(t, "my-wf", data[FieldWorkflow])
+	assert.Equal(t, "other-node", data[FieldNodeID])
+	assert.Equal(t, "hello", data["msg"])
+}
@@ -0,0 +1,69 @@
+package logs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// requestFields returns the fields of the workflow, and node, that a request is about, if any
+func requestFields(fullMethod string, req interface{}) log.Fields {
+	fields := log.Fields{}
+	if r, ok := req.(interface{ GetNamespace() string }); ok && r.GetNamespace() != "" {
+		fields[FieldNamespace] = r.GetNamespace()
+	}
+	switch {
+	case strings.HasPrefix(fullMethod, "/workflow.WorkflowService/"):
+		if r, ok := req.(interface{ GetName() string }); ok && r.GetName() != "" {
+			fields[FieldWorkflow] = r.GetName()
+		} else if r, ok := req.(interface{ GetWorkflow() *wfv1.Workflow }); ok && r.GetWorkflow() != nil && r.GetWorkflow().Name != "" {
+			fields[FieldWorkflow] = r.GetWorkflow().Name
+		}
+		if r, ok := req.(interface{ GetNodeId() string }); ok && r.GetNodeId() != "" {
+			fields[FieldNodeID] = r.GetNodeId()
+		}
+	case strings.HasPrefix(fullMethod, "/workflowarchive.ArchivedWorkflowService/"):
+		if r, ok := req.(interface{ GetUid() string }); ok && r.GetUid() != "" {
+			fields[FieldUID] = r.GetUid()
+		}
+	}
+	return fields
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that adds the fields of the workflow a request is
+// about to the log entry of the request. It must come after the logging interceptor
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctxlogrus.AddFields(ctx, requestFields(info.FullMethod, req))
+		return handler(ctx, req)
+	}
+}
+
+// fieldsServerStream adds the fields of the first message it receives to the log entry of its request
+type fieldsServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	received   bool
+}
+
+func (s *fieldsServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		ctxlogrus.AddFields(s.Context(), requestFields(s.fullMethod, m))
+	}
+	return err
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that adds the fields of the workflow a request is
+// about to the log entry of the request. It must come after the logging interceptor
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &fieldsServerStream{ServerStream: stream, fullMethod: info.FullMethod})
+	}
+}
@@ -0,0 +1,58 @@
+package logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s testServerStream) RecvMsg(m interface{}) error {
+	m.(*workflowpkg.WatchWorkflowsRequest).Namespace = "my-ns"
+	return nil
+}
+
+func TestRequestFields(t *testing.T) {
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf"}, requestFields("/workflow.WorkflowService/GetWorkflow", &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf"}))
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf"}, requestFields("/workflow.WorkflowService/CreateWorkflow", &workflowpkg.WorkflowCreateRequest{Namespace: "my-ns", Workflow: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}}))
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf", "nodeID": "my-node"}, requestFields("/workflow.WorkflowService/PodLogs", &workflowpkg.WorkflowNodePodRequest{Namespace: "my-ns", Name: "my-wf", NodeId: "my-node"}))
+	assert.Equal(t, log.Fields{"uid": "my-uid"}, requestFields("/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflow", &workflowarchive.GetArchivedWorkflowRequest{Uid: "my-uid"}))
+	assert.Equal(t, log.Fields{}, requestFields("/info.InfoService/GetInfo", nil))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	entry := log.NewEntry(log.New())
+	ctx := ctxlogrus.ToContext(context.Background(), entry)
+	_, err := UnaryServerInterceptor()(ctx, &workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf"}, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/GetWorkflow"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, log.Fields{"namespace": "my-ns", "workflow": "my-wf"}, ctxlogrus.Extract(ctx).Data)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	entry := log.NewEntry(log.New())
+	ctx := ctxlogrus.ToContext(context.Background(), entry)
+	err := StreamServerInterceptor()(nil, testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/workflow.WorkflowService/WatchWorkflows"}, func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&workflowpkg.WatchWorkflowsRequest{})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, log.Fields{"namespace": "my-ns"}, ctxlogrus.Extract(ctx).Data)
+}
@@ -0,0 +1,133 @@
+package logs
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The components whose log levels can be set independently of the rest of the controller
+const (
+	// ComponentController is the rest of the controller, i.e. the standard logger
+	ComponentController = "controller"
+	// ComponentPodReconciliation is the reconciliation of workflows' nodes with their pods
+	ComponentPodReconciliation = "podReconciliation"
+	// ComponentAgent is the agent pods of workflows, which are only affected when they are created
+	ComponentAgent = "agent"
+)
+
+var components = map[string]bool{ComponentController: true, ComponentPodReconciliation: true, ComponentAgent: true}
+
+// Levels are the level of every component, and the levels of the components that differ from it
+type Levels struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components,omitempty"`
+}
+
+var (
+	levelsMu sync.Mutex
+	// levels are the levels of the components that have their own level
+	levels = map[string]log.Level{}
+	// defaultLevel is the level of the other components, or nil if it has not been set, and they have the level of the
+	// standard logger
+	defaultLevel *log.Level
+	// loggers are the loggers of the components, created when first used
+	loggers = map[string]*log.Logger{}
+)
+
+// ComponentLogger returns the logger of the component. It writes to, and formats and fires the hooks of, the standard
+// logger, but has its own level.
+func ComponentLogger(component string) *log.Logger {
+	if component == ComponentController {
+		return log.StandardLogger()
+	}
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	l, ok := loggers[component]
+	if !ok {
+		std := log.StandardLogger()
+		l = &log.Logger{
+			Out:          writerFunc(func(p []byte) (int, error) { return log.StandardLogger().Out.Write(p) }),
+			Formatter:    formatterFunc(func(e *log.Entry) ([]byte, error) { return log.StandardLogger().Formatter.Format(e) }),
+			Hooks:        std.Hooks,
+			ReportCaller: std.ReportCaller,
+			ExitFunc:     std.ExitFunc,
+		}
+		l.SetLevel(componentLevel(component))
+		loggers[component] = l
+	}
+	return l
+}
+
+// ComponentLevel returns the level of the component
+func ComponentLevel(component string) log.Level {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	return componentLevel(component)
+}
+
+func componentLevel(component string) log.Level {
+	if level, ok := levels[component]; ok {
+		return level
+	}
+	if defaultLevel != nil {
+		return *defaultLevel
+	}
+	return log.GetLevel()
+}
+
+// SetLevels sets the level of every component, except those with their own level. Nothing is changed if any level is
+// invalid.
+func SetLevels(l Levels) error {
+	level, err := log.ParseLevel(l.Level)
+	if err != nil {
+		return err
+	}
+	x := map[string]log.Level{}
+	for component, s := range l.Components {
+		if !components[component] {
+			return fmt.Errorf("unknown log component %q, must be one of %q, %q, or %q", component, ComponentController, ComponentPodReconciliation, ComponentAgent)
+		}
+		if x[component], err = log.ParseLevel(s); err != nil {
+			return fmt.Errorf("component %q: %w", component, err)
+		}
+	}
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	levels = x
+	defaultLevel = &level
+	log.SetLevel(componentLevel(ComponentController))
+	for component, logger := range loggers {
+		logger.SetLevel(componentLevel(component))
+	}
+	return nil
+}
+
+// GetLevels returns the current levels
+func GetLevels() Levels {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	l := Levels{Level: log.GetLevel().String()}
+	if defaultLevel != nil {
+		l.Level = defaultLevel.String()
+	}
+	for component, level := range levels {
+		if l.Components == nil {
+			l.Components = map[string]string{}
+		}
+		l.Components[component] = level.String()
+	}
+	return l
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+var _ io.Writer = writerFunc(nil)
+
+type formatterFunc func(e *log.Entry) ([]byte, error)
+
+func (f formatterFunc) Format(e *log.Entry) ([]byte, error) { return f(e) }
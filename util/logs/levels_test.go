@@ -0,0 +1,63 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func resetLevels(t *testing.T) {
+	level := log.GetLevel()
+	t.Cleanup(func() {
+		levelsMu.Lock()
+		defer levelsMu.Unlock()
+		levels = map[string]log.Level{}
+		defaultLevel = nil
+		loggers = map[string]*log.Logger{}
+		log.SetLevel(level)
+	})
+}
+
+func TestSetLevels(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		resetLevels(t)
+		assert.NoError(t, SetLevels(Levels{Level: "warn"}))
+		assert.Equal(t, log.WarnLevel, log.GetLevel())
+		assert.Equal(t, log.WarnLevel, ComponentLevel(ComponentPodReconciliation))
+		assert.Equal(t, log.WarnLevel, ComponentLevel(ComponentAgent))
+		assert.Equal(t, Levels{Level: "warning"}, GetLevels())
+	})
+	t.Run("Components", func(t *testing.T) {
+		resetLevels(t)
+		logger := ComponentLogger(ComponentPodReconciliation)
+		assert.NoError(t, SetLevels(Levels{Level: "info", Components: map[string]string{ComponentController: "error", ComponentPodReconciliation: "debug"}}))
+		assert.Equal(t, log.ErrorLevel, log.GetLevel())
+		assert.Equal(t, log.DebugLevel, logger.GetLevel())
+		assert.Equal(t, log.InfoLevel, ComponentLevel(ComponentAgent))
+		assert.Equal(t, Levels{Level: "info", Components: map[string]string{ComponentController: "error", ComponentPodReconciliation: "debug"}}, GetLevels())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		resetLevels(t)
+		assert.NoError(t, SetLevels(Levels{Level: "info"}))
+		assert.Error(t, SetLevels(Levels{Level: "loud"}))
+		assert.EqualError(t, SetLevels(Levels{Level: "debug", Components: map[string]string{"executor": "debug"}}), `unknown log component "executor", must be one of "controller", "podReconciliation", or "agent"`)
+		assert.Error(t, SetLevels(Levels{Level: "debug", Components: map[string]string{ComponentAgent: "loud"}}))
+		assert.Equal(t, Levels{Level: "info"}, GetLevels())
+	})
+}
+
+func TestComponentLogger(t *testing.T) {
+	resetLevels(t)
+	out := log.StandardLogger().Out
+	defer log.SetOutput(out)
+	buf := &bytes.Buffer{}
+	log.SetOutput(buf)
+	assert.Equal(t, log.StandardLogger(), ComponentLogger(ComponentController))
+	assert.NoError(t, SetLevels(Levels{Level: "info", Components: map[string]string{ComponentPodReconciliation: "debug"}}))
+	ComponentLogger(ComponentPodReconciliation).Debug("reconciled")
+	log.Debug("ignored")
+	assert.Contains(t, buf.String(), "reconciled")
+	assert.NotContains(t, buf.String(), "ignored")
+}
@@ -43,7 +43,7 @@ type sender interface {
 
 func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, req request, sender sender) error {
 	wfInterface := wfClient.ArgoprojV1alpha1().Workflows(req.GetNamespace())
-	_, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
+	wf, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
@@ -53,7 +53,7 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		return fmt.Errorf("failed to compile %q: %w", req.GetGrep(), err)
 	}
 
-	podInterface := kubeClient.CoreV1().Pods(req.GetNamespace())
+	podInterface := kubeClient.CoreV1().Pods(wf.GetPodNamespace())
 
 	logCtx := log.WithFields(log.Fields{"workflow": req.GetName(), "namespace": req.GetNamespace()})
 
@@ -0,0 +1,168 @@
+package metricspush
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+type otlpExporter struct {
+	conn     *grpc.ClientConn
+	client   collectormetricsv1.MetricsServiceClient
+	resource *resourcev1.Resource
+	// start is the start time of the cumulative metrics, which is when the pusher was created, as they are not reset
+	// while the process runs
+	start time.Time
+}
+
+func newOTLPExporter(c config.MetricsPushConfig, serviceName, instance string, start time.Time) (*otlpExporter, error) {
+	creds := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	if c.Insecure {
+		creds = grpc.WithInsecure()
+	}
+	// this does not block, it connects in the background
+	conn, err := grpc.Dial(c.Endpoint, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &otlpExporter{
+		conn:   conn,
+		client: collectormetricsv1.NewMetricsServiceClient(conn),
+		resource: &resourcev1.Resource{Attributes: []*commonv1.KeyValue{
+			stringKeyValue("service.name", serviceName),
+			stringKeyValue("service.instance.id", instance),
+		}},
+		start: start,
+	}, nil
+}
+
+func (e *otlpExporter) export(ctx context.Context, families []*dto.MetricFamily, token string, now time.Time) error {
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	_, err := e.client.Export(ctx, &collectormetricsv1.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricsv1.ResourceMetrics{{
+			Resource: e.resource,
+			InstrumentationLibraryMetrics: []*metricsv1.InstrumentationLibraryMetrics{{
+				InstrumentationLibrary: &commonv1.InstrumentationLibrary{Name: "github.com/argoproj/argo-workflows/v3"},
+				Metrics:                toOTLPMetrics(families, e.start, now),
+			}},
+		}},
+	})
+	return err
+}
+
+func (e *otlpExporter) close() error {
+	return e.conn.Close()
+}
+
+// toOTLPMetrics converts metrics to their OpenTelemetry equivalents: counters are cumulative, monotonic sums, gauges and
+// untyped metrics are gauges, and histograms and summaries are cumulative histograms and summaries
+func toOTLPMetrics(families []*dto.MetricFamily, start, now time.Time) []*metricsv1.Metric {
+	var metrics []*metricsv1.Metric
+	startNanos := uint64(start.UnixNano())
+	for _, family := range families {
+		metric := &metricsv1.Metric{Name: family.GetName(), Description: family.GetHelp()}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			sum := &metricsv1.Sum{AggregationTemporality: metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, IsMonotonic: true}
+			for _, m := range family.Metric {
+				sum.DataPoints = append(sum.DataPoints, numberDataPoint(m, m.GetCounter().GetValue(), startNanos, now))
+			}
+			metric.Data = &metricsv1.Metric_Sum{Sum: sum}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			gauge := &metricsv1.Gauge{}
+			for _, m := range family.Metric {
+				value := m.GetGauge().GetValue()
+				if family.GetType() == dto.MetricType_UNTYPED {
+					value = m.GetUntyped().GetValue()
+				}
+				gauge.DataPoints = append(gauge.DataPoints, numberDataPoint(m, value, 0, now))
+			}
+			metric.Data = &metricsv1.Metric_Gauge{Gauge: gauge}
+		case dto.MetricType_HISTOGRAM:
+			histogram := &metricsv1.Histogram{AggregationTemporality: metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE}
+			for _, m := range family.Metric {
+				histogram.DataPoints = append(histogram.DataPoints, histogramDataPoint(m, startNanos, now))
+			}
+			metric.Data = &metricsv1.Metric_Histogram{Histogram: histogram}
+		case dto.MetricType_SUMMARY:
+			summary := &metricsv1.Summary{}
+			for _, m := range family.Metric {
+				dp := &metricsv1.SummaryDataPoint{
+					Attributes:        attributes(m),
+					StartTimeUnixNano: startNanos,
+					TimeUnixNano:      uint64(timestamp(m, now).UnixNano()),
+					Count:             m.GetSummary().GetSampleCount(),
+					Sum:               m.GetSummary().GetSampleSum(),
+				}
+				for _, q := range m.GetSummary().Quantile {
+					dp.QuantileValues = append(dp.QuantileValues, &metricsv1.SummaryDataPoint_ValueAtQuantile{Quantile: q.GetQuantile(), Value: q.GetValue()})
+				}
+				summary.DataPoints = append(summary.DataPoints, dp)
+			}
+			metric.Data = &metricsv1.Metric_Summary{Summary: summary}
+		default:
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
+
+func numberDataPoint(m *dto.Metric, value float64, startNanos uint64, now time.Time) *metricsv1.NumberDataPoint {
+	return &metricsv1.NumberDataPoint{
+		Attributes:        attributes(m),
+		StartTimeUnixNano: startNanos,
+		TimeUnixNano:      uint64(timestamp(m, now).UnixNano()),
+		Value:             &metricsv1.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// histogramDataPoint converts the cumulative buckets of Prometheus to the per-bucket counts of OpenTelemetry, where
+// there is one more count than there are bounds, for the values above the last bound
+func histogramDataPoint(m *dto.Metric, startNanos uint64, now time.Time) *metricsv1.HistogramDataPoint {
+	h := m.GetHistogram()
+	dp := &metricsv1.HistogramDataPoint{
+		Attributes:        attributes(m),
+		StartTimeUnixNano: startNanos,
+		TimeUnixNano:      uint64(timestamp(m, now).UnixNano()),
+		Count:             h.GetSampleCount(),
+		Sum:               h.GetSampleSum(),
+	}
+	var previous uint64
+	for _, b := range h.Bucket {
+		if math.IsInf(b.GetUpperBound(), +1) {
+			continue
+		}
+		dp.ExplicitBounds = append(dp.ExplicitBounds, b.GetUpperBound())
+		dp.BucketCounts = append(dp.BucketCounts, b.GetCumulativeCount()-previous)
+		previous = b.GetCumulativeCount()
+	}
+	dp.BucketCounts = append(dp.BucketCounts, h.GetSampleCount()-previous)
+	return dp
+}
+
+func attributes(m *dto.Metric) []*commonv1.KeyValue {
+	var attributes []*commonv1.KeyValue
+	for _, l := range m.Label {
+		attributes = append(attributes, stringKeyValue(l.GetName(), l.GetValue()))
+	}
+	return attributes
+}
+
+func stringKeyValue(key, value string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: value}}}
+}
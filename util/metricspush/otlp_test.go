@@ -0,0 +1,109 @@
+package metricspush
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+type fakeMetricsService struct {
+	collectormetricsv1.UnimplementedMetricsServiceServer
+	authorization []string
+	request       *collectormetricsv1.ExportMetricsServiceRequest
+}
+
+func (s *fakeMetricsService) Export(ctx context.Context, req *collectormetricsv1.ExportMetricsServiceRequest) (*collectormetricsv1.ExportMetricsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = md.Get("authorization")
+	s.request = req
+	return &collectormetricsv1.ExportMetricsServiceResponse{}, nil
+}
+
+func TestToOTLPMetrics(t *testing.T) {
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "my_counter", Help: "my help"}, []string{"phase"})
+	counter.WithLabelValues("Succeeded").Add(2)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "my_gauge", Help: "help"})
+	gauge.Set(7)
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "my_histogram", Help: "help", Buckets: []float64{1, 5}})
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+	histogram.Observe(10)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(counter, gauge, histogram)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	start, now := time.Unix(1, 0), time.Unix(2, 0)
+	metrics := toOTLPMetrics(families, start, now)
+	require.Len(t, metrics, 3)
+
+	assert.Equal(t, "my_counter", metrics[0].Name)
+	assert.Equal(t, "my help", metrics[0].Description)
+	sum := metrics[0].GetSum()
+	if assert.NotNil(t, sum) {
+		assert.True(t, sum.IsMonotonic)
+		assert.Equal(t, metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.AggregationTemporality)
+		require.Len(t, sum.DataPoints, 1)
+		assert.Equal(t, 2.0, sum.DataPoints[0].GetAsDouble())
+		assert.Equal(t, uint64(start.UnixNano()), sum.DataPoints[0].StartTimeUnixNano)
+		assert.Equal(t, uint64(now.UnixNano()), sum.DataPoints[0].TimeUnixNano)
+		assert.Equal(t, "phase", sum.DataPoints[0].Attributes[0].Key)
+		assert.Equal(t, "Succeeded", sum.DataPoints[0].Attributes[0].GetValue().GetStringValue())
+	}
+
+	gaugeData := metrics[1].GetGauge()
+	if assert.NotNil(t, gaugeData) && assert.Len(t, gaugeData.DataPoints, 1) {
+		assert.Equal(t, 7.0, gaugeData.DataPoints[0].GetAsDouble())
+	}
+
+	histogramData := metrics[2].GetHistogram()
+	if assert.NotNil(t, histogramData) && assert.Len(t, histogramData.DataPoints, 1) {
+		dp := histogramData.DataPoints[0]
+		assert.Equal(t, uint64(3), dp.Count)
+		assert.Equal(t, 13.5, dp.Sum)
+		assert.Equal(t, []float64{1, 5}, dp.ExplicitBounds)
+		assert.Equal(t, []uint64{1, 1, 1}, dp.BucketCounts)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	service := &fakeMetricsService{}
+	server := grpc.NewServer()
+	collectormetricsv1.RegisterMetricsServiceServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	e, err := newOTLPExporter(config.MetricsPushConfig{Protocol: config.MetricsPushProtocolOTLP, Endpoint: listener.Addr().String(), Insecure: true}, "workflow-controller", "my-pod", time.Now())
+	require.NoError(t, err)
+	defer func() { _ = e.close() }()
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "my_gauge", Help: "help"})
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(gauge)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	err = e.export(context.Background(), families, "my-token", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer my-token"}, service.authorization)
+	require.Len(t, service.request.ResourceMetrics, 1)
+	resourceMetrics := service.request.ResourceMetrics[0]
+	assert.Equal(t, "service.name", resourceMetrics.Resource.Attributes[0].Key)
+	assert.Equal(t, "workflow-controller", resourceMetrics.Resource.Attributes[0].GetValue().GetStringValue())
+	assert.Equal(t, "my-pod", resourceMetrics.Resource.Attributes[1].GetValue().GetStringValue())
+	require.Len(t, resourceMetrics.InstrumentationLibraryMetrics, 1)
+	require.Len(t, resourceMetrics.InstrumentationLibraryMetrics[0].Metrics, 1)
+	assert.Equal(t, "my_gauge", resourceMetrics.InstrumentationLibraryMetrics[0].Metrics[0].Name)
+}
@@ -0,0 +1,113 @@
+package metricspush
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// pushTimeout is the longest a push can take, so a hung endpoint does not delay the next one
+const pushTimeout = 10 * time.Second
+
+// exporter sends metrics to an endpoint
+type exporter interface {
+	export(ctx context.Context, families []*dto.MetricFamily, token string, now time.Time) error
+	close() error
+}
+
+// Pusher pushes the metrics of a gatherer to a remote endpoint, for when they cannot be scraped
+type Pusher struct {
+	config   config.MetricsPushConfig
+	gatherer prometheus.Gatherer
+	exporter exporter
+}
+
+// New returns a pusher of the metrics of the service, which are labelled with the service name, as the job, and the
+// host name, as the instance, as they would be if they were scraped
+func New(c config.MetricsPushConfig, serviceName string, gatherer prometheus.Gatherer) (*Pusher, error) {
+	if c.Endpoint == "" {
+		return nil, fmt.Errorf("metrics push endpoint must be specified")
+	}
+	instance, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	var e exporter
+	switch c.GetProtocol() {
+	case config.MetricsPushProtocolRemoteWrite:
+		e, err = newRemoteWriteExporter(c, serviceName, instance)
+	case config.MetricsPushProtocolOTLP:
+		e, err = newOTLPExporter(c, serviceName, instance, time.Now())
+	default:
+		return nil, fmt.Errorf("metrics push protocol %q must be %q or %q", c.Protocol, config.MetricsPushProtocolRemoteWrite, config.MetricsPushProtocolOTLP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Pusher{config: c, gatherer: gatherer, exporter: e}, nil
+}
+
+// Push gathers the metrics and pushes them once
+func (p *Pusher) Push(ctx context.Context) error {
+	families, err := p.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	token, err := p.token()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, pushTimeout)
+	defer cancel()
+	return p.exporter.export(ctx, families, token, time.Now())
+}
+
+// Run pushes the metrics every interval until the context is done
+func (p *Pusher) Run(ctx context.Context) {
+	defer func() {
+		if err := p.exporter.close(); err != nil {
+			log.WithError(err).Warn("Failed to close metrics push connection")
+		}
+	}()
+	log.WithFields(log.Fields{"protocol": p.config.GetProtocol(), "endpoint": p.config.Endpoint, "interval": p.config.GetInterval()}).Info("Pushing metrics")
+	ticker := time.NewTicker(p.config.GetInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Push(ctx); err != nil {
+				log.WithError(err).Warn("Failed to push metrics")
+			}
+		}
+	}
+}
+
+// token reads the bearer token each time it is needed, as a mounted token can be rotated
+func (p *Pusher) token() (string, error) {
+	if p.config.BearerTokenFile == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(p.config.BearerTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read metrics push bearer token: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// timestamp returns the time of a metric, which is the time it was gathered unless it has its own
+func timestamp(m *dto.Metric, now time.Time) time.Time {
+	if m.TimestampMs != nil {
+		return time.Unix(0, m.GetTimestampMs()*int64(time.Millisecond))
+	}
+	return now
+}
@@ -0,0 +1,61 @@
+package metricspush
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestNew(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	t.Run("NoEndpoint", func(t *testing.T) {
+		_, err := New(config.MetricsPushConfig{}, "workflow-controller", registry)
+		assert.EqualError(t, err, "metrics push endpoint must be specified")
+	})
+	t.Run("InvalidProtocol", func(t *testing.T) {
+		_, err := New(config.MetricsPushConfig{Protocol: "statsd", Endpoint: "localhost:8125"}, "workflow-controller", registry)
+		assert.EqualError(t, err, `metrics push protocol "statsd" must be "remote-write" or "otlp"`)
+	})
+	t.Run("RemoteWrite", func(t *testing.T) {
+		p, err := New(config.MetricsPushConfig{Endpoint: "http://localhost:9090/api/v1/write"}, "workflow-controller", registry)
+		require.NoError(t, err)
+		assert.IsType(t, &remoteWriteExporter{}, p.exporter)
+	})
+	t.Run("OTLP", func(t *testing.T) {
+		p, err := New(config.MetricsPushConfig{Protocol: config.MetricsPushProtocolOTLP, Endpoint: "localhost:4317", Insecure: true}, "workflow-controller", registry)
+		require.NoError(t, err)
+		assert.IsType(t, &otlpExporter{}, p.exporter)
+		assert.NoError(t, p.exporter.close())
+	})
+}
+
+func TestPush(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-token\n"), 0o600))
+
+	p, err := New(config.MetricsPushConfig{Endpoint: server.URL, BearerTokenFile: tokenFile}, "workflow-controller", prometheus.NewRegistry())
+	require.NoError(t, err)
+	require.NoError(t, p.Push(context.Background()))
+	assert.Equal(t, "Bearer my-token", authorization)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("rotated-token"), 0o600))
+	require.NoError(t, p.Push(context.Background()))
+	assert.Equal(t, "Bearer rotated-token", authorization)
+
+	require.NoError(t, os.Remove(tokenFile))
+	assert.Error(t, p.Push(context.Background()))
+}
@@ -0,0 +1,169 @@
+package metricspush
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+type label struct {
+	name, value string
+}
+
+// timeSeries is a single sample of a series, as per the remote write protocol, where the labels include "__name__"
+type timeSeries struct {
+	labels []label
+	value  float64
+	// timestamp is in milliseconds
+	timestamp int64
+}
+
+type remoteWriteExporter struct {
+	endpoint string
+	client   *http.Client
+	// labels are added to every series that does not have them
+	labels []label
+}
+
+func newRemoteWriteExporter(c config.MetricsPushConfig, serviceName, instance string) (*remoteWriteExporter, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return &remoteWriteExporter{
+		endpoint: c.Endpoint,
+		client:   &http.Client{Transport: transport},
+		labels:   []label{{"job", serviceName}, {"instance", instance}},
+	}, nil
+}
+
+func (e *remoteWriteExporter) export(ctx context.Context, families []*dto.MetricFamily, token string, now time.Time) error {
+	body := snappy.Encode(nil, encodeWriteRequest(toTimeSeries(families, e.labels, now)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("remote write endpoint returned %s: %s", resp.Status, bytes.TrimSpace(message))
+	}
+	return nil
+}
+
+func (e *remoteWriteExporter) close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// toTimeSeries converts metrics to series the way Prometheus does when it scrapes them, e.g. a histogram is a series per
+// bucket, plus its sum and count
+func toTimeSeries(families []*dto.MetricFamily, extraLabels []label, now time.Time) []timeSeries {
+	var series []timeSeries
+	for _, family := range families {
+		name := family.GetName()
+		for _, m := range family.Metric {
+			labels := make([]label, 0, len(m.Label)+len(extraLabels))
+			has := map[string]bool{}
+			for _, l := range m.Label {
+				labels = append(labels, label{l.GetName(), l.GetValue()})
+				has[l.GetName()] = true
+			}
+			for _, l := range extraLabels {
+				if !has[l.name] {
+					labels = append(labels, l)
+				}
+			}
+			ts := timestamp(m, now).UnixNano() / int64(time.Millisecond)
+			add := func(name string, value float64, extra ...label) {
+				all := append(append([]label{{"__name__", name}}, labels...), extra...)
+				sort.Slice(all, func(i, j int) bool { return all[i].name < all[j].name })
+				series = append(series, timeSeries{labels: all, value: value, timestamp: ts})
+			}
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add(name, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add(name, m.GetGauge().GetValue())
+			case dto.MetricType_UNTYPED:
+				add(name, m.GetUntyped().GetValue())
+			case dto.MetricType_SUMMARY:
+				for _, q := range m.GetSummary().Quantile {
+					add(name, q.GetValue(), label{"quantile", formatFloat(q.GetQuantile())})
+				}
+				add(name+"_sum", m.GetSummary().GetSampleSum())
+				add(name+"_count", float64(m.GetSummary().GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				for _, b := range h.Bucket {
+					if !math.IsInf(b.GetUpperBound(), +1) {
+						add(name+"_bucket", float64(b.GetCumulativeCount()), label{"le", formatFloat(b.GetUpperBound())})
+					}
+				}
+				add(name+"_bucket", float64(h.GetSampleCount()), label{"le", "+Inf"})
+				add(name+"_sum", h.GetSampleSum())
+				add(name+"_count", float64(h.GetSampleCount()))
+			}
+		}
+	}
+	return series
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// encodeWriteRequest encodes the series as the protobuf of a remote write request, which is:
+//
+//	message WriteRequest { repeated TimeSeries timeseries = 1; }
+//	message TimeSeries { repeated Label labels = 1; repeated Sample samples = 2; }
+//	message Label { string name = 1; string value = 2; }
+//	message Sample { double value = 1; int64 timestamp = 2; }
+func encodeWriteRequest(series []timeSeries) []byte {
+	var b []byte
+	for _, s := range series {
+		var ts []byte
+		for _, l := range s.labels {
+			var lb []byte
+			lb = protowire.AppendTag(lb, 1, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.name)
+			lb = protowire.AppendTag(lb, 2, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.value)
+			ts = protowire.AppendTag(ts, 1, protowire.BytesType)
+			ts = protowire.AppendBytes(ts, lb)
+		}
+		var sb []byte
+		sb = protowire.AppendTag(sb, 1, protowire.Fixed64Type)
+		sb = protowire.AppendFixed64(sb, math.Float64bits(s.value))
+		sb = protowire.AppendTag(sb, 2, protowire.VarintType)
+		sb = protowire.AppendVarint(sb, uint64(s.timestamp))
+		ts = protowire.AppendTag(ts, 2, protowire.BytesType)
+		ts = protowire.AppendBytes(ts, sb)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, ts)
+	}
+	return b
+}
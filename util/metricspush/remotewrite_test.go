@@ -0,0 +1,148 @@
+package metricspush
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// decodeWriteRequest is the inverse of encodeWriteRequest
+func decodeWriteRequest(t *testing.T, b []byte) []timeSeries {
+	var series []timeSeries
+	forEachField(t, b, func(_ protowire.Number, ts []byte) {
+		var s timeSeries
+		forEachField(t, ts, func(num protowire.Number, v []byte) {
+			switch num {
+			case 1:
+				var l label
+				forEachField(t, v, func(num protowire.Number, v []byte) {
+					if num == 1 {
+						l.name = string(v)
+					} else {
+						l.value = string(v)
+					}
+				})
+				s.labels = append(s.labels, l)
+			case 2:
+				for len(v) > 0 {
+					num, typ, n := protowire.ConsumeTag(v)
+					require.GreaterOrEqual(t, n, 0)
+					v = v[n:]
+					switch typ {
+					case protowire.Fixed64Type:
+						f, n := protowire.ConsumeFixed64(v)
+						s.value = math.Float64frombits(f)
+						v = v[n:]
+					case protowire.VarintType:
+						i, n := protowire.ConsumeVarint(v)
+						assert.Equal(t, protowire.Number(2), num)
+						s.timestamp = int64(i)
+						v = v[n:]
+					}
+				}
+			}
+		})
+		series = append(series, s)
+	})
+	return series
+}
+
+func forEachField(t *testing.T, b []byte, f func(protowire.Number, []byte)) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		require.Equal(t, protowire.BytesType, typ)
+		b = b[n:]
+		v, n := protowire.ConsumeBytes(b)
+		require.GreaterOrEqual(t, n, 0)
+		f(num, v)
+		b = b[n:]
+	}
+}
+
+func TestToTimeSeries(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "my_counter", Help: "help"}, []string{"phase"})
+	counter.WithLabelValues("Succeeded").Add(2)
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "my_histogram", Help: "help", Buckets: []float64{1, 5}})
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+	histogram.Observe(10)
+	registry.MustRegister(counter, histogram)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	now := time.Unix(100, 0)
+	series := toTimeSeries(families, []label{{"job", "workflow-controller"}, {"phase", "ignored"}}, now)
+	if assert.Len(t, series, 6) {
+		assert.Equal(t, timeSeries{labels: []label{{"__name__", "my_counter"}, {"job", "workflow-controller"}, {"phase", "Succeeded"}}, value: 2, timestamp: 100000}, series[0])
+		assert.Equal(t, []label{{"__name__", "my_histogram_bucket"}, {"job", "workflow-controller"}, {"le", "1"}, {"phase", "ignored"}}, series[1].labels)
+		assert.Equal(t, 1.0, series[1].value)
+		assert.Equal(t, "5", series[2].labels[2].value)
+		assert.Equal(t, 2.0, series[2].value)
+		assert.Equal(t, "+Inf", series[3].labels[2].value)
+		assert.Equal(t, 3.0, series[3].value)
+		assert.Equal(t, "my_histogram_sum", series[4].labels[0].value)
+		assert.Equal(t, 13.5, series[4].value)
+		assert.Equal(t, "my_histogram_count", series[5].labels[0].value)
+		assert.Equal(t, 3.0, series[5].value)
+	}
+}
+
+func TestRemoteWriteExporter(t *testing.T) {
+	var (
+		headers http.Header
+		series  []timeSeries
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		data, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		series = decodeWriteRequest(t, data)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	e, err := newRemoteWriteExporter(config.MetricsPushConfig{Endpoint: server.URL}, "argo-server", "my-pod")
+	require.NoError(t, err)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "my_gauge", Help: "help"})
+	gauge.Set(7)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(gauge)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	err = e.export(context.Background(), families, "my-token", time.Unix(1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "snappy", headers.Get("Content-Encoding"))
+	assert.Equal(t, "application/x-protobuf", headers.Get("Content-Type"))
+	assert.Equal(t, "0.1.0", headers.Get("X-Prometheus-Remote-Write-Version"))
+	assert.Equal(t, "Bearer my-token", headers.Get("Authorization"))
+	assert.Equal(t, []timeSeries{{labels: []label{{"__name__", "my_gauge"}, {"instance", "my-pod"}, {"job", "argo-server"}}, value: 7, timestamp: 1000}}, series)
+}
+
+func TestRemoteWriteExporterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "out of order sample", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	e, err := newRemoteWriteExporter(config.MetricsPushConfig{Endpoint: server.URL}, "argo-server", "my-pod")
+	require.NoError(t, err)
+	err = e.export(context.Background(), nil, "", time.Now())
+	assert.EqualError(t, err, "remote write endpoint returned 400 Bad Request: out of order sample")
+}
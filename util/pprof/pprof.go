@@ -4,21 +4,34 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// Init replaces the default mux, so that only the endpoints we choose are served on it, and enables the pprof endpoints
+// if ARGO_PPROF is "true"
 func Init() {
 	// https://mmcloughlin.com/posts/your-pprof-is-showing
 	http.DefaultServeMux = http.NewServeMux()
 	if os.Getenv("ARGO_PPROF") == "true" {
+		Enable()
+	} else {
+		log.Info("not enabling pprof debug endpoints")
+	}
+}
+
+var enable sync.Once
+
+// Enable serves the pprof endpoints, including the execution tracer at /debug/pprof/trace, on the default mux. It must
+// be called after Init.
+func Enable() {
+	enable.Do(func() {
 		log.Info("enabling pprof debug endpoints - do not do this in production")
 		http.HandleFunc("/debug/pprof/", pprof.Index)
 		http.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 		http.HandleFunc("/debug/pprof/profile", pprof.Profile)
 		http.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 		http.HandleFunc("/debug/pprof/trace", pprof.Trace)
-	} else {
-		log.Info("not enabling pprof debug endpoints")
-	}
+	})
 }
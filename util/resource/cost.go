@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"math"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// UpdateEstimatedCosts sums the estimated costs of the workflow's pods into the workflow, and each fulfilled node that
+// is not a pod. It leaves them unset if none of the pods have an estimated cost, e.g. because prices are not configured
+func UpdateEstimatedCosts(wf *wfv1.Workflow) {
+	total, ok := 0.0, false
+	for nodeID, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			if node.EstimatedCost != nil {
+				total += amount(node.EstimatedCost)
+				ok = true
+			}
+		} else if node.Fulfilled() {
+			if cost, ok := estimatedCost(wf, node, make(map[string]bool)); ok {
+				node.EstimatedCost = NewEstimatedCost(cost)
+				wf.Status.Nodes[nodeID] = node
+			}
+		}
+	}
+	if ok {
+		wf.Status.EstimatedCost = NewEstimatedCost(total)
+	}
+}
+
+func estimatedCost(wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[string]bool) (float64, bool) {
+	v, ok := 0.0, false
+	for _, childID := range node.Children {
+		// as for resources duration, we must not count a child twice
+		if visited[childID] {
+			continue
+		}
+		visited[childID] = true
+		child := wf.Status.Nodes[childID]
+		if child.Type == wfv1.NodeTypePod && child.EstimatedCost != nil {
+			v += amount(child.EstimatedCost)
+			ok = true
+		}
+		if c, childOK := estimatedCost(wf, child, visited); childOK {
+			v += c
+			ok = true
+		}
+	}
+	return v, ok
+}
+
+func amount(a *wfv1.Amount) float64 {
+	f, _ := a.Float64()
+	return f
+}
+
+// NewEstimatedCost returns the cost, rounded to a millionth, so that adding floats does not make a long amount
+func NewEstimatedCost(f float64) *wfv1.Amount {
+	return wfv1.NewAmount(math.Round(f*1e6) / 1e6)
+}
@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateEstimatedCosts(t *testing.T) {
+	t.Run("NoCosts", func(t *testing.T) {
+		wf := &wfv1.Workflow{}
+		wfv1.MustUnmarshal(`
+status:
+  nodes:
+    root:
+      phase: Succeeded
+      children: [pod]
+    pod:
+      phase: Succeeded
+      type: Pod
+`, wf)
+		UpdateEstimatedCosts(wf)
+		assert.Nil(t, wf.Status.Nodes["root"].EstimatedCost)
+		assert.Nil(t, wf.Status.EstimatedCost)
+	})
+	t.Run("Costs", func(t *testing.T) {
+		wf := &wfv1.Workflow{}
+		wfv1.MustUnmarshal(`
+status:
+  nodes:
+    root:
+      phase: Succeeded
+      children: [pod, dag]
+    pod:
+      phase: Succeeded
+      type: Pod
+      estimatedCost: 0.1
+      children: [dag]
+    dag:
+      phase: Succeeded
+      children: [dag-pod]
+    dag-pod:
+      phase: Succeeded
+      type: Pod
+      estimatedCost: 0.2
+`, wf)
+		UpdateEstimatedCosts(wf)
+		assert.Equal(t, wfv1.NewAmount(0.2), wf.Status.Nodes["dag"].EstimatedCost)
+		assert.Equal(t, wfv1.NewAmount(0.3), wf.Status.Nodes["root"].EstimatedCost)
+		assert.Equal(t, wfv1.NewAmount(0.3), wf.Status.EstimatedCost)
+	})
+}
@@ -1,9 +1,12 @@
 package template
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 
+	"github.com/antonmedv/expr/parser"
 	"github.com/valyala/fasttemplate"
 )
 
@@ -23,3 +26,28 @@ func Validate(s string, validator func(tag string) error) error {
 	})
 	return err
 }
+
+// ValidateExpressions returns an error if any expression template in the JSON s cannot be parsed. The variables used
+// by the expressions are not checked, as most are only known when the template runs.
+func ValidateExpressions(s string) error {
+	t, err := fasttemplate.NewTemplate(s, prefix, suffix)
+	if err != nil {
+		return err
+	}
+	_, err = t.ExecuteFunc(ioutil.Discard, func(w io.Writer, tag string) (int, error) {
+		kind, expression := parseTag(tag)
+		if kind != kindExpression {
+			return 0, nil
+		}
+		// as per expressionReplace, the expression is JSON-unmarshalled to undo any character escapes
+		var unmarshalledExpression string
+		if err := json.Unmarshal([]byte(fmt.Sprintf(`"%s"`, expression)), &unmarshalledExpression); err != nil {
+			return 0, fmt.Errorf("failed to unmarshall JSON expression: %w", err)
+		}
+		if _, err := parser.Parse(unmarshalledExpression); err != nil {
+			return 0, fmt.Errorf("invalid expression %q: %w", unmarshalledExpression, err)
+		}
+		return 0, nil
+	})
+	return err
+}
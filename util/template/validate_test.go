@@ -25,3 +25,20 @@ func Test_Validate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_ValidateExpressions(t *testing.T) {
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		assert.Error(t, ValidateExpressions("{{"))
+	})
+	t.Run("Simple", func(t *testing.T) {
+		assert.NoError(t, ValidateExpressions(`{"value": "{{foo"}}`))
+	})
+	t.Run("Expression", func(t *testing.T) {
+		assert.NoError(t, ValidateExpressions(`{"value": "{{=sprig.trim(inputs.parameters[\"my-param\"])}}"}`))
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		err := ValidateExpressions(`{"value": "{{=inputs.parameters.x +}}"}`)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid expression "inputs.parameters.x +"`)
+	})
+}
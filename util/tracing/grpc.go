@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var keyRPCMethod = attribute.Key("rpc.method")
+
+// metadataCarrier is the carrier of the W3C trace context of an incoming request
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	var keys []string
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+var _ propagation.TextMapCarrier = metadataCarrier{}
+
+// InjectGRPCMetadata injects the W3C trace context of the context into the metadata of an outgoing request
+func InjectGRPCMetadata(ctx context.Context, md metadata.MD) {
+	propagator.Inject(ctx, metadataCarrier(md))
+}
+
+// startServerSpan starts the span of a request, which is a child of the span of the client, if it sent one
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+	}
+	// e.g. "/workflow.WorkflowService/GetWorkflow"
+	name := strings.TrimPrefix(fullMethod, "/")
+	return Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(keyRPCMethod.String(name)))
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that starts a span for each request
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		EndSpan(span, err)
+		return resp, err
+	}
+}
+
+// tracedServerStream is a stream whose context has the span of its request
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that starts a span for each request
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(stream.Context(), info.FullMethod)
+		err := handler(srv, tracedServerStream{ServerStream: stream, ctx: ctx})
+		EndSpan(span, err)
+		return err
+	}
+}
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	recorder := withRecorder(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceParent))
+	_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/GetWorkflow"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", trace.SpanContextFromContext(ctx).TraceID().String())
+		return nil, assert.AnError
+	})
+	assert.Equal(t, assert.AnError, err)
+	if assert.Len(t, recorder.Ended(), 1) {
+		s := recorder.Ended()[0]
+		assert.Equal(t, "workflow.WorkflowService/GetWorkflow", s.Name())
+		assert.Equal(t, trace.SpanKindServer, s.SpanKind())
+		assert.Equal(t, "b7ad6b7169203331", s.Parent().SpanID().String())
+		assert.Equal(t, assert.AnError.Error(), s.Status().Description)
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	recorder := withRecorder(t)
+	stream := testServerStream{ctx: context.Background()}
+	err := StreamServerInterceptor()(nil, stream, &grpc.StreamServerInfo{FullMethod: "/workflow.WorkflowService/WatchWorkflows"}, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.True(t, trace.SpanContextFromContext(stream.Context()).IsValid())
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, recorder.Ended(), 1) {
+		s := recorder.Ended()[0]
+		assert.Equal(t, "workflow.WorkflowService/WatchWorkflows", s.Name())
+		assert.False(t, s.Parent().IsValid())
+	}
+}
+
+func TestInjectGRPCMetadata(t *testing.T) {
+	withRecorder(t)
+	md := metadata.MD{}
+	InjectGRPCMetadata(ContextWithTraceParent(context.Background(), traceParent), md)
+	assert.Equal(t, []string{traceParent}, md.Get("traceparent"))
+}
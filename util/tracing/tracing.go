@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// EnvVarTraceParent is the W3C trace context of the span that created a pod, so the spans of its containers are
+	// children of it
+	EnvVarTraceParent = "ARGO_TRACEPARENT"
+	// the environment variables that configure the OTLP exporter, either of which enables tracing
+	envVarEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envVarTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+var (
+	KeyWorkflowNamespace = attribute.Key("workflow.namespace")
+	KeyWorkflowName      = attribute.Key("workflow.name")
+	KeyWorkflowUID       = attribute.Key("workflow.uid")
+	KeyWorkflowPhase     = attribute.Key("workflow.phase")
+	KeyNodeID            = attribute.Key("node.id")
+	KeyArtifactName      = attribute.Key("artifact.name")
+)
+
+var propagator = propagation.TraceContext{}
+
+// Enabled returns true if spans are exported, which they are if the OTLP exporter has an endpoint
+func Enabled() bool {
+	return os.Getenv(envVarEndpoint) != "" || os.Getenv(envVarTracesEndpoint) != ""
+}
+
+// Init exports the spans of the service via OTLP, if it is enabled, and returns a function that exports the spans not
+// yet exported, and stops exporting them
+func Init(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	log.WithField("serviceName", serviceName).Info("Exporting OpenTelemetry spans via OTLP")
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of Argo, which does nothing unless Init enabled it
+func Tracer() trace.Tracer {
+	return otel.Tracer("github.com/argoproj/argo-workflows/v3")
+}
+
+// StartSpan starts a span, which is a child of the span in the context, if there is one
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, recording the error, if there is one
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WorkflowAttributes returns the attributes that link a span to a workflow
+func WorkflowAttributes(namespace, name string, uid types.UID) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{KeyWorkflowNamespace.String(namespace), KeyWorkflowName.String(name)}
+	if uid != "" {
+		attributes = append(attributes, KeyWorkflowUID.String(string(uid)))
+	}
+	return attributes
+}
+
+// TraceParent returns the W3C trace context of the span in the context, or "" if there is not one
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.HeaderCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceParent returns the context, with the remote span of the W3C trace context, if there is one, as the
+// parent of the spans started from it
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	carrier := propagation.HeaderCarrier{}
+	carrier.Set("traceparent", traceParent)
+	return propagator.Extract(ctx, carrier)
+}
+
+// InjectHTTPHeaders adds the W3C trace context of the span in the context to the headers of a request, so the span of
+// the server is a child of it
+func InjectHTTPHeaders(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ContextFromEnv returns the context, with the remote span of the pod, from EnvVarTraceParent, as the parent of the
+// spans started from it
+func ContextFromEnv(ctx context.Context) context.Context {
+	return ContextWithTraceParent(ctx, os.Getenv(EnvVarTraceParent))
+}
+
+// ExporterEnv returns the environment variables that configure the OTLP exporter, other than those that name the
+// service, so a pod can export its spans to the same endpoint
+func ExporterEnv() map[string]string {
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], "OTEL_") || parts[0] == "OTEL_SERVICE_NAME" || parts[0] == "OTEL_RESOURCE_ATTRIBUTES" {
+			continue
+		}
+		env[parts[0]] = parts[1]
+	}
+	return env
+}
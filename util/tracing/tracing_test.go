@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const traceParent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+// withRecorder records the spans of the test
+func withRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(provider) })
+	return recorder
+}
+
+func TestEnabled(t *testing.T) {
+	assert.False(t, Enabled())
+	t.Run("Endpoint", func(t *testing.T) {
+		_ = os.Setenv(envVarEndpoint, "http://otel-collector:4317")
+		defer func() { _ = os.Unsetenv(envVarEndpoint) }()
+		assert.True(t, Enabled())
+	})
+	t.Run("TracesEndpoint", func(t *testing.T) {
+		_ = os.Setenv(envVarTracesEndpoint, "http://otel-collector:4317")
+		defer func() { _ = os.Unsetenv(envVarTracesEndpoint) }()
+		assert.True(t, Enabled())
+	})
+}
+
+func TestInit(t *testing.T) {
+	shutdown, err := Init(context.Background(), "test")
+	if assert.NoError(t, err) {
+		assert.NoError(t, shutdown(context.Background()))
+	}
+}
+
+func TestTraceParent(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		assert.Empty(t, TraceParent(context.Background()))
+		assert.Equal(t, context.Background(), ContextWithTraceParent(context.Background(), ""))
+	})
+	t.Run("Remote", func(t *testing.T) {
+		assert.Equal(t, traceParent, TraceParent(ContextWithTraceParent(context.Background(), traceParent)))
+	})
+	t.Run("Env", func(t *testing.T) {
+		_ = os.Setenv(EnvVarTraceParent, traceParent)
+		defer func() { _ = os.Unsetenv(EnvVarTraceParent) }()
+		assert.Equal(t, traceParent, TraceParent(ContextFromEnv(context.Background())))
+	})
+	t.Run("Child", func(t *testing.T) {
+		recorder := withRecorder(t)
+		ctx, span := StartSpan(ContextWithTraceParent(context.Background(), traceParent), "child", WorkflowAttributes("my-ns", "my-wf", "my-uid")...)
+		header := http.Header{}
+		InjectHTTPHeaders(ctx, header)
+		EndSpan(span, nil)
+		assert.Regexp(t, `^00-0af7651916cd43dd8448eb211c80319c-[0-9a-f]{16}-01$`, header.Get("traceparent"))
+		assert.NotEqual(t, traceParent, header.Get("traceparent"))
+		if assert.Len(t, recorder.Ended(), 1) {
+			s := recorder.Ended()[0]
+			assert.Equal(t, "child", s.Name())
+			assert.Equal(t, "b7ad6b7169203331", s.Parent().SpanID().String())
+			assert.Len(t, s.Attributes(), 3)
+		}
+	})
+}
+
+func TestEndSpan(t *testing.T) {
+	recorder := withRecorder(t)
+	_, span := StartSpan(context.Background(), "failed")
+	EndSpan(span, assert.AnError)
+	if assert.Len(t, recorder.Ended(), 1) {
+		assert.Equal(t, assert.AnError.Error(), recorder.Ended()[0].Status().Description)
+	}
+}
+
+func TestWorkflowAttributes(t *testing.T) {
+	assert.Len(t, WorkflowAttributes("my-ns", "my-wf", ""), 2)
+	assert.Len(t, WorkflowAttributes("my-ns", "my-wf", "my-uid"), 3)
+}
+
+func TestExporterEnv(t *testing.T) {
+	for k, v := range map[string]string{
+		envVarEndpoint:                "http://otel-collector:4317",
+		"OTEL_EXPORTER_OTLP_INSECURE": "true",
+		"OTEL_SERVICE_NAME":           "workflow-controller",
+		"OTEL_RESOURCE_ATTRIBUTES":    "k8s.namespace.name=argo",
+	} {
+		_ = os.Setenv(k, v)
+		defer func(k string) { _ = os.Unsetenv(k) }(k)
+	}
+	assert.Equal(t, map[string]string{
+		envVarEndpoint:                "http://otel-collector:4317",
+		"OTEL_EXPORTER_OTLP_INSECURE": "true",
+	}, ExporterEnv())
+}
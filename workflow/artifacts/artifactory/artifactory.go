@@ -17,7 +17,10 @@ type ArtifactDriver struct {
 	Password string
 }
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ common.ArtifactDriver   = &ArtifactDriver{}
+	_ common.ArtifactStreamer = &ArtifactDriver{}
+)
 
 // Download artifact from an artifactory URL
 func (a *ArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
@@ -29,28 +32,39 @@ func (a *ArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
 		_ = lf.Close()
 	}()
 
-	req, err := http.NewRequest(http.MethodGet, artifact.Artifactory.URL, nil)
+	rc, _, err := a.OpenStream(artifact)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	_, err = io.Copy(lf, rc)
+
+	return err
+}
+
+// OpenStream opens the artifact at an artifactory URL for reading
+func (a *ArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, int64, error) {
+	req, err := http.NewRequest(http.MethodGet, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
 	req.SetBasicAuth(a.Username, a.Password)
 	res, err := (&http.Client{}).Do(req)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
-	defer func() {
-		_ = res.Body.Close()
-	}()
 	if res.StatusCode == 404 {
-		return errors.New(errors.CodeNotFound, res.Status)
+		_ = res.Body.Close()
+		return nil, 0, errors.New(errors.CodeNotFound, res.Status)
 	}
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return errors.InternalErrorf("loading file from artifactory failed with reason:%s", res.Status)
+		_ = res.Body.Close()
+		return nil, 0, errors.InternalErrorf("loading file from artifactory failed with reason:%s", res.Status)
 	}
-
-	_, err = io.Copy(lf, res.Body)
-
-	return err
+	return res.Body, res.ContentLength, nil
 }
 
 // UpLoad artifact to an artifactory URL
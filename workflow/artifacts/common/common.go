@@ -1,6 +1,11 @@
 package common
 
-import "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+import (
+	"io"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
 
 // ArtifactDriver is the interface for loading and saving of artifacts
 type ArtifactDriver interface {
@@ -12,3 +17,26 @@ type ArtifactDriver interface {
 
 	ListObjects(artifact *v1alpha1.Artifact) ([]string, error)
 }
+
+// ArtifactStreamer is implemented by drivers that can open an artifact as a stream, so that it can be unpacked while
+// it is downloaded, rather than first being downloaded to disk
+type ArtifactStreamer interface {
+	// OpenStream opens the artifact for reading and returns its size in bytes, or -1 if unknown.
+	// The caller must close the reader.
+	OpenStream(inputArtifact *v1alpha1.Artifact) (io.ReadCloser, int64, error)
+}
+
+// ArtifactDeleter is implemented by drivers that can delete artifacts, so that the artifacts of completed workflows
+// can be deleted once they are no longer retained
+type ArtifactDeleter interface {
+	// Delete deletes the artifact, i.e. the object of its key, or, if it is a directory, every object in it. Deleting
+	// an artifact that does not exist is not an error.
+	Delete(artifact *v1alpha1.Artifact) error
+}
+
+// ArtifactURLSigner is implemented by drivers that can sign URLs, so that artifacts can be downloaded from their
+// storage directly, rather than through the Argo Server
+type ArtifactURLSigner interface {
+	// SignedURL returns a URL that anyone can download the artifact from until it expires
+	SignedURL(artifact *v1alpha1.Artifact, expires time.Duration) (string, error)
+}
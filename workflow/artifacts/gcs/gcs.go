@@ -2,12 +2,15 @@ package gcs
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iamcredentials/v1"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -28,12 +32,16 @@ import (
 
 // ArtifactDriver is a driver for GCS
 type ArtifactDriver struct {
+	// ServiceAccountKey is the JSON credentials, either a service account key or a workload identity federation
+	// (external_account) configuration
 	ServiceAccountKey string
 }
 
 var (
-	_            common.ArtifactDriver = &ArtifactDriver{}
-	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+	_            common.ArtifactDriver    = &ArtifactDriver{}
+	_            common.ArtifactStreamer  = &ArtifactDriver{}
+	_            common.ArtifactURLSigner = &ArtifactDriver{}
+	defaultRetry                          = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
 )
 
 // from https://github.com/googleapis/google-cloud-go/blob/master/storage/go110.go
@@ -75,8 +83,61 @@ func (g *ArtifactDriver) newGCSClient() (*storage.Client, error) {
 	return newGCSClientDefault()
 }
 
+// credentials is the subset of the fields of a Google credentials JSON file that we need
+type credentials struct {
+	Type                           string `json:"type"`
+	ClientEmail                    string `json:"client_email"`
+	ServiceAccountImpersonationURL string `json:"service_account_impersonation_url"`
+}
+
+var impersonationURLRegexp = regexp.MustCompile(`/serviceAccounts/([^/:]+):generateAccessToken$`)
+
+// signer returns the service account email used to sign URLs, and whether it must sign through the IAM Credentials
+// API, as the credentials have no private key. The email is empty if it must be detected from the environment, e.g.
+// when using GKE Workload Identity.
+func signer(credentialsJSON string) (string, bool, error) {
+	if credentialsJSON == "" {
+		return "", false, nil
+	}
+	c := &credentials{}
+	if err := json.Unmarshal([]byte(credentialsJSON), c); err != nil {
+		return "", false, fmt.Errorf("GCS credentials: %v", err)
+	}
+	switch c.Type {
+	case "service_account":
+		return c.ClientEmail, false, nil
+	case "external_account", "impersonated_service_account":
+		// workload identity federation has no key to sign with, so we sign as the impersonated service account
+		m := impersonationURLRegexp.FindStringSubmatch(c.ServiceAccountImpersonationURL)
+		if m == nil {
+			return "", false, fmt.Errorf("GCS %s credentials must have a service_account_impersonation_url to sign URLs", c.Type)
+		}
+		return m[1], true, nil
+	default:
+		return "", false, nil
+	}
+}
+
+// signBlob returns a function that signs bytes as the service account, with the IAM Credentials SignBlob API
+func signBlob(email string, opts ...option.ClientOption) func([]byte) ([]byte, error) {
+	return func(b []byte) ([]byte, error) {
+		svc, err := iamcredentials.NewService(context.Background(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("GCS IAM credentials client: %v", err)
+		}
+		res, err := svc.Projects.ServiceAccounts.SignBlob("projects/-/serviceAccounts/"+email, &iamcredentials.SignBlobRequest{
+			Payload: base64.StdEncoding.EncodeToString(b),
+		}).Do()
+		if err != nil {
+			return nil, fmt.Errorf("GCS sign blob: %v", err)
+		}
+		return base64.StdEncoding.DecodeString(res.SignedBlob)
+	}
+}
+
 func newGCSClientWithCredential(serviceAccountJSON string) (*storage.Client, error) {
 	ctx := context.Background()
+	// this supports both service account keys and workload identity federation (i.e. external_account) configurations
 	creds, err := google.CredentialsFromJSON(ctx, []byte(serviceAccountJSON), storage.ScopeReadWrite)
 	if err != nil {
 		return nil, fmt.Errorf("GCS client CredentialsFromJSON: %v", err)
@@ -118,6 +179,45 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	return err
 }
 
+// objectReader closes the client once the object has been read
+type objectReader struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *objectReader) Close() error {
+	defer r.client.Close()
+	return r.Reader.Close()
+}
+
+// OpenStream opens the object of the key for reading. Keys of more than one object (i.e. directories) are not found.
+func (g *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, int64, error) {
+	var r *objectReader
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("GCS OpenStream key: %s", inputArtifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return !isTransientGCSErr(err), err
+			}
+			reader, err := client.Bucket(inputArtifact.GCS.Bucket).Object(inputArtifact.GCS.Key).NewReader(context.Background())
+			if err != nil {
+				client.Close()
+				if err == storage.ErrObjectNotExist {
+					return true, errors.New(errors.CodeNotFound, err.Error())
+				}
+				return !isTransientGCSErr(err), fmt.Errorf("new bucket reader: %v", err)
+			}
+			r = &objectReader{reader, client}
+			return true, nil
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+	return r, r.Attrs.Size, nil
+}
+
 // download all the objects of a key from the bucket
 func downloadObjects(client *storage.Client, bucket, key, path string) error {
 	objNames, err := listByPrefix(client, bucket, key, "")
@@ -320,3 +420,62 @@ func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error)
 		})
 	return files, err
 }
+
+// SignedURL returns a V4 signed URL to download the artifact. V4 signing does not use object ACLs, so this works for
+// buckets with uniform bucket-level access. If the credentials have no private key (e.g. workload identity
+// federation), the URL is signed using the IAM Credentials API, which requires the iam.serviceAccounts.signBlob
+// permission on the signing service account.
+func (g *ArtifactDriver) SignedURL(artifact *wfv1.Artifact, expires time.Duration) (string, error) {
+	email, iam, err := signer(g.ServiceAccountKey)
+	if err != nil {
+		return "", err
+	}
+	opts := &storage.SignedURLOptions{
+		GoogleAccessID: email,
+		Method:         "GET",
+		Expires:        time.Now().Add(expires),
+		Scheme:         storage.SigningSchemeV4,
+	}
+	if iam {
+		creds, err := google.CredentialsFromJSON(context.Background(), []byte(g.ServiceAccountKey), iamcredentials.CloudPlatformScope)
+		if err != nil {
+			return "", fmt.Errorf("GCS client CredentialsFromJSON: %v", err)
+		}
+		opts.SignBytes = signBlob(email, option.WithCredentials(creds))
+	}
+	client, err := g.newGCSClient()
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+	signedURL, err := client.Bucket(artifact.GCS.Bucket).SignedURL(artifact.GCS.Key, opts)
+	if err != nil {
+		return "", fmt.Errorf("GCS sign URL: %v", err)
+	}
+	return signedURL, nil
+}
+
+// Delete deletes the object of the artifact's key, and every object of the directory of the key, if it is one
+func (g *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("GCS Delete bucket: %s, key: %s", artifact.GCS.Bucket, artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return !isTransientGCSErr(err), err
+			}
+			defer client.Close()
+			names, err := listByPrefix(client, artifact.GCS.Bucket, strings.TrimSuffix(artifact.GCS.Key, "/")+"/", "")
+			if err != nil {
+				return !isTransientGCSErr(err), err
+			}
+			for _, name := range append([]string{artifact.GCS.Key}, names...) {
+				err := client.Bucket(artifact.GCS.Bucket).Object(name).Delete(context.Background())
+				if err != nil && err != storage.ErrObjectNotExist {
+					return !isTransientGCSErr(err), fmt.Errorf("delete %s: %v", name, err)
+				}
+			}
+			return true, nil
+		})
+}
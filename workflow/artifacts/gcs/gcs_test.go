@@ -1,15 +1,29 @@
 package gcs
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
 
 	argoErrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 type tlsHandshakeTimeoutError struct{}
@@ -39,3 +53,71 @@ func TestIsTransientGCSErr(t *testing.T) {
 		}
 	}
 }
+
+func TestSigner(t *testing.T) {
+	for name, test := range map[string]struct {
+		credentials string
+		email       string
+		iam         bool
+		err         string
+	}{
+		"Default":        {"", "", false, ""},
+		"ServiceAccount": {`{"type": "service_account", "client_email": "argo@my-project.iam.gserviceaccount.com"}`, "argo@my-project.iam.gserviceaccount.com", false, ""},
+		"ExternalAccount": {
+			`{"type": "external_account", "service_account_impersonation_url": "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/argo@my-project.iam.gserviceaccount.com:generateAccessToken"}`,
+			"argo@my-project.iam.gserviceaccount.com",
+			true,
+			"",
+		},
+		"ExternalAccountNoImpersonation": {`{"type": "external_account"}`, "", false, "GCS external_account credentials must have a service_account_impersonation_url to sign URLs"},
+		"Invalid":                        {`{`, "", false, "GCS credentials: unexpected end of JSON input"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			email, iam, err := signer(test.credentials)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.email, email)
+				assert.Equal(t, test.iam, iam)
+			}
+		})
+	}
+}
+
+func TestSignBlob(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/-/serviceAccounts/argo@my-project.iam.gserviceaccount.com:signBlob", r.URL.Path)
+		req := &iamcredentials.SignBlobRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("my-payload")), req.Payload)
+		_ = json.NewEncoder(w).Encode(&iamcredentials.SignBlobResponse{SignedBlob: base64.StdEncoding.EncodeToString([]byte("my-signature"))})
+	}))
+	defer srv.Close()
+	sign := signBlob("argo@my-project.iam.gserviceaccount.com", option.WithEndpoint(srv.URL+"/"), option.WithoutAuthentication())
+	signature, err := sign([]byte("my-payload"))
+	require.NoError(t, err)
+	assert.Equal(t, "my-signature", string(signature))
+}
+
+func TestSignedURL(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	serviceAccountKey, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "argo@my-project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+	})
+	require.NoError(t, err)
+	driver := &ArtifactDriver{ServiceAccountKey: string(serviceAccountKey)}
+	signedURL, err := driver.SignedURL(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{GCS: &wfv1.GCSArtifact{
+		GCSBucket: wfv1.GCSBucket{Bucket: "my-bucket"},
+		Key:       "my-key",
+	}}}, time.Hour)
+	require.NoError(t, err)
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/my-bucket/my-key", u.Path)
+	assert.Contains(t, u.Query().Get("X-Goog-Credential"), "argo@my-project.iam.gserviceaccount.com")
+	assert.NotEmpty(t, u.Query().Get("X-Goog-Signature"))
+}
@@ -1,8 +1,40 @@
 package resource
 
-import "context"
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
 
 type Interface interface {
 	GetSecret(ctx context.Context, name, key string) (string, error)
 	GetConfigMapKey(ctx context.Context, name, key string) (string, error)
 }
+
+// New returns the resources of the namespace, i.e. the secrets, and config maps, that artifact drivers get their
+// credentials, and other configuration, from
+func New(kubeClient kubernetes.Interface, namespace string) Interface {
+	return resources{kubeClient, namespace}
+}
+
+type resources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r resources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r resources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 
 	"github.com/argoproj/pkg/file"
 	argos3 "github.com/argoproj/pkg/s3"
@@ -32,6 +34,7 @@ type ArtifactDriver struct {
 	KmsEncryptionContext  string
 	EnableEncryption      bool
 	ServerSideCustomerKey string
+	Mirrors               []wfv1.S3Mirror
 }
 
 var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
@@ -58,8 +61,63 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 	return argos3.NewS3Client(ctx, opts)
 }
 
-// Load downloads artifacts from S3 compliant storage
+// Load downloads artifacts from S3 compliant storage, from the first of the bucket, or its mirrors, it can be loaded from
 func (s3Driver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	return s3Driver.loadMirrors(inputArtifact, os.Getenv("AWS_REGION"), func(driver *ArtifactDriver, artifact *wfv1.Artifact) error {
+		return driver.load(artifact, path)
+	})
+}
+
+// loadMirrors loads the artifact from the first of the bucket, or its mirrors, it can be loaded from. As the mirrors
+// replicate the bucket, the artifact is not found if the bucket does not have it, whatever the later mirrors return.
+func (s3Driver *ArtifactDriver) loadMirrors(inputArtifact *wfv1.Artifact, region string, load func(driver *ArtifactDriver, artifact *wfv1.Artifact) error) error {
+	var err error
+	for _, m := range s3Driver.loadOrder(region) {
+		driver, artifact := s3Driver.mirror(inputArtifact, m)
+		if err = load(driver, artifact); err == nil {
+			return nil
+		}
+		if m == (wfv1.S3Mirror{}) && errors.IsCode(errors.CodeNotFound, err) {
+			return err
+		}
+		log.WithError(err).WithFields(log.Fields{"endpoint": driver.Endpoint, "bucket": artifact.S3.Bucket, "key": artifact.S3.Key}).Warn("Failed to load S3 artifact")
+	}
+	return err
+}
+
+// loadOrder returns the bucket and its mirrors, in the order artifacts are loaded from them: the mirrors in the region
+// first, then the bucket, then the other mirrors. The bucket is the mirror with no fields set.
+func (s3Driver *ArtifactDriver) loadOrder(region string) []wfv1.S3Mirror {
+	var local, other []wfv1.S3Mirror
+	for _, m := range s3Driver.Mirrors {
+		if region != "" && m.Region == region {
+			local = append(local, m)
+		} else {
+			other = append(other, m)
+		}
+	}
+	return append(append(local, wfv1.S3Mirror{}), other...)
+}
+
+// mirror returns the driver, and the artifact, for the mirror, or for the bucket if the mirror has no fields set
+func (s3Driver *ArtifactDriver) mirror(art *wfv1.Artifact, m wfv1.S3Mirror) (*ArtifactDriver, *wfv1.Artifact) {
+	driver, s3 := *s3Driver, *art.S3
+	if m.Endpoint != "" {
+		driver.Endpoint = m.Endpoint
+	}
+	if m.Region != "" {
+		driver.Region = m.Region
+		s3.Region = m.Region
+	}
+	if m.Bucket != "" {
+		s3.Bucket = m.Bucket
+	}
+	artifact := art.DeepCopy()
+	artifact.S3 = &s3
+	return &driver, artifact
+}
+
+func (s3Driver *ArtifactDriver) load(inputArtifact *wfv1.Artifact, path string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -103,8 +161,33 @@ func loadS3Artifact(s3cli argos3.S3Client, inputArtifact *wfv1.Artifact, path st
 	return true, nil
 }
 
-// Save saves an artifact to S3 compliant storage
+// Save saves an artifact to S3 compliant storage, and then replicates it to the mirrors that are replicated by the
+// executor. Failing to replicate it is only logged.
 func (s3Driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	if err := s3Driver.save(path, outputArtifact); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	for _, m := range s3Driver.Mirrors {
+		if !m.Replicate {
+			continue
+		}
+		wg.Add(1)
+		go func(m wfv1.S3Mirror) {
+			defer wg.Done()
+			driver, artifact := s3Driver.mirror(outputArtifact, m)
+			// the bucket is only created in the bucket's region
+			artifact.S3.CreateBucketIfNotPresent = nil
+			if err := driver.save(path, artifact); err != nil {
+				log.WithError(err).WithFields(log.Fields{"endpoint": driver.Endpoint, "bucket": artifact.S3.Bucket, "key": artifact.S3.Key}).Warn("Failed to replicate S3 artifact to mirror")
+			}
+		}(m)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (s3Driver *ArtifactDriver) save(path string, outputArtifact *wfv1.Artifact) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -173,3 +256,38 @@ func (s3Driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 
 	return files, err
 }
+
+var _ artifactscommon.ArtifactDeleter = &ArtifactDriver{}
+
+// Delete deletes the object of the artifact's key, and every object of the directory of the key, if it is one. The
+// S3 client of argoproj/pkg cannot delete, so this uses a minio client with the same credentials.
+func (s3Driver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	return waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			log.WithFields(log.Fields{"bucket": artifact.S3.Bucket, "key": artifact.S3.Key}).Info("S3 Delete")
+			creds, err := argos3.GetCredentials(argos3.S3ClientOpts{Region: s3Driver.Region, AccessKey: s3Driver.AccessKey, SecretKey: s3Driver.SecretKey, RoleARN: s3Driver.RoleARN, UseSDKCreds: s3Driver.UseSDKCreds})
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to get S3 credentials: %v", err)
+			}
+			client, err := minio.New(s3Driver.Endpoint, &minio.Options{Creds: creds, Secure: s3Driver.Secure, Region: s3Driver.Region})
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
+			}
+			keys := []string{artifact.S3.Key}
+			for object := range client.ListObjects(ctx, artifact.S3.Bucket, minio.ListObjectsOptions{Prefix: strings.TrimSuffix(artifact.S3.Key, "/") + "/", Recursive: true}) {
+				if object.Err != nil {
+					return !isTransientS3Err(object.Err), fmt.Errorf("failed to list directory: %v", object.Err)
+				}
+				keys = append(keys, object.Key)
+			}
+			for _, key := range keys {
+				// deleting a key that does not exist succeeds
+				if err := client.RemoveObject(ctx, artifact.S3.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+					return !isTransientS3Err(err), fmt.Errorf("failed to delete %s: %v", key, err)
+				}
+			}
+			return true, nil
+		})
+}
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -397,3 +399,63 @@ func TestSaveS3Artifact(t *testing.T) {
 		_ = os.Unsetenv(transientEnvVarKey)
 	}
 }
+
+func TestMirrors(t *testing.T) {
+	driver := &ArtifactDriver{Endpoint: "s3.us-east-1.amazonaws.com", Region: "us-east-1", Mirrors: []wfv1.S3Mirror{
+		{Endpoint: "s3.eu-west-1.amazonaws.com", Bucket: "my-bucket-eu", Region: "eu-west-1"},
+		{Endpoint: "s3.us-west-2.amazonaws.com", Bucket: "my-bucket-us-west-2", Region: "us-west-2"},
+	}}
+	t.Run("LoadOrder", func(t *testing.T) {
+		assert.Equal(t, []wfv1.S3Mirror{{}, driver.Mirrors[0], driver.Mirrors[1]}, driver.loadOrder(""))
+		assert.Equal(t, []wfv1.S3Mirror{{}, driver.Mirrors[0], driver.Mirrors[1]}, driver.loadOrder("us-east-1"))
+		assert.Equal(t, []wfv1.S3Mirror{driver.Mirrors[1], {}, driver.Mirrors[0]}, driver.loadOrder("us-west-2"))
+	})
+	t.Run("Mirror", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Region: "us-east-1"}, Key: "my-key"}}}
+		mirrorDriver, mirrorArt := driver.mirror(art, driver.Mirrors[0])
+		assert.Equal(t, "s3.eu-west-1.amazonaws.com", mirrorDriver.Endpoint)
+		assert.Equal(t, "eu-west-1", mirrorDriver.Region)
+		assert.Equal(t, "my-bucket-eu", mirrorArt.S3.Bucket)
+		assert.Equal(t, "my-key", mirrorArt.S3.Key)
+		// the bucket is unchanged
+		assert.Equal(t, "s3.us-east-1.amazonaws.com", driver.Endpoint)
+		assert.Equal(t, "my-bucket", art.S3.Bucket)
+		bucketDriver, bucketArt := driver.mirror(art, wfv1.S3Mirror{})
+		assert.Equal(t, "s3.us-east-1.amazonaws.com", bucketDriver.Endpoint)
+		assert.Equal(t, "my-bucket", bucketArt.S3.Bucket)
+	})
+	t.Run("Load", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-key"}}}
+		loadFrom := func(errs map[string]error) (*[]string, func(*ArtifactDriver, *wfv1.Artifact) error) {
+			var buckets []string
+			return &buckets, func(_ *ArtifactDriver, artifact *wfv1.Artifact) error {
+				buckets = append(buckets, artifact.S3.Bucket)
+				return errs[artifact.S3.Bucket]
+			}
+		}
+		t.Run("Mirror", func(t *testing.T) {
+			buckets, load := loadFrom(map[string]error{"my-bucket-us-west-2": fmt.Errorf("unavailable")})
+			assert.NoError(t, driver.loadMirrors(art, "us-west-2", load))
+			assert.Equal(t, []string{"my-bucket-us-west-2", "my-bucket"}, *buckets)
+		})
+		t.Run("NotFound", func(t *testing.T) {
+			buckets, load := loadFrom(map[string]error{
+				"my-bucket-us-west-2": errors.New(errors.CodeNotFound, "not replicated yet"),
+				"my-bucket":           errors.New(errors.CodeNotFound, "not found"),
+			})
+			err := driver.loadMirrors(art, "us-west-2", load)
+			assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+			assert.EqualError(t, err, "not found")
+			assert.Equal(t, []string{"my-bucket-us-west-2", "my-bucket"}, *buckets, "the mirrors after the bucket are not tried")
+		})
+		t.Run("Unavailable", func(t *testing.T) {
+			buckets, load := loadFrom(map[string]error{
+				"my-bucket":           fmt.Errorf("unavailable"),
+				"my-bucket-eu":        errors.New(errors.CodeNotFound, "not replicated yet"),
+				"my-bucket-us-west-2": fmt.Errorf("access denied"),
+			})
+			assert.EqualError(t, driver.loadMirrors(art, "", load), "access denied")
+			assert.Equal(t, []string{"my-bucket", "my-bucket-eu", "my-bucket-us-west-2"}, *buckets)
+		})
+	})
+}
@@ -15,8 +15,17 @@ const (
 	// DockerSockVolumeName is the volume name for the /var/run/docker.sock host path volume
 	DockerSockVolumeName = "docker-sock"
 
+	// CheckpointArtifactName is the name of the input artifact that a template's checkpoint is loaded from, and saved to
+	CheckpointArtifactName = "argo-checkpoint"
+
 	// AnnotationKeyDefaultContainer is the annotation that specify container that will be used by default in case of kubectl commands for example
 	AnnotationKeyDefaultContainer = "kubectl.kubernetes.io/default-container"
+	// AnnotationKeySafeToEvict is the annotation that tells the cluster autoscaler whether it may evict a pod to scale
+	// down its node
+	AnnotationKeySafeToEvict = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+	// AnnotationKeyUserNamespaceMode is the annotation that tells CRI-O to run a pod's containers in a user namespace of
+	// their own
+	AnnotationKeyUserNamespaceMode = "io.kubernetes.cri-o.userns-mode"
 
 	// AnnotationKeyNodeID is the ID of the node.
 	// Historically, the pod name was the same as the node ID.
@@ -30,6 +39,11 @@ const (
 	// AnnotationKeyRBACRule is a rule to match the claims
 	AnnotationKeyRBACRule           = workflow.WorkflowFullName + "/rbac-rule"
 	AnnotationKeyRBACRulePrecedence = workflow.WorkflowFullName + "/rbac-rule-precedence"
+	// AnnotationKeyRBACReadOnly, if "true", only allows users mapped to the service account to make read-only calls
+	AnnotationKeyRBACReadOnly = workflow.WorkflowFullName + "/rbac-read-only"
+	// AnnotationKeyRBACWorkflowActions is a comma-separated list of the only actions on workflows (e.g. "resume") users
+	// mapped to the service account may perform
+	AnnotationKeyRBACWorkflowActions = workflow.WorkflowFullName + "/rbac-workflow-actions"
 
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
@@ -47,6 +61,34 @@ const (
 
 	// AnnotationKeyProgress is N/M progress for the node
 	AnnotationKeyProgress = workflow.WorkflowFullName + "/progress"
+	// AnnotationKeyProgressMessage is the message the node reports with its progress, e.g. what it is doing
+	AnnotationKeyProgressMessage = workflow.WorkflowFullName + "/progress-message"
+	// AnnotationKeyHeartbeat is the time the wait container of a pod, or the agent of a workflow's task set, last
+	// reported it was running
+	AnnotationKeyHeartbeat = workflow.WorkflowFullName + "/heartbeat"
+	// AnnotationKeyPluginVersion is the version of an executor plugin, shown by the Argo Server's plugin catalog
+	AnnotationKeyPluginVersion = workflow.WorkflowFullName + "/plugin-version"
+	// AnnotationKeyPluginTemplates is a comma-separated list of the keys of the plugin templates an executor plugin
+	// executes, e.g. "slack,tekton", shown by the Argo Server's plugin catalog. The default is the plugin's name
+	AnnotationKeyPluginTemplates = workflow.WorkflowFullName + "/plugin-templates"
+	// AnnotationKeyDescription is the description of a resource, e.g. a workflow template, or an executor plugin
+	AnnotationKeyDescription = workflow.WorkflowFullName + "/description"
+	// AnnotationKeyOutputsTruncated is why the wait container truncated, or did not save, any of the pod's outputs
+	AnnotationKeyOutputsTruncated = workflow.WorkflowFullName + "/outputs-truncated"
+
+	// AnnotationKeyArtifactsDeleted is the time the output artifacts of a completed workflow were deleted, as its
+	// artifact retention tier expired
+	AnnotationKeyArtifactsDeleted = workflow.WorkflowFullName + "/artifacts-deleted"
+
+	// AnnotationKeyPreset is the name of the preset a workflow was submitted with
+	AnnotationKeyPreset = workflow.WorkflowFullName + "/preset"
+
+	// AnnotationKeyInjectedFault is the name of the fault the controller injected into a pod, which fails its node
+	AnnotationKeyInjectedFault = workflow.WorkflowFullName + "/injected-fault"
+
+	// AnnotationKeyCluster is the name of the cluster a workflow is in, added by the Argo Server when it is
+	// configured with other clusters
+	AnnotationKeyCluster = workflow.WorkflowFullName + "/cluster"
 
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
@@ -67,20 +109,44 @@ const (
 	LabelKeyWorkflowArchivingStatus = workflow.WorkflowFullName + "/workflow-archiving-status"
 	// LabelKeyWorkflow is the pod metadata label to indicate the associated workflow name
 	LabelKeyWorkflow = workflow.WorkflowFullName + "/workflow"
+	// LabelKeyWorkflowNamespace is the label of the pods, and the ephemeral namespace, of a workflow whose pods run in
+	// an ephemeral namespace, to indicate the namespace of the workflow
+	LabelKeyWorkflowNamespace = workflow.WorkflowFullName + "/workflow-namespace"
+	// LabelKeyNetworkPolicy is the label of the pods of a template with a network policy, which the network policy the
+	// controller creates for them selects them by
+	LabelKeyNetworkPolicy = workflow.WorkflowFullName + "/network-policy"
 	// LabelKeyPhase is a label applied to workflows to indicate the current phase of the workflow (for filtering purposes)
 	LabelKeyPhase = workflow.WorkflowFullName + "/phase"
 	// LabelKeyPreviousWorkflowName is a label applied to resubmitted workflows
 	LabelKeyPreviousWorkflowName = workflow.WorkflowFullName + "/resubmitted-from-workflow"
+	// LabelKeyParentWorkflow is a label applied to Workflows that are created by a child workflow template, the value
+	// is the name of the workflow that created them
+	LabelKeyParentWorkflow = workflow.WorkflowFullName + "/parent-workflow"
 	// LabelKeyCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
+	// AnnotationKeyWorkflowTemplateRevision is the revision of a WorkflowTemplate, which the Argo Server increments each
+	// time the template's spec is changed
+	AnnotationKeyWorkflowTemplateRevision = workflow.WorkflowFullName + "/workflow-template-revision"
+	// AnnotationKeyWorkflowTemplateRevisionHash is the SHA-256 of the WorkflowTemplate stored in a revision's config map,
+	// which is verified when the revision is loaded
+	AnnotationKeyWorkflowTemplateRevisionHash = workflow.WorkflowFullName + "/workflow-template-revision-hash"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
+	// LabelKeyOnShutdown is a label applied to Pods that are run from onShutdown nodes, so that they are not shut down when stopping, or terminating, a Workflow
+	LabelKeyOnShutdown = workflow.WorkflowFullName + "/on-shutdown"
+	// LabelKeyAgent is a label applied to the agent pods of workflows, which run the executor plugins' sidecars
+	LabelKeyAgent = workflow.WorkflowFullName + "/agent"
+	// LabelKeyAPIToken is a label applied to the secrets that store API tokens issued by the Argo Server, the value is the token's name
+	LabelKeyAPIToken = workflow.WorkflowFullName + "/api-token"
+	// FinalizerPodStatus is a finalizer added to pods, when ARGO_POD_STATUS_CAPTURE_FINALIZER is true, so that they
+	// cannot be removed before the controller has recorded their status and outputs
+	FinalizerPodStatus = workflow.WorkflowFullName + "/status"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -96,6 +162,9 @@ const (
 	ExecutorStagingEmptyDir = "/argo/staging"
 	// ExecutorScriptSourcePath is the path which init will write the script source file to for script templates
 	ExecutorScriptSourcePath = "/argo/staging/script"
+	// ExecutorExternalSecretsDir is the path of the in-memory emptydir which init writes the secrets of input parameters
+	// read from secret providers to, e.g. /argo/secrets/PASSWORD
+	ExecutorExternalSecretsDir = "/argo/secrets"
 	// ExecutorResourceManifestPath is the path which init will write the a manifest file to for resource templates
 	ExecutorResourceManifestPath = "/tmp/manifest.yaml"
 
@@ -105,6 +174,10 @@ const (
 	EnvVarPodName = "ARGO_POD_NAME"
 	// EnvVarWorkflowName is the name of the workflow for which the an agent is responsible for
 	EnvVarWorkflowName = "ARGO_WORKFLOW_NAME"
+	// EnvVarWorkflowUID is the UID of the workflow, which the executor adds to its log entries
+	EnvVarWorkflowUID = "ARGO_WORKFLOW_UID"
+	// EnvVarNodeID is the ID of the node of the pod, which the executor adds to its log entries
+	EnvVarNodeID = "ARGO_NODE_ID"
 	// EnvVarPluginAddresses is a list of plugin addresses
 	EnvVarPluginAddresses = "ARGO_PLUGIN_ADDRESSES"
 	// EnvVarContainerName container the container's name for the current pod
@@ -113,6 +186,9 @@ const (
 	EnvVarDeadline = "ARGO_DEADLINE"
 	// EnvVarIncludeScriptOutput capture the stdout and stderr
 	EnvVarIncludeScriptOutput = "ARGO_INCLUDE_SCRIPT_OUTPUT"
+	// EnvVarScriptSourcePath is the path which init will write the script source file to, if it is not
+	// ExecutorScriptSourcePath, because the script's runtime has a file extension
+	EnvVarScriptSourcePath = "ARGO_SCRIPT_SOURCE_PATH"
 	// EnvVarTemplate is the template
 	EnvVarTemplate = "ARGO_TEMPLATE"
 	// EnvVarContainerRuntimeExecutor contains the name of the container runtime executor to use, empty is equal to "docker"
@@ -133,12 +209,37 @@ const (
 	EnvVarProgressFileTickDuration = "ARGO_PROGRESS_FILE_TICK_DURATION"
 	// EnvVarProgressFile is the file watched for reporting progress
 	EnvVarProgressFile = "ARGO_PROGRESS_FILE"
+	// EnvVarPodStatusCaptureFinalizer is whether the controller adds FinalizerPodStatus to pods
+	EnvVarPodStatusCaptureFinalizer = "ARGO_POD_STATUS_CAPTURE_FINALIZER"
 	// EnvVarDefaultRequeueTime is the default requeue time for Workflow Informers. For more info, see rate_limiters.go
 	EnvVarDefaultRequeueTime = "DEFAULT_REQUEUE_TIME"
 	// EnvAgentTaskWorkers is the number of task workers for the agent pod
 	EnvAgentTaskWorkers = "ARGO_AGENT_TASK_WORKERS"
 	// EnvAgentPatchRate is the rate that the Argo Agent will patch the Workflow TaskSet
 	EnvAgentPatchRate = "ARGO_AGENT_PATCH_RATE"
+	// EnvAgentMaxResultSize is the size, in bytes, over which the Argo Agent offloads the outputs of a task to a config
+	// map, rather than patching them into the Workflow TaskSet. Outputs are never offloaded if it is not set.
+	EnvAgentMaxResultSize = "ARGO_AGENT_MAX_RESULT_SIZE"
+	// EnvVarHeartbeatInterval is how often the wait container, and the Argo Agent, report heartbeats. They are not
+	// reported if it is not set.
+	EnvVarHeartbeatInterval = "ARGO_HEARTBEAT_INTERVAL"
+	// EnvVarMaxOutputParameterSize is the maximum size, in bytes, of an output parameter the wait container reports.
+	// Parameters are not truncated if it is not set.
+	EnvVarMaxOutputParameterSize = "ARGO_MAX_OUTPUT_PARAMETER_SIZE"
+	// EnvVarMaxOutputArtifacts is the maximum number of output artifacts the wait container saves. Artifacts are not
+	// limited if it is not set.
+	EnvVarMaxOutputArtifacts = "ARGO_MAX_OUTPUT_ARTIFACTS"
+	// EnvVarArtifactParallelism is the number of artifacts the init container loads, or the wait container saves, at the
+	// same time. They are loaded, and saved, one at a time if it is not set.
+	EnvVarArtifactParallelism = "ARGO_ARTIFACT_PARALLELISM"
+	// EnvVarDigestArtifacts is whether the wait container records the digest of each output artifact it saves, which it
+	// only does if the workflow has a template with an automatic memoization key
+	EnvVarDigestArtifacts = "ARGO_DIGEST_ARTIFACTS"
+	// EnvVarArtifactUploadFault is the name of the fault the controller injected into a pod, which fails the upload of
+	// its output artifacts
+	EnvVarArtifactUploadFault = "ARGO_ARTIFACT_UPLOAD_FAULT"
+	// EnvVarSecretProviders is the secret providers the init container reads the secrets of input parameters from
+	EnvVarSecretProviders = "ARGO_SECRET_PROVIDERS"
 
 	// ContainerRuntimeExecutorDocker to use docker as container runtime executor
 	ContainerRuntimeExecutorDocker = "docker"
@@ -183,6 +284,8 @@ const (
 	GlobalVarWorkflowParameters = "workflow.parameters"
 	// GlobalVarWorkflowCronScheduleTime is the scheduled timestamp of a Workflow started by a CronWorkflow
 	GlobalVarWorkflowCronScheduleTime = "workflow.scheduledTime"
+	// GlobalVarWorkflowProfile is the prefix of the variables of the workflow's profile
+	GlobalVarWorkflowProfile = "workflow.profile"
 
 	// LabelKeyConfigMapType is the label key for the type of configmap.
 	LabelKeyConfigMapType = "workflows.argoproj.io/configmap-type"
@@ -192,6 +295,18 @@ const (
 	LabelValueTypeConfigMapParameter = "Parameter"
 	// LabelValueTypeConfigMapExecutorPlugin is a key for configmaps that contains an executor plugin.
 	LabelValueTypeConfigMapExecutorPlugin = "ExecutorPlugin"
+	// LabelValueTypeConfigMapNotifications is a key for configmaps that contains notification endpoints.
+	LabelValueTypeConfigMapNotifications = "Notifications"
+	// LabelValueTypeConfigMapWorkflowTemplateRevision is a key for configmaps that contain a revision of a workflow template.
+	LabelValueTypeConfigMapWorkflowTemplateRevision = "WorkflowTemplateRevision"
+	// LabelValueTypeConfigMapShareLink is a key for configmaps that record a single-use share link has not been used.
+	LabelValueTypeConfigMapShareLink = "ShareLink"
+	// LabelValueTypeConfigMapTaskResult is a key for configmaps that contain the offloaded outputs of a Workflow TaskSet task.
+	LabelValueTypeConfigMapTaskResult = "TaskResult"
+	// LabelValueTypeConfigMapProfile is a key for configmaps that contain a profile workflows can bind to.
+	LabelValueTypeConfigMapProfile = "Profile"
+	// LabelValueTypeConfigMapParameterPreset is a key for configmaps that contain a preset of parameters and labels workflows can be submitted with.
+	LabelValueTypeConfigMapParameterPreset = "ParameterPreset"
 
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
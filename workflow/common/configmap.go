@@ -32,3 +32,11 @@ func GetConfigMapValue(configMapInformer cache.SharedIndexInformer, namespace, n
 	return "", fmt.Errorf("ConfigMap '%s' does not exist. Please make sure it has the label %s: %s to be detectable by the controller",
 		name, LabelKeyConfigMapType, LabelValueTypeConfigMapParameter)
 }
+
+// TaskResultConfigMapKey is the key of the outputs in a config map that a task's outputs were offloaded to
+const TaskResultConfigMapKey = "outputs"
+
+// TaskResultConfigMapName is the name of the config map the outputs of a Workflow TaskSet task are offloaded to
+func TaskResultConfigMapName(nodeID string) string {
+	return nodeID + "-outputs"
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
@@ -159,11 +160,21 @@ func ProcessArgs(tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams,
 				}
 				inParam.Value = wfv1.AnyStringPtr(cmValue)
 			}
+		} else if inParam.ValueFrom != nil && inParam.ValueFrom.ExternalSecret != nil {
+			// the secret is only ever read by the init container, the value is the path it writes it to
+			inParam.Value = wfv1.AnyStringPtr(filepath.Join(ExecutorExternalSecretsDir, inParam.Name))
 		} else {
 			if inParam.Value == nil {
 				return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s was not supplied", inParam.Name)
 			}
 		}
+		// when validating, the value may be a variable that is not known until the workflow runs
+		if inParam.Schema != nil && inParam.Value != nil && (inParam.ValueFrom == nil || inParam.ValueFrom.ExternalSecret == nil) &&
+			!(validateOnly && (strings.Contains(inParam.Value.String(), "{{") || NewPlaceholderGenerator().IsPlaceholder(inParam.Value.String()))) {
+			if err := inParam.Schema.ValidateValue(inParam.Value.String()); err != nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s %v", inParam.Name, err)
+			}
+		}
 
 		newTmpl.Inputs.Parameters[i] = inParam
 	}
@@ -315,6 +326,11 @@ var defaultPatchBackoff = wait.Backoff{
 
 // AddPodAnnotation adds an annotation to pod
 func AddPodAnnotation(ctx context.Context, c kubernetes.Interface, podName, namespace, key, value string, options ...interface{}) error {
+	return AddPodAnnotations(ctx, c, podName, namespace, map[string]string{key: value}, options...)
+}
+
+// AddPodAnnotations adds annotations to pod, in one patch
+func AddPodAnnotations(ctx context.Context, c kubernetes.Interface, podName, namespace string, annotations map[string]string, options ...interface{}) error {
 	backoff := defaultPatchBackoff
 	for _, option := range options {
 		switch v := option.(type) {
@@ -324,16 +340,14 @@ func AddPodAnnotation(ctx context.Context, c kubernetes.Interface, podName, name
 			panic("unknown option type")
 		}
 	}
-	return addPodMetadata(ctx, c, "annotations", podName, namespace, key, value, backoff)
+	return addPodMetadata(ctx, c, "annotations", podName, namespace, annotations, backoff)
 }
 
-// addPodMetadata is helper to either add a pod label or annotation to the pod
-func addPodMetadata(ctx context.Context, c kubernetes.Interface, field, podName, namespace, key, value string, backoff wait.Backoff) error {
+// addPodMetadata is helper to either add pod labels or annotations to the pod
+func addPodMetadata(ctx context.Context, c kubernetes.Interface, field, podName, namespace string, values map[string]string, backoff wait.Backoff) error {
 	metadata := map[string]interface{}{
 		"metadata": map[string]interface{}{
-			field: map[string]string{
-				key: value,
-			},
+			field: values,
 		},
 	}
 	patch, err := json.Marshal(metadata)
@@ -383,6 +397,10 @@ func GenerateOnExitNodeName(parentNodeName string) string {
 	return fmt.Sprintf("%s.onExit", parentNodeName)
 }
 
+func GenerateOnShutdownNodeName(parentNodeName string) string {
+	return fmt.Sprintf("%s.onShutdown", parentNodeName)
+}
+
 func IsDone(un *unstructured.Unstructured) bool {
 	return un.GetDeletionTimestamp() == nil &&
 		un.GetLabels()[LabelKeyCompleted] == "true" &&
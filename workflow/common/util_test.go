@@ -290,3 +290,21 @@ func TestOverridableDefaultInputArts(t *testing.T) {
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Artifacts[0].Raw.Data, inputRawArt.Data)
 }
+
+func TestProcessArgsParameterSchema(t *testing.T) {
+	tmpl := wfv1.Template{
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{{
+			Name:    "replicas",
+			Default: wfv1.AnyStringPtr("1"),
+			Schema:  &wfv1.ParameterSchema{Minimum: wfv1.NewAmount(1), Maximum: wfv1.NewAmount(10)},
+		}}},
+	}
+	_, err := ProcessArgs(&tmpl, &wfv1.Arguments{}, map[string]string{}, map[string]string{}, false, "", nil)
+	assert.NoError(t, err)
+	args := &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "replicas", Value: wfv1.AnyStringPtr("20")}}}
+	_, err = ProcessArgs(&tmpl, args, map[string]string{}, map[string]string{}, false, "", nil)
+	assert.EqualError(t, err, `inputs.parameters.replicas value "20" must be at most 10`)
+	args = &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "replicas", Value: wfv1.AnyStringPtr("{{steps.count.outputs.result}}")}}}
+	_, err = ProcessArgs(&tmpl, args, map[string]string{}, map[string]string{}, true, "", nil)
+	assert.NoError(t, err, "variables are not known until the workflow runs")
+}
@@ -1,4 +1,4 @@
-package controller
+package common
 
 import (
 	"testing"
@@ -24,7 +24,7 @@ func TestShouldExecute(t *testing.T) {
 		"true == true",
 	}
 	for _, trueExp := range trueExpressions {
-		res, err := shouldExecute(trueExp)
+		res, err := ShouldExecute(trueExp)
 		assert.NoError(t, err)
 		assert.True(t, res)
 	}
@@ -46,7 +46,7 @@ func TestShouldExecute(t *testing.T) {
 		"false == true",
 	}
 	for _, falseExp := range falseExpressions {
-		res, err := shouldExecute(falseExp)
+		res, err := ShouldExecute(falseExp)
 		assert.NoError(t, err)
 		assert.False(t, res)
 	}
@@ -15,6 +15,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -71,6 +73,8 @@ func assessAgentPodStatus(pod *apiv1.Pod) (wfv1.WorkflowPhase, string) {
 
 func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, error) {
 	podName := woc.getAgentPodName()
+	ctx, span := tracing.StartSpan(ctx, "createAgentPod", tracing.WorkflowAttributes(woc.wf.Namespace, woc.wf.Name, woc.wf.UID)...)
+	defer span.End()
 	log := woc.log.WithField("podName", podName)
 
 	obj, exists, err := woc.controller.podInformer.GetStore().Get(cache.ExplicitKey(woc.wf.Namespace + "/" + podName))
@@ -88,10 +92,16 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 	pluginSidecars := woc.getExecutorPlugins()
 	envVars := []apiv1.EnvVar{
 		{Name: common.EnvVarWorkflowName, Value: woc.wf.Name},
+		{Name: common.EnvVarWorkflowUID, Value: string(woc.wf.UID)},
 		{Name: common.EnvAgentPatchRate, Value: env.LookupEnvStringOr(common.EnvAgentPatchRate, GetRequeueTime().String())},
 		{Name: common.EnvVarPluginAddresses, Value: wfv1.MustMarshallJSON(addresses(pluginSidecars))},
 	}
 
+	envVars = append(envVars, tracingEnvVars(ctx)...)
+	if c := woc.controller.Config.Heartbeats; c != nil {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarHeartbeatInterval, Value: c.GetInterval().String()})
+	}
+
 	// If the default number of task workers is overridden, then pass it to the agent pod.
 	if taskWorkers, exists := os.LookupEnv(common.EnvAgentTaskWorkers); exists {
 		envVars = append(envVars, apiv1.EnvVar{
@@ -100,6 +110,14 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		})
 	}
 
+	// If outputs are to be offloaded, then pass the size it happens at to the agent pod.
+	if maxResultSize, exists := os.LookupEnv(common.EnvAgentMaxResultSize); exists {
+		envVars = append(envVars, apiv1.EnvVar{
+			Name:  common.EnvAgentMaxResultSize,
+			Value: maxResultSize,
+		})
+	}
+
 	pod := &apiv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podName,
@@ -107,6 +125,7 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 			Labels: map[string]string{
 				common.LabelKeyWorkflow:  woc.wf.Name, // Allows filtering by pods related to specific workflow
 				common.LabelKeyCompleted: "false",     // Allows filtering by incomplete workflow pods
+				common.LabelKeyAgent:     "true",
 			},
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
@@ -120,7 +139,7 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 				apiv1.Container{
 					Name:            "main",
 					Command:         []string{"argoexec"},
-					Args:            []string{"agent"},
+					Args:            []string{"agent", "--loglevel", logs.ComponentLevel(logs.ComponentAgent).String()},
 					Image:           woc.controller.executorImage(),
 					ImagePullPolicy: woc.controller.executorImagePullPolicy(),
 					Env:             envVars,
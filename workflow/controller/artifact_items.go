@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+const (
+	// maxArtifactItemsSize is the maximum size of the artifact of a `withArtifact` loop, which is read into the
+	// controller's memory
+	maxArtifactItemsSize = 16 * 1024 * 1024
+	// artifactItemsTTL is how long the items of an artifact are cached for, so that they are not read each time the
+	// loop is reconciled
+	artifactItemsTTL = 10 * time.Minute
+)
+
+// artifactItems returns the items of the `withArtifact` artifact, as the JSON list of a `withParam`
+func (woc *wfOperationCtx) artifactItems(ctx context.Context, scope *wfScope, withArtifact string) (string, error) {
+	art, err := scope.resolveArtifact(&wfv1.Artifact{From: withArtifact})
+	if err != nil {
+		return "", err
+	}
+	if err := art.Relocate(woc.artifactRepository.ToArtifactLocation()); err != nil {
+		return "", err
+	}
+	key := woc.wf.Namespace + "/" + wfv1.MustMarshallJSON(art.ArtifactLocation)
+	if items, ok := woc.controller.artifactItemsCache.Get(key); ok {
+		return items.(string), nil
+	}
+	data, err := woc.readArtifact(ctx, art)
+	if err != nil {
+		return "", err
+	}
+	items, err := parseArtifactItems(data)
+	if err != nil {
+		return "", err
+	}
+	woc.controller.artifactItemsCache.Add(key, items, artifactItemsTTL)
+	return items, nil
+}
+
+// readArtifact reads the artifact, which must have been relocated to its repository, and un-archives it if it is a
+// tarball of a single file
+func (woc *wfOperationCtx) readArtifact(ctx context.Context, art *wfv1.Artifact) ([]byte, error) {
+	driver, err := woc.controller.artifactDriverFactory(ctx, art, resource.New(woc.controller.kubeclientset, woc.wf.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	file, err := ioutil.TempFile("", "artifact-items-")
+	if err != nil {
+		return nil, err
+	}
+	_ = file.Close()
+	defer func() { _ = os.Remove(file.Name()) }()
+	if err := driver.Load(art, file.Name()); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(file.Name())
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxArtifactItemsSize {
+		return nil, fmt.Errorf("artifact %s is %d bytes, which is larger than the maximum of %d bytes", art.Name, info.Size(), maxArtifactItemsSize)
+	}
+	data, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		return data, nil
+	}
+	// output artifacts are archived as a gzipped tarball by default
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("artifact %s is a tarball that does not contain a file", art.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tarball of artifact %s: %w", art.Name, err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			if header.Size > maxArtifactItemsSize {
+				return nil, fmt.Errorf("artifact %s is %d bytes, which is larger than the maximum of %d bytes", art.Name, header.Size, maxArtifactItemsSize)
+			}
+			return ioutil.ReadAll(tr)
+		}
+	}
+}
+
+// parseArtifactItems returns the JSON, or YAML, list as JSON
+func parseArtifactItems(data []byte) (string, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return "", fmt.Errorf("withArtifact value could not be parsed as a JSON, or YAML, list: %w", err)
+	}
+	var items []wfv1.Item
+	if err := json.Unmarshal(data, &items); err != nil {
+		return "", fmt.Errorf("withArtifact value could not be parsed as a JSON, or YAML, list: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+type fakeArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	data  []byte
+	loads int
+}
+
+func (d *fakeArtifactDriver) Load(_ *wfv1.Artifact, path string) error {
+	d.loads++
+	return ioutil.WriteFile(path, d.data, 0o600)
+}
+
+func tarball(t *testing.T, name string, data []byte) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(data))}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestParseArtifactItems(t *testing.T) {
+	items, err := parseArtifactItems([]byte(`[{"id": 1}, {"id": 2}]`))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `[{"id": 1}, {"id": 2}]`, items)
+	}
+	items, err = parseArtifactItems([]byte("- foo\n- bar\n"))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `["foo", "bar"]`, items)
+	}
+	_, err = parseArtifactItems([]byte(`{"id": 1}`))
+	assert.Error(t, err)
+}
+
+var withArtifactSteps = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-artifact
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      inputs:
+        artifacts:
+          - name: items
+            s3:
+              key: items.json.tgz
+      steps:
+        - - name: process
+            template: process
+            arguments:
+              parameters:
+                - name: id
+                  value: "{{item.id}}"
+            withArtifact: "{{inputs.artifacts.items}}"
+    - name: process
+      inputs:
+        parameters:
+          - name: id
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestWithArtifactSteps(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(withArtifactSteps)
+	cancel, controller := newController(wf)
+	defer cancel()
+	driver := &fakeArtifactDriver{data: tarball(t, "items.json", []byte(`[{"id": "a"}, {"id": "b"}]`))}
+	controller.artifactDriverFactory = func(_ context.Context, art *wfv1.Artifact, _ resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		// the artifact is relocated to the artifact repository
+		assert.Equal(t, "my-bucket", art.S3.Bucket)
+		assert.Equal(t, "items.json.tgz", art.S3.Key)
+		return driver, nil
+	}
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("process(0:id:a)"))
+	assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("process(1:id:b)"))
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, 1, driver.loads, "the items are cached")
+}
+
+func TestWithArtifactDAG(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-artifact
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      inputs:
+        artifacts:
+          - name: items
+            raw:
+              data: |
+                - foo
+                - bar
+                - baz
+      dag:
+        tasks:
+          - name: process
+            template: process
+            arguments:
+              parameters:
+                - name: id
+                  value: "{{item}}"
+            withArtifact: "{{inputs.artifacts.items}}"
+    - name: process
+      inputs:
+        parameters:
+          - name: id
+      container:
+        image: argoproj/argosay:v2
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.artifactDriverFactory = artifact.NewDriver
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	for _, name := range []string{"process(0:foo)", "process(1:bar)", "process(2:baz)"} {
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName(name), name)
+	}
+}
+
+func TestWithArtifactNotAList(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(withArtifactSteps)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.artifactDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return &fakeArtifactDriver{data: []byte(`{"id": "a"}`)}, nil
+	}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes.FindByDisplayName("[0]")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, "unable to read withArtifact: withArtifact value could not be parsed as a JSON, or YAML, list")
+	}
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+var artifactRetentionPeriod = env.LookupEnvDurationOr("ARTIFACT_RETENTION_PERIOD", 10*time.Minute)
+
+// deleteExpiredArtifacts deletes the output artifacts of the completed workflows whose artifact retention tier has
+// expired, and annotates the workflows so that they are not deleted again
+func (wfc *WorkflowController) deleteExpiredArtifacts(ctx context.Context) {
+	rules := wfc.Config.ArtifactRetention
+	if len(rules) == 0 {
+		return
+	}
+	for _, obj := range wfc.wfInformer.GetStore().List() {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok || !common.IsDone(un) || un.GetAnnotations()[common.AnnotationKeyArtifactsDeleted] != "" {
+			continue
+		}
+		ttl, ok := rules.GetTTL(labels.Set(un.GetLabels()))
+		if !ok || ttl == 0 {
+			continue
+		}
+		wf, err := util.FromUnstructured(un)
+		if err != nil {
+			log.WithError(err).WithField("workflow", un.GetName()).Error("Failed to convert unstructured to workflow")
+			continue
+		}
+		if wf.Status.FinishedAt.IsZero() || time.Since(wf.Status.FinishedAt.Time) < ttl {
+			continue
+		}
+		logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "ttl": ttl})
+		if err := wfc.deleteArtifacts(ctx, wf); err != nil {
+			logCtx.WithError(err).Error("Failed to delete expired artifacts")
+			continue
+		}
+		logCtx.Info("Deleted expired artifacts")
+	}
+}
+
+// deleteArtifacts deletes the output artifacts of the workflow's pods, apart from those of memoized, or re-used,
+// nodes, which belong to other workflows, and those whose key the template sets, which the workflow does not own. The
+// workflow is only annotated once every other artifact is deleted, so those of drivers that cannot delete, e.g. Git, or
+// HTTP, are kept, and the workflow is tried again.
+func (wfc *WorkflowController) deleteArtifacts(ctx context.Context, wf *wfv1.Workflow) error {
+	if err := wfc.hydrator.Hydrate(wf); err != nil {
+		return err
+	}
+	deleted := make(map[string]bool)
+	var undeletable []string
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.Outputs == nil || (node.MemoizationStatus != nil && node.MemoizationStatus.Hit) {
+			continue
+		}
+		tmpl := nodeTemplate(wf, &node)
+		for _, art := range node.Outputs.Artifacts {
+			if !art.HasLocation() || !hasGeneratedKey(tmpl, art) {
+				continue
+			}
+			key, err := art.GetKey()
+			if err != nil || deleted[key] {
+				continue
+			}
+			art := art
+			driver, err := wfc.artifactDriverFactory(ctx, &art, resource.New(wfc.kubeclientset, wf.Namespace))
+			if err != nil {
+				return fmt.Errorf("artifact %s of node %s: %w", art.Name, node.Name, err)
+			}
+			deleter, ok := driver.(artifactscommon.ArtifactDeleter)
+			if !ok {
+				undeletable = append(undeletable, key)
+				continue
+			}
+			if err := deleter.Delete(&art); err != nil {
+				return fmt.Errorf("failed to delete artifact %s of node %s: %w", art.Name, node.Name, err)
+			}
+			deleted[key] = true
+		}
+	}
+	if len(undeletable) > 0 {
+		return fmt.Errorf("artifacts %s cannot be deleted by their drivers", strings.Join(undeletable, ", "))
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{common.AnnotationKeyArtifactsDeleted: time.Now().UTC().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(ctx, wf.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	return err
+}
+
+// nodeTemplate returns the template of the node, from the workflow, or its stored templates
+func nodeTemplate(wf *wfv1.Workflow, node *wfv1.NodeStatus) *wfv1.Template {
+	scope, resourceName := node.GetTemplateScope()
+	if tmpl := wf.GetStoredTemplate(scope, resourceName, node); tmpl != nil {
+		return tmpl
+	}
+	if node.TemplateName == "" {
+		return nil
+	}
+	return wf.GetTemplateByName(node.TemplateName)
+}
+
+// hasGeneratedKey returns true if the key of the output artifact was generated from the artifact repository, rather
+// than set by the template, e.g. as it is a key of other workflows too. Artifacts the template does not declare, such
+// as archived logs, always have generated keys.
+func hasGeneratedKey(tmpl *wfv1.Template, art wfv1.Artifact) bool {
+	if tmpl == nil {
+		return false
+	}
+	declared := tmpl.Outputs.GetArtifactByName(art.Name)
+	return declared == nil || !declared.HasKey()
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+type deletingArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	deleted []string
+}
+
+func (d *deletingArtifactDriver) Delete(art *wfv1.Artifact) error {
+	d.deleted = append(d.deleted, art.S3.Key)
+	return nil
+}
+
+var expiredArtifactsWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: default
+  labels:
+    retention: short
+    workflows.argoproj.io/completed: "true"
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: argoproj/argosay:v2
+      outputs:
+        artifacts:
+          - name: result
+            path: /tmp/result
+          - name: shared
+            path: /tmp/shared
+            s3:
+              key: shared/report.tgz
+status:
+  phase: Succeeded
+  nodes:
+    my-wf:
+      id: my-wf
+      name: my-wf
+      templateName: main
+      type: Pod
+      phase: Succeeded
+      outputs:
+        artifacts:
+          - name: result
+            s3:
+              endpoint: minio:9000
+              bucket: my-bucket
+              key: my-wf/my-wf/result.tgz
+          - name: shared
+            s3:
+              endpoint: minio:9000
+              bucket: my-bucket
+              key: shared/report.tgz
+          - name: main-logs
+            s3:
+              endpoint: minio:9000
+              bucket: my-bucket
+              key: my-wf/my-wf/main.log
+    my-wf-memoized:
+      id: my-wf-memoized
+      name: my-wf-memoized
+      templateName: main
+      type: Pod
+      phase: Succeeded
+      memoizationStatus:
+        hit: true
+        key: my-key
+        cacheName: my-cache
+      outputs:
+        artifacts:
+          - name: result
+            s3:
+              endpoint: minio:9000
+              bucket: my-bucket
+              key: other-wf/other-wf/result.tgz
+`
+
+func TestDeleteExpiredArtifacts(t *testing.T) {
+	expiredArtifacts := func(t *testing.T, finishedAt time.Time, rules config.ArtifactRetention, canDelete bool) (*wfv1.Workflow, []string) {
+		wf := wfv1.MustUnmarshalWorkflow(expiredArtifactsWorkflow)
+		wf.Status.FinishedAt = metav1.NewTime(finishedAt)
+		cancel, controller := newController(wf)
+		defer cancel()
+		controller.Config.ArtifactRetention = rules
+		driver := &deletingArtifactDriver{}
+		controller.artifactDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			if !canDelete {
+				return driver.ArtifactDriver, nil
+			}
+			return driver, nil
+		}
+		ctx := context.Background()
+		controller.deleteExpiredArtifacts(ctx)
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		return wf, driver.deleted
+	}
+	rules := config.ArtifactRetention{
+		{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"retention": "audit"}}},
+		{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"retention": "short"}}, TTL: config.TTL(7 * 24 * time.Hour)},
+	}
+	t.Run("Expired", func(t *testing.T) {
+		wf, deleted := expiredArtifacts(t, time.Now().Add(-8*24*time.Hour), rules, true)
+		assert.ElementsMatch(t, []string{"my-wf/my-wf/result.tgz", "my-wf/my-wf/main.log"}, deleted)
+		assert.NotEmpty(t, wf.Annotations[common.AnnotationKeyArtifactsDeleted])
+	})
+	t.Run("CannotDelete", func(t *testing.T) {
+		wf, deleted := expiredArtifacts(t, time.Now().Add(-8*24*time.Hour), rules, false)
+		assert.Empty(t, deleted)
+		assert.NotContains(t, wf.Annotations, common.AnnotationKeyArtifactsDeleted)
+	})
+	t.Run("NotExpired", func(t *testing.T) {
+		wf, deleted := expiredArtifacts(t, time.Now().Add(-6*24*time.Hour), rules, true)
+		assert.Empty(t, deleted)
+		assert.NotContains(t, wf.Annotations, common.AnnotationKeyArtifactsDeleted)
+	})
+	t.Run("NeverExpires", func(t *testing.T) {
+		wf, deleted := expiredArtifacts(t, time.Now().Add(-8*24*time.Hour), config.ArtifactRetention{{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"retention": "short"}}}}, true)
+		assert.Empty(t, deleted)
+		assert.NotContains(t, wf.Annotations, common.AnnotationKeyArtifactsDeleted)
+	})
+}
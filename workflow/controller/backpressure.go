@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+)
+
+// checkPodCreationBackpressure returns an error, that wraps ErrPodCreationBackpressure, if the pod should not be
+// created yet, because the cluster is saturated
+func (woc *wfOperationCtx) checkPodCreationBackpressure(pod *apiv1.Pod) error {
+	b := woc.controller.Config.PodCreationBackpressure
+	if b == nil {
+		return nil
+	}
+	if reason, err := woc.pendingPodsSaturation(b); err != nil || reason != "" {
+		return backpressureError(reason, err)
+	}
+	if b.ResourceQuotaHeadroom != nil && woc.controller.resourceQuotaInformer != nil {
+		quotas, err := woc.controller.resourceQuotaInformer.GetIndexer().ByIndex(cache.NamespaceIndex, pod.Namespace)
+		if err != nil {
+			return err
+		}
+		for _, obj := range quotas {
+			quota, ok := obj.(*apiv1.ResourceQuota)
+			if !ok {
+				continue
+			}
+			if reason := resourceQuotaSaturation(quota, pod, *b.ResourceQuotaHeadroom); reason != "" {
+				return backpressureError(reason, nil)
+			}
+		}
+	}
+	return nil
+}
+
+func backpressureError(reason string, err error) error {
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrPodCreationBackpressure, reason)
+}
+
+// pendingPodsSaturation returns why there are too many pending pods, or "" if there are not. The pods created by this
+// operation are counted as pending, as the informer may not have seen them yet.
+func (woc *wfOperationCtx) pendingPodsSaturation(b *config.PodCreationBackpressure) (string, error) {
+	if b.MaxPendingPods == 0 && b.MaxPendingPodsPerNamespace == 0 {
+		return "", nil
+	}
+	keys, err := woc.controller.podInformer.GetIndexer().IndexKeys(indexes.PodPhaseIndex, string(apiv1.PodPending))
+	if err != nil {
+		return "", err
+	}
+	pending := len(keys) + woc.createdPods
+	if b.MaxPendingPods > 0 && pending >= b.MaxPendingPods {
+		return fmt.Sprintf("%d pods are pending, and the maximum is %d", pending, b.MaxPendingPods), nil
+	}
+	if b.MaxPendingPodsPerNamespace > 0 {
+		pending = woc.createdPods
+		for _, key := range keys {
+			if strings.HasPrefix(key, woc.wf.Namespace+"/") {
+				pending++
+			}
+		}
+		if pending >= b.MaxPendingPodsPerNamespace {
+			return fmt.Sprintf("%d pods are pending in namespace %s, and the maximum is %d", pending, woc.wf.Namespace, b.MaxPendingPodsPerNamespace), nil
+		}
+	}
+	return "", nil
+}
+
+// resourceQuotaSaturation returns why creating the pod would leave the quota with less than the headroom, as a
+// percentage of its hard limits, or "" if it would not. Quotas with scopes are not checked, as they may not apply to
+// the pod.
+func resourceQuotaSaturation(quota *apiv1.ResourceQuota, pod *apiv1.Pod, headroom int) string {
+	if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+		return ""
+	}
+	requests, limits := podResources(pod)
+	for name, hard := range quota.Status.Hard {
+		var needed resource.Quantity
+		switch name {
+		case apiv1.ResourcePods, "count/pods":
+			needed = *resource.NewQuantity(1, resource.DecimalSI)
+		case apiv1.ResourceCPU, apiv1.ResourceRequestsCPU:
+			needed = requests[apiv1.ResourceCPU]
+		case apiv1.ResourceMemory, apiv1.ResourceRequestsMemory:
+			needed = requests[apiv1.ResourceMemory]
+		case apiv1.ResourceLimitsCPU:
+			needed = limits[apiv1.ResourceCPU]
+		case apiv1.ResourceLimitsMemory:
+			needed = limits[apiv1.ResourceMemory]
+		default:
+			continue
+		}
+		used := quota.Status.Used[name]
+		available := hard.AsApproximateFloat64() * float64(100-headroom) / 100
+		if used.AsApproximateFloat64()+needed.AsApproximateFloat64() > available {
+			return fmt.Sprintf("resource quota %s has %s of %s %s used, and keeps %d%% headroom", quota.Name, used.String(), hard.String(), name, headroom)
+		}
+	}
+	return ""
+}
+
+// podResources returns the requests and limits of the pod, i.e. those of its containers, or of its largest init
+// container if that is larger, and its overhead
+func podResources(pod *apiv1.Pod) (apiv1.ResourceList, apiv1.ResourceList) {
+	requests, limits := apiv1.ResourceList{}, apiv1.ResourceList{}
+	for _, c := range pod.Spec.Containers {
+		addResources(requests, c.Resources.Requests)
+		addResources(limits, c.Resources.Limits)
+	}
+	for _, c := range pod.Spec.InitContainers {
+		maxResources(requests, c.Resources.Requests)
+		maxResources(limits, c.Resources.Limits)
+	}
+	addResources(requests, pod.Spec.Overhead)
+	addResources(limits, pod.Spec.Overhead)
+	return requests, limits
+}
+
+func addResources(list, x apiv1.ResourceList) {
+	for name, q := range x {
+		v := list[name]
+		v.Add(q)
+		list[name] = v
+	}
+}
+
+func maxResources(list, x apiv1.ResourceList) {
+	for name, q := range x {
+		if v, ok := list[name]; !ok || q.Cmp(v) > 0 {
+			list[name] = q.DeepCopy()
+		}
+	}
+}
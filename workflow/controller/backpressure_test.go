@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var fanOutWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: fan-out
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: pod
+          - name: b
+            template: pod
+          - name: c
+            template: pod
+    - name: pod
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestPodCreationBackpressure(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(fanOutWorkflow)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.PodCreationBackpressure = &config.PodCreationBackpressure{MaxPendingPods: 2}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+	delayed := woc.wf.Status.Nodes.Find(func(node wfv1.NodeStatus) bool {
+		return node.Type == wfv1.NodeTypePod && strings.Contains(node.Message, "pod creation delayed")
+	})
+	if assert.NotNil(t, delayed) {
+		assert.Equal(t, wfv1.NodePending, delayed.Phase)
+		assert.Equal(t, "pod creation delayed, as the cluster is saturated: 2 pods are pending, and the maximum is 2", delayed.Message)
+	}
+}
+
+func TestResourceQuotaSaturation(t *testing.T) {
+	quota := &apiv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-quota"},
+		Status: apiv1.ResourceQuotaStatus{
+			Hard: apiv1.ResourceList{apiv1.ResourcePods: resource.MustParse("10"), apiv1.ResourceRequestsCPU: resource.MustParse("4")},
+			Used: apiv1.ResourceList{apiv1.ResourcePods: resource.MustParse("8"), apiv1.ResourceRequestsCPU: resource.MustParse("2")},
+		},
+	}
+	pod := &apiv1.Pod{Spec: apiv1.PodSpec{Containers: []apiv1.Container{{
+		Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1")}},
+	}}}}
+	assert.Empty(t, resourceQuotaSaturation(quota, pod, 0))
+	assert.Equal(t, "resource quota my-quota has 8 of 10 pods used, and keeps 20% headroom", resourceQuotaSaturation(quota, pod, 20))
+	t.Run("Scoped", func(t *testing.T) {
+		scoped := quota.DeepCopy()
+		scoped.Spec.Scopes = []apiv1.ResourceQuotaScope{apiv1.ResourceQuotaScopeBestEffort}
+		assert.Empty(t, resourceQuotaSaturation(scoped, pod, 50))
+	})
+	t.Run("InitContainers", func(t *testing.T) {
+		pod := pod.DeepCopy()
+		pod.Spec.InitContainers = []apiv1.Container{{
+			Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("3")}},
+		}}
+		assert.Equal(t, "resource quota my-quota has 2 of 4 requests.cpu used, and keeps 0% headroom", resourceQuotaSaturation(quota, pod, 0))
+	})
+}
@@ -0,0 +1,258 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+)
+
+// gracefulShutdownTimeout is how long the controller waits, on shutdown, for the workflows being operated on
+var gracefulShutdownTimeout = env.LookupEnvDurationOr("GRACEFUL_SHUTDOWN_TIMEOUT", 20*time.Second)
+
+const checkpointKey = "checkpoint"
+
+// checkpointEntry is when a workflow is due to be operated on, and the version of the workflow, and of its pods, when it
+// was checkpointed
+type checkpointEntry struct {
+	Due     time.Time `json:"due"`
+	Version string    `json:"version,omitempty"`
+}
+
+// checkpointingQueue is the workflow queue, which records when each workflow is next due to be operated on, so that it
+// can be checkpointed when the controller shuts down, and restored by the next leader. Otherwise the next leader
+// operates on every workflow as soon as it starts, which creates a burst of requests, and requeues.
+type checkpointingQueue struct {
+	workqueue.RateLimitingInterface
+	// rateLimiter is the queue's rate limiter, which says when a workflow added rate limited is due
+	rateLimiter workqueue.RateLimiter
+	mu          sync.Mutex
+	due         map[string]time.Time
+	held        map[string]checkpointEntry
+	draining    bool
+	operations  sync.WaitGroup
+}
+
+func newCheckpointingQueue(queue workqueue.RateLimitingInterface, rateLimiter workqueue.RateLimiter) *checkpointingQueue {
+	return &checkpointingQueue{RateLimitingInterface: queue, rateLimiter: rateLimiter, due: map[string]time.Time{}, held: map[string]checkpointEntry{}}
+}
+
+func (q *checkpointingQueue) Add(item interface{}) {
+	q.record(item, 0)
+	q.RateLimitingInterface.Add(item)
+}
+
+func (q *checkpointingQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.record(item, duration)
+	q.RateLimitingInterface.AddAfter(item, duration)
+}
+
+// AddRateLimited adds the workflow after the delay of the rate limiter, as the queue would, so that the delay recorded
+// is the one the workflow is added after
+func (q *checkpointingQueue) AddRateLimited(item interface{}) {
+	d := q.rateLimiter.When(item)
+	q.record(item, d)
+	q.RateLimitingInterface.AddAfter(item, d)
+}
+
+// record records when the workflow is due, which, as in the queue, is the earliest time it was added for
+func (q *checkpointingQueue) record(item interface{}, duration time.Duration) {
+	key, ok := item.(string)
+	if !ok {
+		return
+	}
+	due := time.Now().Add(duration)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if t, ok := q.due[key]; !ok || due.Before(t) {
+		q.due[key] = due
+	}
+}
+
+// hold returns how much longer the workflow is held for, if the checkpoint restored when the controller started
+// leading says it is not due yet, and neither it, nor its pods, have changed since, as the version returns. A workflow
+// is only held once, so that any later changes to it are operated on.
+func (q *checkpointingQueue) hold(key string, version func() string) (time.Duration, bool) {
+	q.mu.Lock()
+	entry, ok := q.held[key]
+	delete(q.held, key)
+	q.mu.Unlock()
+	if !ok || !time.Now().Before(entry.Due) || entry.Version == "" || entry.Version != version() {
+		return 0, false
+	}
+	return time.Until(entry.Due), true
+}
+
+// operating returns false if the queue is draining, otherwise the workflow is operated on, and no longer due
+func (q *checkpointingQueue) operating(key string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.draining {
+		return false
+	}
+	delete(q.due, key)
+	q.operations.Add(1)
+	return true
+}
+
+func (q *checkpointingQueue) operated() {
+	q.operations.Done()
+}
+
+// drain stops workflows being operated on, and waits up to the timeout for those being operated on, returning whether
+// they all completed
+func (q *checkpointingQueue) drain(timeout time.Duration) bool {
+	q.mu.Lock()
+	q.draining = true
+	q.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		q.operations.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// checkpoint returns when each workflow in the queue is due
+func (q *checkpointingQueue) checkpoint() map[string]time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	checkpoint := make(map[string]time.Time, len(q.due))
+	for key, due := range q.due {
+		checkpoint[key] = due
+	}
+	return checkpoint
+}
+
+// restore holds the workflows of the checkpoint until they are due
+func (q *checkpointingQueue) restore(checkpoint map[string]checkpointEntry) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for key, due := range checkpoint {
+		q.held[key] = due
+	}
+}
+
+func (wfc *WorkflowController) checkpointConfigMapName() string {
+	return wfc.configMap + "-checkpoint"
+}
+
+// Shutdown gracefully shuts the controller down, if it is leading. It stops operating on workflows, waits for the
+// workflows being operated on, so that their pods are not created again by the next leader, and then checkpoints
+// when each workflow is due to be operated on again, so that the next leader does not operate on them all at once.
+// It must be called before the context of Run is cancelled, which releases the leader election lease.
+func (wfc *WorkflowController) Shutdown(ctx context.Context) {
+	if atomic.LoadInt32(&wfc.leading) == 0 {
+		return
+	}
+	log.WithField("timeout", gracefulShutdownTimeout).Info("Shutting down, waiting for the workflows being operated on")
+	if !wfc.wfQueue.drain(gracefulShutdownTimeout) {
+		log.Warn("Timed out waiting for the workflows being operated on")
+	}
+	checkpoint := map[string]checkpointEntry{}
+	for key, due := range wfc.wfQueue.checkpoint() {
+		checkpoint[key] = checkpointEntry{Due: due, Version: wfc.workflowVersion(key)}
+	}
+	if err := wfc.saveCheckpoint(ctx, checkpoint); err != nil {
+		log.WithError(err).Error("Failed to save the checkpoint")
+		return
+	}
+	log.WithField("workflows", len(checkpoint)).Info("Saved the checkpoint")
+}
+
+// workflowVersion returns a version of the workflow, and of its pods, that changes whenever any of them do, or "" if the
+// workflow is not found
+func (wfc *WorkflowController) workflowVersion(key string) string {
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return ""
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	podNamespace, _, _ := unstructured.NestedString(un.Object, "status", "podNamespace")
+	if podNamespace == "" {
+		podNamespace = un.GetNamespace()
+	}
+	pods, err := wfc.podInformer.GetIndexer().ByIndex(indexes.WorkflowIndex, indexes.WorkflowIndexValue(podNamespace, un.GetName()))
+	if err != nil {
+		return ""
+	}
+	var versions []string
+	for _, obj := range pods {
+		if pod, ok := obj.(*apiv1.Pod); ok {
+			versions = append(versions, pod.Name+"="+pod.ResourceVersion)
+		}
+	}
+	sort.Strings(versions)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.Join(append([]string{un.GetResourceVersion()}, versions...), ",")))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+func (wfc *WorkflowController) saveCheckpoint(ctx context.Context, checkpoint map[string]checkpointEntry) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	configMaps := wfc.kubeclientset.CoreV1().ConfigMaps(wfc.namespace)
+	cm := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: wfc.checkpointConfigMapName()},
+		Data:       map[string]string{checkpointKey: string(data)},
+	}
+	_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	}
+	return err
+}
+
+// restoreCheckpoint restores the checkpoint saved by the previous leader, if any, and clears it, so that it is only
+// restored once
+func (wfc *WorkflowController) restoreCheckpoint(ctx context.Context) {
+	configMaps := wfc.kubeclientset.CoreV1().ConfigMaps(wfc.namespace)
+	cm, err := configMaps.Get(ctx, wfc.checkpointConfigMapName(), metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the checkpoint")
+		return
+	}
+	data, ok := cm.Data[checkpointKey]
+	if !ok {
+		return
+	}
+	checkpoint := map[string]checkpointEntry{}
+	if err := json.Unmarshal([]byte(data), &checkpoint); err != nil {
+		log.WithError(err).Warn("Failed to unmarshal the checkpoint")
+	} else {
+		wfc.wfQueue.restore(checkpoint)
+		log.WithField("workflows", len(checkpoint)).Info("Restored the checkpoint")
+	}
+	cm.Data = nil
+	if _, err := configMaps.Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		log.WithError(err).Warn("Failed to clear the checkpoint")
+	}
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestCheckpointingQueue(t *testing.T) {
+	rateLimiter := workqueue.DefaultControllerRateLimiter()
+	q := newCheckpointingQueue(workqueue.NewRateLimitingQueue(rateLimiter), rateLimiter)
+	defer q.ShutDown()
+	q.AddAfter("my-ns/later", time.Hour)
+	q.AddAfter("my-ns/sooner", time.Hour)
+	q.AddAfter("my-ns/sooner", time.Minute)
+	q.AddAfter("my-ns/sooner", time.Hour)
+	q.Add("my-ns/now")
+	checkpoint := q.checkpoint()
+	assert.Len(t, checkpoint, 3)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), checkpoint["my-ns/later"], time.Second)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), checkpoint["my-ns/sooner"], time.Second)
+
+	t.Run("Operating", func(t *testing.T) {
+		assert.True(t, q.operating("my-ns/now"))
+		q.operated()
+		assert.NotContains(t, q.checkpoint(), "my-ns/now")
+	})
+	t.Run("AddRateLimited", func(t *testing.T) {
+		q.AddRateLimited("my-ns/rate-limited")
+		assert.WithinDuration(t, time.Now().Add(5*time.Millisecond), q.checkpoint()["my-ns/rate-limited"], time.Second, "the delay of the rate limiter")
+		assert.Equal(t, 1, q.NumRequeues("my-ns/rate-limited"))
+	})
+	t.Run("Hold", func(t *testing.T) {
+		version := func() string { return "v1" }
+		q.restore(map[string]checkpointEntry{
+			"my-ns/held":    {Due: time.Now().Add(time.Minute), Version: "v1"},
+			"my-ns/due":     {Due: time.Now().Add(-time.Minute), Version: "v1"},
+			"my-ns/changed": {Due: time.Now().Add(time.Minute), Version: "v0"},
+		})
+		d, ok := q.hold("my-ns/held", version)
+		assert.True(t, ok)
+		assert.InDelta(t, time.Minute, d, float64(time.Second))
+		_, ok = q.hold("my-ns/held", version)
+		assert.False(t, ok, "workflows are only held once")
+		_, ok = q.hold("my-ns/due", version)
+		assert.False(t, ok)
+		_, ok = q.hold("my-ns/changed", version)
+		assert.False(t, ok, "the workflow, or its pods, changed after the checkpoint")
+	})
+	t.Run("Drain", func(t *testing.T) {
+		assert.True(t, q.operating("my-ns/sooner"))
+		assert.False(t, q.drain(10*time.Millisecond), "my-ns/sooner is still being operated on")
+		assert.False(t, q.operating("my-ns/later"), "nothing is operated on while draining")
+		assert.Contains(t, q.checkpoint(), "my-ns/later")
+		q.operated()
+		assert.True(t, q.drain(time.Second))
+	})
+}
+
+func TestShutdownCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Namespace = "my-ns"
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.namespace = "argo"
+	controller.configMap = "workflow-controller-configmap"
+	configMaps := controller.kubeclientset.CoreV1().ConfigMaps("argo")
+	key := "my-ns/hello-world"
+
+	t.Run("NotLeading", func(t *testing.T) {
+		controller.Shutdown(ctx)
+		_, err := configMaps.Get(ctx, "workflow-controller-configmap-checkpoint", metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+	t.Run("Shutdown", func(t *testing.T) {
+		atomic.StoreInt32(&controller.leading, 1)
+		controller.wfQueue.AddAfter("my-ns/other", time.Hour)
+		controller.Shutdown(ctx)
+		cm, err := configMaps.Get(ctx, "workflow-controller-configmap-checkpoint", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			checkpoint := map[string]checkpointEntry{}
+			assert.NoError(t, json.Unmarshal([]byte(cm.Data[checkpointKey]), &checkpoint))
+			assert.WithinDuration(t, time.Now(), checkpoint[key].Due, time.Second, "queued by the informer")
+			assert.Equal(t, controller.workflowVersion(key), checkpoint[key].Version)
+			assert.NotEmpty(t, checkpoint[key].Version)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), checkpoint["my-ns/other"].Due, time.Second)
+			assert.Empty(t, checkpoint["my-ns/other"].Version, "the workflow does not exist")
+		}
+		assert.False(t, controller.processNextItem(ctx), "nothing is operated on after shutdown")
+		pods, err := listPods(newWorkflowOperationCtx(wf, controller))
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items)
+		}
+	})
+	restore := func(t *testing.T, version func(controller *WorkflowController) string) (*WorkflowController, context.CancelFunc) {
+		cancel, controller := newController(wf)
+		controller.namespace = "argo"
+		controller.configMap = "workflow-controller-configmap"
+		data, _ := json.Marshal(map[string]checkpointEntry{key: {Due: time.Now().Add(time.Hour), Version: version(controller)}})
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("argo").Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "workflow-controller-configmap-checkpoint"},
+			Data:       map[string]string{checkpointKey: string(data)},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		controller.restoreCheckpoint(ctx)
+		assert.True(t, controller.processNextItem(ctx))
+		return controller, cancel
+	}
+	t.Run("Restore", func(t *testing.T) {
+		controller, cancel := restore(t, func(controller *WorkflowController) string { return controller.workflowVersion(key) })
+		defer cancel()
+		assert.Equal(t, 0, controller.wfQueue.Len(), "the workflow is held until it is due")
+		pods, err := listPods(newWorkflowOperationCtx(wf, controller))
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items)
+		}
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("argo").Get(ctx, "workflow-controller-configmap-checkpoint", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, cm.Data, "the checkpoint is only restored once")
+		}
+	})
+	t.Run("RestoreChanged", func(t *testing.T) {
+		controller, cancel := restore(t, func(*WorkflowController) string { return "changed" })
+		defer cancel()
+		pods, err := listPods(newWorkflowOperationCtx(wf, controller))
+		if assert.NoError(t, err) {
+			assert.Len(t, pods.Items, 1, "the workflow is operated on, as it changed after the checkpoint")
+		}
+	})
+}
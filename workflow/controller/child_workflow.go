@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// executeChildWorkflow creates the node's child workflow, which is named after the node's ID, and updates the node
+// from it until it completes
+func (woc *wfOperationCtx) executeChildWorkflow(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	created := node == nil
+	if created {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+	workflows := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		if !woc.GetShutdownStrategy().ShouldExecute(opts.onExitTemplate) {
+			return woc.markNodePhase(nodeName, wfv1.NodeSkipped, fmt.Sprintf("workflow shutdown with strategy: %s", woc.GetShutdownStrategy())), nil
+		}
+		woc.log.WithField("childWorkflow", node.ID).Info("Creating child workflow")
+		child, err = workflows.Create(ctx, woc.newChildWorkflow(node.ID, tmpl.ChildWorkflow), metav1.CreateOptions{})
+	}
+	if err != nil {
+		return woc.requeueIfTransientErr(err, nodeName)
+	}
+	if child.DeletionTimestamp != nil {
+		// the child workflow from before this workflow was retried is being deleted, so it can be created again
+		woc.requeueAfter(time.Second)
+		return node, nil
+	}
+	if created && (child.Status.Phase == wfv1.WorkflowFailed || child.Status.Phase == wfv1.WorkflowError) {
+		// the node has been reset, because this workflow was retried, but its child workflow has not
+		if !tmpl.ChildWorkflow.ShouldPropagateRetry() {
+			woc.log.WithField("childWorkflow", child.Name).Info("Deleting failed child workflow, to create it again")
+			if err := workflows.Delete(ctx, child.Name, metav1.DeleteOptions{}); err != nil && !apierr.IsNotFound(err) {
+				return woc.requeueIfTransientErr(err, nodeName)
+			}
+			woc.requeueAfter(time.Second)
+			return node, nil
+		}
+		woc.log.WithField("childWorkflow", child.Name).Info("Retrying failed child workflow")
+		child, err = wfutil.RetryWorkflow(ctx, woc.controller.kubeclientset, woc.controller.hydrator, workflows, child.Name, false, "", nil, nil, "")
+		if err != nil {
+			return woc.requeueIfTransientErr(err, nodeName)
+		}
+	}
+	if shutdown := woc.GetShutdownStrategy(); shutdown.Enabled() && tmpl.ChildWorkflow.ShouldPropagateShutdown() && !child.Status.Phase.Completed() && child.Spec.Shutdown != shutdown {
+		woc.log.WithField("childWorkflow", child.Name).WithField("shutdown", shutdown).Info("Shutting down child workflow")
+		patch := []byte(fmt.Sprintf(`{"spec":{"shutdown":%q}}`, shutdown))
+		if _, err := workflows.Patch(ctx, child.Name, "application/merge-patch+json", patch, metav1.PatchOptions{}); err != nil {
+			return woc.requeueIfTransientErr(err, nodeName)
+		}
+	}
+	if child.Status.Progress != "" {
+		node.Progress = child.Status.Progress
+		woc.wf.Status.Nodes[node.ID] = *node
+	}
+	switch child.Status.Phase {
+	case wfv1.WorkflowSucceeded:
+		outputs, err := childWorkflowOutputs(tmpl, child.Status.Outputs)
+		if err != nil {
+			return node, err
+		}
+		node.Outputs = outputs
+		woc.wf.Status.Nodes[node.ID] = *node
+		return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+	case wfv1.WorkflowFailed:
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, childWorkflowMessage(child)), nil
+	case wfv1.WorkflowError:
+		return woc.markNodePhase(nodeName, wfv1.NodeError, childWorkflowMessage(child)), nil
+	case wfv1.WorkflowRunning:
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning, child.Status.Message), nil
+	}
+	return node, nil
+}
+
+// newChildWorkflow returns the child workflow, which is owned by this workflow, so it is deleted with it
+func (woc *wfOperationCtx) newChildWorkflow(name string, childWorkflow *wfv1.ChildWorkflow) *wfv1.Workflow {
+	labels := map[string]string{common.LabelKeyParentWorkflow: woc.wf.Name}
+	if instanceID, ok := woc.wf.Labels[common.LabelKeyControllerInstanceID]; ok {
+		labels[common.LabelKeyControllerInstanceID] = instanceID
+	}
+	if childWorkflow.WorkflowTemplateRef.ClusterScope {
+		labels[common.LabelKeyClusterWorkflowTemplate] = childWorkflow.WorkflowTemplateRef.Name
+	} else {
+		labels[common.LabelKeyWorkflowTemplate] = childWorkflow.WorkflowTemplateRef.Name
+	}
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: woc.wf.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: wfv1.WorkflowSpec{
+			WorkflowTemplateRef: childWorkflow.WorkflowTemplateRef.DeepCopy(),
+			Arguments:           *childWorkflow.Arguments.DeepCopy(),
+		},
+	}
+}
+
+// childWorkflowMessage returns the message of the node of a child workflow that did not succeed
+func childWorkflowMessage(child *wfv1.Workflow) string {
+	if child.Status.Message == "" {
+		return fmt.Sprintf("child workflow %s %s", child.Name, child.Status.Phase)
+	}
+	return fmt.Sprintf("child workflow %s %s: %s", child.Name, child.Status.Phase, child.Status.Message)
+}
+
+// childWorkflowOutputs returns the template's outputs from the outputs of its child workflow: each output parameter's
+// `valueFrom.parameter`, and each output artifact's `from`, or otherwise its name, is the name of the child
+// workflow's output
+func childWorkflowOutputs(tmpl *wfv1.Template, childOutputs *wfv1.Outputs) (*wfv1.Outputs, error) {
+	if !tmpl.Outputs.HasOutputs() {
+		return nil, nil
+	}
+	if childOutputs == nil {
+		childOutputs = &wfv1.Outputs{}
+	}
+	outputs := &wfv1.Outputs{}
+	for _, param := range tmpl.Outputs.Parameters {
+		out := param.DeepCopy()
+		if param.ValueFrom != nil {
+			out.ValueFrom = nil
+			for _, p := range childOutputs.Parameters {
+				if p.Name == param.ValueFrom.Parameter {
+					out.Value = p.Value
+				}
+			}
+			if out.Value == nil {
+				out.Value = param.ValueFrom.Default
+			}
+			if out.Value == nil {
+				return nil, fmt.Errorf("child workflow does not have output parameter %s", param.ValueFrom.Parameter)
+			}
+		}
+		outputs.Parameters = append(outputs.Parameters, *out)
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		from := art.From
+		if from == "" {
+			from = art.Name
+		}
+		childArt := childOutputs.GetArtifactByName(from)
+		if childArt == nil {
+			if art.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("child workflow does not have output artifact %s", from)
+		}
+		out := childArt.DeepCopy()
+		out.Name = art.Name
+		out.GlobalName = art.GlobalName
+		outputs.Artifacts = append(outputs.Artifacts, *out)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var childWorkflowDAG = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parent
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: child
+            template: child
+            arguments:
+              parameters:
+                - name: message
+                  value: hello
+          - name: print
+            depends: child
+            template: print
+            arguments:
+              parameters:
+                - name: result
+                  value: "{{tasks.child.outputs.parameters.result}}"
+    - name: child
+      inputs:
+        parameters:
+          - name: message
+      childWorkflow:
+        workflowTemplateRef:
+          name: my-wftmpl
+        arguments:
+          parameters:
+            - name: message
+              value: "{{inputs.parameters.message}}"
+      outputs:
+        parameters:
+          - name: result
+            valueFrom:
+              parameter: message
+    - name: print
+      inputs:
+        parameters:
+          - name: result
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestChildWorkflow(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowDAG)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows("default")
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("child")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeTypeWorkflow, node.Type)
+	assert.Equal(t, wfv1.NodePending, node.Phase)
+
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "parent", child.Labels[common.LabelKeyParentWorkflow])
+	assert.Equal(t, "my-wftmpl", child.Labels[common.LabelKeyWorkflowTemplate])
+	if assert.Len(t, child.OwnerReferences, 1) {
+		assert.Equal(t, "parent", child.OwnerReferences[0].Name)
+	}
+	assert.Equal(t, "my-wftmpl", child.Spec.WorkflowTemplateRef.Name)
+	assert.Equal(t, "hello", child.Spec.Arguments.GetParameterByName("message").Value.String())
+
+	child.Status.Phase = wfv1.WorkflowRunning
+	child.Status.Progress = "1/2"
+	_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("child")
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	assert.Equal(t, wfv1.Progress("1/2"), node.Progress)
+
+	child.Status.Phase = wfv1.WorkflowSucceeded
+	child.Status.Progress = "2/2"
+	child.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}}
+	_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("child")
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	if assert.NotNil(t, node.Outputs) {
+		assert.Equal(t, "hello", node.Outputs.Parameters[0].Value.String())
+	}
+	print := woc.wf.Status.Nodes.FindByDisplayName("print")
+	if assert.NotNil(t, print) {
+		assert.Equal(t, "hello", print.Inputs.Parameters[0].Value.String())
+	}
+}
+
+func TestChildWorkflowFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowDAG)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows("default")
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("child")
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	child.Status.Phase = wfv1.WorkflowFailed
+	child.Status.Message = "child failed"
+	_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("child")
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "child workflow "+child.Name+" Failed: child failed", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestChildWorkflowShutdown(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowDAG)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows("default")
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("child")
+	child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	child.Status.Phase = wfv1.WorkflowRunning
+	_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	child, err = workflows.Get(ctx, node.ID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.ShutdownStrategyTerminate, child.Spec.Shutdown)
+}
+
+func TestChildWorkflowRetried(t *testing.T) {
+	for _, retry := range []bool{true, false} {
+		wf := wfv1.MustUnmarshalWorkflow(childWorkflowDAG)
+		wf.Spec.Templates[1].ChildWorkflow.Propagate = &wfv1.ChildWorkflowPropagation{Retry: &retry}
+		cancel, controller := newController(wf)
+		ctx := context.Background()
+		workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows("default")
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		child, err := workflows.Get(ctx, node.ID, metav1.GetOptions{})
+		require.NoError(t, err)
+		child.Status.Phase = wfv1.WorkflowFailed
+		_, err = workflows.Update(ctx, child, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		// retrying the parent deletes its failed nodes
+		delete(woc.wf.Status.Nodes, node.ID)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		node = woc.wf.Status.Nodes.FindByDisplayName("child")
+		child, err = workflows.Get(ctx, node.ID, metav1.GetOptions{})
+		if retry {
+			require.NoError(t, err)
+			assert.Equal(t, wfv1.WorkflowRunning, child.Status.Phase, "the child workflow is retried")
+			assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		} else {
+			assert.True(t, apierr.IsNotFound(err), "the child workflow is deleted, to be created again")
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+			_, err = workflows.Get(ctx, node.ID, metav1.GetOptions{})
+			assert.NoError(t, err)
+		}
+		cancel()
+	}
+}
+
+func TestChildWorkflowOutputs(t *testing.T) {
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{
+		Parameters: []wfv1.Parameter{
+			{Name: "a", ValueFrom: &wfv1.ValueFrom{Parameter: "x"}},
+			{Name: "b", ValueFrom: &wfv1.ValueFrom{Parameter: "y", Default: wfv1.AnyStringPtr("default")}, GlobalName: "b"},
+		},
+		Artifacts: []wfv1.Artifact{{Name: "art"}, {Name: "renamed", From: "art"}, {Name: "missing", Optional: true}},
+	}}
+	art := wfv1.Artifact{Name: "art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "art.tgz"}}}
+	outputs, err := childWorkflowOutputs(tmpl, &wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "x", Value: wfv1.AnyStringPtr("1")}},
+		Artifacts:  []wfv1.Artifact{art},
+	})
+	if assert.NoError(t, err) {
+		if assert.Len(t, outputs.Parameters, 2) {
+			assert.Equal(t, "1", outputs.Parameters[0].Value.String())
+			assert.Nil(t, outputs.Parameters[0].ValueFrom)
+			assert.Equal(t, "default", outputs.Parameters[1].Value.String())
+			assert.Equal(t, "b", outputs.Parameters[1].GlobalName)
+		}
+		if assert.Len(t, outputs.Artifacts, 2) {
+			assert.Equal(t, "art", outputs.Artifacts[0].Name)
+			assert.Equal(t, "renamed", outputs.Artifacts[1].Name)
+			assert.Equal(t, "art.tgz", outputs.Artifacts[1].S3.Key)
+		}
+	}
+	_, err = childWorkflowOutputs(tmpl, nil)
+	assert.EqualError(t, err, "child workflow does not have output parameter x")
+}
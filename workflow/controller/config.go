@@ -2,17 +2,21 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/time/rate"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/yaml"
+	"upper.io/db.v3/lib/sqlbuilder"
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/redisdb"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
@@ -27,7 +31,78 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	if wfc.cliExecutorImage == "" && config.ExecutorImage == "" {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap does not have executorImage")
 	}
+	if err := config.MetricsConfig.SLO.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid metricsConfig: %v", err)
+	}
+	if err := config.Costs.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid costs: %v", err)
+	}
+	if err := config.Spot.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid spot: %v", err)
+	}
+	if err := config.SchedulingPresets.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid schedulingPresets: %v", err)
+	}
+	if err := config.Sandbox.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid sandbox: %v", err)
+	}
+	if err := config.ImagePolicy.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid imagePolicy: %v", err)
+	}
+	if err := config.ArtifactRetention.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid artifactRetention: %v", err)
+	}
+	if err := config.PodTuning.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid podTuning: %v", err)
+	}
+	if err := config.PlatformImages.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid platformImages: %v", err)
+	}
+	if err := config.OutputLimits.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid outputLimits: %v", err)
+	}
+	if config.ArtifactParallelism < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "invalid artifactParallelism: must not be negative")
+	}
+	if err := config.PodCreationBackpressure.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid podCreationBackpressure: %v", err)
+	}
+	if err := config.FreezeWindows.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid freezeWindows: %v", err)
+	}
+	if err := config.EphemeralNamespaces.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid ephemeralNamespaces: %v", err)
+	}
+	if config.EphemeralNamespaces != nil && wfc.GetManagedNamespace() != "" {
+		return errors.Errorf(errors.CodeBadRequest, "invalid ephemeralNamespaces: the controller must manage every namespace, rather than %s", wfc.GetManagedNamespace())
+	}
+	if err := config.FaultInjection.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid faultInjection: %v", err)
+	}
+	if config.Persistence != nil {
+		if err := config.Persistence.ArchivePartitioning.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: %v", err)
+		}
+		if config.Persistence.Archive && !config.Persistence.HasDatabase() {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: archiving workflows requires postgresql, mysql, or sqlite")
+		}
+		if config.Persistence.NodeStatusOffload && config.Persistence.Redis == nil && !config.Persistence.HasDatabase() {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: offloading node statuses requires redis, postgresql, mysql, or sqlite")
+		}
+		if err := config.Persistence.WorkflowSnapshots.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: %v", err)
+		}
+		if config.Persistence.WorkflowSnapshots != nil && !config.Persistence.HasDatabase() {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: snapshotting workflows requires postgresql, mysql, or sqlite")
+		}
+		if config.Persistence.PendingWorkflowQueue && !config.Persistence.HasDatabase() {
+			return errors.Errorf(errors.CodeBadRequest, "invalid persistence: queueing pending workflows requires postgresql, mysql, or sqlite")
+		}
+	}
 	wfc.Config = *config
+	if err := wfc.setLogLevels(); err != nil {
+		return err
+	}
 	if wfc.session != nil {
 		err := wfc.session.Close()
 		if err != nil {
@@ -38,27 +113,45 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	wfc.archivePartitions = nil
+	wfc.wfSnapshotter = nil
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
+	if err := wfc.lockSQLite(persistence); err != nil {
+		return err
+	}
 	if persistence != nil {
 		log.Info("Persistence configuration enabled")
-		session, tableName, err := sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
-		if err != nil {
-			return err
-		}
-		log.Info("Persistence Session created successfully")
-		if !persistence.SkipMigration {
-			err = sqldb.NewMigrate(session, persistence.GetClusterName(), tableName).Exec(context.Background())
+		var session sqlbuilder.Database
+		var tableName string
+		if persistence.HasDatabase() {
+			session, tableName, err = sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
 			if err != nil {
 				return err
 			}
-		} else {
-			log.Info("DB migration is disabled")
+			log.Info("Persistence Session created successfully")
+			migrate := sqldb.NewMigrate(session, persistence.GetClusterName(), tableName)
+			if !persistence.SkipMigration {
+				err = migrate.Exec(context.Background())
+				if err != nil {
+					return err
+				}
+			} else {
+				log.Info("DB migration is disabled")
+				// the schema may have been migrated by a different version, e.g. with `workflow-controller migrate`
+				err = migrate.Validate(context.Background())
+				if err != nil {
+					return err
+				}
+			}
+			wfc.session = session
 		}
-
-		wfc.session = session
 		if persistence.NodeStatusOffload {
-			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
+			if persistence.Redis != nil {
+				wfc.offloadNodeStatusRepo, err = redisdb.NewOffloadNodeStatusRepo(wfc.kubeclientset, wfc.namespace, persistence.GetClusterName(), persistence.Redis)
+			} else {
+				wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
+			}
 			if err != nil {
 				return err
 			}
@@ -73,17 +166,49 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 			if err != nil {
 				return err
 			}
-			wfc.wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			if persistence.ArchivePartitioning != nil {
+				wfc.archivePartitions = sqldb.NewArchivePartitions(session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService.InstanceID(), *persistence.ArchivePartitioning)
+				if !persistence.SkipMigration {
+					if err := wfc.archivePartitions.Partition(time.Now()); err != nil {
+						return err
+					}
+				}
+				log.Info("Workflow archive partitioning is enabled")
+			}
+			wfc.wfArchive = sqldb.NewWorkflowArchive(session, session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
 			log.Info("Workflow archiving is enabled")
 		} else {
 			log.Info("Workflow archiving is disabled")
 		}
+		if s := persistence.WorkflowSnapshots; s != nil {
+			wfc.wfSnapshotter = newWorkflowSnapshotter(sqldb.NewWorkflowSnapshotRepo(session, persistence.GetClusterName(), s.GetTTL()), s.GetInterval())
+			log.WithFields(log.Fields{"interval": s.GetInterval(), "ttl": s.GetTTL()}).Info("Workflow snapshots are enabled")
+		}
 	} else {
 		log.Info("Persistence configuration disabled")
 	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory()
 	wfc.rateLimiter = wfc.newRateLimiter()
+	wfc.nodeEventFilter, err = newNodeEventFilter(wfc.Config.NodeEvents.RateLimit)
+	return err
+}
+
+// setLogLevels sets the log levels of the config, or, if it has none, the level of the --loglevel flag
+func (wfc *WorkflowController) setLogLevels() error {
+	levels := logs.Levels{Level: wfc.cliLogLevel}
+	if levels.Level == "" {
+		levels.Level = log.GetLevel().String()
+	}
+	if c := wfc.Config.Logging; c != nil {
+		if c.Level != "" {
+			levels.Level = c.Level
+		}
+		levels.Components = c.Components
+	}
+	if err := logs.SetLevels(levels); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid logging config: %v", err)
+	}
 	return nil
 }
 
@@ -109,3 +234,31 @@ func (wfc *WorkflowController) executorImagePullPolicy() apiv1.PullPolicy {
 		return apiv1.PullPolicy(wfc.Config.ExecutorImagePullPolicy)
 	}
 }
+
+// lockSQLite locks the SQLite database, if one is configured, so that only this controller uses it, and unlocks the
+// previous database, if it is no longer used
+func (wfc *WorkflowController) lockSQLite(persistence *config.PersistConfig) error {
+	path := ""
+	if persistence != nil && persistence.SQLite != nil {
+		path = persistence.SQLite.Path + ".lock"
+	}
+	if wfc.sqliteLock != nil {
+		if wfc.sqliteLock.Path() == path {
+			return nil
+		}
+		if err := wfc.sqliteLock.Unlock(); err != nil {
+			return err
+		}
+		wfc.sqliteLock = nil
+	}
+	if path == "" {
+		return nil
+	}
+	lock, err := sqldb.LockFile(path)
+	if err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("Locked SQLite database")
+	wfc.sqliteLock = lock
+	return nil
+}
@@ -1,11 +1,16 @@
 package controller
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/alicebob/miniredis/v2"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -18,3 +23,98 @@ func TestUpdateConfig(t *testing.T) {
 	assert.NotNil(t, controller.wfArchive)
 	assert.NotNil(t, controller.offloadNodeStatusRepo)
 }
+
+func TestUpdateConfigLogging(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	level := log.GetLevel()
+	defer func() { assert.NoError(t, logs.SetLevels(logs.Levels{Level: level.String()})) }()
+	controller.cliLogLevel = "info"
+	t.Run("Components", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Logging: &config.Logging{Components: map[string]string{logs.ComponentAgent: "debug"}}})
+		assert.NoError(t, err)
+		assert.Equal(t, log.InfoLevel, log.GetLevel())
+		assert.Equal(t, log.DebugLevel, logs.ComponentLevel(logs.ComponentAgent))
+	})
+	t.Run("Level", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Logging: &config.Logging{Level: "warn"}})
+		assert.NoError(t, err)
+		assert.Equal(t, log.WarnLevel, log.GetLevel())
+		assert.Equal(t, log.WarnLevel, logs.ComponentLevel(logs.ComponentAgent))
+	})
+	t.Run("Removed", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest"})
+		assert.NoError(t, err)
+		assert.Equal(t, log.InfoLevel, log.GetLevel())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Logging: &config.Logging{Components: map[string]string{"executor": "debug"}}})
+		assert.EqualError(t, err, `invalid logging config: unknown log component "executor", must be one of "controller", "podReconciliation", or "agent"`)
+	})
+}
+
+func TestUpdateConfigSLO(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", MetricsConfig: config.MetricsConfig{SLO: &config.SLOConfig{SuccessObjective: 1}}})
+	assert.EqualError(t, err, "invalid metricsConfig: slo successObjective 1 must be greater than 0 and less than 1")
+}
+
+func TestUpdateConfigArchivePartitioning(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Persistence: &config.PersistConfig{ArchivePartitioning: &config.ArchivePartitioning{Premake: -1}}})
+	assert.EqualError(t, err, "invalid persistence: archivePartitioning premake must not be negative")
+}
+
+func TestUpdateConfigRedis(t *testing.T) {
+	t.Run("ArchiveWithoutDatabase", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Persistence: &config.PersistConfig{Archive: true, Redis: &config.RedisConfig{Address: "redis:6379"}}})
+		assert.EqualError(t, err, "invalid persistence: archiving workflows requires postgresql, mysql, or sqlite")
+	})
+	t.Run("OffloadWithoutStore", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Persistence: &config.PersistConfig{NodeStatusOffload: true}})
+		assert.EqualError(t, err, "invalid persistence: offloading node statuses requires redis, postgresql, mysql, or sqlite")
+	})
+	t.Run("Offload", func(t *testing.T) {
+		s := miniredis.RunT(t)
+		cancel, controller := newController()
+		defer cancel()
+		err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", Persistence: &config.PersistConfig{NodeStatusOffload: true, Redis: &config.RedisConfig{Address: s.Addr()}}})
+		if assert.NoError(t, err) {
+			assert.Nil(t, controller.session)
+			assert.True(t, controller.offloadNodeStatusRepo.IsEnabled())
+			assert.Equal(t, sqldb.NullWorkflowArchive, controller.wfArchive)
+		}
+	})
+}
+
+func TestLockSQLite(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	dir := t.TempDir()
+	sqlite := func(name string) *config.PersistConfig {
+		return &config.PersistConfig{SQLite: &config.SQLiteConfig{Path: filepath.Join(dir, name)}}
+	}
+	assert.NoError(t, controller.lockSQLite(sqlite("a.db")))
+	lock := controller.sqliteLock
+	assert.Equal(t, filepath.Join(dir, "a.db.lock"), lock.Path())
+	// the lock is kept while the database is the same
+	assert.NoError(t, controller.lockSQLite(sqlite("a.db")))
+	assert.Same(t, lock, controller.sqliteLock)
+	// another controller cannot lock it
+	_, err := sqldb.LockFile(lock.Path())
+	assert.Error(t, err)
+	assert.NoError(t, controller.lockSQLite(sqlite("b.db")))
+	assert.Equal(t, filepath.Join(dir, "b.db.lock"), controller.sqliteLock.Path())
+	assert.NoError(t, controller.lockSQLite(nil))
+	assert.Nil(t, controller.sqliteLock)
+	other, err := sqldb.LockFile(filepath.Join(dir, "a.db.lock"))
+	if assert.NoError(t, err) {
+		assert.NoError(t, other.Unlock())
+	}
+}
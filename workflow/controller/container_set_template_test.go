@@ -67,6 +67,58 @@ spec:
 	}
 }
 
+func TestContainerSetTemplateWithWorkspace(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: pod
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      containerSet:
+        workspace:
+          mountPath: /src
+        containers:
+          - name: ctr-0
+            image: argoproj/argosay:v2
+          - name: ctr-1
+            image: argoproj/argosay:v2
+            workingDir: /src/build
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+
+	pod, err := getPod(woc, "pod")
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []corev1.Volume{
+		{Name: "var-run-argo", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: wfv1.ContainerSetWorkspaceVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}, pod.Spec.Volumes)
+
+	assert.Len(t, pod.Spec.Containers, 3)
+	for _, c := range pod.Spec.Containers {
+		switch c.Name {
+		case common.WaitContainerName:
+		case "ctr-0", "ctr-1":
+			assert.ElementsMatch(t, []corev1.VolumeMount{
+				{Name: wfv1.ContainerSetWorkspaceVolumeName, MountPath: "/src"},
+				{Name: "var-run-argo", MountPath: "/var/run/argo"},
+			}, c.VolumeMounts)
+			if c.Name == "ctr-0" {
+				assert.Equal(t, "/src", c.WorkingDir)
+			} else {
+				assert.Equal(t, "/src/build", c.WorkingDir)
+			}
+		default:
+			t.Fatalf(c.Name)
+		}
+	}
+}
+
 func TestContainerSetTemplateWithInputArtifacts(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
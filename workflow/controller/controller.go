@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -21,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
@@ -39,16 +42,19 @@ import (
 	"github.com/argoproj/argo-workflows/v3/config"
 	argoErr "github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions"
 	wfextvv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
 	authutil "github.com/argoproj/argo-workflows/v3/util/auth"
+	"github.com/argoproj/argo-workflows/v3/util/diagnostics"
 	"github.com/argoproj/argo-workflows/v3/util/diff"
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
@@ -57,11 +63,16 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
 	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
+	"github.com/argoproj/argo-workflows/v3/workflow/eventsinks"
 	"github.com/argoproj/argo-workflows/v3/workflow/gccontroller"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/notifications"
 	"github.com/argoproj/argo-workflows/v3/workflow/signal"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
 )
@@ -77,6 +88,10 @@ type WorkflowController struct {
 	Config config.Config
 	// get the artifact repository
 	artifactRepositories artifactrepositories.Interface
+	// artifactDriverFactory creates the drivers that read the artifacts of `withArtifact` loops
+	artifactDriverFactory artifact.NewDriverFunc
+	// artifactItemsCache caches the items of the artifacts of `withArtifact` loops
+	artifactItemsCache *utilcache.LRUExpireCache
 
 	// cliExecutorImage is the executor image as specified from the command line
 	cliExecutorImage string
@@ -96,9 +111,12 @@ type WorkflowController struct {
 	wfInformer            cache.SharedIndexInformer
 	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
 	cwftmplInformer       wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	wftmplGrantInformer   wfextvv1alpha1.WorkflowTemplateGrantInformer
+	resourceQuotaInformer cache.SharedIndexInformer
 	podInformer           cache.SharedIndexInformer
 	configMapInformer     cache.SharedIndexInformer
-	wfQueue               workqueue.RateLimitingInterface
+	wfQueue               *checkpointingQueue
+	leading               int32                           // 1 while leading, accessed atomically
 	podCleanupQueue       workqueue.RateLimitingInterface // pods to be deleted or labelled depend on GC strategy
 	throttler             sync.Throttler
 	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
@@ -106,6 +124,9 @@ type WorkflowController struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	archivePartitions     sqldb.ArchivePartitions
+	wfSnapshotter         *workflowSnapshotter
+	sqliteLock            *sqldb.FileLock
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
@@ -113,6 +134,13 @@ type WorkflowController struct {
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
 	wfTaskSetInformer     wfextvv1alpha1.WorkflowTaskSetInformer
+	notifier              notifications.Interface
+	eventSinks            eventsinks.Interface
+	nodeEventFilter       *nodeEventFilter
+	// configMap is the name of the config map, which callers of the log level endpoint must be able to get, or update
+	configMap string
+	// cliLogLevel is the level of the --loglevel flag, which is used unless the config map sets a level
+	cliLogLevel string
 
 	// progressPatchTickDuration defines how often the executor will patch pod annotations if an updated progress is found.
 	// Default is 1m and can be configured using the env var ARGO_PROGRESS_PATCH_TICK_DURATION.
@@ -121,6 +149,13 @@ type WorkflowController struct {
 	// Default is 3s and can be configured using the env var ARGO_PROGRESS_FILE_TICK_DURATION
 	progressFileTickDuration time.Duration
 	executorPlugins          map[string]map[string]*spec.Plugin // namespace -> name -> plugin
+	// faultInjection is whether the faults of the config are injected
+	faultInjection bool
+	// podStatusCaptureFinalizer is whether pods get a finalizer, so their status is recorded before they are deleted.
+	// It can be configured using the env var ARGO_POD_STATUS_CAPTURE_FINALIZER.
+	podStatusCaptureFinalizer bool
+	// resourceUsage is the usage of the main containers of running pods, sampled while resource usage is configured
+	resourceUsage resourceUsageSampler
 }
 
 const (
@@ -130,10 +165,14 @@ const (
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
 	workflowExistenceCheckPeriod        = 1 * time.Minute
 	workflowTaskSetResyncPeriod         = 20 * time.Minute
+	workflowTemplateGrantResyncPeriod   = 20 * time.Minute
+	resourceQuotaResyncPeriod           = 20 * time.Minute
 )
 
 var cacheGCPeriod = env.LookupEnvDurationOr("CACHE_GC_PERIOD", 0)
 
+var notificationWorkers = env.LookupEnvIntOr("NOTIFICATION_WORKERS", 4)
+
 func init() {
 	if cacheGCPeriod != 0 {
 		log.WithField("cacheGCPeriod", cacheGCPeriod).Info("GC for memoization caches will be performed every")
@@ -141,54 +180,93 @@ func init() {
 }
 
 // NewWorkflowController instantiates a new WorkflowController
-func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubeclientset kubernetes.Interface, wfclientset wfclientset.Interface, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap string, executorPlugins bool) (*WorkflowController, error) {
+func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubeclientset kubernetes.Interface, wfclientset wfclientset.Interface, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap string, executorPlugins, faultInjection bool) (*WorkflowController, error) {
 	dynamicInterface, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	wfc := WorkflowController{
-		restConfig:                 restConfig,
-		kubeclientset:              kubeclientset,
-		dynamicInterface:           dynamicInterface,
-		wfclientset:                wfclientset,
-		namespace:                  namespace,
-		managedNamespace:           managedNamespace,
-		cliExecutorImage:           executorImage,
-		cliExecutorImagePullPolicy: executorImagePullPolicy,
-		containerRuntimeExecutor:   containerRuntimeExecutor,
-		configController:           config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc),
-		workflowKeyLock:            syncpkg.NewKeyLock(),
-		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
-		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
-		progressPatchTickDuration:  env.LookupEnvDurationOr(common.EnvVarProgressPatchTickDuration, 1*time.Minute),
-		progressFileTickDuration:   env.LookupEnvDurationOr(common.EnvVarProgressFileTickDuration, 3*time.Second),
-	}
+	wfc := newWorkflowController(kubeclientset, dynamicInterface, wfclientset, namespace)
+	wfc.restConfig = restConfig
+	wfc.managedNamespace = managedNamespace
+	wfc.cliExecutorImage = executorImage
+	wfc.cliExecutorImagePullPolicy = executorImagePullPolicy
+	wfc.containerRuntimeExecutor = containerRuntimeExecutor
+	wfc.configController = config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc)
+	wfc.configMap = configMap
+	wfc.cliLogLevel = log.GetLevel().String()
+	wfc.faultInjection = faultInjection
 
 	if executorPlugins {
 		wfc.executorPlugins = map[string]map[string]*spec.Plugin{}
 	}
 
 	wfc.UpdateConfig(ctx)
+	wfc.newQueues()
+
+	return wfc, nil
+}
 
+// newWorkflowController returns a controller of the clients, with the fields that do not depend on its config. It is
+// shared by NewWorkflowController, and simulations
+func newWorkflowController(kubeclientset kubernetes.Interface, dynamicInterface dynamic.Interface, wfclientset wfclientset.Interface, namespace string) *WorkflowController {
+	return &WorkflowController{
+		kubeclientset:             kubeclientset,
+		dynamicInterface:          dynamicInterface,
+		wfclientset:               wfclientset,
+		namespace:                 namespace,
+		workflowKeyLock:           syncpkg.NewKeyLock(),
+		cacheFactory:              controllercache.NewCacheFactory(kubeclientset, namespace),
+		artifactDriverFactory:     artifact.NewDriver,
+		artifactItemsCache:        utilcache.NewLRUExpireCache(256),
+		eventRecorderManager:      events.NewEventRecorderManager(kubeclientset),
+		progressPatchTickDuration: env.LookupEnvDurationOr(common.EnvVarProgressPatchTickDuration, 1*time.Minute),
+		progressFileTickDuration:  env.LookupEnvDurationOr(common.EnvVarProgressFileTickDuration, 3*time.Second),
+		podStatusCaptureFinalizer: env.LookupEnvStringOr(common.EnvVarPodStatusCaptureFinalizer, "false") == "true",
+	}
+}
+
+// newQueues creates the metrics, and the queues, of the controller, once its config has been read
+func (wfc *WorkflowController) newQueues() {
 	wfc.metrics = metrics.New(wfc.getMetricsServerConfig())
 
 	workqueue.SetProvider(wfc.metrics) // must execute SetProvider before we created the queues
-	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(&fixedItemIntervalRateLimiter{}, "workflow_queue")
+	wfRateLimiter := &fixedItemIntervalRateLimiter{}
+	wfc.wfQueue = newCheckpointingQueue(wfc.metrics.NamespaceRateLimitingWorkQueue(wfc.metrics.RateLimiterWithBusyWorkers(wfRateLimiter, "workflow_queue")), wfRateLimiter)
 	wfc.throttler = wfc.newThrottler()
 	wfc.podCleanupQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_cleanup_queue")
+}
 
-	return &wfc, nil
+// parallelismQueuedMessage returns why the workflow is waiting to be processed, and its position in the queue
+func (wfc *WorkflowController) parallelismQueuedMessage(key string) string {
+	var limits []string
+	if wfc.Config.Parallelism > 0 {
+		limits = append(limits, fmt.Sprintf("parallelism: %d", wfc.Config.Parallelism))
+	}
+	if wfc.Config.NamespaceParallelism > 0 {
+		limits = append(limits, fmt.Sprintf("namespaceParallelism: %d", wfc.Config.NamespaceParallelism))
+	}
+	position, length := wfc.throttler.Position(key)
+	return queuedMessage(fmt.Sprintf("Waiting for running workflows to complete, because of the parallelism limits (%s)", strings.Join(limits, ", ")), position, length)
 }
 
 func (wfc *WorkflowController) newThrottler() sync.Throttler {
 	f := func(key string) { wfc.wfQueue.AddRateLimited(key) }
 	return sync.ChainThrottler{
-		sync.NewThrottler(wfc.Config.Parallelism, sync.SingleBucket, f),
-		sync.NewThrottler(wfc.Config.NamespaceParallelism, sync.NamespaceBucket, f),
+		sync.NewThrottlerWithPendingQueue(wfc.Config.Parallelism, sync.SingleBucket, wfc.newPendingQueue("parallelism"), f),
+		sync.NewThrottlerWithPendingQueue(wfc.Config.NamespaceParallelism, sync.NamespaceBucket, wfc.newPendingQueue("namespaceParallelism"), f),
 	}
 }
 
+// newPendingQueue returns the queue of the workflows pending because of the parallelism limit of the name
+func (wfc *WorkflowController) newPendingQueue(name string) sync.PendingQueue {
+	if p := wfc.Config.Persistence; p != nil && p.PendingWorkflowQueue {
+		log.WithField("name", name).Info("Pending workflows are queued in the database")
+		return sqldb.NewPendingWorkflowQueue(func() sqlbuilder.Database { return wfc.session }, p.GetClusterName(), name)
+	}
+	return sync.NewMemoryPendingQueue()
+}
+
 // runGCcontroller runs the workflow garbage collector controller
 func (wfc *WorkflowController) runGCcontroller(ctx context.Context, workflowTTLWorkers int) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
@@ -203,7 +281,7 @@ func (wfc *WorkflowController) runGCcontroller(ctx context.Context, workflowTTLW
 func (wfc *WorkflowController) runCronController(ctx context.Context) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
-	cronController := cron.NewCronController(wfc.wfclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
+	cronController := cron.NewCronController(wfc.wfclientset, wfc.kubeclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
 	cronController.Run(ctx)
 }
 
@@ -235,22 +313,15 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 		WithField("podCleanup", podCleanupWorkers).
 		Info("Current Worker Numbers")
 
-	wfc.wfInformer = util.NewWorkflowInformer(wfc.dynamicInterface, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions, indexers)
-	wfc.wftmplInformer = informer.NewTolerantWorkflowTemplateInformer(wfc.dynamicInterface, workflowTemplateResyncPeriod, wfc.managedNamespace)
-	wfc.wfTaskSetInformer = wfc.newWorkflowTaskSetInformer()
-
-	wfc.addWorkflowInformerHandlers(ctx)
-	wfc.podInformer = wfc.newPodInformer(ctx)
-	wfc.updateEstimatorFactory()
-
-	wfc.configMapInformer = wfc.newConfigMapInformer()
-
-	// Create Synchronization Manager
-	wfc.createSynchronizationManager(ctx)
-	// init managers: throttler and SynchronizationManager
-	if err := wfc.initManagers(ctx); err != nil {
+	if err := wfc.newInformers(ctx); err != nil {
 		log.Fatal(err)
 	}
+	wfc.notifier = notifications.New(wfc.kubeclientset, wfc.configMapInformer.GetIndexer())
+	eventSinks, err := eventsinks.New(ctx, wfc.kubeclientset, wfc.namespace, wfc.Config.EventSinks)
+	if err != nil {
+		log.Fatal(err)
+	}
+	wfc.eventSinks = eventSinks
 
 	go wfc.runConfigMapWatcher(ctx.Done())
 	go wfc.configController.Run(ctx.Done(), wfc.updateConfig)
@@ -259,6 +330,8 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	go wfc.podInformer.Run(ctx.Done())
 	go wfc.configMapInformer.Run(ctx.Done())
 	go wfc.wfTaskSetInformer.Informer().Run(ctx.Done())
+	go wfc.notifier.Run(ctx, notificationWorkers)
+	go wfc.eventSinks.Run(ctx)
 
 	// Wait for all involved caches to be synced, before processing items from the queue is started
 	if !cache.WaitForCacheSync(ctx.Done(), wfc.wfInformer.HasSynced, wfc.wftmplInformer.Informer().HasSynced, wfc.podInformer.HasSynced, wfc.configMapInformer.HasSynced) {
@@ -266,15 +339,20 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	}
 
 	wfc.createClusterWorkflowTemplateInformer(ctx)
+	wfc.createWorkflowTemplateGrantInformer(ctx)
+	wfc.createResourceQuotaInformer(ctx)
+	diagnostics.Register("controller", wfc.Diagnostics)
 
 	// Start the metrics server
 	go wfc.metrics.RunServer(ctx)
 
+	leaderElectionDone := make(chan struct{})
 	leaderElectionOff := os.Getenv("LEADER_ELECTION_DISABLE")
 	if leaderElectionOff == "true" {
 		log.Info("Leader election is turned off. Running in single-instance mode")
 		logCtx := log.WithField("id", "single-instance")
 		go wfc.startLeading(ctx, logCtx, podCleanupWorkers, workflowTTLWorkers, wfWorkers)
+		close(leaderElectionDone)
 	} else {
 		nodeID, ok := os.LookupEnv("LEADER_ELECTION_IDENTITY")
 		if !ok {
@@ -287,36 +365,64 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 			leaderName = fmt.Sprintf("%s-%s", leaderName, wfc.Config.InstanceID)
 		}
 
-		go leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
-			Lock: &resourcelock.LeaseLock{
-				LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: wfc.namespace}, Client: wfc.kubeclientset.CoordinationV1(),
-				LockConfig: resourcelock.ResourceLockConfig{Identity: nodeID, EventRecorder: wfc.eventRecorderManager.Get(wfc.namespace)},
-			},
-			ReleaseOnCancel: true,
-			LeaseDuration:   env.LookupEnvDurationOr("LEADER_ELECTION_LEASE_DURATION", 15*time.Second),
-			RenewDeadline:   env.LookupEnvDurationOr("LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second),
-			RetryPeriod:     env.LookupEnvDurationOr("LEADER_ELECTION_RETRY_PERIOD", 5*time.Second),
-			Callbacks: leaderelection.LeaderCallbacks{
-				OnStartedLeading: func(ctx context.Context) {
-					wfc.startLeading(ctx, logCtx, podCleanupWorkers, workflowTTLWorkers, wfWorkers)
+		go func() {
+			defer close(leaderElectionDone)
+			leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+				Lock: &resourcelock.LeaseLock{
+					LeaseMeta: metav1.ObjectMeta{Name: leaderName, Namespace: wfc.namespace}, Client: wfc.kubeclientset.CoordinationV1(),
+					LockConfig: resourcelock.ResourceLockConfig{Identity: nodeID, EventRecorder: wfc.eventRecorderManager.Get(wfc.namespace)},
 				},
-				OnStoppedLeading: func() {
-					logCtx.Info("stopped leading")
-					cancel()
+				ReleaseOnCancel: true,
+				LeaseDuration:   env.LookupEnvDurationOr("LEADER_ELECTION_LEASE_DURATION", 15*time.Second),
+				RenewDeadline:   env.LookupEnvDurationOr("LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second),
+				RetryPeriod:     env.LookupEnvDurationOr("LEADER_ELECTION_RETRY_PERIOD", 5*time.Second),
+				Callbacks: leaderelection.LeaderCallbacks{
+					OnStartedLeading: func(ctx context.Context) {
+						wfc.startLeading(ctx, logCtx, podCleanupWorkers, workflowTTLWorkers, wfWorkers)
+					},
+					OnStoppedLeading: func() {
+						logCtx.Info("stopped leading")
+						atomic.StoreInt32(&wfc.leading, 0)
+						cancel()
+					},
+					OnNewLeader: func(identity string) {
+						logCtx.WithField("leader", identity).Info("new leader")
+					},
 				},
-				OnNewLeader: func(identity string) {
-					logCtx.WithField("leader", identity).Info("new leader")
-				},
-			},
-		})
+			})
+		}()
 	}
 	<-ctx.Done()
+	// the lease is released when leader election stops
+	<-leaderElectionDone
+}
+
+// newInformers creates the informers of the workflows, workflow templates, task sets, pods, and config maps, and the
+// synchronization manager, and initializes the managers from the running workflows. It is shared by Run, and
+// simulations, which run the informers themselves
+func (wfc *WorkflowController) newInformers(ctx context.Context) error {
+	wfc.wfInformer = util.NewWorkflowInformer(wfc.dynamicInterface, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions, indexers)
+	wfc.wftmplInformer = informer.NewTolerantWorkflowTemplateInformer(wfc.dynamicInterface, workflowTemplateResyncPeriod, wfc.managedNamespace)
+	wfc.wfTaskSetInformer = wfc.newWorkflowTaskSetInformer()
+
+	wfc.addWorkflowInformerHandlers(ctx)
+	wfc.podInformer = wfc.newPodInformer(ctx)
+	wfc.updateEstimatorFactory()
+
+	wfc.configMapInformer = wfc.newConfigMapInformer()
+
+	// Create Synchronization Manager
+	wfc.createSynchronizationManager(ctx)
+	// init managers: throttler and SynchronizationManager
+	return wfc.initManagers(ctx)
 }
 
 func (wfc *WorkflowController) startLeading(ctx context.Context, logCtx *log.Entry, podCleanupWorkers int, workflowTTLWorkers int, wfWorkers int) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
 	logCtx.Info("started leading")
+	atomic.StoreInt32(&wfc.leading, 1)
+	wfc.restoreCheckpoint(ctx)
 
 	for i := 0; i < podCleanupWorkers; i++ {
 		go wait.UntilWithContext(ctx, wfc.runPodCleanup, time.Second)
@@ -337,6 +443,10 @@ func (wfc *WorkflowController) startLeading(ctx context.Context, logCtx *log.Ent
 	if cacheGCPeriod != 0 {
 		go wait.JitterUntilWithContext(ctx, wfc.syncAllCacheForGC, cacheGCPeriod, 0.0, true)
 	}
+	go wait.JitterUntilWithContext(ctx, wfc.deleteExpiredArtifacts, artifactRetentionPeriod, 0.0, true)
+	go wait.JitterUntilWithContext(ctx, wfc.deleteEphemeralNamespaces, ephemeralNamespacePeriod, 0.0, true)
+	go wait.JitterUntilWithContext(ctx, wfc.deleteExpiredWorkflowSnapshots, workflowSnapshotGCPeriod, 0.0, true)
+	go wfc.runResourceUsageSampler(ctx)
 }
 
 // Create and the Synchronization Manager
@@ -460,6 +570,48 @@ func (wfc *WorkflowController) createClusterWorkflowTemplateInformer(ctx context
 	}
 }
 
+func (wfc *WorkflowController) createWorkflowTemplateGrantInformer(ctx context.Context) {
+	grantListAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "list", workflow.WorkflowTemplateGrantPlural, wfc.GetManagedNamespace(), "")
+	errors.CheckError(err)
+	grantWatchAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "watch", workflow.WorkflowTemplateGrantPlural, wfc.GetManagedNamespace(), "")
+	errors.CheckError(err)
+
+	if grantListAllowed && grantWatchAllowed {
+		wfc.wftmplGrantInformer = externalversions.NewSharedInformerFactoryWithOptions(
+			wfc.wfclientset,
+			workflowTemplateGrantResyncPeriod,
+			externalversions.WithNamespace(wfc.GetManagedNamespace())).Argoproj().V1alpha1().WorkflowTemplateGrants()
+		go wfc.wftmplGrantInformer.Informer().Run(ctx.Done())
+	} else {
+		log.Warnf("Controller doesn't have RBAC access for WorkflowTemplateGrants, so workflows cannot refer to the workflow templates of other namespaces")
+	}
+}
+
+func (wfc *WorkflowController) createResourceQuotaInformer(ctx context.Context) {
+	quotaListAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "list", "resourcequotas", wfc.GetManagedNamespace(), "")
+	errors.CheckError(err)
+	quotaWatchAllowed, err := authutil.CanI(ctx, wfc.kubeclientset, "watch", "resourcequotas", wfc.GetManagedNamespace(), "")
+	errors.CheckError(err)
+
+	if quotaListAllowed && quotaWatchAllowed {
+		wfc.resourceQuotaInformer = v1.NewResourceQuotaInformer(wfc.kubeclientset, wfc.GetManagedNamespace(), resourceQuotaResyncPeriod, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		go wfc.resourceQuotaInformer.Run(ctx.Done())
+	} else {
+		log.Warnf("Controller doesn't have RBAC access for ResourceQuotas, so pod creation backpressure cannot keep resource quota headroom")
+	}
+}
+
+// getGrantedWorkflowTemplateGetter returns the getter of the workflow templates of other namespaces that the workflows
+// of the namespace are granted, or nil if the controller cannot get grants
+func (wfc *WorkflowController) getGrantedWorkflowTemplateGetter(namespace string) templateresolution.GrantedWorkflowTemplateGetter {
+	if wfc.wftmplGrantInformer == nil {
+		return nil
+	}
+	return templategrant.NewGetter(namespace, wfc.wftmplGrantInformer.Lister(), wfc.wftmplInformer.Lister())
+}
+
 func (wfc *WorkflowController) UpdateConfig(ctx context.Context) {
 	config, err := wfc.configController.Get(ctx)
 	if err != nil {
@@ -537,6 +689,21 @@ func (wfc *WorkflowController) processNextPodCleanupItem(ctx context.Context) bo
 			if err != nil {
 				return err
 			}
+		case removeFinalizer:
+			_, err := pods.Patch(
+				ctx,
+				podName,
+				types.StrategicMergePatchType,
+				[]byte(`{"metadata": {"$deleteFromPrimitiveList/finalizers": ["`+common.FinalizerPodStatus+`"]}}`),
+				metav1.PatchOptions{},
+			)
+			if err != nil && !apierr.IsNotFound(err) {
+				return err
+			}
+		case deleteEphemeralVolumes:
+			if err := wfc.deleteEphemeralVolumes(ctx, namespace, podName); err != nil {
+				return err
+			}
 		case deletePod:
 			propagation := metav1.DeletePropagationBackground
 			err := pods.Delete(ctx, podName, metav1.DeleteOptions{
@@ -678,11 +845,15 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 		return
 	}
 	ttl := wfc.Config.Persistence.ArchiveTTL
-	if ttl == config.TTL(0) {
+	if ttl == config.TTL(0) && wfc.archivePartitions == nil {
 		log.Info("Archived workflows TTL zero - so archived workflow GC disabled - you must restart the controller if you enable this")
 		return
 	}
-	log.WithFields(log.Fields{"ttl": ttl, "periodicity": periodicity}).Info("Performing archived workflow GC")
+	if p := wfc.Config.Persistence.ArchivePartitioning; p != nil && periodicity >= time.Duration(p.GetPremake())*p.GetInterval() {
+		log.WithFields(log.Fields{"periodicity": periodicity, "interval": p.GetInterval(), "premake": p.GetPremake()}).
+			Warn("Archived workflow GC runs less often than partitions are premade for, so workflows may be archived into the future partition")
+	}
+	log.WithFields(log.Fields{"ttl": ttl, "periodicity": periodicity, "partitioned": wfc.archivePartitions != nil}).Info("Performing archived workflow GC")
 	ticker := time.NewTicker(periodicity)
 	defer ticker.Stop()
 	for {
@@ -691,9 +862,18 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 			return
 		case <-ticker.C:
 			log.Info("Performing archived workflow GC")
-			err := wfc.wfArchive.DeleteExpiredWorkflows(time.Duration(ttl))
-			if err != nil {
-				log.WithField("err", err).Error("Failed to delete archived workflows")
+			if wfc.archivePartitions != nil {
+				// dropping the expired partitions leaves only the expired workflows in the oldest partition to delete
+				err := wfc.archivePartitions.Maintain(time.Duration(ttl), time.Now())
+				if err != nil {
+					log.WithField("err", err).Error("Failed to maintain archived workflow partitions")
+				}
+			}
+			if ttl != config.TTL(0) {
+				err := wfc.wfArchive.DeleteExpiredWorkflows(time.Duration(ttl))
+				if err != nil {
+					log.WithField("err", err).Error("Failed to delete archived workflows")
+				}
 			}
 		}
 	}
@@ -715,6 +895,17 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 	}
 	defer wfc.wfQueue.Done(key)
 
+	if d, ok := wfc.wfQueue.hold(key.(string), func() string { return wfc.workflowVersion(key.(string)) }); ok {
+		// the workflow was not due, and has not changed since, when the previous leader shut down
+		wfc.wfQueue.AddAfter(key, d)
+		return true
+	}
+	if !wfc.wfQueue.operating(key.(string)) {
+		// the controller is shutting down
+		return false
+	}
+	defer wfc.wfQueue.operated()
+
 	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key.(string))
 	if err != nil {
 		log.WithFields(log.Fields{"key": key, "error": err}).Error("Failed to get workflow from informer")
@@ -723,6 +914,9 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 	if !exists {
 		// This happens after a workflow was labeled with completed=true
 		// or was deleted, but the work queue still had an entry for it.
+		// It may also have been deleted while the controller was not running, but still be in a pending queue in the
+		// database, and so must be removed from the throttler, so it does not take up a place.
+		wfc.throttler.Remove(key.(string))
 		return true
 	}
 
@@ -760,6 +954,9 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 		log.WithField("key", key).Info("Workflow processing has been postponed due to max parallelism limit")
 		if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 			woc.markWorkflowPhase(ctx, wfv1.WorkflowPending, "Workflow processing has been postponed because too many workflows are already running")
+		}
+		woc.setCondition(wfv1.ConditionTypeQueued, wfc.parallelismQueuedMessage(key.(string)))
+		if woc.updated {
 			woc.persistUpdates(ctx)
 		}
 		return true
@@ -783,6 +980,7 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 	startTime := time.Now()
 	woc.operate(ctx)
 	wfc.metrics.OperationCompleted(time.Since(startTime).Seconds())
+	wfc.metrics.NamespaceOperationCompleted(woc.wf.Namespace, time.Since(startTime).Seconds())
 	if woc.wf.Status.Fulfilled() {
 		err := woc.completeTaskSet(ctx)
 		if err != nil {
@@ -812,7 +1010,7 @@ func (wfc *WorkflowController) enqueueWfFromPodLabel(obj interface{}) error {
 		// Ignore pods unrelated to workflow (this shouldn't happen unless the watch is setup incorrectly)
 		return fmt.Errorf("Watch returned pod unrelated to any workflow")
 	}
-	wfc.wfQueue.AddRateLimited(pod.ObjectMeta.Namespace + "/" + workflowName)
+	wfc.wfQueue.AddRateLimited(workflowNamespace(pod) + "/" + workflowName)
 	return nil
 }
 
@@ -904,6 +1102,20 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 			}
 		},
 	})
+	// the parent of a child workflow updates its node from the child, so it is reconciled whenever the child changes,
+	// including when the child completes
+	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && un.GetLabels()[common.LabelKeyParentWorkflow] != ""
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(_, obj interface{}) {
+				un := obj.(*unstructured.Unstructured)
+				wfc.wfQueue.AddRateLimited(un.GetNamespace() + "/" + un.GetLabels()[common.LabelKeyParentWorkflow])
+			},
+		},
+	})
 }
 
 func (wfc *WorkflowController) archiveWorkflow(ctx context.Context, obj interface{}) {
@@ -1011,6 +1223,9 @@ func (wfc *WorkflowController) newPodInformer(ctx context.Context) cache.SharedI
 				if oldPod.ResourceVersion == newPod.ResourceVersion {
 					return
 				}
+				if wfc.isOrphanedDeletingPod(newPod) {
+					wfc.queuePodForCleanup(newPod.Namespace, newPod.Name, removeFinalizer)
+				}
 				if !pod.SignificantPodChange(oldPod, newPod) {
 					log.WithField("key", key).Info("insignificant pod change")
 					diff.LogChanges(oldPod, newPod)
@@ -1034,6 +1249,36 @@ func (wfc *WorkflowController) newPodInformer(ctx context.Context) cache.SharedI
 	return informer
 }
 
+// isOrphanedDeletingPod returns whether the pod is being deleted, but is held by the status finalizer after its
+// workflow was deleted or completed, so the controller will never record its status
+func (wfc *WorkflowController) isOrphanedDeletingPod(pod *apiv1.Pod) bool {
+	if pod.DeletionTimestamp == nil || !hasPodStatusFinalizer(pod) {
+		return false
+	}
+	obj, exists, err := wfc.wfInformer.GetStore().GetByKey(workflowNamespace(pod) + "/" + pod.Labels[common.LabelKeyWorkflow])
+	if err != nil {
+		return false
+	}
+	return !exists || obj.(*unstructured.Unstructured).GetLabels()[common.LabelKeyCompleted] == "true"
+}
+
+// getWorkflowTemplateRevision gets the revision of the workflow template from the config map informer, or from the
+// API if it was saved too recently to be in the informer
+func (wfc *WorkflowController) getWorkflowTemplateRevision(ctx context.Context, namespace, name string, revision int64) (*wfv1.WorkflowTemplate, error) {
+	owner, err := wfc.wftmplInformer.Lister().WorkflowTemplates(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	obj, exists, err := wfc.configMapInformer.GetIndexer().GetByKey(namespace + "/" + templaterevision.ConfigMapName(name, revision))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return templaterevision.FromConfigMap(obj.(*apiv1.ConfigMap), owner)
+	}
+	return templaterevision.Get(ctx, wfc.kubeclientset.CoreV1().ConfigMaps(namespace), owner, revision)
+}
+
 func (wfc *WorkflowController) newConfigMapInformer() cache.SharedIndexInformer {
 	indexInformer := v1.NewFilteredConfigMapInformer(wfc.kubeclientset, wfc.GetManagedNamespace(), 20*time.Minute, cache.Indexers{
 		indexes.ConfigMapLabelsIndex: indexes.ConfigMapIndexFunc,
@@ -1150,7 +1395,12 @@ func (wfc *WorkflowController) getMetricsServerConfig() (metrics.ServerConfig, m
 		TTL:          time.Duration(wfc.Config.MetricsConfig.MetricsTTL),
 		IgnoreErrors: wfc.Config.MetricsConfig.IgnoreErrors,
 		// Default to false until v3.5
-		Secure: wfc.Config.MetricsConfig.GetSecure(false),
+		Secure:           wfc.Config.MetricsConfig.GetSecure(false),
+		NamespaceLimit:   wfc.Config.MetricsConfig.GetNamespaceLimit(metrics.DefaultNamespaceLimit),
+		MaxCustomMetrics: wfc.Config.MetricsConfig.MaxCustomMetrics,
+		DeniedLabelKeys:  wfc.Config.MetricsConfig.DeniedLabelKeys,
+		Push:             wfc.Config.MetricsConfig.Push,
+		SLO:              wfc.Config.MetricsConfig.SLO,
 	}
 
 	// Telemetry config
@@ -1237,6 +1487,10 @@ func (wfc *WorkflowController) newWorkflowTaskSetInformer() wfextvv1alpha1.Workf
 	informer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(old, new interface{}) {
+				if heartbeatOnly(old.(*wfv1.WorkflowTaskSet), new.(*wfv1.WorkflowTaskSet)) {
+					// heartbeats are checked when the workflow is next reconciled, rather than reconciling it for each one
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err == nil {
 					wfc.wfQueue.Add(key)
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -156,6 +157,7 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 				S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"},
 			},
 		}),
+		artifactItemsCache:        utilcache.NewLRUExpireCache(256),
 		kubeclientset:             kube,
 		dynamicInterface:          dynamicClient,
 		wfclientset:               wfclientset,
@@ -168,6 +170,7 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 		cacheFactory:              controllercache.NewCacheFactory(kube, "default"),
 		progressPatchTickDuration: envutil.LookupEnvDurationOr(common.EnvVarProgressPatchTickDuration, 1*time.Minute),
 		progressFileTickDuration:  envutil.LookupEnvDurationOr(common.EnvVarProgressFileTickDuration, 3*time.Second),
+		podStatusCaptureFinalizer: envutil.LookupEnvStringOr(common.EnvVarPodStatusCaptureFinalizer, "false") == "true",
 	}
 
 	for _, opt := range options {
@@ -181,10 +184,12 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 	// always compare to NewWorkflowController to see what this block of code should be doing
 	{
 		wfc.metrics = metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
-		wfc.wfQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		wfRateLimiter := workqueue.DefaultControllerRateLimiter()
+		wfc.wfQueue = newCheckpointingQueue(workqueue.NewRateLimitingQueue(wfRateLimiter), wfRateLimiter)
 		wfc.throttler = wfc.newThrottler()
 		wfc.podCleanupQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 		wfc.rateLimiter = wfc.newRateLimiter()
+		wfc.nodeEventFilter, _ = newNodeEventFilter(nil)
 	}
 
 	// always compare to WorkflowController.Run to see what this block of code should be doing
@@ -210,6 +215,7 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 			wfc.wftmplInformer.Informer(),
 			wfc.podInformer,
 			wfc.cwftmplInformer.Informer(),
+			wfc.wfTaskSetInformer.Informer(),
 		} {
 			for !c.HasSynced() {
 				time.Sleep(5 * time.Millisecond)
@@ -523,6 +529,11 @@ spec:
 				if assert.NotNil(t, wf) {
 					assert.Equal(t, wfv1.WorkflowPending, wf.Status.Phase)
 					assert.Equal(t, "Workflow processing has been postponed because too many workflows are already running", wf.Status.Message)
+					if assert.Len(t, wf.Status.Conditions, 1) {
+						assert.Equal(t, wfv1.ConditionTypeQueued, wf.Status.Conditions[0].Type)
+						assert.Contains(t, wf.Status.Conditions[0].Message, "Waiting for running workflows to complete, because of the parallelism limits")
+						assert.Contains(t, wf.Status.Conditions[0].Message, "Position 1 of 1 in the queue")
+					}
 				}
 			})
 		})
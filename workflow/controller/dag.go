@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
@@ -346,7 +347,7 @@ func (woc *wfOperationCtx) updateOutboundNodesForTargetTasks(dagCtx *dagContext,
 	node := woc.wf.GetNodeByName(nodeName)
 	node.OutboundNodes = outbound
 	woc.wf.Status.Nodes[node.ID] = *node
-	woc.log.Infof("Outbound nodes of %s set to %s", node.ID, outbound)
+	woc.log.WithField(logs.FieldNodeID, node.ID).Infof("Outbound nodes of %s set to %s", node.ID, outbound)
 }
 
 // executeDAGTask traverses and executes the upward chain of dependencies of a task
@@ -358,6 +359,10 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 
 	node := dagCtx.getTaskNode(taskName)
 	task := dagCtx.GetTask(taskName)
+	node, proceed := woc.checkResubmittedDAGTask(ctx, dagCtx, task, node)
+	if !proceed {
+		return
+	}
 	if node != nil && node.Fulfilled() {
 		// Collect the completed task metrics
 		_, tmpl, _, _ := dagCtx.tmplCtx.ResolveTemplate(task)
@@ -457,7 +462,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 
 	// All our dependencies were satisfied and successful. It's our turn to run
 	// First resolve/substitute params/artifacts from our dependencies
-	newTask, err := woc.resolveDependencyReferences(dagCtx, task)
+	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
 	if err != nil {
 		woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, err.Error())
 		connectDependencies(nodeName)
@@ -497,7 +502,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			connectDependencies(taskNodeName)
 
 			// Check the task's when clause to decide if it should execute
-			proceed, err := shouldExecute(t.When)
+			proceed, err := common.ShouldExecute(t.When)
 			if err != nil {
 				woc.initializeNode(taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, err.Error())
 				continue
@@ -583,7 +588,7 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv
 
 // resolveDependencyReferences replaces any references to outputs of task dependencies, or artifacts in the inputs
 // NOTE: by now, input parameters should have been substituted throughout the template
-func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, error) {
+func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, error) {
 	scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
 	if err != nil {
 		return nil, err
@@ -613,7 +618,7 @@ func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task
 
 	// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 	// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
-	proceed, err := shouldExecute(newTask.When)
+	proceed, err := common.ShouldExecute(newTask.When)
 	if err != nil {
 		// If we got an error, it might be because our "when" clause contains a task-expansion parameter (e.g. {{item}}).
 		// Since we don't perform task-expansion until later and task-expansion parameters won't get resolved here,
@@ -645,6 +650,15 @@ func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task
 		resolvedArt.Name = art.Name
 		newTask.Arguments.Artifacts[j] = *resolvedArt
 	}
+
+	// read the items of the artifact, which are then expanded like those of a parameter
+	if newTask.WithArtifact != "" {
+		newTask.WithParam, err = woc.artifactItems(ctx, scope, newTask.WithArtifact)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read withArtifact: %w", err)
+		}
+		newTask.WithArtifact = ""
+	}
 	return &newTask, nil
 }
 
@@ -670,7 +684,7 @@ func (d *dagContext) findLeafTaskNames(tasks []wfv1.DAGTask) []string {
 	return leafTaskNames
 }
 
-// expandTask expands a single DAG task containing withItems, withParams, withSequence into multiple parallel tasks
+// expandTask expands a single DAG task containing withItems, withParams, withSequence, or a matrix, into multiple parallel tasks
 func expandTask(task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
 	var err error
 	var items []wfv1.Item
@@ -686,6 +700,11 @@ func expandTask(task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
 		if err != nil {
 			return nil, err
 		}
+	} else if matrix := task.Strategy.GetMatrix(); matrix != nil {
+		items, err = expandMatrix(matrix)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		return []wfv1.DAGTask{task}, nil
 	}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/sync"
+)
+
+// Diagnostics is the state of the controller, for the diagnostics dump
+type Diagnostics struct {
+	// Informers are the number of objects in the cache of each informer
+	Informers map[string]int `json:"informers"`
+	// Queues are the number of items waiting in each queue
+	Queues map[string]int `json:"queues"`
+	// Locks are the semaphores and mutexes workflows have acquired, or are waiting for
+	Locks []sync.LockState `json:"locks"`
+}
+
+// Diagnostics returns the state of the controller. It must only be called once the controller has started its
+// informers.
+func (wfc *WorkflowController) Diagnostics() interface{} {
+	informers := map[string]cache.SharedIndexInformer{
+		"workflows":         wfc.wfInformer,
+		"workflowTemplates": wfc.wftmplInformer.Informer(),
+		"workflowTaskSets":  wfc.wfTaskSetInformer.Informer(),
+		"pods":              wfc.podInformer,
+		"configMaps":        wfc.configMapInformer,
+	}
+	if wfc.cwftmplInformer != nil {
+		informers["clusterWorkflowTemplates"] = wfc.cwftmplInformer.Informer()
+	}
+	if wfc.resourceQuotaInformer != nil {
+		informers["resourceQuotas"] = wfc.resourceQuotaInformer
+	}
+	if wfc.wftmplGrantInformer != nil {
+		informers["workflowTemplateGrants"] = wfc.wftmplGrantInformer.Informer()
+	}
+	d := Diagnostics{
+		Informers: map[string]int{},
+		Queues: map[string]int{
+			"workflow_queue":    wfc.wfQueue.Len(),
+			"pod_cleanup_queue": wfc.podCleanupQueue.Len(),
+		},
+		Locks: wfc.syncManager.State(),
+	}
+	for name, i := range informers {
+		d.Informers[name] = len(i.GetStore().ListKeys())
+	}
+	return d
+}
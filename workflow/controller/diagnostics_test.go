@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestDiagnostics(t *testing.T) {
+	cancel, controller := newController(wfv1.MustUnmarshalWorkflow(helloWorldWf))
+	defer cancel()
+	d := controller.Diagnostics().(Diagnostics)
+	assert.Equal(t, 1, d.Informers["workflows"])
+	assert.Contains(t, d.Informers, "pods")
+	assert.Contains(t, d.Queues, "workflow_queue")
+	assert.Contains(t, d.Queues, "pod_cleanup_queue")
+	assert.Empty(t, d.Locks)
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// ephemeralNamespacePeriod is how often the ephemeral namespaces of deleted workflows are deleted
+var ephemeralNamespacePeriod = env.LookupEnvDurationOr("EPHEMERAL_NAMESPACE_PERIOD", time.Minute)
+
+// ephemeralNamespaceName returns the name of the ephemeral namespace of the workflow with the UID, which is unique,
+// unlike the workflow's name, which is only unique in its namespace, and may be re-used once the workflow is deleted
+func ephemeralNamespaceName(uid types.UID) string {
+	return "argo-" + string(uid)
+}
+
+// createEphemeralNamespace creates the ephemeral namespace of the workflow's pods, if the controller's config map
+// selects the workflow, with its resource quota, limit range, network policies, service accounts, role binding, and
+// secrets. Each is created at most once, so that it can be retried if any fails.
+func (woc *wfOperationCtx) createEphemeralNamespace(ctx context.Context) error {
+	c := woc.controller.Config.EphemeralNamespaces
+	if woc.wf.Status.PodNamespace != "" || !c.Selects(woc.wf.Namespace, labels.Set(woc.wf.Labels)) {
+		return nil
+	}
+	name := ephemeralNamespaceName(woc.wf.UID)
+	kube := woc.controller.kubeclientset
+	nsLabels := map[string]string{}
+	for k, v := range c.Labels {
+		nsLabels[k] = v
+	}
+	nsLabels[common.LabelKeyWorkflow] = woc.wf.Name
+	nsLabels[common.LabelKeyWorkflowNamespace] = woc.wf.Namespace
+	if instanceID := woc.controller.Config.InstanceID; instanceID != "" {
+		nsLabels[common.LabelKeyControllerInstanceID] = instanceID
+	}
+	meta := func(objectName string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: objectName, Namespace: name}
+	}
+	created := func(err error) error {
+		if apierr.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	if _, err := kube.CoreV1().Namespaces().Create(ctx, &apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nsLabels, Annotations: c.Annotations}}, metav1.CreateOptions{}); created(err) != nil {
+		return fmt.Errorf("failed to create namespace %s: %w", name, err)
+	}
+	if c.ResourceQuota != nil {
+		if _, err := kube.CoreV1().ResourceQuotas(name).Create(ctx, &apiv1.ResourceQuota{ObjectMeta: meta("argo"), Spec: *c.ResourceQuota}, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create resource quota: %w", err)
+		}
+	}
+	if c.LimitRange != nil {
+		if _, err := kube.CoreV1().LimitRanges(name).Create(ctx, &apiv1.LimitRange{ObjectMeta: meta("argo"), Spec: *c.LimitRange}, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create limit range: %w", err)
+		}
+	}
+	for i, spec := range c.NetworkPolicies {
+		if _, err := kube.NetworkingV1().NetworkPolicies(name).Create(ctx, &networkingv1.NetworkPolicy{ObjectMeta: meta(fmt.Sprintf("argo-%d", i)), Spec: spec}, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create network policy: %w", err)
+		}
+	}
+	serviceAccountNames := []string{woc.execWf.Spec.ServiceAccountName}
+	if executor := woc.execWf.Spec.Executor; executor != nil {
+		serviceAccountNames = append(serviceAccountNames, executor.ServiceAccountName)
+	}
+	// the default service account is created by Kubernetes
+	for _, serviceAccountName := range serviceAccountNames {
+		if serviceAccountName == "" || serviceAccountName == "default" {
+			continue
+		}
+		if _, err := kube.CoreV1().ServiceAccounts(name).Create(ctx, &apiv1.ServiceAccount{ObjectMeta: meta(serviceAccountName)}, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create service account %s: %w", serviceAccountName, err)
+		}
+	}
+	if c.ClusterRole != "" {
+		roleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: meta("argo"),
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: c.ClusterRole},
+			Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:" + name}},
+		}
+		if _, err := kube.RbacV1().RoleBindings(name).Create(ctx, roleBinding, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create role binding: %w", err)
+		}
+	}
+	for _, secretName := range c.Secrets {
+		secret, err := kube.CoreV1().Secrets(woc.wf.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+		}
+		if _, err := kube.CoreV1().Secrets(name).Create(ctx, &apiv1.Secret{ObjectMeta: meta(secretName), Type: secret.Type, Data: secret.Data}, metav1.CreateOptions{}); created(err) != nil {
+			return fmt.Errorf("failed to create secret %s: %w", secretName, err)
+		}
+	}
+	woc.log.WithField("podNamespace", name).Info("Created ephemeral namespace")
+	woc.wf.Status.PodNamespace = name
+	woc.updated = true
+	return nil
+}
+
+// deleteEphemeralNamespaces deletes the ephemeral namespaces of the workflows that have been deleted, and so all of
+// their pods, and other resources
+func (wfc *WorkflowController) deleteEphemeralNamespaces(ctx context.Context) {
+	if wfc.Config.EphemeralNamespaces == nil {
+		return
+	}
+	ephemeral, err := labels.NewRequirement(common.LabelKeyWorkflowNamespace, selection.Exists, nil)
+	if err != nil {
+		panic(err)
+	}
+	selector := labels.NewSelector().Add(util.InstanceIDRequirement(wfc.Config.InstanceID), *ephemeral)
+	list, err := wfc.kubeclientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		log.WithError(err).Error("Failed to list ephemeral namespaces")
+		return
+	}
+	for _, ns := range list.Items {
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		obj, exists, err := wfc.wfInformer.GetStore().GetByKey(ns.Labels[common.LabelKeyWorkflowNamespace] + "/" + ns.Labels[common.LabelKeyWorkflow])
+		if err != nil {
+			continue
+		}
+		if exists {
+			if wf, ok := obj.(metav1.Object); ok && ephemeralNamespaceName(wf.GetUID()) == ns.Name {
+				continue
+			}
+		}
+		logCtx := log.WithField("namespace", ns.Name)
+		if err := wfc.kubeclientset.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{}); err != nil && !apierr.IsNotFound(err) {
+			logCtx.WithError(err).Error("Failed to delete ephemeral namespace")
+			continue
+		}
+		logCtx.Info("Deleted ephemeral namespace")
+	}
+}
+
+// workflowNamespace returns the namespace of the pod's workflow, which is not the pod's if it runs in an ephemeral
+// namespace
+func workflowNamespace(pod *apiv1.Pod) string {
+	if namespace, ok := pod.Labels[common.LabelKeyWorkflowNamespace]; ok {
+		return namespace
+	}
+	return pod.Namespace
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestEphemeralNamespace(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Namespace = "my-ns"
+	wf.UID = "my-uid"
+	wf.Spec.ServiceAccountName = "my-sa"
+	wf.Spec.PodDisruptionBudget = &policyv1beta.PodDisruptionBudgetSpec{}
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.EphemeralNamespaces = &config.EphemeralNamespaces{
+		Labels:          map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+		ResourceQuota:   &apiv1.ResourceQuotaSpec{Hard: apiv1.ResourceList{apiv1.ResourcePods: resource.MustParse("10")}},
+		NetworkPolicies: []networkingv1.NetworkPolicySpec{{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}}},
+		ClusterRole:     "executor",
+		Secrets:         []string{"my-secret"},
+	}
+	kube := controller.kubeclientset
+	_, err := kube.CoreV1().Secrets("my-ns").Create(ctx, &apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-secret"}, Data: map[string][]byte{"key": []byte("value")}}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, "argo-my-uid", woc.wf.Status.PodNamespace)
+	ns, err := kube.CoreV1().Namespaces().Get(ctx, "argo-my-uid", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]string{
+			"pod-security.kubernetes.io/enforce": "restricted",
+			common.LabelKeyWorkflow:              wf.Name,
+			common.LabelKeyWorkflowNamespace:     "my-ns",
+		}, ns.Labels)
+	}
+	_, err = kube.CoreV1().ResourceQuotas("argo-my-uid").Get(ctx, "argo", metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = kube.NetworkingV1().NetworkPolicies("argo-my-uid").Get(ctx, "argo-0", metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = kube.CoreV1().ServiceAccounts("argo-my-uid").Get(ctx, "my-sa", metav1.GetOptions{})
+	assert.NoError(t, err)
+	roleBinding, err := kube.RbacV1().RoleBindings("argo-my-uid").Get(ctx, "argo", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "executor", roleBinding.RoleRef.Name)
+		assert.Equal(t, "system:serviceaccounts:argo-my-uid", roleBinding.Subjects[0].Name)
+	}
+	secret, err := kube.CoreV1().Secrets("argo-my-uid").Get(ctx, "my-secret", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("value"), secret.Data["key"])
+	}
+	pods, err := kube.CoreV1().Pods("argo-my-uid").List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		assert.Empty(t, pod.OwnerReferences)
+		assert.Equal(t, "my-ns", pod.Labels[common.LabelKeyWorkflowNamespace])
+		assert.Equal(t, "my-ns", workflowNamespace(&pod))
+	}
+	pods, err = listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Empty(t, pods.Items)
+	}
+	pdb, err := kube.PolicyV1beta1().PodDisruptionBudgets("argo-my-uid").Get(ctx, wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, pdb.OwnerReferences)
+	}
+}
+
+func TestDeleteEphemeralNamespaces(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Namespace = "my-ns"
+	wf.UID = "my-uid"
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.EphemeralNamespaces = &config.EphemeralNamespaces{}
+	kube := controller.kubeclientset
+	for _, ns := range []*apiv1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "argo-my-uid", Labels: map[string]string{common.LabelKeyWorkflow: wf.Name, common.LabelKeyWorkflowNamespace: "my-ns"}}},
+		// the namespace of an earlier workflow of the same name
+		{ObjectMeta: metav1.ObjectMeta{Name: "argo-old-uid", Labels: map[string]string{common.LabelKeyWorkflow: wf.Name, common.LabelKeyWorkflowNamespace: "my-ns"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "argo-deleted-uid", Labels: map[string]string{common.LabelKeyWorkflow: "deleted", common.LabelKeyWorkflowNamespace: "my-ns"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	} {
+		_, err := kube.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	controller.deleteEphemeralNamespaces(ctx)
+	list, err := kube.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		var names []string
+		for _, ns := range list.Items {
+			names = append(names, ns.Name)
+		}
+		assert.ElementsMatch(t, []string{"argo-my-uid", "other"}, names)
+	}
+}
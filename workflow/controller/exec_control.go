@@ -31,8 +31,9 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 		if woc.GetShutdownStrategy().Enabled() {
 			// Only delete pods that are not part of an onExit handler if we are "Stopping" or all pods if we are "Terminating"
 			_, onExitPod := pod.Labels[common.LabelKeyOnExit]
+			_, onShutdownPod := pod.Labels[common.LabelKeyOnShutdown]
 
-			if !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
+			if !onShutdownPod && !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
 				woc.log.Infof("Deleting Pending pod %s/%s as part of workflow shutdown with strategy: %s", pod.Namespace, pod.Name, woc.GetShutdownStrategy())
 				err := woc.controller.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
 				if err == nil {
@@ -62,13 +63,41 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 		}
 	}
 	if woc.GetShutdownStrategy().Enabled() {
-		if _, onExitPod := pod.Labels[common.LabelKeyOnExit]; !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
-			woc.log.Infof("Shutting down pod %s", pod.Name)
-			woc.controller.queuePodForCleanup(woc.wf.Namespace, pod.Name, shutdownPod)
+		_, onExitPod := pod.Labels[common.LabelKeyOnExit]
+		_, onShutdownPod := pod.Labels[common.LabelKeyOnShutdown]
+		if !onShutdownPod && !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
+			if gracePeriod := woc.shutdownGracePeriod(pod, wfNodesLock); gracePeriod > 0 {
+				woc.log.Infof("Shutting down pod %s in %v", pod.Name, gracePeriod)
+				woc.controller.queuePodForCleanupAfter(woc.wf.GetPodNamespace(), pod.Name, shutdownPod, gracePeriod)
+			} else {
+				woc.log.Infof("Shutting down pod %s", pod.Name)
+				woc.controller.queuePodForCleanup(woc.wf.GetPodNamespace(), pod.Name, shutdownPod)
+			}
 		}
 	}
 }
 
+// shutdownGracePeriod returns how much longer to wait before shutting down a pod, so its template's onShutdown hook can
+// complete. It is zero once the hook has completed
+func (woc *wfOperationCtx) shutdownGracePeriod(pod *apiv1.Pod, wfNodesLock *sync.RWMutex) time.Duration {
+	wfNodesLock.Lock()
+	defer wfNodesLock.Unlock()
+
+	tmpl := woc.findTemplate(pod)
+	if tmpl == nil || tmpl.ShutdownGracePeriodSeconds == nil {
+		return 0
+	}
+	gracePeriod := time.Duration(*tmpl.ShutdownGracePeriodSeconds) * time.Second
+	hookNode := woc.wf.GetNodeByName(common.GenerateOnShutdownNodeName(pod.Annotations[common.AnnotationKeyNodeName]))
+	if hookNode == nil {
+		return gracePeriod
+	}
+	if hookNode.Fulfilled() {
+		return 0
+	}
+	return gracePeriod - time.Since(hookNode.StartedAt.Time)
+}
+
 // handleExecutionControlError marks a node as failed with an error message
 func (woc *wfOperationCtx) handleExecutionControlError(nodeID string, wfNodesLock *sync.RWMutex, errorMsg string) {
 	wfNodesLock.Lock()
@@ -95,7 +124,7 @@ func (woc *wfOperationCtx) killDaemonedChildren(nodeID string) {
 		if childNode.Daemoned == nil || !*childNode.Daemoned {
 			continue
 		}
-		woc.controller.queuePodForCleanup(woc.wf.Namespace, childNode.ID, shutdownPod)
+		woc.controller.queuePodForCleanup(woc.wf.GetPodNamespace(), childNode.ID, shutdownPod)
 		childNode.Phase = wfv1.NodeSucceeded
 		childNode.Daemoned = nil
 		woc.wf.Status.Nodes[childNode.ID] = childNode
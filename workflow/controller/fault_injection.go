@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// selectFaults returns the faults injected into the pod of the node, if the controller injects faults
+func (woc *wfOperationCtx) selectFaults(tmpl *wfv1.Template, nodeID string) []config.Fault {
+	if !woc.controller.faultInjection {
+		return nil
+	}
+	return woc.controller.Config.FaultInjection.Select(woc.wf.Namespace, labels.Set(woc.wf.Labels), tmpl.Name, nodeID)
+}
+
+// checkPodStartDelay returns an error, that wraps ErrPodStartDelayed, if a fault delays creating the pod of the node,
+// and requeues the workflow for when the delay is over
+func (woc *wfOperationCtx) checkPodStartDelay(faults []config.Fault, nodeName string) error {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		return nil
+	}
+	for _, f := range faults {
+		if f.PodStartDelay == nil {
+			continue
+		}
+		start := node.StartedAt.Add(f.PodStartDelay.Duration)
+		if time.Now().Before(start) {
+			woc.log.WithField("fault", f.Name).WithField("nodeName", nodeName).Info("Injecting pod start delay")
+			woc.requeueAfter(time.Until(start))
+			return fmt.Errorf("%w: fault %q delays the pod until %s", ErrPodStartDelayed, f.Name, start.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// injectFaults annotates the pod with the fault that fails its node, if any, and returns the environment variables of
+// the faults the executor injects
+func injectFaults(pod *apiv1.Pod, faults []config.Fault) []apiv1.EnvVar {
+	var envVars []apiv1.EnvVar
+	for _, f := range faults {
+		if f.PodFailure {
+			pod.Annotations[common.AnnotationKeyInjectedFault] = f.Name
+		}
+		if f.ArtifactUploadFailure && len(envVars) == 0 {
+			envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarArtifactUploadFault, Value: f.Name})
+		}
+	}
+	return envVars
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestFaultInjection(t *testing.T) {
+	ctx := context.Background()
+	faultInjection := config.FaultInjection{
+		{Name: "fail", Templates: []string{"whalesay"}, PodFailure: true, ArtifactUploadFailure: true},
+	}
+	t.Run("Disabled", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		controller.Config.FaultInjection = faultInjection
+		woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+		woc.operate(ctx)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			assert.NotContains(t, pods.Items[0].Annotations, common.AnnotationKeyInjectedFault)
+		}
+	})
+	t.Run("AnnotatedByTemplate", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Templates[0].Metadata.Annotations = map[string]string{common.AnnotationKeyInjectedFault: "fail"}
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+	t.Run("PodFailure", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		controller.faultInjection = true
+		controller.Config.FaultInjection = faultInjection
+		woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+		woc.operate(ctx)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			pod := pods.Items[0]
+			assert.Equal(t, "fail", pod.Annotations[common.AnnotationKeyInjectedFault])
+			for _, c := range pod.Spec.Containers {
+				assert.Contains(t, c.Env, apiv1.EnvVar{Name: common.EnvVarArtifactUploadFault, Value: "fail"})
+			}
+		}
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		node := woc.wf.Status.Nodes.FindByDisplayName(woc.wf.Name)
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Equal(t, `fault "fail" injected by the controller`, node.Message)
+		}
+	})
+	t.Run("PodStartDelay", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		controller.faultInjection = true
+		controller.Config.FaultInjection = config.FaultInjection{
+			{Name: "slow", PodStartDelay: &metav1.Duration{Duration: time.Hour}},
+		}
+		woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+		woc.operate(ctx)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items)
+		}
+		node := woc.wf.Status.Nodes.FindByDisplayName(woc.wf.Name)
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+			assert.True(t, strings.HasPrefix(node.Message, `pod start delayed by an injected fault: fault "slow" delays the pod until `), node.Message)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// checkFreezeWindows returns an error, that wraps ErrPodCreationFrozen, if a freeze window that selects the workflow
+// is active, and requeues the workflow for when the window ends
+func (woc *wfOperationCtx) checkFreezeWindows() error {
+	window, end := woc.controller.Config.FreezeWindows.Active(time.Now(), woc.wf.Namespace, labels.Set(woc.wf.Labels))
+	if window == nil {
+		return nil
+	}
+	woc.requeueAfter(time.Until(end))
+	return fmt.Errorf("%w: freeze window %q ends at %s", ErrPodCreationFrozen, window.Name, end.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestFreezeWindows(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(fanOutWorkflow)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.FreezeWindows = config.FreezeWindows{
+		{Name: "maintenance", Schedule: "* * * * *", Duration: config.TTL(time.Hour), Namespaces: []string{"default"}},
+	}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	assert.Empty(t, pods.Items)
+	frozen := woc.wf.Status.Nodes.Find(func(node wfv1.NodeStatus) bool {
+		return node.Type == wfv1.NodeTypePod && strings.HasPrefix(node.Message, `pod creation frozen: freeze window "maintenance" ends at `)
+	})
+	if assert.NotNil(t, frozen) {
+		assert.Equal(t, wfv1.NodePending, frozen.Phase)
+	}
+	t.Run("OtherNamespace", func(t *testing.T) {
+		controller.Config.FreezeWindows[0].Namespaces = []string{"other"}
+		woc := newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(context.Background())
+		pods, err := listPods(woc)
+		assert.NoError(t, err)
+		assert.Len(t, pods.Items, 3)
+	})
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestExecuteGRPCTemplate(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: grpc-template
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      grpc:
+        target: my-service:50051
+        method: grpc.health.v1.Health/Check
+        request: '{"service": "{{workflow.name}}"}'
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("grpc-template")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeTypeGRPC, node.Type)
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+	}
+	ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Get(ctx, "grpc-template", v1.GetOptions{})
+	if assert.NoError(t, err) && assert.Len(t, ts.Spec.Tasks, 1) {
+		for _, tmpl := range ts.Spec.Tasks {
+			if assert.NotNil(t, tmpl.GRPC) {
+				assert.Equal(t, `{"service": "grpc-template"}`, tmpl.GRPC.Request)
+			}
+		}
+	}
+	assert.True(t, woc.nodeRequiresTaskSetReconciliation("grpc-template"))
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// maxUnresponsiveNodes is the maximum number of nodes named in the ExecutorUnresponsive condition
+const maxUnresponsiveNodes = 5
+
+// lastHeartbeat returns the time of the last heartbeat in the annotations, or when the container started if it has not
+// reported one since
+func lastHeartbeat(annotations map[string]string, started time.Time) time.Time {
+	if t, err := time.Parse(time.RFC3339, annotations[common.AnnotationKeyHeartbeat]); err == nil && t.After(started) {
+		return t
+	}
+	return started
+}
+
+// reportsHeartbeats returns true if the container was created to report heartbeats, pods created before heartbeats
+// were configured do not
+func reportsHeartbeats(pod *apiv1.Pod, containerName string) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == containerName {
+			for _, e := range c.Env {
+				if e.Name == common.EnvVarHeartbeatInterval {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// runningContainerStartedAt returns when the container started, if the pod, and the container, are running, and the
+// container reports heartbeats
+func runningContainerStartedAt(pod *apiv1.Pod, containerName string) (time.Time, bool) {
+	if pod.Status.Phase != apiv1.PodRunning || pod.DeletionTimestamp != nil || !reportsHeartbeats(pod, containerName) {
+		return time.Time{}, false
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		if s.Name == containerName && s.State.Running != nil {
+			return s.State.Running.StartedAt.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// checkHeartbeats sets the AgentUnresponsive, and ExecutorUnresponsive, conditions if the agent, or the wait container
+// of any running pod, has not reported a heartbeat within the timeout, and removes them once they report again
+func (woc *wfOperationCtx) checkHeartbeats(pods []*apiv1.Pod, now time.Time) {
+	c := woc.controller.Config.Heartbeats
+	if c == nil {
+		woc.setCondition(wfv1.ConditionTypeAgentUnresponsive, "")
+		woc.setCondition(wfv1.ConditionTypeExecutorUnresponsive, "")
+		return
+	}
+	timeout := c.GetTimeout()
+	// the workflow is reconciled again when the next container would time out, as heartbeats do not trigger a
+	// reconciliation
+	var next time.Duration
+	check := func(annotations map[string]string, started time.Time) bool {
+		remaining := lastHeartbeat(annotations, started).Add(timeout).Sub(now)
+		if remaining <= 0 {
+			return false
+		}
+		if next == 0 || remaining < next {
+			next = remaining
+		}
+		return true
+	}
+	agentMessage := ""
+	var nodes []string
+	for _, pod := range pods {
+		if woc.isAgentPod(pod) {
+			started, running := runningContainerStartedAt(pod, common.MainContainerName)
+			if running && !check(woc.taskSetAnnotations(), started) {
+				agentMessage = fmt.Sprintf("agent has not reported for %v", timeout)
+			}
+			continue
+		}
+		started, running := runningContainerStartedAt(pod, common.WaitContainerName)
+		if running && !check(pod.Annotations, started) {
+			name := pod.Name
+			if node, ok := woc.wf.Status.Nodes[woc.nodeID(pod)]; ok {
+				name = node.Name
+			}
+			nodes = append(nodes, name)
+		}
+	}
+	woc.setCondition(wfv1.ConditionTypeAgentUnresponsive, agentMessage)
+	executorMessage := ""
+	if len(nodes) > 0 {
+		sort.Strings(nodes)
+		names := strings.Join(nodes, ", ")
+		if len(nodes) > maxUnresponsiveNodes {
+			names = fmt.Sprintf("%s, and %d more", strings.Join(nodes[:maxUnresponsiveNodes], ", "), len(nodes)-maxUnresponsiveNodes)
+		}
+		executorMessage = fmt.Sprintf("wait container of %s has not reported for %v", names, timeout)
+	}
+	woc.setCondition(wfv1.ConditionTypeExecutorUnresponsive, executorMessage)
+	if next > 0 {
+		woc.requeueAfter(next)
+	}
+}
+
+// taskSetAnnotations returns the annotations of the workflow's task set, which the agent reports heartbeats to
+func (woc *wfOperationCtx) taskSetAnnotations() map[string]string {
+	obj, exists, err := woc.controller.wfTaskSetInformer.Informer().GetIndexer().GetByKey(woc.wf.Namespace + "/" + woc.wf.Name)
+	if err != nil || !exists {
+		return nil
+	}
+	return obj.(*wfv1.WorkflowTaskSet).Annotations
+}
+
+// setCondition sets the condition, with the message, or removes it if the message is empty
+func (woc *wfOperationCtx) setCondition(conditionType wfv1.ConditionType, message string) {
+	for _, c := range woc.wf.Status.Conditions {
+		if c.Type == conditionType {
+			if message == "" {
+				woc.wf.Status.Conditions.RemoveCondition(conditionType)
+				woc.updated = true
+			} else if c.Message != message {
+				woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: conditionType, Status: metav1.ConditionTrue, Message: message})
+				woc.updated = true
+			}
+			return
+		}
+	}
+	if message != "" {
+		woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: conditionType, Status: metav1.ConditionTrue, Message: message})
+		woc.updated = true
+	}
+}
+
+// heartbeatOnly returns true if only the agent's heartbeat changed between the task sets
+func heartbeatOnly(old, new *wfv1.WorkflowTaskSet) bool {
+	return old.ResourceVersion != new.ResourceVersion &&
+		old.Annotations[common.AnnotationKeyHeartbeat] != new.Annotations[common.AnnotationKeyHeartbeat] &&
+		equality.Semantic.DeepEqual(old.Spec, new.Spec) &&
+		equality.Semantic.DeepEqual(old.Status, new.Status)
+}
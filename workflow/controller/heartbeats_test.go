@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func heartbeatPod(name, container string, started time.Time, heartbeat string) *apiv1.Pod {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Annotations: map[string]string{}},
+		Spec: apiv1.PodSpec{Containers: []apiv1.Container{
+			{Name: container, Env: []apiv1.EnvVar{{Name: common.EnvVarHeartbeatInterval, Value: "1m0s"}}},
+		}},
+		Status: apiv1.PodStatus{
+			Phase: apiv1.PodRunning,
+			ContainerStatuses: []apiv1.ContainerStatus{
+				{Name: container, State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{StartedAt: metav1.Time{Time: started}}}},
+			},
+		},
+	}
+	if heartbeat != "" {
+		pod.Annotations[common.AnnotationKeyHeartbeat] = heartbeat
+	}
+	return pod
+}
+
+func TestCheckHeartbeats(t *testing.T) {
+	now := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.Heartbeats = &config.Heartbeats{}
+	woc := newWorkflowOperationCtx(wf, controller)
+	condition := func(conditionType wfv1.ConditionType) string {
+		for _, c := range woc.wf.Status.Conditions {
+			if c.Type == conditionType {
+				return c.Message
+			}
+		}
+		return ""
+	}
+
+	t.Run("Responsive", func(t *testing.T) {
+		woc.checkHeartbeats([]*apiv1.Pod{
+			heartbeatPod("my-wf-1", common.WaitContainerName, now.Add(-time.Minute), ""),
+			heartbeatPod("my-wf-2", common.WaitContainerName, now.Add(-time.Hour), now.Add(-time.Minute).Format(time.RFC3339)),
+		}, now)
+		assert.Empty(t, woc.wf.Status.Conditions)
+	})
+	t.Run("ExecutorUnresponsive", func(t *testing.T) {
+		old := heartbeatPod("my-wf-3", common.WaitContainerName, now.Add(-time.Hour), "")
+		// pods created before heartbeats were configured do not report them
+		old.Spec.Containers[0].Env = nil
+		woc.checkHeartbeats([]*apiv1.Pod{
+			heartbeatPod("my-wf-1", common.WaitContainerName, now.Add(-time.Hour), ""),
+			heartbeatPod("my-wf-2", common.WaitContainerName, now.Add(-time.Hour), now.Add(-10*time.Minute).Format(time.RFC3339)),
+			old,
+		}, now)
+		assert.Equal(t, "wait container of my-wf-1, my-wf-2 has not reported for 5m0s", condition(wfv1.ConditionTypeExecutorUnresponsive))
+		assert.Empty(t, condition(wfv1.ConditionTypeAgentUnresponsive))
+	})
+	t.Run("AgentUnresponsive", func(t *testing.T) {
+		assert.NoError(t, controller.wfTaskSetInformer.Informer().GetIndexer().Add(&wfv1.WorkflowTaskSet{ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-wf",
+			Namespace:   "my-ns",
+			Annotations: map[string]string{common.AnnotationKeyHeartbeat: now.Add(-6 * time.Minute).Format(time.RFC3339)},
+		}}))
+		woc.checkHeartbeats([]*apiv1.Pod{heartbeatPod(woc.getAgentPodName(), common.MainContainerName, now.Add(-time.Hour), "")}, now)
+		assert.Equal(t, "agent has not reported for 5m0s", condition(wfv1.ConditionTypeAgentUnresponsive))
+		assert.Empty(t, condition(wfv1.ConditionTypeExecutorUnresponsive))
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		controller.Config.Heartbeats = nil
+		woc.checkHeartbeats([]*apiv1.Pod{heartbeatPod(woc.getAgentPodName(), common.MainContainerName, now.Add(-time.Hour), "")}, now)
+		assert.Empty(t, woc.wf.Status.Conditions)
+	})
+}
+
+func TestHeartbeatOnly(t *testing.T) {
+	taskSet := func(resourceVersion, heartbeat string, tasks map[string]wfv1.Template) *wfv1.WorkflowTaskSet {
+		return &wfv1.WorkflowTaskSet{
+			ObjectMeta: metav1.ObjectMeta{ResourceVersion: resourceVersion, Annotations: map[string]string{common.AnnotationKeyHeartbeat: heartbeat}},
+			Spec:       wfv1.WorkflowTaskSetSpec{Tasks: tasks},
+		}
+	}
+	assert.True(t, heartbeatOnly(taskSet("1", "a", nil), taskSet("2", "b", nil)))
+	assert.False(t, heartbeatOnly(taskSet("1", "a", nil), taskSet("1", "a", nil)), "resync")
+	assert.False(t, heartbeatOnly(taskSet("1", "a", nil), taskSet("2", "b", map[string]wfv1.Template{"my-node": {}})), "changed spec")
+}
+
+func TestHeartbeatEnv(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.Heartbeats = &config.Heartbeats{Interval: &metav1.Duration{Duration: 30 * time.Second}}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		for _, c := range pods.Items[0].Spec.Containers {
+			assert.Contains(t, c.Env, apiv1.EnvVar{Name: common.EnvVarHeartbeatInterval, Value: "30s"})
+		}
+	}
+}
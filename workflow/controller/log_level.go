@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/util/logs"
+)
+
+// LogLevel responds with the log levels, or, for a PUT, sets them to those of the request, until the config map changes,
+// or the controller restarts. Callers authenticate with the bearer token of a user, or service account, that may get
+// the config map, or, to set the levels, update it.
+func (wfc *WorkflowController) LogLevel(w http.ResponseWriter, r *http.Request) {
+	verb := "get"
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		verb = "update"
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if code, err := wfc.authorize(r, verb); err != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
+	if r.Method == http.MethodPut {
+		var levels logs.Levels
+		if err := json.NewDecoder(r.Body).Decode(&levels); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := logs.SetLevels(levels); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.WithField("levels", levels).Info("Log levels changed")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(logs.GetLevels())
+}
+
+// authorize returns an error, and the status code to respond with, unless the caller may perform the verb on the
+// config map
+func (wfc *WorkflowController) authorize(r *http.Request, verb string) (int, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return http.StatusUnauthorized, fmt.Errorf("a bearer token is required")
+	}
+	ctx := r.Context()
+	review, err := wfc.kubeclientset.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to authenticate: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return http.StatusUnauthorized, fmt.Errorf("invalid bearer token")
+	}
+	user := review.Status.User
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	access, err := wfc.kubeclientset.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: wfc.namespace,
+				Verb:      verb,
+				Resource:  "configmaps",
+				Name:      wfc.configMap,
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to authorize: %w", err)
+	}
+	if !access.Status.Allowed {
+		return http.StatusForbidden, fmt.Errorf("%s may not %s configmaps/%s in %s", user.Username, verb, wfc.configMap, wfc.namespace)
+	}
+	return 0, nil
+}
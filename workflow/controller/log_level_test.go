@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/util/logs"
+)
+
+func TestLogLevel(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.namespace = "argo"
+	controller.configMap = "workflow-controller-configmap"
+	level := log.GetLevel()
+	defer func() { assert.NoError(t, logs.SetLevels(logs.Levels{Level: level.String()})) }()
+	assert.NoError(t, logs.SetLevels(logs.Levels{Level: "info"}))
+
+	var reviewed *authorizationv1.ResourceAttributes
+	kube := controller.kubeclientset.(*fake.Clientset)
+	kube.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		review.Status.Authenticated = review.Spec.Token != "invalid"
+		review.Status.User = authenticationv1.UserInfo{Username: review.Spec.Token}
+		return true, review, nil
+	})
+	kube.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		reviewed = review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "admin" || review.Spec.User == "viewer" && review.Spec.ResourceAttributes.Verb == "get"
+		return true, review, nil
+	})
+
+	request := func(method, token, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/log-level", strings.NewReader(body))
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		controller.LogLevel(w, r)
+		return w
+	}
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "invalid", "").Code)
+	})
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		assert.Equal(t, http.StatusMethodNotAllowed, request(http.MethodPost, "admin", "").Code)
+	})
+	t.Run("Get", func(t *testing.T) {
+		w := request(http.MethodGet, "viewer", "")
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.JSONEq(t, `{"level":"info"}`, w.Body.String())
+		}
+		assert.Equal(t, &authorizationv1.ResourceAttributes{Namespace: "argo", Verb: "get", Resource: "configmaps", Name: "workflow-controller-configmap"}, reviewed)
+	})
+	t.Run("Forbidden", func(t *testing.T) {
+		w := request(http.MethodPut, "viewer", `{"level":"debug"}`)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, "update", reviewed.Verb)
+		assert.Equal(t, log.InfoLevel, log.GetLevel())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, request(http.MethodPut, "admin", `{"level":"loud"}`).Code)
+		assert.Equal(t, http.StatusBadRequest, request(http.MethodPut, "admin", `{`).Code)
+		assert.Equal(t, log.InfoLevel, log.GetLevel())
+	})
+	t.Run("Put", func(t *testing.T) {
+		w := request(http.MethodPut, "admin", `{"level":"warn","components":{"podReconciliation":"debug"}}`)
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.JSONEq(t, `{"level":"warning","components":{"podReconciliation":"debug"}}`, w.Body.String())
+		}
+		assert.Equal(t, log.WarnLevel, log.GetLevel())
+		assert.Equal(t, log.DebugLevel, logs.ComponentLevel(logs.ComponentPodReconciliation))
+	})
+}
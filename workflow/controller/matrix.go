@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// expandMatrix returns an item per combination of the values of the matrix's axes, after its excludes, and
+// includes, have been applied. Each item is a map keyed by the name of the axis.
+func expandMatrix(matrix *wfv1.Matrix) ([]wfv1.Item, error) {
+	var combinations []map[string]wfv1.Item
+	if len(matrix.Axes) > 0 {
+		combinations = []map[string]wfv1.Item{{}}
+	}
+	axes := make(map[string]bool)
+	for _, axis := range matrix.Axes {
+		axes[axis.Name] = true
+		var next []map[string]wfv1.Item
+		for _, combination := range combinations {
+			for _, value := range axis.Values {
+				n := copyCombination(combination)
+				n[axis.Name] = value
+				next = append(next, n)
+			}
+		}
+		combinations = next
+	}
+	for _, item := range matrix.Exclude {
+		if item.GetType() != wfv1.Map {
+			return nil, fmt.Errorf("matrix exclude %v is not a map", item)
+		}
+		exclude := item.GetMapVal()
+		var next []map[string]wfv1.Item
+		for _, combination := range combinations {
+			if !matchesCombination(exclude, combination, func(string) bool { return true }) {
+				next = append(next, combination)
+			}
+		}
+		combinations = next
+	}
+	// includes only extend the combinations of the axes, not those added by other includes
+	n := len(combinations)
+	for _, item := range matrix.Include {
+		if item.GetType() != wfv1.Map {
+			return nil, fmt.Errorf("matrix include %v is not a map", item)
+		}
+		include := item.GetMapVal()
+		matched := false
+		for _, combination := range combinations[:n] {
+			if matchesCombination(include, combination, func(key string) bool { return axes[key] }) {
+				matched = true
+				for key, value := range include {
+					if !axes[key] {
+						combination[key] = value
+					}
+				}
+			}
+		}
+		if !matched {
+			combinations = append(combinations, copyCombination(include))
+		}
+	}
+	items := make([]wfv1.Item, len(combinations))
+	for i, combination := range combinations {
+		data, err := json.Marshal(combination)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// matchesCombination returns whether or not the values of the entry, whose keys are compared, equal those of the
+// combination
+func matchesCombination(entry, combination map[string]wfv1.Item, compared func(key string) bool) bool {
+	for key, value := range entry {
+		if !compared(key) {
+			continue
+		}
+		v, ok := combination[key]
+		if !ok || v.String() != value.String() {
+			return false
+		}
+	}
+	return true
+}
+
+func copyCombination(combination map[string]wfv1.Item) map[string]wfv1.Item {
+	n := make(map[string]wfv1.Item, len(combination))
+	for key, value := range combination {
+		n[key] = value
+	}
+	return n
+}
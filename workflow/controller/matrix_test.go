@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func parseItems(t *testing.T, values ...string) []wfv1.Item {
+	var items []wfv1.Item
+	for _, v := range values {
+		item, err := wfv1.ParseItem(v)
+		assert.NoError(t, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestExpandMatrix(t *testing.T) {
+	axes := []wfv1.MatrixAxis{
+		{Name: "os", Values: parseItems(t, `"linux"`, `"windows"`)},
+		{Name: "version", Values: parseItems(t, `"1.16"`, `1.17`)},
+	}
+	t.Run("Axes", func(t *testing.T) {
+		result, err := expandMatrix(&wfv1.Matrix{Axes: axes})
+		if assert.NoError(t, err) {
+			assert.Equal(t, parseItems(t,
+				`{"os":"linux","version":"1.16"}`,
+				`{"os":"linux","version":1.17}`,
+				`{"os":"windows","version":"1.16"}`,
+				`{"os":"windows","version":1.17}`,
+			), result)
+		}
+	})
+	t.Run("NoAxes", func(t *testing.T) {
+		result, err := expandMatrix(&wfv1.Matrix{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, result)
+		}
+	})
+	t.Run("Exclude", func(t *testing.T) {
+		result, err := expandMatrix(&wfv1.Matrix{Axes: axes, Exclude: parseItems(t, `{"os":"windows","version":"1.17"}`, `{"version":"1.16"}`)})
+		if assert.NoError(t, err) {
+			assert.Equal(t, parseItems(t, `{"os":"linux","version":1.17}`), result)
+		}
+	})
+	t.Run("Include", func(t *testing.T) {
+		result, err := expandMatrix(&wfv1.Matrix{Axes: axes, Include: parseItems(t,
+			`{"os":"linux","arch":"arm64"}`,
+			`{"os":"darwin","version":"1.17"}`,
+			`{"os":"darwin","version":"1.18"}`,
+		)})
+		if assert.NoError(t, err) {
+			assert.Equal(t, parseItems(t,
+				`{"arch":"arm64","os":"linux","version":"1.16"}`,
+				`{"arch":"arm64","os":"linux","version":1.17}`,
+				`{"os":"windows","version":"1.16"}`,
+				`{"os":"windows","version":1.17}`,
+				`{"os":"darwin","version":"1.17"}`,
+				`{"os":"darwin","version":"1.18"}`,
+			), result)
+		}
+	})
+	t.Run("NotAMap", func(t *testing.T) {
+		_, err := expandMatrix(&wfv1.Matrix{Axes: axes, Exclude: parseItems(t, `"linux"`)})
+		assert.EqualError(t, err, "matrix exclude linux is not a map")
+	})
+}
+
+func TestMatrixDAG(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: matrix
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: build
+            template: build
+            arguments:
+              parameters:
+                - name: os
+                  value: "{{item.os}}"
+                - name: arch
+                  value: "{{item.arch}}"
+            strategy:
+              matrix:
+                axes:
+                  - name: os
+                    values: [linux, windows]
+                  - name: arch
+                    values: [amd64, arm64]
+                exclude:
+                  - os: windows
+                    arch: arm64
+    - name: build
+      inputs:
+        parameters:
+          - name: os
+          - name: arch
+      container:
+        image: argoproj/argosay:v2
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	for _, name := range []string{"build(0:arch:amd64,os:linux)", "build(1:arch:arm64,os:linux)", "build(2:arch:amd64,os:windows)"} {
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName(name), name)
+	}
+	pods := 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			pods++
+		}
+	}
+	assert.Equal(t, 3, pods)
+}
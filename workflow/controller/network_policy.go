@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// createNetworkPolicy labels the pod, and creates the network policy that selects it, if its template has a network
+// policy. The pods of templates with the same network policy share one, which is deleted with the workflow.
+func (woc *wfOperationCtx) createNetworkPolicy(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	if tmpl.NetworkPolicy == nil {
+		return nil
+	}
+	dns := woc.controller.Config.GetNetworkPolicyDNS()
+	egress := woc.controller.Config.NetworkPolicyEgress
+	// the hash includes the controller's config, so that a network policy is not shared by pods created before, and
+	// after, it changed
+	data, err := json.Marshal([]interface{}{tmpl.NetworkPolicy, dns, egress})
+	if err != nil {
+		return err
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(data)
+	hash := fmt.Sprintf("%x", h.Sum32())
+	pod.Labels[common.LabelKeyNetworkPolicy] = hash
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            woc.wf.Name + "-" + hash,
+			Namespace:       pod.Namespace,
+			Labels:          map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+			OwnerReferences: pod.OwnerReferences,
+		},
+		Spec: networkPolicySpec(metav1.LabelSelector{MatchLabels: map[string]string{
+			common.LabelKeyWorkflow:      woc.wf.Name,
+			common.LabelKeyNetworkPolicy: hash,
+		}}, *tmpl.NetworkPolicy, dns, egress),
+	}
+	_, err = woc.controller.kubeclientset.NetworkingV1().NetworkPolicies(pod.Namespace).Create(ctx, policy, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create network policy %s: %w", policy.Name, err)
+	}
+	woc.log.WithField("networkPolicy", policy.Name).Info("Created network policy")
+	return nil
+}
+
+// networkPolicySpec returns the spec of a network policy that denies all ingress to the selected pods, and all egress
+// from them, but to the cluster DNS, the egress allowed by the controller's config, and the template's allowlist
+func networkPolicySpec(podSelector metav1.LabelSelector, p wfv1.TemplateNetworkPolicy, dns []networkingv1.NetworkPolicyPeer, egress []networkingv1.NetworkPolicyEgressRule) networkingv1.NetworkPolicySpec {
+	udp, tcp := apiv1.ProtocolUDP, apiv1.ProtocolTCP
+	dnsPort := intstr.FromInt(53)
+	spec := networkingv1.NetworkPolicySpec{
+		PodSelector: podSelector,
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		Egress: append([]networkingv1.NetworkPolicyEgressRule{
+			{To: dns, Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &dnsPort}, {Protocol: &tcp, Port: &dnsPort}}},
+		}, egress...),
+	}
+	for _, d := range p.EgressAllowlist {
+		rule := networkingv1.NetworkPolicyEgressRule{To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: d.CIDR}}}}
+		for _, port := range d.Ports {
+			port := intstr.FromInt(int(port))
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: &port})
+		}
+		spec.Egress = append(spec.Egress, rule)
+	}
+	return spec
+}
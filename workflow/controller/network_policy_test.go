@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const networkPolicyWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: network-policy
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: untrusted
+          - name: b
+            template: untrusted
+    - name: untrusted
+      networkPolicy:
+        type: EgressAllowlist
+        egressAllowlist:
+          - cidr: 203.0.113.0/24
+            ports: [443]
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestNetworkPolicy(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(networkPolicyWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.NetworkPolicyEgress = []networkingv1.NetworkPolicyEgressRule{
+		{To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}}},
+	}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 2) {
+		assert.NotEmpty(t, pods.Items[0].Labels[common.LabelKeyNetworkPolicy])
+		assert.Equal(t, pods.Items[0].Labels[common.LabelKeyNetworkPolicy], pods.Items[1].Labels[common.LabelKeyNetworkPolicy], "pods of the same network policy share one")
+	}
+	policies, err := controller.kubeclientset.NetworkingV1().NetworkPolicies("my-ns").List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) && assert.Len(t, policies.Items, 1) {
+		policy := policies.Items[0]
+		assert.Equal(t, "network-policy-"+pods.Items[0].Labels[common.LabelKeyNetworkPolicy], policy.Name)
+		if assert.Len(t, policy.OwnerReferences, 1) {
+			assert.Equal(t, "network-policy", policy.OwnerReferences[0].Name)
+		}
+		assert.Equal(t, map[string]string{
+			common.LabelKeyWorkflow:      "network-policy",
+			common.LabelKeyNetworkPolicy: pods.Items[0].Labels[common.LabelKeyNetworkPolicy],
+		}, policy.Spec.PodSelector.MatchLabels)
+		assert.ElementsMatch(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+		assert.Empty(t, policy.Spec.Ingress)
+		if assert.Len(t, policy.Spec.Egress, 3) {
+			assert.Len(t, policy.Spec.Egress[0].Ports, 2, "DNS")
+			if assert.Len(t, policy.Spec.Egress[0].To, 1, "only to the cluster DNS") {
+				assert.Equal(t, "kube-dns", policy.Spec.Egress[0].To[0].PodSelector.MatchLabels["k8s-app"])
+			}
+			assert.Equal(t, "10.0.0.1/32", policy.Spec.Egress[1].To[0].IPBlock.CIDR)
+			assert.Equal(t, "203.0.113.0/24", policy.Spec.Egress[2].To[0].IPBlock.CIDR)
+			assert.Equal(t, 443, policy.Spec.Egress[2].Ports[0].Port.IntValue())
+		}
+	}
+}
+
+func TestNetworkPolicyConfigChanged(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(networkPolicyWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	tmpl := woc.wf.GetTemplateByName("untrusted")
+	hash := func() string {
+		pod := &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Labels: map[string]string{}}}
+		assert.NoError(t, woc.createNetworkPolicy(ctx, pod, tmpl))
+		return pod.Labels[common.LabelKeyNetworkPolicy]
+	}
+	before := hash()
+	controller.Config.NetworkPolicyDNS = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.96.0.10/32"}}}
+	afterDNS := hash()
+	assert.NotEqual(t, before, afterDNS, "the DNS changed")
+	controller.Config.NetworkPolicyEgress = []networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}}}}
+	assert.NotEqual(t, afterDNS, hash(), "the egress changed")
+	policies, err := controller.kubeclientset.NetworkingV1().NetworkPolicies("my-ns").List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, policies.Items, 3)
+	}
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// workflows that have not had node events for this long are forgotten, by then their nodes are unlikely to be
+// reconciled from a stale copy of the workflow again, and their buckets are full again
+const nodeEventsIdleTimeout = 10 * time.Minute
+
+// nodeEventFilter deduplicates and rate limits the node events emitted about each workflow. Node events are emitted
+// for the changes between the workflow before and after it is reconciled, so an event is emitted again if the workflow
+// is reconciled from a stale copy, e.g. after a conflict.
+type nodeEventFilter struct {
+	limit     *config.NodeEventsRateLimit
+	mu        sync.Mutex
+	workflows map[types.UID]*workflowNodeEvents
+	lastSweep time.Time
+}
+
+type workflowNodeEvents struct {
+	// limiter is nil if node events are not limited
+	limiter  *rate.Limiter
+	emitted  map[string]bool
+	lastUsed time.Time
+}
+
+func newNodeEventFilter(limit *config.NodeEventsRateLimit) (*nodeEventFilter, error) {
+	if limit != nil && limit.EventsPerSecond <= 0 {
+		return nil, fmt.Errorf("nodeEvents rateLimit eventsPerSecond must be greater than zero")
+	}
+	return &nodeEventFilter{limit: limit, workflows: map[types.UID]*workflowNodeEvents{}}, nil
+}
+
+// allow returns true if the event, identified by the key, has not already been emitted about the workflow, and
+// emitting it does not exceed the rate limit
+func (f *nodeEventFilter) allow(uid types.UID, key string, now time.Time) bool {
+	if f == nil {
+		return true
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if now.Sub(f.lastSweep) > nodeEventsIdleTimeout {
+		for k, w := range f.workflows {
+			if now.Sub(w.lastUsed) > nodeEventsIdleTimeout {
+				delete(f.workflows, k)
+			}
+		}
+		f.lastSweep = now
+	}
+	w, ok := f.workflows[uid]
+	if !ok {
+		w = &workflowNodeEvents{emitted: map[string]bool{}}
+		if f.limit != nil {
+			w.limiter = rate.NewLimiter(rate.Limit(f.limit.EventsPerSecond), f.limit.GetBurst())
+		}
+		f.workflows[uid] = w
+	}
+	w.lastUsed = now
+	if w.emitted[key] {
+		return false
+	}
+	if w.limiter != nil && !w.limiter.AllowN(now, 1) {
+		return false
+	}
+	w.emitted[key] = true
+	return true
+}
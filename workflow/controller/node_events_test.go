@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestNodeEventFilter(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := newNodeEventFilter(&config.NodeEventsRateLimit{})
+		assert.EqualError(t, err, "nodeEvents rateLimit eventsPerSecond must be greater than zero")
+	})
+	t.Run("Nil", func(t *testing.T) {
+		var f *nodeEventFilter
+		assert.True(t, f.allow("my-uid", "a", time.Now()))
+	})
+	t.Run("Deduplicate", func(t *testing.T) {
+		f, err := newNodeEventFilter(nil)
+		assert.NoError(t, err)
+		now := time.Now()
+		assert.True(t, f.allow("my-uid", "a", now))
+		assert.False(t, f.allow("my-uid", "a", now))
+		assert.True(t, f.allow("my-uid", "b", now))
+		assert.True(t, f.allow("other-uid", "a", now))
+	})
+	t.Run("RateLimit", func(t *testing.T) {
+		f, err := newNodeEventFilter(&config.NodeEventsRateLimit{EventsPerSecond: 1, Burst: 2})
+		assert.NoError(t, err)
+		now := time.Now()
+		assert.True(t, f.allow("my-uid", "a", now))
+		assert.True(t, f.allow("my-uid", "b", now))
+		assert.False(t, f.allow("my-uid", "c", now))
+		assert.True(t, f.allow("other-uid", "a", now), "each workflow has its own limit")
+		assert.True(t, f.allow("my-uid", "c", now.Add(time.Second)), "a dropped event may be emitted later")
+	})
+	t.Run("Forget", func(t *testing.T) {
+		f, err := newNodeEventFilter(nil)
+		assert.NoError(t, err)
+		now := time.Now()
+		assert.True(t, f.allow("my-uid", "a", now))
+		assert.True(t, f.allow("other-uid", "a", now.Add(nodeEventsIdleTimeout+time.Second)))
+		assert.Len(t, f.workflows, 1)
+	})
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// notificationColors are the default colors of notifications, by the status of the workflow
+var notificationColors = map[wfv1.WorkflowPhase]string{
+	wfv1.WorkflowSucceeded: "#18be94",
+	wfv1.WorkflowFailed:    "#e96d76",
+	wfv1.WorkflowError:     "#e96d76",
+}
+
+func (woc *wfOperationCtx) executeNotificationTemplate(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) *wfv1.NodeStatus {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeNotification, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	if !node.Fulfilled() {
+		task := tmpl.DeepCopy()
+		if task.Notification.Color == "" {
+			// the agent does not know the status of the workflow, e.g. in an exit handler, so the controller colors it
+			task.Notification.Color = notificationColors[wfv1.WorkflowPhase(woc.globalParams[common.GlobalVarWorkflowStatus])]
+		}
+		woc.taskSet[node.ID] = *task
+	}
+	return node
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestExecuteNotificationTemplate(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: notification-template
+  namespace: default
+spec:
+  entrypoint: main
+  onExit: notify
+  templates:
+    - name: main
+      steps:
+        - - name: skipped
+            template: wait
+            when: "false"
+    - name: wait
+      suspend: {}
+    - name: notify
+      notification:
+        provider: slack
+        webhookURLSecret:
+          name: slack
+          key: url
+        message: "{{workflow.name}} {{workflow.status}}"
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("notification-template.onExit")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeTypeNotification, node.Type)
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+	}
+	ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Get(ctx, "notification-template", v1.GetOptions{})
+	if assert.NoError(t, err) && assert.Len(t, ts.Spec.Tasks, 1) {
+		for _, tmpl := range ts.Spec.Tasks {
+			if assert.NotNil(t, tmpl.Notification) {
+				assert.Equal(t, "notification-template Succeeded", tmpl.Notification.Message)
+				assert.Equal(t, "#18be94", tmpl.Notification.Color)
+			}
+		}
+	}
+}
@@ -2,7 +2,9 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"math"
 	"os"
@@ -20,6 +22,7 @@ import (
 	argokubeerr "github.com/argoproj/pkg/kube/errors"
 	"github.com/argoproj/pkg/strftime"
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	policyv1beta "k8s.io/api/policy/v1beta1"
@@ -28,7 +31,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
@@ -44,11 +46,13 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
 	"github.com/argoproj/argo-workflows/v3/util/resource"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	argoruntime "github.com/argoproj/argo-workflows/v3/util/runtime"
 	"github.com/argoproj/argo-workflows/v3/util/slice"
 	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
@@ -57,7 +61,9 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/progress"
 	argosync "github.com/argoproj/argo-workflows/v3/workflow/sync"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -95,6 +101,8 @@ type wfOperationCtx struct {
 	// activePods tracks the number of active (Running/Pending) pods for controlling
 	// parallelism
 	activePods int64
+	// createdPods is the number of pods created by this operation, which the pod informer may not have seen yet
+	createdPods int
 	// workflowDeadline is the deadline which the workflow is expected to complete before we
 	// terminate the workflow.
 	workflowDeadline *time.Time
@@ -118,6 +126,9 @@ var (
 	// ErrParallelismReached indicates this workflow reached its parallelism limit
 	ErrParallelismReached       = errors.New(errors.CodeForbidden, "Max parallelism reached")
 	ErrResourceRateLimitReached = errors.New(errors.CodeForbidden, "resource creation rate-limit reached")
+	ErrPodCreationBackpressure  = errors.New(errors.CodeForbidden, "pod creation delayed, as the cluster is saturated")
+	ErrPodCreationFrozen        = errors.New(errors.CodeForbidden, "pod creation frozen")
+	ErrPodStartDelayed          = errors.New(errors.CodeForbidden, "pod start delayed by an injected fault")
 	// ErrTimeout indicates a specific template timed out
 	ErrTimeout = errors.New(errors.CodeTimeout, "timeout")
 )
@@ -146,14 +157,11 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 	wfCopy := wf.DeepCopyObject().(*wfv1.Workflow)
 
 	woc := wfOperationCtx{
-		wf:      wfCopy,
-		orig:    wf,
-		execWf:  wfCopy,
-		updated: false,
-		log: log.WithFields(log.Fields{
-			"workflow":  wf.ObjectMeta.Name,
-			"namespace": wf.ObjectMeta.Namespace,
-		}),
+		wf:                     wfCopy,
+		orig:                   wf,
+		execWf:                 wfCopy,
+		updated:                false,
+		log:                    log.WithFields(logs.WorkflowFields(wf.Namespace, wf.Name, wf.UID)),
 		controller:             wfc,
 		globalParams:           make(map[string]string),
 		volumes:                wf.Spec.DeepCopy().Volumes,
@@ -180,6 +188,11 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 // later time
 // As you must not call `persistUpdates` twice, you must not call `operate` twice.
 func (woc *wfOperationCtx) operate(ctx context.Context) {
+	ctx, span := tracing.StartSpan(ctx, "operate", tracing.WorkflowAttributes(woc.wf.Namespace, woc.wf.Name, woc.wf.UID)...)
+	defer func() {
+		span.SetAttributes(tracing.KeyWorkflowPhase.String(string(woc.wf.Status.Phase)))
+		span.End()
+	}()
 	defer argoruntime.RecoverFromPanic(woc.log)
 
 	defer func() {
@@ -243,9 +256,12 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 				phase = wfv1.WorkflowPending
 			}
 			woc.markWorkflowPhase(ctx, phase, msg)
+			lockName, position, length := woc.controller.syncManager.QueuePosition(woc.wf, "", woc.execWf.Spec.Synchronization)
+			woc.setCondition(wfv1.ConditionTypeQueued, queuedMessage(fmt.Sprintf("Waiting for the lock %s", lockName), position, length))
 			return
 		}
 	}
+	woc.setCondition(wfv1.ConditionTypeQueued, "")
 
 	// Update workflow duration variable
 	if woc.wf.Status.StartedAt.IsZero() {
@@ -268,6 +284,11 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.markWorkflowRunning(ctx)
 		setWfPodNamesAnnotation(woc.wf)
 
+		if err := woc.createEphemeralNamespace(ctx); err != nil {
+			woc.markWorkflowFailed(ctx, fmt.Sprintf("Unable to create the ephemeral namespace of the workflow's pods: %s", err))
+			return
+		}
+
 		err := woc.createPDBResource(ctx)
 		if err != nil {
 			msg := fmt.Sprintf("Unable to create PDB resource for workflow, %s error: %s", woc.wf.Name, err)
@@ -376,6 +397,15 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	// Reconcile TaskSet and Agent for HTTP templates
 	woc.taskSetReconciliation(ctx)
 
+	if woc.GetShutdownStrategy().Enabled() {
+		if completed, err := woc.runOnShutdownHooks(ctx); err != nil {
+			woc.markNodeError(node.Name, err)
+		} else if !completed {
+			// the workflow does not complete until the onShutdown hooks of its pods have
+			return
+		}
+	}
+
 	if !node.Fulfilled() {
 		// node can be nil if a workflow created immediately in a parallelism == 0 state
 		return
@@ -573,6 +603,7 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 	diff.LogChanges(woc.orig, woc.wf)
 
 	resource.UpdateResourceDurations(woc.wf)
+	resource.UpdateEstimatedCosts(woc.wf)
 	progress.UpdateProgress(woc.wf)
 	// You MUST not call `persistUpdates` twice.
 	// * Fails the `reapplyUpdate` cannot work unless resource versions are different.
@@ -624,6 +655,13 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 
 	// Create WorkflowNode* events for nodes that have changed phase
 	woc.recordNodePhaseChangeEvents(woc.orig.Status.Nodes, woc.wf.Status.Nodes)
+	if woc.controller.notifier != nil {
+		woc.controller.notifier.Notify(woc.orig, woc.wf)
+	}
+	if woc.controller.eventSinks != nil {
+		woc.controller.eventSinks.Publish(woc.orig, woc.wf)
+	}
+	woc.controller.wfSnapshotter.snapshot(woc.wf, time.Now())
 
 	if !woc.controller.hydrator.IsHydrated(woc.wf) {
 		panic("workflow should be hydrated")
@@ -763,11 +801,13 @@ func (woc *wfOperationCtx) reapplyUpdate(ctx context.Context, wfClient v1alpha1.
 func (woc *wfOperationCtx) requeueAfter(afterDuration time.Duration) {
 	key, _ := cache.MetaNamespaceKeyFunc(woc.wf)
 	woc.controller.wfQueue.AddAfter(key, afterDuration)
+	woc.controller.metrics.NamespaceRequeued(woc.wf.Namespace)
 }
 
 func (woc *wfOperationCtx) requeue() {
 	key, _ := cache.MetaNamespaceKeyFunc(woc.wf)
 	woc.controller.wfQueue.AddRateLimited(key)
+	woc.controller.metrics.NamespaceRequeued(woc.wf.Namespace)
 }
 
 // processNodeRetries updates the retry node state based on the child node state and the retry strategy and returns the node.
@@ -778,6 +818,15 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 
 	lastChildNode := getChildNodeIndex(node, woc.wf.Status.Nodes, -1)
 
+	if opts.retryEvictions && lastChildNode != nil && isEvicted(*lastChildNode) && !woc.GetShutdownStrategy().Enabled() {
+		// critical steps, and spot steps, are retried when they are evicted, or preempted, regardless of the retry strategy
+		if evictions := countEvictions(node, woc.wf.Status.Nodes); evictions > maxEvictionRetries {
+			return woc.markNodePhase(node.Name, lastChildNode.Phase, fmt.Sprintf("evicted %d times", evictions)), true, nil
+		}
+		woc.log.WithField("node", node.Name).Infof("node was evicted, retrying: %s", lastChildNode.Message)
+		return node, true, nil
+	}
+
 	if retryStrategy.Expression != "" && len(node.Children) > 0 {
 		localScope := buildRetryStrategyLocalScope(node, woc.wf.Status.Nodes)
 		scope := env.GetFuncMap(localScope)
@@ -914,7 +963,12 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	if err != nil {
 		return nil, false, err
 	}
-	if retryStrategy.Limit != nil && limit != nil && int32(len(node.Children)) > *limit {
+	retries := len(node.Children)
+	if opts.retryEvictions {
+		// evictions do not count towards the limit
+		retries -= countEvictions(node, woc.wf.Status.Nodes)
+	}
+	if retryStrategy.Limit != nil && limit != nil && int32(retries) > *limit {
 		woc.log.Infoln("No more retries left. Failing...")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, "No more retries left"), true, nil
 	}
@@ -923,11 +977,67 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	return node, true, nil
 }
 
+const (
+	// podDeletedMessage is the message of the nodes whose pods were deleted before they completed, e.g. evicted because
+	// their node was drained
+	podDeletedMessage = "pod deleted"
+	// podEvictedReason is the reason of the pods the kubelet evicts, e.g. because their node is low on memory
+	podEvictedReason = "Evicted"
+	// maxEvictionRetries is how many times a node is retried after it is evicted
+	maxEvictionRetries = 5
+)
+
+// podEvictedReasons are the reasons of the pods the kubelet evicts, or terminates because their node is shutting down,
+// e.g. because it was preempted. The messages of their nodes are prefixed with the reason
+var podEvictedReasons = []string{podEvictedReason, "NodeShutdown", "Shutdown", "Terminated"}
+
+func isPodEvictedReason(reason string) bool {
+	for _, r := range podEvictedReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// isEvicted returns whether the node's pod was evicted, preempted, or deleted, before it completed
+func isEvicted(node wfv1.NodeStatus) bool {
+	if !node.FailedOrError() {
+		return false
+	}
+	if node.Message == podDeletedMessage {
+		return true
+	}
+	for _, reason := range podEvictedReasons {
+		if strings.HasPrefix(node.Message, reason+": ") {
+			return true
+		}
+	}
+	return false
+}
+
+// countEvictions returns how many of the retry node's children were evicted
+func countEvictions(node *wfv1.NodeStatus, nodes wfv1.Nodes) int {
+	evictions := 0
+	for _, childID := range node.Children {
+		if isEvicted(nodes[childID]) {
+			evictions++
+		}
+	}
+	return evictions
+}
+
+// podLog returns the logger of pod reconciliation, which has its own level
+func (woc *wfOperationCtx) podLog() *log.Entry {
+	return logs.ComponentLogger(logs.ComponentPodReconciliation).WithFields(woc.log.Data)
+}
+
 // podReconciliation is the process by which a workflow will examine all its related
 // pods and update the node state before continuing the evaluation of the workflow.
 // Records all pods which were observed completed, which will be labeled completed=true
 // after successful persist of the workflow.
 func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
+	logCtx := woc.podLog()
 	podList, err := woc.getAllWorkflowPods()
 	if err != nil {
 		return err
@@ -960,7 +1070,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
 						err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
 						if err != nil {
-							woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
+							logCtx.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
 							node.Phase = wfv1.NodeError
 						}
 					}
@@ -979,7 +1089,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				}
 				podProgress := progress.PodProgress(pod, &node)
 				if podProgress.IsValid() && node.Progress != podProgress {
-					woc.log.WithField("progress", podProgress).Info("pod progress")
+					logCtx.WithField("progress", podProgress).Info("pod progress")
 					node.Progress = podProgress
 					woc.wf.Status.Nodes[nodeID] = node
 					woc.updated = true
@@ -1014,6 +1124,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 	wg.Wait()
 
 	woc.wf.Status.Conditions.UpsertCondition(podRunningCondition)
+	woc.checkHeartbeats(podList, time.Now())
 
 	// Now check for deleted pods. Iterate our nodes. If any one of our nodes does not show up in
 	// the seen list it implies that the pod was deleted without the controller seeing the event.
@@ -1028,7 +1139,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 
 			// grace-period to allow informer sync
 			recentlyStarted := recentlyStarted(node)
-			woc.log.WithFields(log.Fields{"nodeName": node.Name, "nodePhase": node.Phase, "recentlyStarted": recentlyStarted}).Info("Workflow pod is missing")
+			logCtx.WithFields(log.Fields{logs.FieldNodeID: nodeID, "nodeName": node.Name, "nodePhase": node.Phase, "recentlyStarted": recentlyStarted}).Info("Workflow pod is missing")
 			metrics.PodMissingMetric.WithLabelValues(strconv.FormatBool(recentlyStarted), string(node.Phase)).Inc()
 
 			// If the node is pending and the pod does not exist, it could be the case that we want to try to submit it
@@ -1049,7 +1160,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				node.Daemoned = nil
 				woc.updated = true
 			}
-			woc.markNodePhase(node.Name, wfv1.NodeError, "pod deleted")
+			woc.markNodePhase(node.Name, wfv1.NodeError, podDeletedMessage)
 		}
 	}
 	return nil
@@ -1094,7 +1205,7 @@ func (woc *wfOperationCtx) failSuspendedAndPendingNodesAfterDeadlineOrShutdown()
 
 // getAllWorkflowPods returns all pods related to the current workflow
 func (woc *wfOperationCtx) getAllWorkflowPods() ([]*apiv1.Pod, error) {
-	objs, err := woc.controller.podInformer.GetIndexer().ByIndex(indexes.WorkflowIndex, indexes.WorkflowIndexValue(woc.wf.Namespace, woc.wf.Name))
+	objs, err := woc.controller.podInformer.GetIndexer().ByIndex(indexes.WorkflowIndex, indexes.WorkflowIndexValue(woc.wf.GetPodNamespace(), woc.wf.Name))
 	if err != nil {
 		return nil, err
 	}
@@ -1129,6 +1240,7 @@ func printPodSpecLog(pod *apiv1.Pod, wfName string) {
 // assessNodeStatus compares the current state of a pod with its corresponding node
 // and returns the new node status if something changed
 func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
+	logCtx := woc.podLog()
 	var newPhase wfv1.NodePhase
 	var newDaemonStatus *bool
 	var message string
@@ -1141,13 +1253,18 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	case apiv1.PodSucceeded:
 		newPhase = wfv1.NodeSucceeded
 		newDaemonStatus = pointer.BoolPtr(false)
+		// templates can set any annotation, so it is only the controller's when it injects faults
+		if fault, ok := pod.Annotations[common.AnnotationKeyInjectedFault]; ok && woc.controller.faultInjection {
+			newPhase = wfv1.NodeFailed
+			message = fmt.Sprintf("fault %q injected by the controller", fault)
+		}
 	case apiv1.PodFailed:
 		// ignore pod failure for daemoned steps
 		if node.IsDaemoned() {
 			newPhase = wfv1.NodeSucceeded
 		} else {
 			newPhase, message = woc.inferFailedReason(pod)
-			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
+			logCtx.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
 				WithField("pod", pod.Name).Infof("Pod failed: %s", message)
 		}
 		newDaemonStatus = pointer.BoolPtr(false)
@@ -1164,7 +1281,7 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			// proceed to mark node status as running (and daemoned)
 			newPhase = wfv1.NodeRunning
 			newDaemonStatus = pointer.BoolPtr(true)
-			woc.log.Infof("Processing ready daemon pod: %v", pod.ObjectMeta.SelfLink)
+			logCtx.Infof("Processing ready daemon pod: %v", pod.ObjectMeta.SelfLink)
 		}
 		if tmpl != nil {
 			woc.cleanUpPod(pod, *tmpl)
@@ -1172,7 +1289,7 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	default:
 		newPhase = wfv1.NodeError
 		message = fmt.Sprintf("Unexpected pod phase for %s: %s", pod.ObjectMeta.Name, pod.Status.Phase)
-		woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
+		logCtx.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
 			WithField("pod", pod.Name).Error(message)
 	}
 
@@ -1212,12 +1329,12 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 				newDaemonStatus = nil
 			}
 			if (newDaemonStatus != nil && node.Daemoned == nil) || (newDaemonStatus == nil && node.Daemoned != nil) {
-				woc.log.Infof("Setting node %v daemoned: %v -> %v", node.ID, node.Daemoned, newDaemonStatus)
+				logCtx.Infof("Setting node %v daemoned: %v -> %v", node.ID, node.Daemoned, newDaemonStatus)
 				node.Daemoned = newDaemonStatus
 				updated = true
 				if pod.Status.PodIP != "" && pod.Status.PodIP != node.PodIP {
 					// only update Pod IP for daemoned nodes to reduce number of updates
-					woc.log.Infof("Updating daemon node %s IP %s -> %s", node.ID, node.PodIP, pod.Status.PodIP)
+					logCtx.WithField(logs.FieldNodeID, node.ID).Infof("Updating daemon node %s IP %s -> %s", node.ID, node.PodIP, pod.Status.PodIP)
 					node.PodIP = pod.Status.PodIP
 				}
 			}
@@ -1227,20 +1344,26 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		if newPhase.Fulfilled() {
 			// outputs are mixed between the annotation (parameters, artifacts, and result) and the pod's status (exit code)
 			if exitCode := getExitCode(pod); exitCode != nil {
-				woc.log.Infof("Updating node %s exit code %d", node.ID, *exitCode)
+				logCtx.WithField(logs.FieldNodeID, node.ID).Infof("Updating node %s exit code %d", node.ID, *exitCode)
 				node.Outputs = &wfv1.Outputs{ExitCode: pointer.StringPtr(fmt.Sprintf("%d", int(*exitCode)))}
 				if outputStr, ok := pod.Annotations[common.AnnotationKeyOutputs]; ok {
-					woc.log.Infof("Setting node %v outputs: %s", node.ID, outputStr)
+					logCtx.WithField(logs.FieldNodeID, node.ID).Infof("Setting node %v outputs: %s", node.ID, outputStr)
 					if err := json.Unmarshal([]byte(outputStr), node.Outputs); err != nil { // I don't expect an error to ever happen in production
 						node.Phase = wfv1.NodeError
 						node.Message = err.Error()
 					}
 				}
+				if truncated, ok := pod.Annotations[common.AnnotationKeyOutputsTruncated]; ok {
+					woc.markOutputsTruncated(node.Name, truncated)
+					if message == "" {
+						message = truncated
+					}
+				}
 			}
 		}
 
 		if node.Phase != newPhase {
-			woc.log.Infof("Updating node %s status %s -> %s", node.ID, node.Phase, newPhase)
+			logCtx.WithField(logs.FieldNodeID, node.ID).Infof("Updating node %s status %s -> %s", node.ID, node.Phase, newPhase)
 			// if we are transitioning from Pending to a different state, clear out pending message
 			if node.Phase == wfv1.NodePending {
 				node.Message = ""
@@ -1248,8 +1371,18 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			updated = true
 			node.Phase = newPhase
 		}
+		// the message the pod reports with its progress is the node's message only while it runs
+		if progressMessage, ok := pod.Annotations[common.AnnotationKeyProgressMessage]; ok {
+			if newPhase == wfv1.NodeRunning && node.Message != progressMessage {
+				updated = true
+				node.Message = progressMessage
+			} else if newPhase.Fulfilled() && progressMessage != "" && node.Message == progressMessage {
+				updated = true
+				node.Message = ""
+			}
+		}
 		if message != "" && node.Message != message {
-			woc.log.Infof("Updating node %s message: %s", node.ID, message)
+			logCtx.WithField(logs.FieldNodeID, node.ID).Infof("Updating node %s message: %s", node.ID, message)
 			updated = true
 			node.Message = message
 		}
@@ -1258,6 +1391,10 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		updated = true
 		node.FinishedAt = getLatestFinishedAt(pod)
 		node.ResourcesDuration = resource.DurationForPod(pod)
+		node.EstimatedCost = woc.controller.Config.Costs.EstimateCost(node.ResourcesDuration, pod.Spec.NodeSelector)
+		if usage := woc.controller.resourceUsage.take(pod); usage != nil {
+			node.ResourceUsage = usage
+		}
 	}
 	if updated {
 		return node
@@ -1265,6 +1402,18 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	return nil
 }
 
+// markOutputsTruncated adds the node, and why its outputs were truncated, to the OutputsTruncated condition
+func (woc *wfOperationCtx) markOutputsTruncated(nodeName, message string) {
+	entry := nodeName + ": " + message
+	for _, c := range woc.wf.Status.Conditions {
+		if c.Type == wfv1.ConditionTypeOutputsTruncated && strings.Contains(c.Message, entry) {
+			return
+		}
+	}
+	woc.wf.Status.Conditions.UpsertConditionMessage(wfv1.Condition{Type: wfv1.ConditionTypeOutputsTruncated, Status: metav1.ConditionTrue, Message: entry})
+	woc.updated = true
+}
+
 func getExitCode(pod *apiv1.Pod) *int32 {
 	for _, c := range pod.Status.ContainerStatuses {
 		if c.Name == common.MainContainerName && c.State.Terminated != nil {
@@ -1288,7 +1437,7 @@ func podHasContainerNeedingTermination(pod *apiv1.Pod, tmpl wfv1.Template) bool
 
 func (woc *wfOperationCtx) cleanUpPod(pod *apiv1.Pod, tmpl wfv1.Template) {
 	if podHasContainerNeedingTermination(pod, tmpl) {
-		woc.controller.queuePodForCleanup(woc.wf.Namespace, pod.Name, terminateContainers)
+		woc.controller.queuePodForCleanup(woc.wf.GetPodNamespace(), pod.Name, terminateContainers)
 	}
 }
 
@@ -1334,7 +1483,11 @@ func getPendingReason(pod *apiv1.Pod) string {
 // inferFailedReason returns metadata about a Failed pod to be used in its NodeStatus
 // Returns a tuple of the new phase and message
 func (woc *wfOperationCtx) inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
+	logCtx := woc.podLog()
 	if pod.Status.Message != "" {
+		if isPodEvictedReason(pod.Status.Reason) {
+			return wfv1.NodeFailed, fmt.Sprintf("%s: %s", pod.Status.Reason, pod.Status.Message)
+		}
 		// Pod has a nice error message. Use that.
 		return wfv1.NodeFailed, pod.Status.Message
 	}
@@ -1372,7 +1525,7 @@ func (woc *wfOperationCtx) inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, st
 		t := ctr.State.Terminated
 		if t == nil {
 			// We should never get here
-			log.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.Name, ctr.Name)
+			logCtx.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.Name, ctr.Name)
 			continue
 		}
 		if t.ExitCode == 0 {
@@ -1396,7 +1549,7 @@ func (woc *wfOperationCtx) inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, st
 				// if the sidecar was SIGKILL'd (exit code 137) assume it was because argoexec
 				// forcibly killed the container, which we ignore the error for.
 				// Java code 143 is a normal exit 128 + 15 https://github.com/elastic/elasticsearch/issues/31847
-				log.Infof("Ignoring %d exit code of container '%s'", t.ExitCode, ctr.Name)
+				logCtx.Infof("Ignoring %d exit code of container '%s'", t.ExitCode, ctr.Name)
 			} else {
 				return wfv1.NodeFailed, msg
 			}
@@ -1421,7 +1574,7 @@ func (woc *wfOperationCtx) createPVCs(ctx context.Context) error {
 		// This will also handle the case where workflow has no volumeClaimTemplates.
 		return nil
 	}
-	pvcClient := woc.controller.kubeclientset.CoreV1().PersistentVolumeClaims(woc.wf.ObjectMeta.Namespace)
+	pvcClient := woc.controller.kubeclientset.CoreV1().PersistentVolumeClaims(woc.wf.GetPodNamespace())
 	for i, pvcTmpl := range woc.execWf.Spec.VolumeClaimTemplates {
 		if pvcTmpl.ObjectMeta.Name == "" {
 			return errors.Errorf(errors.CodeBadRequest, "volumeClaimTemplates[%d].metadata.name is required", i)
@@ -1436,8 +1589,11 @@ func (woc *wfOperationCtx) createPVCs(ctx context.Context) error {
 			pvcTmpl.ObjectMeta.Labels = make(map[string]string)
 		}
 		pvcTmpl.ObjectMeta.Labels[common.LabelKeyWorkflow] = woc.wf.ObjectMeta.Name
-		pvcTmpl.OwnerReferences = []metav1.OwnerReference{
-			*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+		// the PVCs of an ephemeral namespace are deleted with it, as owners must be in the same namespace
+		if woc.wf.Status.PodNamespace == "" {
+			pvcTmpl.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			}
 		}
 		pvc, err := pvcClient.Create(ctx, &pvcTmpl, metav1.CreateOptions{})
 		if err != nil && apierr.IsAlreadyExists(err) {
@@ -1454,7 +1610,7 @@ func (woc *wfOperationCtx) createPVCs(ctx context.Context) error {
 					break
 				}
 			}
-			if !hasOwnerReference {
+			if !hasOwnerReference && woc.wf.Status.PodNamespace == "" {
 				return errors.Errorf(errors.CodeForbidden, "%s pvc already exists with different ownerreference", pvcTmpl.Name)
 			}
 		}
@@ -1498,7 +1654,7 @@ func (woc *wfOperationCtx) deletePVCs(ctx context.Context) error {
 		// PVC list already empty. nothing to do
 		return nil
 	}
-	pvcClient := woc.controller.kubeclientset.CoreV1().PersistentVolumeClaims(woc.wf.ObjectMeta.Namespace)
+	pvcClient := woc.controller.kubeclientset.CoreV1().PersistentVolumeClaims(woc.wf.GetPodNamespace())
 	newPVClist := make([]apiv1.Volume, 0)
 	// Attempt to delete all PVCs. Record first error encountered
 	var firstErr error
@@ -1604,8 +1760,17 @@ type executeTemplateOpts struct {
 	// onExitTemplate signifies that executeTemplate was called as part of an onExit handler.
 	// Necessary for graceful shutdowns
 	onExitTemplate bool
+	// onShutdownTemplate signifies that executeTemplate was called as part of an onShutdown hook, which runs regardless
+	// of the shutdown strategy
+	onShutdownTemplate bool
 	// activeDeadlineSeconds is a deadline to set to any pods executed. This is necessary for pods to inherit backoff.maxDuration
 	executionDeadline time.Time
+	// retryEvictions signifies that the template is retried when its pods are evicted, or preempted, i.e. it is a
+	// critical step, runs on spot nodes, or has a checkpoint
+	retryEvictions bool
+	// retryNodeName is the name of the node's retry node, if it has one. Unlike the name of the node, it is the same
+	// for every attempt
+	retryNodeName string
 }
 
 // executeTemplate executes the template with the given arguments and returns the created NodeStatus
@@ -1628,6 +1793,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		woc.updated = true
 	}
 
+	node = woc.reexecuteIfArgumentsChanged(node, args)
+
+	if node == nil {
+		if err := woc.checkTemplateDepth(orgTmpl, opts.boundaryID); err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+	}
+
 	localParams := make(map[string]string)
 	// Inject the pod name. If the pod has a retry strategy, the pod name will be changed and will be injected when it
 	// is determined
@@ -1656,7 +1829,11 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
-		entry, err := memoizationCache.Load(ctx, processedTmpl.Memoize.Key)
+		key, err := memoizationKey(processedTmpl)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+		entry, err := memoizationCache.Load(ctx, key)
 		if err != nil {
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
@@ -1681,7 +1858,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
-			Key:       processedTmpl.Memoize.Key,
+			Key:       key,
 			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
 		}
 		if hit {
@@ -1779,6 +1956,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			woc.log.Debugf("Inject a retry node for node %s", retryNodeName)
 			retryParentNode = woc.initializeExecutableNode(retryNodeName, wfv1.NodeTypeRetry, templateScope, processedTmpl, orgTmpl, opts.boundaryID, wfv1.NodeRunning)
 		}
+		opts.retryEvictions = retryEvictions(processedTmpl)
+		opts.retryNodeName = retryNodeName
 		processedRetryParentNode, continueExecution, err := woc.processNodeRetries(retryParentNode, *woc.retryStrategy(processedTmpl), opts)
 		if err != nil {
 			return woc.markNodeError(retryNodeName, err), err
@@ -1857,6 +2036,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		node, err = woc.executeData(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypeHTTP:
 		node = woc.executeHTTPTemplate(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeGRPC:
+		node = woc.executeGRPCTemplate(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeSQL:
+		node, err = woc.executeSQLTemplate(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeNotification:
+		node = woc.executeNotificationTemplate(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeWorkflow:
+		node, err = woc.executeChildWorkflow(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypePlugin:
 		node = woc.executePluginTemplate(nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
@@ -1947,6 +2134,15 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 	return nil, nil
 }
 
+// queuedMessage returns the message of the Queued condition, the reason the workflow is waiting, followed by its
+// position in the queue, if known
+func queuedMessage(reason string, position, length int) string {
+	if position < 1 {
+		return reason
+	}
+	return fmt.Sprintf("%s. Position %d of %d in the queue", reason, position, length)
+}
+
 // markWorkflowPhase is a convenience method to set the phase of the workflow with optional message
 // optionally marks the workflow completed, which sets the finishedAt timestamp and completed label
 func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.WorkflowPhase, message string) {
@@ -2022,6 +2218,7 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 				}
 			}
 			woc.updated = true
+			woc.controller.metrics.WorkflowCompleted(woc.wf)
 		}
 		woc.controller.queuePodForCleanup(woc.wf.Namespace, woc.getAgentPodName(), deletePod)
 	}
@@ -2107,6 +2304,9 @@ func (woc *wfOperationCtx) initializeExecutableNode(nodeName string, nodeType wf
 
 	if nodeType == wfv1.NodeTypeSuspend {
 		node = addRawOutputFields(node, executeTmpl)
+		if executeTmpl.Suspend.Approval != nil {
+			node.Approval = &wfv1.ApprovalStatus{Gate: executeTmpl.Suspend.Approval.DeepCopy()}
+		}
 	}
 
 	if len(messages) > 0 {
@@ -2128,6 +2328,31 @@ func (woc *wfOperationCtx) initializeNodeOrMarkError(node *wfv1.NodeStatus, node
 	return woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, boundaryID, wfv1.NodeError, err.Error())
 }
 
+// memoizationKey returns the key of the template's cache entry. The automatic key is a hash of the template, with its
+// inputs resolved, apart from its memoization. Input artifacts that have a digest are hashed by it, rather than by their
+// locations, which differ for each workflow
+func memoizationKey(tmpl *wfv1.Template) (string, error) {
+	if !tmpl.Memoize.Auto {
+		return tmpl.Memoize.Key, nil
+	}
+	x := tmpl.DeepCopy()
+	x.Memoize = nil
+	for i, art := range x.Inputs.Artifacts {
+		if art.Digest != "" {
+			x.Inputs.Artifacts[i].ArtifactLocation = wfv1.ArtifactLocation{}
+		}
+	}
+	data, err := json.Marshal(x)
+	if err != nil {
+		return "", err
+	}
+	key := fmt.Sprintf("%x", sha256.Sum256(data))
+	if tmpl.Memoize.Key != "" {
+		key = tmpl.Memoize.Key + "-" + key
+	}
+	return key, nil
+}
+
 // Creates a node status that is or will be cached
 func (woc *wfOperationCtx) initializeCacheNode(nodeName string, resolvedTmpl *wfv1.Template, templateScope string, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, memStat *wfv1.MemoizationStatus, messages ...string) *wfv1.NodeStatus {
 	if resolvedTmpl.Memoize == nil {
@@ -2203,23 +2428,23 @@ func (woc *wfOperationCtx) markNodePhase(nodeName string, phase wfv1.NodePhase,
 	}
 	if node.Phase != phase {
 		if node.Phase.Fulfilled() {
-			woc.log.WithFields(log.Fields{"nodeName": node.Name, "fromPhase": node.Phase, "toPhase": phase}).
+			woc.log.WithFields(log.Fields{logs.FieldNodeID: node.ID, "nodeName": node.Name, "fromPhase": node.Phase, "toPhase": phase}).
 				Error("node is already fulfilled")
 		}
-		woc.log.Infof("node %s phase %s -> %s", node.ID, node.Phase, phase)
+		woc.log.WithField(logs.FieldNodeID, node.ID).Infof("node %s phase %s -> %s", node.ID, node.Phase, phase)
 		node.Phase = phase
 		woc.updated = true
 	}
 	if len(message) > 0 {
 		if message[0] != node.Message {
-			woc.log.Infof("node %s message: %s", node.ID, message[0])
+			woc.log.WithField(logs.FieldNodeID, node.ID).Infof("node %s message: %s", node.ID, message[0])
 			node.Message = message[0]
 			woc.updated = true
 		}
 	}
 	if node.Fulfilled() && node.FinishedAt.IsZero() {
 		node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
-		woc.log.Infof("node %s finished: %s", node.ID, node.FinishedAt)
+		woc.log.WithField(logs.FieldNodeID, node.ID).Infof("node %s finished: %s", node.ID, node.FinishedAt)
 		woc.updated = true
 	}
 	woc.wf.Status.Nodes[node.ID] = *node
@@ -2232,7 +2457,7 @@ func (woc *wfOperationCtx) getPodByNode(node *wfv1.NodeStatus) (*apiv1.Pod, erro
 	}
 
 	podName := woc.getPodName(node.Name, node.TemplateName)
-	return woc.controller.getPod(woc.wf.GetNamespace(), podName)
+	return woc.controller.getPod(woc.wf.GetPodNamespace(), podName)
 }
 
 func (woc *wfOperationCtx) recordNodePhaseEvent(node *wfv1.NodeStatus) {
@@ -2245,6 +2470,18 @@ func (woc *wfOperationCtx) recordNodePhaseEvent(node *wfv1.NodeStatus) {
 	case wfv1.NodeSucceeded, wfv1.NodeRunning:
 		eventType = apiv1.EventTypeNormal
 	}
+	woc.recordNodeEvent(node, eventType, fmt.Sprintf("WorkflowNode%s", node.Phase), "", message)
+}
+
+// recordNodeEvent emits an event about the node, unless it has already been emitted, or is over the rate limit. The
+// discriminator distinguishes events with the same reason about the same node, e.g. each retry.
+func (woc *wfOperationCtx) recordNodeEvent(node *wfv1.NodeStatus, eventType, reason, discriminator, message string) {
+	// nodes started again, e.g. by `argo retry`, are new nodes with the same ID
+	key := fmt.Sprintf("%s/%d/%s/%s", node.ID, node.StartedAt.Unix(), reason, discriminator)
+	if !woc.controller.nodeEventFilter.allow(woc.wf.UID, key, time.Now()) {
+		woc.log.WithFields(log.Fields{logs.FieldNodeID: node.ID, "reason": reason}).Debug("Node event dropped")
+		return
+	}
 	eventConfig := woc.controller.Config.NodeEvents
 	annotations := map[string]string{
 		common.AnnotationKeyNodeType: string(node.Type),
@@ -2266,11 +2503,68 @@ func (woc *wfOperationCtx) recordNodePhaseEvent(node *wfv1.NodeStatus) {
 		involvedObject,
 		annotations,
 		eventType,
-		fmt.Sprintf("WorkflowNode%s", node.Phase),
+		reason,
 		message,
 	)
 }
 
+// recordNodeRetryEvents creates a WorkflowNodeRetrying event for each attempt, after the first, that a retry node
+// started during this execution of the operator loop
+func (woc *wfOperationCtx) recordNodeRetryEvents(old wfv1.Nodes, new wfv1.Nodes) {
+	for id, node := range new {
+		if node.Type != wfv1.NodeTypeRetry {
+			continue
+		}
+		for i := len(old[id].Children); i < len(node.Children); i++ {
+			// the first attempt is not a retry
+			if i == 0 {
+				continue
+			}
+			message := fmt.Sprintf("Retrying node %s, attempt %d", node.Name, i+1)
+			if previous, ok := new[node.Children[i-1]]; ok {
+				message = fmt.Sprintf("%s, after attempt %d %s", message, i, previous.Phase)
+				if previous.Message != "" {
+					message = message + ": " + previous.Message
+				}
+			}
+			node := node
+			woc.recordNodeEvent(&node, apiv1.EventTypeWarning, "WorkflowNodeRetrying", strconv.Itoa(i), message)
+		}
+	}
+}
+
+// recordNodeArtifactErrorEvent creates a WorkflowNodeArtifactError event if the pod of the node failed because its
+// executor could not load its input artifacts, or save its outputs
+func (woc *wfOperationCtx) recordNodeArtifactErrorEvent(node *wfv1.NodeStatus) {
+	if node.Type != wfv1.NodeTypePod {
+		return
+	}
+	pod, err := woc.getPodByNode(node)
+	if err != nil || pod == nil {
+		return
+	}
+	for _, ctr := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		t := ctr.State.Terminated
+		if t == nil || t.ExitCode == 0 {
+			continue
+		}
+		var message string
+		switch ctr.Name {
+		case common.InitContainerName:
+			message = fmt.Sprintf("Failed to load input artifacts of node %s", node.Name)
+		case common.WaitContainerName:
+			message = fmt.Sprintf("Failed to save outputs of node %s", node.Name)
+		default:
+			continue
+		}
+		if t.Message != "" {
+			message = message + ": " + t.Message
+		}
+		woc.recordNodeEvent(node, apiv1.EventTypeWarning, "WorkflowNodeArtifactError", "", message)
+		return
+	}
+}
+
 // recordNodePhaseChangeEvents creates WorkflowNode Kubernetes events for each node
 // that has changes logged during this execution of the operator loop.
 func (woc *wfOperationCtx) recordNodePhaseChangeEvents(old wfv1.Nodes, new wfv1.Nodes) {
@@ -2301,7 +2595,11 @@ func (woc *wfOperationCtx) recordNodePhaseChangeEvents(old wfv1.Nodes, new wfv1.
 				woc.recordNodePhaseEvent(&newNode)
 			}
 		}
+		if newNode.FailedOrError() {
+			woc.recordNodeArtifactErrorEvent(&newNode)
+		}
 	}
+	woc.recordNodeRetryEvents(old, new)
 }
 
 // markNodeError is a convenience method to mark a node with an error and set the message from the error
@@ -2357,7 +2655,7 @@ func (woc *wfOperationCtx) checkParallelism(tmpl *wfv1.Template, node *wfv1.Node
 
 		// Check parallelism
 		if tmpl.HasParallelism() && woc.getActivePods(node.ID) >= *tmpl.Parallelism {
-			woc.log.Infof("template (node %s) active children parallelism exceeded %d", node.ID, *tmpl.Parallelism)
+			woc.log.WithField(logs.FieldNodeID, node.ID).Infof("template (node %s) active children parallelism exceeded %d", node.ID, *tmpl.Parallelism)
 			return ErrParallelismReached
 		}
 	}
@@ -2413,7 +2711,9 @@ func (woc *wfOperationCtx) executeContainer(ctx context.Context, nodeName string
 	_, err = woc.createWorkflowPod(ctx, nodeName, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
+		onShutdownPod:       opts.onShutdownTemplate,
 		executionDeadline:   opts.executionDeadline,
+		retryNodeName:       opts.retryNodeName,
 	})
 
 	if err != nil {
@@ -2426,7 +2726,7 @@ func (woc *wfOperationCtx) executeContainer(ctx context.Context, nodeName string
 func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 	node := woc.wf.Status.Nodes[nodeID]
 	switch node.Type {
-	case wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin:
+	case wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypeGRPC, wfv1.NodeTypeSQL, wfv1.NodeTypeNotification, wfv1.NodeTypeWorkflow, wfv1.NodeTypePlugin:
 		return []string{node.ID}
 	case wfv1.NodeTypePod:
 
@@ -2518,8 +2818,8 @@ func getTemplateOutputsFromScope(tmpl *wfv1.Template, scope *wfScope) (*wfv1.Out
 }
 
 func generateOutputResultRegex(name string, parentTmpl *wfv1.Template) (string, string) {
-	referenceRegex := fmt.Sprintf(`\.%s\.outputs\.result`, name)
-	expressionRegex := fmt.Sprintf(`\[['\"]%s['\"]\]\.outputs.result`, name)
+	referenceRegex := fmt.Sprintf(`\.%s\.outputs\.(result|stderr)`, name)
+	expressionRegex := fmt.Sprintf(`\[['\"]%s['\"]\]\.outputs.(result|stderr)`, name)
 	if parentTmpl.DAG != nil {
 		referenceRegex = "tasks" + referenceRegex
 		expressionRegex = "tasks" + expressionRegex
@@ -2611,16 +2911,25 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 		return node, err
 	}
 
+	tmpl, scriptSourcePath, err := woc.applyScriptRuntime(tmpl)
+	if err != nil {
+		return node, err
+	}
 	mainCtr := tmpl.Script.Container
 	if len(tmpl.Script.Source) == 0 {
 		woc.log.Warn("'script.source' is empty, suggest change template into 'container'")
+	} else if scriptSourcePath != "" {
+		mainCtr.Args = append(mainCtr.Args, scriptSourcePath)
 	} else {
 		mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
 	}
 	_, err = woc.createWorkflowPod(ctx, nodeName, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
+		onShutdownPod:       opts.onShutdownTemplate,
 		executionDeadline:   opts.executionDeadline,
+		scriptSourcePath:    scriptSourcePath,
+		retryNodeName:       opts.retryNodeName,
 	})
 	if err != nil {
 		return woc.requeueIfTransientErr(err, node.Name)
@@ -2629,11 +2938,15 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 }
 
 func (woc *wfOperationCtx) requeueIfTransientErr(err error, nodeName string) (*wfv1.NodeStatus, error) {
-	if errorsutil.IsTransientErr(err) || err == ErrResourceRateLimitReached {
+	if errorsutil.IsTransientErr(err) || err == ErrResourceRateLimitReached || stderrors.Is(err, ErrPodCreationBackpressure) {
 		// Our error was most likely caused by a lack of resources.
 		woc.requeue()
 		return woc.markNodePending(nodeName, err), nil
 	}
+	if stderrors.Is(err, ErrPodCreationFrozen) || stderrors.Is(err, ErrPodStartDelayed) {
+		// the workflow has been requeued for when the freeze window ends, or the delay is over
+		return woc.markNodePending(nodeName, err), nil
+	}
 	return nil, err
 }
 
@@ -2682,6 +2995,9 @@ func (woc *wfOperationCtx) addOutputsToLocalScope(prefix string, outputs *wfv1.O
 	if prefix != "workflow" && outputs.ExitCode != nil {
 		scope.addParamToScope(fmt.Sprintf("%s.exitCode", prefix), *outputs.ExitCode)
 	}
+	if prefix != "workflow" && outputs.Stderr != nil {
+		scope.addParamToScope(fmt.Sprintf("%s.outputs.stderr", prefix), *outputs.Stderr)
+	}
 	for _, param := range outputs.Parameters {
 		if param.Value != nil {
 			scope.addParamToScope(fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name), param.Value.String())
@@ -2942,6 +3258,12 @@ func (woc *wfOperationCtx) executeSuspend(nodeName string, templateScope string,
 		suspendDeadline := node.StartedAt.Add(suspendDuration)
 		requeueTime = &suspendDeadline
 		if time.Now().UTC().After(suspendDeadline) {
+			// An approval gate is never resumed automatically: if it is not approved in time, it fails
+			if node.Approval.IsGated() {
+				woc.log.Infof("approval of node %s timed out", nodeName)
+				_ = woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("not approved within %s", tmpl.Suspend.Duration))
+				return node, nil
+			}
 			// Suspension is expired, node can be resumed
 			woc.log.Infof("auto resuming node %s", nodeName)
 			_ = woc.markNodePhase(nodeName, wfv1.NodeSucceeded)
@@ -3139,7 +3461,8 @@ func (woc *wfOperationCtx) createTemplateContext(scope wfv1.ResourceScope, resou
 	} else {
 		clusterWorkflowTemplateGetter = &templateresolution.NullClusterWorkflowTemplateGetter{}
 	}
-	ctx := templateresolution.NewContext(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace), clusterWorkflowTemplateGetter, woc.execWf, woc.wf)
+	ctx := templateresolution.NewContext(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace), clusterWorkflowTemplateGetter, woc.execWf, woc.wf).
+		WithGrantedWorkflowTemplateGetter(woc.controller.getGrantedWorkflowTemplateGetter(woc.wf.Namespace))
 
 	switch scope {
 	case wfv1.ResourceScopeNamespaced:
@@ -3190,7 +3513,7 @@ func (woc *wfOperationCtx) computeMetrics(metricList []*wfv1.Prometheus, localSc
 		metricTmpl.Labels = metricTmplSubstituted.Labels
 		metricTmpl.When = metricTmplSubstituted.When
 
-		proceed, err := shouldExecute(metricTmpl.When)
+		proceed, err := common.ShouldExecute(metricTmpl.When)
 		if err != nil {
 			woc.reportMetricEmissionError(fmt.Sprintf("unable to compute 'when' clause for metric '%s': %s", woc.wf.ObjectMeta.Name, err))
 			continue
@@ -3199,6 +3522,11 @@ func (woc *wfOperationCtx) computeMetrics(metricList []*wfv1.Prometheus, localSc
 			continue
 		}
 
+		if err := woc.controller.metrics.ValidateCustomMetricLabels(metricTmpl.GetMetricLabels()); err != nil {
+			woc.reportMetricEmissionError(fmt.Sprintf("could not construct metric '%s': %s", metricTmpl.Name, err))
+			continue
+		}
+
 		if metricTmpl.IsRealtime() {
 			// Finally substitute value parameters
 			value := metricTmpl.Gauge.Value
@@ -3252,7 +3580,7 @@ func (woc *wfOperationCtx) computeMetrics(metricList []*wfv1.Prometheus, localSc
 
 			metric := woc.controller.metrics.GetCustomMetric(metricSpec.GetDesc())
 			// It is valid to pass a nil metric to ConstructOrUpdateMetric, in that case the metric will be created for us
-			updatedMetric, err := metrics.ConstructOrUpdateMetric(metric, metricSpec)
+			updatedMetric, err := metrics.ConstructOrUpdateMetric(metric, metricSpec, prometheus.Labels{"workflow_uid": string(woc.wf.UID)})
 			if err != nil {
 				woc.reportMetricEmissionError(fmt.Sprintf("could not construct metric '%s': %s", metricSpec.Name, err))
 				continue
@@ -3283,7 +3611,7 @@ func (woc *wfOperationCtx) createPDBResource(ctx context.Context) error {
 		return nil
 	}
 
-	pdb, err := woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.Namespace).Get(
+	pdb, err := woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.GetPodNamespace()).Get(
 		ctx,
 		woc.wf.Name,
 		metav1.GetOptions{},
@@ -3312,7 +3640,11 @@ func (woc *wfOperationCtx) createPDBResource(ctx context.Context) error {
 		},
 		Spec: pdbSpec,
 	}
-	_, err = woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.Namespace).Create(ctx, &newPDB, metav1.CreateOptions{})
+	if woc.wf.Status.PodNamespace != "" {
+		// owners must be in the same namespace, so the PDB is deleted with the ephemeral namespace instead
+		newPDB.OwnerReferences = nil
+	}
+	_, err = woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.GetPodNamespace()).Create(ctx, &newPDB, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -3326,7 +3658,7 @@ func (woc *wfOperationCtx) deletePDBResource(ctx context.Context) error {
 		return nil
 	}
 	err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
-		err := woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.Namespace).Delete(ctx, woc.wf.Name, metav1.DeleteOptions{})
+		err := woc.controller.kubeclientset.PolicyV1beta1().PodDisruptionBudgets(woc.wf.GetPodNamespace()).Delete(ctx, woc.wf.Name, metav1.DeleteOptions{})
 		if apierr.IsNotFound(err) {
 			return true, nil
 		}
@@ -3363,7 +3695,7 @@ func (woc *wfOperationCtx) includeScriptOutput(nodeName, boundaryID string) (boo
 	return false, nil
 }
 
-func (woc *wfOperationCtx) fetchWorkflowSpec() (wfv1.WorkflowSpecHolder, error) {
+func (woc *wfOperationCtx) fetchWorkflowSpec(ctx context.Context) (wfv1.WorkflowSpecHolder, error) {
 	if woc.wf.Spec.WorkflowTemplateRef == nil {
 		return nil, fmt.Errorf("cannot fetch workflow spec without workflowTemplateRef")
 	}
@@ -3377,6 +3709,8 @@ func (woc *wfOperationCtx) fetchWorkflowSpec() (wfv1.WorkflowSpecHolder, error)
 			return nil, fmt.Errorf("cannot get resource clusterWorkflowTemplate at cluster scope")
 		}
 		specHolder, err = woc.controller.cwftmplInformer.Lister().Get(woc.wf.Spec.WorkflowTemplateRef.Name)
+	} else if woc.wf.Spec.WorkflowTemplateRef.Revision > 0 {
+		specHolder, err = woc.controller.getWorkflowTemplateRevision(ctx, woc.wf.Namespace, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Spec.WorkflowTemplateRef.Revision)
 	} else {
 		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace).Get(woc.wf.Spec.WorkflowTemplateRef.Name)
 	}
@@ -3386,16 +3720,25 @@ func (woc *wfOperationCtx) fetchWorkflowSpec() (wfv1.WorkflowSpecHolder, error)
 	return specHolder, nil
 }
 
+// retryEvictions returns whether the template is retried when its pods are evicted, or preempted
+func retryEvictions(tmpl *wfv1.Template) bool {
+	return tmpl.CriticalStep || tmpl.Spot.RunsOnSpot() || tmpl.Checkpoint != nil
+}
+
 func (woc *wfOperationCtx) retryStrategy(tmpl *wfv1.Template) *wfv1.RetryStrategy {
 	if tmpl != nil && tmpl.RetryStrategy != nil {
 		return tmpl.RetryStrategy
 	}
+	if woc.execWf.Spec.RetryStrategy == nil && tmpl != nil && retryEvictions(tmpl) {
+		// a template without a retry strategy is only retried when it is evicted
+		return &wfv1.RetryStrategy{Limit: intstr.ParsePtr("0")}
+	}
 	return woc.execWf.Spec.RetryStrategy
 }
 
 func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 	if woc.wf.Spec.WorkflowTemplateRef != nil {
-		err := woc.setStoredWfSpec()
+		err := woc.setStoredWfSpec(ctx)
 		if err != nil {
 			woc.markWorkflowError(ctx, err)
 			return err
@@ -3407,6 +3750,16 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 		woc.markWorkflowError(ctx, err)
 		return err
 	} else {
+		if name := woc.wf.Spec.Profile; name != "" {
+			profileSpec, err := woc.loadProfile(name)
+			if err == nil {
+				err = wfutil.MergeTo(&wfv1.Workflow{Spec: *profileSpec}, woc.wf)
+			}
+			if err != nil {
+				woc.markWorkflowError(ctx, err)
+				return err
+			}
+		}
 		err := woc.controller.setWorkflowDefaults(woc.wf)
 		if err != nil {
 			woc.markWorkflowError(ctx, err)
@@ -3417,13 +3770,21 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
-		validateOpts := validate.ValidateOpts{ContainerRuntimeExecutor: woc.getContainerRuntimeExecutor()}
-		wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace))
+		validateOpts := validate.ValidateOpts{
+			ContainerRuntimeExecutor:      woc.getContainerRuntimeExecutor(),
+			ImageRules:                    woc.controller.Config.ImagePolicy.GetRules(woc.wf.Namespace),
+			GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, woc.wf.Namespace, woc.controller.wfclientset),
+		}
+		wftmplGetter, err := templaterevision.WrapGetter(ctx, woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace), woc.wf, templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace)))
+		if err != nil {
+			woc.markWorkflowError(ctx, err)
+			return err
+		}
 		cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
 		// Validate the execution wfSpec
 		var wfConditions *wfv1.Conditions
-		err := waitutil.Backoff(retry.DefaultRetry,
+		err = waitutil.Backoff(retry.DefaultRetry,
 			func() (bool, error) {
 				var validationErr error
 				wfConditions, validationErr = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, woc.wf, validateOpts)
@@ -3473,7 +3834,7 @@ func (woc *wfOperationCtx) needsStoredWfSpecUpdate() bool {
 		(woc.wf.Spec.Shutdown != woc.wf.Status.StoredWorkflowSpec.Shutdown)
 }
 
-func (woc *wfOperationCtx) setStoredWfSpec() error {
+func (woc *wfOperationCtx) setStoredWfSpec(ctx context.Context) error {
 	wfDefault := woc.controller.Config.WorkflowDefaults
 	if wfDefault == nil {
 		wfDefault = &wfv1.Workflow{}
@@ -3483,7 +3844,7 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 
 	// Load the spec from WorkflowTemplate in first time.
 	if woc.wf.Status.StoredWorkflowSpec == nil {
-		wftHolder, err := woc.fetchWorkflowSpec()
+		wftHolder, err := woc.fetchWorkflowSpec(ctx)
 		if err != nil {
 			return err
 		}
@@ -3491,21 +3852,38 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 		wfutil.JoinWorkflowMetaData(&woc.wf.ObjectMeta, wftHolder.GetWorkflowMetadata(), &wfDefault.ObjectMeta)
 		workflowTemplateSpec = wftHolder.GetWorkflowSpec()
 	}
+	// the profile takes precedence over the workflow defaults, but not the workflow, or workflow template
+	defaultSpec := &wfDefault.Spec
+	profileName := woc.wf.Spec.Profile
+	if profileName == "" && workflowTemplateSpec != nil {
+		profileName = workflowTemplateSpec.Profile
+	}
+	if profileName != "" {
+		profileSpec, err := woc.loadProfile(profileName)
+		if err != nil {
+			return err
+		}
+		joined, err := wfutil.JoinWorkflowSpec(profileSpec, nil, defaultSpec)
+		if err != nil {
+			return err
+		}
+		defaultSpec = &joined.Spec
+	}
 	// Update the Entrypoint, ShutdownStrategy and Suspend
 	if woc.needsStoredWfSpecUpdate() {
 		// Join workflow, workflow template, and workflow default metadata to workflow spec.
-		mergedWf, err := wfutil.JoinWorkflowSpec(&woc.wf.Spec, workflowTemplateSpec, &wfDefault.Spec)
+		mergedWf, err := wfutil.JoinWorkflowSpec(&woc.wf.Spec, workflowTemplateSpec, defaultSpec)
 		if err != nil {
 			return err
 		}
 		woc.wf.Status.StoredWorkflowSpec = &mergedWf.Spec
 		woc.updated = true
 	} else if woc.controller.Config.WorkflowRestrictions.MustNotChangeSpec() {
-		wftHolder, err := woc.fetchWorkflowSpec()
+		wftHolder, err := woc.fetchWorkflowSpec(ctx)
 		if err != nil {
 			return err
 		}
-		mergedWf, err := wfutil.JoinWorkflowSpec(&woc.wf.Spec, wftHolder.GetWorkflowSpec(), &wfDefault.Spec)
+		mergedWf, err := wfutil.JoinWorkflowSpec(&woc.wf.Spec, wftHolder.GetWorkflowSpec(), defaultSpec)
 		if err != nil {
 			return err
 		}
@@ -3517,30 +3895,7 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 }
 
 func (woc *wfOperationCtx) mergedTemplateDefaultsInto(originalTmpl *wfv1.Template) error {
-	if woc.execWf.Spec.TemplateDefaults != nil {
-		originalTmplType := originalTmpl.GetType()
-
-		tmplDefaultsJson, err := json.Marshal(woc.execWf.Spec.TemplateDefaults)
-		if err != nil {
-			return err
-		}
-
-		targetTmplJson, err := json.Marshal(originalTmpl)
-		if err != nil {
-			return err
-		}
-
-		resultTmpl, err := strategicpatch.StrategicMergePatch(tmplDefaultsJson, targetTmplJson, wfv1.Template{})
-		if err != nil {
-			return err
-		}
-		err = json.Unmarshal(resultTmpl, originalTmpl)
-		if err != nil {
-			return err
-		}
-		originalTmpl.SetType(originalTmplType)
-	}
-	return nil
+	return wfutil.MergeTemplateDefaultsTo(woc.execWf.Spec.TemplateDefaults, originalTmpl)
 }
 
 func (woc *wfOperationCtx) substituteGlobalVariables() error {
@@ -760,3 +760,56 @@ func TestSynchronizationWithStepRetry(t *testing.T) {
 	})
 
 }
+
+const wfWithMutex = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: wf-mutex
+  namespace: default
+spec:
+  entrypoint: whalesay
+  synchronization:
+    mutex:
+      name: my-mutex
+  templates:
+    - name: whalesay
+      container:
+        image: docker/whalesay:latest
+        command: [cowsay]
+`
+
+func TestWorkflowLevelMutexQueued(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
+	}, workflowExistenceFunc)
+
+	wf := wfv1.MustUnmarshalWorkflow(wfWithMutex)
+	wf1 := wf.DeepCopy()
+	wf1.Name = "wf-mutex-1"
+	for _, x := range []*wfv1.Workflow{wf, wf1} {
+		_, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(x.Namespace).Create(ctx, x, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+	woc1 := newWorkflowOperationCtx(wf1, controller)
+	woc1.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowPending, woc1.wf.Status.Phase)
+	if assert.Len(t, woc1.wf.Status.Conditions, 1) {
+		assert.Equal(t, wfv1.ConditionTypeQueued, woc1.wf.Status.Conditions[0].Type)
+		assert.Equal(t, "Waiting for the lock default/Mutex/my-mutex. Position 1 of 1 in the queue", woc1.wf.Status.Conditions[0].Message)
+	}
+
+	controller.syncManager.ReleaseAll(woc.wf)
+	woc1 = newWorkflowOperationCtx(woc1.wf, controller)
+	woc1.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc1.wf.Status.Phase)
+	for _, c := range woc1.wf.Status.Conditions {
+		assert.NotEqual(t, wfv1.ConditionTypeQueued, c.Type, "the lock is acquired")
+	}
+}
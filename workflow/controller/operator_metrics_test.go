@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 )
 
 var basicMetric = `
@@ -137,6 +138,95 @@ func TestCounterMetric(t *testing.T) {
 	}
 }
 
+var exemplarMetric = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: exemplar-metric
+  uid: 6f6c9b0e-8f38-4b57-a2a1-3d5f0e3b1c2d
+spec:
+  entrypoint: whalesay
+  templates:
+    - name: whalesay
+      metrics:
+        prometheus:
+          - name: step_duration
+            help: "Duration of a step"
+            exemplar: true
+            histogram:
+              value: "{{duration}}"
+              buckets: [10, 1, 5]
+          - name: step_counter
+            help: "How many times a step has finished"
+            exemplar: true
+            labels:
+              - key: status
+                value: "{{=sprig.lower(status)}}"
+              - key: outcome
+                value: "{{=status == 'Succeeded' ? 'ok' : 'not_ok'}}"
+            counter:
+              value: "1"
+      container:
+        image: docker/whalesay:latest
+        command: [cowsay]
+`
+
+func TestExemplarMetric(t *testing.T) {
+	wf := v1alpha1.MustUnmarshalWorkflow(exemplarMetric)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	for _, condition := range woc.wf.Status.Conditions {
+		assert.NotEqual(t, v1alpha1.ConditionTypeMetricsError, condition.Type, condition.Message)
+	}
+
+	histogramDesc := woc.wf.Spec.Templates[0].Metrics.Prometheus[0].GetDesc()
+	histogram, ok := controller.metrics.GetCustomMetric(histogramDesc).(prometheus.Histogram)
+	if assert.True(t, ok) {
+		histogramString, err := getMetricStringValue(histogram)
+		assert.NoError(t, err)
+		assert.Regexp(t, `upper_bound:1 .*upper_bound:5 .*upper_bound:10 `, histogramString)
+		assert.Contains(t, histogramString, `exemplar:<label:<name:"workflow_uid" value:"6f6c9b0e-8f38-4b57-a2a1-3d5f0e3b1c2d" >`)
+	}
+
+	counterDesc := (&v1alpha1.Prometheus{Name: "step_counter", Labels: []*v1alpha1.MetricLabel{{Key: "status", Value: "failed"}, {Key: "outcome", Value: "not_ok"}}}).GetDesc()
+	counter, ok := controller.metrics.GetCustomMetric(counterDesc).(prometheus.Counter)
+	if assert.True(t, ok) {
+		counterString, err := getMetricStringValue(counter)
+		assert.NoError(t, err)
+		assert.Contains(t, counterString, `label:<name:"outcome" value:"not_ok" > label:<name:"status" value:"failed" > counter:<value:1 exemplar:<label:<name:"workflow_uid" value:"6f6c9b0e-8f38-4b57-a2a1-3d5f0e3b1c2d" >`)
+	}
+}
+
+func TestDeniedMetricLabel(t *testing.T) {
+	wf := v1alpha1.MustUnmarshalWorkflow(counterMetric)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.metrics = metrics.New(metrics.ServerConfig{DeniedLabelKeys: []string{"name"}}, metrics.ServerConfig{})
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Nil(t, controller.metrics.GetCustomMetric(woc.wf.Spec.Templates[0].Metrics.Prometheus[0].GetDesc()))
+	assert.Contains(t, woc.wf.Status.Conditions, v1alpha1.Condition{
+		Status:  metav1.ConditionTrue,
+		Type:    v1alpha1.ConditionTypeMetricsError,
+		Message: "could not construct metric 'execution_counter': metric label 'name' is denied by the controller config, could not construct metric 'failure_counter': metric label 'name' is denied by the controller config",
+	})
+}
+
 func getMetricStringValue(metric prometheus.Metric) (string, error) {
 	metricString := &dto.Metric{}
 	err := metric.Write(metricString)
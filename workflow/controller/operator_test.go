@@ -37,6 +37,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
@@ -149,6 +150,196 @@ spec:
 	}), "zero node durations empty")
 }
 
+func TestEstimatedCost(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     dag:
+       tasks:
+       - name: pod
+         template: pod
+   - name: pod
+     container: 
+       image: my-image
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.Costs = &config.Costs{Prices: map[apiv1.ResourceName]float64{apiv1.ResourceCPU: 3600}}
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.NotNil(t, woc.wf.Status.EstimatedCost)
+	assert.False(t, woc.wf.Status.Nodes.Any(func(node wfv1.NodeStatus) bool {
+		return node.EstimatedCost == nil
+	}), "every node has an estimated cost")
+}
+
+func TestResourceUsage(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     container:
+       image: my-image
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := &pods.Items[0]
+		for _, usage := range []apiv1.ResourceList{
+			{apiv1.ResourceCPU: resource.MustParse("1500m"), apiv1.ResourceMemory: resource.MustParse("512Mi")},
+			{apiv1.ResourceCPU: resource.MustParse("500m"), apiv1.ResourceMemory: resource.MustParse("1536Mi")},
+		} {
+			assert.NoError(t, controller.resourceUsage.add(pod, map[string]apiv1.ResourceList{
+				"main": usage,
+				"wait": {apiv1.ResourceCPU: resource.MustParse("1"), apiv1.ResourceMemory: resource.MustParse("1Gi")},
+			}))
+		}
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+	if assert.NotNil(t, node) && assert.NotNil(t, node.ResourceUsage) {
+		assert.Equal(t, "1500m", node.ResourceUsage.Peak.Cpu().String())
+		assert.Equal(t, "1536Mi", node.ResourceUsage.Peak.Memory().String())
+		assert.Equal(t, "1", node.ResourceUsage.Average.Cpu().String())
+		assert.Equal(t, "1Gi", node.ResourceUsage.Average.Memory().String())
+		assert.Equal(t, int64(2), node.ResourceUsage.Samples)
+	}
+	assert.Empty(t, controller.resourceUsage.pods, "the samples are forgotten once the node completes")
+}
+
+var criticalStepWf = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     criticalStep: true
+     container: 
+       image: my-image
+`
+
+func TestCriticalStep(t *testing.T) {
+	ctx := context.Background()
+	evicted := func(pod *apiv1.Pod) { pod.Status.Reason = podEvictedReason }
+	t.Run("Evicted", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(criticalStepWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			assert.Equal(t, "false", pods.Items[0].Annotations[common.AnnotationKeySafeToEvict])
+		}
+
+		makePodsPhase(ctx, woc, apiv1.PodFailed, evicted)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+		if assert.NotNil(t, node) && assert.Len(t, node.Children, 2) {
+			assert.Equal(t, "Evicted: Pod failed", woc.wf.Status.Nodes[node.Children[0]].Message)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(criticalStepWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodFailed)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+		if assert.NotNil(t, node) {
+			assert.Len(t, node.Children, 1)
+		}
+	})
+	t.Run("TooManyEvictions", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(criticalStepWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		for i := 0; i <= maxEvictionRetries; i++ {
+			makePodsPhase(ctx, woc, apiv1.PodFailed, evicted)
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+		}
+
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+		if assert.NotNil(t, node) {
+			assert.Len(t, node.Children, maxEvictionRetries+1)
+			assert.Equal(t, "evicted 6 times", node.Message)
+		}
+	})
+}
+
+func TestSpotPreempted(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     spot: prefer
+     container: 
+       image: my-image
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.Spot = &config.Spot{Preset: "karpenter"}
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) { pod.Status.Reason = "Terminated" })
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+	if assert.NotNil(t, node) && assert.Len(t, node.Children, 2) {
+		assert.Equal(t, "Terminated: Pod failed", woc.wf.Status.Nodes[node.Children[0]].Message)
+	}
+}
+
 func TestGlobalParamDuration(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
@@ -1369,6 +1560,67 @@ func TestAssessNodeStatus(t *testing.T) {
 		assert.True(t, got.Phase == wfv1.NodeSucceeded)
 	})
 
+	t.Run("Outputs truncated", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		pod := &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					common.AnnotationKeyOutputs:          `{"parameters": [{"name": "my-param", "value": "my-val... [truncated, was 100 bytes]"}]}`,
+					common.AnnotationKeyOutputsTruncated: "output parameter 'my-param' was truncated from 100 bytes, the limit is 40 bytes",
+				},
+			},
+			Status: apiv1.PodStatus{
+				Phase: apiv1.PodSucceeded,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{Name: common.MainContainerName, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{}}},
+				},
+			},
+		}
+		woc := newWorkflowOperationCtx(wf, controller)
+		for i := 0; i < 2; i++ {
+			got := woc.assessNodeStatus(pod, &wfv1.NodeStatus{Name: "my-node"})
+			assert.Equal(t, wfv1.NodeSucceeded, got.Phase)
+			assert.Equal(t, "output parameter 'my-param' was truncated from 100 bytes, the limit is 40 bytes", got.Message)
+		}
+		if assert.Len(t, woc.wf.Status.Conditions, 1) {
+			c := woc.wf.Status.Conditions[0]
+			assert.Equal(t, wfv1.ConditionTypeOutputsTruncated, c.Type)
+			assert.Equal(t, "my-node: output parameter 'my-param' was truncated from 100 bytes, the limit is 40 bytes", c.Message)
+		}
+	})
+
+	t.Run("Progress message", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		pod := &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					common.AnnotationKeyProgress:        "3/10",
+					common.AnnotationKeyProgressMessage: "downloading shards",
+				},
+			},
+			Status: apiv1.PodStatus{Phase: apiv1.PodRunning},
+		}
+		woc := newWorkflowOperationCtx(wf, controller)
+		got := woc.assessNodeStatus(pod, &wfv1.NodeStatus{Phase: wfv1.NodeRunning})
+		if assert.NotNil(t, got) {
+			assert.Equal(t, "downloading shards", got.Message)
+		}
+		assert.Nil(t, woc.assessNodeStatus(pod, got), "the message is unchanged")
+		pod.Annotations[common.AnnotationKeyProgressMessage] = ""
+		got = woc.assessNodeStatus(pod, got)
+		if assert.NotNil(t, got) {
+			assert.Empty(t, got.Message, "the message is cleared")
+		}
+		pod.Annotations[common.AnnotationKeyProgressMessage] = "uploading results"
+		pod.Status.Phase = apiv1.PodSucceeded
+		got = woc.assessNodeStatus(pod, &wfv1.NodeStatus{Phase: wfv1.NodeRunning, Message: "uploading results"})
+		if assert.NotNil(t, got) {
+			assert.Equal(t, wfv1.NodeSucceeded, got.Phase)
+			assert.Empty(t, got.Message, "the message is cleared when the node completes")
+		}
+	})
 }
 
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
@@ -1904,7 +2156,7 @@ func TestSuspendResume(t *testing.T) {
 	assert.Equal(t, 0, len(pods.Items))
 
 	// resume the workflow and operate again. two pods should be able to be scheduled
-	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "")
+	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "", "")
 	assert.NoError(t, err)
 	wf, err = wfcset.Get(ctx, wf.ObjectMeta.Name, metav1.GetOptions{})
 	assert.NoError(t, err)
@@ -2234,7 +2486,7 @@ func TestSuspendTemplate(t *testing.T) {
 	assert.Equal(t, 0, len(pods.Items))
 
 	// resume the workflow. verify resume workflow edits nodestatus correctly
-	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "")
+	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "", "")
 	assert.NoError(t, err)
 	wf, err = wfcset.Get(ctx, wf.ObjectMeta.Name, metav1.GetOptions{})
 	assert.NoError(t, err)
@@ -2311,7 +2563,7 @@ func TestSuspendTemplateWithFilteredResume(t *testing.T) {
 	assert.Equal(t, 0, len(pods.Items))
 
 	// resume the workflow, but with non-matching selector
-	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "inputs.paramaters.param1.value=value2")
+	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "inputs.paramaters.param1.value=value2", "")
 	assert.Error(t, err)
 
 	// operate the workflow. nothing should have happened
@@ -2323,7 +2575,7 @@ func TestSuspendTemplateWithFilteredResume(t *testing.T) {
 	assert.True(t, util.IsWorkflowSuspended(wf))
 
 	// resume the workflow, but with matching selector
-	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "inputs.parameters.param1.value=value1")
+	err = util.ResumeWorkflow(ctx, wfcset, controller.hydrator, wf.ObjectMeta.Name, "inputs.parameters.param1.value=value1", "")
 	assert.NoError(t, err)
 	wf, err = wfcset.Get(ctx, wf.ObjectMeta.Name, metav1.GetOptions{})
 	assert.NoError(t, err)
@@ -2430,6 +2682,64 @@ func TestSuspendResumeAfterTemplateNoWait(t *testing.T) {
 	assert.Equal(t, 0, len(pods.Items))
 }
 
+var approvalGate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: approval-gate
+spec:
+  entrypoint: suspend
+  templates:
+  - name: suspend
+    steps:
+    - - name: approve
+        template: approve
+    - - name: release
+        template: whalesay
+
+  - name: approve
+    suspend:
+      duration: 1h
+      approval:
+        approvers: [alice]
+
+  - name: whalesay
+    container:
+      image: docker/whalesay
+`
+
+func TestApprovalGate(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(approvalGate)
+	wf, err := wfcset.Create(ctx, wf, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		if assert.True(t, node.Approval.IsGated()) {
+			assert.Equal(t, []string{"alice"}, node.Approval.Gate.Approvers)
+		}
+	}
+
+	// the gate is not resumed when its duration expires, but fails
+	node.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("approve")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "not approved within 1h", node.Message)
+	}
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
 var volumeWithParam = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3568,6 +3878,109 @@ spec:
 	}
 }
 
+func TestEventNodeRetryEvents(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: retry-events
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      retryStrategy:
+        limit: 1
+      container:
+        image: docker/whalesay:latest
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.ElementsMatch(t, []string{
+		"Normal WorkflowRunning Workflow Running",
+		"Normal WorkflowNodeRunning Running node retry-events",
+		"Normal WorkflowNodeRunning Running node retry-events(0): Pod failed",
+		"Warning WorkflowNodeFailed Failed node retry-events(0): Pod failed",
+		"Warning WorkflowNodeRetrying Retrying node retry-events, attempt 2, after attempt 1 Failed: Pod failed",
+	}, dumpEvents(controller))
+}
+
+func TestEventNodeArtifactErrorEvent(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: artifact-error-events
+  namespace: argo
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: docker/whalesay:latest
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) {
+		pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{
+			Name:  common.WaitContainerName,
+			State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 1, Message: "failed to put file"}},
+		}}
+	})
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.ElementsMatch(t, []string{
+		"Normal WorkflowRunning Workflow Running",
+		"Normal WorkflowNodeRunning Running node artifact-error-events: Pod failed",
+		"Warning WorkflowNodeFailed Failed node artifact-error-events: Pod failed",
+		"Warning WorkflowNodeArtifactError Failed to save outputs of node artifact-error-events: failed to put file",
+		"Warning WorkflowFailed Pod failed",
+	}, dumpEvents(controller))
+}
+
+func TestEventNodeEventsRateLimit(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: rate-limited-events
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: whalesay
+          - name: b
+            template: whalesay
+    - name: whalesay
+      container:
+        image: docker/whalesay:latest
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	var err error
+	controller.nodeEventFilter, err = newNodeEventFilter(&config.NodeEventsRateLimit{EventsPerSecond: 0.001, Burst: 2})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	c := controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events
+	// the workflow event, and the first two of the four node events
+	assert.Len(t, c, 3)
+}
+
+func dumpEvents(controller *WorkflowController) []string {
+	c := controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events
+	var events []string
+	for len(c) > 0 {
+		events = append(events, <-c)
+	}
+	return events
+}
+
 func getEvents(controller *WorkflowController, num int) []string {
 	c := controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events
 	events := make([]string, num)
@@ -4693,6 +5106,58 @@ func TestPanicMetric(t *testing.T) {
 	assert.True(t, seen)
 }
 
+func TestSLOMetrics(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: slo
+  namespace: my-ns
+  uid: slo-uid
+  labels:
+    workflows.argoproj.io/workflow-template: my-wftmpl
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: docker/whalesay:latest
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.metrics = metrics.New(metrics.ServerConfig{SLO: &config.SLOConfig{Windows: []metav1.Duration{{Duration: time.Hour}}, SuccessObjective: 0.5}}, metrics.ServerConfig{})
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	stale := woc.wf.DeepCopy()
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	// reconciling a stale copy of the workflow does not count it again
+	woc = newWorkflowOperationCtx(stale, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+
+	metricsChan := make(chan prometheus.Metric)
+	go func() {
+		controller.metrics.Collect(metricsChan)
+		close(metricsChan)
+	}()
+	values := map[string]float64{}
+	for metric := range metricsChan {
+		if desc := metric.Desc().String(); strings.Contains(desc, "argo_workflows_slo_") {
+			var writtenMetric dto.Metric
+			if assert.NoError(t, metric.Write(&writtenMetric)) {
+				values[strings.Split(desc, `"`)[1]] = *writtenMetric.Gauge.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"argo_workflows_slo_workflows_count":   1,
+		"argo_workflows_slo_success_ratio":     0,
+		"argo_workflows_slo_success_burn_rate": 2,
+	}, values)
+}
+
 // Assert Workflows cannot be run without using workflowTemplateRef in reference mode
 func TestControllerReferenceMode(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(globalVariablePlaceholders)
@@ -5140,6 +5605,63 @@ func TestConfigMapCacheSaveOperate(t *testing.T) {
 	}
 }
 
+func TestMemoizationKey(t *testing.T) {
+	key := func(t *testing.T, tmpl *wfv1.Template) string {
+		k, err := memoizationKey(tmpl)
+		require.NoError(t, err)
+		return k
+	}
+	newTmpl := func() *wfv1.Template {
+		return &wfv1.Template{
+			Name:    "main",
+			Memoize: &wfv1.Memoize{Key: "my-key", Auto: true},
+			Inputs: wfv1.Inputs{
+				Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hi")}},
+				Artifacts: []wfv1.Artifact{{
+					Name:             "data",
+					Digest:           "sha256:0123",
+					ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-pod/data.tgz"}},
+				}},
+			},
+			Container: &apiv1.Container{Image: "alpine"},
+		}
+	}
+	auto := key(t, newTmpl())
+	assert.Regexp(t, "^my-key-[0-9a-f]{64}$", auto)
+	t.Run("Manual", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Memoize.Auto = false
+		assert.Equal(t, "my-key", key(t, tmpl))
+	})
+	t.Run("Memoize", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Memoize.MaxAge = "1h"
+		assert.Equal(t, auto, key(t, tmpl))
+	})
+	t.Run("Parameters", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Inputs.Parameters[0].Value = wfv1.AnyStringPtr("bye")
+		assert.NotEqual(t, auto, key(t, tmpl))
+	})
+	t.Run("Template", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Container.Image = "debian"
+		assert.NotEqual(t, auto, key(t, tmpl))
+	})
+	t.Run("ArtifactLocation", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Inputs.Artifacts[0].S3.Key = "my-other-wf/my-pod/data.tgz"
+		assert.Equal(t, auto, key(t, tmpl))
+		tmpl.Inputs.Artifacts[0].Digest = ""
+		assert.NotEqual(t, auto, key(t, tmpl))
+	})
+	t.Run("ArtifactDigest", func(t *testing.T) {
+		tmpl := newTmpl()
+		tmpl.Inputs.Artifacts[0].Digest = "sha256:4567"
+		assert.NotEqual(t, auto, key(t, tmpl))
+	})
+}
+
 var propagate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -5280,7 +5802,7 @@ status:
       name: my-wf
       phase: Failed
 `)
-	wf, err := util.FormulateResubmitWorkflow(wf, true)
+	wf, err := util.FormulateResubmitWorkflow(wf, true, nil)
 	if assert.NoError(t, err) {
 		cancel, controller := newController(wf)
 		defer cancel()
@@ -6826,13 +7348,13 @@ func TestGetStepOrDAGTaskName(t *testing.T) {
 func TestGenerateOutputResultRegex(t *testing.T) {
 	dagTmpl := &wfv1.Template{DAG: &wfv1.DAGTemplate{}}
 	ref, expr := generateOutputResultRegex("template-name", dagTmpl)
-	assert.Equal(t, `tasks\.template-name\.outputs\.result`, ref)
-	assert.Equal(t, `tasks\[['\"]template-name['\"]\]\.outputs.result`, expr)
+	assert.Equal(t, `tasks\.template-name\.outputs\.(result|stderr)`, ref)
+	assert.Equal(t, `tasks\[['\"]template-name['\"]\]\.outputs.(result|stderr)`, expr)
 
 	stepsTmpl := &wfv1.Template{Steps: []wfv1.ParallelSteps{}}
 	ref, expr = generateOutputResultRegex("template-name", stepsTmpl)
-	assert.Equal(t, `steps\.template-name\.outputs\.result`, ref)
-	assert.Equal(t, `steps\[['\"]template-name['\"]\]\.outputs.result`, expr)
+	assert.Equal(t, `steps\.template-name\.outputs\.(result|stderr)`, ref)
+	assert.Equal(t, `steps\[['\"]template-name['\"]\]\.outputs.(result|stderr)`, expr)
 }
 
 const rootRetryStrategyCompletes = `apiVersion: argoproj.io/v1alpha1
@@ -11,6 +11,8 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templaterevision"
 )
 
 func TestWorkflowTemplateRef(t *testing.T) {
@@ -29,6 +31,34 @@ func TestWorkflowTemplateRef(t *testing.T) {
 	assert.Equal(t, "77", woc.globalParams["workflow.priority"])
 }
 
+func TestWorkflowTemplateRefRevision(t *testing.T) {
+	latest := wfv1.MustUnmarshalWorkflowTemplate(wfTmpl)
+	latest.UID = "my-uid"
+	cancel, controller := newController(wfv1.MustUnmarshalWorkflow(wfWithTmplRef), latest)
+	defer cancel()
+
+	ctx := context.Background()
+	revision := latest.DeepCopy()
+	revision.Annotations = map[string]string{common.AnnotationKeyWorkflowTemplateRevision: "1"}
+	revision.Spec.Templates[0].Container.Image = "docker/whalesay:v1"
+	assert.NoError(t, templaterevision.Save(ctx, controller.kubeclientset.CoreV1().ConfigMaps("default"), revision))
+	t.Run("Pinned", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithTmplRef)
+		wf.Spec.WorkflowTemplateRef.Revision = 1
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, "docker/whalesay:v1", woc.execWf.Spec.Templates[0].Container.Image)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithTmplRef)
+		wf.Spec.WorkflowTemplateRef.Revision = 2
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, `revision 2 of workflow template "workflow-template-whalesay-template" not found`)
+	})
+}
+
 func TestWorkflowTemplateRefWithArgs(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(wfWithTmplRef)
 	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(wfTmpl)
@@ -4,6 +4,8 @@ import (
 	"os"
 
 	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func SignificantPodChange(from *apiv1.Pod, to *apiv1.Pod) bool {
@@ -25,7 +27,8 @@ func significantMetadataChange(from map[string]string, to map[string]string) boo
 		return true
 	}
 	for k, v := range from {
-		if to[k] != v {
+		// heartbeats are checked when the workflow is next reconciled, rather than reconciling it for each one
+		if to[k] != v && k != common.AnnotationKeyHeartbeat {
 			return true
 		}
 	}
@@ -1,10 +1,15 @@
 package controller
 
 import (
+	"context"
+
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func (woc *wfOperationCtx) queuePodsForCleanup() {
@@ -14,12 +19,21 @@ func (woc *wfOperationCtx) queuePodsForCleanup() {
 	selector, _ := podGC.GetLabelSelector()
 	workflowPhase := woc.wf.Status.Phase
 	for _, pod := range woc.completedPods {
-		switch determinePodCleanupAction(selector, pod.Labels, strategy, workflowPhase, pod.Status.Phase) {
+		// the pod's status has been recorded, so it no longer needs protecting
+		if hasPodStatusFinalizer(pod) {
+			woc.controller.queuePodForCleanup(pod.Namespace, pod.Name, removeFinalizer)
+		}
+		action := determinePodCleanupAction(selector, pod.Labels, strategy, workflowPhase, pod.Status.Phase)
+		switch action {
 		case deletePod:
 			woc.controller.queuePodForCleanupAfter(pod.Namespace, pod.Name, deletePod, delay)
 		case labelPodCompleted:
 			woc.controller.queuePodForCleanup(pod.Namespace, pod.Name, labelPodCompleted)
 		}
+		// the claims of ephemeral volumes are only deleted with their pod, so free the storage of pods that are kept
+		if action != deletePod && hasEphemeralVolumes(pod) {
+			woc.controller.queuePodForCleanup(pod.Namespace, pod.Name, deleteEphemeralVolumes)
+		}
 	}
 }
 
@@ -50,3 +64,53 @@ func determinePodCleanupAction(
 	}
 	return ""
 }
+
+func hasPodStatusFinalizer(pod *apiv1.Pod) bool {
+	for _, f := range pod.Finalizers {
+		if f == common.FinalizerPodStatus {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEphemeralVolumes(pod *apiv1.Pod) bool {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Ephemeral != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteEphemeralVolumes deletes the claims of the ephemeral volumes of a completed pod, which are named after the pod,
+// and its volume, and controlled by the pod
+func (wfc *WorkflowController) deleteEphemeralVolumes(ctx context.Context, namespace, podName string) error {
+	// the pod may no longer be in the informer, if it has been labelled completed
+	pod, err := wfc.kubeclientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	pvcs := wfc.kubeclientset.CoreV1().PersistentVolumeClaims(namespace)
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Ephemeral == nil {
+			continue
+		}
+		pvc, err := pvcs.Get(ctx, pod.Name+"-"+vol.Name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !metav1.IsControlledBy(pvc, pod) {
+			continue
+		}
+		err = pvcs.Delete(ctx, pvc.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &pvc.UID}})
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
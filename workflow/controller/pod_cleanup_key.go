@@ -15,11 +15,13 @@ type (
 )
 
 const (
-	deletePod           podCleanupAction = "deletePod"
-	shutdownPod         podCleanupAction = "shutdownPod"
-	labelPodCompleted   podCleanupAction = "labelPodCompleted"
-	terminateContainers podCleanupAction = "terminateContainers"
-	killContainers      podCleanupAction = "killContainers"
+	deletePod              podCleanupAction = "deletePod"
+	shutdownPod            podCleanupAction = "shutdownPod"
+	labelPodCompleted      podCleanupAction = "labelPodCompleted"
+	terminateContainers    podCleanupAction = "terminateContainers"
+	killContainers         podCleanupAction = "killContainers"
+	removeFinalizer        podCleanupAction = "removeFinalizer"
+	deleteEphemeralVolumes podCleanupAction = "deleteEphemeralVolumes"
 )
 
 func newPodCleanupKey(namespace string, podName string, action podCleanupAction) podCleanupKey {
@@ -1,13 +1,16 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func Test_determinePodCleanupAction(t *testing.T) {
@@ -70,3 +73,84 @@ func Test_determinePodCleanupAction(t *testing.T) {
 		})
 	}
 }
+
+func TestPodStatusFinalizer(t *testing.T) {
+	cancel, controller := newController(wfv1.MustUnmarshalWorkflow(helloWorldWf), func(wfc *WorkflowController) { wfc.podStatusCaptureFinalizer = true })
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		assert.Contains(t, pods.Items[0].Finalizers, common.FinalizerPodStatus)
+	}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	// the finalizer is removed, and the pod labelled completed
+	for i := 0; i < 2; i++ {
+		controller.processNextPodCleanupItem(ctx)
+	}
+	pods, err = listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		assert.NotContains(t, pods.Items[0].Finalizers, common.FinalizerPodStatus)
+	}
+}
+
+var ephemeralVolumeWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: ephemeral-volume
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: argoproj/argosay:v2
+        volumeMounts:
+          - name: scratch
+            mountPath: /scratch
+      volumes:
+        - name: scratch
+          ephemeral:
+            volumeClaimTemplate:
+              spec:
+                accessModes: [ReadWriteOnce]
+                resources:
+                  requests:
+                    storage: 1Gi
+`
+
+func TestDeleteEphemeralVolumes(t *testing.T) {
+	cancel, controller := newController(wfv1.MustUnmarshalWorkflow(ephemeralVolumeWf))
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(ephemeralVolumeWf), controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		pvcs := controller.kubeclientset.CoreV1().PersistentVolumeClaims(pod.Namespace)
+		_, err := pvcs.Create(ctx, &apiv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name + "-scratch",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&pod, apiv1.SchemeGroupVersion.WithKind("Pod"))},
+		}}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		_, err = pvcs.Create(ctx, &apiv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "not-ephemeral"}}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		// the agent pod is deleted, the pod labelled completed, and the claim of its ephemeral volume deleted
+		for i := 0; i < 3; i++ {
+			controller.processNextPodCleanupItem(ctx)
+		}
+		list, err := pvcs.List(ctx, metav1.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "not-ephemeral", list.Items[0].Name)
+		}
+	}
+}
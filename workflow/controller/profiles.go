@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/profile"
+)
+
+// loadProfile returns the spec of the named profile, from the workflow's namespace, and makes its variables available
+// as global parameters
+func (woc *wfOperationCtx) loadProfile(name string) (*wfv1.WorkflowSpec, error) {
+	obj, exists, err := woc.controller.configMapInformer.GetIndexer().GetByKey(woc.wf.Namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("profile %q does not exist, it must be a config map with the label %s: %s", name, common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapProfile)
+	}
+	p, err := profile.FromConfigMap(obj.(*apiv1.ConfigMap))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.Variables {
+		woc.globalParams[common.GlobalVarWorkflowProfile+"."+k] = v
+	}
+	return &p.Spec, nil
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var profileWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  profile: my-profile
+  nodeSelector:
+    zone: a
+  templates:
+    - name: main
+      container:
+        image: "{{workflow.profile.image}}"
+        command: [cowsay]
+`
+
+var profileWfTmpl = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-tmpl
+  namespace: my-ns
+spec:
+  entrypoint: main
+  profile: my-profile
+  templates:
+    - name: main
+      container:
+        image: "{{workflow.profile.image}}"
+        command: [cowsay]
+`
+
+var profileWfWithTmplRef = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  workflowTemplateRef:
+    name: my-tmpl
+`
+
+func addProfile(t *testing.T, controller *WorkflowController) {
+	err := controller.configMapInformer.GetIndexer().Add(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-profile",
+			Namespace: "my-ns",
+			Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapProfile},
+		},
+		Data: map[string]string{
+			"spec":  "nodeSelector:\n  pool: batch\n  zone: b\nserviceAccountName: my-sa\n",
+			"image": "my-registry/my-image:v1",
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestProfile(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Workflow", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(profileWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		addProfile(t, controller)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			pod := pods.Items[0]
+			assert.Equal(t, "my-registry/my-image:v1", pod.Spec.Containers[1].Image)
+			assert.Equal(t, map[string]string{"pool": "batch", "zone": "a"}, pod.Spec.NodeSelector)
+			assert.Equal(t, "my-sa", pod.Spec.ServiceAccountName)
+		}
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(profileWfWithTmplRef)
+		cancel, controller := newController(wf, wfv1.MustUnmarshalWorkflowTemplate(profileWfTmpl))
+		defer cancel()
+		addProfile(t, controller)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Equal(t, "my-sa", woc.wf.Status.StoredWorkflowSpec.ServiceAccountName)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			pod := pods.Items[0]
+			assert.Equal(t, "my-registry/my-image:v1", pod.Spec.Containers[1].Image)
+			assert.Equal(t, map[string]string{"pool": "batch", "zone": "b"}, pod.Spec.NodeSelector)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(profileWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Equal(t, `profile "my-profile" does not exist, it must be a config map with the label workflows.argoproj.io/configmap-type: Profile`, woc.wf.Status.Message)
+	})
+}
@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// podMetricsList is the part of a PodMetricsList, of the metrics.k8s.io API, about the usage of the containers of pods
+type podMetricsList struct {
+	Items []struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Containers        []struct {
+			Name  string             `json:"name"`
+			Usage apiv1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// resourceUsageSamples is the total, and peak, usage of the main containers of a pod over all the samples
+type resourceUsageSamples struct {
+	mainContainerNames []string
+	samples            int64
+	milliCores         struct{ total, peak int64 }
+	bytes              struct{ total, peak int64 }
+}
+
+func (s *resourceUsageSamples) add(milliCores, bytes int64) {
+	s.samples++
+	s.milliCores.total += milliCores
+	s.bytes.total += bytes
+	if milliCores > s.milliCores.peak {
+		s.milliCores.peak = milliCores
+	}
+	if bytes > s.bytes.peak {
+		s.bytes.peak = bytes
+	}
+}
+
+// usage returns the peak, and average, usage sampled, or nil if there are no samples
+func (s *resourceUsageSamples) usage() *wfv1.ResourceUsage {
+	if s.samples == 0 {
+		return nil
+	}
+	return &wfv1.ResourceUsage{
+		Peak: apiv1.ResourceList{
+			apiv1.ResourceCPU:    *resource.NewMilliQuantity(s.milliCores.peak, resource.DecimalSI),
+			apiv1.ResourceMemory: *resource.NewQuantity(s.bytes.peak, resource.BinarySI),
+		},
+		Average: apiv1.ResourceList{
+			apiv1.ResourceCPU:    *resource.NewMilliQuantity(s.milliCores.total/s.samples, resource.DecimalSI),
+			apiv1.ResourceMemory: *resource.NewQuantity(s.bytes.total/s.samples, resource.BinarySI),
+		},
+		Samples: s.samples,
+	}
+}
+
+// resourceUsageSampler keeps the usage of the main containers of running pods, sampled from the metrics.k8s.io API,
+// until their nodes complete
+type resourceUsageSampler struct {
+	lock sync.Mutex
+	// pods are the samples of each pod, by its key
+	pods map[string]*resourceUsageSamples
+}
+
+// add adds the usage of the main containers of the pod to its samples
+func (r *resourceUsageSampler) add(pod *apiv1.Pod, usage map[string]apiv1.ResourceList) error {
+	key, err := cache.MetaNamespaceKeyFunc(pod)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.pods == nil {
+		r.pods = map[string]*resourceUsageSamples{}
+	}
+	s, ok := r.pods[key]
+	if !ok {
+		tmpl, err := podTemplate(pod)
+		if err != nil {
+			return err
+		}
+		s = &resourceUsageSamples{mainContainerNames: tmpl.GetMainContainerNames()}
+		r.pods[key] = s
+	}
+	var milliCores, bytes int64
+	for name, l := range usage {
+		if slice.ContainsString(s.mainContainerNames, name) {
+			milliCores += l.Cpu().MilliValue()
+			bytes += l.Memory().Value()
+		}
+	}
+	s.add(milliCores, bytes)
+	return nil
+}
+
+// take returns the usage sampled of the pod, if any, and forgets it
+func (r *resourceUsageSampler) take(pod *apiv1.Pod) *wfv1.ResourceUsage {
+	key, err := cache.MetaNamespaceKeyFunc(pod)
+	if err != nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	s, ok := r.pods[key]
+	if !ok {
+		return nil
+	}
+	delete(r.pods, key)
+	return s.usage()
+}
+
+// retain forgets the usage sampled of the pods that are not kept, e.g. because they were deleted before they completed
+func (r *resourceUsageSampler) retain(keep func(key string) bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for key := range r.pods {
+		if !keep(key) {
+			delete(r.pods, key)
+		}
+	}
+}
+
+// runResourceUsageSampler samples the usage of the running pods of workflows every interval, while resource usage is
+// configured
+func (wfc *WorkflowController) runResourceUsageSampler(ctx context.Context) {
+	for {
+		interval := wfc.Config.ResourceUsage.GetInterval()
+		if wfc.Config.ResourceUsage != nil {
+			wfc.sampleResourceUsage(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// sampleResourceUsage samples the usage of the running pods of workflows from the metrics.k8s.io API, which the metrics
+// server serves. The controller's service account needs to be able to list its pods
+func (wfc *WorkflowController) sampleResourceUsage(ctx context.Context) {
+	path := "/apis/metrics.k8s.io/v1beta1/pods"
+	if wfc.managedNamespace != "" {
+		path = "/apis/metrics.k8s.io/v1beta1/namespaces/" + wfc.managedNamespace + "/pods"
+	}
+	data, err := wfc.kubeclientset.CoreV1().RESTClient().Get().
+		AbsPath(path).
+		Param("labelSelector", common.LabelKeyWorkflow).
+		DoRaw(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Failed to sample resource usage")
+		return
+	}
+	list := &podMetricsList{}
+	if err := json.Unmarshal(data, list); err != nil {
+		log.WithError(err).Warn("Failed to unmarshal resource usage")
+		return
+	}
+	for _, item := range list.Items {
+		obj, exists, err := wfc.podInformer.GetStore().GetByKey(item.Namespace + "/" + item.Name)
+		if err != nil || !exists {
+			continue
+		}
+		pod, ok := obj.(*apiv1.Pod)
+		if !ok || pod.Status.Phase != apiv1.PodRunning {
+			continue
+		}
+		usage := map[string]apiv1.ResourceList{}
+		for _, c := range item.Containers {
+			usage[c.Name] = c.Usage
+		}
+		if err := wfc.resourceUsage.add(pod, usage); err != nil {
+			log.WithError(err).WithField("pod", item.Name).Warn("Failed to add resource usage sample")
+		}
+	}
+	wfc.resourceUsage.retain(func(key string) bool {
+		_, exists, err := wfc.podInformer.GetStore().GetByKey(key)
+		return err != nil || exists
+	})
+}
+
+// podTemplate returns the template the pod runs
+func podTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
+	for _, c := range pod.Spec.Containers {
+		for _, e := range c.Env {
+			if e.Name == common.EnvVarTemplate {
+				tmpl := &wfv1.Template{}
+				return tmpl, json.Unmarshal([]byte(e.Value), tmpl)
+			}
+		}
+	}
+	return nil, fmt.Errorf("pod %s has no template", pod.Name)
+}
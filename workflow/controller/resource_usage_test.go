@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestResourceUsageSampler(t *testing.T) {
+	podOf := func(name string, tmpl wfv1.Template) *apiv1.Pod {
+		return &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: name},
+			Spec: apiv1.PodSpec{Containers: []apiv1.Container{
+				{Name: common.WaitContainerName, Env: []apiv1.EnvVar{{Name: common.EnvVarTemplate, Value: wfv1.MustMarshallJSON(tmpl)}}},
+			}},
+		}
+	}
+	usageOf := func(cpu, memory string) apiv1.ResourceList {
+		return apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse(cpu), apiv1.ResourceMemory: resource.MustParse(memory)}
+	}
+	r := &resourceUsageSampler{}
+	containerSet := podOf("container-set", wfv1.Template{ContainerSet: &wfv1.ContainerSetTemplate{Containers: []wfv1.ContainerNode{{Container: apiv1.Container{Name: "a"}}, {Container: apiv1.Container{Name: "b"}}}}})
+	assert.NoError(t, r.add(containerSet, map[string]apiv1.ResourceList{"a": usageOf("1", "1Gi"), "b": usageOf("500m", "1Gi"), "sidecar": usageOf("2", "2Gi")}))
+	deleted := podOf("deleted", wfv1.Template{})
+	assert.NoError(t, r.add(deleted, map[string]apiv1.ResourceList{"main": usageOf("1", "1Gi")}))
+	assert.Error(t, r.add(&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "no-template"}}, nil))
+
+	r.retain(func(key string) bool { return key != "my-ns/deleted" })
+	assert.Nil(t, r.take(deleted))
+
+	usage := r.take(containerSet)
+	if assert.NotNil(t, usage) {
+		assert.Equal(t, "1500m", usage.Peak.Cpu().String())
+		assert.Equal(t, "2Gi", usage.Peak.Memory().String())
+		assert.Equal(t, int64(1), usage.Samples)
+	}
+	assert.Nil(t, r.take(containerSet))
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// argumentsChanged returns true if the arguments differ from the inputs that a node was executed with
+func argumentsChanged(inputs *wfv1.Inputs, args wfv1.Arguments) bool {
+	if inputs == nil {
+		inputs = &wfv1.Inputs{}
+	}
+	for _, p := range args.Parameters {
+		input := inputs.GetParameterByName(p.Name)
+		if input == nil || p.Value == nil || input.Value == nil || p.Value.String() != input.Value.String() {
+			return true
+		}
+	}
+	for _, a := range args.Artifacts {
+		input := inputs.GetArtifactByName(a.Name)
+		if input == nil || !reflect.DeepEqual(input.ArtifactLocation, a.ArtifactLocation) {
+			return true
+		}
+	}
+	return false
+}
+
+// reexecuteIfArgumentsChanged deletes a node re-used from the workflow this one was resubmitted from if its arguments
+// changed, e.g. because a node it depends on was re-executed, so that it is executed again. It returns the node, or
+// nil if it was deleted.
+func (woc *wfOperationCtx) reexecuteIfArgumentsChanged(node *wfv1.NodeStatus, args wfv1.Arguments) *wfv1.NodeStatus {
+	if node == nil || !wfutil.IsResubmittedNode(*node) || !argumentsChanged(node.Inputs, args) {
+		return node
+	}
+	woc.log.WithField("nodeName", node.Name).Info("Arguments of resubmitted node changed, re-executing it")
+	return woc.reexecuteResubmittedNode(node)
+}
+
+// reexecuteResubmittedNode deletes a re-used node, so that it is executed again
+func (woc *wfOperationCtx) reexecuteResubmittedNode(node *wfv1.NodeStatus) *wfv1.NodeStatus {
+	delete(woc.wf.Status.Nodes, node.ID)
+	woc.updated = true
+	return nil
+}
+
+// checkResubmittedDAGTask waits for the dependencies of a task re-used from the workflow this one was resubmitted from,
+// which may have been re-executed, and then re-executes the task if its arguments changed. It returns the task's
+// node, or nil if it was deleted, and false if its dependencies have not completed yet.
+func (woc *wfOperationCtx) checkResubmittedDAGTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask, node *wfv1.NodeStatus) (*wfv1.NodeStatus, bool) {
+	if node == nil || !wfutil.IsResubmittedNode(*node) {
+		return node, true
+	}
+	for _, depName := range dagCtx.GetTaskDependencies(task.Name) {
+		woc.executeDAGTask(ctx, dagCtx, depName)
+		depNode := dagCtx.getTaskNode(depName)
+		if depNode == nil || !depNode.Fulfilled() {
+			return node, false
+		}
+	}
+	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
+	if err != nil {
+		// re-execute the task, which surfaces the error
+		return woc.reexecuteResubmittedNode(node), true
+	}
+	return woc.reexecuteIfArgumentsChanged(node, newTask.Arguments), true
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func TestArgumentsChanged(t *testing.T) {
+	inputs := &wfv1.Inputs{
+		Parameters: []wfv1.Parameter{{Name: "x", Value: wfv1.AnyStringPtr("1")}},
+		Artifacts:  wfv1.Artifacts{{Name: "y", Path: "/y", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "y.tgz"}}}},
+	}
+	assert.False(t, argumentsChanged(inputs, wfv1.Arguments{}))
+	assert.False(t, argumentsChanged(inputs, wfv1.Arguments{
+		Parameters: []wfv1.Parameter{{Name: "x", Value: wfv1.AnyStringPtr("1")}},
+		Artifacts:  wfv1.Artifacts{{Name: "y", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "y.tgz"}}}},
+	}))
+	assert.True(t, argumentsChanged(inputs, wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "x", Value: wfv1.AnyStringPtr("2")}}}))
+	assert.True(t, argumentsChanged(inputs, wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "z", Value: wfv1.AnyStringPtr("1")}}}))
+	assert.True(t, argumentsChanged(inputs, wfv1.Arguments{Artifacts: wfv1.Artifacts{{Name: "y", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "z.tgz"}}}}}))
+	assert.True(t, argumentsChanged(nil, wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "x", Value: wfv1.AnyStringPtr("1")}}}))
+}
+
+func TestResubmitMemoizedChangedInputs(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: message
+        value: hello
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: a
+      - name: b
+        template: b
+        depends: a
+        arguments:
+          parameters:
+          - name: x
+            value: "{{tasks.a.outputs.parameters.p}}"
+      - name: c
+        template: c
+  - name: a
+    container:
+      image: my-image
+      args: ["{{workflow.parameters.message}}"]
+    outputs:
+      parameters:
+      - name: p
+        valueFrom:
+          path: /p
+  - name: b
+    inputs:
+      parameters:
+      - name: x
+    container:
+      image: my-image
+      args: ["{{inputs.parameters.x}}"]
+  - name: c
+    container:
+      image: my-image
+`)
+	ctx := context.Background()
+	run := func(wf *wfv1.Workflow, p string) *wfOperationCtx {
+		cancel, controller := newController(wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(`{"parameters": [{"name": "p", "value": "`+p+`"}]}`))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		return woc
+	}
+	woc := run(wf, "old")
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0))
+	woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+	woc.operate(ctx)
+	require.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+	t.Run("Unchanged", func(t *testing.T) {
+		wf, err := wfutil.FormulateResubmitWorkflow(woc.wf.DeepCopy(), true, []string{"message=hello"})
+		require.NoError(t, err)
+		woc := run(wf, "old")
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		for _, task := range []string{"a", "b", "c"} {
+			assert.Equal(t, wfv1.NodeSkipped, woc.wf.GetNodeByName(woc.wf.Name+"."+task).Phase, task)
+		}
+	})
+	t.Run("Changed", func(t *testing.T) {
+		wf, err := wfutil.FormulateResubmitWorkflow(woc.wf.DeepCopy(), true, []string{"message=goodbye"})
+		require.NoError(t, err)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodePending, woc.wf.GetNodeByName(wf.Name+".a").Phase)
+		// b waits for a, which is re-executed
+		assert.True(t, wfutil.IsResubmittedNode(*woc.wf.GetNodeByName(wf.Name + ".b")))
+		assert.Equal(t, wfv1.NodeSkipped, woc.wf.GetNodeByName(wf.Name+".c").Phase)
+
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(`{"parameters": [{"name": "p", "value": "new"}]}`))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName(wf.Name+".a").Phase)
+		// a's output changed, so b is re-executed
+		b := woc.wf.GetNodeByName(wf.Name + ".b")
+		if assert.NotNil(t, b) {
+			assert.Equal(t, wfv1.NodeTypePod, b.Type)
+			assert.Equal(t, wfv1.NodePending, b.Phase)
+			assert.Equal(t, "new", b.Inputs.GetParameterByName("x").Value.String())
+		}
+		assert.Equal(t, wfv1.NodeSkipped, woc.wf.GetNodeByName(wf.Name+".c").Phase)
+	})
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// applyScriptRuntime returns a copy of the script template, with the image, command, and resources of its runtime where
+// the script does not set them, and the path its source is written to, if the runtime has a file extension
+func (woc *wfOperationCtx) applyScriptRuntime(tmpl *wfv1.Template) (*wfv1.Template, string, error) {
+	name := tmpl.Script.Runtime
+	if name == "" {
+		return tmpl, "", nil
+	}
+	runtime, ok := woc.controller.Config.ScriptRuntimes[name]
+	if !ok {
+		return nil, "", fmt.Errorf("script runtime %q is not configured", name)
+	}
+	tmpl = tmpl.DeepCopy()
+	script := tmpl.Script
+	if script.Image == "" {
+		script.Image = runtime.Image
+	}
+	if len(script.Command) == 0 {
+		script.Command = append([]string{}, runtime.Command...)
+	}
+	if !isResourcesSpecified(&script.Container) {
+		script.Resources = *runtime.Resources.DeepCopy()
+	}
+	if script.Image == "" {
+		return nil, "", fmt.Errorf("script runtime %q does not have an image", name)
+	}
+	scriptSourcePath := ""
+	if ext := runtime.GetExtension(); ext != "" {
+		scriptSourcePath = common.ExecutorScriptSourcePath + ext
+	}
+	return tmpl, scriptSourcePath, nil
+}
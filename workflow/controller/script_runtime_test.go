@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestScriptRuntime(t *testing.T) {
+	newWorkflow := func(script string) *wfv1.Workflow {
+		return wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: script-runtime
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      script:
+` + script)
+	}
+	runtimes := map[string]config.ScriptRuntime{
+		"python311-slim": {
+			Image:     "python:3.11-slim",
+			Command:   []string{"python"},
+			Extension: "py",
+			Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("64Mi")}},
+		},
+	}
+	run := func(t *testing.T, wf *wfv1.Workflow) *wfOperationCtx {
+		cancel, controller := newController(wf)
+		t.Cleanup(cancel)
+		controller.Config.ScriptRuntimes = runtimes
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(context.Background())
+		return woc
+	}
+	t.Run("Runtime", func(t *testing.T) {
+		woc := run(t, newWorkflow(`        runtime: python311-slim
+        source: print("hello")
+`))
+		pod, err := getPod(woc, "script-runtime")
+		if assert.NoError(t, err) {
+			main := pod.Spec.Containers[1]
+			assert.Equal(t, "python:3.11-slim", main.Image)
+			assert.Equal(t, []string{"/var/run/argo/argoexec", "emissary", "--", "python"}, main.Command)
+			assert.Equal(t, []string{"/argo/staging/script.py"}, main.Args)
+			assert.Equal(t, resource.MustParse("64Mi"), main.Resources.Requests[apiv1.ResourceMemory])
+			assert.Contains(t, pod.Spec.InitContainers[0].Env, apiv1.EnvVar{Name: common.EnvVarScriptSourcePath, Value: "/argo/staging/script.py"})
+		}
+	})
+	t.Run("Overrides", func(t *testing.T) {
+		woc := run(t, newWorkflow(`        runtime: python311-slim
+        image: python:3.10
+        command: [python3]
+        resources:
+          requests:
+            memory: 128Mi
+        source: print("hello")
+`))
+		pod, err := getPod(woc, "script-runtime")
+		if assert.NoError(t, err) {
+			main := pod.Spec.Containers[1]
+			assert.Equal(t, "python:3.10", main.Image)
+			assert.Equal(t, []string{"/var/run/argo/argoexec", "emissary", "--", "python3"}, main.Command)
+			assert.Equal(t, resource.MustParse("128Mi"), main.Resources.Requests[apiv1.ResourceMemory])
+		}
+	})
+	t.Run("NoRuntime", func(t *testing.T) {
+		woc := run(t, newWorkflow(`        image: python:3.11
+        command: [python]
+        source: print("hello")
+`))
+		pod, err := getPod(woc, "script-runtime")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{common.ExecutorScriptSourcePath}, pod.Spec.Containers[1].Args)
+			for _, env := range pod.Spec.InitContainers[0].Env {
+				assert.NotEqual(t, common.EnvVarScriptSourcePath, env.Name)
+			}
+		}
+	})
+	t.Run("UnknownRuntime", func(t *testing.T) {
+		woc := run(t, newWorkflow(`        runtime: ruby
+        source: puts "hello"
+`))
+		node := woc.wf.Status.Nodes.FindByDisplayName("script-runtime")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, `script runtime "ruby" is not configured`, node.Message)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// onShutdownHookTimeout is how long the workflow waits for an onShutdown hook whose template has no
+// shutdownGracePeriodSeconds
+var onShutdownHookTimeout = env.LookupEnvDurationOr("ON_SHUTDOWN_HOOK_TIMEOUT", 5*time.Minute)
+
+// runOnShutdownHooks runs the onShutdown hooks of the pods that were running when the workflow was stopped, or
+// terminated, and returns whether they have all completed. A hook that has not completed within the template's
+// shutdownGracePeriodSeconds, or onShutdownHookTimeout, is failed, and its pod shut down, so the workflow can complete.
+func (woc *wfOperationCtx) runOnShutdownHooks(ctx context.Context) (bool, error) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			nodes = append(nodes, node)
+		}
+	}
+	completed := true
+	for _, node := range nodes {
+		hookNodeName := common.GenerateOnShutdownNodeName(node.Name)
+		hookNode := woc.wf.GetNodeByName(hookNodeName)
+		if (hookNode == nil && node.Phase != wfv1.NodeRunning) || (hookNode != nil && hookNode.Fulfilled()) {
+			continue
+		}
+		tmplCtx, err := woc.createTemplateContext(node.GetTemplateScope())
+		if err != nil {
+			return false, err
+		}
+		// the hook is resolved from the same template base as the node's template, e.g. the same workflow template
+		tmplCtx, tmpl, _, err := tmplCtx.ResolveTemplate(&node)
+		if err != nil {
+			return false, err
+		}
+		if tmpl.OnShutdown == "" {
+			continue
+		}
+		woc.log.WithField("node", hookNodeName).Info("Running onShutdown hook")
+		hookNode, err = woc.executeTemplate(ctx, hookNodeName, &wfv1.WorkflowStep{Template: tmpl.OnShutdown}, tmplCtx, wfv1.Arguments{}, &executeTemplateOpts{
+			boundaryID:         node.BoundaryID,
+			onShutdownTemplate: true,
+		})
+		if err != nil {
+			return false, err
+		}
+		woc.addChildNode(node.Name, hookNodeName)
+		if hookNode == nil || hookNode.Fulfilled() {
+			continue
+		}
+		timeout := onShutdownHookTimeout
+		if tmpl.ShutdownGracePeriodSeconds != nil {
+			timeout = time.Duration(*tmpl.ShutdownGracePeriodSeconds) * time.Second
+		}
+		if remaining := timeout - time.Since(hookNode.StartedAt.Time); remaining > 0 {
+			woc.requeueAfter(remaining)
+			completed = false
+			continue
+		}
+		woc.log.WithField("node", hookNodeName).Warn("onShutdown hook timed out")
+		woc.markNodePhase(hookNodeName, wfv1.NodeFailed, fmt.Sprintf("onShutdown hook did not complete within %v", timeout))
+		if hookNode.Type == wfv1.NodeTypePod {
+			woc.controller.queuePodForCleanup(woc.wf.GetPodNamespace(), woc.getPodName(hookNodeName, tmpl.OnShutdown), shutdownPod)
+		}
+	}
+	return completed, nil
+}
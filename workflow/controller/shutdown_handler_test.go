@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var onShutdownWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: on-shutdown
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+  - name: train
+    onShutdown: flush
+    shutdownGracePeriodSeconds: 60
+    container:
+      image: argoproj/argosay:v2
+  - name: flush
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestOnShutdownHooks(t *testing.T) {
+	for _, strategy := range []wfv1.ShutdownStrategy{wfv1.ShutdownStrategyStop, wfv1.ShutdownStrategyTerminate} {
+		t.Run(string(strategy), func(t *testing.T) {
+			ctx := context.Background()
+			wf := wfv1.MustUnmarshalWorkflow(onShutdownWf)
+			cancel, controller := newController(wf)
+			defer cancel()
+
+			woc := newWorkflowOperationCtx(wf, controller)
+			woc.operate(ctx)
+			makePodsPhase(ctx, woc, apiv1.PodRunning)
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+
+			woc.wf.Spec.Shutdown = strategy
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+
+			assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+			hookNode := woc.wf.Status.Nodes.FindByDisplayName("train.onShutdown")
+			require.NotNil(t, hookNode)
+			assert.Equal(t, wfv1.NodePending, hookNode.Phase)
+			pod, err := getPod(woc, hookNode.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "true", pod.Labels[common.LabelKeyOnShutdown])
+
+			// the workflow completes once the hook has
+			makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes[hookNode.ID].Phase)
+			assert.True(t, woc.wf.Status.Fulfilled())
+		})
+	}
+}
+
+func TestOnShutdownHookTimeout(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(onShutdownWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyStop
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	hookNode := woc.wf.Status.Nodes.FindByDisplayName("train.onShutdown")
+	require.NotNil(t, hookNode)
+
+	// the hook has run for longer than the grace period of 60s
+	hookNode.StartedAt.Time = time.Now().Add(-2 * time.Minute)
+	woc.wf.Status.Nodes[hookNode.ID] = *hookNode
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes[hookNode.ID].Phase)
+	assert.Equal(t, "onShutdown hook did not complete within 1m0s", woc.wf.Status.Nodes[hookNode.ID].Message)
+
+	// the workflow completes once the pods are shut down
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.True(t, woc.wf.Status.Fulfilled())
+}
+
+func TestShutdownGracePeriod(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(onShutdownWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := &pods.Items[0]
+	lock := &sync.RWMutex{}
+
+	assert.Equal(t, time.Minute, woc.shutdownGracePeriod(pod, lock))
+	nodeName := pod.Annotations[common.AnnotationKeyNodeName]
+	hookNodeName := common.GenerateOnShutdownNodeName(nodeName)
+	hookNode := woc.initializeNode(hookNodeName, wfv1.NodeTypePod, "", &wfv1.WorkflowStep{Template: "flush"}, "", wfv1.NodeRunning)
+	hookNode.StartedAt.Time = time.Now().Add(-time.Minute / 2)
+	woc.wf.Status.Nodes[hookNode.ID] = *hookNode
+	assert.InDelta(t, time.Minute/2, woc.shutdownGracePeriod(pod, lock), float64(time.Second))
+	woc.markNodePhase(hookNodeName, wfv1.NodeSucceeded)
+	assert.Zero(t, woc.shutdownGracePeriod(pod, lock))
+}
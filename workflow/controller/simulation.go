@@ -0,0 +1,310 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/time/rate"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/informer"
+)
+
+// the default maximum number of times a simulated workflow is reconciled
+const defaultSimulationMaxRounds = 1000
+
+// SimulateOpts are the options of a simulation
+type SimulateOpts struct {
+	// Config is the config of the simulated controller, e.g. its workflow defaults, and the commands of images
+	Config config.Config
+	// WorkflowTemplates, and ClusterWorkflowTemplates, are those the workflow may reference
+	WorkflowTemplates        []wfv1.WorkflowTemplate
+	ClusterWorkflowTemplates []wfv1.ClusterWorkflowTemplate
+	// PodPhase returns the phase each pod completes with. Default is that every pod succeeds
+	PodPhase func(pod *apiv1.Pod) apiv1.PodPhase
+	// MaxRounds is the maximum number of times the workflow is reconciled. Default is 1000
+	MaxRounds int
+	// NewClients returns the clients of the simulated controller, which have the objects, e.g. the fake clients of the
+	// simulation package
+	NewClients func(objects ...runtime.Object) SimulationClients
+}
+
+// SimulationClients are the clients of a simulated controller
+type SimulationClients struct {
+	Kube     kubernetes.Interface
+	Dynamic  dynamic.Interface
+	Workflow wfclientset.Interface
+}
+
+// SimulatedPod is a pod created by a simulated workflow
+type SimulatedPod struct {
+	// Round is the reconciliation the pod was created in, from 1
+	Round        int            `json:"round"`
+	Name         string         `json:"name"`
+	NodeName     string         `json:"nodeName"`
+	TemplateName string         `json:"templateName,omitempty"`
+	Phase        apiv1.PodPhase `json:"phase"`
+}
+
+// Simulation is the course of a simulated workflow
+type Simulation struct {
+	// Rounds is the number of times the workflow was reconciled
+	Rounds int `json:"rounds"`
+	// Pods are the pods the workflow created, in the order they were created
+	Pods []SimulatedPod `json:"pods"`
+	// Workflow is the workflow at the end of the simulation, with its nodes
+	Workflow *wfv1.Workflow `json:"workflow"`
+}
+
+// Simulate runs the workflow with a controller that has the clients of opts.NewClients, e.g. the fake clients of the
+// simulation package, rather than those of a cluster, to find the pods, and nodes, the workflow would create. Each time
+// the workflow is reconciled, the pods it created complete, with the phase opts.PodPhase returns, their output
+// parameters are the defaults of their `valueFrom`, and their output artifacts are at placeholder keys. It returns the
+// simulation so far, and an error, if the workflow stops progressing before it completes, e.g. as it is suspended, or
+// waiting for a retry's backoff.
+func Simulate(ctx context.Context, wf *wfv1.Workflow, opts SimulateOpts) (*Simulation, error) {
+	wf = wf.DeepCopy()
+	if wf.Name == "" {
+		wf.Name = strings.TrimSuffix(wf.GenerateName, "-")
+		if wf.Name == "" {
+			wf.Name = "simulation"
+		}
+	}
+	if wf.Namespace == "" {
+		wf.Namespace = "default"
+	}
+	if opts.PodPhase == nil {
+		opts.PodPhase = func(*apiv1.Pod) apiv1.PodPhase { return apiv1.PodSucceeded }
+	}
+	if opts.MaxRounds <= 0 {
+		opts.MaxRounds = defaultSimulationMaxRounds
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	wfc, err := newSimulationController(ctx, wf, opts)
+	if err != nil {
+		return nil, err
+	}
+	wf, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Simulation{Workflow: wf}
+	seen := map[string]bool{}
+	for s.Rounds < opts.MaxRounds && !s.Workflow.Status.Fulfilled() {
+		s.Rounds++
+		before := simulationProgress(s.Workflow, len(seen))
+		woc := newWorkflowOperationCtx(s.Workflow, wfc)
+		woc.operate(ctx)
+		s.Workflow = woc.wf
+		pods, err := completeSimulatedPods(ctx, woc, opts.PodPhase)
+		if err != nil {
+			return s, err
+		}
+		for _, pod := range pods {
+			if seen[pod.Name] {
+				continue
+			}
+			seen[pod.Name] = true
+			p := SimulatedPod{Round: s.Rounds, Name: pod.Name, NodeName: pod.Annotations[common.AnnotationKeyNodeName], Phase: pod.Status.Phase}
+			if node, ok := s.Workflow.Status.Nodes[pod.Annotations[common.AnnotationKeyNodeID]]; ok {
+				p.TemplateName = node.TemplateName
+			}
+			s.Pods = append(s.Pods, p)
+		}
+		if !s.Workflow.Status.Fulfilled() && simulationProgress(s.Workflow, len(seen)) == before {
+			return s, fmt.Errorf("workflow stopped progressing after %d rounds, it may be suspended, waiting for a retry's backoff, or for a template that does not run a pod", s.Rounds)
+		}
+	}
+	if !s.Workflow.Status.Fulfilled() {
+		return s, fmt.Errorf("workflow did not complete in %d rounds", opts.MaxRounds)
+	}
+	return s, nil
+}
+
+// newSimulationController returns a controller of the clients of the simulation, that has its workflow, and templates,
+// as per NewWorkflowController and WorkflowController.Run
+func newSimulationController(ctx context.Context, wf *wfv1.Workflow, opts SimulateOpts) (*WorkflowController, error) {
+	if opts.NewClients == nil {
+		return nil, fmt.Errorf("the simulation has no clients, use the simulation package to simulate with fake clients")
+	}
+	objects := []runtime.Object{wf}
+	for i := range opts.WorkflowTemplates {
+		objects = append(objects, &opts.WorkflowTemplates[i])
+	}
+	for i := range opts.ClusterWorkflowTemplates {
+		objects = append(objects, &opts.ClusterWorkflowTemplates[i])
+	}
+	clients := opts.NewClients(objects...)
+	wfc := newWorkflowController(clients.Kube, clients.Dynamic, clients.Workflow, wf.Namespace)
+	wfc.eventRecorderManager = simulationEventRecorderManager{}
+	c := opts.Config
+	// the simulation never connects to a database
+	c.Persistence = nil
+	if c.ExecutorImage == "" {
+		wfc.cliExecutorImage = "quay.io/argoproj/argoexec:latest"
+	}
+	if err := wfc.updateConfig(&c); err != nil {
+		return nil, err
+	}
+	wfc.newQueues()
+	// every pod is created as soon as it can be
+	wfc.rateLimiter = rate.NewLimiter(rate.Inf, 0)
+	if err := wfc.newInformers(ctx); err != nil {
+		return nil, err
+	}
+	wfc.cwftmplInformer = informer.NewTolerantClusterWorkflowTemplateInformer(wfc.dynamicInterface, clusterWorkflowTemplateResyncPeriod)
+	// the pods are not watched, each round of the simulation adds them to the informer's store
+	informers := []cache.SharedIndexInformer{wfc.wfInformer, wfc.wftmplInformer.Informer(), wfc.cwftmplInformer.Informer(), wfc.wfTaskSetInformer.Informer(), wfc.configMapInformer}
+	var synced []cache.InformerSynced
+	for _, i := range informers {
+		go i.Run(ctx.Done())
+		synced = append(synced, i.HasSynced)
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), synced...) {
+		return nil, fmt.Errorf("timed out waiting for the caches of the simulation to sync")
+	}
+	return wfc, nil
+}
+
+// completeSimulatedPods completes the workflow's pods that have not, with the phase podPhase returns, exit codes to
+// match, and the defaults of their output parameters, and returns every pod of the workflow, in the order of their nodes' names
+func completeSimulatedPods(ctx context.Context, woc *wfOperationCtx, podPhase func(pod *apiv1.Pod) apiv1.PodPhase) ([]apiv1.Pod, error) {
+	podcs := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.GetPodNamespace())
+	list, err := podcs.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pods := list.Items
+	for i, pod := range pods {
+		if pod.Status.Phase != apiv1.PodSucceeded && pod.Status.Phase != apiv1.PodFailed {
+			pod.Status.Phase = podPhase(&pod)
+			exitCode := int32(0)
+			if pod.Status.Phase == apiv1.PodFailed {
+				exitCode = 1
+			}
+			for _, c := range pod.Spec.Containers {
+				pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, apiv1.ContainerStatus{
+					Name:  c.Name,
+					State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: exitCode}},
+				})
+			}
+			outputs, err := simulatedOutputs(&pod)
+			if err != nil {
+				return nil, err
+			}
+			if outputs != "" {
+				pod.Annotations[common.AnnotationKeyOutputs] = outputs
+			}
+			updated, err := podcs.Update(ctx, &pod, metav1.UpdateOptions{})
+			if err != nil {
+				return nil, err
+			}
+			pod = *updated
+			pods[i] = pod
+		}
+		if err := woc.controller.podInformer.GetStore().Update(&pods[i]); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Annotations[common.AnnotationKeyNodeName] < pods[j].Annotations[common.AnnotationKeyNodeName]
+	})
+	return pods, nil
+}
+
+// simulatedOutputs returns the outputs annotation of the pod, with the defaults of the output parameters of its
+// template, and its output artifacts at placeholder keys, or an empty string if its template has neither
+func simulatedOutputs(pod *apiv1.Pod) (string, error) {
+	for _, c := range pod.Spec.Containers {
+		for _, e := range c.Env {
+			if e.Name != common.EnvVarTemplate {
+				continue
+			}
+			tmpl := &wfv1.Template{}
+			if err := json.Unmarshal([]byte(e.Value), tmpl); err != nil {
+				return "", err
+			}
+			outputs := wfv1.Outputs{}
+			for _, p := range tmpl.Outputs.Parameters {
+				if p.ValueFrom == nil {
+					continue
+				}
+				value := wfv1.AnyStringPtr("")
+				if p.ValueFrom.Default != nil {
+					value = p.ValueFrom.Default
+				}
+				outputs.Parameters = append(outputs.Parameters, wfv1.Parameter{Name: p.Name, Value: value})
+			}
+			for _, a := range tmpl.Outputs.Artifacts {
+				art, err := simulatedArtifact(tmpl, a)
+				if err != nil {
+					return "", err
+				}
+				outputs.Artifacts = append(outputs.Artifacts, art)
+			}
+			if !outputs.HasOutputs() {
+				return "", nil
+			}
+			return wfv1.MustMarshallJSON(outputs), nil
+		}
+	}
+	return "", nil
+}
+
+// simulatedArtifact returns the output artifact as the wait container would save it, at a placeholder key of the
+// template's archive location, if it has no key of its own. Nothing is saved at the key
+func simulatedArtifact(tmpl *wfv1.Template, art wfv1.Artifact) (wfv1.Artifact, error) {
+	if art.HasKey() || !tmpl.ArchiveLocation.HasLocation() {
+		return art, nil
+	}
+	key, err := tmpl.ArchiveLocation.GetKey()
+	if err != nil {
+		return art, err
+	}
+	location, err := tmpl.ArchiveLocation.Get()
+	if err != nil {
+		return art, err
+	}
+	if err := art.SetType(location); err != nil {
+		return art, err
+	}
+	fileName := art.Name + ".tgz"
+	if art.Archive != nil && art.Archive.None != nil {
+		fileName = path.Base(art.Path)
+	}
+	return art, art.SetKey(path.Join(key, fileName))
+}
+
+// simulationProgress returns a summary of the workflow, that changes as the workflow progresses
+func simulationProgress(wf *wfv1.Workflow, pods int) string {
+	var phases []string
+	for id, node := range wf.Status.Nodes {
+		phases = append(phases, id+"="+string(node.Phase))
+	}
+	sort.Strings(phases)
+	return fmt.Sprintf("%s %d %s", wf.Status.Phase, pods, strings.Join(phases, ","))
+}
+
+// simulationEventRecorderManager discards the events of the simulation
+type simulationEventRecorderManager struct{}
+
+func (simulationEventRecorderManager) Get(string) record.EventRecorder {
+	return &record.FakeRecorder{}
+}
@@ -0,0 +1,31 @@
+// Package simulation simulates workflows with a controller that has fake clients, rather than those of a cluster. The
+// fakes are in this package, rather than the controller's, so that only the programs that simulate workflows link them.
+package simulation
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller"
+)
+
+// Simulate simulates the workflow, as per controller.Simulate, with fake clients
+func Simulate(ctx context.Context, wf *wfv1.Workflow, opts controller.SimulateOpts) (*controller.Simulation, error) {
+	opts.NewClients = newFakeClients
+	return controller.Simulate(ctx, wf, opts)
+}
+
+// newFakeClients returns fake clients that have the objects
+func newFakeClients(objects ...runtime.Object) controller.SimulationClients {
+	return controller.SimulationClients{
+		Kube:     fake.NewSimpleClientset(),
+		Dynamic:  dynamicfake.NewSimpleDynamicClient(scheme.Scheme, objects...),
+		Workflow: fakewfclientset.NewSimpleClientset(objects...),
+	}
+}
@@ -0,0 +1,167 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller"
+)
+
+const simulatedWf = `
+metadata:
+  generateName: my-wf-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: list
+            template: list
+          - name: process
+            depends: list
+            template: process
+            arguments:
+              parameters:
+                - name: item
+                  value: "{{item}}"
+            withParam: "{{tasks.list.outputs.parameters.items}}"
+          - name: report
+            depends: process
+            template: process
+            arguments:
+              parameters:
+                - name: item
+                  value: all
+    - name: list
+      container:
+        image: my-image
+      outputs:
+        parameters:
+          - name: items
+            valueFrom:
+              path: /items.json
+              default: '["a", "b", "c"]'
+    - name: process
+      inputs:
+        parameters:
+          - name: item
+      container:
+        image: my-image
+`
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	opts := controller.SimulateOpts{Config: config.Config{Images: map[string]config.Image{"my-image": {Command: []string{"my-cmd"}}}}}
+	t.Run("Succeeded", func(t *testing.T) {
+		s, err := Simulate(ctx, wfv1.MustUnmarshalWorkflow(simulatedWf), opts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, s.Workflow.Status.Phase)
+			var pods []string
+			for _, p := range s.Pods {
+				assert.Equal(t, apiv1.PodSucceeded, p.Phase)
+				pods = append(pods, p.NodeName)
+			}
+			assert.Equal(t, []string{"my-wf.list", "my-wf.process(0:a)", "my-wf.process(1:b)", "my-wf.process(2:c)", "my-wf.report"}, pods)
+			assert.Equal(t, 1, s.Pods[0].Round)
+			assert.Equal(t, "list", s.Pods[0].TemplateName)
+			assert.Equal(t, s.Pods[1].Round, s.Pods[3].Round, "the items are processed at the same time")
+			assert.Less(t, s.Pods[3].Round, s.Pods[4].Round)
+		}
+	})
+	t.Run("PodFailed", func(t *testing.T) {
+		opts := opts
+		opts.PodPhase = func(pod *apiv1.Pod) apiv1.PodPhase {
+			if pod.Annotations[common.AnnotationKeyNodeName] == "my-wf.process(1:b)" {
+				return apiv1.PodFailed
+			}
+			return apiv1.PodSucceeded
+		}
+		s, err := Simulate(ctx, wfv1.MustUnmarshalWorkflow(simulatedWf), opts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowFailed, s.Workflow.Status.Phase)
+			assert.Len(t, s.Pods, 4, "the report is not run")
+		}
+	})
+	t.Run("Stalled", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      suspend: {}
+`)
+		s, err := Simulate(ctx, wf, opts)
+		assert.EqualError(t, err, "workflow stopped progressing after 2 rounds, it may be suspended, waiting for a retry's backoff, or for a template that does not run a pod")
+		if assert.NotNil(t, s) {
+			assert.Equal(t, wfv1.WorkflowRunning, s.Workflow.Status.Phase)
+		}
+	})
+	t.Run("Artifacts", func(t *testing.T) {
+		opts := opts
+		opts.Config.ArtifactRepository = wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}, KeyFormat: "my-key"}}
+		wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: produce
+            template: produce
+        - - name: consume
+            template: consume
+            arguments:
+              artifacts:
+                - name: in
+                  from: "{{steps.produce.outputs.artifacts.out}}"
+    - name: produce
+      container:
+        image: my-image
+      outputs:
+        artifacts:
+          - name: out
+            path: /out
+    - name: consume
+      inputs:
+        artifacts:
+          - name: in
+            path: /in
+      container:
+        image: my-image
+`)
+		s, err := Simulate(ctx, wf, opts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, s.Workflow.Status.Phase, s.Workflow.Status.Message)
+			node := s.Workflow.Status.Nodes.FindByDisplayName("produce")
+			if assert.NotNil(t, node) && assert.NotNil(t, node.Outputs) && assert.Len(t, node.Outputs.Artifacts, 1) {
+				art := node.Outputs.Artifacts[0]
+				assert.Equal(t, "out", art.Name)
+				if assert.NotNil(t, art.S3) {
+					assert.Equal(t, "my-key/out.tgz", art.S3.Key)
+				}
+			}
+		}
+	})
+	t.Run("PodNamespace", func(t *testing.T) {
+		opts := opts
+		opts.Config.EphemeralNamespaces = &config.EphemeralNamespaces{}
+		wf := wfv1.MustUnmarshalWorkflow(simulatedWf)
+		wf.UID = "my-uid"
+		s, err := Simulate(ctx, wf, opts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "argo-my-uid", s.Workflow.Status.PodNamespace)
+			assert.Equal(t, wfv1.WorkflowSucceeded, s.Workflow.Status.Phase)
+			assert.Len(t, s.Pods, 5)
+		}
+	})
+}
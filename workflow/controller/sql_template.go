@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func (woc *wfOperationCtx) executeSQLTemplate(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeSQL, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+	task := tmpl.DeepCopy()
+	if len(task.Outputs.Artifacts) > 0 {
+		// the agent saves the rows to the artifact repository, with the node's ID in place of the pod's name
+		if err := woc.addArchiveLocation(ctx, task); err != nil {
+			return node, err
+		}
+		data, err := json.Marshal(task.ArchiveLocation)
+		if err != nil {
+			return node, err
+		}
+		replaced, err := template.Replace(string(data), woc.globalParams.Merge(common.Parameters{common.LocalVarPodName: node.ID}), true)
+		if err != nil {
+			return node, err
+		}
+		if err := json.Unmarshal([]byte(replaced), &task.ArchiveLocation); err != nil {
+			return node, err
+		}
+	}
+	woc.taskSet[node.ID] = *task
+	return node, nil
+}
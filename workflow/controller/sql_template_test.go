@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestExecuteSQLTemplate(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: sql-template
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      sql:
+        driver: postgres
+        dsnSecret:
+          name: my-db
+          key: dsn
+        query: select id from items where workflow = $1
+        args:
+          - "{{workflow.name}}"
+      outputs:
+        artifacts:
+          - name: rows
+`)
+	ctx := context.Background()
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("sql-template")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeTypeSQL, node.Type)
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+	}
+	ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Get(ctx, "sql-template", v1.GetOptions{})
+	if assert.NoError(t, err) && assert.Len(t, ts.Spec.Tasks, 1) {
+		for _, tmpl := range ts.Spec.Tasks {
+			if assert.NotNil(t, tmpl.SQL) {
+				assert.Equal(t, []string{"sql-template"}, tmpl.SQL.Args)
+			}
+			if assert.NotNil(t, tmpl.ArchiveLocation) && assert.NotNil(t, tmpl.ArchiveLocation.S3) {
+				assert.Equal(t, "my-bucket", tmpl.ArchiveLocation.S3.Bucket)
+				assert.Equal(t, "sql-template/sql-template", tmpl.ArchiveLocation.S3.Key)
+			}
+		}
+	}
+	assert.True(t, woc.nodeRequiresTaskSetReconciliation("sql-template"))
+}
@@ -7,12 +7,12 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Knetic/govaluate"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logs"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
@@ -190,7 +190,7 @@ func (woc *wfOperationCtx) updateOutboundNodes(nodeName string, tmpl *wfv1.Templ
 		outbound = append(outbound, outboundNodeIDs...)
 	}
 	node := woc.wf.GetNodeByName(nodeName)
-	woc.log.Infof("Outbound nodes of %s is %s", node.ID, outbound)
+	woc.log.WithField(logs.FieldNodeID, node.ID).Infof("Outbound nodes of %s is %s", node.ID, outbound)
 	node.OutboundNodes = outbound
 	woc.wf.Status.Nodes[node.ID] = *node
 }
@@ -205,7 +205,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	}
 
 	// First, resolve any references to outputs from previous steps, and perform substitution
-	stepGroup, err := woc.resolveReferences(stepGroup, stepsCtx.scope)
+	stepGroup, err := woc.resolveReferences(ctx, stepGroup, stepsCtx.scope)
 	if err != nil {
 		return woc.markNodeError(sgNodeName, err)
 	}
@@ -227,7 +227,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
 
 		// Check the step's when clause to decide if it should execute
-		proceed, err := shouldExecute(step.When)
+		proceed, err := common.ShouldExecute(step.When)
 		if err != nil {
 			woc.initializeNode(childNodeName, wfv1.NodeTypeSkipped, stepTemplateScope, &step, stepsCtx.boundaryID, wfv1.NodeError, err.Error())
 			woc.addChildNode(sgNodeName, childNodeName)
@@ -301,7 +301,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		step := nodeSteps[childNode.Name]
 		if childNode.FailedOrError() && !step.ContinuesOn(childNode.Phase) {
 			failMessage := fmt.Sprintf("child '%s' failed", childNodeID)
-			woc.log.Infof("Step group node %s deemed failed: %s", node.ID, failMessage)
+			woc.log.WithField(logs.FieldNodeID, node.ID).Infof("Step group node %s deemed failed: %s", node.ID, failMessage)
 			return woc.markNodePhase(node.Name, wfv1.NodeFailed, failMessage)
 		}
 	}
@@ -309,45 +309,6 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(node.Name, wfv1.NodeSucceeded)
 }
 
-// shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
-func shouldExecute(when string) (bool, error) {
-	if when == "" {
-		return true, nil
-	}
-	expression, err := govaluate.NewEvaluableExpression(when)
-	if err != nil {
-		if strings.Contains(err.Error(), "Invalid token") {
-			return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v (hint: try wrapping the affected expression in quotes (\"))", when, err)
-		}
-		return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': %v", when, err)
-	}
-	// The following loop converts govaluate variables (which we don't use), into strings. This
-	// allows us to have expressions like: "foo != bar" without requiring foo and bar to be quoted.
-	tokens := expression.Tokens()
-	for i, tok := range tokens {
-		switch tok.Kind {
-		case govaluate.VARIABLE:
-			tok.Kind = govaluate.STRING
-		default:
-			continue
-		}
-		tokens[i] = tok
-	}
-	expression, err = govaluate.NewEvaluableExpressionFromTokens(tokens)
-	if err != nil {
-		return false, errors.InternalWrapErrorf(err, "Failed to parse 'when' expression '%s': %v", when, err)
-	}
-	result, err := expression.Evaluate(nil)
-	if err != nil {
-		return false, errors.InternalWrapErrorf(err, "Failed to evaluate 'when' expresion '%s': %v", when, err)
-	}
-	boolRes, ok := result.(bool)
-	if !ok {
-		return false, errors.Errorf(errors.CodeBadRequest, "Expected boolean evaluation for '%s'. Got %v", when, result)
-	}
-	return boolRes, nil
-}
-
 // resolveReferences replaces any references to outputs of previous steps, or artifacts in the inputs
 // NOTE: by now, input parameters should have been substituted throughout the template, so we only
 // are concerned with:
@@ -356,7 +317,8 @@ func shouldExecute(when string) (bool, error) {
 // 3) dereferencing output.exitCode from previous steps
 // 4) dereferencing artifacts from previous steps
 // 5) dereferencing artifacts from inputs
-func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scope *wfScope) ([]wfv1.WorkflowStep, error) {
+// 6) reading the items of withArtifact
+func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wfv1.WorkflowStep, scope *wfScope) ([]wfv1.WorkflowStep, error) {
 	newStepGroup := make([]wfv1.WorkflowStep, len(stepGroup))
 
 	// Step 0: replace all parameter scope references for volumes
@@ -384,7 +346,7 @@ func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scop
 
 		// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 		// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
-		proceed, err := shouldExecute(newStep.When)
+		proceed, err := common.ShouldExecute(newStep.When)
 		if err != nil {
 			// If we got an error, it might be because our "when" clause contains a task-expansion parameter (e.g. {{item}}).
 			// Since we don't perform task-expansion until later and task-expansion parameters won't get resolved here,
@@ -418,6 +380,15 @@ func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scop
 			newStep.Arguments.Artifacts[j] = *resolvedArt
 		}
 
+		// Step 3: read the items of the artifact, which are then expanded like those of a parameter
+		if newStep.WithArtifact != "" {
+			newStep.WithParam, err = woc.artifactItems(ctx, scope, newStep.WithArtifact)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read withArtifact: %w", err)
+			}
+			newStep.WithArtifact = ""
+		}
+
 		newStepGroup[i] = newStep
 	}
 	return newStepGroup, nil
@@ -558,6 +529,9 @@ func (woc *wfOperationCtx) prepareMetricScope(node *wfv1.NodeStatus) (map[string
 		if node.Outputs.ExitCode != nil {
 			localScope[common.LocalVarExitCode] = *node.Outputs.ExitCode
 		}
+		if node.Outputs.Stderr != nil {
+			localScope["outputs.stderr"] = *node.Outputs.Stderr
+		}
 		for _, param := range node.Outputs.Parameters {
 			key := fmt.Sprintf("outputs.parameters.%s", param.Name)
 			if param.Value == nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -314,3 +315,50 @@ func TestOptionalArgumentAndParameter(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
 }
+
+var optionalOutputArtifactExists = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: optional-output-artifact
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: generate
+            template: generate
+      outputs:
+        parameters:
+          - name: has-report
+            valueFrom:
+              expression: "exists(steps.generate.outputs.artifacts.report) ? 'yes' : 'no'"
+    - name: generate
+      container:
+        image: argoproj/argosay:v2
+      outputs:
+        artifacts:
+          - name: report
+            path: /tmp/report.txt
+            optional: true
+            when: "false"
+`
+
+func TestOptionalOutputArtifactExists(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(optionalOutputArtifactExists)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	// the executor does not save the artifact, so it has no location
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(`{"artifacts": [{"name": "report", "path": "/tmp/report.txt", "optional": true, "when": "false"}]}`))
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+	if assert.NotNil(t, node.Outputs) && assert.Len(t, node.Outputs.Parameters, 1) {
+		assert.Equal(t, "no", node.Outputs.Parameters[0].Value.String())
+	}
+}
@@ -27,11 +27,25 @@ func (woc *wfOperationCtx) patchTaskSet(ctx context.Context, patch interface{},
 	return nil
 }
 
-func (woc *wfOperationCtx) getDeleteTaskAndNodePatch() map[string]interface{} {
+// getDeleteTaskAndNodePatch returns a patch that prunes the tasks and results of the task set's nodes that have completed,
+// and the number of nodes pruned. Only nodes still in the task set are pruned, so the patch does not grow with the
+// workflow.
+func (woc *wfOperationCtx) getDeleteTaskAndNodePatch() (map[string]interface{}, int, error) {
+	taskSet, err := woc.getWorkflowTaskSet()
+	if err != nil {
+		return nil, 0, err
+	}
 	deletedNode := make(map[string]interface{})
-	for _, node := range woc.wf.Status.Nodes {
-		if (node.Type == wfv1.NodeTypeHTTP || node.Type == wfv1.NodeTypePlugin) && node.Fulfilled() {
-			deletedNode[node.ID] = nil
+	if taskSet != nil {
+		for _, node := range woc.wf.Status.Nodes {
+			if !taskSetNode(node) || !node.Fulfilled() {
+				continue
+			}
+			_, hasTask := taskSet.Spec.Tasks[node.ID]
+			_, hasResult := taskSet.Status.Nodes[node.ID]
+			if hasTask || hasResult {
+				deletedNode[node.ID] = nil
+			}
 		}
 	}
 
@@ -44,10 +58,11 @@ func (woc *wfOperationCtx) getDeleteTaskAndNodePatch() map[string]interface{} {
 			"nodes": deletedNode,
 		},
 	}
-	return patch
+	return patch, len(deletedNode), nil
 }
+
 func taskSetNode(n wfv1.NodeStatus) bool {
-	return n.Type == wfv1.NodeTypeHTTP || n.Type == wfv1.NodeTypePlugin
+	return n.Type == wfv1.NodeTypeHTTP || n.Type == wfv1.NodeTypeGRPC || n.Type == wfv1.NodeTypeSQL || n.Type == wfv1.NodeTypeNotification || n.Type == wfv1.NodeTypePlugin
 }
 
 func (woc *wfOperationCtx) hasTaskSetNodes() bool {
@@ -58,20 +73,38 @@ func (woc *wfOperationCtx) removeCompletedTaskSetStatus(ctx context.Context) err
 	if !woc.hasTaskSetNodes() {
 		return nil
 	}
-	return woc.patchTaskSet(ctx, woc.getDeleteTaskAndNodePatch(), types.MergePatchType)
+	patch, pruned, err := woc.getDeleteTaskAndNodePatch()
+	if err != nil {
+		return err
+	}
+	if pruned == 0 {
+		return nil
+	}
+	if err := woc.patchTaskSet(ctx, patch, types.MergePatchType); err != nil {
+		return err
+	}
+	woc.controller.metrics.TaskSetResultsPruned(pruned)
+	return nil
 }
 
 func (woc *wfOperationCtx) completeTaskSet(ctx context.Context) error {
 	if !woc.hasTaskSetNodes() {
 		return nil
 	}
-	patch := woc.getDeleteTaskAndNodePatch()
+	patch, pruned, err := woc.getDeleteTaskAndNodePatch()
+	if err != nil {
+		return err
+	}
 	patch["metadata"] = metav1.ObjectMeta{
 		Labels: map[string]string{
 			common.LabelKeyCompleted: "true",
 		},
 	}
-	return woc.patchTaskSet(ctx, patch, types.MergePatchType)
+	if err := woc.patchTaskSet(ctx, patch, types.MergePatchType); err != nil {
+		return err
+	}
+	woc.controller.metrics.TaskSetResultsPruned(pruned)
+	return nil
 }
 
 func (woc *wfOperationCtx) getWorkflowTaskSet() (*wfv1.WorkflowTaskSet, error) {
@@ -123,13 +156,36 @@ func (woc *wfOperationCtx) reconcileTaskSet(ctx context.Context) error {
 	}
 
 	woc.log.Info("TaskSet Reconciliation")
+	if workflowTaskSet != nil {
+		if data, err := json.Marshal(workflowTaskSet); err == nil {
+			woc.controller.metrics.TaskSetReconciled(len(data))
+		}
+	}
 	if workflowTaskSet != nil && len(workflowTaskSet.Status.Nodes) > 0 {
 		for nodeID, taskResult := range workflowTaskSet.Status.Nodes {
 			node := woc.wf.Status.Nodes[nodeID]
+			// the result has already been reconciled, but not yet pruned from the task set
+			if node.Fulfilled() {
+				continue
+			}
+
+			outputs := taskResult.Outputs.DeepCopy()
+			phase, message := taskResult.Phase, taskResult.Message
+			if taskResult.OutputsOffloaded {
+				var err error
+				outputs, err = woc.getOffloadedTaskOutputs(ctx, nodeID)
+				if apierr.IsNotFound(err) {
+					phase, message = wfv1.NodeError, "offloaded outputs not found"
+				} else if err != nil {
+					// try again next time
+					woc.log.WithError(err).WithField("nodeID", nodeID).Warn("failed to get offloaded outputs")
+					continue
+				}
+			}
 
-			node.Outputs = taskResult.Outputs.DeepCopy()
-			node.Phase = taskResult.Phase
-			node.Message = taskResult.Message
+			node.Outputs = outputs
+			node.Phase = phase
+			node.Message = message
 			node.FinishedAt = metav1.Now()
 
 			woc.wf.Status.Nodes[nodeID] = node
@@ -139,6 +195,20 @@ func (woc *wfOperationCtx) reconcileTaskSet(ctx context.Context) error {
 	return woc.createTaskSet(ctx)
 }
 
+// getOffloadedTaskOutputs gets the outputs the agent offloaded to a config map, because they were too large to store in
+// the task set
+func (woc *wfOperationCtx) getOffloadedTaskOutputs(ctx context.Context, nodeID string) (*wfv1.Outputs, error) {
+	cm, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).Get(ctx, common.TaskResultConfigMapName(nodeID), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	outputs := &wfv1.Outputs{}
+	if err := json.Unmarshal([]byte(cm.Data[common.TaskResultConfigMapKey]), outputs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal offloaded outputs: %w", err)
+	}
+	return outputs, nil
+}
+
 func (woc *wfOperationCtx) createTaskSet(ctx context.Context) error {
 	if len(woc.taskSet) == 0 {
 		return nil
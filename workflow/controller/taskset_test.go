@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -288,12 +289,10 @@ status:
 
     `, &ts)
 	t.Run("RemoveCompletedTaskSetStatus", func(t *testing.T) {
-		cancel, controller := newController(wf, ts)
+		cancel, controller := newController(wf, &ts)
 		defer cancel()
-		_, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Create(ctx, &ts, v1.CreateOptions{})
-		assert.NoError(t, err)
 		woc := newWorkflowOperationCtx(wf, controller)
-		err = woc.removeCompletedTaskSetStatus(ctx)
+		err := woc.removeCompletedTaskSetStatus(ctx)
 		assert.NoError(t, err)
 		tslist, err := woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").List(ctx, v1.ListOptions{})
 		assert.NoError(t, err)
@@ -333,3 +332,72 @@ func TestNonHTTPTemplateScenario(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestReconcileTaskSet(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http-template
+  namespace: default
+spec:
+  entrypoint: http
+  templates:
+    - name: http
+      http:
+        url: http://my-url
+status:
+  phase: Running
+  nodes:
+    offloaded:
+      id: offloaded
+      type: HTTP
+      phase: Pending
+    reconciled:
+      id: reconciled
+      type: HTTP
+      phase: Succeeded
+      message: my-message
+    missing:
+      id: missing
+      type: HTTP
+      phase: Pending
+`)
+	ts := &wfv1.WorkflowTaskSet{
+		ObjectMeta: v1.ObjectMeta{Name: "http-template", Namespace: "default"},
+		Status: wfv1.WorkflowTaskSetStatus{Nodes: map[string]wfv1.NodeResult{
+			"offloaded":  {Phase: wfv1.NodeSucceeded, OutputsOffloaded: true},
+			"reconciled": {Phase: wfv1.NodeFailed, Message: "other-message"},
+			"missing":    {Phase: wfv1.NodeSucceeded, OutputsOffloaded: true},
+		}},
+	}
+	cancel, controller := newController(wf, ts)
+	defer cancel()
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &apiv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: common.TaskResultConfigMapName("offloaded")},
+		Data:       map[string]string{common.TaskResultConfigMapKey: `{"result": "my-result"}`},
+	}, v1.CreateOptions{})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	err = woc.reconcileTaskSet(ctx)
+	if assert.NoError(t, err) {
+		offloaded := woc.wf.Status.Nodes["offloaded"]
+		assert.Equal(t, wfv1.NodeSucceeded, offloaded.Phase)
+		if assert.NotNil(t, offloaded.Outputs) {
+			assert.Equal(t, "my-result", *offloaded.Outputs.Result)
+		}
+		reconciled := woc.wf.Status.Nodes["reconciled"]
+		assert.Equal(t, wfv1.NodeSucceeded, reconciled.Phase, "a reconciled result is not reconciled again")
+		assert.Equal(t, "my-message", reconciled.Message)
+		missing := woc.wf.Status.Nodes["missing"]
+		assert.Equal(t, wfv1.NodeError, missing.Phase)
+		assert.Equal(t, "offloaded outputs not found", missing.Message)
+	}
+	t.Run("Prune", func(t *testing.T) {
+		patch, pruned, err := woc.getDeleteTaskAndNodePatch()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 3, pruned)
+			assert.Len(t, patch["status"].(map[string]interface{})["nodes"], 3)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// checkTemplateDepth returns an error if executing the template within the boundary would nest steps, and DAG,
+// templates more deeply than the controller's maximum template depth
+func (woc *wfOperationCtx) checkTemplateDepth(tmplHolder wfv1.TemplateReferenceHolder, boundaryID string) error {
+	maxDepth := woc.controller.Config.MaxTemplateDepth
+	if maxDepth <= 0 {
+		return nil
+	}
+	path := []string{templateName(tmplHolder.GetTemplateName(), tmplHolder.GetTemplateRef())}
+	for id := boundaryID; id != ""; {
+		node, ok := woc.wf.Status.Nodes[id]
+		if !ok {
+			break
+		}
+		path = append(path, templateName(node.TemplateName, node.TemplateRef))
+		id = node.BoundaryID
+	}
+	if len(path) <= maxDepth {
+		return nil
+	}
+	// the path is from the template to the entrypoint, reverse it so it is in the order the templates were called
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return fmt.Errorf("maximum template depth of %d exceeded, the template call path ends with %s", maxDepth, describeTemplateCallPath(path))
+}
+
+// describeTemplateCallPath describes the cycle of templates at the end of the path, or, if there is none, the last
+// templates of the path
+func describeTemplateCallPath(path []string) string {
+	last := len(path) - 1
+	for i := last - 1; i >= 0; i-- {
+		if path[i] == path[last] {
+			return "the cycle " + strings.Join(path[i:], " -> ")
+		}
+	}
+	if len(path) > 10 {
+		path = path[len(path)-10:]
+	}
+	return strings.Join(path, " -> ")
+}
+
+// templateName returns the name of a template, or, for a template of a workflow template, the names of both
+func templateName(name string, ref *wfv1.TemplateRef) string {
+	if ref != nil {
+		return ref.Name + "/" + ref.Template
+	}
+	if name == "" {
+		return "inline"
+	}
+	return name
+}
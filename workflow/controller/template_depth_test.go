@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestDescribeTemplateCallPath(t *testing.T) {
+	assert.Equal(t, "the cycle b -> c -> b", describeTemplateCallPath([]string{"main", "a", "b", "c", "b"}))
+	assert.Equal(t, "the cycle main -> main", describeTemplateCallPath([]string{"main", "main"}))
+	assert.Equal(t, "main -> a", describeTemplateCallPath([]string{"main", "a"}))
+	assert.Equal(t, "1 -> 2 -> 3 -> 4 -> 5 -> 6 -> 7 -> 8 -> 9 -> 10", describeTemplateCallPath([]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}))
+}
+
+func TestTemplateName(t *testing.T) {
+	assert.Equal(t, "main", templateName("main", nil))
+	assert.Equal(t, "my-wftmpl/main", templateName("", &wfv1.TemplateRef{Name: "my-wftmpl", Template: "main"}))
+	assert.Equal(t, "inline", templateName("", nil))
+}
+
+var recursiveTemplate = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: recursive
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: again
+            template: again
+    - name: again
+      steps:
+        - - name: main
+            template: main
+            when: "{{workflow.name}} == recursive"
+`
+
+func TestMaxTemplateDepth(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(recursiveTemplate)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.MaxTemplateDepth = 5
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes.Find(func(node wfv1.NodeStatus) bool {
+		return node.Phase == wfv1.NodeError && node.Type != wfv1.NodeTypeStepGroup
+	})
+	if assert.NotNil(t, node) {
+		assert.Equal(t, "maximum template depth of 5 exceeded, the template call path ends with the cycle again -> main -> again", node.Message)
+	}
+	steps := 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeSteps {
+			steps++
+		}
+	}
+	assert.Equal(t, 5, steps)
+}
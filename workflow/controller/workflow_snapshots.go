@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// workflowSnapshotGCPeriod is how often the expired snapshots of workflows are deleted
+var workflowSnapshotGCPeriod = env.LookupEnvDurationOr("WORKFLOW_SNAPSHOT_GC_PERIOD", 10*time.Minute)
+
+// workflowSnapshotter snapshots the status of each workflow at most once an interval, and once it completes
+type workflowSnapshotter struct {
+	repo     sqldb.WorkflowSnapshotRepo
+	interval time.Duration
+	mutex    sync.Mutex
+	// the time each workflow that has not completed was last snapshot
+	lastSnapshots map[types.UID]time.Time
+	// when the times that are over an interval ago are next removed, so deleted workflows are forgotten
+	nextPrune time.Time
+}
+
+func newWorkflowSnapshotter(repo sqldb.WorkflowSnapshotRepo, interval time.Duration) *workflowSnapshotter {
+	return &workflowSnapshotter{repo: repo, interval: interval, lastSnapshots: make(map[types.UID]time.Time)}
+}
+
+// snapshot saves the status of the workflow, which must be hydrated, if it is due a snapshot. It does nothing if
+// snapshots are disabled. The snapshot is saved without holding the lock, so workers are not serialized on the database.
+func (s *workflowSnapshotter) snapshot(wf *wfv1.Workflow, now time.Time) {
+	if s == nil || !s.due(wf, now) {
+		return
+	}
+	if err := s.repo.Save(wf, now); err != nil {
+		log.WithFields(log.Fields{"namespace": wf.Namespace, "name": wf.Name}).WithError(err).Warn("failed to snapshot workflow")
+		s.mutex.Lock()
+		// so it is retried the next time the workflow is updated
+		delete(s.lastSnapshots, wf.UID)
+		s.mutex.Unlock()
+	}
+}
+
+// due returns whether the workflow is due a snapshot, and if so, records that it was snapshot now
+func (s *workflowSnapshotter) due(wf *wfv1.Workflow, now time.Time) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if now.After(s.nextPrune) {
+		// a workflow last snapshot over an interval ago is due a snapshot anyway
+		for uid, last := range s.lastSnapshots {
+			if now.Sub(last) >= s.interval {
+				delete(s.lastSnapshots, uid)
+			}
+		}
+		s.nextPrune = now.Add(s.interval)
+	}
+	completed := wf.Status.Fulfilled()
+	if last, ok := s.lastSnapshots[wf.UID]; ok && !completed && now.Sub(last) < s.interval {
+		return false
+	}
+	if completed {
+		delete(s.lastSnapshots, wf.UID)
+	} else {
+		s.lastSnapshots[wf.UID] = now
+	}
+	return true
+}
+
+// deleteExpired deletes the snapshots that are older than the TTL. It does nothing if snapshots are disabled.
+func (s *workflowSnapshotter) deleteExpired(now time.Time) {
+	if s == nil {
+		return
+	}
+	if err := s.repo.DeleteExpired(now); err != nil {
+		log.WithError(err).Error("Failed to delete expired workflow snapshots")
+	}
+}
+
+// deleteExpiredWorkflowSnapshots deletes the expired snapshots of workflows, if snapshots are enabled
+func (wfc *WorkflowController) deleteExpiredWorkflowSnapshots(context.Context) {
+	wfc.wfSnapshotter.deleteExpired(time.Now())
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type fakeWorkflowSnapshotRepo struct {
+	sqldb.WorkflowSnapshotRepo
+	saved   []wfv1.WorkflowPhase
+	saveErr error
+}
+
+func (r *fakeWorkflowSnapshotRepo) Save(wf *wfv1.Workflow, _ time.Time) error {
+	r.saved = append(r.saved, wf.Status.Phase)
+	return r.saveErr
+}
+
+func TestWorkflowSnapshotter(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		var s *workflowSnapshotter
+		s.snapshot(&wfv1.Workflow{}, time.Now())
+	})
+	repo := &fakeWorkflowSnapshotRepo{}
+	s := newWorkflowSnapshotter(repo, time.Minute)
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{UID: "my-uid"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}}
+	now := time.Now()
+	s.snapshot(wf, now)
+	// not due another snapshot
+	s.snapshot(wf, now.Add(30*time.Second))
+	s.snapshot(wf, now.Add(time.Minute))
+	assert.Equal(t, []wfv1.WorkflowPhase{wfv1.WorkflowRunning, wfv1.WorkflowRunning}, repo.saved)
+	// completed workflows are always snapshot, and then forgotten
+	wf.Status.Phase = wfv1.WorkflowSucceeded
+	s.snapshot(wf, now.Add(70*time.Second))
+	assert.Equal(t, []wfv1.WorkflowPhase{wfv1.WorkflowRunning, wfv1.WorkflowRunning, wfv1.WorkflowSucceeded}, repo.saved)
+	assert.Empty(t, s.lastSnapshots)
+	t.Run("SaveFailed", func(t *testing.T) {
+		repo := &fakeWorkflowSnapshotRepo{saveErr: errors.New("db is down")}
+		s := newWorkflowSnapshotter(repo, time.Minute)
+		wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{UID: "my-uid"}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}}
+		s.snapshot(wf, now)
+		// the failed snapshot is retried the next time
+		s.snapshot(wf, now.Add(time.Second))
+		assert.Len(t, repo.saved, 2)
+	})
+}
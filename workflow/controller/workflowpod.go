@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/utils/pointer"
 
@@ -23,6 +26,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -121,11 +125,18 @@ func (woc *wfOperationCtx) scheduleOnDifferentHost(node *wfv1.NodeStatus, pod *a
 type createWorkflowPodOpts struct {
 	includeScriptOutput bool
 	onExitPod           bool
+	onShutdownPod       bool
 	executionDeadline   time.Time
+	// scriptSourcePath is the path the script's source is written to, if it is not common.ExecutorScriptSourcePath
+	scriptSourcePath string
+	// retryNodeName is the name of the node's retry node, if it has one
+	retryNodeName string
 }
 
 func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName string, mainCtrs []apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (*apiv1.Pod, error) {
 	nodeID := woc.wf.NodeID(nodeName)
+	ctx, span := tracing.StartSpan(ctx, "createWorkflowPod", append(tracing.WorkflowAttributes(woc.wf.Namespace, woc.wf.Name, woc.wf.UID), tracing.KeyNodeID.String(nodeID))...)
+	defer span.End()
 
 	// we must check to see if the pod exists rather than just optimistically creating the pod and see if we get
 	// an `AlreadyExists` error because we won't get that error if there is not enough resources.
@@ -140,12 +151,26 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return existing, nil
 	}
 
-	if !woc.GetShutdownStrategy().ShouldExecute(opts.onExitPod) {
+	if !opts.onShutdownPod && !woc.GetShutdownStrategy().ShouldExecute(opts.onExitPod) {
 		// Do not create pods if we are shutting down
 		woc.markNodePhase(nodeName, wfv1.NodeSkipped, fmt.Sprintf("workflow shutdown with strategy: %s", woc.GetShutdownStrategy()))
 		return nil, nil
 	}
 
+	if err := woc.checkFreezeWindows(); err != nil {
+		return nil, err
+	}
+
+	faults := woc.selectFaults(tmpl, nodeID)
+	if err := woc.checkPodStartDelay(faults, nodeName); err != nil {
+		return nil, err
+	}
+
+	// the executor provisions the pipes when it starts each container
+	if len(tmpl.Pipes) > 0 && woc.getContainerRuntimeExecutor() != common.ContainerRuntimeExecutorEmissary {
+		return nil, errors.Errorf(errors.CodeBadRequest, "template has pipes, so you must use the emissary executor rather than %q", woc.getContainerRuntimeExecutor())
+	}
+
 	tmpl = tmpl.DeepCopy()
 	wfSpec := woc.execWf.Spec.DeepCopy()
 
@@ -205,7 +230,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	pod := &apiv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      util.PodName(woc.wf.Name, nodeName, tmpl.Name, nodeID, util.GetPodNameVersion()),
-			Namespace: woc.wf.ObjectMeta.Namespace,
+			Namespace: woc.wf.GetPodNamespace(),
 			Labels: map[string]string{
 				common.LabelKeyWorkflow:  woc.wf.ObjectMeta.Name, // Allows filtering by pods related to specific workflow
 				common.LabelKeyCompleted: "false",                // Allows filtering by incomplete workflow pods
@@ -226,20 +251,40 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		},
 	}
 
+	if woc.wf.Status.PodNamespace != "" {
+		// owners must be in the same namespace, so the pods are deleted with the ephemeral namespace instead
+		pod.OwnerReferences = nil
+		pod.Labels[common.LabelKeyWorkflowNamespace] = woc.wf.Namespace
+	}
+
+	if woc.controller.podStatusCaptureFinalizer {
+		// the finalizer is removed once the pod's status is recorded, so it cannot be garbage collected before then
+		pod.Finalizers = append(pod.Finalizers, common.FinalizerPodStatus)
+	}
+
 	if opts.onExitPod {
 		// This pod is part of an onExit handler, label it so
 		pod.ObjectMeta.Labels[common.LabelKeyOnExit] = "true"
 	}
 
+	if opts.onShutdownPod {
+		// This pod is part of an onShutdown hook, label it so
+		pod.ObjectMeta.Labels[common.LabelKeyOnShutdown] = "true"
+	}
+
 	if woc.execWf.Spec.HostNetwork != nil {
 		pod.Spec.HostNetwork = *woc.execWf.Spec.HostNetwork
 	}
 
-	if woc.execWf.Spec.DNSPolicy != nil {
+	if tmpl.DNSPolicy != nil {
+		pod.Spec.DNSPolicy = *tmpl.DNSPolicy
+	} else if woc.execWf.Spec.DNSPolicy != nil {
 		pod.Spec.DNSPolicy = *woc.execWf.Spec.DNSPolicy
 	}
 
-	if woc.execWf.Spec.DNSConfig != nil {
+	if tmpl.DNSConfig != nil {
+		pod.Spec.DNSConfig = tmpl.DNSConfig
+	} else if woc.execWf.Spec.DNSConfig != nil {
 		pod.Spec.DNSConfig = woc.execWf.Spec.DNSConfig
 	}
 
@@ -250,7 +295,15 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.ShareProcessNamespace = pointer.BoolPtr(true)
 	}
 
-	woc.addArchiveLocation(tmpl)
+	err = woc.addCheckpoint(ctx, nodeName, tmpl, opts.retryNodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = woc.addArchiveLocation(ctx, tmpl)
+	if err != nil {
+		return nil, err
+	}
 
 	err = woc.setupServiceAccount(ctx, pod, tmpl)
 	if err != nil {
@@ -281,17 +334,29 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	}
 	pod.ObjectMeta.Annotations[common.AnnotationKeyDefaultContainer] = defaultContainer
 
-	// Add init container only if it needs input artifacts. This is also true for
+	// Add init container only if it needs input artifacts, or secrets. This is also true for
 	// script templates (which needs to populate the script)
-	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript || woc.getContainerRuntimeExecutor() == common.ContainerRuntimeExecutorEmissary {
+	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.Inputs.HasExternalSecrets() || tmpl.GetType() == wfv1.TemplateTypeScript || woc.getContainerRuntimeExecutor() == common.ContainerRuntimeExecutorEmissary {
 		initCtr := woc.newInitContainer(tmpl)
 		pod.Spec.InitContainers = []apiv1.Container{initCtr}
 	}
 
 	addSchedulingConstraints(pod, wfSpec, tmpl)
+	if err := woc.addSchedulingPresets(pod, tmpl); err != nil {
+		return nil, err
+	}
+	if err := woc.addSpotConstraints(pod, tmpl); err != nil {
+		return nil, err
+	}
+	woc.addSandbox(pod, tmpl)
 	woc.addMetadata(pod, tmpl)
 
-	err = addVolumeReferences(pod, woc.volumes, tmpl, woc.wf.Status.PersistentVolumeClaims)
+	secretProviders, err := woc.getSecretProviders(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addVolumeReferences(pod, woc.volumes, tmpl, woc.wf.Status.PersistentVolumeClaims, secretProviders)
 	if err != nil {
 		return nil, err
 	}
@@ -305,10 +370,15 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		addScriptStagingVolume(pod)
 	}
 
+	if tmpl.Inputs.HasExternalSecrets() {
+		addExternalSecretsVolume(pod, secretProviders)
+	}
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	addInitContainers(pod, tmpl)
 	addSidecars(pod, tmpl)
+	addTemplateEnv(pod, tmpl)
 	addOutputArtifactsVolumes(pod, tmpl)
 
 	for i, c := range pod.Spec.InitContainers {
@@ -323,6 +393,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		{Name: common.EnvVarDeadline, Value: woc.getDeadline(opts).Format(time.RFC3339)},
 		{Name: common.EnvVarProgressFile, Value: common.ArgoProgressPath},
 	}
+	if opts.scriptSourcePath != "" {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarScriptSourcePath, Value: opts.scriptSourcePath})
+	}
 
 	// only set tick durations if progress is enabled. The EnvVarProgressFile is always set (user convenience) but the
 	// progress is only monitored if the tick durations are >0.
@@ -339,6 +412,25 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		)
 	}
 
+	if c := woc.controller.Config.Heartbeats; c != nil {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarHeartbeatInterval, Value: c.GetInterval().String()})
+	}
+	if c := woc.controller.Config.OutputLimits; c != nil {
+		if c.MaxParameterSize > 0 {
+			envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarMaxOutputParameterSize, Value: strconv.Itoa(c.MaxParameterSize)})
+		}
+		if c.MaxArtifacts > 0 {
+			envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarMaxOutputArtifacts, Value: strconv.Itoa(c.MaxArtifacts)})
+		}
+	}
+	if n := woc.controller.Config.ArtifactParallelism; n > 1 {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarArtifactParallelism, Value: strconv.Itoa(n)})
+	}
+	if woc.hasAutoMemoization() {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarDigestArtifacts, Value: "true"})
+	}
+	envVars = append(envVars, injectFaults(pod, faults)...)
+
 	for i, c := range pod.Spec.InitContainers {
 		c.Env = append(c.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: c.Name})
 		c.Env = append(c.Env, envVars...)
@@ -417,6 +509,17 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		}
 	}
 
+	if err := woc.addPlatforms(pod, tmpl); err != nil {
+		return nil, err
+	}
+	// images that are parameters are only known now, so they could not be checked when the workflow was validated
+	if err := woc.checkImages(pod); err != nil {
+		return nil, err
+	}
+	if err := woc.controller.Config.PodTuning.Check(pod.Spec); err != nil {
+		return nil, errors.Errorf(errors.CodeForbidden, "%v", err)
+	}
+
 	for i, c := range pod.Spec.Containers {
 		if woc.getContainerRuntimeExecutor() == common.ContainerRuntimeExecutorEmissary && c.Name != common.WaitContainerName {
 			// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
@@ -456,13 +559,23 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.ActiveDeadlineSeconds = &newActiveDeadlineSeconds
 	}
 
+	if err := woc.checkPodCreationBackpressure(pod); err != nil {
+		return nil, err
+	}
+
 	if !woc.controller.rateLimiter.Allow() {
 		return nil, ErrResourceRateLimitReached
 	}
 
+	if err := woc.createNetworkPolicy(ctx, pod, tmpl); err != nil {
+		return nil, err
+	}
+
+	addTracingEnvVars(ctx, pod)
+
 	woc.log.Debugf("Creating Pod: %s (%s)", nodeName, pod.Name)
 
-	created, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	created, err := woc.controller.kubeclientset.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
 			// workflow pod names are deterministic. We can get here if the
@@ -478,11 +591,12 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	}
 	woc.log.Infof("Created pod: %s (%s)", nodeName, created.Name)
 	woc.activePods++
+	woc.createdPods++
 	return created, nil
 }
 
 func (woc *wfOperationCtx) podExists(nodeID string) (existing *apiv1.Pod, exists bool, err error) {
-	objs, err := woc.controller.podInformer.GetIndexer().ByIndex(indexes.NodeIDIndex, woc.wf.Namespace+"/"+nodeID)
+	objs, err := woc.controller.podInformer.GetIndexer().ByIndex(indexes.NodeIDIndex, woc.wf.GetPodNamespace()+"/"+nodeID)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to get pod from informer store: %w", err)
 	}
@@ -629,6 +743,19 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 			Name:  common.EnvVarWorkflowName,
 			Value: woc.wf.Name,
 		},
+		{
+			Name:  common.EnvVarWorkflowUID,
+			Value: string(woc.wf.UID),
+		},
+		{
+			Name: common.EnvVarNodeID,
+			ValueFrom: &apiv1.EnvVarSource{
+				FieldRef: &apiv1.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  "metadata.annotations['" + common.AnnotationKeyNodeID + "']",
+				},
+			},
+		},
 	}
 	if woc.controller.Config.Executor != nil {
 		execEnvVars = append(execEnvVars, woc.controller.Config.Executor.Env...)
@@ -657,6 +784,56 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 	return execEnvVars
 }
 
+// addTracingEnvVars adds the environment variables that export the spans of the init and wait containers, as children
+// of the span in the context, to them, unless they are already set, e.g. by the executor config
+func addTracingEnvVars(ctx context.Context, pod *apiv1.Pod) {
+	envVars := tracingEnvVars(ctx)
+	if len(envVars) == 0 {
+		return
+	}
+	add := func(c *apiv1.Container) {
+		set := map[string]bool{}
+		for _, e := range c.Env {
+			set[e.Name] = true
+		}
+		for _, e := range envVars {
+			if !set[e.Name] {
+				c.Env = append(c.Env, e)
+			}
+		}
+	}
+	for i, c := range pod.Spec.InitContainers {
+		if c.Name == common.InitContainerName {
+			add(&pod.Spec.InitContainers[i])
+		}
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name == common.WaitContainerName {
+			add(&pod.Spec.Containers[i])
+		}
+	}
+}
+
+// tracingEnvVars returns the environment variables that export the spans of an executor, as children of the span in
+// the context, or none if there is not one
+func tracingEnvVars(ctx context.Context) []apiv1.EnvVar {
+	traceParent := tracing.TraceParent(ctx)
+	if traceParent == "" {
+		return nil
+	}
+	envVars := []apiv1.EnvVar{{Name: tracing.EnvVarTraceParent, Value: traceParent}}
+	exporterEnv := tracing.ExporterEnv()
+	keys := make([]string, 0, len(exporterEnv))
+	for k := range exporterEnv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		envVars = append(envVars, apiv1.EnvVar{Name: k, Value: exporterEnv[k]})
+	}
+	return envVars
+}
+
 func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 	var volumes []apiv1.Volume
 	if woc.controller.Config.KubeConfig != nil {
@@ -742,6 +919,9 @@ func isResourcesSpecified(ctr *apiv1.Container) bool {
 
 // addMetadata applies metadata specified in the template
 func (woc *wfOperationCtx) addMetadata(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	if tmpl.CriticalStep {
+		pod.ObjectMeta.Annotations[common.AnnotationKeySafeToEvict] = "false"
+	}
 	if woc.execWf.Spec.PodMetadata != nil {
 		// add workflow-level pod annotations and labels
 		for k, v := range woc.execWf.Spec.PodMetadata.Annotations {
@@ -810,11 +990,239 @@ func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *w
 	} else if wfSpec.SecurityContext != nil {
 		pod.Spec.SecurityContext = wfSpec.SecurityContext
 	}
+
+	// add the template's sysctls to those of the security context, replacing any of the same name
+	if len(tmpl.Sysctls) > 0 {
+		securityContext := &apiv1.PodSecurityContext{}
+		if pod.Spec.SecurityContext != nil {
+			securityContext = pod.Spec.SecurityContext.DeepCopy()
+		}
+		for _, sysctl := range tmpl.Sysctls {
+			found := false
+			for i, s := range securityContext.Sysctls {
+				if s.Name == sysctl.Name {
+					securityContext.Sysctls[i] = sysctl
+					found = true
+				}
+			}
+			if !found {
+				securityContext.Sysctls = append(securityContext.Sysctls, sysctl)
+			}
+		}
+		pod.Spec.SecurityContext = securityContext
+	}
+}
+
+// addSchedulingPresets adds the node selectors, tolerations and affinities of the scheduling presets, configured in the
+// controller's config map, that select the template by the labels of its metadata. The pod's own node selector, and
+// affinity, which are the template's, or the workflow's, take precedence
+func (woc *wfOperationCtx) addSchedulingPresets(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	for _, preset := range woc.controller.Config.SchedulingPresets {
+		ok, err := preset.Matches(labels.Set(tmpl.Metadata.Labels))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if len(preset.NodeSelector) > 0 {
+			// the node selector may be the template's, or the workflow's, so it must not be modified
+			nodeSelector := make(map[string]string, len(pod.Spec.NodeSelector)+len(preset.NodeSelector))
+			for k, v := range preset.NodeSelector {
+				nodeSelector[k] = v
+			}
+			for k, v := range pod.Spec.NodeSelector {
+				nodeSelector[k] = v
+			}
+			pod.Spec.NodeSelector = nodeSelector
+		}
+		if pod.Spec.Affinity == nil && preset.Affinity != nil {
+			pod.Spec.Affinity = preset.Affinity.DeepCopy()
+		}
+		if len(preset.Tolerations) > 0 {
+			pod.Spec.Tolerations = append(append([]apiv1.Toleration{}, pod.Spec.Tolerations...), preset.Tolerations...)
+		}
+	}
+	return nil
+}
+
+// addSpotConstraints schedules the pod on, or away from, the spot nodes configured in the controller's config map, as
+// per the template's spot policy
+func (woc *wfOperationCtx) addSpotConstraints(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	if tmpl.Spot == "" {
+		return nil
+	}
+	spot := woc.controller.Config.Spot
+	labels := spot.GetNodeLabels()
+	if len(labels) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.spot requires spot to be configured in the controller's config map", tmpl.Name)
+	}
+	affinity := &apiv1.Affinity{}
+	if pod.Spec.Affinity != nil {
+		// the affinity may be the template's, or the workflow's
+		affinity = pod.Spec.Affinity.DeepCopy()
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &apiv1.NodeAffinity{}
+	}
+	switch tmpl.Spot {
+	case wfv1.SpotPolicyPrefer:
+		affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, apiv1.PreferredSchedulingTerm{
+			Weight:     spot.GetPreferenceWeight(),
+			Preference: apiv1.NodeSelectorTerm{MatchExpressions: nodeLabelRequirements(labels, apiv1.NodeSelectorOpIn)},
+		})
+	case wfv1.SpotPolicyRequire:
+		requireNodes(affinity.NodeAffinity, nodeLabelRequirements(labels, apiv1.NodeSelectorOpIn))
+	case wfv1.SpotPolicyAvoid:
+		requireNodes(affinity.NodeAffinity, nodeLabelRequirements(labels, apiv1.NodeSelectorOpNotIn))
+	}
+	pod.Spec.Affinity = affinity
+	if tmpl.Spot.RunsOnSpot() {
+		pod.Spec.Tolerations = append(append([]apiv1.Toleration{}, pod.Spec.Tolerations...), spot.GetTolerations()...)
+	}
+	return nil
+}
+
+// hasAutoMemoization returns whether any template of the workflow, including the templates it refers to that have been
+// stored, has an automatic memoization key, which the digests of output artifacts are only needed for
+func (woc *wfOperationCtx) hasAutoMemoization() bool {
+	auto := func(tmpl wfv1.Template) bool { return tmpl.Memoize != nil && tmpl.Memoize.Auto }
+	for _, tmpl := range woc.execWf.Spec.Templates {
+		if auto(tmpl) {
+			return true
+		}
+	}
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		if auto(tmpl) {
+			return true
+		}
+	}
+	return false
+}
+
+// addSandbox sets the runtime class, and user namespace, of the pod, as per the template, or else the sandbox
+// configured in the controller's config map
+func (woc *wfOperationCtx) addSandbox(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	sandbox := woc.controller.Config.Sandbox
+	if sandbox == nil {
+		sandbox = &config.Sandbox{}
+	}
+	runtimeClassName := tmpl.RuntimeClassName
+	if runtimeClassName == "" {
+		runtimeClassName = sandbox.RuntimeClassName
+	}
+	if runtimeClassName != "" {
+		pod.Spec.RuntimeClassName = &runtimeClassName
+	}
+	hostUsers := tmpl.HostUsers
+	if hostUsers == nil {
+		hostUsers = sandbox.HostUsers
+	}
+	// this version of the pod spec has no hostUsers, so the user namespace is requested with CRI-O's annotation, which
+	// other container runtimes ignore
+	if hostUsers != nil && !*hostUsers {
+		pod.ObjectMeta.Annotations[common.AnnotationKeyUserNamespaceMode] = "auto"
+	}
+}
+
+// addPlatforms requires nodes of one of the template's platforms, and runs the images of the containers configured
+// for the platform in the controller's config map. If there is an image of any container for one of the platforms, the
+// pod runs on the first such platform, as the images of the containers are not all multi-arch
+func (woc *wfOperationCtx) addPlatforms(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	platforms := tmpl.Platforms
+	if len(platforms) == 0 {
+		return nil
+	}
+	images := woc.controller.Config.PlatformImages
+	containers := [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers}
+	for _, platform := range platforms {
+		selected := false
+		for _, ctrs := range containers {
+			for i, c := range ctrs {
+				if image, ok := images.Get(c.Image, platform); ok {
+					ctrs[i].Image = image
+					selected = true
+				}
+			}
+		}
+		if selected {
+			platforms = []wfv1.Platform{platform}
+			break
+		}
+	}
+	var requirements [][]apiv1.NodeSelectorRequirement
+	for _, platform := range platforms {
+		os, arch, err := platform.Parse()
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.platforms: %v", tmpl.Name, err)
+		}
+		requirements = append(requirements, []apiv1.NodeSelectorRequirement{
+			{Key: apiv1.LabelOSStable, Operator: apiv1.NodeSelectorOpIn, Values: []string{os}},
+			{Key: apiv1.LabelArchStable, Operator: apiv1.NodeSelectorOpIn, Values: []string{arch}},
+		})
+	}
+	affinity := &apiv1.Affinity{}
+	if pod.Spec.Affinity != nil {
+		// the affinity may be the template's, or the workflow's
+		affinity = pod.Spec.Affinity.DeepCopy()
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &apiv1.NodeAffinity{}
+	}
+	requireAnyNodes(affinity.NodeAffinity, requirements)
+	pod.Spec.Affinity = affinity
+	return nil
+}
+
+// nodeLabelRequirements returns the requirements that nodes have, or do not have, each of the labels
+func nodeLabelRequirements(labels map[string]string, op apiv1.NodeSelectorOperator) []apiv1.NodeSelectorRequirement {
+	var requirements []apiv1.NodeSelectorRequirement
+	for key, value := range labels {
+		requirements = append(requirements, apiv1.NodeSelectorRequirement{Key: key, Operator: op, Values: []string{value}})
+	}
+	sort.Slice(requirements, func(i, j int) bool { return requirements[i].Key < requirements[j].Key })
+	return requirements
+}
+
+// requireNodes adds the requirements to each of the node affinity's required terms, any one of which a node must match
+func requireNodes(nodeAffinity *apiv1.NodeAffinity, requirements []apiv1.NodeSelectorRequirement) {
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &apiv1.NodeSelector{}
+	}
+	terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []apiv1.NodeSelectorTerm{{}}
+	}
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirements...)
+	}
+	nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+}
+
+// requireAnyNodes requires nodes to match each of the node affinity's required terms, any one of which a node must
+// match, and any one of the alternative requirements
+func requireAnyNodes(nodeAffinity *apiv1.NodeAffinity, alternatives [][]apiv1.NodeSelectorRequirement) {
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &apiv1.NodeSelector{}
+	}
+	terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []apiv1.NodeSelectorTerm{{}}
+	}
+	var anyTerms []apiv1.NodeSelectorTerm
+	for _, term := range terms {
+		for _, requirements := range alternatives {
+			t := term.DeepCopy()
+			t.MatchExpressions = append(t.MatchExpressions, requirements...)
+			anyTerms = append(anyTerms, *t)
+		}
+	}
+	nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = anyTerms
 }
 
 // addVolumeReferences adds any volumeMounts that a container/sidecar is referencing, to the pod.spec.volumes
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
-func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
+func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume, secretProviders wfv1.SecretProviders) error {
 	switch tmpl.GetType() {
 	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript, wfv1.TemplateTypeData:
 	default:
@@ -841,6 +1249,10 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 				return &pvc
 			}
 		}
+		// Find the container set workspace.
+		if vol := tmpl.ContainerSet.GetWorkspace().GetVolume(); vol != nil && vol.Name == name {
+			return vol
+		}
 		return nil
 	}
 
@@ -886,7 +1298,7 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 		}
 	}
 
-	volumes, volumeMounts := createSecretVolumes(tmpl)
+	volumes, volumeMounts := createSecretVolumes(tmpl, secretProviders)
 	pod.Spec.Volumes = append(pod.Spec.Volumes, volumes...)
 
 	for idx, container := range pod.Spec.Containers {
@@ -1035,13 +1447,17 @@ func addOutputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.Template) {
 // addArchiveLocation conditionally updates the template with the default artifact repository
 // information configured in the controller, for the purposes of archiving outputs. This is skipped
 // for templates which do not need to archive anything, or have explicitly set an archive location
-// in the template.
-func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) {
+// in the template. If the template has an artifact repository ref, that repository is used instead.
+func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Template) error {
 	if tmpl.ArchiveLocation.HasLocation() {
 		// User explicitly set the location. nothing else to do.
-		return
+		return nil
+	}
+	repo, err := woc.getArtifactRepository(ctx, tmpl)
+	if err != nil {
+		return err
 	}
-	archiveLogs := woc.IsArchiveLogs(tmpl)
+	archiveLogs := woc.IsArchiveLogs(repo, tmpl)
 	needLocation := archiveLogs
 	for _, art := range append(tmpl.Inputs.Artifacts, tmpl.Outputs.Artifacts...) {
 		if !art.HasLocation() {
@@ -1050,16 +1466,96 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) {
 	}
 	woc.log.WithField("needLocation", needLocation).Debug()
 	if !needLocation {
-		return
+		return nil
 	}
-	tmpl.ArchiveLocation = woc.artifactRepository.ToArtifactLocation()
+	splitLogs := tmpl.ArchiveLocation.IsSplitLogs()
+	tmpl.ArchiveLocation = repo.ToArtifactLocation()
 	tmpl.ArchiveLocation.ArchiveLogs = &archiveLogs
+	if splitLogs {
+		tmpl.ArchiveLocation.SplitLogs = &splitLogs
+	}
+	return nil
+}
+
+// checkImages returns an error if the image policy of the workflow's namespace does not allow the image of any of the
+// pod's containers, apart from those that run the executor's image. The containers are checked by image, not name, as
+// a pod spec patch can change the image of the init, and wait, containers
+func (woc *wfOperationCtx) checkImages(pod *apiv1.Pod) error {
+	rules := woc.controller.Config.ImagePolicy.GetRules(woc.wf.Namespace)
+	if rules == nil {
+		return nil
+	}
+	executorImage := woc.controller.executorImage()
+	for _, ctrs := range [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, c := range ctrs {
+			if c.Image == executorImage {
+				continue
+			}
+			if err := rules.Check(c.Image); err != nil {
+				return errors.Errorf(errors.CodeForbidden, "container %s: %v", c.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// addCheckpoint adds the template's checkpoint to its input artifacts, as an optional artifact, so that the init
+// container loads the checkpoint of an earlier attempt, if there is one, and the wait container saves it to the same
+// key. The key defaults to one of the node's retry node, which is the same for every attempt.
+func (woc *wfOperationCtx) addCheckpoint(ctx context.Context, nodeName string, tmpl *wfv1.Template, retryNodeName string) error {
+	if tmpl.Checkpoint == nil {
+		return nil
+	}
+	loc := tmpl.ArchiveLocation
+	if !loc.HasLocation() {
+		repo, err := woc.getArtifactRepository(ctx, tmpl)
+		if err != nil {
+			return err
+		}
+		loc = repo.ToArtifactLocation()
+	}
+	loc = loc.DeepCopy()
+	key := tmpl.Checkpoint.Key
+	if key == "" {
+		if retryNodeName != "" {
+			nodeName = retryNodeName
+		}
+		key = path.Join(woc.wf.Name, "checkpoints", woc.wf.NodeID(nodeName)+".tgz")
+	}
+	if loc == nil || loc.SetKey(key) != nil || !loc.HasLocation() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint requires an artifact repository", tmpl.Name)
+	}
+	loc.ArchiveLogs = nil
+	tmpl.Inputs.Artifacts = append(tmpl.Inputs.Artifacts, wfv1.Artifact{
+		Name:             common.CheckpointArtifactName,
+		Path:             tmpl.Checkpoint.Path,
+		Optional:         true,
+		ArtifactLocation: *loc,
+	})
+	return nil
+}
+
+// getArtifactRepository returns the artifact repository for the template, which is the workflow's unless the template
+// has its own artifact repository ref
+func (woc *wfOperationCtx) getArtifactRepository(ctx context.Context, tmpl *wfv1.Template) (*wfv1.ArtifactRepository, error) {
+	if tmpl.ArtifactRepositoryRef == nil {
+		return woc.artifactRepository, nil
+	}
+	ref, err := woc.controller.artifactRepositories.Resolve(ctx, tmpl.ArtifactRepositoryRef, woc.wf.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve artifact repository for template %q: %w", tmpl.Name, err)
+	}
+	repo, err := woc.controller.artifactRepositories.Get(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact repository for template %q: %w", tmpl.Name, err)
+	}
+	return repo, nil
 }
 
 // IsArchiveLogs determines if container should archive logs
 // priorities: controller(on) > template > workflow > controller(off)
-func (woc *wfOperationCtx) IsArchiveLogs(tmpl *wfv1.Template) bool {
-	archiveLogs := woc.artifactRepository.IsArchiveLogs()
+func (woc *wfOperationCtx) IsArchiveLogs(repo *wfv1.ArtifactRepository, tmpl *wfv1.Template) bool {
+	archiveLogs := repo.IsArchiveLogs()
 	if !archiveLogs {
 		if woc.execWf.Spec.ArchiveLogs != nil {
 			archiveLogs = *woc.execWf.Spec.ArchiveLogs
@@ -1085,9 +1581,8 @@ func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.P
 	} else if woc.execWf.Spec.AutomountServiceAccountToken != nil {
 		automountServiceAccountToken = woc.execWf.Spec.AutomountServiceAccountToken
 	}
-	if automountServiceAccountToken != nil && !*automountServiceAccountToken {
-		pod.Spec.AutomountServiceAccountToken = automountServiceAccountToken
-	}
+	// true is set too, so a template can mount the token of a service account that does not automount it
+	pod.Spec.AutomountServiceAccountToken = automountServiceAccountToken
 
 	executorServiceAccountName := ""
 	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
@@ -1114,6 +1609,53 @@ func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.P
 	return nil
 }
 
+// getSecretProviders returns the secret providers the input parameters of the template read secrets from
+func (woc *wfOperationCtx) getSecretProviders(tmpl *wfv1.Template) (wfv1.SecretProviders, error) {
+	var providers wfv1.SecretProviders
+	for _, param := range tmpl.Inputs.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.ExternalSecret == nil {
+			continue
+		}
+		name := param.ValueFrom.ExternalSecret.Provider
+		provider := woc.execWf.Spec.SecretProviders.Get(name)
+		if provider == nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s: secret provider %q not found", param.Name, name)
+		}
+		if providers.Get(name) == nil {
+			providers = append(providers, *provider)
+		}
+	}
+	return providers, nil
+}
+
+// addExternalSecretsVolume sets up a shared in-memory volume between the init container and main container, which the
+// init container writes the secrets of input parameters, read from the secret providers, to. Only the init container is
+// given the providers
+func addExternalSecretsVolume(pod *apiv1.Pod, secretProviders wfv1.SecretProviders) {
+	vol := apiv1.Volume{
+		Name: "argo-secrets",
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{Medium: apiv1.StorageMediumMemory},
+		},
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, vol)
+
+	for i, initCtr := range pod.Spec.InitContainers {
+		if initCtr.Name == common.InitContainerName {
+			initCtr.VolumeMounts = append(initCtr.VolumeMounts, apiv1.VolumeMount{Name: vol.Name, MountPath: common.ExecutorExternalSecretsDir})
+			initCtr.Env = append(initCtr.Env, apiv1.EnvVar{Name: common.EnvVarSecretProviders, Value: wfv1.MustMarshallJSON(secretProviders)})
+			pod.Spec.InitContainers[i] = initCtr
+			break
+		}
+	}
+	for i, ctr := range pod.Spec.Containers {
+		if ctr.Name == common.MainContainerName {
+			ctr.VolumeMounts = append(ctr.VolumeMounts, apiv1.VolumeMount{Name: vol.Name, MountPath: common.ExecutorExternalSecretsDir, ReadOnly: true})
+			pod.Spec.Containers[i] = ctr
+		}
+	}
+}
+
 // addScriptStagingVolume sets up a shared staging volume between the init container
 // and main container for the purpose of holding the script source code for script templates
 func addScriptStagingVolume(pod *apiv1.Pod) {
@@ -1181,6 +1723,39 @@ func addSidecars(pod *apiv1.Pod, tmpl *wfv1.Template) {
 	}
 }
 
+// addTemplateEnv adds the template's environment variables, and their sources, to the main, init, and sidecar
+// containers, but not Argo's init and wait containers. They are added before the container's own, so that its own
+// take precedence, and may refer to them.
+func addTemplateEnv(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	if len(tmpl.Env) == 0 && len(tmpl.EnvFrom) == 0 {
+		return
+	}
+	add := func(c *apiv1.Container) {
+		set := map[string]bool{}
+		for _, e := range c.Env {
+			set[e.Name] = true
+		}
+		var env []apiv1.EnvVar
+		for _, e := range tmpl.Env {
+			if !set[e.Name] {
+				env = append(env, e)
+			}
+		}
+		c.Env = append(env, c.Env...)
+		c.EnvFrom = append(append([]apiv1.EnvFromSource{}, tmpl.EnvFrom...), c.EnvFrom...)
+	}
+	for i, c := range pod.Spec.InitContainers {
+		if c.Name != common.InitContainerName {
+			add(&pod.Spec.InitContainers[i])
+		}
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name != common.WaitContainerName {
+			add(&pod.Spec.Containers[i])
+		}
+	}
+}
+
 // verifyResolvedVariables is a helper to ensure all {{variables}} have been resolved for a object
 func verifyResolvedVariables(obj interface{}) error {
 	str, err := json.Marshal(obj)
@@ -1193,7 +1768,7 @@ func verifyResolvedVariables(obj interface{}) error {
 }
 
 // createSecretVolumes will retrieve and create Volumes and Volumemount object for Pod
-func createSecretVolumes(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.VolumeMount) {
+func createSecretVolumes(tmpl *wfv1.Template, secretProviders wfv1.SecretProviders) ([]apiv1.Volume, []apiv1.VolumeMount) {
 	allVolumesMap := make(map[string]apiv1.Volume)
 	uniqueKeyMap := make(map[string]bool)
 	var secretVolumes []apiv1.Volume
@@ -1213,6 +1788,14 @@ func createSecretVolumes(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.VolumeMou
 			createSecretVolume(allVolumesMap, *art, uniqueKeyMap)
 		}
 	}
+	for _, p := range secretProviders {
+		if p.Vault != nil {
+			createSecretVal(allVolumesMap, p.Vault.TokenSecret, uniqueKeyMap)
+		} else if p.AWSSecretsManager != nil {
+			createSecretVal(allVolumesMap, p.AWSSecretsManager.AccessKeySecret, uniqueKeyMap)
+			createSecretVal(allVolumesMap, p.AWSSecretsManager.SecretKeySecret, uniqueKeyMap)
+		}
+	}
 
 	for volMountName, val := range allVolumesMap {
 		secretVolumes = append(secretVolumes, val)
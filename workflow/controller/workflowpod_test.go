@@ -20,6 +20,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/test/util"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	armocks "github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories/mocks"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -266,6 +268,36 @@ func TestTmplLevelAutomountServiceAccountToken(t *testing.T) {
 	assert.Equal(t, *pod.Spec.AutomountServiceAccountToken, false)
 }
 
+// TestTmplLevelServiceAccountOverridesTokenless verifies that a template can run with a service account token, and its
+// own service account, when the rest of the workflow runs without one.
+func TestTmplLevelServiceAccountOverridesTokenless(t *testing.T) {
+	woc := newWoc()
+	ctx := context.Background()
+	_, err := util.CreateServiceAccountWithToken(ctx, woc.controller.kubeclientset, "", "foo", "foo-token")
+	assert.NoError(t, err)
+
+	trueValue := true
+	falseValue := false
+	woc.execWf.Spec.AutomountServiceAccountToken = &falseValue
+	woc.execWf.Spec.Executor = &wfv1.ExecutorConfig{ServiceAccountName: "foo"}
+	woc.execWf.Spec.Templates[0].ServiceAccountName = "kube-api"
+	woc.execWf.Spec.Templates[0].AutomountServiceAccountToken = &trueValue
+	tmplCtx, err := woc.createTemplateContext(wfv1.ResourceScopeLocal, "")
+	assert.NoError(t, err)
+
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		assert.Equal(t, "kube-api", pod.Spec.ServiceAccountName)
+		if assert.NotNil(t, pod.Spec.AutomountServiceAccountToken) {
+			assert.True(t, *pod.Spec.AutomountServiceAccountToken)
+		}
+	}
+}
+
 // verifyServiceAccountTokenVolumeMount is a helper function to verify service account token volume in a container.
 func verifyServiceAccountTokenVolumeMount(t *testing.T, ctr apiv1.Container, volName, mountPath string) {
 	for _, vol := range ctr.VolumeMounts {
@@ -437,6 +469,408 @@ func TestTolerations(t *testing.T) {
 	assert.Equal(t, pod.Spec.Tolerations[0].Key, "nvidia.com/gpu")
 }
 
+// templatePods executes the first template of the hello world workflow, with the controller's config, and returns the
+// pods that were created, and the error of executing it. The config, the workflow's spec, and the template, are
+// changed by the functions that are not nil.
+func templatePods(t *testing.T, cfg func(cfg *config.Config), spec func(spec *wfv1.WorkflowSpec), tmpl func(tmpl *wfv1.Template), opts *executeTemplateOpts) ([]apiv1.Pod, error) {
+	ctx := context.Background()
+	woc := newWoc()
+	if cfg != nil {
+		cfg(&woc.controller.Config)
+	}
+	if spec != nil {
+		spec(&woc.execWf.Spec)
+	}
+	// as the workflow's artifact repository is got when it is operated on
+	repo, err := woc.controller.artifactRepositories.Get(ctx, woc.wf.Status.ArtifactRepositoryRef)
+	assert.NoError(t, err)
+	woc.artifactRepository = repo
+	template := woc.execWf.Spec.Templates[0].DeepCopy()
+	if tmpl != nil {
+		tmpl(template)
+	}
+	if opts == nil {
+		opts = &executeTemplateOpts{}
+	}
+	tmplCtx, err := woc.createTemplateContext(wfv1.ResourceScopeLocal, "")
+	assert.NoError(t, err)
+	_, execErr := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), template, &wfv1.WorkflowStep{}, opts)
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	return pods.Items, execErr
+}
+
+func TestSpot(t *testing.T) {
+	aks := &config.Spot{Preset: "aks"}
+	zones := &apiv1.Affinity{NodeAffinity: &apiv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{
+		NodeSelectorTerms: []apiv1.NodeSelectorTerm{
+			{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "zone", Operator: apiv1.NodeSelectorOpIn, Values: []string{"a"}}}},
+			{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "zone", Operator: apiv1.NodeSelectorOpIn, Values: []string{"b"}}}},
+		},
+	}}}
+	for _, tt := range []struct {
+		name     string
+		spot     *config.Spot
+		policy   wfv1.SpotPolicy
+		affinity *apiv1.Affinity
+		wantErr  string
+		check    func(t *testing.T, pod apiv1.Pod)
+	}{
+		{name: "NotConfigured", policy: wfv1.SpotPolicyPrefer, wantErr: "templates.whalesay.spot requires spot to be configured in the controller's config map"},
+		{name: "Prefer", spot: aks, policy: wfv1.SpotPolicyPrefer, check: func(t *testing.T, pod apiv1.Pod) {
+			assert.Equal(t, []apiv1.PreferredSchedulingTerm{{
+				Weight:     100,
+				Preference: apiv1.NodeSelectorTerm{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "kubernetes.azure.com/scalesetpriority", Operator: apiv1.NodeSelectorOpIn, Values: []string{"spot"}}}},
+			}}, pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+			assert.Nil(t, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+			if assert.Len(t, pod.Spec.Tolerations, 1) {
+				assert.Equal(t, "kubernetes.azure.com/scalesetpriority", pod.Spec.Tolerations[0].Key)
+			}
+		}},
+		{name: "Require", spot: &config.Spot{NodeLabels: map[string]string{"capacity-type": "spot"}}, policy: wfv1.SpotPolicyRequire, affinity: zones, check: func(t *testing.T, pod apiv1.Pod) {
+			required := apiv1.NodeSelectorRequirement{Key: "capacity-type", Operator: apiv1.NodeSelectorOpIn, Values: []string{"spot"}}
+			terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			if assert.Len(t, terms, 2) {
+				assert.Equal(t, required, terms[0].MatchExpressions[1])
+				assert.Equal(t, required, terms[1].MatchExpressions[1])
+			}
+			assert.Len(t, zones.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions, 1, "the workflow's affinity is not changed")
+		}},
+		{name: "Avoid", spot: aks, policy: wfv1.SpotPolicyAvoid, check: func(t *testing.T, pod apiv1.Pod) {
+			assert.Equal(t, []apiv1.NodeSelectorTerm{{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "kubernetes.azure.com/scalesetpriority", Operator: apiv1.NodeSelectorOpNotIn, Values: []string{"spot"}}}}},
+				pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+			assert.Empty(t, pod.Spec.Tolerations)
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.Spot = tt.spot },
+				func(spec *wfv1.WorkflowSpec) { spec.Affinity = tt.affinity },
+				func(tmpl *wfv1.Template) { tmpl.Spot = tt.policy },
+				nil)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else if assert.NoError(t, err) && assert.Len(t, pods, 1) {
+				tt.check(t, pods[0])
+			}
+		})
+	}
+}
+
+func TestSandbox(t *testing.T) {
+	sandbox := &config.Sandbox{RuntimeClassName: "gvisor", HostUsers: pointer.BoolPtr(false)}
+	for _, tt := range []struct {
+		name                 string
+		sandbox              *config.Sandbox
+		runtimeClassName     string
+		hostUsers            *bool
+		wantRuntimeClassName string
+		wantUserNamespace    bool
+	}{
+		{name: "NotConfigured"},
+		{name: "Template", runtimeClassName: "kata", hostUsers: pointer.BoolPtr(false), wantRuntimeClassName: "kata", wantUserNamespace: true},
+		{name: "Default", sandbox: sandbox, wantRuntimeClassName: "gvisor", wantUserNamespace: true},
+		{name: "Trusted", sandbox: sandbox, runtimeClassName: "runc", hostUsers: pointer.BoolPtr(true), wantRuntimeClassName: "runc"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.Sandbox = tt.sandbox },
+				nil,
+				func(tmpl *wfv1.Template) {
+					tmpl.RuntimeClassName = tt.runtimeClassName
+					tmpl.HostUsers = tt.hostUsers
+				},
+				nil)
+			if assert.NoError(t, err) && assert.Len(t, pods, 1) {
+				pod := pods[0]
+				if tt.wantRuntimeClassName == "" {
+					assert.Nil(t, pod.Spec.RuntimeClassName)
+				} else if assert.NotNil(t, pod.Spec.RuntimeClassName) {
+					assert.Equal(t, tt.wantRuntimeClassName, *pod.Spec.RuntimeClassName)
+				}
+				if tt.wantUserNamespace {
+					assert.Equal(t, "auto", pod.Annotations[common.AnnotationKeyUserNamespaceMode])
+				} else {
+					assert.NotContains(t, pod.Annotations, common.AnnotationKeyUserNamespaceMode)
+				}
+			}
+		})
+	}
+}
+
+func TestSchedulingPresets(t *testing.T) {
+	presets := func(cfg *config.Config) {
+		cfg.SchedulingPresets = config.SchedulingPresets{{
+			Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"workload-class": "gpu"}},
+			NodeSelector: map[string]string{"node-pool": "gpu", "zone": "a"},
+			Tolerations:  []apiv1.Toleration{{Key: "nvidia.com/gpu", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoSchedule}},
+			Affinity:     &apiv1.Affinity{PodAntiAffinity: &apiv1.PodAntiAffinity{}},
+		}}
+	}
+	for _, tt := range []struct {
+		name  string
+		tmpl  func(tmpl *wfv1.Template)
+		check func(t *testing.T, pod apiv1.Pod)
+	}{
+		{name: "NotSelected", check: func(t *testing.T, pod apiv1.Pod) {
+			assert.Empty(t, pod.Spec.NodeSelector)
+			assert.Empty(t, pod.Spec.Tolerations)
+			assert.Nil(t, pod.Spec.Affinity)
+		}},
+		{name: "Selected", tmpl: func(tmpl *wfv1.Template) {
+			tmpl.Metadata.Labels = map[string]string{"workload-class": "gpu"}
+			tmpl.NodeSelector = map[string]string{"zone": "b"}
+			tmpl.Tolerations = []apiv1.Toleration{{Key: "dedicated", Operator: apiv1.TolerationOpExists}}
+		}, check: func(t *testing.T, pod apiv1.Pod) {
+			assert.Equal(t, map[string]string{"node-pool": "gpu", "zone": "b"}, pod.Spec.NodeSelector)
+			if assert.Len(t, pod.Spec.Tolerations, 2) {
+				assert.Equal(t, "dedicated", pod.Spec.Tolerations[0].Key)
+				assert.Equal(t, "nvidia.com/gpu", pod.Spec.Tolerations[1].Key)
+			}
+			if assert.NotNil(t, pod.Spec.Affinity) {
+				assert.NotNil(t, pod.Spec.Affinity.PodAntiAffinity)
+			}
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var tmpl *wfv1.Template
+			pods, err := templatePods(t, presets, nil, func(x *wfv1.Template) {
+				if tt.tmpl != nil {
+					tt.tmpl(x)
+				}
+				tmpl = x
+			}, nil)
+			if assert.NoError(t, err) && assert.Len(t, pods, 1) {
+				tt.check(t, pods[0])
+			}
+			assert.NotContains(t, tmpl.NodeSelector, "node-pool", "the template is not changed")
+		})
+	}
+}
+
+func TestImagePolicy(t *testing.T) {
+	executorImage := newWoc().controller.executorImage()
+	for _, tt := range []struct {
+		name         string
+		imagePolicy  *config.ImagePolicy
+		podSpecPatch string
+		wantErr      string
+	}{
+		{name: "Allowed", imagePolicy: &config.ImagePolicy{ImageRules: config.ImageRules{Allow: []string{"docker.io/docker/"}}}},
+		{name: "NotAllowed", imagePolicy: &config.ImagePolicy{
+			ImageRules: config.ImageRules{Allow: []string{"docker.io/docker/"}},
+			Namespaces: map[string]config.ImageRules{"": {Allow: []string{"my-registry.io/"}}},
+		}, wantErr: `container main: image "docker/whalesay:latest" is not allowed`},
+		{
+			name:         "PodSpecPatch",
+			imagePolicy:  &config.ImagePolicy{ImageRules: config.ImageRules{Allow: []string{"docker.io/docker/", executorImage}}},
+			podSpecPatch: `{"containers": [{"name": "wait", "image": "my-registry.io/evil:v1"}]}`,
+			wantErr:      `container wait: image "my-registry.io/evil:v1" is not allowed`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.ImagePolicy = tt.imagePolicy },
+				nil,
+				func(tmpl *wfv1.Template) { tmpl.PodSpecPatch = tt.podSpecPatch },
+				nil)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, pods)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, pods, 1)
+			}
+		})
+	}
+}
+
+func TestPipes(t *testing.T) {
+	for executor, errMsg := range map[string]string{
+		common.ContainerRuntimeExecutorEmissary: "",
+		common.ContainerRuntimeExecutorPNS:      `template has pipes, so you must use the emissary executor rather than "pns"`,
+	} {
+		t.Run(executor, func(t *testing.T) {
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.ContainerRuntimeExecutor = executor },
+				nil,
+				func(tmpl *wfv1.Template) {
+					tmpl.Sidecars = []wfv1.UserContainer{{Container: apiv1.Container{Name: "sidecar", Image: "busybox", Command: []string{"wc"}}}}
+					tmpl.Pipes = []wfv1.ContainerPipe{{Name: "results", From: "main", To: "sidecar"}}
+				},
+				nil)
+			if errMsg == "" {
+				assert.NoError(t, err)
+				assert.Len(t, pods, 1)
+			} else {
+				assert.EqualError(t, err, errMsg)
+				assert.Empty(t, pods)
+			}
+		})
+	}
+}
+
+func TestPlatforms(t *testing.T) {
+	platformTerm := func(os, arch string, requirements ...apiv1.NodeSelectorRequirement) apiv1.NodeSelectorTerm {
+		return apiv1.NodeSelectorTerm{MatchExpressions: append(requirements,
+			apiv1.NodeSelectorRequirement{Key: "kubernetes.io/os", Operator: apiv1.NodeSelectorOpIn, Values: []string{os}},
+			apiv1.NodeSelectorRequirement{Key: "kubernetes.io/arch", Operator: apiv1.NodeSelectorOpIn, Values: []string{arch}},
+		)}
+	}
+	zone := func(zone string) apiv1.NodeSelectorRequirement {
+		return apiv1.NodeSelectorRequirement{Key: "zone", Operator: apiv1.NodeSelectorOpIn, Values: []string{zone}}
+	}
+	zones := &apiv1.Affinity{NodeAffinity: &apiv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{
+		NodeSelectorTerms: []apiv1.NodeSelectorTerm{{MatchExpressions: []apiv1.NodeSelectorRequirement{zone("a")}}, {MatchExpressions: []apiv1.NodeSelectorRequirement{zone("b")}}},
+	}}}
+	images := config.PlatformImages{"docker/whalesay:latest": {"linux/arm64": "docker/whalesay:latest-arm64"}}
+	for _, tt := range []struct {
+		name      string
+		images    config.PlatformImages
+		affinity  *apiv1.Affinity
+		platforms []wfv1.Platform
+		wantTerms []apiv1.NodeSelectorTerm
+		wantImage string
+	}{
+		{name: "None", images: images, wantImage: "docker/whalesay:latest"},
+		{
+			name:      "MultiArch",
+			platforms: []wfv1.Platform{"linux/amd64", "linux/arm64"},
+			wantTerms: []apiv1.NodeSelectorTerm{platformTerm("linux", "amd64"), platformTerm("linux", "arm64")},
+			wantImage: "docker/whalesay:latest",
+		},
+		{
+			name:      "PlatformImage",
+			images:    images,
+			platforms: []wfv1.Platform{"linux/amd64", "linux/arm64"},
+			wantTerms: []apiv1.NodeSelectorTerm{platformTerm("linux", "arm64")},
+			wantImage: "docker/whalesay:latest-arm64",
+		},
+		{
+			name:      "Affinity",
+			affinity:  zones,
+			platforms: []wfv1.Platform{"linux/amd64", "linux/arm64"},
+			wantTerms: []apiv1.NodeSelectorTerm{
+				platformTerm("linux", "amd64", zone("a")),
+				platformTerm("linux", "arm64", zone("a")),
+				platformTerm("linux", "amd64", zone("b")),
+				platformTerm("linux", "arm64", zone("b")),
+			},
+			wantImage: "docker/whalesay:latest",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.PlatformImages = tt.images },
+				func(spec *wfv1.WorkflowSpec) { spec.Affinity = tt.affinity },
+				func(tmpl *wfv1.Template) { tmpl.Platforms = tt.platforms },
+				nil)
+			if assert.NoError(t, err) && assert.Len(t, pods, 1) {
+				pod := pods[0]
+				if tt.wantTerms == nil {
+					assert.Nil(t, pod.Spec.Affinity)
+				} else {
+					assert.Equal(t, tt.wantTerms, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+				}
+				assert.Equal(t, tt.wantImage, findMainContainer(&pod).Image)
+			}
+		})
+	}
+	// the workflow's affinity is not changed
+	assert.Len(t, zones.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions, 1)
+}
+
+func TestPodTuning(t *testing.T) {
+	workflowSysctls := []apiv1.Sysctl{{Name: "net.core.somaxconn", Value: "1024"}}
+	tune := func(tmpl *wfv1.Template) {
+		tmpl.DNSPolicy = dnsPolicy(apiv1.DNSNone)
+		tmpl.DNSConfig = &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.53"}}
+		tmpl.Sysctls = []apiv1.Sysctl{{Name: "net.core.somaxconn", Value: "4096"}, {Name: "net.ipv4.tcp_keepalive_time", Value: "60"}}
+	}
+	for _, tt := range []struct {
+		name          string
+		podTuning     *config.PodTuning
+		tmpl          func(tmpl *wfv1.Template)
+		wantErr       string
+		wantDNSPolicy apiv1.DNSPolicy
+		wantDNSConfig *apiv1.PodDNSConfig
+		wantSysctls   []apiv1.Sysctl
+	}{
+		{name: "Workflow", wantDNSPolicy: apiv1.DNSDefault, wantSysctls: workflowSysctls},
+		{
+			name: "Template",
+			podTuning: &config.PodTuning{
+				AllowedDNSPolicies: []apiv1.DNSPolicy{apiv1.DNSDefault, apiv1.DNSNone},
+				AllowedSysctls:     []string{"net.*"},
+			},
+			tmpl:          tune,
+			wantDNSPolicy: apiv1.DNSNone,
+			wantDNSConfig: &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.53"}},
+			wantSysctls:   []apiv1.Sysctl{{Name: "net.core.somaxconn", Value: "4096"}, {Name: "net.ipv4.tcp_keepalive_time", Value: "60"}},
+		},
+		{name: "NotAllowed", podTuning: &config.PodTuning{AllowedSysctls: []string{"net.core.*"}}, tmpl: tune, wantErr: `sysctl "net.ipv4.tcp_keepalive_time" is not allowed`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec *wfv1.WorkflowSpec
+			pods, err := templatePods(t,
+				func(cfg *config.Config) { cfg.PodTuning = tt.podTuning },
+				func(s *wfv1.WorkflowSpec) {
+					s.DNSPolicy = dnsPolicy(apiv1.DNSDefault)
+					s.SecurityContext = &apiv1.PodSecurityContext{Sysctls: workflowSysctls}
+					spec = s
+				},
+				tt.tmpl,
+				nil)
+			// the workflow's security context is not changed by the template's sysctls
+			assert.Equal(t, workflowSysctls, spec.SecurityContext.Sysctls)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, pods)
+			} else if assert.NoError(t, err) && assert.Len(t, pods, 1) {
+				pod := pods[0]
+				assert.Equal(t, tt.wantDNSPolicy, pod.Spec.DNSPolicy)
+				assert.Equal(t, tt.wantDNSConfig, pod.Spec.DNSConfig)
+				assert.Equal(t, tt.wantSysctls, pod.Spec.SecurityContext.Sysctls)
+			}
+		})
+	}
+}
+
+func dnsPolicy(policy apiv1.DNSPolicy) *apiv1.DNSPolicy {
+	return &policy
+}
+
+func TestCheckpoint(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		checkpoint *wfv1.Checkpoint
+		opts       *executeTemplateOpts
+		wantKey    string
+	}{
+		{name: "Default", checkpoint: &wfv1.Checkpoint{Path: "/checkpoint"}, opts: &executeTemplateOpts{retryNodeName: "hello-world"}, wantKey: "hello-world/checkpoints/hello-world.tgz"},
+		{name: "Key", checkpoint: &wfv1.Checkpoint{Path: "/checkpoint", Key: "my-model/checkpoint.tgz"}, wantKey: "my-model/checkpoint.tgz"},
+		{name: "None"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := templatePods(t, nil, nil, func(tmpl *wfv1.Template) { tmpl.Checkpoint = tt.checkpoint }, tt.opts)
+			if !assert.NoError(t, err) || !assert.Len(t, pods, 1) {
+				return
+			}
+			podTmpl, err := getPodTemplate(&pods[0])
+			assert.NoError(t, err)
+			art := podTmpl.Inputs.GetArtifactByName(common.CheckpointArtifactName)
+			if tt.checkpoint == nil {
+				assert.Nil(t, art)
+			} else if assert.NotNil(t, art) && assert.NotNil(t, art.S3) {
+				assert.Equal(t, "/checkpoint", art.Path)
+				assert.True(t, art.Optional)
+				assert.Equal(t, "my-bucket", art.S3.Bucket)
+				assert.Equal(t, tt.wantKey, art.S3.Key)
+			}
+		})
+	}
+}
+
 // TestMetadata verifies ability to carry forward annotations and labels
 func TestMetadata(t *testing.T) {
 	woc := newWoc()
@@ -525,6 +959,53 @@ func TestConditionalAddArchiveLocationArchiveLogs(t *testing.T) {
 	assert.NotNil(t, tmpl.ArchiveLocation)
 }
 
+var templateArtifactRepositoryRefWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: template-artifact-repository-ref
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    artifactRepositoryRef:
+      configMap: artifact-repositories
+      key: cheap
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+    outputs:
+      artifacts:
+      - name: intermediate
+        path: /tmp/intermediate
+`
+
+// TestTemplateArtifactRepositoryRef verifies the template's artifact repository ref overrides the workflow's repository
+func TestTemplateArtifactRepositoryRef(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(templateArtifactRepositoryRefWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps(wf.Namespace).Create(ctx, &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "artifact-repositories"},
+		Data:       map[string]string{"cheap": "s3:\n  bucket: cheap\n"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	controller.artifactRepositories = artifactrepositories.New(controller.kubeclientset, "argo", &wfv1.ArtifactRepository{
+		S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "governed"}},
+	})
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	tmpl, err := getPodTemplate(&pods.Items[0])
+	require.NoError(t, err)
+	if assert.NotNil(t, tmpl.ArchiveLocation) && assert.NotNil(t, tmpl.ArchiveLocation.S3) {
+		assert.Equal(t, "cheap", tmpl.ArchiveLocation.S3.Bucket)
+	}
+}
+
 // TestConditionalNoAddArchiveLocation verifies we add archive location when it is needed
 func TestConditionalArchiveLocation(t *testing.T) {
 	ctx := context.Background()
@@ -1951,3 +2432,281 @@ func TestProgressEnvVars(t *testing.T) {
 		})
 	})
 }
+
+func TestAddTracingEnvVars(t *testing.T) {
+	newPod := func() *apiv1.Pod {
+		return &apiv1.Pod{Spec: apiv1.PodSpec{
+			InitContainers: []apiv1.Container{{Name: common.InitContainerName}},
+			Containers:     []apiv1.Container{{Name: common.WaitContainerName}, {Name: common.MainContainerName}},
+		}}
+	}
+	t.Run("NoSpan", func(t *testing.T) {
+		pod := newPod()
+		addTracingEnvVars(context.Background(), pod)
+		assert.Equal(t, newPod(), pod)
+	})
+	t.Run("Span", func(t *testing.T) {
+		_ = os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4317")
+		defer func() { _ = os.Unsetenv("OTEL_EXPORTER_OTLP_ENDPOINT") }()
+		traceParent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+		pod := newPod()
+		addTracingEnvVars(tracing.ContextWithTraceParent(context.Background(), traceParent), pod)
+		envVars := []apiv1.EnvVar{
+			{Name: tracing.EnvVarTraceParent, Value: traceParent},
+			{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://otel-collector:4317"},
+		}
+		assert.Equal(t, envVars, pod.Spec.InitContainers[0].Env)
+		assert.Equal(t, envVars, pod.Spec.Containers[0].Env)
+		assert.Empty(t, pod.Spec.Containers[1].Env)
+	})
+	t.Run("ExecutorConfig", func(t *testing.T) {
+		_ = os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4317")
+		defer func() { _ = os.Unsetenv("OTEL_EXPORTER_OTLP_ENDPOINT") }()
+		pod := newPod()
+		pod.Spec.InitContainers[0].Env = []apiv1.EnvVar{{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://my-collector:4317"}}
+		addTracingEnvVars(tracing.ContextWithTraceParent(context.Background(), "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"), pod)
+		if assert.Len(t, pod.Spec.InitContainers[0].Env, 2) {
+			assert.Equal(t, "http://my-collector:4317", pod.Spec.InitContainers[0].Env[0].Value)
+		}
+	})
+}
+
+func TestCreateEnvVarsLogFields(t *testing.T) {
+	woc := newWoc()
+	woc.wf.UID = "my-uid"
+	envVars := map[string]apiv1.EnvVar{}
+	for _, e := range woc.createEnvVars() {
+		envVars[e.Name] = e
+	}
+	assert.Equal(t, woc.wf.Name, envVars[common.EnvVarWorkflowName].Value)
+	assert.Equal(t, "my-uid", envVars[common.EnvVarWorkflowUID].Value)
+	if assert.NotNil(t, envVars[common.EnvVarNodeID].ValueFrom) {
+		assert.Equal(t, "metadata.annotations['workflows.argoproj.io/node-id']", envVars[common.EnvVarNodeID].ValueFrom.FieldRef.FieldPath)
+	}
+}
+
+func TestOutputLimitsEnv(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.OutputLimits = &config.OutputLimits{MaxParameterSize: 1024}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		for _, c := range pods.Items[0].Spec.Containers {
+			assert.Contains(t, c.Env, apiv1.EnvVar{Name: common.EnvVarMaxOutputParameterSize, Value: "1024"})
+			for _, e := range c.Env {
+				assert.NotEqual(t, common.EnvVarMaxOutputArtifacts, e.Name)
+			}
+		}
+	}
+}
+
+func TestArtifactParallelismEnv(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.ArtifactParallelism = 4
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		for _, c := range pods.Items[0].Spec.InitContainers {
+			assert.Contains(t, c.Env, apiv1.EnvVar{Name: common.EnvVarArtifactParallelism, Value: "4"})
+		}
+		for _, c := range pods.Items[0].Spec.Containers {
+			assert.Contains(t, c.Env, apiv1.EnvVar{Name: common.EnvVarArtifactParallelism, Value: "4"})
+		}
+	}
+}
+
+func TestDigestArtifactsEnv(t *testing.T) {
+	digestArtifacts := func(t *testing.T, memoize *wfv1.Memoize) bool {
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Templates[0].Memoize = memoize
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(context.Background())
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			for _, c := range pods.Items[0].Spec.Containers {
+				for _, e := range c.Env {
+					if e.Name == common.EnvVarDigestArtifacts {
+						return e.Value == "true"
+					}
+				}
+			}
+		}
+		return false
+	}
+	cache := &wfv1.Cache{ConfigMap: &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-cache"}}}
+	t.Run("NotMemoized", func(t *testing.T) {
+		assert.False(t, digestArtifacts(t, nil))
+	})
+	t.Run("Key", func(t *testing.T) {
+		assert.False(t, digestArtifacts(t, &wfv1.Memoize{Key: "my-key", Cache: cache}))
+	})
+	t.Run("Auto", func(t *testing.T) {
+		assert.True(t, digestArtifacts(t, &wfv1.Memoize{Auto: true, Cache: cache}))
+	})
+}
+
+func TestAddTemplateEnv(t *testing.T) {
+	pod := &apiv1.Pod{Spec: apiv1.PodSpec{
+		InitContainers: []apiv1.Container{{Name: common.InitContainerName}, {Name: "my-init"}},
+		Containers: []apiv1.Container{
+			{Name: common.WaitContainerName},
+			{Name: common.MainContainerName, Env: []apiv1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}},
+			{Name: "my-sidecar", EnvFrom: []apiv1.EnvFromSource{{ConfigMapRef: &apiv1.ConfigMapEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-config"}}}}},
+		},
+	}}
+	tmpl := &wfv1.Template{
+		Env:     []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "LOG_LEVEL", Value: "info"}},
+		EnvFrom: []apiv1.EnvFromSource{{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "common"}}}},
+	}
+	addTemplateEnv(pod, tmpl)
+	assert.Empty(t, pod.Spec.InitContainers[0].Env)
+	assert.Empty(t, pod.Spec.Containers[0].Env)
+	assert.Equal(t, tmpl.Env, pod.Spec.InitContainers[1].Env)
+	assert.Equal(t, []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "LOG_LEVEL", Value: "debug"}}, pod.Spec.Containers[1].Env)
+	assert.Equal(t, tmpl.EnvFrom, pod.Spec.Containers[1].EnvFrom)
+	if assert.Len(t, pod.Spec.Containers[2].EnvFrom, 2) {
+		assert.Equal(t, "common", pod.Spec.Containers[2].EnvFrom[0].SecretRef.Name)
+		assert.Equal(t, "my-config", pod.Spec.Containers[2].EnvFrom[1].ConfigMapRef.Name)
+	}
+}
+
+func TestTemplateDefaultsEnv(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: template-defaults-env
+  namespace: default
+spec:
+  entrypoint: main
+  templateDefaults:
+    env:
+      - name: HTTP_PROXY
+        value: http://proxy:3128
+    envFrom:
+      - secretRef:
+          name: common
+  templates:
+    - name: main
+      script:
+        image: python:alpine3.6
+        command: [python]
+        env:
+          - name: HTTP_PROXY
+            value: http://my-proxy:3128
+        source: print("hello")
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pod, err := getPod(woc, "template-defaults-env")
+	if assert.NoError(t, err) {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == common.MainContainerName {
+				var proxies []string
+				for _, e := range c.Env {
+					if e.Name == "HTTP_PROXY" {
+						proxies = append(proxies, e.Value)
+					}
+				}
+				assert.Equal(t, []string{"http://my-proxy:3128"}, proxies)
+				assert.Equal(t, []apiv1.EnvFromSource{{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "common"}}}}, c.EnvFrom)
+			} else {
+				assert.Empty(t, c.EnvFrom, c.Name)
+			}
+		}
+	}
+}
+
+var externalSecretsWorkflow = `
+metadata:
+  name: external-secrets
+  namespace: default
+spec:
+  entrypoint: main
+  secretProviders:
+  - name: vault
+    vault:
+      address: https://vault.vault:8200
+      role: my-role
+  - name: aws
+    awsSecretsManager:
+      region: us-east-1
+      accessKeySecret:
+        name: my-aws
+        key: accessKey
+      secretKeySecret:
+        name: my-aws
+        key: secretKey
+  templates:
+  - name: main
+    steps:
+    - - name: connect
+        template: connect
+        arguments:
+          parameters:
+          - name: password
+            valueFrom:
+              externalSecret:
+                provider: vault
+                path: secret/data/db
+                key: password
+  - name: connect
+    inputs:
+      parameters:
+      - name: password
+    container:
+      image: my-image
+      command: [connect, --password-file, "{{inputs.parameters.password}}"]
+`
+
+func TestExternalSecrets(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(externalSecretsWorkflow)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("connect")
+	if assert.NotNil(t, node) && assert.NotNil(t, node.Inputs) {
+		assert.Equal(t, "/argo/secrets/password", node.Inputs.Parameters[0].Value.String(), "the value is the path, not the secret")
+	}
+	pods, err := listPods(woc)
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		assert.Contains(t, pod.Spec.Volumes, apiv1.Volume{Name: "argo-secrets", VolumeSource: apiv1.VolumeSource{EmptyDir: &apiv1.EmptyDirVolumeSource{Medium: apiv1.StorageMediumMemory}}})
+		for _, v := range pod.Spec.Volumes {
+			assert.NotEqual(t, "my-aws", v.Name, "only the secrets of the providers the template uses are mounted")
+		}
+		if assert.Len(t, pod.Spec.InitContainers, 1) {
+			init := pod.Spec.InitContainers[0]
+			assert.Contains(t, init.VolumeMounts, apiv1.VolumeMount{Name: "argo-secrets", MountPath: "/argo/secrets"})
+			for _, e := range init.Env {
+				if e.Name == common.EnvVarSecretProviders {
+					assert.Equal(t, `[{"name":"vault","vault":{"address":"https://vault.vault:8200","role":"my-role"}}]`, e.Value)
+				}
+			}
+		}
+		for _, c := range pod.Spec.Containers {
+			if c.Name == common.MainContainerName {
+				assert.Contains(t, c.VolumeMounts, apiv1.VolumeMount{Name: "argo-secrets", MountPath: "/argo/secrets", ReadOnly: true})
+				assert.Equal(t, []string{"/var/run/argo/argoexec", "emissary", "--", "connect", "--password-file", "/argo/secrets/password"}, c.Command)
+			} else {
+				for _, e := range c.Env {
+					assert.NotEqual(t, common.EnvVarSecretProviders, e.Name)
+				}
+			}
+		}
+	}
+}
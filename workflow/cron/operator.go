@@ -7,26 +7,33 @@ import (
 	"sort"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	typed "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	cronutil "github.com/argoproj/argo-workflows/v3/util/cron"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
+// the number of the next times the CronWorkflow is scheduled to run at, to record in its status
+const nextScheduledTimesCount = 5
+
 type cronWfOperationCtx struct {
 	// CronWorkflow is the CronWorkflow to be run
 	name        string
@@ -34,19 +41,21 @@ type cronWfOperationCtx struct {
 	wfClientset versioned.Interface
 	wfClient    typed.WorkflowInterface
 	cronWfIf    typed.CronWorkflowInterface
+	configMapIf typedcorev1.ConfigMapInterface
 	log         *log.Entry
 	metrics     *metrics.Metrics
 	// scheduledTimeFunc returns the last scheduled time when it is called
 	scheduledTimeFunc ScheduledTimeFunc
 }
 
-func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
+func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, kubeClientset kubernetes.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
 	return &cronWfOperationCtx{
 		name:        cronWorkflow.ObjectMeta.Name,
 		cronWf:      cronWorkflow,
 		wfClientset: wfClientset,
 		wfClient:    wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
 		cronWfIf:    wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
+		configMapIf: kubeClientset.CoreV1().ConfigMaps(cronWorkflow.Namespace),
 		log: log.WithFields(log.Fields{
 			"workflow":  cronWorkflow.ObjectMeta.Name,
 			"namespace": cronWorkflow.ObjectMeta.Namespace,
@@ -91,12 +100,36 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
+	proceed, err = woc.enforcePreviousRunPolicy(ctx, scheduledRuntime)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Previous run policy error: %s", err))
+		return
+	} else if !proceed {
+		return
+	}
+
+	proceed, err = woc.evaluateWhen(ctx, scheduledRuntime)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("When expression error: %s", err))
+		return
+	} else if !proceed {
+		// it was scheduled, so it is not run later as missed
+		woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+		return
+	}
+
 	wf := common.ConvertCronWorkflowToWorkflowWithProperties(woc.cronWf, getChildWorkflowName(woc.cronWf.Name, scheduledRuntime), scheduledRuntime)
 
-	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
+	submitOpts := &v1alpha1.SubmitOpts{}
+	if woc.cronWf.Spec.Catchup != nil && woc.cronWf.Spec.Catchup.Parameter != "" {
+		submitOpts.Parameters = []string{woc.cronWf.Spec.Catchup.Parameter + "=" + scheduledRuntime.Format(time.RFC3339)}
+	}
+
+	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.wfClientset, woc.cronWf.Namespace, wf, submitOpts)
 	if err != nil {
 		// If the workflow already exists (i.e. this is a duplicate submission), do not report an error
 		if errors.IsAlreadyExists(err) {
+			woc.cronWf.Status.DelayedScheduledTime = nil
 			return
 		}
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit Workflow: %s", err))
@@ -105,13 +138,16 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 
 	woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
 	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	woc.cronWf.Status.DelayedScheduledTime = nil
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+	// it was resumed, if its stop strategy suspended it
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeStopped)
 }
 
 func (woc *cronWfOperationCtx) validateCronWorkflow() error {
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().WorkflowTemplates(woc.cronWf.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	err := validate.ValidateCronWorkflow(wftmplGetter, cwftmplGetter, woc.cronWf)
+	err := validate.ValidateCronWorkflow(wftmplGetter, cwftmplGetter, woc.cronWf, validate.ValidateOpts{GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(context.TODO(), woc.cronWf.Namespace, woc.wfClientset)})
 	if err != nil {
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, fmt.Sprint(err))
 	} else {
@@ -134,13 +170,78 @@ func getWorkflowObjectReference(wf *v1alpha1.Workflow, runWf *v1alpha1.Workflow)
 }
 
 func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": woc.cronWf.Status, "metadata": map[string]interface{}{"annotations": woc.cronWf.Annotations}})
+	woc.setNextScheduledTimes(time.Now())
+	status, err := woc.statusPatch()
+	if err != nil {
+		woc.log.WithError(err).Error("failed to marshall cron workflow status data")
+		return
+	}
+	woc.patch(ctx, map[string]interface{}{"status": status, "metadata": map[string]interface{}{"annotations": woc.cronWf.Annotations}})
+}
+
+// statusPatch returns the status as a merge patch, which removes the fields that are empty, as they are omitted, rather
+// than leave them as they were
+func (woc *cronWfOperationCtx) statusPatch() (map[string]interface{}, error) {
+	data, err := json.Marshal(woc.cronWf.Status)
+	if err != nil {
+		return nil, err
+	}
+	status := map[string]interface{}{}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"nextScheduledTimes", "succeeded", "failed", "consecutiveFailures", "delayedScheduledTime"} {
+		if _, ok := status[key]; !ok {
+			status[key] = nil
+		}
+	}
+	return status, nil
 }
 
 func (woc *cronWfOperationCtx) persistUpdateActiveWorkflows(ctx context.Context) {
 	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active}})
 }
 
+// persistUpdateCompletedWorkflows records the Workflows that completed, and suspends the CronWorkflow if it should stop
+func (woc *cronWfOperationCtx) persistUpdateCompletedWorkflows(ctx context.Context, stop bool) {
+	patch := map[string]interface{}{"status": map[string]interface{}{
+		"active":              woc.cronWf.Status.Active,
+		"succeeded":           woc.cronWf.Status.Succeeded,
+		"failed":              woc.cronWf.Status.Failed,
+		"consecutiveFailures": woc.cronWf.Status.ConsecutiveFailures,
+		"conditions":          woc.cronWf.Status.Conditions,
+	}}
+	if stop {
+		patch["spec"] = map[string]interface{}{"suspend": true}
+	}
+	woc.patch(ctx, patch)
+}
+
+// persistNextScheduledTimes records the next times the CronWorkflow is scheduled to run at, only if they changed, so
+// recording them does not cause the CronWorkflow to be updated again and again
+func (woc *cronWfOperationCtx) persistNextScheduledTimes(ctx context.Context) {
+	if woc.setNextScheduledTimes(time.Now()) {
+		woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"nextScheduledTimes": woc.cronWf.Status.NextScheduledTimes}})
+	}
+}
+
+// setNextScheduledTimes sets the next times, after now, the CronWorkflow is scheduled to run at, which is none if it is
+// suspended, and returns true if they changed
+func (woc *cronWfOperationCtx) setNextScheduledTimes(now time.Time) bool {
+	var times []v1.Time
+	if schedule, err := cronutil.ParseCronWorkflowSchedule(woc.cronWf); err == nil && !woc.cronWf.Spec.Suspend {
+		for t := schedule.Next(now); !t.IsZero() && len(times) < nextScheduledTimesCount; t = schedule.Next(t) {
+			times = append(times, v1.NewTime(t))
+		}
+	}
+	changed := len(times) != len(woc.cronWf.Status.NextScheduledTimes)
+	for i := 0; !changed && i < len(times); i++ {
+		changed = !times[i].Equal(&woc.cronWf.Status.NextScheduledTimes[i])
+	}
+	woc.cronWf.Status.NextScheduledTimes = times
+	return changed
+}
+
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
 	data, err := json.Marshal(patch)
 	if err != nil {
@@ -191,6 +292,112 @@ func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool,
 	return true, nil
 }
 
+// enforcePreviousRunPolicy returns true if the Workflow scheduled for the time should be run, as per the previous run
+// policy, and if it is delayed, records the time
+func (woc *cronWfOperationCtx) enforcePreviousRunPolicy(ctx context.Context, scheduledRuntime time.Time) (bool, error) {
+	policy := woc.cronWf.Spec.PreviousRunPolicy
+	if policy == "" {
+		return true, nil
+	}
+	succeeded, err := woc.previousRunSucceeded(ctx)
+	if err != nil || succeeded {
+		return succeeded, err
+	}
+	switch policy {
+	case v1alpha1.PreviousRunSkip:
+		woc.log.Infof("%s has 'PreviousRunPolicy: Skip' and its previous Workflow did not succeed so it was not run", woc.name)
+	case v1alpha1.PreviousRunDelay:
+		woc.log.Infof("%s has 'PreviousRunPolicy: Delay' and its previous Workflow did not succeed so it was delayed", woc.name)
+		woc.cronWf.Status.DelayedScheduledTime = &v1.Time{Time: scheduledRuntime}
+	default:
+		return false, fmt.Errorf("invalid PreviousRunPolicy: %s", policy)
+	}
+	return false, nil
+}
+
+// previousRunSucceeded returns true if the previous Workflow succeeded, or there has not been one
+func (woc *cronWfOperationCtx) previousRunSucceeded(ctx context.Context) (bool, error) {
+	active := woc.cronWf.Status.Active
+	if len(active) == 0 {
+		return woc.cronWf.Status.ConsecutiveFailures == 0, nil
+	}
+	// it may have completed since the active Workflows were last reconciled
+	wf, err := woc.wfClient.Get(ctx, active[len(active)-1].Name, v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return wf.Status.Successful(), nil
+}
+
+// evaluateWhen returns true if the Workflow scheduled for the time should be run, as per the when expression
+func (woc *cronWfOperationCtx) evaluateWhen(ctx context.Context, scheduledRuntime time.Time) (bool, error) {
+	when := woc.cronWf.Spec.When
+	if when == "" {
+		return true, nil
+	}
+	env, err := woc.whenEnv(ctx, scheduledRuntime)
+	if err != nil {
+		return false, err
+	}
+	proceed, err := argoexpr.EvalBool(when, env)
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
+		woc.log.Infof("%s has 'When: %s', which is false, so it was not run", woc.name, when)
+	}
+	return proceed, nil
+}
+
+// whenEnv returns the variables of the when expression for the time the Workflow was scheduled for
+func (woc *cronWfOperationCtx) whenEnv(ctx context.Context, scheduledRuntime time.Time) (map[string]interface{}, error) {
+	loc := time.Local
+	if woc.cronWf.Spec.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(woc.cronWf.Spec.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	previousRunSucceeded, err := woc.previousRunSucceeded(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configMaps := map[string]interface{}{}
+	for _, name := range woc.cronWf.Spec.WhenConfigMaps {
+		cm, err := woc.configMapIf.Get(ctx, name, v1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s: %w", name, err)
+		}
+		data := map[string]interface{}{}
+		for k, v := range cm.Data {
+			data[k] = v
+		}
+		configMaps[name] = data
+	}
+	env := woc.cronWf.Status.GetStopStrategyEnv()
+	t := scheduledRuntime.In(loc)
+	env["scheduledTime"] = t
+	env["date"] = t.Format("2006-01-02")
+	env["weekday"] = t.Weekday().String()
+	env["previousRunSucceeded"] = previousRunSucceeded
+	env["configMaps"] = configMaps
+	return env, nil
+}
+
+// runDelayedWorkflow runs the Workflow that was delayed by the previous run policy, if the previous one has since
+// succeeded
+func (woc *cronWfOperationCtx) runDelayedWorkflow(ctx context.Context) {
+	delayed := woc.cronWf.Status.DelayedScheduledTime
+	if delayed == nil || len(woc.cronWf.Status.Active) > 0 || woc.cronWf.Status.ConsecutiveFailures > 0 {
+		return
+	}
+	woc.log.Infof("%s was delayed at %s, which will now be run", woc.name, delayed.Format("Mon Jan _2 15:04:05 2006"))
+	woc.run(ctx, delayed.Time)
+}
+
 func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context) error {
 	for _, wfObjectRef := range woc.cronWf.Status.Active {
 		woc.log.Infof("stopping '%s'", wfObjectRef.Name)
@@ -207,72 +414,69 @@ func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context
 }
 
 func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (bool, error) {
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err := woc.getMissedExecutionTimes()
 	if err != nil {
 		return false, err
 	}
-	if !missedExecutionTime.IsZero() {
+	for _, missedExecutionTime := range missedExecutionTimes {
 		woc.run(ctx, missedExecutionTime)
-		return true, nil
 	}
-	return false, nil
+	return len(missedExecutionTimes) > 0, nil
 }
 
-func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, error) {
+// getMissedExecutionTimes returns the times the workflow was scheduled for, since it was last run, which should be run
+// as per the catchup policy, oldest first
+func (woc *cronWfOperationCtx) getMissedExecutionTimes() ([]time.Time, error) {
 	// If the CronWorkflow schedule was just updated, then do not run any outstanding workflows.
 	if woc.cronWf.IsUsingNewSchedule() {
-		return time.Time{}, nil
+		return nil, nil
+	}
+	// Unless there is a catchup policy, missed executions are only run within StartingDeadlineSeconds
+	limit := woc.cronWf.Spec.Catchup.GetLimit()
+	if limit <= 0 || (woc.cronWf.Spec.Catchup == nil && woc.cronWf.Spec.StartingDeadlineSeconds == nil) {
+		return nil, nil
 	}
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
-	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
-		if woc.cronWf.Spec.Timezone != "" {
-			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
-			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := woc.cronWf.Spec.GetScheduleString()
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+	if woc.cronWf.Status.LastScheduledTime == nil {
+		return nil, nil
+	}
+	now := time.Now()
+	if woc.cronWf.Spec.Timezone != "" {
+		loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
 		}
+		now = now.In(loc)
+	}
+	cronSchedule, err := cronutil.ParseCronWorkflowSchedule(woc.cronWf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to form schedule '%s': %s", woc.cronWf.Spec.GetScheduleString(), err)
+	}
 
-		var missedExecutionTime time.Time
-		nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
-		// Workflow should have ran
-		for nextScheduledRunTime.Before(now) {
-			missedExecutionTime = nextScheduledRunTime
-			nextScheduledRunTime = cronSchedule.Next(missedExecutionTime)
+	var missedExecutionTimes []time.Time
+	// Workflow should have ran
+	for t := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time); !t.IsZero() && t.Before(now); t = cronSchedule.Next(t) {
+		// if the execution time is within StartDeadlineSeconds, We are still within the deadline window, run the Workflow
+		if woc.cronWf.Spec.StartingDeadlineSeconds != nil && !now.Before(t.Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
+			continue
 		}
-
-		// We missed the latest execution time
-		if !missedExecutionTime.IsZero() {
-			// if missedExecutionTime is within StartDeadlineSeconds, We are still within the deadline window, run the Workflow
-			if woc.cronWf.Spec.StartingDeadlineSeconds != nil && now.Before(missedExecutionTime.Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
-				woc.log.Infof("%s missed an execution at %s and is within StartingDeadline", woc.cronWf.Name, missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"))
-				return missedExecutionTime, nil
-			}
+		missedExecutionTimes = append(missedExecutionTimes, t)
+		if len(missedExecutionTimes) > limit {
+			missedExecutionTimes = missedExecutionTimes[1:]
 		}
 	}
-	return time.Time{}, nil
+	for _, t := range missedExecutionTimes {
+		woc.log.Infof("%s missed an execution at %s, which will be run", woc.cronWf.Name, t.Format("Mon Jan _2 15:04:05 2006"))
+	}
+	return missedExecutionTimes, nil
 }
 
 func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows []v1alpha1.Workflow) error {
 	updated := false
-	currentWfsFulfilled := make(map[types.UID]bool)
+	completed := false
+	currentWfs := make(map[types.UID]v1alpha1.Workflow)
 	for _, wf := range workflows {
-		currentWfsFulfilled[wf.UID] = wf.Status.Fulfilled()
+		currentWfs[wf.UID] = wf
 
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
@@ -281,19 +485,72 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 	}
 
 	for _, objectRef := range woc.cronWf.Status.Active {
-		if fulfilled, found := currentWfsFulfilled[objectRef.UID]; !found || fulfilled {
+		wf, found := currentWfs[objectRef.UID]
+		if !found || wf.Status.Fulfilled() {
 			updated = true
 			woc.removeFromActiveList(objectRef.UID)
 		}
+		if found && wf.Status.Fulfilled() {
+			completed = true
+			woc.countCompletedWorkflow(&wf)
+		}
 	}
 
-	if updated {
+	if completed {
+		stop, err := woc.shouldStop()
+		if err != nil {
+			woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, fmt.Sprintf("Stop strategy error: %s", err))
+		}
+		woc.persistUpdateCompletedWorkflows(ctx, stop)
+	} else if updated {
 		woc.persistUpdateActiveWorkflows(ctx)
 	}
 
 	return nil
 }
 
+// countCompletedWorkflow counts the Workflow, which completed, as succeeded or failed
+func (woc *cronWfOperationCtx) countCompletedWorkflow(wf *v1alpha1.Workflow) {
+	if wf.Status.Successful() {
+		woc.cronWf.Status.Succeeded++
+		woc.cronWf.Status.ConsecutiveFailures = 0
+	} else {
+		woc.cronWf.Status.Failed++
+		woc.cronWf.Status.ConsecutiveFailures++
+	}
+}
+
+// shouldStop returns true if the CronWorkflow should be suspended as per its stop strategy, and records why in its
+// conditions
+func (woc *cronWfOperationCtx) shouldStop() (bool, error) {
+	stopStrategy := woc.cronWf.Spec.StopStrategy
+	if stopStrategy == nil || woc.cronWf.Spec.Suspend {
+		return false, nil
+	}
+	message := ""
+	if stopStrategy.SuspendAfterFailures != nil && woc.cronWf.Status.ConsecutiveFailures >= int64(*stopStrategy.SuspendAfterFailures) {
+		message = fmt.Sprintf("Suspended after %d consecutive failures", woc.cronWf.Status.ConsecutiveFailures)
+	} else if stopStrategy.Expression != "" {
+		stop, err := argoexpr.EvalBool(stopStrategy.Expression, woc.cronWf.Status.GetStopStrategyEnv())
+		if err != nil {
+			return false, err
+		}
+		if stop {
+			message = fmt.Sprintf("Suspended as '%s' is true", stopStrategy.Expression)
+		}
+	}
+	if message == "" {
+		return false, nil
+	}
+	woc.log.Info(message)
+	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Status:  v1.ConditionTrue,
+		Message: message,
+		Type:    v1alpha1.ConditionTypeStopped,
+	})
+	return true, nil
+}
+
 func (woc *cronWfOperationCtx) removeFromActiveList(uid types.UID) {
 	var newActive []corev1.ObjectReference
 	for _, ref := range woc.cronWf.Status.Active {
@@ -8,7 +8,10 @@ import (
 	"github.com/argoproj/pkg/humanize"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -76,10 +79,12 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
 	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err := woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	if assert.Len(t, missedExecutionTimes, 1) {
+		assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTimes[0].Unix())
+	}
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
@@ -88,16 +93,16 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err = woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
-	assert.True(t, missedExecutionTime.IsZero())
+	assert.Empty(t, missedExecutionTimes)
 
 	// Same test, but simulate a change to the schedule immediately prior by setting a different last-used-schedule annotation
 	// In this case, since a schedule change is detected, not workflow should be run
 	woc.cronWf.SetSchedule("0 * * * *")
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err = woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
-	assert.True(t, missedExecutionTime.IsZero())
+	assert.Empty(t, missedExecutionTimes)
 
 	// Run the same test in a different timezone
 	testTimezone := "Pacific/Niue"
@@ -117,10 +122,12 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	}
 	// Reset last-used-schedule as if the current schedule has been used before
 	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err = woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	if assert.Len(t, missedExecutionTimes, 1) {
+		assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTimes[0].Unix())
+	}
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
@@ -129,16 +136,16 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err = woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
-	assert.True(t, missedExecutionTime.IsZero())
+	assert.Empty(t, missedExecutionTimes)
 
 	// Same test, but simulate a change to the schedule immediately prior by setting a different last-used-schedule annotation
 	// In this case, since a schedule change is detected, not workflow should be run
 	woc.cronWf.SetSchedule("0 * * * *")
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err = woc.getMissedExecutionTimes()
 	assert.NoError(t, err)
-	assert.True(t, missedExecutionTime.IsZero())
+	assert.Empty(t, missedExecutionTimes)
 }
 
 type fakeLister struct{}
@@ -319,9 +326,9 @@ func TestLastUsedSchedule(t *testing.T) {
 		scheduledTimeFunc: inferScheduledTime,
 	}
 
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTimes, err := woc.getMissedExecutionTimes()
 	if assert.NoError(t, err) {
-		assert.Equal(t, time.Time{}, missedExecutionTime)
+		assert.Empty(t, missedExecutionTimes)
 	}
 
 	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
@@ -391,8 +398,305 @@ func TestMissedScheduleAfterCronScheduleWithForbid(t *testing.T) {
 			log:    logrus.WithFields(logrus.Fields{}),
 		}
 		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-		missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		assert.Empty(t, missedExecutionTimes)
+	})
+}
+
+func TestMissedScheduleWithSchedules(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	startingDeadlineSeconds := int64(120)
+	cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
+	cronWf.Spec.Timezone = ""
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(-time.Hour)}
+	woc := &cronWfOperationCtx{
+		cronWf: &cronWf,
+		log:    logrus.WithFields(logrus.Fields{}),
+	}
+	t.Run("NotMissed", func(t *testing.T) {
+		cronWf.Spec.Schedule = ""
+		cronWf.Spec.Schedules = []string{"0 0 1 1 *"}
+		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		assert.Empty(t, missedExecutionTimes)
+	})
+	t.Run("Missed", func(t *testing.T) {
+		cronWf.Spec.Schedule = ""
+		cronWf.Spec.Schedules = []string{"0 0 1 1 *", "* * * * *"}
+		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		if assert.Len(t, missedExecutionTimes, 1) {
+			assert.WithinDuration(t, time.Now(), missedExecutionTimes[0], time.Minute)
+		}
+	})
+}
+
+func TestMissedScheduleWithCatchup(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.StartingDeadlineSeconds = nil
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now().Add(-10 * time.Minute).Truncate(time.Minute)}
+	woc := &cronWfOperationCtx{
+		cronWf: &cronWf,
+		log:    logrus.WithFields(logrus.Fields{}),
+	}
+	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
+	t.Run("NoCatchup", func(t *testing.T) {
+		cronWf.Spec.Catchup = nil
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		assert.Empty(t, missedExecutionTimes)
+	})
+	t.Run("None", func(t *testing.T) {
+		cronWf.Spec.Catchup = &v1alpha1.Catchup{Policy: v1alpha1.CatchupNone}
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		assert.Empty(t, missedExecutionTimes)
+	})
+	t.Run("Latest", func(t *testing.T) {
+		cronWf.Spec.Catchup = &v1alpha1.Catchup{}
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		if assert.Len(t, missedExecutionTimes, 1) {
+			assert.WithinDuration(t, time.Now(), missedExecutionTimes[0], time.Minute)
+		}
+	})
+	t.Run("All", func(t *testing.T) {
+		limit := int32(3)
+		cronWf.Spec.Catchup = &v1alpha1.Catchup{Policy: v1alpha1.CatchupAll, Limit: &limit}
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		if assert.Len(t, missedExecutionTimes, 3) {
+			// the latest ones, oldest first
+			assert.Equal(t, time.Minute, missedExecutionTimes[1].Sub(missedExecutionTimes[0]))
+			assert.Equal(t, time.Minute, missedExecutionTimes[2].Sub(missedExecutionTimes[1]))
+			assert.WithinDuration(t, time.Now(), missedExecutionTimes[2], time.Minute)
+		}
+	})
+	t.Run("StartingDeadlineSeconds", func(t *testing.T) {
+		startingDeadlineSeconds := int64(150)
+		cronWf.Spec.StartingDeadlineSeconds = &startingDeadlineSeconds
+		cronWf.Spec.Catchup = &v1alpha1.Catchup{Policy: v1alpha1.CatchupAll}
+		missedExecutionTimes, err := woc.getMissedExecutionTimes()
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, len(missedExecutionTimes), 2)
+		assert.LessOrEqual(t, len(missedExecutionTimes), 3)
+	})
+}
+
+func TestCatchupParameter(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Catchup = &v1alpha1.Catchup{Parameter: "date"}
+	cronWf.Spec.WorkflowSpec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "date", Value: v1alpha1.AnyStringPtr("today")}}
+
+	cs := fake.NewSimpleClientset()
+	testMetrics := metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
+	}
+	scheduledTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	woc.run(context.Background(), scheduledTime)
+
+	wf, err := cs.ArgoprojV1alpha1().Workflows("").Get(context.Background(), getChildWorkflowName(cronWf.Name, scheduledTime), v1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2021-10-01T00:00:00Z", wf.Spec.Arguments.GetParameterByName("date").Value.String())
+	}
+}
+
+func TestNextScheduledTimes(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Schedule = "30 1,2 * * *"
+	cronWf.Spec.Timezone = "America/Los_Angeles"
+	cronWf.Spec.DaylightSavingPolicy = v1alpha1.DaylightSavingSkip
+	woc := &cronWfOperationCtx{
+		cronWf: &cronWf,
+		log:    logrus.WithFields(logrus.Fields{}),
+	}
+	// the day before the clock moved back in Los Angeles
+	now := time.Date(2020, 10, 31, 12, 0, 0, 0, time.UTC)
+	t.Run("Scheduled", func(t *testing.T) {
+		assert.True(t, woc.setNextScheduledTimes(now))
+		var times []string
+		for _, t := range cronWf.Status.NextScheduledTimes {
+			times = append(times, t.UTC().Format(time.RFC3339))
+		}
+		assert.Equal(t, []string{"2020-11-01T08:30:00Z", "2020-11-01T10:30:00Z", "2020-11-02T09:30:00Z", "2020-11-02T10:30:00Z", "2020-11-03T09:30:00Z"}, times)
+	})
+	t.Run("Unchanged", func(t *testing.T) {
+		assert.False(t, woc.setNextScheduledTimes(now))
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		cronWf.Spec.Suspend = true
+		assert.True(t, woc.setNextScheduledTimes(now))
+		assert.Empty(t, cronWf.Status.NextScheduledTimes)
+	})
+}
+
+func TestStopStrategy(t *testing.T) {
+	completed := func(name string, phase v1alpha1.WorkflowPhase) v1alpha1.Workflow {
+		return v1alpha1.Workflow{ObjectMeta: v1.ObjectMeta{Name: name, UID: types.UID(name)}, Status: v1alpha1.WorkflowStatus{Phase: phase}}
+	}
+	reconcile := func(t *testing.T, stopStrategy *v1alpha1.StopStrategy, workflows ...v1alpha1.Workflow) *v1alpha1.CronWorkflow {
+		var cronWf v1alpha1.CronWorkflow
+		v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+		cronWf.Spec.StopStrategy = stopStrategy
+		for _, wf := range workflows {
+			cronWf.Status.Active = append(cronWf.Status.Active, getWorkflowObjectReference(&wf, &wf))
+		}
+		cs := fake.NewSimpleClientset(&cronWf)
+		woc := &cronWfOperationCtx{
+			cronWf:   &cronWf,
+			cronWfIf: cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+			log:      logrus.WithFields(logrus.Fields{}),
+		}
+		assert.NoError(t, woc.reconcileActiveWfs(context.Background(), workflows))
+		updated, err := woc.cronWfIf.Get(context.Background(), cronWf.Name, v1.GetOptions{})
 		assert.NoError(t, err)
-		assert.True(t, missedExecutionTime.IsZero())
+		return updated
+	}
+	failures := int32(2)
+	t.Run("Counted", func(t *testing.T) {
+		cronWf := reconcile(t, nil, completed("a", v1alpha1.WorkflowFailed), completed("b", v1alpha1.WorkflowSucceeded), completed("c", v1alpha1.WorkflowError))
+		assert.Empty(t, cronWf.Status.Active)
+		assert.Equal(t, int64(1), cronWf.Status.Succeeded)
+		assert.Equal(t, int64(2), cronWf.Status.Failed)
+		assert.Equal(t, int64(1), cronWf.Status.ConsecutiveFailures)
+		assert.False(t, cronWf.Spec.Suspend)
+	})
+	t.Run("NotStopped", func(t *testing.T) {
+		cronWf := reconcile(t, &v1alpha1.StopStrategy{SuspendAfterFailures: &failures}, completed("a", v1alpha1.WorkflowFailed), completed("b", v1alpha1.WorkflowSucceeded), completed("c", v1alpha1.WorkflowFailed))
+		assert.False(t, cronWf.Spec.Suspend)
+		assert.Empty(t, cronWf.Status.Conditions)
+	})
+	t.Run("SuspendAfterFailures", func(t *testing.T) {
+		cronWf := reconcile(t, &v1alpha1.StopStrategy{SuspendAfterFailures: &failures}, completed("a", v1alpha1.WorkflowFailed), completed("b", v1alpha1.WorkflowError))
+		assert.True(t, cronWf.Spec.Suspend)
+		if assert.Len(t, cronWf.Status.Conditions, 1) {
+			assert.Equal(t, v1alpha1.ConditionTypeStopped, cronWf.Status.Conditions[0].Type)
+			assert.Equal(t, "Suspended after 2 consecutive failures", cronWf.Status.Conditions[0].Message)
+		}
+	})
+	t.Run("Expression", func(t *testing.T) {
+		cronWf := reconcile(t, &v1alpha1.StopStrategy{Expression: "failed >= 2 && succeeded < 2"}, completed("a", v1alpha1.WorkflowFailed), completed("b", v1alpha1.WorkflowSucceeded), completed("c", v1alpha1.WorkflowFailed))
+		assert.True(t, cronWf.Spec.Suspend)
+		if assert.Len(t, cronWf.Status.Conditions, 1) {
+			assert.Equal(t, "Suspended as 'failed >= 2 && succeeded < 2' is true", cronWf.Status.Conditions[0].Message)
+		}
+	})
+}
+
+func TestPreviousRunPolicy(t *testing.T) {
+	scheduledTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	operate := func(t *testing.T, policy v1alpha1.PreviousRunPolicy, previous v1alpha1.WorkflowPhase) (*cronWfOperationCtx, bool) {
+		var cronWf v1alpha1.CronWorkflow
+		v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+		cronWf.Spec.PreviousRunPolicy = policy
+		previousWf := &v1alpha1.Workflow{ObjectMeta: v1.ObjectMeta{Name: "previous", Namespace: "argo", UID: "previous"}, Status: v1alpha1.WorkflowStatus{Phase: previous}}
+		cronWf.Status.Active = []corev1.ObjectReference{getWorkflowObjectReference(previousWf, previousWf)}
+		cs := fake.NewSimpleClientset(&cronWf, previousWf)
+		woc := &cronWfOperationCtx{
+			wfClientset: cs,
+			wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+			cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+			cronWf:      &cronWf,
+			log:         logrus.WithFields(logrus.Fields{}),
+			metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+		}
+		woc.run(context.Background(), scheduledTime)
+		_, err := woc.wfClient.Get(context.Background(), getChildWorkflowName(cronWf.Name, scheduledTime), v1.GetOptions{})
+		return woc, err == nil
+	}
+	t.Run("Unset", func(t *testing.T) {
+		_, run := operate(t, "", v1alpha1.WorkflowFailed)
+		assert.True(t, run)
+	})
+	t.Run("Succeeded", func(t *testing.T) {
+		_, run := operate(t, v1alpha1.PreviousRunSkip, v1alpha1.WorkflowSucceeded)
+		assert.True(t, run)
+	})
+	t.Run("Skip", func(t *testing.T) {
+		woc, run := operate(t, v1alpha1.PreviousRunSkip, v1alpha1.WorkflowFailed)
+		assert.False(t, run)
+		assert.Nil(t, woc.cronWf.Status.DelayedScheduledTime)
+	})
+	t.Run("Running", func(t *testing.T) {
+		_, run := operate(t, v1alpha1.PreviousRunSkip, v1alpha1.WorkflowRunning)
+		assert.False(t, run)
+	})
+	t.Run("Delay", func(t *testing.T) {
+		woc, run := operate(t, v1alpha1.PreviousRunDelay, v1alpha1.WorkflowFailed)
+		assert.False(t, run)
+		if assert.NotNil(t, woc.cronWf.Status.DelayedScheduledTime) {
+			assert.Equal(t, scheduledTime, woc.cronWf.Status.DelayedScheduledTime.UTC())
+		}
+
+		// the previous Workflow was retried, and succeeded
+		woc.cronWf.Status.Active = nil
+		woc.cronWf.Status.ConsecutiveFailures = 1
+		woc.runDelayedWorkflow(context.Background())
+		_, err := woc.wfClient.Get(context.Background(), getChildWorkflowName(woc.cronWf.Name, scheduledTime), v1.GetOptions{})
+		assert.Error(t, err, "not run until the previous Workflow succeeds")
+		woc.cronWf.Status.ConsecutiveFailures = 0
+		woc.runDelayedWorkflow(context.Background())
+		_, err = woc.wfClient.Get(context.Background(), getChildWorkflowName(woc.cronWf.Name, scheduledTime), v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, woc.cronWf.Status.DelayedScheduledTime)
+	})
+}
+
+func TestWhen(t *testing.T) {
+	// a Saturday, at midnight in Tokyo
+	scheduledTime := time.Date(2021, 10, 1, 15, 0, 0, 0, time.UTC)
+	operate := func(t *testing.T, when string) (*cronWfOperationCtx, bool) {
+		var cronWf v1alpha1.CronWorkflow
+		v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+		cronWf.Spec.Timezone = "Asia/Tokyo"
+		cronWf.Spec.When = when
+		cronWf.Spec.WhenConfigMaps = []string{"my-flags"}
+		cs := fake.NewSimpleClientset(&cronWf)
+		kube := kubefake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: "my-flags", Namespace: "argo"}, Data: map[string]string{"enabled": "true"}})
+		woc := &cronWfOperationCtx{
+			wfClientset: cs,
+			wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+			cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+			configMapIf: kube.CoreV1().ConfigMaps("argo"),
+			cronWf:      &cronWf,
+			log:         logrus.WithFields(logrus.Fields{}),
+			metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+		}
+		woc.run(context.Background(), scheduledTime)
+		_, err := woc.wfClient.Get(context.Background(), getChildWorkflowName(cronWf.Name, scheduledTime), v1.GetOptions{})
+		return woc, err == nil
+	}
+	t.Run("True", func(t *testing.T) {
+		_, run := operate(t, "weekday == 'Saturday' && date == '2021-10-02' && scheduledTime.Hour() == 0 && previousRunSucceeded && configMaps['my-flags'].enabled == 'true'")
+		assert.True(t, run)
+	})
+	t.Run("False", func(t *testing.T) {
+		woc, run := operate(t, "!(date in ['2021-10-02', '2021-12-25'])")
+		assert.False(t, run)
+		if assert.NotNil(t, woc.cronWf.Status.LastScheduledTime) {
+			assert.Equal(t, scheduledTime, woc.cronWf.Status.LastScheduledTime.UTC())
+		}
+		assert.Empty(t, woc.cronWf.Status.Conditions)
+	})
+	t.Run("Error", func(t *testing.T) {
+		woc, run := operate(t, "configMaps['my-flags'].enabled")
+		assert.False(t, run)
+		if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+			assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, woc.cronWf.Status.Conditions[0].Type)
+			assert.Contains(t, woc.cronWf.Status.Conditions[0].Message, "When expression error: ")
+		}
 	})
 }
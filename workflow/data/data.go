@@ -2,6 +2,7 @@ package data
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/antonmedv/expr"
 
@@ -46,6 +47,12 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 		switch {
 		case step.Expression != "":
 			data, err = processExpression(step.Expression, data)
+		case step.Filter != "":
+			data, err = processFilter(step.Filter, data)
+		case step.Map != "":
+			data, err = processMap(step.Map, data)
+		case step.Chunk != 0:
+			data, err = processChunk(int(step.Chunk), data)
 		}
 		if err != nil {
 			return nil, fmt.Errorf("error processing data step %d: %w", i, err)
@@ -58,3 +65,79 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 func processExpression(expression string, data interface{}) (interface{}, error) {
 	return expr.Eval(expression, map[string]interface{}{"data": data})
 }
+
+func processFilter(expression string, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, fmt.Errorf("filter %w", err)
+	}
+	program, err := expr.Compile(expression, expr.AsBool())
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]interface{}, 0)
+	for _, item := range items {
+		keep, err := expr.Run(program, map[string]interface{}{"item": item})
+		if err != nil {
+			return nil, err
+		}
+		if keep.(bool) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+func processMap(expression string, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, fmt.Errorf("map %w", err)
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	mapped := make([]interface{}, len(items))
+	for i, item := range items {
+		mapped[i], err = expr.Run(program, map[string]interface{}{"item": item})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mapped, nil
+}
+
+func processChunk(size int, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, fmt.Errorf("chunk %w", err)
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk size must be a positive integer, got %d", size)
+	}
+	chunks := make([]interface{}, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks, nil
+}
+
+// toList converts a slice of any type, e.g. the []string of artifact paths, to a []interface{}
+func toList(data interface{}) ([]interface{}, error) {
+	if items, ok := data.([]interface{}); ok {
+		return items, nil
+	}
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("can only be applied to a list, got %T", data)
+	}
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, nil
+}
@@ -101,3 +101,36 @@ func TestProcessTransformation(t *testing.T) {
 	_, err = processTransformation(files, filterFiles)
 	assert.Error(t, err)
 }
+
+func TestProcessTransformationFilterMapChunk(t *testing.T) {
+	files := []string{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf", "zoo/foo.py"}
+
+	filtered, err := processTransformation(files, &v1alpha1.Transformation{{Filter: `item endsWith '.py'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"foo.py", "goo/foo.py", "zoo/foo.py"}, filtered)
+	}
+
+	mapped, err := processTransformation(files, &v1alpha1.Transformation{{Filter: `item contains '/'`}, {Map: `item + '.processed'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"goo/foo.py.processed", "moo/bar.pdf.processed", "zoo/foo.py.processed"}, mapped)
+	}
+
+	chunked, err := processTransformation(files, &v1alpha1.Transformation{{Chunk: 2}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{[]interface{}{"foo.py", "bar.pdf"}, []interface{}{"goo/foo.py", "moo/bar.pdf"}, []interface{}{"zoo/foo.py"}}, chunked)
+	}
+
+	chunked, err = processTransformation([]interface{}{}, &v1alpha1.Transformation{{Chunk: 2}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{}, chunked)
+	}
+
+	_, err = processTransformation(files, &v1alpha1.Transformation{{Filter: `item + 'x'`}})
+	assert.Error(t, err)
+
+	_, err = processTransformation("foo", &v1alpha1.Transformation{{Map: `item`}})
+	assert.Error(t, err)
+
+	_, err = processTransformation(files, &v1alpha1.Transformation{{Chunk: -1}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package eventsinks
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// buffered is an event, and the sequence number it was buffered with, so it can be removed once delivered, even if
+// older events were dropped while it was being delivered
+type buffered struct {
+	seq   uint64
+	event Event
+}
+
+// buffer holds the events of a sink until they are delivered, optionally saving them to a file, as JSON lines, so they
+// survive restarts
+type buffer struct {
+	mu     sync.Mutex
+	events []buffered
+	seq    uint64
+	size   int
+	// path is the file the events are saved to, or "" if they are only held in memory
+	path string
+	// added is signalled when events are added
+	added chan struct{}
+}
+
+func newBuffer(size int, path string) (*buffer, error) {
+	b := &buffer{size: size, path: path, added: make(chan struct{}, 1)}
+	if path == "" {
+		return b, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("failed to read buffered events from %q: %w", path, err)
+		}
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read buffered events from %q: %w", path, err)
+	}
+	if len(events) > size {
+		events = events[len(events)-size:]
+	}
+	for _, e := range events {
+		b.seq++
+		b.events = append(b.events, buffered{seq: b.seq, event: e})
+	}
+	return b, b.save()
+}
+
+// add buffers the events, dropping the oldest events if it is full, and returns the number dropped
+func (b *buffer) add(events []Event) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, e := range events {
+		b.seq++
+		b.events = append(b.events, buffered{seq: b.seq, event: e})
+	}
+	dropped := 0
+	if len(b.events) > b.size {
+		dropped = len(b.events) - b.size
+		b.events = append([]buffered(nil), b.events[dropped:]...)
+	}
+	var err error
+	if dropped > 0 {
+		err = b.save()
+	} else {
+		err = b.append(events)
+	}
+	select {
+	case b.added <- struct{}{}:
+	default:
+	}
+	return dropped, err
+}
+
+// peek returns up to n of the oldest events
+func (b *buffer) peek(n int) []buffered {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if n > len(b.events) {
+		n = len(b.events)
+	}
+	return append([]buffered(nil), b.events[:n]...)
+}
+
+// remove removes the events up to, and including, the sequence number, as they have been delivered
+func (b *buffer) remove(seq uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	i := 0
+	for i < len(b.events) && b.events[i].seq <= seq {
+		i++
+	}
+	if i == 0 {
+		return nil
+	}
+	b.events = append([]buffered(nil), b.events[i:]...)
+	return b.save()
+}
+
+func (b *buffer) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.events)
+}
+
+// append appends the events to the file
+func (b *buffer) append(events []Event) error {
+	if b.path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(b.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, e := range events {
+		if err := writeEvent(w, e); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// save replaces the file with the events, atomically, so a crash does not lose them
+func (b *buffer) save() error {
+	if b.path == "" {
+		return nil
+	}
+	tmp := b.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, x := range b.events {
+		if err := writeEvent(w, x.event); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, b.path)
+}
+
+func writeEvent(w *bufio.Writer, e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
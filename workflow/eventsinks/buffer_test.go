@@ -0,0 +1,66 @@
+package eventsinks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ids(events []buffered) []string {
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.event.ID)
+	}
+	return ids
+}
+
+func TestBuffer(t *testing.T) {
+	b, err := newBuffer(3, "")
+	require.NoError(t, err)
+	assert.Empty(t, b.peek(10))
+
+	dropped, err := b.add([]Event{{ID: "1"}, {ID: "2"}})
+	require.NoError(t, err)
+	assert.Zero(t, dropped)
+	assert.Equal(t, []string{"1"}, ids(b.peek(1)))
+	events := b.peek(10)
+	assert.Equal(t, []string{"1", "2"}, ids(events))
+
+	// events are dropped while the first two are being delivered
+	dropped, err = b.add([]Event{{ID: "3"}, {ID: "4"}, {ID: "5"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, []string{"3", "4", "5"}, ids(b.peek(10)))
+
+	require.NoError(t, b.remove(events[len(events)-1].seq))
+	assert.Equal(t, []string{"3", "4", "5"}, ids(b.peek(10)))
+	require.NoError(t, b.remove(b.peek(1)[0].seq))
+	assert.Equal(t, []string{"4", "5"}, ids(b.peek(10)))
+	assert.Equal(t, 2, b.len())
+}
+
+func TestBufferPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my-sink.jsonl")
+	b, err := newBuffer(3, path)
+	require.NoError(t, err)
+	_, err = b.add([]Event{{ID: "1"}, {ID: "2"}})
+	require.NoError(t, err)
+	_, err = b.add([]Event{{ID: "3"}})
+	require.NoError(t, err)
+	require.NoError(t, b.remove(b.peek(1)[0].seq))
+
+	b, err = newBuffer(3, path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, ids(b.peek(10)))
+
+	b, err = newBuffer(1, path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3"}, ids(b.peek(10)))
+
+	require.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o600))
+	_, err = newBuffer(3, path)
+	assert.Error(t, err)
+}
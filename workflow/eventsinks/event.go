@@ -0,0 +1,126 @@
+package eventsinks
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// the prefix of the types of events, which are the reasons of the equivalent Kubernetes events, e.g.
+// "io.argoproj.workflow.v1alpha1.WorkflowSucceeded" or "io.argoproj.workflow.v1alpha1.WorkflowNodeFailed"
+const typePrefix = "io.argoproj.workflow.v1alpha1."
+
+// Node is the node an event is about
+type Node struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	DisplayName  string        `json:"displayName"`
+	Type         string        `json:"type"`
+	TemplateName string        `json:"templateName,omitempty"`
+	Phase        string        `json:"phase"`
+	Message      string        `json:"message,omitempty"`
+	StartedAt    metav1.Time   `json:"startedAt,omitempty"`
+	FinishedAt   metav1.Time   `json:"finishedAt,omitempty"`
+	Inputs       *wfv1.Inputs  `json:"inputs,omitempty"`
+	Outputs      *wfv1.Outputs `json:"outputs,omitempty"`
+}
+
+// Data is the data of an event
+type Data struct {
+	Namespace  string            `json:"namespace"`
+	Name       string            `json:"name"`
+	UID        string            `json:"uid"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Phase      string            `json:"phase"`
+	Message    string            `json:"message,omitempty"`
+	StartedAt  metav1.Time       `json:"startedAt,omitempty"`
+	FinishedAt metav1.Time       `json:"finishedAt,omitempty"`
+	// Node is only set on node events
+	Node *Node `json:"node,omitempty"`
+}
+
+// Event is a CloudEvent, in the structured JSON format, about the phase change of a workflow, or one of its nodes. The
+// same phase change always has the same ID, so consumers can ignore events delivered more than once
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            Data      `json:"data"`
+}
+
+// IsNodeEvent returns true if the event is about a node
+func (e Event) IsNodeEvent() bool {
+	return e.Data.Node != nil
+}
+
+// changes returns the events for the changes of phase between the old and new workflow, the workflow's first, then
+// its nodes', in the order they started
+func changes(old, new *wfv1.Workflow, now time.Time) []Event {
+	var events []Event
+	newEvent := func(id, reason string) Event {
+		return Event{
+			SpecVersion:     "1.0",
+			ID:              id,
+			Source:          fmt.Sprintf("/apis/argoproj.io/v1alpha1/namespaces/%s/workflows/%s", new.Namespace, new.Name),
+			Type:            typePrefix + reason,
+			Time:            now,
+			DataContentType: "application/json",
+			Data: Data{
+				Namespace:  new.Namespace,
+				Name:       new.Name,
+				UID:        string(new.UID),
+				Labels:     new.Labels,
+				Phase:      string(new.Status.Phase),
+				Message:    new.Status.Message,
+				StartedAt:  new.Status.StartedAt,
+				FinishedAt: new.Status.FinishedAt,
+			},
+		}
+	}
+	if new.Status.Phase != "" && old.Status.Phase != new.Status.Phase {
+		events = append(events, newEvent(fmt.Sprintf("%s/%s", new.UID, new.Status.Phase), "Workflow"+string(new.Status.Phase)))
+	}
+	var nodes []wfv1.NodeStatus
+	for id, node := range new.Status.Nodes {
+		if node.Phase == "" {
+			continue
+		}
+		if oldNode, ok := old.Status.Nodes[id]; ok && oldNode.Phase == node.Phase {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	for _, node := range nodes {
+		x := newEvent(fmt.Sprintf("%s/%s/%s", new.UID, node.ID, node.Phase), "WorkflowNode"+string(node.Phase))
+		x.Subject = node.ID
+		x.Data.Node = &Node{
+			ID:           node.ID,
+			Name:         node.Name,
+			DisplayName:  node.DisplayName,
+			Type:         string(node.Type),
+			TemplateName: node.TemplateName,
+			Phase:        string(node.Phase),
+			Message:      node.Message,
+			StartedAt:    node.StartedAt,
+			FinishedAt:   node.FinishedAt,
+			Inputs:       node.Inputs,
+			Outputs:      node.Outputs,
+		}
+		events = append(events, x)
+	}
+	return events
+}
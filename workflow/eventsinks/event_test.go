@@ -0,0 +1,58 @@
+package eventsinks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestChanges(t *testing.T) {
+	now := time.Now()
+	old := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", Name: "my-wf", Phase: wfv1.NodeRunning},
+			"my-wf-1": {ID: "my-wf-1", Name: "my-wf[0].a", Phase: wfv1.NodeRunning},
+		}},
+	}
+	t.Run("NoChanges", func(t *testing.T) {
+		assert.Empty(t, changes(old, old.DeepCopy(), now))
+	})
+	t.Run("Changes", func(t *testing.T) {
+		new := old.DeepCopy()
+		new.Status.Phase = wfv1.WorkflowFailed
+		new.Status.Message = "my-message"
+		new.Status.Nodes["my-wf"] = wfv1.NodeStatus{ID: "my-wf", Name: "my-wf", Phase: wfv1.NodeFailed, StartedAt: metav1.Unix(1, 0)}
+		new.Status.Nodes["my-wf-1"] = wfv1.NodeStatus{ID: "my-wf-1", Name: "my-wf[0].a", TemplateName: "a", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, StartedAt: metav1.Unix(2, 0), Outputs: &wfv1.Outputs{ExitCode: pointer("1")}}
+		new.Status.Nodes["my-wf-2"] = wfv1.NodeStatus{ID: "my-wf-2", Name: "my-wf[0].b", Phase: wfv1.NodeOmitted, StartedAt: metav1.Unix(2, 0)}
+		new.Status.Nodes["my-wf-3"] = wfv1.NodeStatus{ID: "my-wf-3", Name: "my-wf[1].c"}
+		events := changes(old, new, now)
+		if assert.Len(t, events, 4) {
+			e := events[0]
+			assert.Equal(t, "1.0", e.SpecVersion)
+			assert.Equal(t, "my-uid/Failed", e.ID)
+			assert.Equal(t, "/apis/argoproj.io/v1alpha1/namespaces/my-ns/workflows/my-wf", e.Source)
+			assert.Equal(t, "io.argoproj.workflow.v1alpha1.WorkflowFailed", e.Type)
+			assert.Empty(t, e.Subject)
+			assert.Equal(t, now, e.Time)
+			assert.Equal(t, Data{Namespace: "my-ns", Name: "my-wf", UID: "my-uid", Phase: "Failed", Message: "my-message"}, e.Data)
+			assert.False(t, e.IsNodeEvent())
+
+			assert.Equal(t, "my-uid/my-wf/Failed", events[1].ID)
+			assert.Equal(t, "my-uid/my-wf-1/Failed", events[2].ID)
+			assert.Equal(t, "io.argoproj.workflow.v1alpha1.WorkflowNodeFailed", events[2].Type)
+			assert.Equal(t, "my-wf-1", events[2].Subject)
+			assert.True(t, events[2].IsNodeEvent())
+			assert.Equal(t, &Node{ID: "my-wf-1", Name: "my-wf[0].a", Type: "Pod", TemplateName: "a", Phase: "Failed", StartedAt: metav1.Unix(2, 0), Outputs: &wfv1.Outputs{ExitCode: pointer("1")}}, events[2].Data.Node)
+			assert.Equal(t, "io.argoproj.workflow.v1alpha1.WorkflowNodeOmitted", events[3].Type)
+		}
+	})
+}
+
+func pointer(s string) *string {
+	return &s
+}
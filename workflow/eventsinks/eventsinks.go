@@ -0,0 +1,186 @@
+package eventsinks
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+)
+
+// Interface publishes workflow and node lifecycle events to the configured sinks, delivering each event at least once,
+// unless it is dropped because a sink's buffer is full
+type Interface interface {
+	// Publish buffers events for the changes between the old and new workflow, it does not block on delivery
+	Publish(old, new *wfv1.Workflow)
+	// Run delivers buffered events until the context is done
+	Run(ctx context.Context)
+}
+
+// sink is a destination events are delivered to
+type sink interface {
+	// send delivers the events, in order, returning an error if any may not have been delivered
+	send(ctx context.Context, events []Event) error
+	close()
+}
+
+type publisher struct {
+	name       string
+	sink       sink
+	buffer     *buffer
+	nodeEvents bool
+	retry      wait.Backoff
+}
+
+type publishers []*publisher
+
+// batchSize is the maximum number of events sent at once
+const batchSize = 100
+
+// retry is how long delivery waits after a failure, before trying again, indefinitely
+var retry = wait.Backoff{Duration: 1 * time.Second, Factor: 2, Cap: 1 * time.Minute, Steps: 1 << 30}
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// New creates the sinks, getting their secrets from the namespace, typically the controller's, and loading any events
+// buffered before the controller restarted
+func New(ctx context.Context, kubernetes kubernetes.Interface, namespace string, c *config.EventSinks) (Interface, error) {
+	var ps publishers
+	if c == nil {
+		return ps, nil
+	}
+	names := map[string]bool{}
+	for _, s := range c.Sinks {
+		if !namePattern.MatchString(s.Name) {
+			return nil, fmt.Errorf("event sink name %q must consist of alphanumeric characters, '-' or '_'", s.Name)
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("event sink name %q must be unique", s.Name)
+		}
+		names[s.Name] = true
+		x, err := newSink(ctx, kubernetes, namespace, s)
+		if err != nil {
+			return nil, fmt.Errorf("event sink %q: %w", s.Name, err)
+		}
+		path := ""
+		if c.BufferPath != "" {
+			path = filepath.Join(c.BufferPath, s.Name+".jsonl")
+		}
+		b, err := newBuffer(c.GetBufferSize(), path)
+		if err != nil {
+			return nil, fmt.Errorf("event sink %q: %w", s.Name, err)
+		}
+		ps = append(ps, &publisher{name: s.Name, sink: x, buffer: b, nodeEvents: s.GetNodeEvents(), retry: retry})
+	}
+	return ps, nil
+}
+
+func newSink(ctx context.Context, kubernetes kubernetes.Interface, namespace string, s config.EventSink) (sink, error) {
+	secret := func(name, key string) (string, error) {
+		data, err := util.GetSecrets(ctx, kubernetes, namespace, name, key)
+		return string(data), err
+	}
+	switch {
+	case s.Kafka != nil && s.NATS == nil && s.HTTP == nil:
+		var user, password string
+		if s.Kafka.SASL != nil {
+			var err error
+			if user, err = secret(s.Kafka.SASL.UserSecret.Name, s.Kafka.SASL.UserSecret.Key); err != nil {
+				return nil, err
+			}
+			if password, err = secret(s.Kafka.SASL.PasswordSecret.Name, s.Kafka.SASL.PasswordSecret.Key); err != nil {
+				return nil, err
+			}
+		}
+		return newKafkaSink(*s.Kafka, user, password), nil
+	case s.NATS != nil && s.Kafka == nil && s.HTTP == nil:
+		var token string
+		if s.NATS.TokenSecret != nil {
+			var err error
+			if token, err = secret(s.NATS.TokenSecret.Name, s.NATS.TokenSecret.Key); err != nil {
+				return nil, err
+			}
+		}
+		return newNATSSink(*s.NATS, token), nil
+	case s.HTTP != nil && s.Kafka == nil && s.NATS == nil:
+		return newHTTPSink(*s.HTTP), nil
+	default:
+		return nil, fmt.Errorf("exactly one of kafka, nats, or http must be specified")
+	}
+}
+
+func (ps publishers) Publish(old, new *wfv1.Workflow) {
+	if len(ps) == 0 {
+		return
+	}
+	events := changes(old, new, time.Now().UTC())
+	if len(events) == 0 {
+		return
+	}
+	for _, p := range ps {
+		var x []Event
+		for _, e := range events {
+			if p.nodeEvents || !e.IsNodeEvent() {
+				x = append(x, e)
+			}
+		}
+		dropped, err := p.buffer.add(x)
+		logCtx := log.WithFields(log.Fields{"sink": p.name, "namespace": new.Namespace, "workflow": new.Name})
+		if err != nil {
+			logCtx.WithError(err).Error("failed to save buffered events")
+		}
+		if dropped > 0 {
+			logCtx.WithField("dropped", dropped).Warn("event sink buffer is full, dropping the oldest events")
+		}
+	}
+}
+
+func (ps publishers) Run(ctx context.Context) {
+	for _, p := range ps {
+		go p.run(ctx)
+	}
+}
+
+func (p *publisher) run(ctx context.Context) {
+	defer p.sink.close()
+	logCtx := log.WithField("sink", p.name)
+	backoff := p.retry
+	for {
+		events := p.buffer.peek(batchSize)
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-p.buffer.added:
+			}
+			continue
+		}
+		x := make([]Event, len(events))
+		for i, e := range events {
+			x[i] = e.event
+		}
+		if err := p.sink.send(ctx, x); err != nil {
+			delay := backoff.Step()
+			logCtx.WithError(err).WithFields(log.Fields{"events": len(x), "buffered": p.buffer.len(), "retryIn": delay}).Warn("failed to deliver events")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			continue
+		}
+		backoff = p.retry
+		logCtx.WithField("events", len(x)).Debug("events delivered")
+		if err := p.buffer.remove(events[len(events)-1].seq); err != nil {
+			logCtx.WithError(err).Error("failed to save buffered events")
+		}
+	}
+}
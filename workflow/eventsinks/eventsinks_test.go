@@ -0,0 +1,117 @@
+package eventsinks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type fakeSink struct {
+	mu     sync.Mutex
+	fail   int
+	events []Event
+}
+
+func (s *fakeSink) send(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail > 0 {
+		s.fail--
+		return errors.New("unavailable")
+	}
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *fakeSink) close() {}
+
+func (s *fakeSink) received() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func TestNew(t *testing.T) {
+	kube := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: "my-secret"},
+		Data:       map[string][]byte{"token": []byte("my-token")},
+	})
+	ctx := context.Background()
+	t.Run("None", func(t *testing.T) {
+		ps, err := New(ctx, kube, "argo", nil)
+		require.NoError(t, err)
+		assert.Empty(t, ps)
+	})
+	t.Run("Sinks", func(t *testing.T) {
+		ps, err := New(ctx, kube, "argo", &config.EventSinks{Sinks: []config.EventSink{
+			{Name: "kafka", Kafka: &config.KafkaEventSink{Brokers: []string{"kafka:9092"}, Topic: "my-topic"}},
+			{Name: "nats", NATS: &config.NATSEventSink{URL: "nats://nats:4222", Subject: "my-subject", TokenSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "token"}}},
+			{Name: "http", HTTP: &config.HTTPEventSink{URL: "http://my-url"}, NodeEvents: pointerBool(false)},
+		}})
+		require.NoError(t, err)
+		if assert.Len(t, ps, 3) {
+			p := ps.(publishers)
+			assert.IsType(t, &kafkaSink{}, p[0].sink)
+			assert.Equal(t, "my-token", p[1].sink.(*natsSink).token)
+			assert.True(t, p[1].nodeEvents)
+			assert.False(t, p[2].nodeEvents)
+		}
+	})
+	t.Run("InvalidName", func(t *testing.T) {
+		_, err := New(ctx, kube, "argo", &config.EventSinks{Sinks: []config.EventSink{{Name: "my/sink", HTTP: &config.HTTPEventSink{}}}})
+		assert.EqualError(t, err, `event sink name "my/sink" must consist of alphanumeric characters, '-' or '_'`)
+	})
+	t.Run("DuplicateName", func(t *testing.T) {
+		_, err := New(ctx, kube, "argo", &config.EventSinks{Sinks: []config.EventSink{{Name: "my-sink", HTTP: &config.HTTPEventSink{}}, {Name: "my-sink", HTTP: &config.HTTPEventSink{}}}})
+		assert.EqualError(t, err, `event sink name "my-sink" must be unique`)
+	})
+	t.Run("NoDestination", func(t *testing.T) {
+		_, err := New(ctx, kube, "argo", &config.EventSinks{Sinks: []config.EventSink{{Name: "my-sink"}}})
+		assert.EqualError(t, err, `event sink "my-sink": exactly one of kafka, nats, or http must be specified`)
+	})
+	t.Run("MissingSecret", func(t *testing.T) {
+		_, err := New(ctx, kube, "argo", &config.EventSinks{Sinks: []config.EventSink{{Name: "my-sink", NATS: &config.NATSEventSink{TokenSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "missing"}, Key: "token"}}}}})
+		assert.Error(t, err)
+	})
+}
+
+func pointerBool(b bool) *bool {
+	return &b
+}
+
+func TestPublishers(t *testing.T) {
+	allSink := &fakeSink{fail: 2}
+	workflowSink := &fakeSink{}
+	newPublisher := func(name string, s sink, nodeEvents bool) *publisher {
+		b, err := newBuffer(10, "")
+		require.NoError(t, err)
+		return &publisher{name: name, sink: s, buffer: b, nodeEvents: nodeEvents, retry: wait.Backoff{Duration: time.Millisecond, Steps: 10}}
+	}
+	ps := publishers{newPublisher("all", allSink, true), newPublisher("workflow", workflowSink, false)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ps.Run(ctx)
+
+	old := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"}}
+	new := old.DeepCopy()
+	new.Status.Phase = wfv1.WorkflowRunning
+	new.Status.Nodes = wfv1.Nodes{"my-wf": {ID: "my-wf", Phase: wfv1.NodeRunning}}
+	ps.Publish(old, new)
+
+	assert.Eventually(t, func() bool { return allSink.received() == 2 }, 5*time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return workflowSink.received() == 1 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, "my-uid/Running", workflowSink.events[0].ID)
+	assert.Eventually(t, func() bool { return ps[0].buffer.len() == 0 }, 5*time.Second, time.Millisecond)
+}
@@ -0,0 +1,50 @@
+package eventsinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// httpSink posts each event, as a structured CloudEvent, to an endpoint
+type httpSink struct {
+	config.HTTPEventSink
+	client *http.Client
+}
+
+func newHTTPSink(c config.HTTPEventSink) *httpSink {
+	return &httpSink{HTTPEventSink: c, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *httpSink) send(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		body, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, "POST", s.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/cloudevents+json")
+		for k, v := range s.Headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("%s responded %s", s.URL, resp.Status)
+		}
+	}
+	return nil
+}
+
+func (s *httpSink) close() {}
@@ -0,0 +1,39 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestHTTPSink(t *testing.T) {
+	var received []Event
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "my-value", r.Header.Get("X-My-Header"))
+		e := Event{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		received = append(received, e)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	s := newHTTPSink(config.HTTPEventSink{URL: server.URL, Headers: map[string]string{"X-My-Header": "my-value"}})
+
+	err := s.send(context.Background(), []Event{{ID: "1"}, {ID: "2"}})
+	assert.NoError(t, err)
+	if assert.Len(t, received, 2) {
+		assert.Equal(t, "1", received[0].ID)
+		assert.Equal(t, "2", received[1].ID)
+	}
+
+	status = http.StatusServiceUnavailable
+	err = s.send(context.Background(), []Event{{ID: "3"}})
+	assert.EqualError(t, err, server.URL+" responded 503 Service Unavailable")
+}
@@ -0,0 +1,70 @@
+package eventsinks
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// kafkaSink publishes events to a topic, keyed by workflow UID, waiting for all in-sync replicas to acknowledge them
+type kafkaSink struct {
+	config.KafkaEventSink
+	user, password string
+	// newProducer is replaced in tests
+	newProducer func(brokers []string, c *sarama.Config) (sarama.SyncProducer, error)
+	// producer is created when events are first sent, so the controller starts even if the brokers are unavailable
+	producer sarama.SyncProducer
+}
+
+func newKafkaSink(c config.KafkaEventSink, user, password string) *kafkaSink {
+	return &kafkaSink{KafkaEventSink: c, user: user, password: password, newProducer: sarama.NewSyncProducer}
+}
+
+func (s *kafkaSink) send(_ context.Context, events []Event) error {
+	if s.producer == nil {
+		c := sarama.NewConfig()
+		c.ClientID = "argo-workflows"
+		c.Producer.RequiredAcks = sarama.WaitForAll
+		c.Producer.Return.Successes = true
+		if s.TLS {
+			c.Net.TLS.Enable = true
+			c.Net.TLS.Config = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		if s.SASL != nil {
+			c.Net.SASL.Enable = true
+			c.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+			c.Net.SASL.User = s.user
+			c.Net.SASL.Password = s.password
+		}
+		producer, err := s.newProducer(s.Brokers, c)
+		if err != nil {
+			return err
+		}
+		s.producer = producer
+	}
+	messages := make([]*sarama.ProducerMessage, len(events))
+	for i, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		messages[i] = &sarama.ProducerMessage{
+			Topic: s.Topic,
+			Key:   sarama.StringEncoder(e.Data.UID),
+			Value: sarama.ByteEncoder(data),
+			// the structured mode of the CloudEvents Kafka binding
+			Headers: []sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte("application/cloudevents+json")}},
+		}
+	}
+	return s.producer.SendMessages(messages)
+}
+
+func (s *kafkaSink) close() {
+	if s.producer != nil {
+		_ = s.producer.Close()
+	}
+}
@@ -0,0 +1,45 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestKafkaSink(t *testing.T) {
+	s := newKafkaSink(config.KafkaEventSink{Brokers: []string{"kafka:9092"}, Topic: "my-topic", TLS: true, SASL: &config.KafkaSASL{}}, "my-user", "my-password")
+	producer := mocks.NewSyncProducer(t, nil)
+	s.newProducer = func(brokers []string, c *sarama.Config) (sarama.SyncProducer, error) {
+		assert.Equal(t, []string{"kafka:9092"}, brokers)
+		assert.Equal(t, sarama.WaitForAll, c.Producer.RequiredAcks)
+		assert.True(t, c.Net.TLS.Enable)
+		assert.True(t, c.Net.SASL.Enable)
+		assert.Equal(t, "my-user", c.Net.SASL.User)
+		assert.Equal(t, "my-password", c.Net.SASL.Password)
+		return producer, nil
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(m *sarama.ProducerMessage) error {
+		assert.Equal(t, "my-topic", m.Topic)
+		key, _ := m.Key.Encode()
+		assert.Equal(t, "my-uid", string(key))
+		value, _ := m.Value.Encode()
+		e := Event{}
+		require.NoError(t, json.Unmarshal(value, &e))
+		assert.Equal(t, "my-id", e.ID)
+		return nil
+	})
+	producer.ExpectSendMessageAndFail(sarama.ErrNotLeaderForPartition)
+
+	err := s.send(context.Background(), []Event{{ID: "my-id", Data: Data{UID: "my-uid"}}})
+	assert.NoError(t, err)
+	err = s.send(context.Background(), []Event{{ID: "my-id", Data: Data{UID: "my-uid"}}})
+	assert.Error(t, err)
+	s.close()
+}
@@ -0,0 +1,71 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// natsSink publishes events to a subject. With JetStream, each event is acknowledged, and its ID is its message ID, so
+// the stream ignores events delivered more than once
+type natsSink struct {
+	config.NATSEventSink
+	token string
+	// conn is created when events are first sent, so the controller starts even if the server is unavailable
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+func newNATSSink(c config.NATSEventSink, token string) *natsSink {
+	return &natsSink{NATSEventSink: c, token: token}
+}
+
+func (s *natsSink) send(ctx context.Context, events []Event) error {
+	if s.conn == nil {
+		opts := []nats.Option{nats.Name("argo-workflows"), nats.MaxReconnects(-1)}
+		if s.token != "" {
+			opts = append(opts, nats.Token(s.token))
+		}
+		conn, err := nats.Connect(s.URL, opts...)
+		if err != nil {
+			return err
+		}
+		if s.JetStream {
+			js, err := conn.JetStream()
+			if err != nil {
+				conn.Close()
+				return err
+			}
+			s.js = js
+		}
+		s.conn = conn
+	}
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if s.js != nil {
+			if _, err := s.js.Publish(s.Subject, data, nats.MsgId(e.ID), nats.Context(ctx)); err != nil {
+				return err
+			}
+		} else if err := s.conn.Publish(s.Subject, data); err != nil {
+			return err
+		}
+	}
+	if s.js == nil {
+		// this only means the server received the events, not that any subscriber did
+		return s.conn.FlushTimeout(10 * time.Second)
+	}
+	return nil
+}
+
+func (s *natsSink) close() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/antonmedv/expr"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,8 +19,10 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/utils/pointer"
 
+	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -27,6 +31,9 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	exprenv "github.com/argoproj/argo-workflows/v3/util/expr/env"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -39,6 +46,12 @@ type AgentExecutor struct {
 	Namespace         string
 	consideredTasks   map[string]bool
 	plugins           []executorplugins.TemplateExecutor
+	// maxResultSize is the size over which outputs are offloaded to a config map, zero means never
+	maxResultSize int
+	// newDriver returns the driver of the artifacts that tasks save
+	newDriver artifact.NewDriverFunc
+	// httpCache caches the responses of HTTP templates that have a cache
+	httpCache httpCache
 }
 
 type templateExecutor = func(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error)
@@ -53,12 +66,16 @@ func NewAgentExecutor(clientSet kubernetes.Interface, restClient rest.Interface,
 		WorkflowInterface: workflow.NewForConfigOrDie(config),
 		consideredTasks:   make(map[string]bool),
 		plugins:           plugins,
+		maxResultSize:     env.LookupEnvIntOr(common.EnvAgentMaxResultSize, 0),
+		newDriver:         artifact.NewDriver,
 	}
 }
 
 type task struct {
 	NodeId   string
 	Template wfv1.Template
+	// TaskSetUID is the UID of the task set, which owns any offloaded outputs
+	TaskSetUID types.UID
 }
 
 type response struct {
@@ -78,6 +95,9 @@ func (ae *AgentExecutor) Agent(ctx context.Context) error {
 	taskSetInterface := ae.WorkflowInterface.ArgoprojV1alpha1().WorkflowTaskSets(ae.Namespace)
 
 	go ae.patchWorker(ctx, taskSetInterface, responseQueue, requeueTime)
+	if interval := env.LookupEnvDurationOr(common.EnvVarHeartbeatInterval, 0); interval > 0 {
+		go ae.heartbeat(ctx, taskSetInterface, interval)
+	}
 	for i := 0; i < taskWorkers; i++ {
 		go ae.taskWorker(ctx, taskQueue, responseQueue)
 	}
@@ -106,7 +126,7 @@ func (ae *AgentExecutor) Agent(ctx context.Context) error {
 			}
 
 			for nodeID, tmpl := range taskSet.Spec.Tasks {
-				taskQueue <- task{NodeId: nodeID, Template: tmpl}
+				taskQueue <- task{NodeId: nodeID, Template: tmpl, TaskSetUID: taskSet.UID}
 			}
 		}
 	}
@@ -127,6 +147,7 @@ func (ae *AgentExecutor) taskWorker(ctx context.Context, taskQueue chan task, re
 		ae.consideredTasks[nodeID] = true
 
 		log.Info("Processing task")
+		ctx, span := tracing.StartSpan(ctx, "executeTask", append(tracing.WorkflowAttributes(ae.Namespace, ae.WorkflowName, ""), tracing.KeyNodeID.String(nodeID))...)
 		result, requeue, err := ae.processTask(ctx, tmpl)
 		if err != nil {
 			log.WithError(err).Error("Error in agent task")
@@ -135,7 +156,14 @@ func (ae *AgentExecutor) taskWorker(ctx context.Context, taskQueue chan task, re
 				Message: fmt.Sprintf("error processing task: %s", err),
 			}
 			// Do not return or continue here, the "errored" result still needs to be propagated to the responseQueue below
+		} else if err := ae.offloadOutputs(ctx, task.TaskSetUID, nodeID, result); err != nil {
+			log.WithError(err).Error("Failed to offload outputs")
+			result = &wfv1.NodeResult{
+				Phase:   wfv1.NodeError,
+				Message: fmt.Sprintf("task completed successfully but an error occurred when offloading its outputs: %s", err),
+			}
 		}
+		span.End()
 
 		log.
 			WithField("phase", result.Phase).
@@ -154,6 +182,51 @@ func (ae *AgentExecutor) taskWorker(ctx context.Context, taskQueue chan task, re
 	}
 }
 
+// offloadOutputs stores the outputs of a completed task in a config map if they are larger than the max result size, so
+// that many large results do not take the task set over the maximum size of a resource. The config map is owned by the
+// task set, so it is deleted with the workflow.
+func (ae *AgentExecutor) offloadOutputs(ctx context.Context, taskSetUID types.UID, nodeID string, result *wfv1.NodeResult) error {
+	if ae.maxResultSize <= 0 || result.Outputs == nil || !result.Fulfilled() {
+		return nil
+	}
+	data, err := json.Marshal(result.Outputs)
+	if err != nil {
+		return err
+	}
+	if len(data) <= ae.maxResultSize {
+		return nil
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.TaskResultConfigMapName(nodeID),
+			Labels: map[string]string{
+				common.LabelKeyWorkflow:      ae.WorkflowName,
+				common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapTaskResult,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: wfv1.SchemeGroupVersion.String(),
+				Kind:       wf.WorkflowTaskSetKind,
+				Name:       ae.WorkflowName,
+				UID:        taskSetUID,
+			}},
+		},
+		Data: map[string]string{common.TaskResultConfigMapKey: string(data)},
+	}
+	configMaps := ae.ClientSet.CoreV1().ConfigMaps(ae.Namespace)
+	_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	// the agent may have been restarted after it offloaded the outputs, but before it patched the result
+	if apierr.IsAlreadyExists(err) {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	ae.log.WithFields(log.Fields{"nodeID": nodeID, "size": len(data)}).Info("Offloaded outputs")
+	result.Outputs = nil
+	result.OutputsOffloaded = true
+	return nil
+}
+
 func (ae *AgentExecutor) patchWorker(ctx context.Context, taskSetInterface v1alpha1.WorkflowTaskSetInterface, responseQueue chan response, requeueTime time.Duration) {
 	ticker := time.NewTicker(requeueTime)
 	defer ticker.Stop()
@@ -205,11 +278,41 @@ func (ae *AgentExecutor) patchWorker(ctx context.Context, taskSetInterface v1alp
 	}
 }
 
+// heartbeat annotates the task set with the time, every interval, until the context is done, so the controller can
+// tell the agent is still running
+func (ae *AgentExecutor) heartbeat(ctx context.Context, taskSetInterface v1alpha1.WorkflowTaskSetInterface, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{
+			"annotations": map[string]string{common.AnnotationKeyHeartbeat: time.Now().UTC().Format(time.RFC3339)},
+		}})
+		if err != nil {
+			ae.log.WithError(err).Error("Generating Heartbeat Patch Failed")
+			return
+		}
+		if _, err := taskSetInterface.Patch(ctx, ae.WorkflowName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			ae.log.WithError(err).Warn("TaskSet Heartbeat Patch Failed")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (ae *AgentExecutor) processTask(ctx context.Context, tmpl wfv1.Template) (*wfv1.NodeResult, time.Duration, error) {
 	var executeTemplate templateExecutor
 	switch {
 	case tmpl.HTTP != nil:
 		executeTemplate = ae.executeHTTPTemplate
+	case tmpl.GRPC != nil:
+		executeTemplate = ae.executeGRPCTemplate
+	case tmpl.SQL != nil:
+		executeTemplate = ae.executeSQLTemplate
+	case tmpl.Notification != nil:
+		executeTemplate = ae.executeNotificationTemplate
 	case tmpl.Plugin != nil:
 		executeTemplate = ae.executePluginTemplate
 	default:
@@ -229,41 +332,42 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 		return 0, nil
 	}
 
-	response, err := ae.executeHTTPTemplateRequest(ctx, tmpl.HTTP)
-	if err != nil {
-		return 0, err
-	}
-	defer response.Body.Close()
-
-	bodyBytes, err := ioutil.ReadAll(response.Body)
+	response, err := ae.getHTTPResponse(ctx, tmpl.HTTP)
 	if err != nil {
 		return 0, err
 	}
+	bodyBytes := response.body
 
 	outputs := wfv1.Outputs{Result: pointer.StringPtr(string(bodyBytes))}
+	// the body is also available parsed, when it is JSON, so expressions need not call `jsonpath`
+	var bodyJSON interface{}
+	if err := json.Unmarshal(bodyBytes, &bodyJSON); err != nil {
+		bodyJSON = nil
+	}
+	evalScope := map[string]interface{}{
+		"request": map[string]interface{}{
+			"method":  tmpl.HTTP.Method,
+			"url":     tmpl.HTTP.URL,
+			"body":    tmpl.HTTP.Body,
+			"headers": tmpl.HTTP.Headers.ToHeader(),
+		},
+		"response": map[string]interface{}{
+			"statusCode": response.statusCode,
+			"body":       string(bodyBytes),
+			"json":       bodyJSON,
+			"headers":    response.header,
+		},
+	}
 	phase := wfv1.NodeSucceeded
 	message := ""
 	if tmpl.HTTP.SuccessCondition == "" {
 		// Default success condition: StatusCode == 2xx
-		success := response.StatusCode >= 200 && response.StatusCode < 300
+		success := response.statusCode >= 200 && response.statusCode < 300
 		if !success {
 			phase = wfv1.NodeFailed
-			message = fmt.Sprintf("received non-2xx response code: %d", response.StatusCode)
+			message = fmt.Sprintf("received non-2xx response code: %d", response.statusCode)
 		}
 	} else {
-		evalScope := map[string]interface{}{
-			"request": map[string]interface{}{
-				"method":  tmpl.HTTP.Method,
-				"url":     tmpl.HTTP.URL,
-				"body":    tmpl.HTTP.Body,
-				"headers": tmpl.HTTP.Headers.ToHeader(),
-			},
-			"response": map[string]interface{}{
-				"statusCode": response.StatusCode,
-				"body":       string(bodyBytes),
-				"headers":    response.Header,
-			},
-		}
 		success, err := argoexpr.EvalBool(tmpl.HTTP.SuccessCondition, evalScope)
 		if err != nil {
 			return 0, err
@@ -273,6 +377,12 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 			message = fmt.Sprintf("successCondition '%s' evaluated false", tmpl.HTTP.SuccessCondition)
 		}
 	}
+	if phase == wfv1.NodeSucceeded {
+		outputs.Parameters, err = httpOutputParameters(tmpl.Outputs.Parameters, bodyJSON, evalScope)
+		if err != nil {
+			return 0, err
+		}
+	}
 
 	result.Phase = phase
 	result.Message = message
@@ -280,6 +390,88 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 	return 0, nil
 }
 
+// httpOutputParameters extracts the output parameters of an HTTP template from the response, by the JSONPath of its
+// body, or by an expression over the same scope as the success condition
+func httpOutputParameters(params []wfv1.Parameter, bodyJSON interface{}, evalScope map[string]interface{}) ([]wfv1.Parameter, error) {
+	var outputs []wfv1.Parameter
+	for _, param := range params {
+		param := param
+		if param.ValueFrom != nil {
+			value, err := httpOutputParameterValue(param.ValueFrom, bodyJSON, evalScope)
+			if err != nil {
+				if param.ValueFrom.Default == nil {
+					return nil, fmt.Errorf("failed to get the value of output parameter %q: %w", param.Name, err)
+				}
+				value = param.ValueFrom.Default.String()
+			}
+			param.Value = wfv1.AnyStringPtr(value)
+		}
+		outputs = append(outputs, param)
+	}
+	return outputs, nil
+}
+
+func httpOutputParameterValue(valueFrom *wfv1.ValueFrom, bodyJSON interface{}, evalScope map[string]interface{}) (string, error) {
+	switch {
+	case valueFrom.JSONPath != "":
+		if bodyJSON == nil {
+			return "", fmt.Errorf("the response body is not JSON")
+		}
+		jp := jsonpath.New("")
+		if err := jp.Parse(valueFrom.JSONPath); err != nil {
+			return "", err
+		}
+		buf := &bytes.Buffer{}
+		if err := jp.Execute(buf, bodyJSON); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	case valueFrom.Expression != "":
+		value, err := expr.Eval(valueFrom.Expression, exprenv.GetFuncMap(evalScope))
+		if err != nil {
+			return "", err
+		}
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+		data, err := json.Marshal(value)
+		return string(data), err
+	}
+	return "", fmt.Errorf("jsonPath or expression must be specified")
+}
+
+// getHTTPResponse sends the request, or returns the cached response, if the template is cached
+func (ae *AgentExecutor) getHTTPResponse(ctx context.Context, httpTemplate *wfv1.HTTP) (*httpResponse, error) {
+	get := func() (*httpResponse, error) {
+		response, err := ae.executeHTTPTemplateRequest(ctx, httpTemplate)
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &httpResponse{statusCode: response.StatusCode, header: response.Header, body: body}, nil
+	}
+	if httpTemplate.Cache == nil {
+		return get()
+	}
+	ttl, err := httpTemplate.Cache.GetTTL()
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache TTL: %w", err)
+	}
+	key, err := httpCacheKey(httpTemplate)
+	if err != nil {
+		return nil, err
+	}
+	response, cached, err := ae.httpCache.do(key, ttl, httpTemplate.Cache.GetMaxSize(), get)
+	if cached {
+		ae.log.WithField("url", httpTemplate.URL).Debug("Using cached HTTP response")
+	}
+	return response, err
+}
+
 func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Response, error) {
 	request, err := http.NewRequest(httpTemplate.Method, httpTemplate.URL, bytes.NewBufferString(httpTemplate.Body))
 	if err != nil {
@@ -298,6 +490,7 @@ func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTem
 		}
 		request.Header.Add(header.Name, value)
 	}
+	tracing.InjectHTTPHeaders(ctx, request.Header)
 	httpClient := http.DefaultClient
 	if httpTemplate.TimeoutSeconds != nil {
 		httpClient.Timeout = time.Duration(*httpTemplate.TimeoutSeconds) * time.Second
@@ -328,6 +521,25 @@ func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Te
 	return 0, fmt.Errorf("no plugin executed the template")
 }
 
+// GetSecret returns the key of the secret, so that the agent can save artifacts
+func (ae *AgentExecutor) GetSecret(ctx context.Context, name, key string) (string, error) {
+	data, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, name, key)
+	return string(data), err
+}
+
+// GetConfigMapKey returns the key of the config map, so that the agent can save artifacts
+func (ae *AgentExecutor) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	cm, err := ae.ClientSet.CoreV1().ConfigMaps(ae.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := cm.Data[key]
+	if !ok {
+		return "", fmt.Errorf("config map %s does not have key %s", name, key)
+	}
+	return value, nil
+}
+
 func IsWorkflowCompleted(wts *wfv1.WorkflowTaskSet) bool {
 	return wts.Labels[common.LabelKeyCompleted] == "true"
 }
@@ -2,11 +2,24 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestUnsupportedTemplateTaskWorker(t *testing.T) {
@@ -31,3 +44,171 @@ func TestUnsupportedTemplateTaskWorker(t *testing.T) {
 	assert.Equal(t, v1alpha1.NodeError, response.Result.Phase)
 	assert.Contains(t, response.Result.Message, "agent cannot execute: unknown task type")
 }
+
+func TestOffloadOutputs(t *testing.T) {
+	ctx := context.Background()
+	ae := &AgentExecutor{
+		log:          log.WithField("workflow", "my-wf"),
+		WorkflowName: "my-wf",
+		Namespace:    "my-ns",
+		ClientSet:    fake.NewSimpleClientset(),
+	}
+	newResult := func() *v1alpha1.NodeResult {
+		return &v1alpha1.NodeResult{Phase: v1alpha1.NodeSucceeded, Outputs: &v1alpha1.Outputs{Result: pointer.StringPtr("my-result")}}
+	}
+	t.Run("Disabled", func(t *testing.T) {
+		result := newResult()
+		if assert.NoError(t, ae.offloadOutputs(ctx, "my-uid", "my-node", result)) {
+			assert.NotNil(t, result.Outputs)
+			assert.False(t, result.OutputsOffloaded)
+		}
+	})
+	t.Run("Small", func(t *testing.T) {
+		ae.maxResultSize = 1024
+		result := newResult()
+		if assert.NoError(t, ae.offloadOutputs(ctx, "my-uid", "my-node", result)) {
+			assert.NotNil(t, result.Outputs)
+			assert.False(t, result.OutputsOffloaded)
+		}
+	})
+	t.Run("Large", func(t *testing.T) {
+		ae.maxResultSize = 1
+		result := newResult()
+		if assert.NoError(t, ae.offloadOutputs(ctx, "my-uid", "my-node", result)) {
+			assert.Nil(t, result.Outputs)
+			assert.True(t, result.OutputsOffloaded)
+			cm, err := ae.ClientSet.CoreV1().ConfigMaps("my-ns").Get(ctx, common.TaskResultConfigMapName("my-node"), metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				assert.Equal(t, `{"result":"my-result"}`, cm.Data[common.TaskResultConfigMapKey])
+				assert.Equal(t, types.UID("my-uid"), cm.OwnerReferences[0].UID)
+			}
+		}
+		// offloading again, e.g. because the agent restarted, updates the config map
+		assert.NoError(t, ae.offloadOutputs(ctx, "my-uid", "my-node", newResult()))
+	})
+}
+
+func TestAgentHeartbeat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wfClientset := wffake.NewSimpleClientset(&v1alpha1.WorkflowTaskSet{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}})
+	taskSets := wfClientset.ArgoprojV1alpha1().WorkflowTaskSets("my-ns")
+	ae := &AgentExecutor{
+		log:          log.WithField("workflow", "my-wf"),
+		WorkflowName: "my-wf",
+		Namespace:    "my-ns",
+	}
+	done := make(chan struct{})
+	go func() {
+		ae.heartbeat(ctx, taskSets, time.Hour)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		taskSet, err := taskSets.Get(ctx, "my-wf", metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		_, err = time.Parse(time.RFC3339, taskSet.Annotations[common.AnnotationKeyHeartbeat])
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestAgentExecutor_executeHTTPTemplate(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "my-id")
+		_, _ = w.Write([]byte(`{"status": "ready", "items": [{"name": "a"}, {"name": "b"}]}`))
+	}))
+	defer server.Close()
+	ae := &AgentExecutor{log: log.WithField("workflow", "my-wf")}
+	execute := func(t *testing.T, successCondition string, params ...v1alpha1.Parameter) (*v1alpha1.NodeResult, error) {
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{
+			HTTP:    &v1alpha1.HTTP{URL: server.URL, SuccessCondition: successCondition},
+			Outputs: v1alpha1.Outputs{Parameters: params},
+		}, result)
+		return result, err
+	}
+	t.Run("SuccessConditionOverJSON", func(t *testing.T) {
+		result, err := execute(t, `response.json.status == "ready" && response.headers["X-Request-Id"][0] == "my-id"`)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		}
+		result, err = execute(t, `response.json.status == "done"`)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+			assert.Equal(t, `successCondition 'response.json.status == "done"' evaluated false`, result.Message)
+		}
+	})
+	t.Run("OutputParameters", func(t *testing.T) {
+		result, err := execute(t, "",
+			v1alpha1.Parameter{Name: "status", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "{.status}"}},
+			v1alpha1.Parameter{Name: "names", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "{.items[*].name}"}},
+			v1alpha1.Parameter{Name: "count", ValueFrom: &v1alpha1.ValueFrom{Expression: "len(response.json.items)"}},
+			v1alpha1.Parameter{Name: "id", ValueFrom: &v1alpha1.ValueFrom{Expression: `response.headers["X-Request-Id"][0]`}},
+			v1alpha1.Parameter{Name: "missing", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "{.missing}", Default: v1alpha1.AnyStringPtr("none")}},
+		)
+		if assert.NoError(t, err) && assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase) {
+			values := map[string]string{}
+			for _, p := range result.Outputs.Parameters {
+				values[p.Name] = p.Value.String()
+			}
+			assert.Equal(t, map[string]string{"status": "ready", "names": "a b", "count": "2", "id": "my-id", "missing": "none"}, values)
+		}
+	})
+	t.Run("OutputParameterNotFound", func(t *testing.T) {
+		_, err := execute(t, "", v1alpha1.Parameter{Name: "missing", ValueFrom: &v1alpha1.ValueFrom{JSONPath: "{.missing}"}})
+		assert.EqualError(t, err, `failed to get the value of output parameter "missing": missing is not found`)
+	})
+}
+
+func TestAgentExecutor_executeHTTPTemplateCache(t *testing.T) {
+	ctx := context.Background()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_, _ = fmt.Fprintf(w, `{"request": %d}`, n)
+	}))
+	defer server.Close()
+	ae := &AgentExecutor{log: log.WithField("workflow", "my-wf")}
+	execute := func(t *testing.T, path string, cache *v1alpha1.HTTPCache, headers ...v1alpha1.HTTPHeader) string {
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: server.URL + path, Headers: headers, Cache: cache}}, result)
+		assert.NoError(t, err)
+		return *result.Outputs.Result
+	}
+	t.Run("Cached", func(t *testing.T) {
+		cache := &v1alpha1.HTTPCache{TTL: "1h"}
+		first := execute(t, "/cached", cache)
+		assert.Equal(t, first, execute(t, "/cached", cache))
+		assert.NotEqual(t, first, execute(t, "/other", cache))
+	})
+	t.Run("Key", func(t *testing.T) {
+		cache := &v1alpha1.HTTPCache{Key: "my-key", TTL: "1h"}
+		assert.Equal(t, execute(t, "/a", cache, v1alpha1.HTTPHeader{Name: "X-Request-Id", Value: "1"}), execute(t, "/a", cache, v1alpha1.HTTPHeader{Name: "X-Request-Id", Value: "2"}))
+		assert.NotEqual(t, execute(t, "/a", cache), execute(t, "/b", cache), "requests to other URLs never share a response")
+	})
+	t.Run("Expired", func(t *testing.T) {
+		cache := &v1alpha1.HTTPCache{TTL: "1ns"}
+		first := execute(t, "/expired", cache)
+		time.Sleep(time.Millisecond)
+		assert.NotEqual(t, first, execute(t, "/expired", cache))
+	})
+	t.Run("Non2xx", func(t *testing.T) {
+		cache := &v1alpha1.HTTPCache{TTL: "1h"}
+		assert.NotEqual(t, execute(t, "/error", cache), execute(t, "/error", cache))
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		cache := &v1alpha1.HTTPCache{TTL: "1h", MaxSize: resource.NewQuantity(1, resource.BinarySI)}
+		assert.NotEqual(t, execute(t, "/large", cache), execute(t, "/large", cache))
+	})
+	t.Run("NotCached", func(t *testing.T) {
+		assert.NotEqual(t, execute(t, "/cached", nil), execute(t, "/cached", nil))
+	})
+}
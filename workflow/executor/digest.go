@@ -0,0 +1,124 @@
+package executor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// artifactDigest returns the SHA256 digest of the contents of the file, directory, or tarball, at the path. It is a
+// digest of the relative paths, and the contents, of the regular files, so that it does not change if only the times,
+// or modes, of the files do, and it is the same for a directory, and a tarball of it
+func artifactDigest(artPath string) (string, error) {
+	info, err := os.Stat(artPath)
+	if err != nil {
+		return "", err
+	}
+	isTar := false
+	if !info.IsDir() {
+		isTar, _ = isTarball(artPath)
+	}
+	var files map[string]string
+	if isTar {
+		files, err = tarballDigests(artPath)
+	} else {
+		files, err = fileDigests(artPath)
+	}
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\n", name, files[name])
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
+// fileDigests returns the digests of the regular files under the root, by their paths relative to it
+func fileDigests(root string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(filepath.Clean(p))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		d, err := readerDigest(f)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			rel = ""
+		}
+		files[filepath.ToSlash(rel)] = d
+		return nil
+	})
+	return files, err
+}
+
+// tarballDigests returns the digests of the regular files of the tarball, by their paths relative to its root, which is
+// the file, or directory, it is an archive of
+func tarballDigests(tarball string) (map[string]string, error) {
+	f, err := os.Open(filepath.Clean(tarball))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gzr.Close() }()
+	files := make(map[string]string)
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		} else {
+			name = ""
+		}
+		d, err := readerDigest(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = d
+	}
+}
+
+func readerDigest(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+)
+
+func TestArtifactDigest(t *testing.T) {
+	dir := t.TempDir()
+	artDir := filepath.Join(dir, "out")
+	require.NoError(t, os.MkdirAll(filepath.Join(artDir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(artDir, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(artDir, "sub", "b.txt"), []byte("b"), 0o600))
+	dirDigest, err := artifactDigest(artDir)
+	require.NoError(t, err)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", dirDigest)
+
+	t.Run("Times", func(t *testing.T) {
+		require.NoError(t, os.Chtimes(filepath.Join(artDir, "a.txt"), time.Now(), time.Now().Add(-time.Hour)))
+		d, err := artifactDigest(artDir)
+		require.NoError(t, err)
+		assert.Equal(t, dirDigest, d)
+	})
+	t.Run("Tarball", func(t *testing.T) {
+		tarball := filepath.Join(dir, "out.tgz")
+		f, err := os.Create(tarball)
+		require.NoError(t, err)
+		w := bufio.NewWriter(f)
+		require.NoError(t, archive.TarGzToWriter(artDir, 6, w))
+		require.NoError(t, f.Close())
+		d, err := artifactDigest(tarball)
+		require.NoError(t, err)
+		assert.Equal(t, dirDigest, d)
+	})
+	t.Run("Contents", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(artDir, "a.txt"), []byte("c"), 0o600))
+		d, err := artifactDigest(artDir)
+		require.NoError(t, err)
+		assert.NotEqual(t, dirDigest, d)
+	})
+}
@@ -155,6 +155,27 @@ func (d *DockerExecutor) GetOutputStream(ctx context.Context, containerName stri
 	return &cmdCloser{Reader: reader, cmd: cmd}, nil
 }
 
+func (d *DockerExecutor) GetErrorStream(ctx context.Context, containerName string) (io.ReadCloser, error) {
+	containerID, err := d.getContainerID(containerName)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command("docker", "logs", containerID)
+	log.Info(cmd.Args)
+
+	cmd.Stdout = ioutil.Discard
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return &cmdCloser{Reader: stderr, cmd: cmd}, nil
+}
+
 func (d *DockerExecutor) GetExitCode(ctx context.Context, containerName string) (string, error) {
 	containerID, err := d.getContainerID(containerName)
 	if err != nil {
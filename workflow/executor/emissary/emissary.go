@@ -39,9 +39,10 @@ If the container is named `main` it also copies base-layer artifacts to the shar
 * `/var/run/argo/outputs/parameters/${path}` All output parameters are copied here, e.g. `/tmp/message` is moved to `/var/run/argo/outputs/parameters/tmp/message`.
 * `/var/run/argo/outputs/artifacts/${path}.tgz` All output artifacts are copied here, e.g. `/tmp/message` is moved to /var/run/argo/outputs/artifacts/tmp/message.tgz`.
 
-The wait container can create one file itself, used for terminating the sub-process:
+The wait container can create these files itself:
 
 * `/var/run/argo/ctr/${containerName}/signal` The emissary binary listens to changes in this file, and signals the sub-process with the value found in this file.
+* `/var/run/argo/ctr/${containerName}/ready` Created when a sidecar with `waitForReady` is ready. The emissary does not start the main container until this file exists for all such sidecars.
 
 */
 type emissary struct{}
@@ -69,6 +70,10 @@ func (e emissary) writeTemplate(t wfv1.Template) error {
 	return ioutil.WriteFile("/var/run/argo/template", data, 0o444) // chmod -r--r--r--
 }
 
+func (e emissary) SignalReady(containerName string) error {
+	return ioutil.WriteFile(filepath.Clean("/var/run/argo/ctr/"+containerName+"/ready"), nil, 0o644)
+}
+
 func (e emissary) GetFileContents(_ string, sourcePath string) (string, error) {
 	data, err := ioutil.ReadFile(filepath.Clean(filepath.Join("/var/run/argo/outputs/parameters", sourcePath)))
 	return string(data), err
@@ -120,6 +125,14 @@ func (e emissary) GetOutputStream(_ context.Context, containerName string, combi
 	return newMultiReaderCloser(files...), nil
 }
 
+func (e emissary) GetErrorStream(_ context.Context, containerName string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Clean("/var/run/argo/ctr/" + containerName + "/stderr"))
+	if os.IsNotExist(err) {
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	}
+	return f, err
+}
+
 func (e emissary) Wait(ctx context.Context, containerNames []string) error {
 	for {
 		select {
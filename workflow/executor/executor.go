@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"encoding/json"
@@ -13,20 +14,28 @@ import (
 	"io/fs"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path"
 	"path/filepath"
+	"regexp"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
+	"unicode/utf8"
 
 	argofile "github.com/argoproj/pkg/file"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
 
 	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -34,16 +43,20 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/archive"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	osspecific "github.com/argoproj/argo-workflows/v3/workflow/executor/os-specific"
 	executorretry "github.com/argoproj/argo-workflows/v3/workflow/executor/retry"
 )
 
 const (
 	// This directory temporarily stores the tarballs of the artifacts before uploading
 	tempOutArtDir = "/tmp/argo/outputs/artifacts"
+	// The longest progress message, in bytes, reported to the controller
+	maxProgressMessageLength = 256
 )
 
 // WorkflowExecutor is program which runs as the init/wait container
@@ -52,11 +65,27 @@ type WorkflowExecutor struct {
 	Template            wfv1.Template
 	IncludeScriptOutput bool
 	Deadline            time.Time
+	// MaxOutputParameterSize is the maximum size, in bytes, of an output parameter, larger ones are truncated. 0 is no
+	// limit
+	MaxOutputParameterSize int
+	// MaxOutputArtifacts is the maximum number of output artifacts saved, the rest are not. 0 is no limit
+	MaxOutputArtifacts int
+	// ArtifactParallelism is the number of input artifacts loaded, or output artifacts saved, at the same time. 0 is one
+	// at a time
+	ArtifactParallelism int
+	// DigestArtifacts is whether the digest of each output artifact is recorded, as it is only needed by automatic
+	// memoization keys, and reads the whole of the artifact
+	DigestArtifacts bool
+	// ArtifactUploadFault is the name of the fault the controller injected, which fails the upload of output artifacts
+	ArtifactUploadFault string
 	ClientSet           kubernetes.Interface
 	RESTClient          rest.Interface
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
 
+	// memoLock serializes access to the memoized configmaps and secrets, which artifacts loaded, or saved, at the same
+	// time get their credentials from
+	memoLock sync.Mutex
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
 	// memoized secrets
@@ -64,9 +93,14 @@ type WorkflowExecutor struct {
 	// list of errors that occurred during execution.
 	// the first of these is used as the overall message of the node
 	errors []error
+	// why outputs were truncated, or not saved, because they were over the limits
+	truncations []string
 
-	// current progress which is synced every `annotationPatchTickDuration` to the pods annotations.
-	progress wfv1.Progress
+	// current progress, and its message, which are synced every `annotationPatchTickDuration` to the pods annotations.
+	progress        wfv1.Progress
+	progressMessage string
+	// checkpointLock serializes saves of the checkpoint, which all stage it to the same local path
+	checkpointLock sync.Mutex
 
 	annotationPatchTickDuration  time.Duration
 	readProgressFileTickDuration time.Duration
@@ -76,6 +110,20 @@ type Initializer interface {
 	Init(tmpl wfv1.Template) error
 }
 
+// ErrorStreamer is implemented by container runtime executors that can return the stderr of a container
+// separately from its stdout
+type ErrorStreamer interface {
+	// GetErrorStream returns the entirety of the container stderr as a io.Reader
+	GetErrorStream(ctx context.Context, containerName string) (io.ReadCloser, error)
+}
+
+// ReadinessSignaller is implemented by container runtime executors that can hold back the main container until
+// sidecars are ready
+type ReadinessSignaller interface {
+	// SignalReady tells the main container that the sidecar is ready
+	SignalReady(containerName string) error
+}
+
 //go:generate mockery --name=ContainerRuntimeExecutor
 
 // ContainerRuntimeExecutor is the interface for interacting with a container runtime (e.g. docker)
@@ -140,47 +188,74 @@ func (we *WorkflowExecutor) HandleError(ctx context.Context) {
 	}
 }
 
+// spanAttributes returns the attributes that link the spans of the executor to its workflow, and its node, whose ID is
+// the name of its pod
+func (we *WorkflowExecutor) spanAttributes(attributes ...attribute.KeyValue) []attribute.KeyValue {
+	return append(append(tracing.WorkflowAttributes(we.Namespace, os.Getenv(common.EnvVarWorkflowName), ""), tracing.KeyNodeID.String(we.PodName)), attributes...)
+}
+
 // LoadArtifacts loads artifacts from location to a container path
 func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, "loadArtifacts", we.spanAttributes()...)
+	err := we.loadArtifacts(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (we *WorkflowExecutor) loadArtifacts(ctx context.Context) error {
 	log.Infof("Start loading input artifacts...")
-	for _, art := range we.Template.Inputs.Artifacts {
+	return we.forEachArtifact(we.Template.Inputs.Artifacts, "load", func(i int) error {
+		return we.loadArtifact(ctx, we.Template.Inputs.Artifacts[i])
+	})
+}
 
-		log.Infof("Downloading artifact: %s", art.Name)
+func (we *WorkflowExecutor) loadArtifact(ctx context.Context, art wfv1.Artifact) error {
+	log.Infof("Downloading artifact: %s", art.Name)
 
-		if !art.HasLocationOrKey() {
-			if art.Optional {
-				log.Warnf("Ignoring optional artifact '%s' which was not supplied", art.Name)
-				continue
-			} else {
-				return argoerrs.Errorf(argoerrs.CodeNotFound, "required artifact '%s' not supplied", art.Name)
-			}
-		}
-		driverArt, err := we.newDriverArt(&art)
-		if err != nil {
-			return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
+	if !art.HasLocationOrKey() {
+		if art.Optional {
+			log.Warnf("Ignoring optional artifact '%s' which was not supplied", art.Name)
+			return nil
+		} else {
+			return argoerrs.Errorf(argoerrs.CodeNotFound, "required artifact '%s' not supplied", art.Name)
 		}
-		artDriver, err := we.InitDriver(ctx, driverArt)
+	}
+	driverArt, err := we.newDriverArt(&art)
+	if err != nil {
+		return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	// Determine the file path of where to load the artifact
+	if art.Path == "" {
+		return argoerrs.InternalErrorf("Artifact %s did not specify a path", art.Name)
+	}
+	var artPath string
+	mnt := common.FindOverlappingVolume(&we.Template, art.Path)
+	if mnt == nil {
+		artPath = path.Join(common.ExecutorArtifactBaseDir, art.Name)
+	} else {
+		// If we get here, it means the input artifact path overlaps with an user specified
+		// volumeMount in the container. Because we also implement input artifacts as volume
+		// mounts, we need to load the artifact into the user specified volume mount,
+		// as opposed to the `input-artifacts` volume that is an implementation detail
+		// unbeknownst to the user.
+		log.Infof("Specified artifact path %s overlaps with volume mount at %s. Extracting to volume mount", art.Path, mnt.MountPath)
+		artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
+	}
+
+	// If the driver can stream the artifact, a tarball is unpacked while it is downloaded, so we never need
+	// disk space for both the tarball and its contents.
+	streamed := false
+	if streamer, ok := artDriver.(artifactcommon.ArtifactStreamer); ok && art.GetArchive().None == nil && art.GetArchive().Zip == nil {
+		streamed, err = streamArtifact(streamer, driverArt, art.GetArchive().Tar != nil, artPath)
 		if err != nil {
-			return err
-		}
-		// Determine the file path of where to load the artifact
-		if art.Path == "" {
-			return argoerrs.InternalErrorf("Artifact %s did not specify a path", art.Name)
+			return fmt.Errorf("artifact %s failed to stream: %w", art.Name, err)
 		}
-		var artPath string
-		mnt := common.FindOverlappingVolume(&we.Template, art.Path)
-		if mnt == nil {
-			artPath = path.Join(common.ExecutorArtifactBaseDir, art.Name)
-		} else {
-			// If we get here, it means the input artifact path overlaps with an user specified
-			// volumeMount in the container. Because we also implement input artifacts as volume
-			// mounts, we need to load the artifact into the user specified volume mount,
-			// as opposed to the `input-artifacts` volume that is an implementation detail
-			// unbeknownst to the user.
-			log.Infof("Specified artifact path %s overlaps with volume mount at %s. Extracting to volume mount", art.Path, mnt.MountPath)
-			artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
-		}
-
+	}
+	if !streamed {
 		// The artifact is downloaded to a temporary location, after which we determine if
 		// the file is a tarball or not. If it is, it is first extracted then renamed to
 		// the desired location. If not, it is simply renamed to the location.
@@ -189,7 +264,7 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		if err != nil {
 			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
 				log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
-				continue
+				return nil
 			}
 			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
@@ -215,6 +290,13 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 		}
 
+		if isTar || isZip {
+			if err := checkFileDiskSpace(tempArtPath, artPath); err != nil {
+				_ = os.Remove(tempArtPath)
+				return fmt.Errorf("artifact %s cannot be unpacked: %w", art.Name, err)
+			}
+		}
+
 		if isTar {
 			err = untar(tempArtPath, artPath)
 			_ = os.Remove(tempArtPath)
@@ -227,13 +309,13 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+	}
 
-		log.Infof("Successfully download file: %s", artPath)
-		if art.Mode != nil {
-			err = chmod(artPath, *art.Mode, art.RecurseMode)
-			if err != nil {
-				return err
-			}
+	log.Infof("Successfully download file: %s", artPath)
+	if art.Mode != nil {
+		err = chmod(artPath, *art.Mode, art.RecurseMode)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -245,8 +327,11 @@ func (we *WorkflowExecutor) StageFiles() error {
 	var body []byte
 	switch we.Template.GetType() {
 	case wfv1.TemplateTypeScript:
-		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
+		if p := os.Getenv(common.EnvVarScriptSourcePath); p != "" {
+			filePath = p
+		}
+		log.Infof("Loading script source to %s", filePath)
 		body = []byte(we.Template.Script.Source)
 	case wfv1.TemplateTypeResource:
 		log.Infof("Loading manifest to %s", common.ExecutorResourceManifestPath)
@@ -274,21 +359,78 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) error {
 		return argoerrs.InternalWrapError(err)
 	}
 
-	for i, art := range we.Template.Outputs.Artifacts {
-		err := we.saveArtifact(ctx, common.MainContainerName, &art)
+	artifacts := we.Template.Outputs.Artifacts
+	if we.MaxOutputArtifacts > 0 && len(artifacts) > we.MaxOutputArtifacts {
+		for _, art := range artifacts[we.MaxOutputArtifacts:] {
+			we.addTruncation(fmt.Sprintf("output artifact '%s' was not saved, only %d output artifacts are saved", art.Name, we.MaxOutputArtifacts))
+		}
+		artifacts = artifacts[:we.MaxOutputArtifacts]
+	}
+	// each artifact is saved in place, so that its key, and any digest, are reported
+	return we.forEachArtifact(artifacts, "save", func(i int) error {
+		return we.saveArtifact(ctx, common.MainContainerName, &artifacts[i])
+	})
+}
+
+// forEachArtifact calls f with the index of each of the artifacts, with up to ArtifactParallelism calls at the same
+// time. Every artifact is attempted, even once one has failed, so that the error reports each that failed, rather than
+// only the first.
+func (we *WorkflowExecutor) forEachArtifact(artifacts []wfv1.Artifact, verb string, f func(i int) error) error {
+	parallelism := we.ArtifactParallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	errs := make([]error, len(artifacts))
+	sem := make(chan struct{}, parallelism)
+	wg := sync.WaitGroup{}
+	for i := range artifacts {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
+			defer func() { <-sem; wg.Done() }()
+			errs[i] = f(i)
+		}(i)
+	}
+	wg.Wait()
+	var failed []error
+	var messages []string
+	for i, err := range errs {
 		if err != nil {
-			return err
+			failed = append(failed, err)
+			messages = append(messages, fmt.Sprintf("%s: %v", artifacts[i].Name, err))
 		}
-		we.Template.Outputs.Artifacts[i] = art
 	}
-	return nil
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		return failed[0]
+	default:
+		return fmt.Errorf("%d of %d artifacts failed to %s: %s", len(failed), len(artifacts), verb, strings.Join(messages, "; "))
+	}
 }
 
 func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName string, art *wfv1.Artifact) error {
+	ctx, span := tracing.StartSpan(ctx, "saveArtifact", we.spanAttributes(tracing.KeyArtifactName.String(art.Name))...)
+	err := we.stageAndSaveArtifact(ctx, containerName, art)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (we *WorkflowExecutor) stageAndSaveArtifact(ctx context.Context, containerName string, art *wfv1.Artifact) error {
 	// Determine the file path of where to find the artifact
 	if art.Path == "" {
 		return argoerrs.InternalErrorf("Artifact %s did not specify a path", art.Name)
 	}
+	save, err := common.ShouldExecute(art.When)
+	if err != nil {
+		return err
+	}
+	if !save {
+		log.Infof("Skipping artifact '%s' as its 'when' expression '%s' evaluated false", art.Name, art.When)
+		return nil
+	}
 	fileName, localArtPath, err := we.stageArchiveFile(containerName, art)
 	if err != nil {
 		if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
@@ -297,11 +439,25 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 		}
 		return err
 	}
+	if we.DigestArtifacts {
+		digestPath := localArtPath
+		if !we.isBaseImagePath(art.Path) {
+			digestPath = filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
+		}
+		if digest, err := artifactDigest(digestPath); err != nil {
+			log.WithError(err).Warnf("Failed to digest artifact '%s'", art.Name)
+		} else {
+			art.Digest = digest
+		}
+	}
 	return we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
+	if we.ArtifactUploadFault != "" {
+		return fmt.Errorf("failed to upload artifact %s: fault %q injected by the controller", art.Name, we.ArtifactUploadFault)
+	}
 	if !art.HasKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
@@ -479,7 +635,33 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		return nil
 	}
 	log.Infof("Saving output parameters")
+	var logs *string
 	for i, param := range we.Template.Outputs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.LogsRegex != "" {
+			log.Infof("Saving logs regex output parameter: %s", param.Name)
+			if logs == nil {
+				reader, err := we.RuntimeExecutor.GetOutputStream(ctx, common.MainContainerName, true)
+				if err != nil {
+					return err
+				}
+				data, err := ioutil.ReadAll(reader)
+				_ = reader.Close()
+				if err != nil {
+					return argoerrs.InternalWrapError(err)
+				}
+				logs = pointer.StringPtr(string(data))
+			}
+			output, err := matchLogsRegex(param.ValueFrom.LogsRegex, *logs)
+			if err != nil {
+				if param.ValueFrom.Default == nil {
+					return err
+				}
+				output = param.ValueFrom.Default.String()
+			}
+			we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(we.truncateParameter(param.Name, output))
+			log.Infof("Successfully saved output parameter: %s", param.Name)
+			continue
+		}
 		log.Infof("Saving path output parameter: %s", param.Name)
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -523,15 +705,58 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		}
 
 		// Trims off a single newline for user convenience
-		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
+		output = wfv1.AnyStringPtr(we.truncateParameter(param.Name, strings.TrimSuffix(output.String(), "\n")))
 		we.Template.Outputs.Parameters[i].Value = output
 		log.Infof("Successfully saved output parameter: %s", param.Name)
 	}
 	return nil
 }
 
+// truncateParameter truncates the value of the output parameter to the maximum size of an output parameter, ending it
+// with a marker saying how large it was
+func (we *WorkflowExecutor) truncateParameter(name, value string) string {
+	if we.MaxOutputParameterSize <= 0 || len(value) <= we.MaxOutputParameterSize {
+		return value
+	}
+	marker := fmt.Sprintf("... [truncated, was %d bytes]", len(value))
+	size := we.MaxOutputParameterSize - len(marker)
+	if size < 0 {
+		size = 0
+	}
+	// do not end part-way through a multi-byte character
+	for size > 0 && !utf8.RuneStart(value[size]) {
+		size--
+	}
+	we.addTruncation(fmt.Sprintf("output parameter '%s' was truncated from %d bytes, the limit is %d bytes", name, len(value), we.MaxOutputParameterSize))
+	return value[:size] + marker
+}
+
+// addTruncation records that an output was truncated, or not saved
+func (we *WorkflowExecutor) addTruncation(message string) {
+	log.Warn(message)
+	we.truncations = append(we.truncations, message)
+}
+
+// matchLogsRegex returns the first capture group (or the whole match if there is no capture group) of the last match
+// of the regular expression in the logs
+func matchLogsRegex(expr, logs string) (string, error) {
+	r, err := regexp.Compile(expr)
+	if err != nil {
+		return "", argoerrs.Errorf(argoerrs.CodeBadRequest, "invalid logs regex %q: %v", expr, err)
+	}
+	matches := r.FindAllStringSubmatch(logs, -1)
+	if len(matches) == 0 {
+		return "", argoerrs.Errorf(argoerrs.CodeNotFound, "logs regex %q did not match the logs", expr)
+	}
+	last := matches[len(matches)-1]
+	if len(last) > 1 {
+		return last[1], nil
+	}
+	return last[0], nil
+}
+
 // SaveLogs saves logs
-func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error) {
+func (we *WorkflowExecutor) SaveLogs(ctx context.Context) ([]wfv1.Artifact, error) {
 	if !we.Template.SaveLogsAsArtifact() {
 		return nil, nil
 	}
@@ -547,12 +772,49 @@ func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error
 	if err != nil {
 		return nil, err
 	}
-	art := &wfv1.Artifact{Name: "main-logs"}
-	err = we.saveArtifactFromFile(ctx, art, fileName, mainLog)
+	art := wfv1.Artifact{Name: "main-logs"}
+	err = we.saveArtifactFromFile(ctx, &art, fileName, mainLog)
 	if err != nil {
 		return nil, err
 	}
-	return art, nil
+	arts := []wfv1.Artifact{art}
+	if !we.Template.SaveSplitLogsAsArtifacts() {
+		return arts, nil
+	}
+	errorStreamer, ok := we.RuntimeExecutor.(ErrorStreamer)
+	if !ok {
+		log.Warn("Container runtime executor does not support separate stderr, only the combined logs were saved")
+		return arts, nil
+	}
+	for _, stream := range []struct {
+		name string
+		get  func() (io.ReadCloser, error)
+	}{
+		{"stdout", func() (io.ReadCloser, error) {
+			return we.RuntimeExecutor.GetOutputStream(ctx, common.MainContainerName, false)
+		}},
+		{"stderr", func() (io.ReadCloser, error) {
+			return errorStreamer.GetErrorStream(ctx, common.MainContainerName)
+		}},
+	} {
+		fileName := fmt.Sprintf("main.%s.log", stream.name)
+		streamLog := path.Join(tempLogsDir, fileName)
+		reader, err := stream.get()
+		if err != nil {
+			return nil, err
+		}
+		err = saveStreamToFile(reader, streamLog)
+		if err != nil {
+			return nil, err
+		}
+		art := wfv1.Artifact{Name: "main-" + stream.name}
+		err = we.saveArtifactFromFile(ctx, &art, fileName, streamLog)
+		if err != nil {
+			return nil, err
+		}
+		arts = append(arts, art)
+	}
+	return arts, nil
 }
 
 // GetSecret will retrieve the Secrets from VolumeMount
@@ -566,16 +828,21 @@ func (we *WorkflowExecutor) GetSecret(ctx context.Context, accessKeyName string,
 
 // saveLogToFile saves the entire log output of a container to a local file
 func (we *WorkflowExecutor) saveLogToFile(ctx context.Context, containerName, path string) error {
-	outFile, err := os.Create(path)
-	if err != nil {
-		return argoerrs.InternalWrapError(err)
-	}
-	defer func() { _ = outFile.Close() }()
 	reader, err := we.RuntimeExecutor.GetOutputStream(ctx, containerName, true)
 	if err != nil {
 		return err
 	}
+	return saveStreamToFile(reader, path)
+}
+
+// saveStreamToFile copies the reader to a local file, closing the reader when done
+func saveStreamToFile(reader io.ReadCloser, path string) error {
 	defer func() { _ = reader.Close() }()
+	outFile, err := os.Create(path)
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	defer func() { _ = outFile.Close() }()
 	_, err = io.Copy(outFile, reader)
 	if err != nil {
 		return argoerrs.InternalWrapError(err)
@@ -618,6 +885,8 @@ func (we *WorkflowExecutor) getPod(ctx context.Context) (*apiv1.Pod, error) {
 
 // GetConfigMapKey retrieves a configmap value and memoizes the result
 func (we *WorkflowExecutor) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	we.memoLock.Lock()
+	defer we.memoLock.Unlock()
 	namespace := we.Namespace
 	cachedKey := fmt.Sprintf("%s/%s/%s", namespace, name, key)
 	if val, ok := we.memoizedConfigMaps[cachedKey]; ok {
@@ -647,6 +916,8 @@ func (we *WorkflowExecutor) GetConfigMapKey(ctx context.Context, name, key strin
 
 // GetSecrets retrieves a secret value and memoizes the result
 func (we *WorkflowExecutor) GetSecrets(ctx context.Context, namespace, name, key string) ([]byte, error) {
+	we.memoLock.Lock()
+	defer we.memoLock.Unlock()
 	cachedKey := fmt.Sprintf("%s/%s/%s", namespace, name, key)
 	if val, ok := we.memoizedSecrets[cachedKey]; ok {
 		return val, nil
@@ -683,7 +954,8 @@ func (we *WorkflowExecutor) GetTerminationGracePeriodDuration(ctx context.Contex
 	return terminationGracePeriodDuration, nil
 }
 
-// CaptureScriptResult will add the stdout of a script template as output result
+// CaptureScriptResult will add the stdout of a script template as output result, and the stderr as output stderr
+// when the container runtime executor is able to provide it separately
 func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 	if !we.IncludeScriptOutput {
 		log.Infof("No Script output reference in workflow. Capturing script output ignored")
@@ -698,10 +970,36 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	out, err := readOutput(reader)
+	if err != nil {
+		return err
+	}
+	we.Template.Outputs.Result = &out
+
+	errorStreamer, ok := we.RuntimeExecutor.(ErrorStreamer)
+	if !ok {
+		log.Infof("Container runtime executor does not support separate stderr. Capturing script stderr ignored")
+		return nil
+	}
+	log.Infof("Capturing script stderr")
+	reader, err = errorStreamer.GetErrorStream(ctx, common.MainContainerName)
+	if err != nil {
+		return err
+	}
+	stderr, err := readOutput(reader)
+	if err != nil {
+		return err
+	}
+	we.Template.Outputs.Stderr = &stderr
+	return nil
+}
+
+// readOutput reads the output of a container, truncating it to the maximum size of an output
+func readOutput(reader io.ReadCloser) (string, error) {
 	defer func() { _ = reader.Close() }()
 	bytes, err := ioutil.ReadAll(reader)
 	if err != nil {
-		return argoerrs.InternalWrapError(err)
+		return "", argoerrs.InternalWrapError(err)
 	}
 	out := string(bytes)
 	// Trims off a single newline for user convenience
@@ -715,22 +1013,27 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 	if len(out) > maxAnnotationSize {
 		log.Warnf("Output is larger than the maximum allowed size of 256 kB, only the last 256 kB were saved")
 		out = out[len(out)-maxAnnotationSize:]
+		// do not start part-way through a multi-byte character
+		for len(out) > 0 && !utf8.RuneStart(out[0]) {
+			out = out[1:]
+		}
 	}
-
-	we.Template.Outputs.Result = &out
-	return nil
+	return out, nil
 }
 
 // AnnotateOutputs annotation to the pod indicating all the outputs.
-func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArt *wfv1.Artifact) error {
+func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
-	if logArt != nil {
-		outputs.Artifacts = append(outputs.Artifacts, *logArt)
-	}
+	outputs.Artifacts = append(outputs.Artifacts, logArts...)
 
 	if !outputs.HasOutputs() {
 		return nil
 	}
+	if len(we.truncations) > 0 {
+		if err := we.AddAnnotation(ctx, common.AnnotationKeyOutputsTruncated, strings.Join(we.truncations, "; ")); err != nil {
+			return err
+		}
+	}
 	log.Infof("Annotating pod with output")
 	outputBytes, err := json.Marshal(outputs)
 	if err != nil {
@@ -758,6 +1061,11 @@ func (we *WorkflowExecutor) AddAnnotation(ctx context.Context, key, value string
 	return common.AddPodAnnotation(ctx, we.ClientSet, we.PodName, we.Namespace, key, value, executorretry.ExecutorRetry)
 }
 
+// AddAnnotations adds annotations to the pod, in one patch
+func (we *WorkflowExecutor) AddAnnotations(ctx context.Context, annotations map[string]string) error {
+	return common.AddPodAnnotations(ctx, we.ClientSet, we.PodName, we.Namespace, annotations, executorretry.ExecutorRetry)
+}
+
 // isTarball returns whether or not the file is a tarball
 func isTarball(filePath string) (bool, error) {
 	log.Infof("Detecting if %s is a tarball", filePath)
@@ -780,6 +1088,99 @@ func isTarball(filePath string) (bool, error) {
 	return err == nil, nil
 }
 
+// tarballPeekSize is how much of a stream we read to detect if it is a tarball
+const tarballPeekSize = 64 * 1024
+
+// isTarballStream determines if the stream is a tarball, without consuming it
+func isTarballStream(r *bufio.Reader) bool {
+	b, _ := r.Peek(tarballPeekSize)
+	gzr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return false
+	}
+	defer gzr.Close()
+	_, err = tar.NewReader(gzr).Next()
+	return err == nil
+}
+
+// streamArtifact opens the artifact as a stream and writes it to the path, unpacking it as it is read if it is a
+// tarball. Returns false if the artifact could not be found as a single object (e.g. it is a directory), in
+// which case it must be loaded instead.
+func streamArtifact(streamer artifactcommon.ArtifactStreamer, art *wfv1.Artifact, isTar bool, artPath string) (bool, error) {
+	rc, size, err := streamer.OpenStream(art)
+	if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = rc.Close() }()
+	if err := checkDiskSpace(filepath.Dir(artPath), size); err != nil {
+		return false, err
+	}
+	r := bufio.NewReaderSize(rc, tarballPeekSize)
+	if isTar || isTarballStream(r) {
+		return true, untarStream(r, artPath)
+	}
+	f, err := os.Create(filepath.Clean(artPath))
+	if err != nil {
+		return false, err
+	}
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return true, err
+}
+
+// checkFileDiskSpace checks there is enough disk space to unpack the archive file to the path
+func checkFileDiskSpace(archivePath string, destPath string) error {
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return err
+	}
+	return checkDiskSpace(filepath.Dir(destPath), info.Size())
+}
+
+// checkDiskSpace returns an error if there are fewer than required bytes available in the directory. This is a lower
+// bound, because archives are compressed, but means we fail with a clear error, rather than being evicted.
+func checkDiskSpace(dir string, required int64) error {
+	if required < 0 {
+		return nil
+	}
+	available, err := osspecific.AvailableDiskSpace(dir)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to determine the available disk space in %s", dir)
+		return nil
+	}
+	if available >= 0 && required > available {
+		return fmt.Errorf("not enough disk space in %s: requires at least %s, but only %s is available",
+			dir, resource.NewQuantity(required, resource.BinarySI), resource.NewQuantity(available, resource.BinarySI))
+	}
+	return nil
+}
+
+// untarStream extracts a tarball stream to a temporary directory,
+// renaming it to the desired location
+func untarStream(r *bufio.Reader, destPath string) error {
+	decompressor := func(_ string, dest string) error {
+		args := []string{"-xf", "-", "-C", dest}
+		if magic, _ := r.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+			// unlike files, tar cannot detect the compression of its standard input
+			args[0] = "-xzf"
+		}
+		cmd := exec.Command("tar", args...)
+		cmd.Stdin = r
+		log.Info(strings.Join(cmd.Args, " "))
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return argoerrs.InternalErrorf("`%s` failed: %v: %s", strings.Join(cmd.Args, " "), err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+
+	return unpack("", destPath, decompressor)
+}
+
 // untar extracts a tarball to a temporary directory,
 // renaming it to the desired location
 func untar(tarPath string, destPath string) error {
@@ -938,6 +1339,11 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 	}
 
 	go we.monitorDeadline(ctx, containerNames)
+	if sidecarNames := we.Template.GetWaitForReadySidecarNames(); len(sidecarNames) > 0 {
+		readinessCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go we.monitorSidecarReadiness(readinessCtx, sidecarNames)
+	}
 	err := waitutil.Backoff(executorretry.ExecutorRetry, func() (bool, error) {
 		err := we.RuntimeExecutor.Wait(ctx, containerNames)
 		return err == nil, err
@@ -949,10 +1355,68 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 	return nil
 }
 
+// Heartbeat annotates the pod with the time, every interval, until the context is done, so the controller can tell
+// the wait container is still running
+func (we *WorkflowExecutor) Heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := we.AddAnnotation(ctx, common.AnnotationKeyHeartbeat, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			log.WithError(err).Warn("failed to patch heartbeat annotation")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SaveCheckpoints saves the template's checkpoint, every interval, until the context is done
+func (we *WorkflowExecutor) SaveCheckpoints(ctx context.Context) {
+	if we.Template.Checkpoint == nil {
+		return
+	}
+	ticker := time.NewTicker(we.Template.Checkpoint.GetInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := we.SaveCheckpoint(ctx); err != nil {
+				log.WithError(err).Warn("failed to save checkpoint")
+			}
+		}
+	}
+}
+
+// SaveCheckpoint saves the template's checkpoint, if it has one, and the checkpoint exists, to the key of the checkpoint
+// artifact the controller added to the template's inputs
+func (we *WorkflowExecutor) SaveCheckpoint(ctx context.Context) error {
+	art := we.Template.Inputs.GetArtifactByName(common.CheckpointArtifactName)
+	if we.Template.Checkpoint == nil || art == nil {
+		return nil
+	}
+	// the checkpoint is in a volume of the main container, so it is mirrored into the wait container
+	if !argofile.Exists(filepath.Join(common.ExecutorMainFilesystemDir, art.Path)) {
+		log.Infof("No checkpoint %s to save", art.Path)
+		return nil
+	}
+	we.checkpointLock.Lock()
+	defer we.checkpointLock.Unlock()
+	log.Infof("Saving checkpoint %s", art.Path)
+	if err := os.MkdirAll(tempOutArtDir, os.ModePerm); err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	return we.saveArtifact(ctx, common.MainContainerName, art.DeepCopy())
+}
+
 // monitorProgress monitors for self-reported progress in the progressFile and patches the pod annotations with the parsed progress.
 //
 // The function reads the last line of the `progressFile` every `readFileTickDuration`.
-// If the line matches `N/M`, will set the progress annotation to the parsed progress value.
+// If the line matches `N/M`, optionally followed by a message, e.g. `3/10 downloading shards`, will set the progress
+// annotation to the parsed progress value, and the progress message annotation to the message.
 // Every `annotationPatchTickDuration` the pod is patched with the updated annotations. This way the controller
 // gets notified of new self reported progress.
 func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile string) {
@@ -962,6 +1426,8 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 	defer fileTicker.Stop()
 
 	lastLine := ""
+	// whether the pod has a progress message annotation, which must then be cleared when the message is
+	messageReported := false
 	progressFile = filepath.Clean(progressFile)
 
 	for {
@@ -971,9 +1437,15 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 			return
 		case <-annotationPatchTicker.C:
 			if we.progress != "" {
-				log.WithField("progress", we.progress).Infof("patching pod progress annotation")
-				if err := we.AddAnnotation(ctx, common.AnnotationKeyProgress, string(we.progress)); err != nil {
+				annotations := map[string]string{common.AnnotationKeyProgress: string(we.progress)}
+				if we.progressMessage != "" || messageReported {
+					annotations[common.AnnotationKeyProgressMessage] = we.progressMessage
+				}
+				log.WithField("progress", we.progress).WithField("message", we.progressMessage).Infof("patching pod progress annotation")
+				if err := we.AddAnnotations(ctx, annotations); err != nil {
 					log.WithField("progress", we.progress).WithError(err).Warn("failed to patch progress annotation")
+				} else if we.progressMessage != "" {
+					messageReported = true
 				}
 			}
 		case <-fileTicker.C:
@@ -992,9 +1464,10 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 			}
 			lastLine = mostRecent
 
-			if progress, ok := wfv1.ParseProgress(lastLine); ok {
-				log.WithField("progress", progress).Info()
+			if progress, message, ok := parseProgressLine(lastLine); ok {
+				log.WithField("progress", progress).WithField("message", message).Info()
 				we.progress = progress
+				we.progressMessage = message
 			} else {
 				log.WithField("line", lastLine).Info("unable to parse progress")
 			}
@@ -1002,6 +1475,68 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 	}
 }
 
+// parseProgressLine parses a line of the progress file, `N/M`, optionally followed by a message, which is truncated to
+// maxProgressMessageLength
+func parseProgressLine(line string) (wfv1.Progress, string, bool) {
+	parts := strings.SplitN(line, " ", 2)
+	progress, ok := wfv1.ParseProgress(parts[0])
+	if !ok {
+		return "", "", false
+	}
+	message := ""
+	if len(parts) == 2 {
+		message = strings.TrimSpace(parts[1])
+	}
+	if len(message) > maxProgressMessageLength {
+		// drop any rune that is cut in two
+		message = strings.ToValidUTF8(message[:maxProgressMessageLength], "")
+	}
+	return progress, message, true
+}
+
+// monitorSidecarReadiness polls the pod status and signals the main container as each sidecar becomes ready
+func (we *WorkflowExecutor) monitorSidecarReadiness(ctx context.Context, sidecarNames []string) {
+	signaller, ok := we.RuntimeExecutor.(ReadinessSignaller)
+	if !ok {
+		log.Warnf("Container runtime executor does not support waiting for sidecars to be ready, ignoring waitForReady for %q", sidecarNames)
+		return
+	}
+	pending := make(map[string]bool)
+	for _, n := range sidecarNames {
+		pending[n] = true
+	}
+	log.Infof("Starting sidecar readiness monitor for %q", sidecarNames)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Sidecar readiness monitor stopped")
+			return
+		case <-ticker.C:
+			pod, err := we.getPod(ctx)
+			if err != nil {
+				log.WithError(err).Warn("failed to get pod to check sidecar readiness")
+				continue
+			}
+			for _, s := range pod.Status.ContainerStatuses {
+				if !pending[s.Name] || !s.Ready {
+					continue
+				}
+				if err := signaller.SignalReady(s.Name); err != nil {
+					log.WithError(err).Warnf("failed to signal sidecar %q is ready", s.Name)
+					continue
+				}
+				log.Infof("Sidecar %q is ready", s.Name)
+				delete(pending, s.Name)
+			}
+			if len(pending) == 0 {
+				return
+			}
+		}
+	}
+}
+
 // monitorDeadline checks to see if we exceeded the deadline for the step and
 // terminates the main container if we did
 func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, containerNames []string) {
@@ -3,10 +3,15 @@ package executor
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
@@ -65,6 +71,51 @@ func TestWorkflowExecutor_LoadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutor_forEachArtifact(t *testing.T) {
+	artifacts := []wfv1.Artifact{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	t.Run("Parallelism", func(t *testing.T) {
+		we := WorkflowExecutor{ArtifactParallelism: 2}
+		var running, maxRunning int32
+		err := we.forEachArtifact(artifacts, "load", func(i int) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), maxRunning)
+	})
+	t.Run("OneFailed", func(t *testing.T) {
+		we := WorkflowExecutor{}
+		err := we.forEachArtifact(artifacts, "load", func(i int) error {
+			if i == 1 {
+				return fmt.Errorf("access denied")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, "access denied")
+	})
+	t.Run("SomeFailed", func(t *testing.T) {
+		we := WorkflowExecutor{ArtifactParallelism: 3}
+		attempted := int32(0)
+		err := we.forEachArtifact(artifacts, "save", func(i int) error {
+			atomic.AddInt32(&attempted, 1)
+			if i%2 == 0 {
+				return fmt.Errorf("access denied")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, "3 of 5 artifacts failed to save: a: access denied; c: access denied; e: access denied")
+		assert.Equal(t, int32(5), attempted, "every artifact is attempted")
+	})
+}
+
 func TestSaveParameters(t *testing.T) {
 	fakeClientset := fake.NewSimpleClientset()
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -213,6 +264,43 @@ func TestDefaultParametersEmptyString(t *testing.T) {
 	assert.Equal(t, "", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestReadOutput(t *testing.T) {
+	t.Run("TrimsNewline", func(t *testing.T) {
+		out, err := readOutput(ioutil.NopCloser(strings.NewReader("foo\n")))
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", out)
+	})
+	t.Run("Truncates", func(t *testing.T) {
+		out, err := readOutput(ioutil.NopCloser(strings.NewReader("x" + strings.Repeat("é", 256*(1<<10)))))
+		assert.NoError(t, err)
+		assert.True(t, len(out) <= 256*(1<<10))
+		assert.True(t, utf8.ValidString(out))
+		assert.True(t, strings.HasPrefix(out, "é"))
+	})
+}
+
+func TestMatchLogsRegex(t *testing.T) {
+	logs := "compiling...\nbuild id: 41\nbuild id: 42\n"
+	t.Run("CaptureGroup", func(t *testing.T) {
+		out, err := matchLogsRegex(`build id: (\d+)`, logs)
+		assert.NoError(t, err)
+		assert.Equal(t, "42", out)
+	})
+	t.Run("WholeMatch", func(t *testing.T) {
+		out, err := matchLogsRegex(`build id: \d+`, logs)
+		assert.NoError(t, err)
+		assert.Equal(t, "build id: 42", out)
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		_, err := matchLogsRegex(`version: (\d+)`, logs)
+		assert.Error(t, err)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := matchLogsRegex(`(`, logs)
+		assert.Error(t, err)
+	})
+}
+
 func TestIsTarball(t *testing.T) {
 	tests := []struct {
 		path      string
@@ -275,6 +363,60 @@ func TestUntar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// fileStreamer streams artifacts from the testdata directory
+type fileStreamer struct{}
+
+func (fileStreamer) OpenStream(a *wfv1.Artifact) (io.ReadCloser, int64, error) {
+	f, err := os.Open(filepath.Join("testdata", a.Name))
+	if os.IsNotExist(err) {
+		return nil, 0, argoerrs.New(argoerrs.CodeNotFound, err.Error())
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+func TestStreamArtifact(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		isTar    bool
+		streamed bool
+	}{
+		{"file", false, true},
+		{"file.gz", false, true},
+		{"file.tar", true, true},
+		{"file.tar.gz", false, true},
+		{"file.tgz", true, true},
+		{"not-found", false, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			destPath := filepath.Join(t.TempDir(), "streamedFile")
+			streamed, err := streamArtifact(fileStreamer{}, &wfv1.Artifact{Name: test.name}, test.isTar, destPath)
+			require.NoError(t, err)
+			assert.Equal(t, test.streamed, streamed)
+			if test.streamed {
+				fileInfo, err := os.Stat(destPath)
+				require.NoError(t, err)
+				assert.True(t, fileInfo.Mode().IsRegular())
+			}
+		})
+	}
+}
+
+func TestCheckDiskSpace(t *testing.T) {
+	assert.NoError(t, checkDiskSpace("testdata", -1))
+	assert.NoError(t, checkDiskSpace("testdata", 0))
+	err := checkDiskSpace("testdata", 1<<62)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "not enough disk space in testdata: requires at least 4Ei")
+	}
+}
+
 func TestChmod(t *testing.T) {
 	type perm struct {
 		dir  string
@@ -364,6 +506,46 @@ func TestSaveArtifacts(t *testing.T) {
 	we.Template.Outputs.Artifacts[0].Optional = false
 	err = we.SaveArtifacts(ctx)
 	assert.Error(t, err)
+
+	we.Template.Outputs.Artifacts[0].When = "false == true"
+	err = we.SaveArtifacts(ctx)
+	assert.NoError(t, err)
+
+	we.Template.Outputs.Artifacts[0].When = "true == true"
+	err = we.SaveArtifacts(ctx)
+	assert.Error(t, err)
+
+	we.Template.Outputs.Artifacts[0].When = "1 +"
+	err = we.SaveArtifacts(ctx)
+	assert.Error(t, err)
+}
+
+func TestArtifactUploadFault(t *testing.T) {
+	we := WorkflowExecutor{ArtifactUploadFault: "flaky"}
+	err := we.saveArtifactFromFile(context.Background(), &wfv1.Artifact{Name: "my-art"}, "my-art.tgz", "/tmp/my-art.tgz")
+	assert.EqualError(t, err, `failed to upload artifact my-art: fault "flaky" injected by the controller`)
+}
+
+func TestSaveCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mocks.ContainerRuntimeExecutor{},
+	}
+	t.Run("NoCheckpoint", func(t *testing.T) {
+		assert.NoError(t, we.SaveCheckpoint(ctx))
+	})
+	t.Run("NotWrittenYet", func(t *testing.T) {
+		we.Template = wfv1.Template{
+			Checkpoint: &wfv1.Checkpoint{Path: "/checkpoint/model.pt"},
+			Inputs: wfv1.Inputs{
+				Artifacts: []wfv1.Artifact{{Name: common.CheckpointArtifactName, Path: "/checkpoint/model.pt", Optional: true}},
+			},
+		}
+		assert.NoError(t, we.SaveCheckpoint(ctx))
+	})
 }
 
 func TestMonitorProgress(t *testing.T) {
@@ -423,7 +605,7 @@ func TestMonitorProgress(t *testing.T) {
 				return
 			case <-ticker:
 				t.Logf("tick progress=%d", progress)
-				_, err := fmt.Fprintf(f, "%d/100\n", progress*10)
+				_, err := fmt.Fprintf(f, "%d/100 step %d\n", progress*10, progress)
 				assert.NoError(t, err)
 				if progress >= maxProgress {
 					return
@@ -445,6 +627,7 @@ func TestMonitorProgress(t *testing.T) {
 			assert.NoError(t, err)
 			progress, ok := pod.Annotations[common.AnnotationKeyProgress]
 			if ok && progress == "100/100" {
+				assert.Equal(t, "step 10", pod.Annotations[common.AnnotationKeyProgressMessage])
 				t.Log("success reaching 100/100 progress")
 				return
 			}
@@ -452,3 +635,144 @@ func TestMonitorProgress(t *testing.T) {
 		}
 	}
 }
+
+func TestParseProgressLine(t *testing.T) {
+	for _, tt := range []struct {
+		line     string
+		progress wfv1.Progress
+		message  string
+		ok       bool
+	}{
+		{"3/10", "3/10", "", true},
+		{"3/10 downloading shards", "3/10", "downloading shards", true},
+		{"3/10   ", "3/10", "", true},
+		{"downloading shards", "", "", false},
+		{"3/10 " + strings.Repeat("é", 200), "3/10", strings.Repeat("é", 128), true},
+	} {
+		t.Run(tt.line, func(t *testing.T) {
+			progress, message, ok := parseProgressLine(tt.line)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.progress, progress)
+			assert.Equal(t, tt.message, message)
+		})
+	}
+}
+
+type readinessSignallingExecutor struct {
+	mocks.ContainerRuntimeExecutor
+	ready []string
+}
+
+func (e *readinessSignallingExecutor) SignalReady(containerName string) error {
+	e.ready = append(e.ready, containerName)
+	return nil
+}
+
+func TestMonitorSidecarReadiness(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fakeClientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakePodName,
+			Namespace: fakeNamespace,
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "main"},
+				{Name: "db", Ready: true},
+			},
+		},
+	})
+	runtimeExecutor := &readinessSignallingExecutor{}
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		ClientSet:       fakeClientset,
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: runtimeExecutor,
+	}
+	we.monitorSidecarReadiness(ctx, []string{"db"})
+	assert.NoError(t, ctx.Err())
+	assert.Equal(t, []string{"db"}, runtimeExecutor.ready)
+}
+
+func TestHeartbeat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fakeClientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakePodName,
+			Namespace: fakeNamespace,
+		},
+	})
+	we := WorkflowExecutor{
+		PodName:   fakePodName,
+		ClientSet: fakeClientset,
+		Namespace: fakeNamespace,
+	}
+	done := make(chan struct{})
+	go func() {
+		we.Heartbeat(ctx, time.Hour)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		pod, err := fakeClientset.CoreV1().Pods(fakeNamespace).Get(ctx, fakePodName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		_, err = time.Parse(time.RFC3339, pod.Annotations[common.AnnotationKeyHeartbeat])
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestOutputLimits(t *testing.T) {
+	ctx := context.Background()
+	fakeClientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakePodName,
+			Namespace: fakeNamespace,
+		},
+	})
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/small").Return("small", nil)
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/large").Return(strings.Repeat("ü", 50), nil)
+	we := WorkflowExecutor{
+		PodName:                fakePodName,
+		ClientSet:              fakeClientset,
+		Namespace:              fakeNamespace,
+		RuntimeExecutor:        &mockRuntimeExecutor,
+		MaxOutputParameterSize: 41,
+		MaxOutputArtifacts:     1,
+		Template: wfv1.Template{
+			Inputs: wfv1.Inputs{
+				Artifacts: []wfv1.Artifact{{Name: "optional", Path: "/optional"}},
+			},
+			Outputs: wfv1.Outputs{
+				Parameters: []wfv1.Parameter{
+					{Name: "small", ValueFrom: &wfv1.ValueFrom{Path: "/small"}},
+					{Name: "large", ValueFrom: &wfv1.ValueFrom{Path: "/large"}},
+				},
+				Artifacts: []wfv1.Artifact{
+					{Name: "optional", Path: "/optional", Optional: true},
+					// would fail to save, as it does not exist
+					{Name: "required", Path: "/required"},
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, we.SaveParameters(ctx))
+	assert.Equal(t, "small", we.Template.Outputs.Parameters[0].Value.String())
+	large := we.Template.Outputs.Parameters[1].Value.String()
+	assert.Equal(t, "üüüüü... [truncated, was 100 bytes]", large)
+	assert.LessOrEqual(t, len(large), 41)
+
+	assert.NoError(t, we.SaveArtifacts(ctx))
+
+	assert.NoError(t, we.AnnotateOutputs(ctx, nil))
+	pod, err := fakeClientset.CoreV1().Pods(fakeNamespace).Get(ctx, fakePodName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "output parameter 'large' was truncated from 100 bytes, the limit is 41 bytes; output artifact 'required' was not saved, only 1 output artifacts are saved", pod.Annotations[common.AnnotationKeyOutputsTruncated])
+	assert.Contains(t, pod.Annotations, common.AnnotationKeyOutputs)
+}
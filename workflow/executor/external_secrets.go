@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/secrets"
+)
+
+// LoadExternalSecrets writes the secrets of the input parameters that are read from secret providers to files, whose
+// paths are the values of the parameters
+func (we *WorkflowExecutor) LoadExternalSecrets(ctx context.Context) error {
+	if !we.Template.Inputs.HasExternalSecrets() {
+		return nil
+	}
+	var providers wfv1.SecretProviders
+	if err := json.Unmarshal([]byte(os.Getenv(common.EnvVarSecretProviders)), &providers); err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	clients := make(map[string]secrets.Provider)
+	for _, param := range we.Template.Inputs.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.ExternalSecret == nil {
+			continue
+		}
+		selector := param.ValueFrom.ExternalSecret
+		client, ok := clients[selector.Provider]
+		if !ok {
+			provider := providers.Get(selector.Provider)
+			if provider == nil {
+				return argoerrs.Errorf(argoerrs.CodeNotFound, "secret provider %q not found", selector.Provider)
+			}
+			var err error
+			client, err = secrets.NewProvider(ctx, *provider, we)
+			if err != nil {
+				return fmt.Errorf("failed to create secret provider %q: %w", selector.Provider, err)
+			}
+			clients[selector.Provider] = client
+		}
+		value, err := client.GetSecret(ctx, selector.Path, selector.Key)
+		if err != nil {
+			return fmt.Errorf("failed to read the secret of input parameter '%s': %w", param.Name, err)
+		}
+		path := filepath.Join(common.ExecutorExternalSecretsDir, param.Name)
+		if err := ioutil.WriteFile(path, []byte(value), 0o444); err != nil {
+			return argoerrs.InternalWrapError(err)
+		}
+		log.Infof("Loaded the secret of input parameter %s to %s", param.Name, path)
+	}
+	return nil
+}
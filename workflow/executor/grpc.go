@@ -0,0 +1,292 @@
+package executor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
+)
+
+func (ae *AgentExecutor) executeGRPCTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+	g := tmpl.GRPC
+	if g == nil {
+		return 0, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(g.GetTimeoutSeconds())*time.Second)
+	defer cancel()
+
+	conn, err := ae.dialGRPC(ctx, g)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	method, err := ae.grpcMethod(ctx, conn, g)
+	if err != nil {
+		return 0, err
+	}
+	request := dynamicpb.NewMessage(method.Input())
+	if g.Request != "" {
+		if err := protojson.Unmarshal([]byte(g.Request), request); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+	}
+	md, err := ae.grpcMetadata(ctx, g.Metadata)
+	if err != nil {
+		return 0, err
+	}
+	response := dynamicpb.NewMessage(method.Output())
+	header := metadata.MD{}
+	callErr := conn.Invoke(metadata.NewOutgoingContext(ctx, md), "/"+strings.TrimPrefix(g.Method, "/"), request, response, grpc.Header(&header))
+	st := status.Convert(callErr)
+	body := ""
+	if callErr == nil {
+		data, err := protojson.Marshal(response)
+		if err != nil {
+			return 0, err
+		}
+		body = string(data)
+	}
+
+	outputs := wfv1.Outputs{Result: pointer.StringPtr(body)}
+	phase := wfv1.NodeSucceeded
+	message := ""
+	if g.SuccessCondition == "" {
+		// Default success condition: the status code is OK
+		if callErr != nil {
+			phase = wfv1.NodeFailed
+			message = fmt.Sprintf("received non-OK status code: %s: %s", st.Code(), st.Message())
+		}
+	} else {
+		evalScope := map[string]interface{}{
+			"request": map[string]interface{}{
+				"target":   g.Target,
+				"method":   g.Method,
+				"body":     g.Request,
+				"metadata": map[string][]string(md),
+			},
+			"response": map[string]interface{}{
+				"code":     st.Code().String(),
+				"message":  st.Message(),
+				"body":     body,
+				"metadata": map[string][]string(header),
+			},
+		}
+		success, err := argoexpr.EvalBool(g.SuccessCondition, evalScope)
+		if err != nil {
+			return 0, err
+		}
+		if !success {
+			phase = wfv1.NodeFailed
+			message = fmt.Sprintf("successCondition '%s' evaluated false", g.SuccessCondition)
+		}
+	}
+
+	result.Phase = phase
+	result.Message = message
+	result.Outputs = &outputs
+	return 0, nil
+}
+
+func (ae *AgentExecutor) dialGRPC(ctx context.Context, g *wfv1.GRPC) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if g.TLS != nil {
+		config, err := ae.grpcTLSConfig(ctx, g.TLS)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(config)
+	}
+	return grpc.DialContext(ctx, g.Target, grpc.WithTransportCredentials(creds))
+}
+
+func (ae *AgentExecutor) grpcTLSConfig(ctx context.Context, t *wfv1.GRPCTLS) (*tls.Config, error) {
+	config := &tls.Config{ServerName: t.ServerName, InsecureSkipVerify: t.InsecureSkipVerify, MinVersion: tls.VersionTLS12}
+	if t.CACertSecret != nil {
+		caCert, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, t.CACertSecret.Name, t.CACertSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certificate in secret %s", t.CACertSecret.Name)
+		}
+	}
+	if t.ClientCertSecret != nil && t.ClientKeySecret != nil {
+		clientCert, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, t.ClientCertSecret.Name, t.ClientCertSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		clientKey, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, t.ClientKeySecret.Name, t.ClientKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+func (ae *AgentExecutor) grpcMetadata(ctx context.Context, headers wfv1.HTTPHeaders) (metadata.MD, error) {
+	md := metadata.MD{}
+	for _, header := range headers {
+		value := header.Value
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+			secret, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
+			if err != nil {
+				return nil, err
+			}
+			value = string(secret)
+		}
+		md.Append(header.Name, value)
+	}
+	tracing.InjectGRPCMetadata(ctx, md)
+	return md, nil
+}
+
+// grpcMethod returns the descriptor of the method, from the descriptor set, or the server's reflection service
+func (ae *AgentExecutor) grpcMethod(ctx context.Context, conn *grpc.ClientConn, g *wfv1.GRPC) (protoreflect.MethodDescriptor, error) {
+	parts := strings.Split(strings.TrimPrefix(g.Method, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("method %q must be the full name of the method, e.g. my.package.MyService/MyMethod", g.Method)
+	}
+	serviceName, methodName := parts[0], parts[1]
+	var set *descriptorpb.FileDescriptorSet
+	var err error
+	if g.DescriptorSet != nil {
+		set, err = ae.grpcDescriptorSet(ctx, g.DescriptorSet)
+	} else {
+		set, err = reflectFileDescriptorSet(ctx, conn, serviceName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptor set: %w", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, fmt.Errorf("service %q not found: %w", serviceName, err)
+	}
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a service", serviceName)
+	}
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, fmt.Errorf("method %q not found in service %q", methodName, serviceName)
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil, fmt.Errorf("method %q is a streaming method, which is not supported", g.Method)
+	}
+	return method, nil
+}
+
+func (ae *AgentExecutor) grpcDescriptorSet(ctx context.Context, selector *corev1.ConfigMapKeySelector) (*descriptorpb.FileDescriptorSet, error) {
+	cm, err := ae.ClientSet.CoreV1().ConfigMaps(ae.Namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data, ok := cm.BinaryData[selector.Key]
+	if !ok {
+		value, ok := cm.Data[selector.Key]
+		if !ok {
+			return nil, fmt.Errorf("config map %s does not have key %s", selector.Name, selector.Key)
+		}
+		data = []byte(value)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal file descriptor set in config map %s: %w", selector.Name, err)
+	}
+	return set, nil
+}
+
+// reflectFileDescriptorSet returns the file that defines the symbol, and its dependencies, from the server's reflection
+// service. Dependencies that the server does not return, e.g. the well-known types, are taken from those linked into
+// this binary.
+func reflectFileDescriptorSet(ctx context.Context, conn *grpc.ClientConn, symbol string) (*descriptorpb.FileDescriptorSet, error) {
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.CloseSend() }()
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	add := func(file *descriptorpb.FileDescriptorProto) {
+		if !seen[file.GetName()] {
+			seen[file.GetName()] = true
+			set.File = append(set.File, file)
+		}
+	}
+	request := func(req *reflectionpb.ServerReflectionRequest) error {
+		if err := stream.Send(req); err != nil {
+			return err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if e := resp.GetErrorResponse(); e != nil {
+			return fmt.Errorf("server reflection failed: %s", e.GetErrorMessage())
+		}
+		for _, data := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			file := &descriptorpb.FileDescriptorProto{}
+			if err := proto.Unmarshal(data, file); err != nil {
+				return err
+			}
+			add(file)
+		}
+		return nil
+	}
+	if err := request(&reflectionpb.ServerReflectionRequest{MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: symbol}}); err != nil {
+		return nil, err
+	}
+	// the set grows as the dependencies of each file are added
+	for i := 0; i < len(set.File); i++ {
+		for _, dep := range set.File[i].GetDependency() {
+			if seen[dep] {
+				continue
+			}
+			if file, err := protoregistry.GlobalFiles.FindFileByPath(dep); err == nil {
+				add(protodesc.ToFileDescriptorProto(file))
+				continue
+			}
+			if err := request(&reflectionpb.ServerReflectionRequest{MessageRequest: &reflectionpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep}}); err != nil {
+				return nil, err
+			}
+			if !seen[dep] {
+				return nil, fmt.Errorf("server reflection did not return %s", dep)
+			}
+		}
+	}
+	return set, nil
+}
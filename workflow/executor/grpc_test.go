@@ -0,0 +1,111 @@
+package executor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// newGRPCServer starts a server with the health service, and optionally the reflection service, and returns its address
+func newGRPCServer(t *testing.T, reflect bool) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	if reflect {
+		reflection.Register(s)
+	}
+	go func() { _ = s.Serve(listener) }()
+	t.Cleanup(s.Stop)
+	return listener.Addr().String()
+}
+
+func TestAgentExecutor_executeGRPCTemplate(t *testing.T) {
+	ctx := context.Background()
+	target := newGRPCServer(t, true)
+	set, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto)}})
+	require.NoError(t, err)
+	ae := &AgentExecutor{
+		log:       log.WithField("workflow", "my-wf"),
+		Namespace: "my-ns",
+		ClientSet: fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-descriptors", Namespace: "my-ns"},
+			BinaryData: map[string][]byte{"health.pb": set},
+		}),
+	}
+	execute := func(t *testing.T, g *v1alpha1.GRPC) (*v1alpha1.NodeResult, error) {
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeGRPCTemplate(ctx, v1alpha1.Template{GRPC: g}, result)
+		return result, err
+	}
+	t.Run("Reflection", func(t *testing.T) {
+		result, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check", Request: `{}`})
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+			assert.JSONEq(t, `{"status":"SERVING"}`, *result.Outputs.Result)
+		}
+	})
+	t.Run("DescriptorSet", func(t *testing.T) {
+		target := newGRPCServer(t, false)
+		result, err := execute(t, &v1alpha1.GRPC{
+			Target:        target,
+			Method:        "grpc.health.v1.Health/Check",
+			DescriptorSet: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-descriptors"}, Key: "health.pb"},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+			assert.JSONEq(t, `{"status":"SERVING"}`, *result.Outputs.Result)
+		}
+	})
+	t.Run("NoReflection", func(t *testing.T) {
+		target := newGRPCServer(t, false)
+		_, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check"})
+		assert.Error(t, err)
+	})
+	t.Run("NonOKStatusCode", func(t *testing.T) {
+		result, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check", Request: `{"service": "my-service"}`})
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+			assert.Equal(t, "received non-OK status code: NotFound: unknown service", result.Message)
+		}
+	})
+	t.Run("SuccessCondition", func(t *testing.T) {
+		result, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check", Request: `{"service": "my-service"}`, SuccessCondition: `response.code == "NotFound"`})
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+		}
+		result, err = execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check", SuccessCondition: `response.body contains "NOT_SERVING"`})
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+			assert.Equal(t, `successCondition 'response.body contains "NOT_SERVING"' evaluated false`, result.Message)
+		}
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Check", Request: `{"foo": "bar"}`})
+		assert.Contains(t, err.Error(), "failed to unmarshal request")
+	})
+	t.Run("InvalidMethod", func(t *testing.T) {
+		_, err := execute(t, &v1alpha1.GRPC{Target: target, Method: "Check"})
+		assert.EqualError(t, err, `method "Check" must be the full name of the method, e.g. my.package.MyService/MyMethod`)
+		_, err = execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Foo"})
+		assert.EqualError(t, err, `method "Foo" not found in service "grpc.health.v1.Health"`)
+		_, err = execute(t, &v1alpha1.GRPC{Target: target, Method: "grpc.health.v1.Health/Watch"})
+		assert.EqualError(t, err, `method "grpc.health.v1.Health/Watch" is a streaming method, which is not supported`)
+	})
+}
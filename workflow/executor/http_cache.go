@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// httpResponse is the part of the response of an HTTP template that its success condition and outputs are evaluated over
+type httpResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+type httpCacheEntry struct {
+	response *httpResponse
+	expires  time.Time
+}
+
+// httpCache caches the responses of HTTP templates for the life of the agent. Concurrent requests with the same key,
+// e.g. from the nodes of a fan-out, wait for the first one, rather than each sending the request
+type httpCache struct {
+	group   singleflight.Group
+	mu      sync.Mutex
+	entries map[string]httpCacheEntry
+}
+
+// do returns the cached response, or calls get and caches the response if it is 2xx and no larger than maxSize
+func (c *httpCache) do(key string, ttl time.Duration, maxSize int64, get func() (*httpResponse, error)) (*httpResponse, bool, error) {
+	if response := c.get(key); response != nil {
+		return response, true, nil
+	}
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		response, err := get()
+		if err != nil {
+			return nil, err
+		}
+		if response.statusCode >= 200 && response.statusCode < 300 && int64(len(response.body)) <= maxSize {
+			c.put(key, response, time.Now().Add(ttl))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return v.(*httpResponse), false, nil
+}
+
+func (c *httpCache) get(key string) *httpResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil
+	}
+	return entry.response
+}
+
+// put caches the response, and removes the expired responses, so that the cache does not grow forever
+func (c *httpCache) put(key string, response *httpResponse, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]httpCacheEntry)
+	}
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = httpCacheEntry{response: response, expires: expires}
+}
+
+// httpCacheKey returns the key of the cached response of the request, its method and URL, and either the cache's key,
+// or by default its headers and body, so that requests to other URLs never share a response. Headers from secrets are
+// keyed by the secret key, rather than its value
+func httpCacheKey(h *wfv1.HTTP) (string, error) {
+	if h.Cache.Key != "" {
+		data, err := json.Marshal([]interface{}{h.Method, h.URL, h.Cache.Key})
+		return string(data), err
+	}
+	data, err := json.Marshal([]interface{}{h.Method, h.URL, h.Headers, h.Body})
+	return string(data), err
+}
@@ -0,0 +1,96 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
+)
+
+func (ae *AgentExecutor) executeNotificationTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+	n := tmpl.Notification
+	if n == nil {
+		return 0, nil
+	}
+	payload, err := notificationPayload(n)
+	if err != nil {
+		return 0, err
+	}
+	url, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, n.WebhookURLSecret.Name, n.WebhookURLSecret.Key)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(n.GetTimeoutSeconds())*time.Second)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSpace(string(url)), bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	tracing.InjectHTTPHeaders(ctx, request.Header)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return 0, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		result.Phase = wfv1.NodeFailed
+		result.Message = fmt.Sprintf("%s responded with %d: %s", n.Provider, response.StatusCode, strings.TrimSpace(string(body)))
+		return 0, nil
+	}
+	result.Phase = wfv1.NodeSucceeded
+	result.Message = fmt.Sprintf("sent to %s", n.Provider)
+	return 0, nil
+}
+
+// notificationPayload returns the body of the request to the provider's incoming webhook. Both providers show the
+// color as a bar beside the message.
+func notificationPayload(n *wfv1.Notification) ([]byte, error) {
+	switch n.Provider {
+	case wfv1.NotificationProviderSlack:
+		attachment := map[string]interface{}{"text": n.Message, "mrkdwn_in": []string{"text"}}
+		if n.Title != "" {
+			attachment["title"] = n.Title
+		}
+		if n.Color != "" {
+			attachment["color"] = n.Color
+		}
+		payload := map[string]interface{}{"attachments": []interface{}{attachment}}
+		if n.Channel != "" {
+			payload["channel"] = n.Channel
+		}
+		return json.Marshal(payload)
+	case wfv1.NotificationProviderMSTeams:
+		summary := n.Title
+		if summary == "" {
+			summary = n.Message
+		}
+		payload := map[string]interface{}{
+			"@type":    "MessageCard",
+			"@context": "https://schema.org/extensions",
+			"summary":  summary,
+			"text":     n.Message,
+		}
+		if n.Title != "" {
+			payload["title"] = n.Title
+		}
+		if n.Color != "" {
+			payload["themeColor"] = strings.TrimPrefix(n.Color, "#")
+		}
+		return json.Marshal(payload)
+	default:
+		return nil, fmt.Errorf("unsupported provider %q, must be one of slack, or msteams", n.Provider)
+	}
+}
@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestNotificationPayload(t *testing.T) {
+	t.Run("Slack", func(t *testing.T) {
+		data, err := notificationPayload(&v1alpha1.Notification{Provider: v1alpha1.NotificationProviderSlack, Channel: "#my-team", Title: "my-wf Failed", Message: "*my-wf* failed", Color: "#e96d76"})
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"channel":"#my-team","attachments":[{"title":"my-wf Failed","text":"*my-wf* failed","color":"#e96d76","mrkdwn_in":["text"]}]}`, string(data))
+		}
+	})
+	t.Run("MSTeams", func(t *testing.T) {
+		data, err := notificationPayload(&v1alpha1.Notification{Provider: v1alpha1.NotificationProviderMSTeams, Message: "my-wf succeeded", Color: "#18be94"})
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"@type":"MessageCard","@context":"https://schema.org/extensions","summary":"my-wf succeeded","text":"my-wf succeeded","themeColor":"18be94"}`, string(data))
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := notificationPayload(&v1alpha1.Notification{Provider: "email"})
+		assert.EqualError(t, err, `unsupported provider "email", must be one of slack, or msteams`)
+	})
+}
+
+func TestExecuteNotificationTemplate(t *testing.T) {
+	var body string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("invalid_payload"))
+	}))
+	defer server.Close()
+	ae := &AgentExecutor{
+		log:       log.WithField("workflow", "my-wf"),
+		Namespace: "my-ns",
+		ClientSet: fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack", Namespace: "my-ns"},
+			Data:       map[string][]byte{"url": []byte(server.URL + "\n")},
+		}),
+	}
+	tmpl := v1alpha1.Template{Notification: &v1alpha1.Notification{
+		Provider:         v1alpha1.NotificationProviderSlack,
+		WebhookURLSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "slack"}, Key: "url"},
+		Message:          "my-wf succeeded",
+	}}
+	t.Run("Sent", func(t *testing.T) {
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeNotificationTemplate(context.Background(), tmpl, result)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase)
+			assert.Equal(t, "sent to slack", result.Message)
+			assert.JSONEq(t, `{"attachments":[{"text":"my-wf succeeded","mrkdwn_in":["text"]}]}`, body)
+		}
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		status = http.StatusBadRequest
+		result := &v1alpha1.NodeResult{}
+		_, err := ae.executeNotificationTemplate(context.Background(), tmpl, result)
+		if assert.NoError(t, err) {
+			assert.Equal(t, v1alpha1.NodeFailed, result.Phase)
+			assert.Equal(t, "slack responded with 400: invalid_payload", result.Message)
+		}
+	})
+}
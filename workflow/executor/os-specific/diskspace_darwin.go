@@ -0,0 +1,12 @@
+package os_specific
+
+import "syscall"
+
+// AvailableDiskSpace returns the number of bytes available to unprivileged users on the filesystem containing path
+func AvailableDiskSpace(path string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
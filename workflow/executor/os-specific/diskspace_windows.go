@@ -0,0 +1,6 @@
+package os_specific
+
+// AvailableDiskSpace returns -1, as the available disk space is not currently determined on Windows
+func AvailableDiskSpace(path string) (int64, error) {
+	return -1, nil
+}
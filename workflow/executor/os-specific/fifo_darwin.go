@@ -0,0 +1,14 @@
+package os_specific
+
+import (
+	"os"
+	"syscall"
+)
+
+// Mkfifo creates a named pipe, unless it exists
+func Mkfifo(path string) error {
+	if err := syscall.Mkfifo(path, 0o666); err != nil && !os.IsExist(err) {
+		return err
+	}
+	return nil
+}
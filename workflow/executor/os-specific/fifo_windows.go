@@ -0,0 +1,7 @@
+package os_specific
+
+import "fmt"
+
+func Mkfifo(path string) error {
+	return fmt.Errorf("named pipes are not supported on Windows")
+}
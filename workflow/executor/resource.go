@@ -23,6 +23,8 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	argoerr "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	exprenv "github.com/argoproj/argo-workflows/v3/util/expr/env"
 )
 
 // ExecResource will run kubectl action against a manifest
@@ -164,28 +166,41 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 	if we.Template.Resource.SuccessCondition == "" && we.Template.Resource.FailureCondition == "" {
 		return nil
 	}
-	var successReqs labels.Requirements
-	if we.Template.Resource.SuccessCondition != "" {
-		successSelector, err := labels.Parse(we.Template.Resource.SuccessCondition)
-		if err != nil {
-			return errors.Errorf(errors.CodeBadRequest, "success condition '%s' failed to parse: %v", we.Template.Resource.SuccessCondition, err)
+	var match func(jsonBytes []byte) (bool, error)
+	if we.Template.Resource.IsExprConditions() {
+		successCondition, failureCondition := we.Template.Resource.SuccessCondition, we.Template.Resource.FailureCondition
+		log.Infof("Waiting for condition: %s", successCondition)
+		log.Infof("Failing for condition: %s", failureCondition)
+		match = func(jsonBytes []byte) (bool, error) {
+			return matchExprConditions(jsonBytes, successCondition, failureCondition)
+		}
+	} else {
+		var successReqs labels.Requirements
+		if we.Template.Resource.SuccessCondition != "" {
+			successSelector, err := labels.Parse(we.Template.Resource.SuccessCondition)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "success condition '%s' failed to parse: %v", we.Template.Resource.SuccessCondition, err)
+			}
+			log.Infof("Waiting for conditions: %s", successSelector)
+			successReqs, _ = successSelector.Requirements()
 		}
-		log.Infof("Waiting for conditions: %s", successSelector)
-		successReqs, _ = successSelector.Requirements()
-	}
 
-	var failReqs labels.Requirements
-	if we.Template.Resource.FailureCondition != "" {
-		failSelector, err := labels.Parse(we.Template.Resource.FailureCondition)
-		if err != nil {
-			return errors.Errorf(errors.CodeBadRequest, "fail condition '%s' failed to parse: %v", we.Template.Resource.FailureCondition, err)
+		var failReqs labels.Requirements
+		if we.Template.Resource.FailureCondition != "" {
+			failSelector, err := labels.Parse(we.Template.Resource.FailureCondition)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "fail condition '%s' failed to parse: %v", we.Template.Resource.FailureCondition, err)
+			}
+			log.Infof("Failing for conditions: %s", failSelector)
+			failReqs, _ = failSelector.Requirements()
+		}
+		match = func(jsonBytes []byte) (bool, error) {
+			return matchConditions(jsonBytes, successReqs, failReqs)
 		}
-		log.Infof("Failing for conditions: %s", failSelector)
-		failReqs, _ = failSelector.Requirements()
 	}
 	err := wait.PollImmediateInfinite(envutil.LookupEnvDurationOr("RESOURCE_STATE_CHECK_INTERVAL", time.Second*5),
 		func() (bool, error) {
-			isErrRetryable, err := we.checkResourceState(ctx, selfLink, successReqs, failReqs)
+			isErrRetryable, err := we.checkResourceState(ctx, selfLink, match)
 			if err == nil {
 				log.Infof("Returning from successful wait for resource %s in namespace %s", resourceName, resourceNamespace)
 				return true, nil
@@ -211,7 +226,7 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 
 // checkResourceState performs resource status checking and then waiting on json reading.
 // The returning boolean indicates whether we should retry.
-func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
+func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink string, match func(jsonBytes []byte) (bool, error)) (bool, error) {
 	request := we.RESTClient.Get().RequestURI(selfLink)
 	stream, err := request.Stream(ctx)
 
@@ -233,7 +248,7 @@ func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink str
 	if !gjson.Valid(jsonString) {
 		return false, errors.Errorf(errors.CodeNotFound, "Encountered invalid JSON response when checking resource status. Will not be retried: %q", jsonString)
 	}
-	return matchConditions(jsonBytes, successReqs, failReqs)
+	return match(jsonBytes)
 }
 
 // matchConditions checks whether the returned JSON bytes match success or failure conditions.
@@ -264,6 +279,42 @@ func matchConditions(jsonBytes []byte, successReqs labels.Requirements, failReqs
 	return true, errors.Errorf(errors.CodeNotFound, "Neither success condition nor the failure condition has been matched. Retrying...")
 }
 
+// matchExprConditions checks whether the returned JSON bytes match the success or failure expr conditions.
+// The fields of the resource (e.g. `metadata`, `status`) are available as variables.
+func matchExprConditions(jsonBytes []byte, successCondition, failureCondition string) (bool, error) {
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(jsonBytes, &obj); err != nil {
+		return false, errors.Errorf(errors.CodeBadRequest, "failed to unmarshal resource: %v", err)
+	}
+	env := exprenv.GetFuncMap(obj)
+	if failureCondition != "" {
+		failed, err := argoexpr.EvalBool(failureCondition, env)
+		if err != nil {
+			// the fields may not have been set yet, so we treat this as not failed
+			log.Infof("failure condition '%s' could not be evaluated: %v", failureCondition, err)
+		}
+		msg := fmt.Sprintf("failure condition '%s' evaluated %v", failureCondition, failed)
+		log.Infof(msg)
+		if failed {
+			// We return false here to not retry when failure conditions met.
+			return false, errors.Errorf(errors.CodeBadRequest, msg)
+		}
+	}
+	if successCondition == "" {
+		return false, nil
+	}
+	matched, err := argoexpr.EvalBool(successCondition, env)
+	if err != nil {
+		// the fields may not have been set yet, so we retry
+		return true, errors.Errorf(errors.CodeNotFound, "success condition '%s' could not be evaluated: %v", successCondition, err)
+	}
+	log.Infof("success condition '%s' evaluated %v", successCondition, matched)
+	if matched {
+		return false, nil
+	}
+	return true, errors.Errorf(errors.CodeNotFound, "Neither success condition nor the failure condition has been matched. Retrying...")
+}
+
 // SaveResourceParameters will save any resource output parameters
 func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resourceNamespace string, resourceName string) error {
 	if len(we.Template.Outputs.Parameters) == 0 {
@@ -201,3 +201,37 @@ func TestResourceExecRetry(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "no more retries i/o timeout", err.Error())
 }
+
+// TestResourceExprConditionsMatching tests whether the JSON response match
+// with either success or failure expr conditions.
+func TestResourceExprConditionsMatching(t *testing.T) {
+	successCondition := `status.applicationState.state == "COMPLETED"`
+	failureCondition := `status.applicationState.state in ["FAILED", "SUBMISSION_FAILED"]`
+
+	jsonBytes := []byte(`{"name": "test","status":{"applicationState":{"state":"FAILED"}}}`)
+	retry, err := matchExprConditions(jsonBytes, successCondition, failureCondition)
+	assert.EqualError(t, err, `failure condition 'status.applicationState.state in ["FAILED", "SUBMISSION_FAILED"]' evaluated true`)
+	assert.False(t, retry)
+
+	jsonBytes = []byte(`{"name": "test","status":{"applicationState":{"state":"COMPLETED"}}}`)
+	retry, err = matchExprConditions(jsonBytes, successCondition, failureCondition)
+	assert.NoError(t, err)
+	assert.False(t, retry)
+
+	jsonBytes = []byte(`{"name": "test","status":{"applicationState":{"state":"RUNNING"}}}`)
+	retry, err = matchExprConditions(jsonBytes, successCondition, failureCondition)
+	assert.EqualError(t, err, "Neither success condition nor the failure condition has been matched. Retrying...")
+	assert.True(t, retry)
+
+	// the status has not been set yet
+	jsonBytes = []byte(`{"name": "test"}`)
+	retry, err = matchExprConditions(jsonBytes, successCondition, failureCondition)
+	assert.Error(t, err)
+	assert.True(t, retry)
+
+	// fields used by the failure condition are not set
+	jsonBytes = []byte(`{"name": "test","status":{"succeeded":1}}`)
+	retry, err = matchExprConditions(jsonBytes, "status.succeeded > 0", "status.failed > 3")
+	assert.NoError(t, err)
+	assert.False(t, retry)
+}
@@ -0,0 +1,190 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+)
+
+// sqlDrivers are the database drivers that SQL templates may use
+var sqlDrivers = map[string]bool{"postgres": true, "mysql": true}
+
+func (ae *AgentExecutor) executeSQLTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
+	s := tmpl.SQL
+	if s == nil {
+		return 0, nil
+	}
+	if !sqlDrivers[s.Driver] {
+		return 0, fmt.Errorf("unsupported driver %q, must be one of postgres, or mysql", s.Driver)
+	}
+	dsn, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, s.DSNSecret.Name, s.DSNSecret.Key)
+	if err != nil {
+		return 0, err
+	}
+	db, err := sql.Open(s.Driver, string(dsn))
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(s.GetTimeoutSeconds())*time.Second)
+	defer cancel()
+	columns, rows, err := queryRows(ctx, db, s.Query, s.Args)
+	if err != nil {
+		return 0, err
+	}
+	data, err := formatRows(s.GetFormat(), columns, rows)
+	if err != nil {
+		return 0, err
+	}
+	outputs := &wfv1.Outputs{}
+	if len(tmpl.Outputs.Artifacts) > 0 {
+		art := tmpl.Outputs.Artifacts[0].DeepCopy()
+		if err := ae.saveRows(ctx, tmpl.ArchiveLocation, art, s.GetFormat(), data); err != nil {
+			return 0, err
+		}
+		outputs.Artifacts = wfv1.Artifacts{*art}
+	} else {
+		outputs.Result = pointer.StringPtr(string(data))
+	}
+	result.Phase = wfv1.NodeSucceeded
+	result.Message = fmt.Sprintf("%d rows", len(rows))
+	result.Outputs = outputs
+	return 0, nil
+}
+
+// queryRows returns the names of the columns, and the values of each row, of the query's result
+func queryRows(ctx context.Context, db *sql.DB, query string, args []string) ([]string, [][]interface{}, error) {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+	rows, err := db.QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+	var result [][]interface{}
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, nil, err
+		}
+		for i, v := range row {
+			// drivers return text, and some return numbers, as bytes
+			if b, ok := v.([]byte); ok {
+				row[i] = string(b)
+			}
+		}
+		result = append(result, row)
+	}
+	return columns, result, rows.Err()
+}
+
+// formatRows returns the rows as JSON, an array of objects keyed by column name, so they can be fanned out over with
+// `withParam`, or as CSV
+func formatRows(format wfv1.SQLFormat, columns []string, rows [][]interface{}) ([]byte, error) {
+	switch format {
+	case wfv1.SQLFormatJSON:
+		objects := make([]map[string]interface{}, len(rows))
+		for i, row := range rows {
+			objects[i] = make(map[string]interface{}, len(columns))
+			for j, column := range columns {
+				objects[i][column] = row[j]
+			}
+		}
+		return json.Marshal(objects)
+	case wfv1.SQLFormatCSV:
+		buf := &bytes.Buffer{}
+		w := csv.NewWriter(buf)
+		if err := w.Write(columns); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			record := make([]string, len(row))
+			for i, v := range row {
+				switch x := v.(type) {
+				case nil:
+				case time.Time:
+					record[i] = x.Format(time.RFC3339Nano)
+				default:
+					record[i] = fmt.Sprint(x)
+				}
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	default:
+		return nil, fmt.Errorf("unsupported format %q, must be one of json, or csv", format)
+	}
+}
+
+// saveRows saves the rows to the artifact, in the template's archive location if it does not have its own, without
+// archiving them, so they can be read as they are
+func (ae *AgentExecutor) saveRows(ctx context.Context, archiveLocation *wfv1.ArtifactLocation, art *wfv1.Artifact, format wfv1.SQLFormat, data []byte) error {
+	if !art.HasKey() {
+		if archiveLocation == nil {
+			return fmt.Errorf("artifact %s does not have a location, and there is no artifact repository", art.Name)
+		}
+		key, err := archiveLocation.GetKey()
+		if err != nil {
+			return err
+		}
+		location, err := archiveLocation.Get()
+		if err != nil {
+			return err
+		}
+		if err := art.SetType(location); err != nil {
+			return err
+		}
+		if err := art.SetKey(path.Join(key, art.Name+"."+string(format))); err != nil {
+			return err
+		}
+	}
+	art.Archive = &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}
+	file, err := ioutil.TempFile("", "sql-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	driverArt := art.DeepCopy()
+	if err := driverArt.Relocate(archiveLocation); err != nil {
+		return err
+	}
+	driver, err := ae.newDriver(ctx, driverArt, ae)
+	if err != nil {
+		return err
+	}
+	return driver.Save(file.Name(), driverArt)
+}
@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+type fakeArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	data []byte
+	art  *v1alpha1.Artifact
+}
+
+func (d *fakeArtifactDriver) Save(path string, art *v1alpha1.Artifact) error {
+	data, err := ioutil.ReadFile(path)
+	d.data = data
+	d.art = art
+	return err
+}
+
+func TestQueryRows(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec(`create table items (id integer, name text); insert into items values (1, 'foo'), (2, 'bar'), (3, null)`)
+	require.NoError(t, err)
+
+	columns, rows, err := queryRows(ctx, db, "select id, name from items where id < ? order by id", []string{"3"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"id", "name"}, columns)
+		assert.Equal(t, [][]interface{}{{int64(1), "foo"}, {int64(2), "bar"}}, rows)
+	}
+	_, rows, err = queryRows(ctx, db, "select id, name from items where id = 3", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, [][]interface{}{{int64(3), nil}}, rows)
+	}
+	_, _, err = queryRows(ctx, db, "select * from missing", nil)
+	assert.Error(t, err)
+}
+
+func TestFormatRows(t *testing.T) {
+	columns := []string{"id", "name"}
+	rows := [][]interface{}{{int64(1), "foo"}, {int64(2), nil}}
+	t.Run("JSON", func(t *testing.T) {
+		data, err := formatRows(v1alpha1.SQLFormatJSON, columns, rows)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[{"id":1,"name":"foo"},{"id":2,"name":null}]`, string(data))
+		}
+	})
+	t.Run("NoRows", func(t *testing.T) {
+		data, err := formatRows(v1alpha1.SQLFormatJSON, columns, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `[]`, string(data))
+		}
+	})
+	t.Run("CSV", func(t *testing.T) {
+		data, err := formatRows(v1alpha1.SQLFormatCSV, columns, rows)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "id,name\n1,foo\n2,\n", string(data))
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := formatRows("xml", columns, rows)
+		assert.EqualError(t, err, `unsupported format "xml", must be one of json, or csv`)
+	})
+}
+
+func TestAgentExecutor_saveRows(t *testing.T) {
+	ctx := context.Background()
+	driver := &fakeArtifactDriver{}
+	ae := &AgentExecutor{
+		log: log.WithField("workflow", "my-wf"),
+		newDriver: func(context.Context, *v1alpha1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		},
+	}
+	archiveLocation := &v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-node"}}
+	t.Run("ArchiveLocation", func(t *testing.T) {
+		art := &v1alpha1.Artifact{Name: "rows"}
+		err := ae.saveRows(ctx, archiveLocation, art, v1alpha1.SQLFormatCSV, []byte("id\n1\n"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "id\n1\n", string(driver.data))
+			if assert.NotNil(t, driver.art.S3) {
+				assert.Equal(t, "my-bucket", driver.art.S3.Bucket)
+				assert.Equal(t, "my-wf/my-node/rows.csv", driver.art.S3.Key)
+			}
+			if assert.NotNil(t, art.S3) {
+				assert.Equal(t, "my-wf/my-node/rows.csv", art.S3.Key)
+			}
+			assert.NotNil(t, art.Archive.None)
+		}
+	})
+	t.Run("OwnLocation", func(t *testing.T) {
+		art := &v1alpha1.Artifact{Name: "rows", ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: "rows.json"}}}
+		err := ae.saveRows(ctx, archiveLocation, art, v1alpha1.SQLFormatJSON, []byte("[]"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "rows.json", art.S3.Key)
+			assert.Equal(t, "my-bucket", driver.art.S3.Bucket)
+			assert.Equal(t, "rows.json", driver.art.S3.Key)
+		}
+	})
+	t.Run("NoLocation", func(t *testing.T) {
+		err := ae.saveRows(ctx, nil, &v1alpha1.Artifact{Name: "rows"}, v1alpha1.SQLFormatJSON, []byte("[]"))
+		assert.EqualError(t, err, "artifact rows does not have a location, and there is no artifact repository")
+	})
+}
+
+func TestAgentExecutor_executeSQLTemplate(t *testing.T) {
+	ae := &AgentExecutor{log: log.WithField("workflow", "my-wf")}
+	_, err := ae.executeSQLTemplate(context.Background(), v1alpha1.Template{SQL: &v1alpha1.SQL{
+		Driver:    "sqlite3",
+		DSNSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-db"}, Key: "dsn"},
+		Query:     "select 1",
+	}}, &v1alpha1.NodeResult{})
+	assert.EqualError(t, err, `unsupported driver "sqlite3", must be one of postgres, or mysql`)
+}
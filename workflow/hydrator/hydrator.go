@@ -114,6 +114,12 @@ func (h hydrator) Dehydrate(wf *wfv1.Workflow) error {
 		if offloadErr != nil {
 			return fmt.Errorf("%sTried to offload but encountered error: %s", errMsg, offloadErr.Error())
 		}
+		if wf.Status.Fulfilled() {
+			// this is best effort, the nodes are still deleted once the workflow is
+			if err := h.offloadNodeStatusRepo.Completed(string(wf.UID), offloadVersion); err != nil {
+				log.WithError(err).WithField("uid", wf.UID).Warn("Failed to mark offloaded nodes of completed workflow")
+			}
+		}
 		wf.Status.Nodes = nil
 		wf.Status.CompressedNodes = ""
 		wf.Status.OffloadNodeStatusVersion = offloadVersion
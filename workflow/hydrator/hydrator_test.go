@@ -70,6 +70,22 @@ func TestHydrator(t *testing.T) {
 				assert.Equal(t, "my-offload-version", wf.Status.OffloadNodeStatusVersion)
 			}
 		})
+		t.Run("OffloadCompleted", func(t *testing.T) {
+			offloadNodeStatusRepo := &sqldbmocks.OffloadNodeStatusRepo{}
+			offloadNodeStatusRepo.On("Save", "my-uid", "my-ns", mock.Anything).Return("my-offload-version", nil)
+			offloadNodeStatusRepo.On("Completed", "my-uid", "my-offload-version").Return(nil)
+			hydrator := New(offloadNodeStatusRepo)
+			wf := &wfv1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{UID: "my-uid", Namespace: "my-ns"},
+				Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+				Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, Nodes: wfv1.Nodes{"foo": wfv1.NodeStatus{}, "bar": wfv1.NodeStatus{}, "baz": wfv1.NodeStatus{}, "qux": wfv1.NodeStatus{}}},
+			}
+			err := hydrator.Dehydrate(wf)
+			if assert.NoError(t, err) {
+				assert.True(t, wf.Status.IsOffloadNodeStatus())
+				offloadNodeStatusRepo.AssertCalled(t, "Completed", "my-uid", "my-offload-version")
+			}
+		})
 		t.Run("WorkflowTooLargeButOffloadNotSupported", func(t *testing.T) {
 			offloadNodeStatusRepo := &sqldbmocks.OffloadNodeStatusRepo{}
 			offloadNodeStatusRepo.On("Save", "my-uid", "my-ns", mock.Anything).Return("my-offload-version", sqldb.OffloadNotSupportedError)
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -20,6 +21,8 @@ const (
 	DefaultMetricsServerPath = "/metrics"
 )
 
+var operationDurationBuckets = []float64{0.1, 0.25, 0.5, 0.75, 1.0, 1.25, 1.5, 1.75, 2.0, 2.5, 3.0}
+
 type ServerConfig struct {
 	Enabled      bool
 	Path         string
@@ -27,6 +30,16 @@ type ServerConfig struct {
 	TTL          time.Duration
 	IgnoreErrors bool
 	Secure       bool
+	// NamespaceLimit is the maximum number of namespaces that have their own per-namespace metrics, 0 disables them
+	NamespaceLimit int
+	// MaxCustomMetrics is the maximum number of custom metric series, 0 is no limit
+	MaxCustomMetrics int
+	// DeniedLabelKeys are the label keys that custom metrics must not have
+	DeniedLabelKeys []string
+	// Push is where metrics are pushed to, if anywhere
+	Push *config.MetricsPushConfig
+	// SLO configures the service level objective metrics, if they are emitted
+	SLO *config.SLOConfig
 }
 
 func (s ServerConfig) SameServerAs(other ServerConfig) bool {
@@ -49,10 +62,14 @@ type Metrics struct {
 	workflowsByPhase   map[v1alpha1.NodePhase]prometheus.Gauge
 	workflows          map[string][]string
 	operationDurations prometheus.Histogram
+	taskSetSizes       prometheus.Histogram
+	taskSetPruned      prometheus.Counter
 	errors             map[ErrorCause]prometheus.Counter
 	customMetrics      map[string]metric
 	workqueueMetrics   map[string]prometheus.Metric
 	workersBusy        map[string]prometheus.Gauge
+	namespaces         map[string]*namespaceMetrics
+	slo                *sloTracker
 
 	// Used to quickly check if a metric desc is already used by the system
 	defaultMetricDescs map[string]bool
@@ -79,11 +96,14 @@ func New(metricsConfig, telemetryConfig ServerConfig) *Metrics {
 		podsByPhase:        getPodPhaseGauges(),
 		workflowsByPhase:   getWorkflowPhaseGauges(),
 		workflows:          make(map[string][]string),
-		operationDurations: newHistogram("operation_duration_seconds", "Histogram of durations of operations", nil, []float64{0.1, 0.25, 0.5, 0.75, 1.0, 1.25, 1.5, 1.75, 2.0, 2.5, 3.0}),
+		operationDurations: newHistogram("operation_duration_seconds", "Histogram of durations of operations", nil, operationDurationBuckets),
+		taskSetSizes:       newHistogram("workflowtaskset_size_bytes", "Histogram of sizes of Workflow TaskSets, measured each time they are reconciled", nil, prometheus.ExponentialBuckets(1024, 4, 7)),
+		taskSetPruned:      newCounter("workflowtaskset_pruned_count", "Number of completed task results pruned from Workflow TaskSets", nil),
 		errors:             getErrorCounters(),
 		customMetrics:      make(map[string]metric),
 		workqueueMetrics:   make(map[string]prometheus.Metric),
 		workersBusy:        make(map[string]prometheus.Gauge),
+		namespaces:         make(map[string]*namespaceMetrics),
 		defaultMetricDescs: make(map[string]bool),
 		metricNameHelps:    make(map[string]string),
 		logMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
@@ -92,6 +112,10 @@ func New(metricsConfig, telemetryConfig ServerConfig) *Metrics {
 		}, []string{"level"}),
 	}
 
+	if metricsConfig.SLO != nil {
+		metrics.slo = newSLOTracker(*metricsConfig.SLO)
+	}
+
 	for _, metric := range metrics.allMetrics() {
 		metrics.defaultMetricDescs[metric.Desc().String()] = true
 	}
@@ -112,6 +136,8 @@ func (m *Metrics) allMetrics() []prometheus.Metric {
 	allMetrics := []prometheus.Metric{
 		m.workflowsProcessed,
 		m.operationDurations,
+		m.taskSetSizes,
+		m.taskSetPruned,
 	}
 	for _, metric := range m.workflowsByPhase {
 		allMetrics = append(allMetrics, metric)
@@ -128,6 +154,9 @@ func (m *Metrics) allMetrics() []prometheus.Metric {
 	for _, metric := range m.workersBusy {
 		allMetrics = append(allMetrics, metric)
 	}
+	for _, n := range m.namespaces {
+		allMetrics = append(allMetrics, n.all()...)
+	}
 	for _, metric := range m.customMetrics {
 		allMetrics = append(allMetrics, metric.metric)
 	}
@@ -157,6 +186,20 @@ func (m *Metrics) OperationCompleted(durationSeconds float64) {
 	m.operationDurations.Observe(durationSeconds)
 }
 
+func (m *Metrics) TaskSetReconciled(sizeBytes int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.taskSetSizes.Observe(float64(sizeBytes))
+}
+
+func (m *Metrics) TaskSetResultsPruned(num int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.taskSetPruned.Add(float64(num))
+}
+
 func (m *Metrics) GetCustomMetric(key string) prometheus.Metric {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -173,6 +216,9 @@ func (m *Metrics) UpsertCustomMetric(key string, ownerKey string, newMetric prom
 	if _, inUse := m.defaultMetricDescs[metricDesc]; inUse {
 		return fmt.Errorf("metric '%s' is already in use by the system, please use a different name", newMetric.Desc())
 	}
+	if _, exists := m.customMetrics[key]; !exists && m.metricsConfig.MaxCustomMetrics > 0 && len(m.customMetrics) >= m.metricsConfig.MaxCustomMetrics {
+		return fmt.Errorf("metric '%s' is not emitted because the limit of %d custom metrics has been reached", key, m.metricsConfig.MaxCustomMetrics)
+	}
 	name, help := recoverMetricNameAndHelpFromDesc(metricDesc)
 	if existingHelp, inUse := m.metricNameHelps[name]; inUse && help != existingHelp {
 		return fmt.Errorf("metric '%s' has help string '%s' but should have '%s' (help strings must be identical for metrics of the same name)", name, help, existingHelp)
@@ -189,6 +235,16 @@ func (m *Metrics) UpsertCustomMetric(key string, ownerKey string, newMetric prom
 	return nil
 }
 
+// ValidateCustomMetricLabels returns an error if the labels of a custom metric have a key that is denied
+func (m *Metrics) ValidateCustomMetricLabels(labels map[string]string) error {
+	for _, key := range m.metricsConfig.DeniedLabelKeys {
+		if _, ok := labels[key]; ok {
+			return fmt.Errorf("metric label '%s' is denied by the controller config", key)
+		}
+	}
+	return nil
+}
+
 func (m *Metrics) SetWorkflowPhaseGauge(phase v1alpha1.NodePhase, num int) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
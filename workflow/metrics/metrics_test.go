@@ -65,6 +65,13 @@ func TestMetrics(t *testing.T) {
 	m.OperationCompleted(0.05)
 	assert.Equal(t, uint64(1), *write(m.operationDurations).Histogram.Bucket[0].CumulativeCount)
 
+	m.TaskSetReconciled(2048)
+	assert.Equal(t, uint64(0), *write(m.taskSetSizes).Histogram.Bucket[0].CumulativeCount)
+	assert.Equal(t, uint64(1), *write(m.taskSetSizes).Histogram.Bucket[1].CumulativeCount)
+
+	m.TaskSetResultsPruned(3)
+	assert.Equal(t, float64(3), *write(m.taskSetPruned).Counter.Value)
+
 	assert.Nil(t, m.GetCustomMetric("does-not-exist"))
 
 	err := m.UpsertCustomMetric("metric", "", newCounter("test", "test", nil), false)
@@ -163,7 +170,7 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 	assert.Empty(t, m.workflows["123"])
 	assert.Len(t, m.customMetrics, 0)
 
-	metric, err := ConstructOrUpdateMetric(nil, &v1alpha1.Prometheus{Name: "name", Help: "hello", Gauge: &v1alpha1.Gauge{Value: "1"}})
+	metric, err := ConstructOrUpdateMetric(nil, &v1alpha1.Prometheus{Name: "name", Help: "hello", Gauge: &v1alpha1.Gauge{Value: "1"}}, nil)
 	assert.NoError(t, err)
 
 	err = m.UpsertCustomMetric("metrickey", "456", metric, false)
@@ -175,3 +182,31 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 	assert.Empty(t, m.workflows["456"])
 	assert.Len(t, m.customMetrics, 1)
 }
+
+func TestMaxCustomMetrics(t *testing.T) {
+	m := New(ServerConfig{MaxCustomMetrics: 2}, ServerConfig{})
+	assert.NoError(t, m.UpsertCustomMetric("metric-0", "", newCounter("limited", "limited", map[string]string{"n": "0"}), false))
+	assert.NoError(t, m.UpsertCustomMetric("metric-1", "", newCounter("limited", "limited", map[string]string{"n": "1"}), false))
+	assert.EqualError(t, m.UpsertCustomMetric("metric-2", "", newCounter("limited", "limited", map[string]string{"n": "2"}), false), "metric 'metric-2' is not emitted because the limit of 2 custom metrics has been reached")
+	assert.NoError(t, m.UpsertCustomMetric("metric-1", "", newCounter("limited", "limited", map[string]string{"n": "1"}), false), "existing metrics can be updated")
+	assert.Len(t, m.customMetrics, 2)
+}
+
+func TestValidateCustomMetricLabels(t *testing.T) {
+	m := New(ServerConfig{DeniedLabelKeys: []string{"item", "pod_name"}}, ServerConfig{})
+	assert.NoError(t, m.ValidateCustomMetricLabels(map[string]string{"name": "flakey"}))
+	assert.EqualError(t, m.ValidateCustomMetricLabels(map[string]string{"name": "flakey", "pod_name": "flakey-123"}), "metric label 'pod_name' is denied by the controller config")
+	assert.NoError(t, New(ServerConfig{}, ServerConfig{}).ValidateCustomMetricLabels(map[string]string{"item": "1"}))
+}
+
+func TestDeleteExpiredCustomMetrics(t *testing.T) {
+	m := New(ServerConfig{TTL: time.Minute, MaxCustomMetrics: 1}, ServerConfig{})
+	assert.NoError(t, m.UpsertCustomMetric("expired", "", newCounter("expired", "expired", nil), false))
+	assert.Error(t, m.UpsertCustomMetric("recent", "", newCounter("recent", "recent", nil), false), "the limit has been reached")
+	m.customMetrics["expired"] = metric{metric: m.customMetrics["expired"].metric, lastUpdated: time.Now().Add(-2 * time.Minute)}
+	m.deleteExpiredCustomMetrics()
+	assert.Empty(t, m.customMetrics)
+	assert.NoError(t, m.UpsertCustomMetric("recent", "", newCounter("recent", "recent", nil), false))
+	m.deleteExpiredCustomMetrics()
+	assert.Len(t, m.customMetrics, 1)
+}
@@ -0,0 +1,160 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// DefaultNamespaceLimit is the default maximum number of namespaces that have their own per-namespace metrics
+	DefaultNamespaceLimit = 50
+	// OtherNamespaces is the namespace label of the per-namespace metrics of the namespaces over the limit. It cannot
+	// be the name of a namespace.
+	OtherNamespaces = "_other"
+)
+
+// namespaceMetrics are the metrics of the workflows of a namespace, so operators can tell which namespace is saturating
+// the controller
+type namespaceMetrics struct {
+	queueDepth         prometheus.Gauge
+	queueAdds          prometheus.Counter
+	queueRetries       prometheus.Counter
+	operationDurations prometheus.Histogram
+	estimatedCost      prometheus.Counter
+}
+
+func newNamespaceMetrics(namespace string) *namespaceMetrics {
+	labels := map[string]string{"namespace": namespace}
+	return &namespaceMetrics{
+		queueDepth:         newGauge("namespace_queue_depth_count", "Number of workflows waiting in the workflow queue, by namespace", labels),
+		queueAdds:          newCounter("namespace_queue_adds_count", "Adds to the workflow queue, by namespace", labels),
+		queueRetries:       newCounter("namespace_queue_retries_count", "Workflows requeued by the controller to be reconciled again, by namespace", labels),
+		operationDurations: newHistogram("namespace_operation_duration_seconds", "Histogram of durations of operations, by namespace", labels, operationDurationBuckets),
+		estimatedCost:      newCounter("namespace_estimated_cost_total", "Estimated cost of the completed workflows, by namespace, in the currency of the configured prices", labels),
+	}
+}
+
+func (n *namespaceMetrics) all() []prometheus.Metric {
+	return []prometheus.Metric{n.queueDepth, n.queueAdds, n.queueRetries, n.operationDurations, n.estimatedCost}
+}
+
+// getNamespaceMetrics returns the metrics of the namespace, creating them if needed, or those of OtherNamespaces if
+// there are as many namespaces as the limit, or nil if per-namespace metrics are disabled. The caller must hold the
+// lock.
+func (m *Metrics) getNamespaceMetrics(namespace string) *namespaceMetrics {
+	if m.metricsConfig.NamespaceLimit <= 0 {
+		return nil
+	}
+	if n, ok := m.namespaces[namespace]; ok {
+		return n
+	}
+	if len(m.namespaces) >= m.metricsConfig.NamespaceLimit {
+		namespace = OtherNamespaces
+		if n, ok := m.namespaces[namespace]; ok {
+			return n
+		}
+	}
+	n := newNamespaceMetrics(namespace)
+	m.namespaces[namespace] = n
+	return n
+}
+
+func (m *Metrics) NamespaceOperationCompleted(namespace string, durationSeconds float64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if n := m.getNamespaceMetrics(namespace); n != nil {
+		n.operationDurations.Observe(durationSeconds)
+	}
+}
+
+func (m *Metrics) NamespaceRequeued(namespace string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if n := m.getNamespaceMetrics(namespace); n != nil {
+		n.queueRetries.Inc()
+	}
+}
+
+func (m *Metrics) namespaceQueued(namespace string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if n := m.getNamespaceMetrics(namespace); n != nil {
+		n.queueAdds.Inc()
+		n.queueDepth.Inc()
+	}
+}
+
+func (m *Metrics) namespaceDequeued(namespace string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if n := m.getNamespaceMetrics(namespace); n != nil {
+		n.queueDepth.Dec()
+	}
+}
+
+// namespaceRateLimitingWorkQueue is a queue of "namespace/name" keys that records the adds and depth of each namespace.
+// Like the queue, it does not count a key that is already waiting, including one waiting for a delay.
+type namespaceRateLimitingWorkQueue struct {
+	workqueue.RateLimitingInterface
+	metrics *Metrics
+	mutex   sync.Mutex
+	waiting map[interface{}]string
+}
+
+// NamespaceRateLimitingWorkQueue returns the queue, recording per-namespace metrics of its "namespace/name" keys
+func (m *Metrics) NamespaceRateLimitingWorkQueue(queue workqueue.RateLimitingInterface) workqueue.RateLimitingInterface {
+	return &namespaceRateLimitingWorkQueue{RateLimitingInterface: queue, metrics: m, waiting: map[interface{}]string{}}
+}
+
+func (w *namespaceRateLimitingWorkQueue) queued(item interface{}) {
+	key, ok := item.(string)
+	if !ok {
+		return
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if _, ok := w.waiting[item]; ok {
+		return
+	}
+	w.waiting[item] = namespace
+	w.metrics.namespaceQueued(namespace)
+}
+
+func (w *namespaceRateLimitingWorkQueue) Add(item interface{}) {
+	w.queued(item)
+	w.RateLimitingInterface.Add(item)
+}
+
+func (w *namespaceRateLimitingWorkQueue) AddAfter(item interface{}, duration time.Duration) {
+	w.queued(item)
+	w.RateLimitingInterface.AddAfter(item, duration)
+}
+
+func (w *namespaceRateLimitingWorkQueue) AddRateLimited(item interface{}) {
+	w.queued(item)
+	w.RateLimitingInterface.AddRateLimited(item)
+}
+
+func (w *namespaceRateLimitingWorkQueue) Get() (interface{}, bool) {
+	item, shutdown := w.RateLimitingInterface.Get()
+	w.mutex.Lock()
+	namespace, ok := w.waiting[item]
+	delete(w.waiting, item)
+	w.mutex.Unlock()
+	if ok {
+		w.metrics.namespaceDequeued(namespace)
+	}
+	return item, shutdown
+}
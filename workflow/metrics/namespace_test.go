@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestNamespaceMetrics(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		m := New(ServerConfig{}, ServerConfig{})
+		m.NamespaceOperationCompleted("my-ns", 0.05)
+		m.NamespaceRequeued("my-ns")
+		assert.Empty(t, m.namespaces)
+	})
+	t.Run("Limit", func(t *testing.T) {
+		m := New(ServerConfig{NamespaceLimit: 2}, ServerConfig{})
+		m.NamespaceOperationCompleted("ns-0", 0.05)
+		m.NamespaceOperationCompleted("ns-1", 0.3)
+		m.NamespaceOperationCompleted("ns-2", 0.3)
+		m.NamespaceOperationCompleted("ns-3", 0.3)
+		m.NamespaceRequeued("ns-1")
+		m.NamespaceRequeued("ns-3")
+		if assert.Len(t, m.namespaces, 3) {
+			assert.Equal(t, uint64(1), *write(m.namespaces["ns-0"].operationDurations).Histogram.Bucket[0].CumulativeCount)
+			assert.Equal(t, uint64(1), *write(m.namespaces["ns-1"].operationDurations).Histogram.SampleCount)
+			assert.Equal(t, 1.0, *write(m.namespaces["ns-1"].queueRetries).Counter.Value)
+			assert.Equal(t, uint64(2), *write(m.namespaces[OtherNamespaces].operationDurations).Histogram.SampleCount)
+			assert.Equal(t, 1.0, *write(m.namespaces[OtherNamespaces].queueRetries).Counter.Value)
+		}
+		assert.Len(t, m.allMetrics(), len(New(ServerConfig{}, ServerConfig{}).allMetrics())+3*5)
+	})
+	t.Run("EstimatedCost", func(t *testing.T) {
+		m := New(ServerConfig{NamespaceLimit: DefaultNamespaceLimit}, ServerConfig{})
+		m.WorkflowCompleted(&v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns"}})
+		assert.Empty(t, m.namespaces)
+		for _, cost := range []float64{1.5, 0.25} {
+			m.WorkflowCompleted(&v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns"}, Status: v1alpha1.WorkflowStatus{EstimatedCost: v1alpha1.NewAmount(cost)}})
+		}
+		if assert.Contains(t, m.namespaces, "my-ns") {
+			assert.Equal(t, 1.75, *write(m.namespaces["my-ns"].estimatedCost).Counter.Value)
+		}
+	})
+}
+
+func TestNamespaceRateLimitingWorkQueue(t *testing.T) {
+	m := New(ServerConfig{NamespaceLimit: DefaultNamespaceLimit}, ServerConfig{})
+	queue := m.NamespaceRateLimitingWorkQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
+	defer queue.ShutDown()
+
+	queue.Add("ns-0/wf-0")
+	queue.Add("ns-0/wf-0")
+	queue.AddRateLimited("ns-0/wf-1")
+	queue.AddAfter("ns-1/wf-0", time.Millisecond)
+	queue.Add("not-a-key/in/a/namespace")
+
+	ns0, ns1 := m.namespaces["ns-0"], m.namespaces["ns-1"]
+	if assert.NotNil(t, ns0) && assert.NotNil(t, ns1) {
+		assert.Equal(t, 2.0, *write(ns0.queueAdds).Counter.Value)
+		assert.Equal(t, 2.0, *write(ns0.queueDepth).Gauge.Value)
+		assert.Equal(t, 1.0, *write(ns1.queueAdds).Counter.Value)
+		assert.Equal(t, 1.0, *write(ns1.queueDepth).Gauge.Value)
+
+		for i := 0; i < 4; i++ {
+			item, _ := queue.Get()
+			queue.Done(item)
+		}
+		assert.Equal(t, 0.0, *write(ns0.queueDepth).Gauge.Value)
+		assert.Equal(t, 0.0, *write(ns1.queueDepth).Gauge.Value)
+
+		queue.Add("ns-0/wf-0")
+		assert.Equal(t, 3.0, *write(ns0.queueAdds).Counter.Value)
+		assert.Equal(t, 1.0, *write(ns0.queueDepth).Gauge.Value)
+	}
+	assert.Len(t, m.namespaces, 2)
+}
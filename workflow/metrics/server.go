@@ -14,6 +14,7 @@ import (
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/env"
 
+	"github.com/argoproj/argo-workflows/v3/util/metricspush"
 	tlsutils "github.com/argoproj/argo-workflows/v3/util/tls"
 )
 
@@ -21,6 +22,17 @@ import (
 func (m *Metrics) RunServer(ctx context.Context) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
+	if m.metricsConfig.Push != nil {
+		// Metrics are pushed whether or not they can also be scraped
+		pushRegistry := prometheus.NewRegistry()
+		pushRegistry.MustRegister(m, collectors.NewGoCollector())
+		pusher, err := metricspush.New(*m.metricsConfig.Push, "workflow-controller", pushRegistry)
+		if err != nil {
+			panic(err)
+		}
+		go pusher.Run(ctx)
+	}
+
 	if !m.metricsConfig.Enabled {
 		// If metrics aren't enabled, return
 		return
@@ -46,7 +58,8 @@ func (m *Metrics) RunServer(ctx context.Context) {
 }
 
 func runServer(config ServerConfig, registry *prometheus.Registry, ctx context.Context) {
-	var handlerOpts promhttp.HandlerOpts
+	// OpenMetrics is negotiated with scrapers that support it, as it is the only format that has exemplars
+	handlerOpts := promhttp.HandlerOpts{EnableOpenMetrics: true}
 	if config.IgnoreErrors {
 		handlerOpts.ErrorHandling = promhttp.ContinueOnError
 	}
@@ -100,6 +113,9 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	K8sRequestTotalMetric.Describe(ch)
 	PodMissingMetric.Describe(ch)
 	WorkflowConditionMetric.Describe(ch)
+	if m.slo != nil {
+		m.slo.describe(ch)
+	}
 }
 
 func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
@@ -110,6 +126,20 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	K8sRequestTotalMetric.Collect(ch)
 	PodMissingMetric.Collect(ch)
 	WorkflowConditionMetric.Collect(ch)
+	m.collectSLO(ch)
+}
+
+// deleteExpiredCustomMetrics deletes the custom metrics that have not been updated for the TTL, so they are no longer
+// emitted, and do not count towards the limit
+func (m *Metrics) deleteExpiredCustomMetrics() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for key, metric := range m.customMetrics {
+		if time.Since(metric.lastUpdated) > m.metricsConfig.TTL {
+			delete(m.customMetrics, key)
+		}
+	}
 }
 
 func (m *Metrics) garbageCollector(ctx context.Context) {
@@ -124,11 +154,7 @@ func (m *Metrics) garbageCollector(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			for key, metric := range m.customMetrics {
-				if time.Since(metric.lastUpdated) > m.metricsConfig.TTL {
-					delete(m.customMetrics, key)
-				}
-			}
+			m.deleteExpiredCustomMetrics()
 		}
 	}
 }
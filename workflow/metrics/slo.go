@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var (
+	sloLabels               = []string{"namespace", "template", "window"}
+	sloWorkflowsDesc        = newSLODesc("slo_workflows_count", "Number of workflows completed in the window, by namespace and template")
+	sloSuccessRatioDesc     = newSLODesc("slo_success_ratio", "Ratio of the workflows completed in the window that succeeded, by namespace and template")
+	sloSuccessBurnRateDesc  = newSLODesc("slo_success_burn_rate", "Rate the error budget of the success objective was spent at in the window, by namespace and template. At 1, the budget is spent exactly")
+	sloDurationRatioDesc    = newSLODesc("slo_duration_ratio", "Ratio of the workflows completed in the window that completed within the duration objective, by namespace and template")
+	sloDurationBurnRateDesc = newSLODesc("slo_duration_burn_rate", "Rate the error budget of the duration objective was spent at in the window, by namespace and template. At 1, the budget is spent exactly")
+)
+
+func newSLODesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(argoNamespace, workflowsSubsystem, name), help, sloLabels, nil)
+}
+
+// sloTracker counts the workflows completed each minute, by namespace and template, for long enough to compute their
+// success and duration ratios over each window
+type sloTracker struct {
+	config  config.SLOConfig
+	windows []time.Duration
+	// minutes is the length of the longest window
+	minutes int64
+	series  map[sloKey]*sloSeries
+	// recorded are the workflows already counted, so a workflow reconciled again from a stale copy is not counted twice
+	recorded  map[types.UID]int64
+	lastSweep int64
+}
+
+type sloKey struct {
+	namespace string
+	template  string
+}
+
+type sloBucket struct {
+	minute         int64
+	total          int
+	succeeded      int
+	withinDuration int
+}
+
+type sloSeries struct {
+	// buckets is a ring of the counts of each minute of the longest window
+	buckets    []sloBucket
+	lastMinute int64
+}
+
+func newSLOTracker(c config.SLOConfig) *sloTracker {
+	t := &sloTracker{config: c, windows: c.GetWindows(), series: map[sloKey]*sloSeries{}, recorded: map[types.UID]int64{}}
+	for _, w := range t.windows {
+		if m := int64(w / time.Minute); m > t.minutes {
+			t.minutes = m
+		}
+	}
+	return t
+}
+
+func (t *sloTracker) template(wf *v1alpha1.Workflow) string {
+	if t.config.TemplateLabelKey != "" {
+		return wf.Labels[t.config.TemplateLabelKey]
+	}
+	if name := wf.Labels[common.LabelKeyWorkflowTemplate]; name != "" {
+		return name
+	}
+	return wf.Labels[common.LabelKeyClusterWorkflowTemplate]
+}
+
+func (t *sloTracker) record(wf *v1alpha1.Workflow, now time.Time) {
+	minute := now.Unix() / 60
+	if minute != t.lastSweep {
+		for uid, m := range t.recorded {
+			if minute-m >= t.minutes {
+				delete(t.recorded, uid)
+			}
+		}
+		t.lastSweep = minute
+	}
+	if _, ok := t.recorded[wf.UID]; ok {
+		return
+	}
+	t.recorded[wf.UID] = minute
+	key := sloKey{namespace: wf.Namespace, template: t.template(wf)}
+	s, ok := t.series[key]
+	if !ok {
+		if len(t.series) >= t.config.GetMaxSeries() {
+			key = sloKey{namespace: OtherNamespaces, template: OtherNamespaces}
+			s, ok = t.series[key]
+		}
+		if !ok {
+			s = &sloSeries{buckets: make([]sloBucket, t.minutes)}
+			t.series[key] = s
+		}
+	}
+	b := &s.buckets[minute%t.minutes]
+	if b.minute != minute {
+		*b = sloBucket{minute: minute}
+	}
+	b.total++
+	if wf.Status.Phase == v1alpha1.WorkflowSucceeded {
+		b.succeeded++
+	}
+	if t.config.Duration != nil && wf.Status.GetDuration() <= t.config.Duration.Duration {
+		b.withinDuration++
+	}
+	s.lastMinute = minute
+}
+
+func (t *sloTracker) describe(ch chan<- *prometheus.Desc) {
+	ch <- sloWorkflowsDesc
+	ch <- sloSuccessRatioDesc
+	ch <- sloSuccessBurnRateDesc
+	if t.config.Duration != nil {
+		ch <- sloDurationRatioDesc
+		ch <- sloDurationBurnRateDesc
+	}
+}
+
+// collect emits the metrics of each window with completed workflows, and forgets the series without any
+func (t *sloTracker) collect(now time.Time) []prometheus.Metric {
+	var metrics []prometheus.Metric
+	minute := now.Unix() / 60
+	for key, s := range t.series {
+		if minute-s.lastMinute >= t.minutes {
+			delete(t.series, key)
+			continue
+		}
+		for _, w := range t.windows {
+			var sum sloBucket
+			from := minute - int64(w/time.Minute)
+			for _, b := range s.buckets {
+				if b.minute > from && b.minute <= minute {
+					sum.total += b.total
+					sum.succeeded += b.succeeded
+					sum.withinDuration += b.withinDuration
+				}
+			}
+			if sum.total == 0 {
+				continue
+			}
+			labels := []string{key.namespace, key.template, formatWindow(w)}
+			total := float64(sum.total)
+			metrics = append(metrics, prometheus.MustNewConstMetric(sloWorkflowsDesc, prometheus.GaugeValue, total, labels...))
+			ratio := float64(sum.succeeded) / total
+			metrics = append(metrics, prometheus.MustNewConstMetric(sloSuccessRatioDesc, prometheus.GaugeValue, ratio, labels...))
+			metrics = append(metrics, prometheus.MustNewConstMetric(sloSuccessBurnRateDesc, prometheus.GaugeValue, (1-ratio)/(1-t.config.GetSuccessObjective()), labels...))
+			if t.config.Duration != nil {
+				ratio := float64(sum.withinDuration) / total
+				metrics = append(metrics, prometheus.MustNewConstMetric(sloDurationRatioDesc, prometheus.GaugeValue, ratio, labels...))
+				metrics = append(metrics, prometheus.MustNewConstMetric(sloDurationBurnRateDesc, prometheus.GaugeValue, (1-ratio)/(1-t.config.GetDurationObjective()), labels...))
+			}
+		}
+	}
+	return metrics
+}
+
+// formatWindow formats the window like the windows of the config, e.g. "5m" or "6h"
+func formatWindow(w time.Duration) string {
+	if w%time.Hour == 0 {
+		return fmt.Sprintf("%dh", w/time.Hour)
+	}
+	return fmt.Sprintf("%dm", w/time.Minute)
+}
+
+// WorkflowCompleted counts the completed workflow towards its namespace's, and template's, service level objectives,
+// and adds its estimated cost to its namespace's
+func (m *Metrics) WorkflowCompleted(wf *v1alpha1.Workflow) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if wf.Status.EstimatedCost != nil {
+		if n := m.getNamespaceMetrics(wf.Namespace); n != nil {
+			if cost, err := wf.Status.EstimatedCost.Float64(); err == nil {
+				n.estimatedCost.Add(cost)
+			}
+		}
+	}
+	if m.slo != nil {
+		m.slo.record(wf, time.Now())
+	}
+}
+
+func (m *Metrics) collectSLO(ch chan<- prometheus.Metric) {
+	if m.slo == nil {
+		return
+	}
+	m.mutex.Lock()
+	metrics := m.slo.collect(time.Now())
+	m.mutex.Unlock()
+	for _, metric := range metrics {
+		ch <- metric
+	}
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func sloWorkflow(uid, namespace, template string, phase v1alpha1.WorkflowPhase, duration time.Duration) *v1alpha1.Workflow {
+	started := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	return &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid), Namespace: namespace, Labels: map[string]string{common.LabelKeyWorkflowTemplate: template}},
+		Status: v1alpha1.WorkflowStatus{
+			Phase:      phase,
+			StartedAt:  metav1.Time{Time: started},
+			FinishedAt: metav1.Time{Time: started.Add(duration)},
+		},
+	}
+}
+
+// sloValues returns the values of the metrics, by name and labels, e.g. "slo_success_ratio{my-ns,my-wftmpl,5m}"
+func sloValues(metrics []prometheus.Metric) map[string]float64 {
+	values := map[string]float64{}
+	for _, m := range metrics {
+		d := write(m)
+		var labels []string
+		for _, l := range d.Label {
+			labels = append(labels, l.GetValue())
+		}
+		name := strings.TrimPrefix(strings.Split(m.Desc().String(), `"`)[1], "argo_workflows_")
+		values[name+"{"+strings.Join(labels, ",")+"}"] = d.Gauge.GetValue()
+	}
+	return values
+}
+
+func TestSLOTracker(t *testing.T) {
+	now := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	t.Run("SuccessRatio", func(t *testing.T) {
+		s := newSLOTracker(config.SLOConfig{Windows: []metav1.Duration{{Duration: 5 * time.Minute}, {Duration: time.Hour}}, SuccessObjective: 0.9})
+		s.record(sloWorkflow("1", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now.Add(-30*time.Minute))
+		s.record(sloWorkflow("2", "my-ns", "my-wftmpl", v1alpha1.WorkflowFailed, time.Minute), now.Add(-time.Minute))
+		s.record(sloWorkflow("3", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now)
+		s.record(sloWorkflow("3", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now)
+		values := sloValues(s.collect(now))
+		assert.Len(t, values, 6)
+		assert.Equal(t, 2.0, values["slo_workflows_count{my-ns,my-wftmpl,5m}"])
+		assert.Equal(t, 0.5, values["slo_success_ratio{my-ns,my-wftmpl,5m}"])
+		assert.InDelta(t, 5, values["slo_success_burn_rate{my-ns,my-wftmpl,5m}"], 0.001)
+		assert.Equal(t, 3.0, values["slo_workflows_count{my-ns,my-wftmpl,1h}"])
+		assert.InDelta(t, 0.667, values["slo_success_ratio{my-ns,my-wftmpl,1h}"], 0.001)
+		assert.InDelta(t, 3.333, values["slo_success_burn_rate{my-ns,my-wftmpl,1h}"], 0.001)
+	})
+	t.Run("Duration", func(t *testing.T) {
+		s := newSLOTracker(config.SLOConfig{Windows: []metav1.Duration{{Duration: 5 * time.Minute}}, Duration: &metav1.Duration{Duration: 10 * time.Minute}, DurationObjective: 0.5})
+		s.record(sloWorkflow("1", "my-ns", "", v1alpha1.WorkflowSucceeded, 5*time.Minute), now)
+		s.record(sloWorkflow("2", "my-ns", "", v1alpha1.WorkflowSucceeded, 10*time.Minute), now)
+		s.record(sloWorkflow("3", "my-ns", "", v1alpha1.WorkflowSucceeded, 20*time.Minute), now)
+		s.record(sloWorkflow("4", "my-ns", "", v1alpha1.WorkflowSucceeded, 30*time.Minute), now)
+		values := sloValues(s.collect(now))
+		assert.Equal(t, 0.5, values["slo_duration_ratio{my-ns,,5m}"])
+		assert.Equal(t, 1.0, values["slo_duration_burn_rate{my-ns,,5m}"])
+		assert.Equal(t, 0.0, values["slo_success_burn_rate{my-ns,,5m}"])
+	})
+	t.Run("TemplateLabelKey", func(t *testing.T) {
+		s := newSLOTracker(config.SLOConfig{TemplateLabelKey: "team"})
+		wf := sloWorkflow("1", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute)
+		wf.Labels["team"] = "my-team"
+		s.record(wf, now)
+		assert.Contains(t, s.series, sloKey{namespace: "my-ns", template: "my-team"})
+	})
+	t.Run("MaxSeries", func(t *testing.T) {
+		s := newSLOTracker(config.SLOConfig{MaxSeries: 1})
+		s.record(sloWorkflow("1", "ns-0", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now)
+		s.record(sloWorkflow("2", "ns-1", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now)
+		s.record(sloWorkflow("3", "ns-2", "my-wftmpl", v1alpha1.WorkflowFailed, time.Minute), now)
+		assert.Len(t, s.series, 2)
+		values := sloValues(s.collect(now))
+		assert.Equal(t, 1.0, values["slo_workflows_count{ns-0,my-wftmpl,5m}"])
+		assert.Equal(t, 2.0, values["slo_workflows_count{_other,_other,5m}"])
+		assert.Equal(t, 0.5, values["slo_success_ratio{_other,_other,6h}"])
+	})
+	t.Run("Expiry", func(t *testing.T) {
+		s := newSLOTracker(config.SLOConfig{Windows: []metav1.Duration{{Duration: 5 * time.Minute}}})
+		s.record(sloWorkflow("1", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute), now)
+		// the ring of buckets has wrapped around, so the new bucket replaces the old one
+		s.record(sloWorkflow("2", "my-ns", "my-wftmpl", v1alpha1.WorkflowFailed, time.Minute), now.Add(5*time.Minute))
+		assert.Equal(t, 0.0, sloValues(s.collect(now.Add(5 * time.Minute)))["slo_success_ratio{my-ns,my-wftmpl,5m}"])
+		assert.Empty(t, s.collect(now.Add(10*time.Minute)))
+		assert.Empty(t, s.series)
+		assert.NotContains(t, s.recorded, types.UID("1"))
+	})
+}
+
+func TestWorkflowCompleted(t *testing.T) {
+	m := New(ServerConfig{}, ServerConfig{})
+	m.WorkflowCompleted(sloWorkflow("1", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute))
+	assert.Nil(t, m.slo)
+
+	m = New(ServerConfig{SLO: &config.SLOConfig{}}, ServerConfig{})
+	m.WorkflowCompleted(sloWorkflow("1", "my-ns", "my-wftmpl", v1alpha1.WorkflowSucceeded, time.Minute))
+	ch := make(chan prometheus.Metric, 100)
+	m.collectSLO(ch)
+	assert.Len(t, ch, 3*4)
+}
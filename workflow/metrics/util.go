@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -24,7 +25,9 @@ type RealTimeMetric struct {
 	Func func() float64
 }
 
-func ConstructOrUpdateMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus) (prometheus.Metric, error) {
+// ConstructOrUpdateMetric creates the metric, if it is nil, and updates it with the value of the spec. If the spec has an
+// exemplar, the exemplar labels are attached to the observation or increment.
+func ConstructOrUpdateMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus, exemplar prometheus.Labels) (prometheus.Metric, error) {
 	if !IsValidMetricName(metricSpec.Name) {
 		return nil, fmt.Errorf(invalidMetricNameError)
 	}
@@ -33,9 +36,9 @@ func ConstructOrUpdateMetric(metric prometheus.Metric, metricSpec *wfv1.Promethe
 	case wfv1.MetricTypeGauge:
 		return constructOrUpdateGaugeMetric(metric, metricSpec)
 	case wfv1.MetricTypeHistogram:
-		return constructOrUpdateHistogramMetric(metric, metricSpec)
+		return constructOrUpdateHistogramMetric(metric, metricSpec, exemplar)
 	case wfv1.MetricTypeCounter:
-		return constructOrUpdateCounterMetric(metric, metricSpec)
+		return constructOrUpdateCounterMetric(metric, metricSpec, exemplar)
 	default:
 		return nil, fmt.Errorf("invalid metric spec")
 	}
@@ -57,7 +60,7 @@ func ConstructRealTimeGaugeMetric(metricSpec *wfv1.Prometheus, valueFunc func()
 	return prometheus.NewGaugeFunc(gaugeOpts, valueFunc), nil
 }
 
-func constructOrUpdateCounterMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus) (prometheus.Metric, error) {
+func constructOrUpdateCounterMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus, exemplar prometheus.Labels) (prometheus.Metric, error) {
 	if metric == nil {
 		labels := metricSpec.GetMetricLabels()
 		if err := ValidateMetricLabels(labels); err != nil {
@@ -72,7 +75,11 @@ func constructOrUpdateCounterMetric(metric prometheus.Metric, metricSpec *wfv1.P
 	}
 
 	counter := metric.(prometheus.Counter)
-	counter.Add(val)
+	if adder, ok := counter.(prometheus.ExemplarAdder); ok && metricSpec.HasExemplar() && exemplar != nil {
+		adder.AddWithExemplar(val, exemplar)
+	} else {
+		counter.Add(val)
+	}
 	return counter, nil
 }
 
@@ -95,12 +102,15 @@ func constructOrUpdateGaugeMetric(metric prometheus.Metric, metricSpec *wfv1.Pro
 	return gauge, nil
 }
 
-func constructOrUpdateHistogramMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus) (prometheus.Metric, error) {
+func constructOrUpdateHistogramMetric(metric prometheus.Metric, metricSpec *wfv1.Prometheus, exemplar prometheus.Labels) (prometheus.Metric, error) {
 	if metric == nil {
 		labels := metricSpec.GetMetricLabels()
 		if err := ValidateMetricLabels(labels); err != nil {
 			return nil, err
 		}
+		if err := validateHistogramBuckets(metricSpec.Histogram); err != nil {
+			return nil, err
+		}
 		metric = newHistogram(metricSpec.Name, metricSpec.Help, labels, metricSpec.Histogram.GetBuckets())
 	}
 
@@ -110,7 +120,11 @@ func constructOrUpdateHistogramMetric(metric prometheus.Metric, metricSpec *wfv1
 	}
 
 	hist := metric.(prometheus.Histogram)
-	hist.Observe(val)
+	if observer, ok := hist.(prometheus.ExemplarObserver); ok && metricSpec.HasExemplar() && exemplar != nil {
+		observer.ObserveWithExemplar(val, exemplar)
+	} else {
+		hist.Observe(val)
+	}
 	return hist, nil
 }
 
@@ -141,6 +155,8 @@ func newGauge(name, help string, labels map[string]string) prometheus.Gauge {
 }
 
 func newHistogram(name, help string, labels map[string]string, buckets []float64) prometheus.Histogram {
+	// Prometheus requires the buckets to be in increasing order
+	sort.Float64s(buckets)
 	histOpts := prometheus.HistogramOpts{
 		Namespace:   argoNamespace,
 		Subsystem:   workflowsSubsystem,
@@ -236,8 +252,27 @@ func ValidateMetricValues(metric *wfv1.Prometheus) error {
 	if metric.Counter != nil && metric.Counter.Value == "" {
 		return errors.New("missing counter.value")
 	}
-	if metric.Histogram != nil && metric.Histogram.Value == "" {
-		return errors.New("missing histogram.value")
+	if metric.Histogram != nil {
+		if metric.Histogram.Value == "" {
+			return errors.New("missing histogram.value")
+		}
+		if err := validateHistogramBuckets(metric.Histogram); err != nil {
+			return err
+		}
+	}
+	if metric.HasExemplar() && metric.Histogram == nil && metric.Counter == nil {
+		return errors.New("exemplars can only be attached to histograms and counters")
+	}
+	return nil
+}
+
+func validateHistogramBuckets(histogram *wfv1.Histogram) error {
+	buckets := histogram.GetBuckets()
+	sort.Float64s(buckets)
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] == buckets[i-1] {
+			return fmt.Errorf("histogram.buckets has duplicate bucket %v", buckets[i])
+		}
 	}
 	return nil
 }
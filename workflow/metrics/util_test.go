@@ -3,7 +3,11 @@ package metrics
 import (
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestRecoverMetric(t *testing.T) {
@@ -25,3 +29,60 @@ func TestRecoverMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMetricValues(t *testing.T) {
+	exemplar := true
+	t.Run("Buckets", func(t *testing.T) {
+		assert.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Histogram: &wfv1.Histogram{Value: "1", Buckets: []wfv1.Amount{{Value: "5"}, {Value: "1"}}}}))
+		assert.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Histogram: &wfv1.Histogram{Value: "1", Buckets: []wfv1.Amount{{Value: "1"}, {Value: "5"}, {Value: "1.0"}}}}), "histogram.buckets has duplicate bucket 1")
+	})
+	t.Run("Exemplar", func(t *testing.T) {
+		assert.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Exemplar: &exemplar, Counter: &wfv1.Counter{Value: "1"}}))
+		assert.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Exemplar: &exemplar, Histogram: &wfv1.Histogram{Value: "1"}}))
+		assert.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Exemplar: &exemplar, Gauge: &wfv1.Gauge{Value: "1"}}), "exemplars can only be attached to histograms and counters")
+	})
+}
+
+func TestConstructOrUpdateMetric(t *testing.T) {
+	exemplar := true
+	t.Run("UnsortedBuckets", func(t *testing.T) {
+		metric, err := ConstructOrUpdateMetric(nil, &wfv1.Prometheus{Name: "unsorted", Help: "help", Histogram: &wfv1.Histogram{Value: "3", Buckets: []wfv1.Amount{{Value: "10"}, {Value: "1"}, {Value: "5"}}}}, nil)
+		if assert.NoError(t, err) {
+			m := &dto.Metric{}
+			assert.NoError(t, metric.Write(m))
+			buckets := m.GetHistogram().GetBucket()
+			if assert.Len(t, buckets, 3) {
+				assert.Equal(t, []float64{1, 5, 10}, []float64{buckets[0].GetUpperBound(), buckets[1].GetUpperBound(), buckets[2].GetUpperBound()})
+				assert.Equal(t, uint64(1), buckets[1].GetCumulativeCount())
+			}
+		}
+	})
+	t.Run("HistogramExemplar", func(t *testing.T) {
+		metric, err := ConstructOrUpdateMetric(nil, &wfv1.Prometheus{Name: "histogram_exemplar", Help: "help", Exemplar: &exemplar, Histogram: &wfv1.Histogram{Value: "3", Buckets: []wfv1.Amount{{Value: "5"}}}}, prometheus.Labels{"workflow_uid": "my-uid"})
+		if assert.NoError(t, err) {
+			m := &dto.Metric{}
+			assert.NoError(t, metric.Write(m))
+			assert.Equal(t, "my-uid", m.GetHistogram().GetBucket()[0].GetExemplar().GetLabel()[0].GetValue())
+		}
+	})
+	t.Run("CounterExemplar", func(t *testing.T) {
+		metric, err := ConstructOrUpdateMetric(nil, &wfv1.Prometheus{Name: "counter_exemplar", Help: "help", Exemplar: &exemplar, Counter: &wfv1.Counter{Value: "1"}}, prometheus.Labels{"workflow_uid": "my-uid"})
+		if assert.NoError(t, err) {
+			m := &dto.Metric{}
+			assert.NoError(t, metric.Write(m))
+			assert.Equal(t, "my-uid", m.GetCounter().GetExemplar().GetLabel()[0].GetValue())
+		}
+	})
+	t.Run("NoExemplar", func(t *testing.T) {
+		metric, err := ConstructOrUpdateMetric(nil, &wfv1.Prometheus{Name: "counter_no_exemplar", Help: "help", Counter: &wfv1.Counter{Value: "1"}}, prometheus.Labels{"workflow_uid": "my-uid"})
+		if assert.NoError(t, err) {
+			m := &dto.Metric{}
+			assert.NoError(t, metric.Write(m))
+			assert.Nil(t, m.GetCounter().GetExemplar())
+		}
+	})
+	t.Run("DuplicateBuckets", func(t *testing.T) {
+		_, err := ConstructOrUpdateMetric(nil, &wfv1.Prometheus{Name: "duplicate", Help: "help", Histogram: &wfv1.Histogram{Value: "3", Buckets: []wfv1.Amount{{Value: "1"}, {Value: "1"}}}}, nil)
+		assert.EqualError(t, err, "histogram.buckets has duplicate bucket 1")
+	})
+}
@@ -0,0 +1,65 @@
+package notifications
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Endpoint is a destination that notifications are POSTed to. Endpoints are configured by config maps in the
+// workflow's namespace, labelled `workflows.argoproj.io/configmap-type: Notifications`, one endpoint per key.
+type Endpoint struct {
+	// URL to POST the notification to
+	URL string `json:"url"`
+	// Events to send, e.g. "WorkflowFailed" or "WorkflowNodeFailed". Defaults to all events.
+	Events []EventType `json:"events,omitempty"`
+	// SecretKeyRef selects the key used to sign the notification, the HMAC-SHA256 signature of the body is sent in the
+	// X-Argo-Signature header.
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// Headers to add to the request, e.g. for authorization
+	Headers map[string]string `json:"headers,omitempty"`
+	// Retries is the number of times to retry a notification that fails. Defaults to 3.
+	Retries *int `json:"retries,omitempty"`
+}
+
+func (e Endpoint) wants(eventType EventType) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, t := range e.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Endpoint) getRetries() int {
+	if e.Retries == nil {
+		return 3
+	}
+	return *e.Retries
+}
+
+// endpointsFromConfigMap returns the endpoints in the config map, sorted by key
+func endpointsFromConfigMap(cm *apiv1.ConfigMap) ([]Endpoint, error) {
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var endpoints []Endpoint
+	for _, key := range keys {
+		var e Endpoint
+		if err := yaml.UnmarshalStrict([]byte(cm.Data[key]), &e); err != nil {
+			return nil, fmt.Errorf("failed to parse notification endpoint %q: %w", key, err)
+		}
+		if e.URL == "" {
+			return nil, fmt.Errorf("notification endpoint %q must have a url", key)
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,261 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+)
+
+type EventType string
+
+const (
+	EventTypeWorkflowRunning    EventType = "WorkflowRunning"
+	EventTypeWorkflowSucceeded  EventType = "WorkflowSucceeded"
+	EventTypeWorkflowFailed     EventType = "WorkflowFailed" // failed or errored
+	EventTypeWorkflowNodeFailed EventType = "WorkflowNodeFailed"
+)
+
+// SignatureHeader is the header that contains the hex encoded HMAC-SHA256 signature of the body, e.g. "sha256=..."
+const SignatureHeader = "X-Argo-Signature"
+
+// Node is the node a notification is about
+type Node struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+	Phase       string `json:"phase"`
+	Message     string `json:"message,omitempty"`
+}
+
+// Notification is the JSON body POSTed to each endpoint
+type Notification struct {
+	Type      EventType   `json:"type"`
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	UID       string      `json:"uid"`
+	Phase     string      `json:"phase"`
+	Message   string      `json:"message,omitempty"`
+	Node      *Node       `json:"node,omitempty"`
+	Time      metav1.Time `json:"time"`
+	// Text is a human readable summary, so that chat webhooks (e.g. Slack) can display the notification as-is
+	Text string `json:"text"`
+}
+
+// Interface sends notifications about workflow phase changes, and node failures, to the endpoints configured in the
+// workflow's namespace
+type Interface interface {
+	// Notify queues notifications for the changes between the old and new workflow, it does not block
+	Notify(old, new *wfv1.Workflow)
+	// Run delivers queued notifications until the context is done
+	Run(ctx context.Context, workers int)
+}
+
+type delivery struct {
+	namespace    string
+	endpoint     Endpoint
+	notification Notification
+}
+
+type notifier struct {
+	kubernetes kubernetes.Interface
+	configMaps cache.Indexer
+	client     *http.Client
+	queue      chan delivery
+}
+
+// we keep retrying for around half a minute by default
+var retry = wait.Backoff{Duration: 1 * time.Second, Factor: 2}
+
+// queueSize is the maximum number of notifications waiting to be delivered, any more are dropped
+const queueSize = 1024
+
+// New creates a notifier that finds endpoints in the config maps in the indexer, typically the controller's config
+// map informer
+func New(kubernetes kubernetes.Interface, configMaps cache.Indexer) Interface {
+	return &notifier{
+		kubernetes: kubernetes,
+		configMaps: configMaps,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		queue:      make(chan delivery, queueSize),
+	}
+}
+
+func (n *notifier) Notify(old, new *wfv1.Workflow) {
+	notifications := changes(old, new)
+	if len(notifications) == 0 {
+		return
+	}
+	endpoints, err := n.endpoints(new.Namespace)
+	if err != nil {
+		log.WithField("namespace", new.Namespace).WithError(err).Error("failed to get notification endpoints")
+		return
+	}
+	for _, e := range endpoints {
+		for _, x := range notifications {
+			if !e.wants(x.Type) {
+				continue
+			}
+			select {
+			case n.queue <- delivery{namespace: new.Namespace, endpoint: e, notification: x}:
+			default:
+				log.WithFields(log.Fields{"namespace": new.Namespace, "workflow": new.Name, "type": x.Type}).Warn("notification queue is full, dropping notification")
+			}
+		}
+	}
+}
+
+func (n *notifier) endpoints(namespace string) ([]Endpoint, error) {
+	objs, err := n.configMaps.ByIndex(indexes.ConfigMapLabelsIndex, common.LabelValueTypeConfigMapNotifications)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []Endpoint
+	for _, obj := range objs {
+		cm, ok := obj.(*apiv1.ConfigMap)
+		if !ok || cm.Namespace != namespace {
+			continue
+		}
+		v, err := endpointsFromConfigMap(cm)
+		if err != nil {
+			return nil, fmt.Errorf("config map %q: %w", cm.Name, err)
+		}
+		endpoints = append(endpoints, v...)
+	}
+	return endpoints, nil
+}
+
+func (n *notifier) Run(ctx context.Context, workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-n.queue:
+					logCtx := log.WithFields(log.Fields{"namespace": d.namespace, "workflow": d.notification.Name, "type": d.notification.Type, "url": d.endpoint.URL})
+					if err := n.deliver(ctx, d); err != nil {
+						logCtx.WithError(err).Warn("failed to deliver notification")
+					} else {
+						logCtx.Debug("notification delivered")
+					}
+				}
+			}
+		}()
+	}
+}
+
+func (n *notifier) deliver(ctx context.Context, d delivery) error {
+	body, err := json.Marshal(d.notification)
+	if err != nil {
+		return err
+	}
+	var signature string
+	if ref := d.endpoint.SecretKeyRef; ref != nil {
+		key, err := util.GetSecrets(ctx, n.kubernetes, d.namespace, ref.Name, ref.Key)
+		if err != nil {
+			return err
+		}
+		signature = sign(key, body)
+	}
+	backoff := retry
+	backoff.Steps = d.endpoint.getRetries() + 1
+	return waitutil.Backoff(backoff, func() (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", d.endpoint.URL, bytes.NewReader(body))
+		if err != nil {
+			return true, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range d.endpoint.Headers {
+			req.Header.Set(k, v)
+		}
+		if signature != "" {
+			req.Header.Set(SignatureHeader, signature)
+		}
+		resp, err := n.client.Do(req)
+		if err != nil {
+			return false, err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode < 300 {
+			return true, nil
+		}
+		err = fmt.Errorf("%s responded %s", d.endpoint.URL, resp.Status)
+		// client errors, other than rate limiting, will not succeed on retry
+		retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return !retryable, err
+	})
+}
+
+// sign returns the value of the SignatureHeader
+func sign(key []byte, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// changes returns the notifications for the changes between the old and new workflow
+func changes(old, new *wfv1.Workflow) []Notification {
+	var notifications []Notification
+	newNotification := func(t EventType, text string) Notification {
+		return Notification{
+			Type:      t,
+			Namespace: new.Namespace,
+			Name:      new.Name,
+			UID:       string(new.UID),
+			Phase:     string(new.Status.Phase),
+			Message:   new.Status.Message,
+			Time:      metav1.Now(),
+			Text:      text,
+		}
+	}
+	if old.Status.Phase != new.Status.Phase {
+		text := fmt.Sprintf("Workflow %s/%s %s", new.Namespace, new.Name, new.Status.Phase)
+		if new.Status.Message != "" {
+			text += ": " + new.Status.Message
+		}
+		switch new.Status.Phase {
+		case wfv1.WorkflowRunning:
+			notifications = append(notifications, newNotification(EventTypeWorkflowRunning, text))
+		case wfv1.WorkflowSucceeded:
+			notifications = append(notifications, newNotification(EventTypeWorkflowSucceeded, text))
+		case wfv1.WorkflowFailed, wfv1.WorkflowError:
+			notifications = append(notifications, newNotification(EventTypeWorkflowFailed, text))
+		}
+	}
+	for id, node := range new.Status.Nodes {
+		if node.Phase != wfv1.NodeFailed && node.Phase != wfv1.NodeError {
+			continue
+		}
+		if oldNode, ok := old.Status.Nodes[id]; ok && oldNode.Phase == node.Phase {
+			continue
+		}
+		text := fmt.Sprintf("Workflow %s/%s node %s %s", new.Namespace, new.Name, node.DisplayName, node.Phase)
+		if node.Message != "" {
+			text += ": " + node.Message
+		}
+		x := newNotification(EventTypeWorkflowNodeFailed, text)
+		x.Node = &Node{ID: node.ID, Name: node.Name, DisplayName: node.DisplayName, Type: string(node.Type), Phase: string(node.Phase), Message: node.Message}
+		notifications = append(notifications, x)
+	}
+	return notifications
+}
@@ -0,0 +1,168 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+)
+
+func newWorkflow(phase wfv1.WorkflowPhase, nodes wfv1.Nodes) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"},
+		Status:     wfv1.WorkflowStatus{Phase: phase, Nodes: nodes},
+	}
+}
+
+func TestChanges(t *testing.T) {
+	t.Run("NoChange", func(t *testing.T) {
+		wf := newWorkflow(wfv1.WorkflowRunning, nil)
+		assert.Empty(t, changes(wf, wf))
+	})
+	t.Run("Running", func(t *testing.T) {
+		x := changes(newWorkflow(wfv1.WorkflowPending, nil), newWorkflow(wfv1.WorkflowRunning, nil))
+		if assert.Len(t, x, 1) {
+			assert.Equal(t, EventTypeWorkflowRunning, x[0].Type)
+			assert.Equal(t, "my-uid", x[0].UID)
+			assert.Equal(t, "Workflow my-ns/my-wf Running", x[0].Text)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		x := changes(newWorkflow(wfv1.WorkflowRunning, nil), newWorkflow(wfv1.WorkflowError, nil))
+		if assert.Len(t, x, 1) {
+			assert.Equal(t, EventTypeWorkflowFailed, x[0].Type)
+			assert.Equal(t, "Error", x[0].Phase)
+		}
+	})
+	t.Run("NodeFailed", func(t *testing.T) {
+		old := newWorkflow(wfv1.WorkflowRunning, wfv1.Nodes{
+			"a": {ID: "a", Phase: wfv1.NodeRunning},
+			"b": {ID: "b", Phase: wfv1.NodeFailed},
+		})
+		new := newWorkflow(wfv1.WorkflowRunning, wfv1.Nodes{
+			"a": {ID: "a", Name: "my-wf.a", DisplayName: "a", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, Message: "exit code 1"},
+			"b": {ID: "b", Phase: wfv1.NodeFailed},
+			"c": {ID: "c", Phase: wfv1.NodeSucceeded},
+		})
+		x := changes(old, new)
+		if assert.Len(t, x, 1) {
+			assert.Equal(t, EventTypeWorkflowNodeFailed, x[0].Type)
+			assert.Equal(t, &Node{ID: "a", Name: "my-wf.a", DisplayName: "a", Type: "Pod", Phase: "Failed", Message: "exit code 1"}, x[0].Node)
+			assert.Equal(t, "Workflow my-ns/my-wf node a Failed: exit code 1", x[0].Text)
+		}
+	})
+}
+
+func TestEndpointsFromConfigMap(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		endpoints, err := endpointsFromConfigMap(&apiv1.ConfigMap{Data: map[string]string{
+			"b": "url: http://b",
+			"a": "url: http://a\nevents: [WorkflowFailed]",
+		}})
+		if assert.NoError(t, err) && assert.Len(t, endpoints, 2) {
+			assert.Equal(t, "http://a", endpoints[0].URL)
+			assert.True(t, endpoints[0].wants(EventTypeWorkflowFailed))
+			assert.False(t, endpoints[0].wants(EventTypeWorkflowRunning))
+			assert.True(t, endpoints[1].wants(EventTypeWorkflowRunning))
+			assert.Equal(t, 3, endpoints[1].getRetries())
+		}
+	})
+	t.Run("MissingURL", func(t *testing.T) {
+		_, err := endpointsFromConfigMap(&apiv1.ConfigMap{Data: map[string]string{"a": "events: [WorkflowFailed]"}})
+		assert.EqualError(t, err, `notification endpoint "a" must have a url`)
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := endpointsFromConfigMap(&apiv1.ConfigMap{Data: map[string]string{"a": "uri: http://a"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestNotifier(t *testing.T) {
+	defer func(d time.Duration) { retry.Duration = d }(retry.Duration)
+	retry.Duration = time.Millisecond
+
+	var (
+		mu       sync.Mutex
+		attempts int
+		received = make(chan *http.Request, 1)
+		bodies   = make(chan []byte, 1)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	kube := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-secret"},
+		Data:       map[string][]byte{"hmac": []byte("shh!")},
+	})
+	configMaps := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{indexes.ConfigMapLabelsIndex: indexes.ConfigMapIndexFunc})
+	for _, namespace := range []string{"my-ns", "other-ns"} {
+		assert.NoError(t, configMaps.Add(&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "notifications",
+				Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapNotifications},
+			},
+			Data: map[string]string{
+				"my-endpoint": `
+url: ` + server.URL + `
+events: [WorkflowFailed]
+headers:
+  Authorization: Bearer my-token
+secretKeyRef:
+  name: my-secret
+  key: hmac
+`,
+			},
+		}))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := New(kube, configMaps)
+	n.Run(ctx, 1)
+	n.Notify(newWorkflow(wfv1.WorkflowPending, nil), newWorkflow(wfv1.WorkflowRunning, nil))
+	n.Notify(newWorkflow(wfv1.WorkflowRunning, nil), newWorkflow(wfv1.WorkflowFailed, nil))
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		assert.Equal(t, sign([]byte("shh!"), body), r.Header.Get(SignatureHeader))
+		var x Notification
+		if assert.NoError(t, json.Unmarshal(body, &x)) {
+			assert.Equal(t, EventTypeWorkflowFailed, x.Type)
+			assert.Equal(t, "my-wf", x.Name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, attempts)
+}
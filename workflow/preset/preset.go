@@ -0,0 +1,110 @@
+package preset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// the keys of the config map data a preset is stored in
+const (
+	parametersKey = "parameters"
+	labelsKey     = "labels"
+)
+
+// Preset is a named bundle of parameters and labels, that workflows can be submitted with, so that teams share one
+// definition of, e.g., a nightly run, rather than each copying it into their own submit scripts
+type Preset struct {
+	Name string
+	// Parameters are passed to the workflow, unless a parameter of the same name is passed when it is submitted
+	Parameters []wfv1.Parameter
+	// Labels are added to the workflow, unless a label of the same key is passed when it is submitted
+	Labels map[string]string
+}
+
+// FromConfigMap returns the preset stored in the config map
+func FromConfigMap(cm *apiv1.ConfigMap) (*Preset, error) {
+	if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapParameterPreset {
+		return nil, fmt.Errorf("config map %q needs to have the label %s: %s to be a preset", cm.Name, common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapParameterPreset)
+	}
+	for k := range cm.Data {
+		if k != parametersKey && k != labelsKey {
+			return nil, fmt.Errorf("preset %q has an unknown key %q, only %q and %q are allowed", cm.Name, k, parametersKey, labelsKey)
+		}
+	}
+	p := &Preset{Name: cm.Name}
+	if v, ok := cm.Data[parametersKey]; ok {
+		params, err := util.ParseParameterFile([]byte(v))
+		if err != nil {
+			return nil, fmt.Errorf("preset %q has invalid parameters: %w", cm.Name, err)
+		}
+		p.Parameters = params
+	}
+	if err := yaml.UnmarshalStrict([]byte(cm.Data[labelsKey]), &p.Labels); err != nil {
+		return nil, fmt.Errorf("preset %q has invalid labels: %w", cm.Name, err)
+	}
+	return p, nil
+}
+
+// Get returns the named preset, from the namespace
+func Get(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string) (*Preset, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, fmt.Errorf("preset %q does not exist, it must be a config map with the label %s: %s", name, common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapParameterPreset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return FromConfigMap(cm)
+}
+
+// SubmitOpts returns a copy of the submit options, with the parameters and labels of the preset added to those passed,
+// which take precedence
+func (p *Preset) SubmitOpts(opts *wfv1.SubmitOpts) (*wfv1.SubmitOpts, error) {
+	out := opts.DeepCopy()
+	passedParams := map[string]bool{}
+	for _, paramStr := range opts.Parameters {
+		passedParams[strings.SplitN(paramStr, "=", 2)[0]] = true
+	}
+	for _, param := range p.Parameters {
+		if !passedParams[param.Name] {
+			out.Parameters = append(out.Parameters, param.Name+"="+param.Value.String())
+		}
+	}
+	labels := map[string]string{}
+	for k, v := range p.Labels {
+		labels[k] = v
+	}
+	if opts.Labels != "" {
+		passedLabels, err := cmdutil.ParseLabels(opts.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("expected labels of the form: NAME1=VALUE2,NAME2=VALUE2. Received: %s: %w", opts.Labels, err)
+		}
+		for k, v := range passedLabels {
+			labels[k] = v
+		}
+	}
+	var keys []string
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	out.Labels = strings.Join(pairs, ",")
+	return out, nil
+}
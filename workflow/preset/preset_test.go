@@ -0,0 +1,75 @@
+package preset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newConfigMap(labelValue string, data map[string]string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-eu", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyConfigMapType: labelValue}},
+		Data:       data,
+	}
+}
+
+func TestFromConfigMap(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		p, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapParameterPreset, map[string]string{
+			"parameters": "region: eu-west-1\nregions: [eu-west-1, eu-central-1]\n",
+			"labels":     "schedule: nightly\n",
+		}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "nightly-eu", p.Name)
+			assert.Equal(t, []wfv1.Parameter{
+				{Name: "region", Value: wfv1.AnyStringPtr("eu-west-1")},
+				{Name: "regions", Value: wfv1.AnyStringPtr(`["eu-west-1","eu-central-1"]`)},
+			}, p.Parameters)
+			assert.Equal(t, map[string]string{"schedule": "nightly"}, p.Labels)
+		}
+	})
+	t.Run("NotPreset", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapParameter, nil))
+		assert.EqualError(t, err, `config map "nightly-eu" needs to have the label workflows.argoproj.io/configmap-type: ParameterPreset to be a preset`)
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapParameterPreset, map[string]string{"region": "eu-west-1"}))
+		assert.EqualError(t, err, `preset "nightly-eu" has an unknown key "region", only "parameters" and "labels" are allowed`)
+	})
+	t.Run("InvalidLabels", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapParameterPreset, map[string]string{"labels": "[a]"}))
+		assert.Error(t, err)
+	})
+}
+
+func TestGet(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(newConfigMap(common.LabelValueTypeConfigMapParameterPreset, map[string]string{"parameters": "region: eu-west-1"}))
+	p, err := Get(context.Background(), kubeClient, "my-ns", "nightly-eu")
+	if assert.NoError(t, err) {
+		assert.Len(t, p.Parameters, 1)
+	}
+	_, err = Get(context.Background(), kubeClient, "my-ns", "nightly-us")
+	assert.EqualError(t, err, `preset "nightly-us" does not exist, it must be a config map with the label workflows.argoproj.io/configmap-type: ParameterPreset`)
+}
+
+func TestPreset_SubmitOpts(t *testing.T) {
+	p := &Preset{
+		Name:       "nightly-eu",
+		Parameters: []wfv1.Parameter{{Name: "region", Value: wfv1.AnyStringPtr("eu-west-1")}, {Name: "size", Value: wfv1.AnyStringPtr("large")}},
+		Labels:     map[string]string{"schedule": "nightly", "team": "data"},
+	}
+	opts := &wfv1.SubmitOpts{Preset: "nightly-eu", Parameters: []string{"region=eu-west-2"}, Labels: "team=ml"}
+	got, err := p.SubmitOpts(opts)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"region=eu-west-2", "size=large"}, got.Parameters)
+		assert.Equal(t, "schedule=nightly,team=ml", got.Labels)
+		assert.Equal(t, []string{"region=eu-west-2"}, opts.Parameters, "the options passed are unchanged")
+	}
+}
@@ -0,0 +1,58 @@
+package profile
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// the key of the config map data the spec is stored in, every other key is a variable
+const specKey = "spec"
+
+// Profile binds the workflows that use it to the infrastructure of their namespace, e.g. its artifact repository,
+// node selectors, or image pull secrets, so that the same template can run unchanged in different namespaces
+type Profile struct {
+	// Spec is merged into the spec of the workflow, which takes precedence
+	Spec wfv1.WorkflowSpec
+	// Variables are available to the workflow as `{{workflow.profile.<key>}}`
+	Variables map[string]string
+}
+
+// FromConfigMap returns the profile stored in the config map
+func FromConfigMap(cm *apiv1.ConfigMap) (*Profile, error) {
+	if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapProfile {
+		return nil, fmt.Errorf("config map %q needs to have the label %s: %s to be a profile", cm.Name, common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapProfile)
+	}
+	p := &Profile{Variables: map[string]string{}}
+	if err := yaml.UnmarshalStrict([]byte(cm.Data[specKey]), &p.Spec); err != nil {
+		return nil, fmt.Errorf("profile %q has an invalid spec: %w", cm.Name, err)
+	}
+	if err := validateSpec(p.Spec); err != nil {
+		return nil, fmt.Errorf("profile %q has an invalid spec: %w", cm.Name, err)
+	}
+	for k, v := range cm.Data {
+		if k != specKey {
+			p.Variables[k] = v
+		}
+	}
+	return p, nil
+}
+
+// validateSpec returns an error if the spec has fields that do not bind a workflow to infrastructure
+func validateSpec(spec wfv1.WorkflowSpec) error {
+	switch {
+	case spec.Entrypoint != "":
+		return fmt.Errorf("entrypoint is not allowed")
+	case len(spec.Templates) > 0:
+		return fmt.Errorf("templates are not allowed")
+	case spec.WorkflowTemplateRef != nil:
+		return fmt.Errorf("workflowTemplateRef is not allowed")
+	case spec.Profile != "":
+		return fmt.Errorf("profile is not allowed")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newConfigMap(labelValue string, data map[string]string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-profile", Labels: map[string]string{common.LabelKeyConfigMapType: labelValue}},
+		Data:       data,
+	}
+}
+
+func TestFromConfigMap(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		p, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapProfile, map[string]string{
+			"spec":  "nodeSelector:\n  pool: batch\nartifactRepositoryRef:\n  configMap: my-repos\n",
+			"image": "my-registry/my-image:v1",
+		}))
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"pool": "batch"}, p.Spec.NodeSelector)
+			assert.Equal(t, "my-repos", p.Spec.ArtifactRepositoryRef.ConfigMap)
+			assert.Equal(t, map[string]string{"image": "my-registry/my-image:v1"}, p.Variables)
+		}
+	})
+	t.Run("NoSpec", func(t *testing.T) {
+		p, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapProfile, map[string]string{"image": "my-image"}))
+		if assert.NoError(t, err) {
+			assert.Empty(t, p.Spec.NodeSelector)
+			assert.Equal(t, "my-image", p.Variables["image"])
+		}
+	})
+	t.Run("NotProfile", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapParameter, nil))
+		assert.EqualError(t, err, `config map "my-profile" needs to have the label workflows.argoproj.io/configmap-type: Profile to be a profile`)
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapProfile, map[string]string{"spec": "nodeSelecter: {}"}))
+		assert.Error(t, err)
+	})
+	t.Run("Templates", func(t *testing.T) {
+		_, err := FromConfigMap(newConfigMap(common.LabelValueTypeConfigMapProfile, map[string]string{"spec": "templates: [{name: main}]"}))
+		assert.EqualError(t, err, `profile "my-profile" has an invalid spec: templates are not allowed`)
+	})
+}
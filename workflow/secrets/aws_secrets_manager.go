@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+type awsSecretsManagerProvider struct {
+	client secretsmanageriface.SecretsManagerAPI
+}
+
+func newAWSSecretsManagerProvider(ctx context.Context, a *wfv1.AWSSecretsManagerSecretProvider, ri resource.Interface) (*awsSecretsManagerProvider, error) {
+	config := aws.NewConfig().WithRegion(a.Region)
+	if a.Endpoint != "" {
+		config = config.WithEndpoint(a.Endpoint)
+	}
+	if a.AccessKeySecret != nil && a.SecretKeySecret != nil {
+		accessKey, err := ri.GetSecret(ctx, a.AccessKeySecret.Name, a.AccessKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		secretKey, err := ri.GetSecret(ctx, a.SecretKeySecret.Name, a.SecretKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		config = config.WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, ""))
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+	return &awsSecretsManagerProvider{client: secretsmanager.New(sess)}, nil
+}
+
+func (p *awsSecretsManagerProvider) GetSecret(ctx context.Context, path, key string) (string, error) {
+	out, err := p.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(path)})
+	if err != nil {
+		return "", err
+	}
+	value := aws.StringValue(out.SecretString)
+	if out.SecretString == nil {
+		value = string(out.SecretBinary)
+	}
+	if key == "" {
+		return value, nil
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return "", fmt.Errorf("secret %q is not a JSON object, so has no keys: %w", path, err)
+	}
+	return getKey(data, key)
+}
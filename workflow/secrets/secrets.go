@@ -0,0 +1,41 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// Provider reads secrets from an external secret store
+type Provider interface {
+	// GetSecret returns the value of the key of the secret at the path, or the whole secret if the key is empty
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+// NewProvider returns the provider of the secret store, whose credentials are read from Kubernetes secrets by the
+// resource interface
+func NewProvider(ctx context.Context, p wfv1.SecretProvider, ri resource.Interface) (Provider, error) {
+	switch {
+	case p.Vault != nil:
+		return newVaultProvider(ctx, p.Vault, ri)
+	case p.AWSSecretsManager != nil:
+		return newAWSSecretsManagerProvider(ctx, p.AWSSecretsManager, ri)
+	}
+	return nil, fmt.Errorf("secret provider %q has no secret store", p.Name)
+}
+
+// getKey returns the value of the key of a secret that is a JSON object
+func getKey(data map[string]interface{}, key string) (string, error) {
+	v, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found", key)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(v)
+	return string(out), err
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type resources map[string]string
+
+func (r resources) GetSecret(_ context.Context, name, key string) (string, error) {
+	return r[name+"/"+key], nil
+}
+
+func (r resources) GetConfigMapKey(context.Context, string, string) (string, error) {
+	return "", nil
+}
+
+func TestVaultProvider(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "my-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/my-app":
+			_, _ = w.Write([]byte(`{"data": {"data": {"password": "my-password", "port": 5432}, "metadata": {"version": 1}}}`))
+		case "/v1/kv/my-app":
+			_, _ = w.Write([]byte(`{"data": {"password": "my-v1-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+		}
+	}))
+	defer server.Close()
+	ri := resources{"my-vault/token": "my-token"}
+	p, err := NewProvider(ctx, wfv1.SecretProvider{Name: "vault", Vault: &wfv1.VaultSecretProvider{
+		Address:     server.URL,
+		TokenSecret: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "my-vault"}, Key: "token"},
+	}}, ri)
+	require.NoError(t, err)
+	t.Run("Key", func(t *testing.T) {
+		value, err := p.GetSecret(ctx, "secret/data/my-app", "password")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-password", value)
+		}
+		value, err = p.GetSecret(ctx, "secret/data/my-app", "port")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "5432", value)
+		}
+	})
+	t.Run("Secret", func(t *testing.T) {
+		value, err := p.GetSecret(ctx, "secret/data/my-app", "")
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"password": "my-password", "port": 5432}`, value)
+		}
+	})
+	t.Run("KeyValueVersion1", func(t *testing.T) {
+		value, err := p.GetSecret(ctx, "kv/my-app", "password")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-v1-password", value)
+		}
+	})
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "secret/data/my-app", "username")
+		assert.EqualError(t, err, `key "username" not found`)
+	})
+	t.Run("SecretNotFound", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "secret/data/other-app", "password")
+		assert.EqualError(t, err, "GET secret/data/other-app: 404 Not Found")
+	})
+}
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]string
+}
+
+func (f *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, in *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(f.secrets[aws.StringValue(in.SecretId)])}, nil
+}
+
+func TestAWSSecretsManagerProvider(t *testing.T) {
+	ctx := context.Background()
+	data, err := json.Marshal(map[string]interface{}{"username": "my-user", "password": "my-password"})
+	require.NoError(t, err)
+	p := &awsSecretsManagerProvider{client: &fakeSecretsManager{secrets: map[string]string{"my-app": string(data), "my-token": "my-token-value"}}}
+	t.Run("Key", func(t *testing.T) {
+		value, err := p.GetSecret(ctx, "my-app", "password")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-password", value)
+		}
+	})
+	t.Run("Secret", func(t *testing.T) {
+		value, err := p.GetSecret(ctx, "my-token", "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-token-value", value)
+		}
+	})
+	t.Run("NotAnObject", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "my-token", "password")
+		assert.Error(t, err)
+	})
+}
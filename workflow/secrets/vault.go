@@ -0,0 +1,110 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// serviceAccountTokenPath is the token the Kubernetes auth method logs in with
+var serviceAccountTokenPath = filepath.Join(common.ServiceAccountTokenMountPath, "token")
+
+type vaultProvider struct {
+	address   string
+	namespace string
+	token     string
+	client    *http.Client
+}
+
+func newVaultProvider(ctx context.Context, v *wfv1.VaultSecretProvider, ri resource.Interface) (*vaultProvider, error) {
+	p := &vaultProvider{address: strings.TrimSuffix(v.Address, "/"), namespace: v.Namespace, client: http.DefaultClient}
+	if v.TokenSecret != nil {
+		token, err := ri.GetSecret(ctx, v.TokenSecret.Name, v.TokenSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		p.token = token
+		return p, nil
+	}
+	jwt, err := ioutil.ReadFile(serviceAccountTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the service account token to log in to Vault with: %w", err)
+	}
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	body, err := json.Marshal(map[string]string{"role": v.Role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return nil, err
+	}
+	if err := p.do(ctx, http.MethodPost, "auth/"+strings.Trim(v.GetAuthMountPath(), "/")+"/login", body, &login); err != nil {
+		return nil, fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	p.token = login.Auth.ClientToken
+	return p, nil
+}
+
+func (p *vaultProvider) GetSecret(ctx context.Context, path, key string) (string, error) {
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := p.do(ctx, http.MethodGet, strings.TrimPrefix(path, "/"), nil, &secret); err != nil {
+		return "", err
+	}
+	data := secret.Data
+	// secrets of version 2 of the key-value engine are nested, alongside their metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	if key == "" {
+		out, err := json.Marshal(data)
+		return string(out), err
+	}
+	return getKey(data, key)
+}
+
+func (p *vaultProvider) do(ctx context.Context, method, path string, body []byte, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, p.address+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if p.token != "" {
+		req.Header.Set("X-Vault-Token", p.token)
+	}
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errs struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(data, &errs)
+		if len(errs.Errors) == 0 {
+			return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+		}
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.Join(errs.Errors, ", "))
+	}
+	return json.Unmarshal(data, v)
+}
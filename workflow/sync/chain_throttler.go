@@ -38,4 +38,14 @@ func (c ChainThrottler) Remove(key Key) {
 	}
 }
 
+// Position returns the position of the item in the queue of the first throttler it is pending in
+func (c ChainThrottler) Position(key Key) (int, int) {
+	for _, t := range c {
+		if position, length := t.Position(key); position > 0 {
+			return position, length
+		}
+	}
+	return 0, 0
+}
+
 var _ Throttler = ChainThrottler{}
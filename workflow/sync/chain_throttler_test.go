@@ -14,10 +14,14 @@ func TestChainThrottler(t *testing.T) {
 	m.On("Add", "foo", int32(1), time.Time{}).Return()
 	m.On("Admit", "foo").Return(false)
 	m.On("Remove", "foo").Return()
+	m.On("Position", "foo").Return(2, 3)
 
 	c := ChainThrottler{m}
 	c.Add("foo", 1, time.Time{})
 	assert.False(t, c.Admit("foo"))
+	position, length := c.Position("foo")
+	assert.Equal(t, 2, position)
+	assert.Equal(t, 3, length)
 	c.Remove("foo")
 
 	assert.True(t, ChainThrottler{}.Admit("foo"))
@@ -8,6 +8,9 @@ type Semaphore interface {
 	release(key string) bool
 	addToQueue(holderKey string, priority int32, creationTime time.Time)
 	removeFromQueue(holderKey string)
+	// queuePosition returns the position, starting at 1, of the holder in the queue for the lock, and the length of the
+	// queue. The position is 0 if the holder is not waiting for the lock.
+	queuePosition(holderKey string) (int, int)
 	getCurrentHolders() []string
 	getCurrentPending() []string
 	getName() string
@@ -44,3 +44,24 @@ func (_m *Throttler) Admit(key string) bool {
 func (_m *Throttler) Remove(key string) {
 	_m.Called(key)
 }
+
+// Position provides a mock function with given fields: key
+func (_m *Throttler) Position(key string) (int, int) {
+	ret := _m.Called(key)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(string) int); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	return r0, r1
+}
@@ -68,6 +68,12 @@ func (m *PriorityMutex) removeFromQueue(holderKey string) {
 	m.mutex.removeFromQueue(holderKey)
 }
 
+func (m *PriorityMutex) queuePosition(holderKey string) (int, int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.mutex.queuePosition(holderKey)
+}
+
 func (m *PriorityMutex) tryAcquire(holderKey string) (bool, string) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -0,0 +1,61 @@
+package sync
+
+import (
+	"time"
+)
+
+// PendingQueue is the queue, by bucket, of the items a throttler has pending processing, in priority order. Items of
+// the same priority are in the order they were created, and then in the order they were added.
+// The throttler serializes its calls, without blocking the workers that admit workflows that are already in progress,
+// so implementations need not be safe for concurrent use.
+type PendingQueue interface {
+	// Add adds the item, or, if it is already queued, updates its priority
+	Add(bucketKey BucketKey, key Key, priority int32, creationTime time.Time) error
+	// Pop removes, and returns, the first item of the bucket, or "" if the bucket is empty
+	Pop(bucketKey BucketKey) (Key, error)
+	// Remove removes the item, if it is queued
+	Remove(bucketKey BucketKey, key Key) error
+	// Position returns the position, starting at 1, of the item in its bucket, and the length of the bucket. The
+	// position is 0 if the item is not queued.
+	Position(bucketKey BucketKey, key Key) (int, int, error)
+}
+
+type memoryPendingQueue map[BucketKey]*priorityQueue
+
+// NewMemoryPendingQueue returns a pending queue that is only kept in memory, so it is rebuilt, from the workflows
+// pending, when the controller restarts
+func NewMemoryPendingQueue() PendingQueue {
+	return make(memoryPendingQueue)
+}
+
+func (q memoryPendingQueue) Add(bucketKey BucketKey, key Key, priority int32, creationTime time.Time) error {
+	if _, ok := q[bucketKey]; !ok {
+		q[bucketKey] = &priorityQueue{itemByKey: make(map[string]*item)}
+	}
+	q[bucketKey].add(key, priority, creationTime)
+	return nil
+}
+
+func (q memoryPendingQueue) Pop(bucketKey BucketKey) (Key, error) {
+	pending, ok := q[bucketKey]
+	if !ok || pending.Len() == 0 {
+		return "", nil
+	}
+	return pending.pop().key, nil
+}
+
+func (q memoryPendingQueue) Remove(bucketKey BucketKey, key Key) error {
+	if pending, ok := q[bucketKey]; ok {
+		pending.remove(key)
+	}
+	return nil
+}
+
+func (q memoryPendingQueue) Position(bucketKey BucketKey, key Key) (int, int, error) {
+	pending, ok := q[bucketKey]
+	if !ok {
+		return 0, 0, nil
+	}
+	position, length := pending.position(key)
+	return position, length, nil
+}
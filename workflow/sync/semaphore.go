@@ -139,6 +139,12 @@ func (s *PrioritySemaphore) removeFromQueue(holderKey string) {
 	s.log.Debugf("Removed from queue: %s", holderKey)
 }
 
+func (s *PrioritySemaphore) queuePosition(holderKey string) (int, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pending.position(holderKey)
+}
+
 func (s *PrioritySemaphore) acquire(holderKey string) bool {
 	if s.semaphore.TryAcquire(1) {
 		s.lockHolder[holderKey] = true
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -173,6 +174,25 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 	return false, updated, msg, nil
 }
 
+// QueuePosition returns the name of the lock, the position, starting at 1, of the workflow, or node, in the queue for
+// it, and the length of the queue. The position is 0 if it is not waiting for the lock.
+func (cm *Manager) QueuePosition(wf *wfv1.Workflow, nodeName string, syncLockRef *wfv1.Synchronization) (string, int, int) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	syncLockName, err := GetLockName(syncLockRef, wf.Namespace)
+	if err != nil {
+		return "", 0, 0
+	}
+	lockKey := syncLockName.EncodeName()
+	lock, found := cm.syncLockMap[lockKey]
+	if !found {
+		return lockKey, 0, 0
+	}
+	position, length := lock.queuePosition(getHolderKey(wf, nodeName))
+	return lockKey, position, length
+}
+
 func (cm *Manager) Release(wf *wfv1.Workflow, nodeName string, syncRef *wfv1.Synchronization) {
 	if syncRef == nil {
 		return
@@ -299,6 +319,30 @@ func getResourceKey(namespace, wfName, resourceName string) string {
 	return resourceKey
 }
 
+// LockState is the state of a semaphore or mutex
+type LockState struct {
+	Name    string   `json:"name"`
+	Limit   int      `json:"limit"`
+	Holders []string `json:"holders"`
+	Pending []string `json:"pending"`
+}
+
+// State returns the state of each lock, in name order
+func (cm *Manager) State() []LockState {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	states := make([]LockState, 0, len(cm.syncLockMap))
+	for _, lock := range cm.syncLockMap {
+		holders := lock.getCurrentHolders()
+		pending := lock.getCurrentPending()
+		sort.Strings(holders)
+		sort.Strings(pending)
+		states = append(states, LockState{Name: lock.getName(), Limit: lock.getLimit(), Holders: holders, Pending: pending})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Name < states[j].Name })
+	return states
+}
+
 func (cm *Manager) getCurrentLockHolders(lockName string) []string {
 	if concurrency, ok := cm.syncLockMap[lockName]; ok {
 		return concurrency.getCurrentHolders()
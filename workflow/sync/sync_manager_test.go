@@ -648,12 +648,20 @@ func TestMutexWfLevel(t *testing.T) {
 		assert.True(t, wfUpdate)
 
 		wf2.Name = "three"
+		wf2.CreationTimestamp = metav1.Now()
 		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg)
 		assert.False(t, status)
 		assert.True(t, wfUpdate)
 
+		lockName, position, length := concurrenyMgr.QueuePosition(wf2, "", wf2.Spec.Synchronization)
+		assert.Equal(t, "default/Mutex/my-mutex", lockName)
+		assert.Equal(t, 2, position)
+		assert.Equal(t, 2, length)
+		_, position, _ = concurrenyMgr.QueuePosition(wf, "", wf.Spec.Synchronization)
+		assert.Zero(t, position, "holds the lock")
+
 		mutex := concurrenyMgr.syncLockMap["default/Mutex/my-mutex"].(*PriorityMutex)
 		assert.NotNil(t, mutex)
 		assert.Len(t, mutex.mutex.pending.items, 2)
@@ -706,3 +714,26 @@ func TestCheckWorkflowExistence(t *testing.T) {
 		assert.Len(semaphore.getCurrentPending(), 0)
 	})
 }
+
+func TestManagerState(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	assert.Empty(t, concurrenyMgr.State())
+
+	wf := wfv1.MustUnmarshalWorkflow(wfWithMutex)
+	wf1 := wf.DeepCopy()
+	wf1.Name = "two"
+	status, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, status)
+	status, _, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, status)
+
+	assert.Equal(t, []LockState{{
+		Name:    "default/Mutex/my-mutex",
+		Limit:   1,
+		Holders: []string{"default/" + wf.Name},
+		Pending: []string{"default/two"},
+	}}, concurrenyMgr.State())
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/cache"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -23,6 +24,9 @@ type Throttler interface {
 	Admit(key Key) bool
 	// Remove notifies throttler that item processing is no longer needed
 	Remove(key Key)
+	// Position returns the position, starting at 1, of the item in the queue of items pending processing, and the
+	// length of the queue. The position is 0 if the item is not pending.
+	Position(key Key) (int, int)
 }
 
 type Key = string
@@ -38,37 +42,52 @@ var NamespaceBucket BucketFunc = func(key Key) BucketKey {
 }
 
 type throttler struct {
-	queue       QueueFunc
-	bucketFunc  BucketFunc
-	inProgress  buckets
-	pending     map[BucketKey]*priorityQueue
-	lock        *sync.Mutex
+	queue      QueueFunc
+	bucketFunc BucketFunc
+	// inProgress is guarded by lock, which is never held while the pending queue is called, so that workers admitting
+	// workflows are not blocked on its I/O, e.g. to a database
+	inProgress buckets
+	lock       *sync.Mutex
+	// pending, and positions, are guarded by pendingLock, which is taken before lock, if both are needed
+	pending PendingQueue
+	// positions caches the positions of the items in the pending queue, by bucket, until the bucket changes
+	positions   map[BucketKey]map[Key]position
+	pendingLock *sync.Mutex
 	parallelism int
 }
 
+type position struct{ position, length int }
+
 type bucket map[Key]bool
 type buckets map[BucketKey]bucket
 
 // NewThrottler returns a throttle that only runs `parallelism` items at once. When an item may need processing,
 // `queue` is invoked.
 func NewThrottler(parallelism int, bucketFunc BucketFunc, queue QueueFunc) Throttler {
+	return NewThrottlerWithPendingQueue(parallelism, bucketFunc, NewMemoryPendingQueue(), queue)
+}
+
+// NewThrottlerWithPendingQueue returns a throttle that keeps the items pending processing in the pending queue, e.g.
+// one in a database, so their order survives restarts.
+func NewThrottlerWithPendingQueue(parallelism int, bucketFunc BucketFunc, pending PendingQueue, queue QueueFunc) Throttler {
 	return &throttler{
 		queue:       queue,
 		bucketFunc:  bucketFunc,
 		inProgress:  make(buckets),
-		pending:     make(map[BucketKey]*priorityQueue),
 		lock:        &sync.Mutex{},
+		pending:     pending,
+		positions:   make(map[BucketKey]map[Key]position),
+		pendingLock: &sync.Mutex{},
 		parallelism: parallelism,
 	}
 }
 
 func (t *throttler) Init(wfs []wfv1.Workflow) error {
-	t.lock.Lock()
-	defer t.lock.Unlock()
 	if t.parallelism == 0 {
 		return nil
 	}
-
+	t.pendingLock.Lock()
+	defer t.pendingLock.Unlock()
 	for _, wf := range wfs {
 		key, err := cache.MetaNamespaceKeyFunc(&wf)
 		if err != nil {
@@ -76,37 +95,44 @@ func (t *throttler) Init(wfs []wfv1.Workflow) error {
 		}
 		if wf.Status.Phase == wfv1.WorkflowRunning {
 			bucketKey := t.bucketFunc(key)
-			if _, ok := t.inProgress[bucketKey]; !ok {
-				t.inProgress[bucketKey] = make(bucket)
+			t.setInProgress(bucketKey, key)
+			// it may have been pending when a durable pending queue was last used
+			delete(t.positions, bucketKey)
+			if err := t.pending.Remove(bucketKey, key); err != nil {
+				return err
 			}
-			t.inProgress[bucketKey][key] = true
 		}
 	}
 	return nil
 }
 
 func (t *throttler) Add(key Key, priority int32, creationTime time.Time) {
-	t.lock.Lock()
-	defer t.lock.Unlock()
 	if t.parallelism == 0 {
 		return
 	}
 	bucketKey := t.bucketFunc(key)
-	if _, ok := t.pending[bucketKey]; !ok {
-		t.pending[bucketKey] = &priorityQueue{itemByKey: make(map[string]*item)}
+	t.pendingLock.Lock()
+	// checked while holding the pending lock, so the item cannot be admitted before it is added
+	if t.isInProgress(bucketKey, key) {
+		t.pendingLock.Unlock()
+		return
+	}
+	delete(t.positions, bucketKey)
+	err := t.pending.Add(bucketKey, key, priority, creationTime)
+	t.pendingLock.Unlock()
+	if err != nil {
+		log.WithError(err).WithField("key", key).Error("Failed to add item to the pending queue")
+		return
 	}
-	t.pending[bucketKey].add(key, priority, creationTime)
 	t.queueThrottled(bucketKey)
 }
 
 func (t *throttler) Admit(key Key) bool {
-	t.lock.Lock()
-	defer t.lock.Unlock()
 	if t.parallelism == 0 {
 		return true
 	}
 	bucketKey := t.bucketFunc(key)
-	if x, ok := t.inProgress[bucketKey]; ok && x[key] {
+	if t.isInProgress(bucketKey, key) {
 		return true
 	}
 	t.queueThrottled(bucketKey)
@@ -114,27 +140,86 @@ func (t *throttler) Admit(key Key) bool {
 }
 
 func (t *throttler) Remove(key Key) {
-	t.lock.Lock()
-	defer t.lock.Unlock()
 	bucketKey := t.bucketFunc(key)
+	t.pendingLock.Lock()
+	delete(t.positions, bucketKey)
+	err := t.pending.Remove(bucketKey, key)
+	t.lock.Lock()
 	if x, ok := t.inProgress[bucketKey]; ok {
 		delete(x, key)
 	}
-	if x, ok := t.pending[bucketKey]; ok {
-		x.remove(key)
+	t.lock.Unlock()
+	t.pendingLock.Unlock()
+	if err != nil {
+		log.WithError(err).WithField("key", key).Error("Failed to remove item from the pending queue")
 	}
 	t.queueThrottled(bucketKey)
 }
 
-func (t *throttler) queueThrottled(bucketKey BucketKey) {
+// Position returns the cached position of the item, which is only got from the pending queue again once the item's
+// bucket changes, as it is called every time a pending workflow is reconciled
+func (t *throttler) Position(key Key) (int, int) {
+	bucketKey := t.bucketFunc(key)
+	t.pendingLock.Lock()
+	defer t.pendingLock.Unlock()
+	if p, ok := t.positions[bucketKey][key]; ok {
+		return p.position, p.length
+	}
+	p, length, err := t.pending.Position(bucketKey, key)
+	if err != nil {
+		log.WithError(err).WithField("key", key).Error("Failed to get the position of item in the pending queue")
+		return 0, 0
+	}
+	if _, ok := t.positions[bucketKey]; !ok {
+		t.positions[bucketKey] = make(map[Key]position)
+	}
+	t.positions[bucketKey][key] = position{p, length}
+	return p, length
+}
+
+func (t *throttler) isInProgress(bucketKey BucketKey, key Key) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.inProgress[bucketKey][key]
+}
+
+// setInProgress marks the item in progress, and returns whether the bucket has places for more items
+func (t *throttler) setInProgress(bucketKey BucketKey, key Key) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
 	if _, ok := t.inProgress[bucketKey]; !ok {
 		t.inProgress[bucketKey] = make(bucket)
 	}
-	inProgress := t.inProgress[bucketKey]
-	pending, ok := t.pending[bucketKey]
-	for ok && pending.Len() > 0 && t.parallelism > len(inProgress) {
-		key := pending.pop().key
-		inProgress[key] = true
+	t.inProgress[bucketKey][key] = true
+	return t.parallelism > len(t.inProgress[bucketKey])
+}
+
+func (t *throttler) hasPlaces(bucketKey BucketKey) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.parallelism > len(t.inProgress[bucketKey])
+}
+
+// queueThrottled admits the items pending in the bucket, while it has places, and then queues them. The items are
+// popped while holding the pending lock, so that concurrent calls do not admit more items than the bucket has places.
+func (t *throttler) queueThrottled(bucketKey BucketKey) {
+	var keys []Key
+	t.pendingLock.Lock()
+	for places := t.hasPlaces(bucketKey); places; {
+		key, err := t.pending.Pop(bucketKey)
+		if err != nil {
+			log.WithError(err).WithField("bucketKey", bucketKey).Error("Failed to pop item from the pending queue")
+			break
+		}
+		if key == "" {
+			break
+		}
+		delete(t.positions, bucketKey)
+		places = t.setInProgress(bucketKey, key)
+		keys = append(keys, key)
+	}
+	t.pendingLock.Unlock()
+	for _, key := range keys {
 		t.queue(key)
 	}
 }
@@ -170,6 +255,22 @@ func (pq *priorityQueue) add(key Key, priority int32, creationTime time.Time) {
 	}
 }
 
+// position returns the position, starting at 1, of the key in priority order, and the length of the queue. The position
+// is 0 if the key is not in the queue.
+func (pq *priorityQueue) position(key Key) (int, int) {
+	item, ok := pq.itemByKey[key]
+	if !ok {
+		return 0, pq.Len()
+	}
+	position := 1
+	for _, other := range pq.items {
+		if other != item && other.before(item) {
+			position++
+		}
+	}
+	return position, pq.Len()
+}
+
 func (pq *priorityQueue) remove(key Key) {
 	if item, ok := pq.itemByKey[key]; ok {
 		heap.Remove(pq, item.index)
@@ -180,10 +281,15 @@ func (pq *priorityQueue) remove(key Key) {
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
-	if pq.items[i].priority == pq.items[j].priority {
-		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
+	return pq.items[i].before(pq.items[j])
+}
+
+// before returns whether the item is ahead of the other item in the queue
+func (i *item) before(other *item) bool {
+	if i.priority == other.priority {
+		return i.creationTime.Before(other.creationTime)
 	}
-	return pq.items[i].priority > pq.items[j].priority
+	return i.priority > other.priority
 }
 
 func (pq priorityQueue) Swap(i, j int) {
@@ -62,6 +62,13 @@ func TestWithParallelismLimitAndPriority(t *testing.T) {
 	assert.False(t, throttler.Admit("d"), "cannot start")
 	assert.Equal(t, "b", queuedKey)
 	queuedKey = ""
+	position, length := throttler.Position("d")
+	assert.Equal(t, 1, position, "top priority")
+	assert.Equal(t, 2, length)
+	position, _ = throttler.Position("c")
+	assert.Equal(t, 2, position)
+	position, _ = throttler.Position("a")
+	assert.Zero(t, position, "is running")
 
 	throttler.Remove("a")
 	assert.True(t, throttler.Admit("b"), "stays running")
@@ -76,6 +83,52 @@ func TestWithParallelismLimitAndPriority(t *testing.T) {
 	assert.Equal(t, "c", queuedKey)
 }
 
+func TestAddInProgress(t *testing.T) {
+	throttler := NewThrottler(1, SingleBucket, func(key string) {})
+	throttler.Add("a", 0, time.Now())
+	throttler.Add("b", 0, time.Now())
+	assert.True(t, throttler.Admit("a"))
+
+	// e.g. the running workflow was updated
+	throttler.Add("a", 0, time.Now())
+	position, length := throttler.Position("a")
+	assert.Zero(t, position, "is running, not pending")
+	assert.Equal(t, 1, length)
+	throttler.Remove("a")
+	assert.True(t, throttler.Admit("b"))
+}
+
+// countingPendingQueue counts the calls to get the positions of items
+type countingPendingQueue struct {
+	PendingQueue
+	positions int
+}
+
+func (q *countingPendingQueue) Position(bucketKey BucketKey, key Key) (int, int, error) {
+	q.positions++
+	return q.PendingQueue.Position(bucketKey, key)
+}
+
+func TestPositionCached(t *testing.T) {
+	pending := &countingPendingQueue{PendingQueue: NewMemoryPendingQueue()}
+	throttler := NewThrottlerWithPendingQueue(1, SingleBucket, pending, func(key string) {})
+	throttler.Add("a", 0, time.Now())
+	throttler.Add("b", 0, time.Now().Add(time.Second))
+	throttler.Add("c", 0, time.Now().Add(2*time.Second))
+	for i := 0; i < 3; i++ {
+		position, length := throttler.Position("c")
+		assert.Equal(t, 2, position)
+		assert.Equal(t, 2, length)
+	}
+	assert.Equal(t, 1, pending.positions, "the position is cached")
+
+	throttler.Remove("a")
+	position, length := throttler.Position("c")
+	assert.Equal(t, 1, position, "the bucket changed")
+	assert.Equal(t, 1, length)
+	assert.Equal(t, 2, pending.positions)
+}
+
 func TestInitWithWorkflows(t *testing.T) {
 	queuedKey := ""
 	throttler := NewThrottler(1, SingleBucket, func(key string) { queuedKey = key })
@@ -0,0 +1,89 @@
+package templategrant
+
+import (
+	"context"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/listers/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+type getter struct {
+	// namespace is the namespace of the workflows
+	namespace string
+	grants    func(namespace string) ([]wfv1.WorkflowTemplateGrant, error)
+	templates func(namespace string) templateresolution.WorkflowTemplateNamespacedGetter
+}
+
+// Get returns the workflow template, if a grant in its namespace grants the namespace of the workflows read access to
+// it. Whether the template exists is not revealed unless it is granted.
+func (g getter) Get(namespace, name string) (*wfv1.WorkflowTemplate, error) {
+	grants, err := g.grants(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !Grants(grants, g.namespace, name) {
+		return nil, errors.Errorf(errors.CodeForbidden, "workflow template %s/%s is not granted to namespace %s, by a workflow template grant in namespace %s", namespace, name, g.namespace, namespace)
+	}
+	return g.templates(namespace).Get(name)
+}
+
+// Grants returns whether any of the grants grant the namespace read access to the workflow template of the name
+func Grants(grants []wfv1.WorkflowTemplateGrant, namespace, name string) bool {
+	for _, grant := range grants {
+		if grant.Spec.Grants(namespace, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGetter returns a getter, for the workflows of the namespace, of the workflow templates of other namespaces that
+// they are granted, from the listers
+func NewGetter(namespace string, grantLister v1alpha1.WorkflowTemplateGrantLister, wftmplLister v1alpha1.WorkflowTemplateLister) templateresolution.GrantedWorkflowTemplateGetter {
+	return getter{
+		namespace: namespace,
+		grants: func(namespace string) ([]wfv1.WorkflowTemplateGrant, error) {
+			list, err := grantLister.WorkflowTemplateGrants(namespace).List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			grants := make([]wfv1.WorkflowTemplateGrant, len(list))
+			for i, grant := range list {
+				grants[i] = *grant
+			}
+			return grants, nil
+		},
+		templates: func(namespace string) templateresolution.WorkflowTemplateNamespacedGetter {
+			return wftmplLister.WorkflowTemplates(namespace)
+		},
+	}
+}
+
+// NewClientsetGetter returns a getter, for the workflows of the namespace, of the workflow templates of other
+// namespaces that they are granted, from the clientset
+func NewClientsetGetter(ctx context.Context, namespace string, wfClientset versioned.Interface) templateresolution.GrantedWorkflowTemplateGetter {
+	return getter{
+		namespace: namespace,
+		grants: func(namespace string) ([]wfv1.WorkflowTemplateGrant, error) {
+			list, err := wfClientset.ArgoprojV1alpha1().WorkflowTemplateGrants(namespace).List(ctx, metav1.ListOptions{})
+			if apierr.IsNotFound(err) {
+				// the custom resource definition is not installed
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return list.Items, nil
+		},
+		templates: func(namespace string) templateresolution.WorkflowTemplateNamespacedGetter {
+			return templateresolution.WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(namespace))
+		},
+	}
+}
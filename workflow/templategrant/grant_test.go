@@ -0,0 +1,44 @@
+package templategrant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+)
+
+func TestClientsetGetter(t *testing.T) {
+	ctx := context.Background()
+	wfClientset := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "granted"}},
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "not-granted"}},
+		&wfv1.WorkflowTemplateGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-grant"},
+			Spec:       wfv1.WorkflowTemplateGrantSpec{Namespaces: []string{"my-ns"}, WorkflowTemplates: []string{"granted"}},
+		},
+	)
+	g := NewClientsetGetter(ctx, "my-ns", wfClientset)
+	t.Run("Granted", func(t *testing.T) {
+		wftmpl, err := g.Get("shared", "granted")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "granted", wftmpl.Name)
+		}
+	})
+	t.Run("NotGranted", func(t *testing.T) {
+		_, err := g.Get("shared", "not-granted")
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+	t.Run("OtherNamespace", func(t *testing.T) {
+		_, err := NewClientsetGetter(ctx, "other-ns", wfClientset).Get("shared", "granted")
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+	t.Run("NoGrants", func(t *testing.T) {
+		_, err := g.Get("my-ns", "granted")
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+}
@@ -3,6 +3,7 @@ package templateresolution
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -66,14 +67,33 @@ func (wrapper *clusterWorkflowTemplateInterfaceWrapper) Get(name string) (*wfv1.
 	return wrapper.clientset.Get(ctx, name, metav1.GetOptions{})
 }
 
+// GrantedWorkflowTemplateGetter gets the WorkflowTemplates of other namespaces, that WorkflowTemplateGrants in those
+// namespaces grant the workflows' namespace read access to.
+type GrantedWorkflowTemplateGetter interface {
+	// Get retrieves the WorkflowTemplate of a given namespace and name, if it is granted.
+	Get(namespace, name string) (*wfv1.WorkflowTemplate, error)
+}
+
+type NullGrantedWorkflowTemplateGetter struct{}
+
+func (n *NullGrantedWorkflowTemplateGetter) Get(namespace, name string) (*wfv1.WorkflowTemplate, error) {
+	return nil, errors.Errorf(errors.CodeForbidden, "invalid spec: workflowtemplates.argoproj.io `%s` is forbidden: "+
+		"workflow templates cannot be referred to from other namespaces, such as %s, here", name, namespace)
+}
+
 // Context is a context of template search.
 type Context struct {
 	// wftmplGetter is an interface to get WorkflowTemplates.
 	wftmplGetter WorkflowTemplateNamespacedGetter
 	// cwftmplGetter is an interface to get ClusterWorkflowTemplates
 	cwftmplGetter ClusterWorkflowTemplateGetter
+	// grantedGetter is an interface to get the WorkflowTemplates of other namespaces
+	grantedGetter GrantedWorkflowTemplateGetter
 	// tmplBase is the base of local template search.
 	tmplBase wfv1.TemplateHolder
+	// namespace is the namespace of the template base, if it is a WorkflowTemplate of another namespace than the
+	// workflow's, in which case template refs without a namespace refer to the templates of its namespace
+	namespace string
 	// workflow is the Workflow where templates will be stored
 	workflow *wfv1.Workflow
 	// log is a logrus entry.
@@ -85,6 +105,7 @@ func NewContext(wftmplGetter WorkflowTemplateNamespacedGetter, cwftmplGetter Clu
 	return &Context{
 		wftmplGetter:  wftmplGetter,
 		cwftmplGetter: cwftmplGetter,
+		grantedGetter: &NullGrantedWorkflowTemplateGetter{},
 		tmplBase:      tmplBase,
 		workflow:      workflow,
 		log:           log.WithFields(log.Fields{}),
@@ -93,13 +114,16 @@ func NewContext(wftmplGetter WorkflowTemplateNamespacedGetter, cwftmplGetter Clu
 
 // NewContext returns new Context.
 func NewContextFromClientset(wftmplClientset typed.WorkflowTemplateInterface, clusterWftmplClient typed.ClusterWorkflowTemplateInterface, tmplBase wfv1.TemplateHolder, workflow *wfv1.Workflow) *Context {
-	return &Context{
-		wftmplGetter:  WrapWorkflowTemplateInterface(wftmplClientset),
-		cwftmplGetter: WrapClusterWorkflowTemplateInterface(clusterWftmplClient),
-		tmplBase:      tmplBase,
-		workflow:      workflow,
-		log:           log.WithFields(log.Fields{}),
+	return NewContext(WrapWorkflowTemplateInterface(wftmplClientset), WrapClusterWorkflowTemplateInterface(clusterWftmplClient), tmplBase, workflow)
+}
+
+// WithGrantedWorkflowTemplateGetter returns the context with a getter of the WorkflowTemplates of other namespaces.
+// Without one, they cannot be referred to.
+func (ctx *Context) WithGrantedWorkflowTemplateGetter(grantedGetter GrantedWorkflowTemplateGetter) *Context {
+	if grantedGetter != nil {
+		ctx.grantedGetter = grantedGetter
 	}
+	return ctx
 }
 
 // GetTemplateByName returns a template by name in the context.
@@ -117,7 +141,29 @@ func (ctx *Context) GetTemplateGetterFromRef(tmplRef *wfv1.TemplateRef) (wfv1.Te
 	if tmplRef.ClusterScope {
 		return ctx.cwftmplGetter.Get(tmplRef.Name)
 	}
-	return ctx.wftmplGetter.Get(tmplRef.Name)
+	return ctx.getWorkflowTemplate(ctx.getRefNamespace(tmplRef), tmplRef.Name)
+}
+
+// getRefNamespace returns the namespace of the WorkflowTemplate a template ref refers to, or "" if it is the
+// workflow's namespace.
+func (ctx *Context) getRefNamespace(tmplRef *wfv1.TemplateRef) string {
+	namespace := tmplRef.Namespace
+	if namespace == "" {
+		namespace = ctx.namespace
+	}
+	if ctx.workflow != nil && namespace == ctx.workflow.Namespace {
+		return ""
+	}
+	return namespace
+}
+
+// getWorkflowTemplate returns the WorkflowTemplate of the workflow's namespace, if the namespace is "", or the granted
+// WorkflowTemplate of the namespace.
+func (ctx *Context) getWorkflowTemplate(namespace, name string) (*wfv1.WorkflowTemplate, error) {
+	if namespace == "" {
+		return ctx.wftmplGetter.Get(name)
+	}
+	return ctx.grantedGetter.Get(namespace, name)
 }
 
 // GetTemplateFromRef returns a template found by a given template ref.
@@ -129,7 +175,7 @@ func (ctx *Context) GetTemplateFromRef(tmplRef *wfv1.TemplateRef) (*wfv1.Templat
 	if tmplRef.ClusterScope {
 		wftmpl, err = ctx.cwftmplGetter.Get(tmplRef.Name)
 	} else {
-		wftmpl, err = ctx.wftmplGetter.Get(tmplRef.Name)
+		wftmpl, err = ctx.getWorkflowTemplate(ctx.getRefNamespace(tmplRef), tmplRef.Name)
 	}
 
 	if err != nil {
@@ -166,7 +212,16 @@ func (ctx *Context) GetCurrentTemplateBase() wfv1.TemplateHolder {
 }
 
 func (ctx *Context) GetTemplateScope() string {
-	return string(ctx.tmplBase.GetResourceScope()) + "/" + ctx.tmplBase.GetName()
+	return string(ctx.tmplBase.GetResourceScope()) + "/" + ctx.getResourceName()
+}
+
+// getResourceName returns the name of the template base, qualified by its namespace if it is a WorkflowTemplate of
+// another namespace, e.g. "my-ns/my-wftmpl".
+func (ctx *Context) getResourceName() string {
+	if ctx.namespace != "" {
+		return ctx.namespace + "/" + ctx.tmplBase.GetName()
+	}
+	return ctx.tmplBase.GetName()
 }
 
 // ResolveTemplate digs into referenes and returns a merged template.
@@ -197,7 +252,7 @@ func (ctx *Context) resolveTemplateImpl(tmplHolder wfv1.TemplateReferenceHolder,
 	if ctx.workflow != nil {
 		// Check if the template has been stored.
 		scope := ctx.tmplBase.GetResourceScope()
-		resourceName := ctx.tmplBase.GetName()
+		resourceName := ctx.getResourceName()
 		tmpl = ctx.workflow.GetStoredTemplate(scope, resourceName, tmplHolder)
 	}
 	if tmpl != nil {
@@ -211,7 +266,7 @@ func (ctx *Context) resolveTemplateImpl(tmplHolder wfv1.TemplateReferenceHolder,
 		// Stored the found template.
 		if ctx.workflow != nil {
 			scope := ctx.tmplBase.GetResourceScope()
-			resourceName := ctx.tmplBase.GetName()
+			resourceName := ctx.getResourceName()
 			stored, err := ctx.workflow.SetStoredTemplate(scope, resourceName, tmplHolder, newTmpl)
 			if err != nil {
 				return nil, nil, false, err
@@ -244,25 +299,38 @@ func (ctx *Context) WithTemplateHolder(tmplHolder wfv1.TemplateReferenceHolder)
 		tmplName := tmplRef.Name
 		if tmplRef.ClusterScope {
 			return ctx.WithClusterWorkflowTemplate(tmplName)
+		} else if namespace := ctx.getRefNamespace(tmplRef); namespace != "" {
+			return ctx.WithWorkflowTemplate(namespace + "/" + tmplName)
 		} else {
 			return ctx.WithWorkflowTemplate(tmplName)
 		}
 	}
-	return ctx.WithTemplateBase(ctx.tmplBase), nil
+	return ctx.withTemplateBase(ctx.tmplBase, ctx.namespace), nil
 }
 
 // WithTemplateBase creates new context with a wfv1.TemplateHolder.
 func (ctx *Context) WithTemplateBase(tmplBase wfv1.TemplateHolder) *Context {
-	return NewContext(ctx.wftmplGetter, ctx.cwftmplGetter, tmplBase, ctx.workflow)
+	return ctx.withTemplateBase(tmplBase, "")
 }
 
-// WithWorkflowTemplate creates new context with a wfv1.TemplateHolder.
+func (ctx *Context) withTemplateBase(tmplBase wfv1.TemplateHolder, namespace string) *Context {
+	newCtx := NewContext(ctx.wftmplGetter, ctx.cwftmplGetter, tmplBase, ctx.workflow).WithGrantedWorkflowTemplateGetter(ctx.grantedGetter)
+	newCtx.namespace = namespace
+	return newCtx
+}
+
+// WithWorkflowTemplate creates new context with a wfv1.TemplateHolder. The name is qualified by the namespace if the
+// WorkflowTemplate is of another namespace than the workflow's, e.g. "my-ns/my-wftmpl", as in template scopes.
 func (ctx *Context) WithWorkflowTemplate(name string) (*Context, error) {
-	wftmpl, err := ctx.wftmplGetter.Get(name)
+	namespace := ""
+	if i := strings.Index(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+	wftmpl, err := ctx.getWorkflowTemplate(namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	return ctx.WithTemplateBase(wftmpl), nil
+	return ctx.withTemplateBase(wftmpl, namespace), nil
 }
 
 // WithWorkflowTemplate creates new context with a wfv1.TemplateHolder.
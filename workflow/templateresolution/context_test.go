@@ -2,12 +2,14 @@ package templateresolution
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -386,3 +388,77 @@ func TestOnWorkflowTemplate(t *testing.T) {
 	tmpl := newCtx.tmplBase.GetTemplateByName("whalesay")
 	assert.NotNil(t, tmpl)
 }
+
+type grantedWorkflowTemplateGetter map[string]*wfv1.WorkflowTemplate
+
+func (g grantedWorkflowTemplateGetter) Get(namespace, name string) (*wfv1.WorkflowTemplate, error) {
+	wftmpl, ok := g[namespace+"/"+name]
+	if !ok {
+		return nil, fmt.Errorf("workflow template %s/%s is not granted", namespace, name)
+	}
+	return wftmpl, nil
+}
+
+var sharedWorkflowTemplateYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: shared-workflow-template
+  namespace: shared
+spec:
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay
+  - name: nested-whalesay
+    steps:
+      - - name: step
+          templateRef:
+            name: shared-workflow-template
+            template: whalesay
+`
+
+func TestGrantedWorkflowTemplate(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault}}
+	wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+	newContext := func() *Context {
+		return NewContextFromClientset(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, wf)
+	}
+	tmplHolder := &wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Namespace: "shared", Name: "shared-workflow-template", Template: "nested-whalesay"}}
+
+	t.Run("NotGranted", func(t *testing.T) {
+		_, _, _, err := newContext().ResolveTemplate(tmplHolder)
+		assert.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeForbidden, err))
+	})
+	t.Run("Granted", func(t *testing.T) {
+		ctx := newContext().WithGrantedWorkflowTemplateGetter(grantedWorkflowTemplateGetter{
+			"shared/shared-workflow-template": unmarshalWftmpl(sharedWorkflowTemplateYaml),
+		})
+		newCtx, tmpl, _, err := ctx.ResolveTemplate(tmplHolder)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "nested-whalesay", tmpl.Name)
+			assert.Equal(t, "namespaced/shared/shared-workflow-template", newCtx.GetTemplateScope())
+		}
+		// refs without a namespace, in a workflow template of another namespace, are of its namespace
+		newCtx, tmpl, _, err = newCtx.ResolveTemplate(&tmpl.Steps[0].Steps[0])
+		if assert.NoError(t, err) {
+			assert.Equal(t, "whalesay", tmpl.Name)
+			assert.Equal(t, "namespaced/shared/shared-workflow-template", newCtx.GetTemplateScope())
+		}
+		// and the qualified scope gets the template base back
+		_, err = newContext().WithGrantedWorkflowTemplateGetter(ctx.grantedGetter).WithWorkflowTemplate("shared/shared-workflow-template")
+		assert.NoError(t, err)
+	})
+	t.Run("SameNamespace", func(t *testing.T) {
+		err := createWorkflowTemplate(wfClientset, someWorkflowTemplateYaml)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, tmpl, _, err := newContext().ResolveTemplate(&wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Namespace: metav1.NamespaceDefault, Name: "some-workflow-template", Template: "whalesay"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "whalesay", tmpl.Name)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+package templaterevision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+// the key of the config map data the template is stored in
+const dataKey = "workflowTemplate"
+
+// Revision returns the revision of the template, or zero if it does not have one, e.g. because it was not created
+// with the Argo Server
+func Revision(obj metav1.Object) int64 {
+	revision, _ := strconv.ParseInt(obj.GetAnnotations()[common.AnnotationKeyWorkflowTemplateRevision], 10, 64)
+	return revision
+}
+
+func setRevision(obj metav1.Object, revision int64) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationKeyWorkflowTemplateRevision] = strconv.FormatInt(revision, 10)
+	obj.SetAnnotations(annotations)
+}
+
+// SetNext sets the revision of the template that is to replace the current one, which is nil if the template is
+// being created. The revision only changes if the spec does.
+func SetNext(current, tmpl *wfv1.WorkflowTemplate) {
+	if current == nil {
+		setRevision(tmpl, 1)
+	} else if revision := Revision(current); revision > 0 && reflect.DeepEqual(current.Spec, tmpl.Spec) {
+		setRevision(tmpl, revision)
+	} else {
+		setRevision(tmpl, revision+1)
+	}
+}
+
+// ConfigMapName is the name of the config map the revision of the template is stored in
+func ConfigMapName(name string, revision int64) string {
+	return fmt.Sprintf("%s-revision-%d", name, revision)
+}
+
+func hash(data string) string {
+	h := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(h[:])
+}
+
+// ToConfigMap returns the config map to store the template's revision in. It is immutable, and owned by the template,
+// so it is deleted with it.
+func ToConfigMap(tmpl *wfv1.WorkflowTemplate) (*apiv1.ConfigMap, error) {
+	revision := Revision(tmpl)
+	if revision == 0 {
+		return nil, fmt.Errorf("workflow template %q does not have a revision", tmpl.Name)
+	}
+	if tmpl.UID == "" {
+		return nil, fmt.Errorf("workflow template %q does not have a UID", tmpl.Name)
+	}
+	data, err := yaml.Marshal(&wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        tmpl.Name,
+			Namespace:   tmpl.Namespace,
+			Labels:      tmpl.Labels,
+			Annotations: tmpl.Annotations,
+		},
+		Spec: tmpl.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName(tmpl.Name, revision),
+			Namespace: tmpl.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyConfigMapType:    common.LabelValueTypeConfigMapWorkflowTemplateRevision,
+				common.LabelKeyWorkflowTemplate: tmpl.Name,
+			},
+			Annotations: map[string]string{
+				common.AnnotationKeyWorkflowTemplateRevision:     strconv.FormatInt(revision, 10),
+				common.AnnotationKeyWorkflowTemplateRevisionHash: hash(string(data)),
+			},
+			// not a controller reference, as blocking the owner's deletion needs more permissions than to create the config map
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: wfv1.SchemeGroupVersion.String(),
+				Kind:       workflow.WorkflowTemplateKind,
+				Name:       tmpl.Name,
+				UID:        tmpl.UID,
+			}},
+		},
+		Data:      map[string]string{dataKey: string(data)},
+		Immutable: pointer.BoolPtr(true),
+	}, nil
+}
+
+func ownedBy(cm *apiv1.ConfigMap, owner metav1.Object) bool {
+	for _, ref := range cm.OwnerReferences {
+		if ref.Kind == workflow.WorkflowTemplateKind && ref.Name == owner.GetName() && ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// FromConfigMap returns the revision of the owner template stored in the config map. Its creation timestamp is when
+// the revision was created. As anyone who can write config maps can create one that looks like a revision, the config
+// map must be owned by the template, and its data must match its hash.
+func FromConfigMap(cm *apiv1.ConfigMap, owner metav1.Object) (*wfv1.WorkflowTemplate, error) {
+	if cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapWorkflowTemplateRevision {
+		return nil, fmt.Errorf("config map %q is not a workflow template revision", cm.Name)
+	}
+	if !ownedBy(cm, owner) {
+		return nil, fmt.Errorf("config map %q is not owned by workflow template %q", cm.Name, owner.GetName())
+	}
+	if cm.Annotations[common.AnnotationKeyWorkflowTemplateRevisionHash] != hash(cm.Data[dataKey]) {
+		return nil, fmt.Errorf("config map %q does not match its hash", cm.Name)
+	}
+	tmpl := &wfv1.WorkflowTemplate{}
+	if err := yaml.Unmarshal([]byte(cm.Data[dataKey]), tmpl); err != nil {
+		return nil, err
+	}
+	tmpl.CreationTimestamp = cm.CreationTimestamp
+	return tmpl, nil
+}
+
+// Save stores the template's revision. Saving a revision that is already stored does nothing. A config map left by a
+// deleted template of the same name, or that is not a valid revision, is replaced.
+func Save(ctx context.Context, configMaps corev1.ConfigMapInterface, tmpl *wfv1.WorkflowTemplate) error {
+	cm, err := ToConfigMap(tmpl)
+	if err != nil {
+		return err
+	}
+	_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	if !apierr.IsAlreadyExists(err) {
+		return err
+	}
+	existing, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if stored, err := FromConfigMap(existing, tmpl); err == nil && reflect.DeepEqual(stored.Spec, tmpl.Spec) {
+		return nil
+	}
+	// config maps are immutable, so it must be deleted to be replaced
+	err = configMaps.Delete(ctx, cm.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &existing.UID}})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	return err
+}
+
+// Get returns the revision of the template.
+func Get(ctx context.Context, configMaps corev1.ConfigMapInterface, owner metav1.Object, revision int64) (*wfv1.WorkflowTemplate, error) {
+	cm, err := configMaps.Get(ctx, ConfigMapName(owner.GetName(), revision), metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, errors.Errorf(errors.CodeNotFound, "revision %d of workflow template %q not found", revision, owner.GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return FromConfigMap(cm, owner)
+}
+
+// List returns the revisions of the template, most recent first. Config maps that are not valid revisions of the
+// template are skipped.
+func List(ctx context.Context, configMaps corev1.ConfigMapInterface, owner metav1.Object) ([]wfv1.WorkflowTemplate, error) {
+	list, err := configMaps.List(ctx, metav1.ListOptions{LabelSelector: labels.FormatLabels(map[string]string{
+		common.LabelKeyConfigMapType:    common.LabelValueTypeConfigMapWorkflowTemplateRevision,
+		common.LabelKeyWorkflowTemplate: owner.GetName(),
+	})})
+	if err != nil {
+		return nil, err
+	}
+	tmpls := make([]wfv1.WorkflowTemplate, 0, len(list.Items))
+	for _, cm := range list.Items {
+		tmpl, err := FromConfigMap(&cm, owner)
+		if err != nil {
+			log.WithError(err).Warn("skipping invalid workflow template revision")
+			continue
+		}
+		tmpls = append(tmpls, *tmpl)
+	}
+	sort.Slice(tmpls, func(i, j int) bool { return Revision(&tmpls[i]) > Revision(&tmpls[j]) })
+	return tmpls, nil
+}
+
+type pinnedGetter struct {
+	templateresolution.WorkflowTemplateNamespacedGetter
+	tmpl *wfv1.WorkflowTemplate
+}
+
+func (g pinnedGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	if name == g.tmpl.Name {
+		return g.tmpl, nil
+	}
+	return g.WorkflowTemplateNamespacedGetter.Get(name)
+}
+
+// WrapGetter returns a getter that gets the revision of the template the workflow's workflowTemplateRef pins, and
+// every other template from the getter. If the workflow does not pin a revision, the getter is returned.
+func WrapGetter(ctx context.Context, configMaps corev1.ConfigMapInterface, wf *wfv1.Workflow, getter templateresolution.WorkflowTemplateNamespacedGetter) (templateresolution.WorkflowTemplateNamespacedGetter, error) {
+	ref := wf.Spec.WorkflowTemplateRef
+	if ref == nil || ref.ClusterScope || ref.Revision == 0 {
+		return getter, nil
+	}
+	owner, err := getter.Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := Get(ctx, configMaps, owner, ref.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return pinnedGetter{getter, tmpl}, nil
+}
@@ -0,0 +1,166 @@
+package templaterevision
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newTemplate(entrypoint string) *wfv1.WorkflowTemplate {
+	return &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-tmpl", Namespace: "my-ns", UID: "my-uid"},
+		Spec:       wfv1.WorkflowTemplateSpec{WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: entrypoint}},
+	}
+}
+
+type getter map[string]*wfv1.WorkflowTemplate
+
+func (g getter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	return g[name], nil
+}
+
+func TestSetNext(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		tmpl := newTemplate("main")
+		SetNext(nil, tmpl)
+		assert.Equal(t, int64(1), Revision(tmpl))
+	})
+	t.Run("Unchanged", func(t *testing.T) {
+		current := newTemplate("main")
+		setRevision(current, 2)
+		tmpl := newTemplate("main")
+		SetNext(current, tmpl)
+		assert.Equal(t, int64(2), Revision(tmpl))
+	})
+	t.Run("Changed", func(t *testing.T) {
+		current := newTemplate("main")
+		setRevision(current, 2)
+		tmpl := newTemplate("other")
+		SetNext(current, tmpl)
+		assert.Equal(t, int64(3), Revision(tmpl))
+	})
+	t.Run("NotRevisioned", func(t *testing.T) {
+		tmpl := newTemplate("main")
+		SetNext(newTemplate("main"), tmpl)
+		assert.Equal(t, int64(1), Revision(tmpl))
+	})
+}
+
+func TestConfigMap(t *testing.T) {
+	tmpl := newTemplate("main")
+	_, err := ToConfigMap(tmpl)
+	assert.EqualError(t, err, `workflow template "my-tmpl" does not have a revision`)
+	setRevision(tmpl, 2)
+	tmpl.UID = ""
+	_, err = ToConfigMap(tmpl)
+	assert.EqualError(t, err, `workflow template "my-tmpl" does not have a UID`)
+	tmpl.UID = "my-uid"
+	cm, err := ToConfigMap(tmpl)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-tmpl-revision-2", cm.Name)
+		assert.Equal(t, common.LabelValueTypeConfigMapWorkflowTemplateRevision, cm.Labels[common.LabelKeyConfigMapType])
+		assert.Equal(t, "my-tmpl", cm.Labels[common.LabelKeyWorkflowTemplate])
+		assert.NotEmpty(t, cm.Annotations[common.AnnotationKeyWorkflowTemplateRevisionHash])
+		if assert.NotNil(t, cm.Immutable) {
+			assert.True(t, *cm.Immutable)
+		}
+		if assert.Len(t, cm.OwnerReferences, 1) {
+			assert.Equal(t, "my-uid", string(cm.OwnerReferences[0].UID))
+		}
+		revision, err := FromConfigMap(cm, tmpl)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "main", revision.Spec.Entrypoint)
+			assert.Equal(t, int64(2), Revision(revision))
+		}
+	}
+	t.Run("OtherUID", func(t *testing.T) {
+		other := newTemplate("main")
+		other.UID = "other-uid"
+		_, err := FromConfigMap(cm, other)
+		assert.EqualError(t, err, `config map "my-tmpl-revision-2" is not owned by workflow template "my-tmpl"`)
+	})
+	t.Run("Modified", func(t *testing.T) {
+		modified := cm.DeepCopy()
+		modified.Data[dataKey] = strings.Replace(modified.Data[dataKey], "main", "evil", 1)
+		_, err := FromConfigMap(modified, tmpl)
+		assert.EqualError(t, err, `config map "my-tmpl-revision-2" does not match its hash`)
+	})
+	t.Run("NotRevision", func(t *testing.T) {
+		notRevision := cm.DeepCopy()
+		notRevision.Labels = nil
+		_, err := FromConfigMap(notRevision, tmpl)
+		assert.Error(t, err)
+	})
+}
+
+func TestSaveGetList(t *testing.T) {
+	ctx := context.Background()
+	configMaps := fake.NewSimpleClientset().CoreV1().ConfigMaps("my-ns")
+	for i, entrypoint := range []string{"main", "other"} {
+		tmpl := newTemplate(entrypoint)
+		setRevision(tmpl, int64(i+1))
+		assert.NoError(t, Save(ctx, configMaps, tmpl))
+		assert.NoError(t, Save(ctx, configMaps, tmpl), "saving a revision again does nothing")
+	}
+	owner := newTemplate("latest")
+	t.Run("Get", func(t *testing.T) {
+		tmpl, err := Get(ctx, configMaps, owner, 1)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "main", tmpl.Spec.Entrypoint)
+		}
+		_, err = Get(ctx, configMaps, owner, 3)
+		assert.EqualError(t, err, `revision 3 of workflow template "my-tmpl" not found`)
+	})
+	t.Run("List", func(t *testing.T) {
+		tmpls, err := List(ctx, configMaps, owner)
+		if assert.NoError(t, err) && assert.Len(t, tmpls, 2) {
+			assert.Equal(t, "other", tmpls[0].Spec.Entrypoint)
+			assert.Equal(t, "main", tmpls[1].Spec.Entrypoint)
+		}
+	})
+	t.Run("ReplaceDeletedTemplates", func(t *testing.T) {
+		tmpl := newTemplate("recreated")
+		tmpl.UID = "other-uid"
+		setRevision(tmpl, 1)
+		assert.NoError(t, Save(ctx, configMaps, tmpl))
+		revision, err := Get(ctx, configMaps, tmpl, 1)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "recreated", revision.Spec.Entrypoint)
+		}
+		_, err = Get(ctx, configMaps, owner, 1)
+		assert.Error(t, err)
+		tmpl = newTemplate("main")
+		setRevision(tmpl, 1)
+		assert.NoError(t, Save(ctx, configMaps, tmpl))
+	})
+	t.Run("WrapGetter", func(t *testing.T) {
+		latest := getter{"my-tmpl": newTemplate("latest"), "my-other-tmpl": newTemplate("latest")}
+		wf := &wfv1.Workflow{Spec: wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-tmpl"}}}
+		g, err := WrapGetter(ctx, configMaps, wf, latest)
+		if assert.NoError(t, err) {
+			assert.Equal(t, latest, g)
+		}
+		wf.Spec.WorkflowTemplateRef.Revision = 1
+		g, err = WrapGetter(ctx, configMaps, wf, latest)
+		if assert.NoError(t, err) {
+			tmpl, err := g.Get("my-tmpl")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "main", tmpl.Spec.Entrypoint)
+			}
+			tmpl, err = g.Get("my-other-tmpl")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "latest", tmpl.Spec.Entrypoint)
+			}
+		}
+		wf.Spec.WorkflowTemplateRef.Revision = 3
+		_, err = WrapGetter(ctx, configMaps, wf, latest)
+		assert.Error(t, err)
+	})
+}
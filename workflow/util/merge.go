@@ -2,7 +2,9 @@ package util
 
 import (
 	"encoding/json"
+	"reflect"
 
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
@@ -39,6 +41,54 @@ func MergeTo(patch, target *wfv1.Workflow) error {
 	return nil
 }
 
+// MergeTemplateDefaultsTo will merge the template defaults into the template. If the template defines a field, this
+// takes precedence over the defaults. The type of the template is not changed.
+func MergeTemplateDefaultsTo(tmplDefaults, tmpl *wfv1.Template) error {
+	if tmplDefaults == nil {
+		return nil
+	}
+	tmplType := tmpl.GetType()
+	envFrom := tmpl.EnvFrom
+	tmplDefaultsJson, err := json.Marshal(tmplDefaults)
+	if err != nil {
+		return err
+	}
+	targetTmplJson, err := json.Marshal(tmpl)
+	if err != nil {
+		return err
+	}
+	resultTmpl, err := strategicpatch.StrategicMergePatch(tmplDefaultsJson, targetTmplJson, wfv1.Template{})
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(resultTmpl, tmpl)
+	if err != nil {
+		return err
+	}
+	tmpl.SetType(tmplType)
+	tmpl.EnvFrom = mergeEnvFrom(tmplDefaults.EnvFrom, envFrom)
+	return nil
+}
+
+// mergeEnvFrom returns the sources of both lists, without duplicates, lowest precedence first. A strategic merge patch
+// replaces, rather than merges, lists without a merge key, such as env from sources.
+func mergeEnvFrom(from, to []apiv1.EnvFromSource) []apiv1.EnvFromSource {
+	var result []apiv1.EnvFromSource
+	for _, s := range append(append([]apiv1.EnvFromSource{}, from...), to...) {
+		found := false
+		for _, t := range result {
+			if reflect.DeepEqual(s, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 // mergeMap will merge all element from right map to left map if it is not present in left.
 func mergeMap(from, to map[string]string) {
 	for key, val := range from {
@@ -79,6 +129,17 @@ func JoinWorkflowSpec(wfSpec, wftSpec, wfDefaultSpec *wfv1.WorkflowSpec) (*wfv1.
 		}
 	}
 
+	// The template defaults' env from sources are merged, rather than replaced
+	if targetWf.Spec.TemplateDefaults != nil {
+		var envFrom []apiv1.EnvFromSource
+		for _, spec := range []*wfv1.WorkflowSpec{wfDefaultSpec, wftSpec, wfSpec} {
+			if spec != nil && spec.TemplateDefaults != nil {
+				envFrom = mergeEnvFrom(envFrom, spec.TemplateDefaults.EnvFrom)
+			}
+		}
+		targetWf.Spec.TemplateDefaults.EnvFrom = envFrom
+	}
+
 	// This condition will update the workflow Spec suspend value if merged value is different.
 	// This scenario will happen when Workflow with WorkflowTemplateRef has suspend template
 	if wfSpec.Suspend != targetWf.Spec.Suspend {
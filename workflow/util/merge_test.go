@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -268,3 +269,49 @@ func TestJoinWorkflowMetaData(t *testing.T) {
 		assert.Equal("wf", wf2.Annotations["testAnnotation"])
 	})
 }
+
+func TestMergeTemplateDefaultsTo(t *testing.T) {
+	t.Run("Env", func(t *testing.T) {
+		defaults := &wfv1.Template{
+			Env:     []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "LOG_LEVEL", Value: "info"}},
+			EnvFrom: []apiv1.EnvFromSource{{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "common"}}}},
+		}
+		tmpl := &wfv1.Template{
+			Container: &apiv1.Container{Image: "argoproj/argosay:v2"},
+			Env:       []apiv1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+			EnvFrom:   []apiv1.EnvFromSource{{ConfigMapRef: &apiv1.ConfigMapEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-config"}}}},
+		}
+		err := MergeTemplateDefaultsTo(defaults, tmpl)
+		if assert.NoError(t, err) {
+			assert.ElementsMatch(t, []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "LOG_LEVEL", Value: "debug"}}, tmpl.Env)
+			if assert.Len(t, tmpl.EnvFrom, 2) {
+				assert.Equal(t, "common", tmpl.EnvFrom[0].SecretRef.Name)
+				assert.Equal(t, "my-config", tmpl.EnvFrom[1].ConfigMapRef.Name)
+			}
+			assert.Equal(t, wfv1.TemplateTypeContainer, tmpl.GetType())
+		}
+	})
+	t.Run("NoEnvFrom", func(t *testing.T) {
+		tmpl := &wfv1.Template{Container: &apiv1.Container{}}
+		err := MergeTemplateDefaultsTo(&wfv1.Template{}, tmpl)
+		if assert.NoError(t, err) {
+			assert.Nil(t, tmpl.EnvFrom)
+		}
+	})
+}
+
+func TestJoinWorkflowSpecTemplateDefaultsEnvFrom(t *testing.T) {
+	secretRef := func(name string) apiv1.EnvFromSource {
+		return apiv1.EnvFromSource{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: name}}}
+	}
+	wfSpec := &wfv1.WorkflowSpec{TemplateDefaults: &wfv1.Template{EnvFrom: []apiv1.EnvFromSource{secretRef("wf")}}}
+	wfDefaultSpec := &wfv1.WorkflowSpec{TemplateDefaults: &wfv1.Template{
+		Env:     []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}},
+		EnvFrom: []apiv1.EnvFromSource{secretRef("default"), secretRef("wf")},
+	}}
+	targetWf, err := JoinWorkflowSpec(wfSpec, nil, wfDefaultSpec)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []apiv1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}}, targetWf.Spec.TemplateDefaults.Env)
+		assert.Equal(t, []apiv1.EnvFromSource{secretRef("default"), secretRef("wf")}, targetWf.Spec.TemplateDefaults.EnvFrom)
+	}
+}
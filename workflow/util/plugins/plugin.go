@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/tracing"
 )
 
 type Client struct {
@@ -59,6 +60,7 @@ func (p *Client) Call(ctx context.Context, method string, args interface{}, repl
 		if err != nil {
 			return err
 		}
+		tracing.InjectHTTPHeaders(ctx, req.Header)
 		resp, err := p.client.Do(req)
 		if err != nil {
 			return err
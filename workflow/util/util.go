@@ -9,10 +9,12 @@ import (
 	"math/rand"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	nruntime "runtime"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 
@@ -49,6 +51,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -169,8 +172,10 @@ func IsWorkflowCompleted(wf *wfv1.Workflow) bool {
 	return false
 }
 
-// SubmitWorkflow validates and submits a single workflow and overrides some of the fields of the workflow
-func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClientset wfclientset.Interface, namespace string, wf *wfv1.Workflow, opts *wfv1.SubmitOpts) (*wfv1.Workflow, error) {
+// SubmitWorkflow validates and submits a single workflow and overrides some of the fields of the workflow. The workflow
+// template grants of the workflow's namespace are evaluated, and the templates they grant are got, with
+// grantWfClientset, as the submitter need not be able to read the namespaces that grant them
+func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClientset, grantWfClientset wfclientset.Interface, namespace string, wf *wfv1.Workflow, opts *wfv1.SubmitOpts) (*wfv1.Workflow, error) {
 	err := ApplySubmitOpts(wf, opts)
 	if err != nil {
 		return nil, err
@@ -178,7 +183,7 @@ func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClie
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
-	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{Submit: true})
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, validate.ValidateOpts{Submit: true, GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, namespace, grantWfClientset)})
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +200,33 @@ func SubmitWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClie
 	}
 }
 
+// NewWorkflowFromResource returns a new workflow, to be submitted from the cron workflow, workflow template or cluster
+// workflow template
+func NewWorkflowFromResource(ctx context.Context, wfClient wfclientset.Interface, namespace, kind, name string) (*wfv1.Workflow, error) {
+	switch kind {
+	case workflow.CronWorkflowKind, workflow.CronWorkflowSingular, workflow.CronWorkflowPlural, workflow.CronWorkflowShortName:
+		cronWf, err := wfClient.ArgoprojV1alpha1().CronWorkflows(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return common.ConvertCronWorkflowToWorkflow(cronWf), nil
+	case workflow.WorkflowTemplateKind, workflow.WorkflowTemplateSingular, workflow.WorkflowTemplatePlural, workflow.WorkflowTemplateShortName:
+		wfTmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return common.NewWorkflowFromWorkflowTemplate(name, wfTmpl.Spec.WorkflowMetadata, false), nil
+	case workflow.ClusterWorkflowTemplateKind, workflow.ClusterWorkflowTemplateSingular, workflow.ClusterWorkflowTemplatePlural, workflow.ClusterWorkflowTemplateShortName:
+		cwfTmpl, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return common.NewWorkflowFromWorkflowTemplate(name, cwfTmpl.Spec.WorkflowMetadata, true), nil
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "Resource kind '%s' is not supported for submitting", kind)
+	}
+}
+
 // CreateServerDryRun fills the workflow struct with the server's representation without creating it and returns an error, if there is any
 func CreateServerDryRun(ctx context.Context, wf *wfv1.Workflow, wfClientset wfclientset.Interface) (*wfv1.Workflow, error) {
 	// Keep the workflow metadata because it will be overwritten by the Post request
@@ -216,7 +248,7 @@ func PopulateSubmitOpts(command *cobra.Command, submitOpts *wfv1.SubmitOpts, inc
 	command.Flags().StringVar(&submitOpts.Entrypoint, "entrypoint", "", "override entrypoint")
 	command.Flags().StringArrayVarP(&submitOpts.Parameters, "parameter", "p", []string{}, "pass an input parameter")
 	command.Flags().StringVar(&submitOpts.ServiceAccount, "serviceaccount", "", "run all pods in the workflow using specified serviceaccount")
-	command.Flags().StringVarP(&submitOpts.ParameterFile, "parameter-file", "f", "", "pass a file containing all input parameters")
+	command.Flags().StringVarP(&submitOpts.ParameterFile, "parameter-file", "f", "", "pass a YAML or JSON file mapping the names of input parameters to their values, values that are not strings, e.g. maps, are passed as JSON")
 	command.Flags().StringVarP(&submitOpts.Labels, "labels", "l", "", "Comma separated labels to apply to the workflow. Will override previous values.")
 
 	if includeDryRun {
@@ -275,6 +307,9 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 			wfAnnotations[k] = v
 		}
 	}
+	if opts.Preset != "" {
+		wfAnnotations[common.AnnotationKeyPreset] = opts.Preset
+	}
 	wf.SetAnnotations(wfAnnotations)
 	if len(opts.Parameters) > 0 || opts.ParameterFile != "" {
 		newParams := make([]wfv1.Parameter, 0)
@@ -291,34 +326,11 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 
 		// Add parameters from a parameter-file, if one was provided
 		if opts.ParameterFile != "" {
-			var body []byte
-			var err error
-			if cmdutil.IsURL(opts.ParameterFile) {
-				body, err = ReadFromUrl(opts.ParameterFile)
-				if err != nil {
-					return errors.InternalWrapError(err)
-				}
-			} else {
-				body, err = ioutil.ReadFile(opts.ParameterFile)
-				if err != nil {
-					return errors.InternalWrapError(err)
-				}
-			}
-
-			yamlParams := map[string]json.RawMessage{}
-			err = yaml.Unmarshal(body, &yamlParams)
+			fileParams, err := ReadParameterFile(opts.ParameterFile)
 			if err != nil {
-				return errors.InternalWrapError(err)
+				return err
 			}
-
-			for k, v := range yamlParams {
-				// We get quoted strings from the yaml file.
-				value, err := strconv.Unquote(string(v))
-				if err != nil {
-					// the string is already clean.
-					value = string(v)
-				}
-				param := wfv1.Parameter{Name: k, Value: wfv1.AnyStringPtr(value)}
+			for _, param := range fileParams {
 				if _, ok := passedParams[param.Name]; ok {
 					// this parameter was overridden via command line
 					continue
@@ -349,6 +361,48 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 	return nil
 }
 
+// ReadParameterFile reads the parameters of the parameter file, or URL, the names of parameters mapped to their values
+func ReadParameterFile(file string) ([]wfv1.Parameter, error) {
+	var body []byte
+	var err error
+	if cmdutil.IsURL(file) {
+		body, err = ReadFromUrl(file)
+	} else {
+		body, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return ParseParameterFile(body)
+}
+
+// ParseParameterFile parses a YAML or JSON parameter file, which maps the names of parameters to their values, and
+// returns the parameters ordered by name. Values that are not strings, such as numbers, lists and maps, are given as
+// JSON, so nested structures can be read in expressions, e.g. "jsonpath(workflow.parameters.config, '$.replicas')".
+func ParseParameterFile(body []byte) ([]wfv1.Parameter, error) {
+	values := map[string]json.RawMessage{}
+	if err := yaml.Unmarshal(body, &values); err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "parameter file must map the names of parameters to their values: %v", err)
+	}
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]wfv1.Parameter, 0, len(names))
+	for _, name := range names {
+		raw := values[name]
+		// null is an empty string
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			// not a string
+			value = string(raw)
+		}
+		params = append(params, wfv1.Parameter{Name: name, Value: wfv1.AnyStringPtr(value)})
+	}
+	return params, nil
+}
+
 // SuspendWorkflow suspends a workflow by setting spec.suspend to true. Retries conflict errors
 func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workflowName string) error {
 	err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
@@ -372,11 +426,13 @@ func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workf
 	return err
 }
 
-// ResumeWorkflow resumes a workflow by setting spec.suspend to nil and any suspended nodes to Successful.
+// ResumeWorkflow resumes a workflow by setting spec.suspend to nil and any suspended nodes to Successful. Who resumed
+// it, if known, is recorded on the nodes. Approval gates must be approved, rather than resumed, so they are skipped,
+// unless they are all there is to resume.
 // Retries conflict errors
-func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string) error {
+func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string, resumedBy string) error {
 	if len(nodeFieldSelector) > 0 {
-		return updateSuspendedNode(ctx, wfIf, hydrator, workflowName, nodeFieldSelector, SetOperationValues{Phase: wfv1.NodeSucceeded})
+		return updateSuspendedNode(ctx, wfIf, hydrator, workflowName, nodeFieldSelector, SetOperationValues{Phase: wfv1.NodeSucceeded, ResumedBy: resumedBy})
 	} else {
 		err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
 			wf, err := wfIf.Get(ctx, workflowName, metav1.GetOptions{})
@@ -396,26 +452,28 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 			}
 
 			// To resume a workflow with a suspended node we simply mark the node as Successful
+			var gate *wfv1.NodeStatus
 			for nodeID, node := range wf.Status.Nodes {
 				if node.IsActiveSuspendNode() {
-					if node.Outputs != nil {
-						for i, param := range node.Outputs.Parameters {
-							if param.ValueFrom != nil && param.ValueFrom.Supplied != nil {
-								if param.ValueFrom.Default != nil {
-									node.Outputs.Parameters[i].Value = param.ValueFrom.Default
-									node.Outputs.Parameters[i].ValueFrom = nil
-								} else {
-									return false, fmt.Errorf("raw output parameter '%s' has not been set and does not have a default value", param.Name)
-								}
-							}
-						}
+					if node.Approval.IsGated() {
+						gate = node.DeepCopy()
+						continue
+					}
+					if err := setRawOutputDefaults(&node); err != nil {
+						return false, err
 					}
 					node.Phase = wfv1.NodeSucceeded
 					node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+					if resumedBy != "" {
+						addApprovalDecision(&node, wfv1.ApprovalDecision{Approver: resumedBy, Approved: true})
+					}
 					wf.Status.Nodes[nodeID] = node
 					workflowUpdated = true
 				}
 			}
+			if !workflowUpdated && gate != nil {
+				return true, errors.Errorf(errors.CodeBadRequest, "node %s is an approval gate, so it can only be approved, or rejected", gate.DisplayName)
+			}
 
 			if workflowUpdated {
 				err := hydrator.Dehydrate(wf)
@@ -437,6 +495,112 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 	}
 }
 
+// setRawOutputDefaults sets the raw output parameters of a suspend node that have not been supplied to their defaults
+func setRawOutputDefaults(node *wfv1.NodeStatus) error {
+	if node.Outputs == nil {
+		return nil
+	}
+	for i, param := range node.Outputs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.Supplied != nil {
+			if param.ValueFrom.Default != nil {
+				node.Outputs.Parameters[i].Value = param.ValueFrom.Default
+				node.Outputs.Parameters[i].ValueFrom = nil
+			} else {
+				return fmt.Errorf("raw output parameter '%s' has not been set and does not have a default value", param.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// addApprovalDecision records the approval, or rejection, of a suspend node
+func addApprovalDecision(node *wfv1.NodeStatus, decision wfv1.ApprovalDecision) {
+	if node.Approval == nil {
+		node.Approval = &wfv1.ApprovalStatus{}
+	}
+	decision.Time = metav1.Time{Time: time.Now().UTC()}
+	node.Approval.Decisions = append(node.Approval.Decisions, decision)
+}
+
+// Approver is the identity of the user who approves, or rejects, an approval gate
+type Approver struct {
+	// Name is the email, or subject, of the user
+	Name   string
+	Groups []string
+}
+
+// ApproveWorkflow approves, or rejects, the active approval gates of a workflow that match the node field selector, or
+// all of them if it is empty. A gate succeeds once enough of its approvers approve it, and fails as soon as one of them
+// rejects it. Returns a forbidden error if the approver is not one of the gate's approvers.
+// Retries conflict errors
+func ApproveWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeFieldSelector string, approver Approver, approved bool, comment string) error {
+	if approver.Name == "" {
+		return errors.New(errors.CodeForbidden, "approving, or rejecting, a workflow requires an identity, e.g. an SSO login")
+	}
+	selector, err := fields.ParseSelector(nodeFieldSelector)
+	if err != nil {
+		return err
+	}
+	return waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
+		wf, err := wfIf.Get(ctx, workflowName, metav1.GetOptions{})
+		if err != nil {
+			return !errorsutil.IsTransientErr(err), err
+		}
+
+		err = hydrator.Hydrate(wf)
+		if err != nil {
+			return true, err
+		}
+
+		nodeUpdated := false
+		for nodeID, node := range wf.Status.Nodes {
+			if !node.IsActiveSuspendNode() || !node.Approval.IsGated() || !SelectorMatchesNode(selector, node) {
+				continue
+			}
+			gate := node.Approval.Gate
+			if !gate.IsApprover(approver.Name, approver.Groups) {
+				return true, errors.Errorf(errors.CodeForbidden, "%s is not an approver of node %s", approver.Name, node.DisplayName)
+			}
+			addApprovalDecision(&node, wfv1.ApprovalDecision{Approver: approver.Name, Approved: approved, Comment: comment})
+			switch {
+			case !approved:
+				node.Phase = wfv1.NodeFailed
+				node.Message = fmt.Sprintf("rejected by %s", approver.Name)
+				if comment != "" {
+					node.Message += ": " + comment
+				}
+				node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+			case node.Approval.IsApproved():
+				if err := setRawOutputDefaults(&node); err != nil {
+					return true, err
+				}
+				node.Phase = wfv1.NodeSucceeded
+				node.Message = fmt.Sprintf("approved by %s", strings.Join(node.Approval.Approvers(), ", "))
+				node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+			default:
+				node.Message = fmt.Sprintf("approved by %d of %d required approvers", len(node.Approval.Approvers()), gate.GetRequiredApprovals())
+			}
+			wf.Status.Nodes[nodeID] = node
+			nodeUpdated = true
+		}
+
+		if !nodeUpdated {
+			return true, errors.Errorf(errors.CodeBadRequest, "no active approval gates matching nodeFieldSelector: %s", nodeFieldSelector)
+		}
+
+		err = hydrator.Dehydrate(wf)
+		if err != nil {
+			return true, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+		}
+
+		_, err = wfIf.Update(ctx, wf, metav1.UpdateOptions{})
+		if apierr.IsConflict(err) {
+			return false, nil
+		}
+		return true, err
+	})
+}
+
 func SelectorMatchesNode(selector fields.Selector, node wfv1.NodeStatus) bool {
 	nodeFields := fields.Set{
 		"displayName":  node.DisplayName,
@@ -462,6 +626,8 @@ type SetOperationValues struct {
 	Phase            wfv1.NodePhase
 	Message          string
 	OutputParameters map[string]string
+	// ResumedBy, if set, is recorded as the user who resumed the nodes
+	ResumedBy string
 }
 
 func AddParamToGlobalScope(wf *wfv1.Workflow, log *log.Entry, param wfv1.Parameter) bool {
@@ -520,6 +686,12 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 
 					// Update phase
 					if values.Phase != "" {
+						if node.Approval.IsGated() {
+							return true, errors.Errorf(errors.CodeBadRequest, "node %s is an approval gate, so it can only be approved, or rejected", node.DisplayName)
+						}
+						if values.ResumedBy != "" {
+							addApprovalDecision(&node, wfv1.ApprovalDecision{Approver: values.ResumedBy, Approved: true})
+						}
 						node.Phase = values.Phase
 						if values.Phase.Fulfilled() {
 							node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
@@ -607,8 +779,10 @@ func RandSuffix() string {
 	return randString(5)
 }
 
-// FormulateResubmitWorkflow formulate a new workflow from a previous workflow, optionally re-using successful nodes
-func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow, error) {
+// FormulateResubmitWorkflow formulate a new workflow from a previous workflow, optionally re-using successful nodes.
+// The parameters, e.g. "message=hello", override the workflow's arguments. Successful nodes whose template refers to
+// an overridden workflow parameter are not re-used.
+func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool, parameters []string) (*wfv1.Workflow, error) {
 	newWF := wfv1.Workflow{}
 	newWF.TypeMeta = wf.TypeMeta
 
@@ -622,8 +796,17 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	// in order to formulate the node statuses. Which means we cannot reuse metadata.generateName
 	// The following simulates the behavior of generateName
 	if memoized {
+		err := packer.DecompressWorkflow(wf)
+		if err != nil {
+			return nil, err
+		}
 		switch wf.Status.Phase {
 		case wfv1.WorkflowFailed, wfv1.WorkflowError:
+		case wfv1.WorkflowSucceeded:
+			// a successful workflow is only worth resubmitting if something is re-computed
+			if len(parameters) == 0 && !wf.Status.Nodes.Any(func(node wfv1.NodeStatus) bool { return node.FailedOrError() }) {
+				return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error, or have its parameters overridden, to resubmit in memoized mode")
+			}
 		default:
 			return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to resubmit in memoized mode")
 		}
@@ -631,7 +814,10 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	}
 
 	// carry over the unmodified spec
-	newWF.Spec = wf.Spec
+	newWF.Spec = *wf.Spec.DeepCopy()
+	if err := overrideParameters(&newWF, parameters); err != nil {
+		return nil, err
+	}
 
 	if newWF.Spec.ActiveDeadlineSeconds != nil && *newWF.Spec.ActiveDeadlineSeconds == 0 {
 		// if it was terminated, unset the deadline
@@ -673,10 +859,7 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	replaceRegexp := regexp.MustCompile("^" + wf.ObjectMeta.Name)
 	newWF.Status.Nodes = make(map[string]wfv1.NodeStatus)
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
-	err := packer.DecompressWorkflow(wf)
-	if err != nil {
-		log.Fatal(err)
-	}
+	changed := changedParameters(wf.Spec.Arguments, newWF.Spec.Arguments)
 	for _, node := range wf.Status.Nodes {
 		newNode := node.DeepCopy()
 		if strings.HasPrefix(node.Name, onExitNodeName) {
@@ -688,7 +871,8 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 		if node.BoundaryID != "" {
 			newNode.BoundaryID = convertNodeID(&newWF, replaceRegexp, node.BoundaryID, wf.Status.Nodes)
 		}
-		if newNode.FailedOrError() && newNode.Type == wfv1.NodeTypePod {
+		reuse := newNode.Type == wfv1.NodeTypePod && !newNode.FailedOrError() && !usesParameters(wf, node, changed)
+		if newNode.Type == wfv1.NodeTypePod && !reuse {
 			newNode.StartedAt = metav1.Time{}
 			newNode.FinishedAt = metav1.Time{}
 		} else {
@@ -705,10 +889,10 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 			newOutboundNodes[i] = convertNodeID(&newWF, replaceRegexp, outboundID, wf.Status.Nodes)
 		}
 		newNode.OutboundNodes = newOutboundNodes
-		if !newNode.FailedOrError() && newNode.Type == wfv1.NodeTypePod {
+		if reuse {
 			newNode.Phase = wfv1.NodeSkipped
 			newNode.Type = wfv1.NodeTypeSkipped
-			newNode.Message = fmt.Sprintf("original pod: %s", originalID)
+			newNode.Message = resubmittedNodeMessagePrefix + originalID
 		} else {
 			newNode.Phase = wfv1.NodePending
 			newNode.Message = ""
@@ -727,6 +911,51 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	return &newWF, nil
 }
 
+const resubmittedNodeMessagePrefix = "original pod: "
+
+// IsResubmittedNode returns true if the node re-uses the outputs of a successful node of the workflow it was
+// resubmitted from
+func IsResubmittedNode(node wfv1.NodeStatus) bool {
+	return node.Type == wfv1.NodeTypeSkipped && strings.HasPrefix(node.Message, resubmittedNodeMessagePrefix)
+}
+
+// changedParameters returns the names of the parameters whose value differs between the old and new arguments
+func changedParameters(oldArgs, newArgs wfv1.Arguments) []string {
+	var names []string
+	for _, p := range newArgs.Parameters {
+		o := oldArgs.GetParameterByName(p.Name)
+		if o == nil || !reflect.DeepEqual(o.Value, p.Value) || !reflect.DeepEqual(o.ValueFrom, p.ValueFrom) {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// usesParameters returns true if the node's template refers to any of the named workflow parameters, or if its
+// template cannot be found
+func usesParameters(wf *wfv1.Workflow, node wfv1.NodeStatus, names []string) bool {
+	if len(names) == 0 {
+		return false
+	}
+	scope, resourceName := node.GetTemplateScope()
+	tmpl := wf.GetStoredTemplate(scope, resourceName, &node)
+	if tmpl == nil {
+		tmpl = wf.GetTemplateByName(node.TemplateName)
+	}
+	if tmpl == nil {
+		return true
+	}
+	data, err := json.Marshal(tmpl)
+	if err != nil {
+		return true
+	}
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+	return regexp.MustCompile(`workflow\.parameters(\.json|\[|\.(` + strings.Join(quoted, "|") + `)\b)`).Match(data)
+}
+
 // convertNodeID converts an old nodeID to a new nodeID
 func convertNodeID(newWf *wfv1.Workflow, regex *regexp.Regexp, oldNodeID string, oldNodes map[string]wfv1.NodeStatus) string {
 	node := oldNodes[oldNodeID]
@@ -734,12 +963,16 @@ func convertNodeID(newWf *wfv1.Workflow, regex *regexp.Regexp, oldNodeID string,
 	return newWf.NodeID(newNodeName)
 }
 
-// RetryWorkflow updates a workflow, deleting all failed steps as well as the onExit node (and children)
-func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
+// RetryWorkflow updates a workflow, deleting all failed steps as well as the onExit node (and children). The
+// parameters, e.g. "message=hello", override the workflow's arguments. The nodes in restartNodes, names or glob
+// patterns matching either the node's name or display name, are restarted, with their children, even if they
+// succeeded. If restartFrom, the name or display name of a node, is not empty, that node and every node after it are
+// restarted, and the workflow may have succeeded.
+func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string, parameters []string, restartNodes []string, restartFrom string) (*wfv1.Workflow, error) {
 	var updated *wfv1.Workflow
 	err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
 		var err error
-		updated, err = retryWorkflow(ctx, kubeClient, hydrator, wfClient, name, restartSuccessful, nodeFieldSelector)
+		updated, err = retryWorkflow(ctx, kubeClient, hydrator, wfClient, name, restartSuccessful, nodeFieldSelector, parameters, restartNodes, restartFrom)
 		return !errorsutil.IsTransientErr(err), err
 	})
 	if err != nil {
@@ -748,13 +981,17 @@ func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	return updated, err
 }
 
-func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
+func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string, parameters []string, restartNodes []string, restartFrom string) (*wfv1.Workflow, error) {
 	wf, err := wfClient.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 	switch wf.Status.Phase {
 	case wfv1.WorkflowFailed, wfv1.WorkflowError:
+	case wfv1.WorkflowSucceeded:
+		if restartFrom == "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry, or a node to restart from must be specified")
+		}
 	default:
 		return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
 	}
@@ -764,7 +1001,7 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	}
 
 	newWF := wf.DeepCopy()
-	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
+	podIf := kubeClient.CoreV1().Pods(wf.GetPodNamespace())
 
 	// Delete/reset fields which indicate workflow completed
 	delete(newWF.Labels, common.LabelKeyCompleted)
@@ -781,6 +1018,9 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		// if it was terminated, unset the deadline
 		newWF.Spec.ActiveDeadlineSeconds = nil
 	}
+	if err := overrideParameters(newWF, parameters); err != nil {
+		return nil, err
+	}
 
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
 	// Get all children of nodes that match filter
@@ -788,6 +1028,24 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	if err != nil {
 		return nil, err
 	}
+	restartNodeIDs, err := getNodeIDsToRestart(restartNodes, wf.Status.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	for id := range restartNodeIDs {
+		nodeIDsToReset[id] = true
+	}
+	if restartFrom != "" {
+		restartFromIDs, err := getNodeIDsToRestartFrom(restartFrom, wf.Status.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		for id := range restartFromIDs {
+			nodeIDsToReset[id] = true
+		}
+	}
+	// the steps, DAGs and retries the reset nodes are part of must run again too, even if they succeeded
+	nodeIDsToRerun := getAncestorIDsToRerun(nodeIDsToReset, wf.Status.Nodes)
 
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	deletedNodes := make(map[string]bool)
@@ -800,6 +1058,14 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		switch node.Phase {
 		case wfv1.NodeSucceeded, wfv1.NodeSkipped:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && !doForceResetNode {
+				if nodeIDsToRerun[node.ID] {
+					newNode := node.DeepCopy()
+					newNode.Phase = wfv1.NodeRunning
+					newNode.Message = ""
+					newNode.FinishedAt = metav1.Time{}
+					newWF.Status.Nodes[newNode.ID] = *newNode
+					continue
+				}
 				newWF.Status.Nodes[node.ID] = node
 				continue
 			}
@@ -913,6 +1179,137 @@ func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes w
 	return nodeIDsToReset, nil
 }
 
+// getNodeIDsToRestart returns the IDs of the nodes whose name or display name matches one of the names, which may be
+// glob patterns, and their children
+func getNodeIDsToRestart(names []string, nodes wfv1.Nodes) (map[string]bool, error) {
+	for _, name := range names {
+		if _, err := path.Match(name, ""); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "node name pattern %q is not valid: %v", name, err)
+		}
+	}
+	var ids []string
+	for _, node := range nodes {
+		for _, name := range names {
+			if nodeNameMatches(name, node) {
+				ids = append(ids, node.ID)
+				break
+			}
+		}
+	}
+	return getDescendantIDs(ids, nodes), nil
+}
+
+// getNodeIDsToRestartFrom returns the IDs of the nodes whose name or display name is the name, and every node after
+// them. The nodes after a node are its children, e.g. the tasks that depend on it, or the next group of steps.
+func getNodeIDsToRestartFrom(name string, nodes wfv1.Nodes) (map[string]bool, error) {
+	var ids []string
+	for _, node := range nodes {
+		if name == node.Name || name == node.DisplayName {
+			ids = append(ids, node.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.Errorf(errors.CodeNotFound, "node %q not found", name)
+	}
+	return getDescendantIDs(ids, nodes), nil
+}
+
+// getDescendantIDs returns the IDs, and the IDs of their children, recursively
+func getDescendantIDs(ids []string, nodes wfv1.Nodes) map[string]bool {
+	descendantIDs := make(map[string]bool)
+	queue := ids
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if !descendantIDs[id] {
+			descendantIDs[id] = true
+			queue = append(queue, nodes[id].Children...)
+		}
+	}
+	return descendantIDs
+}
+
+// getAncestorIDsToRerun returns the IDs of the steps, step groups, DAGs, task groups and retries that the nodes are the
+// children of, recursively, that are not in ids themselves
+func getAncestorIDsToRerun(ids map[string]bool, nodes wfv1.Nodes) map[string]bool {
+	parentIDs := make(map[string][]string)
+	for _, node := range nodes {
+		for _, childID := range node.Children {
+			parentIDs[childID] = append(parentIDs[childID], node.ID)
+		}
+	}
+	ancestorIDs := make(map[string]bool)
+	visited := make(map[string]bool)
+	var queue []string
+	for id := range ids {
+		queue = append(queue, parentIDs[id]...)
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if ids[id] || visited[id] {
+			continue
+		}
+		visited[id] = true
+		switch nodes[id].Type {
+		case wfv1.NodeTypeSteps, wfv1.NodeTypeStepGroup, wfv1.NodeTypeDAG, wfv1.NodeTypeTaskGroup, wfv1.NodeTypeRetry:
+			ancestorIDs[id] = true
+		}
+		// a pod that a reset node depends on is kept, but what it is part of must still run again
+		queue = append(queue, parentIDs[id]...)
+	}
+	return ancestorIDs
+}
+
+func nodeNameMatches(name string, node wfv1.NodeStatus) bool {
+	// node names often contain brackets, e.g. "my-wf[0].step", which are not literal in a pattern
+	if name == node.Name || name == node.DisplayName {
+		return true
+	}
+	for _, s := range []string{node.Name, node.DisplayName} {
+		if ok, _ := path.Match(name, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// overrideParameters sets the values of the workflow's arguments to the parameters, e.g. "message=hello". The
+// arguments of the stored workflow spec, which the controller executes, are set too.
+func overrideParameters(wf *wfv1.Workflow, parameters []string) error {
+	if len(parameters) == 0 {
+		return nil
+	}
+	var overrides []wfv1.Parameter
+	for _, p := range parameters {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 {
+			return errors.Errorf(errors.CodeBadRequest, "expected parameter of the form: NAME=VALUE. Received: %s", p)
+		}
+		overrides = append(overrides, wfv1.Parameter{Name: parts[0], Value: wfv1.AnyStringPtr(parts[1])})
+	}
+	override := func(arguments *wfv1.Arguments) {
+		for _, o := range overrides {
+			found := false
+			for i, param := range arguments.Parameters {
+				if param.Name == o.Name {
+					arguments.Parameters[i].Value = o.Value
+					arguments.Parameters[i].ValueFrom = nil
+					found = true
+				}
+			}
+			if !found {
+				arguments.Parameters = append(arguments.Parameters, *o.DeepCopy())
+			}
+		}
+	}
+	override(&wf.Spec.Arguments)
+	if wf.Status.StoredWorkflowSpec != nil {
+		override(&wf.Status.StoredWorkflowSpec.Arguments)
+	}
+	return nil
+}
+
 var errSuspendedCompletedWorkflow = errors.Errorf(errors.CodeBadRequest, "cannot suspend completed workflows")
 
 // IsWorkflowSuspended returns whether or not a workflow is considered suspended
@@ -44,7 +44,7 @@ spec:
 	newWf := wf.DeepCopy()
 	wfClientSet := argofake.NewSimpleClientset()
 	ctx := context.Background()
-	newWf, err := SubmitWorkflow(ctx, nil, wfClientSet, "test-namespace", newWf, &wfv1.SubmitOpts{DryRun: true})
+	newWf, err := SubmitWorkflow(ctx, nil, wfClientSet, wfClientSet, "test-namespace", newWf, &wfv1.SubmitOpts{DryRun: true})
 	assert.NoError(t, err)
 	assert.Equal(t, wf.Spec, newWf.Spec)
 	assert.Equal(t, wf.Status, newWf.Status)
@@ -68,7 +68,7 @@ func TestResubmitWorkflowWithOnExit(t *testing.T) {
 		Name:  onExitName,
 		Phase: wfv1.NodeSucceeded,
 	}
-	newWF, err := FormulateResubmitWorkflow(&wf, true)
+	newWF, err := FormulateResubmitWorkflow(&wf, true, nil)
 	assert.NoError(t, err)
 	newWFOnExitName := newWF.ObjectMeta.Name + ".onExit"
 	newWFOneExitID := newWF.NodeID(newWFOnExitName)
@@ -271,7 +271,7 @@ func TestResumeWorkflowByNodeName(t *testing.T) {
 	assert.NoError(t, err)
 
 	// will return error as displayName does not match any nodes
-	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=nonexistant")
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=nonexistant", "")
 	assert.Error(t, err)
 
 	// displayName didn't match suspend node so should still be running
@@ -279,7 +279,7 @@ func TestResumeWorkflowByNodeName(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes.FindByDisplayName("approve").Phase)
 
-	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "")
 	assert.NoError(t, err)
 
 	// displayName matched node so has succeeded
@@ -289,6 +289,91 @@ func TestResumeWorkflowByNodeName(t *testing.T) {
 	}
 }
 
+func TestApproveWorkflow(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	origWf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+	node := origWf.Status.Nodes.FindByDisplayName("approve")
+	node.Approval = &wfv1.ApprovalStatus{Gate: &wfv1.Approval{Approvers: []string{"alice"}, ApproverGroups: []string{"cab"}, RequiredApprovals: 2}}
+	origWf.Status.Nodes[node.ID] = *node
+
+	ctx := context.Background()
+	_, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", "alice")
+	assert.EqualError(t, err, "node approve is an approval gate, so it can only be approved, or rejected")
+	err = ApproveWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", Approver{}, true, "")
+	assert.EqualError(t, err, "approving, or rejecting, a workflow requires an identity, e.g. an SSO login")
+	err = ApproveWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", Approver{Name: "mallory"}, true, "")
+	assert.EqualError(t, err, "mallory is not an approver of node approve")
+
+	// approving twice counts once
+	for i := 0; i < 2; i++ {
+		err = ApproveWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve", Approver{Name: "alice"}, true, "")
+		assert.NoError(t, err)
+	}
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		node := wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, "approved by 1 of 2 required approvers", node.Message)
+	}
+
+	err = ApproveWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", Approver{Name: "bob", Groups: []string{"cab"}}, true, "")
+	assert.NoError(t, err)
+	wf, err = wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		node := wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "approved by alice, bob", node.Message)
+		assert.Len(t, node.Approval.Decisions, 3)
+	}
+
+	err = ApproveWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", Approver{Name: "alice"}, false, "")
+	assert.EqualError(t, err, "no active approval gates matching nodeFieldSelector: ")
+}
+
+func TestResumeWorkflowSkipsApprovalGates(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	origWf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+	gate := origWf.Status.Nodes.FindByDisplayName("approve").DeepCopy()
+	gate.ID, gate.Name, gate.DisplayName = "gate", "suspend.gate", "gate"
+	gate.Approval = &wfv1.ApprovalStatus{Gate: &wfv1.Approval{Approvers: []string{"alice"}}}
+	origWf.Status.Nodes[gate.ID] = *gate
+
+	ctx := context.Background()
+	_, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", "")
+	assert.NoError(t, err)
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes.FindByDisplayName("approve").Phase, "resumed")
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes.FindByDisplayName("gate").Phase, "still awaiting approval")
+	}
+}
+
+func TestResumeWorkflowResumedBy(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	ctx := context.Background()
+	_, err := wfIf.Create(ctx, wfv1.MustUnmarshalWorkflow(suspendedWf), metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	err = ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "", "alice")
+	assert.NoError(t, err)
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		node := wf.Status.Nodes.FindByDisplayName("approve")
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		if assert.NotNil(t, node.Approval) && assert.Len(t, node.Approval.Decisions, 1) {
+			assert.Equal(t, "alice", node.Approval.Decisions[0].Approver)
+			assert.True(t, node.Approval.Decisions[0].Approved)
+			assert.False(t, node.Approval.IsGated())
+		}
+	}
+}
+
 func TestStopWorkflowByNodeName(t *testing.T) {
 	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
 	origWf := wfv1.MustUnmarshalWorkflow(suspendedWf)
@@ -612,6 +697,52 @@ func TestApplySubmitOpts(t *testing.T) {
 	})
 }
 
+func TestParseParameterFile(t *testing.T) {
+	t.Run("YAML", func(t *testing.T) {
+		params, err := ParseParameterFile([]byte(`message: hello
+count: 3
+enabled: true
+escaped: "é <&> \"b\""
+empty: null
+config:
+  replicas: 2
+  regions: [eu, us]
+multiline: |
+  line 1
+  line 2
+`))
+		if assert.NoError(t, err) {
+			values := map[string]string{}
+			var names []string
+			for _, p := range params {
+				names = append(names, p.Name)
+				values[p.Name] = p.Value.String()
+			}
+			assert.Equal(t, []string{"config", "count", "empty", "enabled", "escaped", "message", "multiline"}, names)
+			assert.Equal(t, map[string]string{
+				"config":    `{"regions":["eu","us"],"replicas":2}`,
+				"count":     "3",
+				"empty":     "",
+				"enabled":   "true",
+				"escaped":   `é <&> "b"`,
+				"message":   "hello",
+				"multiline": "line 1\nline 2\n",
+			}, values)
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		params, err := ParseParameterFile([]byte(`{"config": {"replicas": 2}, "message": "hello"}`))
+		if assert.NoError(t, err) && assert.Len(t, params, 2) {
+			assert.Equal(t, `{"replicas":2}`, params[0].Value.String())
+			assert.Equal(t, "hello", params[1].Value.String())
+		}
+	})
+	t.Run("NotMap", func(t *testing.T) {
+		_, err := ParseParameterFile([]byte(`[a, b]`))
+		assert.Error(t, err)
+	})
+}
+
 func TestFormulateResubmitWorkflow(t *testing.T) {
 	t.Run("Labels", func(t *testing.T) {
 		wf := &wfv1.Workflow{
@@ -634,7 +765,7 @@ func TestFormulateResubmitWorkflow(t *testing.T) {
 				},
 			},
 		}
-		wf, err := FormulateResubmitWorkflow(wf, false)
+		wf, err := FormulateResubmitWorkflow(wf, false, nil)
 		if assert.NoError(t, err) {
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyControllerInstanceID)
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyClusterWorkflowTemplate)
@@ -650,6 +781,44 @@ func TestFormulateResubmitWorkflow(t *testing.T) {
 			assert.Equal(t, "testObj", wf.OwnerReferences[0].Name)
 		}
 	})
+	t.Run("Parameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+				Templates: []wfv1.Template{
+					{Name: "uses", Container: &v1.Container{Args: []string{"{{workflow.parameters.message}}"}}},
+					{Name: "ignores", Container: &v1.Container{}},
+				},
+			},
+			Status: wfv1.WorkflowStatus{
+				Phase: wfv1.WorkflowSucceeded,
+				Nodes: wfv1.Nodes{
+					"my-wf-1": {ID: "my-wf-1", Name: "my-wf.uses", Type: wfv1.NodeTypePod, TemplateName: "uses", Phase: wfv1.NodeSucceeded},
+					"my-wf-2": {ID: "my-wf-2", Name: "my-wf.ignores", Type: wfv1.NodeTypePod, TemplateName: "ignores", Phase: wfv1.NodeSucceeded},
+				},
+			},
+		}
+		_, err := FormulateResubmitWorkflow(wf.DeepCopy(), true, nil)
+		assert.EqualError(t, err, "workflow must be Failed/Error, or have its parameters overridden, to resubmit in memoized mode")
+		_, err = FormulateResubmitWorkflow(wf.DeepCopy(), false, []string{"message"})
+		assert.Error(t, err)
+		newWF, err := FormulateResubmitWorkflow(wf.DeepCopy(), true, []string{"message=goodbye"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "goodbye", newWF.Spec.Arguments.Parameters[0].Value.String())
+			assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[0].Value.String())
+			uses := newWF.GetNodeByName(newWF.Name + ".uses")
+			if assert.NotNil(t, uses) {
+				assert.Equal(t, wfv1.NodePending, uses.Phase)
+				assert.False(t, IsResubmittedNode(*uses))
+			}
+			ignores := newWF.GetNodeByName(newWF.Name + ".ignores")
+			if assert.NotNil(t, ignores) {
+				assert.Equal(t, wfv1.NodeSkipped, ignores.Phase)
+				assert.True(t, IsResubmittedNode(*ignores))
+			}
+		}
+	})
 }
 
 var deepDeleteOfNodes = `
@@ -811,7 +980,7 @@ func TestDeepDeleteNodes(t *testing.T) {
 	ctx := context.Background()
 	wf, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
 	if assert.NoError(t, err) {
-		newWf, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfIf, wf.Name, false, "")
+		newWf, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfIf, wf.Name, false, "", nil, nil, "")
 		assert.NoError(t, err)
 		newWfBytes, err := yaml.Marshal(newWf)
 		assert.NoError(t, err)
@@ -845,7 +1014,7 @@ func TestRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		assert.NoError(t, err)
-		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "")
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil, nil, "")
 		if assert.NoError(t, err) {
 			assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
 			assert.Equal(t, metav1.Time{}, wf.Status.FinishedAt)
@@ -882,7 +1051,7 @@ func TestRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		assert.NoError(t, err)
-		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "")
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil, nil, "")
 		if assert.NoError(t, err) {
 			if assert.Len(t, wf.Status.Nodes, 1) {
 				assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes[""].Phase)
@@ -890,6 +1059,128 @@ func TestRetryWorkflow(t *testing.T) {
 
 		}
 	})
+	t.Run("ParametersAndRestartNodes", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-overrides", Labels: map[string]string{}},
+			Spec:       wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}}},
+			Status: wfv1.WorkflowStatus{
+				Phase: wfv1.WorkflowFailed,
+				Nodes: map[string]wfv1.NodeStatus{
+					"failed-node":    {ID: "failed-node", Name: "failed-node", Phase: wfv1.NodeFailed},
+					"succeeded-node": {ID: "succeeded-node", Name: "succeeded-node", Phase: wfv1.NodeSucceeded},
+					"other-node":     {ID: "other-node", Name: "other-node", Phase: wfv1.NodeSucceeded}},
+			},
+		}
+		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", []string{"message=goodbye"}, []string{"succeeded-*"}, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "goodbye", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+			assert.NotContains(t, wf.Status.Nodes, "succeeded-node")
+			assert.Contains(t, wf.Status.Nodes, "other-node")
+		}
+	})
+	t.Run("RestartFrom", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-restart-from", Labels: map[string]string{}},
+			Status: wfv1.WorkflowStatus{
+				Phase: wfv1.WorkflowSucceeded,
+				Nodes: map[string]wfv1.NodeStatus{
+					"my-restart-from":   {ID: "my-restart-from", Name: "my-restart-from", Type: wfv1.NodeTypeDAG, Phase: wfv1.NodeSucceeded, Children: []string{"my-restart-from-1"}, FinishedAt: finishedTime},
+					"my-restart-from-1": {ID: "my-restart-from-1", Name: "my-restart-from.build", DisplayName: "build", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, Children: []string{"my-restart-from-2"}},
+					"my-restart-from-2": {ID: "my-restart-from-2", Name: "my-restart-from.test", DisplayName: "test", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, Children: []string{"my-restart-from-3"}},
+					"my-restart-from-3": {ID: "my-restart-from-3", Name: "my-restart-from.deploy", DisplayName: "deploy", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded},
+				},
+			},
+		}
+		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		_, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil, nil, "")
+		assert.EqualError(t, err, "workflow must be Failed/Error to retry, or a node to restart from must be specified")
+		_, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil, nil, "not-found")
+		assert.EqualError(t, err, `node "not-found" not found`)
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil, nil, "test")
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
+			if assert.Len(t, wf.Status.Nodes, 2) {
+				assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["my-restart-from"].Phase)
+				assert.Equal(t, metav1.Time{}, wf.Status.Nodes["my-restart-from"].FinishedAt)
+				assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["my-restart-from-1"].Phase)
+			}
+		}
+	})
+	t.Run("InvalidParameter", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-invalid-parameter", Labels: map[string]string{}},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed},
+		}
+		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		_, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", []string{"message"}, nil, "")
+		assert.EqualError(t, err, "expected parameter of the form: NAME=VALUE. Received: message")
+	})
+}
+
+func Test_getNodeIDsToRestart(t *testing.T) {
+	nodes := wfv1.Nodes{
+		"1": {ID: "1", Name: "my-wf[0].build", DisplayName: "build", Children: []string{"2"}},
+		"2": {ID: "2", Name: "my-wf[1].test", DisplayName: "test", Children: []string{"3"}},
+		"3": {ID: "3", Name: "my-wf[2].deploy", DisplayName: "deploy"},
+		"4": {ID: "4", Name: "my-wf[0].lint", DisplayName: "lint"},
+	}
+	t.Run("None", func(t *testing.T) {
+		ids, err := getNodeIDsToRestart(nil, nodes)
+		if assert.NoError(t, err) {
+			assert.Empty(t, ids)
+		}
+	})
+	t.Run("Name", func(t *testing.T) {
+		ids, err := getNodeIDsToRestart([]string{"my-wf[1].test"}, nodes)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"2": true, "3": true}, ids)
+		}
+	})
+	t.Run("DisplayName", func(t *testing.T) {
+		ids, err := getNodeIDsToRestart([]string{"build"}, nodes)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"1": true, "2": true, "3": true}, ids)
+		}
+	})
+	t.Run("Pattern", func(t *testing.T) {
+		ids, err := getNodeIDsToRestart([]string{"l*", "de*"}, nodes)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]bool{"3": true, "4": true}, ids)
+		}
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		_, err := getNodeIDsToRestart([]string{"["}, nodes)
+		assert.Error(t, err)
+	})
+}
+
+func Test_getAncestorIDsToRerun(t *testing.T) {
+	nodes := wfv1.Nodes{
+		"steps": {ID: "steps", Type: wfv1.NodeTypeSteps, Children: []string{"sg0"}},
+		"sg0":   {ID: "sg0", Type: wfv1.NodeTypeStepGroup, Children: []string{"a"}},
+		"a":     {ID: "a", Type: wfv1.NodeTypePod, Children: []string{"sg1"}},
+		"sg1":   {ID: "sg1", Type: wfv1.NodeTypeStepGroup, Children: []string{"b"}},
+		"b":     {ID: "b", Type: wfv1.NodeTypePod},
+	}
+	assert.Equal(t, map[string]bool{"steps": true, "sg0": true}, getAncestorIDsToRerun(map[string]bool{"sg1": true, "b": true}, nodes))
+	assert.Empty(t, getAncestorIDsToRerun(map[string]bool{"steps": true}, nodes))
+}
+
+func Test_overrideParameters(t *testing.T) {
+	wf := &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("1")}, {Name: "b", ValueFrom: &wfv1.ValueFrom{Default: wfv1.AnyStringPtr("0")}}}}},
+		Status: wfv1.WorkflowStatus{
+			StoredWorkflowSpec: &wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("1")}}}},
+		},
+	}
+	if assert.NoError(t, overrideParameters(wf, []string{"b=2", "c=3=4"})) {
+		assert.Equal(t, []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("1")}, {Name: "b", Value: wfv1.AnyStringPtr("2")}, {Name: "c", Value: wfv1.AnyStringPtr("3=4")}}, wf.Spec.Arguments.Parameters)
+		assert.Len(t, wf.Status.StoredWorkflowSpec.Arguments.Parameters, 3)
+	}
 }
 
 func TestFromUnstructuredObj(t *testing.T) {
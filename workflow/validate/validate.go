@@ -9,16 +9,22 @@ import (
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/antonmedv/expr"
 	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
+	cronutil "github.com/argoproj/argo-workflows/v3/util/cron"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
 	"github.com/argoproj/argo-workflows/v3/util/sorting"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/hdfs"
@@ -49,6 +55,18 @@ type ValidateOpts struct {
 	// Submit indicates that the current operation is a workflow submission. This will impose
 	// more stringent requirements (e.g. require input values for all spec arguments)
 	Submit bool
+
+	// ImageRules restricts the images of containers. Images that are parameters are not checked, the controller checks
+	// them when it creates the pods
+	ImageRules *config.ImageRules
+
+	// GrantedWorkflowTemplateGetter gets the workflow templates of other namespaces that template refs refer to. If
+	// unspecified, template refs cannot refer to workflow templates of other namespaces
+	GrantedWorkflowTemplateGetter templateresolution.GrantedWorkflowTemplateGetter
+
+	// StrictTemplateRefArguments rejects arguments of steps and tasks that are not inputs of their referenced
+	// templates, which are otherwise ignored
+	StrictTemplateRefArguments bool
 }
 
 // templateValidationCtx is the context for validating a workflow spec
@@ -112,7 +130,7 @@ var _ wfv1.ArgumentsProvider = &FakeArguments{}
 func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wf *wfv1.Workflow, opts ValidateOpts) (*wfv1.Conditions, error) {
 	wfConditions := &wfv1.Conditions{}
 	ctx := newTemplateValidationCtx(wf, opts)
-	tmplCtx := templateresolution.NewContext(wftmplGetter, cwftmplGetter, wf, wf)
+	tmplCtx := templateresolution.NewContext(wftmplGetter, cwftmplGetter, wf, wf).WithGrantedWorkflowTemplateGetter(opts.GrantedWorkflowTemplateGetter)
 	var wfSpecHolder wfv1.WorkflowSpecHolder
 	var wfTmplRef *wfv1.TemplateRef
 	var err error
@@ -163,6 +181,19 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if err != nil {
 		return nil, err
 	}
+	for _, param := range wfArgs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.ExternalSecret != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "spec.arguments.%s.valueFrom.externalSecret is only valid in the inputs of container and script templates", param.Name)
+		}
+	}
+	err = validateSecretProviders(wf.Spec.SecretProviders)
+	if err != nil {
+		return nil, err
+	}
+	err = validateVolumes("spec.volumes", wf.Spec.Volumes)
+	if err != nil {
+		return nil, err
+	}
 	if len(wfArgs.Parameters) > 0 {
 		ctx.globalParams[common.GlobalVarWorkflowParameters] = placeholderGenerator.NextPlaceholder()
 	}
@@ -227,6 +258,10 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		return nil, errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
 
+	if err := verifyNoUnconditionalRecursion(wf.Spec.Templates); err != nil {
+		return nil, err
+	}
+
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
 		_, err := ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: template.Name}, tmplCtx, &FakeArguments{})
@@ -237,10 +272,54 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	return wfConditions, nil
 }
 
+// validateSecretProviders validates that the secret providers have unique names, and exactly one secret store each
+func validateSecretProviders(providers wfv1.SecretProviders) error {
+	names := make(map[string]bool)
+	for i, p := range providers {
+		prefix := fmt.Sprintf("spec.secretProviders[%d]", i)
+		if p.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name is required", prefix)
+		}
+		if names[p.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name %q is not unique", prefix, p.Name)
+		}
+		names[p.Name] = true
+		switch {
+		case p.Vault != nil && p.AWSSecretsManager != nil:
+			return errors.Errorf(errors.CodeBadRequest, "%s must have exactly one of vault or awsSecretsManager", prefix)
+		case p.Vault != nil:
+			if p.Vault.Address == "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s.vault.address is required", prefix)
+			}
+			if p.Vault.Role == "" && p.Vault.TokenSecret == nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s.vault must have a role, or a tokenSecret", prefix)
+			}
+		case p.AWSSecretsManager != nil:
+			if p.AWSSecretsManager.Region == "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s.awsSecretsManager.region is required", prefix)
+			}
+			if (p.AWSSecretsManager.AccessKeySecret == nil) != (p.AWSSecretsManager.SecretKeySecret == nil) {
+				return errors.Errorf(errors.CodeBadRequest, "%s.awsSecretsManager must have both, or neither, of accessKeySecret and secretKeySecret", prefix)
+			}
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "%s must have exactly one of vault or awsSecretsManager", prefix)
+		}
+	}
+	return nil
+}
+
 func ValidateWorkflowTemplateRefFields(wfSpec wfv1.WorkflowSpec) error {
 	if len(wfSpec.Templates) > 0 {
 		return errors.Errorf(errors.CodeBadRequest, "Templates is invalid field in spec if workflow referred WorkflowTemplate reference")
 	}
+	if ref := wfSpec.WorkflowTemplateRef; ref != nil {
+		if ref.Revision < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "workflowTemplateRef.revision must not be negative")
+		}
+		if ref.Revision > 0 && ref.ClusterScope {
+			return errors.Errorf(errors.CodeBadRequest, "workflowTemplateRef.revision is not supported for cluster workflow templates")
+		}
+	}
 	return nil
 }
 
@@ -252,6 +331,7 @@ func ValidateWorkflowTemplate(wftmplGetter templateresolution.WorkflowTemplateNa
 
 	wf := &wfv1.Workflow{
 		ObjectMeta: v1.ObjectMeta{
+			Namespace:   wftmpl.ObjectMeta.Namespace,
 			Labels:      wftmpl.ObjectMeta.Labels,
 			Annotations: wftmpl.ObjectMeta.Annotations,
 		},
@@ -281,7 +361,7 @@ func ValidateClusterWorkflowTemplate(wftmplGetter templateresolution.WorkflowTem
 }
 
 // ValidateCronWorkflow validates a CronWorkflow
-func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, cronWf *wfv1.CronWorkflow) error {
+func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, cronWf *wfv1.CronWorkflow, opts ValidateOpts) error {
 	// CronWorkflows have fewer max chars allowed in their name because when workflows are created from them, they
 	// are appended with the unix timestamp (`-1615836720`). This lower character allowance allows for that timestamp
 	// to still fit within the 63 character maximum.
@@ -289,7 +369,28 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return fmt.Errorf("cron workflow name %q must not be more than 52 characters long (currently %d)", cronWf.Name, len(cronWf.Name))
 	}
 
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
+	if cronWf.Spec.Schedule != "" && len(cronWf.Spec.Schedules) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "only one of schedule or schedules may be specified")
+	}
+
+	if cronWf.Spec.Timezone != "" {
+		if _, err := time.LoadLocation(cronWf.Spec.Timezone); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "invalid timezone '%s': %s", cronWf.Spec.Timezone, err)
+		}
+	}
+
+	switch cronWf.Spec.DaylightSavingPolicy {
+	case wfv1.DaylightSavingSkip, wfv1.DaylightSavingFire, "":
+		// Do nothing
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid daylightSavingPolicy", cronWf.Spec.DaylightSavingPolicy)
+	}
+
+	if _, err := cronutil.SplayOffset(cronWf); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "invalid splay '%s': %s", cronWf.Spec.Splay, err)
+	}
+
+	if _, err := cronutil.ParseCronWorkflowSchedule(cronWf); err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
 	}
 
@@ -300,13 +401,54 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid concurrencyPolicy", cronWf.Spec.ConcurrencyPolicy)
 	}
 
+	switch cronWf.Spec.PreviousRunPolicy {
+	case wfv1.PreviousRunSkip, wfv1.PreviousRunDelay:
+		if cronWf.Spec.ConcurrencyPolicy == wfv1.ReplaceConcurrent {
+			return errors.Errorf(errors.CodeBadRequest, "previousRunPolicy cannot be used with concurrencyPolicy '%s'", wfv1.ReplaceConcurrent)
+		}
+	case "":
+		// Do nothing
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid previousRunPolicy", cronWf.Spec.PreviousRunPolicy)
+	}
+
 	if cronWf.Spec.StartingDeadlineSeconds != nil && *cronWf.Spec.StartingDeadlineSeconds < 0 {
 		return errors.Errorf(errors.CodeBadRequest, "startingDeadlineSeconds must be positive")
 	}
 
+	if catchup := cronWf.Spec.Catchup; catchup != nil {
+		switch catchup.Policy {
+		case wfv1.CatchupAll, wfv1.CatchupLatest, wfv1.CatchupNone, "":
+			// Do nothing
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid catchup.policy", catchup.Policy)
+		}
+		if catchup.Limit != nil && *catchup.Limit < 1 {
+			return errors.Errorf(errors.CodeBadRequest, "catchup.limit must be at least 1")
+		}
+	}
+
+	if stopStrategy := cronWf.Spec.StopStrategy; stopStrategy != nil {
+		if stopStrategy.SuspendAfterFailures != nil && *stopStrategy.SuspendAfterFailures < 1 {
+			return errors.Errorf(errors.CodeBadRequest, "stopStrategy.suspendAfterFailures must be at least 1")
+		}
+		if stopStrategy.Expression != "" {
+			if _, err := argoexpr.EvalBool(stopStrategy.Expression, (&wfv1.CronWorkflowStatus{}).GetStopStrategyEnv()); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "stopStrategy.expression is invalid: %s", err)
+			}
+		}
+	}
+
+	if cronWf.Spec.When != "" {
+		if _, err := expr.Compile(cronWf.Spec.When); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "when expression is invalid: %s", err)
+		}
+	}
+
 	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
+	wf.Namespace = cronWf.Namespace
 
-	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, opts)
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "cannot validate Workflow: %s", err)
 	}
@@ -327,6 +469,10 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		return err
 	}
 
+	if tmpl.Memoize != nil && tmpl.Memoize.Key == "" && !tmpl.Memoize.Auto {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.key is required, unless memoize.auto is true", tmpl.Name)
+	}
+
 	scope, err := validateInputs(tmpl)
 	if err != nil {
 		return err
@@ -403,6 +549,11 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	if err != nil {
 		return err
 	}
+	if newTmpl.OnShutdown != "" {
+		if err := ctx.validateOnShutdown(newTmpl, tmplCtx); err != nil {
+			return err
+		}
+	}
 	err = validateOutputs(scope, ctx.globalParams, newTmpl)
 	if err != nil {
 		return err
@@ -437,6 +588,21 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	return nil
 }
 
+// validateOnShutdown validates the template's onShutdown hook, which is run without arguments, and must run a container
+func (ctx *templateValidationCtx) validateOnShutdown(tmpl *wfv1.Template, tmplCtx *templateresolution.Context) error {
+	hookTmpl, err := ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: tmpl.OnShutdown}, tmplCtx, &wfv1.Arguments{})
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.onShutdown %s", tmpl.Name, err.Error())
+	}
+	if hookTmpl.Container == nil && hookTmpl.Script == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.onShutdown must be a container, or script, template", tmpl.Name)
+	}
+	if hookTmpl.OnShutdown != "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.onShutdown must not have an onShutdown hook of its own", tmpl.Name)
+	}
+	return nil
+}
+
 // validateTemplateHolder validates a template holder and returns the validated template.
 func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.TemplateReferenceHolder, tmplCtx *templateresolution.Context, args wfv1.ArgumentsProvider) (*wfv1.Template, error) {
 	tmplRef := tmplHolder.GetTemplateRef()
@@ -489,7 +655,7 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.Plugin} {
+	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.GRPC, tmpl.SQL, tmpl.Notification, tmpl.ChildWorkflow, tmpl.Plugin} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -517,6 +683,20 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
+		if param.Schema != nil {
+			if err := param.Schema.Validate(); err != nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.parameters.%s.schema %v", tmpl.Name, param.Name, err)
+			}
+		}
+		if param.ValueFrom != nil && param.ValueFrom.ExternalSecret != nil {
+			paramRef := fmt.Sprintf("templates.%s.inputs.parameters.%s.valueFrom.externalSecret", tmpl.Name, param.Name)
+			if tmplType := tmpl.GetType(); tmplType != wfv1.TemplateTypeContainer && tmplType != wfv1.TemplateTypeScript {
+				return nil, errors.Errorf(errors.CodeBadRequest, "%s is only valid in container and script templates", paramRef)
+			}
+			if param.ValueFrom.ExternalSecret.Provider == "" || param.ValueFrom.ExternalSecret.Path == "" {
+				return nil, errors.Errorf(errors.CodeBadRequest, "%s.provider and path are required", paramRef)
+			}
+		}
 	}
 	if len(tmpl.Inputs.Parameters) > 0 {
 		scope["inputs.parameters"] = true
@@ -588,6 +768,8 @@ func resolveAllVariables(scope map[string]interface{}, globalParams map[string]s
 			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowCreationTimestamp) {
 			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowCronScheduleTime) {
 				// Allow runtime resolution for "scheduledTime" which will pass from CronWorkflow
+			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowProfile+".") {
+				// Allow runtime resolution of the variables of the workflow's profile
 			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowDuration) {
 			} else {
 				return fmt.Errorf("failed to resolve {{%s}}", tag)
@@ -611,6 +793,178 @@ func validateNonLeaf(tmpl *wfv1.Template) error {
 	if tmpl.ActiveDeadlineSeconds != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds is only valid for leaf templates", tmpl.Name)
 	}
+	return validatePodFields(tmpl)
+}
+
+// validatePodFields validates the fields that are only applicable to templates that run pods
+func validatePodFields(tmpl *wfv1.Template) error {
+	if tmpl.CriticalStep && !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.criticalStep is only valid for templates that run pods", tmpl.Name)
+	}
+	switch tmpl.Spot {
+	case "", wfv1.SpotPolicyPrefer, wfv1.SpotPolicyRequire, wfv1.SpotPolicyAvoid:
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.spot must be one of prefer, require or avoid", tmpl.Name)
+	}
+	if tmpl.Spot != "" && !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.spot is only valid for templates that run pods", tmpl.Name)
+	}
+	if len(tmpl.Platforms) > 0 && !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.platforms is only valid for templates that run pods", tmpl.Name)
+	}
+	for i, platform := range tmpl.Platforms {
+		if _, _, err := platform.Parse(); err != nil && !strings.Contains(string(platform), "{{") {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.platforms[%d]: %v", tmpl.Name, i, err)
+		}
+	}
+	if tmpl.NetworkPolicy != nil {
+		if !tmpl.IsPodType() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.networkPolicy is only valid for templates that run pods", tmpl.Name)
+		}
+		if err := tmpl.NetworkPolicy.Validate(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.networkPolicy%s", tmpl.Name, err.Error())
+		}
+	}
+	if tmpl.RuntimeClassName != "" {
+		if !tmpl.IsPodType() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.runtimeClassName is only valid for templates that run pods", tmpl.Name)
+		}
+		if errs := apivalidation.IsDNS1123Subdomain(tmpl.RuntimeClassName); len(errs) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.runtimeClassName '%s' is not valid: %s", tmpl.Name, tmpl.RuntimeClassName, strings.Join(errs, ", "))
+		}
+	}
+	if tmpl.HostUsers != nil && !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.hostUsers is only valid for templates that run pods", tmpl.Name)
+	}
+	if tmpl.OnShutdown != "" && !tmpl.IsPodType() {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.onShutdown is only valid for templates that run pods", tmpl.Name)
+	}
+	if tmpl.ShutdownGracePeriodSeconds != nil {
+		if !tmpl.IsPodType() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.shutdownGracePeriodSeconds is only valid for templates that run pods", tmpl.Name)
+		}
+		if *tmpl.ShutdownGracePeriodSeconds < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.shutdownGracePeriodSeconds must not be negative", tmpl.Name)
+		}
+	}
+	if tmpl.DNSPolicy != nil || tmpl.DNSConfig != nil || len(tmpl.Sysctls) > 0 {
+		if !tmpl.IsPodType() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dnsPolicy, dnsConfig, and sysctls are only valid for templates that run pods", tmpl.Name)
+		}
+		if tmpl.DNSPolicy != nil && *tmpl.DNSPolicy == apiv1.DNSNone && (tmpl.DNSConfig == nil || len(tmpl.DNSConfig.Nameservers) == 0) {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.dnsConfig must list nameservers when dnsPolicy is None", tmpl.Name)
+		}
+		for _, sysctl := range tmpl.Sysctls {
+			if sysctl.Name == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.sysctls name is required", tmpl.Name)
+			}
+		}
+	}
+	if len(tmpl.Pipes) > 0 {
+		if tmpl.Container == nil && tmpl.Script == nil && tmpl.ContainerSet == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.pipes is only valid for container, script, and container set templates", tmpl.Name)
+		}
+		if err := tmpl.ValidatePipes(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.pipes%s", tmpl.Name, err.Error())
+		}
+	}
+	if err := validateCheckpoint(tmpl); err != nil {
+		return err
+	}
+	return validateVolumes(fmt.Sprintf("templates.%s.volumes", tmpl.Name), tmpl.Volumes)
+}
+
+// validateImages validates that the images of the template's containers are allowed by the rules
+func validateImages(tmpl *wfv1.Template, rules *config.ImageRules) error {
+	if rules == nil {
+		return nil
+	}
+	var images []string
+	switch {
+	case tmpl.Container != nil:
+		images = append(images, tmpl.Container.Image)
+	case tmpl.Script != nil:
+		images = append(images, tmpl.Script.Image)
+	case tmpl.ContainerSet != nil:
+		for _, c := range tmpl.ContainerSet.Containers {
+			images = append(images, c.Image)
+		}
+	}
+	for _, c := range tmpl.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range tmpl.Sidecars {
+		images = append(images, c.Image)
+	}
+	for _, image := range images {
+		// images of parameters are checked by the controller, when it creates the pods
+		if strings.Contains(image, "{{") || strings.Contains(image, "placeholder-") {
+			continue
+		}
+		if err := rules.Check(image); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s: %v", tmpl.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateCheckpoint validates that the checkpoint is in a volume of the main container, which the wait container can
+// save it from while the main container runs
+func validateCheckpoint(tmpl *wfv1.Template) error {
+	c := tmpl.Checkpoint
+	if c == nil {
+		return nil
+	}
+	if tmpl.GetType() != wfv1.TemplateTypeContainer && tmpl.GetType() != wfv1.TemplateTypeScript {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint is only valid for container and script templates", tmpl.Name)
+	}
+	if c.Path == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.path is required", tmpl.Name)
+	}
+	if common.FindOverlappingVolume(tmpl, c.Path) == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.path '%s' must be in a volume mount of the container", tmpl.Name, c.Path)
+	}
+	if c.Interval != "" {
+		if d, err := time.ParseDuration(c.Interval); err != nil || d <= 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.interval '%s' is not a positive duration", tmpl.Name, c.Interval)
+		}
+	}
+	return nil
+}
+
+// validateVolumes validates the ephemeral, and projected, volumes, which the API server would otherwise only reject
+// when the pods are created
+func validateVolumes(prefix string, volumes []apiv1.Volume) error {
+	for _, vol := range volumes {
+		if vol.Ephemeral != nil {
+			claim := vol.Ephemeral.VolumeClaimTemplate
+			if claim == nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s.%s.ephemeral.volumeClaimTemplate is required", prefix, vol.Name)
+			}
+			if len(claim.Spec.AccessModes) == 0 {
+				return errors.Errorf(errors.CodeBadRequest, "%s.%s.ephemeral.volumeClaimTemplate.spec.accessModes is required", prefix, vol.Name)
+			}
+			if _, ok := claim.Spec.Resources.Requests[apiv1.ResourceStorage]; !ok {
+				return errors.Errorf(errors.CodeBadRequest, "%s.%s.ephemeral.volumeClaimTemplate.spec.resources.requests.storage is required", prefix, vol.Name)
+			}
+		}
+		if vol.Projected != nil {
+			for i, source := range vol.Projected.Sources {
+				n := 0
+				for _, set := range []bool{source.Secret != nil, source.ConfigMap != nil, source.DownwardAPI != nil, source.ServiceAccountToken != nil} {
+					if set {
+						n++
+					}
+				}
+				if n != 1 {
+					return errors.Errorf(errors.CodeBadRequest, "%s.%s.projected.sources[%d] must have exactly one of secret, configMap, downwardAPI or serviceAccountToken", prefix, vol.Name, i)
+				}
+				if source.ServiceAccountToken != nil && source.ServiceAccountToken.Path == "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.%s.projected.sources[%d].serviceAccountToken.path is required", prefix, vol.Name, i)
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -671,12 +1025,129 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.manifest must be a valid yaml", tmpl.Name)
 			}
 		}
+		switch tmpl.Resource.ConditionLanguage {
+		case "", "selector", "expr":
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.conditionLanguage must be one of: selector, expr", tmpl.Name)
+		}
+		if !placeholderGenerator.IsPlaceholder(tmpl.Resource.MergeStrategy) {
+			switch tmpl.Resource.MergeStrategy {
+			case "", "strategic", "merge":
+			case "json":
+				if len(tmpl.Resource.Flags) == 0 {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.flags must specify the resource to patch when mergeStrategy is json", tmpl.Name)
+				}
+				if !placeholderGenerator.IsPlaceholder(tmpl.Resource.Manifest) {
+					var ops []map[string]interface{}
+					if err := yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &ops); err != nil {
+						return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.manifest must be a list of JSON patch operations when mergeStrategy is json", tmpl.Name)
+					}
+					for i, op := range ops {
+						if op["op"] == nil || op["path"] == nil {
+							return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.manifest[%d] must have an op and a path", tmpl.Name, i)
+						}
+					}
+				}
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.mergeStrategy must be one of: strategic, merge, json", tmpl.Name)
+			}
+		}
 	}
 	if tmpl.Script != nil {
-		if tmpl.Script.Image == "" {
+		if tmpl.Script.Image == "" && tmpl.Script.Runtime == "" {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	for i, sidecar := range tmpl.Sidecars {
+		if sidecar.WaitForReady && sidecar.ReadinessProbe == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sidecars[%d].readinessProbe must be specified if waitForReady is true", tmpl.Name, i)
+		}
+	}
+	if tmpl.Data != nil {
+		for i, step := range tmpl.Data.Transformation {
+			if err := step.Validate(); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d]: %s", tmpl.Name, i, err.Error())
+			}
+		}
+	}
+	if tmpl.HTTP != nil && tmpl.HTTP.Cache != nil {
+		if method := tmpl.HTTP.Method; method != "" && method != "GET" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.cache is only supported for GET requests", tmpl.Name)
+		}
+		if cache := tmpl.HTTP.Cache; !strings.Contains(cache.TTL, "{{") {
+			if ttl, err := cache.GetTTL(); err != nil || ttl <= 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.cache.ttl must be a positive duration, e.g. 5m", tmpl.Name)
+			}
+		}
+		if maxSize := tmpl.HTTP.Cache.MaxSize; maxSize != nil && maxSize.Sign() < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.cache.maxSize may not be negative", tmpl.Name)
+		}
+	}
+	if tmpl.GRPC != nil {
+		if tmpl.GRPC.Target == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.grpc.target may not be empty", tmpl.Name)
+		}
+		if !strings.Contains(tmpl.GRPC.Method, "/") && !strings.Contains(tmpl.GRPC.Method, "{{") {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.grpc.method must be the full name of the method, e.g. my.package.MyService/MyMethod", tmpl.Name)
+		}
+		if tls := tmpl.GRPC.TLS; tls != nil && (tls.ClientCertSecret == nil) != (tls.ClientKeySecret == nil) {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.grpc.tls.clientCertSecret and clientKeySecret must both be specified", tmpl.Name)
+		}
+	}
+	if tmpl.SQL != nil {
+		switch tmpl.SQL.Driver {
+		case "postgres", "mysql":
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sql.driver must be one of postgres, or mysql", tmpl.Name)
+		}
+		if tmpl.SQL.DSNSecret.Name == "" || tmpl.SQL.DSNSecret.Key == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sql.dsnSecret name and key must be specified", tmpl.Name)
+		}
+		if tmpl.SQL.Query == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sql.query may not be empty", tmpl.Name)
+		}
+		switch tmpl.SQL.Format {
+		case "", wfv1.SQLFormatJSON, wfv1.SQLFormatCSV:
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sql.format must be one of json, or csv", tmpl.Name)
+		}
+		if len(tmpl.Outputs.Artifacts) > 1 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts must have at most one artifact, which the rows are saved to", tmpl.Name)
+		}
+	}
+	if tmpl.Notification != nil {
+		switch tmpl.Notification.Provider {
+		case wfv1.NotificationProviderSlack, wfv1.NotificationProviderMSTeams:
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.notification.provider must be one of slack, or msteams", tmpl.Name)
+		}
+		if tmpl.Notification.WebhookURLSecret.Name == "" || tmpl.Notification.WebhookURLSecret.Key == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.notification.webhookURLSecret name and key must be specified", tmpl.Name)
+		}
+		if tmpl.Notification.Message == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.notification.message may not be empty", tmpl.Name)
+		}
+		if tmpl.Notification.Channel != "" && tmpl.Notification.Provider != wfv1.NotificationProviderSlack {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.notification.channel is only supported by slack", tmpl.Name)
+		}
+	}
+	if tmpl.ChildWorkflow != nil {
+		if tmpl.ChildWorkflow.WorkflowTemplateRef.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.childWorkflow.workflowTemplateRef.name is required", tmpl.Name)
+		}
+		if err := validateArgumentsFieldNames(fmt.Sprintf("templates.%s.childWorkflow.arguments.", tmpl.Name), tmpl.ChildWorkflow.Arguments); err != nil {
+			return err
+		}
+	}
+	if tmpl.Suspend != nil && tmpl.Suspend.Approval != nil {
+		approval := tmpl.Suspend.Approval
+		if approval.RequiredApprovals < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.approval.requiredApprovals must be >= 0", tmpl.Name)
+		}
+		if len(approval.Approvers) > 0 && len(approval.ApproverGroups) == 0 && approval.GetRequiredApprovals() > len(approval.Approvers) {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.approval.requiredApprovals must not be greater than the number of approvers", tmpl.Name)
+		}
+	}
 	// we don't validate tmpl.Plugin, because this is done by Plugin.UnmarshallJSON
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
@@ -689,6 +1160,12 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	if tmpl.Parallelism != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.parallelism is only valid for steps and dag templates", tmpl.Name)
 	}
+	if err := validatePodFields(tmpl); err != nil {
+		return err
+	}
+	if err := validateImages(tmpl, ctx.ImageRules); err != nil {
+		return err
+	}
 	var automountServiceAccountToken *bool
 	if tmpl.AutomountServiceAccountToken != nil {
 		automountServiceAccountToken = tmpl.AutomountServiceAccountToken
@@ -752,6 +1229,16 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 				return errors.Errorf(errors.CodeBadRequest, "%s%s.value should be present in %s%s.enum list", prefix, param.Name, prefix, param.Name)
 			}
 		}
+		if param.Schema != nil {
+			if err := param.Schema.Validate(); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.schema %v", prefix, param.Name, err)
+			}
+			if param.Value != nil && !strings.Contains(param.Value.String(), "{{") {
+				if err := param.Schema.ValidateValue(param.Value.String()); err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "%s%s %v", prefix, param.Name, err)
+				}
+			}
+		}
 	}
 	for _, art := range arguments.Artifacts {
 		if art.From == "" && !art.HasLocationOrKey() {
@@ -771,6 +1258,7 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 	}
 	stepNames := make(map[string]bool)
 	resolvedTemplates := make(map[string]*wfv1.Template)
+	templateRefOutputs := make(map[string]templateRefOutput)
 	for i, stepGroup := range tmpl.Steps {
 		for _, step := range stepGroup.Steps {
 			if step.Name == "" {
@@ -786,7 +1274,7 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			stepNames[step.Name] = true
 			prefix := fmt.Sprintf("steps.%s", step.Name)
 			scope[fmt.Sprintf("%s.status", prefix)] = true
-			err := addItemsToScope(step.WithItems, step.WithParam, step.WithSequence, scope)
+			err := addItemsToScope(step.WithItems, step.WithParam, step.WithSequence, step.WithArtifact, nil, scope)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
@@ -809,6 +1297,16 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			resolvedTemplates[step.Name] = resolvedTmpl
 		}
 
+		for _, step := range stepGroup.Steps {
+			stepBytes, err := json.Marshal(step)
+			if err != nil {
+				return errors.InternalWrapError(err)
+			}
+			if err := validateTemplateRefOutputReferences(templateRefOutputs, string(stepBytes)); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			}
+		}
+
 		stepBytes, err := json.Marshal(stepGroup)
 		if err != nil {
 			return errors.InternalWrapError(err)
@@ -819,10 +1317,17 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 		}
 
 		for _, step := range stepGroup.Steps {
-			aggregate := len(step.WithItems) > 0 || step.WithParam != ""
+			aggregate := len(step.WithItems) > 0 || step.WithParam != "" || step.WithArtifact != ""
 			resolvedTmpl := resolvedTemplates[step.Name]
 			ctx.addOutputsToScope(resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, aggregate, false)
 
+			if step.TemplateRef != nil {
+				if err := validateTemplateRefArguments(step.TemplateRef, step.Arguments, resolvedTmpl, ctx.StrictTemplateRefArguments); err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
+				templateRefOutputs[fmt.Sprintf("steps.%s", step.Name)] = templateRefOutput{tmplRef: step.TemplateRef, tmpl: resolvedTmpl}
+			}
+
 			// Validate the template again with actual arguments.
 			_, err = ctx.validateTemplateHolder(&step, tmplCtx, &step.Arguments)
 			if err != nil {
@@ -833,7 +1338,7 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 	return nil
 }
 
-func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
+func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, withArtifact string, matrix *wfv1.Matrix, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
 		defined++
@@ -844,8 +1349,14 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 	if withSequence != nil {
 		defined++
 	}
+	if withArtifact != "" {
+		defined++
+	}
+	if matrix != nil {
+		defined++
+	}
 	if defined > 1 {
-		return fmt.Errorf("only one of withItems, withParam, withSequence can be specified")
+		return fmt.Errorf("only one of withItems, withParam, withSequence, withArtifact, strategy.matrix can be specified")
 	}
 	if len(withItems) > 0 {
 		for i := range withItems {
@@ -865,7 +1376,7 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 				return fmt.Errorf("unsupported withItems type: %v", val)
 			}
 		}
-	} else if withParam != "" {
+	} else if withParam != "" || withArtifact != "" {
 		scope["item"] = true
 		// 'item.*' is magic placeholder value which resolveAllVariables() will look for
 		// when considering if all variables are resolveable.
@@ -875,10 +1386,62 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 			return errors.New(errors.CodeBadRequest, "only one of count or end can be defined in withSequence")
 		}
 		scope["item"] = true
+	} else if matrix != nil {
+		names, err := validateMatrix(matrix)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			scope[fmt.Sprintf("item.%s", name)] = true
+		}
 	}
 	return nil
 }
 
+// validateMatrix validates the matrix, and returns the keys of its items
+func validateMatrix(matrix *wfv1.Matrix) ([]string, error) {
+	if len(matrix.Axes) == 0 {
+		return nil, fmt.Errorf("strategy.matrix.axes must have at least one axis")
+	}
+	axes := make(map[string]bool)
+	var names []string
+	for i, axis := range matrix.Axes {
+		if errs := isValidParamOrArtifactName(axis.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("strategy.matrix.axes[%d].name '%s' is invalid: %s", i, axis.Name, strings.Join(errs, ";"))
+		}
+		if axes[axis.Name] {
+			return nil, fmt.Errorf("strategy.matrix.axes[%d].name '%s' is not unique", i, axis.Name)
+		}
+		if len(axis.Values) == 0 {
+			return nil, fmt.Errorf("strategy.matrix.axes.%s.values must have at least one value", axis.Name)
+		}
+		axes[axis.Name] = true
+		names = append(names, axis.Name)
+	}
+	for i, item := range matrix.Exclude {
+		if item.GetType() != wfv1.Map {
+			return nil, fmt.Errorf("strategy.matrix.exclude[%d] must be a map", i)
+		}
+		for key := range item.GetMapVal() {
+			if !axes[key] {
+				return nil, fmt.Errorf("strategy.matrix.exclude[%d].%s is not an axis", i, key)
+			}
+		}
+	}
+	for i, item := range matrix.Include {
+		if item.GetType() != wfv1.Map {
+			return nil, fmt.Errorf("strategy.matrix.include[%d] must be a map", i)
+		}
+		for key := range item.GetMapVal() {
+			if !axes[key] {
+				axes[key] = true
+				names = append(names, key)
+			}
+		}
+	}
+	return names, nil
+}
+
 func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix string, scope map[string]interface{}, aggregate bool, isAncestor bool) {
 	scope[fmt.Sprintf("%s.id", prefix)] = true
 	scope[fmt.Sprintf("%s.startedAt", prefix)] = true
@@ -888,6 +1451,7 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	}
 	if tmpl.HasOutput() {
 		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
+		scope[fmt.Sprintf("%s.outputs.stderr", prefix)] = true
 		scope[fmt.Sprintf("%s.exitCode", prefix)] = true
 	}
 	for _, param := range tmpl.Outputs.Parameters {
@@ -953,7 +1517,8 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
-		if tmpl.IsLeaf() {
+		// SQL templates save the rows of the query to their output artifact, rather than a file
+		if tmpl.IsLeaf() && tmpl.SQL == nil {
 			if art.Path == "" {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path not specified", tmpl.Name, artRef)
 			}
@@ -961,6 +1526,9 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 			if art.Path != "" {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path only valid in container/script templates", tmpl.Name, artRef)
 			}
+			if art.When != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.when only valid in container/script templates", tmpl.Name, artRef)
+			}
 		}
 		if art.GlobalName != "" && !isParameter(art.GlobalName) {
 			errs := isValidParamOrArtifactName(art.GlobalName)
@@ -979,13 +1547,31 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 			tmplType := tmpl.GetType()
 			switch tmplType {
 			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript:
-				if param.ValueFrom.Path == "" {
+				if param.ValueFrom.Path == "" && param.ValueFrom.LogsRegex == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
 				}
+				if param.ValueFrom.LogsRegex != "" {
+					if _, err := regexp.Compile(param.ValueFrom.LogsRegex); err != nil {
+						return errors.Errorf(errors.CodeBadRequest, "%s.logsRegex is invalid: %v", paramRef, err)
+					}
+				}
 			case wfv1.TemplateTypeResource:
 				if param.ValueFrom.JQFilter == "" && param.ValueFrom.JSONPath == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s .jqFilter or jsonPath must be specified for %s templates", paramRef, tmplType)
 				}
+			case wfv1.TemplateTypeHTTP:
+				if param.ValueFrom.JSONPath == "" && param.ValueFrom.Expression == "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.jsonPath or expression must be specified for %s templates", paramRef, tmplType)
+				}
+				if param.ValueFrom.JSONPath != "" {
+					if err := jsonpath.New("").Parse(param.ValueFrom.JSONPath); err != nil {
+						return errors.Errorf(errors.CodeBadRequest, "%s.jsonPath is invalid: %v", paramRef, err)
+					}
+				}
+			case wfv1.TemplateTypeWorkflow:
+				if param.ValueFrom.Parameter == "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.parameter, the name of the child workflow's output parameter, must be specified for %s templates", paramRef, tmplType)
+				}
 			case wfv1.TemplateTypeDAG, wfv1.TemplateTypeSteps:
 				if param.ValueFrom.Parameter == "" && param.ValueFrom.Expression == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.parameter or expression must be specified for %s templates", paramRef, tmplType)
@@ -1059,7 +1645,7 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 		return errors.Errorf(errors.CodeBadRequest, "%s does not have valueFrom or value specified", paramRef)
 	}
 	paramTypes := 0
-	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter, param.ValueFrom.Expression} {
+	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter, param.ValueFrom.Expression, param.ValueFrom.LogsRegex} {
 		if value != "" {
 			paramTypes++
 		}
@@ -1069,10 +1655,10 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	}
 	switch paramTypes {
 	case 0:
-		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression")
+		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression, logsRegex")
 	case 1:
 	default:
-		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw")
+		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw, logsRegex")
 	}
 	return nil
 }
@@ -1201,6 +1787,7 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 	}
 
 	resolvedTemplates := make(map[string]*wfv1.Template)
+	templateRefOutputs := make(map[string]templateRefOutput)
 
 	// Verify dependencies for all tasks can be resolved as well as template names
 	for _, task := range tmpl.DAG.Tasks {
@@ -1229,7 +1816,10 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		resolvedTemplates[task.Name] = resolvedTmpl
 
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
-		aggregate := len(task.WithItems) > 0 || task.WithParam != ""
+		if task.TemplateRef != nil {
+			templateRefOutputs[prefix] = templateRefOutput{tmplRef: task.TemplateRef, tmpl: resolvedTmpl}
+		}
+		aggregate := len(task.WithItems) > 0 || task.WithParam != "" || task.WithArtifact != "" || task.Strategy.GetMatrix() != nil
 		ctx.addOutputsToScope(resolvedTmpl, prefix, scope, aggregate, false)
 
 		err = common.ValidateTaskResults(&task)
@@ -1243,7 +1833,7 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 				return errors.Errorf(errors.CodeBadRequest,
 					"templates.%s.tasks.%s dependency '%s' not defined",
 					tmpl.Name, task.Name, depName)
-			} else if depType == common.DependencyTypeItems && len(task.WithItems) == 0 && task.WithParam == "" && task.WithSequence == nil {
+			} else if depType == common.DependencyTypeItems && !task.ShouldExpand() {
 				return errors.Errorf(errors.CodeBadRequest,
 					"templates.%s.tasks.%s dependency '%s' uses an items-based condition such as .AnySucceeded or .AllFailed but does not contain any items",
 					tmpl.Name, task.Name, depName)
@@ -1287,7 +1877,7 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			ancestorTask := dagValidationCtx.GetTask(ancestor)
 			resolvedTmpl := resolvedTemplates[ancestor]
 			ancestorPrefix := fmt.Sprintf("tasks.%s", ancestor)
-			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != ""
+			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != "" || ancestorTask.WithArtifact != "" || ancestorTask.Strategy.GetMatrix() != nil
 			ctx.addOutputsToScope(resolvedTmpl, ancestorPrefix, taskScope, aggregate, true)
 		}
 		if i := task.Inline; i != nil {
@@ -1296,7 +1886,11 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			}
 		}
 
-		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, taskScope)
+		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, task.WithArtifact, task.Strategy.GetMatrix(), taskScope)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
+		err = validateTemplateRefOutputReferences(templateRefOutputs, string(taskBytes))
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
@@ -1312,6 +1906,12 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		if task.TemplateRef != nil {
+			err = validateTemplateRefArguments(task.TemplateRef, task.Arguments, resolvedTmpl, ctx.StrictTemplateRefArguments)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+		}
 		// Validate the template again with actual arguments.
 		_, err = ctx.validateTemplateHolder(&task, tmplCtx, &task.Arguments)
 		if err != nil {
@@ -1322,6 +1922,90 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 	return nil
 }
 
+// templateRefString returns the template reference, in errors about its contract
+func templateRefString(tmplRef *wfv1.TemplateRef) string {
+	if tmplRef.ClusterScope {
+		return fmt.Sprintf("cluster template reference %s.%s", tmplRef.Name, tmplRef.Template)
+	}
+	return fmt.Sprintf("template reference %s.%s", tmplRef.Name, tmplRef.Template)
+}
+
+// namesOf returns the names, in errors about the contract of a template reference
+func namesOf(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// validateTemplateRefArguments validates the arguments of a step, or task, against the inputs its referenced template
+// declares, i.e. that it supplies every required input, which is otherwise only found when the workflow runs, and, if
+// strict, no inputs the template does not have, which are otherwise ignored
+func validateTemplateRefArguments(tmplRef *wfv1.TemplateRef, args wfv1.Arguments, tmpl *wfv1.Template, strict bool) error {
+	var paramNames, artNames []string
+	for _, p := range tmpl.Inputs.Parameters {
+		paramNames = append(paramNames, p.Name)
+		if p.Value == nil && p.Default == nil && p.ValueFrom == nil && args.GetParameterByName(p.Name) == nil {
+			return fmt.Errorf("arguments.parameters.%s is required by %s, but was not supplied", p.Name, templateRefString(tmplRef))
+		}
+	}
+	for _, a := range tmpl.Inputs.Artifacts {
+		artNames = append(artNames, a.Name)
+		if !a.Optional && !a.HasLocationOrKey() && args.GetArtifactByName(a.Name) == nil {
+			return fmt.Errorf("arguments.artifacts.%s is required by %s, but was not supplied", a.Name, templateRefString(tmplRef))
+		}
+	}
+	if !strict {
+		return nil
+	}
+	for _, p := range args.Parameters {
+		if tmpl.Inputs.GetParameterByName(p.Name) == nil {
+			return fmt.Errorf("arguments.parameters.%s is not an input of %s, whose input parameters are: %s", p.Name, templateRefString(tmplRef), namesOf(paramNames))
+		}
+	}
+	for _, a := range args.Artifacts {
+		if tmpl.Inputs.GetArtifactByName(a.Name) == nil {
+			return fmt.Errorf("arguments.artifacts.%s is not an input of %s, whose input artifacts are: %s", a.Name, templateRefString(tmplRef), namesOf(artNames))
+		}
+	}
+	return nil
+}
+
+// validateTemplateRefOutputReferences validates that the references to the outputs of the steps, or tasks, that run
+// referenced templates, e.g. "{{tasks.a.outputs.parameters.b}}", are to outputs the templates declare
+func validateTemplateRefOutputReferences(refs map[string]templateRefOutput, s string) error {
+	return template.Validate(s, func(tag string) error {
+		for prefix, ref := range refs {
+			var kind string
+			var names []string
+			switch {
+			case strings.HasPrefix(tag, prefix+".outputs.parameters."):
+				kind = "parameters"
+				for _, p := range ref.tmpl.Outputs.Parameters {
+					names = append(names, p.Name)
+				}
+			case strings.HasPrefix(tag, prefix+".outputs.artifacts."):
+				kind = "artifacts"
+				for _, a := range ref.tmpl.Outputs.Artifacts {
+					names = append(names, a.Name)
+				}
+			default:
+				continue
+			}
+			if name := strings.TrimPrefix(tag, prefix+".outputs."+kind+"."); !slice.ContainsString(names, name) {
+				return fmt.Errorf("{{%s}} is not an output of %s, whose output %s are: %s", tag, templateRefString(ref.tmplRef), kind, namesOf(names))
+			}
+		}
+		return nil
+	})
+}
+
+// templateRefOutput is the template a step, or task, references, whose outputs it has
+type templateRefOutput struct {
+	tmplRef *wfv1.TemplateRef
+	tmpl    *wfv1.Template
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
@@ -1409,6 +2093,62 @@ func verifyNoCycles(tmpl *wfv1.Template, ctx *dagValidationContext) error {
 	return nil
 }
 
+// verifyNoUnconditionalRecursion returns an error, with the cycle of templates, if a template calls itself, directly
+// or through other templates, without a condition, as it would recurse until the workflow exceeds its limits
+func verifyNoUnconditionalRecursion(templates []wfv1.Template) error {
+	calls := make(map[string][]string, len(templates))
+	for _, tmpl := range templates {
+		calls[tmpl.Name] = unconditionalCalls(&tmpl)
+	}
+	visited := make(map[string]bool)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		for i, caller := range path {
+			if caller == name {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s unconditionally recurses: %s -> %s", name, strings.Join(path[i:], " -> "), name)
+			}
+		}
+		if visited[name] {
+			return nil
+		}
+		path = append(path, name)
+		for _, callee := range calls[name] {
+			if err := visit(callee, path); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		return nil
+	}
+	for _, tmpl := range templates {
+		if err := visit(tmpl.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unconditionalCalls returns the local templates that the template always calls: those of the steps of its first
+// group, and of its DAG tasks without dependencies, that are neither conditional nor expanded
+func unconditionalCalls(tmpl *wfv1.Template) []string {
+	var names []string
+	if len(tmpl.Steps) > 0 {
+		for _, step := range tmpl.Steps[0].Steps {
+			if step.Template != "" && step.TemplateRef == nil && step.When == "" && !step.ShouldExpand() {
+				names = append(names, step.Template)
+			}
+		}
+	}
+	if tmpl.DAG != nil {
+		for _, task := range tmpl.DAG.Tasks {
+			if task.Template != "" && task.TemplateRef == nil && task.When == "" && !task.ShouldExpand() && task.Depends == "" && len(task.Dependencies) == 0 {
+				names = append(names, task.Template)
+			}
+		}
+	}
+	return names
+}
+
 func sortDAGTasks(tmpl *wfv1.Template, ctx *dagValidationContext) error {
 	taskMap := make(map[string]*wfv1.DAGTask, len(tmpl.DAG.Tasks))
 	sortingGraph := make([]*sorting.TopologicalSortingNode, len(tmpl.DAG.Tasks))
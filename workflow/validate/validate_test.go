@@ -6,12 +6,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/templategrant"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -979,6 +983,79 @@ spec:
           path: /abc
 `
 
+var sidecarWaitForReadyWithoutProbe = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: sidecar-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+    sidecars:
+    - name: db
+      image: postgres:14
+      waitForReady: true
+`
+
+func TestSidecarWaitForReady(t *testing.T) {
+	_, err := validate(sidecarWaitForReadyWithoutProbe)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.sidecars[0].readinessProbe must be specified if waitForReady is true")
+	}
+}
+
+var invalidOutputLogsRegex = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-param-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [sh, -c]
+      args: ["echo 'build id: 42'"]
+    outputs:
+      parameters:
+      - name: outparam
+        valueFrom:
+          logsRegex: "build id: (\\d+"
+`
+
+var validOutputLogsRegex = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-param-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [sh, -c]
+      args: ["echo 'build id: 42'"]
+    outputs:
+      parameters:
+      - name: outparam
+        valueFrom:
+          logsRegex: "build id: (\\d+)"
+`
+
+func TestOutputLogsRegex(t *testing.T) {
+	_, err := validate(validOutputLogsRegex)
+	assert.NoError(t, err)
+	_, err = validate(invalidOutputLogsRegex)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), ".logsRegex is invalid")
+	}
+}
+
 func TestInvalidOutputParam(t *testing.T) {
 	_, err := validate(invalidOutputParamNames)
 	if assert.NotNil(t, err) {
@@ -1813,6 +1890,84 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	assert.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
 }
 
+var invalidDataTransformationWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-data-
+spec:
+  entrypoint: list
+  templates:
+  - name: list
+    data:
+      source:
+        artifactPaths:
+          name: test-bucket
+          s3:
+            bucket: my-bucket
+      transformation:
+        - filter: item endsWith ".log"
+          chunk: 10
+`
+
+func TestInvalidDataTransformation(t *testing.T) {
+	wf := unmarshalWf(invalidDataTransformationWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.list.data.transformation[0]: exactly one of expression, filter, map or chunk must be specified")
+}
+
+var invalidJSONPatchResourceWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-resource-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    resource:
+      action: patch
+      mergeStrategy: json
+      flags: [configmap, whalesay-cm]
+      manifest: |
+        - value: bar
+`
+
+var invalidConditionLanguageResourceWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-resource-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    resource:
+      action: create
+      conditionLanguage: cel
+      successCondition: status.succeeded > 0
+      manifest: |
+        apiVersion: batch/v1
+        kind: Job
+        metadata:
+          name: whalesay-job
+`
+
+func TestInvalidResourceWorkflowPatchAndConditions(t *testing.T) {
+	wf := unmarshalWf(invalidJSONPatchResourceWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.manifest[0] must have an op and a path")
+
+	wf = unmarshalWf(invalidJSONPatchResourceWorkflow)
+	wf.Spec.Templates[0].Resource.Flags = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.flags must specify the resource to patch when mergeStrategy is json")
+
+	wf = unmarshalWf(invalidConditionLanguageResourceWorkflow)
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.conditionLanguage must be one of: selector, expr")
+}
+
 var invalidPodGC = `
 metadata:
   generateName: pod-gc-strategy-unknown-
@@ -2480,6 +2635,16 @@ func TestValidateFieldsWithWFTRef(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestValidateWorkflowTemplateRefRevision(t *testing.T) {
+	spec := wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-tmpl", Revision: 1}}
+	assert.NoError(t, ValidateWorkflowTemplateRefFields(spec))
+	spec.WorkflowTemplateRef.Revision = -1
+	assert.EqualError(t, ValidateWorkflowTemplateRefFields(spec), "workflowTemplateRef.revision must not be negative")
+	spec.WorkflowTemplateRef.Revision = 1
+	spec.WorkflowTemplateRef.ClusterScope = true
+	assert.EqualError(t, ValidateWorkflowTemplateRefFields(spec), "workflowTemplateRef.revision is not supported for cluster workflow templates")
+}
+
 var invalidWfNoImage = `apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
@@ -2908,10 +3073,157 @@ func TestMaxLengthName(t *testing.T) {
 	assert.EqualError(t, err, "cluster workflow template name \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" must not be more than 63 characters long (currently 70)")
 
 	cwf := &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 60)}}
-	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{})
 	assert.EqualError(t, err, "cron workflow name \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" must not be more than 52 characters long (currently 60)")
 }
 
+func TestValidateCronWorkflowSchedules(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedules:    []string{"0 * * * 1-5", "0 6 * * 6"},
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.Schedules = []string{"0 * * * 1-5", "0 6 * * 8"}
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "cron schedule is malformed: schedule \"0 6 * * 8\": end of range (8) above maximum (6): 8")
+
+	cwf.Spec.Schedules = nil
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "cron schedule is malformed: there must be at least one schedule")
+
+	cwf.Spec.Schedule = "0 * * * *"
+	cwf.Spec.Schedules = []string{"0 6 * * 6"}
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "only one of schedule or schedules may be specified")
+}
+
+func TestValidateCronWorkflowCatchup(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:     "0 * * * *",
+			Catchup:      &wfv1.Catchup{Policy: wfv1.CatchupAll},
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.Catchup.Policy = "Some"
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "'Some' is not a valid catchup.policy")
+
+	limit := int32(0)
+	cwf.Spec.Catchup = &wfv1.Catchup{Limit: &limit}
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "catchup.limit must be at least 1")
+}
+
+func TestValidateCronWorkflowDaylightSaving(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:             "30 2 * * *",
+			Timezone:             "America/Los_Angeles",
+			DaylightSavingPolicy: wfv1.DaylightSavingFire,
+			WorkflowSpec:         wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.DaylightSavingPolicy = "Twice"
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "'Twice' is not a valid daylightSavingPolicy")
+
+	cwf.Spec.DaylightSavingPolicy = wfv1.DaylightSavingSkip
+	cwf.Spec.Timezone = "America/Los_Angles"
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "invalid timezone 'America/Los_Angles': unknown time zone America/Los_Angles")
+}
+
+func TestValidateCronWorkflowSplay(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:     "0 0 * * *",
+			Splay:        "5m",
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.Splay = "-5m"
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "invalid splay '-5m': splay must not be negative")
+}
+
+func TestValidateCronWorkflowStopStrategy(t *testing.T) {
+	failures := int32(5)
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:     "0 0 * * *",
+			StopStrategy: &wfv1.StopStrategy{SuspendAfterFailures: &failures, Expression: "failed > 10 && succeeded == 0"},
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.StopStrategy.Expression = "failed"
+	err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "stopStrategy.expression is invalid")
+	}
+
+	failures = 0
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "stopStrategy.suspendAfterFailures must be at least 1")
+}
+
+func TestValidateCronWorkflowPreviousRunPolicy(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:          "0 0 * * *",
+			PreviousRunPolicy: wfv1.PreviousRunDelay,
+			WorkflowSpec:      wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.ConcurrencyPolicy = wfv1.ReplaceConcurrent
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "previousRunPolicy cannot be used with concurrencyPolicy 'Replace'")
+
+	cwf.Spec.PreviousRunPolicy = "Wait"
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), "'Wait' is not a valid previousRunPolicy")
+}
+
+func TestValidateCronWorkflowInterval(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedules:    []string{"@every 37m", "R5/2021-10-01T00:00:00Z/PT6H"},
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.Schedules = []string{"R/PT"}
+	assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}), `cron schedule is malformed: repeating interval "R/PT" has an invalid ISO 8601 duration`)
+}
+
+func TestValidateCronWorkflowWhen(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule:     "0 0 * * *",
+			When:         "weekday != 'Saturday' && configMaps.flags.enabled == 'true'",
+			WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Suspend: &wfv1.SuspendTemplate{}}}},
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{}))
+
+	cwf.Spec.When = "weekday !="
+	err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "when expression is invalid")
+	}
+}
+
 var invalidContainerSetDependencyNotFound = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3288,3 +3600,1361 @@ func TestInitContainerHasName(t *testing.T) {
 	_, err := validate(testInitContainerHasName)
 	assert.EqualError(t, err, "templates.main.tasks.spurious initContainers must all have container name")
 }
+
+var testGRPCTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: grpc-template-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: method
+      value: grpc.health.v1.Health/Check
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: method
+        value: "{{workflow.parameters.method}}"
+    grpc:
+      target: my-service:50051
+      method: "{{inputs.parameters.method}}"
+`
+
+func TestGRPCTemplate(t *testing.T) {
+	wf := unmarshalWf(testGRPCTemplate)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("EmptyTarget", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].GRPC.Target = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.grpc.target may not be empty")
+	})
+	t.Run("InvalidMethod", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].GRPC.Method = "Check"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.grpc.method must be the full name of the method, e.g. my.package.MyService/MyMethod")
+	})
+	t.Run("ClientCertWithoutKey", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].GRPC.TLS = &wfv1.GRPCTLS{ClientCertSecret: &apiv1.SecretKeySelector{Key: "tls.crt"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.grpc.tls.clientCertSecret and clientKeySecret must both be specified")
+	})
+}
+
+var testHTTPCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-cache-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      url: https://example.com/status
+      cache:
+        key: status
+        ttl: 1m
+        maxSize: 64Ki
+`
+
+func TestHTTPCache(t *testing.T) {
+	wf := unmarshalWf(testHTTPCache)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NotGET", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].HTTP.Method = "POST"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.http.cache is only supported for GET requests")
+	})
+	t.Run("InvalidTTL", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].HTTP.Cache.TTL = "0s"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.http.cache.ttl must be a positive duration, e.g. 5m")
+	})
+}
+
+var testSQLTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: sql-template-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: query
+        template: query
+      - name: process
+        template: process
+        depends: query
+        arguments:
+          parameters:
+          - name: id
+            value: "{{item.id}}"
+        withParam: "{{tasks.query.outputs.result}}"
+  - name: query
+    sql:
+      driver: postgres
+      dsnSecret:
+        name: my-db
+        key: dsn
+      query: select id from items where status = $1
+      args:
+      - pending
+  - name: process
+    inputs:
+      parameters:
+      - name: id
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestSQLTemplate(t *testing.T) {
+	wf := unmarshalWf(testSQLTemplate)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("InvalidDriver", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].SQL.Driver = "sqlite3"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.sql.driver must be one of postgres, or mysql")
+	})
+	t.Run("NoDSNSecret", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].SQL.DSNSecret = apiv1.SecretKeySelector{}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.sql.dsnSecret name and key must be specified")
+	})
+	t.Run("EmptyQuery", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].SQL.Query = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.sql.query may not be empty")
+	})
+	t.Run("InvalidFormat", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].SQL.Format = "xml"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.sql.format must be one of json, or csv")
+	})
+	t.Run("OutputArtifact", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Outputs.Artifacts = wfv1.Artifacts{{Name: "rows"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+		wf.Spec.Templates[1].Outputs.Artifacts[0].Path = "/tmp/rows.json"
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.outputs.artifacts.rows.path only valid in container/script templates")
+		wf.Spec.Templates[1].Outputs.Artifacts = wfv1.Artifacts{{Name: "rows"}, {Name: "more-rows"}}
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.query templates.query.outputs.artifacts must have at most one artifact, which the rows are saved to")
+	})
+}
+
+var testNotificationTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: notification-template-
+spec:
+  entrypoint: main
+  onExit: notify
+  templates:
+    - name: main
+      container:
+        image: argoproj/argosay:v2
+    - name: notify
+      notification:
+        provider: slack
+        webhookURLSecret:
+          name: slack
+          key: url
+        channel: "#my-team"
+        title: "{{workflow.name}} {{workflow.status}}"
+        message: "{{workflow.failures}}"
+`
+
+func TestNotificationTemplate(t *testing.T) {
+	wf := unmarshalWf(testNotificationTemplate)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("InvalidProvider", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Notification.Provider = "email"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.notify.notification.provider must be one of slack, or msteams")
+	})
+	t.Run("NoWebhookURLSecret", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Notification.WebhookURLSecret = apiv1.SecretKeySelector{}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.notify.notification.webhookURLSecret name and key must be specified")
+	})
+	t.Run("EmptyMessage", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Notification.Message = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.notify.notification.message may not be empty")
+	})
+	t.Run("MSTeamsChannel", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Notification.Provider = wfv1.NotificationProviderMSTeams
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.notify.notification.channel is only supported by slack")
+	})
+}
+
+var testScriptRuntime = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: script-runtime-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      runtime: python311-slim
+      source: print("hello")
+`
+
+func TestScriptRuntime(t *testing.T) {
+	wf := unmarshalWf(testScriptRuntime)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	wf.Spec.Templates[0].Script.Runtime = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.script.image may not be empty")
+}
+
+func TestWithArtifact(t *testing.T) {
+	t.Run("Steps", func(t *testing.T) {
+		wf := unmarshalWf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: with-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: generate
+        template: generate
+    - - name: process
+        template: process
+        arguments:
+          parameters:
+          - name: id
+            value: "{{item.id}}"
+        withArtifact: "{{steps.generate.outputs.artifacts.items}}"
+  - name: generate
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: process
+    inputs:
+      parameters:
+      - name: id
+    container:
+      image: argoproj/argosay:v2
+`)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+		wf.Spec.Templates[0].Steps[1].Steps[0].WithParam = "[]"
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[1].process only one of withItems, withParam, withSequence, withArtifact, strategy.matrix can be specified")
+	})
+	t.Run("DAG", func(t *testing.T) {
+		wf := unmarshalWf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: with-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: items
+        raw:
+          data: '["foo", "bar"]'
+    dag:
+      tasks:
+      - name: process
+        template: process
+        arguments:
+          parameters:
+          - name: id
+            value: "{{item}}"
+        withArtifact: "{{inputs.artifacts.items}}"
+      - name: after
+        template: process
+        depends: process.AnySucceeded
+        arguments:
+          parameters:
+          - name: id
+            value: after
+  - name: process
+    inputs:
+      parameters:
+      - name: id
+    container:
+      image: argoproj/argosay:v2
+`)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+}
+
+var testOutputArtifactWhen = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-artifact-when-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: report
+      value: "true"
+  templates:
+  - name: main
+    steps:
+    - - name: generate
+        template: generate
+    outputs:
+      parameters:
+      - name: has-report
+        valueFrom:
+          expression: "exists(steps.generate.outputs.artifacts.report)"
+  - name: generate
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: report
+        path: /tmp/report.txt
+        optional: true
+        when: "{{workflow.parameters.report}} == true"
+`
+
+func TestOutputArtifactWhen(t *testing.T) {
+	wf := unmarshalWf(testOutputArtifactWhen)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("StepsTemplate", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Outputs.Artifacts = wfv1.Artifacts{{Name: "report", From: "{{steps.generate.outputs.artifacts.report}}", When: "true"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.outputs.artifacts.report.when only valid in container/script templates")
+	})
+	t.Run("UnknownVariable", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Outputs.Artifacts[0].When = "{{inputs.parameters.report}} == true"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.Error(t, err)
+	})
+}
+
+var testMatrix = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: matrix-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: build
+        template: build
+        arguments:
+          parameters:
+          - name: os
+            value: "{{item.os}}"
+          - name: arch
+            value: "{{item.arch}}"
+        strategy:
+          matrix:
+            axes:
+            - name: os
+              values: [linux, windows]
+            - name: arch
+              values: [amd64, arm64]
+            exclude:
+            - os: windows
+              arch: arm64
+      - name: report
+        depends: build
+        template: report
+        arguments:
+          parameters:
+          - name: results
+            value: "{{tasks.build.outputs.parameters}}"
+  - name: build
+    inputs:
+      parameters:
+      - name: os
+      - name: arch
+    container:
+      image: argoproj/argosay:v2
+  - name: report
+    inputs:
+      parameters:
+      - name: results
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestMatrix(t *testing.T) {
+	wf := unmarshalWf(testMatrix)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	matrix := func(wf *wfv1.Workflow) *wfv1.Matrix {
+		return wf.Spec.Templates[0].DAG.Tasks[0].Strategy.Matrix
+	}
+	t.Run("WithItems", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].DAG.Tasks[0].WithParam = `["linux"]`
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build only one of withItems, withParam, withSequence, withArtifact, strategy.matrix can be specified")
+	})
+	t.Run("NoAxes", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		matrix(wf).Axes = nil
+		matrix(wf).Exclude = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build strategy.matrix.axes must have at least one axis")
+	})
+	t.Run("DuplicateAxis", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		matrix(wf).Axes[1].Name = "os"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build strategy.matrix.axes[1].name 'os' is not unique")
+	})
+	t.Run("NoValues", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		matrix(wf).Axes[1].Values = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build strategy.matrix.axes.arch.values must have at least one value")
+	})
+	t.Run("ExcludeNotAnAxis", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		matrix(wf).Exclude[0] = wfv1.Item{Value: []byte(`{"version": "1.17"}`)}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build strategy.matrix.exclude[0].version is not an axis")
+	})
+	t.Run("Include", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		matrix(wf).Include = []wfv1.Item{{Value: []byte(`{"os": "linux", "cgo": "true"}`)}}
+		wf.Spec.Templates[0].DAG.Tasks[0].When = "{{item.cgo}} == true"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+		matrix(wf).Include = []wfv1.Item{{Value: []byte(`"linux"`)}}
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.build strategy.matrix.include[0] must be a map")
+	})
+	t.Run("UnknownAxis", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].DAG.Tasks[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{item.version}}")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.Error(t, err)
+	})
+}
+
+var testUnconditionalRecursion = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: recursion-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: a
+  - name: a
+    dag:
+      tasks:
+      - name: b
+        template: b
+  - name: b
+    steps:
+    - - name: main
+        template: main
+`
+
+func TestUnconditionalRecursion(t *testing.T) {
+	wf := unmarshalWf(testUnconditionalRecursion)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main unconditionally recurses: main -> a -> b -> main")
+	t.Run("When", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[2].Steps[0].Steps[0].When = "{{workflow.name}} == foo"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+	t.Run("Depends", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates = append(wf.Spec.Templates, wfv1.Template{Name: "c", Container: &apiv1.Container{Image: "argoproj/argosay:v2"}})
+		wf.Spec.Templates[1].DAG.Tasks = []wfv1.DAGTask{{Name: "c", Template: "c"}, {Name: "b", Template: "b", Depends: "c"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+	t.Run("Self", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].DAG.Tasks[0].Template = "a"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.a unconditionally recurses: a -> a")
+	})
+}
+
+var testChildWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: child
+        template: child
+      - name: print
+        depends: child
+        template: print
+        arguments:
+          parameters:
+          - name: result
+            value: "{{tasks.child.outputs.parameters.result}}"
+  - name: child
+    childWorkflow:
+      workflowTemplateRef:
+        name: my-wftmpl
+      arguments:
+        parameters:
+        - name: message
+          value: "{{workflow.name}}"
+    outputs:
+      parameters:
+      - name: result
+        valueFrom:
+          parameter: message
+  - name: print
+    inputs:
+      parameters:
+      - name: result
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestChildWorkflow(t *testing.T) {
+	wf := unmarshalWf(testChildWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NoWorkflowTemplateRef", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].ChildWorkflow.WorkflowTemplateRef.Name = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.child templates.child.childWorkflow.workflowTemplateRef.name is required")
+	})
+	t.Run("OutputParameter", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Outputs.Parameters[0].ValueFrom = &wfv1.ValueFrom{Expression: "workflow.name"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.child templates.child.outputs.parameters.result.parameter, the name of the child workflow's output parameter, must be specified for Workflow templates")
+	})
+	t.Run("MultipleTypes", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Container = &apiv1.Container{Image: "argoproj/argosay:v2"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.Error(t, err)
+	})
+}
+
+var testApproval = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: approval-
+spec:
+  entrypoint: approve
+  templates:
+  - name: approve
+    suspend:
+      approval:
+        approvers:
+        - alice@example.com
+        - bob@example.com
+        requiredApprovals: 2
+`
+
+func TestApproval(t *testing.T) {
+	wf := unmarshalWf(testApproval)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NegativeRequiredApprovals", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Suspend.Approval.RequiredApprovals = -1
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.approve.suspend.approval.requiredApprovals must be >= 0")
+	})
+	t.Run("TooManyRequiredApprovals", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Suspend.Approval.RequiredApprovals = 3
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.approve.suspend.approval.requiredApprovals must not be greater than the number of approvers")
+	})
+	t.Run("ApproverGroups", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Suspend.Approval.RequiredApprovals = 3
+		wf.Spec.Templates[0].Suspend.Approval.ApproverGroups = []string{"change-advisory-board"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+}
+
+var testCriticalStep = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: critical-step-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+  - name: train
+    criticalStep: true
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestCriticalStep(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].CriticalStep = true
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.criticalStep is only valid for templates that run pods")
+	})
+	t.Run("Suspend", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Container = nil
+		wf.Spec.Templates[1].Suspend = &wfv1.SuspendTemplate{}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[0].train templates.train.criticalStep is only valid for templates that run pods")
+	})
+}
+
+func TestSpot(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].Spot = wfv1.SpotPolicyAvoid
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Invalid", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Spot = "always"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[0].train templates.train.spot must be one of prefer, require or avoid")
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Spot = wfv1.SpotPolicyPrefer
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.spot is only valid for templates that run pods")
+	})
+}
+
+func TestPlatforms(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].Platforms = []wfv1.Platform{"linux/amd64", "linux/arm64"}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Invalid", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Platforms = []wfv1.Platform{"linux/amd64", "arm64"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main.steps[0].train templates.train.platforms[1]: platform "arm64" must be os/arch, e.g. linux/arm64`)
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Platforms = []wfv1.Platform{"linux/arm64"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.platforms is only valid for templates that run pods")
+	})
+}
+
+func TestNetworkPolicy(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].NetworkPolicy = &wfv1.TemplateNetworkPolicy{Type: wfv1.NetworkPolicyIsolated}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Invalid", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].NetworkPolicy.Type = wfv1.NetworkPolicyEgressAllowlist
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[0].train templates.train.networkPolicy.egressAllowlist is required for the EgressAllowlist type")
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].NetworkPolicy = &wfv1.TemplateNetworkPolicy{Type: wfv1.NetworkPolicyIsolated}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.networkPolicy is only valid for templates that run pods")
+	})
+}
+
+func TestSandbox(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].RuntimeClassName = "gvisor"
+	wf.Spec.Templates[1].HostUsers = pointer.BoolPtr(false)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Invalid", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].RuntimeClassName = "gVisor"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.runtimeClassName 'gVisor' is not valid")
+		}
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].HostUsers = pointer.BoolPtr(false)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.hostUsers is only valid for templates that run pods")
+	})
+}
+
+func TestPodTuning(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	dnsNone := apiv1.DNSNone
+	wf.Spec.Templates[1].DNSPolicy = &dnsNone
+	wf.Spec.Templates[1].DNSConfig = &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.53"}}
+	wf.Spec.Templates[1].Sysctls = []apiv1.Sysctl{{Name: "net.core.somaxconn", Value: "1024"}}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NoNameservers", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].DNSConfig = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.dnsConfig must list nameservers when dnsPolicy is None")
+		}
+	})
+	t.Run("NoSysctlName", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Sysctls[0].Name = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.sysctls name is required")
+		}
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Sysctls = wf.Spec.Templates[1].Sysctls
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.dnsPolicy, dnsConfig, and sysctls are only valid for templates that run pods")
+	})
+}
+
+func TestPipes(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].Sidecars = []wfv1.UserContainer{{Container: apiv1.Container{Name: "sidecar", Image: "argoproj/argosay:v2"}}}
+	wf.Spec.Templates[1].Pipes = []wfv1.ContainerPipe{{Name: "results", From: "main", To: "sidecar"}}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NotAContainer", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Pipes[0].To = "consumer"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.pipes.results.to 'consumer' is not a container of the template")
+		}
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Pipes = wf.Spec.Templates[1].Pipes
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.pipes is only valid for container, script, and container set templates")
+	})
+}
+
+func TestImageRules(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{ImageRules: &config.ImageRules{Allow: []string{"argoproj/*"}}})
+	assert.NoError(t, err)
+	t.Run("NotAllowed", func(t *testing.T) {
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{ImageRules: &config.ImageRules{Allow: []string{"my-registry.io/"}}})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `templates.train: image "argoproj/argosay:v2" is not allowed`)
+		}
+	})
+	t.Run("Parameter", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Arguments.Parameters = []wfv1.Parameter{{Name: "image"}}
+		wf.Spec.Templates[1].Container.Image = "{{workflow.parameters.image}}"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{Lint: true, ImageRules: &config.ImageRules{RequireDigest: true}})
+		assert.NoError(t, err)
+	})
+}
+
+var testEphemeralVolumes = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: ephemeral-volumes-
+spec:
+  entrypoint: main
+  volumes:
+  - name: token
+    projected:
+      sources:
+      - serviceAccountToken:
+          path: token
+          audience: vault
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+      volumeMounts:
+      - name: scratch
+        mountPath: /scratch
+      - name: token
+        mountPath: /var/run/token
+    volumes:
+    - name: scratch
+      ephemeral:
+        volumeClaimTemplate:
+          spec:
+            accessModes: [ReadWriteOnce]
+            storageClassName: local-nvme
+            resources:
+              requests:
+                storage: 100Gi
+`
+
+func TestEphemeralVolumes(t *testing.T) {
+	wf := unmarshalWf(testEphemeralVolumes)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NoVolumeClaimTemplate", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Volumes[0].Ephemeral.VolumeClaimTemplate = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.volumes.scratch.ephemeral.volumeClaimTemplate is required")
+	})
+	t.Run("NoStorage", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Volumes[0].Ephemeral.VolumeClaimTemplate.Spec.Resources.Requests = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.volumes.scratch.ephemeral.volumeClaimTemplate.spec.resources.requests.storage is required")
+	})
+	t.Run("ProjectedSources", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Volumes[0].Projected.Sources[0].ConfigMap = &apiv1.ConfigMapProjection{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-config"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.volumes.token.projected.sources[0] must have exactly one of secret, configMap, downwardAPI or serviceAccountToken")
+	})
+	t.Run("ServiceAccountTokenPath", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Volumes[0].Projected.Sources[0].ServiceAccountToken.Path = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.volumes.token.projected.sources[0].serviceAccountToken.path is required")
+	})
+}
+
+func TestProfileVariables(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Profile = "my-profile"
+	wf.Spec.Templates[1].Container.Image = "{{workflow.profile.image}}"
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+}
+
+var httpTemplateOutputParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-output-parameters-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      url: https://example.com
+    outputs:
+      parameters:
+      - name: status
+        valueFrom:
+          jsonPath: "{.status}"
+      - name: id
+        valueFrom:
+          expression: "response.headers['X-Request-Id'][0]"
+`
+
+func TestHTTPTemplateOutputParameters(t *testing.T) {
+	_, err := validate(httpTemplateOutputParameters)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(httpTemplateOutputParameters, `jsonPath: "{.status}"`, `path: /tmp/status`, 1))
+	assert.EqualError(t, err, "templates.main.outputs.parameters.status.jsonPath or expression must be specified for HTTP templates")
+	_, err = validate(strings.Replace(httpTemplateOutputParameters, `jsonPath: "{.status}"`, `jsonPath: "{.status"`, 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.outputs.parameters.status.jsonPath is invalid")
+	}
+}
+
+var externalSecrets = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: external-secrets-
+spec:
+  entrypoint: main
+  secretProviders:
+  - name: vault
+    vault:
+      address: https://vault.vault:8200
+      role: my-role
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: password
+        valueFrom:
+          externalSecret:
+            provider: vault
+            path: secret/data/db
+            key: password
+    container:
+      image: my-image
+      command: [cat, "{{inputs.parameters.password}}"]
+`
+
+func TestExternalSecrets(t *testing.T) {
+	_, err := validate(externalSecrets)
+	assert.NoError(t, err)
+	_, err = validate(strings.Replace(externalSecrets, "      role: my-role\n", "", 1))
+	assert.EqualError(t, err, "spec.secretProviders[0].vault must have a role, or a tokenSecret")
+	_, err = validate(strings.Replace(externalSecrets, "    vault:\n      address: https://vault.vault:8200\n      role: my-role\n", "", 1))
+	assert.EqualError(t, err, "spec.secretProviders[0] must have exactly one of vault or awsSecretsManager")
+	_, err = validate(strings.Replace(externalSecrets, "            path: secret/data/db\n", "", 1))
+	assert.EqualError(t, err, "templates.main.inputs.parameters.password.valueFrom.externalSecret.provider and path are required")
+	_, err = validate(strings.Replace(externalSecrets, `    container:
+      image: my-image
+      command: [cat, "{{inputs.parameters.password}}"]`, `    steps:
+    - - name: a
+        template: main`, 1))
+	assert.EqualError(t, err, "templates.main.inputs.parameters.password.valueFrom.externalSecret is only valid in container and script templates")
+}
+
+var testCheckpoint = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: checkpoint-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+  - name: train
+    checkpoint:
+      path: /checkpoint/model.pt
+      interval: 10m
+    volumes:
+    - name: checkpoint
+      emptyDir: {}
+    container:
+      image: argoproj/argosay:v2
+      volumeMounts:
+      - name: checkpoint
+        mountPath: /checkpoint
+`
+
+func TestCheckpoint(t *testing.T) {
+	wf := unmarshalWf(testCheckpoint)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NotInVolume", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Checkpoint.Path = "/tmp/model.pt"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.checkpoint.path '/tmp/model.pt' must be in a volume mount of the container")
+		}
+	})
+	t.Run("Interval", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Checkpoint.Interval = "10"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.checkpoint.interval '10' is not a positive duration")
+		}
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Checkpoint = wf.Spec.Templates[1].Checkpoint
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.checkpoint is only valid for container and script templates")
+	})
+}
+
+func TestMemoizeAuto(t *testing.T) {
+	wf := unmarshalWf(testCriticalStep)
+	wf.Spec.Templates[1].Memoize = &wfv1.Memoize{Auto: true, Cache: &wfv1.Cache{ConfigMap: &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-cache"}}}}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("NoKey", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Memoize.Auto = false
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.memoize.key is required, unless memoize.auto is true")
+		}
+	})
+}
+
+var testOnShutdown = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: on-shutdown-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+  - name: train
+    onShutdown: flush
+    shutdownGracePeriodSeconds: 60
+    container:
+      image: argoproj/argosay:v2
+  - name: flush
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestOnShutdown(t *testing.T) {
+	wf := unmarshalWf(testOnShutdown)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Undefined", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].OnShutdown = "missing"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.onShutdown template name 'missing' undefined")
+		}
+	})
+	t.Run("Steps", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].OnShutdown = "main"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.onShutdown must be a container, or script, template")
+		}
+	})
+	t.Run("Nested", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].OnShutdown = "train"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.onShutdown must not have an onShutdown hook of its own")
+		}
+	})
+	t.Run("NotPod", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].ShutdownGracePeriodSeconds = pointer.Int64Ptr(60)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.shutdownGracePeriodSeconds is only valid for templates that run pods")
+	})
+	t.Run("NegativeGracePeriod", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].ShutdownGracePeriodSeconds = pointer.Int64Ptr(-1)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.train.shutdownGracePeriodSeconds must not be negative")
+		}
+	})
+}
+
+var templateRefContractTmpl = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: template-ref-contract
+spec:
+  templates:
+  - name: train
+    inputs:
+      parameters:
+      - name: epochs
+      - name: rate
+        default: "0.1"
+      artifacts:
+      - name: data
+        path: /data
+    outputs:
+      parameters:
+      - name: accuracy
+        valueFrom:
+          path: /accuracy
+    container:
+      image: argoproj/argosay:v2
+`
+
+var templateRefContractWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: template-ref-contract-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: train
+        templateRef:
+          name: template-ref-contract
+          template: train
+        arguments:
+          parameters:
+          - name: epochs
+            value: "10"
+          artifacts:
+          - name: data
+            raw:
+              data: my-data
+      - name: report
+        dependencies: [train]
+        template: report
+        arguments:
+          parameters:
+          - name: accuracy
+            value: "{{tasks.train.outputs.parameters.accuracy}}"
+  - name: report
+    inputs:
+      parameters:
+      - name: accuracy
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestTemplateRefContract(t *testing.T) {
+	err := createWorkflowTemplate(templateRefContractTmpl)
+	assert.NoError(t, err)
+	wf := unmarshalWf(templateRefContractWf)
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("MissingParameter", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].DAG.Tasks[0].Arguments.Parameters = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.train arguments.parameters.epochs is required by template reference template-ref-contract.train, but was not supplied")
+	})
+	t.Run("MissingArtifact", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].DAG.Tasks[0].Arguments.Artifacts = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.train arguments.artifacts.data is required by template reference template-ref-contract.train, but was not supplied")
+	})
+	t.Run("UnknownParameter", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		args := &wf.Spec.Templates[0].DAG.Tasks[0].Arguments
+		args.Parameters = append(args.Parameters, wfv1.Parameter{Name: "epoch", Value: wfv1.AnyStringPtr("10")})
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{StrictTemplateRefArguments: true})
+		assert.EqualError(t, err, "templates.main.tasks.train arguments.parameters.epoch is not an input of template reference template-ref-contract.train, whose input parameters are: epochs, rate")
+	})
+	t.Run("UnknownArtifact", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		args := &wf.Spec.Templates[0].DAG.Tasks[0].Arguments
+		args.Artifacts = append(args.Artifacts, wfv1.Artifact{Name: "model", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "my-model"}}})
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{StrictTemplateRefArguments: true})
+		assert.EqualError(t, err, "templates.main.tasks.train arguments.artifacts.model is not an input of template reference template-ref-contract.train, whose input artifacts are: data")
+	})
+	t.Run("UnknownOutput", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].DAG.Tasks[1].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{tasks.train.outputs.parameters.loss}}")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.tasks.report {{tasks.train.outputs.parameters.loss}} is not an output of template reference template-ref-contract.train, whose output parameters are: accuracy")
+	})
+	t.Run("UnknownStepOutput", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		tasks := wf.Spec.Templates[0].DAG.Tasks
+		wf.Spec.Templates[0].DAG = nil
+		wf.Spec.Templates[0].Steps = []wfv1.ParallelSteps{
+			{Steps: []wfv1.WorkflowStep{{Name: "train", TemplateRef: tasks[0].TemplateRef, Arguments: tasks[0].Arguments}}},
+			{Steps: []wfv1.WorkflowStep{{Name: "report", Template: "report", Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+				{Name: "accuracy", Value: wfv1.AnyStringPtr("{{steps.train.outputs.artifacts.accuracy}}")},
+			}}}}},
+		}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[1].report {{steps.train.outputs.artifacts.accuracy}} is not an output of template reference template-ref-contract.train, whose output artifacts are: none")
+	})
+}
+
+var grantedTemplateRefWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: granted-template-ref-
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        templateRef:
+          namespace: shared
+          name: shared-template
+          template: hello
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+`
+
+func TestGrantedTemplateRef(t *testing.T) {
+	ctx := context.Background()
+	wfClientset := fakewfclientset.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "shared-template"},
+			Spec: wfv1.WorkflowTemplateSpec{WorkflowSpec: wfv1.WorkflowSpec{Templates: []wfv1.Template{{
+				Name:      "hello",
+				Inputs:    wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "message"}}},
+				Container: &apiv1.Container{Image: "argoproj/argosay:v2", Args: []string{"echo", "{{inputs.parameters.message}}"}},
+			}}}},
+		},
+	)
+	opts := ValidateOpts{GrantedWorkflowTemplateGetter: templategrant.NewClientsetGetter(ctx, "default", wfClientset)}
+	_, err := validateWithOptions(grantedTemplateRefWorkflow, opts)
+	assert.EqualError(t, err, "templates.main.steps[0].hello workflow template shared/shared-template is not granted to namespace default, by a workflow template grant in namespace shared")
+
+	_, err = wfClientset.ArgoprojV1alpha1().WorkflowTemplateGrants("shared").Create(ctx, &wfv1.WorkflowTemplateGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       wfv1.WorkflowTemplateGrantSpec{Namespaces: []string{"default"}},
+	}, metav1.CreateOptions{})
+	if assert.NoError(t, err) {
+		_, err = validateWithOptions(grantedTemplateRefWorkflow, opts)
+		assert.NoError(t, err)
+	}
+}
+
+var parameterSchemaWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameter-schema-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: env
+      value: prod
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: env
+        schema:
+          enum: [dev, prod]
+    steps:
+    - - name: deploy
+        template: deploy
+        arguments:
+          parameters:
+          - name: env
+            value: "{{inputs.parameters.env}}"
+          - name: replicas
+            value: "3"
+  - name: deploy
+    inputs:
+      parameters:
+      - name: env
+      - name: replicas
+        schema:
+          required: true
+          pattern: ^[0-9]+$
+          minimum: 1
+          maximum: 10
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestParameterSchema(t *testing.T) {
+	wf := unmarshalWf(parameterSchemaWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	t.Run("Enum", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("staging")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main inputs.parameters.env value "staging" must be one of: dev, prod`)
+	})
+	t.Run("Maximum", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Steps[0].Steps[0].Arguments.Parameters[1].Value = wfv1.AnyStringPtr("11")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main.steps[0].deploy templates.deploy inputs.parameters.replicas value "11" must be at most 10`)
+	})
+	t.Run("Variable", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Steps[0].Steps[0].Arguments.Parameters[1].Value = wfv1.AnyStringPtr("{{workflow.name}}")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+	t.Run("Arguments", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Arguments.Parameters[0].Schema = &wfv1.ParameterSchema{Pattern: "^[a-z]+$"}
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("Prod")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `spec.arguments.env value "Prod" must match "^[a-z]+$"`)
+	})
+	t.Run("InvalidSchema", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[1].Inputs.Parameters[1].Schema.Minimum = wfv1.NewAmount(20)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.steps[0].deploy templates.deploy.inputs.parameters.replicas.schema minimum 20 must not be greater than maximum 10")
+	})
+	t.Run("WorkflowTemplate", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Arguments.Parameters = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{WorkflowTemplateValidation: true})
+		assert.NoError(t, err)
+	})
+}